@@ -310,7 +310,7 @@ func New(
 		plansmoduletypes.StoreKey,
 		// this line is used by starport scaffolding # stargate/app/storeKey
 	)
-	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey)
+	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey, projectsmoduletypes.TStoreKey)
 	memKeys := sdk.NewMemoryStoreKeys(capabilitytypes.MemStoreKey)
 
 	app := &LavaApp{
@@ -454,10 +454,10 @@ func New(
 		appCodec,
 		keys[projectsmoduletypes.StoreKey],
 		keys[projectsmoduletypes.MemStoreKey],
+		tkeys[projectsmoduletypes.TStoreKey],
 		app.GetSubspace(projectsmoduletypes.ModuleName),
 		app.EpochstorageKeeper,
 	)
-	projectsModule := projectsmodule.NewAppModule(appCodec, app.ProjectsKeeper)
 
 	app.PairingKeeper = *pairingmodulekeeper.NewKeeper(
 		appCodec,
@@ -483,9 +483,12 @@ func New(
 		app.BankKeeper,
 		app.AccountKeeper,
 		&app.EpochstorageKeeper,
-		app.ProjectsKeeper,
+		&app.ProjectsKeeper,
 		app.PlansKeeper,
 	)
+	// the subscription keeper holds the projects keeper by reference so its calls reach the hooks
+	app.ProjectsKeeper.SetHooks(app.SubscriptionKeeper.Hooks())
+	projectsModule := projectsmodule.NewAppModule(appCodec, app.ProjectsKeeper)
 	subscriptionModule := subscriptionmodule.NewAppModule(appCodec, app.SubscriptionKeeper, app.AccountKeeper, app.BankKeeper)
 
 	app.ConflictKeeper = *conflictmodulekeeper.NewKeeper(
@@ -499,6 +502,7 @@ func New(
 		app.PairingKeeper,
 		app.EpochstorageKeeper,
 		app.SpecKeeper,
+		app.DistrKeeper,
 	)
 	conflictModule := conflictmodule.NewAppModule(appCodec, app.ConflictKeeper, app.AccountKeeper, app.BankKeeper)
 
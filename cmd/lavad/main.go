@@ -9,8 +9,10 @@ import (
 	svrcmd "github.com/cosmos/cosmos-sdk/server/cmd"
 	"github.com/lavanet/lava/app"
 	"github.com/lavanet/lava/cmd/lavad/cmd"
+	"github.com/lavanet/lava/protocol/badgeserver"
 	"github.com/lavanet/lava/protocol/rpcconsumer"
 	"github.com/lavanet/lava/protocol/rpcprovider"
+	"github.com/spf13/cobra"
 )
 
 const (
@@ -30,6 +32,20 @@ func main() {
 	rootCmd.AddCommand(cmdRPCConsumer)
 	// Add RPC Provider Command
 	rootCmd.AddCommand(cmdRPCProvider)
+	// Add Provider Proofs Command
+	rootCmd.AddCommand(rpcprovider.CreateProviderProofsCobraCommand())
+	// Add Consumer Reconcile Command
+	rootCmd.AddCommand(rpcconsumer.CreateConsumerReconcileCobraCommand())
+	// Add Badge Server Command
+	rootCmd.AddCommand(badgeserver.CreateBadgeServerCobraCommand())
+
+	// Add Test Commands, used for debugging the protocol with a specific provider
+	testCmd := &cobra.Command{
+		Use:   "test",
+		Short: "Test commands for debugging the protocol",
+	}
+	testCmd.AddCommand(rpcconsumer.CreateTestRelayCobraCommand())
+	rootCmd.AddCommand(testCmd)
 
 	if err := svrcmd.Execute(rootCmd, app.DefaultNodeHome); err != nil {
 		switch e := err.(type) {
@@ -5,6 +5,7 @@ go 1.18
 require (
 	github.com/99designs/keyring v1.1.6
 	github.com/btcsuite/btcd v0.22.1
+	github.com/btcsuite/btcd/btcec/v2 v2.2.0
 	github.com/cosmos/cosmos-sdk v0.45.11
 	github.com/cosmos/ibc-go/v3 v3.0.1
 	github.com/ethereum/go-ethereum v1.10.18
@@ -33,6 +34,7 @@ require (
 	github.com/cosmos/gogoproto v1.4.3
 	github.com/docker/distribution v2.8.1+incompatible
 	github.com/fullstorydev/grpcurl v1.8.5
+	github.com/go-redis/redis v6.15.9+incompatible
 	github.com/gogo/status v1.1.0
 	github.com/golang/protobuf v1.5.3
 	github.com/ignite-hq/cli v0.22.1-0.20220610070456-1b33c09fceb7
@@ -40,10 +42,10 @@ require (
 	github.com/joho/godotenv v1.3.0
 	github.com/newrelic/go-agent/v3 v3.20.4
 	github.com/spf13/pflag v1.0.5
+	go.etcd.io/bbolt v1.3.6
 )
 
 require (
-	github.com/btcsuite/btcd/btcec/v2 v2.2.0 // indirect
 	github.com/creachadair/taskgroup v0.3.2 // indirect
 	github.com/decred/dcrd/dcrec/secp256k1/v4 v4.0.1 // indirect
 	github.com/ghodss/yaml v1.0.0 // indirect
@@ -187,9 +189,8 @@ require (
 	github.com/xanzy/ssh-agent v0.2.1 // indirect
 	github.com/xo/terminfo v0.0.0-20210125001918-ca9a967f8778 // indirect
 	github.com/zondax/hid v0.9.0 // indirect
-	go.etcd.io/bbolt v1.3.6 // indirect
 	go.opencensus.io v0.23.0 // indirect
-	golang.org/x/crypto v0.1.0 // indirect
+	golang.org/x/crypto v0.1.0
 	golang.org/x/exp v0.0.0-20220722155223-a9213eeb770e
 	golang.org/x/net v0.7.0
 	golang.org/x/sync v0.1.0 // indirect
@@ -0,0 +1,287 @@
+package badgeserver
+
+import (
+	"bufio"
+	"context"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gofiber/fiber/v2"
+	"github.com/lavanet/lava/app"
+	"github.com/lavanet/lava/protocol/lavaprotocol"
+	"github.com/lavanet/lava/utils"
+	"github.com/lavanet/lava/utils/sigs"
+	epochstoragetypes "github.com/lavanet/lava/x/epochstorage/types"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	"github.com/spf13/cobra"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+)
+
+const (
+	CuAllocationFlagName          = "cu-allocation"
+	AuthTokensFileFlagName        = "auth-tokens-file"
+	MaxBadgesPerRequesterFlagName = "max-badges-per-requester"
+	MaxBadgesPerEpochFlagName     = "max-badges-per-epoch"
+	DefaultCuAllocation           = 10000
+	DefaultMaxBadgesPerRequester  = 100
+	DefaultMaxBadgesPerEpoch      = 1000
+)
+
+var (
+	ErrUnauthenticated = fmt.Errorf("missing or unknown badge server token")
+	ErrQuotaExceeded   = fmt.Errorf("badge quota of the epoch exceeded")
+)
+
+// BadgeAccess is who may request badges and how many, every badge is paid by the project so none is granted anonymously
+type BadgeAccess struct {
+	Tokens                map[string]string // by bearer token, the name of the requester it authenticates
+	MaxBadgesPerRequester uint64            // badges a requester is granted per epoch
+	MaxBadgesPerEpoch     uint64            // badges all requesters are granted per epoch together
+}
+
+func (ba BadgeAccess) validate() error {
+	if len(ba.Tokens) == 0 {
+		return fmt.Errorf("badge server needs at least one auth token")
+	}
+	if ba.MaxBadgesPerRequester == 0 || ba.MaxBadgesPerEpoch == 0 {
+		return fmt.Errorf("badge quotas must be positive, per requester %d per epoch %d", ba.MaxBadgesPerRequester, ba.MaxBadgesPerEpoch)
+	}
+	return nil
+}
+
+// requester returns the requester a token authenticates, comparing in constant time so tokens can't be guessed by timing
+func (ba BadgeAccess) requester(token string) (string, bool) {
+	if token == "" {
+		return "", false
+	}
+	found := ""
+	for knownToken, requester := range ba.Tokens {
+		if subtle.ConstantTimeCompare([]byte(knownToken), []byte(token)) == 1 {
+			found = requester
+		}
+	}
+	return found, found != ""
+}
+
+// LoadBadgeAccessTokens reads a file of "requester:token" lines, empty lines and lines starting with # are skipped
+func LoadBadgeAccessTokens(path string) (map[string]string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	tokens := map[string]string{}
+	scanner := bufio.NewScanner(file)
+	for line := 1; scanner.Scan(); line++ {
+		entry := strings.TrimSpace(scanner.Text())
+		if entry == "" || strings.HasPrefix(entry, "#") {
+			continue
+		}
+		requester, token, found := strings.Cut(entry, ":")
+		requester, token = strings.TrimSpace(requester), strings.TrimSpace(token)
+		if !found || requester == "" || token == "" {
+			return nil, fmt.Errorf("invalid auth token on line %d of %s, expected requester:token", line, path)
+		}
+		if _, ok := tokens[token]; ok {
+			return nil, fmt.Errorf("duplicate auth token on line %d of %s", line, path)
+		}
+		tokens[token] = requester
+	}
+	return tokens, scanner.Err()
+}
+
+// BadgeServer grants badges signed with the key of a project developer. a badge lets the key it was granted to relay
+// to the project's pairing in one spec and epoch, up to its cu allocation per provider, paid by the project
+type BadgeServer struct {
+	privKey        *btcec.PrivateKey
+	projectAddress sdk.AccAddress
+	cuAllocation   uint64
+	currentEpoch   func(ctx context.Context) (uint64, error)
+	access         BadgeAccess
+	lock           sync.Mutex
+	epoch          uint64
+	previousEpoch  uint64            // consumers that didn't see the new epoch yet still get badges for the previous one
+	granted        map[string]uint64 // by requester, badges granted since the epoch changed
+	grantedTotal   uint64
+}
+
+func NewBadgeServer(privKey *btcec.PrivateKey, cuAllocation uint64, currentEpoch func(ctx context.Context) (uint64, error), access BadgeAccess) (*BadgeServer, error) {
+	if err := access.validate(); err != nil {
+		return nil, err
+	}
+	projectAddress, err := sdk.AccAddressFromHex(secp256k1.PubKey(privKey.PubKey().SerializeCompressed()).Address().String())
+	if err != nil {
+		return nil, err
+	}
+	return &BadgeServer{privKey: privKey, projectAddress: projectAddress, cuAllocation: cuAllocation, currentEpoch: currentEpoch, access: access, granted: map[string]uint64{}}, nil
+}
+
+// GrantBadge signs a badge for badgePk requested by requester, epoch 0 is the current epoch.
+// a badge is counted against the quotas of the epoch it's granted in
+func (bs *BadgeServer) GrantBadge(ctx context.Context, requester string, badgePk []byte, specID string, epoch uint64) (*pairingtypes.Badge, error) {
+	if len(badgePk) != secp256k1.PubKeySize {
+		return nil, fmt.Errorf("invalid public key length %d, expected a compressed secp256k1 key", len(badgePk))
+	}
+	if specID == "" {
+		return nil, fmt.Errorf("missing spec id")
+	}
+	currentEpoch, err := bs.currentEpoch(ctx)
+	if err != nil {
+		return nil, utils.LavaFormatError("failed getting the current epoch", err)
+	}
+	if epoch == 0 {
+		epoch = currentEpoch
+	}
+	bs.lock.Lock()
+	if currentEpoch > bs.epoch {
+		bs.previousEpoch = bs.epoch
+		bs.epoch = currentEpoch
+		bs.granted = map[string]uint64{}
+		bs.grantedTotal = 0
+	}
+	if epoch != currentEpoch && epoch != bs.previousEpoch {
+		bs.lock.Unlock()
+		return nil, fmt.Errorf("badges are granted for the current epoch %d, requested epoch %d", currentEpoch, epoch)
+	}
+	if bs.granted[requester] >= bs.access.MaxBadgesPerRequester || bs.grantedTotal >= bs.access.MaxBadgesPerEpoch {
+		bs.lock.Unlock()
+		return nil, ErrQuotaExceeded
+	}
+	bs.granted[requester]++
+	bs.grantedTotal++
+	bs.lock.Unlock()
+	badge := pairingtypes.Badge{CuAllocation: bs.cuAllocation, Epoch: int64(epoch), BadgePk: badgePk, SpecId: specID}
+	badge.ProjectSig, err = sigs.SignBadge(bs.privKey, badge)
+	if err != nil {
+		return nil, utils.LavaFormatError("failed signing badge", err)
+	}
+	return &badge, nil
+}
+
+func (bs *BadgeServer) newApp() *fiber.App {
+	app := fiber.New(fiber.Config{DisableStartupMessage: true})
+	app.Get(lavaprotocol.BadgeProjectPath, func(c *fiber.Ctx) error {
+		return c.JSON(lavaprotocol.BadgeProjectReply{ProjectAddress: bs.projectAddress.String()})
+	})
+	app.Get(lavaprotocol.BadgePath, func(c *fiber.Ctx) error {
+		authorization := c.Get(fiber.HeaderAuthorization)
+		if !strings.HasPrefix(authorization, lavaprotocol.BadgeAuthScheme) {
+			return fiber.NewError(fiber.StatusUnauthorized, ErrUnauthenticated.Error())
+		}
+		requester, ok := bs.access.requester(strings.TrimPrefix(authorization, lavaprotocol.BadgeAuthScheme))
+		if !ok {
+			return fiber.NewError(fiber.StatusUnauthorized, ErrUnauthenticated.Error())
+		}
+		badgePk, err := hex.DecodeString(c.Query("pubkey"))
+		if err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, "invalid pubkey, expected hex")
+		}
+		var epoch uint64
+		if epochQuery := c.Query("epoch"); epochQuery != "" {
+			epoch, err = strconv.ParseUint(epochQuery, 10, 64)
+			if err != nil {
+				return fiber.NewError(fiber.StatusBadRequest, "invalid epoch")
+			}
+		}
+		badge, err := bs.GrantBadge(c.UserContext(), requester, badgePk, c.Query("spec-id"), epoch)
+		if err == ErrQuotaExceeded {
+			return fiber.NewError(fiber.StatusTooManyRequests, err.Error())
+		}
+		if err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+		utils.LavaFormatDebug("granted badge", utils.Attribute{Key: "requester", Value: requester}, utils.Attribute{Key: "pubkey", Value: c.Query("pubkey")}, utils.Attribute{Key: "specID", Value: badge.SpecId}, utils.Attribute{Key: "epoch", Value: badge.Epoch})
+		return c.JSON(lavaprotocol.BadgeReply{Badge: badge, ProjectAddress: bs.projectAddress.String()})
+	})
+	return app
+}
+
+func (bs *BadgeServer) Serve(addr string) error {
+	utils.LavaFormatInfo("badge server listening", utils.Attribute{Key: "address", Value: addr}, utils.Attribute{Key: "project", Value: bs.projectAddress}, utils.Attribute{Key: "cuAllocation", Value: bs.cuAllocation},
+		utils.Attribute{Key: "requesters", Value: len(bs.access.Tokens)}, utils.Attribute{Key: "maxBadgesPerRequester", Value: bs.access.MaxBadgesPerRequester}, utils.Attribute{Key: "maxBadgesPerEpoch", Value: bs.access.MaxBadgesPerEpoch})
+	return bs.newApp().Listen(addr)
+}
+
+func CreateBadgeServerCobraCommand() *cobra.Command {
+	cmdBadgeServer := &cobra.Command{
+		Use:   "badgeserver [listen-address]",
+		Short: "badgeserver grants badges letting keyless consumers relay with the pairing of a project, paid by the project",
+		Long: `badgeserver grants badges letting keyless consumers relay with the pairing of a project, paid by the project.
+		badges are signed with the --from key, which must be a developer key of a project with a subscription.
+		consumers get badges with rpcconsumer --` + lavaprotocol.BadgeServerFlagName + ` and --` + lavaprotocol.BadgeServerTokenFlagName + `, a token of the --` + AuthTokensFileFlagName + ` file.
+		each requester is granted up to --` + MaxBadgesPerRequesterFlagName + ` badges per epoch and all of them up to --` + MaxBadgesPerEpochFlagName,
+		Example: `badgeserver 127.0.0.1:4444 --from alice --cu-allocation 10000 --auth-tokens-file tokens.txt`,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			networkChainId, err := cmd.Flags().GetString(flags.FlagChainID)
+			if err != nil {
+				return err
+			}
+			clientCtx = clientCtx.WithChainID(networkChainId)
+			keyName, err := sigs.GetKeyName(clientCtx)
+			if err != nil {
+				return utils.LavaFormatError("failed getting key name from clientCtx", err)
+			}
+			privKey, err := sigs.GetPrivKey(clientCtx, keyName)
+			if err != nil {
+				return utils.LavaFormatError("failed getting the project developer key", err, utils.Attribute{Key: "keyName", Value: keyName})
+			}
+			cuAllocation, err := cmd.Flags().GetUint64(CuAllocationFlagName)
+			if err != nil {
+				return err
+			}
+			authTokensFile, err := cmd.Flags().GetString(AuthTokensFileFlagName)
+			if err != nil {
+				return err
+			}
+			access := BadgeAccess{}
+			access.Tokens, err = LoadBadgeAccessTokens(authTokensFile)
+			if err != nil {
+				return utils.LavaFormatError("failed loading the badge server auth tokens", err, utils.Attribute{Key: "path", Value: authTokensFile})
+			}
+			access.MaxBadgesPerRequester, err = cmd.Flags().GetUint64(MaxBadgesPerRequesterFlagName)
+			if err != nil {
+				return err
+			}
+			access.MaxBadgesPerEpoch, err = cmd.Flags().GetUint64(MaxBadgesPerEpochFlagName)
+			if err != nil {
+				return err
+			}
+			epochStorageQueryClient := epochstoragetypes.NewQueryClient(clientCtx)
+			currentEpoch := func(ctx context.Context) (uint64, error) {
+				epochDetails, err := epochStorageQueryClient.EpochDetails(ctx, &epochstoragetypes.QueryGetEpochDetailsRequest{})
+				if err != nil {
+					return 0, err
+				}
+				return epochDetails.EpochDetails.StartBlock, nil
+			}
+			badgeServer, err := NewBadgeServer(privKey, cuAllocation, currentEpoch, access)
+			if err != nil {
+				return err
+			}
+			return badgeServer.Serve(args[0])
+		},
+	}
+	flags.AddTxFlagsToCmd(cmdBadgeServer)
+	cmdBadgeServer.MarkFlagRequired(flags.FlagFrom)
+	cmdBadgeServer.Flags().String(AuthTokensFileFlagName, "", "file of requester:token lines, badges are granted only to requests with one of the tokens as bearer")
+	cmdBadgeServer.MarkFlagRequired(AuthTokensFileFlagName)
+	cmdBadgeServer.Flags().Uint64(MaxBadgesPerRequesterFlagName, DefaultMaxBadgesPerRequester, "badges granted to each requester per epoch")
+	cmdBadgeServer.Flags().Uint64(MaxBadgesPerEpochFlagName, DefaultMaxBadgesPerEpoch, "badges granted to all requesters together per epoch")
+	cmdBadgeServer.Flags().String(flags.FlagChainID, app.Name, "network chain id")
+	cmdBadgeServer.Flags().Uint64(CuAllocationFlagName, DefaultCuAllocation, "cu a badge allows relaying to each provider in its epoch")
+	return cmdBadgeServer
+}
@@ -0,0 +1,147 @@
+package badgeserver
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/lavanet/lava/protocol/lavaprotocol"
+	"github.com/lavanet/lava/utils/sigs"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBadgeServer(t *testing.T) {
+	projectKey, projectAddress := sigs.GenerateFloatingKey()
+	userKey, userAddress := sigs.GenerateFloatingKey()
+	epoch := uint64(100)
+	access := BadgeAccess{Tokens: map[string]string{"token-a": "a", "token-b": "b"}, MaxBadgesPerRequester: 100, MaxBadgesPerEpoch: 1000}
+	badgeServer, err := NewBadgeServer(projectKey, 1000, func(ctx context.Context) (uint64, error) { return epoch, nil }, access)
+	require.NoError(t, err)
+	app := badgeServer.newApp()
+	getBadgeWithToken := func(token string, pubkey string, specID string, badgeEpoch uint64) (*pairingtypes.Badge, int) {
+		request := httptest.NewRequest(http.MethodGet, lavaprotocol.BadgePath+"?pubkey="+pubkey+"&spec-id="+specID+"&epoch="+strconv.FormatUint(badgeEpoch, 10), nil)
+		if token != "" {
+			request.Header.Set("Authorization", lavaprotocol.BadgeAuthScheme+token)
+		}
+		response, err := app.Test(request)
+		require.NoError(t, err)
+		if response.StatusCode != http.StatusOK {
+			return nil, response.StatusCode
+		}
+		reply := lavaprotocol.BadgeReply{}
+		require.NoError(t, json.NewDecoder(response.Body).Decode(&reply))
+		require.Equal(t, projectAddress.String(), reply.ProjectAddress)
+		return reply.Badge, response.StatusCode
+	}
+	getBadge := func(pubkey string, specID string, badgeEpoch uint64) (*pairingtypes.Badge, int) {
+		return getBadgeWithToken("token-a", pubkey, specID, badgeEpoch)
+	}
+	userPk := hex.EncodeToString(userKey.PubKey().SerializeCompressed())
+
+	badge, status := getBadge(userPk, "LAV1", 100)
+	require.Equal(t, http.StatusOK, status)
+	relay := pairingtypes.RelaySession{SpecId: "LAV1", Epoch: 100, CuSum: 1000, Badge: badge}
+	project, err := sigs.VerifyRelayBadge(relay, userAddress)
+	require.NoError(t, err)
+	require.True(t, project.Equals(projectAddress))
+
+	for _, tt := range []struct {
+		name  string
+		relay pairingtypes.RelaySession
+	}{
+		{name: "other spec", relay: pairingtypes.RelaySession{SpecId: "ETH1", Epoch: 100, Badge: badge}},
+		{name: "other epoch", relay: pairingtypes.RelaySession{SpecId: "LAV1", Epoch: 120, Badge: badge}},
+		{name: "over allocation", relay: pairingtypes.RelaySession{SpecId: "LAV1", Epoch: 100, CuSum: 1001, Badge: badge}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := sigs.VerifyRelayBadge(tt.relay, userAddress)
+			require.Error(t, err)
+		})
+	}
+	// a badge relayed by another key
+	_, otherAddress := sigs.GenerateFloatingKey()
+	_, err = sigs.VerifyRelayBadge(relay, otherAddress)
+	require.Error(t, err)
+	// a changed allocation isn't signed by the project
+	forged := *badge
+	forged.CuAllocation = 1000000
+	forgedProject, err := sigs.RecoverBadgeSigner(forged)
+	require.True(t, err != nil || !forgedProject.Equals(projectAddress))
+
+	// the previous epoch is granted after an epoch change, older and future epochs aren't
+	epoch = 120
+	_, status = getBadge(userPk, "LAV1", 120)
+	require.Equal(t, http.StatusOK, status)
+	_, status = getBadge(userPk, "LAV1", 100)
+	require.Equal(t, http.StatusOK, status)
+	epoch = 140
+	_, status = getBadge(userPk, "LAV1", 100)
+	require.Equal(t, http.StatusBadRequest, status)
+	_, status = getBadge(userPk, "LAV1", 160)
+	require.Equal(t, http.StatusBadRequest, status)
+	_, status = getBadge("00", "LAV1", 140)
+	require.Equal(t, http.StatusBadRequest, status)
+	_, status = getBadge(userPk, "", 140)
+	require.Equal(t, http.StatusBadRequest, status)
+
+	// badges aren't granted without a known token
+	_, status = getBadgeWithToken("", userPk, "LAV1", 140)
+	require.Equal(t, http.StatusUnauthorized, status)
+	_, status = getBadgeWithToken("token-c", userPk, "LAV1", 140)
+	require.Equal(t, http.StatusUnauthorized, status)
+}
+
+func TestBadgeServerQuota(t *testing.T) {
+	projectKey, _ := sigs.GenerateFloatingKey()
+	epoch := uint64(100)
+	access := BadgeAccess{Tokens: map[string]string{"token-a": "a", "token-b": "b", "token-c": "c"}, MaxBadgesPerRequester: 2, MaxBadgesPerEpoch: 3}
+	badgeServer, err := NewBadgeServer(projectKey, 1000, func(ctx context.Context) (uint64, error) { return epoch, nil }, access)
+	require.NoError(t, err)
+	app := badgeServer.newApp()
+	userKey, _ := sigs.GenerateFloatingKey()
+	userPk := hex.EncodeToString(userKey.PubKey().SerializeCompressed())
+	getBadge := func(token string) int {
+		request := httptest.NewRequest(http.MethodGet, lavaprotocol.BadgePath+"?pubkey="+userPk+"&spec-id=LAV1", nil)
+		request.Header.Set("Authorization", lavaprotocol.BadgeAuthScheme+token)
+		response, err := app.Test(request)
+		require.NoError(t, err)
+		return response.StatusCode
+	}
+
+	// a requester is limited by its own quota, and all requesters by the epoch quota of the project
+	require.Equal(t, http.StatusOK, getBadge("token-a"))
+	require.Equal(t, http.StatusOK, getBadge("token-a"))
+	require.Equal(t, http.StatusTooManyRequests, getBadge("token-a"))
+	require.Equal(t, http.StatusOK, getBadge("token-b"))
+	require.Equal(t, http.StatusTooManyRequests, getBadge("token-c"))
+
+	// quotas start over with the epoch
+	epoch = 120
+	require.Equal(t, http.StatusOK, getBadge("token-a"))
+	require.Equal(t, http.StatusOK, getBadge("token-c"))
+
+	_, err = NewBadgeServer(projectKey, 1000, func(ctx context.Context) (uint64, error) { return epoch, nil }, BadgeAccess{MaxBadgesPerRequester: 2, MaxBadgesPerEpoch: 3})
+	require.Error(t, err)
+}
+
+func TestLoadBadgeAccessTokens(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "tokens.txt")
+	require.NoError(t, os.WriteFile(path, []byte("# requesters\na: token-a\n\nb:token-b\n"), 0o600))
+	tokens, err := LoadBadgeAccessTokens(path)
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"token-a": "a", "token-b": "b"}, tokens)
+
+	require.NoError(t, os.WriteFile(path, []byte("a:token-a\nb:token-a\n"), 0o600))
+	_, err = LoadBadgeAccessTokens(path)
+	require.Error(t, err)
+	require.NoError(t, os.WriteFile(path, []byte("token-a\n"), 0o600))
+	_, err = LoadBadgeAccessTokens(path)
+	require.Error(t, err)
+}
@@ -34,16 +34,25 @@ func NewChainParser(apiInterface string) (chainParser ChainParser, err error) {
 	return nil, fmt.Errorf("chainParser for apiInterface (%s) not found", apiInterface)
 }
 
-func NewChainListener(ctx context.Context, listenEndpoint *lavasession.RPCEndpoint, relaySender RelaySender, rpcConsumerLogs *common.RPCConsumerLogs) (ChainListener, error) {
+// NewChainListener creates the listener of the endpoint's api interface, listenerConfig is optional.
+// an invalid listener config or a tls certificate that fails to load is returned as an error instead of serving without it
+func NewChainListener(ctx context.Context, listenEndpoint *lavasession.RPCEndpoint, relaySender RelaySender, rpcConsumerLogs *common.RPCConsumerLogs, listenerConfig *ListenerConfig) (ChainListener, error) {
+	err := listenerConfig.Validate()
+	if err != nil {
+		return nil, err
+	}
+	if _, err := listenerConfig.tlsConfig(listenEndpoint.ApiInterface == spectypes.APIInterfaceGrpc); err != nil {
+		return nil, err
+	}
 	switch listenEndpoint.ApiInterface {
 	case spectypes.APIInterfaceJsonRPC:
-		return NewJrpcChainListener(ctx, listenEndpoint, relaySender, rpcConsumerLogs), nil
+		return NewJrpcChainListener(ctx, listenEndpoint, relaySender, rpcConsumerLogs, listenerConfig), nil
 	case spectypes.APIInterfaceTendermintRPC:
-		return NewTendermintRpcChainListener(ctx, listenEndpoint, relaySender, rpcConsumerLogs), nil
+		return NewTendermintRpcChainListener(ctx, listenEndpoint, relaySender, rpcConsumerLogs, listenerConfig), nil
 	case spectypes.APIInterfaceRest:
-		return NewRestChainListener(ctx, listenEndpoint, relaySender, rpcConsumerLogs), nil
+		return NewRestChainListener(ctx, listenEndpoint, relaySender, rpcConsumerLogs, listenerConfig), nil
 	case spectypes.APIInterfaceGrpc:
-		return NewGrpcChainListener(ctx, listenEndpoint, relaySender, rpcConsumerLogs), nil
+		return NewGrpcChainListener(ctx, listenEndpoint, relaySender, rpcConsumerLogs, listenerConfig), nil
 	}
 	return nil, fmt.Errorf("chainListener for apiInterface (%s) not found", listenEndpoint.ApiInterface)
 }
@@ -57,8 +66,17 @@ type ChainParser interface {
 	CraftMessage(serviceApi spectypes.ServiceApi, craftData *CraftData) (ChainMessageForSend, error)
 }
 
+// RequestedBlockSubstituter is implemented by chain parsers that can rewrite a message asking for a magic block (e.g. latest)
+// to ask for a specific block
+type RequestedBlockSubstituter interface {
+	SubstituteRequestedBlock(chainMessage ChainMessage, block int64) (ChainMessage, error)
+}
+
 type ChainMessage interface {
 	RequestedBlock() int64
+	GetTimeoutOverride() time.Duration
+	SetTimeoutOverride(timeout time.Duration)
+	HasTag(tag string) bool
 	ChainMessageForSend
 }
 
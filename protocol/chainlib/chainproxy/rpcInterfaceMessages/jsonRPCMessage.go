@@ -18,6 +18,8 @@ type JsonrpcMessage struct {
 	Params  interface{}          `json:"params,omitempty"`
 	Error   *rpcclient.JsonError `json:"error,omitempty"`
 	Result  json.RawMessage      `json:"result,omitempty"`
+	// lava extension, a timeout hint for the relay, not forwarded to the node
+	LavaTimeout string `json:"lava_timeout,omitempty"`
 }
 
 func ConvertJsonRPCMsg(rpcMsg *rpcclient.JsonrpcMessage) (*JsonrpcMessage, error) {
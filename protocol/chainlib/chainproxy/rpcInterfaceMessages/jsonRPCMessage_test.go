@@ -115,3 +115,14 @@ func TestParseJsonRPCMsg(t *testing.T) {
 		t.Errorf("Expected error, but got nil")
 	}
 }
+
+func TestParseJsonRPCMsgWithTimeoutExtension(t *testing.T) {
+	data := []byte(`{"jsonrpc": "2.0", "id": 1, "method": "getblock", "params": [], "lava_timeout": "1500ms"}`)
+	msg, err := ParseJsonRPCMsg(data)
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if msg.LavaTimeout != "1500ms" {
+		t.Errorf("Expected msg.LavaTimeout to be 1500ms, but got %s", msg.LavaTimeout)
+	}
+}
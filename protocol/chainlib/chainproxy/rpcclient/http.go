@@ -37,6 +37,43 @@ const (
 	contentType             = "application/json"
 )
 
+// ErrResponseTooLarge fails the calls whose http response is over the limit set on their context with WithResponseSizeLimit
+var ErrResponseTooLarge = errors.New("rpc response is over the response size limit")
+
+type responseSizeLimitKey struct{}
+
+// WithResponseSizeLimit limits the size of the http responses of the calls made with the returned context, 0 is unlimited.
+// a response is failed as soon as it's over the limit instead of being read whole
+func WithResponseSizeLimit(ctx context.Context, limit uint64) context.Context {
+	if limit == 0 {
+		return ctx
+	}
+	return context.WithValue(ctx, responseSizeLimitKey{}, limit)
+}
+
+// limitedResponseBody fails reading once more than its remaining bytes were read
+type limitedResponseBody struct {
+	io.ReadCloser
+	remaining int64
+}
+
+func (lrb *limitedResponseBody) Read(p []byte) (int, error) {
+	if lrb.remaining <= 0 {
+		// one more byte tells a response of exactly the limit from a larger one
+		n, err := lrb.ReadCloser.Read(make([]byte, 1))
+		if n > 0 {
+			return 0, ErrResponseTooLarge
+		}
+		return 0, err
+	}
+	if int64(len(p)) > lrb.remaining {
+		p = p[:lrb.remaining]
+	}
+	n, err := lrb.ReadCloser.Read(p)
+	lrb.remaining -= int64(n)
+	return n, err
+}
+
 // https://www.jsonrpc.org/historical/json-rpc-over-http.html#id13
 var acceptedContentTypes = []string{contentType, "application/json-rpc", "application/jsonrequest"}
 
@@ -213,6 +250,13 @@ func (hc *httpConn) doRequest(ctx context.Context, msg interface{}) (io.ReadClos
 			Body:       body,
 		}
 	}
+	if limit, ok := ctx.Value(responseSizeLimitKey{}).(uint64); ok {
+		if resp.ContentLength > int64(limit) {
+			resp.Body.Close()
+			return nil, ErrResponseTooLarge
+		}
+		return &limitedResponseBody{ReadCloser: resp.Body, remaining: int64(limit)}, nil
+	}
 	return resp.Body, nil
 }
 
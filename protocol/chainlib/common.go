@@ -1,6 +1,8 @@
 package chainlib
 
 import (
+	"context"
+	"crypto/tls"
 	"encoding/json"
 	"fmt"
 	"net"
@@ -16,6 +18,7 @@ import (
 	"github.com/lavanet/lava/protocol/parser"
 	"github.com/lavanet/lava/utils"
 	spectypes "github.com/lavanet/lava/x/spec/types"
+	"google.golang.org/grpc/metadata"
 )
 
 const (
@@ -41,15 +44,18 @@ func (bcp *BaseChainParser) GetSpecApiByTag(tag string) (spectypes.ServiceApi, b
 }
 
 type parsedMessage struct {
-	serviceApi     *spectypes.ServiceApi
-	apiInterface   *spectypes.ApiInterface
-	requestedBlock int64
-	msg            parser.RPCInput
+	serviceApi      *spectypes.ServiceApi
+	apiInterface    *spectypes.ApiInterface
+	requestedBlock  int64
+	msg             parser.RPCInput
+	timeoutOverride time.Duration
+	paginationLimit uint64 // the max pagination limit of the spec, providers lower the page requests of the message to it
 }
 
 type BaseChainProxy struct {
 	averageBlockTime time.Duration
 	NodeUrl          common.NodeUrl
+	responseLimits
 }
 
 func (pm parsedMessage) GetServiceApi() *spectypes.ServiceApi {
@@ -68,7 +74,44 @@ func (pm parsedMessage) GetRPCMessage() parser.RPCInput {
 	return pm.msg
 }
 
+func (pm parsedMessage) PaginationLimit() uint64 {
+	return pm.paginationLimit
+}
+
+// HasTag returns whether the api of the message is tagged with tag in the spec
+func (pm parsedMessage) HasTag(tag string) bool {
+	return pm.serviceApi != nil && pm.serviceApi.HasTag(tag)
+}
+
+// GetTimeoutOverride returns the timeout the user asked for this message, zero if none was requested
+func (pm *parsedMessage) GetTimeoutOverride() time.Duration {
+	return pm.timeoutOverride
+}
+
+func (pm *parsedMessage) SetTimeoutOverride(timeout time.Duration) {
+	pm.timeoutOverride = timeout
+}
+
+// applies a timeout hint sent as part of the request body, the shortest requested timeout wins
+func setTimeoutHintFromRequest(chainMessage ChainMessage, timeoutHint string) error {
+	if timeoutHint == "" {
+		return nil
+	}
+	timeout, err := common.ParseTimeoutHint(timeoutHint)
+	if err != nil {
+		return err
+	}
+	if current := chainMessage.GetTimeoutOverride(); current == 0 || timeout < current {
+		chainMessage.SetTimeoutOverride(timeout)
+	}
+	return nil
+}
+
 func extractDappIDFromFiberContext(c *fiber.Ctx) (dappID string) {
+	// authenticated dapps are identified by their credentials, not by the path they picked
+	if authenticatedDappID, ok := c.Locals(contextUserValueKeyAuthDappID).(string); ok {
+		return authenticatedDappID
+	}
 	dappID = c.Params("dappId")
 	if dappID == "" {
 		dappID = "NoDappID"
@@ -82,12 +125,21 @@ func constructFiberCallbackWithHeaderAndParameterExtraction(callbackToBeCalled f
 		if isMetricEnabled {
 			c.Locals(common.RefererHeaderKey, c.Get(common.RefererHeaderKey, ""))
 		}
+		// the headers are kept for the websocket connection, a context without a request has none to keep
+		if c.Context() != nil {
+			for _, headerName := range lavaRelayHeaders {
+				c.Locals(headerName, c.Get(headerName, ""))
+			}
+		}
 		return webSocketCallback(c) // uses external dappID
 	}
 	return handler
 }
 
 func extractDappIDFromWebsocketConnection(c *websocket.Conn) string {
+	if authenticatedDappID, ok := c.Locals(contextUserValueKeyAuthDappID).(string); ok {
+		return authenticatedDappID
+	}
 	dappId := c.Params("dappId")
 	if dappId == "" {
 		dappId = "NoDappID"
@@ -95,6 +147,58 @@ func extractDappIDFromWebsocketConnection(c *websocket.Conn) string {
 	return dappId
 }
 
+// lava specific request headers that are propagated from the chain listeners into the relay context
+var lavaRelayHeaders = []string{common.PROVIDER_ADDRESS_HEADER_NAME, common.RELAY_TIMEOUT_HEADER_NAME, common.DEBUG_HEADER_NAME}
+
+func withLavaRelayHeaders(ctx context.Context, getHeader func(headerName string) string) context.Context {
+	ctx = common.WithProviderAddressOverride(ctx, getHeader(common.PROVIDER_ADDRESS_HEADER_NAME))
+	if timeoutHint := getHeader(common.RELAY_TIMEOUT_HEADER_NAME); timeoutHint != "" {
+		timeout, err := common.ParseTimeoutHint(timeoutHint)
+		if err != nil {
+			utils.LavaFormatDebug("ignoring invalid timeout header", utils.Attribute{Key: "GUID", Value: ctx}, utils.Attribute{Key: "error", Value: err.Error()})
+		} else {
+			ctx = common.WithTimeoutHint(ctx, timeout)
+		}
+	}
+	if common.ParseDebugHeader(getHeader(common.DEBUG_HEADER_NAME)) {
+		ctx = common.WithRelayTrace(ctx)
+	}
+	return ctx
+}
+
+// setRelayTraceHeader sends the trace of the relay back to the user that asked for it, the consumer fills it only when relay debugging is enabled
+func setRelayTraceHeader(ctx context.Context, setHeader func(key string, value string)) {
+	if trace, ok := common.GetRelayTrace(ctx).Encode(); ok {
+		setHeader(common.DEBUG_TRACE_HEADER_NAME, trace)
+	}
+}
+
+func extractLavaHeadersFromFiberContext(ctx context.Context, c *fiber.Ctx) context.Context {
+	return withLavaRelayHeaders(ctx, func(headerName string) string {
+		return c.Get(headerName)
+	})
+}
+
+func extractLavaHeadersFromWebsocketConnection(ctx context.Context, c *websocket.Conn) context.Context {
+	return withLavaRelayHeaders(ctx, func(headerName string) string {
+		headerValue, ok := c.Locals(headerName).(string)
+		if !ok {
+			return ""
+		}
+		return headerValue
+	})
+}
+
+func extractLavaHeadersFromMetadata(ctx context.Context, metadataValues metadata.MD) context.Context {
+	return withLavaRelayHeaders(ctx, func(headerName string) string {
+		values := metadataValues.Get(headerName)
+		if len(values) == 0 {
+			return ""
+		}
+		return values[0]
+	})
+}
+
 func convertToJsonError(errorMsg string) string {
 	jsonResponse, err := json.Marshal(fiber.Map{
 		"error": errorMsg,
@@ -161,51 +265,78 @@ func matchSpecApiByName(name string, serverApis map[string]spectypes.ServiceApi)
 }
 
 // rpc default endpoint should be websocket. otherwise return an error
-func verifyRPCEndpoint(endpoint string) {
+func verifyRPCEndpoint(endpoint string) error {
 	u, err := url.Parse(endpoint)
 	if err != nil {
-		utils.LavaFormatFatal("unparsable url", err, utils.Attribute{Key: "url", Value: endpoint})
+		return utils.LavaFormatError("unparsable url", err, utils.Attribute{Key: "url", Value: endpoint})
 	}
 	switch u.Scheme {
 	case "ws", "wss":
-		return
+		return nil
 	default:
 		utils.LavaFormatWarning("URL scheme should be websocket (ws/wss), got: "+u.Scheme, nil)
+		return nil
 	}
 }
 
 // rpc default endpoint should be websocket. otherwise return an error
-func verifyTendermintEndpoint(endpoints []common.NodeUrl) (websocketEndpoint common.NodeUrl, httpEndpoint common.NodeUrl) {
+// multiple urls of the same scheme are failover nodes for each other
+func verifyTendermintEndpoint(endpoints []common.NodeUrl) (websocketEndpoints []common.NodeUrl, httpEndpoints []common.NodeUrl, err error) {
 	for _, endpoint := range endpoints {
 		u, err := url.Parse(endpoint.Url)
 		if err != nil {
-			utils.LavaFormatFatal("unparsable url", err, utils.Attribute{Key: "url", Value: endpoint.Url})
+			return nil, nil, utils.LavaFormatError("unparsable url", err, utils.Attribute{Key: "url", Value: endpoint.Url})
 		}
 		switch u.Scheme {
 		case "http", "https":
-			httpEndpoint = endpoint
+			httpEndpoints = append(httpEndpoints, endpoint)
 		case "ws", "wss":
-			websocketEndpoint = endpoint
+			websocketEndpoints = append(websocketEndpoints, endpoint)
 		default:
-			utils.LavaFormatFatal("URL scheme should be websocket (ws/wss) or (http/https), got: "+u.Scheme, nil)
+			return nil, nil, utils.LavaFormatError("URL scheme should be websocket (ws/wss) or (http/https), got: "+u.Scheme, nil)
 		}
 	}
 
-	if websocketEndpoint.String() == "" || httpEndpoint.String() == "" {
+	if len(websocketEndpoints) == 0 || len(httpEndpoints) == 0 {
 		utils.LavaFormatError("Tendermint Provider was not provided with both http and websocket urls. please provide both", nil,
-			utils.Attribute{Key: "websocket", Value: websocketEndpoint.String()}, utils.Attribute{Key: "http", Value: httpEndpoint.String()})
-		if httpEndpoint.String() != "" {
-			return httpEndpoint, httpEndpoint
+			utils.Attribute{Key: "websocket", Value: websocketEndpoints}, utils.Attribute{Key: "http", Value: httpEndpoints})
+		if len(httpEndpoints) != 0 {
+			return httpEndpoints, httpEndpoints, nil
 		} else {
-			utils.LavaFormatFatal("Tendermint Provider was not provided with http url. please provide a url that starts with http/https", nil)
+			return nil, nil, utils.LavaFormatError("Tendermint Provider was not provided with http url. please provide a url that starts with http/https", nil)
 		}
 	}
-	return websocketEndpoint, httpEndpoint
+	return websocketEndpoints, httpEndpoints, nil
 }
 
-func ListenWithRetry(app *fiber.App, address string) {
+// ListenWithRetry serves the app on address until ctx is done, listening again whenever it fails.
+// it serves https when listenerConfig has a tls certificate or autocert domains
+func ListenWithRetry(ctx context.Context, app *fiber.App, address string, listenerConfig *ListenerConfig) {
+	// fiber serves http/1.1 only
+	tlsConfig, err := listenerConfig.tlsConfig(false)
+	if err != nil {
+		utils.LavaFormatError("failed loading the listener tls config, not serving", err, utils.Attribute{Key: "address", Value: address})
+		return
+	}
+	go func() {
+		<-ctx.Done()
+		if err := app.Shutdown(); err != nil {
+			utils.LavaFormatError("failed shutting down listener", err, utils.Attribute{Key: "address", Value: address})
+		}
+	}()
 	for {
-		err := app.Listen(address)
+		if tlsConfig == nil {
+			err = app.Listen(address)
+		} else {
+			var listener net.Listener
+			listener, err = tls.Listen("tcp", address, tlsConfig)
+			if err == nil {
+				err = app.Listener(listener)
+			}
+		}
+		if ctx.Err() != nil {
+			return
+		}
 		if err != nil {
 			utils.LavaFormatError("app.Listen(listenAddr)", err)
 		}
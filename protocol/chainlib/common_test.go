@@ -365,6 +365,32 @@ func TestParsedMessage_GetRPCMessage(t *testing.T) {
 	assert.Equal(t, rpcInput, pm.GetRPCMessage())
 }
 
+func TestParsedMessage_TimeoutOverride(t *testing.T) {
+	pm := &parsedMessage{}
+	assert.Equal(t, time.Duration(0), pm.GetTimeoutOverride())
+	pm.SetTimeoutOverride(time.Second)
+	assert.Equal(t, time.Second, pm.GetTimeoutOverride())
+}
+
+func TestSetTimeoutHintFromRequest(t *testing.T) {
+	pm := &parsedMessage{}
+	assert.Nil(t, setTimeoutHintFromRequest(pm, ""))
+	assert.Equal(t, time.Duration(0), pm.GetTimeoutOverride())
+
+	assert.Nil(t, setTimeoutHintFromRequest(pm, "2s"))
+	assert.Equal(t, 2*time.Second, pm.GetTimeoutOverride())
+
+	// a longer hint doesn't extend an existing one
+	assert.Nil(t, setTimeoutHintFromRequest(pm, "5000"))
+	assert.Equal(t, 2*time.Second, pm.GetTimeoutOverride())
+
+	assert.Nil(t, setTimeoutHintFromRequest(pm, "500ms"))
+	assert.Equal(t, 500*time.Millisecond, pm.GetTimeoutOverride())
+
+	assert.NotNil(t, setTimeoutHintFromRequest(pm, "soon"))
+	assert.Equal(t, 500*time.Millisecond, pm.GetTimeoutOverride())
+}
+
 type mockRPCInput struct{}
 
 func (m *mockRPCInput) GetParams() interface{} {
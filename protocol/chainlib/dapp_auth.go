@@ -0,0 +1,301 @@
+package chainlib
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/lavanet/lava/utils"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	APIKeyHeaderName              = "X-Api-Key"
+	APIKeyQueryName               = "api_key" // for websockets, browsers can't set their headers
+	DefaultJWTDappClaim           = "sub"
+	DappVerifierCacheDuration     = time.Minute
+	DappVerifierRejectedCacheTTL  = 5 * time.Second // rejected credentials are cached shortly, so invalid ones don't reach the backend on every relay
+	DappVerifierTimeout           = 5 * time.Second
+	MaxCachedDappCredentials      = 10000 // the verifier cache is cleared when it's full
+	contextUserValueKeyAuthDappID = "authenticatedDappID"
+)
+
+var ErrDappUnauthenticated = errors.New("missing or invalid dapp credentials")
+
+// DappCredentials are the credentials a relay request was sent with
+type DappCredentials struct {
+	APIKey      string // from the X-Api-Key header or the api_key query parameter
+	BearerToken string // from the Authorization header
+}
+
+func (dc DappCredentials) empty() bool {
+	return dc.APIKey == "" && dc.BearerToken == ""
+}
+
+// DappAuthenticator verifies the credentials of relay requests, the dappID it returns replaces the one in the request path
+// for analytics and caching. portal operators can set their own in ListenerConfig.Authenticator
+type DappAuthenticator interface {
+	Authenticate(ctx context.Context, credentials DappCredentials) (dappID string, err error)
+}
+
+// APIKeyAuthenticator maps api keys to their dapps
+type APIKeyAuthenticator map[string]string
+
+func (aka APIKeyAuthenticator) Authenticate(ctx context.Context, credentials DappCredentials) (string, error) {
+	if credentials.APIKey == "" {
+		return "", ErrDappUnauthenticated
+	}
+	for apiKey, dappID := range aka {
+		if subtle.ConstantTimeCompare([]byte(apiKey), []byte(credentials.APIKey)) == 1 {
+			return dappID, nil
+		}
+	}
+	return "", ErrDappUnauthenticated
+}
+
+// JWTAuthenticator accepts HS256 tokens signed with Secret, the dapp is the DappClaim of the token
+type JWTAuthenticator struct {
+	Secret    []byte
+	DappClaim string // DefaultJWTDappClaim when empty
+	now       func() time.Time
+}
+
+func (ja *JWTAuthenticator) Authenticate(ctx context.Context, credentials DappCredentials) (string, error) {
+	parts := strings.Split(credentials.BearerToken, ".")
+	if len(parts) != 3 {
+		return "", ErrDappUnauthenticated
+	}
+	header := struct {
+		Alg string `json:"alg"`
+	}{}
+	if err := decodeJWTPart(parts[0], &header); err != nil || header.Alg != "HS256" {
+		return "", ErrDappUnauthenticated
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return "", ErrDappUnauthenticated
+	}
+	mac := hmac.New(sha256.New, ja.Secret)
+	mac.Write([]byte(parts[0] + "." + parts[1]))
+	if !hmac.Equal(signature, mac.Sum(nil)) {
+		return "", ErrDappUnauthenticated
+	}
+	claims := map[string]interface{}{}
+	if err := decodeJWTPart(parts[1], &claims); err != nil {
+		return "", ErrDappUnauthenticated
+	}
+	now := time.Now
+	if ja.now != nil {
+		now = ja.now
+	}
+	if exp, ok := claims["exp"].(float64); ok && now().Unix() >= int64(exp) {
+		return "", fmt.Errorf("%w: token expired", ErrDappUnauthenticated)
+	}
+	if nbf, ok := claims["nbf"].(float64); ok && now().Unix() < int64(nbf) {
+		return "", fmt.Errorf("%w: token not valid yet", ErrDappUnauthenticated)
+	}
+	dappClaim := ja.DappClaim
+	if dappClaim == "" {
+		dappClaim = DefaultJWTDappClaim
+	}
+	dappID, ok := claims[dappClaim].(string)
+	if !ok || dappID == "" {
+		return "", fmt.Errorf("%w: token has no %s claim", ErrDappUnauthenticated, dappClaim)
+	}
+	return dappID, nil
+}
+
+func decodeJWTPart(part string, into interface{}) error {
+	decoded, err := base64.RawURLEncoding.DecodeString(part)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(decoded, into)
+}
+
+// HTTPDappVerifier asks the operator's auth backend at URL, forwarding the credentials in the same headers.
+// the backend answers 200 with {"dappId": "..."} for valid credentials, answers are cached for DappVerifierCacheDuration
+// and rejections for DappVerifierRejectedCacheTTL
+type HTTPDappVerifier struct {
+	URL    string
+	client *http.Client
+	lock   sync.Mutex
+	cache  map[DappCredentials]verifiedDapp
+}
+
+type verifiedDapp struct {
+	dappID  string // empty for rejected credentials
+	expires time.Time
+}
+
+func NewHTTPDappVerifier(url string) *HTTPDappVerifier {
+	return &HTTPDappVerifier{URL: url, client: &http.Client{Timeout: DappVerifierTimeout}, cache: map[DappCredentials]verifiedDapp{}}
+}
+
+func (hdv *HTTPDappVerifier) Authenticate(ctx context.Context, credentials DappCredentials) (string, error) {
+	hdv.lock.Lock()
+	cached, ok := hdv.cache[credentials]
+	hdv.lock.Unlock()
+	if ok && time.Now().Before(cached.expires) {
+		if cached.dappID == "" {
+			return "", ErrDappUnauthenticated
+		}
+		return cached.dappID, nil
+	}
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, hdv.URL, nil)
+	if err != nil {
+		return "", err
+	}
+	if credentials.APIKey != "" {
+		request.Header.Set(APIKeyHeaderName, credentials.APIKey)
+	}
+	if credentials.BearerToken != "" {
+		request.Header.Set(fiber.HeaderAuthorization, "Bearer "+credentials.BearerToken)
+	}
+	response, err := hdv.client.Do(request)
+	if err != nil {
+		return "", utils.LavaFormatWarning("dapp auth backend unreachable", err, utils.Attribute{Key: "url", Value: hdv.URL})
+	}
+	defer response.Body.Close()
+	if response.StatusCode == http.StatusUnauthorized || response.StatusCode == http.StatusForbidden {
+		hdv.cacheVerified(credentials, "", DappVerifierRejectedCacheTTL)
+		return "", ErrDappUnauthenticated
+	}
+	if response.StatusCode != http.StatusOK {
+		return "", ErrDappUnauthenticated
+	}
+	verified := struct {
+		DappID string `json:"dappId"`
+	}{}
+	if err := json.NewDecoder(response.Body).Decode(&verified); err != nil || verified.DappID == "" {
+		return "", utils.LavaFormatWarning("invalid dapp auth backend reply", err, utils.Attribute{Key: "url", Value: hdv.URL})
+	}
+	hdv.cacheVerified(credentials, verified.DappID, DappVerifierCacheDuration)
+	return verified.DappID, nil
+}
+
+func (hdv *HTTPDappVerifier) cacheVerified(credentials DappCredentials, dappID string, ttl time.Duration) {
+	hdv.lock.Lock()
+	defer hdv.lock.Unlock()
+	if len(hdv.cache) >= MaxCachedDappCredentials {
+		hdv.cache = map[DappCredentials]verifiedDapp{}
+	}
+	hdv.cache[credentials] = verifiedDapp{dappID: dappID, expires: time.Now().Add(ttl)}
+}
+
+// dappAuthenticators tries the api key authenticator for api keys and the jwt one for bearer tokens, the verifier
+// backend gets the credentials neither of them accepted
+type dappAuthenticators struct {
+	apiKeys  APIKeyAuthenticator
+	jwt      *JWTAuthenticator
+	verifier DappAuthenticator
+}
+
+func (da *dappAuthenticators) Authenticate(ctx context.Context, credentials DappCredentials) (string, error) {
+	if credentials.APIKey != "" && len(da.apiKeys) > 0 {
+		if dappID, err := da.apiKeys.Authenticate(ctx, credentials); err == nil {
+			return dappID, nil
+		}
+	}
+	if credentials.BearerToken != "" && da.jwt != nil {
+		dappID, err := da.jwt.Authenticate(ctx, credentials)
+		if err == nil || da.verifier == nil {
+			return dappID, err
+		}
+	}
+	if da.verifier != nil {
+		return da.verifier.Authenticate(ctx, credentials)
+	}
+	return "", ErrDappUnauthenticated
+}
+
+func extractDappCredentials(c *fiber.Ctx) DappCredentials {
+	credentials := DappCredentials{APIKey: c.Get(APIKeyHeaderName)}
+	if credentials.APIKey == "" {
+		credentials.APIKey = c.Query(APIKeyQueryName)
+	}
+	if authorization := c.Get(fiber.HeaderAuthorization); strings.HasPrefix(authorization, "Bearer ") {
+		credentials.BearerToken = strings.TrimPrefix(authorization, "Bearer ")
+	}
+	return credentials
+}
+
+func extractDappCredentialsFromMetadata(metadataValues metadata.MD) DappCredentials {
+	credentials := DappCredentials{}
+	// grpc metadata keys are lower case
+	if apiKeys := metadataValues.Get(strings.ToLower(APIKeyHeaderName)); len(apiKeys) > 0 {
+		credentials.APIKey = apiKeys[0]
+	}
+	if authorizations := metadataValues.Get("authorization"); len(authorizations) > 0 && strings.HasPrefix(authorizations[0], "Bearer ") {
+		credentials.BearerToken = strings.TrimPrefix(authorizations[0], "Bearer ")
+	}
+	return credentials
+}
+
+// authenticateGrpcRelay is useDappAuth for the grpc listener, it returns the dapp of the relay or an Unauthenticated status
+func authenticateGrpcRelay(ctx context.Context, authenticator DappAuthenticator, metadataValues metadata.MD) (string, error) {
+	credentials := extractDappCredentialsFromMetadata(metadataValues)
+	if credentials.empty() {
+		return "", status.Error(codes.Unauthenticated, ErrDappUnauthenticated.Error())
+	}
+	dappID, err := authenticator.Authenticate(ctx, credentials)
+	if err != nil {
+		utils.LavaFormatRepeatedWarning("rejected grpc relay with invalid dapp credentials", err)
+		return "", status.Error(codes.Unauthenticated, ErrDappUnauthenticated.Error())
+	}
+	return dappID, nil
+}
+
+// useDappAuth rejects requests without valid credentials, the dapp of accepted ones is used instead of the one in their path
+func (lc *ListenerConfig) useDappAuth(app *fiber.App) {
+	authenticator := lc.authenticator()
+	if authenticator == nil {
+		return
+	}
+	app.Use(func(c *fiber.Ctx) error {
+		credentials := extractDappCredentials(c)
+		if credentials.empty() {
+			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": ErrDappUnauthenticated.Error()})
+		}
+		dappID, err := authenticator.Authenticate(c.UserContext(), credentials)
+		if err != nil {
+			utils.LavaFormatRepeatedWarning("rejected relay with invalid dapp credentials", err, utils.Attribute{Key: "ip", Value: c.IP()})
+			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": ErrDappUnauthenticated.Error()})
+		}
+		c.Locals(contextUserValueKeyAuthDappID, dappID)
+		return c.Next()
+	})
+}
+
+// authenticator returns nil when the listener doesn't authenticate dapps
+func (lc *ListenerConfig) authenticator() DappAuthenticator {
+	if lc == nil {
+		return nil
+	}
+	if lc.Authenticator != nil {
+		return lc.Authenticator
+	}
+	authenticators := &dappAuthenticators{apiKeys: lc.APIKeys}
+	if lc.JWTSecret != "" {
+		authenticators.jwt = &JWTAuthenticator{Secret: []byte(lc.JWTSecret), DappClaim: lc.JWTDappClaim}
+	}
+	if lc.DappVerifierURL != "" {
+		authenticators.verifier = NewHTTPDappVerifier(lc.DappVerifierURL)
+	}
+	if len(authenticators.apiKeys) == 0 && authenticators.jwt == nil && authenticators.verifier == nil {
+		return nil
+	}
+	return authenticators
+}
@@ -0,0 +1,147 @@
+package chainlib
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+)
+
+func signedJWT(t *testing.T, secret string, claims map[string]interface{}) string {
+	header := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"HS256","typ":"JWT"}`))
+	payload, err := json.Marshal(claims)
+	require.NoError(t, err)
+	unsigned := header + "." + base64.RawURLEncoding.EncodeToString(payload)
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(unsigned))
+	return unsigned + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func TestJWTAuthenticator(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	authenticator := &JWTAuthenticator{Secret: []byte("secret"), DappClaim: "dapp", now: func() time.Time { return now }}
+	for _, tt := range []struct {
+		name   string
+		token  string
+		dappID string
+	}{
+		{name: "valid", token: signedJWT(t, "secret", map[string]interface{}{"dapp": "dapp1", "exp": now.Unix() + 60}), dappID: "dapp1"},
+		{name: "expired", token: signedJWT(t, "secret", map[string]interface{}{"dapp": "dapp1", "exp": now.Unix()})},
+		{name: "not valid yet", token: signedJWT(t, "secret", map[string]interface{}{"dapp": "dapp1", "nbf": now.Unix() + 60})},
+		{name: "other secret", token: signedJWT(t, "other", map[string]interface{}{"dapp": "dapp1"})},
+		{name: "no dapp claim", token: signedJWT(t, "secret", map[string]interface{}{"sub": "dapp1"})},
+		{name: "malformed", token: "not.a-token"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			dappID, err := authenticator.Authenticate(context.Background(), DappCredentials{BearerToken: tt.token})
+			if tt.dappID == "" {
+				require.ErrorIs(t, err, ErrDappUnauthenticated)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.dappID, dappID)
+			}
+		})
+	}
+}
+
+func TestHTTPDappVerifierCache(t *testing.T) {
+	requests := 0
+	backend := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.Header.Get(APIKeyHeaderName) != "portal-key" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Write([]byte(`{"dappId":"portal-dapp"}`))
+	}))
+	defer backend.Close()
+	verifier := NewHTTPDappVerifier(backend.URL)
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		dappID, err := verifier.Authenticate(ctx, DappCredentials{APIKey: "portal-key"})
+		require.NoError(t, err)
+		require.Equal(t, "portal-dapp", dappID)
+	}
+	require.Equal(t, 1, requests)
+
+	// rejected credentials are cached too
+	for i := 0; i < 2; i++ {
+		_, err := verifier.Authenticate(ctx, DappCredentials{APIKey: "invalid-key"})
+		require.ErrorIs(t, err, ErrDappUnauthenticated)
+	}
+	require.Equal(t, 2, requests)
+
+	// only until the rejection expires
+	verifier.cache[DappCredentials{APIKey: "invalid-key"}] = verifiedDapp{expires: time.Now().Add(-time.Second)}
+	_, err := verifier.Authenticate(ctx, DappCredentials{APIKey: "invalid-key"})
+	require.ErrorIs(t, err, ErrDappUnauthenticated)
+	require.Equal(t, 3, requests)
+}
+
+func TestListenerDappAuth(t *testing.T) {
+	verifier := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get(APIKeyHeaderName) != "portal-key" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Write([]byte(`{"dappId":"portal-dapp"}`))
+	}))
+	defer verifier.Close()
+	config := &ListenerConfig{APIKeys: map[string]string{"key1": "dapp1"}, JWTSecret: "secret", DappVerifierURL: verifier.URL}
+
+	for _, tt := range []struct {
+		name    string
+		config  *ListenerConfig
+		path    string
+		headers map[string]string
+		status  int
+		dappID  string
+	}{
+		{name: "no auth", config: nil, path: "/path-dapp/", status: http.StatusOK, dappID: "path-dapp"},
+		{name: "no credentials", config: config, path: "/path-dapp/", status: http.StatusUnauthorized},
+		{name: "api key header", config: config, path: "/path-dapp/", headers: map[string]string{APIKeyHeaderName: "key1"}, status: http.StatusOK, dappID: "dapp1"},
+		{name: "api key query", config: config, path: "/path-dapp/?api_key=key1", status: http.StatusOK, dappID: "dapp1"},
+		{name: "unknown api key", config: config, path: "/path-dapp/", headers: map[string]string{APIKeyHeaderName: "key2"}, status: http.StatusUnauthorized},
+		{name: "jwt", config: config, path: "/path-dapp/", headers: map[string]string{"Authorization": "Bearer " + signedJWT(t, "secret", map[string]interface{}{"sub": "dapp2"})}, status: http.StatusOK, dappID: "dapp2"},
+		{name: "verifier", config: config, path: "/path-dapp/", headers: map[string]string{APIKeyHeaderName: "portal-key"}, status: http.StatusOK, dappID: "portal-dapp"},
+		{name: "custom authenticator", config: &ListenerConfig{Authenticator: APIKeyAuthenticator{"key3": "dapp3"}}, path: "/path-dapp/", headers: map[string]string{APIKeyHeaderName: "key3"}, status: http.StatusOK, dappID: "dapp3"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			app := fiber.New()
+			tt.config.useDappAuth(app)
+			app.Post("/:dappId/*", func(c *fiber.Ctx) error { return c.SendString(extractDappIDFromFiberContext(c)) })
+
+			request := httptest.NewRequest(http.MethodPost, tt.path, nil)
+			for key, value := range tt.headers {
+				request.Header.Set(key, value)
+			}
+			response, err := app.Test(request)
+			require.NoError(t, err)
+			require.Equal(t, tt.status, response.StatusCode)
+			if tt.status == http.StatusOK {
+				body, err := io.ReadAll(response.Body)
+				require.NoError(t, err)
+				require.Equal(t, tt.dappID, string(body))
+			}
+		})
+	}
+
+	dappID, err := authenticateGrpcRelay(context.Background(), config.authenticator(), metadata.Pairs("x-api-key", "key1"))
+	require.NoError(t, err)
+	require.Equal(t, "dapp1", dappID)
+	_, err = authenticateGrpcRelay(context.Background(), config.authenticator(), metadata.MD{})
+	require.Error(t, err)
+	// a changed secret restarts the listeners
+	require.NotEqual(t, config.String(), (&ListenerConfig{APIKeys: config.APIKeys, JWTSecret: "other", DappVerifierURL: verifier.URL}).String())
+}
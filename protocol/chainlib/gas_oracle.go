@@ -0,0 +1,215 @@
+package chainlib
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	spectypes "github.com/lavanet/lava/x/spec/types"
+)
+
+const (
+	GasPriceApi             = "eth_gasPrice"
+	MaxPriorityFeePerGasApi = "eth_maxPriorityFeePerGas"
+	FeeHistoryApi           = "eth_feeHistory"
+)
+
+// the replies of these apis are each node's own fee estimate, built from its view of the mempool and recent blocks
+var gasOracleApis = map[string]struct{}{
+	GasPriceApi:             {},
+	MaxPriorityFeePerGasApi: {},
+	FeeHistoryApi:           {},
+}
+
+// IsGasOracleApi returns whether the replies of several providers to the relay can be aggregated into one fee estimate.
+// each node estimates the fees by its own view of the mempool, so the aggregated replies aren't compared for conflicts
+func IsGasOracleApi(chainMessage ChainMessageForSend) bool {
+	apiInterface := chainMessage.GetInterface()
+	if apiInterface == nil || apiInterface.Interface != spectypes.APIInterfaceJsonRPC {
+		return false
+	}
+	_, ok := gasOracleApis[chainMessage.GetServiceApi().Name]
+	return ok
+}
+
+type feeHistoryResult struct {
+	OldestBlock   string     `json:"oldestBlock"`
+	BaseFeePerGas []string   `json:"baseFeePerGas"`
+	GasUsedRatio  []float64  `json:"gasUsedRatio"`
+	Reward        [][]string `json:"reward,omitempty"`
+}
+
+// window identifies the blocks a fee history covers, only histories of the same blocks are aggregated
+func (fhr *feeHistoryResult) window() string {
+	rewardPercentiles := 0
+	if len(fhr.Reward) > 0 {
+		rewardPercentiles = len(fhr.Reward[0])
+	}
+	return fmt.Sprintf("%s/%d/%d/%d/%d", fhr.OldestBlock, len(fhr.BaseFeePerGas), len(fhr.GasUsedRatio), len(fhr.Reward), rewardPercentiles)
+}
+
+// AggregateGasReplies returns a reply with the median of the fee estimates in the json rpc replies of several providers to a gas
+// oracle api. error replies and replies that can't be parsed are left out, the fee histories of the window most providers replied
+// with are aggregated block by block. the first reply used is the template of the returned one, so it keeps its id
+func AggregateGasReplies(apiName string, replies [][]byte) ([]byte, error) {
+	templates := []map[string]json.RawMessage{}
+	results := []json.RawMessage{}
+	for _, reply := range replies {
+		message := map[string]json.RawMessage{}
+		if err := json.Unmarshal(reply, &message); err != nil {
+			continue
+		}
+		if _, failed := message["error"]; failed || len(message["result"]) == 0 {
+			continue
+		}
+		templates = append(templates, message)
+		results = append(results, message["result"])
+	}
+	if len(results) == 0 {
+		return nil, fmt.Errorf("no valid replies to aggregate for %s", apiName)
+	}
+
+	var aggregated interface{}
+	var template map[string]json.RawMessage
+	switch apiName {
+	case GasPriceApi, MaxPriorityFeePerGasApi:
+		quantities := []*big.Int{}
+		for idx, result := range results {
+			var encoded string
+			if json.Unmarshal(result, &encoded) != nil {
+				continue
+			}
+			quantity, err := hexutil.DecodeBig(encoded)
+			if err != nil {
+				continue
+			}
+			if template == nil {
+				template = templates[idx]
+			}
+			quantities = append(quantities, quantity)
+		}
+		if len(quantities) == 0 {
+			return nil, fmt.Errorf("no valid fee estimates to aggregate for %s", apiName)
+		}
+		aggregated = hexutil.EncodeBig(medianBig(quantities))
+	case FeeHistoryApi:
+		windows := map[string][]*feeHistoryResult{}
+		windowTemplates := map[string]map[string]json.RawMessage{}
+		var majority string
+		for idx, result := range results {
+			history := &feeHistoryResult{}
+			if json.Unmarshal(result, history) != nil || len(history.BaseFeePerGas) == 0 || !history.rewardsAligned() {
+				continue
+			}
+			window := history.window()
+			if _, ok := windowTemplates[window]; !ok {
+				windowTemplates[window] = templates[idx]
+			}
+			windows[window] = append(windows[window], history)
+			// on a tie the window replied with first is kept
+			if len(windows[window]) > len(windows[majority]) {
+				majority = window
+			}
+		}
+		if majority == "" {
+			return nil, fmt.Errorf("no valid fee histories to aggregate for %s", apiName)
+		}
+		template = windowTemplates[majority]
+		median, err := json.Marshal(medianFeeHistory(windows[majority]))
+		if err != nil {
+			return nil, err
+		}
+		// fields newer than the aggregated ones, such as blob fees, are kept as the template provider sent them
+		fields := map[string]json.RawMessage{}
+		if err := json.Unmarshal(template["result"], &fields); err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(median, &fields); err != nil {
+			return nil, err
+		}
+		aggregated = fields
+	default:
+		return nil, fmt.Errorf("%s isn't a gas oracle api", apiName)
+	}
+
+	encoded, err := json.Marshal(aggregated)
+	if err != nil {
+		return nil, err
+	}
+	reply := map[string]json.RawMessage{}
+	for key, value := range template {
+		reply[key] = value
+	}
+	reply["result"] = encoded
+	return json.Marshal(reply)
+}
+
+func (fhr *feeHistoryResult) rewardsAligned() bool {
+	for _, blockRewards := range fhr.Reward {
+		if len(blockRewards) != len(fhr.Reward[0]) {
+			return false
+		}
+	}
+	return true
+}
+
+// medianFeeHistory aggregates histories of the same window, values that can't be decoded are left out of their block's median
+func medianFeeHistory(histories []*feeHistoryResult) *feeHistoryResult {
+	first := histories[0]
+	aggregated := &feeHistoryResult{
+		OldestBlock:   first.OldestBlock,
+		BaseFeePerGas: make([]string, len(first.BaseFeePerGas)),
+		GasUsedRatio:  make([]float64, len(first.GasUsedRatio)),
+	}
+	medianQuantity := func(value func(history *feeHistoryResult) string) string {
+		quantities := []*big.Int{}
+		for _, history := range histories {
+			if quantity, err := hexutil.DecodeBig(value(history)); err == nil {
+				quantities = append(quantities, quantity)
+			}
+		}
+		if len(quantities) == 0 {
+			return value(first)
+		}
+		return hexutil.EncodeBig(medianBig(quantities))
+	}
+	for block := range first.BaseFeePerGas {
+		aggregated.BaseFeePerGas[block] = medianQuantity(func(history *feeHistoryResult) string { return history.BaseFeePerGas[block] })
+	}
+	for block := range first.GasUsedRatio {
+		ratios := make([]float64, 0, len(histories))
+		for _, history := range histories {
+			ratios = append(ratios, history.GasUsedRatio[block])
+		}
+		sort.Float64s(ratios)
+		middle := len(ratios) / 2
+		if len(ratios)%2 == 0 {
+			aggregated.GasUsedRatio[block] = (ratios[middle-1] + ratios[middle]) / 2
+		} else {
+			aggregated.GasUsedRatio[block] = ratios[middle]
+		}
+	}
+	if len(first.Reward) > 0 {
+		aggregated.Reward = make([][]string, len(first.Reward))
+		for block := range first.Reward {
+			aggregated.Reward[block] = make([]string, len(first.Reward[block]))
+			for percentile := range first.Reward[block] {
+				aggregated.Reward[block][percentile] = medianQuantity(func(history *feeHistoryResult) string { return history.Reward[block][percentile] })
+			}
+		}
+	}
+	return aggregated
+}
+
+// medianBig returns the median of the values, the mean of the two middle values of an even count rounded down
+func medianBig(values []*big.Int) *big.Int {
+	sort.Slice(values, func(i, j int) bool { return values[i].Cmp(values[j]) < 0 })
+	middle := len(values) / 2
+	if len(values)%2 == 1 {
+		return values[middle]
+	}
+	median := new(big.Int).Add(values[middle-1], values[middle])
+	return median.Rsh(median, 1)
+}
@@ -0,0 +1,62 @@
+package chainlib
+
+import (
+	"testing"
+
+	spectypes "github.com/lavanet/lava/x/spec/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAggregateGasPriceReplies(t *testing.T) {
+	replies := [][]byte{
+		[]byte(`{"jsonrpc":"2.0","id":7,"result":"0x64"}`),
+		[]byte(`{"jsonrpc":"2.0","id":7,"result":"0x3b9aca00"}`), // a provider skewing the estimate
+		[]byte(`{"jsonrpc":"2.0","id":7,"error":{"code":-32000,"message":"failed"}}`),
+		[]byte(`{"jsonrpc":"2.0","id":7,"result":"0x6e"}`),
+		[]byte(`not json`),
+	}
+	aggregated, err := AggregateGasReplies(GasPriceApi, replies)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"jsonrpc":"2.0","id":7,"result":"0x6e"}`, string(aggregated))
+
+	// an even count takes the mean of the middle estimates
+	aggregated, err = AggregateGasReplies(MaxPriorityFeePerGasApi, replies[:2])
+	require.NoError(t, err)
+	require.JSONEq(t, `{"jsonrpc":"2.0","id":7,"result":"0x1dcd6532"}`, string(aggregated))
+
+	_, err = AggregateGasReplies(GasPriceApi, replies[2:3])
+	require.Error(t, err)
+	_, err = AggregateGasReplies("eth_blockNumber", replies)
+	require.Error(t, err)
+}
+
+func TestAggregateFeeHistoryReplies(t *testing.T) {
+	replies := [][]byte{
+		[]byte(`{"jsonrpc":"2.0","id":1,"result":{"oldestBlock":"0x10","baseFeePerGas":["0x1","0x2","0x3"],"gasUsedRatio":[0.1,0.5],"reward":[["0x1","0xa"],["0x2","0xb"]],"baseFeePerBlobGas":["0x1","0x1","0x1"]}}`),
+		[]byte(`{"jsonrpc":"2.0","id":1,"result":{"oldestBlock":"0x10","baseFeePerGas":["0x5","0x4","0x9"],"gasUsedRatio":[0.3,0.9],"reward":[["0x3","0xc"],["0x4","0xd"]]}}`),
+		[]byte(`{"jsonrpc":"2.0","id":1,"result":{"oldestBlock":"0x10","baseFeePerGas":["0x3","0x6","0x5"],"gasUsedRatio":[0.2,0.7],"reward":[["0x2","0xff"],["0x3","0xc"]]}}`),
+		// a provider a block ahead covers another window
+		[]byte(`{"jsonrpc":"2.0","id":1,"result":{"oldestBlock":"0x11","baseFeePerGas":["0x2","0x3","0x4"],"gasUsedRatio":[0.5,0.6],"reward":[["0x2","0xb"],["0x3","0xc"]]}}`),
+	}
+	aggregated, err := AggregateGasReplies(FeeHistoryApi, replies)
+	require.NoError(t, err)
+	expected := `{"jsonrpc":"2.0","id":1,"result":{"oldestBlock":"0x10","baseFeePerGas":["0x3","0x4","0x5"],"gasUsedRatio":[0.2,0.7],"reward":[["0x2","0xc"],["0x3","0xc"]],"baseFeePerBlobGas":["0x1","0x1","0x1"]}}`
+	require.JSONEq(t, expected, string(aggregated))
+
+	// histories with a different number of reward percentiles per block are left out
+	_, err = AggregateGasReplies(FeeHistoryApi, [][]byte{[]byte(`{"jsonrpc":"2.0","id":1,"result":{"oldestBlock":"0x10","baseFeePerGas":["0x1"],"gasUsedRatio":[0.1],"reward":[["0x1"],["0x1","0x2"]]}}`)})
+	require.Error(t, err)
+}
+
+func TestIsGasOracleApi(t *testing.T) {
+	message := func(name string, apiInterface string) ChainMessageForSend {
+		return parsedMessage{
+			serviceApi:   &spectypes.ServiceApi{Name: name},
+			apiInterface: &spectypes.ApiInterface{Interface: apiInterface, Category: &spectypes.SpecCategory{Deterministic: true}},
+		}
+	}
+	require.True(t, IsGasOracleApi(message(GasPriceApi, spectypes.APIInterfaceJsonRPC)))
+	require.True(t, IsGasOracleApi(message(FeeHistoryApi, spectypes.APIInterfaceJsonRPC)))
+	require.False(t, IsGasOracleApi(message(GasPriceApi, spectypes.APIInterfaceRest)))
+	require.False(t, IsGasOracleApi(message("eth_blockNumber", spectypes.APIInterfaceJsonRPC)))
+}
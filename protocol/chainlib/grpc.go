@@ -30,7 +30,9 @@ import (
 	pairingtypes "github.com/lavanet/lava/x/pairing/types"
 	spectypes "github.com/lavanet/lava/x/spec/types"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	reflectionpbo "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
+	"google.golang.org/grpc/status"
 )
 
 type GrpcChainParser struct {
@@ -94,12 +96,16 @@ func (apip *GrpcChainParser) ParseMsg(url string, data []byte, connectionType st
 	return nodeMsg, nil
 }
 
-func (*GrpcChainParser) newChainMessage(serviceApi *spectypes.ServiceApi, apiInterface *spectypes.ApiInterface, requestedBlock int64, grpcMessage *rpcInterfaceMessages.GrpcMessage) *parsedMessage {
+func (apip *GrpcChainParser) newChainMessage(serviceApi *spectypes.ServiceApi, apiInterface *spectypes.ApiInterface, requestedBlock int64, grpcMessage *rpcInterfaceMessages.GrpcMessage) *parsedMessage {
+	apip.rwLock.RLock()
+	maxPaginationLimit := apip.spec.MaxPaginationLimit
+	apip.rwLock.RUnlock()
 	nodeMsg := &parsedMessage{
-		serviceApi:     serviceApi,
-		apiInterface:   apiInterface,
-		msg:            grpcMessage, // setting the grpc message as a pointer so we can set descriptors for parsing
-		requestedBlock: requestedBlock,
+		serviceApi:      serviceApi,
+		apiInterface:    apiInterface,
+		msg:             grpcMessage, // setting the grpc message as a pointer so we can set descriptors for parsing
+		requestedBlock:  requestedBlock,
+		paginationLimit: maxPaginationLimit,
 	}
 	return nodeMsg
 }
@@ -186,17 +192,19 @@ func (apip *GrpcChainParser) ChainBlockStats() (allowedBlockLagForQosSync int64,
 }
 
 type GrpcChainListener struct {
-	endpoint    *lavasession.RPCEndpoint
-	relaySender RelaySender
-	logger      *common.RPCConsumerLogs
+	endpoint       *lavasession.RPCEndpoint
+	relaySender    RelaySender
+	logger         *common.RPCConsumerLogs
+	listenerConfig *ListenerConfig
 }
 
-func NewGrpcChainListener(ctx context.Context, listenEndpoint *lavasession.RPCEndpoint, relaySender RelaySender, rpcConsumerLogs *common.RPCConsumerLogs) (chainListener *GrpcChainListener) {
+func NewGrpcChainListener(ctx context.Context, listenEndpoint *lavasession.RPCEndpoint, relaySender RelaySender, rpcConsumerLogs *common.RPCConsumerLogs, listenerConfig *ListenerConfig) (chainListener *GrpcChainListener) {
 	// Create a new instance of GrpcChainListener
 	chainListener = &GrpcChainListener{
 		listenEndpoint,
 		relaySender,
 		rpcConsumerLogs,
+		listenerConfig,
 	}
 
 	return chainListener
@@ -213,14 +221,29 @@ func (apil *GrpcChainListener) Serve(ctx context.Context) {
 
 	lis := GetListenerWithRetryGrpc("tcp", apil.endpoint.NetworkAddress)
 	apiInterface := apil.endpoint.ApiInterface
+	authenticator := apil.listenerConfig.authenticator()
 	sendRelayCallback := func(ctx context.Context, method string, reqBody []byte) ([]byte, error) {
 		ctx = utils.WithUniqueIdentifier(ctx, utils.GenerateUniqueIdentifier())
 		msgSeed := apil.logger.GetMessageSeed()
 		metadataValues, _ := metadata.FromIncomingContext(ctx)
-		utils.LavaFormatInfo("GRPC Got Relay ", utils.Attribute{Key: "GUID", Value: ctx}, utils.Attribute{Key: "method", Value: method})
+		dappID := "NoDappID"
+		if authenticator != nil {
+			authenticatedDappID, err := authenticateGrpcRelay(ctx, authenticator, metadataValues)
+			if err != nil {
+				return nil, err
+			}
+			dappID = authenticatedDappID
+		}
+		ctx = extractLavaHeadersFromMetadata(ctx, metadataValues)
+		utils.LavaFormatInfo("GRPC Got Relay ", utils.Attribute{Key: "GUID", Value: ctx}, utils.Attribute{Key: "method", Value: method}, utils.Attribute{Key: "dappID", Value: dappID})
 		var relayReply *pairingtypes.RelayReply
-		metricsData := metrics.NewRelayAnalytics("NoDappID", apil.endpoint.ChainID, apiInterface)
-		relayReply, _, err := apil.relaySender.SendRelay(ctx, method, string(reqBody), "", "NoDappID", metricsData)
+		metricsData := metrics.NewRelayAnalytics(dappID, apil.endpoint.ChainID, apiInterface)
+		relayReply, _, err := apil.relaySender.SendRelay(ctx, method, string(reqBody), "", dappID, metricsData)
+		setRelayTraceHeader(ctx, func(key string, value string) {
+			if err := grpc.SetHeader(ctx, metadata.Pairs(key, value)); err != nil {
+				utils.LavaFormatDebug("failed setting relay trace header", utils.Attribute{Key: "GUID", Value: ctx}, utils.Attribute{Key: "error", Value: err.Error()})
+			}
+		})
 		go apil.logger.AddMetricForGrpc(metricsData, err, &metadataValues)
 
 		if err != nil {
@@ -238,15 +261,33 @@ func (apil *GrpcChainListener) Serve(ctx context.Context) {
 	}
 
 	utils.LavaFormatInfo("Server listening", utils.Attribute{Key: "Address", Value: lis.Addr()})
+	go func() {
+		<-ctx.Done()
+		if err := httpServer.Shutdown(context.Background()); err != nil {
+			utils.LavaFormatError("failed shutting down listener", err, utils.Attribute{Key: "Address", Value: lis.Addr()})
+		}
+	}()
 
-	if err := httpServer.Serve(lis); !errors.Is(err, http.ErrServerClosed) {
+	// grpc clients negotiate only h2
+	tlsConfig, err := apil.listenerConfig.tlsConfig(true)
+	if err != nil {
+		utils.LavaFormatFatal("failed loading the listener tls config", err, utils.Attribute{Key: "Address", Value: lis.Addr()})
+	}
+	if tlsConfig != nil {
+		// the h2c handler serves http2 over tls as well, ServeTLS negotiates it
+		httpServer.TLSConfig = tlsConfig
+		err = httpServer.ServeTLS(lis, "", "")
+	} else {
+		err = httpServer.Serve(lis)
+	}
+	if !errors.Is(err, http.ErrServerClosed) {
 		utils.LavaFormatFatal("Portal failed to serve", err, utils.Attribute{Key: "Address", Value: lis.Addr()}, utils.Attribute{Key: "ChainID", Value: apil.endpoint.ChainID})
 	}
 }
 
 type GrpcChainProxy struct {
 	BaseChainProxy
-	conn *chainproxy.GRPCConnector
+	conns *nodeFailover[*chainproxy.GRPCConnector]
 }
 
 func NewGrpcChainProxy(ctx context.Context, nConns uint, rpcProviderEndpoint *lavasession.RPCProviderEndpoint, averageBlockTime time.Duration) (ChainProxy, error) {
@@ -254,18 +295,27 @@ func NewGrpcChainProxy(ctx context.Context, nConns uint, rpcProviderEndpoint *la
 		return nil, utils.LavaFormatError("rpcProviderEndpoint.NodeUrl list is empty missing node url", nil, utils.Attribute{Key: "chainID", Value: rpcProviderEndpoint.ChainID}, utils.Attribute{Key: "ApiInterface", Value: rpcProviderEndpoint.ApiInterface})
 	}
 	cp := &GrpcChainProxy{
-		BaseChainProxy: BaseChainProxy{averageBlockTime: averageBlockTime},
+		BaseChainProxy: BaseChainProxy{averageBlockTime: averageBlockTime, responseLimits: newResponseLimits(rpcProviderEndpoint)},
+	}
+	nodeUrls := make([]common.NodeUrl, len(rpcProviderEndpoint.NodeUrls))
+	for idx, nodeUrl := range rpcProviderEndpoint.NodeUrls {
+		nodeUrl.Url = strings.TrimSuffix(nodeUrl.Url, "/") // remove suffix if exists
+		nodeUrls[idx] = nodeUrl
 	}
-	nodeUrl := rpcProviderEndpoint.NodeUrls[0]
-	nodeUrl.Url = strings.TrimSuffix(nodeUrl.Url, "/") // remove suffix if exists
-	conn, err := chainproxy.NewGRPCConnector(ctx, nConns, nodeUrl)
+	conns, err := newNodeFailover(ctx, nodeUrls, func(ctx context.Context, nodeUrl common.NodeUrl) (*chainproxy.GRPCConnector, error) {
+		conn, err := chainproxy.NewGRPCConnector(ctx, nConns, nodeUrl)
+		if err != nil {
+			return nil, err
+		}
+		if conn == nil {
+			return nil, utils.LavaFormatError("g_conn == nil", nil)
+		}
+		return conn, nil
+	})
 	if err != nil {
 		return nil, err
 	}
-	cp.conn = conn
-	if cp.conn == nil {
-		return nil, utils.LavaFormatError("g_conn == nil", nil)
-	}
+	cp.conns = conns
 	return cp, nil
 }
 
@@ -273,11 +323,16 @@ func (cp *GrpcChainProxy) SendNodeMsg(ctx context.Context, ch chan interface{},
 	if ch != nil {
 		return nil, "", nil, utils.LavaFormatError("Subscribe is not allowed on grpc", nil, utils.Attribute{Key: "GUID", Value: ctx})
 	}
-	conn, err := cp.conn.GetRpc(ctx, true)
+	node, err := cp.conns.getNode()
 	if err != nil {
+		return nil, "", nil, utils.LavaFormatError("grpc get node failed", err, utils.Attribute{Key: "GUID", Value: ctx})
+	}
+	conn, err := node.connection.GetRpc(ctx, true)
+	if err != nil {
+		cp.conns.onNodeFailure(node, err)
 		return nil, "", nil, utils.LavaFormatError("grpc get connection failed ", err, utils.Attribute{Key: "GUID", Value: ctx})
 	}
-	defer cp.conn.ReturnRpc(conn)
+	defer node.connection.ReturnRpc(conn)
 
 	rpcInputMessage := chainMessage.GetRPCMessage()
 	nodeMessage, ok := rpcInputMessage.(*rpcInterfaceMessages.GrpcMessage)
@@ -289,7 +344,7 @@ func (cp *GrpcChainProxy) SendNodeMsg(ctx context.Context, ch chan interface{},
 	if chainMessage.GetInterface().Category.HangingApi {
 		relayTimeout += cp.averageBlockTime
 	}
-	connectCtx, cancel := cp.NodeUrl.LowerContextTimeout(ctx, relayTimeout)
+	connectCtx, cancel := node.nodeUrl.LowerContextTimeout(ctx, relayTimeout)
 	defer cancel()
 
 	// TODO: improve functionality, this is reading descriptors every send
@@ -337,14 +392,32 @@ func (cp *GrpcChainProxy) SendNodeMsg(ctx context.Context, ch chan interface{},
 		if err != nil {
 			return nil, "", nil, utils.LavaFormatError("rp.Next(msg) Failed", err, utils.Attribute{Key: "GUID", Value: ctx})
 		}
+		if maxPaginationLimit := paginationLimit(chainMessage); clampGrpcPagination(msg, maxPaginationLimit) {
+			utils.LavaFormatDebug("lowered the pagination limit of a grpc query", utils.Attribute{Key: "GUID", Value: ctx}, utils.Attribute{Key: "limit", Value: maxPaginationLimit})
+		}
 	}
 
 	response := msgFactory.NewMessage(methodDescriptor.GetOutputType())
-	err = grpc.Invoke(connectCtx, nodeMessage.Path, msg, response, conn)
+	var callOptions []grpc.CallOption
+	responseSizeLimit := cp.responseSizeLimit(chainMessage)
+	if responseSizeLimit > 0 {
+		// the grpc client stops receiving a message over the limit, so it's never held whole
+		callOptions = append(callOptions, grpc.MaxCallRecvMsgSize(int(responseSizeLimit)))
+	}
+	err = grpc.Invoke(connectCtx, nodeMessage.Path, msg, response, conn, callOptions...)
 	if err != nil {
+		if responseSizeLimit > 0 && status.Code(err) == codes.ResourceExhausted && strings.Contains(status.Convert(err).Message(), "larger than max") {
+			return nil, "", nil, responseTooLargeError(ctx, chainMessage, responseSizeLimit)
+		}
+		if status.Code(err) == codes.Unavailable && ctx.Err() == nil {
+			// only connectivity failures count against the node, application errors are valid replies
+			cp.conns.onNodeFailure(node, err)
+		}
 		return nil, "", nil, utils.LavaFormatError("Invoke Failed", err, utils.Attribute{Key: "GUID", Value: ctx}, utils.Attribute{Key: "Method", Value: nodeMessage.Path}, utils.Attribute{Key: "msg", Value: nodeMessage.Msg})
 	}
 
+	cp.conns.onNodeSuccess(node)
+
 	var respBytes []byte
 	respBytes, err = proto.Marshal(response)
 	if err != nil {
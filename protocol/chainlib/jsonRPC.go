@@ -82,6 +82,10 @@ func (apip *JsonRPCChainParser) ParseMsg(url string, data []byte, connectionType
 	}
 
 	nodeMsg := apip.newChainMessage(serviceApi, apiInterface, requestedBlock, *msg)
+	err = setTimeoutHintFromRequest(nodeMsg, msg.LavaTimeout)
+	if err != nil {
+		return nil, utils.LavaFormatError("invalid lava_timeout in request", err, utils.Attribute{Key: "lava_timeout", Value: msg.LavaTimeout})
+	}
 	return nodeMsg, nil
 }
 
@@ -177,18 +181,20 @@ func (apip *JsonRPCChainParser) ChainBlockStats() (allowedBlockLagForQosSync int
 }
 
 type JsonRPCChainListener struct {
-	endpoint    *lavasession.RPCEndpoint
-	relaySender RelaySender
-	logger      *common.RPCConsumerLogs
+	endpoint       *lavasession.RPCEndpoint
+	relaySender    RelaySender
+	logger         *common.RPCConsumerLogs
+	listenerConfig *ListenerConfig
 }
 
 // NewJrpcChainListener creates a new instance of JsonRPCChainListener
-func NewJrpcChainListener(ctx context.Context, listenEndpoint *lavasession.RPCEndpoint, relaySender RelaySender, rpcConsumerLogs *common.RPCConsumerLogs) (chainListener *JsonRPCChainListener) {
+func NewJrpcChainListener(ctx context.Context, listenEndpoint *lavasession.RPCEndpoint, relaySender RelaySender, rpcConsumerLogs *common.RPCConsumerLogs, listenerConfig *ListenerConfig) (chainListener *JsonRPCChainListener) {
 	// Create a new instance of JsonRPCChainListener
 	chainListener = &JsonRPCChainListener{
 		listenEndpoint,
 		relaySender,
 		rpcConsumerLogs,
+		listenerConfig,
 	}
 
 	return chainListener
@@ -205,6 +211,8 @@ func (apil *JsonRPCChainListener) Serve(ctx context.Context) {
 	app := fiber.New(fiber.Config{})
 
 	app.Use(favicon.New())
+	apil.listenerConfig.useCORS(app)
+	apil.listenerConfig.useDappAuth(app)
 
 	app.Use("/ws/:dappId", func(c *fiber.Ctx) error {
 		// IsWebSocketUpgrade returns true if the client
@@ -235,6 +243,7 @@ func (apil *JsonRPCChainListener) Serve(ctx context.Context) {
 
 			ctx, cancel := context.WithCancel(context.Background())
 			ctx = utils.WithUniqueIdentifier(ctx, utils.GenerateUniqueIdentifier())
+			ctx = extractLavaHeadersFromWebsocketConnection(ctx, websockConn)
 			defer cancel() // incase there's a problem make sure to cancel the connection
 			utils.LavaFormatInfo("ws in <<<", utils.Attribute{Key: "seed", Value: msgSeed}, utils.Attribute{Key: "GUID", Value: ctx}, utils.Attribute{Key: "msg", Value: msg}, utils.Attribute{Key: "dappID", Value: dappID})
 			metricsData := metrics.NewRelayAnalytics(dappID, chainID, apiInterface)
@@ -297,11 +306,13 @@ func (apil *JsonRPCChainListener) Serve(ctx context.Context) {
 		ctx, cancel := context.WithCancel(context.Background())
 		defer cancel()
 		ctx = utils.WithUniqueIdentifier(ctx, utils.GenerateUniqueIdentifier())
+		ctx = extractLavaHeadersFromFiberContext(ctx, fiberCtx)
 		utils.LavaFormatInfo("in <<<", utils.Attribute{Key: "GUID", Value: ctx}, utils.Attribute{Key: "seed", Value: msgSeed}, utils.Attribute{Key: "msg", Value: fiberCtx.Body()}, utils.Attribute{Key: "dappID", Value: dappID})
 		if test_mode {
 			apil.logger.LogTestMode(fiberCtx)
 		}
 		reply, _, err := apil.relaySender.SendRelay(ctx, "", string(fiberCtx.Body()), http.MethodPost, dappID, metricsData)
+		setRelayTraceHeader(ctx, fiberCtx.Set)
 		go apil.logger.AddMetricForHttp(metricsData, err, fiberCtx.GetReqHeaders())
 		if err != nil {
 			// Get unique GUID response
@@ -335,45 +346,59 @@ func (apil *JsonRPCChainListener) Serve(ctx context.Context) {
 	})
 
 	// Go
-	ListenWithRetry(app, apil.endpoint.NetworkAddress)
+	ListenWithRetry(ctx, app, apil.endpoint.NetworkAddress, apil.listenerConfig)
 }
 
 type JrpcChainProxy struct {
 	BaseChainProxy
-	conn *chainproxy.Connector
+	conns *nodeFailover[*chainproxy.Connector]
 }
 
 func NewJrpcChainProxy(ctx context.Context, nConns uint, rpcProviderEndpoint *lavasession.RPCProviderEndpoint, averageBlockTime time.Duration) (ChainProxy, error) {
 	if len(rpcProviderEndpoint.NodeUrls) == 0 {
 		return nil, utils.LavaFormatError("rpcProviderEndpoint.NodeUrl list is empty missing node url", nil, utils.Attribute{Key: "chainID", Value: rpcProviderEndpoint.ChainID}, utils.Attribute{Key: "ApiInterface", Value: rpcProviderEndpoint.ApiInterface})
 	}
-	nodeUrl := rpcProviderEndpoint.NodeUrls[0]
 	cp := &JrpcChainProxy{
-		BaseChainProxy: BaseChainProxy{averageBlockTime: averageBlockTime, NodeUrl: nodeUrl},
+		BaseChainProxy: BaseChainProxy{averageBlockTime: averageBlockTime, NodeUrl: rpcProviderEndpoint.NodeUrls[0], responseLimits: newResponseLimits(rpcProviderEndpoint)},
+	}
+	for _, nodeUrl := range rpcProviderEndpoint.NodeUrls {
+		if err := verifyRPCEndpoint(nodeUrl.Url); err != nil {
+			return nil, err
+		}
 	}
-	verifyRPCEndpoint(nodeUrl.Url)
-	return cp, cp.start(ctx, nConns, nodeUrl)
+	return cp, cp.start(ctx, nConns, rpcProviderEndpoint.NodeUrls)
 }
 
-func (cp *JrpcChainProxy) start(ctx context.Context, nConns uint, nodeUrl common.NodeUrl) error {
-	conn, err := chainproxy.NewConnector(ctx, nConns, nodeUrl)
+func (cp *JrpcChainProxy) start(ctx context.Context, nConns uint, nodeUrls []common.NodeUrl) error {
+	conns, err := newNodeFailover(ctx, nodeUrls, func(ctx context.Context, nodeUrl common.NodeUrl) (*chainproxy.Connector, error) {
+		conn, err := chainproxy.NewConnector(ctx, nConns, nodeUrl)
+		if err != nil {
+			return nil, err
+		}
+		if conn == nil {
+			return nil, errors.New("g_conn == nil")
+		}
+		return conn, nil
+	})
 	if err != nil {
 		return err
 	}
-	cp.conn = conn
-	if cp.conn == nil {
-		return errors.New("g_conn == nil")
-	}
+	cp.conns = conns
 	return nil
 }
 
 func (cp *JrpcChainProxy) SendNodeMsg(ctx context.Context, ch chan interface{}, chainMessage ChainMessageForSend) (relayReply *pairingtypes.RelayReply, subscriptionID string, relayReplyServer *rpcclient.ClientSubscription, err error) {
 	// Get node
-	rpc, err := cp.conn.GetRpc(ctx, true)
+	node, err := cp.conns.getNode()
+	if err != nil {
+		return nil, "", nil, err
+	}
+	rpc, err := node.connection.GetRpc(ctx, true)
 	if err != nil {
+		cp.conns.onNodeFailure(node, err)
 		return nil, "", nil, err
 	}
-	defer cp.conn.ReturnRpc(rpc)
+	defer node.connection.ReturnRpc(rpc)
 	rpcInputMessage := chainMessage.GetRPCMessage()
 	nodeMessage, ok := rpcInputMessage.(rpcInterfaceMessages.JsonrpcMessage)
 	if !ok {
@@ -391,10 +416,20 @@ func (cp *JrpcChainProxy) SendNodeMsg(ctx context.Context, ch chan interface{},
 		if chainMessage.GetInterface().Category.HangingApi {
 			relayTimeout += cp.averageBlockTime
 		}
-		cp.NodeUrl.SetIpForwardingIfNecessary(ctx, rpc.SetHeader)
-		connectCtx, cancel := cp.NodeUrl.LowerContextTimeout(ctx, relayTimeout)
+		node.nodeUrl.SetIpForwardingIfNecessary(ctx, rpc.SetHeader)
+		connectCtx, cancel := node.nodeUrl.LowerContextTimeout(ctx, relayTimeout)
 		defer cancel()
-		rpcMessage, err = rpc.CallContext(connectCtx, nodeMessage.ID, nodeMessage.Method, nodeMessage.Params)
+		rpcMessage, err = rpc.CallContext(cp.rpcCallContext(connectCtx, chainMessage), nodeMessage.ID, nodeMessage.Method, nodeMessage.Params)
+		if errors.Is(err, rpcclient.ErrResponseTooLarge) {
+			// the node answered, the relay only asked for more than the provider serves
+			return nil, "", nil, responseTooLargeError(ctx, chainMessage, cp.responseSizeLimit(chainMessage))
+		}
+	}
+	if err != nil && ctx.Err() == nil {
+		// the node failed to answer while the relay was still valid
+		cp.conns.onNodeFailure(node, err)
+	} else if err == nil {
+		cp.conns.onNodeSuccess(node)
 	}
 
 	var replyMsg rpcInterfaceMessages.JsonrpcMessage
@@ -422,6 +457,12 @@ func (cp *JrpcChainProxy) SendNodeMsg(ctx context.Context, ch chan interface{},
 	if err != nil {
 		return nil, "", nil, err
 	}
+	if ch == nil {
+		// http responses were limited while being read, a websocket node's response was already read whole
+		if err := cp.verifyResponseSize(ctx, chainMessage, len(retData)); err != nil {
+			return nil, "", nil, err
+		}
+	}
 
 	reply := &pairingtypes.RelayReply{
 		Data: retData,
@@ -0,0 +1,108 @@
+package chainlib
+
+import (
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/cors"
+	"golang.org/x/crypto/acme"
+	"golang.org/x/crypto/acme/autocert"
+)
+
+// ListenerConfig sets how the chain listeners face browsers and the network, the zero value serves plain http without CORS headers
+type ListenerConfig struct {
+	CORSAllowOrigins string // comma separated origins browser dapps may call the listener from, "*" for any, no CORS headers when empty
+	CORSAllowHeaders string // comma separated request headers browsers may send, fiber's default when empty
+	TLSCertFile      string // with TLSKeyFile, serves https and wss with this certificate
+	TLSKeyFile       string
+	AutoCertDomains  []string          // serves https with certificates from Let's Encrypt for these domains instead, the listener must be reachable on port 443
+	AutoCertCacheDir string            // where certificates from Let's Encrypt are kept between restarts
+	APIKeys          map[string]string // api keys dapps are authenticated with, mapped to their dappIDs
+	JWTSecret        string            // HS256 secret of the bearer tokens dapps are authenticated with
+	JWTDappClaim     string            // the token claim holding the dappID, DefaultJWTDappClaim when empty
+	DappVerifierURL  string            // the operator's auth backend, for credentials the keys and tokens above don't accept
+	Authenticator    DappAuthenticator // replaces all of the above with the operator's own verifier
+}
+
+func (lc *ListenerConfig) Validate() error {
+	if lc == nil {
+		return nil
+	}
+	if (lc.TLSCertFile == "") != (lc.TLSKeyFile == "") {
+		return fmt.Errorf("both a tls cert file and a tls key file are required, got cert %q and key %q", lc.TLSCertFile, lc.TLSKeyFile)
+	}
+	if lc.TLSCertFile != "" && len(lc.AutoCertDomains) > 0 {
+		return fmt.Errorf("a tls cert file and autocert domains are both set, only one of them can be used")
+	}
+	if len(lc.AutoCertDomains) > 0 && lc.AutoCertCacheDir == "" {
+		return fmt.Errorf("autocert requires a cache dir so certificates aren't requested again on every restart")
+	}
+	return nil
+}
+
+// String identifies the config, listeners are restarted when it changes
+func (lc *ListenerConfig) String() string {
+	if lc == nil {
+		return ""
+	}
+	return fmt.Sprintf("cors:%s|%s tls:%s|%s autocert:%s|%s auth:%s", lc.CORSAllowOrigins, lc.CORSAllowHeaders, lc.TLSCertFile, lc.TLSKeyFile, strings.Join(lc.AutoCertDomains, ","), lc.AutoCertCacheDir, lc.authFingerprint())
+}
+
+// authFingerprint changes with the auth settings without exposing the keys and secret
+func (lc *ListenerConfig) authFingerprint() string {
+	if len(lc.APIKeys) == 0 && lc.JWTSecret == "" && lc.DappVerifierURL == "" && lc.Authenticator == nil {
+		return ""
+	}
+	apiKeys := make([]string, 0, len(lc.APIKeys))
+	for apiKey, dappID := range lc.APIKeys {
+		apiKeys = append(apiKeys, apiKey+"="+dappID)
+	}
+	sort.Strings(apiKeys)
+	hash := sha256.Sum256([]byte(strings.Join(apiKeys, ",") + "|" + lc.JWTSecret + "|" + lc.JWTDappClaim + "|" + lc.DappVerifierURL + "|" + fmt.Sprintf("%T", lc.Authenticator)))
+	return hex.EncodeToString(hash[:8])
+}
+
+// useCORS answers the preflight requests of browsers and adds the CORS headers to the replies of app
+func (lc *ListenerConfig) useCORS(app *fiber.App) {
+	if lc == nil || lc.CORSAllowOrigins == "" {
+		return
+	}
+	corsConfig := cors.Config{AllowOrigins: lc.CORSAllowOrigins, AllowMethods: "GET,POST,OPTIONS"}
+	if lc.CORSAllowHeaders != "" {
+		corsConfig.AllowHeaders = lc.CORSAllowHeaders
+	}
+	app.Use(cors.New(corsConfig))
+}
+
+// tlsConfig returns nil when the listener serves plain http, http2 is negotiated on listeners whose server supports it
+func (lc *ListenerConfig) tlsConfig(http2 bool) (*tls.Config, error) {
+	if lc == nil {
+		return nil, nil
+	}
+	if lc.TLSCertFile != "" {
+		certificate, err := tls.LoadX509KeyPair(lc.TLSCertFile, lc.TLSKeyFile)
+		if err != nil {
+			return nil, err
+		}
+		return &tls.Config{Certificates: []tls.Certificate{certificate}, MinVersion: tls.VersionTLS12}, nil
+	}
+	if len(lc.AutoCertDomains) > 0 {
+		manager := &autocert.Manager{
+			Prompt:     autocert.AcceptTOS,
+			HostPolicy: autocert.HostWhitelist(lc.AutoCertDomains...),
+			Cache:      autocert.DirCache(lc.AutoCertCacheDir),
+		}
+		// certificates are issued through the tls-alpn-01 challenge, on the listener itself
+		nextProtos := []string{"http/1.1", acme.ALPNProto}
+		if http2 {
+			nextProtos = append([]string{"h2"}, nextProtos...)
+		}
+		return &tls.Config{GetCertificate: manager.GetCertificate, NextProtos: nextProtos, MinVersion: tls.VersionTLS12}, nil
+	}
+	return nil, nil
+}
@@ -0,0 +1,75 @@
+package chainlib
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/acme"
+)
+
+func TestListenerConfigValidate(t *testing.T) {
+	for _, tt := range []struct {
+		name   string
+		config *ListenerConfig
+		valid  bool
+	}{
+		{name: "no config", config: nil, valid: true},
+		{name: "plain http", config: &ListenerConfig{CORSAllowOrigins: "*"}, valid: true},
+		{name: "tls", config: &ListenerConfig{TLSCertFile: "cert.pem", TLSKeyFile: "key.pem"}, valid: true},
+		{name: "tls without a key", config: &ListenerConfig{TLSCertFile: "cert.pem"}, valid: false},
+		{name: "autocert", config: &ListenerConfig{AutoCertDomains: []string{"lava.example.com"}, AutoCertCacheDir: "certs"}, valid: true},
+		{name: "autocert without a cache", config: &ListenerConfig{AutoCertDomains: []string{"lava.example.com"}}, valid: false},
+		{name: "tls and autocert", config: &ListenerConfig{TLSCertFile: "cert.pem", TLSKeyFile: "key.pem", AutoCertDomains: []string{"lava.example.com"}, AutoCertCacheDir: "certs"}, valid: false},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.config.Validate()
+			if tt.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+	_, err := (&ListenerConfig{TLSCertFile: "missing.pem", TLSKeyFile: "missing.pem"}).tlsConfig(false)
+	require.Error(t, err)
+
+	// the grpc listener keeps negotiating http2 with autocert
+	autoCertConfig := &ListenerConfig{AutoCertDomains: []string{"lava.example.com"}, AutoCertCacheDir: t.TempDir()}
+	tlsConfig, err := autoCertConfig.tlsConfig(true)
+	require.NoError(t, err)
+	require.Equal(t, []string{"h2", "http/1.1", acme.ALPNProto}, tlsConfig.NextProtos)
+	tlsConfig, err = autoCertConfig.tlsConfig(false)
+	require.NoError(t, err)
+	require.NotContains(t, tlsConfig.NextProtos, "h2")
+}
+
+func TestListenerCORS(t *testing.T) {
+	for _, tt := range []struct {
+		name          string
+		config        *ListenerConfig
+		origin        string
+		allowedOrigin string
+	}{
+		{name: "no cors", config: nil, origin: "https://dapp.example.com", allowedOrigin: ""},
+		{name: "any origin", config: &ListenerConfig{CORSAllowOrigins: "*"}, origin: "https://dapp.example.com", allowedOrigin: "*"},
+		{name: "allowed origin", config: &ListenerConfig{CORSAllowOrigins: "https://dapp.example.com"}, origin: "https://dapp.example.com", allowedOrigin: "https://dapp.example.com"},
+		{name: "other origin", config: &ListenerConfig{CORSAllowOrigins: "https://dapp.example.com"}, origin: "https://other.example.com", allowedOrigin: ""},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			app := fiber.New()
+			tt.config.useCORS(app)
+			app.Post("/", func(c *fiber.Ctx) error { return c.SendString("{}") })
+
+			// the preflight request a browser sends before the relay
+			request := httptest.NewRequest(http.MethodOptions, "/", nil)
+			request.Header.Set("Origin", tt.origin)
+			request.Header.Set("Access-Control-Request-Method", http.MethodPost)
+			response, err := app.Test(request)
+			require.NoError(t, err)
+			require.Equal(t, tt.allowedOrigin, response.Header.Get("Access-Control-Allow-Origin"))
+		})
+	}
+}
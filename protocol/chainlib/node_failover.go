@@ -0,0 +1,191 @@
+package chainlib
+
+import (
+	"context"
+	"net"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/lavanet/lava/protocol/common"
+	"github.com/lavanet/lava/utils"
+)
+
+const (
+	NodeHealthCheckInterval    = 10 * time.Second
+	MaxConsecutiveNodeFailures = 3 // number of failed relays in a row before a node is taken out of rotation
+	nodeHealthCheckTimeout     = 2 * time.Second
+)
+
+type failoverNode[T any] struct {
+	nodeUrl             common.NodeUrl
+	connection          T
+	connected           bool
+	healthy             bool
+	consecutiveFailures uint64
+}
+
+// nodeFailover holds every upstream node configured for an api interface and round-robins relays between the healthy ones,
+// nodes that keep failing are taken out of rotation until a health check finds them reachable again
+type nodeFailover[T any] struct {
+	lock      sync.RWMutex
+	nodes     []*failoverNode[T]
+	nextIndex int
+	connect   func(ctx context.Context, nodeUrl common.NodeUrl) (T, error)
+}
+
+// newNodeFailover connects to all node urls, nodes that fail to connect are retried by the health checks, fails only if no node could be connected
+func newNodeFailover[T any](ctx context.Context, nodeUrls []common.NodeUrl, connect func(ctx context.Context, nodeUrl common.NodeUrl) (T, error)) (*nodeFailover[T], error) {
+	nf := &nodeFailover[T]{
+		nodes:   make([]*failoverNode[T], 0, len(nodeUrls)),
+		connect: connect,
+	}
+	var lastErr error
+	for _, nodeUrl := range nodeUrls {
+		node := &failoverNode[T]{nodeUrl: nodeUrl}
+		connection, err := connect(ctx, nodeUrl)
+		if err != nil {
+			lastErr = err
+			utils.LavaFormatWarning("failed connecting to node, will retry in the background", err, utils.Attribute{Key: "url", Value: nodeUrl.String()})
+		} else {
+			node.connection = connection
+			node.connected = true
+			node.healthy = true
+		}
+		nf.nodes = append(nf.nodes, node)
+	}
+	if nf.numberOfConnectedNodes() == 0 {
+		return nil, utils.LavaFormatError("could not connect to any of the node urls", lastErr, utils.Attribute{Key: "urls", Value: nodeUrls})
+	}
+	if len(nf.nodes) > 1 {
+		go nf.healthCheckLoop(ctx, NodeHealthCheckInterval)
+	}
+	return nf, nil
+}
+
+func (nf *nodeFailover[T]) numberOfConnectedNodes() (connected int) {
+	nf.lock.RLock()
+	defer nf.lock.RUnlock()
+	for _, node := range nf.nodes {
+		if node.connected {
+			connected++
+		}
+	}
+	return connected
+}
+
+// getNode returns the next healthy node in round-robin order, if all nodes are unhealthy a connected node is returned as a best effort
+func (nf *nodeFailover[T]) getNode() (*failoverNode[T], error) {
+	nf.lock.Lock()
+	defer nf.lock.Unlock()
+	var fallback *failoverNode[T]
+	for attempt := 0; attempt < len(nf.nodes); attempt++ {
+		node := nf.nodes[nf.nextIndex]
+		nf.nextIndex = (nf.nextIndex + 1) % len(nf.nodes)
+		if !node.connected {
+			continue
+		}
+		if node.healthy {
+			return node, nil
+		}
+		if fallback == nil {
+			fallback = node
+		}
+	}
+	if fallback != nil {
+		return fallback, nil
+	}
+	return nil, utils.LavaFormatError("no connected node available", nil, utils.Attribute{Key: "nodes", Value: len(nf.nodes)})
+}
+
+// onNodeFailure is called when a relay failed to reach the node
+func (nf *nodeFailover[T]) onNodeFailure(node *failoverNode[T], err error) {
+	nf.lock.Lock()
+	defer nf.lock.Unlock()
+	node.consecutiveFailures++
+	if node.healthy && node.consecutiveFailures >= MaxConsecutiveNodeFailures && len(nf.nodes) > 1 {
+		node.healthy = false
+		utils.LavaFormatWarning("node failed too many times in a row, taking it out of rotation", err, utils.Attribute{Key: "url", Value: node.nodeUrl.String()}, utils.Attribute{Key: "failures", Value: node.consecutiveFailures})
+	}
+}
+
+func (nf *nodeFailover[T]) onNodeSuccess(node *failoverNode[T]) {
+	nf.lock.Lock()
+	defer nf.lock.Unlock()
+	node.consecutiveFailures = 0
+}
+
+func (nf *nodeFailover[T]) healthCheckLoop(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			nf.checkNodes(ctx)
+		}
+	}
+}
+
+func (nf *nodeFailover[T]) checkNodes(ctx context.Context) {
+	nf.lock.RLock()
+	nodes := make([]*failoverNode[T], len(nf.nodes))
+	copy(nodes, nf.nodes)
+	nf.lock.RUnlock()
+	for _, node := range nodes {
+		err := probeNodeUrl(ctx, node.nodeUrl.Url)
+		nf.lock.RLock()
+		connected := node.connected
+		nf.lock.RUnlock()
+		var connection T
+		if err == nil && !connected {
+			connection, err = nf.connect(ctx, node.nodeUrl)
+		}
+		nf.lock.Lock()
+		if err != nil {
+			if node.healthy {
+				utils.LavaFormatWarning("node health check failed, taking it out of rotation", err, utils.Attribute{Key: "url", Value: node.nodeUrl.String()})
+			}
+			node.healthy = false
+		} else {
+			if !node.connected {
+				node.connection = connection
+				node.connected = true
+			}
+			if !node.healthy {
+				utils.LavaFormatInfo("node is healthy again, returning it to rotation", utils.Attribute{Key: "url", Value: node.nodeUrl.String()})
+			}
+			node.healthy = true
+			node.consecutiveFailures = 0
+		}
+		nf.lock.Unlock()
+	}
+}
+
+// probeNodeUrl checks the node accepts connections, node urls can be full urls or a grpc HOST:PORT
+func probeNodeUrl(ctx context.Context, nodeUrl string) error {
+	address := nodeUrl
+	if strings.Contains(nodeUrl, "://") {
+		parsedUrl, err := url.Parse(nodeUrl)
+		if err != nil {
+			return err
+		}
+		address = parsedUrl.Host
+		if parsedUrl.Port() == "" {
+			switch parsedUrl.Scheme {
+			case "https", "wss":
+				address = net.JoinHostPort(parsedUrl.Hostname(), "443")
+			default:
+				address = net.JoinHostPort(parsedUrl.Hostname(), "80")
+			}
+		}
+	}
+	dialer := net.Dialer{Timeout: nodeHealthCheckTimeout}
+	conn, err := dialer.DialContext(ctx, "tcp", address)
+	if err != nil {
+		return err
+	}
+	return conn.Close()
+}
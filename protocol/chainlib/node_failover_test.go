@@ -0,0 +1,130 @@
+package chainlib
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+
+	"github.com/lavanet/lava/protocol/common"
+	"github.com/stretchr/testify/require"
+)
+
+func connectByUrl(failing map[string]bool) func(ctx context.Context, nodeUrl common.NodeUrl) (string, error) {
+	return func(ctx context.Context, nodeUrl common.NodeUrl) (string, error) {
+		if failing[nodeUrl.Url] {
+			return "", errors.New("connection refused")
+		}
+		return nodeUrl.Url, nil
+	}
+}
+
+func TestNodeFailoverRoundRobin(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	nodeUrls := []common.NodeUrl{{Url: "http://node1"}, {Url: "http://node2"}, {Url: "http://node3"}}
+	nf, err := newNodeFailover(ctx, nodeUrls, connectByUrl(nil))
+	require.NoError(t, err)
+
+	for round := 0; round < 2; round++ {
+		for _, nodeUrl := range nodeUrls {
+			node, err := nf.getNode()
+			require.NoError(t, err)
+			require.Equal(t, nodeUrl.Url, node.connection)
+		}
+	}
+}
+
+func TestNodeFailoverTakesFailingNodeOutOfRotation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	nodeUrls := []common.NodeUrl{{Url: "http://node1"}, {Url: "http://node2"}}
+	nf, err := newNodeFailover(ctx, nodeUrls, connectByUrl(nil))
+	require.NoError(t, err)
+
+	failingNode := nf.nodes[0]
+	for i := 0; i < MaxConsecutiveNodeFailures-1; i++ {
+		nf.onNodeFailure(failingNode, errors.New("timeout"))
+	}
+	require.True(t, failingNode.healthy)
+	// a success resets the failure count
+	nf.onNodeSuccess(failingNode)
+	for i := 0; i < MaxConsecutiveNodeFailures; i++ {
+		nf.onNodeFailure(failingNode, errors.New("timeout"))
+	}
+	require.False(t, failingNode.healthy)
+
+	for i := 0; i < 4; i++ {
+		node, err := nf.getNode()
+		require.NoError(t, err)
+		require.Equal(t, "http://node2", node.connection)
+	}
+
+	// when every node is unhealthy we still try one of them
+	for i := 0; i < MaxConsecutiveNodeFailures; i++ {
+		nf.onNodeFailure(nf.nodes[1], errors.New("timeout"))
+	}
+	_, err = nf.getNode()
+	require.NoError(t, err)
+}
+
+func TestNodeFailoverSingleNodeStaysInRotation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	nf, err := newNodeFailover(ctx, []common.NodeUrl{{Url: "http://node1"}}, connectByUrl(nil))
+	require.NoError(t, err)
+	for i := 0; i < MaxConsecutiveNodeFailures*2; i++ {
+		nf.onNodeFailure(nf.nodes[0], errors.New("timeout"))
+	}
+	require.True(t, nf.nodes[0].healthy)
+}
+
+func TestNodeFailoverConnectFailures(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	nodeUrls := []common.NodeUrl{{Url: "http://node1"}, {Url: "http://node2"}}
+	_, err := newNodeFailover(ctx, nodeUrls, connectByUrl(map[string]bool{"http://node1": true, "http://node2": true}))
+	require.Error(t, err)
+
+	nf, err := newNodeFailover(ctx, nodeUrls, connectByUrl(map[string]bool{"http://node1": true}))
+	require.NoError(t, err)
+	require.False(t, nf.nodes[0].connected)
+	for i := 0; i < 3; i++ {
+		node, err := nf.getNode()
+		require.NoError(t, err)
+		require.Equal(t, "http://node2", node.connection)
+	}
+}
+
+func TestNodeFailoverHealthCheckRestoresNode(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+	reachableUrl := "http://" + listener.Addr().String()
+
+	nodeUrls := []common.NodeUrl{{Url: reachableUrl}, {Url: "http://node2"}}
+	failing := map[string]bool{reachableUrl: true}
+	nf, err := newNodeFailover(ctx, nodeUrls, connectByUrl(failing))
+	require.NoError(t, err)
+	require.False(t, nf.nodes[0].connected)
+
+	// node came back up
+	delete(failing, reachableUrl)
+	nf.checkNodes(ctx)
+	require.True(t, nf.nodes[0].connected)
+	require.True(t, nf.nodes[0].healthy)
+}
+
+func TestProbeNodeUrl(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	address := listener.Addr().String()
+	ctx := context.Background()
+	require.NoError(t, probeNodeUrl(ctx, "http://"+address))
+	require.NoError(t, probeNodeUrl(ctx, "ws://"+address+"/websocket"))
+	require.NoError(t, probeNodeUrl(ctx, address)) // grpc style HOST:PORT
+	listener.Close()
+	require.Error(t, probeNodeUrl(ctx, "http://"+address))
+}
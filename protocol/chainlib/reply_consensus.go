@@ -0,0 +1,94 @@
+package chainlib
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/json"
+	"io"
+	"strconv"
+	"strings"
+
+	spectypes "github.com/lavanet/lava/x/spec/types"
+)
+
+// ReplyConsensusHash hashes a reply for comparing it with the replies other providers gave to the same relay. the fields of
+// the api's response ignore paths are left out, they hold data honest nodes disagree on such as timestamps and peer counts.
+// replies that aren't a single json document, grpc replies for one, are hashed as they are
+func ReplyConsensusHash(serviceApi *spectypes.ServiceApi, data []byte) []byte {
+	if serviceApi != nil && len(serviceApi.ResponseIgnorePaths) > 0 {
+		if stripped, ok := stripIgnoredFields(data, serviceApi.ResponseIgnorePaths); ok {
+			data = stripped
+		}
+	}
+	hash := sha256.Sum256(data)
+	return hash[:]
+}
+
+// RepliesMatch returns whether two replies to the same relay agree, ignoring the fields of the api's response ignore paths
+func RepliesMatch(serviceApi *spectypes.ServiceApi, data0 []byte, data1 []byte) bool {
+	if bytes.Equal(data0, data1) {
+		return true
+	}
+	if serviceApi == nil || len(serviceApi.ResponseIgnorePaths) == 0 {
+		return false
+	}
+	return bytes.Equal(ReplyConsensusHash(serviceApi, data0), ReplyConsensusHash(serviceApi, data1))
+}
+
+// stripIgnoredFields returns the reply without the fields of the paths, re-encoded with sorted keys so equal documents encode
+// the same. numbers are kept as they were sent, ok is false when the reply isn't a single json document
+func stripIgnoredFields(data []byte, ignorePaths []string) (stripped []byte, ok bool) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var reply interface{}
+	if err := decoder.Decode(&reply); err != nil {
+		return nil, false
+	}
+	// trailing data would otherwise be left out of the comparison
+	if _, err := decoder.Token(); err != io.EOF {
+		return nil, false
+	}
+	for _, path := range ignorePaths {
+		removeReplyPath(reply, strings.Split(path, spectypes.ResponseIgnorePathSeparator))
+	}
+	stripped, err := json.Marshal(reply)
+	if err != nil {
+		return nil, false
+	}
+	return stripped, true
+}
+
+// removeReplyPath removes the fields matching the keys, array elements are nulled instead of removed so the indexes of the
+// others don't shift
+func removeReplyPath(value interface{}, keys []string) {
+	if len(keys) == 0 {
+		return
+	}
+	matches := func(key string) bool {
+		return keys[0] == spectypes.ResponseIgnorePathWildcard || keys[0] == key
+	}
+	switch node := value.(type) {
+	case map[string]interface{}:
+		for key, child := range node {
+			if !matches(key) {
+				continue
+			}
+			if len(keys) == 1 {
+				delete(node, key)
+			} else {
+				removeReplyPath(child, keys[1:])
+			}
+		}
+	case []interface{}:
+		for idx, child := range node {
+			if !matches(strconv.Itoa(idx)) {
+				continue
+			}
+			if len(keys) == 1 {
+				node[idx] = nil
+			} else {
+				removeReplyPath(child, keys[1:])
+			}
+		}
+	}
+}
@@ -0,0 +1,85 @@
+package chainlib
+
+import (
+	"testing"
+
+	spectypes "github.com/lavanet/lava/x/spec/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepliesMatch(t *testing.T) {
+	tests := []struct {
+		name        string
+		ignorePaths []string
+		data0       string
+		data1       string
+		match       bool
+	}{
+		{"no rules, equal", nil, `{"result":1}`, `{"result":1}`, true},
+		{"no rules, different", nil, `{"result":1,"time":"a"}`, `{"result":1,"time":"b"}`, false},
+		{"ignored field", []string{"time"}, `{"result":1,"time":"a"}`, `{"result":1,"time":"b"}`, true},
+		{"ignored field missing on one side", []string{"time"}, `{"result":1,"time":"a"}`, `{"result":1}`, true},
+		{"other field differs", []string{"time"}, `{"result":1,"time":"a"}`, `{"result":2,"time":"b"}`, false},
+		{"key order and whitespace", []string{"time"}, `{"a":1, "b":2,"time":"a"}`, `{"b":2,"a":1,"time":"b"}`, true},
+		{"big numbers keep their precision", []string{"time"}, `{"result":123456789012345678901234567890,"time":"a"}`, `{"result":123456789012345678901234567891,"time":"b"}`, false},
+		{"wildcard over array elements", []string{"peers.*.last_seen"}, `{"peers":[{"id":"a","last_seen":1},{"id":"b","last_seen":2}]}`, `{"peers":[{"id":"a","last_seen":3},{"id":"b","last_seen":4}]}`, true},
+		{"wildcard keeps the other element fields", []string{"peers.*.last_seen"}, `{"peers":[{"id":"a","last_seen":1}]}`, `{"peers":[{"id":"c","last_seen":1}]}`, false},
+		{"array index", []string{"result.0"}, `{"result":[1,2]}`, `{"result":[3,2]}`, true},
+		{"array index keeps the others in place", []string{"result.0"}, `{"result":[1,2]}`, `{"result":[2]}`, false},
+		{"not json", []string{"time"}, `binary-a`, `binary-b`, false},
+		{"trailing data is compared", []string{"time"}, `{"time":"a"}x`, `{"time":"b"}y`, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			serviceApi := &spectypes.ServiceApi{ResponseIgnorePaths: tt.ignorePaths}
+			require.Equal(t, tt.match, RepliesMatch(serviceApi, []byte(tt.data0), []byte(tt.data1)))
+			require.Equal(t, tt.match, RepliesMatch(serviceApi, []byte(tt.data1), []byte(tt.data0)))
+		})
+	}
+	require.False(t, RepliesMatch(nil, []byte(`{"time":"a"}`), []byte(`{"time":"b"}`)))
+}
+
+// the rules of the cookbook specs, replies of honest nodes at the same block differ only in the ignored fields
+func TestRepliesMatchSpecRules(t *testing.T) {
+	tests := []struct {
+		spec        string
+		api         string
+		ignorePaths []string
+		data0       string
+		data1       string
+	}{
+		{
+			spec:        "COSMOSSDK",
+			api:         "net_info",
+			ignorePaths: []string{"result.n_peers", "result.peers"},
+			data0:       `{"jsonrpc":"2.0","id":1,"result":{"listening":true,"listeners":["Listener(@)"],"n_peers":"2","peers":[{"node_info":{"id":"a"}},{"node_info":{"id":"b"}}]}}`,
+			data1:       `{"jsonrpc":"2.0","id":1,"result":{"listening":true,"listeners":["Listener(@)"],"n_peers":"1","peers":[{"node_info":{"id":"c"}}]}}`,
+		},
+		{
+			spec:        "COSMOSSDK",
+			api:         "num_unconfirmed_txs",
+			ignorePaths: []string{"result.n_txs", "result.total", "result.total_bytes"},
+			data0:       `{"jsonrpc":"2.0","id":1,"result":{"n_txs":"3","total":"3","total_bytes":"612","txs":null}}`,
+			data1:       `{"jsonrpc":"2.0","id":1,"result":{"n_txs":"0","total":"0","total_bytes":"0","txs":null}}`,
+		},
+		{
+			spec:        "COSMOSSDK",
+			api:         "unconfirmed_txs",
+			ignorePaths: []string{"result.n_txs", "result.total", "result.total_bytes", "result.txs"},
+			data0:       `{"jsonrpc":"2.0","id":1,"result":{"n_txs":"1","total":"1","total_bytes":"204","txs":["CpIBCo8B"]}}`,
+			data1:       `{"jsonrpc":"2.0","id":1,"result":{"n_txs":"0","total":"0","total_bytes":"0","txs":[]}}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.spec+"/"+tt.api, func(t *testing.T) {
+			serviceApi := &spectypes.ServiceApi{Name: tt.api, ResponseIgnorePaths: tt.ignorePaths}
+			for _, path := range tt.ignorePaths {
+				require.NoError(t, spectypes.ValidateResponseIgnorePath(path))
+			}
+			require.True(t, RepliesMatch(serviceApi, []byte(tt.data0), []byte(tt.data1)))
+			require.Equal(t, ReplyConsensusHash(serviceApi, []byte(tt.data0)), ReplyConsensusHash(serviceApi, []byte(tt.data1)))
+			// a different id is a different reply
+			require.False(t, RepliesMatch(serviceApi, []byte(tt.data0), []byte(`{"jsonrpc":"2.0","id":2,"result":{}}`)))
+		})
+	}
+}
@@ -0,0 +1,164 @@
+package chainlib
+
+import (
+	"context"
+	"io"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/jhump/protoreflect/dynamic"
+	"github.com/lavanet/lava/protocol/chainlib/chainproxy/rpcclient"
+	"github.com/lavanet/lava/protocol/lavasession"
+	"github.com/lavanet/lava/utils"
+)
+
+const (
+	cosmosPaginationField        = "pagination"
+	cosmosPaginationLimitField   = "limit"
+	cosmosPaginationLimitParam   = cosmosPaginationField + "." + cosmosPaginationLimitField
+	cosmosPageRequestMessageName = "cosmos.base.query.v1beta1.PageRequest"
+	cosmosDefaultPaginationLimit = 100 // the limit cosmos sdk nodes apply to a query that doesn't set one
+)
+
+// the response size limits the provider puts on the node queries it relays
+type responseLimits struct {
+	maxResponseSize       uint64
+	maxResponseSizePerApi map[string]uint64
+}
+
+func newResponseLimits(rpcProviderEndpoint *lavasession.RPCProviderEndpoint) responseLimits {
+	return responseLimits{
+		maxResponseSize:       rpcProviderEndpoint.MaxResponseSize,
+		maxResponseSizePerApi: rpcProviderEndpoint.MaxResponseSizePerApi,
+	}
+}
+
+// returns the maximum response size for the message's api, 0 when it's unlimited
+func (rl responseLimits) responseSizeLimit(chainMessage ChainMessageForSend) uint64 {
+	if serviceApi := chainMessage.GetServiceApi(); serviceApi != nil {
+		if limit, ok := rl.maxResponseSizePerApi[serviceApi.Name]; ok {
+			return limit
+		}
+	}
+	return rl.maxResponseSize
+}
+
+// limits the http response of the rpc calls made with the returned context to the message's size limit, the rpc client fails
+// them with rpcclient.ErrResponseTooLarge as soon as they're over it
+func (rl responseLimits) rpcCallContext(ctx context.Context, chainMessage ChainMessageForSend) context.Context {
+	return rpcclient.WithResponseSizeLimit(ctx, rl.responseSizeLimit(chainMessage))
+}
+
+// returns an error when a response that was already read is over the message's size limit, for websocket responses
+// that are read whole, up to the websocket message limit, before the call returns
+func (rl responseLimits) verifyResponseSize(ctx context.Context, chainMessage ChainMessageForSend, size int) error {
+	limit := rl.responseSizeLimit(chainMessage)
+	if limit == 0 || uint64(size) <= limit {
+		return nil
+	}
+	return responseTooLargeError(ctx, chainMessage, limit)
+}
+
+// reads a node response, failing as soon as it's over the message's size limit instead of holding all of it in memory
+func (rl responseLimits) readResponse(ctx context.Context, chainMessage ChainMessageForSend, body io.Reader) ([]byte, error) {
+	limit := rl.responseSizeLimit(chainMessage)
+	if limit == 0 {
+		return io.ReadAll(body)
+	}
+	data, err := io.ReadAll(io.LimitReader(body, int64(limit)+1))
+	if err != nil {
+		return nil, err
+	}
+	if uint64(len(data)) > limit {
+		return nil, responseTooLargeError(ctx, chainMessage, limit)
+	}
+	return data, nil
+}
+
+func responseTooLargeError(ctx context.Context, chainMessage ChainMessageForSend, limit uint64) error {
+	apiName := ""
+	if serviceApi := chainMessage.GetServiceApi(); serviceApi != nil {
+		apiName = serviceApi.Name
+	}
+	return utils.LavaFormatWarning("node response is over the max response size", lavasession.NodeResponseTooLargeError,
+		utils.Attribute{Key: "GUID", Value: ctx},
+		utils.Attribute{Key: "api", Value: apiName},
+		utils.Attribute{Key: "maxResponseSize", Value: limit},
+	)
+}
+
+// paginatedMessage is a chain message that carries the max pagination limit of its spec
+type paginatedMessage interface {
+	PaginationLimit() uint64
+}
+
+// returns the max pagination limit of the message's spec, 0 when its queries aren't clamped
+func paginationLimit(chainMessage ChainMessageForSend) uint64 {
+	if paginated, ok := chainMessage.(paginatedMessage); ok {
+		return paginated.PaginationLimit()
+	}
+	return 0
+}
+
+// returns whether a page request limit is over the max pagination limit, a limit of 0 asks for the node's default.
+// lowering it makes the node page the response instead of building all of it
+func exceedsPaginationLimit(limit uint64, maxPaginationLimit uint64) bool {
+	if maxPaginationLimit == 0 {
+		return false
+	}
+	if limit == 0 {
+		limit = cosmosDefaultPaginationLimit
+	}
+	return limit > maxPaginationLimit
+}
+
+// clampRestPagination returns the rest path with its pagination.limit query parameter lowered to the max pagination limit.
+// the limit is set by the spec so every provider sends the node the same page, and data reliability compares the same responses
+func clampRestPagination(path string, maxPaginationLimit uint64) string {
+	pathPart, rawQuery, hasQuery := strings.Cut(path, "?")
+	if !hasQuery {
+		return path
+	}
+	query, err := url.ParseQuery(rawQuery)
+	if err != nil {
+		// the node will reject it anyway
+		return path
+	}
+	values, ok := query[cosmosPaginationLimitParam]
+	if !ok || len(values) == 0 {
+		return path
+	}
+	limit, err := strconv.ParseUint(values[0], 10, 64)
+	if err != nil || !exceedsPaginationLimit(limit, maxPaginationLimit) {
+		return path
+	}
+	query.Set(cosmosPaginationLimitParam, strconv.FormatUint(maxPaginationLimit, 10))
+	return pathPart + "?" + query.Encode()
+}
+
+// clampGrpcPagination lowers the limit of the cosmos page request of a grpc query to the max pagination limit
+func clampGrpcPagination(msg proto.Message, maxPaginationLimit uint64) (clamped bool) {
+	dynamicMsg, ok := msg.(*dynamic.Message)
+	if !ok || !dynamicMsg.HasFieldName(cosmosPaginationField) {
+		return false
+	}
+	field, err := dynamicMsg.TryGetFieldByName(cosmosPaginationField)
+	if err != nil {
+		return false
+	}
+	pagination, ok := field.(*dynamic.Message)
+	if !ok || pagination.GetMessageDescriptor().GetFullyQualifiedName() != cosmosPageRequestMessageName {
+		return false
+	}
+	field, err = pagination.TryGetFieldByName(cosmosPaginationLimitField)
+	if err != nil {
+		return false
+	}
+	limit, ok := field.(uint64)
+	if !ok || !exceedsPaginationLimit(limit, maxPaginationLimit) {
+		return false
+	}
+	return pagination.TrySetFieldByName(cosmosPaginationLimitField, maxPaginationLimit) == nil
+}
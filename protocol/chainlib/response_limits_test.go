@@ -0,0 +1,141 @@
+package chainlib
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/jhump/protoreflect/desc/builder"
+	"github.com/jhump/protoreflect/dynamic"
+	"github.com/lavanet/lava/protocol/chainlib/chainproxy/rpcclient"
+	"github.com/lavanet/lava/protocol/lavasession"
+	spectypes "github.com/lavanet/lava/x/spec/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResponseSizeLimits(t *testing.T) {
+	limits := newResponseLimits(&lavasession.RPCProviderEndpoint{
+		MaxResponseSize:       10,
+		MaxResponseSizePerApi: map[string]uint64{"large": 20, "unlimited": 0},
+	})
+	ctx := context.Background()
+	message := func(apiName string) parsedMessage {
+		return parsedMessage{serviceApi: &spectypes.ServiceApi{Name: apiName}}
+	}
+
+	data, err := limits.readResponse(ctx, message("small"), bytes.NewReader(make([]byte, 10)))
+	require.NoError(t, err)
+	require.Len(t, data, 10)
+	_, err = limits.readResponse(ctx, message("small"), bytes.NewReader(make([]byte, 11)))
+	require.True(t, lavasession.NodeResponseTooLargeError.Is(err))
+
+	_, err = limits.readResponse(ctx, message("large"), bytes.NewReader(make([]byte, 20)))
+	require.NoError(t, err)
+	_, err = limits.readResponse(ctx, message("unlimited"), bytes.NewReader(make([]byte, 1000)))
+	require.NoError(t, err)
+
+	require.NoError(t, limits.verifyResponseSize(ctx, message("large"), 20))
+	require.True(t, lavasession.NodeResponseTooLargeError.Is(limits.verifyResponseSize(ctx, message("large"), 21)))
+}
+
+func TestRpcResponseSizeLimit(t *testing.T) {
+	result := `{"jsonrpc":"2.0","id":1,"result":"` + strings.Repeat("a", 100) + `"}`
+	chunked := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if chunked {
+			// no content length, the limit applies while reading
+			w.(http.Flusher).Flush()
+		}
+		w.Write([]byte(result))
+	}))
+	defer server.Close()
+	client, err := rpcclient.DialHTTP(server.URL)
+	require.NoError(t, err)
+	defer client.Close()
+	call := func(limit uint64) error {
+		_, err := client.CallContext(rpcclient.WithResponseSizeLimit(context.Background(), limit), json.RawMessage("1"), "eth_test", nil)
+		return err
+	}
+
+	require.NoError(t, call(0))
+	require.NoError(t, call(uint64(len(result))))
+	require.ErrorIs(t, call(uint64(len(result))-1), rpcclient.ErrResponseTooLarge)
+	chunked = true
+	require.NoError(t, call(uint64(len(result))))
+	require.ErrorIs(t, call(50), rpcclient.ErrResponseTooLarge)
+}
+
+func TestPaginationLimitFromSpec(t *testing.T) {
+	chainParser, err := NewRestChainParser()
+	require.NoError(t, err)
+	serviceApi := spectypes.ServiceApi{Name: "/validators", Enabled: true, ApiInterfaces: []spectypes.ApiInterface{{Interface: spectypes.APIInterfaceRest, Type: http.MethodGet}}}
+	chainParser.SetSpec(spectypes.Spec{Index: "LAV1", Enabled: true, MaxPaginationLimit: 50, Apis: []spectypes.ServiceApi{serviceApi}})
+	chainMessage, err := chainParser.CraftMessage(serviceApi, nil)
+	require.NoError(t, err)
+	require.Equal(t, uint64(50), paginationLimit(chainMessage))
+}
+
+func TestClampRestPagination(t *testing.T) {
+	testTable := []struct {
+		name     string
+		path     string
+		expected string
+	}{
+		{name: "no query", path: "/cosmos/bank/v1beta1/balances/addr", expected: "/cosmos/bank/v1beta1/balances/addr"},
+		{name: "no pagination", path: "/cosmos/bank/v1beta1/balances/addr?height=5", expected: "/cosmos/bank/v1beta1/balances/addr?height=5"},
+		{name: "under the limit", path: "/cosmos/staking/v1beta1/validators?pagination.limit=20", expected: "/cosmos/staking/v1beta1/validators?pagination.limit=20"},
+		{name: "over the limit", path: "/cosmos/staking/v1beta1/validators?pagination.limit=5000&status=BOND_STATUS_BONDED", expected: "/cosmos/staking/v1beta1/validators?pagination.limit=50&status=BOND_STATUS_BONDED"},
+		{name: "node default over the limit", path: "/cosmos/staking/v1beta1/validators?pagination.limit=0", expected: "/cosmos/staking/v1beta1/validators?pagination.limit=50"},
+		{name: "malformed limit", path: "/cosmos/staking/v1beta1/validators?pagination.limit=all", expected: "/cosmos/staking/v1beta1/validators?pagination.limit=all"},
+	}
+	for _, testCase := range testTable {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(t, testCase.expected, clampRestPagination(testCase.path, 50))
+		})
+	}
+
+	// a spec without a max pagination limit doesn't clamp
+	require.Equal(t, "/validators?pagination.limit=100000", clampRestPagination("/validators?pagination.limit=100000", 0))
+}
+
+func TestClampGrpcPagination(t *testing.T) {
+	pageRequest := builder.NewMessage("PageRequest").
+		AddField(builder.NewField("key", builder.FieldTypeBytes())).
+		AddField(builder.NewField("limit", builder.FieldTypeUInt64()))
+	builder.NewFile("cosmos/base/query/v1beta1/pagination.proto").SetPackageName("cosmos.base.query.v1beta1").AddMessage(pageRequest)
+	request := builder.NewMessage("QueryValidatorsRequest").
+		AddField(builder.NewField("status", builder.FieldTypeString())).
+		AddField(builder.NewField("pagination", builder.FieldTypeMessage(pageRequest)))
+	builder.NewFile("cosmos/staking/v1beta1/query.proto").SetPackageName("cosmos.staking.v1beta1").AddMessage(request)
+	requestDescriptor, err := request.Build()
+	require.NoError(t, err)
+	pageRequestDescriptor, err := pageRequest.Build()
+	require.NoError(t, err)
+
+	newRequest := func(limit uint64) *dynamic.Message {
+		pagination := dynamic.NewMessage(pageRequestDescriptor)
+		pagination.SetFieldByName("limit", limit)
+		msg := dynamic.NewMessage(requestDescriptor)
+		msg.SetFieldByName("pagination", pagination)
+		return msg
+	}
+	limitOf := func(msg *dynamic.Message) uint64 {
+		return msg.GetFieldByName("pagination").(*dynamic.Message).GetFieldByName("limit").(uint64)
+	}
+
+	msg := newRequest(5000)
+	require.True(t, clampGrpcPagination(msg, 50))
+	require.Equal(t, uint64(50), limitOf(msg))
+
+	msg = newRequest(20)
+	require.False(t, clampGrpcPagination(msg, 50))
+	require.Equal(t, uint64(20), limitOf(msg))
+
+	// no page request, the node default applies
+	require.False(t, clampGrpcPagination(dynamic.NewMessage(requestDescriptor), 50))
+}
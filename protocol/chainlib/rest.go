@@ -5,7 +5,6 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"io"
 	"net/http"
 	"strings"
 	"sync"
@@ -97,12 +96,16 @@ func (apip *RestChainParser) ParseMsg(url string, data []byte, connectionType st
 	return nodeMsg, nil
 }
 
-func (*RestChainParser) newChainMessage(serviceApi *spectypes.ServiceApi, apiInterface *spectypes.ApiInterface, requestBlock int64, restMessage rpcInterfaceMessages.RestMessage) *parsedMessage {
+func (apip *RestChainParser) newChainMessage(serviceApi *spectypes.ServiceApi, apiInterface *spectypes.ApiInterface, requestBlock int64, restMessage rpcInterfaceMessages.RestMessage) *parsedMessage {
+	apip.rwLock.RLock()
+	maxPaginationLimit := apip.spec.MaxPaginationLimit
+	apip.rwLock.RUnlock()
 	nodeMsg := &parsedMessage{
-		serviceApi:     serviceApi,
-		apiInterface:   apiInterface,
-		msg:            restMessage,
-		requestedBlock: requestBlock,
+		serviceApi:      serviceApi,
+		apiInterface:    apiInterface,
+		msg:             restMessage,
+		requestedBlock:  requestBlock,
+		paginationLimit: maxPaginationLimit,
 	}
 	return nodeMsg
 }
@@ -189,18 +192,20 @@ func (apip *RestChainParser) ChainBlockStats() (allowedBlockLagForQosSync int64,
 }
 
 type RestChainListener struct {
-	endpoint    *lavasession.RPCEndpoint
-	relaySender RelaySender
-	logger      *common.RPCConsumerLogs
+	endpoint       *lavasession.RPCEndpoint
+	relaySender    RelaySender
+	logger         *common.RPCConsumerLogs
+	listenerConfig *ListenerConfig
 }
 
 // NewRestChainListener creates a new instance of RestChainListener
-func NewRestChainListener(ctx context.Context, listenEndpoint *lavasession.RPCEndpoint, relaySender RelaySender, rpcConsumerLogs *common.RPCConsumerLogs) (chainListener *RestChainListener) {
+func NewRestChainListener(ctx context.Context, listenEndpoint *lavasession.RPCEndpoint, relaySender RelaySender, rpcConsumerLogs *common.RPCConsumerLogs, listenerConfig *ListenerConfig) (chainListener *RestChainListener) {
 	// Create a new instance of JsonRPCChainListener
 	chainListener = &RestChainListener{
 		listenEndpoint,
 		relaySender,
 		rpcConsumerLogs,
+		listenerConfig,
 	}
 
 	return chainListener
@@ -217,6 +222,8 @@ func (apil *RestChainListener) Serve(ctx context.Context) {
 	app := fiber.New(fiber.Config{})
 
 	app.Use(favicon.New())
+	apil.listenerConfig.useCORS(app)
+	apil.listenerConfig.useDappAuth(app)
 
 	chainID := apil.endpoint.ChainID
 	apiInterface := apil.endpoint.ApiInterface
@@ -231,6 +238,7 @@ func (apil *RestChainListener) Serve(ctx context.Context) {
 
 		ctx, cancel := context.WithCancel(context.Background())
 		ctx = utils.WithUniqueIdentifier(ctx, utils.GenerateUniqueIdentifier())
+		ctx = extractLavaHeadersFromFiberContext(ctx, c)
 		defer cancel() // incase there's a problem make sure to cancel the connection
 
 		// TODO: handle contentType, in case its not application/json currently we set it to application/json in the Send() method
@@ -240,6 +248,7 @@ func (apil *RestChainListener) Serve(ctx context.Context) {
 		utils.LavaFormatInfo("in <<<", utils.Attribute{Key: "GUID", Value: ctx}, utils.Attribute{Key: "path", Value: path}, utils.Attribute{Key: "dappID", Value: dappID}, utils.Attribute{Key: "msgSeed", Value: msgSeed})
 		requestBody := string(c.Body())
 		reply, _, err := apil.relaySender.SendRelay(ctx, path, requestBody, http.MethodPost, dappID, analytics)
+		setRelayTraceHeader(ctx, c.Set)
 		go apil.logger.AddMetricForHttp(analytics, err, c.GetReqHeaders())
 
 		if err != nil {
@@ -278,10 +287,12 @@ func (apil *RestChainListener) Serve(ctx context.Context) {
 
 		ctx, cancel := context.WithCancel(context.Background())
 		ctx = utils.WithUniqueIdentifier(ctx, utils.GenerateUniqueIdentifier())
+		ctx = extractLavaHeadersFromFiberContext(ctx, c)
 		defer cancel() // incase there's a problem make sure to cancel the connection
 		utils.LavaFormatInfo("in <<<", utils.Attribute{Key: "GUID", Value: ctx}, utils.Attribute{Key: "path", Value: path}, utils.Attribute{Key: "dappID", Value: dappID}, utils.Attribute{Key: "msgSeed", Value: msgSeed})
 
 		reply, _, err := apil.relaySender.SendRelay(ctx, path, query, http.MethodGet, dappID, analytics)
+		setRelayTraceHeader(ctx, c.Set)
 		go apil.logger.AddMetricForHttp(analytics, err, c.GetReqHeaders())
 		if err != nil {
 			// Get unique GUID response
@@ -307,21 +318,33 @@ func (apil *RestChainListener) Serve(ctx context.Context) {
 	})
 
 	// Go
-	ListenWithRetry(app, apil.endpoint.NetworkAddress)
+	ListenWithRetry(ctx, app, apil.endpoint.NetworkAddress, apil.listenerConfig)
 }
 
 type RestChainProxy struct {
 	BaseChainProxy
+	nodes *nodeFailover[struct{}]
 }
 
 func NewRestChainProxy(ctx context.Context, nConns uint, rpcProviderEndpoint *lavasession.RPCProviderEndpoint, averageBlockTime time.Duration) (ChainProxy, error) {
 	if len(rpcProviderEndpoint.NodeUrls) == 0 {
 		return nil, utils.LavaFormatError("rpcProviderEndpoint.NodeUrl list is empty missing node url", nil, utils.Attribute{Key: "chainID", Value: rpcProviderEndpoint.ChainID}, utils.Attribute{Key: "ApiInterface", Value: rpcProviderEndpoint.ApiInterface})
 	}
-	nodeUrl := rpcProviderEndpoint.NodeUrls[0]
-	nodeUrl.Url = strings.TrimSuffix(rpcProviderEndpoint.NodeUrls[0].Url, "/")
+	nodeUrls := make([]common.NodeUrl, len(rpcProviderEndpoint.NodeUrls))
+	for idx, nodeUrl := range rpcProviderEndpoint.NodeUrls {
+		nodeUrl.Url = strings.TrimSuffix(nodeUrl.Url, "/")
+		nodeUrls[idx] = nodeUrl
+	}
+	// rest has no persistent connections, a new request is made per relay
+	nodes, err := newNodeFailover(ctx, nodeUrls, func(ctx context.Context, nodeUrl common.NodeUrl) (struct{}, error) {
+		return struct{}{}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
 	rcp := &RestChainProxy{
-		BaseChainProxy: BaseChainProxy{averageBlockTime: averageBlockTime, NodeUrl: rpcProviderEndpoint.NodeUrls[0]},
+		BaseChainProxy: BaseChainProxy{averageBlockTime: averageBlockTime, NodeUrl: rpcProviderEndpoint.NodeUrls[0], responseLimits: newResponseLimits(rpcProviderEndpoint)},
+		nodes:          nodes,
 	}
 	return rcp, nil
 }
@@ -346,8 +369,12 @@ func (rcp *RestChainProxy) SendNodeMsg(ctx context.Context, ch chan interface{},
 		connectionTypeSlected = chainMessage.GetInterface().Type
 	}
 
+	node, err := rcp.nodes.getNode()
+	if err != nil {
+		return nil, "", nil, err
+	}
 	msgBuffer := bytes.NewBuffer(nodeMessage.Msg)
-	url := rcp.NodeUrl.Url + nodeMessage.Path
+	url := node.nodeUrl.Url + clampRestPagination(nodeMessage.Path, paginationLimit(chainMessage))
 
 	relayTimeout := LocalNodeTimePerCu(chainMessage.GetServiceApi().ComputeUnits)
 	// check if this API is hanging (waiting for block confirmation)
@@ -355,9 +382,9 @@ func (rcp *RestChainProxy) SendNodeMsg(ctx context.Context, ch chan interface{},
 		relayTimeout += rcp.averageBlockTime
 	}
 
-	connectCtx, cancel := rcp.NodeUrl.LowerContextTimeout(ctx, relayTimeout)
+	connectCtx, cancel := node.nodeUrl.LowerContextTimeout(ctx, relayTimeout)
 	defer cancel()
-	req, err := http.NewRequestWithContext(connectCtx, connectionTypeSlected, rcp.NodeUrl.AuthConfig.AddAuthPath(url), msgBuffer)
+	req, err := http.NewRequestWithContext(connectCtx, connectionTypeSlected, node.nodeUrl.AuthConfig.AddAuthPath(url), msgBuffer)
 	if err != nil {
 		return nil, "", nil, err
 	}
@@ -367,19 +394,23 @@ func (rcp *RestChainProxy) SendNodeMsg(ctx context.Context, ch chan interface{},
 		req.Header.Set("Content-Type", "application/json")
 	}
 
-	rcp.NodeUrl.SetAuthHeaders(ctx, req.Header.Set)
-	rcp.NodeUrl.SetIpForwardingIfNecessary(ctx, req.Header.Set)
+	node.nodeUrl.SetAuthHeaders(ctx, req.Header.Set)
+	node.nodeUrl.SetIpForwardingIfNecessary(ctx, req.Header.Set)
 
 	res, err := httpClient.Do(req)
 	if err != nil {
+		if ctx.Err() == nil {
+			rcp.nodes.onNodeFailure(node, err)
+		}
 		return nil, "", nil, err
 	}
+	rcp.nodes.onNodeSuccess(node)
 
 	if res.Body != nil {
 		defer res.Body.Close()
 	}
 
-	body, err := io.ReadAll(res.Body)
+	body, err := rcp.readResponse(ctx, chainMessage, res.Body)
 	if err != nil {
 		return nil, "", nil, err
 	}
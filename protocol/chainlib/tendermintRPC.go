@@ -5,8 +5,9 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
-	"io"
 	"net/http"
+	"net/url"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -75,32 +76,16 @@ func (apip *TendermintChainParser) ParseMsg(url string, data []byte, connectionT
 		msg = *msgPtr
 	} else {
 		// assuming URI
-		var parsedMethod string
-		idx := strings.Index(url, "?")
-		if idx == -1 {
-			parsedMethod = url
-		} else {
-			parsedMethod = url[0:idx]
+		parsedMethod, rawQuery, _ := strings.Cut(url, "?")
+		params, err := parseTendermintURIParams(rawQuery)
+		if err != nil {
+			return nil, err
 		}
-
 		msg = rpcInterfaceMessages.JsonrpcMessage{
 			ID:      []byte("1"),
 			Version: "2.0",
 			Method:  parsedMethod,
-		}
-		if strings.Contains(url[idx+1:], "=") {
-			params := make(map[string]interface{})
-			rawParams := strings.Split(url[idx+1:], "&") // list with structure ['height=0x500',...]
-			for _, param := range rawParams {
-				splitParam := strings.Split(param, "=")
-				if len(splitParam) != 2 {
-					return nil, utils.LavaFormatError("Cannot parse query params", nil, utils.Attribute{Key: "params", Value: param})
-				}
-				params[splitParam[0]] = splitParam[1]
-			}
-			msg.Params = params
-		} else {
-			msg.Params = make(map[string]interface{}, 0)
+			Params:  params,
 		}
 	}
 
@@ -110,19 +95,11 @@ func (apip *TendermintChainParser) ParseMsg(url string, data []byte, connectionT
 		return nil, utils.LavaFormatError("getSupportedApi failed", err, utils.Attribute{Key: "method", Value: msg.Method})
 	}
 
-	// Extract default block parser
-	blockParser := serviceApi.BlockParsing
-
 	apiInterface := GetApiInterfaceFromServiceApi(serviceApi, connectionType)
 	if apiInterface == nil {
 		return nil, fmt.Errorf("could not find the interface %s in the service %s", connectionType, serviceApi.Name)
 	}
-
-	// Check if custom block parser exists in the api interface
-	// Use custom block parser only for URI calls
-	if apiInterface.GetOverwriteBlockParsing() != nil && !isJsonrpc {
-		blockParser = *apiInterface.GetOverwriteBlockParsing()
-	}
+	blockParser := tendermintBlockParser(serviceApi, apiInterface, isJsonrpc)
 
 	// Fetch requested block, it is used for data reliability
 	requestedBlock, err := parser.ParseBlockFromParams(msg, blockParser)
@@ -134,9 +111,103 @@ func (apip *TendermintChainParser) ParseMsg(url string, data []byte, connectionT
 		tenderMsg.Path = url // add path
 	}
 	nodeMsg := apip.newChainMessage(serviceApi, apiInterface, requestedBlock, tenderMsg)
+	err = setTimeoutHintFromRequest(nodeMsg, msg.LavaTimeout)
+	if err != nil {
+		return nil, utils.LavaFormatError("invalid lava_timeout in request", err, utils.Attribute{Key: "lava_timeout", Value: msg.LavaTimeout})
+	}
 	return nodeMsg, nil
 }
 
+// SubstituteRequestedBlock returns the message asking for block instead of the magic block it was parsed with (e.g. latest).
+// uri calls get the block param set in their path and json-rpc calls in their params
+func (apip *TendermintChainParser) SubstituteRequestedBlock(chainMessage ChainMessage, block int64) (ChainMessage, error) {
+	tenderMsg, ok := chainMessage.GetRPCMessage().(rpcInterfaceMessages.TendermintrpcMessage)
+	if !ok {
+		return nil, utils.LavaFormatError("not a tendermintrpc message", nil, utils.Attribute{Key: "type", Value: fmt.Sprintf("%T", chainMessage.GetRPCMessage())})
+	}
+	isJsonrpc := tenderMsg.Path == ""
+	blockParser := tendermintBlockParser(chainMessage.GetServiceApi(), chainMessage.GetInterface(), isJsonrpc)
+	blockString := strconv.FormatInt(block, 10)
+	if !isJsonrpc {
+		path, err := setTendermintURIParam(tenderMsg.Path, blockParser, blockString)
+		if err != nil {
+			return nil, err
+		}
+		return apip.ParseMsg(path, nil, chainMessage.GetInterface().Type)
+	}
+
+	params, err := parser.ReplaceBlockInParams(tenderMsg.Params, blockParser, blockString)
+	if err != nil {
+		return nil, utils.LavaFormatError("failed replacing the requested block", err, utils.Attribute{Key: "api", Value: chainMessage.GetServiceApi().Name}, utils.Attribute{Key: "blockParsing", Value: blockParser})
+	}
+	tenderMsg.Params = params
+	requestedBlock, err := parser.ParseBlockFromParams(tenderMsg, blockParser)
+	if err != nil {
+		return nil, utils.LavaFormatError("ParseBlockFromParams failed parsing the replaced block", err, utils.Attribute{Key: "api", Value: chainMessage.GetServiceApi().Name})
+	}
+	nodeMsg := apip.newChainMessage(chainMessage.GetServiceApi(), chainMessage.GetInterface(), requestedBlock, tenderMsg)
+	nodeMsg.SetTimeoutOverride(chainMessage.GetTimeoutOverride())
+	return nodeMsg, nil
+}
+
+// returns the block parser of a call, the api interface can overwrite it for uri calls which pass their params by name
+func tendermintBlockParser(serviceApi *spectypes.ServiceApi, apiInterface *spectypes.ApiInterface, isJsonrpc bool) spectypes.BlockParser {
+	if apiInterface.GetOverwriteBlockParsing() != nil && !isJsonrpc {
+		return *apiInterface.GetOverwriteBlockParsing()
+	}
+	return serviceApi.BlockParsing
+}
+
+// parses the query of a uri call into the params of the matching json-rpc call. values are url decoded and string values
+// quoted the way tendermint takes them (e.g. height="5") are unquoted. like the node, a param sent without a value is left unset
+func parseTendermintURIParams(rawQuery string) (map[string]interface{}, error) {
+	params := make(map[string]interface{})
+	for _, param := range strings.Split(rawQuery, "&") {
+		if param == "" {
+			continue
+		}
+		rawKey, rawValue, _ := strings.Cut(param, "=")
+		key, err := url.QueryUnescape(rawKey)
+		if err != nil {
+			return nil, utils.LavaFormatError("Cannot parse query params", err, utils.Attribute{Key: "params", Value: param})
+		}
+		value, err := url.QueryUnescape(rawValue)
+		if err != nil {
+			return nil, utils.LavaFormatError("Cannot parse query params", err, utils.Attribute{Key: "params", Value: param})
+		}
+		if len(value) >= 2 && strings.HasPrefix(value, `"`) && strings.HasSuffix(value, `"`) {
+			value = value[1 : len(value)-1]
+		}
+		if value == "" {
+			continue
+		}
+		params[key] = value
+	}
+	return params, nil
+}
+
+// returns the uri path with the block param of the block parser set to block, the other params are kept as they were sent
+func setTendermintURIParam(path string, blockParser spectypes.BlockParser, block string) (string, error) {
+	if (blockParser.ParserFunc != spectypes.PARSER_FUNC_PARSE_DICTIONARY && blockParser.ParserFunc != spectypes.PARSER_FUNC_PARSE_DICTIONARY_OR_ORDERED) || len(blockParser.ParserArg) == 0 {
+		return "", utils.LavaFormatError("uri calls can only replace a block passed by name", nil, utils.Attribute{Key: "blockParsing", Value: blockParser})
+	}
+	blockParam := blockParser.ParserArg[0]
+	method, rawQuery, _ := strings.Cut(path, "?")
+	params := []string{}
+	for _, param := range strings.Split(rawQuery, "&") {
+		if param == "" {
+			continue
+		}
+		rawKey, _, _ := strings.Cut(param, "=")
+		if key, err := url.QueryUnescape(rawKey); err == nil && key == blockParam {
+			continue
+		}
+		params = append(params, param)
+	}
+	params = append(params, blockParam+"="+block)
+	return method + "?" + strings.Join(params, "&"), nil
+}
+
 func (*TendermintChainParser) newChainMessage(serviceApi *spectypes.ServiceApi, apiInterface *spectypes.ApiInterface, requestedBlock int64, msg rpcInterfaceMessages.TendermintrpcMessage) ChainMessage {
 	nodeMsg := &parsedMessage{
 		serviceApi:     serviceApi,
@@ -229,18 +300,20 @@ func (apip *TendermintChainParser) ChainBlockStats() (allowedBlockLagForQosSync
 }
 
 type TendermintRpcChainListener struct {
-	endpoint    *lavasession.RPCEndpoint
-	relaySender RelaySender
-	logger      *common.RPCConsumerLogs
+	endpoint       *lavasession.RPCEndpoint
+	relaySender    RelaySender
+	logger         *common.RPCConsumerLogs
+	listenerConfig *ListenerConfig
 }
 
 // NewTendermintRpcChainListener creates a new instance of TendermintRpcChainListener
-func NewTendermintRpcChainListener(ctx context.Context, listenEndpoint *lavasession.RPCEndpoint, relaySender RelaySender, rpcConsumerLogs *common.RPCConsumerLogs) (chainListener *TendermintRpcChainListener) {
+func NewTendermintRpcChainListener(ctx context.Context, listenEndpoint *lavasession.RPCEndpoint, relaySender RelaySender, rpcConsumerLogs *common.RPCConsumerLogs, listenerConfig *ListenerConfig) (chainListener *TendermintRpcChainListener) {
 	// Create a new instance of JsonRPCChainListener
 	chainListener = &TendermintRpcChainListener{
 		listenEndpoint,
 		relaySender,
 		rpcConsumerLogs,
+		listenerConfig,
 	}
 
 	return chainListener
@@ -259,6 +332,8 @@ func (apil *TendermintRpcChainListener) Serve(ctx context.Context) {
 	apiInterface := apil.endpoint.ApiInterface
 
 	app.Use(favicon.New())
+	apil.listenerConfig.useCORS(app)
+	apil.listenerConfig.useDappAuth(app)
 
 	app.Use("/ws/:dappId", func(c *fiber.Ctx) error {
 		// IsWebSocketUpgrade returns true if the client
@@ -285,6 +360,7 @@ func (apil *TendermintRpcChainListener) Serve(ctx context.Context) {
 
 			ctx, cancel := context.WithCancel(context.Background())
 			ctx = utils.WithUniqueIdentifier(ctx, utils.GenerateUniqueIdentifier())
+			ctx = extractLavaHeadersFromWebsocketConnection(ctx, c)
 			defer cancel() // incase there's a problem make sure to cancel the connection
 			utils.LavaFormatInfo("ws in <<<", utils.Attribute{Key: "GUID", Value: ctx}, utils.Attribute{Key: "seed", Value: msgSeed}, utils.Attribute{Key: "msg", Value: msg}, utils.Attribute{Key: "dappID", Value: dappID})
 
@@ -345,10 +421,12 @@ func (apil *TendermintRpcChainListener) Serve(ctx context.Context) {
 		metricsData := metrics.NewRelayAnalytics(dappID, chainID, apiInterface)
 		ctx, cancel := context.WithCancel(context.Background())
 		ctx = utils.WithUniqueIdentifier(ctx, utils.GenerateUniqueIdentifier())
+		ctx = extractLavaHeadersFromFiberContext(ctx, c)
 		defer cancel() // incase there's a problem make sure to cancel the connection
 
 		utils.LavaFormatInfo("in <<<", utils.Attribute{Key: "GUID", Value: ctx}, utils.Attribute{Key: "seed", Value: msgSeed}, utils.Attribute{Key: "msg", Value: c.Body()}, utils.Attribute{Key: "dappID", Value: dappID})
 		reply, _, err := apil.relaySender.SendRelay(ctx, "", string(c.Body()), "", dappID, metricsData)
+		setRelayTraceHeader(ctx, c.Set)
 		go apil.logger.AddMetricForHttp(metricsData, err, c.GetReqHeaders())
 
 		if err != nil {
@@ -384,10 +462,12 @@ func (apil *TendermintRpcChainListener) Serve(ctx context.Context) {
 		msgSeed := apil.logger.GetMessageSeed()
 		ctx, cancel := context.WithCancel(context.Background())
 		ctx = utils.WithUniqueIdentifier(ctx, utils.GenerateUniqueIdentifier())
+		ctx = extractLavaHeadersFromFiberContext(ctx, c)
 		defer cancel() // incase there's a problem make sure to cancel the connection
 		utils.LavaFormatInfo("urirpc in <<<", utils.Attribute{Key: "GUID", Value: ctx}, utils.Attribute{Key: "seed", Value: msgSeed}, utils.Attribute{Key: "msg", Value: path}, utils.Attribute{Key: "dappID", Value: dappID})
 		metricsData := metrics.NewRelayAnalytics(dappID, chainID, apiInterface)
 		reply, _, err := apil.relaySender.SendRelay(ctx, path+query, "", "", dappID, metricsData)
+		setRelayTraceHeader(ctx, c.Set)
 		go apil.logger.AddMetricForHttp(metricsData, err, c.GetReqHeaders())
 
 		if err != nil {
@@ -418,39 +498,49 @@ func (apil *TendermintRpcChainListener) Serve(ctx context.Context) {
 	})
 	//
 	// Go
-	ListenWithRetry(app, apil.endpoint.NetworkAddress)
+	ListenWithRetry(ctx, app, apil.endpoint.NetworkAddress, apil.listenerConfig)
 }
 
 type tendermintRpcChainProxy struct {
 	// embedding the jrpc chain proxy because the only diff is on parse message
 	JrpcChainProxy
-	httpNodeUrl   common.NodeUrl
-	httpConnector *chainproxy.Connector
+	httpConns *nodeFailover[*chainproxy.Connector]
 }
 
 func NewtendermintRpcChainProxy(ctx context.Context, nConns uint, rpcProviderEndpoint *lavasession.RPCProviderEndpoint, averageBlockTime time.Duration) (ChainProxy, error) {
 	if len(rpcProviderEndpoint.NodeUrls) == 0 {
 		return nil, utils.LavaFormatError("rpcProviderEndpoint.NodeUrl list is empty missing node url", nil, utils.Attribute{Key: "chainID", Value: rpcProviderEndpoint.ChainID}, utils.Attribute{Key: "ApiInterface", Value: rpcProviderEndpoint.ApiInterface})
 	}
-	websocketUrl, httpUrl := verifyTendermintEndpoint(rpcProviderEndpoint.NodeUrls)
+	websocketUrls, httpUrls, err := verifyTendermintEndpoint(rpcProviderEndpoint.NodeUrls)
+	if err != nil {
+		return nil, err
+	}
 	cp := &tendermintRpcChainProxy{
-		JrpcChainProxy: JrpcChainProxy{BaseChainProxy: BaseChainProxy{averageBlockTime: averageBlockTime, NodeUrl: websocketUrl}},
-		httpNodeUrl:    httpUrl,
-		httpConnector:  nil,
+		JrpcChainProxy: JrpcChainProxy{BaseChainProxy: BaseChainProxy{averageBlockTime: averageBlockTime, NodeUrl: websocketUrls[0], responseLimits: newResponseLimits(rpcProviderEndpoint)}},
+		httpConns:      nil,
 	}
-	cp.addHttpConnector(ctx, nConns, httpUrl)
-	return cp, cp.start(ctx, nConns, websocketUrl)
+	err = cp.addHttpConnectors(ctx, nConns, httpUrls)
+	if err != nil {
+		return nil, err
+	}
+	return cp, cp.start(ctx, nConns, websocketUrls)
 }
 
-func (cp *tendermintRpcChainProxy) addHttpConnector(ctx context.Context, nConns uint, nodeUrl common.NodeUrl) error {
-	conn, err := chainproxy.NewConnector(ctx, nConns, nodeUrl)
+func (cp *tendermintRpcChainProxy) addHttpConnectors(ctx context.Context, nConns uint, nodeUrls []common.NodeUrl) error {
+	conns, err := newNodeFailover(ctx, nodeUrls, func(ctx context.Context, nodeUrl common.NodeUrl) (*chainproxy.Connector, error) {
+		conn, err := chainproxy.NewConnector(ctx, nConns, nodeUrl)
+		if err != nil {
+			return nil, err
+		}
+		if conn == nil {
+			return nil, errors.New("g_conn == nil")
+		}
+		return conn, nil
+	})
 	if err != nil {
 		return err
 	}
-	cp.httpConnector = conn
-	if cp.httpConnector == nil {
-		return errors.New("g_conn == nil")
-	}
+	cp.httpConns = conns
 	return nil
 }
 
@@ -476,13 +566,19 @@ func (cp *tendermintRpcChainProxy) SendURI(ctx context.Context, nodeMessage *rpc
 		return nil, "", nil, utils.LavaFormatError("Subscribe is not allowed on Tendermint URI", nil)
 	}
 
+	// pick the http node to send the request to
+	node, err := cp.httpConns.getNode()
+	if err != nil {
+		return nil, "", nil, err
+	}
+
 	// create a new http client with a timeout set by the getTimePerCu function
 	httpClient := http.Client{
 		Timeout: LocalNodeTimePerCu(chainMessage.GetServiceApi().ComputeUnits),
 	}
 
 	// construct the url by concatenating the node url with the path variable
-	url := cp.httpNodeUrl.Url + "/" + nodeMessage.Path
+	url := node.nodeUrl.Url + "/" + nodeMessage.Path
 
 	// create context
 	relayTimeout := LocalNodeTimePerCu(chainMessage.GetServiceApi().ComputeUnits)
@@ -490,23 +586,27 @@ func (cp *tendermintRpcChainProxy) SendURI(ctx context.Context, nodeMessage *rpc
 	if chainMessage.GetInterface().Category.HangingApi {
 		relayTimeout += cp.averageBlockTime
 	}
-	connectCtx, cancel := cp.httpNodeUrl.LowerContextTimeout(ctx, relayTimeout)
+	connectCtx, cancel := node.nodeUrl.LowerContextTimeout(ctx, relayTimeout)
 	defer cancel()
 
 	// create a new http request
-	req, err := http.NewRequestWithContext(connectCtx, http.MethodGet, cp.httpNodeUrl.AuthConfig.AddAuthPath(url), nil)
+	req, err := http.NewRequestWithContext(connectCtx, http.MethodGet, node.nodeUrl.AuthConfig.AddAuthPath(url), nil)
 	if err != nil {
 		return nil, "", nil, err
 	}
 
-	cp.httpNodeUrl.SetAuthHeaders(ctx, req.Header.Set)
+	node.nodeUrl.SetAuthHeaders(ctx, req.Header.Set)
 
-	cp.httpNodeUrl.SetIpForwardingIfNecessary(ctx, req.Header.Set)
+	node.nodeUrl.SetIpForwardingIfNecessary(ctx, req.Header.Set)
 	// send the http request and get the response
 	res, err := httpClient.Do(req)
 	if err != nil {
+		if ctx.Err() == nil {
+			cp.httpConns.onNodeFailure(node, err)
+		}
 		return nil, "", nil, err
 	}
+	cp.httpConns.onNodeSuccess(node)
 
 	// close the response body
 	if res.Body != nil {
@@ -514,7 +614,7 @@ func (cp *tendermintRpcChainProxy) SendURI(ctx context.Context, nodeMessage *rpc
 	}
 
 	// read the response body
-	body, err := io.ReadAll(res.Body)
+	body, err := cp.readResponse(ctx, chainMessage, res.Body)
 	if err != nil {
 		return nil, "", nil, err
 	}
@@ -529,23 +629,23 @@ func (cp *tendermintRpcChainProxy) SendURI(ctx context.Context, nodeMessage *rpc
 
 // SendRPC sends Tendermint HTTP or WebSockets call
 func (cp *tendermintRpcChainProxy) SendRPC(ctx context.Context, nodeMessage *rpcInterfaceMessages.TendermintrpcMessage, ch chan interface{}, chainMessage ChainMessageForSend) (relayReply *pairingtypes.RelayReply, subscriptionID string, relayReplyServer *rpcclient.ClientSubscription, err error) {
-	// Get rpc connection from the connection pool
-	var rpc *rpcclient.Client
+	// subscriptions go through the websocket nodes, everything else through the http nodes
+	conns := cp.httpConns
 	if ch != nil {
-		rpc, err = cp.conn.GetRpc(ctx, true)
-		if err != nil {
-			return nil, "", nil, err
-		}
-		// return the rpc connection to the websocket pool after the function completes
-		defer cp.conn.ReturnRpc(rpc)
-	} else {
-		rpc, err = cp.httpConnector.GetRpc(ctx, true)
-		if err != nil {
-			return nil, "", nil, err
-		}
-		// return the rpc connection to the http pool after the function completes
-		defer cp.httpConnector.ReturnRpc(rpc)
+		conns = cp.conns
+	}
+	node, err := conns.getNode()
+	if err != nil {
+		return nil, "", nil, err
+	}
+	// Get rpc connection from the connection pool
+	rpc, err := node.connection.GetRpc(ctx, true)
+	if err != nil {
+		conns.onNodeFailure(node, err)
+		return nil, "", nil, err
 	}
+	// return the rpc connection to the pool after the function completes
+	defer node.connection.ReturnRpc(rpc)
 
 	// create variables for the rpc message and reply message
 	var rpcMessage *rpcclient.JsonrpcMessage
@@ -563,12 +663,21 @@ func (cp *tendermintRpcChainProxy) SendRPC(ctx context.Context, nodeMessage *rpc
 		if chainMessage.GetInterface().Category.HangingApi {
 			relayTimeout += cp.averageBlockTime
 		}
-		cp.NodeUrl.SetIpForwardingIfNecessary(ctx, rpc.SetHeader)
+		node.nodeUrl.SetIpForwardingIfNecessary(ctx, rpc.SetHeader)
 
-		connectCtx, cancel := cp.NodeUrl.LowerContextTimeout(ctx, relayTimeout)
+		connectCtx, cancel := node.nodeUrl.LowerContextTimeout(ctx, relayTimeout)
 		defer cancel()
 		// perform the rpc call
-		rpcMessage, err = rpc.CallContext(connectCtx, nodeMessage.ID, nodeMessage.Method, nodeMessage.Params)
+		rpcMessage, err = rpc.CallContext(cp.rpcCallContext(connectCtx, chainMessage), nodeMessage.ID, nodeMessage.Method, nodeMessage.Params)
+		if errors.Is(err, rpcclient.ErrResponseTooLarge) {
+			// the node answered, the relay only asked for more than the provider serves
+			return nil, "", nil, responseTooLargeError(ctx, chainMessage, cp.responseSizeLimit(chainMessage))
+		}
+	}
+	if err != nil && ctx.Err() == nil {
+		conns.onNodeFailure(node, err)
+	} else if err == nil {
+		conns.onNodeSuccess(node)
 	}
 
 	var replyMsg *rpcInterfaceMessages.RPCResponse
@@ -601,6 +710,12 @@ func (cp *tendermintRpcChainProxy) SendRPC(ctx context.Context, nodeMessage *rpc
 	if err != nil {
 		return nil, "", nil, err
 	}
+	if ch == nil {
+		// http responses were limited while being read, a websocket node's response was already read whole
+		if err := cp.verifyResponseSize(ctx, chainMessage, len(data)); err != nil {
+			return nil, "", nil, err
+		}
+	}
 
 	// create a new relay reply struct
 	reply := &pairingtypes.RelayReply{
@@ -9,6 +9,7 @@ import (
 	"github.com/lavanet/lava/protocol/chainlib/chainproxy/rpcInterfaceMessages"
 	spectypes "github.com/lavanet/lava/x/spec/types"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestTendermintChainParser_Spec(t *testing.T) {
@@ -125,3 +126,70 @@ func TestTendermintParseMessage(t *testing.T) {
 	assert.Equal(t, msg.GetServiceApi().Name, apip.serverApis["API1"].Name)
 	assert.Equal(t, msg.RequestedBlock(), int64(-2))
 }
+
+func newTendermintBlockParser() *TendermintChainParser {
+	return &TendermintChainParser{
+		rwLock: sync.RWMutex{},
+		serverApis: map[string]spectypes.ServiceApi{
+			"block": {
+				Name:          "block",
+				Enabled:       true,
+				ApiInterfaces: []spectypes.ApiInterface{{Interface: spectypes.APIInterfaceTendermintRPC}},
+				BlockParsing: spectypes.BlockParser{
+					ParserArg:    []string{"height", "=", "0"},
+					ParserFunc:   spectypes.PARSER_FUNC_PARSE_DICTIONARY_OR_ORDERED,
+					DefaultValue: "latest",
+				},
+			},
+		},
+	}
+}
+
+func TestTendermintParseBlockBothConventions(t *testing.T) {
+	apip := newTendermintBlockParser()
+	testTable := []struct {
+		name          string
+		url           string
+		data          string
+		expectedBlock int64
+	}{
+		{name: "uri", url: "block?height=5", expectedBlock: 5},
+		{name: "uri quoted", url: `block?height="5"`, expectedBlock: 5},
+		{name: "uri encoded", url: "block?height=%225%22", expectedBlock: 5},
+		{name: "uri empty param", url: "block?height=", expectedBlock: spectypes.LATEST_BLOCK},
+		{name: "uri without params", url: "block?", expectedBlock: spectypes.LATEST_BLOCK},
+		{name: "uri without query", url: "block", expectedBlock: spectypes.LATEST_BLOCK},
+		{name: "jsonrpc named", data: `{"jsonrpc":"2.0","id":1,"method":"block","params":{"height":"5"}}`, expectedBlock: 5},
+		{name: "jsonrpc ordered", data: `{"jsonrpc":"2.0","id":1,"method":"block","params":["5"]}`, expectedBlock: 5},
+		{name: "jsonrpc null param", data: `{"jsonrpc":"2.0","id":1,"method":"block","params":{"height":null}}`, expectedBlock: spectypes.LATEST_BLOCK},
+		{name: "jsonrpc without params", data: `{"jsonrpc":"2.0","id":1,"method":"block"}`, expectedBlock: spectypes.LATEST_BLOCK},
+	}
+	for _, testCase := range testTable {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			msg, err := apip.ParseMsg(testCase.url, []byte(testCase.data), "")
+			require.NoError(t, err)
+			require.Equal(t, testCase.expectedBlock, msg.RequestedBlock())
+		})
+	}
+}
+
+func TestTendermintSubstituteRequestedBlock(t *testing.T) {
+	apip := newTendermintBlockParser()
+
+	msg, err := apip.ParseMsg(`block?prove=true&height="latest"`, nil, "")
+	require.NoError(t, err)
+	substituted, err := apip.SubstituteRequestedBlock(msg, 7)
+	require.NoError(t, err)
+	require.Equal(t, int64(7), substituted.RequestedBlock())
+	require.Equal(t, "block?prove=true&height=7", substituted.GetRPCMessage().(rpcInterfaceMessages.TendermintrpcMessage).Path)
+
+	msg, err = apip.ParseMsg("", []byte(`{"jsonrpc":"2.0","id":1,"method":"block"}`), "")
+	require.NoError(t, err)
+	substituted, err = apip.SubstituteRequestedBlock(msg, 7)
+	require.NoError(t, err)
+	require.Equal(t, int64(7), substituted.RequestedBlock())
+	require.Equal(t, map[string]interface{}{"height": "7"}, substituted.GetRPCMessage().(rpcInterfaceMessages.TendermintrpcMessage).Params)
+	// the original message is untouched
+	require.Equal(t, spectypes.LATEST_BLOCK, msg.RequestedBlock())
+}
@@ -10,11 +10,14 @@ import (
 
 type Test_mode_ctx_key struct{}
 
+type provider_address_override_ctx_key struct{}
+
 const (
 	EndpointsConfigName = "endpoints"
 	SaveConfigFlagName  = "save-conf"
 	GeolocationFlag     = "geolocation"
 	TestModeFlagName    = "test-mode"
+	DebugRelaysFlagName = "debug-relays"
 )
 
 func ParseEndpointArgs(endpoint_strings []string, yaml_config_properties []string, endpointsConfigName string) (viper_endpoints *viper.Viper, err error) {
@@ -84,3 +87,16 @@ func IsTestMode(ctx context.Context) bool {
 	test_mode, ok := ctx.Value(Test_mode_ctx_key{}).(bool)
 	return ok && test_mode
 }
+
+// stores a provider address requested by the user to force the relay to, only honored when relay debugging is enabled
+func WithProviderAddressOverride(ctx context.Context, providerAddress string) context.Context {
+	if providerAddress == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, provider_address_override_ctx_key{}, providerAddress)
+}
+
+func GetProviderAddressOverride(ctx context.Context) (providerAddress string, found bool) {
+	providerAddress, found = ctx.Value(provider_address_override_ctx_key{}).(string)
+	return providerAddress, found && providerAddress != ""
+}
@@ -15,6 +15,10 @@ const (
 	URL_QUERY_PARAMETERS_SEPARATOR_FROM_PATH        = "?"
 	URL_QUERY_PARAMETERS_SEPARATOR_OTHER_PARAMETERS = "&"
 	IP_FORWARDING_HEADER_NAME                       = "X-Forwarded-For"
+	PROVIDER_ADDRESS_HEADER_NAME                    = "X-Lava-Provider"
+	RELAY_TIMEOUT_HEADER_NAME                       = "X-Lava-Timeout"
+	DEBUG_HEADER_NAME                               = "X-Lava-Debug"
+	DEBUG_TRACE_HEADER_NAME                         = "X-Lava-Debug-Trace"
 )
 
 type NodeUrl struct {
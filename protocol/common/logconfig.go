@@ -0,0 +1,50 @@
+package common
+
+import (
+	"os"
+
+	"github.com/lavanet/lava/utils"
+	"github.com/spf13/viper"
+)
+
+const (
+	LogModuleLevelsConfigName   = "log-module-levels"
+	LogFormatConfigName         = "log-format"
+	LogFileConfigName           = "log-file"
+	LogMaxFileSizeConfigName    = "log-max-file-size-mb"
+	LogMaxBackupsConfigName     = "log-max-backups"
+	LogSampleBurstConfigName    = "log-sample-burst"
+	LogSampleIntervalConfigName = "log-sample-interval"
+)
+
+// ParseLogConfig reads the logging settings of the consumer and provider config files, logLevel is the level of the log level flag
+func ParseLogConfig(viperConfig *viper.Viper, logLevel string) utils.LogConfig {
+	logFormat := viperConfig.GetString(LogFormatConfigName)
+	if logFormat == "" {
+		logFormat = os.Getenv("LAVA_OUTPUT")
+	}
+	return utils.LogConfig{
+		Level:          logLevel,
+		ModuleLevels:   viperConfig.GetStringMapString(LogModuleLevelsConfigName),
+		JSON:           logFormat == "json",
+		File:           viperConfig.GetString(LogFileConfigName),
+		MaxFileSizeMB:  viperConfig.GetInt(LogMaxFileSizeConfigName),
+		MaxBackups:     viperConfig.GetInt(LogMaxBackupsConfigName),
+		SampleBurst:    viperConfig.GetInt(LogSampleBurstConfigName),
+		SampleInterval: viperConfig.GetDuration(LogSampleIntervalConfigName),
+	}
+}
+
+// ApplyLogConfig applies the logging settings of the config file, it's called on start and again on every config reload.
+// an invalid logging config keeps the running one
+func ApplyLogConfig(viperConfig *viper.Viper, logLevel string) {
+	logConfig := ParseLogConfig(viperConfig, logLevel)
+	err := utils.SetLogConfig(logConfig)
+	if err != nil {
+		utils.LavaFormatError("invalid logging config, keeping the running one", err, utils.Attribute{Key: "moduleLevels", Value: logConfig.ModuleLevels}, utils.Attribute{Key: "file", Value: logConfig.File})
+		return
+	}
+	if len(logConfig.ModuleLevels) > 0 || logConfig.File != "" {
+		utils.LavaFormatInfo("logging config applied", utils.Attribute{Key: "moduleLevels", Value: logConfig.ModuleLevels}, utils.Attribute{Key: "file", Value: logConfig.File})
+	}
+}
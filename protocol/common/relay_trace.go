@@ -0,0 +1,119 @@
+package common
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+type relay_trace_ctx_key struct{}
+
+// RelayTrace records how the consumer served a single request, for users debugging their relays. it is filled only when relay
+// debugging is enabled by the operator, and sent back in the DEBUG_TRACE_HEADER_NAME response header
+type RelayTrace struct {
+	lock         sync.Mutex
+	started      bool
+	ChainID      string              `json:"chainId"`
+	ApiInterface string              `json:"apiInterface"`
+	Api          string              `json:"api,omitempty"`
+	Shared       bool                `json:"shared,omitempty"` // the reply of an identical relay in flight was used
+	Attempts     []RelayTraceAttempt `json:"attempts"`
+	LatencyMs    int64               `json:"latencyMs"`
+	Error        string              `json:"error,omitempty"`
+}
+
+// RelayTraceAttempt is a single send of the relay to a provider
+type RelayTraceAttempt struct {
+	Provider  string `json:"provider,omitempty"`
+	SessionID uint64 `json:"sessionId,omitempty"`
+	Cache     string `json:"cache,omitempty"` // hit, miss or skipped, empty when there is no cache
+	LatencyMs int64  `json:"latencyMs"`
+	Error     string `json:"error,omitempty"`
+}
+
+// ParseDebugHeader returns whether the value of the DEBUG_HEADER_NAME header asks for a trace
+func ParseDebugHeader(value string) bool {
+	enabled, err := strconv.ParseBool(strings.TrimSpace(value))
+	return err == nil && enabled
+}
+
+// stores an empty trace for the consumer to fill, the user asked for it in the request headers
+func WithRelayTrace(ctx context.Context) context.Context {
+	return context.WithValue(ctx, relay_trace_ctx_key{}, &RelayTrace{})
+}
+
+// returns the trace of the relay, nil when the user didn't ask for one. all the trace methods are safe to call on nil
+func GetRelayTrace(ctx context.Context) *RelayTrace {
+	trace, _ := ctx.Value(relay_trace_ctx_key{}).(*RelayTrace)
+	return trace
+}
+
+func (rt *RelayTrace) Start(chainID string, apiInterface string) {
+	if rt == nil {
+		return
+	}
+	rt.lock.Lock()
+	defer rt.lock.Unlock()
+	rt.started = true
+	rt.ChainID = chainID
+	rt.ApiInterface = apiInterface
+}
+
+func (rt *RelayTrace) SetApi(api string) {
+	if rt == nil {
+		return
+	}
+	rt.lock.Lock()
+	defer rt.lock.Unlock()
+	rt.Api = api
+}
+
+func (rt *RelayTrace) SetShared(shared bool) {
+	if rt == nil {
+		return
+	}
+	rt.lock.Lock()
+	defer rt.lock.Unlock()
+	rt.Shared = shared
+}
+
+func (rt *RelayTrace) AddAttempt(attempt RelayTraceAttempt) {
+	if rt == nil {
+		return
+	}
+	rt.lock.Lock()
+	defer rt.lock.Unlock()
+	rt.Attempts = append(rt.Attempts, attempt)
+}
+
+func (rt *RelayTrace) Finish(latency time.Duration, err error) {
+	if rt == nil {
+		return
+	}
+	rt.lock.Lock()
+	defer rt.lock.Unlock()
+	rt.LatencyMs = latency.Milliseconds()
+	if err != nil {
+		rt.Error = err.Error()
+	}
+}
+
+// Encode returns the trace as json for the response header, false when the consumer didn't fill it
+func (rt *RelayTrace) Encode() (string, bool) {
+	if rt == nil {
+		return "", false
+	}
+	rt.lock.Lock()
+	defer rt.lock.Unlock()
+	if !rt.started {
+		return "", false
+	}
+	encoded, err := json.Marshal(rt)
+	if err != nil {
+		return "", false
+	}
+	return string(encoded), true
+}
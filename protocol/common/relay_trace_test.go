@@ -0,0 +1,55 @@
+package common
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRelayTrace(t *testing.T) {
+	require.True(t, ParseDebugHeader("true"))
+	require.True(t, ParseDebugHeader(" 1 "))
+	require.False(t, ParseDebugHeader(""))
+	require.False(t, ParseDebugHeader("yes please"))
+
+	// requests without the debug header have no trace, and recording to it does nothing
+	trace := GetRelayTrace(context.Background())
+	require.Nil(t, trace)
+	trace.Start("LAV1", "rest")
+	trace.AddAttempt(RelayTraceAttempt{Provider: "lava@provider"})
+	_, ok := trace.Encode()
+	require.False(t, ok)
+
+	ctx := WithRelayTrace(context.Background())
+	trace = GetRelayTrace(ctx)
+	require.NotNil(t, trace)
+	// the consumer didn't fill the trace, relay debugging is disabled
+	_, ok = trace.Encode()
+	require.False(t, ok)
+
+	trace.Start("LAV1", "rest")
+	trace.SetApi("/blocks/latest")
+	trace.AddAttempt(RelayTraceAttempt{Provider: "lava@provider1", SessionID: 7, Cache: "miss", LatencyMs: 30, Error: "timeout"})
+	trace.AddAttempt(RelayTraceAttempt{Provider: "lava@provider2", SessionID: 8, Cache: "miss", LatencyMs: 20})
+	trace.Finish(55*time.Millisecond, nil)
+	encoded, ok := trace.Encode()
+	require.True(t, ok)
+
+	decoded := RelayTrace{}
+	require.NoError(t, json.Unmarshal([]byte(encoded), &decoded))
+	require.Equal(t, "LAV1", decoded.ChainID)
+	require.Equal(t, "/blocks/latest", decoded.Api)
+	require.Len(t, decoded.Attempts, 2)
+	require.Equal(t, "timeout", decoded.Attempts[0].Error)
+	require.Equal(t, uint64(8), decoded.Attempts[1].SessionID)
+	require.Equal(t, int64(55), decoded.LatencyMs)
+	require.Empty(t, decoded.Error)
+
+	trace.Finish(time.Second, fmt.Errorf("failed all retries"))
+	encoded, _ = trace.Encode()
+	require.Contains(t, encoded, "failed all retries")
+}
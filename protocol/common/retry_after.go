@@ -0,0 +1,38 @@
+package common
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// RetryAfterError rejects a relay the user can send again once RetryAfter passed, such as a relay over an exhausted quota.
+// its message is returned to the user even when errors are masked, it's about the user's usage and not the provider's
+type RetryAfterError struct {
+	Err        error
+	RetryAfter time.Duration
+}
+
+func NewRetryAfterError(err error, retryAfter time.Duration) *RetryAfterError {
+	return &RetryAfterError{Err: err, RetryAfter: retryAfter}
+}
+
+func (rae *RetryAfterError) Error() string {
+	return fmt.Sprintf("%s, retry after %s", rae.Err, rae.RetryAfter)
+}
+
+func (rae *RetryAfterError) Cause() error {
+	return rae.Err
+}
+
+func (rae *RetryAfterError) Unwrap() error {
+	return rae.Err
+}
+
+// RetryAfterSeconds rounds the wait up to whole seconds, the unit of the http Retry-After header
+func (rae *RetryAfterError) RetryAfterSeconds() uint64 {
+	if rae.RetryAfter <= 0 {
+		return 0
+	}
+	return uint64(math.Ceil(rae.RetryAfter.Seconds()))
+}
@@ -2,6 +2,7 @@ package common
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"math/rand"
 	"os"
@@ -84,14 +85,19 @@ func (pl *RPCConsumerLogs) GetMessageSeed() string {
 // Input will be masked with a random GUID if returnMaskedErrors is set to true
 func (pl *RPCConsumerLogs) GetUniqueGuidResponseForError(responseError error, msgSeed string) string {
 	type ErrorData struct {
-		Error_GUID string `json:"Error_GUID"`
-		Error      string `json:"Error,omitempty"`
+		Error_GUID  string `json:"Error_GUID"`
+		Error       string `json:"Error,omitempty"`
+		Retry_After uint64 `json:"Retry_After,omitempty"` // seconds
 	}
 
 	data := ErrorData{
 		Error_GUID: msgSeed,
 	}
-	if ReturnMaskedErrors == "false" {
+	var retryAfterError *RetryAfterError
+	if errors.As(responseError, &retryAfterError) {
+		data.Error = responseError.Error()
+		data.Retry_After = retryAfterError.RetryAfterSeconds()
+	} else if ReturnMaskedErrors == "false" {
 		data.Error = responseError.Error()
 	}
 
@@ -38,6 +38,34 @@ func TestGetUniqueGuidResponseForError(t *testing.T) {
 	assert.Equal(t, errObject.Error1, "response error")
 }
 
+func TestGetUniqueGuidResponseForRetryAfterError(t *testing.T) {
+	plog, err := NewRPCConsumerLogs()
+	assert.Nil(t, err)
+	masked := ReturnMaskedErrors
+	ReturnMaskedErrors = "true"
+	defer func() { ReturnMaskedErrors = masked }()
+
+	type RetryAfterErrorData struct {
+		GUID       string `json:"Error_GUID"`
+		Error      string `json:"Error"`
+		RetryAfter uint64 `json:"Retry_After"`
+	}
+
+	errObject := &RetryAfterErrorData{}
+	err = json.Unmarshal([]byte(plog.GetUniqueGuidResponseForError(errors.New("response error"), "msgSeed")), errObject)
+	assert.Nil(t, err)
+	assert.Empty(t, errObject.Error)
+
+	// the user needs to know when to retry, so it isn't masked
+	errObject = &RetryAfterErrorData{}
+	responseError := NewRetryAfterError(errors.New("quota exhausted"), 1500*time.Millisecond)
+	err = json.Unmarshal([]byte(plog.GetUniqueGuidResponseForError(responseError, "msgSeed")), errObject)
+	assert.Nil(t, err)
+	assert.Equal(t, "msgSeed", errObject.GUID)
+	assert.Equal(t, "quota exhausted, retry after 1.5s", errObject.Error)
+	assert.Equal(t, uint64(2), errObject.RetryAfter)
+}
+
 func TestGetUniqueGuidResponseDeterministic(t *testing.T) {
 	plog, err := NewRPCConsumerLogs()
 	assert.Nil(t, err)
@@ -3,10 +3,14 @@ package common
 import (
 	"context"
 	"errors"
+	"fmt"
 	"math"
+	"strconv"
 	"time"
 )
 
+type relay_timeout_hint_ctx_key struct{}
+
 const (
 	TimePerCU                      = uint64(100 * time.Millisecond)
 	MinimumTimePerRelayDelay       = time.Second
@@ -44,3 +48,51 @@ func LowerContextTimeout(ctx context.Context, timeout time.Duration) (context.Co
 	}
 	return context.WithCancel(ctx)
 }
+
+// ParseTimeoutHint parses a user provided relay timeout, either a duration string ("1500ms", "2s") or a plain number of milliseconds
+func ParseTimeoutHint(hint string) (time.Duration, error) {
+	if hint == "" {
+		return 0, errors.New("empty timeout hint")
+	}
+	timeout, err := time.ParseDuration(hint)
+	if err != nil {
+		milliseconds, errParse := strconv.ParseUint(hint, 10, 64)
+		if errParse != nil {
+			return 0, fmt.Errorf("invalid timeout hint %s, expected a duration or a number of milliseconds", hint)
+		}
+		timeout = time.Duration(milliseconds) * time.Millisecond
+	}
+	if timeout <= 0 {
+		return 0, fmt.Errorf("invalid timeout hint %s, must be positive", hint)
+	}
+	return timeout, nil
+}
+
+// stores the timeout hint the user attached to the request headers
+func WithTimeoutHint(ctx context.Context, timeout time.Duration) context.Context {
+	if timeout <= 0 {
+		return ctx
+	}
+	return context.WithValue(ctx, relay_timeout_hint_ctx_key{}, timeout)
+}
+
+func GetTimeoutHint(ctx context.Context) (timeout time.Duration, found bool) {
+	timeout, found = ctx.Value(relay_timeout_hint_ctx_key{}).(time.Duration)
+	return timeout, found && timeout > 0
+}
+
+// withoutCancel keeps the values of its parent without its deadline and cancellation
+type withoutCancel struct {
+	parent context.Context
+}
+
+func (withoutCancel) Deadline() (deadline time.Time, ok bool) { return time.Time{}, false }
+func (withoutCancel) Done() <-chan struct{}                   { return nil }
+func (withoutCancel) Err() error                              { return nil }
+func (wc withoutCancel) Value(key interface{}) interface{}    { return wc.parent.Value(key) }
+
+// WithoutCancel returns a context with the values of ctx that isn't canceled when ctx is, for work that ctx's caller shares with
+// other callers and mustn't be aborted when it leaves (context.WithoutCancel of later go versions)
+func WithoutCancel(ctx context.Context) context.Context {
+	return withoutCancel{parent: ctx}
+}
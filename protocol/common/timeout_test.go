@@ -0,0 +1,66 @@
+package common
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTimeoutHint(t *testing.T) {
+	tests := []struct {
+		name     string
+		hint     string
+		expected time.Duration
+		valid    bool
+	}{
+		{name: "duration", hint: "2s", expected: 2 * time.Second, valid: true},
+		{name: "duration milliseconds", hint: "1500ms", expected: 1500 * time.Millisecond, valid: true},
+		{name: "plain milliseconds", hint: "250", expected: 250 * time.Millisecond, valid: true},
+		{name: "empty", hint: "", valid: false},
+		{name: "zero", hint: "0", valid: false},
+		{name: "negative", hint: "-1s", valid: false},
+		{name: "garbage", hint: "soon", valid: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			timeout, err := ParseTimeoutHint(tt.hint)
+			if !tt.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, timeout)
+		})
+	}
+}
+
+func TestTimeoutHintContext(t *testing.T) {
+	ctx := context.Background()
+	_, found := GetTimeoutHint(ctx)
+	require.False(t, found)
+
+	ctx = WithTimeoutHint(ctx, 0)
+	_, found = GetTimeoutHint(ctx)
+	require.False(t, found)
+
+	ctx = WithTimeoutHint(ctx, time.Second)
+	timeout, found := GetTimeoutHint(ctx)
+	require.True(t, found)
+	require.Equal(t, time.Second, timeout)
+}
+
+func TestWithoutCancel(t *testing.T) {
+	ctx, cancel := context.WithTimeout(WithTimeoutHint(context.Background(), time.Second), time.Minute)
+	detached := WithoutCancel(ctx)
+	cancel()
+	require.Error(t, ctx.Err())
+	require.NoError(t, detached.Err())
+	_, hasDeadline := detached.Deadline()
+	require.False(t, hasDeadline)
+	// the values are kept
+	timeout, found := GetTimeoutHint(detached)
+	require.True(t, found)
+	require.Equal(t, time.Second, timeout)
+}
@@ -39,3 +39,32 @@ func ParseTransactionResult(transactionResult string) (string, int) {
 	}
 	return summarizedResult, retCode
 }
+
+type TxResultType int
+
+const (
+	TxResultSuccess          TxResultType = iota
+	TxResultSequenceMismatch              // another tx of the account took the sequence, retry with the expected one
+	TxResultOutOfGas                      // the simulated gas wasn't enough by the time the tx ran, retry with more gas
+	TxResultAlreadyInMempool              // the same tx was already accepted, such as when a broadcast timed out after reaching the node
+	TxResultMempoolFull                   // the node can't take txs right now, retry later
+	TxResultFailed
+)
+
+// ClassifyTransactionResult tells how a broadcast went from its return code and output, errors of the node are matched by their message
+func ClassifyTransactionResult(transactionResult string, returnCode int) TxResultType {
+	if returnCode == 0 {
+		return TxResultSuccess
+	}
+	switch {
+	case strings.Contains(transactionResult, "account sequence"):
+		return TxResultSequenceMismatch
+	case strings.Contains(transactionResult, "out of gas"):
+		return TxResultOutOfGas
+	case strings.Contains(transactionResult, "tx already exists in cache"), strings.Contains(transactionResult, "tx already in mempool"):
+		return TxResultAlreadyInMempool
+	case strings.Contains(transactionResult, "mempool is full"):
+		return TxResultMempoolFull
+	}
+	return TxResultFailed
+}
@@ -0,0 +1,37 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestClassifyTransactionResult(t *testing.T) {
+	tests := []struct {
+		name       string
+		result     string
+		returnCode int
+		expected   TxResultType
+	}{
+		{name: "success", result: "code: 0\ntxhash: ABCD", returnCode: 0, expected: TxResultSuccess},
+		{name: "sequence mismatch", result: "raw_log: 'account sequence mismatch, expected 12, got 11: incorrect account sequence'", returnCode: 32, expected: TxResultSequenceMismatch},
+		{name: "out of gas", result: "raw_log: 'out of gas in location: WriteFlat; gasWanted: 100, gasUsed: 120: out of gas'", returnCode: 11, expected: TxResultOutOfGas},
+		{name: "already in cache", result: "broadcast error: tx already exists in cache", returnCode: 1, expected: TxResultAlreadyInMempool},
+		{name: "already in mempool", result: "raw_log: 'tx already in mempool'", returnCode: 19, expected: TxResultAlreadyInMempool},
+		{name: "mempool full", result: "mempool is full: number of txs 5000 (max: 5000)", returnCode: 1, expected: TxResultMempoolFull},
+		{name: "other failure", result: "raw_log: 'insufficient fees'", returnCode: 13, expected: TxResultFailed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, ClassifyTransactionResult(tt.result, tt.returnCode))
+		})
+	}
+}
+
+func TestFindSequenceNumber(t *testing.T) {
+	sequence, err := FindSequenceNumber("account sequence mismatch, expected 12, got 11: incorrect account sequence")
+	require.NoError(t, err)
+	require.Equal(t, 12, sequence)
+	_, err = FindSequenceNumber("insufficient fees")
+	require.Error(t, err)
+}
@@ -0,0 +1,144 @@
+package lavaprotocol
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/utils"
+	"github.com/lavanet/lava/utils/sigs"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+)
+
+const (
+	BadgeServerFlagName      = "badge-server"
+	BadgeServerTokenFlagName = "badge-server-token"
+	BadgePath                = "/badge"
+	BadgeProjectPath         = "/project"
+	BadgeAuthScheme          = "Bearer "
+	badgeServerTimeout       = 3 * time.Second
+)
+
+// BadgeReply is the json a badge server answers a badge request with
+type BadgeReply struct {
+	Badge          *pairingtypes.Badge `json:"badge"`
+	ProjectAddress string              `json:"project_address"`
+}
+
+// BadgeProjectReply is the json a badge server answers a project request with
+type BadgeProjectReply struct {
+	ProjectAddress string `json:"project_address"`
+}
+
+// BadgeHolder is a signer whose relays carry a badge, providers and the chain pair and pay them as relays of the project that granted it
+type BadgeHolder interface {
+	Badge(ctx context.Context, specID string, epoch uint64) (*pairingtypes.Badge, error)
+	ProjectAddress() sdk.AccAddress
+}
+
+// BadgeSigner signs relays with a key generated for this process, so the consumer needs no key staked on the chain,
+// and gets the badges for its relays from a badge server. a badge is granted per spec and epoch
+type BadgeSigner struct {
+	*LocalSigner
+	serverAddress  string
+	token          string
+	httpClient     *http.Client
+	projectAddress sdk.AccAddress
+	lock           sync.Mutex
+	badges         map[string]*pairingtypes.Badge // by spec, the badge of the latest epoch requested
+}
+
+// NewBadgeSigner requests badges from serverAddress, authenticated with token
+func NewBadgeSigner(ctx context.Context, serverAddress string, token string) (*BadgeSigner, error) {
+	privKey, _ := sigs.GenerateFloatingKey()
+	bs := &BadgeSigner{LocalSigner: NewLocalSigner(privKey), serverAddress: serverAddress, token: token, httpClient: &http.Client{Timeout: badgeServerTimeout}, badges: map[string]*pairingtypes.Badge{}}
+	projectReply := BadgeProjectReply{}
+	err := bs.get(ctx, BadgeProjectPath, nil, &projectReply)
+	if err != nil {
+		return nil, utils.LavaFormatError("failed getting the project of the badge server", err, utils.Attribute{Key: "address", Value: serverAddress})
+	}
+	bs.projectAddress, err = sdk.AccAddressFromBech32(projectReply.ProjectAddress)
+	if err != nil {
+		return nil, utils.LavaFormatError("invalid project address from the badge server", err, utils.Attribute{Key: "address", Value: serverAddress}, utils.Attribute{Key: "project", Value: projectReply.ProjectAddress})
+	}
+	return bs, nil
+}
+
+func (bs *BadgeSigner) ProjectAddress() sdk.AccAddress {
+	return bs.projectAddress
+}
+
+func (bs *BadgeSigner) Badge(ctx context.Context, specID string, epoch uint64) (*pairingtypes.Badge, error) {
+	bs.lock.Lock()
+	badge, ok := bs.badges[specID]
+	bs.lock.Unlock()
+	if ok && uint64(badge.Epoch) == epoch {
+		return badge, nil
+	}
+	query := url.Values{}
+	query.Set("pubkey", hex.EncodeToString(bs.PubKey().SerializeCompressed()))
+	query.Set("spec-id", specID)
+	query.Set("epoch", strconv.FormatUint(epoch, 10))
+	badgeReply := BadgeReply{}
+	err := bs.get(ctx, BadgePath, query, &badgeReply)
+	if err != nil {
+		return nil, utils.LavaFormatError("failed getting a badge", err, utils.Attribute{Key: "address", Value: bs.serverAddress}, utils.Attribute{Key: "specID", Value: specID}, utils.Attribute{Key: "epoch", Value: epoch})
+	}
+	badge = badgeReply.Badge
+	if badge == nil || badge.SpecId != specID || uint64(badge.Epoch) != epoch {
+		return nil, utils.LavaFormatError("badge server granted a badge for another relay", nil, utils.Attribute{Key: "specID", Value: specID}, utils.Attribute{Key: "epoch", Value: epoch}, utils.Attribute{Key: "badge", Value: badge})
+	}
+	// a badge of another project would have the relays paired to a project the consumer didn't get its pairing for
+	project, err := sigs.RecoverBadgeSigner(*badge)
+	if err != nil || !project.Equals(bs.projectAddress) {
+		return nil, utils.LavaFormatError("badge isn't signed by the project of the badge server", err, utils.Attribute{Key: "project", Value: bs.projectAddress}, utils.Attribute{Key: "signer", Value: project})
+	}
+	bs.lock.Lock()
+	bs.badges[specID] = badge
+	bs.lock.Unlock()
+	return badge, nil
+}
+
+func (bs *BadgeSigner) get(ctx context.Context, path string, query url.Values, reply interface{}) error {
+	requestUrl := bs.serverAddress + path
+	if len(query) > 0 {
+		requestUrl += "?" + query.Encode()
+	}
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, requestUrl, nil)
+	if err != nil {
+		return err
+	}
+	if bs.token != "" {
+		request.Header.Set("Authorization", BadgeAuthScheme+bs.token)
+	}
+	response, err := bs.httpClient.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return fmt.Errorf("badge server replied with status %s", response.Status)
+	}
+	return json.NewDecoder(response.Body).Decode(reply)
+}
+
+// attachBadge adds the badge of a badge holding signer to the relay session, the badge isn't part of the signed session data
+func attachBadge(ctx context.Context, signer Signer, relaySession *pairingtypes.RelaySession) error {
+	badgeHolder, ok := signer.(BadgeHolder)
+	if !ok {
+		return nil
+	}
+	badge, err := badgeHolder.Badge(ctx, relaySession.SpecId, uint64(relaySession.Epoch))
+	if err != nil {
+		return err
+	}
+	relaySession.Badge = badge
+	return nil
+}
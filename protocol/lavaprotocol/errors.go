@@ -8,4 +8,7 @@ var (
 	ProviderFinzalizationDataError               = sdkerrors.New("ProviderFinzalizationData Error", 3365, "provider did not sign finalization data correctly")
 	ProviderFinzalizationDataAccountabilityError = sdkerrors.New("ProviderFinzalizationDataAccountability Error", 3366, "provider returned invalid finalization data, with accountability")
 	HashesConsunsusError                         = sdkerrors.New("HashesConsunsus Error", 3367, "identified finalized responses with conflicting hashes, from two providers")
+	ProviderReplyTimestampError                  = sdkerrors.New("ProviderReplyTimestamp Error", 3368, "provider signed a reply timestamp outside of the time the relay was in flight")
+	SameProviderConflictError                    = sdkerrors.New("SameProviderConflict Error", 3369, "identified finalized responses with conflicting hashes, from the same provider")
+	ReplyChecksumMismatchError                   = sdkerrors.New("ReplyChecksumMismatch Error", 3370, "relay reply doesn't match the checksum the provider sent with it")
 )
@@ -2,22 +2,73 @@ package lavaprotocol
 
 import (
 	"fmt"
+	"sort"
 	"strconv"
 	"sync"
 	"time"
 
 	"github.com/lavanet/lava/protocol/chainlib"
+	"github.com/lavanet/lava/protocol/lavasession"
 	"github.com/lavanet/lava/utils"
 	conflicttypes "github.com/lavanet/lava/x/conflict/types"
 	pairingtypes "github.com/lavanet/lava/x/pairing/types"
 	"golang.org/x/exp/slices"
 )
 
+const finalizedHashesHistoryLength = 100 // number of heights below the highest finalized block kept for fork detection
+
 type FinalizationConsensus struct {
 	currentProviderHashesConsensus   []ProviderHashesConsensus
 	prevEpochProviderHashesConsensus []ProviderHashesConsensus
 	providerDataContainersMu         sync.RWMutex
 	currentEpoch                     uint64
+	finalizedHashesHistory           map[int64]map[string]finalizedHashEvidence // block height -> provider address -> finalized hash
+	highestFinalizedBlock            int64
+	forkDetectedCallback             func(ForkDetected) // optional, set with SetForkDetectedCallback
+	providerEarliestBlocks           map[string]int64   // provider address -> earliest block it advertised this epoch
+}
+
+// ForkDetected is emitted when providers report different finalized hashes for the same block height
+type ForkDetected struct {
+	Epoch           uint64              // the epoch of the relay that revealed the fork
+	BlockNum        int64               // the height with conflicting hashes
+	ProvidersByHash map[string][]string // finalized hash -> providers that reported it
+}
+
+// MinorityProviders returns the providers that didn't report the most common hash for the height.
+// returns nil when no single hash is ahead, as there is no way to tell which side is the minority fork
+func (fd ForkDetected) MinorityProviders() []string {
+	majorityHash := ""
+	majorityCount := 0
+	tie := false
+	for hash, providers := range fd.ProvidersByHash {
+		if len(providers) > majorityCount {
+			majorityHash = hash
+			majorityCount = len(providers)
+			tie = false
+		} else if len(providers) == majorityCount {
+			tie = true
+		}
+	}
+	if tie {
+		return nil
+	}
+	minorityProviders := []string{}
+	for hash, providers := range fd.ProvidersByHash {
+		if hash != majorityHash {
+			minorityProviders = append(minorityProviders, providers...)
+		}
+	}
+	sort.Strings(minorityProviders)
+	return minorityProviders
+}
+
+// finalizedHashEvidence is a finalized hash reported by a provider and the signed finalization data it came in,
+// so a provider reporting another hash for the same height can be proven to have signed both
+type finalizedHashEvidence struct {
+	hash    string
+	reply   *pairingtypes.RelayReply
+	session *pairingtypes.RelaySession
 }
 
 type ProviderHashesConsensus struct {
@@ -34,9 +85,72 @@ type providerDataContainer struct {
 	BlockHeight           int64
 	RelayNum              uint64
 	LatestBlock           int64
+	EarliestBlock         int64 // 0 when the provider doesn't advertise it
 	// TODO:: keep relay request for conflict reporting
 }
 
+// SetForkDetectedCallback sets a callback that is called, without holding the consensus lock, for every fork found between providers
+func (fc *FinalizationConsensus) SetForkDetectedCallback(callback func(ForkDetected)) {
+	fc.providerDataContainersMu.Lock()
+	defer fc.providerDataContainersMu.Unlock()
+	fc.forkDetectedCallback = callback
+}
+
+// updateFinalizedHashesHistory records the hashes reported by the provider and returns the heights where they conflict with other providers.
+// a fork is returned only when the provider's hash for the height is new, so a provider repeating itself doesn't emit the same fork again.
+// a provider reporting a different hash than it did before for a height returns a same provider conflict with both signed replies
+func (fc *FinalizationConsensus) updateFinalizedHashesHistory(providerAddress string, finalizedBlocks map[int64]string, req *pairingtypes.RelaySession, reply *pairingtypes.RelayReply) (forks []ForkDetected, sameProviderConflict *conflicttypes.FinalizationConflict, callback func(ForkDetected)) {
+	fc.providerDataContainersMu.Lock()
+	defer fc.providerDataContainersMu.Unlock()
+	if fc.finalizedHashesHistory == nil {
+		fc.finalizedHashesHistory = map[int64]map[string]finalizedHashEvidence{}
+	}
+	// the finalization data is signed on these fields only, the response data isn't kept
+	evidenceReply := &pairingtypes.RelayReply{LatestBlock: reply.LatestBlock, FinalizedBlocksHashes: reply.FinalizedBlocksHashes, SigBlocks: reply.SigBlocks, EarliestBlock: reply.EarliestBlock}
+	evidenceSession := &pairingtypes.RelaySession{SpecId: req.SpecId, SessionId: req.SessionId, Epoch: req.Epoch, RelayNum: req.RelayNum, Provider: req.Provider}
+	sameProviderConflictBlock := int64(-1)
+	for blockNum, blockHash := range finalizedBlocks {
+		if blockNum <= fc.highestFinalizedBlock-finalizedHashesHistoryLength {
+			continue
+		}
+		providerHashes, ok := fc.finalizedHashesHistory[blockNum]
+		if !ok {
+			providerHashes = map[string]finalizedHashEvidence{}
+			fc.finalizedHashesHistory[blockNum] = providerHashes
+		}
+		if existing, ok := providerHashes[providerAddress]; ok {
+			if existing.hash == blockHash {
+				continue
+			}
+			if sameProviderConflictBlock == -1 || blockNum < sameProviderConflictBlock {
+				sameProviderConflictBlock = blockNum
+				sameProviderConflict = &conflicttypes.FinalizationConflict{RelayReply0: existing.reply, RelaySession0: existing.session, RelayReply1: evidenceReply, RelaySession1: evidenceSession}
+			}
+		}
+		providerHashes[providerAddress] = finalizedHashEvidence{hash: blockHash, reply: evidenceReply, session: evidenceSession}
+		providersByHash := map[string][]string{}
+		for provider, evidence := range providerHashes {
+			providersByHash[evidence.hash] = append(providersByHash[evidence.hash], provider)
+		}
+		if len(providersByHash) > 1 {
+			for _, providers := range providersByHash {
+				sort.Strings(providers)
+			}
+			forks = append(forks, ForkDetected{Epoch: uint64(req.Epoch), BlockNum: blockNum, ProvidersByHash: providersByHash})
+		}
+		if blockNum > fc.highestFinalizedBlock {
+			fc.highestFinalizedBlock = blockNum
+		}
+	}
+	for blockNum := range fc.finalizedHashesHistory {
+		if blockNum <= fc.highestFinalizedBlock-finalizedHashesHistoryLength {
+			delete(fc.finalizedHashesHistory, blockNum)
+		}
+	}
+	sort.Slice(forks, func(i, j int) bool { return forks[i].BlockNum < forks[j].BlockNum })
+	return forks, sameProviderConflict, fc.forkDetectedCallback
+}
+
 func GetLatestFinalizedBlock(latestBlock int64, blockDistanceForFinalizedData int64) int64 {
 	finalization_criteria := blockDistanceForFinalizedData
 	return latestBlock - finalization_criteria
@@ -52,6 +166,7 @@ func (fc *FinalizationConsensus) newProviderHashesConsensus(blockDistanceForFina
 		RelayNum:              req.RelayNum,
 		BlockHeight:           req.Epoch,
 		LatestBlock:           latestBlock,
+		EarliestBlock:         reply.EarliestBlock,
 	}
 	providerDataContainers := map[string]providerDataContainer{}
 	providerDataContainers[providerAcc] = newProviderDataContainer
@@ -71,6 +186,7 @@ func (fc *FinalizationConsensus) insertProviderToConsensus(blockDistanceForFinal
 		RelayNum:              req.RelayNum,
 		BlockHeight:           req.Epoch,
 		LatestBlock:           latestBlock,
+		EarliestBlock:         reply.EarliestBlock,
 	}
 	consensus.agreeingProviders[providerAcc] = newProviderDataContainer
 
@@ -85,10 +201,29 @@ func (fc *FinalizationConsensus) insertProviderToConsensus(blockDistanceForFinal
 // create new consensus group if no consensus matched
 // check for discrepancy with old epoch
 // checks if there is a consensus mismatch between hashes provided by different providers
-func (fc *FinalizationConsensus) UpdateFinalizedHashes(blockDistanceForFinalizedData int64, providerAddress string, latestBlock int64, finalizedBlocks map[int64]string, req *pairingtypes.RelaySession, reply *pairingtypes.RelayReply) (finalizationConflict *conflicttypes.FinalizationConflict, err error) {
+// a provider that signed two different hashes for the same height returns a same provider conflict instead
+func (fc *FinalizationConsensus) UpdateFinalizedHashes(blockDistanceForFinalizedData int64, providerAddress string, latestBlock int64, finalizedBlocks map[int64]string, req *pairingtypes.RelaySession, reply *pairingtypes.RelayReply) (finalizationConflict *conflicttypes.FinalizationConflict, sameProviderConflict *conflicttypes.FinalizationConflict, err error) {
+	forks, sameProviderConflict, forkDetectedCallback := fc.updateFinalizedHashesHistory(providerAddress, finalizedBlocks, req, reply)
+	for _, fork := range forks {
+		utils.LavaFormatWarning("providers reported different finalized hashes", nil, utils.Attribute{Key: "blockNum", Value: fork.BlockNum}, utils.Attribute{Key: "providersByHash", Value: fork.ProvidersByHash})
+		if forkDetectedCallback != nil {
+			forkDetectedCallback(fork)
+		}
+	}
+	if sameProviderConflict != nil {
+		return nil, sameProviderConflict, utils.LavaFormatError("Simulation: provider signed different finalized hashes for the same block", SameProviderConflictError, utils.Attribute{Key: "provider", Value: providerAddress})
+	}
+
 	fc.providerDataContainersMu.Lock()
 	defer fc.providerDataContainersMu.Unlock()
 
+	if reply.EarliestBlock > 0 {
+		if fc.providerEarliestBlocks == nil {
+			fc.providerEarliestBlocks = map[string]int64{}
+		}
+		fc.providerEarliestBlocks[providerAddress] = reply.EarliestBlock
+	}
+
 	if len(fc.currentProviderHashesConsensus) == 0 && len(fc.prevEpochProviderHashesConsensus) == 0 {
 		newHashConsensus := fc.newProviderHashesConsensus(blockDistanceForFinalizedData, providerAddress, latestBlock, finalizedBlocks, reply, req)
 		fc.currentProviderHashesConsensus = append(make([]ProviderHashesConsensus, 0), newHashConsensus)
@@ -104,7 +239,7 @@ func (fc *FinalizationConsensus) UpdateFinalizedHashes(blockDistanceForFinalized
 				// create new consensus group if no consensus matched
 				// newHashConsensus := fc.newProviderHashesConsensus(blockDistanceForFinalizedData, providerAddress, latestBlock, finalizedBlocks, reply, req)
 				// fc.currentProviderHashesConsensus = append(make([]ProviderHashesConsensus, 0), newHashConsensus)
-				return finalizationConflict, utils.LavaFormatError("Simulation: Conflict found in discrepancyChecker", err)
+				return finalizationConflict, nil, utils.LavaFormatError("Simulation: Conflict found in discrepancyChecker", err)
 			}
 
 			// if no discrepency with this group -> insert into consensus
@@ -118,12 +253,12 @@ func (fc *FinalizationConsensus) UpdateFinalizedHashes(blockDistanceForFinalized
 			if err != nil {
 				// TODO: bring the other data as proof
 				finalizationConflict = &conflicttypes.FinalizationConflict{RelayReply0: reply}
-				return finalizationConflict, utils.LavaFormatError("Simulation: prev epoch Conflict found in discrepancyChecker", err, utils.Attribute{Key: "Consensus idx", Value: strconv.Itoa(idx)}, utils.Attribute{Key: "provider", Value: providerAddress})
+				return finalizationConflict, nil, utils.LavaFormatError("Simulation: prev epoch Conflict found in discrepancyChecker", err, utils.Attribute{Key: "Consensus idx", Value: strconv.Itoa(idx)}, utils.Attribute{Key: "provider", Value: providerAddress})
 			}
 		}
 	}
 
-	return finalizationConflict, nil
+	return finalizationConflict, nil, nil
 }
 
 func (fc *FinalizationConsensus) discrepancyChecker(finalizedBlocksA map[int64]string, consensus ProviderHashesConsensus) (errRet error) {
@@ -158,15 +293,58 @@ func (fc *FinalizationConsensus) NewEpoch(epoch uint64) {
 		// means it's time to refresh the epoch
 		fc.prevEpochProviderHashesConsensus = fc.currentProviderHashesConsensus
 		fc.currentProviderHashesConsensus = []ProviderHashesConsensus{}
+		fc.providerEarliestBlocks = map[string]int64{}
 		fc.currentEpoch = epoch
 	}
 }
 
+// IsBlockAvailable returns false when the provider advertised an earliest block newer than block this epoch.
+// providers that didn't advertise one are assumed to serve it, as before earliest blocks were advertised
+func (fc *FinalizationConsensus) IsBlockAvailable(providerAddress string, block int64) bool {
+	fc.providerDataContainersMu.RLock()
+	defer fc.providerDataContainersMu.RUnlock()
+	earliestBlock, ok := fc.providerEarliestBlocks[providerAddress]
+	return !ok || block >= earliestBlock
+}
+
 // returns the expected latest block, does the calculation on finalized entries then extrapolates the ending based on blockDistance
 func (s *FinalizationConsensus) ExpectedBlockHeight(chainParser chainlib.ChainParser) (expectedBlockHeight int64, numOfProviders int) {
+	blockLags := s.BlockLags(chainParser)
+	return blockLags.ExpectedBlockHeight, len(blockLags.Lags)
+}
+
+// BlockLags returns the expected latest block along with how far behind it each provider of the consensus is expected to be,
+// the QoS sync score of a relay is based on where its block falls in this distribution
+func (s *FinalizationConsensus) BlockLags(chainParser chainlib.ChainParser) lavasession.BlockLagDistribution {
 	s.providerDataContainersMu.RLock()
 	defer s.providerDataContainersMu.RUnlock()
 	allowedBlockLagForQosSync, averageBlockTime, blockDistanceForFinalizedData, _ := chainParser.ChainBlockStats()
+	listExpectedBlockHeights := s.expectedBlockHeights(averageBlockTime)
+	slices.Sort(listExpectedBlockHeights)
+
+	median := func(data []int64) int64 {
+		var median int64
+		data_len := len(data)
+		if data_len == 0 {
+			return 0
+		} else if data_len%2 == 0 {
+			median = (data[data_len/2-1] + data[data_len/2]/2.0)
+		} else {
+			median = data[data_len/2]
+		}
+		return median
+	}
+	expectedBlockHeight := median(listExpectedBlockHeights) - allowedBlockLagForQosSync + int64(blockDistanceForFinalizedData)
+	lags := make([]int64, len(listExpectedBlockHeights))
+	for idx, expected := range listExpectedBlockHeights {
+		// the expected heights are ascending, so the lags are filled from the end to keep them sorted
+		lags[len(lags)-1-idx] = expectedBlockHeight - (expected + int64(blockDistanceForFinalizedData))
+	}
+	return lavasession.BlockLagDistribution{ExpectedBlockHeight: expectedBlockHeight, Lags: lags}
+}
+
+// expectedBlockHeights interpolates the latest finalized block of every agreeing provider, must be called with the lock held
+func (s *FinalizationConsensus) expectedBlockHeights(averageBlockTime time.Duration) []int64 {
 	averageBlockTime_ms := averageBlockTime
 	listExpectedBlockHeights := []int64{}
 
@@ -201,20 +379,5 @@ func (s *FinalizationConsensus) ExpectedBlockHeight(chainParser chainlib.ChainPa
 	}
 	listExpectedBlockHeights = append(listExpectedBlockHeights, calcExpectedBlocks(s.prevEpochProviderHashesConsensus)...)
 	listExpectedBlockHeights = append(listExpectedBlockHeights, calcExpectedBlocks(s.currentProviderHashesConsensus)...)
-
-	median := func(data []int64) int64 {
-		slices.Sort(data)
-
-		var median int64
-		data_len := len(data)
-		if data_len == 0 {
-			return 0
-		} else if data_len%2 == 0 {
-			median = (data[data_len/2-1] + data[data_len/2]/2.0)
-		} else {
-			median = data[data_len/2]
-		}
-		return median
-	}
-	return median(listExpectedBlockHeights) - allowedBlockLagForQosSync + int64(blockDistanceForFinalizedData), len(listExpectedBlockHeights)
+	return listExpectedBlockHeights
 }
@@ -0,0 +1,114 @@
+package lavaprotocol
+
+import (
+	"sync"
+	"testing"
+
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFinalizationConsensusForkDetected(t *testing.T) {
+	finalizationConsensus := &FinalizationConsensus{}
+	forks := []ForkDetected{}
+	finalizationConsensus.SetForkDetectedCallback(func(fork ForkDetected) {
+		forks = append(forks, fork)
+	})
+	req := &pairingtypes.RelaySession{Epoch: 20}
+	reply := &pairingtypes.RelayReply{}
+	updateFinalizedHashes := func(provider string, finalizedBlocks map[int64]string) {
+		finalizationConsensus.UpdateFinalizedHashes(1, provider, 101, finalizedBlocks, req, reply)
+	}
+
+	updateFinalizedHashes("provider1", map[int64]string{99: "a99", 100: "a100"})
+	updateFinalizedHashes("provider2", map[int64]string{99: "a99", 100: "a100"})
+	require.Empty(t, forks)
+
+	updateFinalizedHashes("provider3", map[int64]string{99: "a99", 100: "b100"})
+	require.Len(t, forks, 1)
+	require.Equal(t, uint64(20), forks[0].Epoch)
+	require.Equal(t, int64(100), forks[0].BlockNum)
+	require.Equal(t, map[string][]string{"a100": {"provider1", "provider2"}, "b100": {"provider3"}}, forks[0].ProvidersByHash)
+	require.Equal(t, []string{"provider3"}, forks[0].MinorityProviders())
+
+	// repeating the same hashes doesn't emit the fork again
+	updateFinalizedHashes("provider3", map[int64]string{100: "b100"})
+	require.Len(t, forks, 1)
+
+	// heights far below the highest finalized block are dropped from the history
+	updateFinalizedHashes("provider1", map[int64]string{100 + finalizedHashesHistoryLength: "a200"})
+	updateFinalizedHashes("provider4", map[int64]string{100: "c100"})
+	require.Len(t, forks, 1)
+}
+
+func TestForkDetectedMinorityProviders(t *testing.T) {
+	fork := ForkDetected{ProvidersByHash: map[string][]string{"a": {"provider1"}, "b": {"provider2"}}}
+	require.Nil(t, fork.MinorityProviders())
+
+	fork = ForkDetected{ProvidersByHash: map[string][]string{"a": {"provider1", "provider2", "provider3"}, "b": {"provider5"}, "c": {"provider4"}}}
+	require.Equal(t, []string{"provider4", "provider5"}, fork.MinorityProviders())
+}
+
+func TestFinalizationConsensusForkDetectedConcurrent(t *testing.T) {
+	finalizationConsensus := &FinalizationConsensus{}
+	lock := sync.Mutex{}
+	forks := 0
+	finalizationConsensus.SetForkDetectedCallback(func(fork ForkDetected) {
+		lock.Lock()
+		defer lock.Unlock()
+		forks++
+	})
+	wg := sync.WaitGroup{}
+	for _, provider := range []string{"provider1", "provider2"} {
+		wg.Add(1)
+		go func(provider string) {
+			defer wg.Done()
+			finalizationConsensus.UpdateFinalizedHashes(1, provider, 101, map[int64]string{100: provider}, &pairingtypes.RelaySession{Epoch: 20}, &pairingtypes.RelayReply{})
+		}(provider)
+	}
+	wg.Wait()
+	require.Equal(t, 1, forks)
+}
+
+func TestFinalizationConsensusEarliestBlock(t *testing.T) {
+	finalizationConsensus := &FinalizationConsensus{}
+	finalizationConsensus.NewEpoch(20)
+	req := &pairingtypes.RelaySession{Epoch: 20}
+	finalizationConsensus.UpdateFinalizedHashes(1, "provider1", 101, map[int64]string{100: "a100"}, req, &pairingtypes.RelayReply{EarliestBlock: 50})
+	finalizationConsensus.UpdateFinalizedHashes(1, "provider2", 101, map[int64]string{100: "a100"}, req, &pairingtypes.RelayReply{})
+
+	require.True(t, finalizationConsensus.IsBlockAvailable("provider1", 50))
+	require.False(t, finalizationConsensus.IsBlockAvailable("provider1", 49))
+	// providers that don't advertise an earliest block are assumed to serve any block
+	require.True(t, finalizationConsensus.IsBlockAvailable("provider2", 1))
+	require.True(t, finalizationConsensus.IsBlockAvailable("provider3", 1))
+
+	// advertisements are dropped on a new epoch
+	finalizationConsensus.NewEpoch(40)
+	require.True(t, finalizationConsensus.IsBlockAvailable("provider1", 49))
+}
+
+func TestFinalizationConsensusSameProviderConflict(t *testing.T) {
+	finalizationConsensus := &FinalizationConsensus{}
+	req0 := &pairingtypes.RelaySession{SpecId: "LAV1", Epoch: 20, SessionId: 1, RelayNum: 1}
+	reply0 := &pairingtypes.RelayReply{LatestBlock: 101, SigBlocks: []byte("sig0"), Data: []byte("data")}
+	finalizationConflict, sameProviderConflict, err := finalizationConsensus.UpdateFinalizedHashes(1, "provider1", 101, map[int64]string{99: "a99", 100: "a100"}, req0, reply0)
+	require.NoError(t, err)
+	require.Nil(t, finalizationConflict)
+	require.Nil(t, sameProviderConflict)
+
+	// another provider with another hash is a fork, not a same provider conflict
+	_, sameProviderConflict, _ = finalizationConsensus.UpdateFinalizedHashes(1, "provider2", 101, map[int64]string{100: "b100"}, req0, reply0)
+	require.Nil(t, sameProviderConflict)
+
+	req1 := &pairingtypes.RelaySession{SpecId: "LAV1", Epoch: 20, SessionId: 1, RelayNum: 2}
+	reply1 := &pairingtypes.RelayReply{LatestBlock: 102, SigBlocks: []byte("sig1")}
+	_, sameProviderConflict, err = finalizationConsensus.UpdateFinalizedHashes(1, "provider1", 102, map[int64]string{100: "c100", 101: "a101"}, req1, reply1)
+	require.True(t, SameProviderConflictError.Is(err))
+	require.NotNil(t, sameProviderConflict)
+	require.Equal(t, []byte("sig0"), sameProviderConflict.RelayReply0.SigBlocks)
+	require.Empty(t, sameProviderConflict.RelayReply0.Data)
+	require.Equal(t, uint64(1), sameProviderConflict.RelaySession0.RelayNum)
+	require.Equal(t, []byte("sig1"), sameProviderConflict.RelayReply1.SigBlocks)
+	require.Equal(t, uint64(2), sameProviderConflict.RelaySession1.RelayNum)
+}
@@ -0,0 +1,88 @@
+package lavaprotocol
+
+import (
+	"context"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/lavanet/lava/utils"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+const remoteSignerTimeout = 3 * time.Second
+
+// RemoteSigner requests signatures from a RelaySigner grpc service, so the consumer key can stay in an HSM behind it
+type RemoteSigner struct {
+	conn   *grpc.ClientConn
+	client pairingtypes.RelaySignerClient
+	pubKey *btcec.PublicKey
+}
+
+func NewRemoteSigner(ctx context.Context, addr string) (*RemoteSigner, error) {
+	connectCtx, cancel := context.WithTimeout(ctx, remoteSignerTimeout)
+	defer cancel()
+	conn, err := grpc.DialContext(connectCtx, addr, grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithBlock())
+	if err != nil {
+		return nil, utils.LavaFormatError("failed connecting to remote signer", err, utils.Attribute{Key: "address", Value: addr})
+	}
+	client := pairingtypes.NewRelaySignerClient(conn)
+	reply, err := client.PubKey(connectCtx, &emptypb.Empty{})
+	if err != nil {
+		conn.Close()
+		return nil, utils.LavaFormatError("failed getting the remote signer public key", err, utils.Attribute{Key: "address", Value: addr})
+	}
+	pubKey, err := btcec.ParsePubKey(reply.PubKey, btcec.S256())
+	if err != nil {
+		conn.Close()
+		return nil, utils.LavaFormatError("invalid remote signer public key", err, utils.Attribute{Key: "address", Value: addr})
+	}
+	return &RemoteSigner{conn: conn, client: client, pubKey: pubKey}, nil
+}
+
+func (rs *RemoteSigner) Sign(ctx context.Context, data []byte) ([]byte, error) {
+	signCtx, cancel := context.WithTimeout(ctx, remoteSignerTimeout)
+	defer cancel()
+	reply, err := rs.client.Sign(signCtx, &pairingtypes.RelaySignRequest{Data: data})
+	if err != nil {
+		return nil, utils.LavaFormatError("remote signer failed signing", err)
+	}
+	// a signature made by another key would have every relay rejected by the providers, catch it here instead
+	err = verifyCompactSignature(reply.Sig, data, rs.pubKey)
+	if err != nil {
+		return nil, utils.LavaFormatError("invalid signature from remote signer", err)
+	}
+	return reply.Sig, nil
+}
+
+func (rs *RemoteSigner) PubKey() *btcec.PublicKey {
+	return rs.pubKey
+}
+
+func (rs *RemoteSigner) Close() error {
+	return rs.conn.Close()
+}
+
+// RelaySignerServer serves a Signer to remote signer clients, for running next to the key's hardware
+type RelaySignerServer struct {
+	pairingtypes.UnimplementedRelaySignerServer
+	signer Signer
+}
+
+func NewRelaySignerServer(signer Signer) *RelaySignerServer {
+	return &RelaySignerServer{signer: signer}
+}
+
+func (rss *RelaySignerServer) Sign(ctx context.Context, req *pairingtypes.RelaySignRequest) (*pairingtypes.RelaySignResponse, error) {
+	sig, err := rss.signer.Sign(ctx, req.Data)
+	if err != nil {
+		return nil, err
+	}
+	return &pairingtypes.RelaySignResponse{Sig: sig}, nil
+}
+
+func (rss *RelaySignerServer) PubKey(ctx context.Context, _ *emptypb.Empty) (*pairingtypes.RelaySignerPubKey, error) {
+	return &pairingtypes.RelaySignerPubKey{PubKey: rss.signer.PubKey().SerializeCompressed()}, nil
+}
@@ -0,0 +1,67 @@
+package lavaprotocol
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+
+	"github.com/lavanet/lava/utils"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// the grpc trailer the provider puts the checksum of the reply's fields in
+const RelayReplyChecksumTrailer = "lava-reply-checksum"
+
+// ReplyChecksum returns the hex sha256 of the reply's fields, each length prefixed. the fields are listed rather than the reply
+// re-serialized, so fields a consumer doesn't know yet, dropped when it decodes the reply, don't fail the checksum. a field added
+// to the reply is added here only once the consumers know it
+func ReplyChecksum(reply *pairingtypes.RelayReply) string {
+	hash := sha256.New()
+	writeField := func(field []byte) {
+		lengthBytes := make([]byte, 8)
+		binary.LittleEndian.PutUint64(lengthBytes, uint64(len(field)))
+		hash.Write(lengthBytes)
+		hash.Write(field)
+	}
+	writeUint := func(value uint64) {
+		valueBytes := make([]byte, 8)
+		binary.LittleEndian.PutUint64(valueBytes, value)
+		writeField(valueBytes)
+	}
+	writeField(reply.Data)
+	writeField(reply.Sig)
+	writeUint(uint64(reply.Nonce))
+	writeUint(uint64(reply.LatestBlock))
+	writeField(reply.FinalizedBlocksHashes)
+	writeField(reply.SigBlocks)
+	writeUint(uint64(reply.Timestamp))
+	writeUint(uint64(reply.EarliestBlock))
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// SetReplyChecksumTrailer sends the checksum of the signed reply in the grpc trailer of the relay
+func SetReplyChecksumTrailer(ctx context.Context, reply *pairingtypes.RelayReply) error {
+	return grpc.SetTrailer(ctx, metadata.Pairs(RelayReplyChecksumTrailer, ReplyChecksum(reply)))
+}
+
+// VerifyReplyChecksum compares the reply with the checksum the provider sent in the trailer, before its signatures are checked.
+// a mismatch means the reply changed on the way, by a proxy or a corrupted transport, and not that the provider misbehaved, so the
+// reply is dropped without being used in conflicts. providers that don't send a checksum aren't checked
+func VerifyReplyChecksum(reply *pairingtypes.RelayReply, trailer metadata.MD, providerAddress string) error {
+	values := trailer.Get(RelayReplyChecksumTrailer)
+	if len(values) == 0 {
+		return nil
+	}
+	checksum := ReplyChecksum(reply)
+	if checksum != values[0] {
+		return utils.LavaFormatWarning("reply doesn't match the checksum the provider sent, it was altered in transport", ReplyChecksumMismatchError,
+			utils.Attribute{Key: "provider", Value: providerAddress},
+			utils.Attribute{Key: "checksum", Value: checksum},
+			utils.Attribute{Key: "expected", Value: values[0]},
+		)
+	}
+	return nil
+}
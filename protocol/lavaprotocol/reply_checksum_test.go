@@ -0,0 +1,37 @@
+package lavaprotocol
+
+import (
+	"testing"
+
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestVerifyReplyChecksum(t *testing.T) {
+	reply := &pairingtypes.RelayReply{Data: []byte(`{"result":"0x10"}`), Sig: []byte("sig"), LatestBlock: 100}
+	trailer := metadata.Pairs(RelayReplyChecksumTrailer, ReplyChecksum(reply))
+
+	replyBytes, err := reply.Marshal()
+	require.NoError(t, err)
+	decoded := &pairingtypes.RelayReply{}
+	require.NoError(t, decoded.Unmarshal(replyBytes))
+	require.NoError(t, VerifyReplyChecksum(decoded, trailer, "provider"))
+
+	// a field the consumer doesn't know is dropped when decoding, and doesn't fail the checksum
+	unknownField := append(replyBytes, 0xf8, 0x01, 0x01) // field 31, varint 1
+	decoded = &pairingtypes.RelayReply{}
+	require.NoError(t, decoded.Unmarshal(unknownField))
+	require.NoError(t, VerifyReplyChecksum(decoded, trailer, "provider"))
+
+	// providers that don't send a checksum aren't checked
+	require.NoError(t, VerifyReplyChecksum(decoded, metadata.MD{}, "provider"))
+
+	decoded.Data = []byte(`{"result":"0x11"}`)
+	err = VerifyReplyChecksum(decoded, trailer, "provider")
+	require.True(t, ReplyChecksumMismatchError.Is(err))
+
+	// the fields are length prefixed, so bytes moved between them change the checksum
+	moved := &pairingtypes.RelayReply{Data: []byte(`{"result":"0x10"}s`), Sig: []byte("ig"), LatestBlock: 100}
+	require.NotEqual(t, ReplyChecksum(reply), ReplyChecksum(moved))
+}
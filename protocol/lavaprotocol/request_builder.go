@@ -1,13 +1,11 @@
 package lavaprotocol
 
 import (
-	"bytes"
 	"context"
 	"encoding/binary"
 	"strconv"
 	"time"
 
-	"github.com/btcsuite/btcd/btcec"
 	"github.com/lavanet/lava/protocol/chainlib"
 	"github.com/lavanet/lava/protocol/lavasession"
 	"github.com/lavanet/lava/utils"
@@ -100,17 +98,43 @@ func dataReliabilityRelaySession(lavaChainID string, relayRequestData *pairingty
 	}
 }
 
-func ConstructRelayRequest(ctx context.Context, privKey *btcec.PrivateKey, lavaChainID string, chainID string, relayRequestData *pairingtypes.RelayPrivateData, providerPublicAddress string, consumerSession *lavasession.SingleConsumerSession, epoch int64, reportedProviders []byte) (*pairingtypes.RelayRequest, error) {
+// requestProtocolVersion leaves the version unset for legacy providers, they drop the unknown field and the request is part of the reply they sign
+func requestProtocolVersion(protocolVersion uint32) uint32 {
+	if protocolVersion <= lavasession.LegacyProtocolVersion {
+		return 0
+	}
+	return protocolVersion
+}
+
+func ConstructRelayRequest(ctx context.Context, signer Signer, lavaChainID string, chainID string, relayRequestData *pairingtypes.RelayPrivateData, providerPublicAddress string, consumerSession *lavasession.SingleConsumerSession, epoch int64, reportedProviders []byte, apiName string) (*pairingtypes.RelayRequest, error) {
+	protocolVersion := lavasession.LegacyProtocolVersion
+	if consumerSession.Client != nil {
+		protocolVersion = consumerSession.Client.GetProtocolVersion()
+	}
+	err := lavasession.VerifyProtocolVersion(protocolVersion)
+	if err != nil {
+		return nil, err
+	}
 	relayRequest := &pairingtypes.RelayRequest{
 		RelayData:       relayRequestData,
 		RelaySession:    ConstructRelaySession(lavaChainID, relayRequestData, chainID, providerPublicAddress, consumerSession, epoch, reportedProviders),
 		DataReliability: nil,
+		ProtocolVersion: requestProtocolVersion(protocolVersion),
+	}
+	// older providers drop the api name and the version, they must be left out of the session they verify the signature of
+	if lavasession.ProtocolVersionSupports(protocolVersion, lavasession.RelayApiNameFeature) {
+		relayRequest.RelaySession.ApiName = apiName
+		relayRequest.RelaySession.ProtocolVersion = protocolVersion
 	}
-	sig, err := sigs.SignRelay(privKey, *relayRequest.RelaySession)
+	sig, err := signer.Sign(ctx, sigs.DataToSignRelay(*relayRequest.RelaySession))
 	if err != nil {
 		return nil, err
 	}
 	relayRequest.RelaySession.Sig = sig
+	err = attachBadge(ctx, signer, relayRequest.RelaySession)
+	if err != nil {
+		return nil, err
+	}
 	return relayRequest, nil
 }
 
@@ -120,10 +144,11 @@ func GetTimePerCu(cu uint64) time.Duration {
 
 func UpdateRequestedBlock(request *pairingtypes.RelayPrivateData, response *pairingtypes.RelayReply) {
 	// since sometimes the user is sending requested block that is a magic like latest, or earliest we need to specify to the reliability what it is
-	request.RequestBlock = ReplaceRequestedBlock(request.RequestBlock, response.LatestBlock)
+	request.RequestBlock = ReplaceRequestedBlock(request.RequestBlock, response.LatestBlock, response.EarliestBlock)
 }
 
-func ReplaceRequestedBlock(requestedBlock int64, latestBlock int64) int64 {
+// ReplaceRequestedBlock maps the magic block numbers to concrete blocks, earliestBlock is 0 when the provider doesn't advertise it
+func ReplaceRequestedBlock(requestedBlock int64, latestBlock int64, earliestBlock int64) int64 {
 	switch requestedBlock {
 	case spectypes.LATEST_BLOCK:
 		return latestBlock
@@ -132,7 +157,10 @@ func ReplaceRequestedBlock(requestedBlock int64, latestBlock int64) int64 {
 	case spectypes.FINALIZED_BLOCK:
 		return latestBlock
 	case spectypes.EARLIEST_BLOCK:
-		return spectypes.NOT_APPLICABLE // TODO: add support for earliest block reliability
+		if earliestBlock <= 0 {
+			return spectypes.NOT_APPLICABLE // without the provider's earliest block there is nothing to pin reliability to
+		}
+		return earliestBlock
 	}
 	return requestedBlock
 }
@@ -168,23 +196,32 @@ func NewVRFData(differentiator bool, vrf_res []byte, vrf_proof []byte, request *
 	return dataReliability
 }
 
-func ConstructDataReliabilityRelayRequest(ctx context.Context, lavaChainID string, vrfData *pairingtypes.VRFData, privKey *btcec.PrivateKey, chainID string, relayRequestData *pairingtypes.RelayPrivateData, providerPublicAddress string, epoch int64, reportedProviders []byte, relayNum uint64) (*pairingtypes.RelayRequest, error) {
+func ConstructDataReliabilityRelayRequest(ctx context.Context, lavaChainID string, vrfData *pairingtypes.VRFData, signer Signer, chainID string, relayRequestData *pairingtypes.RelayPrivateData, providerPublicAddress string, epoch int64, reportedProviders []byte, relayNum uint64, protocolVersion uint32, apiName string) (*pairingtypes.RelayRequest, error) {
 	if relayRequestData.RequestBlock < 0 {
 		return nil, utils.LavaFormatError("tried to construct data reliability relay with invalid request block, need to specify exactly what block is required", nil,
 			utils.Attribute{Key: "requested_common_data", Value: relayRequestData}, utils.Attribute{Key: "epoch", Value: epoch}, utils.Attribute{Key: "chainID", Value: chainID})
 	}
+	err := lavasession.VerifyProtocolVersion(protocolVersion)
+	if err != nil {
+		return nil, err
+	}
 	relayRequest := &pairingtypes.RelayRequest{
 		RelayData:       relayRequestData,
 		RelaySession:    dataReliabilityRelaySession(lavaChainID, relayRequestData, chainID, providerPublicAddress, epoch, relayNum),
 		DataReliability: vrfData,
+		ProtocolVersion: requestProtocolVersion(protocolVersion),
+	}
+	if lavasession.ProtocolVersionSupports(protocolVersion, lavasession.RelayApiNameFeature) {
+		relayRequest.RelaySession.ApiName = apiName
+		relayRequest.RelaySession.ProtocolVersion = protocolVersion
 	}
-	sig, err := sigs.SignRelay(privKey, *relayRequest.RelaySession)
+	sig, err := signer.Sign(ctx, sigs.DataToSignRelay(*relayRequest.RelaySession))
 	if err != nil {
 		return nil, err
 	}
 	relayRequest.RelaySession.Sig = sig
 
-	sig, err = sigs.SignVRFData(privKey, relayRequest.DataReliability)
+	sig, err = signer.Sign(ctx, sigs.DataToSignVRFData(relayRequest.DataReliability))
 	if err != nil {
 		return nil, err
 	}
@@ -192,14 +229,16 @@ func ConstructDataReliabilityRelayRequest(ctx context.Context, lavaChainID strin
 	return relayRequest, nil
 }
 
-func VerifyReliabilityResults(originalResult *RelayResult, dataReliabilityResults []*RelayResult, totalNumberOfSessions int) (conflict bool, conflicts []*conflicttypes.ResponseConflict) {
+// VerifyReliabilityResults compares the reply with the replies of the reliability relays, differences in the fields of the api's
+// response ignore paths aren't conflicts
+func VerifyReliabilityResults(originalResult *RelayResult, dataReliabilityResults []*RelayResult, totalNumberOfSessions int, serviceApi *spectypes.ServiceApi) (conflict bool, conflicts []*conflicttypes.ResponseConflict) {
 	verificationsLength := len(dataReliabilityResults)
 	participatingProviders := make([]utils.Attribute, verificationsLength+1) // only used for logging
 	participatingProviders = append(participatingProviders, utils.Attribute{Key: "originalAddress", Value: originalResult.ProviderAddress})
 	for idx, dataReliabilityResult := range dataReliabilityResults {
 		add := dataReliabilityResult.ProviderAddress
 		participatingProviders = append(participatingProviders, utils.Attribute{Key: "address" + strconv.Itoa(idx), Value: add})
-		conflict_now, detectionMessage := compareRelaysFindConflict(originalResult, dataReliabilityResult)
+		conflict_now, detectionMessage := compareRelaysFindConflict(originalResult, dataReliabilityResult, serviceApi)
 		if conflict_now {
 			conflicts = []*conflicttypes.ResponseConflict{detectionMessage}
 			conflict = true
@@ -209,7 +248,7 @@ func VerifyReliabilityResults(originalResult *RelayResult, dataReliabilityResult
 		// CompareRelaysAndReportConflict to each one of the data reliability relays to confirm that the first relay was'nt ok
 		for idx1 := 0; idx1 < verificationsLength; idx1++ {
 			for idx2 := (idx1 + 1); idx2 < verificationsLength; idx2++ {
-				conflict_responses, moreDetectionMessages := compareRelaysFindConflict(dataReliabilityResults[idx1], dataReliabilityResults[idx2])
+				conflict_responses, moreDetectionMessages := compareRelaysFindConflict(dataReliabilityResults[idx1], dataReliabilityResults[idx2], serviceApi)
 				if conflict_responses {
 					conflicts = append(conflicts, moreDetectionMessages)
 				}
@@ -226,10 +265,9 @@ func VerifyReliabilityResults(originalResult *RelayResult, dataReliabilityResult
 	return conflict, conflicts
 }
 
-func compareRelaysFindConflict(result1 *RelayResult, result2 *RelayResult) (conflict bool, responseConflict *conflicttypes.ResponseConflict) {
-	compare_result := bytes.Compare(result1.Reply.Data, result2.Reply.Data)
-	if compare_result == 0 {
-		// they have equal data
+func compareRelaysFindConflict(result1 *RelayResult, result2 *RelayResult, serviceApi *spectypes.ServiceApi) (conflict bool, responseConflict *conflicttypes.ResponseConflict) {
+	if chainlib.RepliesMatch(serviceApi, result1.Reply.Data, result2.Reply.Data) {
+		// they have equal data, apart from fields nodes don't agree on
 		return false, nil
 	}
 	// they have different data! report!
@@ -238,5 +276,25 @@ func compareRelaysFindConflict(result1 *RelayResult, result2 *RelayResult) (conf
 		ConflictRelayData0: &conflicttypes.ConflictRelayData{Reply: result1.Reply, Request: result1.Request},
 		ConflictRelayData1: &conflicttypes.ConflictRelayData{Reply: result2.Reply, Request: result2.Request},
 	}
-	return
+	return true, responseConflict
+}
+
+// MajorityRelayResult returns a result of the reply most providers agree on, on a tie the reply that got there first. replies that only differ
+// in the fields of the api's response ignore paths agree
+func MajorityRelayResult(relayResults []*RelayResult, serviceApi *spectypes.ServiceApi) *RelayResult {
+	if len(relayResults) == 0 {
+		return nil
+	}
+	votes := map[string]int{}
+	var majority *RelayResult
+	majorityVotes := 0
+	for _, relayResult := range relayResults {
+		hash := string(chainlib.ReplyConsensusHash(serviceApi, relayResult.Reply.Data))
+		votes[hash]++
+		if votes[hash] > majorityVotes {
+			majority = relayResult
+			majorityVotes = votes[hash]
+		}
+	}
+	return majority
 }
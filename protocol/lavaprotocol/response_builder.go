@@ -3,9 +3,11 @@ package lavaprotocol
 import (
 	"encoding/json"
 	"sort"
+	"time"
 
 	btcSecp256k1 "github.com/btcsuite/btcd/btcec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/protocol/lavasession"
 	"github.com/lavanet/lava/utils"
 	"github.com/lavanet/lava/utils/sigs"
 	conflicttypes "github.com/lavanet/lava/x/conflict/types"
@@ -13,10 +15,19 @@ import (
 	spectypes "github.com/lavanet/lava/x/spec/types"
 )
 
+const (
+	ReplyMaxClockSkewFlagName = "reply-max-clock-skew"
+	DefaultReplyMaxClockSkew  = 5 * time.Second
+)
+
 func SignRelayResponse(consumerAddress sdk.AccAddress, request pairingtypes.RelayRequest, pkey *btcSecp256k1.PrivateKey, reply *pairingtypes.RelayReply, signDataReliability bool) (*pairingtypes.RelayReply, error) {
 	// request is a copy of the original request, but won't modify it
 	// update relay request requestedBlock to the provided one in case it was arbitrary
 	UpdateRequestedBlock(request.RelayData, reply)
+	if lavasession.ProtocolVersionSupports(request.ProtocolVersion, lavasession.ReplyTimestampFeature) {
+		// the timestamp is signed with the reply so the consumer can hold the provider to it
+		reply.Timestamp = time.Now().UnixMilli()
+	}
 	// Update signature,
 	sig, err := sigs.SignRelayResponse(pkey, reply, &request)
 	if err != nil {
@@ -27,7 +38,11 @@ func SignRelayResponse(consumerAddress sdk.AccAddress, request pairingtypes.Rela
 
 	if signDataReliability {
 		// update sig blocks signature
-		sigBlocks, err := sigs.SignResponseFinalizationData(pkey, reply, &request, consumerAddress)
+		signFinalizationData := sigs.SignResponseFinalizationData
+		if lavasession.ProtocolVersionSupports(request.ProtocolVersion, lavasession.HashedFinalizationFeature) {
+			signFinalizationData = sigs.SignResponseFinalizationDataHash
+		}
+		sigBlocks, err := signFinalizationData(pkey, reply, &request, consumerAddress)
 		if err != nil {
 			return nil, utils.LavaFormatError("failed signing finalization data", err,
 				utils.Attribute{Key: "request", Value: request}, utils.Attribute{Key: "reply", Value: reply}, utils.Attribute{Key: "userAddr", Value: consumerAddress})
@@ -38,18 +53,47 @@ func SignRelayResponse(consumerAddress sdk.AccAddress, request pairingtypes.Rela
 }
 
 func VerifyRelayReply(reply *pairingtypes.RelayReply, relayRequest *pairingtypes.RelayRequest, addr string) error {
-	serverKey, err := sigs.RecoverPubKeyFromRelayReply(reply, relayRequest)
+	serverAddr, err := ReplySigner(reply, relayRequest)
 	if err != nil {
 		return err
 	}
-	serverAddr, err := sdk.AccAddressFromHex(serverKey.Address().String())
+	if serverAddr != addr {
+		return utils.LavaFormatError("reply server address mismatch ", ProviderFinzalizationDataError, utils.Attribute{Key: "parsed Address", Value: serverAddr}, utils.Attribute{Key: "expected address", Value: addr})
+	}
+	if reply.Timestamp == 0 && lavasession.ProtocolVersionSupports(relayRequest.ProtocolVersion, lavasession.ReplyTimestampFeature) {
+		return utils.LavaFormatError("provider didn't sign a timestamp on a protocol version that requires it", lavasession.ProtocolVersionMismatchError, utils.Attribute{Key: "protocolVersion", Value: relayRequest.ProtocolVersion}, utils.Attribute{Key: "Provider", Value: addr})
+	}
+
+	return nil
+}
+
+// ReplySigner returns the address of the provider that signed reply for relayRequest
+func ReplySigner(reply *pairingtypes.RelayReply, relayRequest *pairingtypes.RelayRequest) (string, error) {
+	serverKey, err := sigs.RecoverPubKeyFromRelayReply(reply, relayRequest)
 	if err != nil {
-		return err
+		return "", err
 	}
-	if serverAddr.String() != addr {
-		return utils.LavaFormatError("reply server address mismatch ", ProviderFinzalizationDataError, utils.Attribute{Key: "parsed Address", Value: serverAddr.String()}, utils.Attribute{Key: "expected address", Value: addr})
+	serverAddr, err := sdk.AccAddressFromHex(serverKey.Address().String())
+	if err != nil {
+		return "", err
 	}
+	return serverAddr.String(), nil
+}
 
+// VerifyReplyTimestamp checks the provider signed timestamp falls between sending the relay and receiving the reply, up to maxClockSkew on each side.
+// the reply signature covers the timestamp, so a reply failing this check proves the provider misreported its response time.
+// replies without a timestamp and a zero maxClockSkew are not checked
+func VerifyReplyTimestamp(reply *pairingtypes.RelayReply, relaySentTime time.Time, replyReceivedTime time.Time, maxClockSkew time.Duration, providerAddr string) error {
+	if reply.Timestamp == 0 || maxClockSkew <= 0 {
+		return nil
+	}
+	replyTime := time.UnixMilli(reply.Timestamp)
+	if replyTime.Before(relaySentTime.Add(-maxClockSkew)) || replyTime.After(replyReceivedTime.Add(maxClockSkew)) {
+		return utils.LavaFormatError("provider reply timestamp is out of the allowed clock skew", ProviderReplyTimestampError,
+			utils.Attribute{Key: "replyTimestamp", Value: replyTime}, utils.Attribute{Key: "relaySentTime", Value: relaySentTime},
+			utils.Attribute{Key: "replyReceivedTime", Value: replyReceivedTime}, utils.Attribute{Key: "maxClockSkew", Value: maxClockSkew},
+			utils.Attribute{Key: "Provider", Value: providerAddr})
+	}
 	return nil
 }
 
@@ -58,7 +102,11 @@ func VerifyFinalizationData(reply *pairingtypes.RelayReply, relayRequest *pairin
 	if err != nil {
 		return nil, nil, err
 	}
-	serverKey, err := sigs.RecoverPubKeyFromResponseFinalizationData(reply, relayRequest, strAdd)
+	recoverFinalizationDataSigner := sigs.RecoverPubKeyFromResponseFinalizationData
+	if lavasession.ProtocolVersionSupports(relayRequest.ProtocolVersion, lavasession.HashedFinalizationFeature) {
+		recoverFinalizationDataSigner = sigs.RecoverPubKeyFromResponseFinalizationDataHash
+	}
+	serverKey, err := recoverFinalizationDataSigner(reply, relayRequest, strAdd)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -0,0 +1,138 @@
+package lavaprotocol
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/protocol/lavasession"
+	"github.com/lavanet/lava/utils/sigs"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	spectypes "github.com/lavanet/lava/x/spec/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignedReplyTimestamp(t *testing.T) {
+	ctx := context.Background()
+	providerKey, providerAddress := sigs.GenerateFloatingKey()
+	consumerKey, consumerAddress := sigs.GenerateFloatingKey()
+	relaySession := &pairingtypes.RelaySession{SpecId: "LAV1", SessionId: 123, Epoch: 100, RelayNum: 1, CuSum: 10}
+	sig, err := sigs.SignRelay(consumerKey, *relaySession)
+	require.Nil(t, err)
+	relaySession.Sig = sig
+	relayRequest := pairingtypes.RelayRequest{RelaySession: relaySession, RelayData: NewRelayData(ctx, "GET", "stub_url", []byte("stub_data"), 10, "tendermintrpc"), ProtocolVersion: lavasession.ProtocolVersion}
+
+	relaySentTime := time.Now()
+	reply, err := SignRelayResponse(consumerAddress, relayRequest, providerKey, &pairingtypes.RelayReply{Data: []byte("stub_reply")}, false)
+	require.Nil(t, err)
+	require.NotZero(t, reply.Timestamp)
+	require.Nil(t, VerifyRelayReply(reply, &relayRequest, providerAddress.String()))
+	require.Nil(t, VerifyReplyTimestamp(reply, relaySentTime, time.Now(), DefaultReplyMaxClockSkew, providerAddress.String()))
+
+	// the timestamp is part of the signature, changing it doesn't verify as the provider
+	reply.Timestamp -= time.Minute.Milliseconds()
+	require.NotNil(t, VerifyRelayReply(reply, &relayRequest, providerAddress.String()))
+
+	// legacy consumers don't get a timestamp they can't verify
+	relayRequest.ProtocolVersion = 0
+	reply, err = SignRelayResponse(consumerAddress, relayRequest, providerKey, &pairingtypes.RelayReply{Data: []byte("stub_reply")}, false)
+	require.Nil(t, err)
+	require.Zero(t, reply.Timestamp)
+	require.Nil(t, VerifyRelayReply(reply, &relayRequest, providerAddress.String()))
+
+	// a provider leaving out the timestamp the version requires is rejected
+	relayRequest.ProtocolVersion = lavasession.ProtocolVersion
+	reply = &pairingtypes.RelayReply{Data: []byte("stub_reply")}
+	reply.Sig, err = sigs.SignRelayResponse(providerKey, reply, &relayRequest)
+	require.Nil(t, err)
+	err = VerifyRelayReply(reply, &relayRequest, providerAddress.String())
+	require.True(t, lavasession.ProtocolVersionMismatchError.Is(err))
+}
+
+func TestSignedFinalizationDataEarliestBlock(t *testing.T) {
+	ctx := context.Background()
+	providerKey, providerAddress := sigs.GenerateFloatingKey()
+	_, consumerAddress := sigs.GenerateFloatingKey()
+	relayRequest := pairingtypes.RelayRequest{
+		RelaySession: &pairingtypes.RelaySession{SpecId: "LAV1", SessionId: 123, Epoch: 100, RelayNum: 1, CuSum: 10},
+		RelayData:    NewRelayData(ctx, "GET", "stub_url", []byte("stub_data"), spectypes.EARLIEST_BLOCK, "tendermintrpc"),
+	}
+	reply, err := SignRelayResponse(consumerAddress, relayRequest, providerKey, &pairingtypes.RelayReply{Data: []byte("stub_reply"), LatestBlock: 1000, EarliestBlock: 200, FinalizedBlocksHashes: []byte("{}")}, true)
+	require.Nil(t, err)
+	// the earliest block replaced the magic block the reply was signed for
+	require.Equal(t, int64(200), relayRequest.RelayData.RequestBlock)
+	pubKey, err := sigs.RecoverPubKeyFromResponseFinalizationData(reply, &relayRequest, consumerAddress)
+	require.Nil(t, err)
+	require.Equal(t, providerAddress, sdk.AccAddress(pubKey.Address()))
+
+	// the earliest block is part of the finalization data signature
+	reply.EarliestBlock = 100
+	pubKey, err = sigs.RecoverPubKeyFromResponseFinalizationData(reply, &relayRequest, consumerAddress)
+	require.Nil(t, err)
+	require.NotEqual(t, providerAddress, sdk.AccAddress(pubKey.Address()))
+}
+
+func TestSignedFinalizationDataHash(t *testing.T) {
+	ctx := context.Background()
+	providerKey, providerAddress := sigs.GenerateFloatingKey()
+	_, consumerAddress := sigs.GenerateFloatingKey()
+	relayRequest := pairingtypes.RelayRequest{
+		RelaySession:    &pairingtypes.RelaySession{SpecId: "LAV1", SessionId: 123, Epoch: 100, RelayNum: 1, CuSum: 10},
+		RelayData:       NewRelayData(ctx, "GET", "stub_url", []byte("stub_data"), 10, "tendermintrpc"),
+		ProtocolVersion: lavasession.ProtocolVersion,
+	}
+	finalizedBlocksHashes := []byte(`{"990":"hash990","991":"hash991"}`)
+	reply, err := SignRelayResponse(consumerAddress, relayRequest, providerKey, &pairingtypes.RelayReply{Data: []byte("stub_reply"), LatestBlock: 1000, FinalizedBlocksHashes: finalizedBlocksHashes}, true)
+	require.Nil(t, err)
+	pubKey, err := sigs.RecoverPubKeyFromResponseFinalizationDataHash(reply, &relayRequest, consumerAddress)
+	require.Nil(t, err)
+	require.Equal(t, providerAddress, sdk.AccAddress(pubKey.Address()))
+
+	// the hashes past the first 32 bytes of the finalization data are signed too
+	reply.FinalizedBlocksHashes = []byte(`{"990":"hash990","991":"forged"}`)
+	pubKey, err = sigs.RecoverPubKeyFromResponseFinalizationDataHash(reply, &relayRequest, consumerAddress)
+	require.Nil(t, err)
+	require.NotEqual(t, providerAddress, sdk.AccAddress(pubKey.Address()))
+
+	// legacy consumers still get the finalization data signed unhashed
+	relayRequest.ProtocolVersion = 0
+	reply, err = SignRelayResponse(consumerAddress, relayRequest, providerKey, &pairingtypes.RelayReply{Data: []byte("stub_reply"), LatestBlock: 1000, FinalizedBlocksHashes: finalizedBlocksHashes}, true)
+	require.Nil(t, err)
+	pubKey, err = sigs.RecoverPubKeyFromResponseFinalizationData(reply, &relayRequest, consumerAddress)
+	require.Nil(t, err)
+	require.Equal(t, providerAddress, sdk.AccAddress(pubKey.Address()))
+}
+
+func TestVerifyReplyTimestamp(t *testing.T) {
+	relaySentTime := time.Now()
+	replyReceivedTime := relaySentTime.Add(100 * time.Millisecond)
+	maxClockSkew := time.Second
+	for _, tt := range []struct {
+		name      string
+		timestamp time.Time
+		skew      time.Duration
+		valid     bool
+	}{
+		{name: "in flight", timestamp: relaySentTime.Add(50 * time.Millisecond), skew: maxClockSkew, valid: true},
+		{name: "early within skew", timestamp: relaySentTime.Add(-500 * time.Millisecond), skew: maxClockSkew, valid: true},
+		{name: "late within skew", timestamp: replyReceivedTime.Add(500 * time.Millisecond), skew: maxClockSkew, valid: true},
+		{name: "before the relay was sent", timestamp: relaySentTime.Add(-2 * time.Second), skew: maxClockSkew, valid: false},
+		{name: "after the reply was received", timestamp: replyReceivedTime.Add(2 * time.Second), skew: maxClockSkew, valid: false},
+		{name: "check disabled", timestamp: relaySentTime.Add(-time.Hour), skew: 0, valid: true},
+		{name: "no timestamp", timestamp: time.Time{}, skew: maxClockSkew, valid: true},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			reply := &pairingtypes.RelayReply{}
+			if !tt.timestamp.IsZero() {
+				reply.Timestamp = tt.timestamp.UnixMilli()
+			}
+			err := VerifyReplyTimestamp(reply, relaySentTime, replyReceivedTime, tt.skew, "provider")
+			if tt.valid {
+				require.Nil(t, err)
+			} else {
+				require.True(t, ProviderReplyTimestampError.Is(err))
+			}
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/lavanet/lava/protocol/lavasession"
 	"github.com/lavanet/lava/utils/sigs"
 	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	spectypes "github.com/lavanet/lava/x/spec/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -28,7 +29,7 @@ func TestSignAndExtract(t *testing.T) {
 		ConsecutiveNumberOfFailures: 0,     // number of times this session has failed
 	}
 	relayRequestData := NewRelayData(ctx, "GET", "stub_url", []byte("stub_data"), 10, "tendermintrpc")
-	relay, err := ConstructRelayRequest(ctx, sk, "lava", specId, relayRequestData, "lava@stubProviderAddress", singleConsumerSession, epoch, []byte("stubbytes"))
+	relay, err := ConstructRelayRequest(ctx, NewLocalSigner(sk), "lava", specId, relayRequestData, "lava@stubProviderAddress", singleConsumerSession, epoch, []byte("stubbytes"), "stub_api")
 	require.Nil(t, err)
 
 	// check signature
@@ -36,3 +37,74 @@ func TestSignAndExtract(t *testing.T) {
 	require.Nil(t, err)
 	require.Equal(t, extractedConsumerAddress, address)
 }
+
+func TestReplaceRequestedBlock(t *testing.T) {
+	latestBlock := int64(1000)
+	for _, tt := range []struct {
+		name           string
+		requestedBlock int64
+		earliestBlock  int64
+		expected       int64
+	}{
+		{name: "latest", requestedBlock: spectypes.LATEST_BLOCK, earliestBlock: 100, expected: latestBlock},
+		{name: "earliest", requestedBlock: spectypes.EARLIEST_BLOCK, earliestBlock: 100, expected: 100},
+		{name: "earliest not advertised", requestedBlock: spectypes.EARLIEST_BLOCK, earliestBlock: 0, expected: spectypes.NOT_APPLICABLE},
+		{name: "specific block", requestedBlock: 500, earliestBlock: 100, expected: 500},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, ReplaceRequestedBlock(tt.requestedBlock, latestBlock, tt.earliestBlock))
+		})
+	}
+}
+
+func TestConstructRelayRequestProtocolVersion(t *testing.T) {
+	ctx := context.Background()
+	sk, _ := sigs.GenerateFloatingKey()
+	for _, tt := range []struct {
+		name              string
+		negotiatedVersion uint32
+		requestVersion    uint32
+		apiName           string
+	}{
+		{name: "not probed", negotiatedVersion: 0, requestVersion: 0, apiName: ""},
+		{name: "legacy provider", negotiatedVersion: lavasession.LegacyProtocolVersion, requestVersion: 0, apiName: ""},
+		{name: "provider without api names", negotiatedVersion: 3, requestVersion: 3, apiName: ""},
+		{name: "current provider", negotiatedVersion: lavasession.ProtocolVersion, requestVersion: lavasession.ProtocolVersion, apiName: "stub_api"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			singleConsumerSession := &lavasession.SingleConsumerSession{
+				QoSInfo:  lavasession.QoSReport{LastQoSReport: &pairingtypes.QualityOfServiceReport{}},
+				Client:   &lavasession.ConsumerSessionsWithProvider{ProtocolVersion: tt.negotiatedVersion},
+				RelayNum: 1,
+			}
+			relayRequestData := NewRelayData(ctx, "GET", "stub_url", []byte("stub_data"), 10, "tendermintrpc")
+			relay, err := ConstructRelayRequest(ctx, NewLocalSigner(sk), "lava", "LAV1", relayRequestData, "lava@stubProviderAddress", singleConsumerSession, 100, nil, "stub_api")
+			require.Nil(t, err)
+			require.Equal(t, tt.requestVersion, relay.ProtocolVersion)
+			require.Equal(t, tt.apiName, relay.RelaySession.ApiName)
+		})
+	}
+}
+
+func TestVerifyReliabilityResultsIgnorePaths(t *testing.T) {
+	result := func(provider string, data string) *RelayResult {
+		return &RelayResult{ProviderAddress: provider, Reply: &pairingtypes.RelayReply{Data: []byte(data)}, Request: &pairingtypes.RelayRequest{}}
+	}
+	serviceApi := &spectypes.ServiceApi{Name: "net_info", ResponseIgnorePaths: []string{"result.n_peers"}}
+	original := result("provider1", `{"result":{"n_peers":"2","listening":true}}`)
+
+	conflict, _ := VerifyReliabilityResults(original, []*RelayResult{result("provider2", `{"result":{"n_peers":"5","listening":true}}`)}, 1, serviceApi)
+	require.False(t, conflict)
+	conflict, conflicts := VerifyReliabilityResults(original, []*RelayResult{result("provider2", `{"result":{"n_peers":"2","listening":false}}`)}, 1, serviceApi)
+	require.True(t, conflict)
+	require.Len(t, conflicts, 1)
+
+	// the majority isn't split by the ignored fields
+	majority := MajorityRelayResult([]*RelayResult{
+		result("provider1", `{"result":{"n_peers":"2","listening":false}}`),
+		result("provider2", `{"result":{"n_peers":"3","listening":true}}`),
+		result("provider3", `{"result":{"n_peers":"4","listening":true}}`),
+	}, serviceApi)
+	require.Equal(t, "provider3", majority.ProviderAddress)
+	require.Nil(t, MajorityRelayResult(nil, serviceApi))
+}
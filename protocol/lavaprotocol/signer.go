@@ -0,0 +1,131 @@
+package lavaprotocol
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/utils"
+	"github.com/lavanet/lava/utils/sigs"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+)
+
+const (
+	SignerFlagName              = "signer"
+	RemoteSignerAddressFlagName = "remote-signer-address"
+	LocalSignerBackend          = "local"   // the key is exported from the keyring and kept in memory
+	KeyringSignerBackend        = "keyring" // every signature is made by the keyring, the key is never exported
+	RemoteSignerBackend         = "remote"  // signatures are requested from a RelaySigner grpc service
+	signatureLength             = 64        // r and s, without the recovery code
+)
+
+// Signer signs the relays of a consumer. signatures are compact and recoverable secp256k1 signatures over the sha256 of the data,
+// the same as btcec.SignCompact produces, since providers and the chain recover the consumer from them
+type Signer interface {
+	Sign(ctx context.Context, data []byte) ([]byte, error)
+	PubKey() *btcec.PublicKey
+}
+
+func SignerAddress(signer Signer) sdk.AccAddress {
+	return sdk.AccAddress(secp256k1.PubKey(signer.PubKey().SerializeCompressed()).Address())
+}
+
+// NewSigner creates the signer for the backend chosen with the signer flag, local and keyring sign with keyName
+func NewSigner(ctx context.Context, clientCtx client.Context, keyName string, backend string, remoteSignerAddress string) (Signer, error) {
+	switch backend {
+	case LocalSignerBackend:
+		privKey, err := sigs.GetPrivKey(clientCtx, keyName)
+		if err != nil {
+			return nil, err
+		}
+		return NewLocalSigner(privKey), nil
+	case KeyringSignerBackend:
+		return NewKeyringSigner(clientCtx.Keyring, keyName)
+	case RemoteSignerBackend:
+		return NewRemoteSigner(ctx, remoteSignerAddress)
+	}
+	return nil, fmt.Errorf("invalid signer %q, expected one of: %s, %s, %s", backend, LocalSignerBackend, KeyringSignerBackend, RemoteSignerBackend)
+}
+
+type LocalSigner struct {
+	privKey *btcec.PrivateKey
+}
+
+func NewLocalSigner(privKey *btcec.PrivateKey) *LocalSigner {
+	return &LocalSigner{privKey: privKey}
+}
+
+func (ls *LocalSigner) Sign(ctx context.Context, data []byte) ([]byte, error) {
+	return btcec.SignCompact(btcec.S256(), ls.privKey, sigs.HashMsg(data), false)
+}
+
+func (ls *LocalSigner) PubKey() *btcec.PublicKey {
+	return ls.privKey.PubKey()
+}
+
+// KeyringSigner signs with a key of the cosmos keyring, such as the os keyring, without exporting it
+type KeyringSigner struct {
+	keyring keyring.Keyring
+	keyName string
+	pubKey  *btcec.PublicKey
+}
+
+func NewKeyringSigner(kr keyring.Keyring, keyName string) (*KeyringSigner, error) {
+	info, err := kr.Key(keyName)
+	if err != nil {
+		return nil, err
+	}
+	if info.GetPubKey().Type() != "secp256k1" {
+		return nil, utils.LavaFormatError("incompatible key algorithm for a keyring signer", nil, utils.Attribute{Key: "keyName", Value: keyName}, utils.Attribute{Key: "algo", Value: info.GetPubKey().Type()})
+	}
+	pubKey, err := btcec.ParsePubKey(info.GetPubKey().Bytes(), btcec.S256())
+	if err != nil {
+		return nil, err
+	}
+	return &KeyringSigner{keyring: kr, keyName: keyName, pubKey: pubKey}, nil
+}
+
+func (ks *KeyringSigner) Sign(ctx context.Context, data []byte) ([]byte, error) {
+	// the keyring hashes the data with sha256 and returns r and s
+	sig, _, err := ks.keyring.Sign(ks.keyName, data)
+	if err != nil {
+		return nil, err
+	}
+	return compactSignature(sig, sigs.HashMsg(data), ks.pubKey)
+}
+
+func (ks *KeyringSigner) PubKey() *btcec.PublicKey {
+	return ks.pubKey
+}
+
+// compactSignature prefixes an r and s signature with the recovery code that recovers pubKey
+func compactSignature(sig []byte, msgHash []byte, pubKey *btcec.PublicKey) ([]byte, error) {
+	if len(sig) != signatureLength {
+		return nil, utils.LavaFormatError("invalid signature length", nil, utils.Attribute{Key: "length", Value: len(sig)})
+	}
+	compactSig := make([]byte, 1+signatureLength)
+	copy(compactSig[1:], sig)
+	for recoveryCode := byte(0); recoveryCode < 4; recoveryCode++ {
+		compactSig[0] = 27 + recoveryCode // the code of an uncompressed key, like SignCompact with isCompressedKey false
+		recoveredPubKey, _, err := btcec.RecoverCompact(btcec.S256(), compactSig, msgHash)
+		if err == nil && recoveredPubKey.IsEqual(pubKey) {
+			return compactSig, nil
+		}
+	}
+	return nil, utils.LavaFormatError("signature doesn't recover the signer public key", nil)
+}
+
+// verifyCompactSignature checks a compact signature over data recovers pubKey
+func verifyCompactSignature(sig []byte, data []byte, pubKey *btcec.PublicKey) error {
+	recoveredPubKey, _, err := btcec.RecoverCompact(btcec.S256(), sig, sigs.HashMsg(data))
+	if err != nil {
+		return err
+	}
+	if !recoveredPubKey.IsEqual(pubKey) {
+		return utils.LavaFormatError("signature doesn't recover the signer public key", nil)
+	}
+	return nil
+}
@@ -0,0 +1,106 @@
+package lavaprotocol
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/utils/sigs"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+func requireSignsRelays(t *testing.T, signer Signer) {
+	ctx := context.Background()
+	relaySession := pairingtypes.RelaySession{SpecId: "LAV1", SessionId: 123, Epoch: 100, RelayNum: 1, CuSum: 10}
+	for i := 0; i < 10; i++ { // every recovery code shows up over a few signatures
+		relaySession.RelayNum++
+		sig, err := signer.Sign(ctx, sigs.DataToSignRelay(relaySession))
+		require.Nil(t, err)
+		relaySession.Sig = sig
+		extractedConsumerAddress, err := sigs.ExtractSignerAddress(&relaySession)
+		require.Nil(t, err)
+		require.Equal(t, SignerAddress(signer), extractedConsumerAddress)
+	}
+}
+
+func TestLocalSigner(t *testing.T) {
+	sk, address := sigs.GenerateFloatingKey()
+	signer := NewLocalSigner(sk)
+	require.Equal(t, address, SignerAddress(signer))
+	requireSignsRelays(t, signer)
+}
+
+func TestKeyringSigner(t *testing.T) {
+	kr := keyring.NewInMemory()
+	info, _, err := kr.NewMnemonic("consumer", keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	require.Nil(t, err)
+	signer, err := NewKeyringSigner(kr, "consumer")
+	require.Nil(t, err)
+	require.Equal(t, info.GetAddress(), SignerAddress(signer))
+	requireSignsRelays(t, signer)
+
+	_, err = NewKeyringSigner(kr, "missing")
+	require.NotNil(t, err)
+}
+
+// signs with one key and claims another
+type mismatchedSigner struct {
+	*LocalSigner
+	pubKey *btcec.PublicKey
+}
+
+func (ms *mismatchedSigner) PubKey() *btcec.PublicKey {
+	return ms.pubKey
+}
+
+func startRelaySignerServer(t *testing.T, signer Signer) string {
+	lis, err := net.Listen("tcp", "localhost:0")
+	require.Nil(t, err)
+	server := grpc.NewServer()
+	pairingtypes.RegisterRelaySignerServer(server, NewRelaySignerServer(signer))
+	go server.Serve(lis)
+	t.Cleanup(server.Stop)
+	return lis.Addr().String()
+}
+
+func TestRemoteSigner(t *testing.T) {
+	ctx := context.Background()
+	sk, address := sigs.GenerateFloatingKey()
+	signer, err := NewRemoteSigner(ctx, startRelaySignerServer(t, NewLocalSigner(sk)))
+	require.Nil(t, err)
+	defer signer.Close()
+	require.Equal(t, address, SignerAddress(signer))
+	requireSignsRelays(t, signer)
+
+	// signatures of a key other than the one the signer reported are rejected
+	otherSk, _ := sigs.GenerateFloatingKey()
+	signer, err = NewRemoteSigner(ctx, startRelaySignerServer(t, &mismatchedSigner{LocalSigner: NewLocalSigner(otherSk), pubKey: sk.PubKey()}))
+	require.Nil(t, err)
+	defer signer.Close()
+	_, err = signer.Sign(ctx, []byte("data"))
+	require.NotNil(t, err)
+}
+
+func TestCompactSignature(t *testing.T) {
+	sk, _ := sigs.GenerateFloatingKey()
+	msgHash := sigs.HashMsg([]byte("data"))
+	expectedSig, err := btcec.SignCompact(btcec.S256(), sk, msgHash, false)
+	require.Nil(t, err)
+
+	// an r and s signature, as returned by the keyring
+	sig, err := compactSignature(expectedSig[1:], msgHash, sk.PubKey())
+	require.Nil(t, err)
+	require.Equal(t, expectedSig, sig)
+
+	otherSk, _ := sigs.GenerateFloatingKey()
+	_, err = compactSignature(expectedSig[1:], msgHash, otherSk.PubKey())
+	require.NotNil(t, err)
+	_, err = compactSignature(expectedSig, msgHash, sk.PubKey())
+	require.NotNil(t, err)
+}
@@ -0,0 +1,36 @@
+package lavasession
+
+import (
+	"math/rand"
+	"time"
+)
+
+// Clock is the time source of the consumer's sessions and relay retries, tests replace it to control backoffs and latencies
+type Clock interface {
+	Now() time.Time
+	Since(t time.Time) time.Duration
+	Sleep(d time.Duration)
+}
+
+// Rand is the randomness source of provider selection, probe scattering and session ids
+type Rand interface {
+	Intn(n int) int
+	Int63() int64
+}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time                  { return time.Now() }
+func (systemClock) Since(t time.Time) time.Duration { return time.Since(t) }
+func (systemClock) Sleep(d time.Duration)           { time.Sleep(d) }
+
+// globalRand uses the global math/rand source, which is safe for concurrent use and seeded by the consumer on start
+type globalRand struct{}
+
+func (globalRand) Intn(n int) int { return rand.Intn(n) }
+func (globalRand) Int63() int64   { return rand.Int63() }
+
+var (
+	SystemClock Clock = systemClock{}
+	SystemRand  Rand  = globalRand{}
+)
@@ -10,6 +10,7 @@ import (
 
 const (
 	MaxConsecutiveConnectionAttempts                 = 10
+	MaxConsecutiveEndpointRelayFailures              = 5 // relays timing out or failing to connect in a row on an endpoint before it's disabled, when the provider has others
 	TimeoutForEstablishingAConnection                = 1 * time.Second
 	MaxSessionsAllowedPerProvider                    = 1000 // Max number of sessions allowed per provider
 	MaxAllowedBlockListedSessionPerProvider          = 3
@@ -23,7 +24,12 @@ const (
 	IndexNotFound                                    = -15
 	AverageWorldLatency                              = 300 * time.Millisecond
 	MinValidAddressesForBlockingProbing              = 2
+	EndpointLatencyDecay                             = 5 // a measured endpoint latency moves 1/EndpointLatencyDecay of the way to every new measurement
 	BACKOFF_TIME_ON_FAILURE                          = 3 * time.Second
+	DataReliabilityMinSamplingFactor                 = 0.25 // the share of the spec reliability threshold fully trusted providers are sampled with
+	DegradedDataReliabilitySamplingFactor            = 0.25 // reliability sampling is lowered further by this while the providers are degraded
+	DegradedRelayTimeoutFactor                       = 2    // relay timeouts are widened by this while the providers are degraded
+	DegradedMaxRelayRetries                          = 2    // relays are sent to at most this many providers while the providers are degraded
 )
 
 var AvailabilityPercentage sdk.Dec = sdk.NewDecWithPrec(5, 2) // TODO move to params pairing
@@ -40,3 +46,8 @@ func IsSessionSyncLoss(err error) bool {
 	code := status.Code(err)
 	return code == codes.Code(SessionOutOfSyncError.ABCICode())
 }
+
+// IsNodeResponseTooLarge returns whether the provider refused the relay because its node's response is over its size limit
+func IsNodeResponseTooLarge(err error) bool {
+	return status.Code(err) == codes.Code(NodeResponseTooLargeError.ABCICode())
+}
@@ -3,7 +3,7 @@ package lavasession
 import (
 	"context"
 	"encoding/json"
-	"math/rand"
+	"math"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -11,8 +11,10 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/gogo/status"
 	"github.com/lavanet/lava/utils"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	projectstypes "github.com/lavanet/lava/x/projects/types"
+	"golang.org/x/exp/slices"
 	"google.golang.org/grpc/codes"
-	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 // created with NewConsumerSessionManager
@@ -28,11 +30,23 @@ type ConsumerSessionManager struct {
 
 	validAddresses        []string            // contains all addresses that are currently valid
 	addedToPurgeAndReport map[string]struct{} // list of purged providers to report for QoS unavailability. (easier to search maps.)
+	quarantinedProviders  map[string]struct{} // providers excluded until the next epoch, kept out of validAddresses even when it is reset
 
 	// pairingPurge - contains all pairings that are unwanted this epoch, keeps them in memory in order to avoid release.
 	// (if a consumer session still uses one of them or we want to report it.)
 	pairingPurge      map[string]*ConsumerSessionsWithProvider
 	providerOptimizer ProviderOptimizer
+	qosMetrics        ProviderQoSMetrics // optional, set with SetProviderQoSMetrics
+	allowedApis       []string           // the apis the consumer's project allows, nil when they aren't restricted
+	addOns            []string           // the add-ons of the consumer's subscription
+	clock             Clock              // SystemClock unless set with SetClock
+	rand              Rand               // SystemRand unless set with SetRand
+	maxClockSkew      time.Duration      // providers whose probed clock is off by more are blocked, 0 disables it
+	usageStore        ConsumerUsageStore // optional, set with SetUsageStore
+	usageSaves        sync.WaitGroup     // usage of replaced pairings being saved, waited for before the consumer closes the store
+	degraded          uint32             // 1 while the optimizer judges the providers degraded, to log the changes
+	sessionWatchdog   sessionWatchdog    // reclaims sessions their callers never reported on, set with SetSessionIdleTimeout
+	providerTLS       *ProviderTLSConfig // providers are dialed over tls when set, with SetProviderTLS
 }
 
 func (csm *ConsumerSessionManager) RPCEndpoint() RPCEndpoint {
@@ -49,8 +63,8 @@ func (csm *ConsumerSessionManager) UpdateAllProviders(epoch uint64, pairingList
 	// }
 	defer func() {
 		// run this after done updating pairing
-		time.Sleep(time.Duration(rand.Intn(500)) * time.Millisecond) // sleep up to 500ms in order to scatter different chains probe triggers
-		go csm.probeProviders(pairingList, epoch)                    // probe providers to eliminate offline ones from affecting relays, pairingList is thread safe it's members are not (accessed through csm.pairing)
+		csm.clock.Sleep(time.Duration(csm.rand.Intn(500)) * time.Millisecond) // sleep up to 500ms in order to scatter different chains probe triggers
+		go csm.probeProviders(pairingList, epoch)                             // probe providers to eliminate offline ones from affecting relays, pairingList is thread safe it's members are not (accessed through csm.pairing)
 	}()
 	csm.lock.Lock()         // start by locking the class lock.
 	defer csm.lock.Unlock() // we defer here so in case we return an error it will unlock automatically.
@@ -65,17 +79,26 @@ func (csm *ConsumerSessionManager) UpdateAllProviders(epoch uint64, pairingList
 	// csm.validAddresses length is reset in setValidAddressesToDefaultValue
 	csm.pairingAddresses = make(map[uint64]string, 0)
 	csm.addedToPurgeAndReport = make(map[string]struct{}, 0)
+	csm.quarantinedProviders = make(map[string]struct{}, 0)
 	csm.pairingAddressesLength = uint64(pairingListLength)
 	csm.numberOfResets = 0
 
 	// Reset the pairingPurge.
 	// This happens only after an entire epoch. so its impossible to have session connected to the old purged list
 	csm.closePurgedUnusedPairingsConnections() // this must be before updating csm.pairingPurge as we want to close the connections of older sessions (prev 2 epochs)
+	// relays of the replaced pairings can still be in flight, the purged one is saved again with them
+	usage := append(csm.pairingUsage(csm.pairingPurge), csm.pairingUsage(csm.pairing)...)
+	csm.usageSaves.Add(1)
+	go func() {
+		defer csm.usageSaves.Done()
+		csm.saveUsage(usage)
+	}()
 	csm.pairingPurge = csm.pairing
 	csm.pairing = make(map[string]*ConsumerSessionsWithProvider, pairingListLength)
 	for idx, provider := range pairingList {
 		csm.pairingAddresses[idx] = provider.PublicLavaAddress
 		csm.pairing[provider.PublicLavaAddress] = provider
+		provider.providerTLS = csm.providerTLS
 	}
 	csm.setValidAddressesToDefaultValue() // the starting point is that valid addresses are equal to pairing addresses.
 	utils.LavaFormatDebug("updated providers", utils.Attribute{Key: "epoch", Value: epoch}, utils.Attribute{Key: "spec", Value: csm.rpcEndpoint.Key()})
@@ -106,50 +129,106 @@ func (csm *ConsumerSessionManager) probeProviders(pairingList map[uint64]*Consum
 	guid := utils.GenerateUniqueIdentifier()
 	ctx = utils.AppendUniqueIdentifier(ctx, guid)
 	utils.LavaFormatInfo("providers probe initiated", utils.Attribute{Key: "endpoint", Value: csm.rpcEndpoint}, utils.Attribute{Key: "GUID", Value: ctx}, utils.Attribute{Key: "epoch", Value: epoch})
+	clockSkews := map[string]time.Duration{}
+	latestBlocks := map[string]int64{}
+	maxLatestBlock := int64(0)
 	for _, consumerSessionWithProvider := range pairingList {
 		// consumerSessionWithProvider is thread safe since it's unreachable yet on other threads
-		latency, providerAddress, err := csm.probeProvider(ctx, consumerSessionWithProvider, epoch)
+		latency, providerAddress, probeReply, clockSkew, err := csm.probeProvider(ctx, consumerSessionWithProvider, epoch)
 		failure := err != nil // if failure then regard it in availability
 		csm.providerOptimizer.AppendRelayData(providerAddress, latency, failure)
+		if failure || probeReply == nil {
+			continue
+		}
+		clockSkews[providerAddress] = clockSkew
+		// legacy providers and providers that don't know their node's block report 0, they aren't counted as lagging
+		if probeReply.LatestBlock > 0 {
+			latestBlocks[providerAddress] = probeReply.LatestBlock
+			if probeReply.LatestBlock > maxLatestBlock {
+				maxLatestBlock = probeReply.LatestBlock
+			}
+		}
+	}
+	for providerAddress, clockSkew := range clockSkews {
+		blockLag := int64(0)
+		if latestBlock, ok := latestBlocks[providerAddress]; ok {
+			blockLag = maxLatestBlock - latestBlock
+		}
+		csm.providerOptimizer.AppendProbeData(providerAddress, clockSkew, blockLag)
 	}
 }
 
-func (csm *ConsumerSessionManager) probeProvider(ctx context.Context, consumerSessionsWithProvider *ConsumerSessionsWithProvider, epoch uint64) (latency time.Duration, providerAddress string, err error) {
+func (csm *ConsumerSessionManager) probeProvider(ctx context.Context, consumerSessionsWithProvider *ConsumerSessionsWithProvider, epoch uint64) (latency time.Duration, providerAddress string, probeReply *pairingtypes.ProbeReply, clockSkew time.Duration, err error) {
 	// TODO: fetch all endpoints not just one
-	connected, endpoint, providerAddress, err := consumerSessionsWithProvider.fetchEndpointConnectionFromConsumerSessionWithProvider(ctx)
+	connected, endpoint, providerAddress, err := consumerSessionsWithProvider.fetchEndpointConnectionFromConsumerSessionWithProvider(ctx, csm.rpcEndpoint.Geolocation)
 	if err != nil || !connected {
-		return 0, providerAddress, err
+		return 0, providerAddress, nil, 0, err
 	}
 	if endpoint.Client == nil {
 		consumerSessionsWithProvider.Lock.Lock()
 		defer consumerSessionsWithProvider.Lock.Unlock()
-		return 0, providerAddress, utils.LavaFormatError("returned nil client in endpoint", nil, utils.Attribute{Key: "consumerSessionWithProvider", Value: consumerSessionsWithProvider})
+		return 0, providerAddress, nil, 0, utils.LavaFormatError("returned nil client in endpoint", nil, utils.Attribute{Key: "consumerSessionWithProvider", Value: consumerSessionsWithProvider})
 	}
-	relaySentTime := time.Now()
+	relaySentTime := csm.clock.Now()
 	connectCtx, cancel := context.WithTimeout(ctx, AverageWorldLatency)
 	defer cancel()
 	guid, found := utils.GetUniqueIdentifier(connectCtx)
 	if !found {
-		return 0, providerAddress, utils.LavaFormatError("probeProvider failed fetching unique identifier from context when it's set", nil)
+		return 0, providerAddress, nil, 0, utils.LavaFormatError("probeProvider failed fetching unique identifier from context when it's set", nil)
 	}
-	probeResp, err := (*endpoint.Client).Probe(ctx, &wrapperspb.UInt64Value{Value: guid})
-	relayLatency := time.Since(relaySentTime)
+	probeReq := &pairingtypes.ProbeRequest{Guid: guid, ProtocolVersion: ProtocolVersion, SpecId: csm.rpcEndpoint.ChainID, ApiInterface: csm.rpcEndpoint.ApiInterface}
+	probeResp, err := (*endpoint.Client).Probe(ctx, probeReq)
+	relayLatency := csm.clock.Since(relaySentTime)
 	if err != nil {
-		return 0, providerAddress, utils.LavaFormatError("probe call error", err, utils.Attribute{Key: "provider", Value: providerAddress})
+		return 0, providerAddress, nil, 0, utils.LavaFormatRepeatedError("probe call error", err, utils.Attribute{Key: "provider", Value: providerAddress})
 	}
-	if probeResp.Value != guid {
-		return 0, providerAddress, utils.LavaFormatWarning("mismatch probe response", nil)
+	if probeResp.Guid != guid {
+		return 0, providerAddress, nil, 0, utils.LavaFormatWarning("mismatch probe response", nil)
+	}
+	protocolVersion, err := NegotiateProtocolVersion(probeResp.ProtocolVersion)
+	if err != nil {
+		return 0, providerAddress, nil, 0, utils.LavaFormatWarning("no common protocol version with provider", err, utils.Attribute{Key: "provider", Value: providerAddress})
 	}
-	utils.LavaFormatDebug("Probed provider successfully", utils.Attribute{Key: "latency", Value: relayLatency}, utils.Attribute{Key: "provider", Value: consumerSessionsWithProvider.PublicLavaAddress})
-	return relayLatency, providerAddress, nil
+	consumerSessionsWithProvider.setProtocolVersion(protocolVersion)
+	consumerSessionsWithProvider.recordEndpointLatency(endpoint, relayLatency)
+	clockSkew = csm.checkProbeClockSkew(providerAddress, epoch, relaySentTime, relayLatency, probeResp.Timestamp)
+	utils.LavaFormatDebug("Probed provider successfully", utils.Attribute{Key: "latency", Value: relayLatency}, utils.Attribute{Key: "provider", Value: consumerSessionsWithProvider.PublicLavaAddress}, utils.Attribute{Key: "geolocation", Value: endpoint.Geolocation}, utils.Attribute{Key: "clockSkew", Value: clockSkew}, utils.Attribute{Key: "latestBlock", Value: probeResp.LatestBlock})
+	return relayLatency, providerAddress, probeResp, clockSkew, nil
+}
+
+// checkProbeClockSkew returns how far the provider's clock is from ours, assuming it replied halfway through the probe.
+// the timestamps the provider signs on replies can't be trusted when it's off by more than the max clock skew, so it's
+// quarantined until the next epoch. legacy providers don't send their time and are reported with no skew
+func (csm *ConsumerSessionManager) checkProbeClockSkew(providerAddress string, epoch uint64, relaySentTime time.Time, latency time.Duration, providerTimestamp int64) time.Duration {
+	if providerTimestamp == 0 {
+		return 0
+	}
+	clockSkew := time.UnixMilli(providerTimestamp).Sub(relaySentTime.Add(latency / 2))
+	if csm.maxClockSkew == 0 {
+		return clockSkew
+	}
+	// the provider replied sometime during the probe, it's only blocked when it's off no matter when
+	absClockSkew := clockSkew
+	if absClockSkew < 0 {
+		absClockSkew = -absClockSkew
+	}
+	if absClockSkew-latency/2 > csm.maxClockSkew {
+		utils.LavaFormatWarning("provider clock skew exceeds the allowed bound, blocking it until the next epoch", nil, utils.Attribute{Key: "provider", Value: providerAddress}, utils.Attribute{Key: "clockSkew", Value: clockSkew}, utils.Attribute{Key: "maxClockSkew", Value: csm.maxClockSkew})
+		err := csm.QuarantineProviders([]string{providerAddress}, epoch)
+		if err != nil && !EpochMismatchError.Is(err) {
+			utils.LavaFormatError("failed blocking provider with a skewed clock", err, utils.Attribute{Key: "provider", Value: providerAddress})
+		}
+	}
+	return clockSkew
 }
 
 func (csm *ConsumerSessionManager) setValidAddressesToDefaultValue() {
-	csm.validAddresses = make([]string, len(csm.pairingAddresses))
-	index := 0
+	csm.validAddresses = make([]string, 0, len(csm.pairingAddresses))
 	for _, provider := range csm.pairingAddresses {
-		csm.validAddresses[index] = provider
-		index++
+		if _, ok := csm.quarantinedProviders[provider]; ok {
+			continue
+		}
+		csm.validAddresses = append(csm.validAddresses, provider)
 	}
 }
 
@@ -202,6 +281,7 @@ func (csm *ConsumerSessionManager) GetSession(ctx context.Context, cuNeededForSe
 	consumerSession *SingleConsumerSession, epoch uint64, providerPublicAddress string, reportedProviders []byte, errRet error,
 ) {
 	numberOfResets := csm.validatePairingListNotEmpty() // if pairing list is empty we reset the state.
+	csm.reclaimIdleSessions()
 
 	if initUnwantedProviders == nil { // verify initUnwantedProviders is not nil
 		initUnwantedProviders = make(map[string]struct{})
@@ -229,7 +309,7 @@ func (csm *ConsumerSessionManager) GetSession(ctx context.Context, cuNeededForSe
 		}
 
 		// Get a valid Endpoint from the provider chosen
-		connected, endpoint, _, err := consumerSessionsWithProvider.fetchEndpointConnectionFromConsumerSessionWithProvider(ctx)
+		connected, endpoint, _, err := consumerSessionsWithProvider.fetchEndpointConnectionFromConsumerSessionWithProvider(ctx, csm.rpcEndpoint.Geolocation)
 		if err != nil {
 			// verify err is AllProviderEndpointsDisabled and report.
 			if AllProviderEndpointsDisabledError.Is(err) {
@@ -259,17 +339,19 @@ func (csm *ConsumerSessionManager) GetSession(ctx context.Context, cuNeededForSe
 		}
 
 		// Get session from endpoint or create new or continue. if more than 10 connections are open.
-		consumerSession, pairingEpoch, err := consumerSessionsWithProvider.getConsumerSessionInstanceFromEndpoint(endpoint, numberOfResets)
+		consumerSession, pairingEpoch, err := consumerSessionsWithProvider.getConsumerSessionInstanceFromEndpoint(endpoint, numberOfResets, csm.rand)
 		if err != nil {
 			utils.LavaFormatDebug("Error on consumerSessionWithProvider.getConsumerSessionInstanceFromEndpoint", utils.Attribute{Key: "Error", Value: err.Error()})
 			if MaximumNumberOfSessionsExceededError.Is(err) {
 				// we can get a different provider, adding this provider to the list of providers to skip on.
 				tempIgnoredProviders.providers[providerAddress] = struct{}{}
 			} else if MaximumNumberOfBlockListedSessionsError.Is(err) {
-				// provider has too many block listed sessions. we block it until the next epoch.
-				err = csm.blockProvider(providerAddress, false, sessionEpoch)
-				if err != nil {
-					return nil, 0, "", nil, err
+				// the endpoint has too many block listed sessions. we block it, or the provider when it has no other endpoint, until the next epoch.
+				if !consumerSessionsWithProvider.blockEndpoint(endpoint) {
+					err = csm.blockProvider(providerAddress, false, sessionEpoch)
+					if err != nil {
+						return nil, 0, "", nil, err
+					}
 				}
 			} else {
 				utils.LavaFormatFatal("Unsupported Error", err)
@@ -299,6 +381,8 @@ func (csm *ConsumerSessionManager) GetSession(ctx context.Context, cuNeededForSe
 			// consumer session is locked and valid, we need to set the relayNumber and the relay cu. before returning.
 			consumerSession.LatestRelayCu = cuNeededForSession // set latestRelayCu
 			consumerSession.RelayNum += RelayNumberIncrement   // increase relayNum
+			consumerSession.recordSignedCu()
+			csm.sessionWatchdog.watch(consumerSession, cuNeededForSession, csm.clock.Now())
 			// Successfully created/got a consumerSession.
 			return consumerSession, sessionEpoch, providerAddress, reportedProviders, nil
 		}
@@ -317,8 +401,8 @@ func (csm *ConsumerSessionManager) getValidProviderAddress(ignoredProvidersList
 		err = PairingListEmptyError
 		return
 	}
-	validAddressIndex := rand.Intn(totalValidLength) // get the N'th valid provider index, only valid providers will increase the addressIndex counter
-	validAddressesCounter := 0                       // this counter will try to reach the addressIndex
+	validAddressIndex := csm.rand.Intn(totalValidLength) // get the N'th valid provider index, only valid providers will increase the addressIndex counter
+	validAddressesCounter := 0                           // this counter will try to reach the addressIndex
 	for index := 0; index < validAddressesLength; index++ {
 		if _, ok := ignoredProvidersList[csm.validAddresses[index]]; !ok { // not ignored -> yes valid
 			if validAddressesCounter == validAddressIndex {
@@ -342,7 +426,7 @@ func (csm *ConsumerSessionManager) getValidConsumerSessionsWithProvider(ignoredP
 
 	providerAddress, err = csm.getValidProviderAddress(ignoredProviders.providers)
 	if err != nil {
-		utils.LavaFormatError("could not get a provider address", err)
+		utils.LavaFormatRepeatedError("could not get a provider address", err)
 		return nil, "", 0, err
 	}
 	consumerSessionsWithProvider = csm.pairing[providerAddress]
@@ -352,6 +436,31 @@ func (csm *ConsumerSessionManager) getValidConsumerSessionsWithProvider(ignoredP
 	return
 }
 
+// IsPairedProvider returns whether address is a provider of the current pairing
+func (csm *ConsumerSessionManager) IsPairedProvider(address string) bool {
+	csm.lock.RLock()
+	defer csm.lock.RUnlock()
+	_, ok := csm.pairing[address]
+	return ok
+}
+
+// returns every provider in the current pairing except the given one, used to force a relay to a specific provider.
+// returns ProviderNotInPairingError if the provider is not part of the current pairing
+func (csm *ConsumerSessionManager) GetAllProvidersExcept(address string) (unwantedProviders map[string]struct{}, err error) {
+	csm.lock.RLock()
+	defer csm.lock.RUnlock()
+	if _, ok := csm.pairing[address]; !ok {
+		return nil, ProviderNotInPairingError
+	}
+	unwantedProviders = make(map[string]struct{}, len(csm.pairing))
+	for providerAddress := range csm.pairing {
+		if providerAddress != address {
+			unwantedProviders[providerAddress] = struct{}{}
+		}
+	}
+	return unwantedProviders, nil
+}
+
 // removes a given address from the valid addresses list.
 func (csm *ConsumerSessionManager) removeAddressFromValidAddresses(address string) error {
 	// cs Must be Locked here.
@@ -399,6 +508,53 @@ func (csm *ConsumerSessionManager) blockProvider(address string, reportProvider
 	return nil
 }
 
+// QuarantineProviders blocks the given providers until the next epoch, unlike blockProvider they stay blocked when the valid addresses are reset.
+// used for providers caught on a minority fork, returns EpochMismatchError if the pairing changed since sessionEpoch
+func (csm *ConsumerSessionManager) QuarantineProviders(addresses []string, sessionEpoch uint64) error {
+	if sessionEpoch != csm.atomicReadCurrentEpoch() {
+		return EpochMismatchError
+	}
+
+	csm.lock.Lock()
+	defer csm.lock.Unlock()
+	if sessionEpoch != csm.atomicReadCurrentEpoch() { // verify again after locking, the epoch could have changed while we waited
+		return EpochMismatchError
+	}
+	if csm.quarantinedProviders == nil {
+		csm.quarantinedProviders = make(map[string]struct{}, 0)
+	}
+
+	for _, address := range addresses {
+		if _, ok := csm.quarantinedProviders[address]; ok {
+			continue
+		}
+		utils.LavaFormatInfo("Quarantining provider until the next epoch", utils.Attribute{Key: "Provider address", Value: address}, utils.Attribute{Key: "epoch", Value: sessionEpoch})
+		csm.quarantinedProviders[address] = struct{}{}
+		err := csm.removeAddressFromValidAddresses(address)
+		if err != nil && !AddressIndexWasNotFoundError.Is(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// QuarantineProvidersInCurrentEpoch quarantines the addresses that are part of the current pairing, for providers the chain took out of service mid epoch
+func (csm *ConsumerSessionManager) QuarantineProvidersInCurrentEpoch(addresses []string) error {
+	csm.lock.RLock()
+	pairedAddresses := []string{}
+	for _, address := range addresses {
+		if _, ok := csm.pairing[address]; ok {
+			pairedAddresses = append(pairedAddresses, address)
+		}
+	}
+	currentEpoch := csm.atomicReadCurrentEpoch()
+	csm.lock.RUnlock()
+	if len(pairedAddresses) == 0 {
+		return nil
+	}
+	return csm.QuarantineProviders(pairedAddresses, currentEpoch)
+}
+
 // Verify the consumerSession is locked when getting to this function, if its not locked throw an error
 func (csm *ConsumerSessionManager) verifyLock(consumerSession *SingleConsumerSession) error {
 	if consumerSession.lock.TryLock() { // verify.
@@ -415,6 +571,9 @@ func (csm *ConsumerSessionManager) OnSessionUnUsed(consumerSession *SingleConsum
 	if err := csm.verifyLock(consumerSession); err != nil {
 		return sdkerrors.Wrapf(err, "OnSessionUnUsed, consumerSession.lock must be locked before accessing this method, additional info:")
 	}
+	if err := csm.releaseWatchedSession(consumerSession); err != nil {
+		return err
+	}
 	cuToDecrease := consumerSession.LatestRelayCu
 	consumerSession.LatestRelayCu = 0                            // making sure no one uses it in a wrong way
 	parentConsumerSessionsWithProvider := consumerSession.Client // must read this pointer before unlocking
@@ -435,6 +594,9 @@ func (csm *ConsumerSessionManager) OnSessionFailure(consumerSession *SingleConsu
 	if err := csm.verifyLock(consumerSession); err != nil {
 		return sdkerrors.Wrapf(err, "OnSessionFailure, consumerSession.lock must be locked before accessing this method, additional info:")
 	}
+	if err := csm.releaseWatchedSession(consumerSession); err != nil {
+		return err
+	}
 
 	// consumer Session should be locked here. so we can just apply the session failure here.
 	if consumerSession.BlockListed {
@@ -443,7 +605,10 @@ func (csm *ConsumerSessionManager) OnSessionFailure(consumerSession *SingleConsu
 	}
 
 	consumerSession.QoSInfo.TotalRelays++
-	consumerSession.ConsecutiveNumberOfFailures += 1 // increase number of failures for this session
+	if !IsNodeResponseTooLarge(errorReceived) {
+		// a provider refusing a response over its size limit answered, its session isn't failing
+		consumerSession.ConsecutiveNumberOfFailures += 1 // increase number of failures for this session
+	}
 
 	// if this session failed more than MaximumNumberOfFailuresAllowedPerConsumerSession times or session went out of sync we block it.
 	var consumerSessionBlockListed bool
@@ -463,6 +628,7 @@ func (csm *ConsumerSessionManager) OnSessionFailure(consumerSession *SingleConsu
 	if err != nil {
 		return err
 	}
+	parentConsumerSessionsWithProvider.errorCounts.countRelayError(errorReceived)
 
 	// check if need to block & report
 	var blockProvider, reportProvider bool
@@ -473,17 +639,27 @@ func (csm *ConsumerSessionManager) OnSessionFailure(consumerSession *SingleConsu
 		blockProvider = true
 	}
 
+	// a provider with other endpoints keeps serving through them when one of its endpoints keeps failing
+	if isEndpointRelayError(errorReceived) && parentConsumerSessionsWithProvider.countEndpointRelayFailure(consumerSession.Endpoint) {
+		parentConsumerSessionsWithProvider.blockEndpoint(consumerSession.Endpoint)
+	}
+
 	// if BlockListed is true here meaning we had a ConsecutiveNumberOfFailures > MaximumNumberOfFailuresAllowedPerConsumerSession or out of sync
 	// we will check the total number of cu for this provider and decide if we need to report it.
 	if consumerSessionBlockListed {
-		if parentConsumerSessionsWithProvider.atomicReadUsedComputeUnits() == 0 { // if we had 0 successful relays and we reached block session we need to report this provider
+		// if we had 0 successful relays and we reached block session we need to block the endpoint, or report this provider when it was its last endpoint
+		if parentConsumerSessionsWithProvider.atomicReadUsedComputeUnits() == 0 && !parentConsumerSessionsWithProvider.blockEndpoint(consumerSession.Endpoint) {
 			blockProvider = true
 			reportProvider = true
 		}
 	}
 
+	publicProviderAddress, pairingEpoch := parentConsumerSessionsWithProvider.getPublicLavaAddressAndPairingEpoch()
+	csm.providerOptimizer.AppendRelayData(publicProviderAddress, 0, true)
+	if csm.qosMetrics != nil {
+		csm.qosMetrics.OnRelayFailure(csm.rpcEndpoint.ChainID, csm.rpcEndpoint.ApiInterface, publicProviderAddress)
+	}
 	if blockProvider {
-		publicProviderAddress, pairingEpoch := parentConsumerSessionsWithProvider.getPublicLavaAddressAndPairingEpoch()
 		err = csm.blockProvider(publicProviderAddress, reportProvider, pairingEpoch)
 		if err != nil {
 			if EpochMismatchError.Is(err) {
@@ -491,6 +667,9 @@ func (csm *ConsumerSessionManager) OnSessionFailure(consumerSession *SingleConsu
 			}
 			return err
 		}
+		if csm.qosMetrics != nil {
+			csm.qosMetrics.OnProviderBlocked(csm.rpcEndpoint.ChainID, csm.rpcEndpoint.ApiInterface, publicProviderAddress, reportProvider)
+		}
 	}
 	return nil
 }
@@ -512,8 +691,7 @@ func (csm *ConsumerSessionManager) OnDataReliabilitySessionDone(consumerSession
 	specComputeUnits uint64,
 	currentLatency time.Duration,
 	expectedLatency time.Duration,
-	expectedBH int64,
-	numOfProviders int,
+	blockLags BlockLagDistribution,
 	providersCount uint64,
 ) error {
 	if err := csm.verifyLock(consumerSession); err != nil {
@@ -523,7 +701,8 @@ func (csm *ConsumerSessionManager) OnDataReliabilitySessionDone(consumerSession
 	defer consumerSession.lock.Unlock()               // we need to be locked here, if we didn't get it locked we try lock anyway
 	consumerSession.ConsecutiveNumberOfFailures = 0   // reset failures.
 	consumerSession.LatestBlock = latestServicedBlock // update latest serviced block
-	consumerSession.CalculateQoS(specComputeUnits, currentLatency, expectedLatency, expectedBH-latestServicedBlock, numOfProviders, int64(providersCount))
+	syncScore := blockLags.SyncScore(latestServicedBlock, int64(providersCount))
+	consumerSession.CalculateQoS(specComputeUnits, currentLatency, expectedLatency, blockLags.ExpectedBlockHeight-latestServicedBlock, syncScore)
 	return nil
 }
 
@@ -535,14 +714,16 @@ func (csm *ConsumerSessionManager) OnSessionDone(
 	specComputeUnits uint64,
 	currentLatency time.Duration,
 	expectedLatency time.Duration,
-	expectedBH int64,
-	numOfProviders int,
+	blockLags BlockLagDistribution,
 	providersCount uint64,
 ) error {
 	// release locks, update CU, relaynum etc..
 	if err := csm.verifyLock(consumerSession); err != nil {
 		return sdkerrors.Wrapf(err, "OnSessionDone, consumerSession.lock must be locked before accessing this method")
 	}
+	if err := csm.releaseWatchedSession(consumerSession); err != nil {
+		return err
+	}
 
 	defer consumerSession.lock.Unlock()                    // we need to be locked here, if we didn't get it locked we try lock anyway
 	consumerSession.CuSum += consumerSession.LatestRelayCu // add CuSum to current cu usage.
@@ -550,22 +731,39 @@ func (csm *ConsumerSessionManager) OnSessionDone(
 	consumerSession.ConsecutiveNumberOfFailures = 0        // reset failures.
 	consumerSession.LatestBlock = latestServicedBlock      // update latest serviced block
 	// calculate QoS
-	consumerSession.CalculateQoS(specComputeUnits, currentLatency, expectedLatency, expectedBH-latestServicedBlock, numOfProviders, int64(providersCount))
+	syncScore := blockLags.SyncScore(latestServicedBlock, int64(providersCount))
+	consumerSession.CalculateQoS(specComputeUnits, currentLatency, expectedLatency, blockLags.ExpectedBlockHeight-latestServicedBlock, syncScore)
+	consumerSession.Client.recordEndpointLatency(consumerSession.Endpoint, currentLatency)
+	consumerSession.Client.resetEndpointRelayFailures(consumerSession.Endpoint)
+	providerAddress, _ := consumerSession.Client.getPublicLavaAddressAndPairingEpoch()
+	csm.providerOptimizer.AppendRelayData(providerAddress, currentLatency, false)
+	if blockLags.ExpectedBlockHeight > 0 {
+		csm.providerOptimizer.AppendSyncData(providerAddress, blockLags.ExpectedBlockHeight-latestServicedBlock)
+	}
+	if csm.qosMetrics != nil {
+		syncScoreFloat, _ := syncScore.Float64()
+		csm.qosMetrics.OnRelayDone(csm.rpcEndpoint.ChainID, csm.rpcEndpoint.ApiInterface, providerAddress, consumerSession.Endpoint.Geolocation, currentLatency, syncScoreFloat)
+	}
 	return nil
 }
 
-// Get the reported providers currently stored in the session manager.
+// Get the reported providers currently stored in the session manager, with the failures counted for each this epoch.
 func (csm *ConsumerSessionManager) GetReportedProviders(epoch uint64) ([]byte, error) {
 	csm.lock.RLock()
 	defer csm.lock.RUnlock()
 	if epoch != csm.atomicReadCurrentEpoch() {
 		return []byte{}, nil // if epochs are not equal, we will return an empty list.
 	}
-	keys := make([]string, 0, len(csm.addedToPurgeAndReport))
-	for k := range csm.addedToPurgeAndReport {
-		keys = append(keys, k)
+	reportedProviders := make([]pairingtypes.UnresponsiveProvider, 0, len(csm.addedToPurgeAndReport))
+	for address := range csm.addedToPurgeAndReport {
+		reportedProvider := pairingtypes.UnresponsiveProvider{Address: address}
+		if consumerSessionsWithProvider, ok := csm.pairing[address]; ok {
+			// the failures with the provider back the report
+			reportedProvider.Errors = consumerSessionsWithProvider.Snapshot().Errors
+		}
+		reportedProviders = append(reportedProviders, reportedProvider)
 	}
-	bytes, err := json.Marshal(keys)
+	bytes, err := json.Marshal(reportedProviders)
 
 	return bytes, err
 }
@@ -598,7 +796,7 @@ func (csm *ConsumerSessionManager) fetchEndpointFromConsumerSessionsWithProvider
 	var connected bool
 	var providerAddress string
 	for idx := 0; idx < MaxConsecutiveConnectionAttempts; idx++ { // try to connect to the endpoint 3 times
-		connected, endpoint, providerAddress, err = consumerSessionsWithProvider.fetchEndpointConnectionFromConsumerSessionWithProvider(ctx)
+		connected, endpoint, providerAddress, err = consumerSessionsWithProvider.fetchEndpointConnectionFromConsumerSessionWithProvider(ctx, csm.rpcEndpoint.Geolocation)
 		if err != nil {
 			// verify err is AllProviderEndpointsDisabled and report.
 			if AllProviderEndpointsDisabledError.Is(err) {
@@ -628,6 +826,12 @@ func (csm *ConsumerSessionManager) fetchEndpointFromConsumerSessionsWithProvider
 	return endpoint, nil
 }
 
+// GetDataReliabilityProviderAddress returns the provider GetDataReliabilitySession would pick for index, without creating a session
+func (csm *ConsumerSessionManager) GetDataReliabilityProviderAddress(originalProviderAddress string, index int64) (providerAddress string, err error) {
+	_, providerAddress, _, err = csm.getDataReliabilityProviderIndex(originalProviderAddress, uint64(index))
+	return providerAddress, err
+}
+
 // Get a Data Reliability Session
 func (csm *ConsumerSessionManager) GetDataReliabilitySession(ctx context.Context, originalProviderAddress string, index int64, sessionEpoch uint64) (singleConsumerSession *SingleConsumerSession, providerAddress string, epoch uint64, err error) {
 	consumerSessionWithProvider, providerAddress, currentEpoch, err := csm.getDataReliabilityProviderIndex(originalProviderAddress, uint64(index))
@@ -672,6 +876,9 @@ func (csm *ConsumerSessionManager) OnSessionDoneIncreaseCUOnly(consumerSession *
 	if err := csm.verifyLock(consumerSession); err != nil {
 		return sdkerrors.Wrapf(err, "OnSessionDoneIncreaseRelayAndCu consumerSession.lock must be locked before accessing this method")
 	}
+	if err := csm.releaseWatchedSession(consumerSession); err != nil {
+		return err
+	}
 
 	defer consumerSession.lock.Unlock()                    // we need to be locked here, if we didn't get it locked we try lock anyway
 	consumerSession.CuSum += consumerSession.LatestRelayCu // add CuSum to current cu usage.
@@ -727,9 +934,171 @@ func (csm *ConsumerSessionManager) OnDataReliabilitySessionFailure(consumerSessi
 	return nil
 }
 
+// SetClock replaces the time source of probes and probe scattering, it must be called before the first pairing update
+func (csm *ConsumerSessionManager) SetClock(clock Clock) {
+	csm.clock = clock
+}
+
+// Clock returns the time source of the session manager, relay retries of its consumer use it as well
+func (csm *ConsumerSessionManager) Clock() Clock {
+	return csm.clock
+}
+
+// SetRand replaces the randomness source of provider selection, probe scattering and session ids,
+// it must be called before the first pairing update
+func (csm *ConsumerSessionManager) SetRand(rand Rand) {
+	csm.rand = rand
+}
+
+// SetProviderQoSMetrics reports the outcome of relays and provider blocks to qosMetrics
+func (csm *ConsumerSessionManager) SetProviderQoSMetrics(qosMetrics ProviderQoSMetrics) {
+	csm.qosMetrics = qosMetrics
+}
+
+// SetMaxClockSkew blocks providers whose clock is off from ours by more than maxClockSkew in probes until the next epoch,
+// 0 disables it. it must be called before the first pairing update
+func (csm *ConsumerSessionManager) SetMaxClockSkew(maxClockSkew time.Duration) {
+	csm.maxClockSkew = maxClockSkew
+}
+
+// SetUsageStore records the cu signed to the providers of every pairing in the store, for reconciling it with the relay payments they claim
+func (csm *ConsumerSessionManager) SetUsageStore(usageStore ConsumerUsageStore) {
+	csm.usageStore = usageStore
+}
+
+// SaveUsage records the cu signed to the providers of the current and the previous pairing, pairings are otherwise recorded
+// when they are replaced so this is called before the consumer stops. it returns once the saves of replaced pairings are done too,
+// so the store can be closed after it
+func (csm *ConsumerSessionManager) SaveUsage() {
+	csm.lock.RLock()
+	usage := append(csm.pairingUsage(csm.pairingPurge), csm.pairingUsage(csm.pairing)...)
+	csm.lock.RUnlock()
+	csm.saveUsage(usage)
+	csm.usageSaves.Wait()
+}
+
+func (csm *ConsumerSessionManager) pairingUsage(pairing map[string]*ConsumerSessionsWithProvider) []ConsumerUsageRecord {
+	if csm.usageStore == nil {
+		return nil
+	}
+	usage := []ConsumerUsageRecord{}
+	for _, cswp := range pairing {
+		signedCu := cswp.atomicReadSignedCu()
+		if signedCu == 0 {
+			continue
+		}
+		usage = append(usage, ConsumerUsageRecord{
+			Epoch:        cswp.PairingEpoch,
+			ChainID:      csm.rpcEndpoint.ChainID,
+			ApiInterface: csm.rpcEndpoint.ApiInterface,
+			Provider:     cswp.PublicLavaAddress,
+			SignedCu:     signedCu,
+		})
+	}
+	return usage
+}
+
+func (csm *ConsumerSessionManager) saveUsage(usage []ConsumerUsageRecord) {
+	for _, record := range usage {
+		err := csm.usageStore.SaveUsage(record)
+		if err != nil {
+			utils.LavaFormatWarning("failed saving signed cu of provider", err, utils.Attribute{Key: "provider", Value: record.Provider}, utils.Attribute{Key: "epoch", Value: record.Epoch})
+		}
+	}
+}
+
+// Degraded is whether most of the providers are failing or lagging behind the expected block height, relays then wait longer
+// for the providers and add less load to them until they recover
+func (csm *ConsumerSessionManager) Degraded() bool {
+	degraded := csm.providerOptimizer.Degraded()
+	var value uint32
+	if degraded {
+		value = 1
+	}
+	if atomic.SwapUint32(&csm.degraded, value) != value {
+		if degraded {
+			utils.LavaFormatWarning("providers degraded, widening relay timeouts and shedding retries and reliability relays", nil, utils.Attribute{Key: "endpoint", Value: csm.rpcEndpoint.Key()})
+		} else {
+			utils.LavaFormatInfo("providers recovered, relaying normally", utils.Attribute{Key: "endpoint", Value: csm.rpcEndpoint.Key()})
+		}
+	}
+	return degraded
+}
+
+// DataReliabilityThreshold lowers the spec threshold for providers the optimizer trusts, so long trusted providers are sampled
+// less and new or failing ones are sampled at the spec rate. providers verify reliability relays against the spec threshold,
+// so it's never raised above it
+func (csm *ConsumerSessionManager) DataReliabilityThreshold(providerAddress string, specThreshold uint32) uint32 {
+	trust := csm.providerOptimizer.TrustScore(providerAddress)
+	factor := 1 - trust*(1-DataReliabilityMinSamplingFactor)
+	if csm.Degraded() {
+		factor *= DegradedDataReliabilitySamplingFactor
+	}
+	threshold := uint32(float64(specThreshold) * factor)
+	if csm.qosMetrics != nil {
+		// a relay is sampled when its vrf value, uniform over uint32, is at most the threshold
+		samplingRate := (float64(threshold) + 1) / (float64(math.MaxUint32) + 1)
+		csm.qosMetrics.OnDataReliabilitySampling(csm.rpcEndpoint.ChainID, csm.rpcEndpoint.ApiInterface, providerAddress, samplingRate)
+	}
+	return threshold
+}
+
+// OnDataReliabilityConflict distrusts the providers of conflicting replies, they are sampled at the spec rate again
+func (csm *ConsumerSessionManager) OnDataReliabilityConflict(providerAddresses ...string) {
+	for _, providerAddress := range providerAddresses {
+		csm.providerOptimizer.AppendReliabilityConflict(providerAddress)
+	}
+}
+
+// SetAllowedApis sets the apis the policies of the consumer's project allow, relays of other apis aren't paid for
+func (csm *ConsumerSessionManager) SetAllowedApis(allowedApis []string) {
+	csm.lock.Lock()
+	defer csm.lock.Unlock()
+	csm.allowedApis = allowedApis
+}
+
+// IsApiAllowed returns whether the consumer's project allows relaying the api
+func (csm *ConsumerSessionManager) IsApiAllowed(apiName string, functionTag string) bool {
+	csm.lock.RLock()
+	defer csm.lock.RUnlock()
+	return projectstypes.IsApiAllowed(csm.allowedApis, apiName, functionTag)
+}
+
+// SetAddOns sets the add-ons of the consumer's subscription, relays of apis of other add-ons aren't paid for
+func (csm *ConsumerSessionManager) SetAddOns(addOns []string) {
+	csm.lock.Lock()
+	defer csm.lock.Unlock()
+	csm.addOns = addOns
+}
+
+// IsAddOnActive returns whether the consumer can relay the apis of the add-on, base apis have no add-on
+func (csm *ConsumerSessionManager) IsAddOnActive(addOn string) bool {
+	if addOn == "" {
+		return true
+	}
+	csm.lock.RLock()
+	defer csm.lock.RUnlock()
+	return slices.Contains(csm.addOns, addOn)
+}
+
+// GetProvidersWithoutAddOn returns the providers of the pairing that don't serve the add-on on any of their endpoints
+func (csm *ConsumerSessionManager) GetProvidersWithoutAddOn(addOn string) map[string]struct{} {
+	csm.lock.RLock()
+	defer csm.lock.RUnlock()
+	providersWithoutAddOn := map[string]struct{}{}
+	for providerAddress, consumerSessionsWithProvider := range csm.pairing {
+		if !slices.Contains(consumerSessionsWithProvider.AddOns, addOn) {
+			providersWithoutAddOn[providerAddress] = struct{}{}
+		}
+	}
+	return providersWithoutAddOn
+}
+
 func NewConsumerSessionManager(rpcEndpoint *RPCEndpoint, providerOptimizer ProviderOptimizer) *ConsumerSessionManager {
 	csm := ConsumerSessionManager{}
 	csm.rpcEndpoint = rpcEndpoint
 	csm.providerOptimizer = providerOptimizer
+	csm.clock = SystemClock
+	csm.rand = SystemRand
 	return &csm
 }
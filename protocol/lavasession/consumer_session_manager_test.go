@@ -2,21 +2,25 @@ package lavasession
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
+	"math"
 	"math/rand"
 	"net"
+	"path/filepath"
 	"strconv"
+	"sync"
 	"testing"
 	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/lavanet/lava/protocol/provideroptimizer"
 	"github.com/lavanet/lava/utils"
 	pairingtypes "github.com/lavanet/lava/x/pairing/types"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
-	"google.golang.org/protobuf/types/known/wrapperspb"
+	"google.golang.org/grpc/status"
 )
 
 const (
@@ -80,7 +84,7 @@ func TestHappyFlow(t *testing.T) {
 	require.NotNil(t, cs)
 	require.Equal(t, epoch, csm.currentEpoch)
 	require.Equal(t, cs.LatestRelayCu, uint64(cuForFirstRequest))
-	err = csm.OnSessionDone(cs, firstEpochHeight, servicedBlockNumber, cuForFirstRequest, time.Duration(time.Millisecond), cs.CalculateExpectedLatency(2*time.Duration(time.Millisecond)), (servicedBlockNumber - 1), numberOfProviders, numberOfProviders)
+	err = csm.OnSessionDone(cs, firstEpochHeight, servicedBlockNumber, cuForFirstRequest, time.Duration(time.Millisecond), cs.CalculateExpectedLatency(2*time.Duration(time.Millisecond)), syncedBlockLags(servicedBlockNumber-1, numberOfProviders), numberOfProviders)
 	require.Nil(t, err)
 	require.Equal(t, cs.CuSum, cuForFirstRequest)
 	require.Equal(t, cs.LatestRelayCu, latestRelayCuAfterDone)
@@ -103,7 +107,7 @@ func TestPairingReset(t *testing.T) {
 	require.NotNil(t, cs)
 	require.Equal(t, epoch, csm.currentEpoch)
 	require.Equal(t, cs.LatestRelayCu, uint64(cuForFirstRequest))
-	err = csm.OnSessionDone(cs, firstEpochHeight, servicedBlockNumber, cuForFirstRequest, time.Duration(time.Millisecond), cs.CalculateExpectedLatency(2*time.Duration(time.Millisecond)), (servicedBlockNumber - 1), numberOfProviders, numberOfProviders)
+	err = csm.OnSessionDone(cs, firstEpochHeight, servicedBlockNumber, cuForFirstRequest, time.Duration(time.Millisecond), cs.CalculateExpectedLatency(2*time.Duration(time.Millisecond)), syncedBlockLags(servicedBlockNumber-1, numberOfProviders), numberOfProviders)
 	require.Nil(t, err)
 	require.Equal(t, cs.CuSum, cuForFirstRequest)
 	require.Equal(t, cs.LatestRelayCu, latestRelayCuAfterDone)
@@ -177,7 +181,7 @@ func TestPairingResetWithMultipleFailures(t *testing.T) {
 	require.NotNil(t, cs)
 	require.Equal(t, epoch, csm.currentEpoch)
 	require.Equal(t, cs.LatestRelayCu, uint64(cuForFirstRequest))
-	err = csm.OnSessionDone(cs, firstEpochHeight, servicedBlockNumber, cuForFirstRequest, time.Duration(time.Millisecond), cs.CalculateExpectedLatency(2*time.Duration(time.Millisecond)), (servicedBlockNumber - 1), numberOfProviders, numberOfProviders)
+	err = csm.OnSessionDone(cs, firstEpochHeight, servicedBlockNumber, cuForFirstRequest, time.Duration(time.Millisecond), cs.CalculateExpectedLatency(2*time.Duration(time.Millisecond)), syncedBlockLags(servicedBlockNumber-1, numberOfProviders), numberOfProviders)
 	require.Nil(t, err)
 	require.Equal(t, cs.CuSum, cuForFirstRequest)
 	require.Equal(t, cs.LatestRelayCu, latestRelayCuAfterDone)
@@ -222,7 +226,7 @@ func TestSuccessAndFailureOfSessionWithUpdatePairingsInTheMiddle(t *testing.T) {
 		require.Equal(t, epoch, csm.currentEpoch)
 
 		if rand.Intn(2) > 0 {
-			err = csm.OnSessionDone(cs, epoch, servicedBlockNumber, cuForFirstRequest, time.Duration(time.Millisecond), cs.CalculateExpectedLatency(2*time.Duration(time.Millisecond)), (servicedBlockNumber - 1), numberOfProviders, numberOfProviders)
+			err = csm.OnSessionDone(cs, epoch, servicedBlockNumber, cuForFirstRequest, time.Duration(time.Millisecond), cs.CalculateExpectedLatency(2*time.Duration(time.Millisecond)), syncedBlockLags(servicedBlockNumber-1, numberOfProviders), numberOfProviders)
 			require.Nil(t, err)
 			require.Equal(t, cs.CuSum, cuForFirstRequest)
 			require.Equal(t, cs.LatestRelayCu, latestRelayCuAfterDone)
@@ -255,7 +259,7 @@ func TestSuccessAndFailureOfSessionWithUpdatePairingsInTheMiddle(t *testing.T) {
 		epoch := sessionList[j].epoch
 		if rand.Intn(2) > 0 {
 
-			err = csm.OnSessionDone(cs, epoch, servicedBlockNumber, cuForFirstRequest, time.Duration(time.Millisecond), cs.CalculateExpectedLatency(2*time.Duration(time.Millisecond)), (servicedBlockNumber - 1), numberOfProviders, numberOfProviders)
+			err = csm.OnSessionDone(cs, epoch, servicedBlockNumber, cuForFirstRequest, time.Duration(time.Millisecond), cs.CalculateExpectedLatency(2*time.Duration(time.Millisecond)), syncedBlockLags(servicedBlockNumber-1, numberOfProviders), numberOfProviders)
 			require.Nil(t, err)
 			require.Equal(t, sessionListData[j].cuSum+cuForFirstRequest, cs.CuSum)
 			require.Equal(t, cs.LatestRelayCu, latestRelayCuAfterDone)
@@ -277,7 +281,7 @@ func successfulSession(ctx context.Context, csm *ConsumerSessionManager, t *test
 	require.Nil(t, err)
 	require.NotNil(t, cs)
 	time.Sleep(time.Duration((rand.Intn(500) + 1)) * time.Millisecond)
-	err = csm.OnSessionDone(cs, firstEpochHeight, servicedBlockNumber, cuForFirstRequest, time.Duration(time.Millisecond), cs.CalculateExpectedLatency(2*time.Duration(time.Millisecond)), (servicedBlockNumber - 1), numberOfProviders, numberOfProviders)
+	err = csm.OnSessionDone(cs, firstEpochHeight, servicedBlockNumber, cuForFirstRequest, time.Duration(time.Millisecond), cs.CalculateExpectedLatency(2*time.Duration(time.Millisecond)), syncedBlockLags(servicedBlockNumber-1, numberOfProviders), numberOfProviders)
 	require.Nil(t, err)
 	ch <- p
 }
@@ -426,14 +430,173 @@ func TestSessionFailureAndGetReportedProviders(t *testing.T) {
 	reported, err := csm.GetReportedProviders(firstEpochHeight)
 	require.Nil(t, err)
 	require.NotEmpty(t, reported)
-	reportedSlice := make([]string, 0, len(reported))
-	err = json.Unmarshal(reported, &reportedSlice)
+	reportedSlice, err := pairingtypes.ParseUnresponsiveProviders(reported)
 	require.Nil(t, err)
 	for _, providerReported := range reportedSlice {
-		require.Contains(t, csm.addedToPurgeAndReport, providerReported)
+		require.Contains(t, csm.addedToPurgeAndReport, providerReported.Address)
 	}
 }
 
+func TestEndpointBlockedBeforeProvider(t *testing.T) {
+	s := createGRPCServer(t) // create a grpcServer so we can connect to its endpoint and validate everything works.
+	defer s.Stop()           // stop the server when finished.
+	ctx := context.Background()
+	csm := CreateConsumerSessionManager()
+	endpoints := []*Endpoint{
+		{NetworkAddress: grpcListener, Enabled: true},
+		{NetworkAddress: grpcListener, Enabled: true},
+	}
+	providerAddress := "provider0"
+	pairingList := map[uint64]*ConsumerSessionsWithProvider{0: {
+		PublicLavaAddress: providerAddress,
+		Endpoints:         endpoints,
+		Sessions:          map[int64]*SingleConsumerSession{},
+		MaxComputeUnits:   200,
+		PairingEpoch:      firstEpochHeight,
+	}}
+	err := csm.UpdateAllProviders(firstEpochHeight, pairingList) // update the providers.
+	require.Nil(t, err)
+
+	// the first endpoint keeps timing out, it's disabled and the provider is kept
+	for i := 0; i < MaxConsecutiveEndpointRelayFailures && endpoints[0].Enabled; i++ {
+		cs, _, _, _, err := csm.GetSession(ctx, cuForFirstRequest, nil)
+		require.Nil(t, err)
+		require.Equal(t, endpoints[0], cs.Endpoint)
+		err = csm.OnSessionFailure(cs, status.Error(codes.DeadlineExceeded, "relay timeout"))
+		require.Nil(t, err)
+	}
+	require.False(t, endpoints[0].Enabled)
+	require.True(t, endpoints[1].Enabled)
+	require.Contains(t, csm.validAddresses, providerAddress)
+	require.NotContains(t, csm.addedToPurgeAndReport, providerAddress)
+
+	// the last endpoint isn't disabled, the provider is blocked and reported once its sessions fail without serving a relay
+	for i := 0; i < 10*MaximumNumberOfFailuresAllowedPerConsumerSession && len(csm.validAddresses) > 0; i++ {
+		cs, _, _, _, err := csm.GetSession(ctx, cuForFirstRequest, nil)
+		require.Nil(t, err)
+		require.Equal(t, endpoints[1], cs.Endpoint)
+		err = csm.OnSessionFailure(cs, nil)
+		require.Nil(t, err)
+	}
+	require.NotContains(t, csm.validAddresses, providerAddress)
+	require.Contains(t, csm.addedToPurgeAndReport, providerAddress)
+	require.True(t, endpoints[1].Enabled)
+}
+
+func TestSignedCuSaved(t *testing.T) {
+	s := createGRPCServer(t) // create a grpcServer so we can connect to its endpoint and validate everything works.
+	defer s.Stop()           // stop the server when finished.
+	ctx := context.Background()
+	csm := CreateConsumerSessionManager()
+	usageStore, err := NewBoltConsumerUsageStore(filepath.Join(t.TempDir(), "usage.db"))
+	require.Nil(t, err)
+	defer usageStore.Close()
+	csm.SetUsageStore(usageStore)
+	providerAddress := "provider0"
+	pairingList := map[uint64]*ConsumerSessionsWithProvider{0: {
+		PublicLavaAddress: providerAddress,
+		Endpoints:         []*Endpoint{{NetworkAddress: grpcListener, Enabled: true}},
+		Sessions:          map[int64]*SingleConsumerSession{},
+		MaxComputeUnits:   200,
+		PairingEpoch:      firstEpochHeight,
+	}}
+	err = csm.UpdateAllProviders(firstEpochHeight, pairingList) // update the providers.
+	require.Nil(t, err)
+
+	cs, _, _, _, err := csm.GetSession(ctx, cuForFirstRequest, nil)
+	require.Nil(t, err)
+	err = csm.OnSessionDone(cs, firstEpochHeight, servicedBlockNumber, cuForFirstRequest, time.Duration(time.Millisecond), cs.CalculateExpectedLatency(2*time.Duration(time.Millisecond)), syncedBlockLags(servicedBlockNumber-1, 1), 1)
+	require.Nil(t, err)
+	// a failed relay is still signed, the provider can claim its cu
+	cs, _, _, _, err = csm.GetSession(ctx, cuForFirstRequest, nil)
+	require.Nil(t, err)
+	err = csm.OnSessionFailure(cs, nil)
+	require.Nil(t, err)
+	snapshot := pairingList[0].Snapshot()
+	require.Equal(t, cuForFirstRequest, snapshot.UsedComputeUnits)
+	require.Equal(t, 2*cuForFirstRequest, snapshot.SignedCu)
+
+	csm.SaveUsage()
+	records, err := usageStore.LoadUsage()
+	require.Nil(t, err)
+	require.Equal(t, []ConsumerUsageRecord{{Epoch: firstEpochHeight, ChainID: "stub", ApiInterface: "stub", Provider: providerAddress, SignedCu: 2 * cuForFirstRequest}}, records)
+}
+
+// slowUsageStore takes a while to save, so a save of a replaced pairing is still running when the consumer stops
+type slowUsageStore struct {
+	lock    sync.Mutex
+	records []ConsumerUsageRecord
+}
+
+func (store *slowUsageStore) SaveUsage(record ConsumerUsageRecord) error {
+	time.Sleep(50 * time.Millisecond)
+	store.lock.Lock()
+	defer store.lock.Unlock()
+	store.records = append(store.records, record)
+	return nil
+}
+
+func (store *slowUsageStore) LoadUsage() ([]ConsumerUsageRecord, error) {
+	store.lock.Lock()
+	defer store.lock.Unlock()
+	return store.records, nil
+}
+
+func (store *slowUsageStore) Close() error {
+	return nil
+}
+
+func TestSaveUsageWaitsForReplacedPairings(t *testing.T) {
+	csm := CreateConsumerSessionManager()
+	usageStore := &slowUsageStore{}
+	csm.SetUsageStore(usageStore)
+	newPairing := func(epoch uint64) map[uint64]*ConsumerSessionsWithProvider {
+		return map[uint64]*ConsumerSessionsWithProvider{0: {PublicLavaAddress: "provider0", Sessions: map[int64]*SingleConsumerSession{}, MaxComputeUnits: 200, PairingEpoch: epoch, signedCu: 10}}
+	}
+	require.Nil(t, csm.UpdateAllProviders(firstEpochHeight, newPairing(firstEpochHeight)))
+	require.Nil(t, csm.UpdateAllProviders(secondEpochHeight, newPairing(secondEpochHeight)))
+	require.Nil(t, csm.UpdateAllProviders(secondEpochHeight+20, newPairing(secondEpochHeight+20)))
+
+	// the store can be closed once SaveUsage returns, the saves of the replaced pairings are done by then
+	csm.SaveUsage()
+	records, err := usageStore.LoadUsage()
+	require.Nil(t, err)
+	require.Len(t, records, 5)
+}
+
+func TestSessionFailureErrorCounts(t *testing.T) {
+	s := createGRPCServer(t) // create a grpcServer so we can connect to its endpoint and validate everything works.
+	defer s.Stop()           // stop the server when finished.
+	ctx := context.Background()
+	csm := CreateConsumerSessionManager()
+	pairingList := createPairingList("")
+	err := csm.UpdateAllProviders(firstEpochHeight, pairingList) // update the providers.
+	require.Nil(t, err)
+	cs, epoch, providerAddress, _, err := csm.GetSession(ctx, cuForFirstRequest, nil)
+	require.Nil(t, err)
+	err = csm.OnSessionFailure(cs, status.Error(codes.DeadlineExceeded, "relay timeout"))
+	require.Nil(t, err)
+
+	snapshot := cs.Client.Snapshot()
+	require.Equal(t, providerAddress, snapshot.PublicLavaAddress)
+	require.Equal(t, epoch, snapshot.PairingEpoch)
+	require.Equal(t, pairingtypes.UnresponsiveProviderErrors{Timeouts: 1}, snapshot.Errors)
+
+	cs.Client.errorCounts.countRelayError(status.Error(codes.Unavailable, "connection refused"))
+	cs.Client.errorCounts.countRelayError(status.Error(codes.Code(SessionOutOfSyncError.ABCICode()), "out of sync"))
+	cs.Client.errorCounts.countRelayError(ReportAndBlockProviderError) // not a counted class
+	require.Equal(t, pairingtypes.UnresponsiveProviderErrors{Timeouts: 1, ConnectionFailures: 1, SessionOutOfSync: 1}, cs.Client.Snapshot().Errors)
+
+	// the counts are sent with the report
+	err = csm.blockProvider(providerAddress, true, epoch)
+	require.Nil(t, err)
+	reported, err := csm.GetReportedProviders(epoch)
+	require.Nil(t, err)
+	reportedProviders, err := pairingtypes.ParseUnresponsiveProviders(reported)
+	require.Nil(t, err)
+	require.Equal(t, []pairingtypes.UnresponsiveProvider{{Address: providerAddress, Errors: cs.Client.Snapshot().Errors}}, reportedProviders)
+}
+
 // Test the basic functionality of the consumerSessionManager
 func TestSessionFailureEpochMisMatch(t *testing.T) {
 	s := createGRPCServer(t) // create a grpcServer so we can connect to its endpoint and validate everything works.
@@ -512,6 +675,50 @@ func TestGetSession(t *testing.T) {
 	require.Equal(t, cs.LatestRelayCu, uint64(cuForFirstRequest))
 }
 
+func TestGetSessionFromForcedProvider(t *testing.T) {
+	s := createGRPCServer(t) // create a grpcServer so we can connect to its endpoint and validate everything works.
+	defer s.Stop()           // stop the server when finished.
+	ctx := context.Background()
+	csm := CreateConsumerSessionManager()
+	pairingList := createPairingList("")
+	err := csm.UpdateAllProviders(firstEpochHeight, pairingList)
+	require.Nil(t, err)
+	forcedProvider := pairingList[3].PublicLavaAddress
+	unwantedProviders, err := csm.GetAllProvidersExcept(forcedProvider)
+	require.Nil(t, err)
+	require.Len(t, unwantedProviders, numberOfProviders-1)
+	cs, _, providerAddress, _, err := csm.GetSession(ctx, cuForFirstRequest, unwantedProviders)
+	require.Nil(t, err)
+	require.NotNil(t, cs)
+	require.Equal(t, forcedProvider, providerAddress)
+
+	_, err = csm.GetAllProvidersExcept("notInPairing")
+	require.True(t, ProviderNotInPairingError.Is(err))
+}
+
+func TestGetSessionFromAddOnProvider(t *testing.T) {
+	s := createGRPCServer(t) // create a grpcServer so we can connect to its endpoint and validate everything works.
+	defer s.Stop()           // stop the server when finished.
+	ctx := context.Background()
+	csm := CreateConsumerSessionManager()
+	pairingList := createPairingList("")
+	pairingList[2].AddOns = []string{"archive"}
+	err := csm.UpdateAllProviders(firstEpochHeight, pairingList)
+	require.Nil(t, err)
+
+	csm.SetAddOns([]string{"archive"})
+	require.True(t, csm.IsAddOnActive(""))
+	require.True(t, csm.IsAddOnActive("archive"))
+	require.False(t, csm.IsAddOnActive("trace"))
+
+	unwantedProviders := csm.GetProvidersWithoutAddOn("archive")
+	require.Len(t, unwantedProviders, numberOfProviders-1)
+	cs, _, providerAddress, _, err := csm.GetSession(ctx, cuForFirstRequest, unwantedProviders)
+	require.Nil(t, err)
+	require.NotNil(t, cs)
+	require.Equal(t, pairingList[2].PublicLavaAddress, providerAddress)
+}
+
 func TestContext(t *testing.T) {
 	ctx := context.Background()
 	ctxTO, cancel := context.WithTimeout(ctx, time.Millisecond)
@@ -531,7 +738,353 @@ func TestGrpcClientHang(t *testing.T) {
 	require.NoError(t, err)
 	err = conn.Close()
 	require.Error(t, err)
-	_, err = client.Probe(ctx, &wrapperspb.UInt64Value{})
+	_, err = client.Probe(ctx, &pairingtypes.ProbeRequest{})
 	fmt.Println(err)
 	require.Error(t, err)
 }
+
+// syncedBlockLags is a consensus of numOfProviders providers all at the expected block
+func syncedBlockLags(expectedBH int64, numOfProviders int) BlockLagDistribution {
+	return BlockLagDistribution{ExpectedBlockHeight: expectedBH, Lags: make([]int64, numOfProviders)}
+}
+
+func TestBlockLagSyncScore(t *testing.T) {
+	blockLags := BlockLagDistribution{ExpectedBlockHeight: 100, Lags: []int64{-2, 0, 0, 1, 1, 3, 3, 3, 5, 10}}
+	for _, tt := range []struct {
+		name        string
+		latestBlock int64
+		providers   int64
+		syncScore   sdk.Dec
+	}{
+		{name: "at expected block", latestBlock: 100, providers: 10, syncScore: sdk.OneDec()},
+		{name: "ahead", latestBlock: 102, providers: 10, syncScore: sdk.OneDec()},
+		{name: "common lag", latestBlock: 99, providers: 10, syncScore: sdk.NewDecWithPrec(7, 1)},
+		{name: "slowest provider", latestBlock: 90, providers: 10, syncScore: sdk.NewDecWithPrec(1, 1)},
+		{name: "behind every provider", latestBlock: 80, providers: 10, syncScore: sdk.ZeroDec()},
+		{name: "not enough providers", latestBlock: 80, providers: 100, syncScore: sdk.OneDec()},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			require.True(t, tt.syncScore.Equal(blockLags.SyncScore(tt.latestBlock, tt.providers)), blockLags.SyncScore(tt.latestBlock, tt.providers).String())
+		})
+	}
+
+	cs := &SingleConsumerSession{}
+	cs.CalculateQoS(10, time.Millisecond, time.Millisecond, 1, blockLags.SyncScore(99, 10))
+	cs.CalculateQoS(10, time.Millisecond, time.Millisecond, 0, blockLags.SyncScore(100, 10))
+	require.True(t, sdk.NewDecWithPrec(85, 2).Equal(cs.QoSInfo.LastQoSReport.Sync))
+	require.True(t, sdk.OneDec().Equal(cs.QoSInfo.LatestSyncScore))
+	require.True(t, sdk.OneDec().Equal(cs.QoSInfo.LatestLatencyScore))
+	require.Zero(t, cs.QoSInfo.LatestBlockLag)
+}
+
+type mockProviderQoSMetrics struct {
+	done         map[string]int
+	failures     map[string]int
+	reported     map[string]bool
+	samplingRate map[string]float64
+}
+
+func (m *mockProviderQoSMetrics) OnRelayDone(chainID string, apiInterface string, providerAddress string, geolocation uint64, latency time.Duration, syncScore float64) {
+	m.done[providerAddress]++
+}
+
+func (m *mockProviderQoSMetrics) OnRelayFailure(chainID string, apiInterface string, providerAddress string) {
+	m.failures[providerAddress]++
+}
+
+func (m *mockProviderQoSMetrics) OnProviderBlocked(chainID string, apiInterface string, providerAddress string, reported bool) {
+	m.reported[providerAddress] = reported
+}
+
+func (m *mockProviderQoSMetrics) OnDataReliabilitySampling(chainID string, apiInterface string, providerAddress string, samplingRate float64) {
+	m.samplingRate[providerAddress] = samplingRate
+}
+
+// trustOptimizer trusts providers by a fixed score
+type trustOptimizer map[string]float64
+
+func (to trustOptimizer) AppendRelayData(providerAddress string, latency time.Duration, failure bool) {
+}
+
+func (to trustOptimizer) AppendProbeData(providerAddress string, clockSkew time.Duration, blockLag int64) {
+}
+
+func (to trustOptimizer) AppendSyncData(providerAddress string, syncLag int64) {
+}
+
+func (to trustOptimizer) Degraded() bool {
+	return false
+}
+
+func (to trustOptimizer) AppendReliabilityConflict(providerAddress string) {
+	delete(to, providerAddress)
+}
+
+func (to trustOptimizer) TrustScore(providerAddress string) float64 {
+	return to[providerAddress]
+}
+
+func TestDataReliabilityThreshold(t *testing.T) {
+	specThreshold := uint32(math.MaxUint32 / 4)
+	optimizer := trustOptimizer{"trusted": 1, "half": 0.5}
+	csm := NewConsumerSessionManager(&RPCEndpoint{"stub", "stub", "stub", 0}, optimizer)
+	qosMetrics := &mockProviderQoSMetrics{samplingRate: map[string]float64{}}
+	csm.SetProviderQoSMetrics(qosMetrics)
+
+	for _, tt := range []struct {
+		provider  string
+		threshold uint32
+	}{
+		{provider: "new", threshold: specThreshold},
+		{provider: "half", threshold: uint32(float64(specThreshold) * (1 - 0.5*(1-DataReliabilityMinSamplingFactor)))},
+		{provider: "trusted", threshold: uint32(float64(specThreshold) * DataReliabilityMinSamplingFactor)},
+	} {
+		require.Equal(t, tt.threshold, csm.DataReliabilityThreshold(tt.provider, specThreshold), tt.provider)
+		require.InDelta(t, float64(tt.threshold)/math.MaxUint32, qosMetrics.samplingRate[tt.provider], 0.001, tt.provider)
+	}
+
+	// a conflict brings a trusted provider back to the spec threshold
+	csm.OnDataReliabilityConflict("trusted")
+	require.Equal(t, specThreshold, csm.DataReliabilityThreshold("trusted", specThreshold))
+}
+
+func TestProviderQoSMetricsHooks(t *testing.T) {
+	s := createGRPCServer(t) // create a grpcServer so we can connect to its endpoint and validate everything works.
+	defer s.Stop()           // stop the server when finished.
+	ctx := context.Background()
+	csm := CreateConsumerSessionManager()
+	qosMetrics := &mockProviderQoSMetrics{done: map[string]int{}, failures: map[string]int{}, reported: map[string]bool{}, samplingRate: map[string]float64{}}
+	csm.SetProviderQoSMetrics(qosMetrics)
+	pairingList := createPairingList("")
+	err := csm.UpdateAllProviders(firstEpochHeight, pairingList) // update the providers.
+	require.Nil(t, err)
+
+	cs, _, providerAddress, _, err := csm.GetSession(ctx, cuForFirstRequest, nil)
+	require.Nil(t, err)
+	err = csm.OnSessionDone(cs, firstEpochHeight, servicedBlockNumber, cuForFirstRequest, time.Millisecond, cs.CalculateExpectedLatency(2*time.Millisecond), syncedBlockLags(servicedBlockNumber-1, numberOfProviders), numberOfProviders)
+	require.Nil(t, err)
+	require.Equal(t, 1, qosMetrics.done[providerAddress])
+
+	cs, _, providerAddress, _, err = csm.GetSession(ctx, cuForFirstRequest, nil)
+	require.Nil(t, err)
+	err = csm.OnSessionFailure(cs, ReportAndBlockProviderError)
+	require.Nil(t, err)
+	require.Equal(t, 1, qosMetrics.failures[providerAddress])
+	require.True(t, qosMetrics.reported[providerAddress])
+}
+
+func TestQuarantineProviders(t *testing.T) {
+	s := createGRPCServer(t) // create a grpcServer so we can connect to its endpoint and validate everything works.
+	defer s.Stop()           // stop the server when finished.
+	ctx := context.Background()
+	csm := CreateConsumerSessionManager()
+	pairingList := createPairingList("")
+	err := csm.UpdateAllProviders(firstEpochHeight, pairingList) // update the providers.
+	require.Nil(t, err)
+
+	quarantined := []string{}
+	for p := 0; p < numberOfProviders-1; p++ {
+		quarantined = append(quarantined, "provider"+strconv.Itoa(p))
+	}
+	err = csm.QuarantineProviders(quarantined, secondEpochHeight)
+	require.True(t, EpochMismatchError.Is(err))
+	err = csm.QuarantineProviders(quarantined, firstEpochHeight)
+	require.Nil(t, err)
+	require.Equal(t, 1, len(csm.validAddresses))
+
+	// quarantined providers are not picked even after the valid addresses are reset
+	for i := 0; i < numberOfResetsToTest; i++ {
+		cs, _, providerAddress, _, err := csm.GetSession(ctx, cuForFirstRequest, nil)
+		require.Nil(t, err)
+		require.Equal(t, "provider"+strconv.Itoa(numberOfProviders-1), providerAddress)
+		err = csm.OnSessionFailure(cs, BlockProviderError)
+		require.Nil(t, err)
+	}
+
+	// the quarantine ends with the epoch
+	err = csm.UpdateAllProviders(secondEpochHeight, pairingList)
+	require.Nil(t, err)
+	require.Equal(t, numberOfProviders, len(csm.validAddresses))
+}
+
+func TestQuarantineProvidersInCurrentEpoch(t *testing.T) {
+	s := createGRPCServer(t) // create a grpcServer so we can connect to its endpoint and validate everything works.
+	defer s.Stop()           // stop the server when finished.
+	csm := CreateConsumerSessionManager()
+	pairingList := createPairingList("")
+	err := csm.UpdateAllProviders(firstEpochHeight, pairingList) // update the providers.
+	require.Nil(t, err)
+
+	// addresses that aren't paired are ignored
+	err = csm.QuarantineProvidersInCurrentEpoch([]string{"not-paired"})
+	require.Nil(t, err)
+	require.Equal(t, numberOfProviders, len(csm.validAddresses))
+	require.Equal(t, 0, len(csm.quarantinedProviders))
+
+	err = csm.QuarantineProvidersInCurrentEpoch([]string{"provider0", "not-paired"})
+	require.Nil(t, err)
+	require.Equal(t, numberOfProviders-1, len(csm.validAddresses))
+	require.NotContains(t, csm.validAddresses, "provider0")
+}
+
+// fixedRand always picks the same index and session id
+type fixedRand struct {
+	index     int
+	sessionId int64
+}
+
+func (fr fixedRand) Intn(n int) int { return fr.index % n }
+func (fr fixedRand) Int63() int64   { return fr.sessionId }
+
+// fakeClock records sleeps instead of sleeping, time only advances by them
+type fakeClock struct {
+	lock  sync.Mutex
+	now   time.Time
+	slept []time.Duration
+}
+
+func (fc *fakeClock) Now() time.Time {
+	fc.lock.Lock()
+	defer fc.lock.Unlock()
+	return fc.now
+}
+
+func (fc *fakeClock) Since(t time.Time) time.Duration {
+	return fc.Now().Sub(t)
+}
+
+func (fc *fakeClock) Sleep(d time.Duration) {
+	fc.lock.Lock()
+	defer fc.lock.Unlock()
+	fc.slept = append(fc.slept, d)
+	fc.now = fc.now.Add(d)
+}
+
+func TestInjectedClockAndRand(t *testing.T) {
+	s := createGRPCServer(t) // create a grpcServer so we can connect to its endpoint and validate everything works.
+	defer s.Stop()           // stop the server when finished.
+	ctx := context.Background()
+	csm := CreateConsumerSessionManager()
+	clock := &fakeClock{now: time.Now()}
+	csm.SetClock(clock)
+	csm.SetRand(fixedRand{index: 3, sessionId: 42})
+	pairingList := createPairingList("")
+	err := csm.UpdateAllProviders(firstEpochHeight, pairingList)
+	require.Nil(t, err)
+	// the probe scatter sleeps the picked number of milliseconds
+	clock.lock.Lock()
+	require.Equal(t, []time.Duration{3 * time.Millisecond}, clock.slept)
+	clock.lock.Unlock()
+
+	csm.lock.RLock()
+	expectedProvider := csm.validAddresses[3]
+	csm.lock.RUnlock()
+	for i := 0; i < 3; i++ {
+		cs, _, providerAddress, _, err := csm.GetSession(ctx, cuForFirstRequest, nil)
+		require.Nil(t, err)
+		require.Equal(t, expectedProvider, providerAddress)
+		require.Equal(t, int64(42), cs.SessionId)
+		err = csm.OnSessionDone(cs, firstEpochHeight, servicedBlockNumber, cuForFirstRequest, time.Millisecond, cs.CalculateExpectedLatency(time.Second), syncedBlockLags(servicedBlockNumber-1, numberOfProviders), numberOfProviders)
+		require.Nil(t, err)
+	}
+}
+
+func TestSessionIdleTimeout(t *testing.T) {
+	s := createGRPCServer(t) // create a grpcServer so we can connect to its endpoint and validate everything works.
+	defer s.Stop()           // stop the server when finished.
+	ctx := context.Background()
+	csm := CreateConsumerSessionManager()
+	clock := &fakeClock{now: time.Now()}
+	csm.SetClock(clock)
+	csm.SetSessionIdleTimeout(time.Minute)
+	pairingList := map[uint64]*ConsumerSessionsWithProvider{0: {
+		PublicLavaAddress: "provider0",
+		Endpoints:         []*Endpoint{{NetworkAddress: grpcListener, Enabled: true}},
+		Sessions:          map[int64]*SingleConsumerSession{},
+		MaxComputeUnits:   200,
+		PairingEpoch:      firstEpochHeight,
+	}}
+	err := csm.UpdateAllProviders(firstEpochHeight, pairingList)
+	require.Nil(t, err)
+
+	// the caller of this session never reports on it
+	leaked, _, _, _, err := csm.GetSession(ctx, cuForFirstRequest, nil)
+	require.Nil(t, err)
+	csm.sessionWatchdog.lock.Lock()
+	require.Contains(t, csm.sessionWatchdog.sessions[leaked].callSite, "consumer_session_manager_test.go")
+	csm.sessionWatchdog.lock.Unlock()
+
+	clock.Sleep(time.Minute)
+	cs, _, _, _, err := csm.GetSession(ctx, cuForFirstRequest, nil)
+	require.Nil(t, err)
+	require.NotEqual(t, leaked.SessionId, cs.SessionId)
+	err = csm.OnSessionDone(cs, firstEpochHeight, servicedBlockNumber, cuForFirstRequest, time.Millisecond, cs.CalculateExpectedLatency(time.Second), syncedBlockLags(servicedBlockNumber-1, 1), 1)
+	require.Nil(t, err)
+	require.Equal(t, 2*cuForFirstRequest, pairingList[0].Snapshot().UsedComputeUnits)
+
+	// past the idle timeout the leaked session is reclaimed with its cu
+	clock.Sleep(time.Minute)
+	cs, _, _, _, err = csm.GetSession(ctx, cuForFirstRequest, nil)
+	require.Nil(t, err)
+	require.Equal(t, 2*cuForFirstRequest, pairingList[0].Snapshot().UsedComputeUnits)
+	pairingList[0].Lock.Lock()
+	require.NotContains(t, pairingList[0].Sessions, leaked.SessionId)
+	pairingList[0].Lock.Unlock()
+	err = csm.OnSessionUnUsed(cs)
+	require.Nil(t, err)
+
+	// reporting on the reclaimed session later only unlocks it
+	err = csm.OnSessionFailure(leaked, nil)
+	require.True(t, SessionReclaimedError.Is(err))
+	require.Equal(t, cuForFirstRequest, pairingList[0].Snapshot().UsedComputeUnits)
+	require.True(t, leaked.lock.TryLock())
+	leaked.lock.Unlock()
+}
+
+func TestPreferredEndpoints(t *testing.T) {
+	local := &Endpoint{NetworkAddress: "local", Enabled: true, Geolocation: 1}
+	remote := &Endpoint{NetworkAddress: "remote", Enabled: true, Geolocation: 2}
+	cswp := &ConsumerSessionsWithProvider{Endpoints: []*Endpoint{remote, local}}
+	// unmeasured endpoints in the consumer's geolocation are tried first
+	require.Equal(t, []*Endpoint{local, remote}, cswp.preferredEndpoints(1))
+
+	// an endpoint is measured once it served a relay, the unmeasured ones are tried before it
+	cswp.recordEndpointLatency(local, 100*time.Millisecond)
+	require.Equal(t, []*Endpoint{remote, local}, cswp.preferredEndpoints(1))
+
+	// once both are measured the faster one is used, even when it's in another geolocation
+	cswp.recordEndpointLatency(remote, 50*time.Millisecond)
+	require.Equal(t, []*Endpoint{remote, local}, cswp.preferredEndpoints(1))
+	for i := 0; i < 10; i++ {
+		cswp.recordEndpointLatency(remote, 500*time.Millisecond)
+	}
+	require.Equal(t, []*Endpoint{local, remote}, cswp.preferredEndpoints(1))
+}
+
+func TestProbeClockSkew(t *testing.T) {
+	s := createGRPCServer(t) // create a grpcServer so we can connect to its endpoint and validate everything works.
+	defer s.Stop()           // stop the server when finished.
+	csm := CreateConsumerSessionManager()
+	pairingList := createPairingList("")
+	err := csm.UpdateAllProviders(firstEpochHeight, pairingList)
+	require.Nil(t, err)
+	sent := time.Now().Truncate(time.Millisecond) // providers send their time in milliseconds
+	latency := 200 * time.Millisecond
+	replied := sent.Add(latency / 2)
+	skewed := pairingList[0].PublicLavaAddress
+
+	// without a bound the skew is only reported, legacy providers don't send their time
+	require.Equal(t, 10*time.Second, csm.checkProbeClockSkew(skewed, firstEpochHeight, sent, latency, replied.Add(10*time.Second).UnixMilli()))
+	require.Zero(t, csm.checkProbeClockSkew(skewed, firstEpochHeight, sent, latency, 0))
+	require.Equal(t, numberOfProviders, csm.validAddressesLen())
+
+	csm.SetMaxClockSkew(time.Second)
+	// a skew the probe latency can explain isn't blocked
+	require.Equal(t, -time.Second-latency/2, csm.checkProbeClockSkew(skewed, firstEpochHeight, sent, latency, replied.Add(-time.Second-latency/2).UnixMilli()))
+	require.Equal(t, numberOfProviders, csm.validAddressesLen())
+	require.Equal(t, -2*time.Second, csm.checkProbeClockSkew(skewed, firstEpochHeight, sent, latency, replied.Add(-2*time.Second).UnixMilli()))
+	require.Equal(t, numberOfProviders-1, csm.validAddressesLen())
+	csm.lock.RLock()
+	require.NotContains(t, csm.validAddresses, skewed)
+	csm.lock.RUnlock()
+}
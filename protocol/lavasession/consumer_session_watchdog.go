@@ -0,0 +1,130 @@
+package lavasession
+
+import (
+	"fmt"
+	"runtime"
+	"sync"
+	"time"
+
+	"github.com/lavanet/lava/utils"
+)
+
+const (
+	SessionIdleTimeoutFlag    = "session-idle-timeout"
+	DefaultSessionIdleTimeout = 5 * time.Minute
+	SessionIdleCheckInterval  = 10 * time.Second // how often GetSession looks for sessions locked past the idle timeout
+)
+
+// lockedSession is a session GetSession handed out, until its caller reports how the relay ended
+type lockedSession struct {
+	client    *ConsumerSessionsWithProvider
+	sessionId int64
+	cu        uint64
+	lockedAt  time.Time
+	callSite  string // where GetSession was called from, to find the path that didn't report on the session
+}
+
+// sessionWatchdog reclaims sessions whose callers never reported on them, a panic or a forgotten return path would otherwise
+// hold the session and the cu reserved for its relay until the end of the epoch
+type sessionWatchdog struct {
+	lock        sync.Mutex
+	idleTimeout time.Duration // 0 disables the watchdog
+	checkedAt   time.Time
+	sessions    map[*SingleConsumerSession]lockedSession
+}
+
+// watch starts timing a session handed out by GetSession
+func (sw *sessionWatchdog) watch(consumerSession *SingleConsumerSession, cu uint64, now time.Time) {
+	sw.lock.Lock()
+	defer sw.lock.Unlock()
+	if sw.idleTimeout == 0 {
+		return
+	}
+	if sw.sessions == nil {
+		sw.sessions = map[*SingleConsumerSession]lockedSession{}
+	}
+	sw.sessions[consumerSession] = lockedSession{
+		client:    consumerSession.Client,
+		sessionId: consumerSession.SessionId,
+		cu:        cu,
+		lockedAt:  now,
+		callSite:  sessionCallSite(),
+	}
+}
+
+// release stops timing a session its caller reported on, and returns whether the watchdog already reclaimed it
+func (sw *sessionWatchdog) release(consumerSession *SingleConsumerSession) (reclaimed bool) {
+	sw.lock.Lock()
+	defer sw.lock.Unlock()
+	delete(sw.sessions, consumerSession)
+	return consumerSession.reclaimed
+}
+
+// expired returns the sessions locked for longer than the idle timeout, marked as reclaimed. sessions are checked once per
+// SessionIdleCheckInterval
+func (sw *sessionWatchdog) expired(now time.Time) map[*SingleConsumerSession]lockedSession {
+	sw.lock.Lock()
+	defer sw.lock.Unlock()
+	if sw.idleTimeout == 0 || now.Sub(sw.checkedAt) < SessionIdleCheckInterval {
+		return nil
+	}
+	sw.checkedAt = now
+	expired := map[*SingleConsumerSession]lockedSession{}
+	for consumerSession, locked := range sw.sessions {
+		if now.Sub(locked.lockedAt) <= sw.idleTimeout {
+			continue
+		}
+		consumerSession.reclaimed = true
+		delete(sw.sessions, consumerSession)
+		expired[consumerSession] = locked
+	}
+	return expired
+}
+
+// sessionCallSite returns the file and line GetSession was called from
+func sessionCallSite() string {
+	// skip sessionCallSite, watch and GetSession
+	_, file, line, ok := runtime.Caller(3)
+	if !ok {
+		return "unknown"
+	}
+	return fmt.Sprintf("%s:%d", file, line)
+}
+
+// SetSessionIdleTimeout reclaims sessions locked for longer than idleTimeout without their caller reporting on them, 0 disables it.
+// it must be called before the first pairing update
+func (csm *ConsumerSessionManager) SetSessionIdleTimeout(idleTimeout time.Duration) {
+	csm.sessionWatchdog.lock.Lock()
+	defer csm.sessionWatchdog.lock.Unlock()
+	csm.sessionWatchdog.idleTimeout = idleTimeout
+}
+
+// reclaimIdleSessions force fails the sessions locked past the idle timeout: their cu are given back to the provider and they are
+// removed from its pool, so a new session takes their place. the sessions stay locked, a caller reporting on one later only unlocks it
+func (csm *ConsumerSessionManager) reclaimIdleSessions() {
+	for consumerSession, locked := range csm.sessionWatchdog.expired(csm.clock.Now()) {
+		locked.client.Lock.Lock()
+		if locked.client.Sessions[locked.sessionId] == consumerSession {
+			delete(locked.client.Sessions, locked.sessionId)
+		}
+		locked.client.Lock.Unlock()
+		err := locked.client.decreaseUsedComputeUnits(locked.cu)
+		if err != nil {
+			utils.LavaFormatError("failed restoring the cu of a reclaimed session", err, utils.Attribute{Key: "provider", Value: locked.client.PublicLavaAddress}, utils.Attribute{Key: "sessionId", Value: locked.sessionId})
+		}
+		utils.LavaFormatWarning("reclaimed a session locked past the idle timeout, its caller never reported on it", nil,
+			utils.Attribute{Key: "provider", Value: locked.client.PublicLavaAddress}, utils.Attribute{Key: "sessionId", Value: locked.sessionId},
+			utils.Attribute{Key: "cu", Value: locked.cu}, utils.Attribute{Key: "lockedFor", Value: csm.clock.Since(locked.lockedAt)},
+			utils.Attribute{Key: "callSite", Value: locked.callSite}, utils.Attribute{Key: "endpoint", Value: csm.rpcEndpoint.Key()})
+	}
+}
+
+// releaseWatchedSession is called by the session's caller reporting on it. a session the watchdog already reclaimed is only
+// unlocked, its cu were restored and it no longer belongs to the provider's pool
+func (csm *ConsumerSessionManager) releaseWatchedSession(consumerSession *SingleConsumerSession) error {
+	if !csm.sessionWatchdog.release(consumerSession) {
+		return nil
+	}
+	consumerSession.lock.Unlock()
+	return SessionReclaimedError
+}
@@ -2,8 +2,8 @@ package lavasession
 
 import (
 	"context"
+	"errors"
 	"math"
-	"math/rand"
 	"sort"
 	"strconv"
 	"sync/atomic"
@@ -13,12 +13,26 @@ import (
 	"github.com/lavanet/lava/utils"
 	pairingtypes "github.com/lavanet/lava/x/pairing/types"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/connectivity"
-	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
 )
 
 type ProviderOptimizer interface {
 	AppendRelayData(providerAddress string, latency time.Duration, failure bool)
+	AppendProbeData(providerAddress string, clockSkew time.Duration, blockLag int64)
+	AppendSyncData(providerAddress string, syncLag int64)
+	AppendReliabilityConflict(providerAddress string)
+	TrustScore(providerAddress string) float64 // between 0 and 1
+	Degraded() bool                            // most providers are failing or lagging, the consumer sheds load until they recover
+}
+
+// ProviderQoSMetrics receives the outcome of relays and provider blocks, so operators can see which provider degrades service
+type ProviderQoSMetrics interface {
+	OnRelayDone(chainID string, apiInterface string, providerAddress string, geolocation uint64, latency time.Duration, syncScore float64)
+	OnRelayFailure(chainID string, apiInterface string, providerAddress string)
+	OnProviderBlocked(chainID string, apiInterface string, providerAddress string, reported bool)
+	OnDataReliabilitySampling(chainID string, apiInterface string, providerAddress string, samplingRate float64)
 }
 
 type ignoredProviders struct {
@@ -29,10 +43,33 @@ type ignoredProviders struct {
 type QoSReport struct {
 	LastQoSReport    *pairingtypes.QualityOfServiceReport
 	LatencyScoreList []sdk.Dec
-	SyncScoreSum     int64
+	SyncScoreSum     sdk.Dec
 	TotalSyncScore   int64
 	TotalRelays      uint64
 	AnsweredRelays   uint64
+	// the components of the latest relay, LastQoSReport holds them aggregated over the session
+	LatestAvailability sdk.Dec
+	LatestLatencyScore sdk.Dec
+	LatestSyncScore    sdk.Dec
+	LatestBlockLag     int64
+}
+
+// BlockLagDistribution is where the providers of the finalization consensus are expected to be, relays are scored for sync against it
+type BlockLagDistribution struct {
+	ExpectedBlockHeight int64   // the latest block minus the lag allowed for QoS sync
+	Lags                []int64 // how many blocks each provider is expected to be behind ExpectedBlockHeight, ascending
+}
+
+// SyncScore is 1 for a block at or above ExpectedBlockHeight. below it the score is the share of providers lagging at least as much,
+// so a lag most of the providers share costs little while the slowest provider gets the lowest score.
+// when there aren't enough providers to agree on the expected block every relay gets the full score
+func (bld BlockLagDistribution) SyncScore(latestServicedBlock int64, servicersToCount int64) sdk.Dec {
+	lag := bld.ExpectedBlockHeight - latestServicedBlock
+	if lag <= 0 || int64(len(bld.Lags)) <= int64(math.Ceil(float64(servicersToCount)*MinProvidersForSync)) {
+		return sdk.OneDec()
+	}
+	laggingLess := sort.Search(len(bld.Lags), func(i int) bool { return bld.Lags[i] >= lag })
+	return sdk.NewDec(int64(len(bld.Lags) - laggingLess)).QuoInt64(int64(len(bld.Lags)))
 }
 
 type SingleConsumerSession struct {
@@ -47,6 +84,19 @@ type SingleConsumerSession struct {
 	Endpoint                    *Endpoint
 	BlockListed                 bool   // if session lost sync we blacklist it.
 	ConsecutiveNumberOfFailures uint64 // number of times this session has failed
+	maxSignedCuSum              uint64 // the highest cu sum the consumer signed on the session
+	reclaimed                   bool   // the session watchdog reclaimed the session, guarded by the watchdog's lock
+}
+
+// recordSignedCu counts the cu of the relay about to be signed to the provider. a provider can claim the highest cu sum signed
+// on a session even when its relay failed, so it is counted before the relay is sent. must be called with the session locked
+func (scs *SingleConsumerSession) recordSignedCu() {
+	signedCuSum := scs.CuSum + scs.LatestRelayCu
+	if scs.Client == nil || signedCuSum <= scs.maxSignedCuSum {
+		return
+	}
+	atomic.AddUint64(&scs.Client.signedCu, signedCuSum-scs.maxSignedCuSum)
+	scs.maxSignedCuSum = signedCuSum
 }
 
 type DataReliabilitySession struct {
@@ -62,6 +112,9 @@ type Endpoint struct {
 	Client             *pairingtypes.RelayerClient
 	connection         *grpc.ClientConn
 	ConnectionRefusals uint64
+	Geolocation        uint64        // the geolocation the provider staked the endpoint in
+	latency            time.Duration // average of the relays and probes the endpoint served, 0 until one is measured. guarded by the provider's Lock
+	relayFailures      uint64        // consecutive relays that timed out or failed to connect on the endpoint. guarded by the provider's Lock
 }
 
 type RPCEndpoint struct {
@@ -98,12 +151,87 @@ type ConsumerSessionsWithProvider struct {
 	UsedComputeUnits  uint64
 	ReliabilitySent   bool
 	PairingEpoch      uint64
+	ProtocolVersion   uint32   // negotiated on probe, 0 until the provider is probed
+	AddOns            []string // add-ons the provider serves on its endpoints
+	errorCounts       providerErrorCounts
+	signedCu          uint64             // the cu the provider can claim with the relays signed to it, updated atomically
+	providerTLS       *ProviderTLSConfig // the endpoints are dialed over tls verified with it, plaintext when nil
+}
+
+// providerErrorCounts counts the failures with a provider by error class, updated atomically.
+// a ConsumerSessionsWithProvider is created for every pairing so the counts are of a single epoch
+type providerErrorCounts struct {
+	maxComputeUnitsExceeded uint64
+	connectionFailures      uint64
+	sessionOutOfSync        uint64
+	timeouts                uint64
+}
+
+func (pec *providerErrorCounts) read() pairingtypes.UnresponsiveProviderErrors {
+	return pairingtypes.UnresponsiveProviderErrors{
+		MaxComputeUnitsExceeded: atomic.LoadUint64(&pec.maxComputeUnitsExceeded),
+		ConnectionFailures:      atomic.LoadUint64(&pec.connectionFailures),
+		SessionOutOfSync:        atomic.LoadUint64(&pec.sessionOutOfSync),
+		Timeouts:                atomic.LoadUint64(&pec.timeouts),
+	}
+}
+
+// countRelayError counts a failed relay by the class of its error, errors of no tracked class aren't counted
+func (pec *providerErrorCounts) countRelayError(errorReceived error) {
+	code := status.Code(errorReceived)
+	switch {
+	case code == codes.Code(SessionOutOfSyncError.ABCICode()):
+		atomic.AddUint64(&pec.sessionOutOfSync, 1)
+	case code == codes.DeadlineExceeded || errors.Is(errorReceived, context.DeadlineExceeded):
+		atomic.AddUint64(&pec.timeouts, 1)
+	case code == codes.Unavailable:
+		atomic.AddUint64(&pec.connectionFailures, 1)
+	}
+}
+
+// ConsumerSessionsWithProviderSnapshot is a copy of a provider's session state at a point in time
+type ConsumerSessionsWithProviderSnapshot struct {
+	PublicLavaAddress string
+	PairingEpoch      uint64
+	UsedComputeUnits  uint64
+	MaxComputeUnits   uint64
+	ProtocolVersion   uint32
+	Errors            pairingtypes.UnresponsiveProviderErrors
+	SignedCu          uint64
+}
+
+func (cswp *ConsumerSessionsWithProvider) Snapshot() ConsumerSessionsWithProviderSnapshot {
+	cswp.Lock.Lock()
+	snapshot := ConsumerSessionsWithProviderSnapshot{
+		PublicLavaAddress: cswp.PublicLavaAddress,
+		PairingEpoch:      cswp.PairingEpoch,
+		UsedComputeUnits:  cswp.UsedComputeUnits,
+		MaxComputeUnits:   cswp.MaxComputeUnits,
+	}
+	cswp.Lock.Unlock()
+	snapshot.ProtocolVersion = cswp.GetProtocolVersion()
+	snapshot.Errors = cswp.errorCounts.read()
+	snapshot.SignedCu = cswp.atomicReadSignedCu()
+	return snapshot
 }
 
 func (cswp *ConsumerSessionsWithProvider) atomicReadUsedComputeUnits() uint64 {
 	return atomic.LoadUint64(&cswp.UsedComputeUnits)
 }
 
+func (cswp *ConsumerSessionsWithProvider) atomicReadSignedCu() uint64 {
+	return atomic.LoadUint64(&cswp.signedCu)
+}
+
+// GetProtocolVersion returns the relay protocol version negotiated with the provider, the legacy version before it was probed
+func (cswp *ConsumerSessionsWithProvider) GetProtocolVersion() uint32 {
+	return EffectiveProtocolVersion(atomic.LoadUint32(&cswp.ProtocolVersion))
+}
+
+func (cswp *ConsumerSessionsWithProvider) setProtocolVersion(version uint32) {
+	atomic.StoreUint32(&cswp.ProtocolVersion, version)
+}
+
 // verify data reliability session exists or not
 func (cswp *ConsumerSessionsWithProvider) verifyDataReliabilitySessionWasNotAlreadyCreated() (singleConsumerSession *SingleConsumerSession, pairingEpoch uint64, err error) {
 	cswp.Lock.Lock()
@@ -159,6 +287,7 @@ func (cswp *ConsumerSessionsWithProvider) validateComputeUnits(cu uint64) error
 	cswp.Lock.Lock()
 	defer cswp.Lock.Unlock()
 	if (cswp.UsedComputeUnits + cu) > cswp.MaxComputeUnits {
+		atomic.AddUint64(&cswp.errorCounts.maxComputeUnitsExceeded, 1)
 		return utils.LavaFormatError("validateComputeUnits", MaxComputeUnitsExceededError, utils.Attribute{Key: "cu", Value: cswp.UsedComputeUnits + cu}, utils.Attribute{Key: "maxCu", Value: cswp.MaxComputeUnits})
 	}
 	return nil
@@ -169,6 +298,7 @@ func (cswp *ConsumerSessionsWithProvider) addUsedComputeUnits(cu uint64) error {
 	cswp.Lock.Lock()
 	defer cswp.Lock.Unlock()
 	if (cswp.UsedComputeUnits + cu) > cswp.MaxComputeUnits {
+		atomic.AddUint64(&cswp.errorCounts.maxComputeUnitsExceeded, 1)
 		return MaxComputeUnitsExceededError
 	}
 	cswp.UsedComputeUnits += cu
@@ -187,10 +317,7 @@ func (cswp *ConsumerSessionsWithProvider) decreaseUsedComputeUnits(cu uint64) er
 }
 
 func (cswp *ConsumerSessionsWithProvider) connectRawClientWithTimeout(ctx context.Context, addr string) (*pairingtypes.RelayerClient, *grpc.ClientConn, error) {
-	connectCtx, cancel := context.WithTimeout(ctx, TimeoutForEstablishingAConnection)
-	defer cancel()
-
-	conn, err := grpc.DialContext(connectCtx, addr, grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithBlock())
+	conn, err := DialProvider(ctx, cswp.providerTLS, cswp.PublicLavaAddress, addr)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -200,7 +327,7 @@ func (cswp *ConsumerSessionsWithProvider) connectRawClientWithTimeout(ctx contex
 	return &c, conn, nil
 }
 
-func (cswp *ConsumerSessionsWithProvider) getConsumerSessionInstanceFromEndpoint(endpoint *Endpoint, numberOfResets uint64) (singleConsumerSession *SingleConsumerSession, pairingEpoch uint64, err error) {
+func (cswp *ConsumerSessionsWithProvider) getConsumerSessionInstanceFromEndpoint(endpoint *Endpoint, numberOfResets uint64, sessionIdRand Rand) (singleConsumerSession *SingleConsumerSession, pairingEpoch uint64, err error) {
 	// TODO: validate that the endpoint even belongs to the ConsumerSessionsWithProvider and is enabled.
 
 	// Multiply numberOfReset +1 by MaxAllowedBlockListedSessionPerProvider as every reset needs to allow more blocked sessions allowed.
@@ -239,7 +366,7 @@ func (cswp *ConsumerSessionsWithProvider) getConsumerSessionInstanceFromEndpoint
 
 	randomSessionId := int64(0)
 	for randomSessionId == 0 { // we don't allow 0
-		randomSessionId = rand.Int63()
+		randomSessionId = sessionIdRand.Int63()
 	}
 
 	consumerSession := &SingleConsumerSession{
@@ -253,14 +380,95 @@ func (cswp *ConsumerSessionsWithProvider) getConsumerSessionInstanceFromEndpoint
 	return consumerSession, cswp.PairingEpoch, nil
 }
 
+// preferredEndpoints orders the endpoints to connect to: the ones without a measured latency come first so each of them gets
+// measured, those in the consumer's geolocation before the others, then the measured ones from the lowest latency.
+// cswp.Lock must be locked
+func (cswp *ConsumerSessionsWithProvider) preferredEndpoints(geolocation uint64) []*Endpoint {
+	endpoints := make([]*Endpoint, len(cswp.Endpoints))
+	copy(endpoints, cswp.Endpoints)
+	sort.SliceStable(endpoints, func(i, j int) bool {
+		if (endpoints[i].latency == 0) != (endpoints[j].latency == 0) {
+			return endpoints[i].latency == 0
+		}
+		if endpoints[i].latency == 0 {
+			return endpoints[i].Geolocation == geolocation && endpoints[j].Geolocation != geolocation
+		}
+		return endpoints[i].latency < endpoints[j].latency
+	})
+	return endpoints
+}
+
+// recordEndpointLatency adds a relay or probe latency to the average latency of the endpoint that served it
+func (cswp *ConsumerSessionsWithProvider) recordEndpointLatency(endpoint *Endpoint, latency time.Duration) {
+	if endpoint == nil || latency <= 0 {
+		return
+	}
+	cswp.Lock.Lock()
+	defer cswp.Lock.Unlock()
+	if endpoint.latency == 0 {
+		endpoint.latency = latency
+		return
+	}
+	endpoint.latency += (latency - endpoint.latency) / EndpointLatencyDecay
+}
+
+// isEndpointRelayError returns whether the relay failed on the way to the provider, which another endpoint of the provider may not suffer from
+func isEndpointRelayError(errorReceived error) bool {
+	code := status.Code(errorReceived)
+	return code == codes.DeadlineExceeded || code == codes.Unavailable || errors.Is(errorReceived, context.DeadlineExceeded)
+}
+
+// countEndpointRelayFailure counts a relay that failed on the endpoint, and returns whether the endpoint failed
+// MaxConsecutiveEndpointRelayFailures relays in a row
+func (cswp *ConsumerSessionsWithProvider) countEndpointRelayFailure(endpoint *Endpoint) (exhausted bool) {
+	if endpoint == nil {
+		return false
+	}
+	cswp.Lock.Lock()
+	defer cswp.Lock.Unlock()
+	endpoint.relayFailures++
+	return endpoint.relayFailures >= MaxConsecutiveEndpointRelayFailures
+}
+
+func (cswp *ConsumerSessionsWithProvider) resetEndpointRelayFailures(endpoint *Endpoint) {
+	if endpoint == nil {
+		return
+	}
+	cswp.Lock.Lock()
+	defer cswp.Lock.Unlock()
+	endpoint.relayFailures = 0
+}
+
+// blockEndpoint disables a failing endpoint for the rest of the epoch so the provider is used through its other endpoints.
+// the last enabled endpoint isn't disabled, it returns false and the caller decides whether to block the provider instead
+func (cswp *ConsumerSessionsWithProvider) blockEndpoint(endpoint *Endpoint) (blocked bool) {
+	if endpoint == nil {
+		return false
+	}
+	cswp.Lock.Lock()
+	defer cswp.Lock.Unlock()
+	if !endpoint.Enabled {
+		return true
+	}
+	for _, otherEndpoint := range cswp.Endpoints {
+		if otherEndpoint != endpoint && otherEndpoint.Enabled {
+			endpoint.Enabled = false
+			utils.LavaFormatWarning("disabling failing provider endpoint for the duration of current epoch", nil, utils.Attribute{Key: "Endpoint", Value: endpoint.NetworkAddress}, utils.Attribute{Key: "address", Value: cswp.PublicLavaAddress})
+			return true
+		}
+	}
+	return false
+}
+
 // fetching an endpoint from a ConsumerSessionWithProvider and establishing a connection,
 // can fail without an error if trying to connect once to each endpoint but none of them are active.
-func (cswp *ConsumerSessionsWithProvider) fetchEndpointConnectionFromConsumerSessionWithProvider(ctx context.Context) (connected bool, endpointPtr *Endpoint, providerAddress string, err error) {
+// endpoints are tried in the order of preferredEndpoints, so the consumer ends up on the endpoint that's fastest for it.
+func (cswp *ConsumerSessionsWithProvider) fetchEndpointConnectionFromConsumerSessionWithProvider(ctx context.Context, geolocation uint64) (connected bool, endpointPtr *Endpoint, providerAddress string, err error) {
 	getConnectionFromConsumerSessionsWithProvider := func(ctx context.Context) (connected bool, endpointPtr *Endpoint, allDisabled bool) {
 		cswp.Lock.Lock()
 		defer cswp.Lock.Unlock()
 
-		for idx, endpoint := range cswp.Endpoints {
+		for _, endpoint := range cswp.preferredEndpoints(geolocation) {
 			if !endpoint.Enabled {
 				continue
 			}
@@ -271,7 +479,14 @@ func (cswp *ConsumerSessionsWithProvider) fetchEndpointConnectionFromConsumerSes
 				client, conn, err := cswp.connectRawClientWithTimeout(ctx, endpoint.NetworkAddress)
 				if err != nil {
 					endpoint.ConnectionRefusals++
-					utils.LavaFormatError("error connecting to provider", err, utils.Attribute{Key: "provider endpoint", Value: endpoint.NetworkAddress}, utils.Attribute{Key: "provider address", Value: cswp.PublicLavaAddress}, utils.Attribute{Key: "endpoint", Value: endpoint})
+					atomic.AddUint64(&cswp.errorCounts.connectionFailures, 1)
+					if ProviderIdentityMismatchError.Is(err) {
+						// another attempt would reach the same certificate, the endpoint isn't the provider of the pairing
+						endpoint.Enabled = false
+						utils.LavaFormatError("disabling provider endpoint for the duration of current epoch, its certificate doesn't match the provider", err, utils.Attribute{Key: "Endpoint", Value: endpoint.NetworkAddress}, utils.Attribute{Key: "address", Value: cswp.PublicLavaAddress})
+						return false
+					}
+					utils.LavaFormatRepeatedError("error connecting to provider", err, utils.Attribute{Key: "provider endpoint", Value: endpoint.NetworkAddress}, utils.Attribute{Key: "provider address", Value: cswp.PublicLavaAddress}, utils.Attribute{Key: "endpoint", Value: endpoint})
 					if endpoint.ConnectionRefusals >= MaxConsecutiveConnectionAttempts {
 						endpoint.Enabled = false
 						utils.LavaFormatWarning("disabling provider endpoint for the duration of current epoch.", nil, utils.Attribute{Key: "Endpoint", Value: endpoint.NetworkAddress}, utils.Attribute{Key: "address", Value: cswp.PublicLavaAddress})
@@ -300,7 +515,6 @@ func (cswp *ConsumerSessionsWithProvider) fetchEndpointConnectionFromConsumerSes
 					continue
 				}
 			}
-			cswp.Endpoints[idx] = endpoint
 			return true, endpoint, false
 		}
 
@@ -334,7 +548,7 @@ func (cs *SingleConsumerSession) CalculateExpectedLatency(timeoutGivenToRelay ti
 	return expectedLatency
 }
 
-func (cs *SingleConsumerSession) CalculateQoS(cu uint64, latency time.Duration, expectedLatency time.Duration, blockHeightDiff int64, numOfProviders int, servicersToCount int64) {
+func (cs *SingleConsumerSession) CalculateQoS(cu uint64, latency time.Duration, expectedLatency time.Duration, blockHeightDiff int64, syncScore sdk.Dec) {
 	// Add current Session QoS
 	cs.QoSInfo.TotalRelays++    // increase total relays
 	cs.QoSInfo.AnsweredRelays++ // increase answered relays
@@ -365,22 +579,23 @@ func (cs *SingleConsumerSession) CalculateQoS(cu uint64, latency time.Duration,
 	cs.QoSInfo.LatencyScoreList = insertSorted(cs.QoSInfo.LatencyScoreList, latencyScore)
 	cs.QoSInfo.LastQoSReport.Latency = cs.QoSInfo.LatencyScoreList[int(float64(len(cs.QoSInfo.LatencyScoreList))*PercentileToCalculateLatency)]
 
-	if int64(numOfProviders) > int64(math.Ceil(float64(servicersToCount)*MinProvidersForSync)) { //
-		if blockHeightDiff <= 0 { // if the diff is bigger than 0 than the block is too old (blockHeightDiff = expected - allowedLag - blockHeight) and we don't give him the score
-			cs.QoSInfo.SyncScoreSum++
-		}
-	} else {
-		cs.QoSInfo.SyncScoreSum++
+	if cs.QoSInfo.SyncScoreSum.IsNil() {
+		cs.QoSInfo.SyncScoreSum = sdk.ZeroDec()
 	}
+	cs.QoSInfo.SyncScoreSum = cs.QoSInfo.SyncScoreSum.Add(syncScore)
 	cs.QoSInfo.TotalSyncScore++
 
-	cs.QoSInfo.LastQoSReport.Sync = sdk.NewDec(cs.QoSInfo.SyncScoreSum).QuoInt64(cs.QoSInfo.TotalSyncScore)
+	cs.QoSInfo.LastQoSReport.Sync = cs.QoSInfo.SyncScoreSum.QuoInt64(cs.QoSInfo.TotalSyncScore)
+	cs.QoSInfo.LatestAvailability = sdk.OneDec() // the relay was answered
+	cs.QoSInfo.LatestLatencyScore = latencyScore
+	cs.QoSInfo.LatestSyncScore = syncScore
+	cs.QoSInfo.LatestBlockLag = blockHeightDiff
 
 	if sdk.OneDec().GT(cs.QoSInfo.LastQoSReport.Sync) {
 		utils.LavaFormatDebug("QoS Sync report",
 			utils.Attribute{Key: "Sync", Value: cs.QoSInfo.LastQoSReport.Sync},
 			utils.Attribute{Key: "block diff", Value: blockHeightDiff},
-			utils.Attribute{Key: "sync score", Value: strconv.FormatInt(cs.QoSInfo.SyncScoreSum, 10) + "/" + strconv.FormatInt(cs.QoSInfo.TotalSyncScore, 10)},
+			utils.Attribute{Key: "sync score", Value: cs.QoSInfo.SyncScoreSum.String() + "/" + strconv.FormatInt(cs.QoSInfo.TotalSyncScore, 10)},
 			utils.Attribute{Key: "session_id", Value: blockHeightDiff},
 		)
 	}
@@ -0,0 +1,88 @@
+package lavasession
+
+import (
+	"encoding/json"
+
+	"github.com/lavanet/lava/utils"
+	bolt "go.etcd.io/bbolt"
+)
+
+const (
+	ConsumerUsageStoreFlag = "usage-store-path"
+	usageStoreBucket       = "usage"
+)
+
+// ConsumerUsageRecord is the cu a consumer signed to a provider in an epoch on one of its endpoints
+type ConsumerUsageRecord struct {
+	Epoch        uint64 `json:"epoch"`
+	ChainID      string `json:"chain_id"`
+	ApiInterface string `json:"api_interface"`
+	Provider     string `json:"provider"`
+	SignedCu     uint64 `json:"signed_cu"`
+}
+
+// ConsumerUsageStore persists the cu a consumer signed to its providers, to compare with the relay payments they claimed
+type ConsumerUsageStore interface {
+	SaveUsage(record ConsumerUsageRecord) error
+	LoadUsage() ([]ConsumerUsageRecord, error)
+	Close() error
+}
+
+// BoltConsumerUsageStore keeps the records in a bolt file, keyed like the provider session store by the big endian epoch
+type BoltConsumerUsageStore struct {
+	db *bolt.DB
+}
+
+func NewBoltConsumerUsageStore(path string) (*BoltConsumerUsageStore, error) {
+	db, err := bolt.Open(path, 0o600, &bolt.Options{Timeout: sessionStoreOpenTimeout})
+	if err != nil {
+		return nil, utils.LavaFormatError("failed opening consumer usage store", err, utils.Attribute{Key: "path", Value: path})
+	}
+	err = db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists([]byte(usageStoreBucket))
+		return err
+	})
+	if err != nil {
+		db.Close()
+		return nil, utils.LavaFormatError("failed initializing consumer usage store", err, utils.Attribute{Key: "path", Value: path})
+	}
+	return &BoltConsumerUsageStore{db: db}, nil
+}
+
+// SaveUsage keeps the highest signed cu of a provider in an epoch, a pairing is saved again with the relays that were still in flight
+func (bcus *BoltConsumerUsageStore) SaveUsage(record ConsumerUsageRecord) error {
+	value, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	key := sessionStoreKey(record.Epoch, record.ChainID, record.ApiInterface, record.Provider)
+	return bcus.db.Batch(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket([]byte(usageStoreBucket))
+		if storedValue := bucket.Get(key); storedValue != nil {
+			stored := ConsumerUsageRecord{}
+			if json.Unmarshal(storedValue, &stored) == nil && stored.SignedCu >= record.SignedCu {
+				return nil
+			}
+		}
+		return bucket.Put(key, value)
+	})
+}
+
+func (bcus *BoltConsumerUsageStore) LoadUsage() (records []ConsumerUsageRecord, err error) {
+	err = bcus.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket([]byte(usageStoreBucket)).ForEach(func(key, value []byte) error {
+			record := ConsumerUsageRecord{}
+			if err := json.Unmarshal(value, &record); err != nil {
+				utils.LavaFormatWarning("skipping invalid consumer usage record", err)
+				return nil
+			}
+			records = append(records, record)
+			return nil
+		})
+	})
+	return records, err
+}
+
+func (bcus *BoltConsumerUsageStore) Close() error {
+	return bcus.db.Close()
+}
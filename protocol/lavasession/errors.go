@@ -26,6 +26,11 @@ var ( // Consumer Side Errors
 	FailedToConnectToEndPointForDataReliabilityError     = sdkerrors.New("FailedToConnectToEndPointForDataReliability Error", 683, "Failed to connect to a providers endpoints")
 	DataReliabilityEpochMismatchError                    = sdkerrors.New("DataReliabilityEpochMismatch Error", 684, "Data reliability epoch mismatch original session epoch.")
 	NoDataReliabilitySessionWasCreatedError              = sdkerrors.New("NoDataReliabilitySessionWasCreated Error", 685, "No Data reliability session was created")
+	ProviderNotInPairingError                            = sdkerrors.New("ProviderNotInPairing Error", 686, "Requested provider is not in the current pairing.")
+	RelayTimeoutExceededError                            = sdkerrors.New("RelayTimeoutExceeded Error", 687, "Relay did not complete within the requested timeout.")
+	ProjectCuQuotaExhaustedError                         = sdkerrors.New("ProjectCuQuotaExhausted Error", 688, "Project cu allowance for the epoch is exhausted.")
+	SessionReclaimedError                                = sdkerrors.New("SessionReclaimed Error", 689, "Session was locked past the idle timeout and reclaimed.")
+	ProviderIdentityMismatchError                        = sdkerrors.New("ProviderIdentityMismatch Error", 690, "Provider certificate doesn't match its pins or lava address identity.")
 )
 
 var ( // Provider Side Errors
@@ -48,4 +53,12 @@ var ( // Provider Side Errors
 	CouldNotFindIndexAsConsumerNotYetRegisteredError = sdkerrors.New("CouldNotFindIndexAsConsumerNotYetRegistered Error", 897, "fetching provider index from psm failed")
 	ProviderIndexMisMatchError                       = sdkerrors.New("ProviderIndexMisMatch Error", 898, "provider index mismatch")
 	SessionIdNotFoundError                           = sdkerrors.New("SessionIdNotFound Error", 899, "Session Id not found")
+	ConsumerRateLimitExceededError                   = sdkerrors.New("ConsumerRateLimitExceeded Error", 900, "Consumer exceeded the provider relay rate limit")
+	ConsumerCUBudgetExceededError                    = sdkerrors.New("ConsumerCUBudgetExceeded Error", 901, "Consumer exceeded the provider cu budget for the epoch")
+	ProviderNodeUnhealthyError                       = sdkerrors.New("ProviderNodeUnhealthy Error", 902, "Provider's node is unhealthy, relays are not accepted until it recovers")
+	ProtocolVersionMismatchError                     = sdkerrors.New("ProtocolVersionMismatch Error", 903, "Consumer and provider have no common relay protocol version")
+	EndpointRateLimitExceededError                   = sdkerrors.New("EndpointRateLimitExceeded Error", 904, "Consumer endpoint exceeded its relays per second limit")
+	SubscriptionConsumerTooSlowError                 = sdkerrors.New("SubscriptionConsumerTooSlow Error", 905, "Consumer fell too far behind a subscription shared with other consumers")
+	BadgeCuAllocationExceededError                   = sdkerrors.New("BadgeCuAllocationExceeded Error", 906, "Badge user exceeded the cu allocation of its badge for the epoch")
+	NodeResponseTooLargeError                        = sdkerrors.New("NodeResponseTooLarge Error", 907, "Node response exceeds the provider's maximum response size for the api")
 )
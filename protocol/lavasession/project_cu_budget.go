@@ -0,0 +1,93 @@
+package lavasession
+
+import (
+	"sync"
+	"time"
+
+	"github.com/lavanet/lava/protocol/common"
+	"github.com/lavanet/lava/utils"
+)
+
+// the retry hint when the allowance should have renewed already but the next epoch wasn't seen yet
+const projectCuBudgetMinRetryAfter = time.Second
+
+// ProjectCuBudget tracks the cu the consumer relays on a chain against its project's cu allowance for the epoch, so relays the
+// allowance can't cover are rejected by the consumer instead of failing at the providers.
+// a nil budget, or one that wasn't given a limited allowance, allows every relay
+type ProjectCuBudget struct {
+	lock      sync.Mutex
+	clock     Clock
+	limited   bool
+	epoch     uint64
+	allowance uint64
+	usedCu    uint64
+	epochEnd  time.Time // the estimated time the allowance renews
+}
+
+func NewProjectCuBudget(clock Clock) *ProjectCuBudget {
+	return &ProjectCuBudget{clock: clock}
+}
+
+// SetAllowance sets the cu the project can still use on the chain in the epoch, limited is false when nothing limits it.
+// untilEpochEnd estimates when the allowance renews. the cu already relayed in the epoch is kept when the allowance of the same
+// epoch is set again
+func (pcb *ProjectCuBudget) SetAllowance(epoch uint64, allowance uint64, limited bool, untilEpochEnd time.Duration) {
+	if pcb == nil {
+		return
+	}
+	pcb.lock.Lock()
+	defer pcb.lock.Unlock()
+	if epoch < pcb.epoch {
+		return // an update that arrived after the next epoch's
+	}
+	if epoch != pcb.epoch {
+		pcb.usedCu = 0
+	}
+	pcb.epoch = epoch
+	pcb.allowance = allowance
+	pcb.limited = limited
+	pcb.epochEnd = pcb.clock.Now().Add(untilEpochEnd)
+}
+
+// Reserve takes the cu of a relay from the allowance, returning the epoch it was taken from. when the allowance can't cover it
+// the error is a common.RetryAfterError hinting when the allowance renews
+func (pcb *ProjectCuBudget) Reserve(cu uint64) (epoch uint64, err error) {
+	if pcb == nil {
+		return 0, nil
+	}
+	pcb.lock.Lock()
+	defer pcb.lock.Unlock()
+	if !pcb.limited {
+		return pcb.epoch, nil
+	}
+	if pcb.usedCu+cu > pcb.allowance {
+		retryAfter := pcb.epochEnd.Sub(pcb.clock.Now())
+		if retryAfter < projectCuBudgetMinRetryAfter {
+			retryAfter = projectCuBudgetMinRetryAfter
+		}
+		return pcb.epoch, common.NewRetryAfterError(utils.LavaFormatWarning("project cu quota exhausted, relay is over the project's cu allowance for the epoch", ProjectCuQuotaExhaustedError,
+			utils.Attribute{Key: "epoch", Value: pcb.epoch},
+			utils.Attribute{Key: "usedCu", Value: pcb.usedCu},
+			utils.Attribute{Key: "relayCu", Value: cu},
+			utils.Attribute{Key: "allowance", Value: pcb.allowance},
+		), retryAfter)
+	}
+	pcb.usedCu += cu
+	return pcb.epoch, nil
+}
+
+// Refund returns the cu of a relay that failed, cu reserved in an epoch that already ended isn't returned to the next one
+func (pcb *ProjectCuBudget) Refund(epoch uint64, cu uint64) {
+	if pcb == nil {
+		return
+	}
+	pcb.lock.Lock()
+	defer pcb.lock.Unlock()
+	if epoch != pcb.epoch || !pcb.limited {
+		return
+	}
+	if cu > pcb.usedCu {
+		cu = pcb.usedCu
+	}
+	pcb.usedCu -= cu
+}
@@ -0,0 +1,73 @@
+package lavasession
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lavanet/lava/protocol/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProjectCuBudgetUnlimited(t *testing.T) {
+	var nilBudget *ProjectCuBudget
+	_, err := nilBudget.Reserve(1000)
+	require.NoError(t, err)
+
+	// no allowance was set yet
+	budget := NewProjectCuBudget(&fakeClock{now: time.Now()})
+	_, err = budget.Reserve(1000)
+	require.NoError(t, err)
+
+	budget.SetAllowance(100, 0, false, time.Minute)
+	_, err = budget.Reserve(1000)
+	require.NoError(t, err)
+}
+
+func TestProjectCuBudget(t *testing.T) {
+	clock := &fakeClock{now: time.Now()}
+	budget := NewProjectCuBudget(clock)
+	budget.SetAllowance(100, 50, true, 30*time.Second)
+
+	epoch, err := budget.Reserve(30)
+	require.NoError(t, err)
+	require.Equal(t, uint64(100), epoch)
+	_, err = budget.Reserve(20)
+	require.NoError(t, err)
+
+	clock.Sleep(10 * time.Second)
+	_, err = budget.Reserve(10)
+	require.True(t, ProjectCuQuotaExhaustedError.Is(err))
+	var retryAfterError *common.RetryAfterError
+	require.True(t, errors.As(err, &retryAfterError))
+	require.Equal(t, 20*time.Second, retryAfterError.RetryAfter)
+
+	// a failed relay gives its cu back
+	budget.Refund(epoch, 30)
+	_, err = budget.Reserve(10)
+	require.NoError(t, err)
+
+	// the same epoch's allowance queried again keeps the used cu
+	budget.SetAllowance(100, 40, true, 20*time.Second)
+	_, err = budget.Reserve(30)
+	require.Error(t, err)
+
+	// the allowance renews with the epoch, a late refund of the last one is ignored
+	budget.SetAllowance(120, 50, true, time.Minute)
+	budget.Refund(epoch, 30)
+	_, err = budget.Reserve(50)
+	require.NoError(t, err)
+	_, err = budget.Reserve(1)
+	require.Error(t, err)
+
+	// an update of an older epoch arriving late doesn't replace the current one
+	budget.SetAllowance(100, 1000, true, 0)
+	_, err = budget.Reserve(1)
+	require.Error(t, err)
+
+	// the epoch end passed without the next epoch's allowance
+	clock.Sleep(2 * time.Minute)
+	_, err = budget.Reserve(1)
+	require.True(t, errors.As(err, &retryAfterError))
+	require.Equal(t, projectCuBudgetMinRetryAfter, retryAfterError.RetryAfter)
+}
@@ -0,0 +1,64 @@
+package lavasession
+
+import (
+	"github.com/lavanet/lava/utils"
+)
+
+const (
+	ProtocolVersion             uint32 = 5 // the relay protocol version of this binary
+	MinSupportedProtocolVersion uint32 = 1 // the oldest version this binary still relays with
+	LegacyProtocolVersion       uint32 = 1 // peers that don't report a version, from before versions were exchanged
+)
+
+type ProtocolFeature string
+
+const (
+	ReplyTimestampFeature     ProtocolFeature = "reply-timestamp"     // providers sign a timestamp on replies
+	EarliestBlockFeature      ProtocolFeature = "earliest-block"      // providers advertise the earliest block they serve
+	RelayApiNameFeature       ProtocolFeature = "relay-api-name"      // consumers sign the name of the relayed api in the relay session
+	HashedFinalizationFeature ProtocolFeature = "hashed-finalization" // providers sign the hash of the finalization data
+)
+
+// protocolFeatureVersions is the negotiation table, the protocol version each feature was introduced in.
+// peers relay with the lower of their versions, so a feature is used only when both sides support it
+var protocolFeatureVersions = map[ProtocolFeature]uint32{
+	ReplyTimestampFeature:     2,
+	EarliestBlockFeature:      3,
+	RelayApiNameFeature:       4,
+	HashedFinalizationFeature: 5,
+}
+
+// EffectiveProtocolVersion returns the version a peer runs, peers that didn't report one run the legacy version
+func EffectiveProtocolVersion(version uint32) uint32 {
+	if version == 0 {
+		return LegacyProtocolVersion
+	}
+	return version
+}
+
+// NegotiateProtocolVersion returns the highest version both this binary and a peer reporting peerVersion support
+func NegotiateProtocolVersion(peerVersion uint32) (uint32, error) {
+	peerVersion = EffectiveProtocolVersion(peerVersion)
+	if peerVersion < MinSupportedProtocolVersion {
+		return 0, utils.LavaFormatWarning("peer protocol version is no longer supported", ProtocolVersionMismatchError, utils.Attribute{Key: "peerVersion", Value: peerVersion}, utils.Attribute{Key: "minSupportedVersion", Value: MinSupportedProtocolVersion})
+	}
+	if peerVersion > ProtocolVersion {
+		return ProtocolVersion, nil
+	}
+	return peerVersion, nil
+}
+
+// VerifyProtocolVersion checks a peer relays with a version this binary supports, a negotiated version is never newer than ours
+func VerifyProtocolVersion(version uint32) error {
+	version = EffectiveProtocolVersion(version)
+	if version < MinSupportedProtocolVersion || version > ProtocolVersion {
+		return utils.LavaFormatWarning("unsupported relay protocol version", ProtocolVersionMismatchError, utils.Attribute{Key: "version", Value: version}, utils.Attribute{Key: "minSupportedVersion", Value: MinSupportedProtocolVersion}, utils.Attribute{Key: "protocolVersion", Value: ProtocolVersion})
+	}
+	return nil
+}
+
+// ProtocolVersionSupports returns whether peers relaying with version use feature
+func ProtocolVersionSupports(version uint32, feature ProtocolFeature) bool {
+	featureVersion, ok := protocolFeatureVersions[feature]
+	return ok && EffectiveProtocolVersion(version) >= featureVersion
+}
@@ -0,0 +1,46 @@
+package lavasession
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNegotiateProtocolVersion(t *testing.T) {
+	for _, tt := range []struct {
+		name        string
+		peerVersion uint32
+		expected    uint32
+	}{
+		{name: "legacy peer", peerVersion: 0, expected: LegacyProtocolVersion},
+		{name: "same version", peerVersion: ProtocolVersion, expected: ProtocolVersion},
+		{name: "older peer", peerVersion: ProtocolVersion - 1, expected: ProtocolVersion - 1},
+		{name: "newer peer", peerVersion: ProtocolVersion + 5, expected: ProtocolVersion},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			version, err := NegotiateProtocolVersion(tt.peerVersion)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, version)
+			require.NoError(t, VerifyProtocolVersion(version))
+		})
+	}
+}
+
+func TestVerifyProtocolVersion(t *testing.T) {
+	require.NoError(t, VerifyProtocolVersion(0))
+	require.NoError(t, VerifyProtocolVersion(ProtocolVersion))
+	// a consumer can't relay with a version newer than the provider's, it should have negotiated down
+	err := VerifyProtocolVersion(ProtocolVersion + 1)
+	require.True(t, ProtocolVersionMismatchError.Is(err))
+}
+
+func TestProtocolVersionSupports(t *testing.T) {
+	require.False(t, ProtocolVersionSupports(0, ReplyTimestampFeature))
+	require.False(t, ProtocolVersionSupports(LegacyProtocolVersion, EarliestBlockFeature))
+	require.True(t, ProtocolVersionSupports(2, ReplyTimestampFeature))
+	require.False(t, ProtocolVersionSupports(2, EarliestBlockFeature))
+	require.False(t, ProtocolVersionSupports(ProtocolVersion, "unknown-feature"))
+	for feature := range protocolFeatureVersions {
+		require.True(t, ProtocolVersionSupports(ProtocolVersion, feature))
+	}
+}
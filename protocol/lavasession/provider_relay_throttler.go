@@ -0,0 +1,138 @@
+package lavasession
+
+import (
+	"sync"
+	"time"
+
+	"github.com/lavanet/lava/utils"
+)
+
+const (
+	RelaysPerSecondLimitFlag = "relays-per-second-per-consumer"
+	CUPerEpochLimitFlag      = "cu-per-epoch-per-consumer"
+)
+
+// ProviderRelayThrottlerConfig holds the local limits a provider applies to each consumer, zero disables a limit
+type ProviderRelayThrottlerConfig struct {
+	RelaysPerSecond      uint64
+	ComputeUnitsPerEpoch uint64
+}
+
+type consumerRelayBucket struct {
+	tokens     float64
+	lastRefill time.Time
+}
+
+// ProviderRelayThrottler rejects relays of consumers that exceed the provider's local request rate or epoch cu budget,
+// before the relay reaches the node. consumers are identified by their project key, which is the signing consumer address.
+// a nil throttler allows every relay
+type ProviderRelayThrottler struct {
+	lock        sync.Mutex
+	config      ProviderRelayThrottlerConfig
+	buckets     map[string]*consumerRelayBucket
+	usedCU      map[uint64]map[string]uint64 // epoch -> consumer -> cu
+	latestEpoch uint64
+	now         func() time.Time
+}
+
+// NewProviderRelayThrottler returns nil when no limit is configured
+func NewProviderRelayThrottler(config ProviderRelayThrottlerConfig) *ProviderRelayThrottler {
+	if config.RelaysPerSecond == 0 && config.ComputeUnitsPerEpoch == 0 {
+		return nil
+	}
+	return &ProviderRelayThrottler{
+		config:  config,
+		buckets: map[string]*consumerRelayBucket{},
+		usedCU:  map[uint64]map[string]uint64{},
+		now:     time.Now,
+	}
+}
+
+// TryAddRelay accounts a relay of the consumer on the given epoch, returning an error without accounting it if a limit would be exceeded
+func (prt *ProviderRelayThrottler) TryAddRelay(consumerAddress string, epoch uint64, cu uint64) error {
+	if prt == nil {
+		return nil
+	}
+	prt.lock.Lock()
+	defer prt.lock.Unlock()
+
+	var usedCU uint64
+	if prt.config.ComputeUnitsPerEpoch > 0 {
+		usedCU = prt.usedCU[epoch][consumerAddress]
+		if usedCU+cu > prt.config.ComputeUnitsPerEpoch {
+			return utils.LavaFormatWarning("consumer exceeded the provider cu budget for the epoch", ConsumerCUBudgetExceededError,
+				utils.Attribute{Key: "consumer", Value: consumerAddress},
+				utils.Attribute{Key: "epoch", Value: epoch},
+				utils.Attribute{Key: "usedCU", Value: usedCU},
+				utils.Attribute{Key: "relayCU", Value: cu},
+				utils.Attribute{Key: "budget", Value: prt.config.ComputeUnitsPerEpoch},
+			)
+		}
+	}
+
+	if prt.config.RelaysPerSecond > 0 {
+		now := prt.now()
+		limit := float64(prt.config.RelaysPerSecond)
+		bucket, ok := prt.buckets[consumerAddress]
+		if !ok {
+			bucket = &consumerRelayBucket{tokens: limit, lastRefill: now}
+			prt.buckets[consumerAddress] = bucket
+		}
+		bucket.tokens += now.Sub(bucket.lastRefill).Seconds() * limit
+		if bucket.tokens > limit {
+			bucket.tokens = limit
+		}
+		bucket.lastRefill = now
+		if bucket.tokens < 1 {
+			return utils.LavaFormatWarning("consumer exceeded the provider relay rate", ConsumerRateLimitExceededError,
+				utils.Attribute{Key: "consumer", Value: consumerAddress},
+				utils.Attribute{Key: "relaysPerSecond", Value: prt.config.RelaysPerSecond},
+			)
+		}
+		bucket.tokens--
+	}
+
+	if prt.config.ComputeUnitsPerEpoch > 0 {
+		if _, ok := prt.usedCU[epoch]; !ok {
+			prt.usedCU[epoch] = map[string]uint64{}
+		}
+		prt.usedCU[epoch][consumerAddress] = usedCU + cu
+	}
+	return nil
+}
+
+// UsedCU returns the cu accounted for the consumer on the given epoch
+func (prt *ProviderRelayThrottler) UsedCU(consumerAddress string, epoch uint64) uint64 {
+	if prt == nil {
+		return 0
+	}
+	prt.lock.Lock()
+	defer prt.lock.Unlock()
+	return prt.usedCU[epoch][consumerAddress]
+}
+
+// UpdateEpoch keeps the cu usage of the previous and current epochs, as relays of the previous epoch can still arrive,
+// and drops the rate buckets of idle consumers
+func (prt *ProviderRelayThrottler) UpdateEpoch(epoch uint64) {
+	if prt == nil {
+		return
+	}
+	prt.lock.Lock()
+	defer prt.lock.Unlock()
+	if epoch <= prt.latestEpoch {
+		return
+	}
+	previousEpoch := prt.latestEpoch
+	prt.latestEpoch = epoch
+	for epochStored := range prt.usedCU {
+		if epochStored < previousEpoch {
+			delete(prt.usedCU, epochStored)
+		}
+	}
+	now := prt.now()
+	for consumerAddress, bucket := range prt.buckets {
+		if now.Sub(bucket.lastRefill) > time.Second { // a bucket idle for a second is full anyway
+			delete(prt.buckets, consumerAddress)
+		}
+	}
+}
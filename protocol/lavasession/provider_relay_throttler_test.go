@@ -0,0 +1,62 @@
+package lavasession
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestProviderRelayThrottlerDisabled(t *testing.T) {
+	throttler := NewProviderRelayThrottler(ProviderRelayThrottlerConfig{})
+	require.Nil(t, throttler)
+	for i := 0; i < 100; i++ {
+		require.NoError(t, throttler.TryAddRelay("consumer", 1, 10))
+	}
+	throttler.UpdateEpoch(2)
+	require.Zero(t, throttler.UsedCU("consumer", 1))
+}
+
+func TestProviderRelayThrottlerRateLimit(t *testing.T) {
+	now := time.Now()
+	throttler := NewProviderRelayThrottler(ProviderRelayThrottlerConfig{RelaysPerSecond: 5})
+	throttler.now = func() time.Time { return now }
+
+	for i := 0; i < 5; i++ {
+		require.NoError(t, throttler.TryAddRelay("consumer", 1, 10))
+	}
+	err := throttler.TryAddRelay("consumer", 1, 10)
+	require.True(t, ConsumerRateLimitExceededError.Is(err))
+	// other consumers have their own rate
+	require.NoError(t, throttler.TryAddRelay("other consumer", 1, 10))
+
+	// tokens refill over time
+	now = now.Add(200 * time.Millisecond)
+	require.NoError(t, throttler.TryAddRelay("consumer", 1, 10))
+	require.Error(t, throttler.TryAddRelay("consumer", 1, 10))
+
+	// idle buckets are dropped on a new epoch
+	now = now.Add(2 * time.Second)
+	throttler.UpdateEpoch(2)
+	require.Len(t, throttler.buckets, 0)
+}
+
+func TestProviderRelayThrottlerCUBudget(t *testing.T) {
+	throttler := NewProviderRelayThrottler(ProviderRelayThrottlerConfig{ComputeUnitsPerEpoch: 100})
+	require.NoError(t, throttler.TryAddRelay("consumer", 10, 60))
+	err := throttler.TryAddRelay("consumer", 10, 50)
+	require.True(t, ConsumerCUBudgetExceededError.Is(err))
+	// rejected relays are not accounted
+	require.Equal(t, uint64(60), throttler.UsedCU("consumer", 10))
+	require.NoError(t, throttler.TryAddRelay("consumer", 10, 40))
+	require.Error(t, throttler.TryAddRelay("consumer", 10, 1))
+
+	// a new epoch has a new budget, the previous epoch's usage is kept until the one after
+	require.NoError(t, throttler.TryAddRelay("consumer", 20, 100))
+	throttler.UpdateEpoch(10)
+	throttler.UpdateEpoch(20)
+	require.Equal(t, uint64(100), throttler.UsedCU("consumer", 10))
+	throttler.UpdateEpoch(30)
+	require.Zero(t, throttler.UsedCU("consumer", 10))
+	require.Equal(t, uint64(100), throttler.UsedCU("consumer", 20))
+}
@@ -16,7 +16,8 @@ type ProviderSessionManager struct {
 	lock                                    sync.RWMutex
 	blockedEpochHeight                      uint64 // requests from this epoch are blocked
 	rpcProviderEndpoint                     *RPCProviderEndpoint
-	blockDistanceForEpochValidity           uint64 // sessionsWithAllConsumers with epochs older than ((latest epoch) - numberOfBlocksKeptInMemory) are deleted.
+	blockDistanceForEpochValidity           uint64               // sessionsWithAllConsumers with epochs older than ((latest epoch) - numberOfBlocksKeptInMemory) are deleted.
+	sessionStore                            ProviderSessionStore // optional, persists sessions so relay numbers and cu sums survive a restart
 }
 
 func (psm *ProviderSessionManager) GetProviderIndexWithConsumer(epoch uint64, consumerAddress string) (int64, int64, error) {
@@ -266,7 +267,62 @@ func (psm *ProviderSessionManager) OnSessionFailure(singleProviderSession *Singl
 
 // OnSessionDone unlocks the session gracefully, this happens when session finished successfully
 func (psm *ProviderSessionManager) OnSessionDone(singleProviderSession *SingleProviderSession, relayNumber uint64) (err error) {
-	return singleProviderSession.onSessionDone(relayNumber)
+	if psm.sessionStore == nil || singleProviderSession.userSessionsParent.atomicReadIsDataReliability() == isDataReliabilityPSWC {
+		return singleProviderSession.onSessionDone(relayNumber)
+	}
+	// the record is read while the session is still locked
+	record := singleProviderSession.sessionRecord(relayNumber)
+	err = singleProviderSession.onSessionDone(relayNumber)
+	if err != nil {
+		return err
+	}
+	storeErr := psm.sessionStore.SaveSession(psm.rpcProviderEndpoint.Key(), record)
+	if storeErr != nil {
+		utils.LavaFormatWarning("failed persisting provider session", storeErr, utils.Attribute{Key: "sessionID", Value: record.SessionID}, utils.Attribute{Key: "consumer", Value: record.ConsumerAddress})
+	}
+	return nil
+}
+
+// SetSessionStore restores the sessions persisted for this endpoint and persists finished relays from now on
+func (psm *ProviderSessionManager) SetSessionStore(sessionStore ProviderSessionStore) error {
+	records, err := sessionStore.LoadSessions(psm.rpcProviderEndpoint.Key())
+	if err != nil {
+		return utils.LavaFormatError("failed loading provider sessions from store", err, utils.Attribute{Key: "endpoint", Value: psm.rpcProviderEndpoint.Key()})
+	}
+	psm.lock.Lock()
+	defer psm.lock.Unlock()
+	psm.sessionStore = sessionStore
+	restored := 0
+	for _, record := range records {
+		if !IsEpochValidForUse(record.Epoch, psm.blockedEpochHeight) {
+			continue
+		}
+		mapOfProviderSessionsWithConsumer, foundEpochInMap := psm.sessionsWithAllConsumers[record.Epoch]
+		if !foundEpochInMap {
+			mapOfProviderSessionsWithConsumer = sessionData{sessionMap: make(map[string]*ProviderSessionsWithConsumer)}
+			psm.sessionsWithAllConsumers[record.Epoch] = mapOfProviderSessionsWithConsumer
+		}
+		providerSessionWithConsumer, foundAddressInMap := mapOfProviderSessionsWithConsumer.sessionMap[record.ConsumerAddress]
+		if !foundAddressInMap {
+			epochData := &ProviderSessionsEpochData{MaxComputeUnits: record.MaxComputeUnits}
+			providerSessionWithConsumer = NewProviderSessionsWithConsumer(record.ConsumerAddress, epochData, notDataReliabilityPSWC, record.SelfProviderIndex, record.PairedProviders)
+			mapOfProviderSessionsWithConsumer.sessionMap[record.ConsumerAddress] = providerSessionWithConsumer
+		}
+		if _, found := providerSessionWithConsumer.Sessions[record.SessionID]; found {
+			continue
+		}
+		providerSessionWithConsumer.Sessions[record.SessionID] = &SingleProviderSession{
+			userSessionsParent: providerSessionWithConsumer,
+			SessionID:          record.SessionID,
+			PairingEpoch:       record.Epoch,
+			RelayNum:           record.RelayNum,
+			CuSum:              record.CuSum,
+		}
+		providerSessionWithConsumer.epochData.UsedComputeUnits += record.CuSum
+		restored++
+	}
+	utils.LavaFormatInfo("restored provider sessions from store", utils.Attribute{Key: "endpoint", Value: psm.rpcProviderEndpoint.Key()}, utils.Attribute{Key: "sessions", Value: restored})
+	return nil
 }
 
 func (psm *ProviderSessionManager) RPCProviderEndpoint() *RPCProviderEndpoint {
@@ -290,6 +346,12 @@ func (psm *ProviderSessionManager) UpdateEpoch(epoch uint64) {
 	psm.sessionsWithAllConsumers = filterOldEpochEntries(psm.blockedEpochHeight, psm.sessionsWithAllConsumers)
 	psm.dataReliabilitySessionsWithAllConsumers = filterOldEpochEntries(psm.blockedEpochHeight, psm.dataReliabilitySessionsWithAllConsumers)
 	psm.subscriptionSessionsWithAllConsumers = filterOldEpochEntries(psm.blockedEpochHeight, psm.subscriptionSessionsWithAllConsumers)
+	if psm.sessionStore != nil {
+		err := psm.sessionStore.CompactSessions(psm.rpcProviderEndpoint.Key(), psm.blockedEpochHeight)
+		if err != nil {
+			utils.LavaFormatWarning("failed compacting provider session store", err, utils.Attribute{Key: "blockedEpoch", Value: psm.blockedEpochHeight})
+		}
+	}
 }
 
 func filterOldEpochEntries[T dataHandler](blockedEpochHeight uint64, allEpochsMap map[uint64]T) (validEpochsMap map[uint64]T) {
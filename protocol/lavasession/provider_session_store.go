@@ -0,0 +1,249 @@
+package lavasession
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"time"
+
+	"github.com/lavanet/lava/utils"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	bolt "go.etcd.io/bbolt"
+)
+
+const (
+	ProviderSessionStoreFlag            = "session-store-path"
+	sessionStoreOpenTimeout             = time.Second
+	sessionStoreEpochKeyLength          = 8
+	sessionStoreSessionIdKeyLength      = 8
+	sessionStoreSessionsBucket          = "sessions"
+	sessionStoreProofsBucket            = "proofs"
+	sessionStoreKeySeparator       byte = 0
+)
+
+// ProviderSessionRecord is the persisted state of a single provider session, enough to reject replayed relay numbers after a restart
+type ProviderSessionRecord struct {
+	ConsumerAddress   string `json:"consumer"`
+	Epoch             uint64 `json:"epoch"`
+	SessionID         uint64 `json:"session_id"`
+	RelayNum          uint64 `json:"relay_num"`
+	CuSum             uint64 `json:"cu_sum"`
+	MaxComputeUnits   uint64 `json:"max_cu"`
+	SelfProviderIndex int64  `json:"self_provider_index"`
+	PairedProviders   int64  `json:"paired_providers"`
+}
+
+// ProviderProofRecord is a persisted latest relay proof of a session, as kept by the reward server
+type ProviderProofRecord struct {
+	Epoch        uint64                     `json:"epoch"`
+	ConsumerAddr string                     `json:"consumer"`
+	ApiInterface string                     `json:"api_interface"`
+	Proof        *pairingtypes.RelaySession `json:"proof"`
+}
+
+// ProviderSessionStore persists provider sessions and relay proofs so they survive a restart
+type ProviderSessionStore interface {
+	SaveSession(endpointKey string, record ProviderSessionRecord) error
+	LoadSessions(endpointKey string) ([]ProviderSessionRecord, error)
+	CompactSessions(endpointKey string, blockedEpochHeight uint64) error
+	SaveProof(record ProviderProofRecord) error
+	LoadProofs() ([]ProviderProofRecord, error)
+	CompactProofs(activeEpochThreshold uint64) error
+	Close() error
+}
+
+// BoltProviderSessionStore keeps the records in a bolt file, keys start with the big endian epoch so compaction only walks the stale prefix
+type BoltProviderSessionStore struct {
+	db *bolt.DB
+}
+
+func NewBoltProviderSessionStore(path string) (*BoltProviderSessionStore, error) {
+	db, err := bolt.Open(path, 0o600, &bolt.Options{Timeout: sessionStoreOpenTimeout})
+	if err != nil {
+		return nil, utils.LavaFormatError("failed opening provider session store", err, utils.Attribute{Key: "path", Value: path})
+	}
+	err = db.Update(func(tx *bolt.Tx) error {
+		for _, bucket := range []string{sessionStoreSessionsBucket, sessionStoreProofsBucket} {
+			if _, err := tx.CreateBucketIfNotExists([]byte(bucket)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		db.Close()
+		return nil, utils.LavaFormatError("failed initializing provider session store", err, utils.Attribute{Key: "path", Value: path})
+	}
+	return &BoltProviderSessionStore{db: db}, nil
+}
+
+func sessionStoreKey(epoch uint64, parts ...string) []byte {
+	key := make([]byte, sessionStoreEpochKeyLength)
+	binary.BigEndian.PutUint64(key, epoch)
+	for _, part := range parts {
+		key = append(key, []byte(part)...)
+		key = append(key, sessionStoreKeySeparator)
+	}
+	return key
+}
+
+func sessionStoreKeyEpoch(key []byte) uint64 {
+	if len(key) < sessionStoreEpochKeyLength {
+		return 0
+	}
+	return binary.BigEndian.Uint64(key[:sessionStoreEpochKeyLength])
+}
+
+func sessionStoreSessionKey(record ProviderSessionRecord) []byte {
+	key := sessionStoreKey(record.Epoch, record.ConsumerAddress)
+	sessionId := make([]byte, sessionStoreSessionIdKeyLength)
+	binary.BigEndian.PutUint64(sessionId, record.SessionID)
+	return append(key, sessionId...)
+}
+
+// sessionStoreProofKey tells apart the sessions of a consumer on each chain, session ids are only unique within a chain
+func sessionStoreProofKey(record ProviderProofRecord) []byte {
+	key := sessionStoreKey(record.Epoch, record.ConsumerAddr, record.ApiInterface, record.Proof.SpecId)
+	sessionId := make([]byte, sessionStoreSessionIdKeyLength)
+	binary.BigEndian.PutUint64(sessionId, record.Proof.SessionId)
+	return append(key, sessionId...)
+}
+
+// SaveSession uses bolt batching, so concurrent relays finishing together share a single commit.
+// saves can arrive out of order once the session is unlocked, so a record never overwrites a later relay number
+func (bpss *BoltProviderSessionStore) SaveSession(endpointKey string, record ProviderSessionRecord) error {
+	value, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	return bpss.db.Batch(func(tx *bolt.Tx) error {
+		bucket, err := tx.Bucket([]byte(sessionStoreSessionsBucket)).CreateBucketIfNotExists([]byte(endpointKey))
+		if err != nil {
+			return err
+		}
+		key := sessionStoreSessionKey(record)
+		if storedValue := bucket.Get(key); storedValue != nil {
+			stored := ProviderSessionRecord{}
+			if json.Unmarshal(storedValue, &stored) == nil && stored.RelayNum > record.RelayNum {
+				return nil
+			}
+		}
+		return bucket.Put(key, value)
+	})
+}
+
+func (bpss *BoltProviderSessionStore) LoadSessions(endpointKey string) (records []ProviderSessionRecord, err error) {
+	err = bpss.db.View(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket([]byte(sessionStoreSessionsBucket)).Bucket([]byte(endpointKey))
+		if bucket == nil {
+			return nil
+		}
+		return bucket.ForEach(func(key, value []byte) error {
+			record := ProviderSessionRecord{}
+			if err := json.Unmarshal(value, &record); err != nil {
+				utils.LavaFormatWarning("skipping invalid provider session record", err, utils.Attribute{Key: "endpoint", Value: endpointKey})
+				return nil
+			}
+			records = append(records, record)
+			return nil
+		})
+	})
+	return records, err
+}
+
+// CompactSessions deletes the sessions of epochs that are no longer valid for use
+func (bpss *BoltProviderSessionStore) CompactSessions(endpointKey string, blockedEpochHeight uint64) error {
+	return bpss.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket([]byte(sessionStoreSessionsBucket)).Bucket([]byte(endpointKey))
+		if bucket == nil {
+			return nil
+		}
+		return deleteEpochsUpTo(bucket, blockedEpochHeight)
+	})
+}
+
+// SaveProof keeps the proof with the highest cu of each session
+func (bpss *BoltProviderSessionStore) SaveProof(record ProviderProofRecord) error {
+	if record.Proof == nil {
+		return nil
+	}
+	proof, err := record.Proof.Marshal()
+	if err != nil {
+		return err
+	}
+	key := sessionStoreProofKey(record)
+	return bpss.db.Batch(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket([]byte(sessionStoreProofsBucket))
+		if storedValue := bucket.Get(key); storedValue != nil {
+			stored := &pairingtypes.RelaySession{}
+			if stored.Unmarshal(storedValue) == nil && stored.CuSum > record.Proof.CuSum {
+				return nil
+			}
+		}
+		return bucket.Put(key, proof)
+	})
+}
+
+func (bpss *BoltProviderSessionStore) LoadProofs() (records []ProviderProofRecord, err error) {
+	err = bpss.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket([]byte(sessionStoreProofsBucket)).ForEach(func(key, value []byte) error {
+			parts := splitSessionStoreKey(key)
+			if len(parts) != 2 {
+				utils.LavaFormatWarning("skipping invalid relay proof key", nil, utils.Attribute{Key: "key", Value: key})
+				return nil
+			}
+			proof := &pairingtypes.RelaySession{}
+			if err := proof.Unmarshal(value); err != nil {
+				utils.LavaFormatWarning("skipping invalid relay proof", err)
+				return nil
+			}
+			records = append(records, ProviderProofRecord{Epoch: sessionStoreKeyEpoch(key), ConsumerAddr: parts[0], ApiInterface: parts[1], Proof: proof})
+			return nil
+		})
+	})
+	return records, err
+}
+
+// CompactProofs deletes the proofs of epochs that were already gathered for a rewards claim
+func (bpss *BoltProviderSessionStore) CompactProofs(activeEpochThreshold uint64) error {
+	return bpss.db.Update(func(tx *bolt.Tx) error {
+		return deleteEpochsUpTo(tx.Bucket([]byte(sessionStoreProofsBucket)), activeEpochThreshold)
+	})
+}
+
+func (bpss *BoltProviderSessionStore) Close() error {
+	return bpss.db.Close()
+}
+
+func deleteEpochsUpTo(bucket *bolt.Bucket, epoch uint64) error {
+	// keys are collected first, deleting while moving the cursor skips entries
+	staleKeys := [][]byte{}
+	cursor := bucket.Cursor()
+	for key, _ := cursor.First(); key != nil && sessionStoreKeyEpoch(key) <= epoch; key, _ = cursor.Next() {
+		staleKeys = append(staleKeys, append([]byte{}, key...))
+	}
+	for _, key := range staleKeys {
+		if err := bucket.Delete(key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// splitSessionStoreKey returns the separated string parts of a key, after the epoch prefix
+func splitSessionStoreKey(key []byte) (parts []string) {
+	if len(key) < sessionStoreEpochKeyLength {
+		return nil
+	}
+	rest := key[sessionStoreEpochKeyLength:]
+	start := 0
+	for idx, b := range rest {
+		if b == sessionStoreKeySeparator {
+			parts = append(parts, string(rest[start:idx]))
+			start = idx + 1
+			if len(parts) == 2 {
+				break
+			}
+		}
+	}
+	return parts
+}
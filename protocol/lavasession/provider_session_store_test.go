@@ -0,0 +1,112 @@
+package lavasession
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	"github.com/stretchr/testify/require"
+)
+
+func initBoltProviderSessionStore(t *testing.T) (*BoltProviderSessionStore, string) {
+	path := filepath.Join(t.TempDir(), "sessions.db")
+	store, err := NewBoltProviderSessionStore(path)
+	require.NoError(t, err)
+	return store, path
+}
+
+func TestBoltProviderSessionStoreSessions(t *testing.T) {
+	store, path := initBoltProviderSessionStore(t)
+	record := ProviderSessionRecord{ConsumerAddress: consumerOneAddress, Epoch: epoch1, SessionID: sessionId, RelayNum: 3, CuSum: 30, MaxComputeUnits: maxCu}
+	require.NoError(t, store.SaveSession("LAV1tendermint", record))
+	record.RelayNum = 4
+	require.NoError(t, store.SaveSession("LAV1tendermint", record))
+	// a save of an earlier relay arriving late doesn't overwrite
+	require.NoError(t, store.SaveSession("LAV1tendermint", ProviderSessionRecord{ConsumerAddress: consumerOneAddress, Epoch: epoch1, SessionID: sessionId, RelayNum: 2, CuSum: 20}))
+	require.NoError(t, store.SaveSession("LAV1tendermint", ProviderSessionRecord{ConsumerAddress: consumerOneAddress, Epoch: epoch2, SessionID: sessionId, RelayNum: 1}))
+	require.NoError(t, store.Close())
+
+	// records survive reopening and are kept per endpoint
+	store, err := NewBoltProviderSessionStore(path)
+	require.NoError(t, err)
+	defer store.Close()
+	records, err := store.LoadSessions("LAV1tendermint")
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+	require.Equal(t, record, records[0])
+	records, err = store.LoadSessions("LAV1rest")
+	require.NoError(t, err)
+	require.Empty(t, records)
+
+	require.NoError(t, store.CompactSessions("LAV1tendermint", epoch1))
+	records, err = store.LoadSessions("LAV1tendermint")
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	require.Equal(t, epoch2, records[0].Epoch)
+}
+
+func TestBoltProviderSessionStoreProofs(t *testing.T) {
+	store, _ := initBoltProviderSessionStore(t)
+	defer store.Close()
+	proof := &pairingtypes.RelaySession{SpecId: "LAV1", SessionId: sessionId, CuSum: 20, RelayNum: 2, Epoch: int64(epoch1)}
+	require.NoError(t, store.SaveProof(ProviderProofRecord{Epoch: epoch1, ConsumerAddr: consumerOneAddress, ApiInterface: "tendermint", Proof: proof}))
+	require.NoError(t, store.SaveProof(ProviderProofRecord{Epoch: epoch2, ConsumerAddr: consumerOneAddress, ApiInterface: "rest", Proof: proof}))
+	olderProof := &pairingtypes.RelaySession{SpecId: "LAV1", SessionId: sessionId, CuSum: 10, RelayNum: 1, Epoch: int64(epoch1)}
+	require.NoError(t, store.SaveProof(ProviderProofRecord{Epoch: epoch1, ConsumerAddr: consumerOneAddress, ApiInterface: "tendermint", Proof: olderProof}))
+
+	// the same session id of the consumer on another chain is another session
+	otherChainProof := &pairingtypes.RelaySession{SpecId: "ETH1", SessionId: sessionId, CuSum: 5, RelayNum: 1, Epoch: int64(epoch1)}
+	require.NoError(t, store.SaveProof(ProviderProofRecord{Epoch: epoch1, ConsumerAddr: consumerOneAddress, ApiInterface: "tendermint", Proof: otherChainProof}))
+
+	records, err := store.LoadProofs()
+	require.NoError(t, err)
+	require.Len(t, records, 3)
+	specProofs := map[string]uint64{}
+	for _, record := range records[:2] {
+		specProofs[record.Proof.SpecId] = record.Proof.CuSum
+	}
+	require.Equal(t, map[string]uint64{"LAV1": proof.CuSum, "ETH1": otherChainProof.CuSum}, specProofs)
+	require.Equal(t, epoch1, records[0].Epoch)
+	require.Equal(t, consumerOneAddress, records[0].ConsumerAddr)
+	require.Equal(t, "tendermint", records[0].ApiInterface)
+
+	require.NoError(t, store.CompactProofs(epoch1))
+	records, err = store.LoadProofs()
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	require.Equal(t, "rest", records[0].ApiInterface)
+}
+
+func TestProviderSessionManagerRestoresSessionsFromStore(t *testing.T) {
+	ctx := context.Background()
+	store, _ := initBoltProviderSessionStore(t)
+	defer store.Close()
+	psm, sps := prepareSession(t, ctx)
+	require.NoError(t, psm.SetSessionStore(store))
+	require.NoError(t, psm.OnSessionDone(sps, relayNumber))
+
+	// a restarted provider restores the relay number and cu of the session
+	restartedPsm := initProviderSessionManager()
+	require.NoError(t, restartedPsm.SetSessionStore(store))
+	providerSessionsWithConsumer, err := restartedPsm.IsActiveConsumer(epoch1, consumerOneAddress)
+	require.NoError(t, err)
+	require.Equal(t, relayCu, providerSessionsWithConsumer.atomicReadUsedComputeUnits())
+	require.Equal(t, maxCu, providerSessionsWithConsumer.atomicReadMaxComputeUnits())
+
+	// replaying the relay number is rejected
+	_, err = restartedPsm.GetSession(ctx, consumerOneAddress, epoch1, sessionId, relayNumber)
+	require.True(t, SessionOutOfSyncError.Is(err))
+
+	sps, err = restartedPsm.GetSession(ctx, consumerOneAddress, epoch1, sessionId, relayNumber+1)
+	require.NoError(t, err)
+	require.Equal(t, relayCu, sps.CuSum)
+	require.NoError(t, sps.PrepareSessionForUsage(ctx, relayCu, 2*relayCu, 0))
+	require.NoError(t, restartedPsm.OnSessionDone(sps, relayNumber+1))
+
+	// the epoch is compacted from the store once it is no longer valid
+	restartedPsm.UpdateEpoch(epoch2 + 1)
+	records, err := store.LoadSessions(restartedPsm.RPCProviderEndpoint().Key())
+	require.NoError(t, err)
+	require.Empty(t, records)
+}
@@ -0,0 +1,207 @@
+package lavasession
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/lavanet/lava/protocol/tracing"
+	"github.com/lavanet/lava/utils"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+const (
+	ProviderTLSFlag                = "provider-tls"
+	ProviderTLSCAFileFlag          = "provider-tls-ca-file"
+	ProviderTLSPinsFileFlag        = "provider-tls-pins-file"
+	ProviderTLSRequireIdentityFlag = "provider-tls-require-identity"
+	// a provider's certificate carries its identity as a URI SAN of this prefix followed by its lava address
+	ProviderIdentityURIPrefix = "spiffe://lava/"
+)
+
+// ProviderTLSConfig sets how the consumer verifies the provider endpoints it dials over tls
+type ProviderTLSConfig struct {
+	RootCAs         *x509.CertPool      // verifies the certificates of providers without pins, the system pool when nil
+	RequireIdentity bool                // the certificate must carry the identity of the provider of the pairing, ProviderIdentityURIPrefix+address
+	Pins            map[string][]string // hex sha256 of the public keys a provider's certificates may have, by provider address
+}
+
+// LoadProviderTLSConfig reads the ca file, pem certificates, and the pins file, a json object of provider addresses to their pins.
+// both files are optional
+func LoadProviderTLSConfig(caFile string, pinsFile string, requireIdentity bool) (*ProviderTLSConfig, error) {
+	config := &ProviderTLSConfig{RequireIdentity: requireIdentity, Pins: map[string][]string{}}
+	if caFile != "" {
+		caPem, err := os.ReadFile(caFile)
+		if err != nil {
+			return nil, utils.LavaFormatError("failed reading the provider tls ca file", err, utils.Attribute{Key: "path", Value: caFile})
+		}
+		config.RootCAs = x509.NewCertPool()
+		if !config.RootCAs.AppendCertsFromPEM(caPem) {
+			return nil, utils.LavaFormatError("no certificates in the provider tls ca file", nil, utils.Attribute{Key: "path", Value: caFile})
+		}
+	}
+	if pinsFile != "" {
+		pinsJson, err := os.ReadFile(pinsFile)
+		if err != nil {
+			return nil, utils.LavaFormatError("failed reading the provider tls pins file", err, utils.Attribute{Key: "path", Value: pinsFile})
+		}
+		if err := json.Unmarshal(pinsJson, &config.Pins); err != nil {
+			return nil, utils.LavaFormatError("invalid provider tls pins file", err, utils.Attribute{Key: "path", Value: pinsFile})
+		}
+		for provider, pins := range config.Pins {
+			for idx, pin := range pins {
+				decoded, err := hex.DecodeString(pin)
+				if err != nil || len(decoded) != sha256.Size {
+					return nil, utils.LavaFormatError("invalid provider tls pin, expected the hex sha256 of a public key", err, utils.Attribute{Key: "provider", Value: provider}, utils.Attribute{Key: "pin", Value: pin})
+				}
+				pins[idx] = strings.ToLower(pin)
+			}
+		}
+	}
+	return config, nil
+}
+
+// PublicKeyPin returns the pin of a certificate, the hex sha256 of its SubjectPublicKeyInfo. pinning the key and not the certificate
+// lets providers renew their certificates with the same key
+func PublicKeyPin(certificate *x509.Certificate) string {
+	hash := sha256.Sum256(certificate.RawSubjectPublicKeyInfo)
+	return hex.EncodeToString(hash[:])
+}
+
+// verifyProviderCertificate checks the certificate chain a provider presented on a dial to host. a pinned provider is verified by its
+// pins alone so it can use a self signed certificate, the others by the root cas
+func (ptc *ProviderTLSConfig) verifyProviderCertificate(providerAddress string, host string, state tls.ConnectionState) error {
+	if len(state.PeerCertificates) == 0 {
+		return ProviderIdentityMismatchError.Wrapf("provider %s presented no certificate", providerAddress)
+	}
+	leaf := state.PeerCertificates[0]
+	if pins, ok := ptc.Pins[providerAddress]; ok {
+		pin := PublicKeyPin(leaf)
+		pinned := false
+		for _, allowed := range pins {
+			if pin == allowed {
+				pinned = true
+				break
+			}
+		}
+		if !pinned {
+			return ProviderIdentityMismatchError.Wrapf("certificate public key %s of provider %s isn't pinned", pin, providerAddress)
+		}
+	} else {
+		opts := x509.VerifyOptions{Roots: ptc.RootCAs, Intermediates: x509.NewCertPool()}
+		if ptc.RequireIdentity {
+			// the identity replaces the host name, providers are paired by address and their endpoints can be plain ips
+			opts.DNSName = ""
+		} else {
+			// any certificate of a trusted ca would do without a host, an ip endpoint's certificate must carry it as an ip SAN
+			if host == "" {
+				return fmt.Errorf("no host to verify the certificate of provider %s against", providerAddress)
+			}
+			opts.DNSName = host
+		}
+		for _, intermediate := range state.PeerCertificates[1:] {
+			opts.Intermediates.AddCert(intermediate)
+		}
+		if _, err := leaf.Verify(opts); err != nil {
+			return fmt.Errorf("failed verifying the certificate of provider %s: %w", providerAddress, err)
+		}
+	}
+	if !ptc.RequireIdentity {
+		return nil
+	}
+	identity := ProviderIdentityURIPrefix + providerAddress
+	for _, uri := range leaf.URIs {
+		if uri.String() == identity {
+			return nil
+		}
+	}
+	return ProviderIdentityMismatchError.Wrapf("certificate of provider %s doesn't carry its identity %s", providerAddress, identity)
+}
+
+// providerCertificateVerifier binds the verification of a dial to the provider of the pairing, and keeps an identity mismatch
+// for the dial to report, as grpc retries failed handshakes until it times out
+type providerCertificateVerifier struct {
+	config          *ProviderTLSConfig
+	providerAddress string
+	host            string // of the dialed endpoint
+	lock            sync.Mutex
+	mismatch        error
+}
+
+func (pcv *providerCertificateVerifier) verifyConnection(state tls.ConnectionState) error {
+	err := pcv.config.verifyProviderCertificate(pcv.providerAddress, pcv.host, state)
+	if err != nil && ProviderIdentityMismatchError.Is(err) {
+		pcv.lock.Lock()
+		pcv.mismatch = err
+		pcv.lock.Unlock()
+	}
+	return err
+}
+
+func (pcv *providerCertificateVerifier) identityMismatch() error {
+	pcv.lock.Lock()
+	defer pcv.lock.Unlock()
+	return pcv.mismatch
+}
+
+// dialedHost returns the host of an endpoint address, the address itself when it has no port
+func dialedHost(addr string) string {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return addr
+	}
+	return host
+}
+
+// transportCredentials returns the credentials of a dial to the provider's endpoint, and the verifier holding its identity mismatch
+func (ptc *ProviderTLSConfig) transportCredentials(providerAddress string, addr string) (credentials.TransportCredentials, *providerCertificateVerifier) {
+	verifier := &providerCertificateVerifier{config: ptc, providerAddress: providerAddress, host: dialedHost(addr)}
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		// the default verification can't skip the host name check or accept pinned self signed certificates, the chain is
+		// verified in VerifyConnection instead
+		InsecureSkipVerify: true, //nolint:gosec
+		VerifyConnection:   verifier.verifyConnection,
+	}
+	return credentials.NewTLS(tlsConfig), verifier
+}
+
+// DialProvider connects to an endpoint of a provider, over tls verified with providerTLS when it's set and plaintext when it's nil.
+// a certificate that doesn't match the provider's pins or identity fails the dial with ProviderIdentityMismatchError
+func DialProvider(ctx context.Context, providerTLS *ProviderTLSConfig, providerAddress string, addr string) (*grpc.ClientConn, error) {
+	connectCtx, cancel := context.WithTimeout(ctx, TimeoutForEstablishingAConnection)
+	defer cancel()
+
+	transportCredentials := insecure.NewCredentials()
+	var verifier *providerCertificateVerifier
+	if providerTLS != nil {
+		transportCredentials, verifier = providerTLS.transportCredentials(providerAddress, addr)
+	}
+	dialOptions := append([]grpc.DialOption{grpc.WithTransportCredentials(transportCredentials), grpc.WithBlock()}, tracing.GRPCDialOptions()...)
+	conn, err := grpc.DialContext(connectCtx, addr, dialOptions...)
+	if err != nil {
+		if verifier != nil {
+			if mismatch := verifier.identityMismatch(); mismatch != nil {
+				return nil, mismatch
+			}
+		}
+		return nil, err
+	}
+	return conn, nil
+}
+
+// SetProviderTLS dials the provider endpoints over tls verified with the config, a provider whose certificate doesn't match its pins
+// or identity has its endpoint disabled for the epoch. it must be called before the first pairing update
+func (csm *ConsumerSessionManager) SetProviderTLS(providerTLS *ProviderTLSConfig) {
+	csm.providerTLS = providerTLS
+}
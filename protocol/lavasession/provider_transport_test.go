@@ -0,0 +1,124 @@
+package lavasession
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"math/big"
+	"net"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func createTestCertificate(t *testing.T, identity string, parent *x509.Certificate, parentKey *ecdsa.PrivateKey, hosts ...string) (*x509.Certificate, *ecdsa.PrivateKey) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: "provider"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	if identity != "" {
+		uri, err := url.Parse(identity)
+		require.NoError(t, err)
+		template.URIs = []*url.URL{uri}
+	}
+	for _, host := range hosts {
+		if ip := net.ParseIP(host); ip != nil {
+			template.IPAddresses = append(template.IPAddresses, ip)
+		} else {
+			template.DNSNames = append(template.DNSNames, host)
+		}
+	}
+	if parent == nil {
+		template.IsCA = true
+		template.BasicConstraintsValid = true
+		parent, parentKey = template, key
+	}
+	raw, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+	require.NoError(t, err)
+	certificate, err := x509.ParseCertificate(raw)
+	require.NoError(t, err)
+	return certificate, key
+}
+
+func TestVerifyProviderCertificate(t *testing.T) {
+	provider := "lava@provider"
+	ca, caKey := createTestCertificate(t, "", nil, nil)
+	roots := x509.NewCertPool()
+	roots.AddCert(ca)
+	leaf, _ := createTestCertificate(t, ProviderIdentityURIPrefix+provider, ca, caKey)
+	state := tls.ConnectionState{PeerCertificates: []*x509.Certificate{leaf}}
+
+	config := &ProviderTLSConfig{RootCAs: roots, RequireIdentity: true}
+	require.NoError(t, config.verifyProviderCertificate(provider, "10.0.0.1", state))
+	// another provider's endpoint serving the pairing of this one
+	err := config.verifyProviderCertificate("lava@other", "10.0.0.1", state)
+	require.True(t, ProviderIdentityMismatchError.Is(err))
+
+	// a certificate of an untrusted ca isn't an identity mismatch, the endpoint may just be misconfigured
+	untrusted, _ := createTestCertificate(t, ProviderIdentityURIPrefix+provider, nil, nil)
+	err = config.verifyProviderCertificate(provider, "10.0.0.1", tls.ConnectionState{PeerCertificates: []*x509.Certificate{untrusted}})
+	require.Error(t, err)
+	require.False(t, ProviderIdentityMismatchError.Is(err))
+
+	// a pinned provider is verified by its pins instead of the cas
+	config.Pins = map[string][]string{provider: {PublicKeyPin(untrusted)}}
+	require.NoError(t, config.verifyProviderCertificate(provider, "10.0.0.1", tls.ConnectionState{PeerCertificates: []*x509.Certificate{untrusted}}))
+	err = config.verifyProviderCertificate(provider, "10.0.0.1", state)
+	require.True(t, ProviderIdentityMismatchError.Is(err))
+}
+
+func TestVerifyProviderCertificateHost(t *testing.T) {
+	provider := "lava@provider"
+	ca, caKey := createTestCertificate(t, "", nil, nil)
+	roots := x509.NewCertPool()
+	roots.AddCert(ca)
+	config := &ProviderTLSConfig{RootCAs: roots}
+
+	// without the identity the certificate must be issued for the dialed host, an ip endpoint by its ip SANs
+	leaf, _ := createTestCertificate(t, "", ca, caKey, "10.0.0.1", "provider.example.com")
+	state := tls.ConnectionState{PeerCertificates: []*x509.Certificate{leaf}}
+	require.NoError(t, config.verifyProviderCertificate(provider, "10.0.0.1", state))
+	require.NoError(t, config.verifyProviderCertificate(provider, "provider.example.com", state))
+	require.Error(t, config.verifyProviderCertificate(provider, "10.0.0.2", state))
+	require.Error(t, config.verifyProviderCertificate(provider, "", state))
+
+	// a certificate of a trusted ca issued for another host
+	other, _ := createTestCertificate(t, "", ca, caKey, "other.example.com")
+	require.Error(t, config.verifyProviderCertificate(provider, "10.0.0.1", tls.ConnectionState{PeerCertificates: []*x509.Certificate{other}}))
+
+	require.Equal(t, "10.0.0.1", dialedHost("10.0.0.1:2222"))
+	require.Equal(t, "::1", dialedHost("[::1]:2222"))
+	require.Equal(t, "provider.example.com", dialedHost("provider.example.com"))
+}
+
+func TestLoadProviderTLSConfig(t *testing.T) {
+	certificate, _ := createTestCertificate(t, "", nil, nil)
+	pin := PublicKeyPin(certificate)
+	pinsFile := filepath.Join(t.TempDir(), "pins.json")
+	pinsJson, err := json.Marshal(map[string][]string{"lava@provider": {pin}})
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(pinsFile, pinsJson, 0o600))
+
+	config, err := LoadProviderTLSConfig("", pinsFile, false)
+	require.NoError(t, err)
+	require.Nil(t, config.RootCAs)
+	require.Equal(t, []string{pin}, config.Pins["lava@provider"])
+
+	require.NoError(t, os.WriteFile(pinsFile, []byte(`{"lava@provider":["not a pin"]}`), 0o600))
+	_, err = LoadProviderTLSConfig("", pinsFile, false)
+	require.Error(t, err)
+}
@@ -7,7 +7,6 @@ import (
 	"sync"
 	"sync/atomic"
 
-	"github.com/lavanet/lava/protocol/chainlib/chainproxy/rpcclient"
 	"github.com/lavanet/lava/protocol/common"
 	"github.com/lavanet/lava/utils"
 )
@@ -24,6 +23,13 @@ type RPCProviderEndpoint struct {
 	ApiInterface   string           `yaml:"api-interface,omitempty" json:"api-interface,omitempty" mapstructure:"api-interface"`
 	Geolocation    uint64           `yaml:"geolocation,omitempty" json:"geolocation,omitempty" mapstructure:"geolocation"`
 	NodeUrls       []common.NodeUrl `yaml:"node-urls,omitempty" json:"node-urls,omitempty" mapstructure:"node-urls"`
+	// number of blocks up to the latest the node serves, advertised to consumers for data reliability on historical blocks.
+	// 0 doesn't advertise, an archive node can set it over the chain height
+	AvailableBlocks uint64 `yaml:"available-blocks,omitempty" json:"available-blocks,omitempty" mapstructure:"available-blocks"`
+	// maximum size in bytes of a node response, relays with larger responses fail with NodeResponseTooLargeError. 0 is unlimited
+	MaxResponseSize uint64 `yaml:"max-response-size,omitempty" json:"max-response-size,omitempty" mapstructure:"max-response-size"`
+	// overrides of MaxResponseSize by api name, for apis known to return larger (or smaller) responses than the rest
+	MaxResponseSizePerApi map[string]uint64 `yaml:"max-response-size-per-api,omitempty" json:"max-response-size-per-api,omitempty" mapstructure:"max-response-size-per-api"`
 }
 
 func (endpoint *RPCProviderEndpoint) UrlsString() string {
@@ -38,6 +44,17 @@ func (endpoint *RPCProviderEndpoint) String() (retStr string) {
 	return endpoint.ChainID + ":" + endpoint.ApiInterface + " Network Address:" + endpoint.NetworkAddress + " Node: " + endpoint.UrlsString() + " Geolocation:" + strconv.FormatUint(endpoint.Geolocation, 10)
 }
 
+// EarliestAvailableBlock returns the earliest block the node serves given its latest block, 0 when the endpoint doesn't advertise it
+func (endpoint *RPCProviderEndpoint) EarliestAvailableBlock(latestBlock int64) int64 {
+	if endpoint.AvailableBlocks == 0 || latestBlock <= 0 {
+		return 0
+	}
+	if endpoint.AvailableBlocks >= uint64(latestBlock) {
+		return 1
+	}
+	return latestBlock - int64(endpoint.AvailableBlocks) + 1
+}
+
 func (endpoint *RPCProviderEndpoint) Validate() error {
 	if len(endpoint.NodeUrls) == 0 {
 		return utils.LavaFormatError("Empty URL list for endpoint", nil, utils.Attribute{Key: "endpoint", Value: endpoint.String()})
@@ -78,9 +95,15 @@ type sessionData struct {
 func (sm sessionData) onDeleteEvent() { // do nothing
 }
 
+// NodeSubscription is the node subscription serving a consumer, *rpcclient.ClientSubscription or a share of one
+type NodeSubscription interface {
+	Err() <-chan error
+	Unsubscribe() // safe to call more than once
+}
+
 type RPCSubscription struct {
 	Id                   string
-	Sub                  *rpcclient.ClientSubscription
+	Sub                  NodeSubscription
 	SubscribeRepliesChan chan interface{}
 }
 
@@ -0,0 +1,96 @@
+package lavasession
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	RelayTimeoutModelMinFactor      = 0.5 // the adapted timeout of an api is never shorter than this share of the cu based timeout
+	RelayTimeoutModelMaxFactor      = 4.0 // or longer than this many times the cu based timeout
+	relayTimeoutModelHeadroom       = 3.0 // the adapted timeout stays this many times above the measured latency
+	relayTimeoutModelMinSamples     = 10  // an api's timeout is adapted only once it has enough measurements
+	relayTimeoutModelLatencyDecay   = 10  // a measured latency moves the api's latency 1/relayTimeoutModelLatencyDecay of the way to it
+	relayTimeoutModelTimeoutPenalty = 1.5 // a timed out relay counts as a latency this many times over the timeout
+)
+
+type relayTimeoutModelKey struct {
+	chainID string
+	api     string
+}
+
+// the latency of an api relative to its cu based timeout
+type apiLatencyEstimate struct {
+	latencyRatio float64
+	samples      uint64
+}
+
+// RelayTimeoutModel adapts the relay timeout the cu of an api estimates to the latencies measured for it, per chain and api.
+// heavy apis that take longer than their cu suggests get longer timeouts instead of timing out early, and light apis get
+// shorter ones so a stuck provider is left sooner. the adapted timeout is bounded around the cu based one
+type RelayTimeoutModel struct {
+	lock sync.RWMutex
+	apis map[relayTimeoutModelKey]*apiLatencyEstimate
+}
+
+func NewRelayTimeoutModel() *RelayTimeoutModel {
+	return &RelayTimeoutModel{apis: map[relayTimeoutModelKey]*apiLatencyEstimate{}}
+}
+
+// Timeout returns the timeout of a relay of the api, estimated is the timeout its cu gives
+func (rtm *RelayTimeoutModel) Timeout(chainID string, api string, estimated time.Duration) time.Duration {
+	if rtm == nil {
+		return estimated
+	}
+	rtm.lock.RLock()
+	defer rtm.lock.RUnlock()
+	estimate, ok := rtm.apis[relayTimeoutModelKey{chainID: chainID, api: api}]
+	if !ok {
+		return estimated
+	}
+	return time.Duration(float64(estimated) * estimate.factor())
+}
+
+// OnRelayDone measures the latency of a successful relay of the api against the timeout its cu gives
+func (rtm *RelayTimeoutModel) OnRelayDone(chainID string, api string, estimated time.Duration, latency time.Duration) {
+	if rtm == nil || estimated <= 0 {
+		return
+	}
+	rtm.addSample(chainID, api, float64(latency)/float64(estimated))
+}
+
+// OnRelayTimeout counts a relay of the api that timed out, its latency is unknown but longer than the timeout it had
+func (rtm *RelayTimeoutModel) OnRelayTimeout(chainID string, api string, estimated time.Duration, timeout time.Duration) {
+	if rtm == nil || estimated <= 0 {
+		return
+	}
+	rtm.addSample(chainID, api, float64(timeout)*relayTimeoutModelTimeoutPenalty/float64(estimated))
+}
+
+func (rtm *RelayTimeoutModel) addSample(chainID string, api string, latencyRatio float64) {
+	rtm.lock.Lock()
+	defer rtm.lock.Unlock()
+	key := relayTimeoutModelKey{chainID: chainID, api: api}
+	estimate, ok := rtm.apis[key]
+	if !ok {
+		rtm.apis[key] = &apiLatencyEstimate{latencyRatio: latencyRatio, samples: 1}
+		return
+	}
+	estimate.latencyRatio += (latencyRatio - estimate.latencyRatio) / relayTimeoutModelLatencyDecay
+	estimate.samples++
+}
+
+// the factor the cu based timeout is multiplied by, 1 until there are enough measurements
+func (estimate *apiLatencyEstimate) factor() float64 {
+	if estimate.samples < relayTimeoutModelMinSamples {
+		return 1
+	}
+	factor := estimate.latencyRatio * relayTimeoutModelHeadroom
+	if factor < RelayTimeoutModelMinFactor {
+		return RelayTimeoutModelMinFactor
+	}
+	if factor > RelayTimeoutModelMaxFactor {
+		return RelayTimeoutModelMaxFactor
+	}
+	return factor
+}
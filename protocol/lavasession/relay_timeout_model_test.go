@@ -0,0 +1,39 @@
+package lavasession
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRelayTimeoutModel(t *testing.T) {
+	var nilModel *RelayTimeoutModel
+	require.Equal(t, time.Second, nilModel.Timeout("LAV1", "heavy", time.Second))
+
+	model := NewRelayTimeoutModel()
+	estimated := 2 * time.Second
+	require.Equal(t, estimated, model.Timeout("LAV1", "heavy", estimated))
+
+	// the timeout isn't adapted before there are enough measurements
+	for i := 0; i < relayTimeoutModelMinSamples-1; i++ {
+		model.OnRelayDone("LAV1", "heavy", estimated, 2*estimated)
+		model.OnRelayDone("LAV1", "light", estimated, estimated/20)
+	}
+	require.Equal(t, estimated, model.Timeout("LAV1", "heavy", estimated))
+
+	model.OnRelayDone("LAV1", "heavy", estimated, 2*estimated)
+	model.OnRelayDone("LAV1", "light", estimated, estimated/20)
+	// a heavy api gets a longer timeout and a light one a shorter timeout, within the bounds
+	require.Equal(t, time.Duration(float64(estimated)*RelayTimeoutModelMaxFactor), model.Timeout("LAV1", "heavy", estimated))
+	require.Equal(t, time.Duration(float64(estimated)*RelayTimeoutModelMinFactor), model.Timeout("LAV1", "light", estimated))
+	// other chains keep their own measurements
+	require.Equal(t, estimated, model.Timeout("ETH1", "heavy", estimated))
+
+	// timeouts lengthen the timeout of the api
+	timeout := model.Timeout("LAV1", "light", estimated)
+	for i := 0; i < 5; i++ {
+		model.OnRelayTimeout("LAV1", "light", estimated, model.Timeout("LAV1", "light", estimated))
+	}
+	require.Greater(t, model.Timeout("LAV1", "light", estimated), timeout)
+}
@@ -235,6 +235,20 @@ func (sps *SingleProviderSession) onSessionFailure() error {
 	return nil
 }
 
+// to be used only when locked, returns the state the session will have once the relay is done
+func (sps *SingleProviderSession) sessionRecord(relayNumber uint64) ProviderSessionRecord {
+	return ProviderSessionRecord{
+		ConsumerAddress:   sps.userSessionsParent.consumerAddr,
+		Epoch:             sps.PairingEpoch,
+		SessionID:         sps.SessionID,
+		RelayNum:          relayNumber,
+		CuSum:             sps.atomicReadCuSum(),
+		MaxComputeUnits:   sps.userSessionsParent.atomicReadMaxComputeUnits(),
+		SelfProviderIndex: sps.userSessionsParent.atomicReadProviderIndex(),
+		PairedProviders:   sps.userSessionsParent.atomicReadPairedProviders(),
+	}
+}
+
 func (sps *SingleProviderSession) onSessionDone(relayNumber uint64) error {
 	// this can be called on collected sessions, so if in the future you need to touch the parent, take this into consideration to change the OnSessionDone calls in provider_session_manager
 	err := sps.VerifyLock() // sps is locked
@@ -0,0 +1,46 @@
+package metrics
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+const kafkaRestJsonFormat = "application/vnd.kafka.json.v2+json"
+
+type kafkaRecord struct {
+	Key   string            `json:"key"`
+	Value RelayAnalyticsDTO `json:"value"`
+}
+
+type kafkaRecords struct {
+	Records []kafkaRecord `json:"records"`
+}
+
+// kafkaRestSink produces the analytics to a kafka topic through a kafka rest proxy, keyed by project
+type kafkaRestSink struct {
+	restProxyUrl string
+	topic        string
+}
+
+func NewKafkaRestSink(restProxyUrl string, topic string) MetricsSink {
+	return &kafkaRestSink{restProxyUrl: strings.TrimSuffix(restProxyUrl, "/"), topic: topic}
+}
+
+func (krs *kafkaRestSink) Name() string {
+	return "kafka"
+}
+
+func (krs *kafkaRestSink) Send(ctx context.Context, data []RelayAnalyticsDTO) error {
+	records := kafkaRecords{Records: make([]kafkaRecord, 0, len(data))}
+	for _, analytics := range data {
+		records.Records = append(records.Records, kafkaRecord{Key: analytics.ProjectHash, Value: analytics})
+	}
+	body, err := json.Marshal(records)
+	if err != nil {
+		return err
+	}
+	return postToSink(ctx, fmt.Sprintf("%s/topics/%s", krs.restProxyUrl, url.PathEscape(krs.topic)), kafkaRestJsonFormat, body)
+}
@@ -1,9 +1,6 @@
 package metrics
 
 import (
-	"bytes"
-	"encoding/json"
-	"net/http"
 	"os"
 	"strconv"
 	"time"
@@ -20,13 +17,40 @@ type AggregatedMetric struct {
 type MetricService struct {
 	AggregatedMetricMap *map[string]map[string]map[string]*AggregatedMetric
 	MetricsChannel      chan RelayMetrics
-	ReportUrl           string
+	sinks               []*sinkWorker
+}
+
+// metricsSinksFromEnv returns the sinks configured for the aggregated data
+func metricsSinksFromEnv() []MetricsSink {
+	sinks := []MetricsSink{}
+	if reportMetricsUrl := os.Getenv("REPORT_METRICS_URL"); reportMetricsUrl != "" {
+		sinks = append(sinks, NewHttpSink(reportMetricsUrl))
+	}
+	if pushGatewayUrl := os.Getenv("METRICS_PROMETHEUS_PUSH_URL"); pushGatewayUrl != "" {
+		job := os.Getenv("METRICS_PROMETHEUS_JOB")
+		if job == "" {
+			job = "lava_rpcconsumer"
+		}
+		sinks = append(sinks, NewPrometheusPushSink(pushGatewayUrl, job))
+	}
+	if statsdAddress := os.Getenv("METRICS_STATSD_ADDRESS"); statsdAddress != "" {
+		prefix := os.Getenv("METRICS_STATSD_PREFIX")
+		if prefix == "" {
+			prefix = "lava"
+		}
+		sinks = append(sinks, NewStatsdSink(statsdAddress, prefix))
+	}
+	kafkaRestUrl, kafkaTopic := os.Getenv("METRICS_KAFKA_REST_URL"), os.Getenv("METRICS_KAFKA_TOPIC")
+	if kafkaRestUrl != "" && kafkaTopic != "" {
+		sinks = append(sinks, NewKafkaRestSink(kafkaRestUrl, kafkaTopic))
+	}
+	return sinks
 }
 
 func NewMetricService() *MetricService {
-	reportMetricsUrl := os.Getenv("REPORT_METRICS_URL")
+	sinks := metricsSinksFromEnv()
 	intervalData := os.Getenv("METRICS_INTERVAL_FOR_SENDING_DATA_MIN")
-	if reportMetricsUrl == "" || intervalData == "" {
+	if len(sinks) == 0 || intervalData == "" {
 		return nil
 	}
 	intervalForMetrics, _ := strconv.ParseInt(intervalData, 10, 32)
@@ -35,18 +59,21 @@ func NewMetricService() *MetricService {
 	mChannel := make(chan RelayMetrics, metricChannelBufferSize)
 	result := &MetricService{
 		MetricsChannel:      mChannel,
-		ReportUrl:           reportMetricsUrl,
 		AggregatedMetricMap: &map[string]map[string]map[string]*AggregatedMetric{},
 	}
+	for _, sink := range sinks {
+		utils.LavaFormatInfo("sending relay metrics to sink", utils.Attribute{Key: "sink", Value: sink.Name()})
+		result.sinks = append(result.sinks, newSinkWorker(sink, SinkRetryInitialDelay))
+	}
 
-	// setup reader & sending of the results via http
+	// setup reader & sending of the results to the sinks
 	ticker := time.NewTicker(time.Duration(intervalForMetrics * time.Minute.Nanoseconds()))
 	go func() {
 		for {
 			select {
 			case <-ticker.C:
 				{
-					utils.LavaFormatInfo("metric triggered, sending accumulated data to server")
+					utils.LavaFormatInfo("metric triggered, sending accumulated data to sinks")
 					result.SendEachProjectMetricData()
 				}
 			case metricData := <-mChannel:
@@ -79,7 +106,15 @@ func (m *MetricService) SendEachProjectMetricData() {
 
 	for projectKey, projectData := range *m.AggregatedMetricMap {
 		toSendData := prepareArrayForProject(projectData, projectKey)
-		go sendMetricsViaHttp(m.ReportUrl, toSendData)
+		if len(toSendData) == 0 {
+			utils.LavaFormatDebug("no metrics found for this project.")
+			continue
+		}
+		for _, batch := range splitToBatches(toSendData, SinkMaxBatchSize) {
+			for _, sink := range m.sinks {
+				sink.enqueue(batch)
+			}
+		}
 	}
 	// we reset to be ready for new metric data
 	m.AggregatedMetricMap = &map[string]map[string]map[string]*AggregatedMetric{}
@@ -107,27 +142,6 @@ func prepareArrayForProject(projectData map[string]map[string]*AggregatedMetric,
 	return toSendData
 }
 
-func sendMetricsViaHttp(reportUrl string, data []RelayAnalyticsDTO) error {
-	if len(data) == 0 {
-		utils.LavaFormatDebug("no metrics found for this project.")
-		return nil
-	}
-	jsonValue, err := json.Marshal(data)
-	if err != nil {
-		utils.LavaFormatError("error converting data to json", err)
-		return err
-	}
-	resp, err := http.Post(reportUrl, "application/json", bytes.NewBuffer(jsonValue))
-	if err != nil {
-		utils.LavaFormatError("error posting data to report url.", err, utils.Attribute{Key: "url", Value: reportUrl})
-		return err
-	}
-	if resp.StatusCode != http.StatusOK {
-		utils.LavaFormatError("error status code returned from server.", nil, utils.Attribute{Key: "url", Value: reportUrl})
-	}
-	return nil
-}
-
 func (m *MetricService) storeAggregatedData(data RelayMetrics) error {
 	utils.LavaFormatDebug("new data to store",
 		utils.Attribute{Key: "projectHash", Value: data.ProjectHash},
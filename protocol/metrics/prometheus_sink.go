@@ -0,0 +1,122 @@
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+)
+
+const prometheusTextFormat = "text/plain; version=0.0.4"
+
+type prometheusSeriesKey struct {
+	chainID      string
+	apiInterface string
+}
+
+// prometheusSeries is the merged analytics of a chain and api interface of a project
+type prometheusSeries struct {
+	relays     uint64
+	successful uint64
+	latency    uint64 // average latency of the last batch with successful relays
+}
+
+// prometheusPushSink pushes the analytics of each project to a prometheus pushgateway, grouped by job and project.
+// a push replaces the project's group, so every batch is merged into the project's totals and the push carries all of them:
+// a batch doesn't erase the chains of an earlier one, and a failed push is made up for by the next
+type prometheusPushSink struct {
+	pushGatewayUrl string
+	job            string
+	lock           sync.Mutex
+	projects       map[string]map[prometheusSeriesKey]*prometheusSeries
+}
+
+func NewPrometheusPushSink(pushGatewayUrl string, job string) MetricsSink {
+	return &prometheusPushSink{pushGatewayUrl: strings.TrimSuffix(pushGatewayUrl, "/"), job: job, projects: map[string]map[prometheusSeriesKey]*prometheusSeries{}}
+}
+
+func (pps *prometheusPushSink) Name() string {
+	return "prometheus"
+}
+
+func (pps *prometheusPushSink) Send(ctx context.Context, data []RelayAnalyticsDTO) error {
+	pushes := pps.merge(data)
+	for projectHash, text := range pushes {
+		// PUT replaces the group of the project, which holds all of its merged series
+		pushUrl := fmt.Sprintf("%s/metrics/job/%s/project/%s", pps.pushGatewayUrl, url.PathEscape(pps.job), url.PathEscape(projectHash))
+		err := sendToSink(ctx, http.MethodPut, pushUrl, prometheusTextFormat, []byte(text))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// merge adds the batch to the totals of its projects and returns the text to push for each of them
+func (pps *prometheusPushSink) merge(data []RelayAnalyticsDTO) map[string]string {
+	pps.lock.Lock()
+	defer pps.lock.Unlock()
+	for _, analytics := range data {
+		project, ok := pps.projects[analytics.ProjectHash]
+		if !ok {
+			project = map[prometheusSeriesKey]*prometheusSeries{}
+			pps.projects[analytics.ProjectHash] = project
+		}
+		key := prometheusSeriesKey{chainID: analytics.ChainID, apiInterface: analytics.APIType}
+		series, ok := project[key]
+		if !ok {
+			series = &prometheusSeries{}
+			project[key] = series
+		}
+		series.relays += uint64(analytics.RelayCounts)
+		series.successful += uint64(analytics.SuccessCount)
+		if analytics.SuccessCount > 0 {
+			series.latency = analytics.Latency
+		}
+	}
+	pushes := map[string]string{}
+	for _, analytics := range data {
+		if _, ok := pushes[analytics.ProjectHash]; !ok {
+			pushes[analytics.ProjectHash] = formatPrometheusText(pps.projects[analytics.ProjectHash])
+		}
+	}
+	return pushes
+}
+
+func formatPrometheusText(project map[prometheusSeriesKey]*prometheusSeries) string {
+	keys := make([]prometheusSeriesKey, 0, len(project))
+	for key := range project {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].chainID != keys[j].chainID {
+			return keys[i].chainID < keys[j].chainID
+		}
+		return keys[i].apiInterface < keys[j].apiInterface
+	})
+	metrics := []struct {
+		name       string
+		help       string
+		metricType string
+		value      func(*prometheusSeries) uint64
+	}{
+		{name: "lava_consumer_relays_total", help: "relays sent", metricType: "counter", value: func(series *prometheusSeries) uint64 { return series.relays }},
+		{name: "lava_consumer_successful_relays_total", help: "successful relays", metricType: "counter", value: func(series *prometheusSeries) uint64 { return series.successful }},
+		{name: "lava_consumer_relay_latency_ms", help: "average latency of successful relays in the last reporting interval that had any", metricType: "gauge", value: func(series *prometheusSeries) uint64 { return series.latency }},
+	}
+	var text strings.Builder
+	for _, metric := range metrics {
+		fmt.Fprintf(&text, "# HELP %s %s\n# TYPE %s %s\n", metric.name, metric.help, metric.name, metric.metricType)
+		for _, key := range keys {
+			fmt.Fprintf(&text, "%s{chain_id=\"%s\",api_interface=\"%s\"} %d\n", metric.name, escapePrometheusLabel(key.chainID), escapePrometheusLabel(key.apiInterface), metric.value(project[key]))
+		}
+	}
+	return text.String()
+}
+
+func escapePrometheusLabel(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(value)
+}
@@ -0,0 +1,181 @@
+package metrics
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/lavanet/lava/utils"
+)
+
+const (
+	QoSDashboardAddressFlagName = "qos-dashboard-address"
+	ProviderQoSWindow           = 10 * time.Minute
+	MaxProviderQoSSamples       = 1000
+)
+
+type qosSample struct {
+	timestamp   time.Time
+	latency     time.Duration
+	success     bool
+	syncScore   float64
+	geolocation uint64 // of the provider endpoint that served the relay
+}
+
+type providerQoSKey struct {
+	chainID         string
+	apiInterface    string
+	providerAddress string
+}
+
+type providerQoSData struct {
+	samples                 []qosSample
+	blockedCount            uint64
+	reportedCount           uint64
+	reliabilitySamplingRate float64
+}
+
+// ProviderQoSSummary is the QoS of a provider over the recent relays of a chain
+type ProviderQoSSummary struct {
+	ProviderAddress string  `json:"provider"`
+	ChainID         string  `json:"chainId"`
+	ApiInterface    string  `json:"apiInterface"`
+	Relays          int     `json:"relays"`
+	Availability    float64 `json:"availability"`
+	LatencyP50Ms    float64 `json:"latencyP50Ms"`
+	LatencyP90Ms    float64 `json:"latencyP90Ms"`
+	LatencyP99Ms    float64 `json:"latencyP99Ms"`
+	SyncScore       float64 `json:"syncScore"`
+	BlockedCount    uint64  `json:"blockedCount"`
+	ReportedCount   uint64  `json:"reportedCount"`
+	Geolocation     uint64  `json:"geolocation"` // of the endpoint that served the latest successful relay
+	// the share of the provider's relays checked with data reliability, lower for trusted providers
+	ReliabilitySamplingRate float64 `json:"reliabilitySamplingRate"`
+}
+
+// ProviderQoSTracker aggregates the QoS of each provider from the consumer session hooks
+type ProviderQoSTracker struct {
+	lock      sync.RWMutex
+	providers map[providerQoSKey]*providerQoSData
+	window    time.Duration
+}
+
+func NewProviderQoSTracker() *ProviderQoSTracker {
+	return &ProviderQoSTracker{providers: map[providerQoSKey]*providerQoSData{}, window: ProviderQoSWindow}
+}
+
+func (pqt *ProviderQoSTracker) OnRelayDone(chainID string, apiInterface string, providerAddress string, geolocation uint64, latency time.Duration, syncScore float64) {
+	pqt.addSample(providerQoSKey{chainID: chainID, apiInterface: apiInterface, providerAddress: providerAddress}, qosSample{timestamp: time.Now(), latency: latency, success: true, syncScore: syncScore, geolocation: geolocation})
+}
+
+func (pqt *ProviderQoSTracker) OnRelayFailure(chainID string, apiInterface string, providerAddress string) {
+	pqt.addSample(providerQoSKey{chainID: chainID, apiInterface: apiInterface, providerAddress: providerAddress}, qosSample{timestamp: time.Now()})
+}
+
+func (pqt *ProviderQoSTracker) OnProviderBlocked(chainID string, apiInterface string, providerAddress string, reported bool) {
+	pqt.lock.Lock()
+	defer pqt.lock.Unlock()
+	data := pqt.provider(providerQoSKey{chainID: chainID, apiInterface: apiInterface, providerAddress: providerAddress})
+	data.blockedCount++
+	if reported {
+		data.reportedCount++
+	}
+}
+
+func (pqt *ProviderQoSTracker) OnDataReliabilitySampling(chainID string, apiInterface string, providerAddress string, samplingRate float64) {
+	pqt.lock.Lock()
+	defer pqt.lock.Unlock()
+	pqt.provider(providerQoSKey{chainID: chainID, apiInterface: apiInterface, providerAddress: providerAddress}).reliabilitySamplingRate = samplingRate
+}
+
+// must be called with the lock held
+func (pqt *ProviderQoSTracker) provider(key providerQoSKey) *providerQoSData {
+	data, ok := pqt.providers[key]
+	if !ok {
+		data = &providerQoSData{}
+		pqt.providers[key] = data
+	}
+	return data
+}
+
+func (pqt *ProviderQoSTracker) addSample(key providerQoSKey, sample qosSample) {
+	pqt.lock.Lock()
+	defer pqt.lock.Unlock()
+	data := pqt.provider(key)
+	data.samples = append(data.samples, sample)
+	if len(data.samples) > MaxProviderQoSSamples {
+		data.samples = data.samples[len(data.samples)-MaxProviderQoSSamples:]
+	}
+}
+
+// Summaries returns the QoS of every provider over the window, only for chainID when it's not empty
+func (pqt *ProviderQoSTracker) Summaries(chainID string) []ProviderQoSSummary {
+	pqt.lock.RLock()
+	defer pqt.lock.RUnlock()
+	windowStart := time.Now().Add(-pqt.window)
+	summaries := []ProviderQoSSummary{}
+	for key, data := range pqt.providers {
+		if chainID != "" && key.chainID != chainID {
+			continue
+		}
+		summary := ProviderQoSSummary{ProviderAddress: key.providerAddress, ChainID: key.chainID, ApiInterface: key.apiInterface, BlockedCount: data.blockedCount, ReportedCount: data.reportedCount, ReliabilitySamplingRate: data.reliabilitySamplingRate}
+		latencies := []time.Duration{}
+		syncScoreSum := 0.0
+		for _, sample := range data.samples {
+			if sample.timestamp.Before(windowStart) {
+				continue
+			}
+			summary.Relays++
+			if sample.success {
+				latencies = append(latencies, sample.latency)
+				summary.Geolocation = sample.geolocation
+				syncScoreSum += sample.syncScore
+			}
+		}
+		if summary.Relays > 0 {
+			summary.Availability = float64(len(latencies)) / float64(summary.Relays)
+		}
+		if len(latencies) > 0 {
+			sort.Slice(latencies, func(i, j int) bool { return latencies[i] < latencies[j] })
+			summary.LatencyP50Ms = latencyPercentileMs(latencies, 0.5)
+			summary.LatencyP90Ms = latencyPercentileMs(latencies, 0.9)
+			summary.LatencyP99Ms = latencyPercentileMs(latencies, 0.99)
+			summary.SyncScore = syncScoreSum / float64(len(latencies))
+		}
+		summaries = append(summaries, summary)
+	}
+	sort.Slice(summaries, func(i, j int) bool {
+		if summaries[i].ChainID != summaries[j].ChainID {
+			return summaries[i].ChainID < summaries[j].ChainID
+		}
+		if summaries[i].ApiInterface != summaries[j].ApiInterface {
+			return summaries[i].ApiInterface < summaries[j].ApiInterface
+		}
+		return summaries[i].ProviderAddress < summaries[j].ProviderAddress
+	})
+	return summaries
+}
+
+// latencies must be sorted
+func latencyPercentileMs(latencies []time.Duration, percentile float64) float64 {
+	index := int(percentile * float64(len(latencies)-1))
+	return float64(latencies[index]) / float64(time.Millisecond)
+}
+
+// StartQoSDashboardServer serves the provider QoS summaries as json on /qos, filtered by the chain query parameter
+func StartQoSDashboardServer(addr string, tracker *ProviderQoSTracker) error {
+	app := fiber.New(fiber.Config{DisableStartupMessage: true})
+	app.Get("/qos", func(c *fiber.Ctx) error {
+		return c.JSON(tracker.Summaries(c.Query("chain")))
+	})
+
+	go func() {
+		if err := app.Listen(addr); err != nil {
+			utils.LavaFormatError("qos dashboard server failed", err, utils.Attribute{Key: "address", Value: addr})
+		}
+	}()
+
+	utils.LavaFormatInfo("start qos dashboard HTTP server", utils.Attribute{Key: "IPAddress", Value: addr})
+	return nil
+}
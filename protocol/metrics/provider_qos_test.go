@@ -0,0 +1,62 @@
+package metrics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestProviderQoSSummaries(t *testing.T) {
+	tracker := NewProviderQoSTracker()
+	for i := 1; i <= 100; i++ {
+		syncScore := 1.0
+		if i%4 == 0 {
+			syncScore = 0
+		}
+		tracker.OnRelayDone("LAV1", "rest", "provider1", 1, time.Duration(i)*time.Millisecond, syncScore)
+	}
+	for i := 0; i < 25; i++ {
+		tracker.OnRelayFailure("LAV1", "rest", "provider1")
+	}
+	tracker.OnProviderBlocked("LAV1", "rest", "provider1", false)
+	tracker.OnProviderBlocked("LAV1", "rest", "provider1", true)
+	tracker.OnDataReliabilitySampling("LAV1", "rest", "provider1", 0.01)
+	tracker.OnRelayDone("ETH1", "jsonrpc", "provider2", 1, time.Millisecond, 1)
+	tracker.OnRelayDone("LAV1", "rest", "provider0", 2, time.Millisecond, 1)
+
+	summaries := tracker.Summaries("LAV1")
+	require.Equal(t, 2, len(summaries))
+	require.Equal(t, "provider0", summaries[0].ProviderAddress)
+	require.Equal(t, uint64(2), summaries[0].Geolocation)
+	summary := summaries[1]
+	require.Equal(t, "provider1", summary.ProviderAddress)
+	require.Equal(t, 125, summary.Relays)
+	require.Equal(t, 0.8, summary.Availability)
+	require.Equal(t, float64(50), summary.LatencyP50Ms)
+	require.Equal(t, float64(90), summary.LatencyP90Ms)
+	require.Equal(t, float64(99), summary.LatencyP99Ms)
+	require.Equal(t, 0.75, summary.SyncScore)
+	require.Equal(t, uint64(2), summary.BlockedCount)
+	require.Equal(t, uint64(1), summary.ReportedCount)
+	require.Equal(t, uint64(1), summary.Geolocation)
+	require.Equal(t, 0.01, summary.ReliabilitySamplingRate)
+
+	require.Equal(t, 3, len(tracker.Summaries("")))
+}
+
+func TestProviderQoSWindow(t *testing.T) {
+	tracker := NewProviderQoSTracker()
+	tracker.window = 50 * time.Millisecond
+	tracker.OnRelayFailure("LAV1", "rest", "provider1")
+	time.Sleep(100 * time.Millisecond)
+	tracker.OnRelayDone("LAV1", "rest", "provider1", 1, time.Millisecond, 1)
+	summaries := tracker.Summaries("")
+	require.Equal(t, 1, summaries[0].Relays)
+	require.Equal(t, float64(1), summaries[0].Availability)
+
+	for i := 0; i < MaxProviderQoSSamples+10; i++ {
+		tracker.OnRelayFailure("LAV1", "rest", "provider1")
+	}
+	require.Equal(t, MaxProviderQoSSamples, tracker.Summaries("")[0].Relays)
+}
@@ -0,0 +1,127 @@
+package metrics
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/lavanet/lava/utils"
+)
+
+const (
+	SinkQueueSize         = 100 // batches waiting for a sink, new batches are dropped when it's full
+	SinkMaxBatchSize      = 500
+	SinkMaxRetries        = 3
+	SinkRetryInitialDelay = time.Second
+	sinkSendTimeout       = 10 * time.Second
+)
+
+// MetricsSink exports aggregated relay analytics to an external system
+type MetricsSink interface {
+	Name() string
+	Send(ctx context.Context, data []RelayAnalyticsDTO) error
+}
+
+// sinkWorker sends batches to a sink one at a time, retrying with backoff,
+// a slow sink fills its queue and drops new batches instead of holding back the others
+type sinkWorker struct {
+	sink       MetricsSink
+	queue      chan []RelayAnalyticsDTO
+	retryDelay time.Duration
+}
+
+func newSinkWorker(sink MetricsSink, retryDelay time.Duration) *sinkWorker {
+	worker := &sinkWorker{sink: sink, queue: make(chan []RelayAnalyticsDTO, SinkQueueSize), retryDelay: retryDelay}
+	go worker.run()
+	return worker
+}
+
+func (sw *sinkWorker) enqueue(batch []RelayAnalyticsDTO) bool {
+	select {
+	case sw.queue <- batch:
+		return true
+	default:
+		utils.LavaFormatWarning("metrics sink queue is full, dropping batch", nil, utils.Attribute{Key: "sink", Value: sw.sink.Name()}, utils.Attribute{Key: "batchSize", Value: len(batch)})
+		return false
+	}
+}
+
+func (sw *sinkWorker) run() {
+	for batch := range sw.queue {
+		sw.sendWithRetries(batch)
+	}
+}
+
+func (sw *sinkWorker) sendWithRetries(batch []RelayAnalyticsDTO) error {
+	delay := sw.retryDelay
+	for attempt := 0; ; attempt++ {
+		ctx, cancel := context.WithTimeout(context.Background(), sinkSendTimeout)
+		err := sw.sink.Send(ctx, batch)
+		cancel()
+		if err == nil {
+			return nil
+		}
+		if attempt >= SinkMaxRetries {
+			return utils.LavaFormatError("failed sending metrics to sink", err, utils.Attribute{Key: "sink", Value: sw.sink.Name()}, utils.Attribute{Key: "attempts", Value: attempt + 1})
+		}
+		time.Sleep(delay)
+		delay *= 2
+	}
+}
+
+func splitToBatches(data []RelayAnalyticsDTO, maxBatchSize int) [][]RelayAnalyticsDTO {
+	batches := [][]RelayAnalyticsDTO{}
+	for len(data) > maxBatchSize {
+		batches = append(batches, data[:maxBatchSize])
+		data = data[maxBatchSize:]
+	}
+	if len(data) > 0 {
+		batches = append(batches, data)
+	}
+	return batches
+}
+
+// httpSink posts the analytics as json to the report url
+type httpSink struct {
+	reportUrl string
+}
+
+func NewHttpSink(reportUrl string) MetricsSink {
+	return &httpSink{reportUrl: reportUrl}
+}
+
+func (hs *httpSink) Name() string {
+	return "http"
+}
+
+func (hs *httpSink) Send(ctx context.Context, data []RelayAnalyticsDTO) error {
+	jsonValue, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	return postToSink(ctx, hs.reportUrl, "application/json", jsonValue)
+}
+
+func postToSink(ctx context.Context, url string, contentType string, body []byte) error {
+	return sendToSink(ctx, http.MethodPost, url, contentType, body)
+}
+
+func sendToSink(ctx context.Context, method string, url string, contentType string, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, method, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", contentType)
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("metrics sink returned status %d from %s", resp.StatusCode, url)
+	}
+	return nil
+}
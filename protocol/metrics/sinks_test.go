@@ -0,0 +1,196 @@
+package metrics
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+var sinkTestData = []RelayAnalyticsDTO{
+	{ProjectHash: "project1", ChainID: "LAV1", APIType: "rest", Latency: 50, SuccessCount: 9, RelayCounts: 10},
+	{ProjectHash: "project1", ChainID: "ETH1", APIType: "jsonrpc", Latency: 20, SuccessCount: 0, RelayCounts: 1},
+}
+
+type receivedRequest struct {
+	method      string
+	path        string
+	contentType string
+	body        string
+}
+
+func newRecordingServer(t *testing.T) (*httptest.Server, chan receivedRequest) {
+	requests := make(chan receivedRequest, 10)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		requests <- receivedRequest{method: r.Method, path: r.URL.Path, contentType: r.Header.Get("Content-Type"), body: string(body)}
+	}))
+	t.Cleanup(server.Close)
+	return server, requests
+}
+
+func TestHttpSink(t *testing.T) {
+	server, requests := newRecordingServer(t)
+	require.NoError(t, NewHttpSink(server.URL+"/report").Send(context.Background(), sinkTestData))
+	request := <-requests
+	require.Equal(t, http.MethodPost, request.method)
+	require.Equal(t, "/report", request.path)
+	sent := []RelayAnalyticsDTO{}
+	require.NoError(t, json.Unmarshal([]byte(request.body), &sent))
+	require.Equal(t, sinkTestData, sent)
+}
+
+func TestPrometheusPushSink(t *testing.T) {
+	server, requests := newRecordingServer(t)
+	require.NoError(t, NewPrometheusPushSink(server.URL+"/", "lava").Send(context.Background(), sinkTestData))
+	request := <-requests
+	require.Equal(t, http.MethodPut, request.method)
+	require.Equal(t, "/metrics/job/lava/project/project1", request.path)
+	require.Equal(t, prometheusTextFormat, request.contentType)
+	require.Contains(t, request.body, "# TYPE lava_consumer_relays_total counter\n")
+	require.Contains(t, request.body, `lava_consumer_relays_total{chain_id="LAV1",api_interface="rest"} 10`)
+	require.Contains(t, request.body, `lava_consumer_successful_relays_total{chain_id="ETH1",api_interface="jsonrpc"} 0`)
+	require.Contains(t, request.body, `lava_consumer_relay_latency_ms{chain_id="LAV1",api_interface="rest"} 50`)
+	require.Equal(t, `a\"b\\c\n`, escapePrometheusLabel("a\"b\\c\n"))
+}
+
+func TestPrometheusPushSinkMergesBatches(t *testing.T) {
+	server, requests := newRecordingServer(t)
+	sink := NewPrometheusPushSink(server.URL, "lava")
+	require.NoError(t, sink.Send(context.Background(), sinkTestData[:1]))
+	<-requests
+	// the push of a later batch replaces the group, it keeps the series of the earlier one
+	require.NoError(t, sink.Send(context.Background(), []RelayAnalyticsDTO{
+		sinkTestData[1],
+		{ProjectHash: "project1", ChainID: "LAV1", APIType: "rest", Latency: 70, SuccessCount: 4, RelayCounts: 5},
+	}))
+	request := <-requests
+	require.Contains(t, request.body, `lava_consumer_relays_total{chain_id="LAV1",api_interface="rest"} 15`)
+	require.Contains(t, request.body, `lava_consumer_successful_relays_total{chain_id="LAV1",api_interface="rest"} 13`)
+	require.Contains(t, request.body, `lava_consumer_relay_latency_ms{chain_id="LAV1",api_interface="rest"} 70`)
+	require.Contains(t, request.body, `lava_consumer_relays_total{chain_id="ETH1",api_interface="jsonrpc"} 1`)
+}
+
+func TestKafkaRestSink(t *testing.T) {
+	server, requests := newRecordingServer(t)
+	require.NoError(t, NewKafkaRestSink(server.URL, "relays").Send(context.Background(), sinkTestData))
+	request := <-requests
+	require.Equal(t, "/topics/relays", request.path)
+	require.Equal(t, kafkaRestJsonFormat, request.contentType)
+	records := kafkaRecords{}
+	require.NoError(t, json.Unmarshal([]byte(request.body), &records))
+	require.Equal(t, 2, len(records.Records))
+	require.Equal(t, "project1", records.Records[0].Key)
+	require.Equal(t, sinkTestData[0], records.Records[0].Value)
+}
+
+func TestStatsdSink(t *testing.T) {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer conn.Close()
+	require.NoError(t, NewStatsdSink(conn.LocalAddr().String(), "lava").Send(context.Background(), sinkTestData))
+
+	buffer := make([]byte, statsdMaxPacketSize)
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(time.Second)))
+	n, _, err := conn.ReadFrom(buffer)
+	require.NoError(t, err)
+	lines := strings.Split(string(buffer[:n]), "\n")
+	// no latency for a chain without successful relays
+	require.Equal(t, []string{
+		"lava.project1.LAV1.rest.relays:10|c",
+		"lava.project1.LAV1.rest.success:9|c",
+		"lava.project1.LAV1.rest.latency:50|ms",
+		"lava.project1.ETH1.jsonrpc.relays:1|c",
+		"lava.project1.ETH1.jsonrpc.success:0|c",
+	}, lines)
+}
+
+func TestStatsdPackets(t *testing.T) {
+	packets := statsdPackets([]string{"aaaa", "bbbb", "cccc"}, 9)
+	require.Equal(t, []string{"aaaa\nbbbb", "cccc"}, packets)
+	require.Equal(t, "a_b_c", sanitizeStatsdName("a.b:c"))
+}
+
+type mockSink struct {
+	lock     sync.Mutex
+	failures int
+	sent     [][]RelayAnalyticsDTO
+	block    chan struct{}
+}
+
+func (ms *mockSink) Name() string {
+	return "mock"
+}
+
+func (ms *mockSink) Send(ctx context.Context, data []RelayAnalyticsDTO) error {
+	if ms.block != nil {
+		<-ms.block
+	}
+	ms.lock.Lock()
+	defer ms.lock.Unlock()
+	if ms.failures > 0 {
+		ms.failures--
+		return errors.New("sink unavailable")
+	}
+	ms.sent = append(ms.sent, data)
+	return nil
+}
+
+func (ms *mockSink) sentBatches() int {
+	ms.lock.Lock()
+	defer ms.lock.Unlock()
+	return len(ms.sent)
+}
+
+func TestSinkWorkerRetries(t *testing.T) {
+	sink := &mockSink{failures: SinkMaxRetries}
+	worker := newSinkWorker(sink, time.Millisecond)
+	require.True(t, worker.enqueue(sinkTestData))
+	require.Eventually(t, func() bool { return sink.sentBatches() == 1 }, time.Second, time.Millisecond)
+
+	// a batch is dropped after the last retry
+	sink = &mockSink{failures: SinkMaxRetries + 1}
+	worker = &sinkWorker{sink: sink, retryDelay: time.Millisecond}
+	require.Error(t, worker.sendWithRetries(sinkTestData))
+	require.Equal(t, 0, sink.sentBatches())
+}
+
+func TestSinkWorkerBackpressure(t *testing.T) {
+	sink := &mockSink{block: make(chan struct{})}
+	worker := newSinkWorker(sink, time.Millisecond)
+	// one batch is being sent and the rest wait in the queue
+	accepted := 0
+	for i := 0; i < SinkQueueSize+2; i++ {
+		if worker.enqueue(sinkTestData) {
+			accepted++
+		}
+	}
+	require.GreaterOrEqual(t, accepted, SinkQueueSize)
+	require.Less(t, accepted, SinkQueueSize+2)
+	close(sink.block)
+	require.Eventually(t, func() bool { return sink.sentBatches() == accepted }, time.Second, time.Millisecond)
+}
+
+func TestMetricServiceSendsBatchesToSinks(t *testing.T) {
+	sink := &mockSink{}
+	metricService := MetricService{
+		AggregatedMetricMap: &map[string]map[string]map[string]*AggregatedMetric{},
+		sinks:               []*sinkWorker{newSinkWorker(sink, time.Millisecond)},
+	}
+	for i := 0; i < SinkMaxBatchSize+1; i++ {
+		metricService.storeAggregatedData(RelayMetrics{ProjectHash: "1", ChainID: "LAV1", APIType: strings.Repeat("a", i+1), Success: true, Latency: 10})
+	}
+	metricService.SendEachProjectMetricData()
+	require.Eventually(t, func() bool { return sink.sentBatches() == 2 }, time.Second, time.Millisecond)
+	require.Equal(t, 0, len(*metricService.AggregatedMetricMap))
+}
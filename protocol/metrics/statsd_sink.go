@@ -0,0 +1,79 @@
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+)
+
+const statsdMaxPacketSize = 1432 // fits a single ethernet frame
+
+// statsdSink sends the analytics as statsd counters and timers over udp
+type statsdSink struct {
+	address string
+	prefix  string
+}
+
+func NewStatsdSink(address string, prefix string) MetricsSink {
+	return &statsdSink{address: address, prefix: prefix}
+}
+
+func (ss *statsdSink) Name() string {
+	return "statsd"
+}
+
+func (ss *statsdSink) Send(ctx context.Context, data []RelayAnalyticsDTO) error {
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "udp", ss.address)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	for _, packet := range statsdPackets(formatStatsdLines(ss.prefix, data), statsdMaxPacketSize) {
+		_, err = conn.Write([]byte(packet))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func formatStatsdLines(prefix string, data []RelayAnalyticsDTO) []string {
+	lines := []string{}
+	for _, analytics := range data {
+		path := strings.Join([]string{prefix, sanitizeStatsdName(analytics.ProjectHash), sanitizeStatsdName(analytics.ChainID), sanitizeStatsdName(analytics.APIType)}, ".")
+		lines = append(lines,
+			fmt.Sprintf("%s.relays:%d|c", path, analytics.RelayCounts),
+			fmt.Sprintf("%s.success:%d|c", path, analytics.SuccessCount),
+		)
+		if analytics.SuccessCount > 0 {
+			lines = append(lines, fmt.Sprintf("%s.latency:%d|ms", path, analytics.Latency))
+		}
+	}
+	return lines
+}
+
+// statsd reserves these characters
+func sanitizeStatsdName(name string) string {
+	return strings.NewReplacer(".", "_", ":", "_", "|", "_", "@", "_", " ", "_", "\n", "_").Replace(name)
+}
+
+func statsdPackets(lines []string, maxPacketSize int) []string {
+	packets := []string{}
+	var packet strings.Builder
+	for _, line := range lines {
+		if packet.Len() > 0 && packet.Len()+1+len(line) > maxPacketSize {
+			packets = append(packets, packet.String())
+			packet.Reset()
+		}
+		if packet.Len() > 0 {
+			packet.WriteString("\n")
+		}
+		packet.WriteString(line)
+	}
+	if packet.Len() > 0 {
+		packets = append(packets, packet.String())
+	}
+	return packets
+}
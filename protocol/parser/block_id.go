@@ -0,0 +1,66 @@
+package parser
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// the properties of a block id object, e.g. StarkNet's {"block_number":15} or {"block_hash":"0x1a"}
+const (
+	BlockIdNumberKey = "block_number"
+	BlockIdHashKey   = "block_hash"
+)
+
+// blockHash is a block id that names its block by hash, the block number isn't known before the node replies
+type blockHash string
+
+// ParseBlockId returns the block of a block id param, which is either a tag such as "latest" and "pending" or an object with the
+// block's number or hash. expected arguments are [param index, param name], the name is looked up when the params are named
+func ParseBlockId(rpcInput RPCInput, input []string, dataSource int) ([]interface{}, error) {
+	if len(input) != 1 && len(input) != 2 {
+		return nil, fmt.Errorf("invalid input format, input length: %d and needs to be 1 or 2", len(input))
+	}
+	paramIndex, err := strconv.ParseUint(input[0], 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("invalid input format, input isn't an unsigned index: %s, error: %s", input[0], err)
+	}
+
+	unmarshalledData, err := GetDataToParse(rpcInput, dataSource)
+	if err != nil {
+		return nil, fmt.Errorf("invalid input format, data is not json: %s, error: %s", unmarshalledData, err)
+	}
+	var blockId interface{}
+	switch unmarshalledDataTyped := unmarshalledData.(type) {
+	case nil:
+		return nil, ValueNotSetError
+	case []interface{}:
+		if uint64(len(unmarshalledDataTyped)) <= paramIndex {
+			return nil, ValueNotSetError
+		}
+		blockId = unmarshalledDataTyped[paramIndex]
+	case map[string]interface{}:
+		if len(input) != 2 {
+			return nil, fmt.Errorf("invalid input format, named params need the param name of the block id")
+		}
+		blockId = unmarshalledDataTyped[input[1]]
+	default:
+		return nil, fmt.Errorf("not Supported ParseBlockId with other types")
+	}
+
+	switch blockIdTyped := blockId.(type) {
+	case nil:
+		return nil, ValueNotSetError
+	case string:
+		return appendInterfaceToInterfaceArray(blockIdTyped), nil
+	case map[string]interface{}:
+		if number, ok := blockIdTyped[BlockIdNumberKey]; ok && number != nil {
+			return appendInterfaceToInterfaceArray(blockInterfaceToString(number)), nil
+		}
+		if hash, ok := blockIdTyped[BlockIdHashKey].(string); ok {
+			return appendInterfaceToInterfaceArray(blockHash(hash)), nil
+		}
+		return nil, fmt.Errorf("invalid block id, expected a %s or a %s property: %v", BlockIdNumberKey, BlockIdHashKey, blockIdTyped)
+	default:
+		return nil, fmt.Errorf("invalid block id, expected a tag or an object: %v", blockIdTyped)
+	}
+}
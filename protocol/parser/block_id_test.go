@@ -0,0 +1,51 @@
+package parser
+
+import (
+	"encoding/json"
+	"testing"
+
+	spectypes "github.com/lavanet/lava/x/spec/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseBlockId(t *testing.T) {
+	blockParser := spectypes.BlockParser{ParserArg: []string{"1", "block_id"}, ParserFunc: spectypes.PARSER_FUNC_PARSE_BLOCK_ID}
+	tests := []struct {
+		name      string
+		params    interface{}
+		expected  int64
+		expectErr bool
+	}{
+		{name: "tag", params: []interface{}{"0x1", "latest"}, expected: spectypes.LATEST_BLOCK},
+		{name: "pending tag", params: []interface{}{"0x1", "pending"}, expected: spectypes.PENDING_BLOCK},
+		{name: "block number", params: []interface{}{"0x1", map[string]interface{}{"block_number": float64(15)}}, expected: 15},
+		{name: "block hash", params: []interface{}{"0x1", map[string]interface{}{"block_hash": "0x1a2b"}}, expected: spectypes.NOT_APPLICABLE},
+		{name: "named params", params: map[string]interface{}{"contract_address": "0x1", "block_id": map[string]interface{}{"block_number": float64(20)}}, expected: 20},
+		{name: "neither number nor hash", params: []interface{}{"0x1", map[string]interface{}{"block_tag": "latest"}}, expectErr: true},
+		{name: "not a block id", params: []interface{}{"0x1", true}, expectErr: true},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			block, err := ParseBlockFromParams(jsonPathTestInput{params: test.params}, blockParser)
+			if test.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.expected, block)
+		})
+	}
+
+	// a left out block id falls back to the default
+	blockParser.DefaultValue = "latest"
+	block, err := ParseBlockFromParams(jsonPathTestInput{params: []interface{}{"0x1"}}, blockParser)
+	require.NoError(t, err)
+	require.Equal(t, spectypes.LATEST_BLOCK, block)
+
+	// the block number of a reply, such as starknet_getBlockWithTxHashes'
+	result := json.RawMessage(`{"block_hash":"0x1a2b","block_number":21,"status":"ACCEPTED_ON_L2"}`)
+	block, err = ParseBlockFromReply(jsonPathTestInput{result: result}, spectypes.BlockParser{ParserArg: []string{"0"}, ParserFunc: spectypes.PARSER_FUNC_PARSE_BLOCK_ID})
+	require.NoError(t, err)
+	require.Equal(t, int64(21), block)
+}
@@ -0,0 +1,232 @@
+package parser
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/lavanet/lava/utils"
+)
+
+type jsonPathSelector int
+
+const (
+	jsonPathSelectName jsonPathSelector = iota
+	jsonPathSelectIndex
+	jsonPathSelectWildcard
+)
+
+// jsonPathStep is a single step of a JSONPath expression, e.g. .name, [2], [-1], [*] or ..name
+type jsonPathStep struct {
+	selector  jsonPathSelector
+	name      string
+	index     int  // negative indexes count from the end of the array
+	recursive bool // the step selects from all the descendants and not only from the children
+}
+
+// ParseJsonPath returns the values a JSONPath expression selects from the params or the result, the expression is the only argument.
+// supported are the root $, child names (.name or ['name']), array indexes ([0], [-1] for the last element), wildcards (.* or [*])
+// and recursive descent (..name)
+func ParseJsonPath(rpcInput RPCInput, input []string, dataSource int) ([]interface{}, error) {
+	if len(input) != 1 {
+		return nil, fmt.Errorf("invalid input format, input length: %d and needs to be 1", len(input))
+	}
+	steps, err := compileJsonPath(input[0])
+	if err != nil {
+		return nil, err
+	}
+	data, err := getJsonPathData(rpcInput, dataSource)
+	if err != nil {
+		return nil, err
+	}
+
+	retArr := make([]interface{}, 0)
+	for _, match := range evaluateJsonPath(data, steps) {
+		if match == nil {
+			continue
+		}
+		value, err := jsonPathValueToString(match)
+		if err != nil {
+			return nil, err
+		}
+		retArr = append(retArr, value)
+	}
+	if len(retArr) == 0 {
+		return nil, ValueNotSetError
+	}
+	return retArr, nil
+}
+
+// the expression is evaluated on the params or on the result itself, numbers in the result are kept as written so big block
+// numbers aren't rounded
+func getJsonPathData(rpcInput RPCInput, dataSource int) (interface{}, error) {
+	switch dataSource {
+	case PARSE_PARAMS:
+		return rpcInput.GetParams(), nil
+	case PARSE_RESULT:
+		result := rpcInput.GetResult()
+		if len(result) == 0 {
+			return nil, utils.LavaFormatError("GetDataToParse Result is empty", nil, utils.Attribute{Key: "data source", Value: "PARSE_RESULT"})
+		}
+		decoder := json.NewDecoder(bytes.NewReader(result))
+		decoder.UseNumber()
+		var data interface{}
+		if err := decoder.Decode(&data); err != nil {
+			return nil, fmt.Errorf("invalid input format, result is not json: %s, error: %s", result, err)
+		}
+		return data, nil
+	default:
+		return nil, fmt.Errorf("unsupported block parser parserFunc")
+	}
+}
+
+func compileJsonPath(expression string) ([]jsonPathStep, error) {
+	if !strings.HasPrefix(expression, "$") {
+		return nil, fmt.Errorf("invalid JSONPath expression %s, it must start with $", expression)
+	}
+	steps := []jsonPathStep{}
+	rest := expression[1:]
+	for rest != "" {
+		step := jsonPathStep{}
+		switch {
+		case strings.HasPrefix(rest, ".."):
+			step.recursive = true
+			rest = rest[2:]
+		case rest[0] == '.':
+			rest = rest[1:]
+		case rest[0] != '[':
+			return nil, fmt.Errorf("invalid JSONPath expression %s, unexpected %q", expression, rest[0])
+		}
+
+		if strings.HasPrefix(rest, "[") {
+			end := strings.IndexByte(rest, ']')
+			if end == -1 {
+				return nil, fmt.Errorf("invalid JSONPath expression %s, unclosed [", expression)
+			}
+			selector := strings.TrimSpace(rest[1:end])
+			rest = rest[end+1:]
+			switch {
+			case selector == "*":
+				step.selector = jsonPathSelectWildcard
+			case len(selector) >= 2 && (selector[0] == '\'' || selector[0] == '"') && selector[len(selector)-1] == selector[0]:
+				step.selector = jsonPathSelectName
+				step.name = selector[1 : len(selector)-1]
+			default:
+				index, err := strconv.Atoi(selector)
+				if err != nil {
+					return nil, fmt.Errorf("invalid JSONPath expression %s, %s is not an index", expression, selector)
+				}
+				step.selector = jsonPathSelectIndex
+				step.index = index
+			}
+		} else {
+			end := strings.IndexAny(rest, ".[")
+			if end == -1 {
+				end = len(rest)
+			}
+			name := rest[:end]
+			rest = rest[end:]
+			switch name {
+			case "":
+				return nil, fmt.Errorf("invalid JSONPath expression %s, missing a name", expression)
+			case "*":
+				step.selector = jsonPathSelectWildcard
+			default:
+				step.selector = jsonPathSelectName
+				step.name = name
+			}
+		}
+		steps = append(steps, step)
+	}
+	return steps, nil
+}
+
+func evaluateJsonPath(data interface{}, steps []jsonPathStep) []interface{} {
+	nodes := []interface{}{data}
+	for _, step := range steps {
+		selected := []interface{}{}
+		for _, node := range nodes {
+			if step.recursive {
+				selected = append(selected, step.selectDescendants(node)...)
+			} else {
+				selected = append(selected, step.selectChildren(node)...)
+			}
+		}
+		nodes = selected
+	}
+	return nodes
+}
+
+func (step jsonPathStep) selectChildren(node interface{}) []interface{} {
+	switch step.selector {
+	case jsonPathSelectName:
+		if object, ok := node.(map[string]interface{}); ok {
+			if value, ok := object[step.name]; ok {
+				return []interface{}{value}
+			}
+		}
+	case jsonPathSelectIndex:
+		if array, ok := node.([]interface{}); ok {
+			index := step.index
+			if index < 0 {
+				index += len(array)
+			}
+			if index >= 0 && index < len(array) {
+				return []interface{}{array[index]}
+			}
+		}
+	case jsonPathSelectWildcard:
+		return jsonPathChildren(node)
+	}
+	return nil
+}
+
+func (step jsonPathStep) selectDescendants(node interface{}) []interface{} {
+	selected := step.selectChildren(node)
+	for _, child := range jsonPathChildren(node) {
+		selected = append(selected, step.selectDescendants(child)...)
+	}
+	return selected
+}
+
+// returns the elements of an array or the values of an object ordered by their keys
+func jsonPathChildren(node interface{}) []interface{} {
+	switch typed := node.(type) {
+	case []interface{}:
+		return typed
+	case map[string]interface{}:
+		keys := make([]string, 0, len(typed))
+		for key := range typed {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		children := make([]interface{}, 0, len(typed))
+		for _, key := range keys {
+			children = append(children, typed[key])
+		}
+		return children
+	default:
+		return nil
+	}
+}
+
+// selected objects and arrays are returned as their json
+func jsonPathValueToString(value interface{}) (string, error) {
+	switch typed := value.(type) {
+	case json.Number:
+		return typed.String(), nil
+	case bool:
+		return strconv.FormatBool(typed), nil
+	case map[string]interface{}, []interface{}:
+		marshalled, err := json.Marshal(typed)
+		if err != nil {
+			return "", err
+		}
+		return string(marshalled), nil
+	default:
+		return blockInterfaceToString(typed), nil
+	}
+}
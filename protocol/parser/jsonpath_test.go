@@ -0,0 +1,84 @@
+package parser
+
+import (
+	"encoding/json"
+	"testing"
+
+	spectypes "github.com/lavanet/lava/x/spec/types"
+	"github.com/stretchr/testify/require"
+)
+
+type jsonPathTestInput struct {
+	params interface{}
+	result json.RawMessage
+}
+
+func (input jsonPathTestInput) GetParams() interface{} {
+	return input.params
+}
+
+func (input jsonPathTestInput) GetResult() json.RawMessage {
+	return input.result
+}
+
+func (input jsonPathTestInput) ParseBlock(block string) (int64, error) {
+	return ParseDefaultBlockParameter(block)
+}
+
+func TestParseJsonPath(t *testing.T) {
+	result := json.RawMessage(`{"blocks":[{"header":{"number":"0x10","hash":"0xab"}},{"header":{"number":"0x11","hash":"0xcd"}}],"height":18446744073709551615,"synced":true,"missing":null}`)
+	input := jsonPathTestInput{result: result}
+	tests := []struct {
+		name       string
+		expression string
+		expected   []interface{}
+		expectErr  bool
+	}{
+		{name: "nested field", expression: "$.blocks[0].header.number", expected: []interface{}{"0x10"}},
+		{name: "last element", expression: "$.blocks[-1].header.hash", expected: []interface{}{"0xcd"}},
+		{name: "bracket names", expression: `$['blocks'][1]["header"].number`, expected: []interface{}{"0x11"}},
+		{name: "wildcard", expression: "$.blocks[*].header.number", expected: []interface{}{"0x10", "0x11"}},
+		{name: "recursive descent", expression: "$..hash", expected: []interface{}{"0xab", "0xcd"}},
+		{name: "big number", expression: "$.height", expected: []interface{}{"18446744073709551615"}},
+		{name: "bool", expression: "$.synced", expected: []interface{}{"true"}},
+		{name: "object", expression: "$.blocks[0].header", expected: []interface{}{`{"hash":"0xab","number":"0x10"}`}},
+		{name: "out of range", expression: "$.blocks[2]", expectErr: true},
+		{name: "null", expression: "$.missing", expectErr: true},
+		{name: "no root", expression: "blocks[0]", expectErr: true},
+		{name: "unclosed bracket", expression: "$.blocks[0", expectErr: true},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			values, err := ParseJsonPath(input, []string{test.expression}, PARSE_RESULT)
+			if test.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.expected, values)
+		})
+	}
+}
+
+func TestParseJsonPathBlock(t *testing.T) {
+	input := jsonPathTestInput{
+		params: []interface{}{map[string]interface{}{"filter": map[string]interface{}{"block": "0x20"}}},
+		result: json.RawMessage(`[{"number":"0x10"},{"number":"0x1f"}]`),
+	}
+	blockParser := spectypes.BlockParser{ParserArg: []string{"$[0].filter.block"}, ParserFunc: spectypes.PARSER_FUNC_PARSE_JSONPATH}
+	block, err := ParseBlockFromParams(input, blockParser)
+	require.NoError(t, err)
+	require.Equal(t, int64(0x20), block)
+
+	blockParser.ParserArg = []string{"$[-1].number"}
+	block, err = ParseBlockFromReply(input, blockParser)
+	require.NoError(t, err)
+	require.Equal(t, int64(0x1f), block)
+
+	// a value that isn't there falls back to the default
+	blockParser = spectypes.BlockParser{ParserArg: []string{"$[0].filter.toBlock"}, ParserFunc: spectypes.PARSER_FUNC_PARSE_JSONPATH, DefaultValue: "latest"}
+	block, err = ParseBlockFromParams(input, blockParser)
+	require.NoError(t, err)
+	require.Equal(t, spectypes.LATEST_BLOCK, block)
+}
@@ -70,6 +70,10 @@ func Parse(rpcInput RPCInput, blockParser spectypes.BlockParser, dataSource int)
 		retval, err = ParseDictionaryOrOrdered(rpcInput, blockParser.ParserArg, dataSource)
 	case spectypes.PARSER_FUNC_DEFAULT:
 		retval = ParseDefault(rpcInput, blockParser.ParserArg, dataSource)
+	case spectypes.PARSER_FUNC_PARSE_JSONPATH:
+		retval, err = ParseJsonPath(rpcInput, blockParser.ParserArg, dataSource)
+	case spectypes.PARSER_FUNC_PARSE_BLOCK_ID:
+		retval, err = ParseBlockId(rpcInput, blockParser.ParserArg, dataSource)
 	default:
 		return nil, fmt.Errorf("unsupported block parser parserFunc")
 	}
@@ -98,6 +102,10 @@ func ParseBlockFromParams(rpcInput RPCInput, blockParser spectypes.BlockParser)
 	if err != nil || result == nil {
 		return spectypes.NOT_APPLICABLE, err
 	}
+	if _, ok := result[0].(blockHash); ok {
+		// the reply is of a specific block, but which one isn't known to compare it with other providers or cache it as finalized
+		return spectypes.NOT_APPLICABLE, nil
+	}
 	resString, ok := result[0].(string)
 	if !ok {
 		return spectypes.NOT_APPLICABLE, fmt.Errorf("ParseBlockFromParams - result[0].(string) - type assertion failed, type:" + fmt.Sprintf("%s", result[0]))
@@ -218,8 +226,11 @@ func ParseByArg(rpcInput RPCInput, input []string, dataSource int) ([]interface{
 		return nil, utils.LavaFormatError("invalid input format, data is not json", err, utils.Attribute{Key: "data", Value: unmarshalledData})
 	}
 	switch unmarshaledDataTyped := unmarshalledData.(type) {
+	case nil:
+		// the request left out its optional params
+		return nil, ValueNotSetError
 	case []interface{}:
-		if uint64(len(unmarshaledDataTyped)) <= param_index {
+		if uint64(len(unmarshaledDataTyped)) <= param_index || unmarshaledDataTyped[param_index] == nil {
 			return nil, ValueNotSetError
 		}
 		block := unmarshaledDataTyped[param_index]
@@ -326,6 +337,8 @@ func ParseDictionary(rpcInput RPCInput, input []string, dataSource int) ([]inter
 	innerSeparator := input[1]
 
 	switch unmarshalledDataTyped := unmarshalledData.(type) {
+	case nil:
+		return nil, ValueNotSetError
 	case []interface{}:
 		// If value attribute with propName exists in array return it
 		value := parseArrayOfInterfaces(unmarshalledDataTyped, propName, innerSeparator)
@@ -336,8 +349,8 @@ func ParseDictionary(rpcInput RPCInput, input []string, dataSource int) ([]inter
 		// Else return an error
 		return nil, ValueNotSetError
 	case map[string]interface{}:
-		// If attribute with key propName exists return value
-		if val, ok := unmarshalledDataTyped[propName]; ok {
+		// If attribute with key propName exists return value, a null value counts as not set
+		if val, ok := unmarshalledDataTyped[propName]; ok && val != nil {
 			return appendInterfaceToInterfaceArray(blockInterfaceToString(val)), nil
 		}
 
@@ -376,6 +389,8 @@ func ParseDictionaryOrOrdered(rpcInput RPCInput, input []string, dataSource int)
 	}
 
 	switch unmarshalledDataTyped := unmarshalledData.(type) {
+	case nil:
+		return nil, ValueNotSetError
 	case []interface{}:
 		// If value attribute with propName exists in array return it
 		value := parseArrayOfInterfaces(unmarshalledDataTyped, propName, innerSeparator)
@@ -384,7 +399,7 @@ func ParseDictionaryOrOrdered(rpcInput RPCInput, input []string, dataSource int)
 		}
 
 		// If not make sure there are enough elements
-		if uint64(len(unmarshalledDataTyped)) <= propIndex {
+		if uint64(len(unmarshalledDataTyped)) <= propIndex || unmarshalledDataTyped[propIndex] == nil {
 			return nil, ValueNotSetError
 		}
 
@@ -392,13 +407,13 @@ func ParseDictionaryOrOrdered(rpcInput RPCInput, input []string, dataSource int)
 		block := unmarshalledDataTyped[propIndex]
 		return appendInterfaceToInterfaceArray(blockInterfaceToString(block)), nil
 	case map[string]interface{}:
-		// If attribute with key propName exists return value
-		if val, ok := unmarshalledDataTyped[propName]; ok {
+		// If attribute with key propName exists return value, a null value counts as not set
+		if val, ok := unmarshalledDataTyped[propName]; ok && val != nil {
 			return appendInterfaceToInterfaceArray(blockInterfaceToString(val)), nil
 		}
 
 		// If attribute with key index exists return value
-		if val, ok := unmarshalledDataTyped[inp]; ok {
+		if val, ok := unmarshalledDataTyped[inp]; ok && val != nil {
 			return appendInterfaceToInterfaceArray(blockInterfaceToString(val)), nil
 		}
 
@@ -409,6 +424,94 @@ func ParseDictionaryOrOrdered(rpcInput RPCInput, input []string, dataSource int)
 	}
 }
 
+// ReplaceBlockInParams returns a copy of the params with the block the block parser reads replaced by block,
+// setting it when the params left it out. only parsers that read the block from a single param are supported
+func ReplaceBlockInParams(params interface{}, blockParser spectypes.BlockParser, block string) (interface{}, error) {
+	var propName, innerSeparator string
+	propIndex := -1
+	switch blockParser.ParserFunc {
+	case spectypes.PARSER_FUNC_PARSE_BY_ARG:
+		if len(blockParser.ParserArg) != 1 {
+			return nil, fmt.Errorf("invalid input format, input length: %d and needs to be 1", len(blockParser.ParserArg))
+		}
+	case spectypes.PARSER_FUNC_PARSE_DICTIONARY:
+		if len(blockParser.ParserArg) != 2 {
+			return nil, fmt.Errorf("invalid input format, input length: %d and needs to be 2", len(blockParser.ParserArg))
+		}
+		propName, innerSeparator = blockParser.ParserArg[0], blockParser.ParserArg[1]
+	case spectypes.PARSER_FUNC_PARSE_DICTIONARY_OR_ORDERED:
+		if len(blockParser.ParserArg) != 3 {
+			return nil, fmt.Errorf("invalid input format, input length: %d and needs to be 3", len(blockParser.ParserArg))
+		}
+		propName, innerSeparator = blockParser.ParserArg[0], blockParser.ParserArg[1]
+	default:
+		return nil, fmt.Errorf("replacing the block is unsupported for parser func %s", blockParser.ParserFunc)
+	}
+	if blockParser.ParserFunc != spectypes.PARSER_FUNC_PARSE_DICTIONARY {
+		index, err := strconv.ParseUint(blockParser.ParserArg[len(blockParser.ParserArg)-1], 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid input format, input isn't an unsigned index: %s, error: %s", blockParser.ParserArg[len(blockParser.ParserArg)-1], err)
+		}
+		propIndex = int(index)
+	}
+
+	switch paramsTyped := params.(type) {
+	case nil:
+		if propName != "" {
+			return map[string]interface{}{propName: block}, nil
+		}
+		return replaceBlockInArray(nil, propIndex, block)
+	case []interface{}:
+		replaced := make([]interface{}, len(paramsTyped))
+		copy(replaced, paramsTyped)
+		if propName != "" {
+			for idx, val := range replaced {
+				if prop, ok := val.(string); ok && strings.HasPrefix(prop, propName+innerSeparator) {
+					replaced[idx] = propName + innerSeparator + block
+					return replaced, nil
+				}
+			}
+			if propIndex == -1 {
+				return append(replaced, propName+innerSeparator+block), nil
+			}
+		}
+		return replaceBlockInArray(replaced, propIndex, block)
+	case map[string]interface{}:
+		if propName == "" {
+			return nil, fmt.Errorf("replacing the block by arg is supported only for list params")
+		}
+		replaced := make(map[string]interface{}, len(paramsTyped)+1)
+		for key, val := range paramsTyped {
+			replaced[key] = val
+		}
+		key := propName
+		if propIndex != -1 {
+			if val, ok := paramsTyped[propName]; !ok || val == nil {
+				if _, ok := paramsTyped[strconv.Itoa(propIndex)]; ok {
+					key = strconv.Itoa(propIndex)
+				}
+			}
+		}
+		replaced[key] = block
+		return replaced, nil
+	default:
+		return nil, fmt.Errorf("replacing the block is unsupported for params of type %T", params)
+	}
+}
+
+// sets the block at index, an array that ends right before it is extended
+func replaceBlockInArray(params []interface{}, index int, block string) ([]interface{}, error) {
+	switch {
+	case index < len(params):
+		params[index] = block
+		return params, nil
+	case index == len(params):
+		return append(params, block), nil
+	default:
+		return nil, fmt.Errorf("can't set the block at param %d, the params have only %d values", index, len(params))
+	}
+}
+
 // parseArrayOfInterfaces returns value of item with specified prop name
 // If it doesn't exist return nil
 func parseArrayOfInterfaces(data []interface{}, propName string, innerSeparator string) []interface{} {
@@ -115,3 +115,43 @@ func TestParseResponseByEncoding(t *testing.T) {
 	testData = []data{{bytes: []byte("0x968ec00fd34eedc03b0577ee8116f74c75127b7d775e51c7a72519f760b821a8"), encoding: spectypes.EncodingHex}, {bytes: []byte("lo7AD9NO7cA7BXfugRb3THUSe313XlHHpyUZ92C4Iag="), encoding: spectypes.EncodingBase64}}
 	testInputs(testData)
 }
+
+func TestReplaceBlockInParams(t *testing.T) {
+	byName := spectypes.BlockParser{ParserArg: []string{"height", "=", "0"}, ParserFunc: spectypes.PARSER_FUNC_PARSE_DICTIONARY_OR_ORDERED}
+	byArg := spectypes.BlockParser{ParserArg: []string{"1"}, ParserFunc: spectypes.PARSER_FUNC_PARSE_BY_ARG}
+	tests := []struct {
+		name        string
+		params      interface{}
+		blockParser spectypes.BlockParser
+		expected    interface{}
+		expectErr   bool
+	}{
+		{name: "named param", params: map[string]interface{}{"height": "latest", "prove": true}, blockParser: byName, expected: map[string]interface{}{"height": "5", "prove": true}},
+		{name: "named param left out", params: map[string]interface{}{}, blockParser: byName, expected: map[string]interface{}{"height": "5"}},
+		{name: "no params", params: nil, blockParser: byName, expected: map[string]interface{}{"height": "5"}},
+		{name: "ordered param", params: []interface{}{nil}, blockParser: byName, expected: []interface{}{"5"}},
+		{name: "ordered param left out", params: []interface{}{}, blockParser: byName, expected: []interface{}{"5"}},
+		{name: "by arg", params: []interface{}{"0x1", "latest"}, blockParser: byArg, expected: []interface{}{"0x1", "5"}},
+		{name: "by arg out of range", params: []interface{}{}, blockParser: byArg, expectErr: true},
+		{name: "by arg in a dictionary", params: map[string]interface{}{}, blockParser: byArg, expectErr: true},
+		{name: "unsupported parser", params: []interface{}{}, blockParser: spectypes.BlockParser{ParserArg: []string{"latest"}, ParserFunc: spectypes.PARSER_FUNC_DEFAULT}, expectErr: true},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			result, err := ReplaceBlockInParams(test.params, test.blockParser, "5")
+			if test.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.expected, result)
+		})
+	}
+
+	// the params of the original message are left as they were
+	params := []interface{}{"0x1", "latest"}
+	_, err := ReplaceBlockInParams(params, byArg, "5")
+	require.NoError(t, err)
+	require.Equal(t, "latest", params[1])
+}
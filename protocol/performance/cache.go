@@ -6,12 +6,30 @@ import (
 
 	pairingtypes "github.com/lavanet/lava/x/pairing/types"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
 )
 
+// implemented by cache clients that expire entries depending on the latest block
+type latestBlockAwareCacheClient interface {
+	OnNewLatestBlock(chainID string, latestBlock int64, averageBlockTime time.Duration)
+}
+
+// implemented by cache clients that can drop all the entries of a chain
+type flushableCacheClient interface {
+	Flush(chainID string)
+}
+
+// implemented by cache clients that count the entries their storage evicted
+type evictionsCountingCacheClient interface {
+	Evictions() uint64
+}
+
 type Cache struct {
 	client  pairingtypes.RelayerCacheClient
 	address string
+	metrics cacheMetrics
 }
 
 func ConnectGRPCConnectionToRelayerCacheService(ctx context.Context, addr string) (*pairingtypes.RelayerCacheClient, error) {
@@ -45,7 +63,26 @@ func (cache *Cache) GetEntry(ctx context.Context, request *pairingtypes.RelayReq
 		return nil, NotConnectedError.Wrapf("No client connected to address: %s", cache.address)
 	}
 	// TODO: handle disconnections and error types here
-	return cache.client.GetRelay(ctx, &pairingtypes.RelayCacheGet{Request: request, ApiInterface: apiInterface, BlockHash: blockHash, ChainID: chainID, Finalized: finalized})
+	start := time.Now()
+	reply, err = cache.client.GetRelay(ctx, &pairingtypes.RelayCacheGet{Request: request, ApiInterface: apiInterface, BlockHash: blockHash, ChainID: chainID, Finalized: finalized})
+	cache.metrics.onGet(chainID, apiInterface, request.GetRelayData(), time.Since(start), err == nil)
+	return reply, err
+}
+
+// GetSignedEntry returns a finalized cached reply with the request its provider signed it for, so the reply can be
+// attributed to its provider. cache services without the signed entries return UnsupportedCacheOperationError
+func (cache *Cache) GetSignedEntry(ctx context.Context, request *pairingtypes.RelayRequest, apiInterface string, chainID string) (*pairingtypes.RelayCacheEntry, error) {
+	if cache == nil {
+		return nil, NotInitialisedError
+	}
+	if cache.client == nil {
+		return nil, NotConnectedError.Wrapf("No client connected to address: %s", cache.address)
+	}
+	entry, err := cache.client.GetSignedRelay(ctx, &pairingtypes.RelayCacheGet{Request: request, ApiInterface: apiInterface, ChainID: chainID, Finalized: true})
+	if status.Code(err) == codes.Unimplemented {
+		return nil, UnsupportedCacheOperationError.Wrapf("cache service at %s doesn't return signed entries", cache.address)
+	}
+	return entry, err
 }
 
 func (cache *Cache) SetEntry(ctx context.Context, request *pairingtypes.RelayRequest, apiInterface string, blockHash []byte, chainID string, bucketID string, reply *pairingtypes.RelayReply, finalized bool) error {
@@ -57,6 +94,55 @@ func (cache *Cache) SetEntry(ctx context.Context, request *pairingtypes.RelayReq
 		return NotConnectedError.Wrapf("No client connected to address: %s", cache.address)
 	}
 	// TODO: handle disconnections and SetRelay error types here
+	start := time.Now()
 	_, err := cache.client.SetRelay(ctx, &pairingtypes.RelayCacheSet{Request: request, ApiInterface: apiInterface, BlockHash: blockHash, ChainID: chainID, Response: reply, Finalized: finalized, BucketID: bucketID})
+	cache.metrics.onSet(chainID, time.Since(start), err == nil)
 	return err
 }
+
+// OnNewLatestBlock lets the cache invalidate non finalized entries of a chain when its latest block advances
+func (cache *Cache) OnNewLatestBlock(chainID string, latestBlock int64, averageBlockTime time.Duration) {
+	if cache == nil || cache.client == nil {
+		return
+	}
+	if client, ok := cache.client.(latestBlockAwareCacheClient); ok {
+		client.OnNewLatestBlock(chainID, latestBlock, averageBlockTime)
+	}
+}
+
+// Stats returns the hits, misses, stored entries and latencies of each chain as seen by this process
+func (cache *Cache) Stats() *pairingtypes.CacheStats {
+	if cache == nil {
+		return &pairingtypes.CacheStats{}
+	}
+	stats := cache.metrics.stats()
+	if client, ok := cache.client.(evictionsCountingCacheClient); ok {
+		stats.Evictions = client.Evictions()
+	}
+	return stats
+}
+
+// Flush drops all the entries of a chain, only supported by caches held by this process
+func (cache *Cache) Flush(chainID string) error {
+	if cache == nil {
+		return NotInitialisedError
+	}
+	client, ok := cache.client.(flushableCacheClient)
+	if !ok {
+		return UnsupportedCacheOperationError.Wrapf("flush is not supported by the cache at address: %s", cache.address)
+	}
+	client.Flush(chainID)
+	cache.metrics.onFlush(chainID)
+	return nil
+}
+
+// HotKeys returns the requests with the most cache hits, for all chains when chainID is empty
+func (cache *Cache) HotKeys(chainID string, limit int) []*pairingtypes.CacheHotKey {
+	if cache == nil {
+		return nil
+	}
+	if limit <= 0 {
+		limit = DefaultHotKeysLimit
+	}
+	return cache.metrics.hottestKeys(chainID, limit)
+}
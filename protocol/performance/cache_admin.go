@@ -0,0 +1,62 @@
+package performance
+
+import (
+	"context"
+	"net"
+
+	"github.com/lavanet/lava/utils"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// CacheAdminServer lets operators inspect and flush the cache of a running consumer or provider
+type CacheAdminServer struct {
+	pairingtypes.UnimplementedRelayerCacheAdminServer
+	cache *Cache
+}
+
+func NewCacheAdminServer(cache *Cache) *CacheAdminServer {
+	return &CacheAdminServer{cache: cache}
+}
+
+func (cas *CacheAdminServer) Stats(ctx context.Context, in *emptypb.Empty) (*pairingtypes.CacheStats, error) {
+	return cas.cache.Stats(), nil
+}
+
+func (cas *CacheAdminServer) Flush(ctx context.Context, in *pairingtypes.CacheFlushRequest) (*emptypb.Empty, error) {
+	if in.ChainID == "" {
+		return nil, UnsupportedCacheOperationError.Wrapf("flush requires a chainID")
+	}
+	err := cas.cache.Flush(in.ChainID)
+	if err != nil {
+		return nil, err
+	}
+	utils.LavaFormatInfo("flushed cache", utils.Attribute{Key: "chainID", Value: in.ChainID})
+	return &emptypb.Empty{}, nil
+}
+
+func (cas *CacheAdminServer) HotKeys(ctx context.Context, in *pairingtypes.CacheHotKeysRequest) (*pairingtypes.CacheHotKeysResponse, error) {
+	return &pairingtypes.CacheHotKeysResponse{Keys: cas.cache.HotKeys(in.ChainID, int(in.Limit))}, nil
+}
+
+// ServeCacheAdmin serves the cache admin endpoints on listenAddr until ctx is done
+func ServeCacheAdmin(ctx context.Context, listenAddr string, cache *Cache) error {
+	lis, err := net.Listen("tcp", listenAddr)
+	if err != nil {
+		return err
+	}
+	server := grpc.NewServer()
+	pairingtypes.RegisterRelayerCacheAdminServer(server, NewCacheAdminServer(cache))
+	go func() {
+		<-ctx.Done()
+		server.GracefulStop()
+	}()
+	go func() {
+		if err := server.Serve(lis); err != nil {
+			utils.LavaFormatError("cache admin server stopped", err, utils.Attribute{Key: "address", Value: listenAddr})
+		}
+	}()
+	utils.LavaFormatInfo("cache admin listening", utils.Attribute{Key: "address", Value: lis.Addr().String()})
+	return nil
+}
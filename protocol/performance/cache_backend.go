@@ -0,0 +1,131 @@
+package performance
+
+import (
+	"bytes"
+	"context"
+	"sync/atomic"
+	"time"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+const (
+	BackendCacheExpirationForFinalized    = time.Hour
+	BackendCacheExpirationForNonFinalized = 500 * time.Millisecond
+)
+
+// RelayCacheBackend stores serialized cache entries, a backend outside the process lets several consumers share a warm cache
+type RelayCacheBackend interface {
+	// Get returns NotFoundError when the key is missing or expired
+	Get(ctx context.Context, key string) ([]byte, error)
+	Set(ctx context.Context, key string, value []byte, expiration time.Duration) error
+}
+
+// backendCacheClient implements the RelayerCacheClient on top of a RelayCacheBackend
+type backendCacheClient struct {
+	backend RelayCacheBackend
+	hits    uint64
+	misses  uint64
+	latestBlocks
+}
+
+func newBackendCacheClient(backend RelayCacheBackend) *backendCacheClient {
+	return &backendCacheClient{backend: backend, latestBlocks: newLatestBlocks()}
+}
+
+func (bcc *backendCacheClient) GetRelay(ctx context.Context, in *pairingtypes.RelayCacheGet, opts ...grpc.CallOption) (*pairingtypes.RelayReply, error) {
+	reply, err := bcc.getRelay(ctx, in)
+	if err != nil {
+		atomic.AddUint64(&bcc.misses, 1)
+		return nil, err
+	}
+	atomic.AddUint64(&bcc.hits, 1)
+	return reply, nil
+}
+
+func (bcc *backendCacheClient) getRelay(ctx context.Context, in *pairingtypes.RelayCacheGet) (*pairingtypes.RelayReply, error) {
+	entry, err := bcc.getEntry(ctx, in, true)
+	if err != nil {
+		return nil, err
+	}
+	return entry.Response, nil
+}
+
+// GetSignedRelay returns finalized entries only, they are the ones data reliability can be checked against
+func (bcc *backendCacheClient) GetSignedRelay(ctx context.Context, in *pairingtypes.RelayCacheGet, opts ...grpc.CallOption) (*pairingtypes.RelayCacheEntry, error) {
+	entry, err := bcc.getEntry(ctx, in, false)
+	if err != nil {
+		return nil, err
+	}
+	if !entry.Finalized || entry.Request == nil {
+		return nil, NotFoundError
+	}
+	return entry, nil
+}
+
+func (bcc *backendCacheClient) getEntry(ctx context.Context, in *pairingtypes.RelayCacheGet, nonFinalized bool) (*pairingtypes.RelayCacheEntry, error) {
+	finalizedKey, nonFinalizedKey := bcc.entryKeys(in.ChainID, RelayRequestKey(in.ChainID, in.ApiInterface, in.BlockHash, in.Request.GetRelayData()))
+	value, err := bcc.backend.Get(ctx, finalizedKey)
+	if NotFoundError.Is(err) && nonFinalized {
+		value, err = bcc.backend.Get(ctx, nonFinalizedKey)
+	}
+	if err != nil {
+		return nil, err
+	}
+	entry, err := DecodeRelayCacheEntry(value)
+	if err != nil {
+		return nil, err
+	}
+	// the key is a hash, make sure the entry was stored for the requested block hash
+	if len(in.BlockHash) > 0 && !bytes.Equal(in.BlockHash, entry.BlockHash) {
+		return nil, NotFoundError
+	}
+	return entry, nil
+}
+
+func (bcc *backendCacheClient) SetRelay(ctx context.Context, in *pairingtypes.RelayCacheSet, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	if in.Response == nil {
+		return nil, InvalidCacheEntryError
+	}
+	key, expiration := bcc.entryKeyAndExpiration(in, BackendCacheExpirationForFinalized, BackendCacheExpirationForNonFinalized)
+	if in.Finalized {
+		// finalized replies don't change, the first one is kept with its provider's signature for data reliability
+		if _, err := bcc.backend.Get(ctx, key); err == nil {
+			return &emptypb.Empty{}, nil
+		}
+	}
+	value, err := EncodeRelayCacheEntry(in.Response, in.Request, in.BlockHash, in.Finalized)
+	if err != nil {
+		return nil, err
+	}
+	err = bcc.backend.Set(ctx, key, value, expiration)
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (bcc *backendCacheClient) Health(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*pairingtypes.CacheUsage, error) {
+	return &pairingtypes.CacheUsage{CacheHits: atomic.LoadUint64(&bcc.hits), CacheMisses: atomic.LoadUint64(&bcc.misses)}, nil
+}
+
+// EncodeRelayCacheEntry serializes a reply together with the block hash it was cached for and the request its provider signed
+func EncodeRelayCacheEntry(reply *pairingtypes.RelayReply, request *pairingtypes.RelayRequest, blockHash []byte, finalized bool) ([]byte, error) {
+	entry := pairingtypes.RelayCacheEntry{Response: reply, Request: request, BlockHash: blockHash, Finalized: finalized}
+	return entry.Marshal()
+}
+
+func DecodeRelayCacheEntry(value []byte) (*pairingtypes.RelayCacheEntry, error) {
+	entry := &pairingtypes.RelayCacheEntry{}
+	err := entry.Unmarshal(value)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(InvalidCacheEntryError, "failed decoding cache entry: %s", err.Error())
+	}
+	if entry.Response == nil {
+		return nil, InvalidCacheEntryError
+	}
+	return entry, nil
+}
@@ -0,0 +1,101 @@
+package performance
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type mockBackendEntry struct {
+	value      []byte
+	expiration time.Duration
+}
+
+type mockCacheBackend struct {
+	lock    sync.Mutex
+	entries map[string]mockBackendEntry
+}
+
+func (mcb *mockCacheBackend) Get(ctx context.Context, key string) ([]byte, error) {
+	mcb.lock.Lock()
+	defer mcb.lock.Unlock()
+	entry, ok := mcb.entries[key]
+	if !ok {
+		return nil, NotFoundError
+	}
+	return entry.value, nil
+}
+
+func (mcb *mockCacheBackend) Set(ctx context.Context, key string, value []byte, expiration time.Duration) error {
+	mcb.lock.Lock()
+	defer mcb.lock.Unlock()
+	mcb.entries[key] = mockBackendEntry{value: value, expiration: expiration}
+	return nil
+}
+
+func TestBackendCacheSharedBetweenClients(t *testing.T) {
+	ctx := context.Background()
+	backend := &mockCacheBackend{entries: map[string]mockBackendEntry{}}
+	// two consumers using the same backend
+	writer := &Cache{client: newBackendCacheClient(backend)}
+	reader := &Cache{client: newBackendCacheClient(backend)}
+	reply := &pairingtypes.RelayReply{Data: []byte("reply"), LatestBlock: 100}
+
+	_, err := reader.GetEntry(ctx, relayRequestForCache("request", 1), "jsonrpc", nil, "ETH1", false)
+	require.True(t, NotFoundError.Is(err))
+
+	err = writer.SetEntry(ctx, relayRequestForCache("request", 1), "jsonrpc", nil, "ETH1", "dapp", reply, true)
+	require.NoError(t, err)
+	cachedReply, err := reader.GetEntry(ctx, relayRequestForCache("request", 2), "jsonrpc", nil, "ETH1", false)
+	require.NoError(t, err)
+	require.Equal(t, reply.Data, cachedReply.Data)
+	require.Equal(t, reply.LatestBlock, cachedReply.LatestBlock)
+
+	usage, err := reader.client.Health(ctx, &emptypb.Empty{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), usage.CacheHits)
+	require.Equal(t, uint64(1), usage.CacheMisses)
+}
+
+func TestBackendCacheNonFinalizedEntries(t *testing.T) {
+	ctx := context.Background()
+	backend := &mockCacheBackend{entries: map[string]mockBackendEntry{}}
+	cache := &Cache{client: newBackendCacheClient(backend)}
+	reply := &pairingtypes.RelayReply{Data: []byte("reply")}
+	cache.OnNewLatestBlock("ETH1", 100, 10*time.Second)
+
+	err := cache.SetEntry(ctx, relayRequestForCache("request", 1), "jsonrpc", nil, "ETH1", "dapp", reply, false)
+	require.NoError(t, err)
+	for _, entry := range backend.entries {
+		require.Equal(t, 10*time.Second, entry.expiration)
+	}
+	_, err = cache.GetEntry(ctx, relayRequestForCache("request", 1), "jsonrpc", nil, "ETH1", false)
+	require.NoError(t, err)
+
+	cache.OnNewLatestBlock("ETH1", 101, 0)
+	_, err = cache.GetEntry(ctx, relayRequestForCache("request", 1), "jsonrpc", nil, "ETH1", false)
+	require.True(t, NotFoundError.Is(err))
+}
+
+func TestRelayCacheEntryEncoding(t *testing.T) {
+	reply := &pairingtypes.RelayReply{Data: []byte("reply"), LatestBlock: 7, FinalizedBlocksHashes: []byte("hashes")}
+	request := relayRequestForCache("request", 1)
+	value, err := EncodeRelayCacheEntry(reply, request, []byte("hash"), true)
+	require.NoError(t, err)
+	entry, err := DecodeRelayCacheEntry(value)
+	require.NoError(t, err)
+	require.Equal(t, reply.Data, entry.Response.Data)
+	require.Equal(t, reply.LatestBlock, entry.Response.LatestBlock)
+	require.Equal(t, reply.FinalizedBlocksHashes, entry.Response.FinalizedBlocksHashes)
+	require.Equal(t, []byte("hash"), entry.BlockHash)
+	require.True(t, entry.Finalized)
+	require.Equal(t, request.RelayData.Data, entry.Request.RelayData.Data)
+
+	_, err = DecodeRelayCacheEntry([]byte("not an entry"))
+	require.True(t, InvalidCacheEntryError.Is(err))
+}
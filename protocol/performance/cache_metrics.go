@@ -0,0 +1,152 @@
+package performance
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+)
+
+const (
+	MaxTrackedHotKeys   = 1000
+	DefaultHotKeysLimit = 20
+	hotKeyDataMaxLength = 256
+)
+
+type chainCacheMetrics struct {
+	hits          uint64
+	misses        uint64
+	entries       uint64
+	sets          uint64
+	getLatencySum time.Duration
+	setLatencySum time.Duration
+}
+
+type hotKey struct {
+	chainID      string
+	apiInterface string
+	apiUrl       string
+	data         string
+	hits         uint64
+}
+
+// cacheMetrics counts the cache usage of each chain and the keys with the most hits, the zero value is ready to use
+type cacheMetrics struct {
+	lock    sync.Mutex
+	chains  map[string]*chainCacheMetrics
+	hotKeys map[string]*hotKey
+}
+
+// must be called with the lock held
+func (cm *cacheMetrics) chain(chainID string) *chainCacheMetrics {
+	if cm.chains == nil {
+		cm.chains = map[string]*chainCacheMetrics{}
+	}
+	metrics, ok := cm.chains[chainID]
+	if !ok {
+		metrics = &chainCacheMetrics{}
+		cm.chains[chainID] = metrics
+	}
+	return metrics
+}
+
+func (cm *cacheMetrics) onGet(chainID string, apiInterface string, relayData *pairingtypes.RelayPrivateData, latency time.Duration, hit bool) {
+	cm.lock.Lock()
+	defer cm.lock.Unlock()
+	metrics := cm.chain(chainID)
+	metrics.getLatencySum += latency
+	if !hit {
+		metrics.misses++
+		return
+	}
+	metrics.hits++
+	cm.onHit(chainID, apiInterface, relayData)
+}
+
+// must be called with the lock held
+func (cm *cacheMetrics) onHit(chainID string, apiInterface string, relayData *pairingtypes.RelayPrivateData) {
+	if cm.hotKeys == nil {
+		cm.hotKeys = map[string]*hotKey{}
+	}
+	key := RelayRequestKey(chainID, apiInterface, nil, relayData)
+	if tracked, ok := cm.hotKeys[key]; ok {
+		tracked.hits++
+		return
+	}
+	if len(cm.hotKeys) >= MaxTrackedHotKeys {
+		// make room by forgetting the coldest key
+		coldestKey := ""
+		for trackedKey, tracked := range cm.hotKeys {
+			if coldestKey == "" || tracked.hits < cm.hotKeys[coldestKey].hits {
+				coldestKey = trackedKey
+			}
+		}
+		delete(cm.hotKeys, coldestKey)
+	}
+	data := string(relayData.GetData())
+	if len(data) > hotKeyDataMaxLength {
+		data = data[:hotKeyDataMaxLength]
+	}
+	cm.hotKeys[key] = &hotKey{chainID: chainID, apiInterface: apiInterface, apiUrl: relayData.GetApiUrl(), data: data, hits: 1}
+}
+
+func (cm *cacheMetrics) onSet(chainID string, latency time.Duration, stored bool) {
+	cm.lock.Lock()
+	defer cm.lock.Unlock()
+	metrics := cm.chain(chainID)
+	metrics.sets++
+	metrics.setLatencySum += latency
+	if stored {
+		metrics.entries++
+	}
+}
+
+func (cm *cacheMetrics) onFlush(chainID string) {
+	cm.lock.Lock()
+	defer cm.lock.Unlock()
+	cm.chain(chainID).entries = 0
+	for key, tracked := range cm.hotKeys {
+		if tracked.chainID == chainID {
+			delete(cm.hotKeys, key)
+		}
+	}
+}
+
+func (cm *cacheMetrics) stats() *pairingtypes.CacheStats {
+	cm.lock.Lock()
+	defer cm.lock.Unlock()
+	stats := &pairingtypes.CacheStats{}
+	for chainID, metrics := range cm.chains {
+		chainStats := &pairingtypes.CacheChainStats{ChainID: chainID, Hits: metrics.hits, Misses: metrics.misses, Entries: metrics.entries}
+		if gets := metrics.hits + metrics.misses; gets > 0 {
+			chainStats.AverageGetLatencyMicroseconds = uint64(metrics.getLatencySum/time.Microsecond) / gets
+		}
+		if metrics.sets > 0 {
+			chainStats.AverageSetLatencyMicroseconds = uint64(metrics.setLatencySum/time.Microsecond) / metrics.sets
+		}
+		stats.Hits += metrics.hits
+		stats.Misses += metrics.misses
+		stats.Chains = append(stats.Chains, chainStats)
+	}
+	sort.Slice(stats.Chains, func(i, j int) bool { return stats.Chains[i].ChainID < stats.Chains[j].ChainID })
+	return stats
+}
+
+// returns the keys with the most hits, hottest first
+func (cm *cacheMetrics) hottestKeys(chainID string, limit int) []*pairingtypes.CacheHotKey {
+	cm.lock.Lock()
+	defer cm.lock.Unlock()
+	keys := []*pairingtypes.CacheHotKey{}
+	for _, tracked := range cm.hotKeys {
+		if chainID != "" && tracked.chainID != chainID {
+			continue
+		}
+		keys = append(keys, &pairingtypes.CacheHotKey{ChainID: tracked.chainID, ApiInterface: tracked.apiInterface, ApiUrl: tracked.apiUrl, Data: tracked.data, Hits: tracked.hits})
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i].Hits > keys[j].Hits })
+	if len(keys) > limit {
+		keys = keys[:limit]
+	}
+	return keys
+}
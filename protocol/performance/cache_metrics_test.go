@@ -0,0 +1,92 @@
+package performance
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCacheStatsAndHotKeys(t *testing.T) {
+	ctx := context.Background()
+	cache, err := InitLocalCache()
+	require.NoError(t, err)
+	reply := &pairingtypes.RelayReply{Data: []byte("reply")}
+	for _, data := range []string{"hot", "warm"} {
+		err = cache.SetEntry(ctx, relayRequestForCache(data, 1), "jsonrpc", nil, "ETH1", "dapp", reply, true)
+		require.NoError(t, err)
+	}
+	err = cache.SetEntry(ctx, relayRequestForCache("other", 1), "jsonrpc", nil, "GTH1", "dapp", reply, true)
+	require.NoError(t, err)
+	cache.client.(*localCacheClient).cache.Wait()
+
+	for i := 0; i < 3; i++ {
+		_, err = cache.GetEntry(ctx, relayRequestForCache("hot", byte(i)), "jsonrpc", nil, "ETH1", false)
+		require.NoError(t, err)
+	}
+	_, err = cache.GetEntry(ctx, relayRequestForCache("warm", 1), "jsonrpc", nil, "ETH1", false)
+	require.NoError(t, err)
+	_, err = cache.GetEntry(ctx, relayRequestForCache("other", 1), "jsonrpc", nil, "GTH1", false)
+	require.NoError(t, err)
+	_, err = cache.GetEntry(ctx, relayRequestForCache("missing", 1), "jsonrpc", nil, "ETH1", false)
+	require.Error(t, err)
+
+	stats := cache.Stats()
+	require.Equal(t, uint64(5), stats.Hits)
+	require.Equal(t, uint64(1), stats.Misses)
+	require.Equal(t, 2, len(stats.Chains))
+	require.Equal(t, "ETH1", stats.Chains[0].ChainID)
+	require.Equal(t, uint64(4), stats.Chains[0].Hits)
+	require.Equal(t, uint64(2), stats.Chains[0].Entries)
+
+	hotKeys := cache.HotKeys("", 2)
+	require.Equal(t, 2, len(hotKeys))
+	require.Equal(t, "hot", hotKeys[0].Data)
+	require.Equal(t, uint64(3), hotKeys[0].Hits)
+	hotKeys = cache.HotKeys("GTH1", 0)
+	require.Equal(t, 1, len(hotKeys))
+	require.Equal(t, "other", hotKeys[0].Data)
+}
+
+func TestCacheFlushChain(t *testing.T) {
+	ctx := context.Background()
+	cache, err := InitLocalCache()
+	require.NoError(t, err)
+	reply := &pairingtypes.RelayReply{Data: []byte("reply")}
+	for _, chainID := range []string{"ETH1", "GTH1"} {
+		err = cache.SetEntry(ctx, relayRequestForCache("request", 1), "jsonrpc", nil, chainID, "dapp", reply, true)
+		require.NoError(t, err)
+	}
+	cache.client.(*localCacheClient).cache.Wait()
+	_, err = cache.GetEntry(ctx, relayRequestForCache("request", 1), "jsonrpc", nil, "ETH1", false)
+	require.NoError(t, err)
+
+	require.NoError(t, cache.Flush("ETH1"))
+	_, err = cache.GetEntry(ctx, relayRequestForCache("request", 1), "jsonrpc", nil, "ETH1", false)
+	require.True(t, NotFoundError.Is(err))
+	_, err = cache.GetEntry(ctx, relayRequestForCache("request", 1), "jsonrpc", nil, "GTH1", false)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(cache.HotKeys("ETH1", 0)))
+	require.Equal(t, uint64(0), cache.Stats().Chains[0].Entries)
+
+	// new entries are stored after the flush
+	err = cache.SetEntry(ctx, relayRequestForCache("request", 1), "jsonrpc", nil, "ETH1", "dapp", reply, true)
+	require.NoError(t, err)
+	cache.client.(*localCacheClient).cache.Wait()
+	_, err = cache.GetEntry(ctx, relayRequestForCache("request", 1), "jsonrpc", nil, "ETH1", false)
+	require.NoError(t, err)
+
+	// the cache service can't be flushed from here
+	remoteCache := &Cache{client: pairingtypes.NewRelayerCacheClient(nil), address: "remote"}
+	require.True(t, UnsupportedCacheOperationError.Is(remoteCache.Flush("ETH1")))
+}
+
+func TestCacheHotKeysBounded(t *testing.T) {
+	metrics := cacheMetrics{}
+	for i := 0; i < MaxTrackedHotKeys+10; i++ {
+		metrics.onGet("ETH1", "jsonrpc", &pairingtypes.RelayPrivateData{Data: []byte(fmt.Sprintf("request %d", i))}, 0, true)
+	}
+	require.Equal(t, MaxTrackedHotKeys, len(metrics.hotKeys))
+}
@@ -0,0 +1,69 @@
+package performance
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/lavanet/lava/protocol/common"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+)
+
+// CoalescedRelayTimeout limits a relay shared by several callers, it no longer runs under the deadline of the caller that started it
+const CoalescedRelayTimeout = time.Minute
+
+type inFlightRelay struct {
+	done  chan struct{}
+	reply *pairingtypes.RelayReply
+	err   error
+}
+
+// RelayCoalescer lets identical relays that arrive while one of them is in flight wait for its reply,
+// instead of each one taking a provider session
+type RelayCoalescer struct {
+	lock     sync.Mutex
+	inFlight map[string]*inFlightRelay
+}
+
+func NewRelayCoalescer() *RelayCoalescer {
+	return &RelayCoalescer{inFlight: map[string]*inFlightRelay{}}
+}
+
+// Do calls sendRelay once for all concurrent callers with the same key, shared is true for callers that got the reply of another caller.
+// the relay runs under a context of its own, detached from the caller that started it and limited to timeout, so a caller that
+// cancels or times out doesn't fail the others
+func (rc *RelayCoalescer) Do(ctx context.Context, key string, timeout time.Duration, sendRelay func(ctx context.Context) (*pairingtypes.RelayReply, error)) (reply *pairingtypes.RelayReply, shared bool, err error) {
+	rc.lock.Lock()
+	relay, shared := rc.inFlight[key]
+	if !shared {
+		relay = &inFlightRelay{done: make(chan struct{})}
+		rc.inFlight[key] = relay
+		go rc.send(common.WithoutCancel(ctx), key, timeout, relay, sendRelay)
+	}
+	rc.lock.Unlock()
+
+	select {
+	case <-relay.done:
+		return relay.reply, shared, relay.err
+	case <-ctx.Done():
+		return nil, shared, ctx.Err()
+	}
+}
+
+func (rc *RelayCoalescer) send(ctx context.Context, key string, timeout time.Duration, relay *inFlightRelay, sendRelay func(ctx context.Context) (*pairingtypes.RelayReply, error)) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer func() {
+		cancel()
+		rc.lock.Lock()
+		delete(rc.inFlight, key)
+		rc.lock.Unlock()
+		close(relay.done)
+	}()
+	relay.reply, relay.err = sendRelay(ctx)
+}
+
+func (rc *RelayCoalescer) InFlight() int {
+	rc.lock.Lock()
+	defer rc.lock.Unlock()
+	return len(rc.inFlight)
+}
@@ -0,0 +1,133 @@
+package performance
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRelayCoalescerSharesInFlightRelay(t *testing.T) {
+	coalescer := NewRelayCoalescer()
+	ctx := context.Background()
+	release := make(chan struct{})
+	var calls int64
+	sendRelay := func(ctx context.Context) (*pairingtypes.RelayReply, error) {
+		atomic.AddInt64(&calls, 1)
+		<-release
+		return &pairingtypes.RelayReply{Data: []byte("reply")}, nil
+	}
+
+	const callers = 10
+	var wg sync.WaitGroup
+	var sharedCount int64
+	wg.Add(callers)
+	for i := 0; i < callers; i++ {
+		go func() {
+			defer wg.Done()
+			reply, shared, err := coalescer.Do(ctx, "key", time.Second, sendRelay)
+			require.NoError(t, err)
+			require.Equal(t, []byte("reply"), reply.Data)
+			if shared {
+				atomic.AddInt64(&sharedCount, 1)
+			}
+		}()
+	}
+	// wait for all callers to join the in flight relay
+	require.Eventually(t, func() bool { return coalescer.InFlight() == 1 }, time.Second, time.Millisecond)
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	require.Equal(t, int64(1), atomic.LoadInt64(&calls))
+	require.Equal(t, int64(callers-1), atomic.LoadInt64(&sharedCount))
+	require.Equal(t, 0, coalescer.InFlight())
+}
+
+func TestRelayCoalescerSequentialAndDistinctRelays(t *testing.T) {
+	coalescer := NewRelayCoalescer()
+	ctx := context.Background()
+	var calls int64
+	sendRelay := func(ctx context.Context) (*pairingtypes.RelayReply, error) {
+		atomic.AddInt64(&calls, 1)
+		return &pairingtypes.RelayReply{}, nil
+	}
+	for _, key := range []string{"a", "a", "b"} {
+		_, shared, err := coalescer.Do(ctx, key, time.Second, sendRelay)
+		require.NoError(t, err)
+		require.False(t, shared)
+	}
+	require.Equal(t, int64(3), calls)
+}
+
+func TestRelayCoalescerSharesErrorsAndHonorsContext(t *testing.T) {
+	coalescer := NewRelayCoalescer()
+	release := make(chan struct{})
+	relayErr := errors.New("relay failed")
+	leaderDone := make(chan error)
+	go func() {
+		_, _, err := coalescer.Do(context.Background(), "key", time.Second, func(ctx context.Context) (*pairingtypes.RelayReply, error) {
+			<-release
+			return nil, relayErr
+		})
+		leaderDone <- err
+	}()
+	require.Eventually(t, func() bool { return coalescer.InFlight() == 1 }, time.Second, time.Millisecond)
+
+	// a waiting caller gives up when its own context is done
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, shared, err := coalescer.Do(ctx, "key", time.Second, nil)
+	require.True(t, shared)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	close(release)
+	require.ErrorIs(t, <-leaderDone, relayErr)
+}
+
+func TestRelayCoalescerDetachesTheSharedRelay(t *testing.T) {
+	coalescer := NewRelayCoalescer()
+	release := make(chan struct{})
+	sendRelay := func(ctx context.Context) (*pairingtypes.RelayReply, error) {
+		select {
+		case <-release:
+			return &pairingtypes.RelayReply{Data: []byte("reply")}, nil
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	// the caller that started the relay leaves, the relay goes on for the caller waiting on it
+	leaderCtx, cancelLeader := context.WithCancel(context.Background())
+	leaderDone := make(chan error)
+	go func() {
+		_, _, err := coalescer.Do(leaderCtx, "key", time.Second, sendRelay)
+		leaderDone <- err
+	}()
+	require.Eventually(t, func() bool { return coalescer.InFlight() == 1 }, time.Second, time.Millisecond)
+	waiterDone := make(chan *pairingtypes.RelayReply)
+	go func() {
+		reply, shared, err := coalescer.Do(context.Background(), "key", time.Second, nil)
+		require.NoError(t, err)
+		require.True(t, shared)
+		waiterDone <- reply
+	}()
+	// wait for the caller to join the in flight relay
+	time.Sleep(50 * time.Millisecond)
+	cancelLeader()
+	require.ErrorIs(t, <-leaderDone, context.Canceled)
+	close(release)
+	require.Equal(t, []byte("reply"), (<-waiterDone).Data)
+
+	// the shared relay is limited by its own timeout
+	_, _, err := coalescer.Do(context.Background(), "other key", 10*time.Millisecond, func(ctx context.Context) (*pairingtypes.RelayReply, error) {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	})
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
@@ -1,3 +1,8 @@
 package performance
 
-const CacheFlagName = "cache-be"
+const (
+	CacheFlagName            = "cache-be"
+	CacheLocalFlagName       = "cache-local"
+	CacheRedisFlagName       = "cache-redis"
+	CacheAdminListenFlagName = "cache-admin-listen"
+)
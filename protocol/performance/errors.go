@@ -5,6 +5,9 @@ import (
 )
 
 var (
-	NotConnectedError   = sdkerrors.New("Not Connected Error", 700, "No Connection To grpc server")
-	NotInitialisedError = sdkerrors.New("Not Initialised Error", 701, "to use cache run initCache")
+	NotConnectedError              = sdkerrors.New("Not Connected Error", 700, "No Connection To grpc server")
+	NotInitialisedError            = sdkerrors.New("Not Initialised Error", 701, "to use cache run initCache")
+	NotFoundError                  = sdkerrors.New("Not Found Error", 702, "entry not found in cache")
+	InvalidCacheEntryError         = sdkerrors.New("Invalid Cache Entry Error", 703, "cache entry is missing a response")
+	UnsupportedCacheOperationError = sdkerrors.New("Unsupported Cache Operation Error", 704, "operation is not supported by this cache")
 )
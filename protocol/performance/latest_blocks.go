@@ -0,0 +1,80 @@
+package performance
+
+import (
+	"encoding/binary"
+	"sync"
+	"time"
+
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+)
+
+type chainBlockState struct {
+	latestBlock      int64
+	averageBlockTime time.Duration
+	generation       uint64 // bumped on flush, so all the entries stored before are left behind
+}
+
+// latestBlocks tracks the latest block of each chain, cache clients use it to re-key non finalized entries
+type latestBlocks struct {
+	chainsLock sync.RWMutex
+	chains     map[string]chainBlockState
+}
+
+func newLatestBlocks() latestBlocks {
+	return latestBlocks{chains: map[string]chainBlockState{}}
+}
+
+// OnNewLatestBlock re-keys the non finalized entries of the chain, so replies from older blocks are no longer served
+func (lb *latestBlocks) OnNewLatestBlock(chainID string, latestBlock int64, averageBlockTime time.Duration) {
+	lb.chainsLock.Lock()
+	defer lb.chainsLock.Unlock()
+	state := lb.chains[chainID]
+	if latestBlock > state.latestBlock {
+		state.latestBlock = latestBlock
+	}
+	if averageBlockTime > 0 {
+		state.averageBlockTime = averageBlockTime
+	}
+	lb.chains[chainID] = state
+}
+
+// Flush re-keys all the entries of the chain, the storage drops them when they expire
+func (lb *latestBlocks) Flush(chainID string) {
+	lb.chainsLock.Lock()
+	defer lb.chainsLock.Unlock()
+	state := lb.chains[chainID]
+	state.generation++
+	lb.chains[chainID] = state
+}
+
+func (lb *latestBlocks) chainState(chainID string) chainBlockState {
+	lb.chainsLock.RLock()
+	defer lb.chainsLock.RUnlock()
+	return lb.chains[chainID]
+}
+
+// returns the keys a request's finalized and non finalized replies are stored under,
+// non finalized replies can change with every block, so their key includes the latest block of the chain
+func (lb *latestBlocks) entryKeys(chainID string, requestKey string) (finalizedKey string, nonFinalizedKey string) {
+	state := lb.chainState(chainID)
+	finalizedKey = requestKey + uint64Bytes(state.generation)
+	return finalizedKey, finalizedKey + uint64Bytes(uint64(state.latestBlock))
+}
+
+// returns the key an entry is stored under and how long it stays, a non finalized reply is valid for a block at most
+func (lb *latestBlocks) entryKeyAndExpiration(in *pairingtypes.RelayCacheSet, finalizedExpiration time.Duration, nonFinalizedExpiration time.Duration) (string, time.Duration) {
+	finalizedKey, nonFinalizedKey := lb.entryKeys(in.ChainID, RelayRequestKey(in.ChainID, in.ApiInterface, in.BlockHash, in.Request.GetRelayData()))
+	if in.Finalized {
+		return finalizedKey, finalizedExpiration
+	}
+	if averageBlockTime := lb.chainState(in.ChainID).averageBlockTime; averageBlockTime > 0 {
+		nonFinalizedExpiration = averageBlockTime
+	}
+	return nonFinalizedKey, nonFinalizedExpiration
+}
+
+func uint64Bytes(value uint64) string {
+	valueBytes := make([]byte, 8)
+	binary.LittleEndian.PutUint64(valueBytes, value)
+	return string(valueBytes)
+}
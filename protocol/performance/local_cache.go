@@ -0,0 +1,142 @@
+package performance
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"sync/atomic"
+	"time"
+
+	"github.com/dgraph-io/ristretto"
+	"github.com/gogo/protobuf/proto"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+const (
+	LocalCacheMaxCost                   = 64 * 1024 * 1024 // 64MB of reply data
+	LocalCacheNumCounters               = 100000           // expect 10K items
+	LocalCacheExpirationForFinalized    = time.Hour
+	LocalCacheExpirationForNonFinalized = 500 * time.Millisecond
+	localCacheAddress                   = "in-process"
+	localCacheBufferItems               = 64
+	localCacheMinimumCostPerEntry       = 1
+)
+
+// localCacheClient implements the RelayerCacheClient in memory, so relays can be cached without running the cache service
+type localCacheClient struct {
+	cache     *ristretto.Cache
+	hits      uint64
+	misses    uint64
+	evictions uint64
+	latestBlocks
+}
+
+func newLocalCacheClient(maxCost int64) (*localCacheClient, error) {
+	lcc := &localCacheClient{latestBlocks: newLatestBlocks()}
+	onEvict := func(item *ristretto.Item) {
+		atomic.AddUint64(&lcc.evictions, 1)
+	}
+	cache, err := ristretto.NewCache(&ristretto.Config{NumCounters: LocalCacheNumCounters, MaxCost: maxCost, BufferItems: localCacheBufferItems, OnEvict: onEvict})
+	if err != nil {
+		return nil, err
+	}
+	lcc.cache = cache
+	return lcc, nil
+}
+
+// InitLocalCache creates a Cache backed by an in-process LRU with TTLs instead of the cache service
+func InitLocalCache() (*Cache, error) {
+	client, err := newLocalCacheClient(LocalCacheMaxCost)
+	if err != nil {
+		return nil, err
+	}
+	return &Cache{client: client, address: localCacheAddress}, nil
+}
+
+func (lcc *localCacheClient) GetRelay(ctx context.Context, in *pairingtypes.RelayCacheGet, opts ...grpc.CallOption) (*pairingtypes.RelayReply, error) {
+	finalizedKey, nonFinalizedKey := lcc.entryKeys(in.ChainID, RelayRequestKey(in.ChainID, in.ApiInterface, in.BlockHash, in.Request.GetRelayData()))
+	value, found := lcc.cache.Get(finalizedKey)
+	if !found {
+		value, found = lcc.cache.Get(nonFinalizedKey)
+	}
+	if !found {
+		atomic.AddUint64(&lcc.misses, 1)
+		return nil, NotFoundError
+	}
+	entry, ok := value.(*pairingtypes.RelayCacheEntry)
+	if !ok {
+		atomic.AddUint64(&lcc.misses, 1)
+		return nil, NotFoundError
+	}
+	atomic.AddUint64(&lcc.hits, 1)
+	// callers sign and fill in the reply they get, each of them gets its own copy
+	return proto.Clone(entry.Response).(*pairingtypes.RelayReply), nil
+}
+
+// GetSignedRelay returns finalized entries only, they are the ones data reliability can be checked against
+func (lcc *localCacheClient) GetSignedRelay(ctx context.Context, in *pairingtypes.RelayCacheGet, opts ...grpc.CallOption) (*pairingtypes.RelayCacheEntry, error) {
+	finalizedKey, _ := lcc.entryKeys(in.ChainID, RelayRequestKey(in.ChainID, in.ApiInterface, in.BlockHash, in.Request.GetRelayData()))
+	value, found := lcc.cache.Get(finalizedKey)
+	if !found {
+		return nil, NotFoundError
+	}
+	entry, ok := value.(*pairingtypes.RelayCacheEntry)
+	if !ok || !entry.Finalized || entry.Request == nil {
+		return nil, NotFoundError
+	}
+	return proto.Clone(entry).(*pairingtypes.RelayCacheEntry), nil
+}
+
+func (lcc *localCacheClient) SetRelay(ctx context.Context, in *pairingtypes.RelayCacheSet, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	if in.Response == nil {
+		return nil, InvalidCacheEntryError
+	}
+	key, expiration := lcc.entryKeyAndExpiration(in, LocalCacheExpirationForFinalized, LocalCacheExpirationForNonFinalized)
+	if in.Finalized {
+		// finalized replies don't change, the first one is kept with its provider's signature for data reliability
+		if _, found := lcc.cache.Get(key); found {
+			return &emptypb.Empty{}, nil
+		}
+	}
+	cost := int64(len(in.Response.Data))
+	if cost < localCacheMinimumCostPerEntry {
+		cost = localCacheMinimumCostPerEntry
+	}
+	// the caller keeps using the reply and request it cached, the cache keeps its own copy
+	entry := &pairingtypes.RelayCacheEntry{Response: in.Response, BlockHash: in.BlockHash, Finalized: in.Finalized, Request: in.Request}
+	entry = proto.Clone(entry).(*pairingtypes.RelayCacheEntry)
+	lcc.cache.SetWithTTL(key, entry, cost, expiration)
+	return &emptypb.Empty{}, nil
+}
+
+func (lcc *localCacheClient) Health(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*pairingtypes.CacheUsage, error) {
+	return &pairingtypes.CacheUsage{CacheHits: atomic.LoadUint64(&lcc.hits), CacheMisses: atomic.LoadUint64(&lcc.misses)}, nil
+}
+
+// Evictions returns the number of entries evicted for cost or expired
+func (lcc *localCacheClient) Evictions() uint64 {
+	return atomic.LoadUint64(&lcc.evictions)
+}
+
+// RelayRequestKey identifies relays that get the same reply, session data and the per request salt are left out
+func RelayRequestKey(chainID string, apiInterface string, blockHash []byte, relayData *pairingtypes.RelayPrivateData) string {
+	hasher := sha256.New()
+	write := func(data []byte) {
+		hasher.Write(data)
+		hasher.Write([]byte{0})
+	}
+	write([]byte(chainID))
+	write([]byte(apiInterface))
+	write(blockHash)
+	if relayData != nil {
+		write([]byte(relayData.ConnectionType))
+		write([]byte(relayData.ApiUrl))
+		write(relayData.Data)
+		requestBlock := make([]byte, 8)
+		binary.LittleEndian.PutUint64(requestBlock, uint64(relayData.RequestBlock))
+		write(requestBlock)
+	}
+	return string(hasher.Sum(nil))
+}
@@ -0,0 +1,192 @@
+package performance
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func relayRequestForCache(data string, salt byte) *pairingtypes.RelayRequest {
+	return &pairingtypes.RelayRequest{
+		RelaySession: &pairingtypes.RelaySession{SessionId: uint64(salt)},
+		RelayData: &pairingtypes.RelayPrivateData{
+			ConnectionType: "",
+			ApiUrl:         "",
+			Data:           []byte(data),
+			RequestBlock:   100,
+			ApiInterface:   "jsonrpc",
+			Salt:           []byte{salt},
+		},
+	}
+}
+
+func TestLocalCacheSetAndGet(t *testing.T) {
+	ctx := context.Background()
+	cache, err := InitLocalCache()
+	require.NoError(t, err)
+	reply := &pairingtypes.RelayReply{Data: []byte("reply")}
+
+	_, err = cache.GetEntry(ctx, relayRequestForCache("request", 1), "jsonrpc", nil, "ETH1", false)
+	require.True(t, NotFoundError.Is(err))
+
+	err = cache.SetEntry(ctx, relayRequestForCache("request", 1), "jsonrpc", nil, "ETH1", "dapp", reply, true)
+	require.NoError(t, err)
+	cache.client.(*localCacheClient).cache.Wait()
+
+	// session data and salt don't affect the lookup
+	cachedReply, err := cache.GetEntry(ctx, relayRequestForCache("request", 2), "jsonrpc", nil, "ETH1", false)
+	require.NoError(t, err)
+	require.Equal(t, reply.Data, cachedReply.Data)
+
+	// other requests, chains and interfaces miss
+	_, err = cache.GetEntry(ctx, relayRequestForCache("other request", 1), "jsonrpc", nil, "ETH1", false)
+	require.Error(t, err)
+	_, err = cache.GetEntry(ctx, relayRequestForCache("request", 1), "jsonrpc", nil, "GTH1", false)
+	require.Error(t, err)
+	_, err = cache.GetEntry(ctx, relayRequestForCache("request", 1), "rest", nil, "ETH1", false)
+	require.Error(t, err)
+
+	usage, err := cache.client.Health(ctx, &emptypb.Empty{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), usage.CacheHits)
+	require.Equal(t, uint64(4), usage.CacheMisses)
+}
+
+func TestLocalCacheSignedEntries(t *testing.T) {
+	ctx := context.Background()
+	cache, err := InitLocalCache()
+	require.NoError(t, err)
+	reply := &pairingtypes.RelayReply{Data: []byte("reply"), Sig: []byte("sig")}
+
+	err = cache.SetEntry(ctx, relayRequestForCache("finalized", 1), "jsonrpc", nil, "ETH1", "dapp", reply, true)
+	require.NoError(t, err)
+	err = cache.SetEntry(ctx, relayRequestForCache("not finalized", 1), "jsonrpc", nil, "ETH1", "dapp", reply, false)
+	require.NoError(t, err)
+	cache.client.(*localCacheClient).cache.Wait()
+
+	// the entry keeps the request the reply was signed for, not the one looking it up
+	entry, err := cache.GetSignedEntry(ctx, relayRequestForCache("finalized", 2), "jsonrpc", "ETH1")
+	require.NoError(t, err)
+	require.Equal(t, reply.Sig, entry.Response.Sig)
+	require.Equal(t, uint64(1), entry.Request.RelaySession.SessionId)
+
+	_, err = cache.GetSignedEntry(ctx, relayRequestForCache("not finalized", 1), "jsonrpc", "ETH1")
+	require.True(t, NotFoundError.Is(err))
+
+	// the first finalized reply is kept
+	err = cache.SetEntry(ctx, relayRequestForCache("finalized", 3), "jsonrpc", nil, "ETH1", "dapp", &pairingtypes.RelayReply{Data: []byte("reply"), Sig: []byte("other sig")}, true)
+	require.NoError(t, err)
+	cache.client.(*localCacheClient).cache.Wait()
+	entry, err = cache.GetSignedEntry(ctx, relayRequestForCache("finalized", 2), "jsonrpc", "ETH1")
+	require.NoError(t, err)
+	require.Equal(t, reply.Sig, entry.Response.Sig)
+}
+
+func TestLocalCacheNonFinalizedExpires(t *testing.T) {
+	ctx := context.Background()
+	cache, err := InitLocalCache()
+	require.NoError(t, err)
+	reply := &pairingtypes.RelayReply{Data: []byte("reply")}
+
+	err = cache.SetEntry(ctx, relayRequestForCache("request", 1), "jsonrpc", nil, "ETH1", "dapp", reply, false)
+	require.NoError(t, err)
+	cache.client.(*localCacheClient).cache.Wait()
+	_, err = cache.GetEntry(ctx, relayRequestForCache("request", 1), "jsonrpc", nil, "ETH1", false)
+	require.NoError(t, err)
+
+	time.Sleep(LocalCacheExpirationForNonFinalized + 100*time.Millisecond)
+	_, err = cache.GetEntry(ctx, relayRequestForCache("request", 1), "jsonrpc", nil, "ETH1", false)
+	require.Error(t, err)
+}
+
+func TestLocalCacheRejectsEmptyResponse(t *testing.T) {
+	cache, err := InitLocalCache()
+	require.NoError(t, err)
+	err = cache.SetEntry(context.Background(), relayRequestForCache("request", 1), "jsonrpc", nil, "ETH1", "dapp", nil, true)
+	require.True(t, InvalidCacheEntryError.Is(err))
+}
+
+func TestLocalCacheNonFinalizedInvalidatedOnNewBlock(t *testing.T) {
+	ctx := context.Background()
+	cache, err := InitLocalCache()
+	require.NoError(t, err)
+	reply := &pairingtypes.RelayReply{Data: []byte("reply")}
+	cache.OnNewLatestBlock("ETH1", 100, time.Minute)
+
+	err = cache.SetEntry(ctx, relayRequestForCache("request", 1), "jsonrpc", nil, "ETH1", "dapp", reply, false)
+	require.NoError(t, err)
+	err = cache.SetEntry(ctx, relayRequestForCache("finalized request", 1), "jsonrpc", nil, "ETH1", "dapp", reply, true)
+	require.NoError(t, err)
+	cache.client.(*localCacheClient).cache.Wait()
+	_, err = cache.GetEntry(ctx, relayRequestForCache("request", 1), "jsonrpc", nil, "ETH1", false)
+	require.NoError(t, err)
+
+	// an older block doesn't move the chain back
+	cache.OnNewLatestBlock("ETH1", 99, 0)
+	_, err = cache.GetEntry(ctx, relayRequestForCache("request", 1), "jsonrpc", nil, "ETH1", false)
+	require.NoError(t, err)
+
+	// other chains are not affected
+	cache.OnNewLatestBlock("GTH1", 200, 0)
+	_, err = cache.GetEntry(ctx, relayRequestForCache("request", 1), "jsonrpc", nil, "ETH1", false)
+	require.NoError(t, err)
+
+	cache.OnNewLatestBlock("ETH1", 101, 0)
+	_, err = cache.GetEntry(ctx, relayRequestForCache("request", 1), "jsonrpc", nil, "ETH1", false)
+	require.True(t, NotFoundError.Is(err))
+	// finalized entries stay
+	_, err = cache.GetEntry(ctx, relayRequestForCache("finalized request", 1), "jsonrpc", nil, "ETH1", false)
+	require.NoError(t, err)
+}
+
+func TestLocalCacheNonFinalizedExpiresWithBlockTime(t *testing.T) {
+	ctx := context.Background()
+	cache, err := InitLocalCache()
+	require.NoError(t, err)
+	reply := &pairingtypes.RelayReply{Data: []byte("reply")}
+	blockTime := 2 * LocalCacheExpirationForNonFinalized
+	cache.OnNewLatestBlock("ETH1", 100, blockTime)
+
+	err = cache.SetEntry(ctx, relayRequestForCache("request", 1), "jsonrpc", nil, "ETH1", "dapp", reply, false)
+	require.NoError(t, err)
+	cache.client.(*localCacheClient).cache.Wait()
+
+	// still cached after the default expiration, the chain's block time is used instead
+	time.Sleep(LocalCacheExpirationForNonFinalized + 100*time.Millisecond)
+	_, err = cache.GetEntry(ctx, relayRequestForCache("request", 1), "jsonrpc", nil, "ETH1", false)
+	require.NoError(t, err)
+
+	time.Sleep(blockTime - LocalCacheExpirationForNonFinalized)
+	_, err = cache.GetEntry(ctx, relayRequestForCache("request", 1), "jsonrpc", nil, "ETH1", false)
+	require.Error(t, err)
+}
+
+func TestLocalCacheRepliesAreCopies(t *testing.T) {
+	ctx := context.Background()
+	cache, err := InitLocalCache()
+	require.NoError(t, err)
+	reply := &pairingtypes.RelayReply{Data: []byte("reply"), Sig: []byte("sig")}
+
+	err = cache.SetEntry(ctx, relayRequestForCache("request", 1), "jsonrpc", nil, "ETH1", "dapp", reply, true)
+	require.NoError(t, err)
+	cache.client.(*localCacheClient).cache.Wait()
+	// the relay that cached the reply keeps signing it
+	reply.Sig = []byte("other sig")
+
+	cachedReply, err := cache.GetEntry(ctx, relayRequestForCache("request", 1), "jsonrpc", nil, "ETH1", false)
+	require.NoError(t, err)
+	require.Equal(t, []byte("sig"), cachedReply.Sig)
+	cachedReply.Sig = []byte("relay sig")
+	cachedReply.Data = []byte("relay data")
+	cachedReply.FinalizedBlocksHashes = []byte("{}")
+
+	cachedReply, err = cache.GetEntry(ctx, relayRequestForCache("request", 1), "jsonrpc", nil, "ETH1", false)
+	require.NoError(t, err)
+	require.Equal(t, []byte("sig"), cachedReply.Sig)
+	require.Equal(t, []byte("reply"), cachedReply.Data)
+	require.Nil(t, cachedReply.FinalizedBlocksHashes)
+}
@@ -0,0 +1,83 @@
+package performance
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	spectypes "github.com/lavanet/lava/x/spec/types"
+)
+
+const (
+	// pages are stored under their own urls, so they don't collide with the entries of the relays
+	paginationIndexUrlPrefix    = "pages:"
+	cosmosPaginationKeyParam    = "pagination.key"
+	cosmosPaginationOffsetParam = "pagination.offset"
+)
+
+// the part of a cosmos rest response that links it to its next page
+type paginatedRestResponse struct {
+	Pagination *struct {
+		NextKey *string `json:"next_key"`
+	} `json:"pagination"`
+}
+
+// pageRequest returns the request a page of a paginated cosmos rest query is indexed by, it is keyed by the path with the rest of
+// the query, the height and the page key, so the order of the query parameters doesn't matter. only queries of a specific height
+// are indexed, and pages selected by an offset are left to the regular entries as the next keys can't link them
+func pageRequest(relayData *pairingtypes.RelayPrivateData) (*pairingtypes.RelayRequest, bool) {
+	if relayData == nil || relayData.ApiInterface != spectypes.APIInterfaceRest || relayData.ConnectionType != http.MethodGet || relayData.RequestBlock < 0 {
+		return nil, false
+	}
+	query, err := url.ParseQuery(strings.TrimPrefix(string(relayData.Data), "?"))
+	if err != nil {
+		return nil, false
+	}
+	if offset := query.Get(cosmosPaginationOffsetParam); offset != "" && offset != "0" {
+		return nil, false
+	}
+	key := query.Get(cosmosPaginationKeyParam)
+	query.Del(cosmosPaginationKeyParam)
+	query.Del(cosmosPaginationOffsetParam)
+	return &pairingtypes.RelayRequest{RelayData: &pairingtypes.RelayPrivateData{
+		ConnectionType: relayData.ConnectionType,
+		ApiUrl:         paginationIndexUrlPrefix + relayData.ApiUrl + "?" + query.Encode(),
+		Data:           []byte(key),
+		RequestBlock:   relayData.RequestBlock,
+		ApiInterface:   relayData.ApiInterface,
+	}}, true
+}
+
+// returns whether the reply is a page of a paginated query, the last page has a null next key
+func isPageReply(reply *pairingtypes.RelayReply) bool {
+	var response paginatedRestResponse
+	return reply != nil && json.Unmarshal(reply.Data, &response) == nil && response.Pagination != nil
+}
+
+// SetPage indexes a finalized reply of a paginated cosmos rest query at a specific height, indexed is false when the reply isn't
+// such a page and should be stored as a regular entry
+func (cache *Cache) SetPage(ctx context.Context, relayData *pairingtypes.RelayPrivateData, chainID string, bucketID string, reply *pairingtypes.RelayReply, finalized bool) (indexed bool, err error) {
+	if !finalized {
+		return false, nil
+	}
+	request, ok := pageRequest(relayData)
+	if !ok {
+		return false, nil
+	}
+	if !isPageReply(reply) {
+		return false, nil
+	}
+	return true, cache.SetEntry(ctx, request, spectypes.APIInterfaceRest, nil, chainID, bucketID, reply, true)
+}
+
+// GetPage returns the indexed reply of a page of a paginated cosmos rest query at a specific height
+func (cache *Cache) GetPage(ctx context.Context, relayData *pairingtypes.RelayPrivateData, chainID string) (*pairingtypes.RelayReply, error) {
+	request, ok := pageRequest(relayData)
+	if !ok {
+		return nil, NotFoundError
+	}
+	return cache.GetEntry(ctx, request, spectypes.APIInterfaceRest, nil, chainID, true)
+}
@@ -0,0 +1,55 @@
+package performance
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	spectypes "github.com/lavanet/lava/x/spec/types"
+	"github.com/stretchr/testify/require"
+)
+
+func pageRelayData(query string, height int64) *pairingtypes.RelayPrivateData {
+	return &pairingtypes.RelayPrivateData{
+		ConnectionType: http.MethodGet,
+		ApiUrl:         "/cosmos/staking/v1beta1/validators",
+		Data:           []byte(query),
+		RequestBlock:   height,
+		ApiInterface:   spectypes.APIInterfaceRest,
+	}
+}
+
+func pageReply(data string, nextKey string) *pairingtypes.RelayReply {
+	return &pairingtypes.RelayReply{Data: []byte(`{"validators":["` + data + `"],"pagination":{"next_key":` + nextKey + `,"total":"0"}}`)}
+}
+
+func TestPaginationIndex(t *testing.T) {
+	ctx := context.Background()
+	cache, err := InitLocalCache()
+	require.NoError(t, err)
+	setPage := func(query string, height int64, reply *pairingtypes.RelayReply, finalized bool) bool {
+		indexed, err := cache.SetPage(ctx, pageRelayData(query, height), "LAV1", "dapp", reply, finalized)
+		require.NoError(t, err)
+		cache.client.(*localCacheClient).cache.Wait()
+		return indexed
+	}
+
+	// only finalized pages of a specific height are indexed
+	require.False(t, setPage("?pagination.limit=2", 100, pageReply("a", `"a2V5MQ=="`), false))
+	require.False(t, setPage("?pagination.limit=2", -2, pageReply("a", `"a2V5MQ=="`), true))
+	require.False(t, setPage("?pagination.limit=2&pagination.offset=4", 100, pageReply("c", "null"), true))
+	require.False(t, setPage("?pagination.limit=2", 100, &pairingtypes.RelayReply{Data: []byte(`{"validator":{}}`)}, true))
+
+	require.True(t, setPage("?pagination.limit=2", 100, pageReply("a", `"a2V5MQ=="`), true))
+	require.True(t, setPage("?pagination.limit=2&pagination.key=a2V5MQ%3D%3D", 100, pageReply("b", `"a2V5Mg=="`), true))
+
+	// the query's parameters are matched in any order
+	reply, err := cache.GetPage(ctx, pageRelayData("?pagination.key=a2V5MQ%3D%3D&pagination.limit=2", 100), "LAV1")
+	require.NoError(t, err)
+	require.Equal(t, pageReply("b", `"a2V5Mg=="`).Data, reply.Data)
+	_, err = cache.GetPage(ctx, pageRelayData("?pagination.limit=2", 101), "LAV1")
+	require.Error(t, err)
+	_, err = cache.GetPage(ctx, pageRelayData("?pagination.limit=3", 100), "LAV1")
+	require.Error(t, err)
+}
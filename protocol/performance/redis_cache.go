@@ -0,0 +1,53 @@
+package performance
+
+import (
+	"context"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"github.com/go-redis/redis"
+)
+
+const (
+	redisKeyPrefix      = "lava-relay-cache:"
+	redisConnectTimeout = 3 * time.Second
+	// the redis client doesn't take a context, relays shouldn't wait on the cache longer than this
+	redisOperationTimeout = 200 * time.Millisecond
+)
+
+// redisCacheBackend stores cache entries in redis, two or more addresses are treated as a redis cluster
+type redisCacheBackend struct {
+	client redis.UniversalClient
+}
+
+// InitRedisCache creates a Cache backed by redis, so several consumers share the cache and it survives restarts
+func InitRedisCache(addresses string) (*Cache, error) {
+	addrs := strings.Split(addresses, ",")
+	for idx := range addrs {
+		addrs[idx] = strings.TrimSpace(addrs[idx])
+	}
+	client := redis.NewUniversalClient(&redis.UniversalOptions{Addrs: addrs, DialTimeout: redisConnectTimeout, ReadTimeout: redisOperationTimeout, WriteTimeout: redisOperationTimeout})
+	err := client.Ping().Err()
+	if err != nil {
+		client.Close()
+		return nil, err
+	}
+	return &Cache{client: newBackendCacheClient(&redisCacheBackend{client: client}), address: addresses}, nil
+}
+
+func (rcb *redisCacheBackend) Get(ctx context.Context, key string) ([]byte, error) {
+	value, err := rcb.client.Get(redisKey(key)).Bytes()
+	if err == redis.Nil {
+		return nil, NotFoundError
+	}
+	return value, err
+}
+
+func (rcb *redisCacheBackend) Set(ctx context.Context, key string, value []byte, expiration time.Duration) error {
+	return rcb.client.Set(redisKey(key), value, expiration).Err()
+}
+
+func redisKey(key string) string {
+	return redisKeyPrefix + hex.EncodeToString([]byte(key))
+}
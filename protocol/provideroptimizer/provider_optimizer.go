@@ -1,9 +1,46 @@
 package provideroptimizer
 
-import "time"
+import (
+	"sync"
+	"time"
+)
+
+const (
+	TrustSuccessDecay   = 0.01            // weight of each relay in the success rate of a provider
+	TrustMatureRelays   = 1000            // relays before a provider can be fully trusted
+	TrustMatureDuration = 2 * time.Hour   // time since the first relay before a provider can be fully trusted
+	TrustMaxBlockLag    = 10              // a provider this many blocks behind the others in its last probe isn't trusted
+	TrustMaxClockSkew   = 5 * time.Second // a provider whose clock is off by this much in its last probe isn't trusted
+	MaxTrackedProviders = 10000           // the least recently used providers are dropped past this many
+	trackedProvidersTTL = 24 * time.Hour  // providers without relays for this long are dropped first
+
+	DegradedSuccessRate      = 0.8             // a provider succeeding less is degraded
+	DegradedBlockLag         = 5               // a provider whose last reply was this many blocks behind the expected block height is degraded
+	DegradationMinProviders  = 3               // providers relayed to recently needed to judge them all degraded
+	DegradationEnterShare    = 0.5             // share of degraded providers that starts a degradation
+	DegradationExitShare     = 0.25            // share of degraded providers under which a degradation ends, lower so it doesn't flap
+	degradationWindow        = 5 * time.Minute // providers without relays for this long aren't judged
+	degradationCheckInterval = time.Second     // degradation is judged again at most this often
+)
 
 type ProviderOptimizer struct {
-	strategy Strategy
+	strategy  Strategy
+	lock      sync.RWMutex
+	providers map[string]*providerData
+	now       func() time.Time
+	degraded  bool
+	checkedAt time.Time // when degraded was judged
+}
+
+// providerData is the relay history the trust in a provider is built from
+type providerData struct {
+	firstRelay  time.Time
+	lastRelay   time.Time
+	relays      uint64
+	successRate float64       // moving average of the relays' success
+	clockSkew   time.Duration // of the provider's clock from ours, as of the last probe
+	blockLag    int64         // blocks behind the most advanced provider, as of the last probe
+	syncLag     int64         // blocks behind the expected block height, as of the last reply
 }
 
 type Strategy int
@@ -16,8 +53,147 @@ const (
 )
 
 func (po *ProviderOptimizer) AppendRelayData(providerAddress string, latency time.Duration, failure bool) {
+	if providerAddress == "" {
+		return
+	}
+	success := 1.0
+	if failure {
+		success = 0
+	}
+	po.lock.Lock()
+	defer po.lock.Unlock()
+	now := po.now()
+	data, ok := po.providers[providerAddress]
+	if !ok {
+		if len(po.providers) >= MaxTrackedProviders {
+			po.dropStale(now)
+		}
+		data = &providerData{firstRelay: now, successRate: success}
+		po.providers[providerAddress] = data
+	}
+	data.lastRelay = now
+	data.relays++
+	data.successRate = data.successRate*(1-TrustSuccessDecay) + success*TrustSuccessDecay
+}
+
+// AppendProbeData records how far the provider's clock and node were off in its last probe, it's ignored for providers
+// without relay data since a successful probe appends a relay first
+func (po *ProviderOptimizer) AppendProbeData(providerAddress string, clockSkew time.Duration, blockLag int64) {
+	po.lock.Lock()
+	defer po.lock.Unlock()
+	data, ok := po.providers[providerAddress]
+	if !ok {
+		return
+	}
+	data.clockSkew = clockSkew
+	data.blockLag = blockLag
+}
+
+// AppendSyncData records how far behind the expected block height of the chain the provider's last reply was, it's ignored
+// for providers without relay data like probe data
+func (po *ProviderOptimizer) AppendSyncData(providerAddress string, syncLag int64) {
+	po.lock.Lock()
+	defer po.lock.Unlock()
+	data, ok := po.providers[providerAddress]
+	if !ok {
+		return
+	}
+	data.syncLag = syncLag
+}
+
+// AppendReliabilityConflict forgets the history of a provider involved in a data reliability conflict,
+// its trust is built again from its next relays
+func (po *ProviderOptimizer) AppendReliabilityConflict(providerAddress string) {
+	po.lock.Lock()
+	defer po.lock.Unlock()
+	delete(po.providers, providerAddress)
+}
+
+// TrustScore is between 0 for unknown or failing providers and 1 for providers that served many relays
+// successfully for a long time, it's lowered for providers lagging behind the others or with a skewed clock
+func (po *ProviderOptimizer) TrustScore(providerAddress string) float64 {
+	po.lock.RLock()
+	defer po.lock.RUnlock()
+	data, ok := po.providers[providerAddress]
+	if !ok {
+		return 0
+	}
+	maturity := float64(data.relays) / TrustMatureRelays
+	if ageMaturity := float64(po.now().Sub(data.firstRelay)) / float64(TrustMatureDuration); ageMaturity < maturity {
+		maturity = ageMaturity
+	}
+	if maturity > 1 {
+		maturity = 1
+	}
+	return data.successRate * maturity * probeFactor(data.blockLag, TrustMaxBlockLag) * probeFactor(int64(data.clockSkew), int64(TrustMaxClockSkew))
+}
+
+// Degraded is whether most of the providers relayed to recently are failing or lagging behind the expected block height,
+// the consumer sheds its own load while they are. it ends only once few providers are still degraded
+func (po *ProviderOptimizer) Degraded() bool {
+	po.lock.RLock()
+	now := po.now()
+	if now.Sub(po.checkedAt) < degradationCheckInterval {
+		defer po.lock.RUnlock()
+		return po.degraded
+	}
+	po.lock.RUnlock()
+
+	po.lock.Lock()
+	defer po.lock.Unlock()
+	po.checkedAt = now
+	active, degraded := 0, 0
+	for _, data := range po.providers {
+		if now.Sub(data.lastRelay) > degradationWindow {
+			continue
+		}
+		active++
+		if data.successRate < DegradedSuccessRate || data.syncLag >= DegradedBlockLag {
+			degraded++
+		}
+	}
+	if active < DegradationMinProviders {
+		po.degraded = false
+		return false
+	}
+	share := float64(degraded) / float64(active)
+	if po.degraded {
+		po.degraded = share >= DegradationExitShare
+	} else {
+		po.degraded = share >= DegradationEnterShare
+	}
+	return po.degraded
+}
+
+// probeFactor drops linearly from 1 with no deviation to 0 at max
+func probeFactor(deviation int64, max int64) float64 {
+	if deviation < 0 {
+		deviation = -deviation
+	}
+	if deviation >= max {
+		return 0
+	}
+	return 1 - float64(deviation)/float64(max)
+}
+
+// must be called with the lock held
+func (po *ProviderOptimizer) dropStale(now time.Time) {
+	var oldestAddress string
+	var oldest time.Time
+	for providerAddress, data := range po.providers {
+		if now.Sub(data.lastRelay) >= trackedProvidersTTL {
+			delete(po.providers, providerAddress)
+			continue
+		}
+		if oldestAddress == "" || data.lastRelay.Before(oldest) {
+			oldestAddress, oldest = providerAddress, data.lastRelay
+		}
+	}
+	if len(po.providers) >= MaxTrackedProviders {
+		delete(po.providers, oldestAddress)
+	}
 }
 
 func NewProviderOptimizer(strategy Strategy) *ProviderOptimizer {
-	return &ProviderOptimizer{strategy: strategy}
+	return &ProviderOptimizer{strategy: strategy, providers: map[string]*providerData{}, now: time.Now}
 }
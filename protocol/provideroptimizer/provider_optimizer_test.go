@@ -0,0 +1,93 @@
+package provideroptimizer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTrustScore(t *testing.T) {
+	now := time.Now()
+	po := NewProviderOptimizer(STRATEGY_QOS)
+	po.now = func() time.Time { return now }
+	require.Zero(t, po.TrustScore("unknown"))
+
+	for i := 0; i < TrustMatureRelays; i++ {
+		po.AppendRelayData("reliable", time.Millisecond, false)
+		po.AppendRelayData("failing", time.Millisecond, i%2 == 0)
+	}
+	// many relays aren't enough without time
+	require.Zero(t, po.TrustScore("reliable"))
+	now = now.Add(TrustMatureDuration / 2)
+	require.InDelta(t, 0.5, po.TrustScore("reliable"), 0.01)
+	now = now.Add(TrustMatureDuration)
+	require.InDelta(t, 1, po.TrustScore("reliable"), 0.01)
+	require.InDelta(t, 0.5, po.TrustScore("failing"), 0.05)
+
+	po.AppendReliabilityConflict("reliable")
+	require.Zero(t, po.TrustScore("reliable"))
+}
+
+func TestTrustScoreProbeData(t *testing.T) {
+	now := time.Now()
+	po := NewProviderOptimizer(STRATEGY_QOS)
+	po.now = func() time.Time { return now }
+	for i := 0; i < TrustMatureRelays; i++ {
+		po.AppendRelayData("provider", time.Millisecond, false)
+	}
+	now = now.Add(TrustMatureDuration)
+	require.InDelta(t, 1, po.TrustScore("provider"), 0.01)
+
+	po.AppendProbeData("provider", 0, TrustMaxBlockLag/2)
+	require.InDelta(t, 0.5, po.TrustScore("provider"), 0.01)
+	po.AppendProbeData("provider", -TrustMaxClockSkew/2, 0)
+	require.InDelta(t, 0.5, po.TrustScore("provider"), 0.01)
+	po.AppendProbeData("provider", TrustMaxClockSkew, 0)
+	require.Zero(t, po.TrustScore("provider"))
+	po.AppendProbeData("provider", 0, 0)
+	require.InDelta(t, 1, po.TrustScore("provider"), 0.01)
+
+	// probe data alone doesn't track a provider
+	po.AppendProbeData("unknown", 0, 0)
+	require.Zero(t, po.TrustScore("unknown"))
+}
+
+func TestDegraded(t *testing.T) {
+	now := time.Now()
+	po := NewProviderOptimizer(STRATEGY_QOS)
+	po.now = func() time.Time { return now }
+	providers := []string{"provider0", "provider1", "provider2", "provider3"}
+	for _, provider := range providers {
+		po.AppendRelayData(provider, time.Millisecond, false)
+	}
+	require.False(t, po.Degraded())
+
+	// half the providers fall behind the expected block
+	po.AppendSyncData("provider0", DegradedBlockLag)
+	po.AppendSyncData("provider1", DegradedBlockLag+1)
+	require.False(t, po.Degraded(), "judged again only after the check interval")
+	now = now.Add(degradationCheckInterval)
+	require.True(t, po.Degraded())
+
+	// a single degraded provider left doesn't end the degradation
+	po.AppendSyncData("provider0", 0)
+	now = now.Add(degradationCheckInterval)
+	require.True(t, po.Degraded())
+	po.AppendSyncData("provider1", 0)
+	now = now.Add(degradationCheckInterval)
+	require.False(t, po.Degraded())
+
+	// failing providers are degraded
+	for i := 0; i < 100; i++ {
+		po.AppendRelayData("provider2", time.Millisecond, true)
+		po.AppendRelayData("provider3", time.Millisecond, true)
+	}
+	now = now.Add(degradationCheckInterval)
+	require.True(t, po.Degraded())
+
+	// providers that weren't relayed to recently aren't judged, too few are left
+	now = now.Add(degradationWindow + time.Second)
+	po.AppendRelayData("provider0", time.Millisecond, false)
+	require.False(t, po.Degraded())
+}
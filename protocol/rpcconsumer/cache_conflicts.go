@@ -0,0 +1,44 @@
+package rpcconsumer
+
+import (
+	"sync"
+)
+
+// cacheConflicts keeps the cached replies a conflict was reported on in the epoch. a conflict on a cached reply is reported once,
+// and the disputed reply isn't used to verify other replies after it, they are checked with reliability relays instead
+type cacheConflicts struct {
+	lock     sync.Mutex
+	epoch    int64
+	disputed map[string]struct{} // keys of the disputed cached replies, with their signers
+}
+
+func newCacheConflicts() *cacheConflicts {
+	return &cacheConflicts{disputed: map[string]struct{}{}}
+}
+
+// isDisputed is whether a conflict was reported on the cached reply in the latest epoch
+func (cc *cacheConflicts) isDisputed(entryKey string) bool {
+	cc.lock.Lock()
+	defer cc.lock.Unlock()
+	_, ok := cc.disputed[entryKey]
+	return ok
+}
+
+// dispute marks the cached reply as disputed in the epoch, it returns false when it already was so the conflict isn't reported again.
+// the replies disputed in older epochs are dropped, cached replies are only reported in the epoch they were signed in
+func (cc *cacheConflicts) dispute(entryKey string, epoch int64) bool {
+	cc.lock.Lock()
+	defer cc.lock.Unlock()
+	if epoch < cc.epoch {
+		return false
+	}
+	if epoch > cc.epoch {
+		cc.epoch = epoch
+		cc.disputed = map[string]struct{}{}
+	}
+	if _, ok := cc.disputed[entryKey]; ok {
+		return false
+	}
+	cc.disputed[entryKey] = struct{}{}
+	return true
+}
@@ -0,0 +1,25 @@
+package rpcconsumer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCacheConflicts(t *testing.T) {
+	cc := newCacheConflicts()
+	require.False(t, cc.isDisputed("entry"))
+
+	// a conflict on a cached reply is reported once in the epoch
+	require.True(t, cc.dispute("entry", 20))
+	require.True(t, cc.isDisputed("entry"))
+	require.False(t, cc.dispute("entry", 20))
+	require.True(t, cc.dispute("other", 20))
+
+	// relays of an older epoch can't report anymore
+	require.False(t, cc.dispute("old", 10))
+
+	// the disputes of the previous epoch are dropped
+	require.True(t, cc.dispute("other", 40))
+	require.False(t, cc.isDisputed("entry"))
+}
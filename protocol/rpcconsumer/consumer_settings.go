@@ -0,0 +1,113 @@
+package rpcconsumer
+
+import (
+	"fmt"
+
+	"github.com/lavanet/lava/protocol/chainlib"
+	commonlib "github.com/lavanet/lava/protocol/common"
+	"github.com/lavanet/lava/protocol/lavasession"
+	"github.com/lavanet/lava/protocol/performance"
+	"github.com/spf13/viper"
+)
+
+const (
+	ConfigWatchIntervalFlagName  = "config-watch-interval"
+	MaxRelayRetriesConfigName    = "max-relay-retries"
+	RelaysPerSecondConfigName    = "relays-per-second"
+	CORSAllowOriginsConfigName   = "cors-allow-origins"
+	CORSAllowHeadersConfigName   = "cors-allow-headers"
+	TLSCertFileConfigName        = "tls-cert-file"
+	TLSKeyFileConfigName         = "tls-key-file"
+	AutoCertDomainsConfigName    = "tls-autocert-domains"
+	AutoCertCacheDirConfigName   = "tls-autocert-cache-dir"
+	AuthAPIKeysConfigName        = "auth-api-keys"
+	AuthJWTSecretConfigName      = "auth-jwt-secret"
+	AuthJWTDappClaimConfigName   = "auth-jwt-dapp-claim"
+	AuthVerifierURLConfigName    = "auth-verifier-url"
+	GasOracleProvidersConfigName = "gas-oracle-providers"
+)
+
+// ConsumerSettings are the rpcconsumer settings of the config file, they are all applied again when the config is reloaded
+type ConsumerSettings struct {
+	Endpoints          []*lavasession.RPCEndpoint
+	CacheAddress       string                  // the cache server address, the cache flag is used when it isn't set in the config
+	MaxRelayRetries    int                     // the providers a relay is sent to before failing
+	RelaysPerSecond    uint64                  // the relays each endpoint accepts per second, 0 for no limit
+	GasOracleProviders int                     // the providers whose fee estimates are aggregated for ethereum gas price queries, 0 to send them to one
+	Listener           chainlib.ListenerConfig // CORS, TLS and dapp authentication of every endpoint's listener
+}
+
+// ParseConsumerSettings reads the settings from the config, unlike ParseEndpoints an invalid config is returned as an error
+// so a bad reload keeps the running settings
+func ParseConsumerSettings(viperConfig *viper.Viper, geolocation uint64, cacheAddressFlag string) (*ConsumerSettings, error) {
+	settings := &ConsumerSettings{CacheAddress: cacheAddressFlag, MaxRelayRetries: MaxRelayRetries}
+	err := viperConfig.UnmarshalKey(commonlib.EndpointsConfigName, &settings.Endpoints)
+	if err != nil {
+		return nil, fmt.Errorf("could not unmarshal endpoints: %w", err)
+	}
+	if len(settings.Endpoints) == 0 {
+		return nil, fmt.Errorf("no endpoints configured")
+	}
+	networkAddresses := map[string]struct{}{}
+	for _, endpoint := range settings.Endpoints {
+		if _, ok := networkAddresses[endpoint.NetworkAddress]; ok {
+			return nil, fmt.Errorf("several endpoints listen on %s", endpoint.NetworkAddress)
+		}
+		networkAddresses[endpoint.NetworkAddress] = struct{}{}
+		endpoint.Geolocation = geolocation
+	}
+	if viperConfig.IsSet(performance.CacheFlagName) {
+		settings.CacheAddress = viperConfig.GetString(performance.CacheFlagName)
+	}
+	if viperConfig.IsSet(MaxRelayRetriesConfigName) {
+		settings.MaxRelayRetries = viperConfig.GetInt(MaxRelayRetriesConfigName)
+		if settings.MaxRelayRetries <= 0 {
+			return nil, fmt.Errorf("%s must be positive, got %d", MaxRelayRetriesConfigName, settings.MaxRelayRetries)
+		}
+	}
+	settings.RelaysPerSecond = viperConfig.GetUint64(RelaysPerSecondConfigName)
+	settings.GasOracleProviders = viperConfig.GetInt(GasOracleProvidersConfigName)
+	if settings.GasOracleProviders < 0 {
+		return nil, fmt.Errorf("%s can't be negative, got %d", GasOracleProvidersConfigName, settings.GasOracleProviders)
+	}
+	settings.Listener = chainlib.ListenerConfig{
+		CORSAllowOrigins: viperConfig.GetString(CORSAllowOriginsConfigName),
+		CORSAllowHeaders: viperConfig.GetString(CORSAllowHeadersConfigName),
+		TLSCertFile:      viperConfig.GetString(TLSCertFileConfigName),
+		TLSKeyFile:       viperConfig.GetString(TLSKeyFileConfigName),
+		AutoCertDomains:  viperConfig.GetStringSlice(AutoCertDomainsConfigName),
+		AutoCertCacheDir: viperConfig.GetString(AutoCertCacheDirConfigName),
+		JWTSecret:        viperConfig.GetString(AuthJWTSecretConfigName),
+		JWTDappClaim:     viperConfig.GetString(AuthJWTDappClaimConfigName),
+		DappVerifierURL:  viperConfig.GetString(AuthVerifierURLConfigName),
+	}
+	// a list rather than a map, viper lower cases map keys
+	apiKeys := []struct {
+		Key    string `mapstructure:"key"`
+		DappID string `mapstructure:"dapp-id"`
+	}{}
+	err = viperConfig.UnmarshalKey(AuthAPIKeysConfigName, &apiKeys)
+	if err != nil {
+		return nil, fmt.Errorf("could not unmarshal %s: %w", AuthAPIKeysConfigName, err)
+	}
+	for _, apiKey := range apiKeys {
+		if apiKey.Key == "" || apiKey.DappID == "" {
+			return nil, fmt.Errorf("%s entries need both a key and a dapp-id", AuthAPIKeysConfigName)
+		}
+		if settings.Listener.APIKeys == nil {
+			settings.Listener.APIKeys = map[string]string{}
+		}
+		settings.Listener.APIKeys[apiKey.Key] = apiKey.DappID
+	}
+	err = settings.Listener.Validate()
+	if err != nil {
+		return nil, err
+	}
+	return settings, nil
+}
+
+// endpointConfigKey identifies an endpoint by all of its settings, an endpoint with a changed setting is replaced.
+// the listener config is shared, so changing it replaces every endpoint
+func endpointConfigKey(endpoint *lavasession.RPCEndpoint, listenerConfig *chainlib.ListenerConfig) string {
+	return endpoint.String() + " " + listenerConfig.String()
+}
@@ -0,0 +1,218 @@
+package rpcconsumer
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/lavanet/lava/protocol/lavasession"
+	"github.com/lavanet/lava/utils"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	"github.com/spf13/cobra"
+)
+
+const EvidenceFileFlagName = "evidence-file"
+
+// UsageDiscrepancy is a provider that claimed more cu from the consumer in an epoch than the consumer signed to it
+type UsageDiscrepancy struct {
+	Epoch     uint64 `json:"epoch"`
+	ChainID   string `json:"chain_id"`
+	Provider  string `json:"provider"`
+	ClaimedCu uint64 `json:"claimed_cu"`
+	SignedCu  uint64 `json:"signed_cu"`
+	Sessions  uint64 `json:"sessions"`
+}
+
+// UsageEvidence backs a dispute with a provider, the on chain claims next to the cu the consumer recorded signing on each of its endpoints
+type UsageEvidence struct {
+	Consumer      string                            `json:"consumer"`
+	Discrepancies []UsageDiscrepancy                `json:"discrepancies"`
+	Usage         []lavasession.ConsumerUsageRecord `json:"usage"`
+}
+
+type usageKey struct {
+	epoch    uint64
+	chainID  string
+	provider string
+}
+
+// CreateConsumerReconcileCobraCommand compares the cu a consumer recorded signing to its providers with the relay payments the
+// providers claimed from it on chain
+func CreateConsumerReconcileCobraCommand() *cobra.Command {
+	cmdReconcile := &cobra.Command{
+		Use:   "consumer-reconcile [usage-store-path] [consumer-address]",
+		Short: "flag providers that claimed more cu than the consumer signed to them",
+		Long: `compare the cu a consumer signed to its providers, recorded with rpcconsumer --` + lavasession.ConsumerUsageStoreFlag + `,
+		with the relay payments the providers claimed from the consumer on chain. only the epochs and chains the consumer recorded are compared,
+		a consumer key shared by several rpcconsumer processes is reconciled with the stores of all of them.
+		the flagged providers are printed, and with --` + EvidenceFileFlagName + ` written to a file with the recorded usage as evidence for a dispute`,
+		Example: `consumer-reconcile ~/.lava/consumer-usage.db lava@1... --evidence-file evidence.json`,
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			consumer := args[1]
+			if _, err := sdk.AccAddressFromBech32(consumer); err != nil {
+				return utils.LavaFormatError("invalid consumer address", err, utils.Attribute{Key: "consumer", Value: consumer})
+			}
+			fromEpoch, err := cmd.Flags().GetUint64(pairingtypes.FlagFromEpoch)
+			if err != nil {
+				return err
+			}
+			toEpoch, err := cmd.Flags().GetUint64(pairingtypes.FlagToEpoch)
+			if err != nil {
+				return err
+			}
+			evidencePath, err := cmd.Flags().GetString(EvidenceFileFlagName)
+			if err != nil {
+				return err
+			}
+
+			usageStore, err := lavasession.NewBoltConsumerUsageStore(args[0])
+			if err != nil {
+				return utils.LavaFormatError("failed opening the usage store, is the consumer still running?", err, utils.Attribute{Key: "path", Value: args[0]})
+			}
+			records, err := usageStore.LoadUsage()
+			usageStore.Close()
+			if err != nil {
+				return err
+			}
+			records = filterUsageEpochs(records, fromEpoch, toEpoch)
+			if len(records) == 0 {
+				utils.LavaFormatInfo("no signed cu recorded in the epochs", utils.Attribute{Key: "path", Value: args[0]})
+				return nil
+			}
+			// the range of the query is narrowed to the recorded epochs
+			fromEpoch, toEpoch = records[0].Epoch, records[0].Epoch
+			for _, record := range records {
+				if record.Epoch < fromEpoch {
+					fromEpoch = record.Epoch
+				}
+				if record.Epoch > toEpoch {
+					toEpoch = record.Epoch
+				}
+			}
+
+			payments, err := queryConsumerPayments(cmd.Context(), pairingtypes.NewQueryClient(clientCtx), consumer, fromEpoch, toEpoch)
+			if err != nil {
+				return err
+			}
+			evidence := reconcileUsage(consumer, records, payments)
+			out, err := json.MarshalIndent(evidence.Discrepancies, "", "  ")
+			if err != nil {
+				return err
+			}
+			err = clientCtx.PrintBytes(out)
+			if err != nil {
+				return err
+			}
+			if evidencePath == "" || len(evidence.Discrepancies) == 0 {
+				return nil
+			}
+			out, err = json.MarshalIndent(evidence, "", "  ")
+			if err != nil {
+				return err
+			}
+			err = os.WriteFile(evidencePath, out, 0o600)
+			if err != nil {
+				return utils.LavaFormatError("failed writing evidence file", err, utils.Attribute{Key: "path", Value: evidencePath})
+			}
+			utils.LavaFormatInfo("wrote usage evidence", utils.Attribute{Key: "providers", Value: len(evidence.Discrepancies)}, utils.Attribute{Key: "path", Value: evidencePath})
+			return nil
+		},
+	}
+	cmdReconcile.Flags().Uint64(pairingtypes.FlagFromEpoch, 0, "the first epoch to reconcile, the earliest recorded epoch by default")
+	cmdReconcile.Flags().Uint64(pairingtypes.FlagToEpoch, 0, "the last epoch to reconcile, the latest recorded epoch by default")
+	cmdReconcile.Flags().String(EvidenceFileFlagName, "", "path of a file to write the flagged providers and the usage recorded for them to, as evidence for a dispute")
+	flags.AddQueryFlagsToCmd(cmdReconcile)
+	return cmdReconcile
+}
+
+func filterUsageEpochs(records []lavasession.ConsumerUsageRecord, fromEpoch uint64, toEpoch uint64) []lavasession.ConsumerUsageRecord {
+	filtered := []lavasession.ConsumerUsageRecord{}
+	for _, record := range records {
+		if record.Epoch < fromEpoch || (toEpoch != 0 && record.Epoch > toEpoch) {
+			continue
+		}
+		filtered = append(filtered, record)
+	}
+	return filtered
+}
+
+func queryConsumerPayments(ctx context.Context, queryClient pairingtypes.QueryClient, consumer string, fromEpoch uint64, toEpoch uint64) (payments []pairingtypes.ProviderEpochPayment, err error) {
+	pageReq := &query.PageRequest{}
+	for {
+		res, err := queryClient.FilteredEpochPayments(ctx, &pairingtypes.QueryFilteredEpochPaymentsRequest{
+			Consumer:   consumer,
+			FromEpoch:  fromEpoch,
+			ToEpoch:    toEpoch,
+			Pagination: pageReq,
+		})
+		if err != nil {
+			return nil, utils.LavaFormatError("failed querying relay payments", err, utils.Attribute{Key: "consumer", Value: consumer})
+		}
+		payments = append(payments, res.Payments...)
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			return payments, nil
+		}
+		pageReq = &query.PageRequest{Key: res.Pagination.NextKey}
+	}
+}
+
+// reconcileUsage flags the payments of the consumer that claimed more cu than it signed to the provider on all of its endpoints of the chain.
+// payments of epochs and chains the consumer has no record of are skipped, it may not have been recording then
+func reconcileUsage(consumer string, records []lavasession.ConsumerUsageRecord, payments []pairingtypes.ProviderEpochPayment) UsageEvidence {
+	signed := map[usageKey]uint64{}
+	recorded := map[usageKey]struct{}{}
+	for _, record := range records {
+		signed[usageKey{epoch: record.Epoch, chainID: record.ChainID, provider: record.Provider}] += record.SignedCu
+		recorded[usageKey{epoch: record.Epoch, chainID: record.ChainID}] = struct{}{}
+	}
+
+	evidence := UsageEvidence{Consumer: consumer, Discrepancies: []UsageDiscrepancy{}, Usage: []lavasession.ConsumerUsageRecord{}}
+	flagged := map[usageKey]struct{}{}
+	for _, payment := range payments {
+		if _, ok := recorded[usageKey{epoch: payment.Epoch, chainID: payment.ChainID}]; !ok {
+			continue
+		}
+		key := usageKey{epoch: payment.Epoch, chainID: payment.ChainID, provider: payment.Provider}
+		for _, consumerPayment := range payment.ConsumerPayments {
+			if consumerPayment.Consumer != consumer || consumerPayment.Cu <= signed[key] {
+				continue
+			}
+			evidence.Discrepancies = append(evidence.Discrepancies, UsageDiscrepancy{
+				Epoch:     payment.Epoch,
+				ChainID:   payment.ChainID,
+				Provider:  payment.Provider,
+				ClaimedCu: consumerPayment.Cu,
+				SignedCu:  signed[key],
+				Sessions:  consumerPayment.Sessions,
+			})
+			flagged[key] = struct{}{}
+		}
+	}
+	sort.Slice(evidence.Discrepancies, func(i, j int) bool {
+		a, b := evidence.Discrepancies[i], evidence.Discrepancies[j]
+		if a.Epoch != b.Epoch {
+			return a.Epoch < b.Epoch
+		}
+		if a.ChainID != b.ChainID {
+			return a.ChainID < b.ChainID
+		}
+		return a.Provider < b.Provider
+	})
+
+	for _, record := range records {
+		if _, ok := flagged[usageKey{epoch: record.Epoch, chainID: record.ChainID, provider: record.Provider}]; ok {
+			evidence.Usage = append(evidence.Usage, record)
+		}
+	}
+	return evidence
+}
@@ -0,0 +1,42 @@
+package rpcconsumer
+
+import (
+	"testing"
+
+	"github.com/lavanet/lava/protocol/lavasession"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReconcileUsage(t *testing.T) {
+	consumer := "consumer"
+	records := []lavasession.ConsumerUsageRecord{
+		{Epoch: 20, ChainID: "LAV1", ApiInterface: "rest", Provider: "provider1", SignedCu: 30},
+		{Epoch: 20, ChainID: "LAV1", ApiInterface: "tendermintrpc", Provider: "provider1", SignedCu: 20},
+		{Epoch: 20, ChainID: "LAV1", ApiInterface: "rest", Provider: "provider2", SignedCu: 10},
+	}
+	payments := []pairingtypes.ProviderEpochPayment{
+		// the cu signed on all the endpoints of the chain is claimed
+		{ChainID: "LAV1", Epoch: 20, Provider: "provider1", ConsumerPayments: []pairingtypes.ConsumerEpochPayment{{Consumer: consumer, Cu: 50, Sessions: 2}}},
+		{ChainID: "LAV1", Epoch: 20, Provider: "provider2", ConsumerPayments: []pairingtypes.ConsumerEpochPayment{{Consumer: consumer, Cu: 40, Sessions: 1}}},
+		// a provider that was never signed to in a recorded epoch
+		{ChainID: "LAV1", Epoch: 20, Provider: "provider3", ConsumerPayments: []pairingtypes.ConsumerEpochPayment{{Consumer: consumer, Cu: 10, Sessions: 1}}},
+		// the consumer wasn't recording in this epoch
+		{ChainID: "LAV1", Epoch: 40, Provider: "provider1", ConsumerPayments: []pairingtypes.ConsumerEpochPayment{{Consumer: consumer, Cu: 100, Sessions: 1}}},
+	}
+
+	evidence := reconcileUsage(consumer, records, payments)
+	require.Equal(t, consumer, evidence.Consumer)
+	require.Equal(t, []UsageDiscrepancy{
+		{Epoch: 20, ChainID: "LAV1", Provider: "provider2", ClaimedCu: 40, SignedCu: 10, Sessions: 1},
+		{Epoch: 20, ChainID: "LAV1", Provider: "provider3", ClaimedCu: 10, SignedCu: 0, Sessions: 1},
+	}, evidence.Discrepancies)
+	require.Equal(t, []lavasession.ConsumerUsageRecord{records[2]}, evidence.Usage)
+}
+
+func TestFilterUsageEpochs(t *testing.T) {
+	records := []lavasession.ConsumerUsageRecord{{Epoch: 20}, {Epoch: 40}, {Epoch: 60}}
+	require.Len(t, filterUsageEpochs(records, 0, 0), 3)
+	require.Equal(t, []lavasession.ConsumerUsageRecord{{Epoch: 40}}, filterUsageEpochs(records, 30, 50))
+	require.Equal(t, []lavasession.ConsumerUsageRecord{{Epoch: 40}, {Epoch: 60}}, filterUsageEpochs(records, 40, 0))
+}
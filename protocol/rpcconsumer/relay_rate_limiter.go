@@ -0,0 +1,44 @@
+package rpcconsumer
+
+import (
+	"sync"
+	"time"
+)
+
+// relayRateLimiter is a token bucket of the relays an endpoint accepts per second, a nil limiter allows every relay
+type relayRateLimiter struct {
+	lock            sync.Mutex
+	relaysPerSecond uint64
+	tokens          float64
+	lastRefill      time.Time
+	now             func() time.Time
+}
+
+// newRelayRateLimiter returns nil when relaysPerSecond is 0
+func newRelayRateLimiter(relaysPerSecond uint64, now func() time.Time) *relayRateLimiter {
+	if relaysPerSecond == 0 {
+		return nil
+	}
+	return &relayRateLimiter{relaysPerSecond: relaysPerSecond, tokens: float64(relaysPerSecond), lastRefill: now(), now: now}
+}
+
+// allow takes a token for a relay, returning false when the endpoint already used its relays of the last second
+func (rl *relayRateLimiter) allow() bool {
+	if rl == nil {
+		return true
+	}
+	rl.lock.Lock()
+	defer rl.lock.Unlock()
+	now := rl.now()
+	limit := float64(rl.relaysPerSecond)
+	rl.tokens += now.Sub(rl.lastRefill).Seconds() * limit
+	if rl.tokens > limit {
+		rl.tokens = limit
+	}
+	rl.lastRefill = now
+	if rl.tokens < 1 {
+		return false
+	}
+	rl.tokens--
+	return true
+}
@@ -0,0 +1,31 @@
+package rpcconsumer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRelayRateLimiter(t *testing.T) {
+	require.Nil(t, newRelayRateLimiter(0, time.Now))
+	var unlimited *relayRateLimiter
+	require.True(t, unlimited.allow())
+
+	now := time.Now()
+	rl := newRelayRateLimiter(2, func() time.Time { return now })
+	require.True(t, rl.allow())
+	require.True(t, rl.allow())
+	require.False(t, rl.allow())
+
+	// half a second refills one relay
+	now = now.Add(500 * time.Millisecond)
+	require.True(t, rl.allow())
+	require.False(t, rl.allow())
+
+	// an idle limiter doesn't accumulate more than a second of relays
+	now = now.Add(10 * time.Second)
+	require.True(t, rl.allow())
+	require.True(t, rl.allow())
+	require.False(t, rl.allow())
+}
@@ -9,6 +9,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"syscall"
 	"time"
 
 	"github.com/coniks-sys/coniks-go/crypto/vrf"
@@ -22,9 +23,11 @@ import (
 	commonlib "github.com/lavanet/lava/protocol/common"
 	"github.com/lavanet/lava/protocol/lavaprotocol"
 	"github.com/lavanet/lava/protocol/lavasession"
+	"github.com/lavanet/lava/protocol/metrics"
 	"github.com/lavanet/lava/protocol/performance"
 	"github.com/lavanet/lava/protocol/provideroptimizer"
 	"github.com/lavanet/lava/protocol/statetracker"
+	"github.com/lavanet/lava/protocol/tracing"
 	"github.com/lavanet/lava/utils"
 	"github.com/lavanet/lava/utils/sigs"
 	conflicttypes "github.com/lavanet/lava/x/conflict/types"
@@ -39,17 +42,49 @@ var (
 
 type ConsumerStateTrackerInf interface {
 	RegisterConsumerSessionManagerForPairingUpdates(ctx context.Context, consumerSessionManager *lavasession.ConsumerSessionManager)
+	UnregisterConsumerSessionManagerForPairingUpdates(ctx context.Context, consumerSessionManager *lavasession.ConsumerSessionManager)
 	RegisterChainParserForSpecUpdates(ctx context.Context, chainParser chainlib.ChainParser, chainID string) error
 	RegisterFinalizationConsensusForUpdates(context.Context, *lavaprotocol.FinalizationConsensus)
+	UnregisterFinalizationConsensusForUpdates(ctx context.Context, finalizationConsensus *lavaprotocol.FinalizationConsensus)
+	RegisterProjectCuBudgetForUpdates(ctx context.Context, budget *lavasession.ProjectCuBudget, chainID string)
 	TxConflictDetection(ctx context.Context, finalizationConflict *conflicttypes.FinalizationConflict, responseConflict *conflicttypes.ResponseConflict, sameProviderConflict *conflicttypes.FinalizationConflict) error
 }
 
 type RPCConsumer struct {
 	consumerStateTracker ConsumerStateTrackerInf
+	qosTracker           *metrics.ProviderQoSTracker // set when the qos dashboard is served
+	maxReplyClockSkew    time.Duration
+	sessionIdleTimeout   time.Duration                  // sessions locked for longer without their relay reporting on them are reclaimed, 0 never reclaims them
+	providerTLS          *lavasession.ProviderTLSConfig // providers are dialed over tls when set
+	signerBackend        string
+	remoteSignerAddress  string                            // used by the remote signer backend
+	badgeServerAddress   string                            // relays are signed with badges of this server instead of the consumer key
+	badgeServerToken     string                            // authenticates the badge requests
+	usageStore           lavasession.ConsumerUsageStore    // records the cu signed to the providers, nil when it isn't persisted
+	reloadSettings       func() (*ConsumerSettings, error) // reads the config again on SIGHUP, nil when there is no config to reload
+	configWatchInterval  time.Duration                     // how often the config file is checked for changes, 0 reloads on SIGHUP only
+	requiredResponses    int
+	signer               lavaprotocol.Signer
+	vrfSk                vrf.PrivateKey
+	lavaChainID          string
+	debugRelays          bool
+	endpointsLock        sync.Mutex // guards the running endpoints and the settings they were started with
+	endpoints            map[string]*consumerEndpoint
+	settings             *ConsumerSettings
+	cache                *performance.Cache
+}
+
+// consumerEndpoint is a served endpoint, stopping it closes its listener and subscriptions
+type consumerEndpoint struct {
+	endpoint               *lavasession.RPCEndpoint
+	server                 *RPCConsumerServer
+	consumerSessionManager *lavasession.ConsumerSessionManager
+	finalizationConsensus  *lavaprotocol.FinalizationConsensus
+	cancel                 context.CancelFunc
 }
 
 // spawns a new RPCConsumer server with all it's processes and internals ready for communications
-func (rpcc *RPCConsumer) Start(ctx context.Context, txFactory tx.Factory, clientCtx client.Context, rpcEndpoints []*lavasession.RPCEndpoint, requiredResponses int, vrf_sk vrf.PrivateKey, cache *performance.Cache) (err error) {
+func (rpcc *RPCConsumer) Start(ctx context.Context, txFactory tx.Factory, clientCtx client.Context, settings *ConsumerSettings, requiredResponses int, vrf_sk vrf.PrivateKey, debugRelays bool, cache *performance.Cache) (err error) {
 	if commonlib.IsTestMode(ctx) {
 		testModeWarn("RPCConsumer running tests")
 	}
@@ -65,10 +100,6 @@ func (rpcc *RPCConsumer) Start(ctx context.Context, txFactory tx.Factory, client
 	if err != nil {
 		utils.LavaFormatFatal("failed getting key name from clientCtx", err)
 	}
-	privKey, err := sigs.GetPrivKey(clientCtx, keyName)
-	if err != nil {
-		utils.LavaFormatFatal("failed getting private key from key name", err, utils.Attribute{Key: "keyName", Value: keyName})
-	}
 	clientKey, _ := clientCtx.Keyring.Key(keyName)
 
 	var addr sdk.AccAddress
@@ -76,60 +107,249 @@ func (rpcc *RPCConsumer) Start(ctx context.Context, txFactory tx.Factory, client
 	if err != nil {
 		utils.LavaFormatFatal("failed unmarshaling public address", err, utils.Attribute{Key: "keyName", Value: keyName}, utils.Attribute{Key: "pubkey", Value: clientKey.GetPubKey().Address()})
 	}
+	var signer lavaprotocol.Signer
+	if rpcc.badgeServerAddress != "" {
+		// relays are signed with a key of this process and paired and paid as relays of the project granting the badges,
+		// the --from key only sends conflict transactions
+		badgeSigner, err := lavaprotocol.NewBadgeSigner(ctx, rpcc.badgeServerAddress, rpcc.badgeServerToken)
+		if err != nil {
+			utils.LavaFormatFatal("failed creating badge signer", err, utils.Attribute{Key: "badgeServer", Value: rpcc.badgeServerAddress})
+		}
+		consumerStateTracker.SetPairingAddress(badgeSigner.ProjectAddress())
+		utils.LavaFormatInfo("RPCConsumer relaying with badges", utils.Attribute{Key: "project", Value: badgeSigner.ProjectAddress()}, utils.Attribute{Key: "badgeKey", Value: lavaprotocol.SignerAddress(badgeSigner)})
+		signer = badgeSigner
+	} else {
+		signer, err = lavaprotocol.NewSigner(ctx, clientCtx, keyName, rpcc.signerBackend, rpcc.remoteSignerAddress)
+		if err != nil {
+			utils.LavaFormatFatal("failed creating relay signer", err, utils.Attribute{Key: "keyName", Value: keyName}, utils.Attribute{Key: "signer", Value: rpcc.signerBackend})
+		}
+		if signerAddress := lavaprotocol.SignerAddress(signer); !signerAddress.Equals(addr) {
+			// providers pair relays to the signing consumer, a signer with another key would have all relays rejected
+			utils.LavaFormatFatal("relay signer key doesn't match the consumer key", nil, utils.Attribute{Key: "signerAddress", Value: signerAddress}, utils.Attribute{Key: "consumerAddress", Value: addr})
+		}
+	}
 
-	var wg sync.WaitGroup
-	parallelJobs := len(rpcEndpoints)
-	wg.Add(parallelJobs)
-	errCh := make(chan error)
+	rpcc.requiredResponses = requiredResponses
+	rpcc.signer = signer
+	rpcc.vrfSk = vrf_sk
+	rpcc.lavaChainID = lavaChainID
+	rpcc.debugRelays = debugRelays
+	rpcc.cache = cache
+	rpcc.settings = settings
+	rpcc.endpoints = map[string]*consumerEndpoint{}
 
 	utils.LavaFormatInfo("RPCConsumer pubkey: " + addr.String())
-	utils.LavaFormatInfo("RPCConsumer setting up endpoints", utils.Attribute{Key: "length", Value: strconv.Itoa(parallelJobs)})
+	utils.LavaFormatInfo("RPCConsumer setting up endpoints", utils.Attribute{Key: "length", Value: strconv.Itoa(len(settings.Endpoints))})
+	rpcc.endpointsLock.Lock()
+	err = rpcc.startEndpoints(ctx, settings.Endpoints)
+	rpcc.endpointsLock.Unlock()
+	if err != nil {
+		return err
+	}
+
+	utils.LavaFormatInfo("RPCConsumer done setting up all endpoints, ready for requests")
+
+	configChanged := make(chan struct{}, 1)
+	if rpcc.configWatchInterval > 0 && rpcc.reloadSettings != nil {
+		go watchConfigFile(ctx, viper.ConfigFileUsed(), rpcc.configWatchInterval, configChanged)
+	}
+	signalChan := make(chan os.Signal, 1)
+	signal.Notify(signalChan, os.Interrupt, syscall.SIGHUP)
+	for {
+		select {
+		case sig := <-signalChan:
+			if sig != syscall.SIGHUP {
+				rpcc.stopEndpoints(ctx)
+				return nil
+			}
+			rpcc.reload(ctx)
+		case <-configChanged:
+			rpcc.reload(ctx)
+		}
+	}
+}
+
+// startEndpoints serves the endpoints in parallel, endpoints that failed aren't served. must be called with endpointsLock held
+func (rpcc *RPCConsumer) startEndpoints(ctx context.Context, rpcEndpoints []*lavasession.RPCEndpoint) error {
+	var wg sync.WaitGroup
+	var lock sync.Mutex
+	var errs []error
 	for _, rpcEndpoint := range rpcEndpoints {
-		go func(rpcEndpoint *lavasession.RPCEndpoint) error {
+		wg.Add(1)
+		go func(rpcEndpoint *lavasession.RPCEndpoint) {
 			defer wg.Done()
-			strategy := provideroptimizer.STRATEGY_QOS
-			optimizer := provideroptimizer.NewProviderOptimizer(strategy)
-			consumerSessionManager := lavasession.NewConsumerSessionManager(rpcEndpoint, optimizer)
-			rpcc.consumerStateTracker.RegisterConsumerSessionManagerForPairingUpdates(ctx, consumerSessionManager)
-			chainParser, err := chainlib.NewChainParser(rpcEndpoint.ApiInterface)
+			started, err := rpcc.startEndpoint(ctx, rpcEndpoint)
+			lock.Lock()
+			defer lock.Unlock()
 			if err != nil {
-				err = utils.LavaFormatError("failed creating chain parser", err, utils.Attribute{Key: "endpoint", Value: rpcEndpoint})
-				errCh <- err
-				return err
+				errs = append(errs, err)
+				return
 			}
-			err = consumerStateTracker.RegisterChainParserForSpecUpdates(ctx, chainParser, rpcEndpoint.ChainID)
-			if err != nil {
-				err = utils.LavaFormatError("failed registering for spec updates", err, utils.Attribute{Key: "endpoint", Value: rpcEndpoint})
-				errCh <- err
-				return err
-			}
-			finalizationConsensus := &lavaprotocol.FinalizationConsensus{}
-			consumerStateTracker.RegisterFinalizationConsensusForUpdates(ctx, finalizationConsensus)
-			rpcConsumerServer := &RPCConsumerServer{}
-			utils.LavaFormatInfo("RPCConsumer Listening", utils.Attribute{Key: "endpoints", Value: rpcEndpoint.String()})
-			err = rpcConsumerServer.ServeRPCRequests(ctx, rpcEndpoint, rpcc.consumerStateTracker, chainParser, finalizationConsensus, consumerSessionManager, requiredResponses, privKey, vrf_sk, lavaChainID, cache)
-			if err != nil {
-				err = utils.LavaFormatError("failed serving rpc requests", err, utils.Attribute{Key: "endpoint", Value: rpcEndpoint})
-				errCh <- err
-				return err
-			}
-			return nil
+			rpcc.endpoints[endpointConfigKey(rpcEndpoint, &rpcc.settings.Listener)] = started
 		}(rpcEndpoint)
 	}
-
 	wg.Wait()
-	close(errCh)
+	if len(errs) > 0 {
+		return errs[0]
+	}
+	return nil
+}
 
-	for err := range errCh {
-		return err
+func (rpcc *RPCConsumer) startEndpoint(ctx context.Context, rpcEndpoint *lavasession.RPCEndpoint) (*consumerEndpoint, error) {
+	strategy := provideroptimizer.STRATEGY_QOS
+	optimizer := provideroptimizer.NewProviderOptimizer(strategy)
+	consumerSessionManager := lavasession.NewConsumerSessionManager(rpcEndpoint, optimizer)
+	// a provider whose clock is off by more than its signed reply timestamps are allowed to be is blocked from probes on
+	consumerSessionManager.SetMaxClockSkew(rpcc.maxReplyClockSkew)
+	consumerSessionManager.SetSessionIdleTimeout(rpcc.sessionIdleTimeout)
+	consumerSessionManager.SetProviderTLS(rpcc.providerTLS)
+	if rpcc.usageStore != nil {
+		consumerSessionManager.SetUsageStore(rpcc.usageStore)
+	}
+	if rpcc.qosTracker != nil {
+		consumerSessionManager.SetProviderQoSMetrics(rpcc.qosTracker)
 	}
+	// state updates are shared between the endpoints, so they are registered with the consumer's context and not the endpoint's
+	rpcc.consumerStateTracker.RegisterConsumerSessionManagerForPairingUpdates(ctx, consumerSessionManager)
+	chainParser, err := chainlib.NewChainParser(rpcEndpoint.ApiInterface)
+	if err != nil {
+		rpcc.consumerStateTracker.UnregisterConsumerSessionManagerForPairingUpdates(ctx, consumerSessionManager)
+		return nil, utils.LavaFormatError("failed creating chain parser", err, utils.Attribute{Key: "endpoint", Value: rpcEndpoint})
+	}
+	err = rpcc.consumerStateTracker.RegisterChainParserForSpecUpdates(ctx, chainParser, rpcEndpoint.ChainID)
+	if err != nil {
+		rpcc.consumerStateTracker.UnregisterConsumerSessionManagerForPairingUpdates(ctx, consumerSessionManager)
+		return nil, utils.LavaFormatError("failed registering for spec updates", err, utils.Attribute{Key: "endpoint", Value: rpcEndpoint})
+	}
+	finalizationConsensus := &lavaprotocol.FinalizationConsensus{}
+	rpcc.consumerStateTracker.RegisterFinalizationConsensusForUpdates(ctx, finalizationConsensus)
+	projectCuBudget := lavasession.NewProjectCuBudget(consumerSessionManager.Clock())
+	rpcConsumerServer := &RPCConsumerServer{maxReplyClockSkew: rpcc.maxReplyClockSkew, projectCuBudget: projectCuBudget}
+	rpcConsumerServer.UpdateSettings(rpcc.cache, rpcc.settings.MaxRelayRetries, rpcc.settings.RelaysPerSecond, rpcc.settings.GasOracleProviders)
+	endpointCtx, cancel := context.WithCancel(ctx)
+	// the budget of a stopped endpoint isn't updated anymore
+	rpcc.consumerStateTracker.RegisterProjectCuBudgetForUpdates(endpointCtx, projectCuBudget, rpcEndpoint.ChainID)
+	utils.LavaFormatInfo("RPCConsumer Listening", utils.Attribute{Key: "endpoints", Value: rpcEndpoint.String()})
+	err = rpcConsumerServer.ServeRPCRequests(endpointCtx, rpcEndpoint, rpcc.consumerStateTracker, chainParser, finalizationConsensus, consumerSessionManager, rpcc.requiredResponses, rpcc.signer, rpcc.vrfSk, rpcc.lavaChainID, rpcc.debugRelays, rpcc.cache, &rpcc.settings.Listener)
+	if err != nil {
+		cancel()
+		rpcc.consumerStateTracker.UnregisterConsumerSessionManagerForPairingUpdates(ctx, consumerSessionManager)
+		rpcc.consumerStateTracker.UnregisterFinalizationConsensusForUpdates(ctx, finalizationConsensus)
+		return nil, utils.LavaFormatError("failed serving rpc requests", err, utils.Attribute{Key: "endpoint", Value: rpcEndpoint})
+	}
+	return &consumerEndpoint{endpoint: rpcEndpoint, server: rpcConsumerServer, consumerSessionManager: consumerSessionManager, finalizationConsensus: finalizationConsensus, cancel: cancel}, nil
+}
 
-	utils.LavaFormatInfo("RPCConsumer done setting up all endpoints, ready for requests")
+func (rpcc *RPCConsumer) stopEndpoint(ctx context.Context, running *consumerEndpoint) {
+	running.cancel()
+	rpcc.consumerStateTracker.UnregisterConsumerSessionManagerForPairingUpdates(ctx, running.consumerSessionManager)
+	rpcc.consumerStateTracker.UnregisterFinalizationConsensusForUpdates(ctx, running.finalizationConsensus)
+	running.consumerSessionManager.SaveUsage()
+	utils.LavaFormatInfo("RPCConsumer stopped listening", utils.Attribute{Key: "endpoint", Value: running.endpoint.String()})
+}
 
-	signalChan := make(chan os.Signal, 1)
-	signal.Notify(signalChan, os.Interrupt)
-	<-signalChan
-	return nil
+// stopEndpoints stops every served endpoint before the consumer exits, which also records the cu signed in their pairings
+func (rpcc *RPCConsumer) stopEndpoints(ctx context.Context) {
+	rpcc.endpointsLock.Lock()
+	defer rpcc.endpointsLock.Unlock()
+	for key, running := range rpcc.endpoints {
+		rpcc.stopEndpoint(ctx, running)
+		delete(rpcc.endpoints, key)
+	}
+}
+
+// reload applies the config again, a config that fails to load keeps the running settings
+func (rpcc *RPCConsumer) reload(ctx context.Context) {
+	if rpcc.reloadSettings == nil {
+		utils.LavaFormatWarning("no config file to reload, endpoints were set in the command arguments", nil)
+		return
+	}
+	settings, err := rpcc.reloadSettings()
+	if err != nil {
+		utils.LavaFormatError("failed reloading the config, keeping the running settings", err)
+		return
+	}
+	rpcc.applySettings(ctx, settings)
+}
+
+// applySettings re-creates only what changed: endpoints whose settings changed are replaced while the others keep serving,
+// with their open subscriptions, and get the new retry policy, rate limit and cache
+func (rpcc *RPCConsumer) applySettings(ctx context.Context, settings *ConsumerSettings) {
+	rpcc.endpointsLock.Lock()
+	defer rpcc.endpointsLock.Unlock()
+
+	if settings.CacheAddress != rpcc.settings.CacheAddress {
+		var cache *performance.Cache
+		var err error
+		if settings.CacheAddress != "" {
+			cache, err = performance.InitCache(ctx, settings.CacheAddress)
+		}
+		if err != nil {
+			utils.LavaFormatError("failed connecting to the reloaded cache address, keeping the running cache", err, utils.Attribute{Key: "address", Value: settings.CacheAddress})
+			settings.CacheAddress = rpcc.settings.CacheAddress
+		} else {
+			utils.LavaFormatInfo("cache address reloaded", utils.Attribute{Key: "address", Value: settings.CacheAddress})
+			rpcc.cache = cache
+		}
+	}
+	rpcc.settings = settings
+
+	wantedEndpoints := map[string]*lavasession.RPCEndpoint{}
+	for _, endpoint := range settings.Endpoints {
+		wantedEndpoints[endpointConfigKey(endpoint, &settings.Listener)] = endpoint
+	}
+	// removed and changed endpoints are stopped first, so their replacements can listen on the same address
+	stopped := 0
+	for key, running := range rpcc.endpoints {
+		if _, ok := wantedEndpoints[key]; !ok {
+			rpcc.stopEndpoint(ctx, running)
+			delete(rpcc.endpoints, key)
+			stopped++
+		}
+	}
+	for _, running := range rpcc.endpoints {
+		running.server.UpdateSettings(rpcc.cache, settings.MaxRelayRetries, settings.RelaysPerSecond, settings.GasOracleProviders)
+	}
+	addedEndpoints := []*lavasession.RPCEndpoint{}
+	for key, endpoint := range wantedEndpoints {
+		if _, ok := rpcc.endpoints[key]; !ok {
+			addedEndpoints = append(addedEndpoints, endpoint)
+		}
+	}
+	err := rpcc.startEndpoints(ctx, addedEndpoints)
+	if err != nil {
+		utils.LavaFormatError("failed starting reloaded endpoints", err)
+	}
+	utils.LavaFormatInfo("RPCConsumer config reloaded", utils.Attribute{Key: "stopped", Value: stopped}, utils.Attribute{Key: "started", Value: len(addedEndpoints)}, utils.Attribute{Key: "serving", Value: len(rpcc.endpoints)})
+}
+
+// watchConfigFile signals configChanged when the modification time of the config file changes, checking every interval
+func watchConfigFile(ctx context.Context, configFile string, interval time.Duration, configChanged chan<- struct{}) {
+	if configFile == "" {
+		return
+	}
+	modTime := time.Time{}
+	if info, err := os.Stat(configFile); err == nil {
+		modTime = info.ModTime()
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			info, err := os.Stat(configFile)
+			if err != nil || info.ModTime().Equal(modTime) {
+				continue
+			}
+			modTime = info.ModTime()
+			select {
+			case configChanged <- struct{}{}:
+			default: // a reload is already pending
+			}
+		}
+	}
 }
 
 func ParseEndpoints(viper_endpoints *viper.Viper, geolocation uint64) (endpoints []*lavasession.RPCEndpoint, err error) {
@@ -182,7 +402,6 @@ rpcconsumer 127.0.0.1:3333 COS3 tendermintrpc 127.0.0.1:3334 COS3 rest <flags>`,
 			viper.AddConfigPath(".")
 			viper.AddConfigPath("./config")
 			viper.AddConfigPath(app.DefaultNodeHome)
-			var rpcEndpoints []*lavasession.RPCEndpoint
 			var endpoints_strings []string
 			var viper_endpoints *viper.Viper
 			if len(args) > 1 {
@@ -208,8 +427,12 @@ rpcconsumer 127.0.0.1:3333 COS3 tendermintrpc 127.0.0.1:3334 COS3 rest <flags>`,
 			if err != nil {
 				utils.LavaFormatFatal("failed to read geolocation flag, required flag", err)
 			}
-			rpcEndpoints, err = ParseEndpoints(viper.GetViper(), geolocation)
-			if err != nil || len(rpcEndpoints) == 0 {
+			cacheAddr, err := cmd.Flags().GetString(performance.CacheFlagName)
+			if err != nil {
+				utils.LavaFormatError("Failed To Get Cache Address flag", err, utils.Attribute{Key: "flags", Value: cmd.Flags()})
+			}
+			settings, err := ParseConsumerSettings(viper.GetViper(), geolocation, cacheAddr)
+			if err != nil {
 				return utils.LavaFormatError("invalid endpoints definition", err, utils.Attribute{Key: "endpoint_strings", Value: strings.Join(endpoints_strings, "")})
 			}
 			// handle flags, pass necessary fields
@@ -223,6 +446,7 @@ rpcconsumer 127.0.0.1:3333 COS3 tendermintrpc 127.0.0.1:3334 COS3 rest <flags>`,
 				utils.LavaFormatFatal("failed to read log level flag", err)
 			}
 			utils.LoggingLevel(logLevel)
+			commonlib.ApplyLogConfig(viper.GetViper(), logLevel)
 
 			test_mode, err := cmd.Flags().GetBool(commonlib.TestModeFlagName)
 			if err != nil {
@@ -244,6 +468,14 @@ rpcconsumer 127.0.0.1:3333 COS3 tendermintrpc 127.0.0.1:3334 COS3 rest <flags>`,
 					return utils.LavaFormatError("failed to start pprof HTTP server", err)
 				}
 			}
+			lavaNodes, err := cmd.Flags().GetStringSlice(statetracker.LavaNodesFlagName)
+			if err != nil {
+				utils.LavaFormatFatal("failed to read lava nodes flag", err)
+			}
+			clientCtx, err = statetracker.WithLavaNodes(ctx, clientCtx, lavaNodes)
+			if err != nil {
+				return err
+			}
 			clientCtx = clientCtx.WithChainID(networkChainId)
 			txFactory := tx.NewFactoryCLI(clientCtx, cmd.Flags())
 			rpcConsumer := RPCConsumer{}
@@ -255,18 +487,114 @@ rpcconsumer 127.0.0.1:3333 COS3 tendermintrpc 127.0.0.1:3334 COS3 rest <flags>`,
 				utils.LavaFormatFatal("failed getting or creating a VRF key", err)
 			}
 			var cache *performance.Cache = nil
-			cacheAddr, err := cmd.Flags().GetString(performance.CacheFlagName)
-			if err != nil {
-				utils.LavaFormatError("Failed To Get Cache Address flag", err, utils.Attribute{Key: "flags", Value: cmd.Flags()})
-			} else if cacheAddr != "" {
-				cache, err = performance.InitCache(ctx, cacheAddr)
+			if settings.CacheAddress != "" {
+				cache, err = performance.InitCache(ctx, settings.CacheAddress)
+				if err != nil {
+					utils.LavaFormatError("Failed To Connect to cache at address", err, utils.Attribute{Key: "address", Value: settings.CacheAddress})
+				} else {
+					utils.LavaFormatInfo("cache service connected", utils.Attribute{Key: "address", Value: settings.CacheAddress})
+				}
+			} else if redisAddr, err := cmd.Flags().GetString(performance.CacheRedisFlagName); err == nil && redisAddr != "" {
+				cache, err = performance.InitRedisCache(redisAddr)
 				if err != nil {
-					utils.LavaFormatError("Failed To Connect to cache at address", err, utils.Attribute{Key: "address", Value: cacheAddr})
+					utils.LavaFormatError("Failed To Connect to redis cache", err, utils.Attribute{Key: "address", Value: redisAddr})
 				} else {
-					utils.LavaFormatInfo("cache service connected", utils.Attribute{Key: "address", Value: cacheAddr})
+					utils.LavaFormatInfo("redis cache connected", utils.Attribute{Key: "address", Value: redisAddr})
+				}
+			} else if useLocalCache, err := cmd.Flags().GetBool(performance.CacheLocalFlagName); err == nil && useLocalCache {
+				cache, err = performance.InitLocalCache()
+				if err != nil {
+					utils.LavaFormatError("Failed To create in-process cache", err)
+				} else {
+					utils.LavaFormatInfo("using in-process cache", utils.Attribute{Key: "maxCost", Value: performance.LocalCacheMaxCost})
+				}
+			}
+			if cacheAdminAddr, err := cmd.Flags().GetString(performance.CacheAdminListenFlagName); err == nil && cacheAdminAddr != "" && cache != nil {
+				err = performance.ServeCacheAdmin(ctx, cacheAdminAddr, cache)
+				if err != nil {
+					utils.LavaFormatError("Failed To serve cache admin endpoint", err, utils.Attribute{Key: "address", Value: cacheAdminAddr})
+				}
+			}
+			if qosDashboardAddress, err := cmd.Flags().GetString(metrics.QoSDashboardAddressFlagName); err == nil && qosDashboardAddress != "" {
+				rpcConsumer.qosTracker = metrics.NewProviderQoSTracker()
+				err = metrics.StartQoSDashboardServer(qosDashboardAddress, rpcConsumer.qosTracker)
+				if err != nil {
+					return utils.LavaFormatError("failed to start qos dashboard HTTP server", err)
 				}
 			}
-			err = rpcConsumer.Start(ctx, txFactory, clientCtx, rpcEndpoints, requiredResponses, vrf_sk, cache)
+			debugRelays, err := cmd.Flags().GetBool(commonlib.DebugRelaysFlagName)
+			if err != nil {
+				utils.LavaFormatFatal("failed to read debug relays flag", err)
+			}
+			if debugRelays {
+				utils.LavaFormatWarning("relay debugging enabled, users can force relays to a specific provider with the "+commonlib.PROVIDER_ADDRESS_HEADER_NAME+" header and get relay traces with the "+commonlib.DEBUG_HEADER_NAME+" header", nil)
+			}
+			rpcConsumer.maxReplyClockSkew, err = cmd.Flags().GetDuration(lavaprotocol.ReplyMaxClockSkewFlagName)
+			if err != nil {
+				utils.LavaFormatFatal("failed to read reply max clock skew flag", err)
+			}
+			rpcConsumer.sessionIdleTimeout, err = cmd.Flags().GetDuration(lavasession.SessionIdleTimeoutFlag)
+			if err != nil {
+				utils.LavaFormatFatal("failed to read session idle timeout flag", err)
+			}
+			rpcConsumer.providerTLS, err = providerTLSFromFlags(cmd)
+			if err != nil {
+				utils.LavaFormatFatal("failed loading the provider tls config", err)
+			}
+			rpcConsumer.signerBackend, err = cmd.Flags().GetString(lavaprotocol.SignerFlagName)
+			if err != nil {
+				utils.LavaFormatFatal("failed to read signer flag", err)
+			}
+			rpcConsumer.remoteSignerAddress, err = cmd.Flags().GetString(lavaprotocol.RemoteSignerAddressFlagName)
+			if err != nil {
+				utils.LavaFormatFatal("failed to read remote signer address flag", err)
+			}
+			rpcConsumer.badgeServerAddress, err = cmd.Flags().GetString(lavaprotocol.BadgeServerFlagName)
+			if err != nil {
+				utils.LavaFormatFatal("failed to read badge server flag", err)
+			}
+			rpcConsumer.badgeServerToken, err = cmd.Flags().GetString(lavaprotocol.BadgeServerTokenFlagName)
+			if err != nil {
+				utils.LavaFormatFatal("failed to read badge server token flag", err)
+			}
+			usageStorePath, err := cmd.Flags().GetString(lavasession.ConsumerUsageStoreFlag)
+			if err != nil {
+				utils.LavaFormatFatal("failed to read usage store path flag", err)
+			}
+			if usageStorePath != "" {
+				usageStore, err := lavasession.NewBoltConsumerUsageStore(usageStorePath)
+				if err != nil {
+					return err
+				}
+				defer usageStore.Close()
+				rpcConsumer.usageStore = usageStore
+				utils.LavaFormatInfo("recording the cu signed to providers", utils.Attribute{Key: "path", Value: usageStorePath})
+			}
+			if viper.ConfigFileUsed() != "" {
+				rpcConsumer.reloadSettings = func() (*ConsumerSettings, error) {
+					err := viper.ReadInConfig()
+					if err != nil {
+						return nil, err
+					}
+					commonlib.ApplyLogConfig(viper.GetViper(), logLevel)
+					return ParseConsumerSettings(viper.GetViper(), geolocation, cacheAddr)
+				}
+			}
+			rpcConsumer.configWatchInterval, err = cmd.Flags().GetDuration(ConfigWatchIntervalFlagName)
+			if err != nil {
+				utils.LavaFormatFatal("failed to read config watch interval flag", err)
+			}
+			tracingEndpoint, err := cmd.Flags().GetString(tracing.OTLPEndpointFlagName)
+			if err != nil {
+				utils.LavaFormatFatal("failed to read tracing endpoint flag", err)
+			}
+			tracingSampleRatio, err := cmd.Flags().GetFloat64(tracing.SampleRatioFlagName)
+			if err != nil {
+				utils.LavaFormatFatal("failed to read tracing sample ratio flag", err)
+			}
+			stopTracing := tracing.StartOTLPTracer(tracingEndpoint, "lava-rpcconsumer", tracingSampleRatio)
+			defer stopTracing()
+			err = rpcConsumer.Start(ctx, txFactory, clientCtx, settings, requiredResponses, vrf_sk, debugRelays, cache)
 			return err
 		},
 	}
@@ -275,16 +603,62 @@ rpcconsumer 127.0.0.1:3333 COS3 tendermintrpc 127.0.0.1:3334 COS3 rest <flags>`,
 	flags.AddTxFlagsToCmd(cmdRPCConsumer)
 	cmdRPCConsumer.MarkFlagRequired(flags.FlagFrom)
 	cmdRPCConsumer.Flags().String(flags.FlagChainID, app.Name, "network chain id")
+	cmdRPCConsumer.Flags().StringSlice(statetracker.LavaNodesFlagName, []string{}, "comma separated uris of lava nodes to fail over to and spread state queries between, in addition to --node")
 	cmdRPCConsumer.Flags().Uint64(commonlib.GeolocationFlag, 0, "geolocation to run from")
 	cmdRPCConsumer.MarkFlagRequired(commonlib.GeolocationFlag)
 	cmdRPCConsumer.Flags().Bool("secure", false, "secure sends reliability on every message")
 	cmdRPCConsumer.Flags().Bool(commonlib.TestModeFlagName, false, "test mode causes rpcconsumer to send dummy data and print all of the metadata in it's listeners")
 	cmdRPCConsumer.Flags().String(performance.PprofAddressFlagName, "", "pprof server address, used for code profiling")
+	cmdRPCConsumer.Flags().String(metrics.QoSDashboardAddressFlagName, "", "address to serve recent per provider QoS as json on /qos, for diagnosing degrading providers")
 	cmdRPCConsumer.Flags().String(performance.CacheFlagName, "", "address for a cache server to improve performance")
+	cmdRPCConsumer.Flags().String(performance.CacheRedisFlagName, "", "comma separated redis addresses to share the cache between processes, two or more addresses connect to a redis cluster")
+	cmdRPCConsumer.Flags().Bool(performance.CacheLocalFlagName, false, "use an in-process cache when no cache server address is set")
+	cmdRPCConsumer.Flags().String(performance.CacheAdminListenFlagName, "", "address to serve the cache admin grpc endpoints on: stats, flush by chain and hot keys")
+	cmdRPCConsumer.Flags().Duration(lavaprotocol.ReplyMaxClockSkewFlagName, lavaprotocol.DefaultReplyMaxClockSkew, "allowed clock difference from providers when verifying the timestamp they sign on replies, 0 disables the check")
+	addProviderTLSFlags(cmdRPCConsumer)
+	cmdRPCConsumer.Flags().Duration(lavasession.SessionIdleTimeoutFlag, lavasession.DefaultSessionIdleTimeout, "how long a provider session can stay locked by a relay that never finished before it is reclaimed with its cu, 0 disables reclaiming")
+	cmdRPCConsumer.Flags().String(lavaprotocol.SignerFlagName, lavaprotocol.LocalSignerBackend, "how relays are signed: "+lavaprotocol.LocalSignerBackend+" keeps the --from key in memory, "+lavaprotocol.KeyringSignerBackend+" signs with the keyring without exporting the key, "+lavaprotocol.RemoteSignerBackend+" requests signatures from --"+lavaprotocol.RemoteSignerAddressFlagName)
+	cmdRPCConsumer.Flags().String(lavaprotocol.RemoteSignerAddressFlagName, "", "grpc address of a relay signer service holding the consumer key, such as in an HSM")
+	cmdRPCConsumer.Flags().String(lavaprotocol.BadgeServerFlagName, "", "url of a badge server, relays are paid by the project granting its badges instead of the --from key")
+	cmdRPCConsumer.Flags().String(lavaprotocol.BadgeServerTokenFlagName, "", "token authenticating the consumer to the badge server")
+	cmdRPCConsumer.Flags().String(lavasession.ConsumerUsageStoreFlag, "", "path of a file to record the cu signed to each provider per epoch in, for reconciling it with the relay payments providers claim with consumer-reconcile")
+	cmdRPCConsumer.Flags().Duration(ConfigWatchIntervalFlagName, 0, "how often to check the config file for changes and reload it, 0 reloads only on SIGHUP")
+	cmdRPCConsumer.Flags().String(tracing.OTLPEndpointFlagName, "", "OpenTelemetry collector OTLP/HTTP endpoint to export relay traces to, such as http://localhost:4318, traces are propagated to the providers")
+	cmdRPCConsumer.Flags().Float64(tracing.SampleRatioFlagName, 1, "fraction of the relays to trace, between 0 and 1")
+	cmdRPCConsumer.Flags().Bool(commonlib.DebugRelaysFlagName, false, "allows forcing relays to a specific provider in the pairing with the "+commonlib.PROVIDER_ADDRESS_HEADER_NAME+" header, and replying with a trace of the relay in the "+commonlib.DEBUG_TRACE_HEADER_NAME+" header to http and grpc requests with the "+commonlib.DEBUG_HEADER_NAME+": true header, used for debugging")
 
 	return cmdRPCConsumer
 }
 
+// addProviderTLSFlags adds the flags of how provider endpoints are dialed, read with providerTLSFromFlags
+func addProviderTLSFlags(cmd *cobra.Command) {
+	cmd.Flags().Bool(lavasession.ProviderTLSFlag, false, "dial the provider endpoints over tls, all providers of the pairing must serve it")
+	cmd.Flags().String(lavasession.ProviderTLSCAFileFlag, "", "pem file of the certificate authorities provider certificates are verified with, the system ones by default")
+	cmd.Flags().String(lavasession.ProviderTLSPinsFileFlag, "", "json file of provider addresses to the hex sha256 of the public keys their certificates may have, a pinned provider is verified by its pins alone")
+	cmd.Flags().Bool(lavasession.ProviderTLSRequireIdentityFlag, false, "require provider certificates to carry the URI SAN "+lavasession.ProviderIdentityURIPrefix+"<provider address>, so an endpoint can't serve another provider's pairing")
+}
+
+// providerTLSFromFlags returns the tls config provider endpoints are dialed with, nil when they're dialed in plaintext
+func providerTLSFromFlags(cmd *cobra.Command) (*lavasession.ProviderTLSConfig, error) {
+	providerTLS, err := cmd.Flags().GetBool(lavasession.ProviderTLSFlag)
+	if err != nil || !providerTLS {
+		return nil, err
+	}
+	caFile, err := cmd.Flags().GetString(lavasession.ProviderTLSCAFileFlag)
+	if err != nil {
+		return nil, err
+	}
+	pinsFile, err := cmd.Flags().GetString(lavasession.ProviderTLSPinsFileFlag)
+	if err != nil {
+		return nil, err
+	}
+	requireIdentity, err := cmd.Flags().GetBool(lavasession.ProviderTLSRequireIdentityFlag)
+	if err != nil {
+		return nil, err
+	}
+	return lavasession.LoadProviderTLSConfig(caFile, pinsFile, requireIdentity)
+}
+
 func testModeWarn(desc string) {
 	utils.LavaFormatWarning("------------------------------test mode --------------------------------\n\t\t\t"+
 		desc+"\n\t\t\t"+
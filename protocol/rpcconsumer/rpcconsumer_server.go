@@ -5,20 +5,26 @@ import (
 	"encoding/binary"
 	"errors"
 	"strconv"
+	"sync"
 	"time"
 
-	"github.com/btcsuite/btcd/btcec"
 	"github.com/coniks-sys/coniks-go/crypto/vrf"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/gogo/protobuf/proto"
 	"github.com/lavanet/lava/protocol/chainlib"
 	"github.com/lavanet/lava/protocol/common"
 	"github.com/lavanet/lava/protocol/lavaprotocol"
 	"github.com/lavanet/lava/protocol/lavasession"
 	"github.com/lavanet/lava/protocol/metrics"
 	"github.com/lavanet/lava/protocol/performance"
+	"github.com/lavanet/lava/protocol/tracing"
 	"github.com/lavanet/lava/utils"
+	"github.com/lavanet/lava/utils/sigs"
 	conflicttypes "github.com/lavanet/lava/x/conflict/types"
 	pairingtypes "github.com/lavanet/lava/x/pairing/types"
 	spectypes "github.com/lavanet/lava/x/spec/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 )
 
 const (
@@ -31,13 +37,23 @@ type RPCConsumerServer struct {
 	consumerSessionManager *lavasession.ConsumerSessionManager
 	listenEndpoint         *lavasession.RPCEndpoint
 	rpcConsumerLogs        *common.RPCConsumerLogs
-	cache                  *performance.Cache
-	privKey                *btcec.PrivateKey
+	signer                 lavaprotocol.Signer
 	consumerTxSender       ConsumerTxSender
 	requiredResponses      int
 	finalizationConsensus  *lavaprotocol.FinalizationConsensus
 	VrfSk                  vrf.PrivateKey
 	lavaChainID            string
+	debugRelays            bool
+	relayCoalescer         *performance.RelayCoalescer
+	maxReplyClockSkew      time.Duration                  // provider reply timestamps further than this from the relay time are rejected
+	relayTimeoutModel      *lavasession.RelayTimeoutModel // adapts the cu based relay timeouts to the latencies measured per api
+	settingsLock           sync.RWMutex                   // guards the settings below, which are replaced when the config is reloaded
+	cache                  *performance.Cache
+	maxRelayRetries        int
+	relayRateLimiter       *relayRateLimiter
+	gasOracleProviders     int
+	projectCuBudget        *lavasession.ProjectCuBudget // relays over the project's cu allowance are rejected before reaching a provider, nil allows all
+	cacheConflicts         *cacheConflicts              // cached replies a conflict was reported on, they aren't trusted anymore
 }
 
 type ConsumerTxSender interface {
@@ -50,14 +66,22 @@ func (rpccs *RPCConsumerServer) ServeRPCRequests(ctx context.Context, listenEndp
 	finalizationConsensus *lavaprotocol.FinalizationConsensus,
 	consumerSessionManager *lavasession.ConsumerSessionManager,
 	requiredResponses int,
-	privKey *btcec.PrivateKey,
+	signer lavaprotocol.Signer,
 	vrfSk vrf.PrivateKey,
 	lavaChainID string,
+	debugRelays bool,
 	cache *performance.Cache, // optional
+	listenerConfig *chainlib.ListenerConfig, // optional
 ) (err error) {
 	rpccs.consumerSessionManager = consumerSessionManager
 	rpccs.listenEndpoint = listenEndpoint
 	rpccs.cache = cache
+	if rpccs.maxRelayRetries == 0 {
+		rpccs.maxRelayRetries = MaxRelayRetries
+	}
+	rpccs.relayCoalescer = performance.NewRelayCoalescer()
+	rpccs.relayTimeoutModel = lavasession.NewRelayTimeoutModel()
+	rpccs.cacheConflicts = newCacheConflicts()
 	rpccs.consumerTxSender = consumerStateTracker
 	rpccs.requiredResponses = requiredResponses
 	rpccs.VrfSk = vrfSk
@@ -66,11 +90,13 @@ func (rpccs *RPCConsumerServer) ServeRPCRequests(ctx context.Context, listenEndp
 		utils.LavaFormatFatal("failed creating RPCConsumer logs", err)
 	}
 	rpccs.lavaChainID = lavaChainID
+	rpccs.debugRelays = debugRelays
 	rpccs.rpcConsumerLogs = pLogs
-	rpccs.privKey = privKey
+	rpccs.signer = signer
 	rpccs.chainParser = chainParser
 	rpccs.finalizationConsensus = finalizationConsensus
-	chainListener, err := chainlib.NewChainListener(ctx, listenEndpoint, rpccs, pLogs)
+	finalizationConsensus.SetForkDetectedCallback(rpccs.onForkDetected)
+	chainListener, err := chainlib.NewChainListener(ctx, listenEndpoint, rpccs, pLogs, listenerConfig)
 	if err != nil {
 		return err
 	}
@@ -78,6 +104,60 @@ func (rpccs *RPCConsumerServer) ServeRPCRequests(ctx context.Context, listenEndp
 	return nil
 }
 
+// UpdateSettings applies reloaded settings to the relays sent from now on, relays in flight and open subscriptions are unaffected.
+// the rate limit is restarted only when relaysPerSecond changed
+func (rpccs *RPCConsumerServer) UpdateSettings(cache *performance.Cache, maxRelayRetries int, relaysPerSecond uint64, gasOracleProviders int) {
+	rpccs.settingsLock.Lock()
+	defer rpccs.settingsLock.Unlock()
+	rpccs.cache = cache
+	rpccs.maxRelayRetries = maxRelayRetries
+	rpccs.gasOracleProviders = gasOracleProviders
+	currentRelaysPerSecond := uint64(0)
+	if rpccs.relayRateLimiter != nil {
+		currentRelaysPerSecond = rpccs.relayRateLimiter.relaysPerSecond
+	}
+	if currentRelaysPerSecond != relaysPerSecond {
+		rpccs.relayRateLimiter = newRelayRateLimiter(relaysPerSecond, func() time.Time { return rpccs.clock().Now() })
+	}
+}
+
+// clock is the time source of relay timing and retry backoffs, shared with the consumer session manager so tests control both
+func (rpccs *RPCConsumerServer) clock() lavasession.Clock {
+	if rpccs.consumerSessionManager == nil { // settings are applied before serving starts
+		return lavasession.SystemClock
+	}
+	return rpccs.consumerSessionManager.Clock()
+}
+
+func (rpccs *RPCConsumerServer) getCache() *performance.Cache {
+	rpccs.settingsLock.RLock()
+	defer rpccs.settingsLock.RUnlock()
+	return rpccs.cache
+}
+
+func (rpccs *RPCConsumerServer) getMaxRelayRetries() int {
+	rpccs.settingsLock.RLock()
+	defer rpccs.settingsLock.RUnlock()
+	return rpccs.maxRelayRetries
+}
+
+// returns the providers the replies of the relay are aggregated from by the gas oracle, 0 when they aren't aggregated
+func (rpccs *RPCConsumerServer) getGasOracleProviders(chainMessage chainlib.ChainMessage) int {
+	rpccs.settingsLock.RLock()
+	gasOracleProviders := rpccs.gasOracleProviders
+	rpccs.settingsLock.RUnlock()
+	if gasOracleProviders < 2 || !chainlib.IsGasOracleApi(chainMessage) {
+		return 0
+	}
+	return gasOracleProviders
+}
+
+func (rpccs *RPCConsumerServer) getRelayRateLimiter() *relayRateLimiter {
+	rpccs.settingsLock.RLock()
+	defer rpccs.settingsLock.RUnlock()
+	return rpccs.relayRateLimiter
+}
+
 func (rpccs *RPCConsumerServer) SendRelay(
 	ctx context.Context,
 	url string,
@@ -93,22 +173,138 @@ func (rpccs *RPCConsumerServer) SendRelay(
 	// compares the result with other providers if defined so
 	// compares the response with other consumer wallets if defined so
 	// asynchronously sends data reliability if necessary
-	relaySentTime := time.Now()
+	relaySentTime := rpccs.clock().Now()
+	ctx, span := tracing.StartSpanWithKind(ctx, "rpcconsumer.SendRelay", tracing.SpanKindServer, utils.Attribute{Key: "chainID", Value: rpccs.listenEndpoint.ChainID}, utils.Attribute{Key: "apiInterface", Value: rpccs.listenEndpoint.ApiInterface})
+	trace := rpccs.getRelayTrace(ctx)
+	trace.Start(rpccs.listenEndpoint.ChainID, rpccs.listenEndpoint.ApiInterface)
+	defer func() {
+		trace.Finish(rpccs.clock().Since(relaySentTime), errRet)
+		span.RecordError(errRet)
+		span.End()
+	}()
+	if !rpccs.getRelayRateLimiter().allow() {
+		return nil, nil, utils.LavaFormatWarning("relay rejected by the endpoint rate limit", lavasession.EndpointRateLimitExceededError, utils.Attribute{Key: "GUID", Value: ctx}, utils.Attribute{Key: "endpoint", Value: rpccs.listenEndpoint.String()})
+	}
+	_, parseSpan := tracing.StartSpan(ctx, "rpcconsumer.ParseMsg")
 	chainMessage, err := rpccs.chainParser.ParseMsg(url, []byte(req), connectionType)
+	parseSpan.RecordError(err)
+	parseSpan.End()
 	if err != nil {
 		return nil, nil, err
 	}
+	serviceApi := chainMessage.GetServiceApi()
+	span.SetAttributes(utils.Attribute{Key: "api", Value: serviceApi.Name})
+	trace.SetApi(serviceApi.Name)
+	if !rpccs.consumerSessionManager.IsApiAllowed(serviceApi.Name, serviceApi.Parsing.FunctionTag) {
+		return nil, nil, utils.LavaFormatWarning("api is not allowed by the project policy", nil, utils.Attribute{Key: "GUID", Value: ctx}, utils.Attribute{Key: "api", Value: serviceApi.Name})
+	}
+	if !rpccs.consumerSessionManager.IsAddOnActive(serviceApi.AddOn) {
+		return nil, nil, utils.LavaFormatWarning("api belongs to an add-on the subscription didn't buy", nil, utils.Attribute{Key: "GUID", Value: ctx}, utils.Attribute{Key: "api", Value: serviceApi.Name}, utils.Attribute{Key: "addOn", Value: serviceApi.AddOn})
+	}
+	if timeoutHint, ok := common.GetTimeoutHint(ctx); ok {
+		// the user asked for a timeout in the request headers, the shortest requested timeout wins
+		if current := chainMessage.GetTimeoutOverride(); current == 0 || timeoutHint < current {
+			chainMessage.SetTimeoutOverride(timeoutHint)
+		}
+	}
 	// Unmarshal request
 	unwantedProviders := map[string]struct{}{}
+	forcedProvider, providerForced := rpccs.getProviderAddressOverride(ctx)
+	if providerForced {
+		// debugging a specific provider, every other provider in the pairing is unwanted
+		unwantedProviders, err = rpccs.consumerSessionManager.GetAllProvidersExcept(forcedProvider)
+		if err != nil {
+			return nil, nil, utils.LavaFormatError("requested provider override is not in the current pairing", err, utils.Attribute{Key: "GUID", Value: ctx}, utils.Attribute{Key: "provider", Value: forcedProvider})
+		}
+		utils.LavaFormatDebug("forcing relay to provider", utils.Attribute{Key: "GUID", Value: ctx}, utils.Attribute{Key: "provider", Value: forcedProvider})
+	}
+	if serviceApi.AddOn != "" {
+		// only providers serving the add-on can relay its apis
+		for providerAddress := range rpccs.consumerSessionManager.GetProvidersWithoutAddOn(serviceApi.AddOn) {
+			unwantedProviders[providerAddress] = struct{}{}
+		}
+	}
 
-	// do this in a loop with retry attempts, configurable via a flag, limited by the number of providers in CSM
 	relayRequestData := lavaprotocol.NewRelayData(ctx, connectionType, url, []byte(req), chainMessage.RequestedBlock(), rpccs.listenEndpoint.ApiInterface)
+	if chainMessage.GetInterface().Category.Subscription || providerForced || !isReplyShareable(chainMessage) || rpccs.relayCoalescer == nil {
+		returnedResult, err := rpccs.sendRelayWithRetries(ctx, chainMessage, relayRequestData, dappID, unwantedProviders)
+		if err != nil {
+			return nil, nil, err
+		}
+		if analytics != nil {
+			analytics.Latency = rpccs.clock().Since(relaySentTime).Milliseconds()
+			analytics.ComputeUnits = returnedResult.Request.RelaySession.CuSum
+		}
+		return returnedResult.Reply, returnedResult.ReplyServer, nil
+	}
+
+	// identical relays that are already in flight share the reply instead of using another provider session, relays that asked
+	// for different timeouts aren't merged
+	var returnedResult *lavaprotocol.RelayResult
+	timeoutOverride := chainMessage.GetTimeoutOverride()
+	coalescingKey := performance.RelayRequestKey(rpccs.listenEndpoint.ChainID, rpccs.listenEndpoint.ApiInterface, nil, relayRequestData) + "/" + timeoutOverride.String()
+	coalescedRelayTimeout := performance.CoalescedRelayTimeout
+	if timeoutOverride > 0 && timeoutOverride < coalescedRelayTimeout {
+		coalescedRelayTimeout = timeoutOverride
+	}
+	reply, shared, err := rpccs.relayCoalescer.Do(ctx, coalescingKey, coalescedRelayTimeout, func(ctx context.Context) (*pairingtypes.RelayReply, error) {
+		relayResult, err := rpccs.sendRelayWithRetries(ctx, chainMessage, relayRequestData, dappID, unwantedProviders)
+		if err != nil {
+			return nil, err
+		}
+		returnedResult = relayResult
+		return relayResult.Reply, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	if shared {
+		utils.LavaFormatDebug("relay reply shared with an identical relay in flight", utils.Attribute{Key: "GUID", Value: ctx})
+		trace.SetShared(true)
+	}
+	if analytics != nil {
+		analytics.Latency = rpccs.clock().Since(relaySentTime).Milliseconds()
+		if !shared {
+			analytics.ComputeUnits = returnedResult.Request.RelaySession.CuSum
+		}
+	}
+	return reply, nil, nil
+}
+
+// sends the relay to providers until enough responses were received or the retries ran out
+func (rpccs *RPCConsumerServer) sendRelayWithRetries(
+	ctx context.Context,
+	chainMessage chainlib.ChainMessage,
+	relayRequestData *pairingtypes.RelayPrivateData,
+	dappID string,
+	unwantedProviders map[string]struct{},
+) (returnedResult *lavaprotocol.RelayResult, errRet error) {
+	if gasOracleProviders := rpccs.getGasOracleProviders(chainMessage); gasOracleProviders > 0 {
+		return rpccs.sendGasOracleRelays(ctx, chainMessage, relayRequestData, dappID, unwantedProviders, gasOracleProviders)
+	}
+	// do this in a loop with retry attempts, configurable via a flag, limited by the number of providers in CSM
 	relayResults := []*lavaprotocol.RelayResult{}
 	relayErrors := []error{}
 	blockOnSyncLoss := true
-	for retries := 0; retries < MaxRelayRetries; retries++ {
+	maxRelayRetries := rpccs.getRelayAttempts()
+	requiredResponses := rpccs.requiredResponses
+	relayCu := chainMessage.GetServiceApi().ComputeUnits
+	for retries := 0; retries < maxRelayRetries; retries++ {
+		budgetEpoch, err := rpccs.projectCuBudget.Reserve(relayCu)
+		if err != nil {
+			if retries == 0 {
+				// the user is told when the allowance renews instead of the providers rejecting the relay
+				return nil, err
+			}
+			relayErrors = append(relayErrors, err)
+			break
+		}
 		// TODO: make this async between different providers
-		relayResult, err := rpccs.sendRelayToProvider(ctx, chainMessage, relayRequestData, dappID, &unwantedProviders)
+		relayResult, err := rpccs.sendRelayToProvider(ctx, chainMessage, relayRequestData, dappID, &unwantedProviders, nil)
+		if err != nil {
+			// the provider isn't paid for a failed relay
+			rpccs.projectCuBudget.Refund(budgetEpoch, relayCu)
+		}
 		if relayResult.ProviderAddress != "" {
 			if blockOnSyncLoss && lavasession.IsSessionSyncLoss(err) {
 				utils.LavaFormatDebug("Identified SyncLoss in provider, not removing it from list for another attempt", utils.Attribute{Key: "address", Value: relayResult.ProviderAddress})
@@ -123,12 +319,16 @@ func (rpccs *RPCConsumerServer) SendRelay(
 				// if we ran out of pairings because unwantedProviders is too long or validProviders is too short, continue to reply handling code
 				break
 			}
+			if lavasession.RelayTimeoutExceededError.Is(err) {
+				// the user asked for a tight timeout, retrying with another provider would exceed it
+				break
+			}
 			// decide if we should break here if its something retry won't solve
 			utils.LavaFormatDebug("could not send relay to provider", utils.Attribute{Key: "GUID", Value: ctx}, utils.Attribute{Key: "error", Value: err.Error()})
 			continue
 		}
 		relayResults = append(relayResults, relayResult)
-		if len(relayResults) >= rpccs.requiredResponses {
+		if len(relayResults) >= requiredResponses {
 			break
 		}
 		// future requests need to ask for the same block height to get consensus on the reply
@@ -136,7 +336,8 @@ func (rpccs *RPCConsumerServer) SendRelay(
 	}
 
 	enabled, dataReliabilityThreshold := rpccs.chainParser.DataReliabilityParams()
-	if enabled {
+	// reliability relays are proven with the vrf key of the paired consumer, a badge user doesn't hold the one of its project
+	if _, badgeUser := rpccs.signer.(lavaprotocol.BadgeHolder); enabled && !badgeUser {
 		for _, relayResult := range relayResults {
 			// new context is needed for data reliability as some clients cancel the context they provide when the relay returns
 			// as data reliability happens in a go routine it will continue while the response returns.
@@ -145,29 +346,144 @@ func (rpccs *RPCConsumerServer) SendRelay(
 			if found {
 				dataReliabilityContext = utils.WithUniqueIdentifier(dataReliabilityContext, guid)
 			}
+			if spanContext, traced := tracing.SpanContextFromContext(ctx); traced {
+				// the reliability relay is part of the relay's trace even though it ends after it
+				dataReliabilityContext = tracing.ContextWithSpanContext(dataReliabilityContext, spanContext)
+			}
 			go rpccs.sendDataReliabilityRelayIfApplicable(dataReliabilityContext, relayResult, chainMessage, dataReliabilityThreshold) // runs asynchronously
 		}
 	}
 
 	// TODO: secure, go over relay results to find discrepancies and choose majority, or trigger a second wallet relay
 	if len(relayResults) == 0 {
-		return nil, nil, utils.LavaFormatError("Failed all retries", nil, utils.Attribute{Key: "GUID", Value: ctx}, utils.Attribute{Key: "errors", Value: relayErrors})
+		if len(relayErrors) > 0 && lavasession.RelayTimeoutExceededError.Is(relayErrors[len(relayErrors)-1]) {
+			return nil, utils.LavaFormatError("relay exceeded the requested timeout", lavasession.RelayTimeoutExceededError, utils.Attribute{Key: "GUID", Value: ctx}, utils.Attribute{Key: "timeout", Value: chainMessage.GetTimeoutOverride()}, utils.Attribute{Key: "errors", Value: relayErrors})
+		}
+		return nil, utils.LavaFormatRepeatedError("Failed all retries", nil, utils.Attribute{Key: "GUID", Value: ctx}, utils.Attribute{Key: "errors", Value: relayErrors})
 	} else if len(relayErrors) > 0 {
 		utils.LavaFormatDebug("relay succeeded but had some errors", utils.Attribute{Key: "GUID", Value: ctx}, utils.Attribute{Key: "errors", Value: relayErrors})
 	}
-	var returnedResult *lavaprotocol.RelayResult
-	for _, iteratedResult := range relayResults {
-		// TODO: go over rpccs.requiredResponses and get majority
-		returnedResult = iteratedResult
+	return lavaprotocol.MajorityRelayResult(relayResults, chainMessage.GetServiceApi()), nil
+}
+
+// returns the attempts a relay is sent in, the first one included
+func (rpccs *RPCConsumerServer) getRelayAttempts() int {
+	maxRelayRetries := rpccs.getMaxRelayRetries()
+	if maxRelayRetries > lavasession.DegradedMaxRelayRetries && rpccs.consumerSessionManager.Degraded() {
+		// retries mostly reach other degraded providers and add to their load
+		maxRelayRetries = lavasession.DegradedMaxRelayRetries
 	}
+	return maxRelayRetries
+}
 
-	if analytics != nil {
-		currentLatency := time.Since(relaySentTime)
-		analytics.Latency = currentLatency.Milliseconds()
-		analytics.ComputeUnits = returnedResult.Request.RelaySession.CuSum
+type gasOracleRelay struct {
+	relayResult *lavaprotocol.RelayResult
+	err         error
+}
+
+// sendGasOracleRelays sends the relay to the gas oracle's providers at once and aggregates their fee estimates as the
+// replies arrive, a failed relay is retried with another provider while retries are left. each node estimates the fees
+// by its own mempool, so the replies aren't checked by data reliability
+func (rpccs *RPCConsumerServer) sendGasOracleRelays(
+	ctx context.Context,
+	chainMessage chainlib.ChainMessage,
+	relayRequestData *pairingtypes.RelayPrivateData,
+	dappID string,
+	unwantedProviders map[string]struct{},
+	gasOracleProviders int,
+) (*lavaprotocol.RelayResult, error) {
+	relayCu := chainMessage.GetServiceApi().ComputeUnits
+	// a relay holds the lock until its provider was picked, so every relay reaches another provider
+	var providerPickLock sync.Mutex
+	replies := make(chan gasOracleRelay)
+	inFlight := 0
+	sendRelay := func() error {
+		budgetEpoch, err := rpccs.projectCuBudget.Reserve(relayCu)
+		if err != nil {
+			return err
+		}
+		inFlight++
+		// each relay updates the requested block of its own data by its reply
+		relayData := proto.Clone(relayRequestData).(*pairingtypes.RelayPrivateData)
+		go func() {
+			providerPickLock.Lock()
+			relayResult, err := rpccs.sendRelayToProvider(ctx, chainMessage, relayData, dappID, &unwantedProviders, func(providerAddress string) {
+				if providerAddress != "" {
+					unwantedProviders[providerAddress] = struct{}{}
+				}
+				providerPickLock.Unlock()
+			})
+			if err != nil {
+				// the provider isn't paid for a failed relay
+				rpccs.projectCuBudget.Refund(budgetEpoch, relayCu)
+			}
+			replies <- gasOracleRelay{relayResult: relayResult, err: err}
+		}()
+		return nil
 	}
 
-	return returnedResult.Reply, returnedResult.ReplyServer, nil
+	relayErrors := []error{}
+	for i := 0; i < gasOracleProviders; i++ {
+		err := sendRelay()
+		if err != nil {
+			if i == 0 {
+				// the user is told when the allowance renews instead of the providers rejecting the relay
+				return nil, err
+			}
+			relayErrors = append(relayErrors, err)
+			break
+		}
+	}
+	retries := rpccs.getRelayAttempts() - 1
+	relayResults := []*lavaprotocol.RelayResult{}
+	for inFlight > 0 {
+		reply := <-replies
+		inFlight--
+		if reply.err == nil {
+			relayResults = append(relayResults, reply.relayResult)
+			continue
+		}
+		relayErrors = append(relayErrors, reply.err)
+		if retries <= 0 || lavasession.PairingListEmptyError.Is(reply.err) || lavasession.RelayTimeoutExceededError.Is(reply.err) {
+			continue
+		}
+		retries--
+		utils.LavaFormatDebug("could not send gas oracle relay to provider, retrying with another provider", utils.Attribute{Key: "GUID", Value: ctx}, utils.Attribute{Key: "error", Value: reply.err.Error()})
+		err := sendRelay()
+		if err != nil {
+			relayErrors = append(relayErrors, err)
+		}
+	}
+
+	if len(relayResults) == 0 {
+		return nil, utils.LavaFormatRepeatedError("Failed all retries", nil, utils.Attribute{Key: "GUID", Value: ctx}, utils.Attribute{Key: "errors", Value: relayErrors})
+	} else if len(relayErrors) > 0 {
+		utils.LavaFormatDebug("relay succeeded but had some errors", utils.Attribute{Key: "GUID", Value: ctx}, utils.Attribute{Key: "errors", Value: relayErrors})
+	}
+	if len(relayResults) == 1 {
+		return relayResults[0], nil
+	}
+	return rpccs.aggregateGasReplies(ctx, chainMessage, relayResults), nil
+}
+
+// aggregateGasReplies returns a result with the median of the providers' fee estimates, so a single provider can't skew it.
+// the reply is no longer signed by its provider, it is only returned to the user. when the replies can't be aggregated the
+// majority reply is returned
+func (rpccs *RPCConsumerServer) aggregateGasReplies(ctx context.Context, chainMessage chainlib.ChainMessage, relayResults []*lavaprotocol.RelayResult) *lavaprotocol.RelayResult {
+	replies := make([][]byte, 0, len(relayResults))
+	for _, relayResult := range relayResults {
+		replies = append(replies, relayResult.Reply.Data)
+	}
+	data, err := chainlib.AggregateGasReplies(chainMessage.GetServiceApi().Name, replies)
+	if err != nil {
+		utils.LavaFormatWarning("failed aggregating fee estimates, returning the majority reply", err, utils.Attribute{Key: "GUID", Value: ctx}, utils.Attribute{Key: "api", Value: chainMessage.GetServiceApi().Name})
+		return lavaprotocol.MajorityRelayResult(relayResults, chainMessage.GetServiceApi())
+	}
+	aggregated := *relayResults[0]
+	reply := *aggregated.Reply
+	reply.Data = data
+	aggregated.Reply = &reply
+	return &aggregated
 }
 
 func (rpccs *RPCConsumerServer) sendRelayToProvider(
@@ -176,6 +492,7 @@ func (rpccs *RPCConsumerServer) sendRelayToProvider(
 	relayRequestData *pairingtypes.RelayPrivateData,
 	dappID string,
 	unwantedProviders *map[string]struct{},
+	onProviderPicked func(providerAddress string), // called once the session's provider was picked, with an empty address when none was
 ) (relayResult *lavaprotocol.RelayResult, errRet error) {
 	// get a session for the relay from the ConsumerSessionManager
 	// construct a relay message with lavaprotocol package, include QoS and jail providers
@@ -190,17 +507,45 @@ func (rpccs *RPCConsumerServer) sendRelayToProvider(
 	// in case connection totally fails, update unresponsive providers in ConsumerSessionManager
 
 	isSubscription := chainMessage.GetInterface().Category.Subscription
+	ctx, span := tracing.StartSpan(ctx, "rpcconsumer.sendRelayToProvider")
+	defer func() {
+		span.RecordError(errRet)
+		span.End()
+	}()
+	cacheDecision := "skipped"
+	if trace := rpccs.getRelayTrace(ctx); trace != nil {
+		attemptStart := rpccs.clock().Now()
+		defer func() {
+			attempt := common.RelayTraceAttempt{Cache: cacheDecision, LatencyMs: rpccs.clock().Since(attemptStart).Milliseconds()}
+			if relayResult != nil {
+				attempt.Provider = relayResult.ProviderAddress
+				if relayResult.Request != nil && relayResult.Request.RelaySession != nil {
+					attempt.SessionID = relayResult.Request.RelaySession.SessionId
+				}
+			}
+			if errRet != nil {
+				attempt.Error = errRet.Error()
+			}
+			trace.AddAttempt(attempt)
+		}()
+	}
 
 	// Get Session. we get session here so we can use the epoch in the callbacks
+	_, sessionSpan := tracing.StartSpan(ctx, "rpcconsumer.GetSession")
 	singleConsumerSession, epoch, providerPublicAddress, reportedProviders, err := rpccs.consumerSessionManager.GetSession(ctx, chainMessage.GetServiceApi().ComputeUnits, *unwantedProviders)
+	sessionSpan.RecordError(err)
+	sessionSpan.End()
+	if onProviderPicked != nil {
+		onProviderPicked(providerPublicAddress)
+	}
 	relayResult = &lavaprotocol.RelayResult{ProviderAddress: providerPublicAddress, Finalized: false}
 	if err != nil {
 		return relayResult, err
 	}
-	privKey := rpccs.privKey
+	span.SetAttributes(utils.Attribute{Key: "provider", Value: providerPublicAddress}, utils.Attribute{Key: "epoch", Value: epoch})
 	chainID := rpccs.listenEndpoint.ChainID
 	lavaChainID := rpccs.lavaChainID
-	relayRequest, err := lavaprotocol.ConstructRelayRequest(ctx, privKey, lavaChainID, chainID, relayRequestData, providerPublicAddress, singleConsumerSession, int64(epoch), reportedProviders)
+	relayRequest, err := lavaprotocol.ConstructRelayRequest(ctx, rpccs.signer, lavaChainID, chainID, relayRequestData, providerPublicAddress, singleConsumerSession, int64(epoch), reportedProviders, chainMessage.GetServiceApi().Name)
 	if err != nil {
 		return relayResult, err
 	}
@@ -211,10 +556,29 @@ func (rpccs *RPCConsumerServer) sendRelayToProvider(
 		return rpccs.relaySubscriptionInner(ctx, endpointClient, singleConsumerSession, relayResult)
 	}
 
-	// try using cache before sending relay
+	// try using cache before sending relay, unless the user asked for a specific provider or the api isn't cacheable
+	cache := rpccs.getCache()
 	var reply *pairingtypes.RelayReply
-
-	reply, err = rpccs.cache.GetEntry(ctx, relayRequest, chainMessage.GetInterface().Interface, nil, chainID, false) // caching in the portal doesn't care about hashes, and we don't have data on finalization yet
+	// the gas oracle needs a fresh estimate from each provider
+	gasOracle := rpccs.getGasOracleProviders(chainMessage) > 0
+	if _, forced := rpccs.getProviderAddressOverride(ctx); !forced && !gasOracle && isReplyShareable(chainMessage) {
+		_, cacheSpan := tracing.StartSpan(ctx, "rpcconsumer.CacheGetEntry")
+		// pages of paginated rest queries at a finalized height are indexed apart from the other entries
+		reply, err = cache.GetPage(ctx, relayRequest.RelayData, chainID)
+		if err != nil {
+			reply, err = cache.GetEntry(ctx, relayRequest, chainMessage.GetInterface().Interface, nil, chainID, false) // caching in the portal doesn't care about hashes, and we don't have data on finalization yet
+		}
+		cacheSpan.SetAttributes(utils.Attribute{Key: "hit", Value: err == nil && reply != nil})
+		cacheSpan.End()
+		switch {
+		case err == nil && reply != nil:
+			cacheDecision = "hit"
+		case performance.NotInitialisedError.Is(err):
+			cacheDecision = ""
+		default:
+			cacheDecision = "miss"
+		}
+	}
 	if err == nil && reply != nil {
 		// Info was fetched from cache, so we don't need to change the state
 		// so we can return here, no need to update anything and calculate as this info was fetched from the cache
@@ -233,35 +597,71 @@ func (rpccs *RPCConsumerServer) sendRelayToProvider(
 		_, extraRelayTimeout, _, _ = rpccs.chainParser.ChainBlockStats()
 	}
 	relayTimeout := extraRelayTimeout + lavaprotocol.GetTimePerCu(singleConsumerSession.LatestRelayCu) + lavasession.AverageWorldLatency
+	expectedRelayTimeout := relayTimeout // QoS expectations are based on the spec derived timeout
+	apiName := chainMessage.GetServiceApi().Name
+	relayTimeout = rpccs.relayTimeoutModel.Timeout(chainID, apiName, expectedRelayTimeout)
+	modelRelayTimeout := relayTimeout // the model doesn't learn from widened timeouts, they end with the degradation
+	if rpccs.consumerSessionManager.Degraded() {
+		// slow providers are waited for rather than failed over to others that are as slow
+		relayTimeout *= lavasession.DegradedRelayTimeoutFactor
+	}
+	timeoutHinted := false
+	if timeoutOverride := chainMessage.GetTimeoutOverride(); timeoutOverride > 0 && timeoutOverride < relayTimeout {
+		relayTimeout = timeoutOverride
+		timeoutHinted = true
+	}
 	relayResult, relayLatency, err, backoff := rpccs.relayInner(ctx, singleConsumerSession, relayResult, relayTimeout)
 	if err != nil {
+		if backoff && !timeoutHinted {
+			rpccs.relayTimeoutModel.OnRelayTimeout(chainID, apiName, expectedRelayTimeout, modelRelayTimeout)
+		}
+		if timeoutHinted && backoff {
+			// the deadline was set by the user and not by the spec, the provider shouldn't be backed off for it
+			backoff = false
+			err = sdkerrors.Wrapf(lavasession.RelayTimeoutExceededError, "timeout: %s, provider: %s, error: %s", relayTimeout, relayResult.ProviderAddress, err.Error())
+		}
 		failRelaySession := func(origErr error, backoff_ bool) {
 			backOffDuration := 0 * time.Second
 			if backoff_ {
 				backOffDuration = lavasession.BACKOFF_TIME_ON_FAILURE
 			}
-			time.Sleep(backOffDuration) // sleep before releasing this singleConsumerSession
+			rpccs.clock().Sleep(backOffDuration) // sleep before releasing this singleConsumerSession
 			// relay failed need to fail the session advancement
 			errReport := rpccs.consumerSessionManager.OnSessionFailure(singleConsumerSession, err)
 			if errReport != nil {
-				utils.LavaFormatError("failed relay onSessionFailure errored", errReport, utils.Attribute{Key: "GUID", Value: ctx}, utils.Attribute{Key: "original error", Value: err.Error()})
+				utils.LavaFormatRepeatedError("failed relay onSessionFailure errored", errReport, utils.Attribute{Key: "GUID", Value: ctx}, utils.Attribute{Key: "original error", Value: err.Error()})
 			}
 		}
 		go failRelaySession(err, backoff)
 		return relayResult, err
 	}
 	// get here only if performed a regular relay successfully
-	expectedBH, numOfProviders := rpccs.finalizationConsensus.ExpectedBlockHeight(rpccs.chainParser)
+	rpccs.relayTimeoutModel.OnRelayDone(chainID, apiName, expectedRelayTimeout, relayLatency)
+	blockLags := rpccs.finalizationConsensus.BlockLags(rpccs.chainParser)
 	pairingAddressesLen := rpccs.consumerSessionManager.GetAtomicPairingAddressesLength()
 	latestBlock := relayResult.Reply.LatestBlock
-	err = rpccs.consumerSessionManager.OnSessionDone(singleConsumerSession, epoch, latestBlock, chainMessage.GetServiceApi().ComputeUnits, relayLatency, singleConsumerSession.CalculateExpectedLatency(relayTimeout), expectedBH, numOfProviders, pairingAddressesLen) // session done successfully
+	err = rpccs.consumerSessionManager.OnSessionDone(singleConsumerSession, epoch, latestBlock, chainMessage.GetServiceApi().ComputeUnits, relayLatency, singleConsumerSession.CalculateExpectedLatency(expectedRelayTimeout), blockLags, pairingAddressesLen) // session done successfully
+
+	// let the cache drop non finalized entries from older blocks before storing this reply
+	cacheLatestBlock := blockLags.ExpectedBlockHeight
+	if cacheLatestBlock <= 0 {
+		cacheLatestBlock = latestBlock
+	}
+	_, averageBlockTime, _, _ := rpccs.chainParser.ChainBlockStats()
+	cache.OnNewLatestBlock(chainID, cacheLatestBlock, averageBlockTime)
 
+	if !isReplyShareable(chainMessage) || gasOracle {
+		return relayResult, err
+	}
 	// set cache in a non blocking call
 	go func() {
 		new_ctx := context.Background()
 		new_ctx, cancel := context.WithTimeout(new_ctx, chainlib.DataReliabilityTimeoutIncrease)
 		defer cancel()
-		err2 := rpccs.cache.SetEntry(new_ctx, relayRequest, chainMessage.GetInterface().Interface, nil, chainID, dappID, relayResult.Reply, relayResult.Finalized) // caching in the portal doesn't care about hashes
+		indexed, err2 := cache.SetPage(new_ctx, relayRequest.RelayData, chainID, dappID, relayResult.Reply, relayResult.Finalized)
+		if !indexed {
+			err2 = cache.SetEntry(new_ctx, relayRequest, chainMessage.GetInterface().Interface, nil, chainID, dappID, relayResult.Reply, relayResult.Finalized) // caching in the portal doesn't care about hashes
+		}
 		if err2 != nil && !performance.NotInitialisedError.Is(err2) {
 			utils.LavaFormatWarning("error updating cache with new entry", err2)
 		}
@@ -269,17 +669,101 @@ func (rpccs *RPCConsumerServer) sendRelayToProvider(
 	return relayResult, err
 }
 
+// reliabilityVerifiedByCache compares the reply with a finalized reply another paired provider signed for the same query. the cached
+// reply may come from any consumer sharing the cache, so popular finalized queries are checked on every reply without paying for
+// reliability relays. a mismatch is reported right away when the cached request was signed with this consumer's key in the same epoch,
+// the chain only accepts conflicts between relays of the reporter, and needs no reliability relays either. the reported cached reply is
+// disputed, it is reported once and not used to verify replies after. a missing, disputed or otherwise mismatching cached reply returns
+// false so the reply is checked with reliability relays, which report a conflict when there is one
+func (rpccs *RPCConsumerServer) reliabilityVerifiedByCache(ctx context.Context, relayResult *lavaprotocol.RelayResult, chainMessage chainlib.ChainMessage) bool {
+	chainID := rpccs.listenEndpoint.ChainID
+	apiInterface := chainMessage.GetInterface().Interface
+	entry, err := rpccs.getCache().GetSignedEntry(ctx, relayResult.Request, apiInterface, chainID)
+	if err != nil {
+		if !performance.NotFoundError.Is(err) && !performance.NotInitialisedError.Is(err) {
+			utils.LavaFormatDebug("DataReliability: could not read a signed reply from the cache", utils.Attribute{Key: "GUID", Value: ctx}, utils.Attribute{Key: "error", Value: err.Error()})
+		}
+		return false
+	}
+	// the cache key is a hash, make sure the cached reply answers the same query
+	requestKey := performance.RelayRequestKey(chainID, apiInterface, nil, entry.Request.GetRelayData())
+	if requestKey != performance.RelayRequestKey(chainID, apiInterface, nil, relayResult.Request.RelayData) {
+		return false
+	}
+	signer, err := lavaprotocol.ReplySigner(entry.Response, entry.Request)
+	if err != nil || signer == relayResult.ProviderAddress || !rpccs.consumerSessionManager.IsPairedProvider(signer) {
+		// the provider's own cached reply proves nothing, and only providers of the pairing are accountable for their replies
+		return false
+	}
+	entryKey := requestKey + "/" + signer
+	if rpccs.cacheConflicts.isDisputed(entryKey) {
+		return false
+	}
+	if chainlib.RepliesMatch(chainMessage.GetServiceApi(), entry.Response.Data, relayResult.Reply.Data) {
+		utils.LavaFormatDebug("DataReliability: verified against the cached reply of another provider", utils.Attribute{Key: "GUID", Value: ctx}, utils.Attribute{Key: "provider", Value: relayResult.ProviderAddress}, utils.Attribute{Key: "cachedProvider", Value: signer})
+		return true
+	}
+	cachedConsumer, err := sigs.ExtractSignerAddress(entry.Request.RelaySession)
+	if err != nil || !cachedConsumer.Equals(lavaprotocol.SignerAddress(rpccs.signer)) || entry.Request.RelaySession.Epoch != relayResult.Request.RelaySession.Epoch {
+		utils.LavaFormatInfo("DataReliability: reply differs from the cached reply of another provider, sending reliability relays", utils.Attribute{Key: "GUID", Value: ctx}, utils.Attribute{Key: "provider", Value: relayResult.ProviderAddress}, utils.Attribute{Key: "cachedProvider", Value: signer})
+		return false
+	}
+	if !rpccs.cacheConflicts.dispute(entryKey, entry.Request.RelaySession.Epoch) {
+		// another reply reported the conflict on the cached reply meanwhile
+		return false
+	}
+	utils.LavaFormatWarning("DataReliability: reply differs from the cached reply of another provider, reporting", nil, utils.Attribute{Key: "GUID", Value: ctx}, utils.Attribute{Key: "provider", Value: relayResult.ProviderAddress}, utils.Attribute{Key: "cachedProvider", Value: signer}, utils.Attribute{Key: "Data0", Value: string(relayResult.Reply.Data)}, utils.Attribute{Key: "Data1", Value: string(entry.Response.Data)})
+	conflict := &conflicttypes.ResponseConflict{
+		ConflictRelayData0: &conflicttypes.ConflictRelayData{Reply: relayResult.Reply, Request: relayResult.Request},
+		ConflictRelayData1: &conflicttypes.ConflictRelayData{Reply: entry.Response, Request: entry.Request},
+	}
+	rpccs.consumerSessionManager.OnDataReliabilityConflict(relayResult.ProviderAddress, signer)
+	err = rpccs.consumerTxSender.TxConflictDetection(ctx, nil, conflict, nil)
+	if err != nil {
+		utils.LavaFormatError("could not send detection Transaction", err, utils.Attribute{Key: "GUID", Value: ctx}, utils.Attribute{Key: "conflict", Value: conflict})
+	}
+	return true
+}
+
+// replies of personal data apis belong to the user that asked, and identical stateful relays are each meant to reach the chain,
+// so their replies aren't cached or shared with identical relays in flight
+func isReplyShareable(chainMessage chainlib.ChainMessage) bool {
+	return !chainMessage.HasTag(spectypes.ApiTagPersonalData) && !chainMessage.HasTag(spectypes.ApiTagStateful)
+}
+
+// returns the provider address requested in the relay headers, only when relay debugging is enabled by the operator
+func (rpccs *RPCConsumerServer) getProviderAddressOverride(ctx context.Context) (providerAddress string, found bool) {
+	if !rpccs.debugRelays {
+		return "", false
+	}
+	return common.GetProviderAddressOverride(ctx)
+}
+
+// returns the trace the user asked for in the relay headers, only when relay debugging is enabled by the operator
+func (rpccs *RPCConsumerServer) getRelayTrace(ctx context.Context) *common.RelayTrace {
+	if !rpccs.debugRelays {
+		return nil
+	}
+	return common.GetRelayTrace(ctx)
+}
+
 func (rpccs *RPCConsumerServer) relayInner(ctx context.Context, singleConsumerSession *lavasession.SingleConsumerSession, relayResult *lavaprotocol.RelayResult, relayTimeout time.Duration) (relayResultRet *lavaprotocol.RelayResult, relayLatency time.Duration, err error, needsBackoff bool) {
 	existingSessionLatestBlock := singleConsumerSession.LatestBlock // we read it now because singleConsumerSession is locked, and later it's not
 	endpointClient := *singleConsumerSession.Endpoint.Client
 	providerPublicAddress := relayResult.ProviderAddress
 	relayRequest := relayResult.Request
+	var relaySentTime time.Time
+	var trailer metadata.MD
 	callRelay := func() (reply *pairingtypes.RelayReply, relayLatency time.Duration, err error, backoff bool) {
-		relaySentTime := time.Now()
+		relaySentTime = rpccs.clock().Now()
 		connectCtx, connectCtxCancel := context.WithTimeout(ctx, relayTimeout)
 		defer connectCtxCancel()
-		reply, err = endpointClient.Relay(connectCtx, relayRequest)
-		relayLatency = time.Since(relaySentTime)
+		// the provider continues the trace from the relay span it gets in the grpc metadata
+		connectCtx, relaySpan := tracing.StartSpanWithKind(connectCtx, "rpcconsumer.ProviderRelay", tracing.SpanKindClient, utils.Attribute{Key: "provider", Value: providerPublicAddress})
+		reply, err = endpointClient.Relay(connectCtx, relayRequest, grpc.Trailer(&trailer))
+		relaySpan.RecordError(err)
+		relaySpan.End()
+		relayLatency = rpccs.clock().Since(relaySentTime)
 		if err != nil {
 			backoff := false
 			if errors.Is(connectCtx.Err(), context.DeadlineExceeded) {
@@ -293,6 +777,11 @@ func (rpccs *RPCConsumerServer) relayInner(ctx context.Context, singleConsumerSe
 	if err != nil {
 		return relayResult, 0, err, backoff
 	}
+	// a reply altered in transport is dropped before its signatures are checked, so it doesn't look like the provider's misbehavior
+	err = lavaprotocol.VerifyReplyChecksum(reply, trailer, providerPublicAddress)
+	if err != nil {
+		return relayResult, 0, err, false
+	}
 	relayResult.Reply = reply
 	lavaprotocol.UpdateRequestedBlock(relayRequest.RelayData, reply) // update relay request requestedBlock to the provided one in case it was arbitrary
 	_, _, blockDistanceForFinalizedData, _ := rpccs.chainParser.ChainBlockStats()
@@ -301,12 +790,19 @@ func (rpccs *RPCConsumerServer) relayInner(ctx context.Context, singleConsumerSe
 	if err != nil {
 		return relayResult, 0, err, false
 	}
+	err = lavaprotocol.VerifyReplyTimestamp(reply, relaySentTime, relaySentTime.Add(relayLatency), rpccs.maxReplyClockSkew, providerPublicAddress)
+	if err != nil {
+		return relayResult, 0, err, false
+	}
 
 	// TODO: response data sanity, check its under an expected format add that format to spec
 	enabled, _ := rpccs.chainParser.DataReliabilityParams()
 	if enabled {
 		// TODO: DETECTION instead of existingSessionLatestBlock, we need proof of last reply to send the previous reply and the current reply
+		_, finalizationSpan := tracing.StartSpan(ctx, "rpcconsumer.VerifyFinalization")
 		finalizedBlocks, finalizationConflict, err := lavaprotocol.VerifyFinalizationData(reply, relayRequest, providerPublicAddress, existingSessionLatestBlock, blockDistanceForFinalizedData)
+		finalizationSpan.RecordError(err)
+		finalizationSpan.End()
 		if err != nil {
 			if lavaprotocol.ProviderFinzalizationDataAccountabilityError.Is(err) && finalizationConflict != nil {
 				go rpccs.consumerTxSender.TxConflictDetection(ctx, finalizationConflict, nil, nil)
@@ -314,9 +810,16 @@ func (rpccs *RPCConsumerServer) relayInner(ctx context.Context, singleConsumerSe
 			return relayResult, 0, err, false
 		}
 
-		finalizationConflict, err = rpccs.finalizationConsensus.UpdateFinalizedHashes(int64(blockDistanceForFinalizedData), providerPublicAddress, reply.LatestBlock, finalizedBlocks, relayRequest.RelaySession, reply)
+		finalizationConflict, sameProviderConflict, err := rpccs.finalizationConsensus.UpdateFinalizedHashes(int64(blockDistanceForFinalizedData), providerPublicAddress, reply.LatestBlock, finalizedBlocks, relayRequest.RelaySession, reply)
 		if err != nil {
-			go rpccs.consumerTxSender.TxConflictDetection(ctx, finalizationConflict, nil, nil)
+			if sameProviderConflict != nil {
+				// the chain only accepts same provider evidence signed over the hash of the finalization data
+				if lavasession.ProtocolVersionSupports(relayRequest.ProtocolVersion, lavasession.HashedFinalizationFeature) {
+					go rpccs.consumerTxSender.TxConflictDetection(ctx, nil, nil, sameProviderConflict)
+				}
+			} else {
+				go rpccs.consumerTxSender.TxConflictDetection(ctx, finalizationConflict, nil, nil)
+			}
 			return relayResult, 0, err, false
 		}
 	}
@@ -324,6 +827,24 @@ func (rpccs *RPCConsumerServer) relayInner(ctx context.Context, singleConsumerSe
 	return relayResult, relayLatency, nil, false
 }
 
+// onForkDetected quarantines the providers on the minority side of a fork for the rest of the epoch.
+// the conflict tx is sent separately from the relay that found the conflict
+func (rpccs *RPCConsumerServer) onForkDetected(fork lavaprotocol.ForkDetected) {
+	minorityProviders := fork.MinorityProviders()
+	if len(minorityProviders) == 0 {
+		return
+	}
+	err := rpccs.consumerSessionManager.QuarantineProviders(minorityProviders, fork.Epoch)
+	if err != nil {
+		if lavasession.EpochMismatchError.Is(err) {
+			return // the pairing changed, the quarantine no longer applies
+		}
+		utils.LavaFormatError("failed quarantining providers on a minority fork", err, utils.Attribute{Key: "blockNum", Value: fork.BlockNum}, utils.Attribute{Key: "providers", Value: minorityProviders})
+		return
+	}
+	utils.LavaFormatWarning("quarantined providers on a minority fork until the next epoch", nil, utils.Attribute{Key: "blockNum", Value: fork.BlockNum}, utils.Attribute{Key: "providers", Value: minorityProviders}, utils.Attribute{Key: "epoch", Value: fork.Epoch})
+}
+
 func (rpccs *RPCConsumerServer) relaySubscriptionInner(ctx context.Context, endpointClient pairingtypes.RelayerClient, singleConsumerSession *lavasession.SingleConsumerSession, relayResult *lavaprotocol.RelayResult) (relayResultRet *lavaprotocol.RelayResult, err error) {
 	// relaySentTime := time.Now()
 	replyServer, err := endpointClient.RelaySubscribe(ctx, relayResult.Request)
@@ -343,7 +864,7 @@ func (rpccs *RPCConsumerServer) relaySubscriptionInner(ctx context.Context, endp
 	return relayResult, err
 }
 
-func (rpccs *RPCConsumerServer) sendDataReliabilityRelayIfApplicable(ctx context.Context, relayResult *lavaprotocol.RelayResult, chainMessage chainlib.ChainMessage, dataReliabilityThreshold uint32) error {
+func (rpccs *RPCConsumerServer) sendDataReliabilityRelayIfApplicable(ctx context.Context, relayResult *lavaprotocol.RelayResult, chainMessage chainlib.ChainMessage, dataReliabilityThreshold uint32) (errRet error) {
 	// Data reliability:
 	// handle data reliability VRF random value check with the lavaprotocol package
 	// asynchronous: if applicable, get a data reliability session from ConsumerSessionManager
@@ -362,17 +883,33 @@ func (rpccs *RPCConsumerServer) sendDataReliabilityRelayIfApplicable(ctx context
 	if !specCategory.Deterministic || !relayResult.Finalized {
 		return nil // disabled for this spec and requested block so no data reliability messages
 	}
+	ctx, span := tracing.StartSpan(ctx, "rpcconsumer.DataReliability", utils.Attribute{Key: "provider", Value: relayResult.ProviderAddress})
+	defer func() {
+		span.RecordError(errRet)
+		span.End()
+	}()
+	if rpccs.reliabilityVerifiedByCache(ctx, relayResult, chainMessage) {
+		return nil // the reply was checked without sending reliability relays
+	}
 	var dataReliabilitySessions []*lavasession.DataReliabilitySession
 	sessionEpoch := uint64(relayResult.Request.RelaySession.Epoch)
 	providerPubAddress := relayResult.ProviderAddress
+	requestedBlock := relayResult.Request.RelayData.RequestBlock // a concrete block, magic blocks were replaced with the provider's reply
 	// handle data reliability
 	vrfRes0, vrfRes1 := utils.CalculateVrfOnRelay(relayResult.Request.RelayData, relayResult.Reply, rpccs.VrfSk, sessionEpoch)
 	// get two indexesMap for data reliability.
 	providersCount := uint32(rpccs.consumerSessionManager.GetAtomicPairingAddressesLength())
+	// trusted providers are sampled below the spec threshold
+	dataReliabilityThreshold = rpccs.consumerSessionManager.DataReliabilityThreshold(providerPubAddress, dataReliabilityThreshold)
 	indexesMap := lavaprotocol.DataReliabilityThresholdToSession([][]byte{vrfRes0, vrfRes1}, []bool{false, true}, dataReliabilityThreshold, providersCount)
 	utils.LavaFormatDebug("DataReliability Randomized Values", utils.Attribute{Key: "GUID", Value: ctx}, utils.Attribute{Key: "vrf0", Value: uint64(binary.LittleEndian.Uint32(vrfRes0))}, utils.Attribute{Key: "vrf1", Value: uint64(binary.LittleEndian.Uint32(vrfRes1))}, utils.Attribute{Key: "decisionMap", Value: indexesMap})
 	for idxExtract, uniqueIdentifier := range indexesMap { // go over each unique index and get a session.
 		// the key in the indexesMap are unique indexes to fetch from consumerSessionManager
+		if reliabilityProviderAddress, err := rpccs.consumerSessionManager.GetDataReliabilityProviderAddress(providerPubAddress, idxExtract); err == nil && !rpccs.finalizationConsensus.IsBlockAvailable(reliabilityProviderAddress, requestedBlock) {
+			// a pruned provider can't serve the block, comparing its reply would only fail
+			utils.LavaFormatInfo("DataReliability: requested block is older than the provider's earliest block", utils.Attribute{Key: "GUID", Value: ctx}, utils.Attribute{Key: "provider", Value: reliabilityProviderAddress}, utils.Attribute{Key: "requestedBlock", Value: requestedBlock})
+			continue
+		}
 		dataReliabilityConsumerSession, providerPublicAddress, epoch, err := rpccs.consumerSessionManager.GetDataReliabilitySession(ctx, providerPubAddress, idxExtract, sessionEpoch)
 		if err != nil {
 			if lavasession.DataReliabilityIndexRequestedIsOriginalProviderError.Is(err) {
@@ -407,20 +944,21 @@ func (rpccs *RPCConsumerServer) sendDataReliabilityRelayIfApplicable(ctx context
 			reportedProviders = nil
 			utils.LavaFormatError("failed reading reported providers for epoch", err, utils.Attribute{Key: "GUID", Value: ctx}, utils.Attribute{Key: "epoch", Value: epoch})
 		}
-		reliabilityRequest, err := lavaprotocol.ConstructDataReliabilityRelayRequest(ctx, rpccs.lavaChainID, vrfData, rpccs.privKey, rpccs.listenEndpoint.ChainID, relayResult.Request.RelayData, providerAddress, epoch, reportedProviders, singleConsumerSession.RelayNum)
+		reliabilityRequest, err := lavaprotocol.ConstructDataReliabilityRelayRequest(ctx, rpccs.lavaChainID, vrfData, rpccs.signer, rpccs.listenEndpoint.ChainID, relayResult.Request.RelayData, providerAddress, epoch, reportedProviders, singleConsumerSession.RelayNum, singleConsumerSession.Client.GetProtocolVersion(), chainMessage.GetServiceApi().Name)
 		if err != nil {
 			return nil, utils.LavaFormatError("failed creating data reliability relay", err, utils.Attribute{Key: "GUID", Value: ctx}, utils.Attribute{Key: "relayRequestData", Value: relayResult.Request.RelayData})
 		}
-		relayResult = &lavaprotocol.RelayResult{Request: reliabilityRequest, ProviderAddress: providerAddress, Finalized: false}
+		// a new result, the original relay result is compared with it
+		reliabilityResult = &lavaprotocol.RelayResult{Request: reliabilityRequest, ProviderAddress: providerAddress, Finalized: false}
 		relayTimeout := lavaprotocol.GetTimePerCu(singleConsumerSession.LatestRelayCu) + lavasession.AverageWorldLatency + chainlib.DataReliabilityTimeoutIncrease
-		relayResult, dataReliabilityLatency, err, backoff := rpccs.relayInner(ctx, singleConsumerSession, relayResult, relayTimeout)
+		reliabilityResult, dataReliabilityLatency, err, backoff := rpccs.relayInner(ctx, singleConsumerSession, reliabilityResult, relayTimeout)
 		if err != nil {
 			failRelaySession := func(origErr error, backoff_ bool) {
 				backOffDuration := 0 * time.Second
 				if backoff_ {
 					backOffDuration = lavasession.BACKOFF_TIME_ON_FAILURE
 				}
-				time.Sleep(backOffDuration) // sleep before releasing this singleConsumerSession
+				rpccs.clock().Sleep(backOffDuration) // sleep before releasing this singleConsumerSession
 				// relay failed need to fail the session advancement
 				errReport := rpccs.consumerSessionManager.OnDataReliabilitySessionFailure(singleConsumerSession, err)
 				if errReport != nil {
@@ -428,12 +966,12 @@ func (rpccs *RPCConsumerServer) sendDataReliabilityRelayIfApplicable(ctx context
 				}
 			}
 			go failRelaySession(err, backoff)
-			return nil, utils.LavaFormatError("sendReliabilityRelay Could not get reply to reliability relay from provider", err, utils.Attribute{Key: "GUID", Value: ctx}, utils.Attribute{Key: "Address", Value: providerAddress})
+			return nil, utils.LavaFormatRepeatedError("sendReliabilityRelay Could not get reply to reliability relay from provider", err, utils.Attribute{Key: "GUID", Value: ctx}, utils.Attribute{Key: "Address", Value: providerAddress})
 		}
 
-		expectedBH, numOfProviders := rpccs.finalizationConsensus.ExpectedBlockHeight(rpccs.chainParser)
-		err = rpccs.consumerSessionManager.OnDataReliabilitySessionDone(singleConsumerSession, relayResult.Reply.LatestBlock, singleConsumerSession.LatestRelayCu, dataReliabilityLatency, singleConsumerSession.CalculateExpectedLatency(relayTimeout), expectedBH, numOfProviders, uint64(providersCount))
-		return relayResult, err
+		blockLags := rpccs.finalizationConsensus.BlockLags(rpccs.chainParser)
+		err = rpccs.consumerSessionManager.OnDataReliabilitySessionDone(singleConsumerSession, reliabilityResult.Reply.LatestBlock, singleConsumerSession.LatestRelayCu, dataReliabilityLatency, singleConsumerSession.CalculateExpectedLatency(relayTimeout), blockLags, uint64(providersCount))
+		return reliabilityResult, err
 	}
 
 	checkReliability := func() {
@@ -458,12 +996,17 @@ func (rpccs *RPCConsumerServer) sendDataReliabilityRelayIfApplicable(ctx context
 						ProviderAddress: dataReliabilitySession.ProviderPublicAddress,
 					})
 			} else {
-				utils.LavaFormatWarning("failed data reliability relay", err, utils.Attribute{Key: "GUID", Value: ctx})
+				utils.LavaFormatRepeatedWarning("failed data reliability relay", err, utils.Attribute{Key: "GUID", Value: ctx})
 			}
 		}
 		if len(dataReliabilityVerifications) > 0 {
-			report, conflicts := lavaprotocol.VerifyReliabilityResults(relayResult, dataReliabilityVerifications, numberOfReliabilitySessions)
+			report, conflicts := lavaprotocol.VerifyReliabilityResults(relayResult, dataReliabilityVerifications, numberOfReliabilitySessions, chainMessage.GetServiceApi())
 			if report {
+				conflictingProviders := []string{providerPubAddress}
+				for _, verification := range dataReliabilityVerifications {
+					conflictingProviders = append(conflictingProviders, verification.ProviderAddress)
+				}
+				rpccs.consumerSessionManager.OnDataReliabilityConflict(conflictingProviders...)
 				for _, conflict := range conflicts {
 					err := rpccs.consumerTxSender.TxConflictDetection(ctx, nil, conflict, nil)
 					if err != nil {
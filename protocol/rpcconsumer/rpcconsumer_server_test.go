@@ -0,0 +1,97 @@
+package rpcconsumer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/lavanet/lava/protocol/chainlib"
+	"github.com/lavanet/lava/protocol/lavaprotocol"
+	"github.com/lavanet/lava/protocol/lavasession"
+	"github.com/lavanet/lava/protocol/performance"
+	"github.com/lavanet/lava/protocol/provideroptimizer"
+	"github.com/lavanet/lava/utils/sigs"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	spectypes "github.com/lavanet/lava/x/spec/types"
+	"github.com/stretchr/testify/require"
+)
+
+type mockChainMessage struct {
+	chainlib.ChainMessage
+	apiInterface spectypes.ApiInterface
+	serviceApi   spectypes.ServiceApi
+}
+
+func (m *mockChainMessage) GetInterface() *spectypes.ApiInterface {
+	return &m.apiInterface
+}
+
+func (m *mockChainMessage) GetServiceApi() *spectypes.ServiceApi {
+	return &m.serviceApi
+}
+
+// signedRelay returns a relay of the consumer with the reply the provider signed for it
+func signedRelay(t *testing.T, consumerKey *btcec.PrivateKey, providerKey *btcec.PrivateKey, providerAddress string, relayData *pairingtypes.RelayPrivateData, data string) *lavaprotocol.RelayResult {
+	consumerAddress := lavaprotocol.SignerAddress(lavaprotocol.NewLocalSigner(consumerKey))
+	request := &pairingtypes.RelayRequest{
+		RelayData:    relayData,
+		RelaySession: &pairingtypes.RelaySession{SpecId: "LAV1", SessionId: 1, CuSum: 10, Provider: providerAddress, RelayNum: 1, Epoch: 20, ContentHash: sigs.CalculateContentHashForRelayData(relayData)},
+	}
+	sig, err := sigs.SignRelay(consumerKey, *request.RelaySession)
+	require.NoError(t, err)
+	request.RelaySession.Sig = sig
+	reply, err := lavaprotocol.SignRelayResponse(consumerAddress, *request, providerKey, &pairingtypes.RelayReply{Data: []byte(data), LatestBlock: 100}, true)
+	require.NoError(t, err)
+	return &lavaprotocol.RelayResult{Request: request, Reply: reply, ProviderAddress: providerAddress, Finalized: true}
+}
+
+func TestReliabilityVerifiedByCacheReportsConflictOnce(t *testing.T) {
+	ctx := context.Background()
+	consumerKey, _ := sigs.GenerateFloatingKey()
+	cachedProviderKey, cachedProviderAddress := sigs.GenerateFloatingKey()
+	providerKey, providerAddress := sigs.GenerateFloatingKey()
+	otherProviderKey, otherProviderAddress := sigs.GenerateFloatingKey()
+
+	endpoint := &lavasession.RPCEndpoint{NetworkAddress: "127.0.0.1:3333", ChainID: "LAV1", ApiInterface: "rest"}
+	consumerSessionManager := lavasession.NewConsumerSessionManager(endpoint, provideroptimizer.NewProviderOptimizer(provideroptimizer.STRATEGY_QOS))
+	pairingList := map[uint64]*lavasession.ConsumerSessionsWithProvider{}
+	for idx, address := range []string{cachedProviderAddress.String(), providerAddress.String(), otherProviderAddress.String()} {
+		pairingList[uint64(idx)] = &lavasession.ConsumerSessionsWithProvider{PublicLavaAddress: address, Sessions: map[int64]*lavasession.SingleConsumerSession{}, MaxComputeUnits: 200, PairingEpoch: 20}
+	}
+	require.NoError(t, consumerSessionManager.UpdateAllProviders(20, pairingList))
+	cache, err := performance.InitLocalCache()
+	require.NoError(t, err)
+	stateTracker := newMockConsumerStateTracker()
+	rpccs := &RPCConsumerServer{
+		listenEndpoint:         endpoint,
+		consumerSessionManager: consumerSessionManager,
+		signer:                 lavaprotocol.NewLocalSigner(consumerKey),
+		cache:                  cache,
+		cacheConflicts:         newCacheConflicts(),
+		consumerTxSender:       stateTracker,
+	}
+	chainMessage := &mockChainMessage{apiInterface: spectypes.ApiInterface{Interface: "rest"}, serviceApi: spectypes.ServiceApi{Name: "/blocks/100"}}
+	relayData := &pairingtypes.RelayPrivateData{ConnectionType: "GET", ApiUrl: "/blocks/100", RequestBlock: 100, ApiInterface: "rest"}
+
+	// a finalized reply another provider signed for this consumer in the epoch is cached
+	cached := signedRelay(t, consumerKey, cachedProviderKey, cachedProviderAddress.String(), relayData, "cached")
+	require.NoError(t, cache.SetEntry(ctx, cached.Request, "rest", nil, "LAV1", "", cached.Reply, true))
+	require.Eventually(t, func() bool {
+		_, err := cache.GetSignedEntry(ctx, cached.Request, "rest", "LAV1")
+		return err == nil
+	}, time.Second, 10*time.Millisecond)
+
+	// a matching reply is verified by the cached reply
+	require.True(t, rpccs.reliabilityVerifiedByCache(ctx, signedRelay(t, consumerKey, providerKey, providerAddress.String(), relayData, "cached"), chainMessage))
+	require.Zero(t, stateTracker.responseConflicts)
+
+	// a mismatching reply is reported right away
+	require.True(t, rpccs.reliabilityVerifiedByCache(ctx, signedRelay(t, consumerKey, providerKey, providerAddress.String(), relayData, "conflicting"), chainMessage))
+	require.Equal(t, 1, stateTracker.responseConflicts)
+
+	// the disputed cached reply isn't reported again, and doesn't verify replies anymore
+	require.False(t, rpccs.reliabilityVerifiedByCache(ctx, signedRelay(t, consumerKey, otherProviderKey, otherProviderAddress.String(), relayData, "conflicting"), chainMessage))
+	require.False(t, rpccs.reliabilityVerifiedByCache(ctx, signedRelay(t, consumerKey, otherProviderKey, otherProviderAddress.String(), relayData, "cached"), chainMessage))
+	require.Equal(t, 1, stateTracker.responseConflicts)
+}
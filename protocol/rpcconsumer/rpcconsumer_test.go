@@ -0,0 +1,119 @@
+package rpcconsumer
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/lavanet/lava/protocol/chainlib"
+	"github.com/lavanet/lava/protocol/lavaprotocol"
+	"github.com/lavanet/lava/protocol/lavasession"
+	"github.com/lavanet/lava/protocol/provideroptimizer"
+	conflicttypes "github.com/lavanet/lava/x/conflict/types"
+	"github.com/stretchr/testify/require"
+)
+
+// mockConsumerStateTracker counts the registrations that are still active
+type mockConsumerStateTracker struct {
+	lock                   sync.Mutex
+	consumerSessionManager map[*lavasession.ConsumerSessionManager]int
+	finalizationConsensus  map[*lavaprotocol.FinalizationConsensus]int
+	responseConflicts      int
+}
+
+func newMockConsumerStateTracker() *mockConsumerStateTracker {
+	return &mockConsumerStateTracker{consumerSessionManager: map[*lavasession.ConsumerSessionManager]int{}, finalizationConsensus: map[*lavaprotocol.FinalizationConsensus]int{}}
+}
+
+func (m *mockConsumerStateTracker) RegisterConsumerSessionManagerForPairingUpdates(ctx context.Context, consumerSessionManager *lavasession.ConsumerSessionManager) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.consumerSessionManager[consumerSessionManager]++
+}
+
+func (m *mockConsumerStateTracker) UnregisterConsumerSessionManagerForPairingUpdates(ctx context.Context, consumerSessionManager *lavasession.ConsumerSessionManager) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.consumerSessionManager[consumerSessionManager]--
+}
+
+func (m *mockConsumerStateTracker) RegisterChainParserForSpecUpdates(ctx context.Context, chainParser chainlib.ChainParser, chainID string) error {
+	return nil
+}
+
+func (m *mockConsumerStateTracker) RegisterFinalizationConsensusForUpdates(ctx context.Context, finalizationConsensus *lavaprotocol.FinalizationConsensus) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.finalizationConsensus[finalizationConsensus]++
+}
+
+func (m *mockConsumerStateTracker) UnregisterFinalizationConsensusForUpdates(ctx context.Context, finalizationConsensus *lavaprotocol.FinalizationConsensus) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.finalizationConsensus[finalizationConsensus]--
+}
+
+func (m *mockConsumerStateTracker) RegisterProjectCuBudgetForUpdates(ctx context.Context, budget *lavasession.ProjectCuBudget, chainID string) {
+}
+
+func (m *mockConsumerStateTracker) TxConflictDetection(ctx context.Context, finalizationConflict *conflicttypes.FinalizationConflict, responseConflict *conflicttypes.ResponseConflict, sameProviderConflict *conflicttypes.FinalizationConflict) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if responseConflict != nil {
+		m.responseConflicts++
+	}
+	return nil
+}
+
+func (m *mockConsumerStateTracker) registered() int {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	registered := 0
+	for _, count := range m.consumerSessionManager {
+		registered += count
+	}
+	for _, count := range m.finalizationConsensus {
+		registered += count
+	}
+	return registered
+}
+
+func TestReloadUnregistersRemovedEndpoints(t *testing.T) {
+	ctx := context.Background()
+	stateTracker := newMockConsumerStateTracker()
+	rpcc := &RPCConsumer{consumerStateTracker: stateTracker, endpoints: map[string]*consumerEndpoint{}}
+	rpcc.settings = &ConsumerSettings{Endpoints: []*lavasession.RPCEndpoint{
+		{NetworkAddress: "127.0.0.1:3333", ChainID: "LAV1", ApiInterface: "rest"},
+		{NetworkAddress: "127.0.0.1:3334", ChainID: "LAV1", ApiInterface: "tendermintrpc"},
+	}}
+	// the endpoints are registered the way startEndpoint registers them, without listening
+	canceled := map[string]bool{}
+	for _, endpoint := range rpcc.settings.Endpoints {
+		endpoint := endpoint
+		consumerSessionManager := lavasession.NewConsumerSessionManager(endpoint, provideroptimizer.NewProviderOptimizer(provideroptimizer.STRATEGY_QOS))
+		finalizationConsensus := &lavaprotocol.FinalizationConsensus{}
+		stateTracker.RegisterConsumerSessionManagerForPairingUpdates(ctx, consumerSessionManager)
+		stateTracker.RegisterFinalizationConsensusForUpdates(ctx, finalizationConsensus)
+		rpcc.endpoints[endpointConfigKey(endpoint, &rpcc.settings.Listener)] = &consumerEndpoint{
+			endpoint:               endpoint,
+			server:                 &RPCConsumerServer{},
+			consumerSessionManager: consumerSessionManager,
+			finalizationConsensus:  finalizationConsensus,
+			cancel:                 func() { canceled[endpoint.NetworkAddress] = true },
+		}
+	}
+	require.Equal(t, 4, stateTracker.registered())
+
+	// a reload without the rest endpoint stops it and drops its registrations, the other endpoint keeps its own
+	rpcc.applySettings(ctx, &ConsumerSettings{Endpoints: rpcc.settings.Endpoints[1:]})
+	require.Len(t, rpcc.endpoints, 1)
+	require.True(t, canceled["127.0.0.1:3333"])
+	require.False(t, canceled["127.0.0.1:3334"])
+	require.Equal(t, 2, stateTracker.registered())
+
+	// stopping the consumer drops the rest
+	rpcc.stopEndpoints(ctx)
+	require.Empty(t, rpcc.endpoints)
+	require.True(t, canceled["127.0.0.1:3334"])
+	require.Equal(t, 0, stateTracker.registered())
+}
@@ -0,0 +1,306 @@
+package rpcconsumer
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/lavanet/lava/app"
+	"github.com/lavanet/lava/protocol/chainlib"
+	"github.com/lavanet/lava/protocol/lavaprotocol"
+	"github.com/lavanet/lava/protocol/lavasession"
+	"github.com/lavanet/lava/protocol/statetracker"
+	"github.com/lavanet/lava/utils"
+	"github.com/lavanet/lava/utils/sigs"
+	epochstoragetypes "github.com/lavanet/lava/x/epochstorage/types"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	spectypes "github.com/lavanet/lava/x/spec/types"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+)
+
+const (
+	TestRelayUrlFlagName            = "url"
+	TestRelayConnectionTypeFlagName = "connection-type"
+	TestRelayEndpointFlagName       = "endpoint"
+)
+
+// testRelay is a single relay sent to a chosen provider, outside of the pairing selection of a running consumer
+type testRelay struct {
+	clientCtx       client.Context
+	signer          lavaprotocol.Signer
+	providerAddress string
+	chainID         string
+	apiInterface    string
+	url             string
+	data            string
+	connectionType  string
+	endpoint        string // the provider's network address, looked up in its stake entry when empty
+	geolocation     uint64
+	providerTLS     *lavasession.ProviderTLSConfig // the provider is dialed over tls when set, like the consumer dials it
+}
+
+func CreateTestRelayCobraCommand() *cobra.Command {
+	cmdTestRelay := &cobra.Command{
+		Use:   "relay [provider-address] [spec-chain-id] [api-interface] [data]",
+		Short: `relay sends a single signed relay to a provider and prints the request, reply and their verification`,
+		Long: `relay sends a single signed relay to a provider, whether or not the pairing would pick it, and prints the full request and reply,
+		the relay latency, the verification of the provider's signature and reply timestamp and the finalization data of the reply.
+		the relay is paid by the --from key like any other relay of the consumer.
+		data is the request body, for rest and grpc apis the path or method is set with --` + TestRelayUrlFlagName,
+		Example: `lavad test relay lava@1hm... LAV1 tendermintrpc '{"jsonrpc":"2.0","method":"status","params":[],"id":1}' --from alice
+lavad test relay lava@1hm... LAV1 rest --url /cosmos/base/tendermint/v1beta1/blocks/latest --from alice`,
+		Args: cobra.RangeArgs(3, 4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			networkChainId, err := cmd.Flags().GetString(flags.FlagChainID)
+			if err != nil {
+				return err
+			}
+			logLevel, err := cmd.Flags().GetString(flags.FlagLogLevel)
+			if err != nil {
+				utils.LavaFormatFatal("failed to read log level flag", err)
+			}
+			utils.LoggingLevel(logLevel)
+			ctx := context.Background()
+			lavaNodes, err := cmd.Flags().GetStringSlice(statetracker.LavaNodesFlagName)
+			if err != nil {
+				utils.LavaFormatFatal("failed to read lava nodes flag", err)
+			}
+			clientCtx, err = statetracker.WithLavaNodes(ctx, clientCtx, lavaNodes)
+			if err != nil {
+				return err
+			}
+			clientCtx = clientCtx.WithChainID(networkChainId)
+			keyName, err := sigs.GetKeyName(clientCtx)
+			if err != nil {
+				return utils.LavaFormatError("failed getting key name from clientCtx", err)
+			}
+			signerBackend, err := cmd.Flags().GetString(lavaprotocol.SignerFlagName)
+			if err != nil {
+				utils.LavaFormatFatal("failed to read signer flag", err)
+			}
+			remoteSignerAddress, err := cmd.Flags().GetString(lavaprotocol.RemoteSignerAddressFlagName)
+			if err != nil {
+				utils.LavaFormatFatal("failed to read remote signer address flag", err)
+			}
+			signer, err := lavaprotocol.NewSigner(ctx, clientCtx, keyName, signerBackend, remoteSignerAddress)
+			if err != nil {
+				return utils.LavaFormatError("failed creating relay signer", err, utils.Attribute{Key: "keyName", Value: keyName}, utils.Attribute{Key: "signer", Value: signerBackend})
+			}
+			relay := &testRelay{clientCtx: clientCtx, signer: signer, providerAddress: args[0], chainID: args[1], apiInterface: args[2]}
+			if len(args) == 4 {
+				relay.data = args[3]
+			}
+			if relay.url, err = cmd.Flags().GetString(TestRelayUrlFlagName); err != nil {
+				return err
+			}
+			if relay.connectionType, err = cmd.Flags().GetString(TestRelayConnectionTypeFlagName); err != nil {
+				return err
+			}
+			if relay.endpoint, err = cmd.Flags().GetString(TestRelayEndpointFlagName); err != nil {
+				return err
+			}
+			if relay.geolocation, err = cmd.Flags().GetUint64(lavasession.GeolocationFlag); err != nil {
+				return err
+			}
+			if relay.providerTLS, err = providerTLSFromFlags(cmd); err != nil {
+				return utils.LavaFormatError("failed loading the provider tls config", err)
+			}
+			return relay.send(ctx)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmdTestRelay)
+	cmdTestRelay.MarkFlagRequired(flags.FlagFrom)
+	cmdTestRelay.Flags().String(flags.FlagChainID, app.Name, "network chain id")
+	cmdTestRelay.Flags().StringSlice(statetracker.LavaNodesFlagName, []string{}, "comma separated uris of lava nodes to fail over to and spread state queries between, in addition to --node")
+	cmdTestRelay.Flags().Uint64(lavasession.GeolocationFlag, 0, "prefer the provider endpoint staked in this geolocation, any endpoint of the api interface when 0")
+	cmdTestRelay.Flags().String(TestRelayUrlFlagName, "", "the rest path or grpc method of the relay, empty for jsonrpc and tendermintrpc")
+	cmdTestRelay.Flags().String(TestRelayConnectionTypeFlagName, "", "the http method of the relay, defaults to POST for jsonrpc and for rest with data and to GET for rest without")
+	cmdTestRelay.Flags().String(TestRelayEndpointFlagName, "", "provider network address to send the relay to instead of the endpoint in its stake entry")
+	addProviderTLSFlags(cmdTestRelay)
+	cmdTestRelay.Flags().String(lavaprotocol.SignerFlagName, lavaprotocol.LocalSignerBackend, "how the relay is signed: "+lavaprotocol.LocalSignerBackend+", "+lavaprotocol.KeyringSignerBackend+" or "+lavaprotocol.RemoteSignerBackend)
+	cmdTestRelay.Flags().String(lavaprotocol.RemoteSignerAddressFlagName, "", "grpc address of a relay signer service holding the consumer key, such as in an HSM")
+	return cmdTestRelay
+}
+
+func (tr *testRelay) send(ctx context.Context) error {
+	specResp, err := spectypes.NewQueryClient(tr.clientCtx).Spec(ctx, &spectypes.QueryGetSpecRequest{ChainID: tr.chainID})
+	if err != nil {
+		return utils.LavaFormatError("failed querying the spec", err, utils.Attribute{Key: "chainID", Value: tr.chainID})
+	}
+	chainParser, err := chainlib.NewChainParser(tr.apiInterface)
+	if err != nil {
+		return err
+	}
+	chainParser.SetSpec(specResp.Spec)
+	chainMessage, err := chainParser.ParseMsg(tr.url, []byte(tr.data), tr.defaultConnectionType())
+	if err != nil {
+		return utils.LavaFormatError("failed parsing the relay with the spec", err, utils.Attribute{Key: "url", Value: tr.url}, utils.Attribute{Key: "data", Value: tr.data})
+	}
+
+	consumerAddress := lavaprotocol.SignerAddress(tr.signer).String()
+	pairingResp, err := pairingtypes.NewQueryClient(tr.clientCtx).GetPairing(ctx, &pairingtypes.QueryGetPairingRequest{ChainID: tr.chainID, Client: consumerAddress})
+	if err != nil {
+		return utils.LavaFormatError("failed querying the pairing", err, utils.Attribute{Key: "chainID", Value: tr.chainID}, utils.Attribute{Key: "consumer", Value: consumerAddress})
+	}
+	paired := false
+	for _, stakeEntry := range pairingResp.Providers {
+		if stakeEntry.Address == tr.providerAddress {
+			paired = true
+		}
+	}
+	if !paired {
+		// the provider rejects relays of consumers it isn't paired with, which is worth seeing when debugging it
+		utils.LavaFormatWarning("provider isn't in the pairing of the consumer, the relay is sent anyway", nil, utils.Attribute{Key: "provider", Value: tr.providerAddress}, utils.Attribute{Key: "epoch", Value: pairingResp.CurrentEpoch})
+	}
+	networkAddress, err := tr.providerNetworkAddress(ctx)
+	if err != nil {
+		return err
+	}
+
+	conn, err := tr.dial(ctx, networkAddress)
+	if err != nil {
+		return utils.LavaFormatError("failed connecting to the provider", err, utils.Attribute{Key: "endpoint", Value: networkAddress})
+	}
+	defer conn.Close()
+	relayerClient := pairingtypes.NewRelayerClient(conn)
+
+	// probe first to relay with the protocol version the provider speaks
+	guid := utils.GenerateUniqueIdentifier()
+	probeSentTime := time.Now()
+	probeReply, err := relayerClient.Probe(ctx, &pairingtypes.ProbeRequest{Guid: guid, ProtocolVersion: lavasession.ProtocolVersion, SpecId: tr.chainID, ApiInterface: tr.apiInterface})
+	if err != nil {
+		return utils.LavaFormatError("failed probing the provider", err, utils.Attribute{Key: "endpoint", Value: networkAddress})
+	}
+	probeLatency := time.Since(probeSentTime)
+	protocolVersion, err := lavasession.NegotiateProtocolVersion(probeReply.ProtocolVersion)
+	if err != nil {
+		return err
+	}
+
+	cu := chainMessage.GetServiceApi().ComputeUnits
+	consumerSession := &lavasession.SingleConsumerSession{
+		SessionId:     rand.Int63(),
+		RelayNum:      1,
+		LatestRelayCu: cu,
+		Client:        &lavasession.ConsumerSessionsWithProvider{PublicLavaAddress: tr.providerAddress, PairingEpoch: pairingResp.CurrentEpoch, ProtocolVersion: protocolVersion},
+	}
+	ctx = utils.AppendUniqueIdentifier(ctx, guid)
+	relayData := lavaprotocol.NewRelayData(ctx, tr.defaultConnectionType(), tr.url, []byte(tr.data), chainMessage.RequestedBlock(), tr.apiInterface)
+	relayRequest, err := lavaprotocol.ConstructRelayRequest(ctx, tr.signer, tr.clientCtx.ChainID, tr.chainID, relayData, tr.providerAddress, consumerSession, int64(pairingResp.CurrentEpoch), nil, chainMessage.GetServiceApi().Name)
+	if err != nil {
+		return utils.LavaFormatError("failed constructing the relay", err)
+	}
+	fmt.Println("request:")
+	if err = tr.clientCtx.PrintProto(relayRequest); err != nil {
+		return err
+	}
+
+	relayTimeout := lavaprotocol.GetTimePerCu(cu) + lavasession.AverageWorldLatency
+	relayCtx, relayCancel := context.WithTimeout(ctx, relayTimeout)
+	defer relayCancel()
+	relaySentTime := time.Now()
+	reply, err := relayerClient.Relay(relayCtx, relayRequest)
+	relayLatency := time.Since(relaySentTime)
+	if err != nil {
+		return utils.LavaFormatError("relay failed", err, utils.Attribute{Key: "provider", Value: tr.providerAddress}, utils.Attribute{Key: "latency", Value: relayLatency})
+	}
+	fmt.Println("reply:")
+	if err = tr.clientCtx.PrintProto(reply); err != nil {
+		return err
+	}
+	fmt.Printf("reply data: %s\n", reply.Data)
+
+	fmt.Printf("provider: %s endpoint: %s paired: %t epoch: %d\n", tr.providerAddress, networkAddress, paired, pairingResp.CurrentEpoch)
+	fmt.Printf("protocol version: %d probe latency: %s relay latency: %s timeout: %s\n", protocolVersion, probeLatency, relayLatency, relayTimeout)
+	if probeReply.Timestamp != 0 {
+		fmt.Printf("provider clock skew: %s latest block on probe: %d\n", time.UnixMilli(probeReply.Timestamp).Sub(probeSentTime.Add(probeLatency/2)), probeReply.LatestBlock)
+	}
+	fmt.Printf("signature: %s\n", verificationResult(lavaprotocol.VerifyRelayReply(reply, relayRequest, tr.providerAddress)))
+	fmt.Printf("timestamp: %s\n", verificationResult(lavaprotocol.VerifyReplyTimestamp(reply, relaySentTime, relaySentTime.Add(relayLatency), lavaprotocol.DefaultReplyMaxClockSkew, tr.providerAddress)))
+
+	lavaprotocol.UpdateRequestedBlock(relayRequest.RelayData, reply)
+	_, _, blockDistanceForFinalizedData, _ := chainParser.ChainBlockStats()
+	finalizedBlocks, _, err := lavaprotocol.VerifyFinalizationData(reply, relayRequest, tr.providerAddress, 0, blockDistanceForFinalizedData)
+	fmt.Printf("finalization data: %s\n", verificationResult(err))
+	fmt.Printf("latest block: %d requested block: %d finalized: %t\n", reply.LatestBlock, relayRequest.RelayData.RequestBlock, spectypes.IsFinalizedBlock(relayRequest.RelayData.RequestBlock, reply.LatestBlock, blockDistanceForFinalizedData))
+	blocks := make([]int64, 0, len(finalizedBlocks))
+	for block := range finalizedBlocks {
+		blocks = append(blocks, block)
+	}
+	sort.Slice(blocks, func(i, j int) bool { return blocks[i] < blocks[j] })
+	for _, block := range blocks {
+		fmt.Printf("  finalized block %d: %s\n", block, finalizedBlocks[block])
+	}
+	return nil
+}
+
+// dial connects to the provider with the transport the consumer uses for it, so the relay verifies the endpoint the same way
+func (tr *testRelay) dial(ctx context.Context, networkAddress string) (*grpc.ClientConn, error) {
+	return lavasession.DialProvider(ctx, tr.providerTLS, tr.providerAddress, networkAddress)
+}
+
+// defaultConnectionType is the http method the listeners of the api interface send relays with
+func (tr *testRelay) defaultConnectionType() string {
+	if tr.connectionType != "" {
+		return tr.connectionType
+	}
+	switch tr.apiInterface {
+	case spectypes.APIInterfaceJsonRPC:
+		return http.MethodPost
+	case spectypes.APIInterfaceRest:
+		if tr.data == "" {
+			return http.MethodGet
+		}
+		return http.MethodPost
+	}
+	return ""
+}
+
+// providerNetworkAddress returns the endpoint flag or the provider's staked endpoint for the api interface,
+// preferring one in the requested geolocation
+func (tr *testRelay) providerNetworkAddress(ctx context.Context) (string, error) {
+	if tr.endpoint != "" {
+		return tr.endpoint, nil
+	}
+	providersResp, err := pairingtypes.NewQueryClient(tr.clientCtx).Providers(ctx, &pairingtypes.QueryProvidersRequest{ChainID: tr.chainID, ShowFrozen: true})
+	if err != nil {
+		return "", utils.LavaFormatError("failed querying the providers", err, utils.Attribute{Key: "chainID", Value: tr.chainID})
+	}
+	var endpoints []epochstoragetypes.Endpoint
+	for _, stakeEntry := range providersResp.StakeEntry {
+		if stakeEntry.Address == tr.providerAddress {
+			endpoints = stakeEntry.Endpoints
+		}
+	}
+	networkAddress := ""
+	for _, endpoint := range endpoints {
+		if endpoint.UseType != tr.apiInterface {
+			continue
+		}
+		if networkAddress == "" || endpoint.Geolocation == tr.geolocation {
+			networkAddress = endpoint.IPPORT
+		}
+	}
+	if networkAddress == "" {
+		return "", utils.LavaFormatError("provider has no staked endpoint for the api interface, set one with --"+TestRelayEndpointFlagName, nil, utils.Attribute{Key: "provider", Value: tr.providerAddress}, utils.Attribute{Key: "chainID", Value: tr.chainID}, utils.Attribute{Key: "apiInterface", Value: tr.apiInterface})
+	}
+	return networkAddress, nil
+}
+
+func verificationResult(err error) string {
+	if err != nil {
+		return "failed: " + err.Error()
+	}
+	return "ok"
+}
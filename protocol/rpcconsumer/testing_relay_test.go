@@ -0,0 +1,81 @@
+package rpcconsumer
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/lavanet/lava/protocol/lavasession"
+	spectypes "github.com/lavanet/lava/x/spec/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+// serveTLSProvider serves grpc over tls with a self signed certificate, returning its address and certificate
+func serveTLSProvider(t *testing.T) (string, *x509.Certificate) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: "provider"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	raw, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	certificate, err := x509.ParseCertificate(raw)
+	require.NoError(t, err)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := grpc.NewServer(grpc.Creds(credentials.NewServerTLSFromCert(&tls.Certificate{Certificate: [][]byte{raw}, PrivateKey: key})))
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+	return listener.Addr().String(), certificate
+}
+
+func TestTestRelayDialsWithProviderTLS(t *testing.T) {
+	provider := "lava@provider"
+	addr, certificate := serveTLSProvider(t)
+
+	// the provider is verified by its pins like the consumer verifies it
+	tr := &testRelay{providerAddress: provider, providerTLS: &lavasession.ProviderTLSConfig{Pins: map[string][]string{provider: {lavasession.PublicKeyPin(certificate)}}}}
+	conn, err := tr.dial(context.Background(), addr)
+	require.NoError(t, err)
+	conn.Close()
+
+	// an endpoint with another key isn't dialed
+	otherAddr, _ := serveTLSProvider(t)
+	_, err = tr.dial(context.Background(), otherAddr)
+	require.True(t, lavasession.ProviderIdentityMismatchError.Is(err))
+}
+
+func TestTestRelayDefaultConnectionType(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		relay    testRelay
+		expected string
+	}{
+		{name: "jsonrpc", relay: testRelay{apiInterface: spectypes.APIInterfaceJsonRPC}, expected: http.MethodPost},
+		{name: "rest without data", relay: testRelay{apiInterface: spectypes.APIInterfaceRest}, expected: http.MethodGet},
+		{name: "rest with data", relay: testRelay{apiInterface: spectypes.APIInterfaceRest, data: "{}"}, expected: http.MethodPost},
+		{name: "flag", relay: testRelay{apiInterface: spectypes.APIInterfaceRest, connectionType: http.MethodPut}, expected: http.MethodPut},
+		{name: "grpc", relay: testRelay{apiInterface: spectypes.APIInterfaceGrpc}, expected: ""},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, tt.relay.defaultConnectionType())
+		})
+	}
+}
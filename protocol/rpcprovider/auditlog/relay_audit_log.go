@@ -0,0 +1,177 @@
+package auditlog
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/lavanet/lava/utils"
+)
+
+const (
+	RelayAuditLogFlag           = "relay-audit-log"
+	RelayAuditLogMaxSizeFlag    = "relay-audit-log-max-size-mb"
+	RelayAuditLogMaxBackupsFlag = "relay-audit-log-max-backups"
+	RelayAuditLogRedactionFlag  = "relay-audit-log-redaction"
+	DefaultMaxSizeMB            = 100
+	DefaultMaxBackups           = 10
+	auditLogQueueSize           = 1000 // entries waiting to be written, new entries are dropped when it's full so relays never wait on the disk
+)
+
+// RedactionMode sets what is kept of the relay request payload
+type RedactionMode string
+
+const (
+	RedactNone RedactionMode = "none" // the payload is written as is
+	RedactHash RedactionMode = "hash" // only the sha256 of the payload is written, enough to match it against a disputed request
+	RedactDrop RedactionMode = "drop" // no trace of the payload is written
+)
+
+func ParseRedactionMode(mode string) (RedactionMode, error) {
+	switch redactionMode := RedactionMode(mode); redactionMode {
+	case RedactNone, RedactHash, RedactDrop:
+		return redactionMode, nil
+	}
+	return "", fmt.Errorf("invalid relay audit log redaction %q, expected one of: %s, %s, %s", mode, RedactNone, RedactHash, RedactDrop)
+}
+
+type Config struct {
+	Path       string // an empty path disables the audit log
+	MaxSizeMB  int64
+	MaxBackups int
+	Redaction  RedactionMode
+}
+
+// RelayAuditEntry is a single line of the audit log
+type RelayAuditEntry struct {
+	Time           time.Time `json:"time"`
+	GUID           uint64    `json:"guid,omitempty"`
+	Consumer       string    `json:"consumer"`
+	ChainID        string    `json:"chain_id"`
+	ApiInterface   string    `json:"api_interface"`
+	Api            string    `json:"api"`
+	Epoch          int64     `json:"epoch"`
+	SessionID      uint64    `json:"session_id"`
+	RelayNum       uint64    `json:"relay_num"`
+	CU             uint64    `json:"cu"`
+	RequestedBlock int64     `json:"requested_block"`
+	LatencyMs      int64     `json:"latency_ms"`
+	ResultSize     int       `json:"result_size"`
+	Error          string    `json:"error,omitempty"`
+	Request        string    `json:"request,omitempty"`
+	RequestHash    string    `json:"request_hash,omitempty"`
+}
+
+// RelayAuditLog writes relay metadata as json lines to a rotating file, for resolving disputes over the relays a provider served.
+// a nil audit log records nothing
+type RelayAuditLog struct {
+	redaction RedactionMode
+	file      *rotatingFile
+	lock      sync.RWMutex // held for reading while queueing, so closing the queue can't race a send
+	closed    bool
+	queue     chan *RelayAuditEntry
+	done      chan struct{}
+	dropped   uint64
+}
+
+// NewRelayAuditLog returns nil when no path is configured
+func NewRelayAuditLog(config Config) (*RelayAuditLog, error) {
+	if config.Path == "" {
+		return nil, nil
+	}
+	if config.Redaction == "" {
+		config.Redaction = RedactHash
+	}
+	if _, err := ParseRedactionMode(string(config.Redaction)); err != nil {
+		return nil, err
+	}
+	file, err := newRotatingFile(config.Path, config.MaxSizeMB*1024*1024, config.MaxBackups)
+	if err != nil {
+		return nil, utils.LavaFormatError("failed opening relay audit log", err, utils.Attribute{Key: "path", Value: config.Path})
+	}
+	ral := &RelayAuditLog{
+		redaction: config.Redaction,
+		file:      file,
+		queue:     make(chan *RelayAuditEntry, auditLogQueueSize),
+		done:      make(chan struct{}),
+	}
+	go ral.run()
+	return ral, nil
+}
+
+// Record redacts the request of the entry and queues it for writing
+func (ral *RelayAuditLog) Record(entry *RelayAuditEntry) {
+	if ral == nil {
+		return
+	}
+	ral.redact(entry)
+	ral.lock.RLock()
+	defer ral.lock.RUnlock()
+	if ral.closed {
+		return
+	}
+	select {
+	case ral.queue <- entry:
+	default:
+		if atomic.AddUint64(&ral.dropped, 1)%auditLogQueueSize == 1 { // don't flood the log when the disk can't keep up
+			utils.LavaFormatWarning("relay audit log queue is full, dropping entries", nil, utils.Attribute{Key: "dropped", Value: atomic.LoadUint64(&ral.dropped)})
+		}
+	}
+}
+
+func (ral *RelayAuditLog) redact(entry *RelayAuditEntry) {
+	switch ral.redaction {
+	case RedactNone:
+		return
+	case RedactHash:
+		if entry.Request != "" {
+			hash := sha256.Sum256([]byte(entry.Request))
+			entry.RequestHash = hex.EncodeToString(hash[:])
+		}
+	}
+	entry.Request = ""
+}
+
+// Dropped returns the number of entries dropped on a full queue
+func (ral *RelayAuditLog) Dropped() uint64 {
+	if ral == nil {
+		return 0
+	}
+	return atomic.LoadUint64(&ral.dropped)
+}
+
+func (ral *RelayAuditLog) run() {
+	defer close(ral.done)
+	for entry := range ral.queue {
+		line, err := json.Marshal(entry)
+		if err != nil {
+			utils.LavaFormatWarning("failed encoding relay audit entry", err, utils.Attribute{Key: "GUID", Value: entry.GUID})
+			continue
+		}
+		_, err = ral.file.Write(append(line, '\n'))
+		if err != nil {
+			utils.LavaFormatWarning("failed writing relay audit entry", err, utils.Attribute{Key: "GUID", Value: entry.GUID})
+		}
+	}
+}
+
+// Close writes the queued entries and closes the file, entries recorded after Close are dropped
+func (ral *RelayAuditLog) Close() error {
+	if ral == nil {
+		return nil
+	}
+	ral.lock.Lock()
+	if ral.closed {
+		ral.lock.Unlock()
+		return nil
+	}
+	ral.closed = true
+	close(ral.queue)
+	ral.lock.Unlock()
+	<-ral.done
+	return ral.file.Close()
+}
@@ -0,0 +1,99 @@
+package auditlog
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func readAuditEntries(t *testing.T, path string) (entries []RelayAuditEntry) {
+	file, err := os.Open(path)
+	require.NoError(t, err)
+	defer file.Close()
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		entry := RelayAuditEntry{}
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &entry))
+		entries = append(entries, entry)
+	}
+	require.NoError(t, scanner.Err())
+	return entries
+}
+
+func TestRelayAuditLogRedaction(t *testing.T) {
+	request := `{"jsonrpc":"2.0","method":"eth_getBalance","params":["0xabc","latest"],"id":1}`
+	for _, redaction := range []RedactionMode{RedactNone, RedactHash, RedactDrop} {
+		t.Run(string(redaction), func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "audit.log")
+			relayAuditLog, err := NewRelayAuditLog(Config{Path: path, Redaction: redaction})
+			require.NoError(t, err)
+			relayAuditLog.Record(&RelayAuditEntry{Consumer: "consumer1", ChainID: "ETH1", Api: "eth_getBalance", CU: 10, Request: request})
+			require.NoError(t, relayAuditLog.Close())
+
+			entries := readAuditEntries(t, path)
+			require.Len(t, entries, 1)
+			require.Equal(t, "consumer1", entries[0].Consumer)
+			require.Equal(t, uint64(10), entries[0].CU)
+			switch redaction {
+			case RedactNone:
+				require.Equal(t, request, entries[0].Request)
+			case RedactHash:
+				require.Empty(t, entries[0].Request)
+				require.Len(t, entries[0].RequestHash, 64)
+			case RedactDrop:
+				require.Empty(t, entries[0].Request)
+				require.Empty(t, entries[0].RequestHash)
+			}
+		})
+	}
+}
+
+func TestRelayAuditLogDisabled(t *testing.T) {
+	relayAuditLog, err := NewRelayAuditLog(Config{})
+	require.NoError(t, err)
+	require.Nil(t, relayAuditLog)
+	relayAuditLog.Record(&RelayAuditEntry{})
+	require.NoError(t, relayAuditLog.Close())
+
+	_, err = NewRelayAuditLog(Config{Path: filepath.Join(t.TempDir(), "audit.log"), Redaction: "partial"})
+	require.Error(t, err)
+}
+
+func TestRotatingFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+	rotatingFile, err := newRotatingFile(path, 10, 2)
+	require.NoError(t, err)
+	currentTime := time.Now()
+	rotatingFile.now = func() time.Time {
+		currentTime = currentTime.Add(time.Second)
+		return currentTime
+	}
+	for i := 0; i < 5; i++ {
+		_, err = rotatingFile.Write([]byte("12345678\n"))
+		require.NoError(t, err)
+	}
+	require.NoError(t, rotatingFile.Close())
+
+	// every write over the size starts a new file and only the newest backups are kept
+	backups, err := rotatingFile.backups()
+	require.NoError(t, err)
+	require.Len(t, backups, 2)
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, "12345678\n", string(content))
+
+	// reopening appends to the existing file
+	rotatingFile, err = newRotatingFile(path, 100, 2)
+	require.NoError(t, err)
+	_, err = rotatingFile.Write([]byte("12345678\n"))
+	require.NoError(t, err)
+	require.NoError(t, rotatingFile.Close())
+	content, err = os.ReadFile(path)
+	require.NoError(t, err)
+	require.Len(t, content, 18)
+}
@@ -0,0 +1,96 @@
+package auditlog
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+)
+
+const rotatedFileTimeFormat = "20060102T150405.000000000"
+
+// rotatingFile appends to a file and moves it aside once it grows over maxSize, keeping up to maxBackups rotated files.
+// it is written by a single routine so it isn't locked
+type rotatingFile struct {
+	path       string
+	maxSize    int64 // 0 never rotates
+	maxBackups int   // 0 keeps all rotated files
+	file       *os.File
+	size       int64
+	now        func() time.Time
+}
+
+func newRotatingFile(path string, maxSize int64, maxBackups int) (*rotatingFile, error) {
+	rf := &rotatingFile{path: path, maxSize: maxSize, maxBackups: maxBackups, now: time.Now}
+	return rf, rf.open()
+}
+
+func (rf *rotatingFile) open() error {
+	file, err := os.OpenFile(rf.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+	if err != nil {
+		return err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return err
+	}
+	rf.file = file
+	rf.size = info.Size()
+	return nil
+}
+
+func (rf *rotatingFile) Write(data []byte) (int, error) {
+	if rf.maxSize > 0 && rf.size > 0 && rf.size+int64(len(data)) > rf.maxSize {
+		if err := rf.rotate(); err != nil {
+			return 0, err
+		}
+	}
+	written, err := rf.file.Write(data)
+	rf.size += int64(written)
+	return written, err
+}
+
+func (rf *rotatingFile) rotate() error {
+	if err := rf.file.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(rf.path, rf.path+"."+rf.now().UTC().Format(rotatedFileTimeFormat)); err != nil {
+		return err
+	}
+	if err := rf.open(); err != nil {
+		return err
+	}
+	return rf.removeOldBackups()
+}
+
+func (rf *rotatingFile) removeOldBackups() error {
+	if rf.maxBackups <= 0 {
+		return nil
+	}
+	backups, err := rf.backups()
+	if err != nil {
+		return err
+	}
+	for len(backups) > rf.maxBackups {
+		if err := os.Remove(backups[0]); err != nil {
+			return err
+		}
+		backups = backups[1:]
+	}
+	return nil
+}
+
+// backups returns the rotated files from the oldest, the timestamp suffix sorts by name
+func (rf *rotatingFile) backups() ([]string, error) {
+	backups, err := filepath.Glob(rf.path + ".*")
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(backups)
+	return backups, nil
+}
+
+func (rf *rotatingFile) Close() error {
+	return rf.file.Close()
+}
@@ -0,0 +1,54 @@
+package rpcprovider
+
+import (
+	"sync"
+
+	"github.com/lavanet/lava/protocol/lavasession"
+	"github.com/lavanet/lava/utils"
+)
+
+// badgeCuTracker sums the cu of the sessions of each badge user, the chain pays a provider up to the badge's cu allocation
+// for all the sessions of a badge user in an epoch, while each relay only proves the cu of its own session
+type badgeCuTracker struct {
+	lock   sync.Mutex
+	epochs map[uint64]map[string]map[uint64]uint64 // epoch -> badge user -> session id -> cu sum
+}
+
+func newBadgeCuTracker() *badgeCuTracker {
+	return &badgeCuTracker{epochs: map[uint64]map[string]map[uint64]uint64{}}
+}
+
+// addRelay records the cu sum of a badge user's session, it fails without recording when the sessions of the badge user
+// would be over cuAllocation. epochs up to blockedEpoch aren't served anymore and are dropped
+func (bct *badgeCuTracker) addRelay(epoch uint64, badgeUser string, sessionID uint64, cuSum uint64, cuAllocation uint64, blockedEpoch uint64) error {
+	bct.lock.Lock()
+	defer bct.lock.Unlock()
+	for trackedEpoch := range bct.epochs {
+		if trackedEpoch <= blockedEpoch {
+			delete(bct.epochs, trackedEpoch)
+		}
+	}
+	users, ok := bct.epochs[epoch]
+	if !ok {
+		users = map[string]map[uint64]uint64{}
+		bct.epochs[epoch] = users
+	}
+	sessions, ok := users[badgeUser]
+	if !ok {
+		sessions = map[uint64]uint64{}
+		users[badgeUser] = sessions
+	}
+	usedCu := cuSum
+	for trackedSessionID, sessionCu := range sessions {
+		if trackedSessionID != sessionID {
+			usedCu += sessionCu
+		}
+	}
+	if usedCu > cuAllocation {
+		return utils.LavaFormatWarning("badge user is over its cu allocation", lavasession.BadgeCuAllocationExceededError, utils.Attribute{Key: "badgeUser", Value: badgeUser}, utils.Attribute{Key: "epoch", Value: epoch}, utils.Attribute{Key: "usedCu", Value: usedCu}, utils.Attribute{Key: "cuAllocation", Value: cuAllocation})
+	}
+	if cuSum > sessions[sessionID] {
+		sessions[sessionID] = cuSum
+	}
+	return nil
+}
@@ -0,0 +1,97 @@
+package rpcprovider
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"sync/atomic"
+
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/lavanet/lava/protocol/lavasession"
+	"github.com/lavanet/lava/utils"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+)
+
+const AttestEndpointsEpochsFlag = "attest-endpoints-epochs"
+
+type EndpointHealthAttester interface {
+	TxAttestEndpointHealth(ctx context.Context, chainIDs []string, protocolVersion uint32, binaryVersion string) error
+}
+
+// EndpointAttester attests on chain every few epochs that the provider's endpoints are live, so the provider stays paired
+// on chains with an attestation stale window. only chains whose node is healthy are attested, a chain with a stalled node
+// ages out of the pairing instead
+type EndpointAttester struct {
+	lock          sync.Mutex
+	attester      EndpointHealthAttester
+	everyEpochs   uint64
+	epochsSince   uint64
+	attested      bool
+	monitors      map[string]*NodeHealthMonitor // by chain, a nil monitor is always healthy
+	attesting     uint32
+	binaryVersion string
+}
+
+// NewEndpointAttester returns nil when attesting is disabled
+func NewEndpointAttester(attester EndpointHealthAttester, everyEpochs uint64) *EndpointAttester {
+	if everyEpochs == 0 {
+		return nil
+	}
+	binaryVersion := version.Version
+	if len(binaryVersion) > pairingtypes.MAX_LEN_ATTESTATION_VERSION {
+		binaryVersion = binaryVersion[:pairingtypes.MAX_LEN_ATTESTATION_VERSION]
+	}
+	return &EndpointAttester{attester: attester, everyEpochs: everyEpochs, monitors: map[string]*NodeHealthMonitor{}, binaryVersion: binaryVersion}
+}
+
+// AddChain attests the chain from the next attestation on
+func (ea *EndpointAttester) AddChain(chainID string, nodeHealthMonitor *NodeHealthMonitor) {
+	if ea == nil {
+		return
+	}
+	ea.lock.Lock()
+	defer ea.lock.Unlock()
+	ea.monitors[chainID] = nodeHealthMonitor
+}
+
+func (ea *EndpointAttester) UpdateEpoch(epoch uint64) {
+	ea.lock.Lock()
+	ea.epochsSince++
+	if ea.attested && ea.epochsSince < ea.everyEpochs {
+		ea.lock.Unlock()
+		return
+	}
+	chainIDs := ea.healthyChains()
+	ea.lock.Unlock()
+	if len(chainIDs) == 0 {
+		return
+	}
+	// the tx retries on sequence mismatches, it shouldn't hold the other epoch updates
+	if !atomic.CompareAndSwapUint32(&ea.attesting, 0, 1) {
+		return
+	}
+	go func() {
+		defer atomic.StoreUint32(&ea.attesting, 0)
+		err := ea.attester.TxAttestEndpointHealth(context.Background(), chainIDs, lavasession.ProtocolVersion, ea.binaryVersion)
+		if err != nil {
+			utils.LavaFormatWarning("failed attesting endpoint health, retrying next epoch", err, utils.Attribute{Key: "epoch", Value: epoch}, utils.Attribute{Key: "chainIDs", Value: chainIDs})
+			return
+		}
+		ea.lock.Lock()
+		defer ea.lock.Unlock()
+		ea.attested = true
+		ea.epochsSince = 0
+	}()
+}
+
+// must be called with the lock held
+func (ea *EndpointAttester) healthyChains() []string {
+	chainIDs := []string{}
+	for chainID, nodeHealthMonitor := range ea.monitors {
+		if nodeHealthMonitor.IsHealthy() {
+			chainIDs = append(chainIDs, chainID)
+		}
+	}
+	sort.Strings(chainIDs)
+	return chainIDs
+}
@@ -0,0 +1,68 @@
+package rpcprovider
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type mockEndpointHealthAttester struct {
+	lock     sync.Mutex
+	attested [][]string
+	fail     bool
+}
+
+func (m *mockEndpointHealthAttester) TxAttestEndpointHealth(ctx context.Context, chainIDs []string, protocolVersion uint32, binaryVersion string) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if m.fail {
+		return fmt.Errorf("tx failed")
+	}
+	m.attested = append(m.attested, chainIDs)
+	return nil
+}
+
+func (m *mockEndpointHealthAttester) attestations() [][]string {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return append([][]string{}, m.attested...)
+}
+
+func TestEndpointAttester(t *testing.T) {
+	require.Nil(t, NewEndpointAttester(&mockEndpointHealthAttester{}, 0))
+
+	mock := &mockEndpointHealthAttester{fail: true}
+	ea := NewEndpointAttester(mock, 2)
+	unhealthy := NewNodeHealthMonitor("LAV1", time.Second, NodeHealthConfig{Enabled: true}, nil)
+	unhealthy.healthy = false
+	ea.AddChain("LAV1", unhealthy)
+	ea.AddChain("ETH1", nil)
+	ea.AddChain("COS3", nil)
+	waitAttesting := func() {
+		require.Eventually(t, func() bool { return atomic.LoadUint32(&ea.attesting) == 0 }, time.Second, time.Millisecond)
+	}
+
+	// a failed attestation is retried on the next epoch
+	ea.UpdateEpoch(20)
+	waitAttesting()
+	require.Empty(t, mock.attestations())
+	mock.lock.Lock()
+	mock.fail = false
+	mock.lock.Unlock()
+	ea.UpdateEpoch(40)
+	waitAttesting()
+	require.Equal(t, [][]string{{"COS3", "ETH1"}}, mock.attestations())
+
+	// then the chains with a healthy node are attested every few epochs
+	ea.UpdateEpoch(60)
+	waitAttesting()
+	require.Len(t, mock.attestations(), 1)
+	ea.UpdateEpoch(80)
+	waitAttesting()
+	require.Len(t, mock.attestations(), 2)
+}
@@ -0,0 +1,202 @@
+package rpcprovider
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/lavanet/lava/utils"
+)
+
+const (
+	NodeHealthCheckFlag           = "node-health-check"
+	NodeHealthFreezeFlag          = "node-health-freeze"
+	NodeHealthMaxBlockLagFlag     = "node-health-max-block-lag"
+	NodeHealthMaxErrorRateFlag    = "node-health-max-error-rate"
+	DefaultNodeHealthMaxBlockLag  = 10
+	DefaultNodeHealthMaxErrorRate = 0.5
+	nodeHealthRecoveryChecks      = 3  // consecutive healthy checks needed before accepting relays again, so a flapping node doesn't toggle the freeze
+	nodeHealthMinRelaysForRate    = 10 // the error rate is not evaluated on fewer node responses
+	nodeHealthFreezeReason        = "provider node is unhealthy"
+)
+
+// NodeHealthConfig sets when the provider considers its node unhealthy, a zero threshold disables that check
+type NodeHealthConfig struct {
+	Enabled       bool
+	FreezeOnChain bool
+	MaxBlockLag   uint64
+	MaxErrorRate  float64
+}
+
+type ProviderFreezer interface {
+	TxFreezeProvider(ctx context.Context, chainIDs []string, reason string) error
+	TxUnfreezeProvider(ctx context.Context, chainIDs []string) error
+}
+
+// NodeHealthMonitor tracks the node of a single chain, shared by all the endpoints of the chain.
+// the node is unhealthy when its latest block stops advancing for more than MaxBlockLag average block times,
+// or when too many of the relays sent to it fail. while unhealthy relays are rejected before reaching the node,
+// and if configured the provider freezes itself on chain so it isn't paired and punished for a stalled node.
+// a nil monitor is always healthy
+type NodeHealthMonitor struct {
+	lock             sync.RWMutex
+	chainID          string
+	config           NodeHealthConfig
+	averageBlockTime time.Duration
+	freezer          ProviderFreezer
+	latestBlock      int64
+	latestBlockTime  time.Time
+	nodeResponses    uint64
+	nodeErrors       uint64
+	healthy          bool
+	healthyChecks    int
+	frozen           bool
+	now              func() time.Time
+}
+
+// NewNodeHealthMonitor returns nil when the health check is disabled
+func NewNodeHealthMonitor(chainID string, averageBlockTime time.Duration, config NodeHealthConfig, freezer ProviderFreezer) *NodeHealthMonitor {
+	if !config.Enabled {
+		return nil
+	}
+	nhm := &NodeHealthMonitor{
+		chainID:          chainID,
+		config:           config,
+		averageBlockTime: averageBlockTime,
+		freezer:          freezer,
+		healthy:          true,
+		now:              time.Now,
+	}
+	nhm.latestBlockTime = nhm.now()
+	return nhm
+}
+
+// Start runs the health checks every average block time until the context is done
+func (nhm *NodeHealthMonitor) Start(ctx context.Context) {
+	if nhm == nil {
+		return
+	}
+	interval := nhm.averageBlockTime
+	if interval <= 0 {
+		interval = time.Second
+	}
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				nhm.checkHealth(ctx)
+			}
+		}
+	}()
+}
+
+func (nhm *NodeHealthMonitor) OnNewLatestBlock(block int64) {
+	if nhm == nil {
+		return
+	}
+	nhm.lock.Lock()
+	defer nhm.lock.Unlock()
+	if block > nhm.latestBlock {
+		nhm.latestBlock = block
+		nhm.latestBlockTime = nhm.now()
+	}
+}
+
+// OnNodeResponse accounts the result of a message sent to the node for the error rate
+func (nhm *NodeHealthMonitor) OnNodeResponse(err error) {
+	if nhm == nil {
+		return
+	}
+	nhm.lock.Lock()
+	defer nhm.lock.Unlock()
+	nhm.nodeResponses++
+	if err != nil {
+		nhm.nodeErrors++
+	}
+}
+
+func (nhm *NodeHealthMonitor) IsHealthy() bool {
+	if nhm == nil {
+		return true
+	}
+	nhm.lock.RLock()
+	defer nhm.lock.RUnlock()
+	return nhm.healthy
+}
+
+// healthIssue returns why the node is unhealthy, or an empty string. the error counters are reset once evaluated
+func (nhm *NodeHealthMonitor) healthIssue() (issue string, attributes []utils.Attribute) {
+	if nhm.config.MaxBlockLag > 0 && nhm.averageBlockTime > 0 {
+		blockLag := uint64(nhm.now().Sub(nhm.latestBlockTime) / nhm.averageBlockTime)
+		if blockLag > nhm.config.MaxBlockLag {
+			return "node latest block is lagging", []utils.Attribute{{Key: "latestBlock", Value: nhm.latestBlock}, {Key: "blockLag", Value: blockLag}, {Key: "maxBlockLag", Value: nhm.config.MaxBlockLag}}
+		}
+	}
+	if nhm.config.MaxErrorRate > 0 && nhm.nodeResponses >= nodeHealthMinRelaysForRate {
+		errorRate := float64(nhm.nodeErrors) / float64(nhm.nodeResponses)
+		nhm.nodeResponses = 0
+		nhm.nodeErrors = 0
+		if errorRate > nhm.config.MaxErrorRate {
+			return "node error rate is too high", []utils.Attribute{{Key: "errorRate", Value: errorRate}, {Key: "maxErrorRate", Value: nhm.config.MaxErrorRate}}
+		}
+	}
+	return "", nil
+}
+
+func (nhm *NodeHealthMonitor) checkHealth(ctx context.Context) {
+	var freeze, unfreeze bool
+	func() {
+		nhm.lock.Lock()
+		defer nhm.lock.Unlock()
+		issue, attributes := nhm.healthIssue()
+		if issue != "" {
+			nhm.healthyChecks = 0
+			if nhm.healthy {
+				nhm.healthy = false
+				utils.LavaFormatWarning("provider node is unhealthy, rejecting relays: "+issue, nil, append(attributes, utils.Attribute{Key: "chainID", Value: nhm.chainID})...)
+			}
+			freeze = nhm.config.FreezeOnChain && !nhm.frozen
+			return
+		}
+		if !nhm.healthy {
+			nhm.healthyChecks++
+			if nhm.healthyChecks < nodeHealthRecoveryChecks {
+				return
+			}
+			nhm.healthy = true
+			utils.LavaFormatInfo("provider node recovered, accepting relays", utils.Attribute{Key: "chainID", Value: nhm.chainID})
+		}
+		// a failed unfreeze is retried on the next check
+		unfreeze = nhm.frozen
+	}()
+
+	// txs are sent outside the lock so relays aren't blocked on them, only this routine changes the frozen state
+	if freeze {
+		err := nhm.freezer.TxFreezeProvider(ctx, []string{nhm.chainID}, nodeHealthFreezeReason)
+		if err != nil {
+			utils.LavaFormatError("failed freezing provider with an unhealthy node", err, utils.Attribute{Key: "chainID", Value: nhm.chainID})
+			return
+		}
+		nhm.setFrozen(true)
+		utils.LavaFormatInfo("froze provider until its node recovers", utils.Attribute{Key: "chainID", Value: nhm.chainID})
+	}
+	if unfreeze {
+		err := nhm.freezer.TxUnfreezeProvider(ctx, []string{nhm.chainID})
+		if err != nil {
+			utils.LavaFormatError("failed unfreezing provider with a recovered node", err, utils.Attribute{Key: "chainID", Value: nhm.chainID})
+			return
+		}
+		nhm.setFrozen(false)
+		utils.LavaFormatInfo("unfroze provider after its node recovered", utils.Attribute{Key: "chainID", Value: nhm.chainID})
+	}
+}
+
+func (nhm *NodeHealthMonitor) setFrozen(frozen bool) {
+	nhm.lock.Lock()
+	defer nhm.lock.Unlock()
+	nhm.frozen = frozen
+}
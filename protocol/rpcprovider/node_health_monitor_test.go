@@ -0,0 +1,106 @@
+package rpcprovider
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testNodeHealthBlockTime = time.Second
+
+type mockProviderFreezer struct {
+	frozen       bool
+	freezeCalls  int
+	unfreezeErrs int
+}
+
+func (mpf *mockProviderFreezer) TxFreezeProvider(ctx context.Context, chainIDs []string, reason string) error {
+	mpf.freezeCalls++
+	mpf.frozen = true
+	return nil
+}
+
+func (mpf *mockProviderFreezer) TxUnfreezeProvider(ctx context.Context, chainIDs []string) error {
+	if mpf.unfreezeErrs > 0 {
+		mpf.unfreezeErrs--
+		return errors.New("tx failed")
+	}
+	mpf.frozen = false
+	return nil
+}
+
+func newTestNodeHealthMonitor(config NodeHealthConfig) (*NodeHealthMonitor, *mockProviderFreezer, *time.Time) {
+	config.Enabled = true
+	currentTime := time.Now()
+	freezer := &mockProviderFreezer{}
+	nhm := NewNodeHealthMonitor("LAV1", testNodeHealthBlockTime, config, freezer)
+	nhm.now = func() time.Time { return currentTime }
+	nhm.latestBlockTime = currentTime
+	return nhm, freezer, &currentTime
+}
+
+func TestNodeHealthMonitorDisabled(t *testing.T) {
+	nhm := NewNodeHealthMonitor("LAV1", testNodeHealthBlockTime, NodeHealthConfig{}, &mockProviderFreezer{})
+	require.Nil(t, nhm)
+	nhm.OnNewLatestBlock(10)
+	nhm.OnNodeResponse(errors.New("node error"))
+	require.True(t, nhm.IsHealthy())
+}
+
+func TestNodeHealthMonitorBlockLag(t *testing.T) {
+	ctx := context.Background()
+	nhm, freezer, currentTime := newTestNodeHealthMonitor(NodeHealthConfig{MaxBlockLag: 5, FreezeOnChain: true})
+	nhm.OnNewLatestBlock(100)
+	*currentTime = currentTime.Add(5 * testNodeHealthBlockTime)
+	nhm.checkHealth(ctx)
+	require.True(t, nhm.IsHealthy())
+
+	// the node stopped advancing for more than the allowed lag
+	*currentTime = currentTime.Add(testNodeHealthBlockTime)
+	nhm.checkHealth(ctx)
+	require.False(t, nhm.IsHealthy())
+	require.True(t, freezer.frozen)
+	nhm.checkHealth(ctx)
+	require.Equal(t, 1, freezer.freezeCalls)
+
+	// recovery needs consecutive healthy checks, and a failed unfreeze is retried
+	freezer.unfreezeErrs = 1
+	nhm.OnNewLatestBlock(101)
+	for check := 1; check < nodeHealthRecoveryChecks; check++ {
+		nhm.checkHealth(ctx)
+		require.False(t, nhm.IsHealthy())
+	}
+	nhm.checkHealth(ctx)
+	require.True(t, nhm.IsHealthy())
+	require.True(t, freezer.frozen)
+	nhm.checkHealth(ctx)
+	require.False(t, freezer.frozen)
+}
+
+func TestNodeHealthMonitorErrorRate(t *testing.T) {
+	ctx := context.Background()
+	nhm, freezer, _ := newTestNodeHealthMonitor(NodeHealthConfig{MaxErrorRate: 0.5})
+	// too few responses to evaluate the rate
+	for i := 0; i < nodeHealthMinRelaysForRate-1; i++ {
+		nhm.OnNodeResponse(errors.New("node error"))
+	}
+	nhm.checkHealth(ctx)
+	require.True(t, nhm.IsHealthy())
+
+	nhm.OnNodeResponse(nil)
+	nhm.checkHealth(ctx)
+	require.False(t, nhm.IsHealthy())
+	// freezing on chain is optional
+	require.Zero(t, freezer.freezeCalls)
+
+	for i := 0; i < nodeHealthRecoveryChecks; i++ {
+		for relay := 0; relay < nodeHealthMinRelaysForRate; relay++ {
+			nhm.OnNodeResponse(nil)
+		}
+		nhm.checkHealth(ctx)
+	}
+	require.True(t, nhm.IsHealthy())
+}
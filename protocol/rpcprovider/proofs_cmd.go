@@ -0,0 +1,104 @@
+package rpcprovider
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/lavanet/lava/protocol/lavasession"
+	"github.com/lavanet/lava/protocol/rpcprovider/rewardserver"
+	"github.com/lavanet/lava/utils"
+	"github.com/spf13/cobra"
+)
+
+// CreateProviderProofsCobraCommand moves unclaimed relay proofs between provider machines, so rewards earned before a migration
+// are claimed by the provider on the new machine
+func CreateProviderProofsCobraCommand() *cobra.Command {
+	cmdProofs := &cobra.Command{
+		Use:   "provider-proofs",
+		Short: "export and import the unclaimed relay proofs of a provider",
+		Long: `export and import the unclaimed relay proofs of a provider.
+		proofs a running provider holds in memory are exported on shutdown with rpcprovider --` + rewardserver.ProofsExportPathFlag + `,
+		proofs persisted with rpcprovider --` + lavasession.ProviderSessionStoreFlag + ` are exported from the session store of a stopped provider.
+		imported proofs are claimed by the provider started with the session store they were imported to, it must use the key they were imported with`,
+	}
+	cmdProofs.AddCommand(createExportProofsCobraCommand(), createImportProofsCobraCommand())
+	return cmdProofs
+}
+
+func createExportProofsCobraCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:     "export [session-store-path] [output-file]",
+		Short:   "export the unclaimed relay proofs in the session store of a stopped provider to a file",
+		Example: `provider-proofs export ~/.lava/provider-sessions.db proofs.json`,
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			sessionStore, err := lavasession.NewBoltProviderSessionStore(args[0])
+			if err != nil {
+				return utils.LavaFormatError("failed opening the session store, is the provider still running?", err, utils.Attribute{Key: "path", Value: args[0]})
+			}
+			defer sessionStore.Close()
+			records, err := sessionStore.LoadProofs()
+			if err != nil {
+				return err
+			}
+			err = rewardserver.WriteProofsFile(args[1], records)
+			if err != nil {
+				return err
+			}
+			utils.LavaFormatInfo("exported relay proofs", utils.Attribute{Key: "proofs", Value: len(records)}, utils.Attribute{Key: "path", Value: args[1]})
+			return nil
+		},
+	}
+}
+
+func createImportProofsCobraCommand() *cobra.Command {
+	cmdImport := &cobra.Command{
+		Use:     "import [proofs-file] [session-store-path] --from <provider-key>",
+		Short:   "import exported relay proofs to the session store of a provider, which claims them once it is started with it",
+		Long:    `import exported relay proofs to the session store of a provider, which claims them once it is started with it. proofs that weren't sent to the provider of the key or aren't signed by their consumer are skipped and reported`,
+		Example: `provider-proofs import proofs.json ~/.lava/provider-sessions.db --from providerKey`,
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			providerAddress := clientCtx.GetFromAddress().String()
+			records, err := rewardserver.ReadProofsFile(args[0])
+			if err != nil {
+				return err
+			}
+			sessionStore, err := lavasession.NewBoltProviderSessionStore(args[1])
+			if err != nil {
+				return utils.LavaFormatError("failed opening the session store, is the provider still running?", err, utils.Attribute{Key: "path", Value: args[1]})
+			}
+			defer sessionStore.Close()
+			imported := 0
+			for _, record := range records {
+				err = rewardserver.VerifyProofRecord(record, providerAddress)
+				if err != nil {
+					utils.LavaFormatWarning("skipped invalid relay proof", err,
+						utils.Attribute{Key: "epoch", Value: record.Epoch},
+						utils.Attribute{Key: "consumer", Value: record.ConsumerAddr},
+					)
+					continue
+				}
+				// the store keeps the proof with the highest cu of a session, importing the same file twice changes nothing
+				err = sessionStore.SaveProof(record)
+				if err != nil {
+					return err
+				}
+				imported++
+			}
+			utils.LavaFormatInfo("imported relay proofs",
+				utils.Attribute{Key: "proofs", Value: imported},
+				utils.Attribute{Key: "skipped", Value: len(records) - imported},
+				utils.Attribute{Key: "provider", Value: providerAddress},
+				utils.Attribute{Key: "path", Value: args[1]},
+			)
+			return nil
+		},
+	}
+	flags.AddTxFlagsToCmd(cmdImport)
+	cmdImport.MarkFlagRequired(flags.FlagFrom)
+	return cmdImport
+}
@@ -2,20 +2,23 @@ package rpcprovider
 
 import (
 	"context"
+	"crypto/tls"
 	"errors"
 	"net/http"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/improbable-eng/grpc-web/go/grpcweb"
 	"github.com/lavanet/lava/protocol/chainlib"
+	"github.com/lavanet/lava/protocol/lavaprotocol"
 	"github.com/lavanet/lava/protocol/lavasession"
+	"github.com/lavanet/lava/protocol/tracing"
 	"github.com/lavanet/lava/utils"
 	pairingtypes "github.com/lavanet/lava/x/pairing/types"
 	"golang.org/x/net/http2"
 	"golang.org/x/net/http2/h2c"
 	grpc "google.golang.org/grpc"
-	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 type ProviderListener struct {
@@ -49,31 +52,54 @@ func (pl *ProviderListener) Shutdown(shutdownCtx context.Context) error {
 	return nil
 }
 
-func NewProviderListener(ctx context.Context, networkAddress string) *ProviderListener {
+func NewProviderListener(ctx context.Context, networkAddress string, config ProviderListenerConfig) *ProviderListener {
 	pl := &ProviderListener{networkAddress: networkAddress}
 
 	// GRPC
 	lis := chainlib.GetListenerWithRetryGrpc("tcp", networkAddress)
-	grpcServer := grpc.NewServer()
+	grpcServer := grpc.NewServer(tracing.GRPCServerOptions()...)
+	relayServer := &relayServer{relayReceivers: map[string]RelayReceiver{}}
+	pl.relayServer = relayServer
 
 	wrappedServer := grpcweb.WrapServer(grpcServer)
 	handler := func(resp http.ResponseWriter, req *http.Request) {
-		// Set CORS headers
-		resp.Header().Set("Access-Control-Allow-Origin", "*")
-		resp.Header().Set("Access-Control-Allow-Headers", "Content-Type,x-grpc-web")
-
-		wrappedServer.ServeHTTP(resp, req)
+		if config.GrpcWeb || config.RestGateway {
+			// Set CORS headers
+			resp.Header().Set("Access-Control-Allow-Origin", "*")
+			resp.Header().Set("Access-Control-Allow-Headers", "Content-Type,x-grpc-web")
+			resp.Header().Set("Access-Control-Expose-Headers", lavaprotocol.RelayReplyChecksumTrailer)
+		}
+		switch {
+		case config.RestGateway && isRestGatewayRequest(req):
+			relayServer.serveRestGateway(resp, req)
+		case config.GrpcWeb:
+			wrappedServer.ServeHTTP(resp, req)
+		default:
+			grpcServer.ServeHTTP(resp, req)
+		}
 	}
 
 	pl.httpServer = http.Server{
 		Handler: h2c.NewHandler(http.HandlerFunc(handler), &http2.Server{}),
 	}
-	relayServer := &relayServer{relayReceivers: map[string]RelayReceiver{}}
-	pl.relayServer = relayServer
+	if config.TLSCertFile != "" {
+		certificate, err := tls.LoadX509KeyPair(config.TLSCertFile, config.TLSKeyFile)
+		if err != nil {
+			utils.LavaFormatFatal("failed loading the provider listener tls certificate", err, utils.Attribute{Key: "cert", Value: config.TLSCertFile}, utils.Attribute{Key: "key", Value: config.TLSKeyFile})
+		}
+		pl.httpServer.TLSConfig = &tls.Config{Certificates: []tls.Certificate{certificate}, MinVersion: tls.VersionTLS12}
+	}
 	pairingtypes.RegisterRelayerServer(grpcServer, relayServer)
 	go func() {
-		utils.LavaFormatInfo("New provider listener active", utils.Attribute{Key: "address", Value: networkAddress})
-		if err := pl.httpServer.Serve(lis); !errors.Is(err, http.ErrServerClosed) {
+		utils.LavaFormatInfo("New provider listener active", utils.Attribute{Key: "address", Value: networkAddress}, utils.Attribute{Key: "tls", Value: pl.httpServer.TLSConfig != nil})
+		var err error
+		if pl.httpServer.TLSConfig != nil {
+			// the h2c handler serves http2 over tls as well, ServeTLS negotiates it
+			err = pl.httpServer.ServeTLS(lis, "", "")
+		} else {
+			err = pl.httpServer.Serve(lis)
+		}
+		if !errors.Is(err, http.ErrServerClosed) {
 			utils.LavaFormatFatal("provider failed to serve", err, utils.Attribute{Key: "Address", Value: lis.Addr().String()})
 		}
 		utils.LavaFormatInfo("listener closed server", utils.Attribute{Key: "address", Value: networkAddress})
@@ -90,6 +116,7 @@ type relayServer struct {
 type RelayReceiver interface {
 	Relay(ctx context.Context, request *pairingtypes.RelayRequest) (*pairingtypes.RelayReply, error)
 	RelaySubscribe(request *pairingtypes.RelayRequest, srv pairingtypes.Relayer_RelaySubscribeServer) error
+	LatestBlock() int64
 }
 
 func (rs *relayServer) Relay(ctx context.Context, request *pairingtypes.RelayRequest) (*pairingtypes.RelayReply, error) {
@@ -100,8 +127,18 @@ func (rs *relayServer) Relay(ctx context.Context, request *pairingtypes.RelayReq
 	return relayReceiver.Relay(ctx, request)
 }
 
-func (rs *relayServer) Probe(ctx context.Context, probeReq *wrapperspb.UInt64Value) (*wrapperspb.UInt64Value, error) {
-	return probeReq, nil
+func (rs *relayServer) Probe(ctx context.Context, probeReq *pairingtypes.ProbeRequest) (*pairingtypes.ProbeReply, error) {
+	// consumers negotiate down to our version, a legacy consumer ignores it
+	probeReply := &pairingtypes.ProbeReply{Guid: probeReq.Guid, ProtocolVersion: lavasession.ProtocolVersion, Timestamp: time.Now().UnixMilli()}
+	// the latest block lets the consumer tell how far behind our node is, a legacy consumer doesn't send the chain
+	endpoint := lavasession.RPCEndpoint{ChainID: probeReq.SpecId, ApiInterface: probeReq.ApiInterface}
+	rs.lock.RLock()
+	relayReceiver, ok := rs.relayReceivers[endpoint.Key()]
+	rs.lock.RUnlock()
+	if ok {
+		probeReply.LatestBlock = relayReceiver.LatestBlock()
+	}
+	return probeReply, nil
 }
 
 func (rs *relayServer) RelaySubscribe(request *pairingtypes.RelayRequest, srv pairingtypes.Relayer_RelaySubscribeServer) error {
@@ -0,0 +1,118 @@
+package rpcprovider
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/lavanet/lava/protocol/lavaprotocol"
+	"github.com/lavanet/lava/utils"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	GrpcWebFlag      = "grpc-web"
+	RestGatewayFlag  = "relay-rest-gateway"
+	TLSCertFileFlag  = "tls-cert-file"
+	TLSKeyFileFlag   = "tls-key-file"
+	RelayRestPath    = "/lavanet/lava/pairing/relay"
+	ProbeRestPath    = "/lavanet/lava/pairing/probe"
+	restMaxBodyBytes = 10 * 1024 * 1024 // relay requests carry the consumer's query, bigger bodies are rejected before decoding
+)
+
+// ProviderListenerConfig sets the transports the provider listener serves next to native grpc, for consumers that can't speak it
+type ProviderListenerConfig struct {
+	GrpcWeb     bool   // grpc-web for browsers
+	RestGateway bool   // relay and probe as json posts on RelayRestPath and ProbeRestPath
+	TLSCertFile string // with TLSKeyFile, consumers connect over tls with this certificate
+	TLSKeyFile  string
+}
+
+type restGatewayError struct {
+	Code    uint32 `json:"code"`
+	Message string `json:"message"`
+}
+
+// isRestGatewayRequest returns whether the request is for the json relay and probe endpoints, cors preflights included
+func isRestGatewayRequest(req *http.Request) bool {
+	return req.URL.Path == RelayRestPath || req.URL.Path == ProbeRestPath
+}
+
+// serveRestGateway transcodes json posts to the relayer service, relays are handled the same as over grpc so their signatures are
+// verified the same way. the reply checksum is sent in a header as there is no grpc trailer
+func (rs *relayServer) serveRestGateway(resp http.ResponseWriter, req *http.Request) {
+	if req.Method == http.MethodOptions {
+		resp.WriteHeader(http.StatusNoContent)
+		return
+	}
+	if req.Method != http.MethodPost {
+		writeRestGatewayError(resp, http.StatusMethodNotAllowed, 0, "only POST is supported")
+		return
+	}
+	body, err := io.ReadAll(io.LimitReader(req.Body, restMaxBodyBytes+1))
+	if err != nil {
+		writeRestGatewayError(resp, http.StatusBadRequest, 0, err.Error())
+		return
+	}
+	if len(body) > restMaxBodyBytes {
+		writeRestGatewayError(resp, http.StatusRequestEntityTooLarge, 0, "request body is too large")
+		return
+	}
+
+	var reply proto.Message
+	switch req.URL.Path {
+	case RelayRestPath:
+		request := &pairingtypes.RelayRequest{}
+		if err := jsonpb.Unmarshal(bytes.NewReader(body), request); err != nil {
+			writeRestGatewayError(resp, http.StatusBadRequest, 0, err.Error())
+			return
+		}
+		if request.RelayData == nil || request.RelaySession == nil {
+			writeRestGatewayError(resp, http.StatusBadRequest, 0, "invalid relay request, internal fields are nil")
+			return
+		}
+		relayReply, err := rs.Relay(req.Context(), request)
+		if err != nil {
+			writeRestGatewayStatus(resp, err)
+			return
+		}
+		resp.Header().Set(lavaprotocol.RelayReplyChecksumTrailer, lavaprotocol.ReplyChecksum(relayReply))
+		reply = relayReply
+	case ProbeRestPath:
+		request := &pairingtypes.ProbeRequest{}
+		if err := jsonpb.Unmarshal(bytes.NewReader(body), request); err != nil {
+			writeRestGatewayError(resp, http.StatusBadRequest, 0, err.Error())
+			return
+		}
+		probeReply, err := rs.Probe(req.Context(), request)
+		if err != nil {
+			writeRestGatewayStatus(resp, err)
+			return
+		}
+		reply = probeReply
+	}
+
+	resp.Header().Set("Content-Type", "application/json")
+	if err := (&jsonpb.Marshaler{}).Marshal(resp, reply); err != nil {
+		utils.LavaFormatWarning("failed writing rest gateway reply", err, utils.Attribute{Key: "path", Value: req.URL.Path})
+	}
+}
+
+// the grpc code of the error is kept in the body, lava codes have no http status of their own
+func writeRestGatewayStatus(resp http.ResponseWriter, err error) {
+	grpcStatus := status.Convert(err)
+	writeRestGatewayError(resp, runtime.HTTPStatusFromCode(grpcStatus.Code()), uint32(grpcStatus.Code()), grpcStatus.Message())
+}
+
+func writeRestGatewayError(resp http.ResponseWriter, httpStatus int, code uint32, message string) {
+	resp.Header().Set("Content-Type", "application/json")
+	resp.WriteHeader(httpStatus)
+	if err := json.NewEncoder(resp).Encode(restGatewayError{Code: code, Message: message}); err != nil {
+		utils.LavaFormatWarning("failed writing rest gateway error", err)
+	}
+}
@@ -0,0 +1,70 @@
+package rpcprovider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/lavanet/lava/protocol/lavaprotocol"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	"github.com/stretchr/testify/require"
+)
+
+type mockRelayReceiver struct {
+	reply *pairingtypes.RelayReply
+}
+
+func (mrr *mockRelayReceiver) Relay(ctx context.Context, request *pairingtypes.RelayRequest) (*pairingtypes.RelayReply, error) {
+	return mrr.reply, nil
+}
+
+func (mrr *mockRelayReceiver) RelaySubscribe(request *pairingtypes.RelayRequest, srv pairingtypes.Relayer_RelaySubscribeServer) error {
+	return nil
+}
+
+func (mrr *mockRelayReceiver) LatestBlock() int64 {
+	return 1000
+}
+
+func TestRestGateway(t *testing.T) {
+	reply := &pairingtypes.RelayReply{Data: []byte(`{"result":"0x10"}`), Sig: []byte("sig"), LatestBlock: 1000}
+	rs := &relayServer{relayReceivers: map[string]RelayReceiver{"LAV1rest": &mockRelayReceiver{reply: reply}}}
+	post := func(path string, body string) *httptest.ResponseRecorder {
+		recorder := httptest.NewRecorder()
+		rs.serveRestGateway(recorder, httptest.NewRequest(http.MethodPost, path, strings.NewReader(body)))
+		return recorder
+	}
+
+	recorder := post(RelayRestPath, `{"relaySession":{"specId":"LAV1","sessionId":"7"},"relayData":{"apiInterface":"rest","apiUrl":"/blocks/latest"}}`)
+	require.Equal(t, http.StatusOK, recorder.Code)
+	relayReply := &pairingtypes.RelayReply{}
+	require.NoError(t, jsonpb.Unmarshal(recorder.Body, relayReply))
+	require.Equal(t, reply.Data, relayReply.Data)
+	require.Equal(t, reply.Sig, relayReply.Sig)
+	require.Equal(t, lavaprotocol.ReplyChecksum(reply), recorder.Header().Get(lavaprotocol.RelayReplyChecksumTrailer))
+
+	recorder = post(ProbeRestPath, `{"guid":"5","specId":"LAV1","apiInterface":"rest"}`)
+	require.Equal(t, http.StatusOK, recorder.Code)
+	probeReply := &pairingtypes.ProbeReply{}
+	require.NoError(t, jsonpb.Unmarshal(recorder.Body, probeReply))
+	require.Equal(t, uint64(5), probeReply.Guid)
+	require.Equal(t, int64(1000), probeReply.LatestBlock)
+
+	// relays of chains the provider doesn't serve fail as they do over grpc
+	recorder = post(RelayRestPath, `{"relaySession":{"specId":"ETH1"},"relayData":{"apiInterface":"rest"}}`)
+	require.Equal(t, http.StatusInternalServerError, recorder.Code)
+
+	recorder = post(RelayRestPath, `{"relaySession":`)
+	require.Equal(t, http.StatusBadRequest, recorder.Code)
+	var gatewayError restGatewayError
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &gatewayError))
+	require.NotEmpty(t, gatewayError.Message)
+
+	recorder = httptest.NewRecorder()
+	rs.serveRestGateway(recorder, httptest.NewRequest(http.MethodGet, RelayRestPath, nil))
+	require.Equal(t, http.StatusMethodNotAllowed, recorder.Code)
+}
@@ -0,0 +1,271 @@
+package rpcprovider
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/app"
+	"github.com/lavanet/lava/protocol/chainlib"
+	"github.com/lavanet/lava/protocol/common"
+	"github.com/lavanet/lava/protocol/lavasession"
+	"github.com/lavanet/lava/protocol/statetracker"
+	"github.com/lavanet/lava/utils"
+	"github.com/lavanet/lava/utils/sigs"
+	epochstoragetypes "github.com/lavanet/lava/x/epochstorage/types"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	spectypes "github.com/lavanet/lava/x/spec/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const validateNodeTimeout = 10 * time.Second
+
+// providerValidator checks the endpoints of a provider config before it serves them, every problem found is reported
+// so the operator can fix them all at once
+type providerValidator struct {
+	clientCtx       client.Context
+	providerAddress string
+	specs           map[string]*spectypes.Spec               // by chain
+	stakeEntries    map[string]*epochstoragetypes.StakeEntry // by chain, nil when the provider isn't staked on it
+}
+
+func CreateValidateRPCProviderCobraCommand() *cobra.Command {
+	cmdValidate := &cobra.Command{
+		Use:   `validate [config-file]`,
+		Short: `validate checks the provider config against the nodes and the chain before serving it`,
+		Long: `validate loads the provider config and checks for every endpoint that its nodes are reachable and report a latest block,
+		that the on chain spec is enabled and supports the api interface, and that the provider is staked on the chain with an
+		endpoint of the api interface in its geolocation, listening on the port of the config.
+		if no arguments are passed, assumes default config file: ` + DefaultRPCProviderFileName,
+		Example: `rpcprovider validate --geolocation 1 --from alice
+rpcprovider validate rpcprovider_conf.yml --geolocation 1 --from alice`,
+		Args: cobra.RangeArgs(0, 1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			config_name := DefaultRPCProviderFileName
+			if len(args) == 1 {
+				config_name = args[0] // name of config file (without extension)
+			}
+			viper.SetConfigName(config_name)
+			viper.SetConfigType("yml")
+			viper.AddConfigPath(".")
+			viper.AddConfigPath("./config")
+			err = viper.ReadInConfig()
+			if err != nil {
+				return utils.LavaFormatError("could not load config file", err, utils.Attribute{Key: "expected_config_name", Value: config_name})
+			}
+			geolocation, err := cmd.Flags().GetUint64(lavasession.GeolocationFlag)
+			if err != nil {
+				utils.LavaFormatFatal("failed to read geolocation flag, required flag", err)
+			}
+			rpcProviderEndpoints, err := ParseEndpoints(viper.GetViper(), geolocation)
+			if err != nil || len(rpcProviderEndpoints) == 0 {
+				return utils.LavaFormatError("invalid endpoints definition", err, utils.Attribute{Key: "config", Value: viper.ConfigFileUsed()})
+			}
+			fillSharedNetworkAddresses(rpcProviderEndpoints)
+			ctx := context.Background()
+			networkChainId, err := cmd.Flags().GetString(flags.FlagChainID)
+			if err != nil {
+				return err
+			}
+			lavaNodes, err := cmd.Flags().GetStringSlice(statetracker.LavaNodesFlagName)
+			if err != nil {
+				utils.LavaFormatFatal("failed to read lava nodes flag", err)
+			}
+			clientCtx, err = statetracker.WithLavaNodes(ctx, clientCtx, lavaNodes)
+			if err != nil {
+				return err
+			}
+			clientCtx = clientCtx.WithChainID(networkChainId)
+			logLevel, err := cmd.Flags().GetString(flags.FlagLogLevel)
+			if err != nil {
+				utils.LavaFormatFatal("failed to read log level flag", err)
+			}
+			utils.LoggingLevel(logLevel)
+			keyName, err := sigs.GetKeyName(clientCtx)
+			if err != nil {
+				return utils.LavaFormatError("failed getting key name from clientCtx", err)
+			}
+			clientKey, err := clientCtx.Keyring.Key(keyName)
+			if err != nil {
+				return utils.LavaFormatError("failed reading the provider key", err, utils.Attribute{Key: "keyName", Value: keyName})
+			}
+			providerAddress := sdk.AccAddress(clientKey.GetPubKey().Address()).String()
+			validator := &providerValidator{clientCtx: clientCtx, providerAddress: providerAddress, specs: map[string]*spectypes.Spec{}, stakeEntries: map[string]*epochstoragetypes.StakeEntry{}}
+			failed := 0
+			for _, rpcProviderEndpoint := range rpcProviderEndpoints {
+				problems := validator.validateEndpoint(ctx, rpcProviderEndpoint)
+				if len(problems) == 0 {
+					fmt.Printf("%s %s: ok\n", rpcProviderEndpoint.ChainID, rpcProviderEndpoint.ApiInterface)
+					continue
+				}
+				failed++
+				fmt.Printf("%s %s: %d problems\n", rpcProviderEndpoint.ChainID, rpcProviderEndpoint.ApiInterface, len(problems))
+				for _, problem := range problems {
+					fmt.Printf("  - %s\n", problem)
+				}
+			}
+			if failed > 0 {
+				return fmt.Errorf("%d of %d endpoints failed validation for provider %s", failed, len(rpcProviderEndpoints), providerAddress)
+			}
+			return nil
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmdValidate)
+	cmdValidate.MarkFlagRequired(flags.FlagFrom)
+	cmdValidate.Flags().String(flags.FlagChainID, app.Name, "network chain id")
+	cmdValidate.Flags().StringSlice(statetracker.LavaNodesFlagName, []string{}, "comma separated uris of lava nodes to fail over to and spread state queries between, in addition to --node")
+	cmdValidate.Flags().Uint64(common.GeolocationFlag, 0, "geolocation the provider runs from")
+	cmdValidate.MarkFlagRequired(common.GeolocationFlag)
+	cmdValidate.Flags().String(flags.FlagLogLevel, "error", "log level")
+	return cmdValidate
+}
+
+func (pv *providerValidator) validateEndpoint(ctx context.Context, rpcProviderEndpoint *lavasession.RPCProviderEndpoint) (problems []string) {
+	stakeEntry, err := pv.stakeEntry(ctx, rpcProviderEndpoint.ChainID)
+	if err != nil {
+		problems = append(problems, fmt.Sprintf("failed querying the providers of %s: %s", rpcProviderEndpoint.ChainID, err))
+	} else {
+		problems = append(problems, validateEndpointStake(rpcProviderEndpoint, stakeEntry)...)
+	}
+	spec, err := pv.spec(ctx, rpcProviderEndpoint.ChainID)
+	if err != nil {
+		return append(problems, fmt.Sprintf("failed querying spec %s: %s", rpcProviderEndpoint.ChainID, err))
+	}
+	specProblems := validateEndpointSpec(spec, rpcProviderEndpoint.ApiInterface)
+	problems = append(problems, specProblems...)
+	if err := rpcProviderEndpoint.Validate(); err != nil {
+		return append(problems, "invalid node urls: "+err.Error())
+	}
+	if len(specProblems) > 0 {
+		// the node is queried with the apis of the spec
+		return problems
+	}
+	return append(problems, pv.validateNode(ctx, rpcProviderEndpoint, spec)...)
+}
+
+// validateNode queries the latest block and its hash from the node the same way the chain tracker does when serving
+func (pv *providerValidator) validateNode(ctx context.Context, rpcProviderEndpoint *lavasession.RPCProviderEndpoint, spec *spectypes.Spec) (problems []string) {
+	chainParser, err := chainlib.NewChainParser(rpcProviderEndpoint.ApiInterface)
+	if err != nil {
+		return append(problems, err.Error())
+	}
+	chainParser.SetSpec(*spec)
+	nodeCtx, cancel := context.WithTimeout(ctx, validateNodeTimeout)
+	defer cancel()
+	_, averageBlockTime, _, _ := chainParser.ChainBlockStats()
+	chainProxy, err := chainlib.GetChainProxy(nodeCtx, 1, rpcProviderEndpoint, averageBlockTime)
+	if err != nil {
+		return append(problems, fmt.Sprintf("failed connecting to node %s: %s", rpcProviderEndpoint.UrlsString(), err))
+	}
+	chainFetcher := chainlib.NewChainFetcher(nodeCtx, chainProxy, chainParser, rpcProviderEndpoint)
+	latestBlock, err := chainFetcher.FetchLatestBlockNum(nodeCtx)
+	if err != nil {
+		return append(problems, fmt.Sprintf("failed fetching the latest block from node %s: %s", rpcProviderEndpoint.UrlsString(), err))
+	}
+	_, err = chainFetcher.FetchBlockHashByNum(nodeCtx, latestBlock)
+	if err != nil {
+		return append(problems, fmt.Sprintf("failed fetching the hash of block %d from node %s: %s", latestBlock, rpcProviderEndpoint.UrlsString(), err))
+	}
+	return problems
+}
+
+func (pv *providerValidator) spec(ctx context.Context, chainID string) (*spectypes.Spec, error) {
+	if spec, ok := pv.specs[chainID]; ok {
+		return spec, nil
+	}
+	specResp, err := spectypes.NewQueryClient(pv.clientCtx).Spec(ctx, &spectypes.QueryGetSpecRequest{ChainID: chainID})
+	if err != nil {
+		return nil, err
+	}
+	pv.specs[chainID] = &specResp.Spec
+	return &specResp.Spec, nil
+}
+
+func (pv *providerValidator) stakeEntry(ctx context.Context, chainID string) (*epochstoragetypes.StakeEntry, error) {
+	if stakeEntry, ok := pv.stakeEntries[chainID]; ok {
+		return stakeEntry, nil
+	}
+	providersResp, err := pairingtypes.NewQueryClient(pv.clientCtx).Providers(ctx, &pairingtypes.QueryProvidersRequest{ChainID: chainID, ShowFrozen: true})
+	if err != nil {
+		return nil, err
+	}
+	pv.stakeEntries[chainID] = nil
+	for idx := range providersResp.StakeEntry {
+		if providersResp.StakeEntry[idx].Address == pv.providerAddress {
+			pv.stakeEntries[chainID] = &providersResp.StakeEntry[idx]
+		}
+	}
+	return pv.stakeEntries[chainID], nil
+}
+
+// validateEndpointSpec checks the spec can be served on the api interface
+func validateEndpointSpec(spec *spectypes.Spec, apiInterface string) (problems []string) {
+	if !spec.Enabled {
+		problems = append(problems, fmt.Sprintf("spec %s is disabled", spec.Index))
+	}
+	supported := false
+	blockNumSupported := false
+	for _, serviceApi := range spec.Apis {
+		if !serviceApi.Enabled {
+			continue
+		}
+		for _, apiInterfaceDef := range serviceApi.ApiInterfaces {
+			if apiInterfaceDef.Interface != apiInterface {
+				continue
+			}
+			supported = true
+			if serviceApi.Parsing.FunctionTag == spectypes.GET_BLOCKNUM {
+				blockNumSupported = true
+			}
+		}
+	}
+	if !supported {
+		return append(problems, fmt.Sprintf("spec %s has no enabled apis on api interface %s", spec.Index, apiInterface))
+	}
+	if !blockNumSupported {
+		problems = append(problems, fmt.Sprintf("spec %s has no %s api on api interface %s, the provider can't track the node's blocks", spec.Index, spectypes.GET_BLOCKNUM, apiInterface))
+	}
+	return problems
+}
+
+// validateEndpointStake checks consumers paired with the provider reach the endpoint, the staked endpoint must be of the
+// api interface in the geolocation of the config and on the port it listens on. the host isn't compared, providers
+// commonly listen on a local address behind the public one they stake
+func validateEndpointStake(rpcProviderEndpoint *lavasession.RPCProviderEndpoint, stakeEntry *epochstoragetypes.StakeEntry) (problems []string) {
+	if stakeEntry == nil {
+		return append(problems, fmt.Sprintf("provider isn't staked on %s", rpcProviderEndpoint.ChainID))
+	}
+	if stakeEntry.Geolocation&rpcProviderEndpoint.Geolocation == 0 {
+		problems = append(problems, fmt.Sprintf("provider is staked in geolocation %d which doesn't include geolocation %d of the config", stakeEntry.Geolocation, rpcProviderEndpoint.Geolocation))
+	}
+	_, listenPort, err := net.SplitHostPort(rpcProviderEndpoint.NetworkAddress)
+	if err != nil {
+		return append(problems, fmt.Sprintf("invalid network address %s: %s", rpcProviderEndpoint.NetworkAddress, err))
+	}
+	stakedAddresses := []string{}
+	for _, endpoint := range stakeEntry.Endpoints {
+		if endpoint.UseType != rpcProviderEndpoint.ApiInterface || endpoint.Geolocation != rpcProviderEndpoint.Geolocation {
+			continue
+		}
+		_, stakedPort, err := net.SplitHostPort(endpoint.IPPORT)
+		if err == nil && stakedPort == listenPort {
+			return problems
+		}
+		stakedAddresses = append(stakedAddresses, endpoint.IPPORT)
+	}
+	if len(stakedAddresses) == 0 {
+		return append(problems, fmt.Sprintf("provider has no staked endpoint for api interface %s in geolocation %d", rpcProviderEndpoint.ApiInterface, rpcProviderEndpoint.Geolocation))
+	}
+	return append(problems, fmt.Sprintf("staked endpoints %s aren't on port %s the config listens on", strings.Join(stakedAddresses, ","), listenPort))
+}
@@ -0,0 +1,50 @@
+package rpcprovider
+
+import (
+	"testing"
+
+	"github.com/lavanet/lava/protocol/lavasession"
+	epochstoragetypes "github.com/lavanet/lava/x/epochstorage/types"
+	spectypes "github.com/lavanet/lava/x/spec/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateEndpointStake(t *testing.T) {
+	endpoint := &lavasession.RPCProviderEndpoint{NetworkAddress: "0.0.0.0:2221", ChainID: "LAV1", ApiInterface: spectypes.APIInterfaceRest, Geolocation: 1}
+	stakeEntry := func(geolocation uint64, endpoints ...epochstoragetypes.Endpoint) *epochstoragetypes.StakeEntry {
+		return &epochstoragetypes.StakeEntry{Address: "provider", Chain: "LAV1", Geolocation: geolocation, Endpoints: endpoints}
+	}
+	for _, tt := range []struct {
+		name       string
+		stakeEntry *epochstoragetypes.StakeEntry
+		problems   int
+	}{
+		{name: "public host on the listened port", stakeEntry: stakeEntry(1, epochstoragetypes.Endpoint{IPPORT: "provider.com:2221", UseType: spectypes.APIInterfaceRest, Geolocation: 1}), problems: 0},
+		{name: "one of many endpoints", stakeEntry: stakeEntry(3, epochstoragetypes.Endpoint{IPPORT: "provider.com:2221", UseType: spectypes.APIInterfaceGrpc, Geolocation: 1}, epochstoragetypes.Endpoint{IPPORT: "provider.com:2221", UseType: spectypes.APIInterfaceRest, Geolocation: 1}), problems: 0},
+		{name: "not staked", stakeEntry: nil, problems: 1},
+		{name: "other port", stakeEntry: stakeEntry(1, epochstoragetypes.Endpoint{IPPORT: "provider.com:443", UseType: spectypes.APIInterfaceRest, Geolocation: 1}), problems: 1},
+		{name: "other api interface", stakeEntry: stakeEntry(1, epochstoragetypes.Endpoint{IPPORT: "provider.com:2221", UseType: spectypes.APIInterfaceGrpc, Geolocation: 1}), problems: 1},
+		{name: "other geolocation", stakeEntry: stakeEntry(2, epochstoragetypes.Endpoint{IPPORT: "provider.com:2221", UseType: spectypes.APIInterfaceRest, Geolocation: 2}), problems: 2},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			problems := validateEndpointStake(endpoint, tt.stakeEntry)
+			require.Len(t, problems, tt.problems, problems)
+		})
+	}
+}
+
+func TestValidateEndpointSpec(t *testing.T) {
+	serviceApi := func(name string, functionTag string, apiInterface string) spectypes.ServiceApi {
+		return spectypes.ServiceApi{Name: name, Enabled: true, ApiInterfaces: []spectypes.ApiInterface{{Interface: apiInterface}}, Parsing: spectypes.Parsing{FunctionTag: functionTag}}
+	}
+	spec := &spectypes.Spec{Index: "LAV1", Enabled: true, Apis: []spectypes.ServiceApi{
+		serviceApi("status", spectypes.GET_BLOCKNUM, spectypes.APIInterfaceTendermintRPC),
+		serviceApi("/blocks/latest", "", spectypes.APIInterfaceRest),
+	}}
+	require.Empty(t, validateEndpointSpec(spec, spectypes.APIInterfaceTendermintRPC))
+	// the provider can't track blocks without a block number api
+	require.Len(t, validateEndpointSpec(spec, spectypes.APIInterfaceRest), 1)
+	require.Len(t, validateEndpointSpec(spec, spectypes.APIInterfaceGrpc), 1)
+	spec.Enabled = false
+	require.Len(t, validateEndpointSpec(spec, spectypes.APIInterfaceTendermintRPC), 1)
+}
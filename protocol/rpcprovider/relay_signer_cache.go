@@ -0,0 +1,68 @@
+package rpcprovider
+
+import (
+	"strconv"
+	"time"
+
+	btcecv2 "github.com/btcsuite/btcd/btcec/v2"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dgraph-io/ristretto"
+	"github.com/lavanet/lava/utils/sigs"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+)
+
+const (
+	relaySignerCacheMaxEntries  = 100000
+	relaySignerCacheNumCounters = 10 * relaySignerCacheMaxEntries
+	relaySignerCacheTTL         = 30 * time.Minute // relays of stale epochs are rejected before reaching the cache, the ttl only bounds its memory
+)
+
+type relaySigner struct {
+	consumerAddress sdk.AccAddress
+	pubKey          *btcecv2.PublicKey
+}
+
+// RelaySignerCache remembers the public key that signed the first relay of each session, so later relays of the session
+// are verified against it instead of recovering the key from the signature. the relay number and cu are still checked by the session manager.
+// a relay not signed by the cached key, such as another consumer reusing the session id, falls back to a full recovery.
+// a nil cache always recovers
+type RelaySignerCache struct {
+	cache *ristretto.Cache
+}
+
+func NewRelaySignerCache() (*RelaySignerCache, error) {
+	cache, err := ristretto.NewCache(&ristretto.Config{NumCounters: relaySignerCacheNumCounters, MaxCost: relaySignerCacheMaxEntries, BufferItems: 64})
+	if err != nil {
+		return nil, err
+	}
+	return &RelaySignerCache{cache: cache}, nil
+}
+
+func relaySignerCacheKey(relaySession *pairingtypes.RelaySession) string {
+	return relaySession.SpecId + "_" + strconv.FormatInt(relaySession.Epoch, 10) + "_" + strconv.FormatUint(relaySession.SessionId, 10)
+}
+
+func (rsc *RelaySignerCache) ExtractSignerAddress(relaySession *pairingtypes.RelaySession) (sdk.AccAddress, error) {
+	if rsc == nil {
+		return sigs.ExtractSignerAddress(relaySession)
+	}
+	key := relaySignerCacheKey(relaySession)
+	cached, found := rsc.cache.Get(key)
+	if found {
+		if signer, ok := cached.(*relaySigner); ok && sigs.VerifyRelaySigner(*relaySession, signer.pubKey) {
+			return signer.consumerAddress, nil
+		}
+	}
+	consumerAddress, pubKey, err := sigs.RecoverRelaySigner(*relaySession)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		// an existing signer isn't replaced, so relays signed by another key can't evict the session's consumer
+		parsedPubKey, err := btcecv2.ParsePubKey(pubKey)
+		if err == nil {
+			rsc.cache.SetWithTTL(key, &relaySigner{consumerAddress: consumerAddress, pubKey: parsedPubKey}, 1, relaySignerCacheTTL)
+		}
+	}
+	return consumerAddress, nil
+}
@@ -0,0 +1,76 @@
+package rpcprovider
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/lavanet/lava/utils/sigs"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	"github.com/stretchr/testify/require"
+)
+
+func signTestRelaySession(t require.TestingT, consumerKey *btcec.PrivateKey, relayNum uint64) *pairingtypes.RelaySession {
+	relaySession := &pairingtypes.RelaySession{SpecId: "LAV1", SessionId: 123, Epoch: 20, RelayNum: relayNum, CuSum: 10 * relayNum}
+	sig, err := sigs.SignRelay(consumerKey, *relaySession)
+	require.NoError(t, err)
+	relaySession.Sig = sig
+	return relaySession
+}
+
+func TestRelaySignerCache(t *testing.T) {
+	relaySignerCache, err := NewRelaySignerCache()
+	require.NoError(t, err)
+	consumerKey, consumerAddress := sigs.GenerateFloatingKey()
+	extractedAddress, err := relaySignerCache.ExtractSignerAddress(signTestRelaySession(t, consumerKey, 1))
+	require.NoError(t, err)
+	require.Equal(t, consumerAddress, extractedAddress)
+	relaySignerCache.cache.Wait()
+
+	// a later relay of the session is verified against the cached key
+	relaySession := signTestRelaySession(t, consumerKey, 2)
+	extractedAddress, err = relaySignerCache.ExtractSignerAddress(relaySession)
+	require.NoError(t, err)
+	require.Equal(t, consumerAddress, extractedAddress)
+
+	// a relay changed after signing doesn't pass as the cached consumer
+	relaySession.CuSum += 10
+	extractedAddress, err = relaySignerCache.ExtractSignerAddress(relaySession)
+	if err == nil {
+		require.NotEqual(t, consumerAddress, extractedAddress)
+	}
+
+	// another consumer reusing the session id gets its own address, without replacing the cached consumer
+	otherConsumerKey, otherConsumerAddress := sigs.GenerateFloatingKey()
+	extractedAddress, err = relaySignerCache.ExtractSignerAddress(signTestRelaySession(t, otherConsumerKey, 3))
+	require.NoError(t, err)
+	require.Equal(t, otherConsumerAddress, extractedAddress)
+	relaySignerCache.cache.Wait()
+	cached, found := relaySignerCache.cache.Get(relaySignerCacheKey(relaySession))
+	require.True(t, found)
+	require.Equal(t, consumerAddress, cached.(*relaySigner).consumerAddress)
+}
+
+func BenchmarkExtractSignerAddress(b *testing.B) {
+	consumerKey, _ := sigs.GenerateFloatingKey()
+	relaySession := signTestRelaySession(b, consumerKey, 1)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, err := sigs.ExtractSignerAddress(relaySession)
+		require.NoError(b, err)
+	}
+}
+
+func BenchmarkRelaySignerCacheExtractSignerAddress(b *testing.B) {
+	relaySignerCache, err := NewRelaySignerCache()
+	require.NoError(b, err)
+	consumerKey, _ := sigs.GenerateFloatingKey()
+	relaySession := signTestRelaySession(b, consumerKey, 1)
+	_, err = relaySignerCache.ExtractSignerAddress(relaySession)
+	require.NoError(b, err)
+	relaySignerCache.cache.Wait()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, err := relaySignerCache.ExtractSignerAddress(relaySession)
+		require.NoError(b, err)
+	}
+}
@@ -0,0 +1,64 @@
+package rpcprovider
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/lavanet/lava/protocol/chainlib"
+	"github.com/lavanet/lava/protocol/chainlib/chainproxy/rpcclient"
+	"github.com/lavanet/lava/utils"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+)
+
+// ChainProxyDrainTimeout is how long a replaced chain proxy keeps its node connections for messages and subscriptions still using them
+const ChainProxyDrainTimeout = 30 * time.Second
+
+type chainProxyGeneration struct {
+	chainProxy chainlib.ChainProxy
+	cancel     context.CancelFunc
+	inFlight   sync.WaitGroup
+}
+
+// reloadableChainProxy forwards node messages to the chain proxy of the current node urls, so the relays, chain tracker and
+// reliability manager of an endpoint all switch together when the node urls are reloaded
+type reloadableChainProxy struct {
+	lock    sync.RWMutex
+	current *chainProxyGeneration
+}
+
+func newReloadableChainProxy(chainProxy chainlib.ChainProxy, cancel context.CancelFunc) *reloadableChainProxy {
+	return &reloadableChainProxy{current: &chainProxyGeneration{chainProxy: chainProxy, cancel: cancel}}
+}
+
+func (rcp *reloadableChainProxy) SendNodeMsg(ctx context.Context, ch chan interface{}, chainMessage chainlib.ChainMessageForSend) (relayReply *pairingtypes.RelayReply, subscriptionID string, relayReplyServer *rpcclient.ClientSubscription, err error) {
+	rcp.lock.RLock()
+	generation := rcp.current
+	generation.inFlight.Add(1)
+	rcp.lock.RUnlock()
+	defer generation.inFlight.Done()
+	return generation.chainProxy.SendNodeMsg(ctx, ch, chainMessage)
+}
+
+// swap sends new messages to chainProxy right away, the replaced chain proxy is closed ChainProxyDrainTimeout later so the
+// messages in flight on it can finish, its subscriptions end then and consumers subscribe again through the new nodes
+func (rcp *reloadableChainProxy) swap(chainProxy chainlib.ChainProxy, cancel context.CancelFunc) {
+	rcp.lock.Lock()
+	replaced := rcp.current
+	rcp.current = &chainProxyGeneration{chainProxy: chainProxy, cancel: cancel}
+	rcp.lock.Unlock()
+	go func() {
+		drained := make(chan struct{})
+		go func() {
+			replaced.inFlight.Wait()
+			close(drained)
+		}()
+		<-time.After(ChainProxyDrainTimeout)
+		select {
+		case <-drained:
+		default:
+			utils.LavaFormatWarning("replaced chain proxy still has messages in flight, closing it", nil, utils.Attribute{Key: "drainTimeout", Value: ChainProxyDrainTimeout})
+		}
+		replaced.cancel()
+	}()
+}
@@ -0,0 +1,36 @@
+package rpcprovider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lavanet/lava/protocol/chainlib"
+	"github.com/lavanet/lava/protocol/chainlib/chainproxy/rpcclient"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	"github.com/stretchr/testify/require"
+)
+
+type mockChainProxy struct {
+	data string
+}
+
+func (m *mockChainProxy) SendNodeMsg(ctx context.Context, ch chan interface{}, chainMessage chainlib.ChainMessageForSend) (*pairingtypes.RelayReply, string, *rpcclient.ClientSubscription, error) {
+	return &pairingtypes.RelayReply{Data: []byte(m.data)}, "", nil, nil
+}
+
+func TestReloadableChainProxySwap(t *testing.T) {
+	oldCtx, oldCancel := context.WithCancel(context.Background())
+	rcp := newReloadableChainProxy(&mockChainProxy{data: "old"}, oldCancel)
+	reply, _, _, err := rcp.SendNodeMsg(context.Background(), nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, "old", string(reply.Data))
+
+	_, newCancel := context.WithCancel(context.Background())
+	defer newCancel()
+	rcp.swap(&mockChainProxy{data: "new"}, newCancel)
+	reply, _, _, err = rcp.SendNodeMsg(context.Background(), nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, "new", string(reply.Data))
+	// the replaced nodes stay connected for the drain timeout
+	require.NoError(t, oldCtx.Err())
+}
@@ -0,0 +1,105 @@
+package rewardserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/lavanet/lava/protocol/lavasession"
+	"github.com/lavanet/lava/utils"
+	"github.com/lavanet/lava/utils/sigs"
+)
+
+const ProofsExportPathFlag = "proofs-export-path"
+
+// ProofsExport is the file format of exported relay proofs, the proofs are signed by their consumers for the provider's address
+// so they can be claimed with the provider's key from any machine. data reliability proofs aren't exported
+type ProofsExport struct {
+	Proofs []lavasession.ProviderProofRecord `json:"proofs"`
+}
+
+// UnclaimedProofs returns the latest proof of every session that wasn't claimed yet, including claims that are waiting to be
+// sent again, ordered by epoch
+func (rws *RewardServer) UnclaimedProofs() (records []lavasession.ProviderProofRecord) {
+	rws.lock.RLock()
+	for epoch, epochRewards := range rws.rewards {
+		for _, consumerRewards := range epochRewards.consumerRewards {
+			for _, proof := range consumerRewards.proofs {
+				records = append(records, lavasession.ProviderProofRecord{Epoch: epoch, ConsumerAddr: consumerRewards.consumer, ApiInterface: consumerRewards.apiInterface, Proof: proof})
+			}
+		}
+	}
+	rws.lock.RUnlock()
+	rws.claimsLock.Lock()
+	for _, claim := range rws.pendingClaims {
+		for _, proof := range claim.proofs {
+			records = append(records, lavasession.ProviderProofRecord{Epoch: claim.epoch, ConsumerAddr: claim.consumer, ApiInterface: claim.apiInterface, Proof: proof})
+		}
+	}
+	rws.claimsLock.Unlock()
+	sortProofRecords(records)
+	return records
+}
+
+func sortProofRecords(records []lavasession.ProviderProofRecord) {
+	sort.Slice(records, func(i, j int) bool {
+		if records[i].Epoch != records[j].Epoch {
+			return records[i].Epoch < records[j].Epoch
+		}
+		if records[i].ConsumerAddr != records[j].ConsumerAddr {
+			return records[i].ConsumerAddr < records[j].ConsumerAddr
+		}
+		return records[i].Proof.SessionId < records[j].Proof.SessionId
+	})
+}
+
+// WriteProofsFile writes the proofs to path as json, readable only by the operator
+func WriteProofsFile(path string, records []lavasession.ProviderProofRecord) error {
+	data, err := json.MarshalIndent(ProofsExport{Proofs: records}, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o600)
+}
+
+func ReadProofsFile(path string) ([]lavasession.ProviderProofRecord, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var export ProofsExport
+	if err := json.Unmarshal(data, &export); err != nil {
+		return nil, utils.LavaFormatError("invalid relay proofs file", err, utils.Attribute{Key: "path", Value: path})
+	}
+	return export.Proofs, nil
+}
+
+// VerifyProofRecord checks an imported proof can be claimed by the provider, it was sent to the provider in the record's epoch and
+// signed by the record's consumer, or by a key the consumer granted a badge to
+func VerifyProofRecord(record lavasession.ProviderProofRecord, providerAddress string) error {
+	proof := record.Proof
+	if proof == nil {
+		return fmt.Errorf("record has no proof")
+	}
+	if proof.Provider != providerAddress {
+		return fmt.Errorf("proof was sent to provider %s", proof.Provider)
+	}
+	if uint64(proof.Epoch) != record.Epoch {
+		return fmt.Errorf("proof of epoch %d recorded in epoch %d", proof.Epoch, record.Epoch)
+	}
+	consumerAddress, err := sigs.ExtractSignerAddress(proof)
+	if err != nil {
+		return fmt.Errorf("invalid consumer signature: %w", err)
+	}
+	if proof.Badge != nil {
+		consumerAddress, err = sigs.VerifyRelayBadge(*proof, consumerAddress)
+		if err != nil {
+			return fmt.Errorf("invalid badge: %w", err)
+		}
+	}
+	if consumerAddress.String() != record.ConsumerAddr {
+		return fmt.Errorf("proof was signed by %s and not the recorded consumer %s", consumerAddress, record.ConsumerAddr)
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+package rewardserver
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/lavanet/lava/protocol/lavasession"
+	"github.com/lavanet/lava/utils/sigs"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExportUnclaimedProofs(t *testing.T) {
+	rws, _ := newTestRewardServer(RewardClaimConfig{})
+	addTestProofs(rws, 40, "consumer1", 2, 10)
+	addTestProofs(rws, 20, "consumer2", 1, 30)
+	// a claim that failed and waits to be sent again
+	rws.pendingClaims = []*pendingClaim{{epoch: 0, consumer: "consumer3", apiInterface: "rest", proofs: []*pairingtypes.RelaySession{{SpecId: "LAV1", SessionId: 9, CuSum: 50}}}}
+
+	records := rws.UnclaimedProofs()
+	require.Len(t, records, 4)
+	require.Equal(t, uint64(0), records[0].Epoch)
+	require.Equal(t, "consumer3", records[0].ConsumerAddr)
+	require.Equal(t, "rest", records[0].ApiInterface)
+	require.Equal(t, "consumer2", records[1].ConsumerAddr)
+	require.Equal(t, "tendermint", records[1].ApiInterface)
+	require.Equal(t, uint64(1), records[2].Proof.SessionId)
+
+	path := filepath.Join(t.TempDir(), "proofs.json")
+	require.NoError(t, WriteProofsFile(path, records))
+	imported, err := ReadProofsFile(path)
+	require.NoError(t, err)
+	require.Equal(t, records, imported)
+}
+
+func TestVerifyProofRecord(t *testing.T) {
+	consumerKey, consumerAddress := sigs.GenerateFloatingKey()
+	_, otherAddress := sigs.GenerateFloatingKey()
+	provider := "lava@provider"
+	signedRecord := func(proof *pairingtypes.RelaySession, key *btcec.PrivateKey) lavasession.ProviderProofRecord {
+		sig, err := sigs.SignRelay(key, *proof)
+		require.NoError(t, err)
+		proof.Sig = sig
+		return lavasession.ProviderProofRecord{Epoch: uint64(proof.Epoch), ConsumerAddr: consumerAddress.String(), ApiInterface: "rest", Proof: proof}
+	}
+	newProof := func() *pairingtypes.RelaySession {
+		return &pairingtypes.RelaySession{SpecId: "LAV1", SessionId: 7, Epoch: 20, CuSum: 10, Provider: provider}
+	}
+
+	record := signedRecord(newProof(), consumerKey)
+	require.NoError(t, VerifyProofRecord(record, provider))
+	// proofs of another provider can't be claimed with this provider's key
+	require.Error(t, VerifyProofRecord(record, "lava@other"))
+
+	record.ConsumerAddr = otherAddress.String()
+	require.Error(t, VerifyProofRecord(record, provider))
+
+	record = signedRecord(newProof(), consumerKey)
+	record.Proof.CuSum = 100 // changed after it was signed
+	require.Error(t, VerifyProofRecord(record, provider))
+
+	record = signedRecord(newProof(), consumerKey)
+	record.Epoch = 40
+	require.Error(t, VerifyProofRecord(record, provider))
+	require.Error(t, VerifyProofRecord(lavasession.ProviderProofRecord{Epoch: 20, ConsumerAddr: consumerAddress.String()}, provider))
+
+	// a relay signed with a badge is claimed for the consumer that granted the badge
+	badgeKey, _ := sigs.GenerateFloatingKey()
+	proof := newProof()
+	proof.Badge = &pairingtypes.Badge{CuAllocation: 100, Epoch: 20, BadgePk: badgeKey.PubKey().SerializeCompressed(), SpecId: "LAV1"}
+	badgeSig, err := sigs.SignBadge(consumerKey, *proof.Badge)
+	require.NoError(t, err)
+	proof.Badge.ProjectSig = badgeSig
+	require.NoError(t, VerifyProofRecord(signedRecord(proof, badgeKey), provider))
+}
@@ -0,0 +1,252 @@
+package rewardserver
+
+import (
+	"context"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/utils"
+	"github.com/lavanet/lava/utils/sigs"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+)
+
+const (
+	RewardClaimMaxProofsFlag          = "reward-claim-max-proofs"
+	RewardClaimMinCUFlag              = "reward-claim-min-cu"
+	RewardClaimBlocksBeforeExpiryFlag = "reward-claim-blocks-before-expiry"
+	RewardClaimMaxRetries             = 3
+	RewardClaimRetryBackoff           = 2 * time.Second
+)
+
+// RewardClaimConfig sets when claimable proofs are sent and how many go in one payment tx.
+// the zero value sends all of them in a single tx as soon as their epoch is claimable
+type RewardClaimConfig struct {
+	MaxProofsPerTx     int    // 0 sends every pending proof in one tx
+	MinCUPerClaim      uint64 // pending proofs wait until they add up to this cu, unless they are close to expiring
+	BlocksBeforeExpiry uint64 // proofs this many blocks from leaving the chain's memory are sent regardless of MinCUPerClaim
+}
+
+type RewardClaimStats struct {
+	ClaimedProofs  uint64
+	ClaimedCU      uint64
+	ExpiredProofs  uint64
+	ExpiredCU      uint64
+	FailedClaimTxs uint64
+}
+
+// ChainRewardStats are the reward counters of a single chain, so a provider serving many chains can tell them apart
+type ChainRewardStats struct {
+	ClaimedCU uint64
+	PaidCU    uint64
+	ExpiredCU uint64
+}
+
+// pendingClaim holds the claimable proofs of a consumer in an epoch, with the data reliability proofs that go along with them
+type pendingClaim struct {
+	epoch                 uint64
+	consumer              string
+	apiInterface          string
+	proofs                []*pairingtypes.RelaySession
+	dataReliabilityProofs []*pairingtypes.VRFData
+}
+
+func (pc *pendingClaim) cu() (cu uint64) {
+	for _, proof := range pc.proofs {
+		cu += proof.CuSum
+	}
+	return cu
+}
+
+func (rws *RewardServer) sendRewardsClaim(ctx context.Context, epoch uint64) error {
+	claims, err := rws.gatherRewardsForClaim(ctx, epoch)
+	if err != nil {
+		return err
+	}
+	earliestBlockInMemory, err := rws.rewardsTxSender.EarliestBlockInMemory(ctx)
+	if err != nil {
+		utils.LavaFormatWarning("failed fetching earliest block in memory, claiming without expiry info", err)
+		earliestBlockInMemory = 0
+	}
+	claimsToSend := rws.takeClaimsToSend(claims, earliestBlockInMemory)
+	if len(claimsToSend) == 0 {
+		utils.LavaFormatDebug("no rewards to claim")
+		rws.compactProofStore()
+		return nil
+	}
+	var errRet error
+	for _, batch := range splitClaimsToBatches(claimsToSend, rws.claimConfig.MaxProofsPerTx) {
+		err = rws.sendClaimBatch(ctx, batch)
+		if err != nil {
+			// keep the batch for the next epoch, it is dropped once it expires
+			rws.claimsLock.Lock()
+			rws.pendingClaims = append(rws.pendingClaims, batch...)
+			rws.claimsLock.Unlock()
+			errRet = utils.LavaFormatError("failed sending rewards claim", err)
+		}
+	}
+	rws.compactProofStore()
+	return errRet
+}
+
+// takeClaimsToSend adds the new claims to the pending ones, drops expired claims and returns the claims to send now according to the claim config
+func (rws *RewardServer) takeClaimsToSend(newClaims []*pendingClaim, earliestBlockInMemory uint64) []*pendingClaim {
+	rws.claimsLock.Lock()
+	defer rws.claimsLock.Unlock()
+	pendingClaims := make([]*pendingClaim, 0, len(rws.pendingClaims)+len(newClaims))
+	pendingCU := uint64(0)
+	oldestEpoch := uint64(0)
+	for _, claim := range append(rws.pendingClaims, newClaims...) {
+		if claim.epoch < earliestBlockInMemory {
+			atomic.AddUint64(&rws.claimStats.ExpiredProofs, uint64(len(claim.proofs)))
+			atomic.AddUint64(&rws.claimStats.ExpiredCU, claim.cu())
+			for _, proof := range claim.proofs {
+				rws.updateChainRewardStats(proof.SpecId, func(stats *ChainRewardStats) { stats.ExpiredCU += proof.CuSum })
+			}
+			utils.LavaFormatWarning("relay proofs expired before they were claimed", nil, utils.Attribute{Key: "epoch", Value: claim.epoch}, utils.Attribute{Key: "proofs", Value: len(claim.proofs)}, utils.Attribute{Key: "earliestBlockInMemory", Value: earliestBlockInMemory})
+			continue
+		}
+		pendingClaims = append(pendingClaims, claim)
+		pendingCU += claim.cu()
+		if oldestEpoch == 0 || claim.epoch < oldestEpoch {
+			oldestEpoch = claim.epoch
+		}
+	}
+	closeToExpiry := rws.claimConfig.BlocksBeforeExpiry > 0 && len(pendingClaims) > 0 && oldestEpoch < earliestBlockInMemory+rws.claimConfig.BlocksBeforeExpiry
+	if pendingCU < rws.claimConfig.MinCUPerClaim && !closeToExpiry {
+		rws.pendingClaims = pendingClaims
+		return nil
+	}
+	rws.pendingClaims = nil
+	return pendingClaims
+}
+
+// splitClaimsToBatches packs claims into batches of up to maxProofs proofs, a claim larger than that is split with its data reliability proofs in the first part
+func splitClaimsToBatches(claims []*pendingClaim, maxProofs int) (batches [][]*pendingClaim) {
+	if maxProofs <= 0 {
+		return [][]*pendingClaim{claims}
+	}
+	batch := []*pendingClaim{}
+	batchProofs := 0
+	for _, claim := range claims {
+		proofs := claim.proofs
+		dataReliabilityProofs := claim.dataReliabilityProofs
+		for len(proofs) > 0 {
+			if batchProofs == maxProofs {
+				batches = append(batches, batch)
+				batch = []*pendingClaim{}
+				batchProofs = 0
+			}
+			count := maxProofs - batchProofs
+			if count > len(proofs) {
+				count = len(proofs)
+			}
+			batch = append(batch, &pendingClaim{epoch: claim.epoch, consumer: claim.consumer, apiInterface: claim.apiInterface, proofs: proofs[:count], dataReliabilityProofs: dataReliabilityProofs})
+			batchProofs += count
+			proofs = proofs[count:]
+			dataReliabilityProofs = nil
+		}
+	}
+	if len(batch) > 0 {
+		batches = append(batches, batch)
+	}
+	return batches
+}
+
+func (rws *RewardServer) sendClaimBatch(ctx context.Context, batch []*pendingClaim) (err error) {
+	proofs := []*pairingtypes.RelaySession{}
+	dataReliabilityProofs := []*pairingtypes.VRFData{}
+	for _, claim := range batch {
+		proofs = append(proofs, claim.proofs...)
+		dataReliabilityProofs = append(dataReliabilityProofs, claim.dataReliabilityProofs...)
+	}
+	backoff := rws.claimRetryBackoff
+	for attempt := 0; attempt <= RewardClaimMaxRetries; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(backoff):
+			}
+			backoff *= 2
+		}
+		err = rws.rewardsTxSender.TxRelayPayment(ctx, proofs, dataReliabilityProofs, strconv.FormatUint(rws.serverID, 10))
+		if err == nil {
+			break
+		}
+		atomic.AddUint64(&rws.claimStats.FailedClaimTxs, 1)
+		utils.LavaFormatWarning("rewards claim tx failed", err, utils.Attribute{Key: "attempt", Value: attempt}, utils.Attribute{Key: "proofs", Value: len(proofs)})
+	}
+	if err != nil {
+		return err
+	}
+	for _, relay := range proofs {
+		atomic.AddUint64(&rws.claimStats.ClaimedProofs, 1)
+		atomic.AddUint64(&rws.claimStats.ClaimedCU, relay.CuSum)
+		rws.updateCUServiced(relay.CuSum)
+		rws.updateChainRewardStats(relay.SpecId, func(stats *ChainRewardStats) { stats.ClaimedCU += relay.CuSum })
+		consumerAddr, err := sigs.ExtractSignerAddress(relay)
+		if err != nil {
+			utils.LavaFormatError("invalid consumer address extraction from relay", err, utils.Attribute{Key: "relay", Value: relay})
+			continue
+		}
+		expectedPay := PaymentRequest{ChainID: relay.SpecId, CU: relay.CuSum, BlockHeightDeadline: relay.Epoch, Amount: sdk.Coin{}, Client: consumerAddr, UniqueIdentifier: relay.SessionId, Description: strconv.FormatUint(rws.serverID, 10)}
+		rws.addExpectedPayment(expectedPay)
+	}
+	return nil
+}
+
+// compactProofStore removes claimed proofs from the store, pending claims are kept so they are restored after a restart
+func (rws *RewardServer) compactProofStore() {
+	if rws.proofStore == nil {
+		return
+	}
+	rws.lock.RLock()
+	compactUpTo := rws.claimableEpochThreshold
+	rws.lock.RUnlock()
+	rws.claimsLock.Lock()
+	for _, claim := range rws.pendingClaims {
+		if claim.epoch <= compactUpTo {
+			compactUpTo = claim.epoch - 1
+		}
+	}
+	rws.claimsLock.Unlock()
+	err := rws.proofStore.CompactProofs(compactUpTo)
+	if err != nil {
+		utils.LavaFormatWarning("failed compacting relay proofs store", err, utils.Attribute{Key: "compactUpTo", Value: compactUpTo})
+	}
+}
+
+// ClaimStats returns the claimed and expired proofs counters
+func (rws *RewardServer) ClaimStats() RewardClaimStats {
+	return RewardClaimStats{
+		ClaimedProofs:  atomic.LoadUint64(&rws.claimStats.ClaimedProofs),
+		ClaimedCU:      atomic.LoadUint64(&rws.claimStats.ClaimedCU),
+		ExpiredProofs:  atomic.LoadUint64(&rws.claimStats.ExpiredProofs),
+		ExpiredCU:      atomic.LoadUint64(&rws.claimStats.ExpiredCU),
+		FailedClaimTxs: atomic.LoadUint64(&rws.claimStats.FailedClaimTxs),
+	}
+}
+
+func (rws *RewardServer) updateChainRewardStats(chainID string, update func(stats *ChainRewardStats)) {
+	rws.chainStatsLock.Lock()
+	defer rws.chainStatsLock.Unlock()
+	stats, ok := rws.chainStats[chainID]
+	if !ok {
+		stats = &ChainRewardStats{}
+		rws.chainStats[chainID] = stats
+	}
+	update(stats)
+}
+
+// ChainRewardStats returns the reward counters of every chain the provider claimed or got paid on
+func (rws *RewardServer) ChainRewardStats() map[string]ChainRewardStats {
+	rws.chainStatsLock.Lock()
+	defer rws.chainStatsLock.Unlock()
+	chainStats := make(map[string]ChainRewardStats, len(rws.chainStats))
+	for chainID, stats := range rws.chainStats {
+		chainStats[chainID] = *stats
+	}
+	return chainStats
+}
@@ -0,0 +1,135 @@
+package rewardserver
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testBlockDistanceForEpochValidity = 40
+	testEpochSize                     = 20
+)
+
+type mockRewardsTxSender struct {
+	lock                  sync.Mutex
+	sentBatches           [][]*pairingtypes.RelaySession
+	failures              int
+	earliestBlockInMemory uint64
+}
+
+func (mrts *mockRewardsTxSender) TxRelayPayment(ctx context.Context, relayRequests []*pairingtypes.RelaySession, dataReliabilityProofs []*pairingtypes.VRFData, description string) error {
+	mrts.lock.Lock()
+	defer mrts.lock.Unlock()
+	if mrts.failures > 0 {
+		mrts.failures--
+		return errors.New("tx failed")
+	}
+	mrts.sentBatches = append(mrts.sentBatches, relayRequests)
+	return nil
+}
+
+func (mrts *mockRewardsTxSender) GetEpochSizeMultipliedByRecommendedEpochNumToCollectPayment(ctx context.Context) (uint64, error) {
+	return testBlockDistanceForEpochValidity, nil
+}
+
+func (mrts *mockRewardsTxSender) EarliestBlockInMemory(ctx context.Context) (uint64, error) {
+	return mrts.earliestBlockInMemory, nil
+}
+
+func newTestRewardServer(claimConfig RewardClaimConfig) (*RewardServer, *mockRewardsTxSender) {
+	txSender := &mockRewardsTxSender{}
+	rws := NewRewardServer(txSender, claimConfig)
+	rws.claimRetryBackoff = 0
+	return rws, txSender
+}
+
+func addTestProofs(rws *RewardServer, epoch uint64, consumer string, count int, cu uint64) {
+	for sessionId := 1; sessionId <= count; sessionId++ {
+		proof := &pairingtypes.RelaySession{SpecId: "LAV1", SessionId: uint64(sessionId), CuSum: cu, Epoch: int64(epoch)}
+		rws.SendNewProof(context.Background(), proof, epoch, consumer, "tendermint")
+	}
+}
+
+func TestRewardClaimsSentInBatches(t *testing.T) {
+	ctx := context.Background()
+	rws, txSender := newTestRewardServer(RewardClaimConfig{MaxProofsPerTx: 2})
+	addTestProofs(rws, testEpochSize, "consumer1", 3, 10)
+	addTestProofs(rws, testEpochSize, "consumer2", 2, 10)
+	// proofs of an epoch that can still be used are not claimed
+	addTestProofs(rws, 3*testEpochSize, "consumer1", 1, 10)
+
+	require.NoError(t, rws.sendRewardsClaim(ctx, 3*testEpochSize))
+	require.Len(t, txSender.sentBatches, 3)
+	for _, batch := range txSender.sentBatches {
+		require.LessOrEqual(t, len(batch), 2)
+	}
+	stats := rws.ClaimStats()
+	require.Equal(t, uint64(5), stats.ClaimedProofs)
+	require.Equal(t, uint64(50), stats.ClaimedCU)
+	require.Zero(t, stats.FailedClaimTxs)
+}
+
+func TestRewardClaimsWaitForMinimumCU(t *testing.T) {
+	ctx := context.Background()
+	rws, txSender := newTestRewardServer(RewardClaimConfig{MinCUPerClaim: 30, BlocksBeforeExpiry: testEpochSize})
+	addTestProofs(rws, testEpochSize, "consumer1", 2, 10)
+	require.NoError(t, rws.sendRewardsClaim(ctx, 3*testEpochSize))
+	require.Empty(t, txSender.sentBatches)
+
+	// pending proofs are claimed together with new ones once there is enough cu
+	addTestProofs(rws, 2*testEpochSize, "consumer1", 1, 10)
+	require.NoError(t, rws.sendRewardsClaim(ctx, 4*testEpochSize))
+	require.Len(t, txSender.sentBatches, 1)
+	require.Len(t, txSender.sentBatches[0], 3)
+
+	// proofs close to leaving the chain's memory are claimed without enough cu
+	addTestProofs(rws, 3*testEpochSize, "consumer1", 1, 10)
+	txSender.earliestBlockInMemory = 3*testEpochSize - 1
+	require.NoError(t, rws.sendRewardsClaim(ctx, 5*testEpochSize))
+	require.Len(t, txSender.sentBatches, 2)
+}
+
+func TestRewardClaimsRetryAndExpire(t *testing.T) {
+	ctx := context.Background()
+	rws, txSender := newTestRewardServer(RewardClaimConfig{})
+	addTestProofs(rws, testEpochSize, "consumer1", 1, 10)
+
+	// a failed tx is retried
+	txSender.failures = RewardClaimMaxRetries
+	require.NoError(t, rws.sendRewardsClaim(ctx, 3*testEpochSize))
+	require.Len(t, txSender.sentBatches, 1)
+	require.Equal(t, uint64(RewardClaimMaxRetries), rws.ClaimStats().FailedClaimTxs)
+
+	// a claim that keeps failing stays pending until it expires
+	addTestProofs(rws, 2*testEpochSize, "consumer1", 1, 10)
+	txSender.failures = RewardClaimMaxRetries + 1
+	require.Error(t, rws.sendRewardsClaim(ctx, 4*testEpochSize))
+	require.Len(t, rws.pendingClaims, 1)
+
+	txSender.earliestBlockInMemory = 3 * testEpochSize
+	require.NoError(t, rws.sendRewardsClaim(ctx, 5*testEpochSize))
+	require.Empty(t, rws.pendingClaims)
+	stats := rws.ClaimStats()
+	require.Equal(t, uint64(1), stats.ClaimedProofs)
+	require.Equal(t, uint64(1), stats.ExpiredProofs)
+	require.Equal(t, uint64(10), stats.ExpiredCU)
+}
+
+func TestChainRewardStats(t *testing.T) {
+	ctx := context.Background()
+	rws, _ := newTestRewardServer(RewardClaimConfig{})
+	addTestProofs(rws, testEpochSize, "consumer1", 2, 10)
+	rws.SendNewProof(ctx, &pairingtypes.RelaySession{SpecId: "ETH1", SessionId: 1, CuSum: 30, Epoch: testEpochSize}, testEpochSize, "consumer1", "jsonrpc")
+	require.NoError(t, rws.sendRewardsClaim(ctx, 3*testEpochSize))
+
+	rws.PaymentHandler(&PaymentRequest{ChainID: "ETH1", CU: 30, Description: rws.Description()})
+	chainStats := rws.ChainRewardStats()
+	require.Len(t, chainStats, 2)
+	require.Equal(t, ChainRewardStats{ClaimedCU: 20}, chainStats["LAV1"])
+	require.Equal(t, ChainRewardStats{ClaimedCU: 30, PaidCU: 30}, chainStats["ETH1"])
+}
@@ -6,11 +6,11 @@ import (
 	"strconv"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/lavanet/lava/protocol/lavasession"
 	"github.com/lavanet/lava/utils"
-	"github.com/lavanet/lava/utils/sigs"
 	pairingtypes "github.com/lavanet/lava/x/pairing/types"
 	terderminttypes "github.com/tendermint/tendermint/abci/types"
 )
@@ -28,6 +28,7 @@ type PaymentRequest struct {
 type ConsumerRewards struct {
 	epoch                 uint64
 	consumer              string
+	apiInterface          string
 	proofs                map[uint64]*pairingtypes.RelaySession // key is sessionID
 	dataReliabilityProofs []*pairingtypes.VRFData
 }
@@ -62,6 +63,17 @@ type RewardServer struct {
 	expectedPayments []PaymentRequest
 	totalCUServiced  uint64
 	totalCUPaid      uint64
+	proofStore       lavasession.ProviderSessionStore // optional, persists the latest proofs until they are claimed
+
+	claimConfig             RewardClaimConfig
+	claimsLock              sync.Mutex
+	pendingClaims           []*pendingClaim
+	claimableEpochThreshold uint64
+	claiming                uint32
+	claimRetryBackoff       time.Duration
+	claimStats              RewardClaimStats
+	chainStatsLock          sync.Mutex
+	chainStats              map[string]*ChainRewardStats
 }
 
 type RewardsTxSender interface {
@@ -72,19 +84,32 @@ type RewardsTxSender interface {
 
 func (rws *RewardServer) SendNewProof(ctx context.Context, proof *pairingtypes.RelaySession, epoch uint64, consumerAddr string, apiInterface string) (existingCU uint64, updatedWithProof bool) {
 	rws.lock.Lock() // assuming 99% of the time we will need to write the new entry so there's no use in doing the read lock first to check stuff
-	defer rws.lock.Unlock()
+	existingCU, updatedWithProof = rws.addProof(proof, epoch, consumerAddr, apiInterface)
+	rws.lock.Unlock()
+	// persisting is done unlocked, the store keeps the highest cu proof if saves are reordered
+	if updatedWithProof && rws.proofStore != nil {
+		err := rws.proofStore.SaveProof(lavasession.ProviderProofRecord{Epoch: epoch, ConsumerAddr: consumerAddr, ApiInterface: apiInterface, Proof: proof})
+		if err != nil {
+			utils.LavaFormatWarning("failed persisting relay proof", err, utils.Attribute{Key: "sessionID", Value: proof.SessionId}, utils.Attribute{Key: "consumer", Value: consumerAddr})
+		}
+	}
+	return existingCU, updatedWithProof
+}
+
+// addProof keeps the proof with the highest cu per session, must be called while locked
+func (rws *RewardServer) addProof(proof *pairingtypes.RelaySession, epoch uint64, consumerAddr string, apiInterface string) (existingCU uint64, updatedWithProof bool) {
 	consumerRewardsKey := getKeyForConsumerRewards(proof.SpecId, apiInterface, consumerAddr)
 	epochRewards, ok := rws.rewards[epoch]
 	if !ok {
 		proofs := map[uint64]*pairingtypes.RelaySession{proof.SessionId: proof}
-		consumerRewardsMap := map[string]*ConsumerRewards{consumerRewardsKey: {epoch: epoch, consumer: consumerAddr, proofs: proofs, dataReliabilityProofs: []*pairingtypes.VRFData{}}}
+		consumerRewardsMap := map[string]*ConsumerRewards{consumerRewardsKey: {epoch: epoch, consumer: consumerAddr, apiInterface: apiInterface, proofs: proofs, dataReliabilityProofs: []*pairingtypes.VRFData{}}}
 		rws.rewards[epoch] = &EpochRewards{epoch: epoch, consumerRewards: consumerRewardsMap}
 		return 0, true
 	}
 	consumerRewards, ok := epochRewards.consumerRewards[consumerRewardsKey]
 	if !ok {
 		proofs := map[uint64]*pairingtypes.RelaySession{proof.SessionId: proof}
-		consumerRewards := &ConsumerRewards{epoch: epoch, consumer: consumerAddr, proofs: proofs, dataReliabilityProofs: []*pairingtypes.VRFData{}}
+		consumerRewards := &ConsumerRewards{epoch: epoch, consumer: consumerAddr, apiInterface: apiInterface, proofs: proofs, dataReliabilityProofs: []*pairingtypes.VRFData{}}
 		epochRewards.consumerRewards[consumerRewardsKey] = consumerRewards
 		return 0, true
 	}
@@ -107,13 +132,13 @@ func (rws *RewardServer) SendNewDataReliabilityProof(ctx context.Context, dataRe
 	consumerRewardsKey := getKeyForConsumerRewards(specId, apiInterface, consumerAddr)
 	epochRewards, ok := rws.rewards[epoch]
 	if !ok {
-		consumerRewardsMap := map[string]*ConsumerRewards{(consumerRewardsKey): {epoch: epoch, consumer: consumerAddr, proofs: map[uint64]*pairingtypes.RelaySession{}, dataReliabilityProofs: []*pairingtypes.VRFData{dataReliability}}}
+		consumerRewardsMap := map[string]*ConsumerRewards{(consumerRewardsKey): {epoch: epoch, consumer: consumerAddr, apiInterface: apiInterface, proofs: map[uint64]*pairingtypes.RelaySession{}, dataReliabilityProofs: []*pairingtypes.VRFData{dataReliability}}}
 		rws.rewards[epoch] = &EpochRewards{epoch: epoch, consumerRewards: consumerRewardsMap}
 		return true
 	}
 	consumerRewards, ok := epochRewards.consumerRewards[consumerRewardsKey]
 	if !ok {
-		consumerRewards := &ConsumerRewards{epoch: epoch, consumer: consumerAddr, proofs: map[uint64]*pairingtypes.RelaySession{}, dataReliabilityProofs: []*pairingtypes.VRFData{dataReliability}}
+		consumerRewards := &ConsumerRewards{epoch: epoch, consumer: consumerAddr, apiInterface: apiInterface, proofs: map[uint64]*pairingtypes.RelaySession{}, dataReliabilityProofs: []*pairingtypes.VRFData{dataReliability}}
 		epochRewards.consumerRewards[consumerRewardsKey] = consumerRewards
 		return true
 	}
@@ -126,34 +151,14 @@ func (rws *RewardServer) SendNewDataReliabilityProof(ctx context.Context, dataRe
 
 func (rws *RewardServer) UpdateEpoch(epoch uint64) {
 	ctx := context.Background()
-	_ = rws.sendRewardsClaim(ctx, epoch)
-	_, _ = rws.identifyMissingPayments(ctx)
-}
-
-func (rws *RewardServer) sendRewardsClaim(ctx context.Context, epoch uint64) error {
-	rewardsToClaim, dataReliabilityProofs, err := rws.gatherRewardsForClaim(ctx, epoch)
-	if err != nil {
-		return err
-	}
-	for _, relay := range rewardsToClaim {
-		consumerAddr, err := sigs.ExtractSignerAddress(relay)
-		if err != nil {
-			utils.LavaFormatError("invalid consumer address extraction from relay", err, utils.Attribute{Key: "relay", Value: relay})
-			continue
-		}
-		expectedPay := PaymentRequest{ChainID: relay.SpecId, CU: relay.CuSum, BlockHeightDeadline: relay.Epoch, Amount: sdk.Coin{}, Client: consumerAddr, UniqueIdentifier: relay.SessionId, Description: strconv.FormatUint(rws.serverID, 10)}
-		rws.addExpectedPayment(expectedPay)
-		rws.updateCUServiced(relay.CuSum)
+	// claims retry with a backoff so they don't hold the epoch update, a claim still running leaves new proofs pending for the next epoch
+	if atomic.CompareAndSwapUint32(&rws.claiming, 0, 1) {
+		go func() {
+			defer atomic.StoreUint32(&rws.claiming, 0)
+			_ = rws.sendRewardsClaim(ctx, epoch)
+		}()
 	}
-	if len(rewardsToClaim) > 0 {
-		err = rws.rewardsTxSender.TxRelayPayment(ctx, rewardsToClaim, dataReliabilityProofs, strconv.FormatUint(rws.serverID, 10))
-		if err != nil {
-			return utils.LavaFormatError("failed sending rewards claim", err)
-		}
-	} else {
-		utils.LavaFormatDebug("no rewards to claim")
-	}
-	return nil
+	_, _ = rws.identifyMissingPayments(ctx)
 }
 
 func (rws *RewardServer) identifyMissingPayments(ctx context.Context) (missingPayments bool, err error) {
@@ -190,6 +195,8 @@ func (rws *RewardServer) identifyMissingPayments(ctx context.Context) (missingPa
 	utils.LavaFormatInfo("Service report",
 		utils.Attribute{Key: "total CU serviced", Value: rws.cUServiced()},
 		utils.Attribute{Key: "total CU that got paid", Value: rws.paidCU()},
+		utils.Attribute{Key: "claims", Value: rws.ClaimStats()},
+		utils.Attribute{Key: "chains", Value: rws.ChainRewardStats()},
 	)
 	return missingPayments, err
 }
@@ -223,18 +230,19 @@ func (rws *RewardServer) RemoveExpectedPayment(paidCUToFInd uint64, expectedClie
 	return false
 }
 
-func (rws *RewardServer) gatherRewardsForClaim(ctx context.Context, currentEpoch uint64) (rewardsForClaim []*pairingtypes.RelaySession, dataReliabilityProofs []*pairingtypes.VRFData, errRet error) {
+func (rws *RewardServer) gatherRewardsForClaim(ctx context.Context, currentEpoch uint64) (claims []*pendingClaim, errRet error) {
 	rws.lock.Lock()
 	defer rws.lock.Unlock()
 	blockDistanceForEpochValidity, err := rws.rewardsTxSender.GetEpochSizeMultipliedByRecommendedEpochNumToCollectPayment(ctx)
 	if err != nil {
-		return nil, nil, utils.LavaFormatError("gatherRewardsForClaim failed to GetEpochSizeMultipliedByRecommendedEpochNumToCollectPayment", err)
+		return nil, utils.LavaFormatError("gatherRewardsForClaim failed to GetEpochSizeMultipliedByRecommendedEpochNumToCollectPayment", err)
 	}
 
 	if blockDistanceForEpochValidity > currentEpoch {
-		return nil, nil, utils.LavaFormatWarning("gatherRewardsForClaim current epoch is too low to claim rewards", nil, utils.Attribute{Key: "current epoch", Value: currentEpoch})
+		return nil, utils.LavaFormatWarning("gatherRewardsForClaim current epoch is too low to claim rewards", nil, utils.Attribute{Key: "current epoch", Value: currentEpoch})
 	}
 	activeEpochThreshold := currentEpoch - blockDistanceForEpochValidity
+	rws.claimableEpochThreshold = activeEpochThreshold
 	for epoch, epochRewards := range rws.rewards {
 		if lavasession.IsEpochValidForUse(epoch, activeEpochThreshold) {
 			// Epoch is still active so we don't claim the rewards yet.
@@ -247,15 +255,16 @@ func (rws *RewardServer) gatherRewardsForClaim(ctx context.Context, currentEpoch
 				// can't claim this now
 				continue
 			}
-			rewardsForClaim = append(rewardsForClaim, claimables...)
-			dataReliabilityProofs = append(dataReliabilityProofs, dataReliabilities...)
+			if len(claimables) > 0 {
+				claims = append(claims, &pendingClaim{epoch: epoch, consumer: rewards.consumer, apiInterface: rewards.apiInterface, proofs: claimables, dataReliabilityProofs: dataReliabilities})
+			}
 			delete(epochRewards.consumerRewards, consumerAddr)
 		}
 		if len(epochRewards.consumerRewards) == 0 {
 			delete(rws.rewards, epoch)
 		}
 	}
-	return rewardsForClaim, dataReliabilityProofs, errRet
+	return claims, errRet
 }
 
 func (rws *RewardServer) SubscribeStarted(consumer string, epoch uint64, subscribeID string) {
@@ -292,6 +301,7 @@ func (rws *RewardServer) PaymentHandler(payment *PaymentRequest) {
 	}
 	if serverID == rws.serverID {
 		rws.updateCUPaid(payment.CU)
+		rws.updateChainRewardStats(payment.ChainID, func(stats *ChainRewardStats) { stats.PaidCU += payment.CU })
 		removedPayment := rws.RemoveExpectedPayment(payment.CU, payment.Client, payment.BlockHeightDeadline, payment.UniqueIdentifier, payment.ChainID)
 		if !removedPayment {
 			utils.LavaFormatWarning("tried removing payment that wasn;t expected", nil, utils.Attribute{Key: "payment", Value: payment})
@@ -299,14 +309,30 @@ func (rws *RewardServer) PaymentHandler(payment *PaymentRequest) {
 	}
 }
 
-func NewRewardServer(rewardsTxSender RewardsTxSender) *RewardServer {
+// SetProofStore restores the proofs that were not claimed before a restart and persists new proofs from now on
+func (rws *RewardServer) SetProofStore(proofStore lavasession.ProviderSessionStore) error {
+	records, err := proofStore.LoadProofs()
+	if err != nil {
+		return utils.LavaFormatError("failed loading relay proofs from store", err)
+	}
+	rws.lock.Lock()
+	defer rws.lock.Unlock()
+	rws.proofStore = proofStore
+	for _, record := range records {
+		rws.addProof(record.Proof, record.Epoch, record.ConsumerAddr, record.ApiInterface)
+	}
+	utils.LavaFormatInfo("restored relay proofs from store", utils.Attribute{Key: "proofs", Value: len(records)})
+	return nil
+}
+
+func NewRewardServer(rewardsTxSender RewardsTxSender, claimConfig RewardClaimConfig) *RewardServer {
 	//
-	rws := &RewardServer{totalCUServiced: 0, totalCUPaid: 0}
+	rws := &RewardServer{totalCUServiced: 0, totalCUPaid: 0, claimConfig: claimConfig, claimRetryBackoff: RewardClaimRetryBackoff}
 	rws.serverID = uint64(rand.Int63())
 	rws.rewardsTxSender = rewardsTxSender
 	rws.expectedPayments = []PaymentRequest{}
-	// TODO: load this from persistency
 	rws.rewards = map[uint64]*EpochRewards{}
+	rws.chainStats = map[string]*ChainRewardStats{}
 	return rws
 }
 
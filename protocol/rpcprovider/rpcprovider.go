@@ -6,9 +6,11 @@ import (
 	"math/rand"
 	"os"
 	"os/signal"
+	"reflect"
 	"strconv"
 	"strings"
 	"sync"
+	"syscall"
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -23,9 +25,11 @@ import (
 	"github.com/lavanet/lava/protocol/common"
 	"github.com/lavanet/lava/protocol/lavasession"
 	"github.com/lavanet/lava/protocol/performance"
+	"github.com/lavanet/lava/protocol/rpcprovider/auditlog"
 	"github.com/lavanet/lava/protocol/rpcprovider/reliabilitymanager"
 	"github.com/lavanet/lava/protocol/rpcprovider/rewardserver"
 	"github.com/lavanet/lava/protocol/statetracker"
+	"github.com/lavanet/lava/protocol/tracing"
 	"github.com/lavanet/lava/utils"
 	"github.com/lavanet/lava/utils/sigs"
 	pairingtypes "github.com/lavanet/lava/x/pairing/types"
@@ -48,6 +52,9 @@ type ProviderStateTrackerInf interface {
 	RegisterReliabilityManagerForVoteUpdates(ctx context.Context, voteUpdatable statetracker.VoteUpdatable, endpointP *lavasession.RPCProviderEndpoint)
 	RegisterForEpochUpdates(ctx context.Context, epochUpdatable statetracker.EpochUpdatable)
 	TxRelayPayment(ctx context.Context, relayRequests []*pairingtypes.RelaySession, dataReliabilityProofs []*pairingtypes.VRFData, description string) error
+	TxFreezeProvider(ctx context.Context, chainIDs []string, reason string) error
+	TxUnfreezeProvider(ctx context.Context, chainIDs []string) error
+	TxAttestEndpointHealth(ctx context.Context, chainIDs []string, protocolVersion uint32, binaryVersion string) error
 	SendVoteReveal(voteID string, vote *reliabilitymanager.VoteData) error
 	SendVoteCommitment(voteID string, vote *reliabilitymanager.VoteData) error
 	LatestBlock() int64
@@ -65,17 +72,34 @@ type RPCProvider struct {
 	providerStateTracker ProviderStateTrackerInf
 	rpcProviderListeners map[string]*ProviderListener
 	lock                 sync.Mutex
+	reloadEndpoints      func() ([]*lavasession.RPCProviderEndpoint, error) // reads the config again on SIGHUP, nil when there is no config to reload
+	parallelConnections  uint
+	endpoints            map[string]*providerEndpoint // serving endpoints by key, guarded by lock
+	listenerConfig       ProviderListenerConfig
+	proofsExportPath     string // the unclaimed relay proofs are written to it on shutdown, empty doesn't export them
 }
 
-func (rpcp *RPCProvider) Start(ctx context.Context, txFactory tx.Factory, clientCtx client.Context, rpcProviderEndpoints []*lavasession.RPCProviderEndpoint, cache *performance.Cache, parallelConnections uint) (err error) {
+// providerEndpoint holds what a reload can replace in a serving endpoint
+type providerEndpoint struct {
+	endpoint         *lavasession.RPCProviderEndpoint
+	nodeUrls         []common.NodeUrl
+	averageBlockTime time.Duration
+	chainProxy       *reloadableChainProxy
+	server           *RPCProviderServer
+}
+
+func (rpcp *RPCProvider) Start(ctx context.Context, txFactory tx.Factory, clientCtx client.Context, rpcProviderEndpoints []*lavasession.RPCProviderEndpoint, cache *performance.Cache, parallelConnections uint, relayThrottlerConfig lavasession.ProviderRelayThrottlerConfig, sessionStore lavasession.ProviderSessionStore, claimConfig rewardserver.RewardClaimConfig, nodeHealthConfig NodeHealthConfig, auditLogConfig auditlog.Config, attestEndpointsEpochs uint64, listenerConfig ProviderListenerConfig) (err error) {
 	ctx, cancel := context.WithCancel(ctx)
 	signalChan := make(chan os.Signal, 1)
-	signal.Notify(signalChan, os.Interrupt)
+	signal.Notify(signalChan, os.Interrupt, syscall.SIGHUP)
 	defer func() {
 		signal.Stop(signalChan)
 		cancel()
 	}()
 	rpcp.rpcProviderListeners = make(map[string]*ProviderListener)
+	rpcp.endpoints = make(map[string]*providerEndpoint)
+	rpcp.parallelConnections = parallelConnections
+	rpcp.listenerConfig = listenerConfig
 	// single state tracker
 	lavaChainFetcher := chainlib.NewLavaChainFetcher(ctx, clientCtx)
 	providerStateTracker, err := statetracker.NewProviderStateTracker(ctx, txFactory, clientCtx, lavaChainFetcher)
@@ -83,10 +107,24 @@ func (rpcp *RPCProvider) Start(ctx context.Context, txFactory tx.Factory, client
 		return err
 	}
 	rpcp.providerStateTracker = providerStateTracker
+	// single audit log, relays of all endpoints are written to the same file
+	relayAuditLog, err := auditlog.NewRelayAuditLog(auditLogConfig)
+	if err != nil {
+		return err
+	}
+	defer relayAuditLog.Close()
 	// single reward server
-	rewardServer := rewardserver.NewRewardServer(providerStateTracker)
+	rewardServer := rewardserver.NewRewardServer(providerStateTracker, claimConfig)
+	if sessionStore != nil {
+		err = rewardServer.SetProofStore(sessionStore)
+		if err != nil {
+			return err
+		}
+	}
 	rpcp.providerStateTracker.RegisterForEpochUpdates(ctx, rewardServer)
 	rpcp.providerStateTracker.RegisterPaymentUpdatableForPayments(ctx, rewardServer)
+	// single endpoint attester, attesting the healthy chains of all endpoints together
+	endpointAttester := NewEndpointAttester(providerStateTracker, attestEndpointsEpochs)
 	keyName, err := sigs.GetKeyName(clientCtx)
 	if err != nil {
 		utils.LavaFormatFatal("failed getting key name from clientCtx", err)
@@ -111,13 +149,12 @@ func (rpcp *RPCProvider) Start(ctx context.Context, txFactory tx.Factory, client
 
 	// pre loop to handle synchronous actions
 	chainMutexes := map[string]*sync.Mutex{}
-	for idx, endpoint := range rpcProviderEndpoints {
+	fillSharedNetworkAddresses(rpcProviderEndpoints)
+	for _, endpoint := range rpcProviderEndpoints {
 		chainMutexes[endpoint.ChainID] = &sync.Mutex{} // create a mutex per chain for shared resources
-		if idx > 0 && endpoint.NetworkAddress == "" {  // handle undefined addresses as the previous endpoint for shared listeners
-			endpoint.NetworkAddress = rpcProviderEndpoints[idx-1].NetworkAddress
-		}
 	}
 	var stateTrackersPerChain sync.Map
+	var nodeHealthMonitorsPerChain sync.Map
 	var wg sync.WaitGroup
 	parallelJobs := len(rpcProviderEndpoints)
 	wg.Add(parallelJobs)
@@ -126,13 +163,33 @@ func (rpcp *RPCProvider) Start(ctx context.Context, txFactory tx.Factory, client
 	for _, rpcProviderEndpoint := range rpcProviderEndpoints {
 		go func(rpcProviderEndpoint *lavasession.RPCProviderEndpoint) error {
 			defer wg.Done()
+			// endpoints of all chains share this process, so a chain failing its setup is disabled without stopping the others.
+			// setup failures are returned as errors rather than LavaFormatFatal, which exits the process and can't be recovered,
+			// the fatal calls left are of the listeners shared by all chains
+			defer func() {
+				if r := recover(); r != nil {
+					utils.LavaFormatError("panic severity critical error, endpoint setup panicked, continuing with other endpoints", nil, utils.Attribute{Key: "panic", Value: r}, utils.Attribute{Key: "endpoint", Value: rpcProviderEndpoint.String()})
+					disabledEndpoints <- rpcProviderEndpoint
+				}
+			}()
 			err := rpcProviderEndpoint.Validate()
 			if err != nil {
 				return utils.LavaFormatError("panic severity critical error, aborting support for chain api due to invalid node url definition, continuing with others", err, utils.Attribute{Key: "endpoint", Value: rpcProviderEndpoint.String()})
 			}
 			chainID := rpcProviderEndpoint.ChainID
 			providerSessionManager := lavasession.NewProviderSessionManager(rpcProviderEndpoint, blockMemorySize)
+			if sessionStore != nil {
+				err = providerSessionManager.SetSessionStore(sessionStore)
+				if err != nil {
+					disabledEndpoints <- rpcProviderEndpoint
+					return utils.LavaFormatError("panic severity critical error, failed restoring provider sessions, continuing with others", err, utils.Attribute{Key: "endpoint", Value: rpcProviderEndpoint.String()})
+				}
+			}
 			rpcp.providerStateTracker.RegisterForEpochUpdates(ctx, providerSessionManager)
+			relayThrottler := lavasession.NewProviderRelayThrottler(relayThrottlerConfig)
+			if relayThrottler != nil {
+				rpcp.providerStateTracker.RegisterForEpochUpdates(ctx, relayThrottler)
+			}
 			chainParser, err := chainlib.NewChainParser(rpcProviderEndpoint.ApiInterface)
 			if err != nil {
 				disabledEndpoints <- rpcProviderEndpoint
@@ -140,14 +197,19 @@ func (rpcp *RPCProvider) Start(ctx context.Context, txFactory tx.Factory, client
 			}
 			providerStateTracker.RegisterChainParserForSpecUpdates(ctx, chainParser, chainID)
 			_, averageBlockTime, _, _ := chainParser.ChainBlockStats()
-			chainProxy, err := chainlib.GetChainProxy(ctx, parallelConnections, rpcProviderEndpoint, averageBlockTime)
+			// the node connections live in their own context, so reloading the node urls can close them
+			nodeCtx, nodeCancel := context.WithCancel(ctx)
+			nodeChainProxy, err := chainlib.GetChainProxy(nodeCtx, parallelConnections, rpcProviderEndpoint, averageBlockTime)
 			if err != nil {
+				nodeCancel()
 				disabledEndpoints <- rpcProviderEndpoint
 				return utils.LavaFormatError("panic severity critical error, failed creating chain proxy, continuing with others endpoints", err, utils.Attribute{Key: "parallelConnections", Value: uint64(parallelConnections)}, utils.Attribute{Key: "rpcProviderEndpoint", Value: rpcProviderEndpoint})
 			}
+			chainProxy := newReloadableChainProxy(nodeChainProxy, nodeCancel)
 
 			_, averageBlockTime, blocksToFinalization, blocksInFinalizationData := chainParser.ChainBlockStats()
 			var chainTracker *chaintracker.ChainTracker
+			var nodeHealthMonitor *NodeHealthMonitor
 
 			// in order to utilize shared resources between chains we need go routines with the same chain to wait for one another here
 			chainCommonSetup := func() error {
@@ -155,11 +217,16 @@ func (rpcp *RPCProvider) Start(ctx context.Context, txFactory tx.Factory, client
 				defer chainMutexes[chainID].Unlock()
 				chainTrackerInf, found := stateTrackersPerChain.Load(chainID)
 				if !found {
+					nodeHealthMonitor = NewNodeHealthMonitor(chainID, averageBlockTime, nodeHealthConfig, providerStateTracker)
 					blocksToSaveChainTracker := uint64(blocksToFinalization + blocksInFinalizationData)
 					chainTrackerConfig := chaintracker.ChainTrackerConfig{
 						BlocksToSave:      blocksToSaveChainTracker,
 						AverageBlockTime:  averageBlockTime,
 						ServerBlockMemory: ChainTrackerDefaultMemory + blocksToSaveChainTracker,
+						NewLatestCallback: func(block int64) {
+							cache.OnNewLatestBlock(chainID, block, averageBlockTime)
+							nodeHealthMonitor.OnNewLatestBlock(block)
+						},
 					}
 					chainFetcher := chainlib.NewChainFetcher(ctx, chainProxy, chainParser, rpcProviderEndpoint)
 					chainTracker, err = chaintracker.NewChainTracker(ctx, chainFetcher, chainTrackerConfig)
@@ -167,12 +234,17 @@ func (rpcp *RPCProvider) Start(ctx context.Context, txFactory tx.Factory, client
 						return utils.LavaFormatError("panic severity critical error, aborting support for chain api due to node access, continuing with other endpoints", err, utils.Attribute{Key: "chainTrackerConfig", Value: chainTrackerConfig}, utils.Attribute{Key: "endpoint", Value: rpcProviderEndpoint})
 					}
 					stateTrackersPerChain.Store(rpcProviderEndpoint.ChainID, chainTracker)
+					nodeHealthMonitorsPerChain.Store(rpcProviderEndpoint.ChainID, nodeHealthMonitor)
+					nodeHealthMonitor.Start(ctx)
+					endpointAttester.AddChain(chainID, nodeHealthMonitor)
 				} else {
 					var ok bool
 					chainTracker, ok = chainTrackerInf.(*chaintracker.ChainTracker)
 					if !ok {
-						utils.LavaFormatFatal("invalid usage of syncmap, could not cast result into a chaintracker", nil)
+						return utils.LavaFormatError("panic severity critical error, invalid usage of syncmap, could not cast result into a chaintracker", nil, utils.Attribute{Key: "endpoint", Value: rpcProviderEndpoint})
 					}
+					nodeHealthMonitorInf, _ := nodeHealthMonitorsPerChain.Load(chainID)
+					nodeHealthMonitor, _ = nodeHealthMonitorInf.(*NodeHealthMonitor) // nil when the health check is disabled
 					utils.LavaFormatDebug("reusing chain tracker", utils.Attribute{Key: "chain", Value: rpcProviderEndpoint.ChainID})
 				}
 
@@ -187,7 +259,7 @@ func (rpcp *RPCProvider) Start(ctx context.Context, txFactory tx.Factory, client
 			providerStateTracker.RegisterReliabilityManagerForVoteUpdates(ctx, reliabilityManager, rpcProviderEndpoint)
 
 			rpcProviderServer := &RPCProviderServer{}
-			rpcProviderServer.ServeRPCRequests(ctx, rpcProviderEndpoint, chainParser, rewardServer, providerSessionManager, reliabilityManager, privKey, cache, chainProxy, providerStateTracker, addr, lavaChainID, DEFAULT_ALLOWED_MISSING_CU)
+			rpcProviderServer.ServeRPCRequests(ctx, rpcProviderEndpoint, chainParser, rewardServer, providerSessionManager, reliabilityManager, privKey, cache, chainProxy, providerStateTracker, addr, lavaChainID, DEFAULT_ALLOWED_MISSING_CU, relayThrottler, nodeHealthMonitor, relayAuditLog)
 			// set up grpc listener
 			var listener *ProviderListener
 			func() {
@@ -197,14 +269,29 @@ func (rpcp *RPCProvider) Start(ctx context.Context, txFactory tx.Factory, client
 				listener, ok = rpcp.rpcProviderListeners[rpcProviderEndpoint.NetworkAddress]
 				if !ok {
 					utils.LavaFormatDebug("creating new listener", utils.Attribute{Key: "NetworkAddress", Value: rpcProviderEndpoint.NetworkAddress})
-					listener = NewProviderListener(ctx, rpcProviderEndpoint.NetworkAddress)
+					listener = NewProviderListener(ctx, rpcProviderEndpoint.NetworkAddress, rpcp.listenerConfig)
 					rpcp.rpcProviderListeners[rpcProviderEndpoint.NetworkAddress] = listener
 				}
 			}()
 			if listener == nil {
-				utils.LavaFormatFatal("listener not defined, cant register RPCProviderServer", nil, utils.Attribute{Key: "RPCProviderEndpoint", Value: rpcProviderEndpoint.String()})
+				disabledEndpoints <- rpcProviderEndpoint
+				return utils.LavaFormatError("panic severity critical error, listener not defined, cant register RPCProviderServer", nil, utils.Attribute{Key: "RPCProviderEndpoint", Value: rpcProviderEndpoint.String()})
+			}
+			err = listener.RegisterReceiver(rpcProviderServer, rpcProviderEndpoint)
+			if err != nil {
+				disabledEndpoints <- rpcProviderEndpoint
+				return err
+			}
+			providerStateTracker.RegisterForSpecUpdates(ctx, rpcProviderServer, chainID)
+			rpcp.lock.Lock()
+			rpcp.endpoints[rpcProviderEndpoint.Key()] = &providerEndpoint{
+				endpoint:         rpcProviderEndpoint,
+				nodeUrls:         rpcProviderEndpoint.NodeUrls,
+				averageBlockTime: averageBlockTime,
+				chainProxy:       chainProxy,
+				server:           rpcProviderServer,
 			}
-			listener.RegisterReceiver(rpcProviderServer, rpcProviderEndpoint)
+			rpcp.lock.Unlock()
 			utils.LavaFormatDebug("provider finished setting up endpoint", utils.Attribute{Key: "endpoint", Value: rpcProviderEndpoint.Key()})
 			return nil
 		}(rpcProviderEndpoint) // continue on error
@@ -222,12 +309,24 @@ func (rpcp *RPCProvider) Start(ctx context.Context, txFactory tx.Factory, client
 			utils.LavaFormatFatal("all endpoints are disabled", nil)
 		}
 	}
+	if endpointAttester != nil {
+		rpcp.providerStateTracker.RegisterForEpochUpdates(ctx, endpointAttester)
+	}
 	// tearing down
-	select {
-	case <-ctx.Done():
-		utils.LavaFormatInfo("Provider Server ctx.Done")
-	case <-signalChan:
-		utils.LavaFormatInfo("Provider Server signalChan")
+serving:
+	for {
+		select {
+		case <-ctx.Done():
+			utils.LavaFormatInfo("Provider Server ctx.Done")
+			break serving
+		case sig := <-signalChan:
+			if sig == syscall.SIGHUP {
+				rpcp.reload(ctx)
+				continue
+			}
+			utils.LavaFormatInfo("Provider Server signalChan")
+			break serving
+		}
 	}
 
 	for _, listener := range rpcp.rpcProviderListeners {
@@ -235,14 +334,105 @@ func (rpcp *RPCProvider) Start(ctx context.Context, txFactory tx.Factory, client
 		listener.Shutdown(shutdownCtx)
 		defer shutdownRelease()
 	}
+	rpcp.exportProofs(rewardServer)
+
+	return nil
+}
+
+// exportProofs writes the proofs that weren't claimed yet, so another machine running the provider can claim them
+func (rpcp *RPCProvider) exportProofs(rewardServer *rewardserver.RewardServer) {
+	if rpcp.proofsExportPath == "" {
+		return
+	}
+	records := rewardServer.UnclaimedProofs()
+	err := rewardserver.WriteProofsFile(rpcp.proofsExportPath, records)
+	if err != nil {
+		utils.LavaFormatError("failed exporting unclaimed relay proofs", err, utils.Attribute{Key: "path", Value: rpcp.proofsExportPath})
+		return
+	}
+	utils.LavaFormatInfo("exported unclaimed relay proofs", utils.Attribute{Key: "proofs", Value: len(records)}, utils.Attribute{Key: "path", Value: rpcp.proofsExportPath})
+}
 
+func (rpcp *RPCProvider) reload(ctx context.Context) {
+	if rpcp.reloadEndpoints == nil {
+		utils.LavaFormatWarning("no config file to reload, endpoints were set in the command arguments", nil)
+		return
+	}
+	rpcProviderEndpoints, err := rpcp.reloadEndpoints()
+	if err != nil {
+		utils.LavaFormatError("failed reloading the config, keeping the running endpoints", err)
+		return
+	}
+	rpcp.applyEndpoints(ctx, rpcProviderEndpoints)
+}
+
+// applyEndpoints switches serving endpoints to their reloaded node urls and available blocks. the listeners and sessions
+// stay as they are, so endpoints added, removed or moved to another network address only take effect on a restart
+func (rpcp *RPCProvider) applyEndpoints(ctx context.Context, rpcProviderEndpoints []*lavasession.RPCProviderEndpoint) {
+	rpcp.lock.Lock()
+	defer rpcp.lock.Unlock()
+	fillSharedNetworkAddresses(rpcProviderEndpoints)
+	reloaded := map[string]struct{}{}
+	for _, rpcProviderEndpoint := range rpcProviderEndpoints {
+		key := rpcProviderEndpoint.Key()
+		reloaded[key] = struct{}{}
+		existing, ok := rpcp.endpoints[key]
+		if !ok {
+			utils.LavaFormatWarning("new endpoint in the reloaded config, a restart is required to serve it", nil, utils.Attribute{Key: "endpoint", Value: rpcProviderEndpoint.String()})
+			continue
+		}
+		if rpcProviderEndpoint.NetworkAddress != existing.endpoint.NetworkAddress {
+			utils.LavaFormatWarning("endpoint network address changed, a restart is required to apply it", nil, utils.Attribute{Key: "endpoint", Value: key}, utils.Attribute{Key: "networkAddress", Value: rpcProviderEndpoint.NetworkAddress})
+		}
+		if !reflect.DeepEqual(rpcProviderEndpoint.NodeUrls, existing.nodeUrls) {
+			err := rpcp.reloadNodeUrls(ctx, existing, rpcProviderEndpoint)
+			if err != nil {
+				utils.LavaFormatError("failed reloading node urls, keeping the running nodes", err, utils.Attribute{Key: "endpoint", Value: rpcProviderEndpoint.String()})
+			}
+		}
+		if rpcProviderEndpoint.AvailableBlocks != existing.endpoint.AvailableBlocks {
+			existing.server.SetAvailableBlocks(rpcProviderEndpoint.AvailableBlocks)
+			utils.LavaFormatInfo("reloaded endpoint available blocks", utils.Attribute{Key: "endpoint", Value: key}, utils.Attribute{Key: "availableBlocks", Value: rpcProviderEndpoint.AvailableBlocks})
+		}
+	}
+	for key := range rpcp.endpoints {
+		if _, ok := reloaded[key]; !ok {
+			utils.LavaFormatWarning("endpoint missing from the reloaded config, a restart is required to stop serving it", nil, utils.Attribute{Key: "endpoint", Value: key})
+		}
+	}
+}
+
+// reloadNodeUrls connects to the reloaded node urls before switching the endpoint to them, so a failed connection keeps the running nodes
+func (rpcp *RPCProvider) reloadNodeUrls(ctx context.Context, existing *providerEndpoint, rpcProviderEndpoint *lavasession.RPCProviderEndpoint) error {
+	err := rpcProviderEndpoint.Validate()
+	if err != nil {
+		return err
+	}
+	nodeCtx, nodeCancel := context.WithCancel(ctx)
+	nodeChainProxy, err := chainlib.GetChainProxy(nodeCtx, rpcp.parallelConnections, rpcProviderEndpoint, existing.averageBlockTime)
+	if err != nil {
+		nodeCancel()
+		return err
+	}
+	existing.chainProxy.swap(nodeChainProxy, nodeCancel)
+	existing.nodeUrls = rpcProviderEndpoint.NodeUrls
+	utils.LavaFormatInfo("reloaded endpoint node urls", utils.Attribute{Key: "endpoint", Value: existing.endpoint.Key()}, utils.Attribute{Key: "nodeUrls", Value: rpcProviderEndpoint.UrlsString()})
 	return nil
 }
 
+// fillSharedNetworkAddresses handles undefined addresses as the previous endpoint for shared listeners
+func fillSharedNetworkAddresses(rpcProviderEndpoints []*lavasession.RPCProviderEndpoint) {
+	for idx, endpoint := range rpcProviderEndpoints {
+		if idx > 0 && endpoint.NetworkAddress == "" {
+			endpoint.NetworkAddress = rpcProviderEndpoints[idx-1].NetworkAddress
+		}
+	}
+}
+
 func ParseEndpoints(viper_endpoints *viper.Viper, geolocation uint64) (endpoints []*lavasession.RPCProviderEndpoint, err error) {
 	err = viper_endpoints.UnmarshalKey(common.EndpointsConfigName, &endpoints)
 	if err != nil {
-		utils.LavaFormatFatal("could not unmarshal endpoints", err, utils.Attribute{Key: "viper_endpoints", Value: viper_endpoints.AllSettings()})
+		return nil, utils.LavaFormatError("could not unmarshal endpoints", err, utils.Attribute{Key: "viper_endpoints", Value: viper_endpoints.AllSettings()})
 	}
 	for _, endpoint := range endpoints {
 		endpoint.Geolocation = geolocation
@@ -258,6 +448,7 @@ func CreateRPCProviderCobraCommand() *cobra.Command {
 		all configs should be located in` + app.DefaultNodeHome + "/config or the local running directory" + ` 
 		if no arguments are passed, assumes default config file: ` + DefaultRPCProviderFileName + `
 		if one argument is passed, its assumed the config file name
+		sending SIGHUP reloads the node urls and available blocks of the endpoints from the config file
 		`,
 		Example: `required flags: --geolocation 1 --from alice
 optional: --save-conf
@@ -332,6 +523,14 @@ rpcprovider 127.0.0.1:3333 COS3 tendermintrpc "wss://www.node-path.com:80,https:
 			if err != nil {
 				return err
 			}
+			lavaNodes, err := cmd.Flags().GetStringSlice(statetracker.LavaNodesFlagName)
+			if err != nil {
+				utils.LavaFormatFatal("failed to read lava nodes flag", err)
+			}
+			clientCtx, err = statetracker.WithLavaNodes(ctx, clientCtx, lavaNodes)
+			if err != nil {
+				return err
+			}
 			clientCtx = clientCtx.WithChainID(networkChainId)
 			txFactory := tx.NewFactoryCLI(clientCtx, cmd.Flags())
 			logLevel, err := cmd.Flags().GetString(flags.FlagLogLevel)
@@ -339,6 +538,7 @@ rpcprovider 127.0.0.1:3333 COS3 tendermintrpc "wss://www.node-path.com:80,https:
 				utils.LavaFormatFatal("failed to read log level flag", err)
 			}
 			utils.LoggingLevel(logLevel)
+			common.ApplyLogConfig(viper.GetViper(), logLevel)
 
 			// check if the command includes --pprof-address
 			pprofAddressFlagUsed := cmd.Flags().Lookup("pprof-address").Changed
@@ -369,16 +569,158 @@ rpcprovider 127.0.0.1:3333 COS3 tendermintrpc "wss://www.node-path.com:80,https:
 				} else {
 					utils.LavaFormatInfo("cache service connected", utils.Attribute{Key: "address", Value: cacheAddr})
 				}
+			} else if redisAddr, err := cmd.Flags().GetString(performance.CacheRedisFlagName); err == nil && redisAddr != "" {
+				cache, err = performance.InitRedisCache(redisAddr)
+				if err != nil {
+					utils.LavaFormatError("Failed To Connect to redis cache", err, utils.Attribute{Key: "address", Value: redisAddr})
+				} else {
+					utils.LavaFormatInfo("redis cache connected", utils.Attribute{Key: "address", Value: redisAddr})
+				}
+			} else if useLocalCache, err := cmd.Flags().GetBool(performance.CacheLocalFlagName); err == nil && useLocalCache {
+				cache, err = performance.InitLocalCache()
+				if err != nil {
+					utils.LavaFormatError("Failed To create in-process cache", err)
+				} else {
+					utils.LavaFormatInfo("using in-process cache", utils.Attribute{Key: "maxCost", Value: performance.LocalCacheMaxCost})
+				}
 			}
+			if cacheAdminAddr, err := cmd.Flags().GetString(performance.CacheAdminListenFlagName); err == nil && cacheAdminAddr != "" && cache != nil {
+				err = performance.ServeCacheAdmin(ctx, cacheAdminAddr, cache)
+				if err != nil {
+					utils.LavaFormatError("Failed To serve cache admin endpoint", err, utils.Attribute{Key: "address", Value: cacheAdminAddr})
+				}
+			}
+			tracingEndpoint, err := cmd.Flags().GetString(tracing.OTLPEndpointFlagName)
+			if err != nil {
+				utils.LavaFormatFatal("failed to read tracing endpoint flag", err)
+			}
+			tracingSampleRatio, err := cmd.Flags().GetFloat64(tracing.SampleRatioFlagName)
+			if err != nil {
+				utils.LavaFormatFatal("failed to read tracing sample ratio flag", err)
+			}
+			stopTracing := tracing.StartOTLPTracer(tracingEndpoint, "lava-rpcprovider", tracingSampleRatio)
+			defer stopTracing()
 			numberOfNodeParallelConnections, err := cmd.Flags().GetUint(chainproxy.ParallelConnectionsFlag)
 			if err != nil {
 				utils.LavaFormatFatal("error fetching chainproxy.ParallelConnectionsFlag", err)
 			}
+			relayThrottlerConfig := lavasession.ProviderRelayThrottlerConfig{}
+			relayThrottlerConfig.RelaysPerSecond, err = cmd.Flags().GetUint64(lavasession.RelaysPerSecondLimitFlag)
+			if err != nil {
+				utils.LavaFormatFatal("failed to read relays per second limit flag", err)
+			}
+			relayThrottlerConfig.ComputeUnitsPerEpoch, err = cmd.Flags().GetUint64(lavasession.CUPerEpochLimitFlag)
+			if err != nil {
+				utils.LavaFormatFatal("failed to read cu per epoch limit flag", err)
+			}
+			var sessionStore lavasession.ProviderSessionStore
+			sessionStorePath, err := cmd.Flags().GetString(lavasession.ProviderSessionStoreFlag)
+			if err != nil {
+				utils.LavaFormatFatal("failed to read session store path flag", err)
+			}
+			if sessionStorePath != "" {
+				boltSessionStore, err := lavasession.NewBoltProviderSessionStore(sessionStorePath)
+				if err != nil {
+					return err
+				}
+				defer boltSessionStore.Close()
+				sessionStore = boltSessionStore
+				utils.LavaFormatInfo("persisting provider sessions", utils.Attribute{Key: "path", Value: sessionStorePath})
+			}
+			claimConfig := rewardserver.RewardClaimConfig{}
+			claimConfig.MaxProofsPerTx, err = cmd.Flags().GetInt(rewardserver.RewardClaimMaxProofsFlag)
+			if err != nil {
+				utils.LavaFormatFatal("failed to read reward claim max proofs flag", err)
+			}
+			claimConfig.MinCUPerClaim, err = cmd.Flags().GetUint64(rewardserver.RewardClaimMinCUFlag)
+			if err != nil {
+				utils.LavaFormatFatal("failed to read reward claim min cu flag", err)
+			}
+			claimConfig.BlocksBeforeExpiry, err = cmd.Flags().GetUint64(rewardserver.RewardClaimBlocksBeforeExpiryFlag)
+			if err != nil {
+				utils.LavaFormatFatal("failed to read reward claim blocks before expiry flag", err)
+			}
+			nodeHealthConfig := NodeHealthConfig{}
+			nodeHealthConfig.Enabled, err = cmd.Flags().GetBool(NodeHealthCheckFlag)
+			if err != nil {
+				utils.LavaFormatFatal("failed to read node health check flag", err)
+			}
+			nodeHealthConfig.FreezeOnChain, err = cmd.Flags().GetBool(NodeHealthFreezeFlag)
+			if err != nil {
+				utils.LavaFormatFatal("failed to read node health freeze flag", err)
+			}
+			nodeHealthConfig.MaxBlockLag, err = cmd.Flags().GetUint64(NodeHealthMaxBlockLagFlag)
+			if err != nil {
+				utils.LavaFormatFatal("failed to read node health max block lag flag", err)
+			}
+			nodeHealthConfig.MaxErrorRate, err = cmd.Flags().GetFloat64(NodeHealthMaxErrorRateFlag)
+			if err != nil {
+				utils.LavaFormatFatal("failed to read node health max error rate flag", err)
+			}
+			auditLogConfig := auditlog.Config{}
+			auditLogConfig.Path, err = cmd.Flags().GetString(auditlog.RelayAuditLogFlag)
+			if err != nil {
+				utils.LavaFormatFatal("failed to read relay audit log flag", err)
+			}
+			auditLogConfig.MaxSizeMB, err = cmd.Flags().GetInt64(auditlog.RelayAuditLogMaxSizeFlag)
+			if err != nil {
+				utils.LavaFormatFatal("failed to read relay audit log max size flag", err)
+			}
+			auditLogConfig.MaxBackups, err = cmd.Flags().GetInt(auditlog.RelayAuditLogMaxBackupsFlag)
+			if err != nil {
+				utils.LavaFormatFatal("failed to read relay audit log max backups flag", err)
+			}
+			redaction, err := cmd.Flags().GetString(auditlog.RelayAuditLogRedactionFlag)
+			if err != nil {
+				utils.LavaFormatFatal("failed to read relay audit log redaction flag", err)
+			}
+			auditLogConfig.Redaction, err = auditlog.ParseRedactionMode(redaction)
+			if err != nil {
+				utils.LavaFormatFatal("invalid relay audit log redaction", err)
+			}
+			attestEndpointsEpochs, err := cmd.Flags().GetUint64(AttestEndpointsEpochsFlag)
+			if err != nil {
+				utils.LavaFormatFatal("failed to read attest endpoints epochs flag", err)
+			}
 			for _, endpoint := range rpcProviderEndpoints {
 				utils.LavaFormatDebug("endpoint description", utils.Attribute{Key: "endpoint", Value: endpoint})
 			}
+			listenerConfig := ProviderListenerConfig{}
+			listenerConfig.GrpcWeb, err = cmd.Flags().GetBool(GrpcWebFlag)
+			if err != nil {
+				utils.LavaFormatFatal("failed to read grpc web flag", err)
+			}
+			listenerConfig.RestGateway, err = cmd.Flags().GetBool(RestGatewayFlag)
+			if err != nil {
+				utils.LavaFormatFatal("failed to read rest gateway flag", err)
+			}
+			listenerConfig.TLSCertFile, err = cmd.Flags().GetString(TLSCertFileFlag)
+			if err != nil {
+				utils.LavaFormatFatal("failed to read tls cert file flag", err)
+			}
+			listenerConfig.TLSKeyFile, err = cmd.Flags().GetString(TLSKeyFileFlag)
+			if err != nil {
+				utils.LavaFormatFatal("failed to read tls key file flag", err)
+			}
+			if (listenerConfig.TLSCertFile == "") != (listenerConfig.TLSKeyFile == "") {
+				utils.LavaFormatFatal("both a tls cert file and a tls key file are required", nil, utils.Attribute{Key: "cert", Value: listenerConfig.TLSCertFile}, utils.Attribute{Key: "key", Value: listenerConfig.TLSKeyFile})
+			}
 			rpcProvider := RPCProvider{}
-			err = rpcProvider.Start(ctx, txFactory, clientCtx, rpcProviderEndpoints, cache, numberOfNodeParallelConnections)
+			rpcProvider.proofsExportPath, err = cmd.Flags().GetString(rewardserver.ProofsExportPathFlag)
+			if err != nil {
+				utils.LavaFormatFatal("failed to read proofs export path flag", err)
+			}
+			if len(args) <= 1 {
+				rpcProvider.reloadEndpoints = func() ([]*lavasession.RPCProviderEndpoint, error) {
+					err := viper.ReadInConfig()
+					if err != nil {
+						return nil, err
+					}
+					common.ApplyLogConfig(viper.GetViper(), logLevel)
+					return ParseEndpoints(viper.GetViper(), geolocation)
+				}
+			}
+			err = rpcProvider.Start(ctx, txFactory, clientCtx, rpcProviderEndpoints, cache, numberOfNodeParallelConnections, relayThrottlerConfig, sessionStore, claimConfig, nodeHealthConfig, auditLogConfig, attestEndpointsEpochs, listenerConfig)
 			return err
 		},
 	}
@@ -388,12 +730,39 @@ rpcprovider 127.0.0.1:3333 COS3 tendermintrpc "wss://www.node-path.com:80,https:
 	cmdRPCProvider.MarkFlagRequired(flags.FlagFrom)
 	cmdRPCProvider.Flags().Bool(common.SaveConfigFlagName, false, "save cmd args to a config file")
 	cmdRPCProvider.Flags().String(flags.FlagChainID, app.Name, "network chain id")
+	cmdRPCProvider.Flags().StringSlice(statetracker.LavaNodesFlagName, []string{}, "comma separated uris of lava nodes to fail over to and spread state queries between, in addition to --node")
 	cmdRPCProvider.Flags().Uint64(common.GeolocationFlag, 0, "geolocation to run from")
 	cmdRPCProvider.MarkFlagRequired(common.GeolocationFlag)
 	cmdRPCProvider.Flags().String(performance.PprofAddressFlagName, "", "pprof server address, used for code profiling")
 	cmdRPCProvider.Flags().String(performance.CacheFlagName, "", "address for a cache server to improve performance")
+	cmdRPCProvider.Flags().String(performance.CacheRedisFlagName, "", "comma separated redis addresses to share the cache between processes, two or more addresses connect to a redis cluster")
+	cmdRPCProvider.Flags().Bool(performance.CacheLocalFlagName, false, "use an in-process cache when no cache server address is set")
+	cmdRPCProvider.Flags().String(performance.CacheAdminListenFlagName, "", "address to serve the cache admin grpc endpoints on: stats, flush by chain and hot keys")
+	cmdRPCProvider.Flags().Uint64(lavasession.RelaysPerSecondLimitFlag, 0, "relays per second allowed for each consumer before the relay reaches the node, 0 for no limit")
+	cmdRPCProvider.Flags().Uint64(lavasession.CUPerEpochLimitFlag, 0, "compute units allowed for each consumer in an epoch before the relay reaches the node, 0 for no limit")
+	cmdRPCProvider.Flags().String(lavasession.ProviderSessionStoreFlag, "", "path of a file to persist provider sessions and relay proofs in, so relay numbers and unclaimed proofs survive a restart")
+	cmdRPCProvider.Flags().Int(rewardserver.RewardClaimMaxProofsFlag, 0, "maximum relay proofs in a single payment tx, 0 sends all claimable proofs together")
+	cmdRPCProvider.Flags().Uint64(rewardserver.RewardClaimMinCUFlag, 0, "claimable proofs wait until they add up to this cu before a payment tx is sent")
+	cmdRPCProvider.Flags().Uint64(rewardserver.RewardClaimBlocksBeforeExpiryFlag, 0, "claim pending proofs regardless of the minimum cu once they are this many blocks from leaving the chain's memory")
+	cmdRPCProvider.Flags().Bool(NodeHealthCheckFlag, false, "reject relays while the node's latest block lags or its error rate is too high")
+	cmdRPCProvider.Flags().Bool(NodeHealthFreezeFlag, false, "freeze the provider on chain while its node is unhealthy and unfreeze once it recovers, requires "+NodeHealthCheckFlag)
+	cmdRPCProvider.Flags().Uint64(NodeHealthMaxBlockLagFlag, DefaultNodeHealthMaxBlockLag, "average block times without a new latest block before the node is unhealthy, 0 disables the check")
+	cmdRPCProvider.Flags().Float64(NodeHealthMaxErrorRateFlag, DefaultNodeHealthMaxErrorRate, "fraction of failed node responses before the node is unhealthy, 0 disables the check")
+	cmdRPCProvider.Flags().String(rewardserver.ProofsExportPathFlag, "", "path of a file to write the unclaimed relay proofs to on shutdown, import them on another machine with provider-proofs import")
+	cmdRPCProvider.Flags().Bool(GrpcWebFlag, true, "serve grpc-web on the provider listeners, for consumers in browsers")
+	cmdRPCProvider.Flags().String(TLSCertFileFlag, "", "with --"+TLSKeyFileFlag+", serve consumers over tls with this certificate, consumers verifying identities require the URI SAN "+lavasession.ProviderIdentityURIPrefix+"<provider address>")
+	cmdRPCProvider.Flags().String(TLSKeyFileFlag, "", "the key of the tls certificate of the provider listeners")
+	cmdRPCProvider.Flags().Bool(RestGatewayFlag, false, "serve relay and probe as json posts on "+RelayRestPath+" and "+ProbeRestPath+", for consumers that can't use grpc")
+	cmdRPCProvider.Flags().Uint64(AttestEndpointsEpochsFlag, 0, "attest every this many epochs on chain that the endpoints of the chains with a healthy node are live, so the provider isn't left out of pairing as stale, 0 never attests")
+	cmdRPCProvider.Flags().String(auditlog.RelayAuditLogFlag, "", "path of a json lines file to record the metadata of every relay in, for resolving disputes with consumers")
+	cmdRPCProvider.Flags().Int64(auditlog.RelayAuditLogMaxSizeFlag, auditlog.DefaultMaxSizeMB, "size in megabytes the relay audit log is rotated at, 0 never rotates")
+	cmdRPCProvider.Flags().Int(auditlog.RelayAuditLogMaxBackupsFlag, auditlog.DefaultMaxBackups, "rotated relay audit log files to keep, 0 keeps all of them")
+	cmdRPCProvider.Flags().String(auditlog.RelayAuditLogRedactionFlag, string(auditlog.RedactHash), "what the relay audit log keeps of request payloads: none keeps them as is, hash keeps their sha256, drop keeps nothing")
+	cmdRPCProvider.Flags().String(tracing.OTLPEndpointFlagName, "", "OpenTelemetry collector OTLP/HTTP endpoint to export relay traces to, such as http://localhost:4318, continuing the traces of consumers")
+	cmdRPCProvider.Flags().Float64(tracing.SampleRatioFlagName, 1, "fraction of the relays without a consumer trace to trace, between 0 and 1")
 	cmdRPCProvider.Flags().Uint(chainproxy.ParallelConnectionsFlag, chainproxy.NumberOfParallelConnections, "parallel connections")
 	cmdRPCProvider.Flags().String(flags.FlagLogLevel, "debug", "log level")
 
+	cmdRPCProvider.AddCommand(CreateValidateRPCProviderCobraCommand())
 	return cmdRPCProvider
 }
@@ -5,6 +5,8 @@ import (
 	"context"
 	"encoding/json"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/btcsuite/btcd/btcec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -12,12 +14,13 @@ import (
 	"github.com/gogo/status"
 	"github.com/lavanet/lava/protocol/chainlib"
 	"github.com/lavanet/lava/protocol/chainlib/chainproxy/rpcInterfaceMessages"
-	"github.com/lavanet/lava/protocol/chainlib/chainproxy/rpcclient"
 	"github.com/lavanet/lava/protocol/chaintracker"
 	"github.com/lavanet/lava/protocol/common"
 	"github.com/lavanet/lava/protocol/lavaprotocol"
 	"github.com/lavanet/lava/protocol/lavasession"
 	"github.com/lavanet/lava/protocol/performance"
+	"github.com/lavanet/lava/protocol/rpcprovider/auditlog"
+	"github.com/lavanet/lava/protocol/tracing"
 	"github.com/lavanet/lava/utils"
 	"github.com/lavanet/lava/utils/sigs"
 	pairingtypes "github.com/lavanet/lava/x/pairing/types"
@@ -27,7 +30,7 @@ import (
 
 type RPCProviderServer struct {
 	cache                     *performance.Cache
-	chainProxy                chainlib.ChainProxy
+	chainProxy                *sharingChainProxy
 	privKey                   *btcec.PrivateKey
 	reliabilityManager        ReliabilityManagerInf
 	providerSessionManager    *lavasession.ProviderSessionManager
@@ -38,6 +41,12 @@ type RPCProviderServer struct {
 	providerAddress           sdk.AccAddress
 	lavaChainID               string
 	allowedMissingCUThreshold float64
+	relayThrottler            *lavasession.ProviderRelayThrottler
+	nodeHealthMonitor         *NodeHealthMonitor
+	specReloadLock            sync.RWMutex // held for reading by relays in flight, so a spec reload waits for them to drain
+	relaySignerCache          *RelaySignerCache
+	relayAuditLog             *auditlog.RelayAuditLog
+	badgeCuTracker            *badgeCuTracker
 }
 
 type ReliabilityManagerInf interface {
@@ -71,9 +80,12 @@ func (rpcps *RPCProviderServer) ServeRPCRequests(
 	providerAddress sdk.AccAddress,
 	lavaChainID string,
 	allowedMissingCUThreshold float64,
+	relayThrottler *lavasession.ProviderRelayThrottler,
+	nodeHealthMonitor *NodeHealthMonitor,
+	relayAuditLog *auditlog.RelayAuditLog,
 ) {
 	rpcps.cache = cache
-	rpcps.chainProxy = chainProxy
+	rpcps.chainProxy = newSharingChainProxy(chainProxy)
 	rpcps.privKey = privKey
 	rpcps.providerSessionManager = providerSessionManager
 	rpcps.reliabilityManager = reliabilityManager
@@ -84,10 +96,55 @@ func (rpcps *RPCProviderServer) ServeRPCRequests(
 	rpcps.providerAddress = providerAddress
 	rpcps.lavaChainID = lavaChainID
 	rpcps.allowedMissingCUThreshold = allowedMissingCUThreshold
+	rpcps.relayThrottler = relayThrottler
+	rpcps.nodeHealthMonitor = nodeHealthMonitor
+	rpcps.relayAuditLog = relayAuditLog
+	relaySignerCache, err := NewRelaySignerCache()
+	if err != nil {
+		utils.LavaFormatError("failed creating relay signer cache, recovering the signer of every relay", err, utils.Attribute{Key: "endpoint", Value: rpcProviderEndpoint.Key()})
+	}
+	rpcps.relaySignerCache = relaySignerCache
+	rpcps.badgeCuTracker = newBadgeCuTracker()
+}
+
+// SetSpec reloads the chain parser when the spec changes on chain. relays already in flight finish against the old spec
+// before the reload, and relays arriving during the reload wait for the new one
+func (rpcps *RPCProviderServer) SetSpec(spec spectypes.Spec) {
+	rpcps.specReloadLock.Lock()
+	defer rpcps.specReloadLock.Unlock()
+	_, averageBlockTime, blocksToFinalization, blocksInFinalizationData := rpcps.chainParser.ChainBlockStats()
+	rpcps.chainParser.SetSpec(spec)
+	_, newAverageBlockTime, newBlocksToFinalization, newBlocksInFinalizationData := rpcps.chainParser.ChainBlockStats()
+	if averageBlockTime != newAverageBlockTime || blocksToFinalization != newBlocksToFinalization || blocksInFinalizationData != newBlocksInFinalizationData {
+		// the chain tracker and node connections were set up with the previous block stats
+		utils.LavaFormatWarning("spec block stats changed, a restart is required to apply them", nil,
+			utils.Attribute{Key: "endpoint", Value: rpcps.rpcProviderEndpoint.Key()},
+			utils.Attribute{Key: "averageBlockTime", Value: newAverageBlockTime},
+			utils.Attribute{Key: "blocksToFinalization", Value: newBlocksToFinalization},
+			utils.Attribute{Key: "blocksInFinalizationData", Value: newBlocksInFinalizationData},
+		)
+	}
+	utils.LavaFormatInfo("provider reloaded spec", utils.Attribute{Key: "endpoint", Value: rpcps.rpcProviderEndpoint.Key()}, utils.Attribute{Key: "blockLastUpdated", Value: spec.BlockLastUpdated})
+}
+
+// SetAvailableBlocks updates the blocks the node serves after a config reload, relays in flight finish with the previous value
+func (rpcps *RPCProviderServer) SetAvailableBlocks(availableBlocks uint64) {
+	rpcps.specReloadLock.Lock()
+	defer rpcps.specReloadLock.Unlock()
+	rpcps.rpcProviderEndpoint.AvailableBlocks = availableBlocks
+}
+
+// LatestBlock returns the latest block of the node as the reliability manager last saw it, it's reported to probing consumers
+func (rpcps *RPCProviderServer) LatestBlock() int64 {
+	if rpcps.reliabilityManager == nil {
+		return 0
+	}
+	return rpcps.reliabilityManager.GetLatestBlockNum()
 }
 
 // function used to handle relay requests from a consumer, it is called by a provider_listener by calling RegisterReceiver
 func (rpcps *RPCProviderServer) Relay(ctx context.Context, request *pairingtypes.RelayRequest) (*pairingtypes.RelayReply, error) {
+	startTime := time.Now()
 	if request.RelayData == nil || request.RelaySession == nil {
 		return nil, utils.LavaFormatError("invalid relay request, internal fields are nil", nil)
 	}
@@ -99,6 +156,11 @@ func (rpcps *RPCProviderServer) Relay(ctx context.Context, request *pairingtypes
 		utils.Attribute{Key: "request.cu", Value: request.RelaySession.CuSum},
 		utils.Attribute{Key: "relay_timeout", Value: common.GetRemainingTimeoutFromContext(ctx)},
 	)
+	rpcps.specReloadLock.RLock()
+	defer rpcps.specReloadLock.RUnlock()
+	if !rpcps.nodeHealthMonitor.IsHealthy() {
+		return nil, rpcps.handleRelayErrorStatus(utils.LavaFormatWarning("rejecting relay, node is unhealthy", lavasession.ProviderNodeUnhealthyError, utils.Attribute{Key: "GUID", Value: ctx}))
+	}
 
 	// Init relay
 	relaySession, consumerAddress, chainMessage, err := rpcps.initRelay(ctx, request)
@@ -106,6 +168,17 @@ func (rpcps *RPCProviderServer) Relay(ctx context.Context, request *pairingtypes
 		return nil, rpcps.handleRelayErrorStatus(err)
 	}
 
+	// reject consumers over the local rate and cu limits before the relay reaches the node
+	relayCU := chainMessage.GetServiceApi().ComputeUnits
+	err = rpcps.relayThrottler.TryAddRelay(consumerAddress.String(), uint64(request.RelaySession.Epoch), relayCU)
+	if err != nil {
+		relayFailureError := rpcps.providerSessionManager.OnSessionFailure(relaySession, request.RelaySession.RelayNum)
+		if relayFailureError != nil {
+			utils.LavaFormatError("OnSessionFailure failed after throttling relay", relayFailureError, utils.Attribute{Key: "GUID", Value: ctx})
+		}
+		return nil, rpcps.handleRelayErrorStatus(err)
+	}
+
 	// Try sending relay
 	reply, err := rpcps.TryRelay(ctx, request, consumerAddress, chainMessage)
 
@@ -169,10 +242,52 @@ func (rpcps *RPCProviderServer) Relay(ctx context.Context, request *pairingtypes
 		utils.Attribute{Key: "request.cu", Value: request.RelaySession.CuSum},
 		utils.Attribute{Key: "relay_timeout", Value: common.GetRemainingTimeoutFromContext(ctx)},
 	)
+	rpcps.recordRelayAudit(ctx, request, consumerAddress, chainMessage, reply, err, startTime)
+	if err == nil {
+		// lets the consumer tell a reply altered in transport from a wrong reply of this provider
+		if checksumErr := lavaprotocol.SetReplyChecksumTrailer(ctx, reply); checksumErr != nil {
+			utils.LavaFormatDebug("failed setting the reply checksum trailer", utils.Attribute{Key: "GUID", Value: ctx}, utils.Attribute{Key: "error", Value: checksumErr.Error()})
+		}
+	}
 	return reply, rpcps.handleRelayErrorStatus(err)
 }
 
+func (rpcps *RPCProviderServer) recordRelayAudit(ctx context.Context, request *pairingtypes.RelayRequest, consumerAddress sdk.AccAddress, chainMessage chainlib.ChainMessage, reply *pairingtypes.RelayReply, relayErr error, startTime time.Time) {
+	if rpcps.relayAuditLog == nil {
+		return
+	}
+	guid, _ := utils.GetUniqueIdentifier(ctx)
+	serviceApi := chainMessage.GetServiceApi()
+	entry := &auditlog.RelayAuditEntry{
+		Time:           startTime,
+		GUID:           guid,
+		Consumer:       consumerAddress.String(),
+		ChainID:        rpcps.rpcProviderEndpoint.ChainID,
+		ApiInterface:   rpcps.rpcProviderEndpoint.ApiInterface,
+		Api:            serviceApi.Name,
+		Epoch:          request.RelaySession.Epoch,
+		SessionID:      request.RelaySession.SessionId,
+		RelayNum:       request.RelaySession.RelayNum,
+		CU:             serviceApi.ComputeUnits,
+		RequestedBlock: request.RelayData.RequestBlock,
+		LatencyMs:      time.Since(startTime).Milliseconds(),
+		Request:        request.RelayData.ApiUrl + string(request.RelayData.Data),
+	}
+	if reply != nil {
+		entry.ResultSize = len(reply.Data)
+	}
+	if relayErr != nil {
+		entry.Error = relayErr.Error()
+	}
+	rpcps.relayAuditLog.Record(entry)
+}
+
 func (rpcps *RPCProviderServer) initRelay(ctx context.Context, request *pairingtypes.RelayRequest) (relaySession *lavasession.SingleProviderSession, consumerAddress sdk.AccAddress, chainMessage chainlib.ChainMessage, err error) {
+	// checked before the session is touched, a consumer on an unsupported version can't be served at all
+	err = lavasession.VerifyProtocolVersion(request.ProtocolVersion)
+	if err != nil {
+		return nil, nil, nil, utils.LavaFormatWarning("rejecting relay", err, utils.Attribute{Key: "GUID", Value: ctx})
+	}
 	relaySession, consumerAddress, err = rpcps.verifyRelaySession(ctx, request)
 	if err != nil {
 		return nil, nil, nil, err
@@ -182,6 +297,10 @@ func (rpcps *RPCProviderServer) initRelay(ctx context.Context, request *pairingt
 	if err != nil {
 		return nil, nil, nil, err
 	}
+	// payments are validated against the api the consumer signed, relaying another api could leave the relay unpaid
+	if lavasession.ProtocolVersionSupports(request.ProtocolVersion, lavasession.RelayApiNameFeature) && request.RelaySession.ApiName != chainMessage.GetServiceApi().Name {
+		return nil, nil, nil, utils.LavaFormatWarning("relay session signed for a different api", nil, utils.Attribute{Key: "signedApi", Value: request.RelaySession.ApiName}, utils.Attribute{Key: "api", Value: chainMessage.GetServiceApi().Name}, utils.Attribute{Key: "GUID", Value: ctx})
+	}
 	relayCU := chainMessage.GetServiceApi().ComputeUnits
 	err = relaySession.PrepareSessionForUsage(ctx, relayCU, request.RelaySession.CuSum, rpcps.allowedMissingCUThreshold)
 	if err != nil {
@@ -207,11 +326,17 @@ func (rpcps *RPCProviderServer) RelaySubscribe(request *pairingtypes.RelayReques
 		utils.Attribute{Key: "request.cu", Value: request.RelaySession.CuSum},
 		utils.Attribute{Key: "GUID", Value: ctx},
 	)
+	if !rpcps.nodeHealthMonitor.IsHealthy() {
+		return rpcps.handleRelayErrorStatus(utils.LavaFormatWarning("rejecting relay subscribe, node is unhealthy", lavasession.ProviderNodeUnhealthyError, utils.Attribute{Key: "GUID", Value: ctx}))
+	}
+	// subscriptions are long lived, so only parsing the request holds off a spec reload
+	rpcps.specReloadLock.RLock()
 	relaySession, consumerAddress, chainMessage, err := rpcps.initRelay(ctx, request)
+	rpcps.specReloadLock.RUnlock()
 	if err != nil {
 		return rpcps.handleRelayErrorStatus(err)
 	}
-	subscribed, err := rpcps.TryRelaySubscribe(ctx, uint64(request.RelaySession.Epoch), srv, chainMessage, consumerAddress, relaySession, request.RelaySession.RelayNum) // this function does not return until subscription ends
+	subscribed, err := rpcps.TryRelaySubscribe(ctx, uint64(request.RelaySession.Epoch), srv, request.RelayData, chainMessage, consumerAddress, relaySession, request.RelaySession.RelayNum) // this function does not return until subscription ends
 	if subscribed {
 		// meaning we created a subscription and used it for at least a message
 		pairingEpoch := relaySession.PairingEpoch
@@ -249,15 +374,14 @@ func (rpcps *RPCProviderServer) SendProof(ctx context.Context, epoch uint64, req
 	return nil
 }
 
-func (rpcps *RPCProviderServer) TryRelaySubscribe(ctx context.Context, requestBlockHeight uint64, srv pairingtypes.Relayer_RelaySubscribeServer, chainMessage chainlib.ChainMessage, consumerAddress sdk.AccAddress, relaySession *lavasession.SingleProviderSession, relayNumber uint64) (subscribed bool, errRet error) {
-	var reply *pairingtypes.RelayReply
-	var clientSub *rpcclient.ClientSubscription
-	var subscriptionID string
-	subscribeRepliesChan := make(chan interface{})
-	reply, subscriptionID, clientSub, err := rpcps.chainProxy.SendNodeMsg(ctx, subscribeRepliesChan, chainMessage)
+func (rpcps *RPCProviderServer) TryRelaySubscribe(ctx context.Context, requestBlockHeight uint64, srv pairingtypes.Relayer_RelaySubscribeServer, relayData *pairingtypes.RelayPrivateData, chainMessage chainlib.ChainMessage, consumerAddress sdk.AccAddress, relaySession *lavasession.SingleProviderSession, relayNumber uint64) (subscribed bool, errRet error) {
+	// consumers subscribing to the same events share the node subscription
+	reply, subscriptionID, clientSub, err := rpcps.chainProxy.Subscribe(ctx, consumerAddress.String(), relayData, chainMessage)
+	rpcps.nodeHealthMonitor.OnNodeResponse(err)
 	if err != nil {
 		return false, utils.LavaFormatError("Subscription failed", err, utils.Attribute{Key: "GUID", Value: ctx})
 	}
+	subscribeRepliesChan := clientSub.Replies()
 	subscription := &lavasession.RPCSubscription{
 		Id:                   subscriptionID,
 		Sub:                  clientSub,
@@ -265,6 +389,7 @@ func (rpcps *RPCProviderServer) TryRelaySubscribe(ctx context.Context, requestBl
 	}
 	err = rpcps.providerSessionManager.ReleaseSessionAndCreateSubscription(relaySession, subscription, consumerAddress.String(), requestBlockHeight, relayNumber)
 	if err != nil {
+		clientSub.Unsubscribe()
 		return false, err
 	}
 	rpcps.rewardServer.SubscribeStarted(consumerAddress.String(), requestBlockHeight, subscriptionID)
@@ -311,6 +436,7 @@ func (rpcps *RPCProviderServer) TryRelaySubscribe(ctx context.Context, requestBl
 		}
 	}
 	subscribed, errRet = processSubscribeMessages()
+	utils.LavaFormatDebug("subscription ended", utils.Attribute{Key: "GUID", Value: ctx}, utils.Attribute{Key: "subscriptionID", Value: subscriptionID}, utils.Attribute{Key: "messages", Value: clientSub.Delivered()})
 	rpcps.providerSessionManager.SubscriptionEnded(consumerAddress.String(), requestBlockHeight, subscriptionID)
 	rpcps.rewardServer.SubscribeEnded(consumerAddress.String(), requestBlockHeight, subscriptionID)
 	return subscribed, errRet
@@ -339,10 +465,16 @@ func (rpcps *RPCProviderServer) verifyRelaySession(ctx context.Context, request
 		return nil, nil, utils.LavaFormatError("did not pass relay validation", err, utils.Attribute{Key: "GUID", Value: ctx})
 	}
 	// check signature
-	extractedConsumerAddress, err = sigs.ExtractSignerAddress(request.RelaySession)
+	extractedConsumerAddress, err = rpcps.relaySignerCache.ExtractSignerAddress(request.RelaySession)
 	if err != nil {
 		return nil, nil, utils.LavaFormatError("extract signer address from relay", err, utils.Attribute{Key: "GUID", Value: ctx})
 	}
+	if request.RelaySession.Badge != nil {
+		extractedConsumerAddress, err = rpcps.verifyBadge(ctx, request, extractedConsumerAddress)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
 
 	// handle non data reliability relays
 	consumerAddressString := extractedConsumerAddress.String()
@@ -429,6 +561,23 @@ func (rpcps *RPCProviderServer) verifyRelaySession(ctx context.Context, request
 	return dataReliabilitySingleProviderSession, extractedConsumerAddress, nil
 }
 
+// verifyBadge returns the project that granted the badge of a relay, the relay is served as a relay of the project
+func (rpcps *RPCProviderServer) verifyBadge(ctx context.Context, request *pairingtypes.RelayRequest, badgeUser sdk.AccAddress) (sdk.AccAddress, error) {
+	if request.DataReliability != nil {
+		return nil, utils.LavaFormatError("data reliability relays can't be sent with a badge", nil, utils.Attribute{Key: "badgeUser", Value: badgeUser}, utils.Attribute{Key: "GUID", Value: ctx})
+	}
+	projectAddress, err := sigs.VerifyRelayBadge(*request.RelaySession, badgeUser)
+	if err != nil {
+		return nil, utils.LavaFormatError("invalid relay badge", err, utils.Attribute{Key: "badgeUser", Value: badgeUser}, utils.Attribute{Key: "GUID", Value: ctx})
+	}
+	relaySession := request.RelaySession
+	err = rpcps.badgeCuTracker.addRelay(uint64(relaySession.Epoch), badgeUser.String(), relaySession.SessionId, relaySession.CuSum, relaySession.Badge.CuAllocation, rpcps.providerSessionManager.GetBlockedEpochHeight())
+	if err != nil {
+		return nil, err
+	}
+	return projectAddress, nil
+}
+
 func (rpcps *RPCProviderServer) getSingleProviderSession(ctx context.Context, request *pairingtypes.RelaySession, consumerAddressString string) (*lavasession.SingleProviderSession, error) {
 	// regular session, verifies pairing epoch and relay number
 	singleProviderSession, err := rpcps.providerSessionManager.GetSession(ctx, consumerAddressString, uint64(request.Epoch), request.SessionId, request.RelayNum)
@@ -631,10 +780,26 @@ func (rpcps *RPCProviderServer) handleRelayErrorStatus(err error) error {
 	}
 	if lavasession.SessionOutOfSyncError.Is(err) {
 		err = status.Error(codes.Code(lavasession.SessionOutOfSyncError.ABCICode()), err.Error())
+	} else if lavasession.NodeResponseTooLargeError.Is(err) {
+		err = status.Error(codes.Code(lavasession.NodeResponseTooLargeError.ABCICode()), err.Error())
 	}
 	return err
 }
 
+// returns the message asking for the concrete block, or the message as is when its chain parser can't rewrite it
+func (rpcps *RPCProviderServer) substituteRequestedBlock(ctx context.Context, chainMsg chainlib.ChainMessage, block int64) chainlib.ChainMessage {
+	substituter, ok := rpcps.chainParser.(chainlib.RequestedBlockSubstituter)
+	if !ok {
+		return chainMsg
+	}
+	substituted, err := substituter.SubstituteRequestedBlock(chainMsg, block)
+	if err != nil {
+		utils.LavaFormatWarning("failed substituting the requested block of a data reliability relay", err, utils.Attribute{Key: "GUID", Value: ctx}, utils.Attribute{Key: "requestedBlock", Value: block})
+		return chainMsg
+	}
+	return substituted
+}
+
 func (rpcps *RPCProviderServer) TryRelay(ctx context.Context, request *pairingtypes.RelayRequest, consumerAddr sdk.AccAddress, chainMsg chainlib.ChainMessage) (*pairingtypes.RelayReply, error) {
 	// Send
 	var reqMsg *rpcInterfaceMessages.JsonrpcMessage
@@ -647,6 +812,7 @@ func (rpcps *RPCProviderServer) TryRelay(ctx context.Context, request *pairingty
 		reqMsg = nil
 	}
 	latestBlock := int64(0)
+	earliestBlock := int64(0)
 	finalizedBlockHashes := map[int64]interface{}{}
 	var requestedBlockHash []byte = nil
 	finalized := false
@@ -670,7 +836,14 @@ func (rpcps *RPCProviderServer) TryRelay(ctx context.Context, request *pairingty
 				return nil, utils.LavaFormatError("Could not guarantee data reliability", err, utils.Attribute{Key: "GUID", Value: ctx}, utils.Attribute{Key: "requestedBlock", Value: request.RelayData.RequestBlock}, utils.Attribute{Key: "latestBlock", Value: latestBlock}, utils.Attribute{Key: "fromBlock", Value: fromBlock}, utils.Attribute{Key: "toBlock", Value: toBlock})
 			}
 		}
-		request.RelayData.RequestBlock = lavaprotocol.ReplaceRequestedBlock(request.RelayData.RequestBlock, latestBlock)
+		if lavasession.ProtocolVersionSupports(request.ProtocolVersion, lavasession.EarliestBlockFeature) {
+			earliestBlock = rpcps.rpcProviderEndpoint.EarliestAvailableBlock(latestBlock)
+		}
+		request.RelayData.RequestBlock = lavaprotocol.ReplaceRequestedBlock(request.RelayData.RequestBlock, latestBlock, earliestBlock)
+		if request.DataReliability != nil && chainMsg.RequestedBlock() < 0 && request.RelayData.RequestBlock >= 0 {
+			// a reliability relay is compared with the block the first provider answered for, not with this provider's latest
+			chainMsg = rpcps.substituteRequestedBlock(ctx, chainMsg, request.RelayData.RequestBlock)
+		}
 		for _, block := range requestedHashes {
 			if block.Block == request.RelayData.RequestBlock {
 				requestedBlockHash = []byte(block.Hash)
@@ -698,19 +871,48 @@ func (rpcps *RPCProviderServer) TryRelay(ctx context.Context, request *pairingty
 	// TODO: handle cache on fork for dataReliability = false
 	var reply *pairingtypes.RelayReply = nil
 	var err error = nil
-	if requestedBlockHash != nil || finalized {
+	apiName := chainMsg.GetServiceApi().Name
+	// node subscriptions are shared between consumers, an unsubscribe only drops the consumer's share and isn't sent to the node,
+	// the node subscription is closed when its last consumer leaves
+	unsubscribe := reqMsg != nil && strings.Contains(apiName, "unsubscribe")
+	// replies of personal data apis belong to the consumer that asked
+	cacheable := (requestedBlockHash != nil || finalized) && !chainMsg.HasTag(spectypes.ApiTagPersonalData) && !unsubscribe
+	if cacheable {
 		reply, err = cache.GetEntry(ctx, request, rpcps.rpcProviderEndpoint.ApiInterface, requestedBlockHash, rpcps.rpcProviderEndpoint.ChainID, finalized)
 	}
-	if err != nil || reply == nil {
+	if unsubscribe {
+		err = rpcps.processUnsubscribe(ctx, apiName, consumerAddr, reqParams, uint64(request.RelayData.RequestBlock))
+		if err != nil {
+			return nil, err
+		}
+		reply, err = unsubscribeReply(reqMsg, apiName)
+		if err != nil {
+			return nil, err
+		}
+	} else if err != nil || reply == nil {
 		if err != nil && performance.NotConnectedError.Is(err) {
 			utils.LavaFormatWarning("cache not connected", err, utils.Attribute{Key: "GUID", Value: ctx})
 		}
 		// cache miss or invalid
-		reply, _, _, err = rpcps.chainProxy.SendNodeMsg(ctx, nil, chainMsg)
+		nodeCtx, nodeSpan := tracing.StartSpanWithKind(ctx, "rpcprovider.SendNodeMsg", tracing.SpanKindClient, utils.Attribute{Key: "api", Value: chainMsg.GetServiceApi().Name})
+		if finalized && cacheable && !chainMsg.HasTag(spectypes.ApiTagStateful) {
+			// consumers asking for the same finalized data together share a single node request
+			reply, err = rpcps.chainProxy.SendFinalizedNodeMsg(nodeCtx, request.RelayData, chainMsg)
+		} else {
+			reply, _, _, err = rpcps.chainProxy.SendNodeMsg(nodeCtx, nil, chainMsg)
+		}
+		nodeSpan.RecordError(err)
+		nodeSpan.End()
+		if lavasession.NodeResponseTooLargeError.Is(err) {
+			// the node answered, the relay only asked for more than the provider serves
+			rpcps.nodeHealthMonitor.OnNodeResponse(nil)
+		} else {
+			rpcps.nodeHealthMonitor.OnNodeResponse(err)
+		}
 		if err != nil {
 			return nil, utils.LavaFormatError("Sending chainMsg failed", err, utils.Attribute{Key: "GUID", Value: ctx})
 		}
-		if requestedBlockHash != nil || finalized {
+		if cacheable {
 			err := cache.SetEntry(ctx, request, rpcps.rpcProviderEndpoint.ApiInterface, requestedBlockHash, rpcps.rpcProviderEndpoint.ChainID, consumerAddr.String(), reply, finalized)
 			if err != nil && !performance.NotInitialisedError.Is(err) && request.RelaySession.Epoch != spectypes.NOT_APPLICABLE {
 				utils.LavaFormatWarning("error updating cache with new entry", err, utils.Attribute{Key: "GUID", Value: ctx})
@@ -718,13 +920,6 @@ func (rpcps *RPCProviderServer) TryRelay(ctx context.Context, request *pairingty
 		}
 	}
 
-	apiName := chainMsg.GetServiceApi().Name
-	if reqMsg != nil && strings.Contains(apiName, "unsubscribe") {
-		err := rpcps.processUnsubscribe(ctx, apiName, consumerAddr, reqParams, uint64(request.RelayData.RequestBlock))
-		if err != nil {
-			return nil, err
-		}
-	}
 	// TODO: verify that the consumer still listens, if it took to much time to get the response we cant update the CU.
 
 	jsonStr, err := json.Marshal(finalizedBlockHashes)
@@ -734,6 +929,7 @@ func (rpcps *RPCProviderServer) TryRelay(ctx context.Context, request *pairingty
 	}
 	reply.FinalizedBlocksHashes = jsonStr
 	reply.LatestBlock = latestBlock
+	reply.EarliestBlock = earliestBlock
 
 	reply, err = lavaprotocol.SignRelayResponse(consumerAddr, *request, rpcps.privKey, reply, dataReliabilityEnabled)
 	if err != nil {
@@ -744,6 +940,19 @@ func (rpcps *RPCProviderServer) TryRelay(ctx context.Context, request *pairingty
 	return reply, nil
 }
 
+// unsubscribeReply is the node's answer to a successful unsubscribe, tendermint answers an empty result and ethereum true
+func unsubscribeReply(reqMsg *rpcInterfaceMessages.JsonrpcMessage, apiName string) (*pairingtypes.RelayReply, error) {
+	result := json.RawMessage("true")
+	if apiName == "unsubscribe" || apiName == lavasession.TendermintUnsubscribeAll {
+		result = json.RawMessage("{}")
+	}
+	data, err := json.Marshal(rpcInterfaceMessages.JsonrpcMessage{Version: "2.0", ID: reqMsg.ID, Result: result})
+	if err != nil {
+		return nil, utils.LavaFormatError("failed marshaling unsubscribe reply", err)
+	}
+	return &pairingtypes.RelayReply{Data: data}, nil
+}
+
 func (rpcps *RPCProviderServer) processUnsubscribe(ctx context.Context, apiName string, consumerAddr sdk.AccAddress, reqParams interface{}, epoch uint64) error {
 	var subscriptionID string
 	switch reqParamsCasted := reqParams.(type) {
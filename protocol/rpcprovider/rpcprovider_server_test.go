@@ -0,0 +1,51 @@
+package rpcprovider
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lavanet/lava/protocol/chainlib"
+	"github.com/lavanet/lava/protocol/lavasession"
+	spectypes "github.com/lavanet/lava/x/spec/types"
+	"github.com/stretchr/testify/require"
+)
+
+type mockSpecChainParser struct {
+	chainlib.ChainParser
+	specs chan spectypes.Spec
+}
+
+func (m *mockSpecChainParser) SetSpec(spec spectypes.Spec) {
+	m.specs <- spec
+}
+
+func (m *mockSpecChainParser) ChainBlockStats() (int64, time.Duration, uint32, uint32) {
+	return 0, time.Second, 1, 1
+}
+
+func TestSetSpecDrainsRelaysInFlight(t *testing.T) {
+	chainParser := &mockSpecChainParser{specs: make(chan spectypes.Spec, 1)}
+	rpcps := &RPCProviderServer{chainParser: chainParser, rpcProviderEndpoint: &lavasession.RPCProviderEndpoint{ChainID: "LAV1"}}
+
+	// a relay in flight holds the reload until it's done
+	rpcps.specReloadLock.RLock()
+	reloaded := make(chan struct{})
+	go func() {
+		rpcps.SetSpec(spectypes.Spec{Index: "LAV1", BlockLastUpdated: 10})
+		close(reloaded)
+	}()
+	select {
+	case <-chainParser.specs:
+		require.Fail(t, "spec reloaded while a relay was in flight")
+	case <-time.After(50 * time.Millisecond):
+	}
+	rpcps.specReloadLock.RUnlock()
+
+	select {
+	case spec := <-chainParser.specs:
+		require.Equal(t, uint64(10), spec.BlockLastUpdated)
+	case <-time.After(time.Second):
+		require.Fail(t, "spec wasn't reloaded after the relay finished")
+	}
+	<-reloaded
+}
@@ -0,0 +1,162 @@
+package rpcprovider
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+
+	"github.com/lavanet/lava/protocol/chainlib"
+	"github.com/lavanet/lava/protocol/lavasession"
+	"github.com/lavanet/lava/utils"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+)
+
+// SharedSubscriptionBuffer is how many messages a consumer can fall behind a shared subscription before it's dropped from it
+const SharedSubscriptionBuffer = 100
+
+// sharedSubscription is a node subscription fanned out to the RelaySubscribe streams of all the consumers that sent the same request
+type sharedSubscription struct {
+	key            [sharedNodeReplyKeyLen]byte
+	reply          *pairingtypes.RelayReply
+	subscriptionID string
+	nodeSub        lavasession.NodeSubscription
+	nodeReplies    chan interface{}
+	consumers      map[*consumerSubscription]struct{} // guarded by the subscriptions lock of the chain proxy
+}
+
+// consumerSubscription is the share of a single consumer in a shared subscription
+type consumerSubscription struct {
+	scp             *sharingChainProxy
+	shared          *sharedSubscription
+	consumerAddress string
+	replies         chan interface{}
+	err             chan error
+	endOnce         sync.Once
+	delivered       uint64
+}
+
+func (cs *consumerSubscription) Err() <-chan error {
+	return cs.err
+}
+
+// Replies are the subscription messages for this consumer, the channel is never closed, Err is once the subscription ends
+func (cs *consumerSubscription) Replies() chan interface{} {
+	return cs.replies
+}
+
+func (cs *consumerSubscription) Unsubscribe() {
+	cs.end(nil)
+}
+
+// Delivered is the number of subscription messages this consumer got
+func (cs *consumerSubscription) Delivered() uint64 {
+	return atomic.LoadUint64(&cs.delivered)
+}
+
+// end removes the consumer from the shared subscription, the node subscription is closed with its last consumer
+func (cs *consumerSubscription) end(err error) {
+	cs.endOnce.Do(func() {
+		scp := cs.scp
+		scp.subscriptionsLock.Lock()
+		_, member := cs.shared.consumers[cs]
+		delete(cs.shared.consumers, cs)
+		last := member && len(cs.shared.consumers) == 0
+		if last && scp.subscriptions[cs.shared.key] == cs.shared {
+			delete(scp.subscriptions, cs.shared.key)
+		}
+		scp.subscriptionsLock.Unlock()
+		if err != nil {
+			cs.err <- err
+		}
+		close(cs.err)
+		if last {
+			cs.shared.nodeSub.Unsubscribe()
+		}
+	})
+}
+
+// must be called with the subscriptions lock held
+func (ss *sharedSubscription) join(scp *sharingChainProxy, consumerAddress string) *consumerSubscription {
+	cs := &consumerSubscription{scp: scp, shared: ss, consumerAddress: consumerAddress, replies: make(chan interface{}, SharedSubscriptionBuffer), err: make(chan error, 1)}
+	ss.consumers[cs] = struct{}{}
+	return cs
+}
+
+// must be called with the subscriptions lock held
+func (ss *sharedSubscription) hasConsumer(consumerAddress string) bool {
+	for cs := range ss.consumers {
+		if cs.consumerAddress == consumerAddress {
+			return true
+		}
+	}
+	return false
+}
+
+// Subscribe serves the subscription of a consumer from the node subscription of an identical request when there is one,
+// and opens a node subscription otherwise. a consumer subscribing twice to the same request gets a node subscription of its
+// own, since the subscriptions of a consumer are kept by their id
+func (scp *sharingChainProxy) Subscribe(ctx context.Context, consumerAddress string, relayData *pairingtypes.RelayPrivateData, chainMessage chainlib.ChainMessageForSend) (*pairingtypes.RelayReply, string, *consumerSubscription, error) {
+	key := sharedNodeReplyKey(relayData)
+	scp.subscriptionsLock.Lock()
+	if shared, ok := scp.subscriptions[key]; ok && !shared.hasConsumer(consumerAddress) {
+		consumerSub := shared.join(scp, consumerAddress)
+		scp.subscriptionsLock.Unlock()
+		return copyNodeReply(shared.reply), shared.subscriptionID, consumerSub, nil
+	}
+	scp.subscriptionsLock.Unlock()
+
+	nodeReplies := make(chan interface{})
+	reply, subscriptionID, nodeSub, err := scp.ChainProxy.SendNodeMsg(ctx, nodeReplies, chainMessage)
+	if err != nil {
+		return nil, "", nil, err
+	}
+	if nodeSub == nil {
+		return nil, "", nil, utils.LavaFormatError("node did not open a subscription", nil, utils.Attribute{Key: "GUID", Value: ctx}, utils.Attribute{Key: "api", Value: chainMessage.GetServiceApi().Name})
+	}
+	shared := &sharedSubscription{key: key, reply: reply, subscriptionID: subscriptionID, nodeSub: nodeSub, nodeReplies: nodeReplies, consumers: map[*consumerSubscription]struct{}{}}
+	scp.subscriptionsLock.Lock()
+	consumerSub := shared.join(scp, consumerAddress)
+	if _, ok := scp.subscriptions[key]; !ok {
+		scp.subscriptions[key] = shared
+	}
+	scp.subscriptionsLock.Unlock()
+	go scp.fanOut(shared)
+	return copyNodeReply(reply), subscriptionID, consumerSub, nil
+}
+
+// fanOut copies every message of the node subscription to its consumers until the node subscription ends. a consumer that
+// isn't reading its messages is dropped instead of holding back the others
+func (scp *sharingChainProxy) fanOut(shared *sharedSubscription) {
+	for {
+		select {
+		case err := <-shared.nodeSub.Err():
+			scp.subscriptionsLock.Lock()
+			if scp.subscriptions[shared.key] == shared {
+				delete(scp.subscriptions, shared.key)
+			}
+			consumers := shared.consumers
+			shared.consumers = map[*consumerSubscription]struct{}{}
+			scp.subscriptionsLock.Unlock()
+			for consumerSub := range consumers {
+				consumerSub.end(err)
+			}
+			return
+		case reply := <-shared.nodeReplies:
+			slowConsumers := []*consumerSubscription{}
+			scp.subscriptionsLock.Lock()
+			for consumerSub := range shared.consumers {
+				select {
+				case consumerSub.replies <- reply:
+					atomic.AddUint64(&consumerSub.delivered, 1)
+				default:
+					slowConsumers = append(slowConsumers, consumerSub)
+				}
+			}
+			scp.subscriptionsLock.Unlock()
+			for _, consumerSub := range slowConsumers {
+				utils.LavaFormatWarning("dropping a consumer behind on a shared subscription", lavasession.SubscriptionConsumerTooSlowError, utils.Attribute{Key: "consumer", Value: consumerSub.consumerAddress}, utils.Attribute{Key: "subscriptionID", Value: shared.subscriptionID})
+				consumerSub.end(lavasession.SubscriptionConsumerTooSlowError)
+			}
+		}
+	}
+}
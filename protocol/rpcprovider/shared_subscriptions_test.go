@@ -0,0 +1,84 @@
+package rpcprovider
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lavanet/lava/protocol/chainlib/chainproxy/rpcInterfaceMessages"
+	"github.com/lavanet/lava/protocol/lavasession"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	"github.com/stretchr/testify/require"
+)
+
+type mockNodeSubscription struct {
+	err          chan error
+	unsubscribed bool
+}
+
+func (m *mockNodeSubscription) Err() <-chan error {
+	return m.err
+}
+
+func (m *mockNodeSubscription) Unsubscribe() {
+	if !m.unsubscribed {
+		m.unsubscribed = true
+		close(m.err)
+	}
+}
+
+func TestSharedSubscriptionFanOut(t *testing.T) {
+	scp := newSharingChainProxy(&mockChainProxy{})
+	relayData := &pairingtypes.RelayPrivateData{Data: []byte(`{"method":"eth_subscribe","params":["newHeads"]}`)}
+	nodeSub := &mockNodeSubscription{err: make(chan error, 1)}
+	shared := &sharedSubscription{key: sharedNodeReplyKey(relayData), reply: &pairingtypes.RelayReply{Data: []byte("0x1")}, subscriptionID: "0x1", nodeSub: nodeSub, nodeReplies: make(chan interface{}), consumers: map[*consumerSubscription]struct{}{}}
+	scp.subscriptions[shared.key] = shared
+	first := shared.join(scp, "consumer1")
+	go scp.fanOut(shared)
+
+	// an identical request of another consumer joins the node subscription
+	reply, subscriptionID, second, err := scp.Subscribe(context.Background(), "consumer2", relayData, nil)
+	require.NoError(t, err)
+	require.Equal(t, "0x1", subscriptionID)
+	require.Equal(t, "0x1", string(reply.Data))
+
+	shared.nodeReplies <- "head1"
+	shared.nodeReplies <- "head2"
+	for _, consumerSub := range []*consumerSubscription{first, second} {
+		require.Equal(t, "head1", <-consumerSub.Replies())
+		require.Equal(t, "head2", <-consumerSub.Replies())
+		require.Equal(t, uint64(2), consumerSub.Delivered())
+	}
+
+	// a consumer that stops reading is dropped, the others keep their stream
+	for i := 0; i <= SharedSubscriptionBuffer; i++ {
+		shared.nodeReplies <- "head"
+		if i < SharedSubscriptionBuffer {
+			<-first.Replies()
+		}
+	}
+	select {
+	case err := <-second.Err():
+		require.ErrorIs(t, err, lavasession.SubscriptionConsumerTooSlowError)
+	case <-time.After(time.Second):
+		require.Fail(t, "slow consumer wasn't dropped")
+	}
+	require.False(t, nodeSub.unsubscribed)
+
+	// the node subscription closes with its last consumer
+	first.Unsubscribe()
+	require.True(t, nodeSub.unsubscribed)
+	scp.subscriptionsLock.Lock()
+	require.Empty(t, scp.subscriptions)
+	scp.subscriptionsLock.Unlock()
+}
+
+func TestUnsubscribeReply(t *testing.T) {
+	// the consumer's unsubscribe is answered by the provider, the shared node subscription stays open for the others
+	reply, err := unsubscribeReply(&rpcInterfaceMessages.JsonrpcMessage{ID: []byte("7"), Method: "eth_unsubscribe"}, "eth_unsubscribe")
+	require.NoError(t, err)
+	require.JSONEq(t, `{"jsonrpc":"2.0","id":7,"result":true}`, string(reply.Data))
+	reply, err = unsubscribeReply(&rpcInterfaceMessages.JsonrpcMessage{ID: []byte(`"a"`), Method: "unsubscribe"}, "unsubscribe")
+	require.NoError(t, err)
+	require.JSONEq(t, `{"jsonrpc":"2.0","id":"a","result":{}}`, string(reply.Data))
+}
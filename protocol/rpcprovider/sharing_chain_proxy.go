@@ -0,0 +1,135 @@
+package rpcprovider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"sync"
+	"time"
+
+	"github.com/lavanet/lava/protocol/chainlib"
+	"github.com/lavanet/lava/protocol/common"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+)
+
+const (
+	SharedNodeReplyTTL    = 500 * time.Millisecond // how long a node reply to finalized data answers identical requests
+	MaxSharedNodeReplies  = 10000                  // the shared replies are cleared when they don't fit
+	sharedNodeReplyKeyLen = sha256.Size
+)
+
+// sharingChainProxy sends identical requests for finalized data to the node once. requests arriving while one is in flight
+// wait for its reply and requests arriving up to SharedNodeReplyTTL after it reuse it, finalized data doesn't change so every
+// consumer still gets the reply the node would have given it. replies are copied for each request, they are signed per request.
+// identical subscriptions share a node subscription the same way, see Subscribe
+type sharingChainProxy struct {
+	chainlib.ChainProxy
+	lock              sync.Mutex
+	inFlight          map[[sharedNodeReplyKeyLen]byte]*nodeReplyFlight
+	replies           map[[sharedNodeReplyKeyLen]byte]sharedNodeReply
+	subscriptionsLock sync.Mutex
+	subscriptions     map[[sharedNodeReplyKeyLen]byte]*sharedSubscription
+	now               func() time.Time
+}
+
+type nodeReplyFlight struct {
+	done  chan struct{}
+	reply *pairingtypes.RelayReply
+	err   error
+}
+
+type sharedNodeReply struct {
+	reply   *pairingtypes.RelayReply
+	expires time.Time
+}
+
+func newSharingChainProxy(chainProxy chainlib.ChainProxy) *sharingChainProxy {
+	return &sharingChainProxy{
+		ChainProxy:    chainProxy,
+		inFlight:      map[[sharedNodeReplyKeyLen]byte]*nodeReplyFlight{},
+		replies:       map[[sharedNodeReplyKeyLen]byte]sharedNodeReply{},
+		subscriptions: map[[sharedNodeReplyKeyLen]byte]*sharedSubscription{},
+		now:           time.Now,
+	}
+}
+
+// sharedNodeReplyKey identifies the node request of a relay regardless of the consumer that sent it
+func sharedNodeReplyKey(relayData *pairingtypes.RelayPrivateData) [sharedNodeReplyKeyLen]byte {
+	hash := sha256.New()
+	for _, field := range []string{relayData.ApiInterface, relayData.ConnectionType, relayData.ApiUrl} {
+		binary.Write(hash, binary.LittleEndian, int64(len(field)))
+		hash.Write([]byte(field))
+	}
+	binary.Write(hash, binary.LittleEndian, relayData.RequestBlock)
+	hash.Write(relayData.Data)
+	var key [sharedNodeReplyKeyLen]byte
+	copy(key[:], hash.Sum(nil))
+	return key
+}
+
+// SendFinalizedNodeMsg is SendNodeMsg for relays of finalized data. requests waiting for another one in flight share its
+// outcome, errors included, but errors are never reused by later requests.
+// the node request runs detached from the request that started it, limited to the api's node timeout, so a consumer that
+// cancels or times out doesn't fail the others waiting on it
+func (scp *sharingChainProxy) SendFinalizedNodeMsg(ctx context.Context, relayData *pairingtypes.RelayPrivateData, chainMessage chainlib.ChainMessageForSend) (*pairingtypes.RelayReply, error) {
+	key := sharedNodeReplyKey(relayData)
+	scp.lock.Lock()
+	if shared, ok := scp.replies[key]; ok && scp.now().Before(shared.expires) {
+		scp.lock.Unlock()
+		return copyNodeReply(shared.reply), nil
+	}
+	flight, ok := scp.inFlight[key]
+	if !ok {
+		flight = &nodeReplyFlight{done: make(chan struct{})}
+		scp.inFlight[key] = flight
+		go scp.send(common.WithoutCancel(ctx), key, flight, chainMessage)
+	}
+	scp.lock.Unlock()
+	select {
+	case <-flight.done:
+		return flight.result()
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (scp *sharingChainProxy) send(ctx context.Context, key [sharedNodeReplyKeyLen]byte, flight *nodeReplyFlight, chainMessage chainlib.ChainMessageForSend) {
+	ctx, cancel := context.WithTimeout(ctx, chainlib.LocalNodeTimePerCu(chainMessage.GetServiceApi().ComputeUnits))
+	defer cancel()
+	flight.reply, _, _, flight.err = scp.ChainProxy.SendNodeMsg(ctx, nil, chainMessage)
+	scp.lock.Lock()
+	delete(scp.inFlight, key)
+	if flight.err == nil {
+		now := scp.now()
+		if len(scp.replies) >= MaxSharedNodeReplies {
+			scp.dropExpired(now)
+		}
+		if len(scp.replies) >= MaxSharedNodeReplies {
+			scp.replies = map[[sharedNodeReplyKeyLen]byte]sharedNodeReply{}
+		}
+		scp.replies[key] = sharedNodeReply{reply: flight.reply, expires: now.Add(SharedNodeReplyTTL)}
+	}
+	scp.lock.Unlock()
+	close(flight.done)
+}
+
+// must be called with the lock held
+func (scp *sharingChainProxy) dropExpired(now time.Time) {
+	for key, shared := range scp.replies {
+		if !now.Before(shared.expires) {
+			delete(scp.replies, key)
+		}
+	}
+}
+
+func (nrf *nodeReplyFlight) result() (*pairingtypes.RelayReply, error) {
+	if nrf.err != nil {
+		return nil, nrf.err
+	}
+	return copyNodeReply(nrf.reply), nil
+}
+
+func copyNodeReply(reply *pairingtypes.RelayReply) *pairingtypes.RelayReply {
+	replyCopy := *reply
+	return &replyCopy
+}
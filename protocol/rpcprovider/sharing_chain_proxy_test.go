@@ -0,0 +1,111 @@
+package rpcprovider
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/lavanet/lava/protocol/chainlib"
+	"github.com/lavanet/lava/protocol/chainlib/chainproxy/rpcclient"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	spectypes "github.com/lavanet/lava/x/spec/types"
+	"github.com/stretchr/testify/require"
+)
+
+type countingChainProxy struct {
+	sent    int32
+	release chan struct{}
+}
+
+func (m *countingChainProxy) SendNodeMsg(ctx context.Context, ch chan interface{}, chainMessage chainlib.ChainMessageForSend) (*pairingtypes.RelayReply, string, *rpcclient.ClientSubscription, error) {
+	atomic.AddInt32(&m.sent, 1)
+	select {
+	case <-m.release:
+		return &pairingtypes.RelayReply{Data: []byte("reply")}, "", nil, nil
+	case <-ctx.Done():
+		return nil, "", nil, ctx.Err()
+	}
+}
+
+type stubChainMessage struct {
+	chainlib.ChainMessageForSend
+}
+
+func (stubChainMessage) GetServiceApi() *spectypes.ServiceApi {
+	return &spectypes.ServiceApi{Name: "stub_api", ComputeUnits: 10}
+}
+
+func TestSharingChainProxy(t *testing.T) {
+	node := &countingChainProxy{release: make(chan struct{})}
+	now := time.Now()
+	scp := newSharingChainProxy(node)
+	scp.now = func() time.Time { return now }
+	relayData := &pairingtypes.RelayPrivateData{ApiUrl: "/blocks/10", RequestBlock: 10, Salt: []byte("consumer1")}
+
+	replies := make([]*pairingtypes.RelayReply, 10)
+	wg := sync.WaitGroup{}
+	for i := range replies {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			reply, err := scp.SendFinalizedNodeMsg(context.Background(), relayData, stubChainMessage{})
+			require.NoError(t, err)
+			replies[i] = reply
+		}(i)
+	}
+	require.Eventually(t, func() bool { return atomic.LoadInt32(&node.sent) == 1 }, time.Second, time.Millisecond)
+	time.Sleep(10 * time.Millisecond) // let the other requests wait for the one in flight
+	close(node.release)
+	wg.Wait()
+	require.Equal(t, int32(1), atomic.LoadInt32(&node.sent))
+	for _, reply := range replies {
+		require.Equal(t, "reply", string(reply.Data))
+	}
+	// every request signs its own copy
+	replies[0].LatestBlock = 100
+	require.Zero(t, replies[1].LatestBlock)
+
+	// the salt is per consumer, it doesn't change the node request
+	_, err := scp.SendFinalizedNodeMsg(context.Background(), &pairingtypes.RelayPrivateData{ApiUrl: "/blocks/10", RequestBlock: 10, Salt: []byte("consumer2")}, stubChainMessage{})
+	require.NoError(t, err)
+	require.Equal(t, int32(1), atomic.LoadInt32(&node.sent))
+	_, err = scp.SendFinalizedNodeMsg(context.Background(), &pairingtypes.RelayPrivateData{ApiUrl: "/blocks/11", RequestBlock: 11}, stubChainMessage{})
+	require.NoError(t, err)
+	require.Equal(t, int32(2), atomic.LoadInt32(&node.sent))
+
+	now = now.Add(SharedNodeReplyTTL)
+	_, err = scp.SendFinalizedNodeMsg(context.Background(), relayData, stubChainMessage{})
+	require.NoError(t, err)
+	require.Equal(t, int32(3), atomic.LoadInt32(&node.sent))
+}
+
+func TestSharingChainProxyLeaderCanceled(t *testing.T) {
+	node := &countingChainProxy{release: make(chan struct{})}
+	scp := newSharingChainProxy(node)
+	relayData := &pairingtypes.RelayPrivateData{ApiUrl: "/blocks/10", RequestBlock: 10}
+
+	// the request that started the node request leaves before the node replies
+	leaderCtx, cancel := context.WithCancel(context.Background())
+	leaderErr := make(chan error, 1)
+	go func() {
+		_, err := scp.SendFinalizedNodeMsg(leaderCtx, relayData, stubChainMessage{})
+		leaderErr <- err
+	}()
+	require.Eventually(t, func() bool { return atomic.LoadInt32(&node.sent) == 1 }, time.Second, time.Millisecond)
+	followerReply := make(chan *pairingtypes.RelayReply, 1)
+	go func() {
+		reply, err := scp.SendFinalizedNodeMsg(context.Background(), relayData, stubChainMessage{})
+		require.NoError(t, err)
+		followerReply <- reply
+	}()
+	time.Sleep(10 * time.Millisecond) // let the follower wait for the request in flight
+	cancel()
+	require.ErrorIs(t, <-leaderErr, context.Canceled)
+
+	// the request waiting on it still gets the node reply
+	close(node.release)
+	require.Equal(t, "reply", string((<-followerReply).Data))
+	require.Equal(t, int32(1), atomic.LoadInt32(&node.sent))
+}
@@ -2,9 +2,12 @@ package statetracker
 
 import (
 	"context"
+	"sync"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/lavanet/lava/protocol/chainlib"
 	"github.com/lavanet/lava/protocol/chaintracker"
 	"github.com/lavanet/lava/protocol/lavaprotocol"
@@ -16,8 +19,10 @@ import (
 // ConsumerStateTracker CSTis a class for tracking consumer data from the lava blockchain, such as epoch changes.
 // it allows also to query specific data form the blockchain and acts as a single place to send transactions
 type ConsumerStateTracker struct {
-	stateQuery *ConsumerStateQuery
-	txSender   *ConsumerTxSender
+	stateQuery        *ConsumerStateQuery
+	txSender          *ConsumerTxSender
+	eventTracker      *EventTracker
+	pairingEventsOnce sync.Once
 	*StateTracker
 }
 
@@ -30,10 +35,21 @@ func NewConsumerStateTracker(ctx context.Context, txFactory tx.Factory, clientCt
 	if err != nil {
 		return nil, err
 	}
-	cst := &ConsumerStateTracker{StateTracker: stateTrackerBase, stateQuery: NewConsumerStateQuery(ctx, clientCtx), txSender: txSender}
+	cst := &ConsumerStateTracker{StateTracker: stateTrackerBase, stateQuery: NewConsumerStateQuery(ctx, clientCtx), txSender: txSender, eventTracker: NewEventTracker(clientCtx)}
 	return cst, nil
 }
 
+// SetPairingAddress has the pairing queried for another consumer than the --from key, such as the project of a badge.
+// must be called before registering for pairing updates
+func (cst *ConsumerStateTracker) SetPairingAddress(address sdk.AccAddress) {
+	cst.stateQuery.pairingAddress = address
+}
+
+// RegisterForEpochSchedule has the callback called once every epoch, at a block relative to the epoch start or end
+func (cst *ConsumerStateTracker) RegisterForEpochSchedule(ctx context.Context, offset EpochOffset, callback func(epoch uint64)) {
+	cst.StateTracker.registerForEpochSchedule(ctx, cst.stateQuery, offset, callback)
+}
+
 func (cst *ConsumerStateTracker) RegisterConsumerSessionManagerForPairingUpdates(ctx context.Context, consumerSessionManager *lavasession.ConsumerSessionManager) {
 	// register this CSM to get the updated pairing list when a new epoch starts
 	pairingUpdater := NewPairingUpdater(cst.stateQuery)
@@ -46,6 +62,53 @@ func (cst *ConsumerStateTracker) RegisterConsumerSessionManagerForPairingUpdates
 	if err != nil {
 		utils.LavaFormatError("failed registering for pairing updates", err, utils.Attribute{Key: "data", Value: consumerSessionManager.RPCEndpoint()})
 	}
+	// all chains share the pairing updater, so its events are subscribed to once
+	cst.pairingEventsOnce.Do(func() {
+		pairingUpdater.SubscribeToPairingEvents(ctx, cst.eventTracker)
+	})
+}
+
+// UnregisterConsumerSessionManagerForPairingUpdates stops the pairing updates of a consumer session manager of a removed endpoint
+func (cst *ConsumerStateTracker) UnregisterConsumerSessionManagerForPairingUpdates(ctx context.Context, consumerSessionManager *lavasession.ConsumerSessionManager) {
+	pairingUpdaterRaw := cst.StateTracker.RegisterForUpdates(ctx, NewPairingUpdater(cst.stateQuery))
+	pairingUpdater, ok := pairingUpdaterRaw.(*PairingUpdater)
+	if !ok {
+		utils.LavaFormatFatal("invalid updater type returned from RegisterForUpdates", nil, utils.Attribute{Key: "updater", Value: pairingUpdaterRaw})
+	}
+	pairingUpdater.UnregisterPairing(consumerSessionManager)
+}
+
+// RegisterProjectCuBudgetForUpdates sets the budget to the project's cu allowance on the chain at the start of every epoch,
+// until ctx is done
+func (cst *ConsumerStateTracker) RegisterProjectCuBudgetForUpdates(ctx context.Context, budget *lavasession.ProjectCuBudget, chainID string) {
+	cst.RegisterForEpochSchedule(ctx, AfterEpochStart(0), func(epoch uint64) {
+		if ctx.Err() != nil {
+			return
+		}
+		// the callback runs on the block updates routine, the queries don't hold it
+		go cst.updateProjectCuBudget(ctx, budget, chainID, epoch)
+	})
+}
+
+func (cst *ConsumerStateTracker) updateProjectCuBudget(ctx context.Context, budget *lavasession.ProjectCuBudget, chainID string, epoch uint64) {
+	allowance, found, err := cst.stateQuery.GetProjectCuAllowance(ctx, chainID, epoch)
+	if err != nil {
+		// the providers still enforce the allowance, relays aren't rejected on an allowance that may be outdated
+		budget.SetAllowance(epoch, 0, false, 0)
+		return
+	}
+	epochSize, err := cst.stateQuery.GetEpochSize(ctx)
+	if err != nil {
+		utils.LavaFormatWarning("failed querying epoch size for the project cu budget", err)
+	}
+	blocksLeft := int64(epoch+epochSize) - cst.chainTracker.GetLatestBlockNum()
+	if blocksLeft < 0 {
+		blocksLeft = 0
+	}
+	budget.SetAllowance(epoch, allowance, found, time.Duration(blocksLeft)*cst.AverageBlockTime())
+	if found {
+		utils.LavaFormatDebug("project cu allowance updated", utils.Attribute{Key: "chainID", Value: chainID}, utils.Attribute{Key: "epoch", Value: epoch}, utils.Attribute{Key: "allowance", Value: allowance})
+	}
 }
 
 func (cst *ConsumerStateTracker) RegisterFinalizationConsensusForUpdates(ctx context.Context, finalizationConsensus *lavaprotocol.FinalizationConsensus) {
@@ -58,6 +121,16 @@ func (cst *ConsumerStateTracker) RegisterFinalizationConsensusForUpdates(ctx con
 	finalizationConsensusUpdater.RegisterFinalizationConsensus(finalizationConsensus)
 }
 
+// UnregisterFinalizationConsensusForUpdates stops the epoch updates of the finalization consensus of a removed endpoint
+func (cst *ConsumerStateTracker) UnregisterFinalizationConsensusForUpdates(ctx context.Context, finalizationConsensus *lavaprotocol.FinalizationConsensus) {
+	finalizationConsensusUpdaterRaw := cst.StateTracker.RegisterForUpdates(ctx, NewFinalizationConsensusUpdater(cst.stateQuery))
+	finalizationConsensusUpdater, ok := finalizationConsensusUpdaterRaw.(*FinalizationConsensusUpdater)
+	if !ok {
+		utils.LavaFormatFatal("invalid updater type returned from RegisterForUpdates", nil, utils.Attribute{Key: "updater", Value: finalizationConsensusUpdaterRaw})
+	}
+	finalizationConsensusUpdater.UnregisterFinalizationConsensus(finalizationConsensus)
+}
+
 func (cst *ConsumerStateTracker) RegisterChainParserForSpecUpdates(ctx context.Context, chainParser chainlib.ChainParser, chainID string) error {
 	// TODO: handle spec changes
 	spec, err := cst.stateQuery.GetSpec(ctx, chainID)
@@ -0,0 +1,141 @@
+package statetracker
+
+import (
+	"context"
+	"sync"
+
+	"github.com/lavanet/lava/utils"
+)
+
+const (
+	CallbackKeyForEpochSchedule = "epoch-schedule"
+)
+
+// EpochUpdatable is updated once every epoch, at the epoch start
+type EpochUpdatable interface {
+	UpdateEpoch(epoch uint64)
+}
+
+type EpochScheduleStateQuery interface {
+	CurrentEpochStart(ctx context.Context) (uint64, error)
+	GetEpochSize(ctx context.Context) (uint64, error)
+}
+
+// EpochOffset is a block of every epoch, counted from the epoch start or back from the epoch end
+type EpochOffset struct {
+	Blocks    uint64
+	BeforeEnd bool
+}
+
+// AfterEpochStart is the block that comes the given number of blocks after an epoch starts, 0 is the epoch start itself
+func AfterEpochStart(blocks uint64) EpochOffset {
+	return EpochOffset{Blocks: blocks}
+}
+
+// BeforeEpochEnd is the block that comes the given number of blocks before the next epoch starts
+func BeforeEpochEnd(blocks uint64) EpochOffset {
+	return EpochOffset{Blocks: blocks, BeforeEnd: true}
+}
+
+// block returns the offset's block in the epoch, offsets longer than the epoch are clamped to its first or last block
+func (eo EpochOffset) block(epochStart uint64, epochSize uint64) uint64 {
+	if eo.BeforeEnd {
+		if eo.Blocks >= epochSize {
+			return epochStart
+		}
+		return epochStart + epochSize - eo.Blocks
+	}
+	if eo.Blocks >= epochSize {
+		return epochStart + epochSize - 1
+	}
+	return epochStart + eo.Blocks
+}
+
+type scheduledCallback struct {
+	ctx       context.Context // the callback is dropped once it's done
+	offset    EpochOffset
+	callback  func(epoch uint64)
+	lastEpoch uint64
+	called    bool
+}
+
+// EpochScheduler calls registered callbacks once every epoch, at a block relative to the epoch start or end.
+// a callback whose block was already passed when the scheduler learns of the epoch, for example on startup, is called right away.
+// callbacks run on the block update routine so anything slow should be started on its own routine
+type EpochScheduler struct {
+	lock       sync.Mutex
+	callbacks  []*scheduledCallback
+	stateQuery EpochScheduleStateQuery
+	epochStart uint64
+	epochSize  uint64
+}
+
+func NewEpochScheduler(stateQuery EpochScheduleStateQuery) *EpochScheduler {
+	return &EpochScheduler{stateQuery: stateQuery}
+}
+
+// Schedule has the callback called every epoch at the offset's block until ctx is done
+func (es *EpochScheduler) Schedule(ctx context.Context, offset EpochOffset, callback func(epoch uint64)) {
+	es.lock.Lock()
+	defer es.lock.Unlock()
+	es.callbacks = append(es.callbacks, &scheduledCallback{ctx: ctx, offset: offset, callback: callback})
+}
+
+func (es *EpochScheduler) UpdaterKey() string {
+	return CallbackKeyForEpochSchedule
+}
+
+func (es *EpochScheduler) Update(latestBlock int64) {
+	if latestBlock < 0 {
+		return
+	}
+	block := uint64(latestBlock)
+	es.lock.Lock()
+	defer es.lock.Unlock()
+	// the epoch is only queried again once its last block passed
+	if es.epochSize == 0 || block >= es.epochStart+es.epochSize {
+		if !es.updateEpoch() {
+			return
+		}
+	}
+	activeCallbacks := es.callbacks[:0]
+	for _, scheduled := range es.callbacks {
+		if scheduled.ctx.Err() == nil {
+			activeCallbacks = append(activeCallbacks, scheduled)
+		}
+	}
+	es.callbacks = activeCallbacks
+	for _, scheduled := range es.callbacks {
+		if scheduled.called && scheduled.lastEpoch == es.epochStart {
+			continue
+		}
+		if block < scheduled.offset.block(es.epochStart, es.epochSize) {
+			continue
+		}
+		scheduled.called = true
+		scheduled.lastEpoch = es.epochStart
+		scheduled.callback(es.epochStart)
+	}
+}
+
+func (es *EpochScheduler) updateEpoch() bool {
+	ctx := context.Background()
+	epochStart, err := es.stateQuery.CurrentEpochStart(ctx)
+	if err != nil {
+		return false
+	}
+	if epochStart == es.epochStart && es.epochSize != 0 {
+		return false // the chain didn't start the next epoch yet
+	}
+	epochSize, err := es.stateQuery.GetEpochSize(ctx)
+	if err != nil {
+		utils.LavaFormatWarning("failed querying epoch size for the epoch scheduler", err)
+		return false
+	}
+	if epochSize == 0 {
+		return false
+	}
+	es.epochStart = epochStart
+	es.epochSize = epochSize
+	return true
+}
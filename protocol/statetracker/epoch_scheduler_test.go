@@ -0,0 +1,67 @@
+package statetracker
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type mockEpochScheduleStateQuery struct {
+	epochStart uint64
+	epochSize  uint64
+}
+
+func (m *mockEpochScheduleStateQuery) CurrentEpochStart(ctx context.Context) (uint64, error) {
+	return m.epochStart, nil
+}
+
+func (m *mockEpochScheduleStateQuery) GetEpochSize(ctx context.Context) (uint64, error) {
+	return m.epochSize, nil
+}
+
+func TestEpochScheduler(t *testing.T) {
+	stateQuery := &mockEpochScheduleStateQuery{epochStart: 100, epochSize: 20}
+	es := NewEpochScheduler(stateQuery)
+	ctx := context.Background()
+	calls := map[string][]uint64{}
+	es.Schedule(ctx, AfterEpochStart(0), func(epoch uint64) { calls["start"] = append(calls["start"], epoch) })
+	es.Schedule(ctx, AfterEpochStart(5), func(epoch uint64) { calls["after"] = append(calls["after"], epoch) })
+	es.Schedule(ctx, BeforeEpochEnd(3), func(epoch uint64) { calls["before"] = append(calls["before"], epoch) })
+	es.Schedule(ctx, BeforeEpochEnd(50), func(epoch uint64) { calls["clamped"] = append(calls["clamped"], epoch) })
+
+	// started mid epoch, the blocks already passed are called right away
+	es.Update(106)
+	require.Equal(t, []uint64{100}, calls["start"])
+	require.Equal(t, []uint64{100}, calls["after"])
+	require.Equal(t, []uint64{100}, calls["clamped"])
+	require.Empty(t, calls["before"])
+
+	for block := int64(107); block < 120; block++ {
+		es.Update(block)
+	}
+	require.Equal(t, []uint64{100}, calls["before"])
+	require.Len(t, calls["start"], 1)
+
+	// the chain didn't start the next epoch yet
+	es.Update(120)
+	require.Len(t, calls["start"], 1)
+
+	stateQuery.epochStart = 120
+	es.Update(121)
+	require.Equal(t, []uint64{100, 120}, calls["start"])
+	require.Equal(t, []uint64{100, 120}, calls["clamped"])
+	require.Len(t, calls["after"], 1)
+	es.Update(125)
+	require.Equal(t, []uint64{100, 120}, calls["after"])
+
+	// a callback of a done context isn't called anymore
+	unscheduledCtx, cancel := context.WithCancel(ctx)
+	es.Schedule(unscheduledCtx, AfterEpochStart(0), func(epoch uint64) { calls["unscheduled"] = append(calls["unscheduled"], epoch) })
+	cancel()
+	stateQuery.epochStart = 140
+	es.Update(141)
+	require.Equal(t, []uint64{100, 120, 140}, calls["start"])
+	require.Empty(t, calls["unscheduled"])
+	require.Len(t, es.callbacks, 4)
+}
@@ -0,0 +1,71 @@
+package statetracker
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/lavanet/lava/utils"
+	"github.com/tendermint/tendermint/libs/service"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+)
+
+const (
+	eventSubscriber          = "lava-state-tracker"
+	eventSubscriptionBuffer  = 100
+	eventResubscribeInterval = 10 * time.Second
+)
+
+// EventTracker pushes lava chain events to callbacks over the node's websocket, updaters still poll so a dropped subscription only adds latency
+type EventTracker struct {
+	clientCtx client.Context
+}
+
+func NewEventTracker(clientCtx client.Context) *EventTracker {
+	return &EventTracker{clientCtx: clientCtx}
+}
+
+// Subscribe calls callback with every event matching query until ctx is done, subscribing again whenever the subscription drops
+func (et *EventTracker) Subscribe(ctx context.Context, query string, callback func(event ctypes.ResultEvent)) {
+	go func() {
+		for {
+			err := et.subscribe(ctx, query, callback)
+			if ctx.Err() != nil {
+				return
+			}
+			utils.LavaFormatWarning("event subscription dropped, falling back to polling until resubscribed", err, utils.Attribute{Key: "query", Value: query}, utils.Attribute{Key: "retryIn", Value: eventResubscribeInterval})
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(eventResubscribeInterval):
+			}
+		}
+	}()
+}
+
+func (et *EventTracker) subscribe(ctx context.Context, query string, callback func(event ctypes.ResultEvent)) error {
+	// the websocket of the rpc client is opened on start
+	if !et.clientCtx.Client.IsRunning() {
+		err := et.clientCtx.Client.Start()
+		if err != nil && !errors.Is(err, service.ErrAlreadyStarted) {
+			return err
+		}
+	}
+	events, err := et.clientCtx.Client.Subscribe(ctx, eventSubscriber, query, eventSubscriptionBuffer)
+	if err != nil {
+		return err
+	}
+	defer et.clientCtx.Client.Unsubscribe(context.Background(), eventSubscriber, query)
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case event, ok := <-events:
+			if !ok {
+				return errors.New("event subscription channel closed")
+			}
+			callback(event)
+		}
+	}
+}
@@ -2,6 +2,7 @@ package statetracker
 
 import (
 	"context"
+	"sync"
 
 	"github.com/lavanet/lava/protocol/lavaprotocol"
 	"github.com/lavanet/lava/utils"
@@ -12,6 +13,7 @@ const (
 )
 
 type FinalizationConsensusUpdater struct {
+	lock                              sync.Mutex // guards the registered finalization consensuses
 	registeredFinalizationConsensuses []*lavaprotocol.FinalizationConsensus
 	nextBlockForUpdate                uint64
 	stateQuery                        *ConsumerStateQuery
@@ -23,9 +25,23 @@ func NewFinalizationConsensusUpdater(stateQuery *ConsumerStateQuery) *Finalizati
 
 func (fcu *FinalizationConsensusUpdater) RegisterFinalizationConsensus(finalizationConsensus *lavaprotocol.FinalizationConsensus) {
 	// TODO: also update here for the first time
+	fcu.lock.Lock()
+	defer fcu.lock.Unlock()
 	fcu.registeredFinalizationConsensuses = append(fcu.registeredFinalizationConsensuses, finalizationConsensus)
 }
 
+// UnregisterFinalizationConsensus stops the epoch updates of a finalization consensus that is no longer used
+func (fcu *FinalizationConsensusUpdater) UnregisterFinalizationConsensus(finalizationConsensus *lavaprotocol.FinalizationConsensus) {
+	fcu.lock.Lock()
+	defer fcu.lock.Unlock()
+	for idx, registered := range fcu.registeredFinalizationConsensuses {
+		if registered == finalizationConsensus {
+			fcu.registeredFinalizationConsensuses = append(fcu.registeredFinalizationConsensuses[:idx], fcu.registeredFinalizationConsensuses[idx+1:]...)
+			return
+		}
+	}
+}
+
 func (fcu *FinalizationConsensusUpdater) UpdaterKey() string {
 	return CallbackKeyForFinalizationConsensusUpdate
 }
@@ -35,13 +51,15 @@ func (fcu *FinalizationConsensusUpdater) Update(latestBlock int64) {
 	if int64(fcu.nextBlockForUpdate) > latestBlock {
 		return
 	}
-	_, epoch, nextBlockForUpdate, err := fcu.stateQuery.GetPairing(ctx, "", latestBlock)
+	_, epoch, nextBlockForUpdate, _, _, err := fcu.stateQuery.GetPairing(ctx, "", latestBlock)
 	if err != nil {
 		utils.LavaFormatError("could not get block stats for finzalizationConsensus, trying again later", err, utils.Attribute{Key: "latestBlock", Value: latestBlock})
 		fcu.nextBlockForUpdate += 1
 		return
 	}
 	fcu.nextBlockForUpdate = nextBlockForUpdate
+	fcu.lock.Lock()
+	defer fcu.lock.Unlock()
 	for _, finalizationConsensus := range fcu.registeredFinalizationConsensuses {
 		finalizationConsensus.NewEpoch(epoch)
 	}
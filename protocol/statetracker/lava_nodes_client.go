@@ -0,0 +1,330 @@
+package statetracker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/lavanet/lava/utils"
+	"github.com/tendermint/tendermint/libs/bytes"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+const (
+	LavaNodesFlagName              = "lava-nodes"
+	LavaNodeHealthCheckInterval    = 10 * time.Second
+	MaxConsecutiveLavaNodeFailures = 3 // failed requests in a row before a lava node is taken out of rotation
+	lavaNodeHealthCheckTimeout     = 3 * time.Second
+)
+
+type lavaNode struct {
+	index               int
+	uri                 string
+	client              rpcclient.Client
+	healthy             bool
+	consecutiveFailures uint64
+}
+
+// LavaNodesClient fails over state queries of the lava chain between several lava nodes when a node is unavailable,
+// nodes that keep failing or fall behind are taken out of rotation until a health check finds them synced again.
+// queries stay on one node until it fails so related queries read the same height,
+// txs are sent to the first healthy node so consecutive txs of the same account reach the same mempool
+type LavaNodesClient struct {
+	rpcclient.Client // the first node, serves the requests that aren't failed over
+	lock             sync.RWMutex
+	nodes            []*lavaNode
+	queryIndex       int                  // the node queries are sent to, changes only when it fails
+	subscriptions    map[string]*lavaNode // the node each subscription is on, by subscriber and query
+}
+
+// NewLavaNodesClient creates an rpc client for every node uri, the first uri is the node set for the client context
+func NewLavaNodesClient(ctx context.Context, uris []string) (*LavaNodesClient, error) {
+	lnc := &LavaNodesClient{nodes: make([]*lavaNode, 0, len(uris)), subscriptions: map[string]*lavaNode{}}
+	for idx, uri := range uris {
+		nodeClient, err := client.NewClientFromNode(uri)
+		if err != nil {
+			return nil, utils.LavaFormatError("invalid lava node uri", err, utils.Attribute{Key: "uri", Value: uri})
+		}
+		lnc.nodes = append(lnc.nodes, &lavaNode{index: idx, uri: uri, client: nodeClient, healthy: true})
+	}
+	if len(lnc.nodes) == 0 {
+		return nil, utils.LavaFormatError("no lava node uris", nil)
+	}
+	lnc.Client = lnc.nodes[0].client
+	if len(lnc.nodes) > 1 {
+		go lnc.healthCheckLoop(ctx, LavaNodeHealthCheckInterval)
+	}
+	return lnc, nil
+}
+
+// WithLavaNodes sets a client context to fail over between its own node and the extra lava nodes
+func WithLavaNodes(ctx context.Context, clientCtx client.Context, lavaNodes []string) (client.Context, error) {
+	if len(lavaNodes) == 0 {
+		return clientCtx, nil
+	}
+	lavaNodesClient, err := NewLavaNodesClient(ctx, append([]string{clientCtx.NodeURI}, lavaNodes...))
+	if err != nil {
+		return clientCtx, err
+	}
+	return clientCtx.WithClient(lavaNodesClient), nil
+}
+
+// queryNodes returns the nodes to try a query on, starting from the node that served the last queries
+func (lnc *LavaNodesClient) queryNodes() []*lavaNode {
+	lnc.lock.RLock()
+	defer lnc.lock.RUnlock()
+	return lnc.orderedNodes(lnc.queryIndex)
+}
+
+// txNodes returns the nodes to try a tx on, healthy nodes in configuration order
+func (lnc *LavaNodesClient) txNodes() []*lavaNode {
+	lnc.lock.RLock()
+	defer lnc.lock.RUnlock()
+	return lnc.orderedNodes(0)
+}
+
+// orderedNodes lists the healthy nodes from start and then the unhealthy ones as a best effort, lock must be held
+func (lnc *LavaNodesClient) orderedNodes(start int) []*lavaNode {
+	healthy := make([]*lavaNode, 0, len(lnc.nodes))
+	unhealthy := []*lavaNode{}
+	for idx := range lnc.nodes {
+		node := lnc.nodes[(start+idx)%len(lnc.nodes)]
+		if node.healthy {
+			healthy = append(healthy, node)
+		} else {
+			unhealthy = append(unhealthy, node)
+		}
+	}
+	return append(healthy, unhealthy...)
+}
+
+// failover calls request with the nodes in order until a node answers. only unavailable nodes are failed over,
+// other errors are the answer of the node to the request and are returned as they are.
+// a request that fails on every node is likely a local connectivity issue, so nodes are only penalized when another node answered
+func (lnc *LavaNodesClient) failover(ctx context.Context, nodes []*lavaNode, request func(nodeClient rpcclient.Client) error) (err error) {
+	for idx, node := range nodes {
+		err = request(node.client)
+		if err == nil || !isLavaNodeUnavailable(ctx, err) {
+			if idx > 0 || err == nil {
+				lnc.onNodesResult(nodes[:idx], node, err)
+			}
+			return err
+		}
+	}
+	return err
+}
+
+// isLavaNodeUnavailable reports whether a request failed because the node couldn't be reached or didn't serve it,
+// a canceled request or an error the node replied with would fail the same on any node
+func isLavaNodeUnavailable(ctx context.Context, err error) bool {
+	if ctx.Err() != nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	var netErr net.Error
+	var syntaxErr *json.SyntaxError
+	// a reply that isn't json comes from a proxy in front of a node that is down
+	return errors.As(err, &netErr) || errors.As(err, &syntaxErr) || errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF)
+}
+
+// onNodesResult resets the failures of the node that answered and moves the following queries to it,
+// the nodes that were unavailable before it are penalized
+func (lnc *LavaNodesClient) onNodesResult(failedNodes []*lavaNode, answeredNode *lavaNode, err error) {
+	lnc.lock.Lock()
+	defer lnc.lock.Unlock()
+	answeredNode.consecutiveFailures = 0
+	if len(failedNodes) > 0 {
+		lnc.queryIndex = answeredNode.index
+	}
+	for _, node := range failedNodes {
+		node.consecutiveFailures++
+		if node.healthy && node.consecutiveFailures >= MaxConsecutiveLavaNodeFailures {
+			node.healthy = false
+			utils.LavaFormatWarning("lava node failed too many times in a row, taking it out of rotation", err, utils.Attribute{Key: "uri", Value: node.uri}, utils.Attribute{Key: "failures", Value: node.consecutiveFailures})
+		}
+	}
+}
+
+func (lnc *LavaNodesClient) healthCheckLoop(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			lnc.checkNodes(ctx)
+		}
+	}
+}
+
+// checkNodes marks nodes healthy when they are reachable and synced, a node that is catching up serves stale pairings
+func (lnc *LavaNodesClient) checkNodes(ctx context.Context) {
+	for _, node := range lnc.nodes {
+		statusCtx, cancel := context.WithTimeout(ctx, lavaNodeHealthCheckTimeout)
+		status, err := node.client.Status(statusCtx)
+		cancel()
+		if err == nil && status.SyncInfo.CatchingUp {
+			err = utils.LavaFormatWarning("lava node is catching up", nil, utils.Attribute{Key: "latestBlock", Value: status.SyncInfo.LatestBlockHeight})
+		}
+		lnc.lock.Lock()
+		if err != nil {
+			if node.healthy {
+				utils.LavaFormatWarning("lava node health check failed, taking it out of rotation", err, utils.Attribute{Key: "uri", Value: node.uri})
+			}
+			node.healthy = false
+		} else {
+			if !node.healthy {
+				utils.LavaFormatInfo("lava node is healthy again, returning it to rotation", utils.Attribute{Key: "uri", Value: node.uri})
+			}
+			node.healthy = true
+			node.consecutiveFailures = 0
+		}
+		lnc.lock.Unlock()
+	}
+}
+
+func (lnc *LavaNodesClient) ABCIInfo(ctx context.Context) (res *ctypes.ResultABCIInfo, err error) {
+	err = lnc.failover(ctx, lnc.queryNodes(), func(nodeClient rpcclient.Client) (err error) {
+		res, err = nodeClient.ABCIInfo(ctx)
+		return err
+	})
+	return res, err
+}
+
+func (lnc *LavaNodesClient) ABCIQuery(ctx context.Context, path string, data bytes.HexBytes) (res *ctypes.ResultABCIQuery, err error) {
+	return lnc.ABCIQueryWithOptions(ctx, path, data, rpcclient.DefaultABCIQueryOptions)
+}
+
+func (lnc *LavaNodesClient) ABCIQueryWithOptions(ctx context.Context, path string, data bytes.HexBytes, opts rpcclient.ABCIQueryOptions) (res *ctypes.ResultABCIQuery, err error) {
+	err = lnc.failover(ctx, lnc.queryNodes(), func(nodeClient rpcclient.Client) (err error) {
+		res, err = nodeClient.ABCIQueryWithOptions(ctx, path, data, opts)
+		return err
+	})
+	return res, err
+}
+
+func (lnc *LavaNodesClient) BroadcastTxCommit(ctx context.Context, tx tmtypes.Tx) (res *ctypes.ResultBroadcastTxCommit, err error) {
+	err = lnc.failover(ctx, lnc.txNodes(), func(nodeClient rpcclient.Client) (err error) {
+		res, err = nodeClient.BroadcastTxCommit(ctx, tx)
+		return err
+	})
+	return res, err
+}
+
+func (lnc *LavaNodesClient) BroadcastTxAsync(ctx context.Context, tx tmtypes.Tx) (res *ctypes.ResultBroadcastTx, err error) {
+	err = lnc.failover(ctx, lnc.txNodes(), func(nodeClient rpcclient.Client) (err error) {
+		res, err = nodeClient.BroadcastTxAsync(ctx, tx)
+		return err
+	})
+	return res, err
+}
+
+func (lnc *LavaNodesClient) BroadcastTxSync(ctx context.Context, tx tmtypes.Tx) (res *ctypes.ResultBroadcastTx, err error) {
+	err = lnc.failover(ctx, lnc.txNodes(), func(nodeClient rpcclient.Client) (err error) {
+		res, err = nodeClient.BroadcastTxSync(ctx, tx)
+		return err
+	})
+	return res, err
+}
+
+func (lnc *LavaNodesClient) Status(ctx context.Context) (res *ctypes.ResultStatus, err error) {
+	err = lnc.failover(ctx, lnc.queryNodes(), func(nodeClient rpcclient.Client) (err error) {
+		res, err = nodeClient.Status(ctx)
+		return err
+	})
+	return res, err
+}
+
+func (lnc *LavaNodesClient) Block(ctx context.Context, height *int64) (res *ctypes.ResultBlock, err error) {
+	err = lnc.failover(ctx, lnc.queryNodes(), func(nodeClient rpcclient.Client) (err error) {
+		res, err = nodeClient.Block(ctx, height)
+		return err
+	})
+	return res, err
+}
+
+func (lnc *LavaNodesClient) BlockResults(ctx context.Context, height *int64) (res *ctypes.ResultBlockResults, err error) {
+	err = lnc.failover(ctx, lnc.queryNodes(), func(nodeClient rpcclient.Client) (err error) {
+		res, err = nodeClient.BlockResults(ctx, height)
+		return err
+	})
+	return res, err
+}
+
+func (lnc *LavaNodesClient) ConsensusParams(ctx context.Context, height *int64) (res *ctypes.ResultConsensusParams, err error) {
+	err = lnc.failover(ctx, lnc.queryNodes(), func(nodeClient rpcclient.Client) (err error) {
+		res, err = nodeClient.ConsensusParams(ctx, height)
+		return err
+	})
+	return res, err
+}
+
+func (lnc *LavaNodesClient) Tx(ctx context.Context, hash []byte, prove bool) (res *ctypes.ResultTx, err error) {
+	err = lnc.failover(ctx, lnc.queryNodes(), func(nodeClient rpcclient.Client) (err error) {
+		res, err = nodeClient.Tx(ctx, hash, prove)
+		return err
+	})
+	return res, err
+}
+
+// IsRunning reports true since node clients are started on their first subscription
+func (lnc *LavaNodesClient) IsRunning() bool {
+	return true
+}
+
+func (lnc *LavaNodesClient) Start() error {
+	return nil
+}
+
+func (lnc *LavaNodesClient) Stop() error {
+	for _, node := range lnc.nodes {
+		if node.client.IsRunning() {
+			node.client.Stop()
+		}
+	}
+	return nil
+}
+
+// subscriptionKey identifies a subscription in the subscriptions map
+func subscriptionKey(subscriber string, query string) string {
+	return subscriber + "/" + query
+}
+
+// Subscribe subscribes on the first healthy node that accepts it, the subscription stays on that node until it's unsubscribed
+func (lnc *LavaNodesClient) Subscribe(ctx context.Context, subscriber, query string, outCapacity ...int) (out <-chan ctypes.ResultEvent, err error) {
+	for _, node := range lnc.txNodes() {
+		if !node.client.IsRunning() {
+			err = node.client.Start()
+			if err != nil {
+				continue
+			}
+		}
+		out, err = node.client.Subscribe(ctx, subscriber, query, outCapacity...)
+		if err == nil {
+			lnc.lock.Lock()
+			lnc.subscriptions[subscriptionKey(subscriber, query)] = node
+			lnc.lock.Unlock()
+			return out, nil
+		}
+	}
+	return nil, err
+}
+
+func (lnc *LavaNodesClient) Unsubscribe(ctx context.Context, subscriber, query string) error {
+	lnc.lock.Lock()
+	key := subscriptionKey(subscriber, query)
+	node, ok := lnc.subscriptions[key]
+	delete(lnc.subscriptions, key)
+	lnc.lock.Unlock()
+	if !ok {
+		return utils.LavaFormatWarning("unsubscribing from an unknown subscription", nil, utils.Attribute{Key: "subscriber", Value: subscriber}, utils.Attribute{Key: "query", Value: query})
+	}
+	return node.client.Unsubscribe(ctx, subscriber, query)
+}
@@ -0,0 +1,156 @@
+package statetracker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/bytes"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	rpctypes "github.com/tendermint/tendermint/rpc/jsonrpc/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+type mockLavaNode struct {
+	rpcclient.Client
+	name  string
+	err   error // returned by every request while set
+	calls int
+}
+
+func (m *mockLavaNode) ABCIQueryWithOptions(ctx context.Context, path string, data bytes.HexBytes, opts rpcclient.ABCIQueryOptions) (*ctypes.ResultABCIQuery, error) {
+	m.calls++
+	if m.err != nil {
+		return nil, m.err
+	}
+	return &ctypes.ResultABCIQuery{Response: abci.ResponseQuery{Log: m.name}}, nil
+}
+
+func (m *mockLavaNode) BroadcastTxSync(ctx context.Context, tx tmtypes.Tx) (*ctypes.ResultBroadcastTx, error) {
+	m.calls++
+	if m.err != nil {
+		return nil, m.err
+	}
+	return &ctypes.ResultBroadcastTx{Log: m.name}, nil
+}
+
+func (m *mockLavaNode) Status(ctx context.Context) (*ctypes.ResultStatus, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	return &ctypes.ResultStatus{}, nil
+}
+
+func unavailableNodeError() error {
+	return fmt.Errorf("post failed: %w", &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")})
+}
+
+func newMockLavaNodesClient(names ...string) (*LavaNodesClient, []*mockLavaNode) {
+	lnc := &LavaNodesClient{subscriptions: map[string]*lavaNode{}}
+	mocks := []*mockLavaNode{}
+	for idx, name := range names {
+		mock := &mockLavaNode{name: name}
+		mocks = append(mocks, mock)
+		lnc.nodes = append(lnc.nodes, &lavaNode{index: idx, uri: name, client: mock, healthy: true})
+	}
+	lnc.Client = lnc.nodes[0].client
+	return lnc, mocks
+}
+
+func queriedNode(t *testing.T, lnc *LavaNodesClient) string {
+	res, err := lnc.ABCIQuery(context.Background(), "/store", nil)
+	require.NoError(t, err)
+	return res.Response.Log
+}
+
+func TestLavaNodesClientFailover(t *testing.T) {
+	lnc, mocks := newMockLavaNodesClient("node0", "node1", "node2")
+	require.Equal(t, "node0", queriedNode(t, lnc))
+	require.Equal(t, "node0", queriedNode(t, lnc)) // related queries stay on the same node
+
+	// unavailable nodes are failed over in order
+	mocks[0].err = unavailableNodeError()
+	mocks[1].err = unavailableNodeError()
+	require.Equal(t, "node2", queriedNode(t, lnc))
+	require.Equal(t, 3, mocks[0].calls)
+	require.Equal(t, 1, mocks[1].calls)
+
+	// the node that answered keeps serving queries after the others are back
+	mocks[0].err = nil
+	mocks[1].err = nil
+	require.Equal(t, "node2", queriedNode(t, lnc))
+	require.Equal(t, 3, mocks[0].calls)
+
+	// an error the node replied with isn't failed over
+	mocks[2].err = fmt.Errorf("response error: %w", &rpctypes.RPCError{Code: -32603, Message: "Internal error"})
+	_, err := lnc.ABCIQuery(context.Background(), "/store", nil)
+	require.Error(t, err)
+	require.Equal(t, 3, mocks[0].calls)
+	require.Equal(t, 1, mocks[1].calls)
+
+	// a request that fails on every node doesn't penalize them
+	failures := []uint64{}
+	for idx, mock := range mocks {
+		mock.err = unavailableNodeError()
+		failures = append(failures, lnc.nodes[idx].consecutiveFailures)
+	}
+	_, err = lnc.ABCIQuery(context.Background(), "/store", nil)
+	require.Error(t, err)
+	for idx, node := range lnc.nodes {
+		require.Equal(t, failures[idx], node.consecutiveFailures)
+	}
+}
+
+func TestLavaNodesClientCancellation(t *testing.T) {
+	lnc, mocks := newMockLavaNodesClient("node0", "node1")
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	mocks[0].err = fmt.Errorf("post failed: %w", context.Canceled)
+	_, err := lnc.ABCIQuery(ctx, "/store", nil)
+	require.ErrorIs(t, err, context.Canceled)
+	require.Zero(t, mocks[1].calls)
+
+	// a transport error of a canceled request isn't the node's fault either
+	mocks[0].err = unavailableNodeError()
+	_, err = lnc.ABCIQuery(ctx, "/store", nil)
+	require.Error(t, err)
+	require.Zero(t, mocks[1].calls)
+
+	mocks[0].err = fmt.Errorf("post failed: %w", context.DeadlineExceeded)
+	_, err = lnc.ABCIQuery(context.Background(), "/store", nil)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.Zero(t, mocks[1].calls)
+	require.Zero(t, lnc.nodes[0].consecutiveFailures)
+}
+
+func TestLavaNodesClientRecovery(t *testing.T) {
+	lnc, mocks := newMockLavaNodesClient("node0", "node1")
+	broadcastNode := func() string {
+		res, err := lnc.BroadcastTxSync(context.Background(), tmtypes.Tx{})
+		require.NoError(t, err)
+		return res.Log
+	}
+
+	mocks[0].err = unavailableNodeError()
+	for i := 0; i < MaxConsecutiveLavaNodeFailures; i++ {
+		require.Equal(t, "node1", broadcastNode())
+	}
+	require.False(t, lnc.nodes[0].healthy)
+
+	// a node out of rotation is tried last even when it answers
+	mocks[0].err = nil
+	calls := mocks[0].calls
+	require.Equal(t, "node1", broadcastNode())
+	require.Equal(t, calls, mocks[0].calls)
+
+	// a health check returns it to rotation
+	lnc.checkNodes(context.Background())
+	require.True(t, lnc.nodes[0].healthy)
+	require.Zero(t, lnc.nodes[0].consecutiveFailures)
+	require.Equal(t, "node0", broadcastNode())
+}
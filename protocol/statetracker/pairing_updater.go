@@ -1,9 +1,17 @@
 package statetracker
 
 import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
 	"github.com/lavanet/lava/protocol/lavasession"
 	"github.com/lavanet/lava/utils"
 	epochstoragetypes "github.com/lavanet/lava/x/epochstorage/types"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	"golang.org/x/exp/slices"
 	"golang.org/x/net/context"
 )
 
@@ -11,7 +19,52 @@ const (
 	CallbackKeyForPairingUpdate = "pairing-update"
 )
 
+// providerRemovalEvent is an event taking a provider out of service before the pairing changes on the next epoch
+type providerRemovalEvent struct {
+	query       string
+	addressKey  string // the attribute holding the provider address
+	chainIDsKey string // the attribute holding the chains, comma separated
+}
+
+var (
+	newEpochHeightKey = utils.EventPrefix + epochstoragetypes.NewEpochEventName + ".height"
+	newEpochQuery     = fmt.Sprintf("tm.event = 'NewBlock' AND %s EXISTS", newEpochHeightKey)
+
+	providerRemovalEvents = []providerRemovalEvent{
+		newProviderRemovalEvent("NewBlock", pairingtypes.ProviderJailedEventName, "provider_address", "chain_id"), // jailed on epoch start
+		newProviderRemovalEvent("Tx", pairingtypes.ProviderFreezeEventName, "providerAddress", "chainIDs"),
+		newProviderRemovalEvent("Tx", pairingtypes.ProviderUnstakeEventName, "address", "chainID"),
+	}
+)
+
+func newProviderRemovalEvent(tendermintEvent string, eventName string, addressKey string, chainIDsKey string) providerRemovalEvent {
+	addressKey = utils.EventPrefix + eventName + "." + addressKey
+	return providerRemovalEvent{
+		query:       fmt.Sprintf("tm.event = '%s' AND %s EXISTS", tendermintEvent, addressKey),
+		addressKey:  addressKey,
+		chainIDsKey: utils.EventPrefix + eventName + "." + chainIDsKey,
+	}
+}
+
+// providersByChain returns the removed providers of each chain, tendermint lists the attributes of every event in order so they pair up by index
+func (pre providerRemovalEvent) providersByChain(event ctypes.ResultEvent) map[string][]string {
+	addresses := event.Events[pre.addressKey]
+	chainIDs := event.Events[pre.chainIDsKey]
+	if len(addresses) != len(chainIDs) {
+		utils.LavaFormatWarning("invalid provider removal event, attributes don't match", nil, utils.Attribute{Key: "query", Value: pre.query}, utils.Attribute{Key: "addresses", Value: addresses}, utils.Attribute{Key: "chainIDs", Value: chainIDs})
+		return nil
+	}
+	providers := map[string][]string{}
+	for idx, address := range addresses {
+		for _, chainID := range strings.Split(chainIDs[idx], ",") {
+			providers[chainID] = append(providers[chainID], address)
+		}
+	}
+	return providers
+}
+
 type PairingUpdater struct {
+	lock                       sync.Mutex
 	consumerSessionManagersMap map[string][]*lavasession.ConsumerSessionManager // key is chainID so we don;t run getPairing more than once per chain
 	nextBlockForUpdate         uint64
 	stateQuery                 *ConsumerStateQuery
@@ -22,15 +75,17 @@ func NewPairingUpdater(stateQuery *ConsumerStateQuery) *PairingUpdater {
 }
 
 func (pu *PairingUpdater) RegisterPairing(ctx context.Context, consumerSessionManager *lavasession.ConsumerSessionManager) error {
+	pu.lock.Lock()
+	defer pu.lock.Unlock()
 	chainID := consumerSessionManager.RPCEndpoint().ChainID
-	pairingList, epoch, nextBlockForUpdate, err := pu.stateQuery.GetPairing(context.Background(), chainID, -1)
+	pairingList, epoch, nextBlockForUpdate, allowedApis, addOns, err := pu.stateQuery.GetPairing(context.Background(), chainID, -1)
 	if err != nil {
 		return err
 	}
-	pu.updateConsummerSessionManager(ctx, pairingList, consumerSessionManager, epoch)
+	pu.updateConsummerSessionManager(ctx, pairingList, consumerSessionManager, epoch, allowedApis, addOns)
 	if nextBlockForUpdate > pu.nextBlockForUpdate {
 		// make sure we don't update twice, this updates pu.nextBlockForUpdate
-		pu.Update(int64(nextBlockForUpdate))
+		pu.update(int64(nextBlockForUpdate))
 	}
 	consumerSessionsManagersList, ok := pu.consumerSessionManagersMap[chainID]
 	if !ok {
@@ -41,18 +96,80 @@ func (pu *PairingUpdater) RegisterPairing(ctx context.Context, consumerSessionMa
 	return nil
 }
 
+// UnregisterPairing stops updating the pairing of a consumer session manager that is no longer used
+func (pu *PairingUpdater) UnregisterPairing(consumerSessionManager *lavasession.ConsumerSessionManager) {
+	pu.lock.Lock()
+	defer pu.lock.Unlock()
+	chainID := consumerSessionManager.RPCEndpoint().ChainID
+	consumerSessionManagers := pu.consumerSessionManagersMap[chainID]
+	for idx, registered := range consumerSessionManagers {
+		if registered == consumerSessionManager {
+			consumerSessionManagers = append(consumerSessionManagers[:idx], consumerSessionManagers[idx+1:]...)
+			break
+		}
+	}
+	if len(consumerSessionManagers) == 0 {
+		delete(pu.consumerSessionManagersMap, chainID)
+		return
+	}
+	pu.consumerSessionManagersMap[chainID] = consumerSessionManagers
+}
+
 func (pu *PairingUpdater) UpdaterKey() string {
 	return CallbackKeyForPairingUpdate
 }
 
 func (pu *PairingUpdater) Update(latestBlock int64) {
+	pu.lock.Lock()
+	defer pu.lock.Unlock()
+	pu.update(latestBlock)
+}
+
+// SubscribeToPairingEvents updates the pairing the moment an epoch starts and quarantines providers leaving service mid epoch,
+// polling on new blocks keeps updating the pairing if the subscriptions drop
+func (pu *PairingUpdater) SubscribeToPairingEvents(ctx context.Context, eventTracker *EventTracker) {
+	eventTracker.Subscribe(ctx, newEpochQuery, pu.newEpochEvent)
+	for _, removalEvent := range providerRemovalEvents {
+		removalEvent := removalEvent
+		eventTracker.Subscribe(ctx, removalEvent.query, func(event ctypes.ResultEvent) {
+			pu.quarantineProviders(removalEvent.providersByChain(event))
+		})
+	}
+}
+
+func (pu *PairingUpdater) newEpochEvent(event ctypes.ResultEvent) {
+	for _, heightStr := range event.Events[newEpochHeightKey] {
+		height, err := strconv.ParseInt(heightStr, 10, 64)
+		if err != nil {
+			utils.LavaFormatWarning("invalid new epoch event height", err, utils.Attribute{Key: "height", Value: heightStr})
+			continue
+		}
+		pu.Update(height)
+	}
+}
+
+// quarantineProviders stops relaying to providers that left service, the pairing query lists them until the next epoch
+func (pu *PairingUpdater) quarantineProviders(providersByChain map[string][]string) {
+	pu.lock.Lock()
+	defer pu.lock.Unlock()
+	for chainID, addresses := range providersByChain {
+		for _, consumerSessionManager := range pu.consumerSessionManagersMap[chainID] {
+			err := consumerSessionManager.QuarantineProvidersInCurrentEpoch(addresses)
+			if err != nil {
+				utils.LavaFormatError("failed quarantining providers removed from service", err, utils.Attribute{Key: "chainID", Value: chainID}, utils.Attribute{Key: "providers", Value: addresses})
+			}
+		}
+	}
+}
+
+func (pu *PairingUpdater) update(latestBlock int64) {
 	ctx := context.Background()
 	if int64(pu.nextBlockForUpdate) > latestBlock {
 		return
 	}
 	nextBlockForUpdateList := []uint64{}
 	for chainID, consumerSessionManagerList := range pu.consumerSessionManagersMap {
-		pairingList, epoch, nextBlockForUpdate, err := pu.stateQuery.GetPairing(ctx, chainID, latestBlock)
+		pairingList, epoch, nextBlockForUpdate, allowedApis, addOns, err := pu.stateQuery.GetPairing(ctx, chainID, latestBlock)
 		if err != nil {
 			utils.LavaFormatError("could not update pairing for chain, trying again next block", err, utils.Attribute{Key: "chain", Value: chainID})
 			nextBlockForUpdateList = append(nextBlockForUpdateList, pu.nextBlockForUpdate+1)
@@ -62,7 +179,7 @@ func (pu *PairingUpdater) Update(latestBlock int64) {
 		}
 		for _, consumerSessionManager := range consumerSessionManagerList {
 			// same pairing for all apiInterfaces, they pick the right endpoints from inside using our filter function
-			err = pu.updateConsummerSessionManager(ctx, pairingList, consumerSessionManager, epoch)
+			err = pu.updateConsummerSessionManager(ctx, pairingList, consumerSessionManager, epoch, allowedApis, addOns)
 			if err != nil {
 				utils.LavaFormatError("failed updating consumer session manager", err, utils.Attribute{Key: "chainID", Value: chainID}, utils.Attribute{Key: "apiInterface", Value: consumerSessionManager.RPCEndpoint().ApiInterface}, utils.Attribute{Key: "pairingListLen", Value: len(pairingList)})
 				continue
@@ -78,7 +195,9 @@ func (pu *PairingUpdater) Update(latestBlock int64) {
 	pu.nextBlockForUpdate = nextBlockForUpdateMin
 }
 
-func (pu *PairingUpdater) updateConsummerSessionManager(ctx context.Context, pairingList []epochstoragetypes.StakeEntry, consumerSessionManager *lavasession.ConsumerSessionManager, epoch uint64) (err error) {
+func (pu *PairingUpdater) updateConsummerSessionManager(ctx context.Context, pairingList []epochstoragetypes.StakeEntry, consumerSessionManager *lavasession.ConsumerSessionManager, epoch uint64, allowedApis []string, addOns []string) (err error) {
+	consumerSessionManager.SetAllowedApis(allowedApis)
+	consumerSessionManager.SetAddOns(addOns)
 	pairingListForThisCSM, err := pu.filterPairingListByEndpoint(ctx, pairingList, consumerSessionManager.RPCEndpoint(), epoch)
 	if err != nil {
 		return err
@@ -101,8 +220,9 @@ func (pu *PairingUpdater) filterPairingListByEndpoint(ctx context.Context, pairi
 
 		relevantEndpoints := []epochstoragetypes.Endpoint{}
 		for _, endpoint := range providerEndpoints {
-			// only take into account endpoints that use the same api interface and the same geolocation
-			if endpoint.UseType == rpcEndpoint.ApiInterface && endpoint.Geolocation == rpcEndpoint.Geolocation {
+			// only take into account endpoints that use the same api interface, endpoints of every geolocation are kept
+			// so the session manager can use the one with the lowest latency for this consumer
+			if endpoint.UseType == rpcEndpoint.ApiInterface {
 				relevantEndpoints = append(relevantEndpoints, endpoint)
 			}
 		}
@@ -117,9 +237,15 @@ func (pu *PairingUpdater) filterPairingListByEndpoint(ctx context.Context, pairi
 		}
 		//
 		pairingEndpoints := make([]*lavasession.Endpoint, len(relevantEndpoints))
+		providerAddOns := []string{}
 		for idx, relevantEndpoint := range relevantEndpoints {
-			endp := &lavasession.Endpoint{NetworkAddress: relevantEndpoint.IPPORT, Enabled: true, Client: nil, ConnectionRefusals: 0}
+			endp := &lavasession.Endpoint{NetworkAddress: relevantEndpoint.IPPORT, Enabled: true, Client: nil, ConnectionRefusals: 0, Geolocation: relevantEndpoint.Geolocation}
 			pairingEndpoints[idx] = endp
+			for _, addOn := range relevantEndpoint.AddOns {
+				if !slices.Contains(providerAddOns, addOn) {
+					providerAddOns = append(providerAddOns, addOn)
+				}
+			}
 		}
 
 		pairing[uint64(providerIdx)] = &lavasession.ConsumerSessionsWithProvider{
@@ -129,6 +255,7 @@ func (pu *PairingUpdater) filterPairingListByEndpoint(ctx context.Context, pairi
 			MaxComputeUnits:   maxcu,
 			ReliabilitySent:   false,
 			PairingEpoch:      epoch,
+			AddOns:            providerAddOns,
 		}
 	}
 	if len(pairing) == 0 {
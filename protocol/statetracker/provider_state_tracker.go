@@ -34,14 +34,14 @@ func NewProviderStateTracker(ctx context.Context, txFactory tx.Factory, clientCt
 	return pst, nil
 }
 
+// RegisterForEpochUpdates has the epoch updatable updated at the start of every epoch, until ctx is done
 func (pst *ProviderStateTracker) RegisterForEpochUpdates(ctx context.Context, epochUpdatable EpochUpdatable) {
-	epochUpdater := NewEpochUpdater(pst.stateQuery)
-	epochUpdaterRaw := pst.StateTracker.RegisterForUpdates(ctx, epochUpdater)
-	epochUpdater, ok := epochUpdaterRaw.(*EpochUpdater)
-	if !ok {
-		utils.LavaFormatFatal("invalid updater type returned from RegisterForUpdates", nil, utils.Attribute{Key: "updater", Value: epochUpdaterRaw})
-	}
-	epochUpdater.RegisterEpochUpdatable(ctx, epochUpdatable)
+	pst.RegisterForEpochSchedule(ctx, AfterEpochStart(0), epochUpdatable.UpdateEpoch)
+}
+
+// RegisterForEpochSchedule has the callback called once every epoch, at a block relative to the epoch start or end
+func (pst *ProviderStateTracker) RegisterForEpochSchedule(ctx context.Context, offset EpochOffset, callback func(epoch uint64)) {
+	pst.StateTracker.registerForEpochSchedule(ctx, pst.stateQuery, offset, callback)
 }
 
 func (pst *ProviderStateTracker) RegisterChainParserForSpecUpdates(ctx context.Context, chainParser chainlib.ChainParser, chainID string) error {
@@ -53,6 +53,17 @@ func (pst *ProviderStateTracker) RegisterChainParserForSpecUpdates(ctx context.C
 	return nil
 }
 
+// RegisterForSpecUpdates calls the updatable with the chain's spec every time specs change on chain
+func (pst *ProviderStateTracker) RegisterForSpecUpdates(ctx context.Context, specUpdatable SpecUpdatable, chainID string) {
+	specUpdater := NewSpecUpdater(pst.stateQuery)
+	specUpdaterRaw := pst.StateTracker.RegisterForUpdates(ctx, specUpdater)
+	specUpdater, ok := specUpdaterRaw.(*SpecUpdater)
+	if !ok {
+		utils.LavaFormatFatal("invalid updater type returned from RegisterForUpdates", nil, utils.Attribute{Key: "updater", Value: specUpdaterRaw})
+	}
+	specUpdater.RegisterSpecUpdatable(ctx, &specUpdatable, chainID)
+}
+
 func (pst *ProviderStateTracker) RegisterReliabilityManagerForVoteUpdates(ctx context.Context, voteUpdatable VoteUpdatable, endpointP *lavasession.RPCProviderEndpoint) {
 	voteUpdater := NewVoteUpdater(pst.stateQuery)
 	voteUpdaterRaw := pst.StateTracker.RegisterForUpdates(ctx, voteUpdater)
@@ -79,6 +90,18 @@ func (pst *ProviderStateTracker) TxRelayPayment(ctx context.Context, relayReques
 	return pst.txSender.TxRelayPayment(ctx, relayRequests, dataReliabilityProofs, description)
 }
 
+func (pst *ProviderStateTracker) TxFreezeProvider(ctx context.Context, chainIDs []string, reason string) error {
+	return pst.txSender.TxFreezeProvider(ctx, chainIDs, reason)
+}
+
+func (pst *ProviderStateTracker) TxUnfreezeProvider(ctx context.Context, chainIDs []string) error {
+	return pst.txSender.TxUnfreezeProvider(ctx, chainIDs)
+}
+
+func (pst *ProviderStateTracker) TxAttestEndpointHealth(ctx context.Context, chainIDs []string, protocolVersion uint32, binaryVersion string) error {
+	return pst.txSender.TxAttestEndpointHealth(ctx, chainIDs, protocolVersion, binaryVersion)
+}
+
 func (pst *ProviderStateTracker) SendVoteReveal(voteID string, vote *reliabilitymanager.VoteData) error {
 	return pst.txSender.SendVoteReveal(voteID, vote)
 }
@@ -0,0 +1,89 @@
+package statetracker
+
+import (
+	"sync"
+
+	"github.com/lavanet/lava/utils"
+	spectypes "github.com/lavanet/lava/x/spec/types"
+	"golang.org/x/net/context"
+)
+
+const (
+	CallbackKeyForSpecUpdate = "spec-update"
+	specUpdaterMaxBlocksScan = 10 // blocks skipped by the lava chain tracker are scanned for spec changes, up to this many
+)
+
+type SpecUpdatable interface {
+	SetSpec(spectypes.Spec)
+}
+
+type SpecUpdaterStateQuery interface {
+	SpecChangeEvents(ctx context.Context, latestBlock int64) (changed bool, err error)
+	GetSpec(ctx context.Context, chainID string) (*spectypes.Spec, error)
+}
+
+type SpecUpdater struct {
+	lock             sync.RWMutex
+	specUpdatables   map[string][]*SpecUpdatable // chainID -> updatables
+	stateQuery       SpecUpdaterStateQuery
+	lastScannedBlock int64
+}
+
+func NewSpecUpdater(stateQuery SpecUpdaterStateQuery) *SpecUpdater {
+	return &SpecUpdater{specUpdatables: map[string][]*SpecUpdatable{}, stateQuery: stateQuery}
+}
+
+func (su *SpecUpdater) RegisterSpecUpdatable(ctx context.Context, specUpdatable *SpecUpdatable, chainID string) {
+	su.lock.Lock()
+	defer su.lock.Unlock()
+	su.specUpdatables[chainID] = append(su.specUpdatables[chainID], specUpdatable)
+}
+
+func (su *SpecUpdater) UpdaterKey() string {
+	return CallbackKeyForSpecUpdate
+}
+
+// Update looks for spec changes since the last scanned block, a change to one spec can change the specs importing it
+// so all the registered chains are reloaded
+func (su *SpecUpdater) Update(latestBlock int64) {
+	ctx := context.Background()
+	if latestBlock <= su.lastScannedBlock {
+		return
+	}
+	fromBlock := latestBlock
+	if su.lastScannedBlock > 0 && latestBlock-su.lastScannedBlock <= specUpdaterMaxBlocksScan {
+		fromBlock = su.lastScannedBlock + 1
+	}
+	changed := false
+	for block := fromBlock; block <= latestBlock && !changed; block++ {
+		var err error
+		changed, err = su.stateQuery.SpecChangeEvents(ctx, block)
+		if err != nil {
+			utils.LavaFormatWarning("failed fetching spec change events, will retry on the next block", err, utils.Attribute{Key: "block", Value: block})
+			su.lastScannedBlock = block - 1
+			return
+		}
+	}
+	su.lastScannedBlock = latestBlock
+	if !changed {
+		return
+	}
+
+	su.lock.RLock()
+	specUpdatables := make(map[string][]*SpecUpdatable, len(su.specUpdatables))
+	for chainID, updatables := range su.specUpdatables {
+		specUpdatables[chainID] = updatables
+	}
+	su.lock.RUnlock()
+	for chainID, updatables := range specUpdatables {
+		spec, err := su.stateQuery.GetSpec(ctx, chainID)
+		if err != nil {
+			// GetSpec already logs the error, the chain keeps its current spec
+			continue
+		}
+		utils.LavaFormatInfo("reloading spec", utils.Attribute{Key: "chainID", Value: chainID}, utils.Attribute{Key: "blockLastUpdated", Value: spec.BlockLastUpdated})
+		for _, updatable := range updatables {
+			(*updatable).SetSpec(*spec)
+		}
+	}
+}
@@ -0,0 +1,76 @@
+package statetracker
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	spectypes "github.com/lavanet/lava/x/spec/types"
+	"github.com/stretchr/testify/require"
+)
+
+type mockSpecUpdaterStateQuery struct {
+	changedBlocks map[int64]bool
+	failedBlocks  map[int64]bool
+	scannedBlocks []int64
+	blockUpdated  uint64
+}
+
+func (m *mockSpecUpdaterStateQuery) SpecChangeEvents(ctx context.Context, latestBlock int64) (bool, error) {
+	m.scannedBlocks = append(m.scannedBlocks, latestBlock)
+	if m.failedBlocks[latestBlock] {
+		return false, errors.New("block results unavailable")
+	}
+	return m.changedBlocks[latestBlock], nil
+}
+
+func (m *mockSpecUpdaterStateQuery) GetSpec(ctx context.Context, chainID string) (*spectypes.Spec, error) {
+	return &spectypes.Spec{Index: chainID, BlockLastUpdated: m.blockUpdated}, nil
+}
+
+type mockSpecUpdatable struct {
+	specs []spectypes.Spec
+}
+
+func (m *mockSpecUpdatable) SetSpec(spec spectypes.Spec) {
+	m.specs = append(m.specs, spec)
+}
+
+func TestSpecUpdaterReload(t *testing.T) {
+	stateQuery := &mockSpecUpdaterStateQuery{changedBlocks: map[int64]bool{}, failedBlocks: map[int64]bool{}}
+	su := NewSpecUpdater(stateQuery)
+	ethUpdatable, lavaUpdatable := &mockSpecUpdatable{}, &mockSpecUpdatable{}
+	var ethSpecUpdatable, lavaSpecUpdatable SpecUpdatable = ethUpdatable, lavaUpdatable
+	su.RegisterSpecUpdatable(context.Background(), &ethSpecUpdatable, "ETH1")
+	su.RegisterSpecUpdatable(context.Background(), &lavaSpecUpdatable, "LAV1")
+
+	// the first update scans only the latest block
+	su.Update(100)
+	require.Equal(t, []int64{100}, stateQuery.scannedBlocks)
+	require.Empty(t, ethUpdatable.specs)
+
+	// blocks skipped by the chain tracker are scanned, a change reloads every registered chain
+	stateQuery.changedBlocks[102] = true
+	stateQuery.blockUpdated = 102
+	su.Update(103)
+	require.Equal(t, []int64{100, 101, 102}, stateQuery.scannedBlocks)
+	require.Len(t, ethUpdatable.specs, 1)
+	require.Equal(t, "ETH1", ethUpdatable.specs[0].Index)
+	require.Len(t, lavaUpdatable.specs, 1)
+	require.Equal(t, uint64(102), lavaUpdatable.specs[0].BlockLastUpdated)
+
+	// a block that failed to scan is scanned again on the next update
+	stateQuery.scannedBlocks = nil
+	stateQuery.failedBlocks[104] = true
+	su.Update(104)
+	require.Len(t, ethUpdatable.specs, 1)
+	delete(stateQuery.failedBlocks, 104)
+	stateQuery.changedBlocks[104] = true
+	su.Update(104)
+	require.Equal(t, []int64{104, 104}, stateQuery.scannedBlocks)
+	require.Len(t, ethUpdatable.specs, 2)
+
+	// a block that was already scanned isn't reloaded again
+	su.Update(104)
+	require.Len(t, ethUpdatable.specs, 2)
+}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/dgraph-io/ristretto"
 	reliabilitymanager "github.com/lavanet/lava/protocol/rpcprovider/reliabilitymanager"
 	"github.com/lavanet/lava/protocol/rpcprovider/rewardserver"
@@ -14,7 +15,10 @@ import (
 	conflicttypes "github.com/lavanet/lava/x/conflict/types"
 	epochstoragetypes "github.com/lavanet/lava/x/epochstorage/types"
 	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	planstypes "github.com/lavanet/lava/x/plans/types"
+	projectstypes "github.com/lavanet/lava/x/projects/types"
 	spectypes "github.com/lavanet/lava/x/spec/types"
+	subscriptiontypes "github.com/lavanet/lava/x/subscription/types"
 )
 
 const (
@@ -56,18 +60,49 @@ func (csq *StateQuery) GetSpec(ctx context.Context, chainID string) (*spectypes.
 	return &spec.Spec, nil
 }
 
+func (csq *StateQuery) CurrentEpochStart(ctx context.Context) (uint64, error) {
+	epochDetails, err := csq.EpochStorageQueryClient.EpochDetails(ctx, &epochstoragetypes.QueryGetEpochDetailsRequest{})
+	if err != nil {
+		return 0, utils.LavaFormatError("Failed Querying EpochDetails", err)
+	}
+	details := epochDetails.GetEpochDetails()
+	return details.StartBlock, nil
+}
+
+func (csq *StateQuery) GetEpochSize(ctx context.Context) (uint64, error) {
+	res, err := csq.EpochStorageQueryClient.Params(ctx, &epochstoragetypes.QueryParamsRequest{})
+	if err != nil {
+		return 0, err
+	}
+	return res.Params.EpochBlocks, nil
+}
+
 type ConsumerStateQuery struct {
 	StateQuery
-	clientCtx   client.Context
-	lastChainID string
+	ProjectsQueryClient     projectstypes.QueryClient
+	SubscriptionQueryClient subscriptiontypes.QueryClient
+	PlansQueryClient        planstypes.QueryClient
+	clientCtx               client.Context
+	lastChainID             string
+	pairingAddress          sdk.AccAddress // the consumer the pairing is queried for
 }
 
 func NewConsumerStateQuery(ctx context.Context, clientCtx client.Context) *ConsumerStateQuery {
-	csq := &ConsumerStateQuery{StateQuery: *NewStateQuery(ctx, clientCtx), clientCtx: clientCtx, lastChainID: ""}
+	csq := &ConsumerStateQuery{
+		StateQuery:              *NewStateQuery(ctx, clientCtx),
+		ProjectsQueryClient:     projectstypes.NewQueryClient(clientCtx),
+		SubscriptionQueryClient: subscriptiontypes.NewQueryClient(clientCtx),
+		PlansQueryClient:        planstypes.NewQueryClient(clientCtx),
+		clientCtx:               clientCtx,
+		lastChainID:             "",
+		pairingAddress:          clientCtx.FromAddress,
+	}
 	return csq
 }
 
-func (csq *ConsumerStateQuery) GetPairing(ctx context.Context, chainID string, latestBlock int64) (pairingList []epochstoragetypes.StakeEntry, epoch uint64, nextBlockForUpdate uint64, errRet error) {
+// GetPairing returns the providers paired with the consumer on the chain, the apis its project allows, nil when they aren't restricted,
+// and the add-ons of its subscription
+func (csq *ConsumerStateQuery) GetPairing(ctx context.Context, chainID string, latestBlock int64) (pairingList []epochstoragetypes.StakeEntry, epoch uint64, nextBlockForUpdate uint64, allowedApis []string, addOns []string, errRet error) {
 	if chainID == "" {
 		if csq.lastChainID != "" {
 			chainID = csq.lastChainID
@@ -82,7 +117,7 @@ func (csq *ConsumerStateQuery) GetPairing(ctx context.Context, chainID string, l
 	if found && cachedInterface != nil {
 		if cachedResp, ok := cachedInterface.(*pairingtypes.QueryGetPairingResponse); ok {
 			if cachedResp.BlockOfNextPairing > uint64(latestBlock) {
-				return cachedResp.Providers, cachedResp.CurrentEpoch, cachedResp.BlockOfNextPairing, nil
+				return cachedResp.Providers, cachedResp.CurrentEpoch, cachedResp.BlockOfNextPairing, cachedResp.AllowedApis, cachedResp.AddOns, nil
 			}
 		} else {
 			utils.LavaFormatError("invalid cache entry - failed casting response", nil, utils.Attribute{Key: "castingType", Value: "*pairingtypes.QueryGetPairingResponse"}, utils.Attribute{Key: "type", Value: cachedInterface})
@@ -91,18 +126,18 @@ func (csq *ConsumerStateQuery) GetPairing(ctx context.Context, chainID string, l
 
 	pairingResp, err := csq.PairingQueryClient.GetPairing(ctx, &pairingtypes.QueryGetPairingRequest{
 		ChainID: chainID,
-		Client:  csq.clientCtx.FromAddress.String(),
+		Client:  csq.pairingAddress.String(),
 	})
 	if err != nil {
-		return nil, 0, 0, utils.LavaFormatError("Failed in get pairing query", err, utils.Attribute{})
+		return nil, 0, 0, nil, nil, utils.LavaFormatError("Failed in get pairing query", err, utils.Attribute{})
 	}
 	csq.lastChainID = chainID
 	csq.ResponsesCache.SetWithTTL(PairingRespKey+chainID, pairingResp, 1, DefaultTimeToLiveExpiration)
-	return pairingResp.Providers, pairingResp.CurrentEpoch, pairingResp.BlockOfNextPairing, nil
+	return pairingResp.Providers, pairingResp.CurrentEpoch, pairingResp.BlockOfNextPairing, pairingResp.AllowedApis, pairingResp.AddOns, nil
 }
 
 func (csq *ConsumerStateQuery) GetMaxCUForUser(ctx context.Context, chainID string, epoch uint64) (maxCu uint64, err error) {
-	address := csq.clientCtx.FromAddress.String()
+	address := csq.pairingAddress.String()
 	UserEntryRes, err := csq.PairingQueryClient.UserEntry(ctx, &pairingtypes.QueryUserEntryRequest{ChainID: chainID, Address: address, Block: epoch})
 	if err != nil {
 		return 0, utils.LavaFormatError("failed querying StakeEntry for consumer", err, utils.Attribute{Key: "chainID", Value: chainID}, utils.Attribute{Key: "address", Value: address}, utils.Attribute{Key: "block", Value: epoch})
@@ -110,6 +145,66 @@ func (csq *ConsumerStateQuery) GetMaxCUForUser(ctx context.Context, chainID stri
 	return UserEntryRes.GetMaxCU(), nil
 }
 
+// GetProjectCuAllowance returns the cu the consumer's project can still use on the chain in the epoch, found is false when the consumer
+// doesn't relay for a project, such as a legacy staked consumer
+func (csq *ConsumerStateQuery) GetProjectCuAllowance(ctx context.Context, chainID string, epoch uint64) (allowance uint64, found bool, err error) {
+	developer := csq.pairingAddress.String()
+	developerRes, err := csq.ProjectsQueryClient.Developer(ctx, &projectstypes.QueryDeveloperRequest{Developer: developer})
+	if err != nil || developerRes.Project == nil {
+		return 0, false, nil
+	}
+	project := developerRes.Project
+	subscriptionRes, err := csq.SubscriptionQueryClient.Current(ctx, &subscriptiontypes.QueryCurrentRequest{Consumer: project.Subscription})
+	if err != nil {
+		return 0, false, utils.LavaFormatError("failed querying the subscription of the project", err, utils.Attribute{Key: "project", Value: project.Index}, utils.Attribute{Key: "subscription", Value: project.Subscription})
+	}
+	planRes, err := csq.PlansQueryClient.Info(ctx, &planstypes.QueryInfoRequest{PlanIndex: subscriptionRes.Sub.PlanIndex})
+	if err != nil {
+		return 0, false, utils.LavaFormatError("failed querying the plan of the subscription", err, utils.Attribute{Key: "subscription", Value: project.Subscription}, utils.Attribute{Key: "plan", Value: subscriptionRes.Sub.PlanIndex})
+	}
+	usageRes, err := csq.ProjectsQueryClient.ProjectUsage(ctx, &projectstypes.QueryProjectUsageRequest{Project: project.Index, FromEpoch: epoch, ToEpoch: epoch})
+	if err != nil {
+		return 0, false, utils.LavaFormatError("failed querying the project's usage in the epoch", err, utils.Attribute{Key: "project", Value: project.Index}, utils.Attribute{Key: "epoch", Value: epoch})
+	}
+	chainUsedCu := uint64(0)
+	for _, chainUsage := range usageRes.Chains {
+		if chainUsage.Id == chainID {
+			chainUsedCu = chainUsage.UsedCu
+		}
+	}
+	planPolicy := planRes.PlanInfo.PlanPolicy
+	policies := []*projectstypes.Policy{&planPolicy, project.AdminPolicy, project.SubscriptionPolicy}
+	return projectCuAllowance(chainID, policies, project.UsedCu, chainUsedCu, subscriptionRes.Sub.ProjectCuLeft(project.Index, project.UsedCu)), true, nil
+}
+
+// projectCuAllowance is the cu a project can use on a chain before the chain rejects its relays: what's left of the strictest total cu
+// limit of its policies, of its subscription's month or its allocation in it and of the chain's epoch limit
+func projectCuAllowance(chainID string, policies []*projectstypes.Policy, projectUsedCu uint64, chainUsedCu uint64, subscriptionCuLeft uint64) uint64 {
+	allowance := subscriptionCuLeft
+	for _, policy := range policies {
+		if policy == nil {
+			continue
+		}
+		totalCuLeft := uint64(0)
+		if policy.TotalCuLimit > projectUsedCu {
+			totalCuLeft = policy.TotalCuLimit - projectUsedCu
+		}
+		if totalCuLeft < allowance {
+			allowance = totalCuLeft
+		}
+	}
+	if chainEpochCuLimit, found := projectstypes.GetChainEpochCuLimit(chainID, policies); found {
+		chainCuLeft := uint64(0)
+		if chainEpochCuLimit > chainUsedCu {
+			chainCuLeft = chainEpochCuLimit - chainUsedCu
+		}
+		if chainCuLeft < allowance {
+			allowance = chainCuLeft
+		}
+	}
+	return allowance
+}
+
 type ProviderStateQuery struct {
 	StateQuery
 	clientCtx client.Context
@@ -150,15 +245,6 @@ func (psq *ProviderStateQuery) entryKey(consumerAddress string, chainID string,
 	return consumerAddress + chainID + strconv.FormatUint(epoch, 10) + providerAddress
 }
 
-func (psq *ProviderStateQuery) CurrentEpochStart(ctx context.Context) (uint64, error) {
-	epochDetails, err := psq.EpochStorageQueryClient.EpochDetails(ctx, &epochstoragetypes.QueryGetEpochDetailsRequest{})
-	if err != nil {
-		return 0, utils.LavaFormatError("Failed Querying EpochDetails", err)
-	}
-	details := epochDetails.GetEpochDetails()
-	return details.StartBlock, nil
-}
-
 func (psq *ProviderStateQuery) PaymentEvents(ctx context.Context, latestBlock int64) (payments []*rewardserver.PaymentRequest, err error) {
 	blockResults, err := psq.clientCtx.Client.BlockResults(ctx, &latestBlock)
 	if err != nil {
@@ -227,6 +313,21 @@ func (psq *ProviderStateQuery) VoteEvents(ctx context.Context, latestBlock int64
 	return votes, err
 }
 
+// SpecChangeEvents returns whether specs were added or modified on the block, spec proposals are applied at the end of the block
+func (psq *ProviderStateQuery) SpecChangeEvents(ctx context.Context, latestBlock int64) (changed bool, err error) {
+	blockResults, err := psq.clientCtx.Client.BlockResults(ctx, &latestBlock)
+	if err != nil {
+		return false, err
+	}
+	for _, event := range blockResults.EndBlockEvents {
+		if event.Type == utils.EventPrefix+spectypes.SpecAddEventName || event.Type == utils.EventPrefix+spectypes.SpecModifyEventName {
+			utils.LavaFormatDebug("spec_change_event", utils.Attribute{Key: "block", Value: latestBlock}, utils.Attribute{Key: "event", Value: event.Type})
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
 func (psq *ProviderStateQuery) VerifyPairing(ctx context.Context, consumerAddress string, providerAddress string, epoch uint64, chainID string) (valid bool, index, total int64, err error) {
 	key := psq.entryKey(consumerAddress, chainID, epoch, providerAddress)
 	extractedResultFromCache := false
@@ -266,14 +367,6 @@ func (psq *ProviderStateQuery) GetProvidersCountForConsumer(ctx context.Context,
 	return uint32(res.GetParams().ServicersToPairCount), nil
 }
 
-func (psq *ProviderStateQuery) GetEpochSize(ctx context.Context) (uint64, error) {
-	res, err := psq.EpochStorageQueryClient.Params(ctx, &epochstoragetypes.QueryParamsRequest{})
-	if err != nil {
-		return 0, err
-	}
-	return res.Params.EpochBlocks, nil
-}
-
 func (psq *ProviderStateQuery) EarliestBlockInMemory(ctx context.Context) (uint64, error) {
 	res, err := psq.EpochStorageQueryClient.EpochDetails(ctx, &epochstoragetypes.QueryGetEpochDetailsRequest{})
 	if err != nil {
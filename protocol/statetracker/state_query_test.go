@@ -0,0 +1,28 @@
+package statetracker
+
+import (
+	"testing"
+
+	projectstypes "github.com/lavanet/lava/x/projects/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProjectCuAllowance(t *testing.T) {
+	planPolicy := &projectstypes.Policy{TotalCuLimit: 10000, EpochCuLimit: 1000}
+	subscriptionPolicy := &projectstypes.Policy{
+		TotalCuLimit:  5000,
+		EpochCuLimit:  1000,
+		ChainPolicies: []projectstypes.ChainPolicy{{ChainId: "LAV1", EpochCuLimit: 300}},
+	}
+	policies := []*projectstypes.Policy{planPolicy, nil, subscriptionPolicy}
+
+	// the subscription's month is the strictest
+	require.Equal(t, uint64(100), projectCuAllowance("ETH1", policies, 0, 0, 100))
+	// the strictest total limit
+	require.Equal(t, uint64(1000), projectCuAllowance("ETH1", policies, 4000, 0, 100000))
+	require.Equal(t, uint64(0), projectCuAllowance("ETH1", policies, 6000, 0, 100000))
+	// the chain's epoch limit, shared with the project's other developer keys
+	require.Equal(t, uint64(300), projectCuAllowance("LAV1", policies, 0, 0, 100000))
+	require.Equal(t, uint64(50), projectCuAllowance("LAV1", policies, 0, 250, 100000))
+	require.Equal(t, uint64(0), projectCuAllowance("LAV1", policies, 0, 400, 100000))
+}
@@ -8,19 +8,26 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	"github.com/lavanet/lava/protocol/chaintracker"
+	"github.com/lavanet/lava/utils"
 )
 
 const (
 	BlocksToSaveLavaChainTracker   = 1 // we only need the latest block
 	TendermintConsensusParamsQuery = "consensus_params"
+	BlockTimeMeasurementDecay      = 10 // each measured block time moves the average a tenth of the way to it
 )
 
 // ConsumerStateTracker CSTis a class for tracking consumer data from the lava blockchain, such as epoch changes.
 // it allows also to query specific data form the blockchain and acts as a single place to send transactions
 type StateTracker struct {
 	chainTracker         *chaintracker.ChainTracker
+	averageBlockTime     time.Duration // of the consensus params, the lava chain tracker polls by it
 	registrationLock     sync.RWMutex
 	newLavaBlockUpdaters map[string]Updater
+	blockTimeLock        sync.RWMutex
+	measuredBlockTime    time.Duration // average time between the lava blocks seen, zero until two were seen
+	lastBlock            int64
+	lastBlockTime        time.Time
 }
 
 type Updater interface {
@@ -34,10 +41,11 @@ func NewStateTracker(ctx context.Context, txFactory tx.Factory, clientCtx client
 	if err != nil {
 		return nil, err
 	}
+	cst.averageBlockTime = time.Duration(resultConsensusParams.ConsensusParams.Block.TimeIotaMs) * time.Millisecond
 	chainTrackerConfig := chaintracker.ChainTrackerConfig{
 		NewLatestCallback: cst.newLavaBlock,
 		BlocksToSave:      BlocksToSaveLavaChainTracker,
-		AverageBlockTime:  time.Duration(resultConsensusParams.ConsensusParams.Block.TimeIotaMs) * time.Millisecond,
+		AverageBlockTime:  cst.averageBlockTime,
 		ServerBlockMemory: BlocksToSaveLavaChainTracker,
 	}
 	cst.chainTracker, err = chaintracker.NewChainTracker(ctx, chainFetcher, chainTrackerConfig)
@@ -45,6 +53,7 @@ func NewStateTracker(ctx context.Context, txFactory tx.Factory, clientCtx client
 }
 
 func (cst *StateTracker) newLavaBlock(latestBlock int64) {
+	cst.measureBlockTime(latestBlock, time.Now())
 	// go over the registered updaters and trigger update
 	cst.registrationLock.RLock()
 	defer cst.registrationLock.RUnlock()
@@ -53,6 +62,34 @@ func (cst *StateTracker) newLavaBlock(latestBlock int64) {
 	}
 }
 
+// measureBlockTime averages the time between the lava blocks seen, the chain tracker can skip blocks so it's divided by the blocks passed
+func (cst *StateTracker) measureBlockTime(latestBlock int64, now time.Time) {
+	cst.blockTimeLock.Lock()
+	defer cst.blockTimeLock.Unlock()
+	if cst.lastBlock != 0 && latestBlock > cst.lastBlock {
+		blockTime := now.Sub(cst.lastBlockTime) / time.Duration(latestBlock-cst.lastBlock)
+		if cst.measuredBlockTime == 0 {
+			cst.measuredBlockTime = blockTime
+		} else {
+			cst.measuredBlockTime += (blockTime - cst.measuredBlockTime) / BlockTimeMeasurementDecay
+		}
+	}
+	if latestBlock > cst.lastBlock {
+		cst.lastBlock = latestBlock
+		cst.lastBlockTime = now
+	}
+}
+
+// AverageBlockTime returns the measured average time between lava blocks, or the consensus params' block time until one was measured
+func (cst *StateTracker) AverageBlockTime() time.Duration {
+	cst.blockTimeLock.RLock()
+	defer cst.blockTimeLock.RUnlock()
+	if cst.measuredBlockTime == 0 {
+		return cst.averageBlockTime
+	}
+	return cst.measuredBlockTime
+}
+
 func (cst *StateTracker) RegisterForUpdates(ctx context.Context, updater Updater) Updater {
 	cst.registrationLock.Lock()
 	defer cst.registrationLock.Unlock()
@@ -63,3 +100,14 @@ func (cst *StateTracker) RegisterForUpdates(ctx context.Context, updater Updater
 	}
 	return existingUpdater
 }
+
+// registerForEpochSchedule has the callback called once every epoch at the offset's block, until ctx is done
+func (cst *StateTracker) registerForEpochSchedule(ctx context.Context, stateQuery EpochScheduleStateQuery, offset EpochOffset, callback func(epoch uint64)) {
+	epochScheduler := NewEpochScheduler(stateQuery)
+	epochSchedulerRaw := cst.RegisterForUpdates(ctx, epochScheduler)
+	epochScheduler, ok := epochSchedulerRaw.(*EpochScheduler)
+	if !ok {
+		utils.LavaFormatFatal("invalid updater type returned from RegisterForUpdates", nil, utils.Attribute{Key: "updater", Value: epochSchedulerRaw})
+	}
+	epochScheduler.Schedule(ctx, offset, callback)
+}
@@ -0,0 +1,29 @@
+package statetracker
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStateTrackerMeasuresBlockTime(t *testing.T) {
+	cst := &StateTracker{averageBlockTime: time.Millisecond}
+	start := time.Now()
+
+	// the consensus params' block time is used until two blocks were seen
+	cst.measureBlockTime(100, start)
+	require.Equal(t, time.Millisecond, cst.AverageBlockTime())
+
+	// skipped blocks are divided out of the elapsed time
+	cst.measureBlockTime(102, start.Add(60*time.Second))
+	require.Equal(t, 30*time.Second, cst.AverageBlockTime())
+
+	// a block seen again doesn't change the measurement
+	cst.measureBlockTime(102, start.Add(90*time.Second))
+	require.Equal(t, 30*time.Second, cst.AverageBlockTime())
+
+	// later measurements move the average by the decay
+	cst.measureBlockTime(103, start.Add(100*time.Second))
+	require.Equal(t, 30*time.Second+(40*time.Second-30*time.Second)/BlockTimeMeasurementDecay, cst.AverageBlockTime())
+}
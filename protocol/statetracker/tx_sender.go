@@ -4,8 +4,8 @@ import (
 	"bytes"
 	"context"
 	"fmt"
-	"strconv"
-	"strings"
+	"sync"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/tx"
@@ -21,18 +21,31 @@ import (
 const (
 	defaultGasPrice          = "0.000000001ulava"
 	defaultGasAdjustment     = 1.5
+	outOfGasAdjustmentFactor = 1.3 // the gas adjustment grows by this factor every time a tx runs out of gas
 	RETRY_INCORRECT_SEQUENCE = 5
+	mempoolFullRetryInterval = 2 * time.Second
 )
 
+// TxSender sends the txs of the account one at a time, concurrent txs wait for the ones before them,
+// and tracks the account sequence so txs sent in the same block don't collide on it
 type TxSender struct {
-	txFactory tx.Factory
-	clientCtx client.Context
+	txFactory     tx.Factory
+	clientCtx     client.Context
+	gasAdjustment float64
+	lock          sync.Mutex
+	accountNumber uint64
+	sequence      uint64 // the sequence of the next tx, 0 when it needs to be queried from the chain
 }
 
 func NewTxSender(ctx context.Context, clientCtx client.Context, txFactory tx.Factory) (ret *TxSender, err error) {
 	// set up the rpcClient, and factory necessary to make queries
 	clientCtx.SkipConfirm = true
-	ts := &TxSender{txFactory: txFactory, clientCtx: clientCtx}
+	// the gas-adjustment flag defaults to 1, which leaves no room for state changing between the simulation and the tx, so it can only raise the default
+	gasAdjustment := defaultGasAdjustment
+	if txFactory.GasAdjustment() > gasAdjustment {
+		gasAdjustment = txFactory.GasAdjustment()
+	}
+	ts := &TxSender{txFactory: txFactory, clientCtx: clientCtx, gasAdjustment: gasAdjustment}
 	return ts, nil
 }
 
@@ -67,57 +80,40 @@ func (ts *TxSender) checkProfitability(simResult *typestx.SimulateResponse, gasU
 }
 
 func (ts *TxSender) SimulateAndBroadCastTxWithRetryOnSeqMismatch(msg sdk.Msg, checkProfitability bool) error {
-	txfactory := ts.txFactory.WithGasPrices(defaultGasPrice)
-	txfactory = txfactory.WithGasAdjustment(defaultGasAdjustment)
 	if err := msg.ValidateBasic(); err != nil {
 		return err
 	}
+	ts.lock.Lock()
+	defer ts.lock.Unlock()
 	clientCtx := ts.clientCtx
-	txfactory, err := ts.prepareFactory(txfactory)
-	if err != nil {
-		return err
-	}
-
-	simResult, gasUsed, err := tx.CalculateGas(clientCtx, txfactory, msg)
-	if err != nil {
-		return err
-	}
-
-	if checkProfitability {
-		err := ts.checkProfitability(simResult, gasUsed, txfactory)
+	gasAdjustment := ts.gasAdjustment
+	myWriter := bytes.Buffer{}
+	summarizedTransactionResult := ""
+	for idx := 0; idx <= RETRY_INCORRECT_SEQUENCE; idx++ {
+		txfactory := ts.txFactory.WithGasPrices(defaultGasPrice).WithGasAdjustment(gasAdjustment)
+		txfactory, err := ts.prepareFactory(txfactory)
 		if err != nil {
 			return err
 		}
-	}
 
-	txfactory = txfactory.WithGas(gasUsed)
-	myWriter := bytes.Buffer{}
-	hasSequenceError := false
-	success := false
-	idx := -1
-	sequenceNumberParsed := 0
-	summarizedTransactionResult := ""
-	for ; idx < RETRY_INCORRECT_SEQUENCE && !success; idx++ {
-		if hasSequenceError { // a retry
-			// if sequence number error happened it means that we already sent a tx this block.
-			// we need to wait a block for the tx to be approved,
-			// only then we can ask for a new sequence number continue and try again.
-			var seq uint64
-			if sequenceNumberParsed != 0 {
-				utils.LavaFormatInfo("Sequence Number extracted from transaction error, retrying", utils.Attribute{Key: "sequence", Value: strconv.Itoa(sequenceNumberParsed)})
-				seq = uint64(sequenceNumberParsed)
-			} else {
-				var err error
-				_, seq, err = clientCtx.AccountRetriever.GetAccountNumberSequence(clientCtx, clientCtx.GetFromAddress())
-				if err != nil {
-					utils.LavaFormatError("failed to get correct sequence number for account, give up", err)
-					break // give up
-				}
+		simResult, gasUsed, err := tx.CalculateGas(clientCtx, txfactory, msg)
+		if err != nil {
+			if common.ClassifyTransactionResult(err.Error(), 1) != common.TxResultSequenceMismatch {
+				return err
+			}
+			ts.onSequenceMismatch(err.Error())
+			continue
+		}
+
+		if checkProfitability {
+			err := ts.checkProfitability(simResult, gasUsed, txfactory)
+			if err != nil {
+				return err
 			}
-			txfactory = txfactory.WithSequence(seq)
-			myWriter.Reset()
-			utils.LavaFormatInfo("Retrying with sequence number:", utils.Attribute{Key: "SeqNum", Value: seq})
 		}
+
+		txfactory = txfactory.WithGas(gasUsed)
+		myWriter.Reset()
 		var transactionResult string
 		clientCtx.Output = &myWriter
 		err = tx.GenerateOrBroadcastTxWithFactory(clientCtx, txfactory, msg)
@@ -129,51 +125,59 @@ func (ts *TxSender) SimulateAndBroadCastTxWithRetryOnSeqMismatch(msg sdk.Msg, ch
 		}
 		var returnCode int
 		summarizedTransactionResult, returnCode = common.ParseTransactionResult(transactionResult)
-		// utils.LavaFormatDebug("parsed transaction code", utils.Attribute{"code",  strconv.Itoa(returnCode)}, "transactionResult": transactionResult})
-		if returnCode == 0 { // if we get some other code which isn't 0 then keep retrying
-			success = true
-		} else if strings.Contains(transactionResult, "account sequence") {
-			hasSequenceError = true
-			sequenceNumberParsed, err = common.FindSequenceNumber(transactionResult)
-			if err != nil {
-				utils.LavaFormatWarning("Failed findSequenceNumber", err, utils.Attribute{Key: "sequence", Value: transactionResult})
-			}
+		switch common.ClassifyTransactionResult(transactionResult, returnCode) {
+		case common.TxResultSuccess, common.TxResultAlreadyInMempool:
+			ts.sequence = txfactory.Sequence() + 1
+			utils.LavaFormatInfo(fmt.Sprintf("succeeded sending transaction %s", summarizedTransactionResult))
+			return nil
+		case common.TxResultSequenceMismatch:
+			ts.onSequenceMismatch(transactionResult)
 			summarizedTransactionResult = transactionResult
+		case common.TxResultOutOfGas:
+			gasAdjustment *= outOfGasAdjustmentFactor
+			utils.LavaFormatInfo("Transaction ran out of gas, retrying with more gas", utils.Attribute{Key: "gasAdjustment", Value: gasAdjustment})
+		case common.TxResultMempoolFull:
+			utils.LavaFormatInfo("Mempool is full, retrying", utils.Attribute{Key: "retryIn", Value: mempoolFullRetryInterval})
+			time.Sleep(mempoolFullRetryInterval)
+		default:
+			// the sequence may have been used or not, query it again before retrying
+			ts.sequence = 0
 		}
 	}
-	if !success {
-		return utils.LavaFormatError(fmt.Sprintf("failed sending transaction %s", summarizedTransactionResult), nil)
+	return utils.LavaFormatError(fmt.Sprintf("failed sending transaction %s", summarizedTransactionResult), nil)
+}
+
+// onSequenceMismatch sets the sequence of the next tx to the one the node expects, or to be queried if it isn't in the error
+func (ts *TxSender) onSequenceMismatch(transactionResult string) {
+	sequenceNumberParsed, err := common.FindSequenceNumber(transactionResult)
+	if err != nil {
+		utils.LavaFormatWarning("Failed findSequenceNumber", err, utils.Attribute{Key: "sequence", Value: transactionResult})
 	}
-	utils.LavaFormatInfo(fmt.Sprintf("succeeded sending transaction %s", summarizedTransactionResult))
-	return nil
+	ts.sequence = uint64(sequenceNumberParsed)
+	utils.LavaFormatInfo("Sequence mismatch, retrying", utils.Attribute{Key: "sequence", Value: ts.sequence})
 }
 
-// this function is extracted from the tx package so that we can use it locally to set the tx factory correctly
+// this function is extracted from the tx package so that we can use it locally to set the tx factory correctly,
+// the account number and sequence are only queried when they aren't tracked, since the chain doesn't count txs still in the mempool
 func (ts *TxSender) prepareFactory(txf tx.Factory) (tx.Factory, error) {
 	clientCtx := ts.clientCtx
 	from := clientCtx.GetFromAddress()
 
-	if err := clientCtx.AccountRetriever.EnsureExists(clientCtx, from); err != nil {
-		return txf, err
-	}
-
-	initNum, initSeq := txf.AccountNumber(), txf.Sequence()
-	if initNum == 0 || initSeq == 0 {
+	if ts.accountNumber == 0 || ts.sequence == 0 {
+		if err := clientCtx.AccountRetriever.EnsureExists(clientCtx, from); err != nil {
+			return txf, err
+		}
 		num, seq, err := clientCtx.AccountRetriever.GetAccountNumberSequence(clientCtx, from)
 		if err != nil {
 			return txf, err
 		}
-
-		if initNum == 0 {
-			txf = txf.WithAccountNumber(num)
-		}
-
-		if initSeq == 0 {
-			txf = txf.WithSequence(seq)
+		ts.accountNumber = num
+		if ts.sequence == 0 {
+			ts.sequence = seq
 		}
 	}
 
-	return txf, nil
+	return txf.WithAccountNumber(ts.accountNumber).WithSequence(ts.sequence), nil
 }
 
 type ConsumerTxSender struct {
@@ -190,7 +194,6 @@ func NewConsumerTxSender(ctx context.Context, clientCtx client.Context, txFactor
 }
 
 func (ts *ConsumerTxSender) TxConflictDetection(ctx context.Context, finalizationConflict *conflicttypes.FinalizationConflict, responseConflict *conflicttypes.ResponseConflict, sameProviderConflict *conflicttypes.FinalizationConflict) error {
-	// TODO: retry logic for sequence number mismatch
 	// TODO: make sure we are not spamming the same conflicts, previous code only detecs relay by relay, it has no state tracking wether it reported already
 	msg := conflicttypes.NewMsgDetection(ts.clientCtx.FromAddress.String(), finalizationConflict, responseConflict, sameProviderConflict)
 	err := ts.SimulateAndBroadCastTxWithRetryOnSeqMismatch(msg, false)
@@ -222,6 +225,33 @@ func (pts *ProviderTxSender) TxRelayPayment(ctx context.Context, relayRequests [
 	return nil
 }
 
+func (pts *ProviderTxSender) TxFreezeProvider(ctx context.Context, chainIDs []string, reason string) error {
+	msg := pairingtypes.NewMsgFreeze(pts.clientCtx.FromAddress.String(), chainIDs, reason, 0, 0)
+	err := pts.SimulateAndBroadCastTxWithRetryOnSeqMismatch(msg, false)
+	if err != nil {
+		return utils.LavaFormatError("freeze_provider - sending Tx Failed", err)
+	}
+	return nil
+}
+
+func (pts *ProviderTxSender) TxUnfreezeProvider(ctx context.Context, chainIDs []string) error {
+	msg := pairingtypes.NewMsgUnfreeze(pts.clientCtx.FromAddress.String(), chainIDs)
+	err := pts.SimulateAndBroadCastTxWithRetryOnSeqMismatch(msg, false)
+	if err != nil {
+		return utils.LavaFormatError("unfreeze_provider - sending Tx Failed", err)
+	}
+	return nil
+}
+
+func (pts *ProviderTxSender) TxAttestEndpointHealth(ctx context.Context, chainIDs []string, protocolVersion uint32, binaryVersion string) error {
+	msg := pairingtypes.NewMsgAttestEndpointHealth(pts.clientCtx.FromAddress.String(), chainIDs, protocolVersion, binaryVersion)
+	err := pts.SimulateAndBroadCastTxWithRetryOnSeqMismatch(msg, false)
+	if err != nil {
+		return utils.LavaFormatError("attest_endpoint_health - sending Tx Failed", err)
+	}
+	return nil
+}
+
 func (pts *ProviderTxSender) SendVoteReveal(voteID string, vote *reliabilitymanager.VoteData) error {
 	msg := conflicttypes.NewMsgConflictVoteReveal(pts.clientCtx.FromAddress.String(), voteID, vote.Nonce, vote.RelayDataHash)
 	err := pts.SimulateAndBroadCastTxWithRetryOnSeqMismatch(msg, false)
@@ -0,0 +1,76 @@
+package tracing
+
+import (
+	"context"
+
+	"github.com/lavanet/lava/utils"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// InjectGRPCMetadata adds the span context of ctx to the outgoing grpc metadata, so the other side continues the trace
+func InjectGRPCMetadata(ctx context.Context) context.Context {
+	sc, ok := SpanContextFromContext(ctx)
+	if !ok {
+		return ctx
+	}
+	return metadata.AppendToOutgoingContext(ctx, TraceParentHeader, sc.TraceParent())
+}
+
+// ExtractGRPCMetadata returns a context whose spans continue the trace in the incoming grpc metadata, if there is one
+func ExtractGRPCMetadata(ctx context.Context) context.Context {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ctx
+	}
+	values := md.Get(TraceParentHeader)
+	if len(values) == 0 {
+		return ctx
+	}
+	sc, ok := ParseTraceParent(values[0])
+	if !ok {
+		return ctx
+	}
+	return ContextWithSpanContext(ctx, sc)
+}
+
+// GRPCDialOptions propagate the trace of the calls made on the connection
+func GRPCDialOptions() []grpc.DialOption {
+	return []grpc.DialOption{
+		grpc.WithUnaryInterceptor(func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+			return invoker(InjectGRPCMetadata(ctx), method, req, reply, cc, opts...)
+		}),
+		grpc.WithStreamInterceptor(func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+			return streamer(InjectGRPCMetadata(ctx), desc, cc, method, opts...)
+		}),
+	}
+}
+
+// GRPCServerOptions continue the callers' traces with a server span for every call
+func GRPCServerOptions() []grpc.ServerOption {
+	return []grpc.ServerOption{
+		grpc.UnaryInterceptor(func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+			ctx, span := StartSpanWithKind(ExtractGRPCMetadata(ctx), info.FullMethod, SpanKindServer, utils.Attribute{Key: "rpc.system", Value: "grpc"})
+			defer span.End()
+			resp, err := handler(ctx, req)
+			span.RecordError(err)
+			return resp, err
+		}),
+		grpc.StreamInterceptor(func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+			ctx, span := StartSpanWithKind(ExtractGRPCMetadata(stream.Context()), info.FullMethod, SpanKindServer, utils.Attribute{Key: "rpc.system", Value: "grpc"})
+			defer span.End()
+			err := handler(srv, &tracedServerStream{ServerStream: stream, ctx: ctx})
+			span.RecordError(err)
+			return err
+		}),
+	}
+}
+
+type tracedServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (tss *tracedServerStream) Context() context.Context {
+	return tss.ctx
+}
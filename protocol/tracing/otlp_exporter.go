@@ -0,0 +1,256 @@
+package tracing
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/lavanet/lava/utils"
+)
+
+const (
+	OTLPQueueSize       = 2048 // ended spans waiting for export, new spans are dropped when it's full
+	OTLPMaxBatchSize    = 512
+	OTLPExportInterval  = 5 * time.Second
+	otlpExportTimeout   = 10 * time.Second
+	otlpTracesPath      = "/v1/traces"
+	otlpScopeName       = "github.com/lavanet/lava/protocol/tracing"
+	otlpStatusCodeError = 2
+)
+
+// otlpHttpExporter batches the ended spans and posts them to an OpenTelemetry collector with the OTLP/HTTP json encoding
+type otlpHttpExporter struct {
+	tracesUrl   string
+	serviceName string
+	queue       chan *Span
+	done        chan struct{}
+	stopOnce    sync.Once
+	stopped     chan struct{}
+}
+
+// NewOTLPHttpExporter exports to the collector at endpoint, such as http://localhost:4318
+func NewOTLPHttpExporter(endpoint string, serviceName string) SpanExporter {
+	exporter := &otlpHttpExporter{
+		tracesUrl:   strings.TrimSuffix(endpoint, "/") + otlpTracesPath,
+		serviceName: serviceName,
+		queue:       make(chan *Span, OTLPQueueSize),
+		done:        make(chan struct{}),
+		stopped:     make(chan struct{}),
+	}
+	go exporter.run()
+	return exporter
+}
+
+func (oe *otlpHttpExporter) ExportSpan(span *Span) {
+	select {
+	case oe.queue <- span:
+	default:
+		utils.LavaFormatRepeatedError("tracing export queue is full, dropping span", nil, utils.Attribute{Key: "span", Value: span.name})
+	}
+}
+
+func (oe *otlpHttpExporter) run() {
+	defer close(oe.stopped)
+	ticker := time.NewTicker(OTLPExportInterval)
+	defer ticker.Stop()
+	batch := make([]*Span, 0, OTLPMaxBatchSize)
+	flush := func() {
+		if len(batch) == 0 {
+			return
+		}
+		if err := oe.send(batch); err != nil {
+			utils.LavaFormatWarning("failed exporting traces", err, utils.Attribute{Key: "url", Value: oe.tracesUrl}, utils.Attribute{Key: "spans", Value: len(batch)})
+		}
+		batch = make([]*Span, 0, OTLPMaxBatchSize)
+	}
+	for {
+		select {
+		case span := <-oe.queue:
+			batch = append(batch, span)
+			if len(batch) >= OTLPMaxBatchSize {
+				flush()
+			}
+		case <-ticker.C:
+			flush()
+		case <-oe.done:
+			// export what already ended before stopping
+			for {
+				select {
+				case span := <-oe.queue:
+					batch = append(batch, span)
+					if len(batch) >= OTLPMaxBatchSize {
+						flush()
+					}
+				default:
+					flush()
+					return
+				}
+			}
+		}
+	}
+}
+
+// Shutdown exports the queued spans and stops the exporter
+func (oe *otlpHttpExporter) Shutdown(ctx context.Context) error {
+	oe.stopOnce.Do(func() { close(oe.done) })
+	select {
+	case <-oe.stopped:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (oe *otlpHttpExporter) send(spans []*Span) error {
+	body, err := json.Marshal(encodeOTLPSpans(oe.serviceName, spans))
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), otlpExportTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, oe.tracesUrl, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("collector returned status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// the OTLP json encoding of ExportTraceServiceRequest, ids are hex and 64 bit integers are strings
+type otlpTracesRequest struct {
+	ResourceSpans []otlpResourceSpans `json:"resourceSpans"`
+}
+
+type otlpResourceSpans struct {
+	Resource   otlpResource     `json:"resource"`
+	ScopeSpans []otlpScopeSpans `json:"scopeSpans"`
+}
+
+type otlpResource struct {
+	Attributes []otlpAttribute `json:"attributes"`
+}
+
+type otlpScopeSpans struct {
+	Scope otlpScope  `json:"scope"`
+	Spans []otlpSpan `json:"spans"`
+}
+
+type otlpScope struct {
+	Name string `json:"name"`
+}
+
+type otlpSpan struct {
+	TraceID           string          `json:"traceId"`
+	SpanID            string          `json:"spanId"`
+	ParentSpanID      string          `json:"parentSpanId,omitempty"`
+	Name              string          `json:"name"`
+	Kind              SpanKind        `json:"kind"`
+	StartTimeUnixNano string          `json:"startTimeUnixNano"`
+	EndTimeUnixNano   string          `json:"endTimeUnixNano"`
+	Attributes        []otlpAttribute `json:"attributes,omitempty"`
+	Status            *otlpStatus     `json:"status,omitempty"`
+}
+
+type otlpStatus struct {
+	Code    int    `json:"code"`
+	Message string `json:"message,omitempty"`
+}
+
+type otlpAttribute struct {
+	Key   string       `json:"key"`
+	Value otlpAnyValue `json:"value"`
+}
+
+type otlpAnyValue struct {
+	StringValue *string  `json:"stringValue,omitempty"`
+	BoolValue   *bool    `json:"boolValue,omitempty"`
+	IntValue    *string  `json:"intValue,omitempty"`
+	DoubleValue *float64 `json:"doubleValue,omitempty"`
+}
+
+func encodeOTLPSpans(serviceName string, spans []*Span) otlpTracesRequest {
+	encoded := make([]otlpSpan, 0, len(spans))
+	for _, span := range spans {
+		encoded = append(encoded, encodeOTLPSpan(span))
+	}
+	return otlpTracesRequest{ResourceSpans: []otlpResourceSpans{{
+		Resource:   otlpResource{Attributes: []otlpAttribute{encodeOTLPAttribute(utils.Attribute{Key: "service.name", Value: serviceName})}},
+		ScopeSpans: []otlpScopeSpans{{Scope: otlpScope{Name: otlpScopeName}, Spans: encoded}},
+	}}}
+}
+
+func encodeOTLPSpan(span *Span) otlpSpan {
+	span.lock.Lock()
+	defer span.lock.Unlock()
+	encoded := otlpSpan{
+		TraceID:           span.spanContext.TraceID.String(),
+		SpanID:            span.spanContext.SpanID.String(),
+		Name:              span.name,
+		Kind:              span.kind,
+		StartTimeUnixNano: strconv.FormatInt(span.startTime.UnixNano(), 10),
+		EndTimeUnixNano:   strconv.FormatInt(span.endTime.UnixNano(), 10),
+	}
+	if span.parentSpanID != (SpanID{}) {
+		encoded.ParentSpanID = span.parentSpanID.String()
+	}
+	for _, attribute := range span.attributes {
+		encoded.Attributes = append(encoded.Attributes, encodeOTLPAttribute(attribute))
+	}
+	if span.err != nil {
+		encoded.Status = &otlpStatus{Code: otlpStatusCodeError, Message: span.err.Error()}
+	}
+	return encoded
+}
+
+func encodeOTLPAttribute(attribute utils.Attribute) otlpAttribute {
+	value := otlpAnyValue{}
+	switch typed := attribute.Value.(type) {
+	case bool:
+		value.BoolValue = &typed
+	case int, int32, int64, uint, uint32, uint64:
+		intValue := fmt.Sprint(typed)
+		value.IntValue = &intValue
+	case float64:
+		value.DoubleValue = &typed
+	case float32:
+		doubleValue := float64(typed)
+		value.DoubleValue = &doubleValue
+	default:
+		stringValue := fmt.Sprint(typed)
+		value.StringValue = &stringValue
+	}
+	return otlpAttribute{Key: attribute.Key, Value: value}
+}
+
+// StartOTLPTracer traces the service's relays to the collector at otlpEndpoint, it's a noop when otlpEndpoint is empty.
+// the returned function exports the spans that already ended
+func StartOTLPTracer(otlpEndpoint string, serviceName string, sampleRatio float64) (shutdown func()) {
+	if otlpEndpoint == "" {
+		return func() {}
+	}
+	tracer := NewTracer(serviceName, sampleRatio, NewOTLPHttpExporter(otlpEndpoint, serviceName))
+	SetTracer(tracer)
+	utils.LavaFormatInfo("exporting relay traces", utils.Attribute{Key: "endpoint", Value: otlpEndpoint}, utils.Attribute{Key: "service", Value: serviceName}, utils.Attribute{Key: "sampleRatio", Value: sampleRatio})
+	return func() {
+		SetTracer(nil)
+		ctx, cancel := context.WithTimeout(context.Background(), otlpExportTimeout)
+		defer cancel()
+		if err := tracer.Shutdown(ctx); err != nil {
+			utils.LavaFormatWarning("failed exporting the last traces", err)
+		}
+	}
+}
@@ -0,0 +1,266 @@
+package tracing
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/lavanet/lava/utils"
+)
+
+const (
+	OTLPEndpointFlagName = "tracing-otlp-endpoint"
+	SampleRatioFlagName  = "tracing-sample-ratio"
+	// TraceParentHeader is the w3c trace context header, spans started from it continue the trace of the other side
+	TraceParentHeader  = "traceparent"
+	traceParentVersion = "00"
+	traceFlagSampled   = 0x01
+)
+
+type SpanKind int
+
+// the OTLP span kinds
+const (
+	SpanKindInternal SpanKind = 1
+	SpanKindServer   SpanKind = 2
+	SpanKindClient   SpanKind = 3
+)
+
+type (
+	TraceID [16]byte
+	SpanID  [8]byte
+)
+
+func (tid TraceID) String() string {
+	return hex.EncodeToString(tid[:])
+}
+
+func (sid SpanID) String() string {
+	return hex.EncodeToString(sid[:])
+}
+
+// SpanContext identifies a span across processes
+type SpanContext struct {
+	TraceID TraceID
+	SpanID  SpanID
+	Sampled bool
+}
+
+func (sc SpanContext) IsValid() bool {
+	return sc.TraceID != (TraceID{}) && sc.SpanID != (SpanID{})
+}
+
+// TraceParent encodes the span context as a w3c traceparent header value
+func (sc SpanContext) TraceParent() string {
+	flags := 0
+	if sc.Sampled {
+		flags = traceFlagSampled
+	}
+	return fmt.Sprintf("%s-%s-%s-%02x", traceParentVersion, sc.TraceID, sc.SpanID, flags)
+}
+
+// ParseTraceParent decodes a w3c traceparent header value, ok is false when it's malformed
+func ParseTraceParent(value string) (sc SpanContext, ok bool) {
+	parts := strings.Split(strings.TrimSpace(value), "-")
+	if len(parts) < 4 || len(parts[0]) != 2 || parts[0] == "ff" || len(parts[1]) != 32 || len(parts[2]) != 16 || len(parts[3]) != 2 {
+		return SpanContext{}, false
+	}
+	if parts[0] == traceParentVersion && len(parts) != 4 {
+		return SpanContext{}, false
+	}
+	if _, err := hex.Decode(sc.TraceID[:], []byte(parts[1])); err != nil {
+		return SpanContext{}, false
+	}
+	if _, err := hex.Decode(sc.SpanID[:], []byte(parts[2])); err != nil {
+		return SpanContext{}, false
+	}
+	flags, err := hex.DecodeString(parts[3])
+	if err != nil {
+		return SpanContext{}, false
+	}
+	sc.Sampled = flags[0]&traceFlagSampled != 0
+	return sc, sc.IsValid()
+}
+
+// SpanExporter receives the ended sampled spans, it must not block
+type SpanExporter interface {
+	ExportSpan(span *Span)
+	Shutdown(ctx context.Context) error
+}
+
+// Tracer starts the spans of a service, a sampled trace is sampled on every span so it stays whole
+type Tracer struct {
+	serviceName string
+	sampleRatio float64
+	exporter    SpanExporter
+}
+
+func NewTracer(serviceName string, sampleRatio float64, exporter SpanExporter) *Tracer {
+	return &Tracer{serviceName: serviceName, sampleRatio: sampleRatio, exporter: exporter}
+}
+
+func (t *Tracer) ServiceName() string {
+	return t.serviceName
+}
+
+func (t *Tracer) shouldSample(traceID TraceID) bool {
+	if t.sampleRatio >= 1 {
+		return true
+	}
+	if t.sampleRatio <= 0 {
+		return false
+	}
+	// the trace id is random, deciding by it samples the same traces on every service using the same ratio
+	bound := uint64(t.sampleRatio * (1 << 63))
+	return binary.BigEndian.Uint64(traceID[8:])>>1 < bound
+}
+
+func (t *Tracer) Shutdown(ctx context.Context) error {
+	return t.exporter.Shutdown(ctx)
+}
+
+// tracerHolder keeps the type stored in the atomic value the same when the tracer is cleared
+type tracerHolder struct {
+	tracer *Tracer
+}
+
+var globalTracer atomic.Value
+
+// SetTracer sets the tracer spans are started with, nil disables tracing
+func SetTracer(tracer *Tracer) {
+	globalTracer.Store(tracerHolder{tracer: tracer})
+}
+
+func getTracer() *Tracer {
+	holder, ok := globalTracer.Load().(tracerHolder)
+	if !ok {
+		return nil
+	}
+	return holder.tracer
+}
+
+// Span is a timed operation of a trace. all methods can be called on a nil span, which is what StartSpan returns when tracing
+// is disabled or the trace isn't sampled
+type Span struct {
+	tracer       *Tracer
+	name         string
+	kind         SpanKind
+	spanContext  SpanContext
+	parentSpanID SpanID
+	startTime    time.Time
+	lock         sync.Mutex
+	endTime      time.Time
+	attributes   []utils.Attribute
+	err          error
+	ended        bool
+}
+
+func (s *Span) SpanContext() SpanContext {
+	if s == nil {
+		return SpanContext{}
+	}
+	return s.spanContext
+}
+
+func (s *Span) SetAttributes(attributes ...utils.Attribute) {
+	if s == nil {
+		return
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.attributes = append(s.attributes, attributes...)
+}
+
+// RecordError marks the span as failed, a nil error is ignored
+func (s *Span) RecordError(err error) {
+	if s == nil || err == nil {
+		return
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.err = err
+}
+
+// End ends the span and hands it to the exporter, calls after the first are ignored
+func (s *Span) End() {
+	if s == nil {
+		return
+	}
+	s.lock.Lock()
+	if s.ended {
+		s.lock.Unlock()
+		return
+	}
+	s.ended = true
+	s.endTime = time.Now()
+	s.lock.Unlock()
+	s.tracer.exporter.ExportSpan(s)
+}
+
+type spanContextKey struct{}
+
+// ContextWithSpanContext returns a context whose next span is a child of the span context, used to continue a trace
+// on a new context or from a remote parent
+func ContextWithSpanContext(ctx context.Context, sc SpanContext) context.Context {
+	if !sc.IsValid() {
+		return ctx
+	}
+	return context.WithValue(ctx, spanContextKey{}, sc)
+}
+
+// SpanContextFromContext returns the span context of the latest span started on the context
+func SpanContextFromContext(ctx context.Context) (SpanContext, bool) {
+	sc, ok := ctx.Value(spanContextKey{}).(SpanContext)
+	return sc, ok
+}
+
+// StartSpan starts a span as the child of the span on the context, or as a new trace when there is none
+func StartSpan(ctx context.Context, name string, attributes ...utils.Attribute) (context.Context, *Span) {
+	return StartSpanWithKind(ctx, name, SpanKindInternal, attributes...)
+}
+
+func StartSpanWithKind(ctx context.Context, name string, kind SpanKind, attributes ...utils.Attribute) (context.Context, *Span) {
+	tracer := getTracer()
+	if tracer == nil {
+		return ctx, nil
+	}
+	parent, hasParent := SpanContextFromContext(ctx)
+	sc := SpanContext{SpanID: newSpanID()}
+	if hasParent {
+		sc.TraceID = parent.TraceID
+		sc.Sampled = parent.Sampled
+	} else {
+		sc.TraceID = newTraceID()
+		sc.Sampled = tracer.shouldSample(sc.TraceID)
+	}
+	// the span context is kept on unsampled traces too, so the decision reaches the provider
+	ctx = ContextWithSpanContext(ctx, sc)
+	if !sc.Sampled {
+		return ctx, nil
+	}
+	span := &Span{tracer: tracer, name: name, kind: kind, spanContext: sc, startTime: time.Now(), attributes: attributes}
+	if hasParent {
+		span.parentSpanID = parent.SpanID
+	}
+	return ctx, span
+}
+
+func newTraceID() (traceID TraceID) {
+	for traceID == (TraceID{}) {
+		rand.Read(traceID[:])
+	}
+	return traceID
+}
+
+func newSpanID() (spanID SpanID) {
+	for spanID == (SpanID{}) {
+		rand.Read(spanID[:])
+	}
+	return spanID
+}
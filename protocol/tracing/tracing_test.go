@@ -0,0 +1,139 @@
+package tracing
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/lavanet/lava/utils"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+)
+
+type mockSpanExporter struct {
+	lock  sync.Mutex
+	spans []*Span
+}
+
+func (m *mockSpanExporter) ExportSpan(span *Span) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.spans = append(m.spans, span)
+}
+
+func (m *mockSpanExporter) Shutdown(ctx context.Context) error {
+	return nil
+}
+
+func TestTraceParent(t *testing.T) {
+	sc := SpanContext{TraceID: newTraceID(), SpanID: newSpanID(), Sampled: true}
+	parsed, ok := ParseTraceParent(sc.TraceParent())
+	require.True(t, ok)
+	require.Equal(t, sc, parsed)
+
+	parsed, ok = ParseTraceParent("00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-00")
+	require.True(t, ok)
+	require.False(t, parsed.Sampled)
+	require.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", parsed.TraceID.String())
+
+	for _, invalid := range []string{
+		"",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7",
+		"00-00000000000000000000000000000000-00f067aa0ba902b7-01",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-0000000000000000-01",
+		"00-4bf92f3577b34da6a3ce929d0e0e473z-00f067aa0ba902b7-01",
+		"ff-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01-extra",
+	} {
+		_, ok := ParseTraceParent(invalid)
+		require.False(t, ok, invalid)
+	}
+}
+
+func TestSpans(t *testing.T) {
+	defer SetTracer(nil)
+	// without a tracer spans are nil and safe to use
+	SetTracer(nil)
+	ctx, span := StartSpan(context.Background(), "disabled")
+	require.Nil(t, span)
+	span.SetAttributes(utils.Attribute{Key: "a", Value: 1})
+	span.RecordError(fmt.Errorf("failed"))
+	span.End()
+	_, traced := SpanContextFromContext(ctx)
+	require.False(t, traced)
+
+	exporter := &mockSpanExporter{}
+	SetTracer(NewTracer("test", 1, exporter))
+	ctx, parent := StartSpan(context.Background(), "parent")
+	childCtx, child := StartSpanWithKind(ctx, "child", SpanKindClient)
+	child.RecordError(fmt.Errorf("failed"))
+	child.End()
+	child.End()
+	parent.End()
+	require.Len(t, exporter.spans, 2)
+	require.Equal(t, parent.SpanContext().TraceID, child.SpanContext().TraceID)
+	require.Equal(t, parent.SpanContext().SpanID, child.parentSpanID)
+
+	// the child's span context is sent to the provider, which continues the trace
+	outgoing, ok := metadata.FromOutgoingContext(InjectGRPCMetadata(childCtx))
+	require.True(t, ok)
+	incomingCtx := ExtractGRPCMetadata(metadata.NewIncomingContext(context.Background(), outgoing))
+	_, remote := StartSpanWithKind(incomingCtx, "server", SpanKindServer)
+	require.Equal(t, child.SpanContext().TraceID, remote.SpanContext().TraceID)
+	require.Equal(t, child.SpanContext().SpanID, remote.parentSpanID)
+
+	// unsampled traces keep their span context so the provider doesn't sample them either
+	SetTracer(NewTracer("test", 0, exporter))
+	ctx, unsampled := StartSpan(context.Background(), "unsampled")
+	require.Nil(t, unsampled)
+	sc, traced := SpanContextFromContext(ctx)
+	require.True(t, traced)
+	require.False(t, sc.Sampled)
+	SetTracer(NewTracer("test", 1, exporter))
+	_, remote = StartSpan(ExtractGRPCMetadata(metadata.NewIncomingContext(context.Background(), metadata.Pairs(TraceParentHeader, sc.TraceParent()))), "server")
+	require.Nil(t, remote)
+}
+
+func TestOTLPHttpExporter(t *testing.T) {
+	received := make(chan otlpTracesRequest, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, otlpTracesPath, r.URL.Path)
+		var request otlpTracesRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+		received <- request
+	}))
+	defer server.Close()
+
+	exporter := NewOTLPHttpExporter(server.URL+"/", "lava-test")
+	tracer := NewTracer("lava-test", 1, exporter)
+	SetTracer(tracer)
+	defer SetTracer(nil)
+	ctx, parent := StartSpan(context.Background(), "parent", utils.Attribute{Key: "chainID", Value: "LAV1"})
+	_, child := StartSpan(ctx, "child", utils.Attribute{Key: "epoch", Value: uint64(20)}, utils.Attribute{Key: "hit", Value: true})
+	child.RecordError(fmt.Errorf("failed"))
+	child.End()
+	parent.End()
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	require.NoError(t, tracer.Shutdown(shutdownCtx))
+
+	request := <-received
+	require.Len(t, request.ResourceSpans, 1)
+	require.Equal(t, "service.name", request.ResourceSpans[0].Resource.Attributes[0].Key)
+	require.Equal(t, "lava-test", *request.ResourceSpans[0].Resource.Attributes[0].Value.StringValue)
+	spans := request.ResourceSpans[0].ScopeSpans[0].Spans
+	require.Len(t, spans, 2)
+	require.Equal(t, "child", spans[0].Name)
+	require.Equal(t, parent.SpanContext().SpanID.String(), spans[0].ParentSpanID)
+	require.Equal(t, "20", *spans[0].Attributes[0].Value.IntValue)
+	require.True(t, *spans[0].Attributes[1].Value.BoolValue)
+	require.Equal(t, otlpStatusCodeError, spans[0].Status.Code)
+	require.Equal(t, "parent", spans[1].Name)
+	require.Empty(t, spans[1].ParentSpanID)
+	require.Nil(t, spans[1].Status)
+}
@@ -46,17 +46,18 @@ const (
 const BLOCK_HEADER_LEN = 32
 
 type Keepers struct {
-	Epochstorage  epochstoragekeeper.Keeper
-	Spec          speckeeper.Keeper
-	Plans         planskeeper.Keeper
-	Projects      projectskeeper.Keeper
-	Subscription  subscriptionkeeper.Keeper
-	Pairing       pairingkeeper.Keeper
-	Conflict      conflictkeeper.Keeper
-	BankKeeper    mockBankKeeper
-	AccountKeeper mockAccountKeeper
-	ParamsKeeper  paramskeeper.Keeper
-	BlockStore    MockBlockStore
+	Epochstorage       epochstoragekeeper.Keeper
+	Spec               speckeeper.Keeper
+	Plans              planskeeper.Keeper
+	Projects           projectskeeper.Keeper
+	Subscription       subscriptionkeeper.Keeper
+	Pairing            pairingkeeper.Keeper
+	Conflict           conflictkeeper.Keeper
+	BankKeeper         mockBankKeeper
+	AccountKeeper      mockAccountKeeper
+	DistributionKeeper mockDistributionKeeper
+	ParamsKeeper       paramskeeper.Keeper
+	BlockStore         MockBlockStore
 }
 
 type Servers struct {
@@ -112,6 +113,8 @@ func InitAllKeepers(t testing.TB) (*Servers, *Keepers, context.Context) {
 	projectsMemStoreKey := storetypes.NewMemoryStoreKey(projectstypes.MemStoreKey)
 	stateStore.MountStoreWithDB(projectsStoreKey, sdk.StoreTypeIAVL, db)
 	stateStore.MountStoreWithDB(projectsMemStoreKey, sdk.StoreTypeMemory, nil)
+	projectsTStoreKey := sdk.NewTransientStoreKey(projectstypes.TStoreKey)
+	stateStore.MountStoreWithDB(projectsTStoreKey, sdk.StoreTypeTransient, nil)
 
 	subscriptionStoreKey := sdk.NewKVStoreKey(subscriptiontypes.StoreKey)
 	subscriptionMemStoreKey := storetypes.NewMemoryStoreKey(subscriptiontypes.MemStoreKey)
@@ -139,6 +142,9 @@ func InitAllKeepers(t testing.TB) (*Servers, *Keepers, context.Context) {
 	paramsKeeper.Subspace(spectypes.ModuleName)
 	paramsKeeper.Subspace(epochstoragetypes.ModuleName)
 	paramsKeeper.Subspace(pairingtypes.ModuleName)
+	paramsKeeper.Subspace(projectstypes.ModuleName)
+	paramsKeeper.Subspace(planstypes.ModuleName)
+	paramsKeeper.Subspace(subscriptiontypes.ModuleName)
 	// paramsKeeper.Subspace(conflicttypes.ModuleName) //TODO...
 
 	epochparamsSubspace, _ := paramsKeeper.GetSubspace(epochstoragetypes.ModuleName)
@@ -163,14 +169,16 @@ func InitAllKeepers(t testing.TB) (*Servers, *Keepers, context.Context) {
 	ks := Keepers{}
 	ks.AccountKeeper = mockAccountKeeper{}
 	ks.BankKeeper = mockBankKeeper{balance: make(map[string]sdk.Coins)}
+	ks.DistributionKeeper = mockDistributionKeeper{bankKeeper: &ks.BankKeeper, communityPool: sdk.NewCoins()}
 	ks.Spec = *speckeeper.NewKeeper(cdc, specStoreKey, specMemStoreKey, specparamsSubspace)
 	ks.Epochstorage = *epochstoragekeeper.NewKeeper(cdc, epochStoreKey, epochMemStoreKey, epochparamsSubspace, &ks.BankKeeper, &ks.AccountKeeper, ks.Spec)
 	ks.Plans = *planskeeper.NewKeeper(cdc, plansStoreKey, plansMemStoreKey, plansparamsSubspace)
-	ks.Projects = *projectskeeper.NewKeeper(cdc, projectsStoreKey, projectsMemStoreKey, projectsparamsSubspace, ks.Epochstorage)
-	ks.Subscription = *subscriptionkeeper.NewKeeper(cdc, subscriptionStoreKey, subscriptionMemStoreKey, subscriptionparamsSubspace, &ks.BankKeeper, &ks.AccountKeeper, &ks.Epochstorage, ks.Projects, ks.Plans)
+	ks.Projects = *projectskeeper.NewKeeper(cdc, projectsStoreKey, projectsMemStoreKey, projectsTStoreKey, projectsparamsSubspace, ks.Epochstorage)
+	ks.Subscription = *subscriptionkeeper.NewKeeper(cdc, subscriptionStoreKey, subscriptionMemStoreKey, subscriptionparamsSubspace, &ks.BankKeeper, &ks.AccountKeeper, &ks.Epochstorage, &ks.Projects, ks.Plans)
+	ks.Projects.SetHooks(ks.Subscription.Hooks())
 	ks.Pairing = *pairingkeeper.NewKeeper(cdc, pairingStoreKey, pairingMemStoreKey, pairingparamsSubspace, &ks.BankKeeper, &ks.AccountKeeper, ks.Spec, &ks.Epochstorage, ks.Projects, ks.Subscription)
 	ks.ParamsKeeper = paramsKeeper
-	ks.Conflict = *conflictkeeper.NewKeeper(cdc, conflictStoreKey, conflictMemStoreKey, conflictparamsSubspace, &ks.BankKeeper, &ks.AccountKeeper, ks.Pairing, ks.Epochstorage, ks.Spec)
+	ks.Conflict = *conflictkeeper.NewKeeper(cdc, conflictStoreKey, conflictMemStoreKey, conflictparamsSubspace, &ks.BankKeeper, &ks.AccountKeeper, ks.Pairing, ks.Epochstorage, ks.Spec, &ks.DistributionKeeper)
 	ks.BlockStore = MockBlockStore{height: 0, blockHistory: make(map[int64]*tenderminttypes.Block)}
 
 	ctx := sdk.NewContext(stateStore, tmproto.Header{}, false, log.TestingLogger())
@@ -111,6 +111,25 @@ func (k *mockBankKeeper) SubFromBalance(addr sdk.AccAddress, amounts sdk.Coins)
 	return nil
 }
 
+// mock distribution keeper
+type mockDistributionKeeper struct {
+	bankKeeper    *mockBankKeeper
+	communityPool sdk.Coins
+}
+
+func (k *mockDistributionKeeper) FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error {
+	if !k.bankKeeper.balance[sender.String()].IsAllGTE(amount) {
+		return fmt.Errorf("not enough coins")
+	}
+	k.bankKeeper.SubFromBalance(sender, amount)
+	k.communityPool = k.communityPool.Add(amount...)
+	return nil
+}
+
+func (k *mockDistributionKeeper) GetCommunityPool() sdk.Coins {
+	return k.communityPool
+}
+
 type MockBlockStore struct {
 	height       int64
 	blockHistory map[int64]*tenderminttypes.Block
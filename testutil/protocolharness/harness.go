@@ -0,0 +1,221 @@
+package protocolharness
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/protocol/chainlib"
+	"github.com/lavanet/lava/protocol/lavaprotocol"
+	"github.com/lavanet/lava/protocol/lavasession"
+	"github.com/lavanet/lava/protocol/provideroptimizer"
+	"github.com/lavanet/lava/protocol/rpcconsumer"
+	"github.com/lavanet/lava/utils"
+	"github.com/lavanet/lava/utils/sigs"
+	conflicttypes "github.com/lavanet/lava/x/conflict/types"
+	epochstoragetypes "github.com/lavanet/lava/x/epochstorage/types"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	spectypes "github.com/lavanet/lava/x/spec/types"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	HarnessChainID     = "MOCKJSONRPC"
+	HarnessLavaChainID = "lava"
+	HarnessEpoch       = uint64(20)
+	providerMaxCU      = 100000
+)
+
+// HarnessConfig describes the providers paired with the consumer and how the consumer relays to them
+type HarnessConfig struct {
+	Providers          []MockProviderConfig
+	Spec               *spectypes.Spec // MockJsonRpcSpec(true) when nil
+	RequiredResponses  int             // 1 when 0
+	MaxRelayRetries    int             // rpcconsumer.MaxRelayRetries when 0
+	GasOracleProviders int             // the fee estimates aren't aggregated when 0
+}
+
+// Harness runs a consumer server paired with in-process mock providers, relays go through the real session manager,
+// reply verification, data reliability and conflict detection without a lava chain
+type Harness struct {
+	Providers              []*MockProvider
+	ConsumerAddress        sdk.AccAddress
+	ConsumerServer         *rpcconsumer.RPCConsumerServer
+	ConsumerSessionManager *lavasession.ConsumerSessionManager
+	Spec                   spectypes.Spec
+	stateTracker           *mockConsumerStateTracker
+}
+
+// NewHarness starts the mock providers and the consumer, everything is stopped when the test ends
+func NewHarness(t *testing.T, config HarnessConfig) *Harness {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	spec := MockJsonRpcSpec(true)
+	if config.Spec != nil {
+		spec = *config.Spec
+	}
+	h := &Harness{Spec: spec, stateTracker: &mockConsumerStateTracker{}}
+
+	pairingList := map[uint64]*lavasession.ConsumerSessionsWithProvider{}
+	for idx, providerConfig := range config.Providers {
+		provider, err := NewMockProvider(providerConfig, spec.BlockDistanceForFinalizedData, spec.BlocksInFinalizationProof)
+		require.NoError(t, err)
+		t.Cleanup(provider.Stop)
+		h.Providers = append(h.Providers, provider)
+		pairingList[uint64(idx)] = &lavasession.ConsumerSessionsWithProvider{
+			PublicLavaAddress: provider.Address.String(),
+			Endpoints:         []*lavasession.Endpoint{{NetworkAddress: provider.NetworkAddress, Enabled: true}},
+			Sessions:          map[int64]*lavasession.SingleConsumerSession{},
+			MaxComputeUnits:   providerMaxCU,
+			PairingEpoch:      HarnessEpoch,
+		}
+	}
+
+	rpcEndpoint := &lavasession.RPCEndpoint{NetworkAddress: "127.0.0.1:0", ChainID: spec.Index, ApiInterface: spectypes.APIInterfaceJsonRPC}
+	h.ConsumerSessionManager = lavasession.NewConsumerSessionManager(rpcEndpoint, provideroptimizer.NewProviderOptimizer(provideroptimizer.STRATEGY_QOS))
+	require.NoError(t, h.ConsumerSessionManager.UpdateAllProviders(HarnessEpoch, pairingList))
+
+	chainParser, err := chainlib.NewChainParser(spectypes.APIInterfaceJsonRPC)
+	require.NoError(t, err)
+	chainParser.SetSpec(spec)
+	finalizationConsensus := &lavaprotocol.FinalizationConsensus{}
+	finalizationConsensus.NewEpoch(HarnessEpoch)
+
+	consumerKey, consumerAddress := sigs.GenerateFloatingKey()
+	h.ConsumerAddress = consumerAddress
+	vrfSk, _, err := utils.GeneratePrivateVRFKey()
+	require.NoError(t, err)
+	requiredResponses := config.RequiredResponses
+	if requiredResponses == 0 {
+		requiredResponses = 1
+	}
+	h.ConsumerServer = &rpcconsumer.RPCConsumerServer{}
+	err = h.ConsumerServer.ServeRPCRequests(ctx, rpcEndpoint, h.stateTracker, chainParser, finalizationConsensus, h.ConsumerSessionManager, requiredResponses, lavaprotocol.NewLocalSigner(consumerKey), vrfSk, HarnessLavaChainID, false, nil, nil)
+	require.NoError(t, err)
+	maxRelayRetries := config.MaxRelayRetries
+	if maxRelayRetries == 0 {
+		maxRelayRetries = rpcconsumer.MaxRelayRetries
+	}
+	h.ConsumerServer.UpdateSettings(nil, maxRelayRetries, 0, config.GasOracleProviders)
+	return h
+}
+
+// SendRelay sends a json rpc request through the consumer as a user of the consumer's endpoint would
+func (h *Harness) SendRelay(ctx context.Context, data string) (*pairingtypes.RelayReply, error) {
+	reply, _, err := h.ConsumerServer.SendRelay(ctx, "", data, http.MethodPost, "", nil)
+	return reply, err
+}
+
+// FinalizationConflicts returns the finalization conflicts the consumer sent detection txs for
+func (h *Harness) FinalizationConflicts() []*conflicttypes.FinalizationConflict {
+	return h.stateTracker.conflicts(func(c detectedConflict) *conflicttypes.FinalizationConflict { return c.finalizationConflict })
+}
+
+// SameProviderConflicts returns the conflicts of providers signing different hashes for a block the consumer sent detection txs for
+func (h *Harness) SameProviderConflicts() []*conflicttypes.FinalizationConflict {
+	return h.stateTracker.conflicts(func(c detectedConflict) *conflicttypes.FinalizationConflict { return c.sameProviderConflict })
+}
+
+// ResponseConflicts returns the data reliability response conflicts the consumer sent detection txs for
+func (h *Harness) ResponseConflicts() []*conflicttypes.ResponseConflict {
+	h.stateTracker.lock.Lock()
+	defer h.stateTracker.lock.Unlock()
+	responseConflicts := []*conflicttypes.ResponseConflict{}
+	for _, detected := range h.stateTracker.detected {
+		if detected.responseConflict != nil {
+			responseConflicts = append(responseConflicts, detected.responseConflict)
+		}
+	}
+	return responseConflicts
+}
+
+// MockJsonRpcSpec returns a json rpc spec with a latest block api and a deterministic get block api,
+// relays of the get block api on a finalized block trigger data reliability when it's enabled
+func MockJsonRpcSpec(dataReliabilityEnabled bool) spectypes.Spec {
+	apiInterface := func(deterministic bool) []spectypes.ApiInterface {
+		return []spectypes.ApiInterface{{Interface: spectypes.APIInterfaceJsonRPC, Type: http.MethodPost, Category: &spectypes.SpecCategory{Deterministic: deterministic}}}
+	}
+	return spectypes.Spec{
+		Index:                         HarnessChainID,
+		Name:                          HarnessChainID,
+		Enabled:                       true,
+		ReliabilityThreshold:          4294967295, // every relay on a finalized block is checked
+		DataReliabilityEnabled:        dataReliabilityEnabled,
+		BlockDistanceForFinalizedData: 2,
+		BlocksInFinalizationProof:     3,
+		AverageBlockTime:              1000,
+		AllowedBlockLagForQosSync:     2,
+		MinStakeProvider:              sdk.NewCoin(epochstoragetypes.TokenDenom, sdk.NewInt(1000)),
+		MinStakeClient:                sdk.NewCoin(epochstoragetypes.TokenDenom, sdk.NewInt(100)),
+		Apis: []spectypes.ServiceApi{
+			{
+				Name:          "eth_blockNumber",
+				BlockParsing:  spectypes.BlockParser{ParserArg: []string{"latest"}, ParserFunc: spectypes.PARSER_FUNC_DEFAULT},
+				ComputeUnits:  10,
+				Enabled:       true,
+				ApiInterfaces: apiInterface(false),
+				Parsing:       spectypes.Parsing{FunctionTag: spectypes.GET_BLOCKNUM, FunctionTemplate: `{"jsonrpc":"2.0","method":"eth_blockNumber","params":[],"id":1}`},
+			},
+			{
+				Name:          "eth_getBlockByNumber",
+				BlockParsing:  spectypes.BlockParser{ParserArg: []string{"0"}, ParserFunc: spectypes.PARSER_FUNC_PARSE_BY_ARG},
+				ComputeUnits:  20,
+				Enabled:       true,
+				ApiInterfaces: apiInterface(true),
+				Parsing:       spectypes.Parsing{FunctionTag: spectypes.GET_BLOCK_BY_NUM, FunctionTemplate: `{"jsonrpc":"2.0","method":"eth_getBlockByNumber","params":["0x%x", false],"id":1}`},
+			},
+		},
+	}
+}
+
+type detectedConflict struct {
+	finalizationConflict *conflicttypes.FinalizationConflict
+	responseConflict     *conflicttypes.ResponseConflict
+	sameProviderConflict *conflicttypes.FinalizationConflict
+}
+
+// mockConsumerStateTracker records the conflict detection txs instead of sending them, pairing and spec updates come from the harness
+type mockConsumerStateTracker struct {
+	lock     sync.Mutex
+	detected []detectedConflict
+}
+
+func (m *mockConsumerStateTracker) RegisterConsumerSessionManagerForPairingUpdates(ctx context.Context, consumerSessionManager *lavasession.ConsumerSessionManager) {
+}
+
+func (m *mockConsumerStateTracker) UnregisterConsumerSessionManagerForPairingUpdates(ctx context.Context, consumerSessionManager *lavasession.ConsumerSessionManager) {
+}
+
+func (m *mockConsumerStateTracker) RegisterChainParserForSpecUpdates(ctx context.Context, chainParser chainlib.ChainParser, chainID string) error {
+	return nil
+}
+
+func (m *mockConsumerStateTracker) RegisterFinalizationConsensusForUpdates(context.Context, *lavaprotocol.FinalizationConsensus) {
+}
+
+func (m *mockConsumerStateTracker) UnregisterFinalizationConsensusForUpdates(ctx context.Context, finalizationConsensus *lavaprotocol.FinalizationConsensus) {
+}
+
+func (m *mockConsumerStateTracker) RegisterProjectCuBudgetForUpdates(ctx context.Context, budget *lavasession.ProjectCuBudget, chainID string) {
+}
+
+func (m *mockConsumerStateTracker) TxConflictDetection(ctx context.Context, finalizationConflict *conflicttypes.FinalizationConflict, responseConflict *conflicttypes.ResponseConflict, sameProviderConflict *conflicttypes.FinalizationConflict) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.detected = append(m.detected, detectedConflict{finalizationConflict: finalizationConflict, responseConflict: responseConflict, sameProviderConflict: sameProviderConflict})
+	return nil
+}
+
+func (m *mockConsumerStateTracker) conflicts(get func(detectedConflict) *conflicttypes.FinalizationConflict) []*conflicttypes.FinalizationConflict {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	finalizationConflicts := []*conflicttypes.FinalizationConflict{}
+	for _, detected := range m.detected {
+		if conflict := get(detected); conflict != nil {
+			finalizationConflicts = append(finalizationConflicts, conflict)
+		}
+	}
+	return finalizationConflicts
+}
@@ -0,0 +1,94 @@
+package protocolharness
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	latestBlockRequest    = `{"jsonrpc":"2.0","id":1,"method":"eth_blockNumber","params":[]}`
+	finalizedBlockRequest = `{"jsonrpc":"2.0","id":1,"method":"eth_getBlockByNumber","params":["0x10", false]}`
+)
+
+func TestHarnessRetries(t *testing.T) {
+	for _, tt := range []struct {
+		name            string
+		providers       []MockProviderConfig
+		maxRelayRetries int
+		success         bool
+	}{
+		{name: "single provider", providers: []MockProviderConfig{{}}, success: true},
+		{name: "failed relay is retried", providers: []MockProviderConfig{{FailFirst: 1}, {FailFirst: 1}, {}}, success: true},
+		{name: "unavailable provider is replaced", providers: []MockProviderConfig{{Unavailable: true}, {}}, success: true},
+		{name: "slow provider is replaced", providers: []MockProviderConfig{{Latency: 5 * time.Second}, {}}, success: true},
+		{name: "all providers unavailable", providers: []MockProviderConfig{{Unavailable: true}, {Unavailable: true}}, success: false},
+		{name: "retries run out", providers: []MockProviderConfig{{Unavailable: true}, {Unavailable: true}, {Unavailable: true}}, maxRelayRetries: 2, success: false},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			h := NewHarness(t, HarnessConfig{Providers: tt.providers, MaxRelayRetries: tt.maxRelayRetries})
+			reply, err := h.SendRelay(context.Background(), latestBlockRequest)
+			if !tt.success {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, DefaultReplyData, string(reply.Data))
+			require.Equal(t, DefaultLatestBlock, reply.LatestBlock)
+		})
+	}
+}
+
+func TestHarnessConflicts(t *testing.T) {
+	forkedHash := func(block int64) string { return fmt.Sprintf("0xfork%d", block) }
+	for _, tt := range []struct {
+		name                  string
+		providers             []MockProviderConfig
+		requiredResponses     int
+		request               string
+		finalizationConflicts bool
+		responseConflicts     bool
+	}{
+		{
+			name:      "honest providers",
+			providers: []MockProviderConfig{{}, {}, {}},
+			request:   finalizedBlockRequest,
+		},
+		{
+			name:                  "provider on a fork",
+			providers:             []MockProviderConfig{{}, {BlockHash: forkedHash}},
+			requiredResponses:     2, // every relay reaches both providers
+			request:               latestBlockRequest,
+			finalizationConflicts: true,
+		},
+		{
+			name:              "providers replying differently on a finalized block",
+			providers:         []MockProviderConfig{{ReplyData: `{"jsonrpc":"2.0","id":1,"result":"0x1"}`}, {ReplyData: `{"jsonrpc":"2.0","id":1,"result":"0x2"}`}, {ReplyData: `{"jsonrpc":"2.0","id":1,"result":"0x3"}`}},
+			request:           finalizedBlockRequest,
+			responseConflicts: true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			h := NewHarness(t, HarnessConfig{Providers: tt.providers, RequiredResponses: tt.requiredResponses})
+			detected := func() bool {
+				return len(h.FinalizationConflicts()) > 0 || len(h.ResponseConflicts()) > 0
+			}
+			// every provider is relayed to before the consumer has something to compare
+			for i := 0; i < 2*len(tt.providers) && !detected(); i++ {
+				h.SendRelay(context.Background(), tt.request)
+			}
+			if tt.finalizationConflicts || tt.responseConflicts {
+				// data reliability relays are sent in the background
+				require.Eventually(t, detected, 10*time.Second, 100*time.Millisecond)
+			} else {
+				time.Sleep(time.Second)
+			}
+			require.Equal(t, tt.finalizationConflicts, len(h.FinalizationConflicts()) > 0)
+			require.Equal(t, tt.responseConflicts, len(h.ResponseConflicts()) > 0)
+			require.Empty(t, h.SameProviderConflicts())
+		})
+	}
+}
@@ -0,0 +1,147 @@
+package protocolharness
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	btcSecp256k1 "github.com/btcsuite/btcd/btcec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/protocol/lavaprotocol"
+	"github.com/lavanet/lava/protocol/lavasession"
+	"github.com/lavanet/lava/utils"
+	"github.com/lavanet/lava/utils/sigs"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	"google.golang.org/grpc"
+)
+
+const (
+	DefaultLatestBlock = int64(100)
+	DefaultReplyData   = `{"jsonrpc":"2.0","id":1,"result":"0x1"}`
+)
+
+// MockProviderConfig sets how a mock provider answers relays, the zero value answers every relay right away with DefaultReplyData
+type MockProviderConfig struct {
+	Latency     time.Duration // delay before answering, relays whose context ends first fail like a timed out node
+	FailFirst   int           // the first FailFirst relays are answered with an error
+	Unavailable bool          // every relay is answered with an error, probes still succeed
+	LatestBlock int64         // latest block of the reply, DefaultLatestBlock when 0
+	ReplyData   string        // data of the reply, DefaultReplyData when empty
+	// BlockHash returns the hash the provider reports for a finalized block, providers disagreeing on a hash are on a fork
+	BlockHash func(block int64) string
+}
+
+// DefaultBlockHash is the block hash of providers without a BlockHash
+func DefaultBlockHash(block int64) string {
+	return fmt.Sprintf("0xhash%d", block)
+}
+
+// MockProvider is an in-process Relayer server that signs its replies and finalization data like a real provider
+type MockProvider struct {
+	pairingtypes.UnimplementedRelayerServer
+	config                        MockProviderConfig
+	privKey                       *btcSecp256k1.PrivateKey
+	Address                       sdk.AccAddress
+	NetworkAddress                string
+	blockDistanceForFinalizedData int64
+	blocksInFinalizationProof     int64
+	server                        *grpc.Server
+	relays                        uint64
+	lock                          sync.Mutex
+	requests                      []*pairingtypes.RelayRequest
+}
+
+// NewMockProvider starts serving the provider on a free local port until Stop is called
+func NewMockProvider(config MockProviderConfig, blockDistanceForFinalizedData uint32, blocksInFinalizationProof uint32) (*MockProvider, error) {
+	if config.LatestBlock == 0 {
+		config.LatestBlock = DefaultLatestBlock
+	}
+	if config.ReplyData == "" {
+		config.ReplyData = DefaultReplyData
+	}
+	if config.BlockHash == nil {
+		config.BlockHash = DefaultBlockHash
+	}
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+	privKey, address := sigs.GenerateFloatingKey()
+	mp := &MockProvider{
+		config:                        config,
+		privKey:                       privKey,
+		Address:                       address,
+		NetworkAddress:                listener.Addr().String(),
+		blockDistanceForFinalizedData: int64(blockDistanceForFinalizedData),
+		blocksInFinalizationProof:     int64(blocksInFinalizationProof),
+		server:                        grpc.NewServer(),
+	}
+	pairingtypes.RegisterRelayerServer(mp.server, mp)
+	go mp.server.Serve(listener)
+	return mp, nil
+}
+
+func (mp *MockProvider) Stop() {
+	mp.server.Stop()
+}
+
+// Relays returns the number of relays the provider got, including data reliability relays and failed ones
+func (mp *MockProvider) Relays() int {
+	return int(atomic.LoadUint64(&mp.relays))
+}
+
+// Requests returns the relay requests the provider got, in the order they arrived
+func (mp *MockProvider) Requests() []*pairingtypes.RelayRequest {
+	mp.lock.Lock()
+	defer mp.lock.Unlock()
+	return append([]*pairingtypes.RelayRequest{}, mp.requests...)
+}
+
+func (mp *MockProvider) Relay(ctx context.Context, request *pairingtypes.RelayRequest) (*pairingtypes.RelayReply, error) {
+	relayNumber := atomic.AddUint64(&mp.relays, 1)
+	mp.lock.Lock()
+	mp.requests = append(mp.requests, request)
+	mp.lock.Unlock()
+	if mp.config.Latency > 0 {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(mp.config.Latency):
+		}
+	}
+	if mp.config.Unavailable || relayNumber <= uint64(mp.config.FailFirst) {
+		return nil, utils.LavaFormatWarning("mock provider failing relay", nil, utils.Attribute{Key: "provider", Value: mp.Address}, utils.Attribute{Key: "relayNumber", Value: relayNumber})
+	}
+	if request.RelaySession == nil || request.RelayData == nil {
+		return nil, utils.LavaFormatError("invalid relay request, internal fields are nil", nil)
+	}
+	// the consumer verifies the finalization data was signed for the address of the provider it relayed to
+	finalizedBlocksHashes, err := json.Marshal(mp.finalizedBlocksHashes())
+	if err != nil {
+		return nil, err
+	}
+	reply := &pairingtypes.RelayReply{
+		Data:                  []byte(mp.config.ReplyData),
+		LatestBlock:           mp.config.LatestBlock,
+		FinalizedBlocksHashes: finalizedBlocksHashes,
+	}
+	return lavaprotocol.SignRelayResponse(mp.Address, *request, mp.privKey, reply, true)
+}
+
+func (mp *MockProvider) Probe(ctx context.Context, probeReq *pairingtypes.ProbeRequest) (*pairingtypes.ProbeReply, error) {
+	return &pairingtypes.ProbeReply{Guid: probeReq.Guid, ProtocolVersion: lavasession.ProtocolVersion}, nil
+}
+
+// finalizedBlocksHashes returns the hashes of the consecutive finalized blocks up to the latest finalized one, as a real provider's chain tracker does
+func (mp *MockProvider) finalizedBlocksHashes() map[int64]string {
+	latestFinalizedBlock := lavaprotocol.GetLatestFinalizedBlock(mp.config.LatestBlock, mp.blockDistanceForFinalizedData)
+	hashes := map[int64]string{}
+	for block := latestFinalizedBlock; block > latestFinalizedBlock-mp.blocksInFinalizationProof && block >= 0; block-- {
+		hashes[block] = mp.config.BlockHash(block)
+	}
+	return hashes
+}
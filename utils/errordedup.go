@@ -0,0 +1,113 @@
+package utils
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	DefaultErrorDedupWindow = 10 * time.Second
+	maxDedupedErrors        = 1000 // expired errors are dropped past this many distinct errors
+)
+
+// ErrorDeduplicator collapses identical errors: the first one in a window is logged right away, the identical ones after it
+// in the window aren't logged and are reported once when the window ends, as a single line with an occurrences counter.
+// errors are identical when their description, severity and wrapped error message are, the attributes aren't compared
+type ErrorDeduplicator struct {
+	lock   sync.Mutex
+	window time.Duration
+	errors map[dedupKey]*dedupedError
+}
+
+type dedupKey struct {
+	description string
+	severity    uint
+	err         string
+}
+
+type dedupedError struct {
+	start      time.Time
+	repeated   int // identical errors since the first one, reported when the window ends
+	err        error
+	attributes []Attribute // of the last repeated error
+	module     string
+}
+
+func NewErrorDeduplicator(window time.Duration) *ErrorDeduplicator {
+	return &ErrorDeduplicator{window: window, errors: map[dedupKey]*dedupedError{}}
+}
+
+// errorDeduplicator is shared by the package level helpers, so repeated errors from different paths are collapsed together
+var errorDeduplicator = NewErrorDeduplicator(DefaultErrorDedupWindow)
+
+// LavaFormatRepeatedError is LavaFormatError for errors that can flood the logs, such as relays to a provider that is down
+func LavaFormatRepeatedError(description string, err error, attributes ...Attribute) error {
+	return errorDeduplicator.format(description, err, attributes, 3)
+}
+
+// LavaFormatRepeatedWarning is LavaFormatWarning for warnings that can flood the logs
+func LavaFormatRepeatedWarning(description string, err error, attributes ...Attribute) error {
+	return errorDeduplicator.format(description, err, attributes, 2)
+}
+
+func (ed *ErrorDeduplicator) LavaFormatError(description string, err error, attributes ...Attribute) error {
+	return ed.format(description, err, attributes, 3)
+}
+
+func (ed *ErrorDeduplicator) LavaFormatWarning(description string, err error, attributes ...Attribute) error {
+	return ed.format(description, err, attributes, 2)
+}
+
+// format returns the same error LavaFormatLog does, whether it's logged or not
+func (ed *ErrorDeduplicator) format(description string, err error, attributes []Attribute, severity uint) error {
+	key := dedupKey{description: description, severity: severity}
+	if err != nil {
+		key.err = err.Error()
+	}
+	ed.lock.Lock()
+	now := time.Now()
+	deduped, ok := ed.errors[key]
+	if ok && now.Sub(deduped.start) < ed.window {
+		deduped.repeated++
+		deduped.err = err
+		deduped.attributes = attributes
+		if deduped.repeated == 1 {
+			// the module is detected here as the report is logged from a timer
+			deduped.module = callerModule()
+			time.AfterFunc(ed.window-now.Sub(deduped.start), func() { ed.report(key) })
+		}
+		ed.lock.Unlock()
+		return formatLog(description, err, attributes, severity, 0, "")
+	}
+	if len(ed.errors) >= maxDedupedErrors {
+		ed.dropExpired(now)
+	}
+	// a window that ended with repeated errors keeps its entry until they are reported, the next window starts after that
+	if !ok || deduped.repeated == 0 {
+		ed.errors[key] = &dedupedError{start: now}
+	}
+	ed.lock.Unlock()
+	return formatLog(description, err, attributes, severity, 1, "")
+}
+
+// report logs the errors repeated in the window of key, the next identical error starts a new window
+func (ed *ErrorDeduplicator) report(key dedupKey) {
+	ed.lock.Lock()
+	deduped, ok := ed.errors[key]
+	if !ok || deduped.repeated == 0 {
+		ed.lock.Unlock()
+		return
+	}
+	delete(ed.errors, key)
+	ed.lock.Unlock()
+	formatLog(key.description, deduped.err, deduped.attributes, key.severity, deduped.repeated, deduped.module)
+}
+
+func (ed *ErrorDeduplicator) dropExpired(now time.Time) {
+	for key, deduped := range ed.errors {
+		// errors waiting for their report are dropped by it
+		if deduped.repeated == 0 && now.Sub(deduped.start) >= ed.window {
+			delete(ed.errors, key)
+		}
+	}
+}
@@ -0,0 +1,43 @@
+package utils
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestErrorDeduplicator(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "lava.log")
+	require.NoError(t, SetLogConfig(LogConfig{Level: "debug", File: path, JSON: true}))
+	defer SetLogConfig(LogConfig{Level: "debug"})
+	read := func() string {
+		content, err := os.ReadFile(path)
+		require.NoError(t, err)
+		return string(content)
+	}
+
+	ed := NewErrorDeduplicator(200 * time.Millisecond)
+	providerDown := errors.New("connection refused")
+	for i := 0; i < 5; i++ {
+		// repeated errors are returned like logged ones
+		err := ed.LavaFormatError("failed relay", providerDown, Attribute{Key: "relay", Value: i})
+		require.ErrorContains(t, err, "failed relay ErrMsg: connection refused")
+	}
+	ed.LavaFormatError("failed relay", errors.New("timeout"))
+	require.Equal(t, 1, strings.Count(read(), `"error":"connection refused"`))
+	require.Equal(t, 1, strings.Count(read(), `"error":"timeout"`))
+
+	// the repeated ones are reported in a single line when the window ends
+	require.Eventually(t, func() bool { return strings.Contains(read(), `"occurrences":4`) }, time.Second, 10*time.Millisecond)
+	require.Equal(t, 2, strings.Count(read(), `"error":"connection refused"`))
+	require.Equal(t, 1, strings.Count(read(), `"occurrences"`))
+
+	// the next one starts a new window
+	ed.LavaFormatError("failed relay", providerDown)
+	require.Equal(t, 3, strings.Count(read(), `"error":"connection refused"`))
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/binary"
 	"fmt"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	pairingtypes "github.com/lavanet/lava/x/pairing/types"
 )
 
@@ -19,6 +20,12 @@ func Serialize(data any) []byte {
 			break
 		}
 		return raw
+	case sdk.Dec:
+		raw, err := castedData.Marshal()
+		if err != nil {
+			break
+		}
+		return raw
 	}
 	panic(fmt.Sprintf("Lava can't Serialize typetype %T!\n", data))
 }
@@ -34,6 +41,12 @@ func Deserialize(raw []byte, data any) {
 			break
 		}
 		return
+	case *sdk.Dec:
+		err := casted.Unmarshal(raw)
+		if err != nil {
+			break
+		}
+		return
 	}
 	panic(fmt.Sprintf("Lava can't DeSerialize typetype %T!\n", data))
 }
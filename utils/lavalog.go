@@ -7,12 +7,10 @@ import (
 	"os"
 	"runtime/debug"
 	"strconv"
-	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	zerolog "github.com/rs/zerolog"
-	zerologlog "github.com/rs/zerolog/log"
 	"github.com/tendermint/tendermint/libs/log"
 )
 
@@ -54,50 +52,49 @@ func LavaError(ctx sdk.Context, logger log.Logger, name string, attributes map[s
 	return errors.New(err_msg)
 }
 
+// LoggingLevel sets the level of the modules without a level of their own, unknown levels are info
 func LoggingLevel(logLevel string) {
-	switch logLevel {
-	case "debug":
-		zerolog.SetGlobalLevel(zerolog.DebugLevel)
-	case "info":
-		zerolog.SetGlobalLevel(zerolog.InfoLevel)
-	case "warn":
-		zerolog.SetGlobalLevel(zerolog.WarnLevel)
-	case "error":
-		zerolog.SetGlobalLevel(zerolog.ErrorLevel)
-	case "fatal":
-		zerolog.SetGlobalLevel(zerolog.FatalLevel)
-	default:
-		zerolog.SetGlobalLevel(zerolog.InfoLevel)
+	level, err := ParseLogLevel(logLevel)
+	if err != nil {
+		level = zerolog.InfoLevel
 	}
+	logState.lock.Lock()
+	logState.defaultLevel = level
+	logState.lock.Unlock()
 	LavaFormatInfo("setting log level", Attribute{Key: "loglevel", Value: logLevel})
 }
 
 func LavaFormatLog(description string, err error, attributes []Attribute, severity uint) error {
-	zerolog.TimeFieldFormat = zerolog.TimeFormatUnix
-	// os.Getenv("LAVA_DISABLE_COLORS") == "true"
-	NoColor := true
-	if os.Getenv("LAVA_OUTPUT") != "json" {
-		zerologlog.Logger = zerologlog.Output(zerolog.ConsoleWriter{Out: os.Stderr, NoColor: NoColor, TimeFormat: time.Stamp})
-	}
+	return formatLog(description, err, attributes, severity, 1, "")
+}
 
-	var logEvent *zerolog.Event
+// formatLog returns the formatted error and logs it unless occurrences is 0, more than one occurrence is logged with
+// an occurrences counter. module overrides the module of the caller when it isn't empty
+func formatLog(description string, err error, attributes []Attribute, severity uint, occurrences int, module string) error {
+	var level zerolog.Level
 	switch severity {
 	case 4:
-		// prefix = "Fatal:"
-		logEvent = zerologlog.Fatal()
-
+		level = zerolog.FatalLevel
 	case 3:
-		// prefix = "Error:"
-		logEvent = zerologlog.Error()
+		level = zerolog.ErrorLevel
 	case 2:
-		// prefix = "Warning:"
-		logEvent = zerologlog.Warn()
+		level = zerolog.WarnLevel
 	case 1:
-		logEvent = zerologlog.Info()
-		// prefix = "Info:"
+		level = zerolog.InfoLevel
 	case 0:
-		logEvent = zerologlog.Debug()
-		// prefix = "Debug:"
+		level = zerolog.DebugLevel
+	}
+	// a nil event ignores everything logged on it, the error is still formatted and returned
+	var logEvent *zerolog.Event
+	if occurrences > 0 {
+		var suppressed int
+		logEvent, suppressed = logState.logEvent(level, description, module)
+		if suppressed > 0 {
+			logEvent = logEvent.Int("suppressed", suppressed)
+		}
+		if occurrences > 1 {
+			logEvent = logEvent.Int("occurrences", occurrences)
+		}
 	}
 	output := description
 	if err != nil {
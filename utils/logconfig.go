@@ -0,0 +1,299 @@
+package utils
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+
+	zerolog "github.com/rs/zerolog"
+)
+
+const (
+	DefaultLogSampleInterval = time.Minute
+	maxSampledMessages       = 1000 // expired sampling windows are dropped past this many distinct messages
+)
+
+// LogConfig sets where the lava logs go and which of them are written, it can be replaced at runtime with SetLogConfig
+type LogConfig struct {
+	Level string // level of the modules without a level of their own, info when empty
+	// ModuleLevels are levels by module, the module of a log is the name of the package it's called from, e.g. lavasession or chainlib
+	ModuleLevels   map[string]string
+	JSON           bool          // a json object per line instead of console output
+	File           string        // stderr when empty
+	MaxFileSizeMB  int           // the file is rotated when it reaches this size, 0 never rotates it
+	MaxBackups     int           // rotated files kept, older ones are removed
+	SampleBurst    int           // identical errors and warnings logged per SampleInterval, the rest are counted and reported with the next one logged. 0 logs all of them
+	SampleInterval time.Duration // DefaultLogSampleInterval when 0
+}
+
+type logSettings struct {
+	lock         sync.RWMutex
+	defaultLevel zerolog.Level
+	moduleLevels map[string]zerolog.Level
+	logger       zerolog.Logger
+	sampler      *logSampler
+	output       io.Closer // the log file, nil for stderr
+}
+
+var logState = newLogSettings()
+
+func newLogSettings() *logSettings {
+	zerolog.TimeFieldFormat = zerolog.TimeFormatUnix
+	return &logSettings{defaultLevel: zerolog.DebugLevel, logger: newLogger(os.Stderr, os.Getenv("LAVA_OUTPUT") == "json")}
+}
+
+func newLogger(out io.Writer, json bool) zerolog.Logger {
+	if json {
+		return zerolog.New(out).With().Timestamp().Logger()
+	}
+	return zerolog.New(zerolog.ConsoleWriter{Out: out, NoColor: true, TimeFormat: time.Stamp}).With().Timestamp().Logger()
+}
+
+// ParseLogLevel accepts the levels of the log-level flag
+func ParseLogLevel(level string) (zerolog.Level, error) {
+	switch level {
+	case "debug":
+		return zerolog.DebugLevel, nil
+	case "info":
+		return zerolog.InfoLevel, nil
+	case "warn":
+		return zerolog.WarnLevel, nil
+	case "error":
+		return zerolog.ErrorLevel, nil
+	case "fatal":
+		return zerolog.FatalLevel, nil
+	default:
+		return zerolog.NoLevel, fmt.Errorf("invalid log level %q, expected one of debug, info, warn, error, fatal", level)
+	}
+}
+
+// SetLogConfig validates the config and applies it to the logs from now on, an invalid config keeps the current one
+func SetLogConfig(config LogConfig) error {
+	defaultLevel := zerolog.InfoLevel
+	var err error
+	if config.Level != "" {
+		defaultLevel, err = ParseLogLevel(config.Level)
+		if err != nil {
+			return err
+		}
+	}
+	moduleLevels := make(map[string]zerolog.Level, len(config.ModuleLevels))
+	for module, level := range config.ModuleLevels {
+		moduleLevels[module], err = ParseLogLevel(level)
+		if err != nil {
+			return fmt.Errorf("module %s: %w", module, err)
+		}
+	}
+	var out io.Writer = os.Stderr
+	var output io.Closer
+	if config.File != "" {
+		file, err := newRotatingFile(config.File, int64(config.MaxFileSizeMB)*1024*1024, config.MaxBackups)
+		if err != nil {
+			return err
+		}
+		out, output = file, file
+	}
+	var sampler *logSampler
+	if config.SampleBurst > 0 {
+		sampleInterval := config.SampleInterval
+		if sampleInterval == 0 {
+			sampleInterval = DefaultLogSampleInterval
+		}
+		sampler = newLogSampler(config.SampleBurst, sampleInterval, time.Now)
+	}
+
+	logState.lock.Lock()
+	previousOutput := logState.output
+	logState.defaultLevel = defaultLevel
+	logState.moduleLevels = moduleLevels
+	logState.logger = newLogger(out, config.JSON)
+	logState.sampler = sampler
+	logState.output = output
+	logState.lock.Unlock()
+	if previousOutput != nil {
+		previousOutput.Close()
+	}
+	return nil
+}
+
+// logEvent starts a log event of the level, nil when the level of the calling module filters it out or the message is sampled out.
+// suppressed is the number of identical messages sampled out since this one was last logged. module is detected from the caller when empty
+func (ls *logSettings) logEvent(level zerolog.Level, description string, module string) (event *zerolog.Event, suppressed int) {
+	ls.lock.RLock()
+	defer ls.lock.RUnlock()
+	minLevel := ls.defaultLevel
+	if len(ls.moduleLevels) > 0 {
+		if module == "" {
+			module = callerModule()
+		}
+		if moduleLevel, ok := ls.moduleLevels[module]; ok {
+			minLevel = moduleLevel
+		}
+	}
+	if level < minLevel && level != zerolog.FatalLevel {
+		return nil, 0
+	}
+	if ls.sampler != nil && (level == zerolog.WarnLevel || level == zerolog.ErrorLevel) {
+		var allowed bool
+		allowed, suppressed = ls.sampler.allow(description)
+		if !allowed {
+			return nil, 0
+		}
+	}
+	return ls.logger.WithLevel(level), suppressed
+}
+
+// callerModule returns the package name of the first caller outside of the logging functions
+func callerModule() string {
+	pcs := make([]uintptr, 10)
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(2, pcs)])
+	for {
+		frame, more := frames.Next()
+		module := packageName(frame.Function)
+		if module != "utils" || !strings.HasSuffix(frame.File, "utils/lavalog.go") && !strings.HasSuffix(frame.File, "utils/logconfig.go") && !strings.HasSuffix(frame.File, "utils/errordedup.go") {
+			return module
+		}
+		if !more {
+			return ""
+		}
+	}
+}
+
+// packageName returns lavasession for github.com/lavanet/lava/protocol/lavasession.(*ConsumerSessionManager).GetSession
+func packageName(function string) string {
+	function = function[strings.LastIndex(function, "/")+1:]
+	if dot := strings.Index(function, "."); dot >= 0 {
+		return function[:dot]
+	}
+	return function
+}
+
+// logSampler lets through burst identical messages per interval
+type logSampler struct {
+	lock     sync.Mutex
+	burst    int
+	interval time.Duration
+	now      func() time.Time
+	windows  map[string]*sampleWindow
+}
+
+type sampleWindow struct {
+	start      time.Time
+	logged     int
+	suppressed int
+}
+
+func newLogSampler(burst int, interval time.Duration, now func() time.Time) *logSampler {
+	return &logSampler{burst: burst, interval: interval, now: now, windows: map[string]*sampleWindow{}}
+}
+
+// allow returns whether the message is logged, and when it is, how many were suppressed since the last time it was
+func (ls *logSampler) allow(message string) (allowed bool, suppressed int) {
+	ls.lock.Lock()
+	defer ls.lock.Unlock()
+	now := ls.now()
+	window, ok := ls.windows[message]
+	if !ok {
+		if len(ls.windows) >= maxSampledMessages {
+			ls.dropExpired(now)
+		}
+		window = &sampleWindow{start: now}
+		ls.windows[message] = window
+	} else if now.Sub(window.start) >= ls.interval {
+		window.start = now
+		window.logged = 0
+	}
+	if window.logged >= ls.burst {
+		window.suppressed++
+		return false, 0
+	}
+	window.logged++
+	suppressed = window.suppressed
+	window.suppressed = 0
+	return true, suppressed
+}
+
+func (ls *logSampler) dropExpired(now time.Time) {
+	for message, window := range ls.windows {
+		if now.Sub(window.start) >= ls.interval {
+			delete(ls.windows, message)
+		}
+	}
+}
+
+// rotatingFile is a log file renamed to file.1 when it reaches maxSize, previous backups are shifted to file.2 and so on
+type rotatingFile struct {
+	lock       sync.Mutex
+	path       string
+	maxSize    int64
+	maxBackups int
+	file       *os.File
+	size       int64
+}
+
+func newRotatingFile(path string, maxSize int64, maxBackups int) (*rotatingFile, error) {
+	rf := &rotatingFile{path: path, maxSize: maxSize, maxBackups: maxBackups}
+	return rf, rf.open()
+}
+
+func (rf *rotatingFile) open() error {
+	file, err := os.OpenFile(rf.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return err
+	}
+	rf.file = file
+	rf.size = info.Size()
+	return nil
+}
+
+func (rf *rotatingFile) Write(p []byte) (int, error) {
+	rf.lock.Lock()
+	defer rf.lock.Unlock()
+	if rf.file == nil {
+		return 0, os.ErrClosed
+	}
+	if rf.maxSize > 0 && rf.size > 0 && rf.size+int64(len(p)) > rf.maxSize {
+		if err := rf.rotate(); err != nil {
+			return 0, err
+		}
+	}
+	n, err := rf.file.Write(p)
+	rf.size += int64(n)
+	return n, err
+}
+
+func (rf *rotatingFile) rotate() error {
+	rf.file.Close()
+	rf.file = nil
+	backup := func(index int) string { return fmt.Sprintf("%s.%d", rf.path, index) }
+	if rf.maxBackups == 0 {
+		os.Remove(rf.path)
+	} else {
+		os.Remove(backup(rf.maxBackups))
+		for index := rf.maxBackups - 1; index >= 1; index-- {
+			os.Rename(backup(index), backup(index+1))
+		}
+		os.Rename(rf.path, backup(1))
+	}
+	return rf.open()
+}
+
+func (rf *rotatingFile) Close() error {
+	rf.lock.Lock()
+	defer rf.lock.Unlock()
+	if rf.file == nil {
+		return nil
+	}
+	err := rf.file.Close()
+	rf.file = nil
+	return err
+}
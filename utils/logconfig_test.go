@@ -0,0 +1,78 @@
+package utils
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPackageName(t *testing.T) {
+	require.Equal(t, "lavasession", packageName("github.com/lavanet/lava/protocol/lavasession.(*ConsumerSessionManager).GetSession"))
+	require.Equal(t, "rpcclient", packageName("github.com/lavanet/lava/protocol/chainlib/chainproxy/rpcclient.(*Client).send.func1"))
+	require.Equal(t, "main", packageName("main.main"))
+}
+
+func TestLogSampler(t *testing.T) {
+	now := time.Now()
+	sampler := newLogSampler(2, time.Minute, func() time.Time { return now })
+	for i := 0; i < 2; i++ {
+		allowed, suppressed := sampler.allow("failed relay")
+		require.True(t, allowed)
+		require.Zero(t, suppressed)
+	}
+	for i := 0; i < 3; i++ {
+		allowed, _ := sampler.allow("failed relay")
+		require.False(t, allowed)
+	}
+	// other messages have their own burst
+	allowed, _ := sampler.allow("failed probe")
+	require.True(t, allowed)
+
+	// the next window reports what the previous one suppressed
+	now = now.Add(time.Minute)
+	allowed, suppressed := sampler.allow("failed relay")
+	require.True(t, allowed)
+	require.Equal(t, 3, suppressed)
+	allowed, suppressed = sampler.allow("failed relay")
+	require.True(t, allowed)
+	require.Zero(t, suppressed)
+}
+
+func TestRotatingFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "lava.log")
+	rf, err := newRotatingFile(path, 10, 2)
+	require.NoError(t, err)
+	defer rf.Close()
+	for _, line := range []string{"first\n", "second\n", "third\n", "fourth\n"} {
+		_, err = rf.Write([]byte(line))
+		require.NoError(t, err)
+	}
+	read := func(path string) string {
+		content, err := os.ReadFile(path)
+		require.NoError(t, err)
+		return string(content)
+	}
+	require.Equal(t, "fourth\n", read(path))
+	require.Equal(t, "third\n", read(path+".1"))
+	require.Equal(t, "second\n", read(path+".2"))
+	_, err = os.Stat(path + ".3")
+	require.True(t, os.IsNotExist(err))
+}
+
+func TestModuleLevels(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "lava.log")
+	require.Error(t, SetLogConfig(LogConfig{ModuleLevels: map[string]string{"utils": "verbose"}}))
+	require.NoError(t, SetLogConfig(LogConfig{Level: "debug", ModuleLevels: map[string]string{"utils": "warn"}, File: path, JSON: true}))
+	defer SetLogConfig(LogConfig{Level: "debug"})
+
+	LavaFormatInfo("info of a warn module")
+	LavaFormatWarning("warning of a warn module", nil)
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.False(t, strings.Contains(string(content), "info of a warn module"))
+	require.True(t, strings.Contains(string(content), `"message":"warning of a warn module"`))
+}
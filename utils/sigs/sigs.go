@@ -7,6 +7,8 @@ import (
 	"fmt"
 
 	btcSecp256k1 "github.com/btcsuite/btcd/btcec"
+	btcecv2 "github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/ecdsa"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/crypto"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -17,6 +19,11 @@ import (
 	"github.com/tendermint/tendermint/crypto/secp256k1"
 )
 
+const (
+	compactSigScalarLength = 32
+	compactSigLength       = 1 + 2*compactSigScalarLength // recovery code followed by r and s
+)
+
 func GetKeyName(clientCtx client.Context) (string, error) {
 	_, name, _, err := client.GetFromFields(clientCtx, clientCtx.Keyring, clientCtx.From)
 	if err != nil {
@@ -50,8 +57,13 @@ func HashMsg(msgData []byte) []byte {
 	return tendermintcrypto.Sha256(msgData)
 }
 
+// DataToSignVRFData returns the data signed on vrf data, the signature is over its hash
+func DataToSignVRFData(vrfData *pairingtypes.VRFData) []byte {
+	return []byte(vrfData.String())
+}
+
 func SignVRFData(pkey *btcSecp256k1.PrivateKey, vrfData *pairingtypes.VRFData) ([]byte, error) {
-	msgData := []byte(vrfData.String())
+	msgData := DataToSignVRFData(vrfData)
 	// Sign
 	sig, err := btcSecp256k1.SignCompact(btcSecp256k1.S256(), pkey, HashMsg(msgData), false)
 	if err != nil {
@@ -66,9 +78,14 @@ func prepareRelaySessionForSignature(request *pairingtypes.RelaySession) {
 	request.Sig = []byte{}
 }
 
-func SignRelay(pkey *btcSecp256k1.PrivateKey, request pairingtypes.RelaySession) ([]byte, error) {
+// DataToSignRelay returns the data signed on a relay session, the signature is over its hash
+func DataToSignRelay(request pairingtypes.RelaySession) []byte {
 	prepareRelaySessionForSignature(&request)
-	msgData := []byte(request.String())
+	return []byte(request.String())
+}
+
+func SignRelay(pkey *btcSecp256k1.PrivateKey, request pairingtypes.RelaySession) ([]byte, error) {
+	msgData := DataToSignRelay(request)
 	// Sign
 	sig, err := btcSecp256k1.SignCompact(btcSecp256k1.S256(), pkey, HashMsg(msgData), false)
 	if err != nil {
@@ -81,7 +98,14 @@ func SignRelay(pkey *btcSecp256k1.PrivateKey, request pairingtypes.RelaySession)
 func AllDataHash(relayResponse *pairingtypes.RelayReply, relayReq *pairingtypes.RelayRequest) (data_hash []byte) {
 	nonceBytes := make([]byte, 4)
 	binary.LittleEndian.PutUint32(nonceBytes, relayResponse.Nonce)
-	data_hash = HashMsg(bytes.Join([][]byte{relayResponse.Data, nonceBytes, []byte(relayReq.String())}, nil))
+	dataToHash := [][]byte{relayResponse.Data, nonceBytes, []byte(relayReq.String())}
+	if relayResponse.Timestamp != 0 {
+		// replies of providers that don't sign a timestamp keep the original hash
+		timestampBytes := make([]byte, 8)
+		binary.LittleEndian.PutUint64(timestampBytes, uint64(relayResponse.Timestamp))
+		dataToHash = append(dataToHash, timestampBytes)
+	}
+	data_hash = HashMsg(bytes.Join(dataToHash, nil))
 	return
 }
 
@@ -103,7 +127,15 @@ func DataToVerifyProviderSig(request *pairingtypes.RelayRequest, data_hash []byt
 
 func DataToSignResponseFinalizationData(relayResponse *pairingtypes.RelayReply, relayReq *pairingtypes.RelayRequest, clientAddress sdk.AccAddress) (dataToSign []byte) {
 	// sign latest_block+finalized_blocks_hashes+session_id+block_height+relay_num
-	return DataToSignResponseFinalizationDataInner(relayResponse.LatestBlock, relayReq.RelaySession.SessionId, relayReq.RelaySession.Epoch, relayReq.RelaySession.RelayNum, relayResponse.FinalizedBlocksHashes, clientAddress)
+	dataToSign = DataToSignResponseFinalizationDataInner(relayResponse.LatestBlock, relayReq.RelaySession.SessionId, relayReq.RelaySession.Epoch, relayReq.RelaySession.RelayNum, relayResponse.FinalizedBlocksHashes, clientAddress)
+	if relayResponse.EarliestBlock != 0 {
+		// only when advertised, so finalization data of providers that don't advertise it signs the same.
+		// prepended since legacy finalization data isn't hashed before signing and only its first 32 bytes are signed
+		earliestBlockBytes := make([]byte, 8)
+		binary.LittleEndian.PutUint64(earliestBlockBytes, uint64(relayResponse.EarliestBlock))
+		dataToSign = append(earliestBlockBytes, dataToSign...)
+	}
+	return dataToSign
 }
 
 func DataToSignResponseFinalizationDataInner(latestBlock int64, sessionID uint64, blockHeight int64, relayNum uint64, finalizedBlockHashes []byte, clientAddress sdk.AccAddress) (dataToSign []byte) {
@@ -141,6 +173,17 @@ func SignResponseFinalizationData(pkey *btcSecp256k1.PrivateKey, relayResponse *
 	return sig, nil
 }
 
+// SignResponseFinalizationDataHash signs the hash of the finalization data, so every byte of it is covered by the signature
+func SignResponseFinalizationDataHash(pkey *btcSecp256k1.PrivateKey, relayResponse *pairingtypes.RelayReply, relayReq *pairingtypes.RelayRequest, clientAddress sdk.AccAddress) ([]byte, error) {
+	msgHash := HashMsg(DataToSignResponseFinalizationData(relayResponse, relayReq, clientAddress))
+	sig, err := btcSecp256k1.SignCompact(btcSecp256k1.S256(), pkey, msgHash, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return sig, nil
+}
+
 func RecoverPubKey(sig []byte, msgHash []byte) (secp256k1.PubKey, error) {
 	//
 	// Recover public key from signature
@@ -229,15 +272,35 @@ func RecoverPubKeyFromRelay(relay pairingtypes.RelaySession) (secp256k1.PubKey,
 }
 
 func ExtractSignerAddress(in *pairingtypes.RelaySession) (sdk.AccAddress, error) {
-	pubKey, err := RecoverPubKeyFromRelay(*in)
+	extractedConsumerAddress, _, err := RecoverRelaySigner(*in)
+	return extractedConsumerAddress, err
+}
+
+// RecoverRelaySigner returns the address and the public key of the consumer that signed the relay session
+func RecoverRelaySigner(relay pairingtypes.RelaySession) (sdk.AccAddress, secp256k1.PubKey, error) {
+	pubKey, err := RecoverPubKeyFromRelay(relay)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	extractedConsumerAddress, err := sdk.AccAddressFromHex(pubKey.Address().String())
 	if err != nil {
-		return nil, utils.LavaFormatError("get relay consumer address", err)
+		return nil, nil, utils.LavaFormatError("get relay consumer address", err)
 	}
-	return extractedConsumerAddress, nil
+	return extractedConsumerAddress, pubKey, nil
+}
+
+// VerifyRelaySigner checks the relay session was signed by a known public key, which is a lot cheaper than recovering the key
+func VerifyRelaySigner(relay pairingtypes.RelaySession, pubKey *btcecv2.PublicKey) bool {
+	signature := relay.Sig
+	if len(signature) != compactSigLength || pubKey == nil {
+		return false
+	}
+	var r, s btcecv2.ModNScalar
+	if r.SetByteSlice(signature[1:compactSigScalarLength+1]) || s.SetByteSlice(signature[compactSigScalarLength+1:]) || r.IsZero() || s.IsZero() {
+		return false
+	}
+	prepareRelaySessionForSignature(&relay)
+	return ecdsa.NewSignature(&r, &s).Verify(HashMsg([]byte(relay.String())), pubKey)
 }
 
 func RecoverPubKeyFromRelayReply(relayResponse *pairingtypes.RelayReply, relayReq *pairingtypes.RelayRequest) (secp256k1.PubKey, error) {
@@ -258,6 +321,16 @@ func RecoverPubKeyFromResponseFinalizationData(relayResponse *pairingtypes.Relay
 	return pubKey, nil
 }
 
+// RecoverPubKeyFromResponseFinalizationDataHash recovers the signer of finalization data signed with SignResponseFinalizationDataHash
+func RecoverPubKeyFromResponseFinalizationDataHash(relayResponse *pairingtypes.RelayReply, relayReq *pairingtypes.RelayRequest, addr sdk.AccAddress) (secp256k1.PubKey, error) {
+	msgHash := HashMsg(DataToSignResponseFinalizationData(relayResponse, relayReq, addr))
+	pubKey, err := RecoverPubKey(relayResponse.SigBlocks, msgHash)
+	if err != nil {
+		return nil, err
+	}
+	return pubKey, nil
+}
+
 func GenerateFloatingKey() (secretKey *btcSecp256k1.PrivateKey, addr sdk.AccAddress) {
 	secretKey, _ = btcSecp256k1.NewPrivateKey(btcSecp256k1.S256())
 	publicBytes := (secp256k1.PubKey)(secretKey.PubKey().SerializeCompressed())
@@ -271,3 +344,44 @@ func CalculateContentHashForRelayData(relayRequestData *pairingtypes.RelayPrivat
 	msgData := bytes.Join([][]byte{[]byte(relayRequestData.ApiInterface), []byte(relayRequestData.ConnectionType), []byte(relayRequestData.ApiUrl), relayRequestData.Data, requestBlockBytes, relayRequestData.Salt}, nil)
 	return HashMsg(msgData)
 }
+
+// DataToSignBadge returns the data a project signs on a badge, the signature is over its hash
+func DataToSignBadge(badge pairingtypes.Badge) []byte {
+	badge.ProjectSig = nil
+	return []byte(badge.String())
+}
+
+func SignBadge(pkey *btcSecp256k1.PrivateKey, badge pairingtypes.Badge) ([]byte, error) {
+	return btcSecp256k1.SignCompact(btcSecp256k1.S256(), pkey, HashMsg(DataToSignBadge(badge)), false)
+}
+
+// RecoverBadgeSigner returns the developer key of the project that granted the badge
+func RecoverBadgeSigner(badge pairingtypes.Badge) (sdk.AccAddress, error) {
+	pubKey, err := RecoverPubKey(badge.ProjectSig, HashMsg(DataToSignBadge(badge)))
+	if err != nil {
+		return nil, err
+	}
+	return sdk.AccAddressFromHex(pubKey.Address().String())
+}
+
+// VerifyRelayBadge checks the badge of a relay was granted to relaySigner for the relay's spec and epoch and covers its cu,
+// it returns the developer key of the project that granted it, the relay is paired and paid as if that key signed it
+func VerifyRelayBadge(relay pairingtypes.RelaySession, relaySigner sdk.AccAddress) (sdk.AccAddress, error) {
+	badge := relay.Badge
+	if badge == nil {
+		return nil, errors.New("relay has no badge")
+	}
+	if len(badge.BadgePk) != secp256k1.PubKeySize {
+		return nil, fmt.Errorf("invalid badge public key length %d", len(badge.BadgePk))
+	}
+	if !sdk.AccAddress(secp256k1.PubKey(badge.BadgePk).Address()).Equals(relaySigner) {
+		return nil, fmt.Errorf("badge was granted to another key than the relay signer %s", relaySigner)
+	}
+	if badge.SpecId != relay.SpecId || badge.Epoch != relay.Epoch {
+		return nil, fmt.Errorf("badge of spec %s epoch %d used for a relay of spec %s epoch %d", badge.SpecId, badge.Epoch, relay.SpecId, relay.Epoch)
+	}
+	if relay.CuSum > badge.CuAllocation {
+		return nil, fmt.Errorf("relay cu %d is over the badge allocation %d", relay.CuSum, badge.CuAllocation)
+	}
+	return RecoverBadgeSigner(*badge)
+}
@@ -0,0 +1,50 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/lavanet/lava/x/conflict/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdActiveConflicts() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "active-conflicts",
+		Short: "list the conflicts being voted on with the vote state of their jurors",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			specID, err := cmd.Flags().GetString(types.FlagSpecID)
+			if err != nil {
+				return err
+			}
+			juror, err := cmd.Flags().GetString(types.FlagJuror)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryActiveConflictsRequest{
+				ChainID: specID,
+				Juror:   juror,
+			}
+
+			res, err := queryClient.ActiveConflicts(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(types.FlagSpecID, "", "Only list the conflicts of this spec")
+	cmd.Flags().String(types.FlagJuror, "", "Only list the conflicts this provider is a juror of")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
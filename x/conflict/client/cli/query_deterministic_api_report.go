@@ -0,0 +1,58 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/lavanet/lava/x/conflict/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdDeterministicApiReport() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deterministic-api-report",
+		Short: "list the apis categorized as deterministic whose response conflicts are mostly benign",
+		Long: `list the apis categorized as deterministic whose response conflicts are mostly benign, where the jurors sided with neither provider.
+		such apis are likely not deterministic, and a spec proposal should change their category or set their response ignore paths`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			specID, err := cmd.Flags().GetString(types.FlagSpecID)
+			if err != nil {
+				return err
+			}
+			minConflicts, err := cmd.Flags().GetUint64(types.FlagMinConflicts)
+			if err != nil {
+				return err
+			}
+			minBenignPercent, err := cmd.Flags().GetUint64(types.FlagMinBenignPercent)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryDeterministicApiReportRequest{
+				ChainID:          specID,
+				MinConflicts:     minConflicts,
+				MinBenignPercent: minBenignPercent,
+			}
+
+			res, err := queryClient.DeterministicApiReport(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(types.FlagSpecID, "", "Only report the apis of this spec")
+	cmd.Flags().Uint64(types.FlagMinConflicts, types.DefaultReportMinConflicts, "Only report apis with at least this many decided conflicts")
+	cmd.Flags().Uint64(types.FlagMinBenignPercent, types.DefaultReportMinBenignPercent, "Only report apis with at least this percent of benign conflicts")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/x/conflict/types"
+)
+
+// SetApiConflictStats set the conflict stats of an api in the store
+func (k Keeper) SetApiConflictStats(ctx sdk.Context, stats types.ApiConflictStats) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ApiConflictStatsKeyPrefix))
+	b := k.cdc.MustMarshal(&stats)
+	store.Set(types.ApiConflictStatsKey(stats.ChainID, stats.ApiInterface, stats.Api), b)
+}
+
+// GetApiConflictStats returns the conflict stats of an api
+func (k Keeper) GetApiConflictStats(ctx sdk.Context, chainID string, apiInterface string, api string) (val types.ApiConflictStats, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ApiConflictStatsKeyPrefix))
+
+	b := store.Get(types.ApiConflictStatsKey(chainID, apiInterface, api))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllApiConflictStats returns the conflict stats of all apis
+func (k Keeper) GetAllApiConflictStats(ctx sdk.Context) (list []types.ApiConflictStats) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ApiConflictStatsKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.ApiConflictStats
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// recordApiConflict counts a decided conflict on the api it was detected on. relays that match no api of the spec aren't counted,
+// they can't point at a spec fix
+func (k Keeper) recordApiConflict(ctx sdk.Context, conflictVote types.ConflictVote, benign bool) {
+	spec, found := k.expandedSpec(ctx, conflictVote.ChainID)
+	if !found {
+		return
+	}
+	api, _, found := spec.MatchApi(conflictVote.ApiInterface, conflictVote.ApiUrl, conflictVote.RequestData)
+	if !found {
+		return
+	}
+
+	stats, found := k.GetApiConflictStats(ctx, conflictVote.ChainID, conflictVote.ApiInterface, api.Name)
+	if !found {
+		stats = types.ApiConflictStats{ChainID: conflictVote.ChainID, ApiInterface: conflictVote.ApiInterface, Api: api.Name}
+	}
+	stats.Conflicts++
+	if benign {
+		stats.Benign++
+	}
+	k.SetApiConflictStats(ctx, stats)
+}
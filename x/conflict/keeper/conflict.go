@@ -2,11 +2,16 @@ package keeper
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
+	"strconv"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/utils"
 	"github.com/lavanet/lava/utils/sigs"
 	"github.com/lavanet/lava/x/conflict/types"
+	epochstoragetypes "github.com/lavanet/lava/x/epochstorage/types"
 	pairingtypes "github.com/lavanet/lava/x/pairing/types"
 )
 
@@ -135,13 +140,28 @@ func (k Keeper) ValidateResponseConflict(ctx sdk.Context, conflictData *types.Re
 			print_st = "second"
 		}
 
-		pubKey, err := sigs.RecoverPubKeyFromResponseFinalizationData(response, request, clientAddr)
-		if err != nil {
-			return fmt.Errorf("RecoverPubKey %s provider ResponseFinalizationData: %w", print_st, err)
+		// the relay protocol version isn't part of the evidence, providers sign either the hash of the finalization data or the legacy unhashed data
+		signedBy := func(hashed bool) (sdk.AccAddress, error) {
+			recoverPubKey := sigs.RecoverPubKeyFromResponseFinalizationData
+			if hashed {
+				recoverPubKey = sigs.RecoverPubKeyFromResponseFinalizationDataHash
+			}
+			pubKey, err := recoverPubKey(response, request, clientAddr)
+			if err != nil {
+				return nil, fmt.Errorf("RecoverPubKey %s provider ResponseFinalizationData: %w", print_st, err)
+			}
+			derived_providerAccAddress, err := sdk.AccAddressFromHex(pubKey.Address().String())
+			if err != nil {
+				return nil, fmt.Errorf("AccAddressFromHex %s provider ResponseFinalizationData: %w", print_st, err)
+			}
+			return derived_providerAccAddress, nil
 		}
-		derived_providerAccAddress, err := sdk.AccAddressFromHex(pubKey.Address().String())
-		if err != nil {
-			return fmt.Errorf("AccAddressFromHex %s provider ResponseFinalizationData: %w", print_st, err)
+		derived_providerAccAddress, err := signedBy(true)
+		if err != nil || !derived_providerAccAddress.Equals(expectedAddress) {
+			derived_providerAccAddress, err = signedBy(false)
+			if err != nil {
+				return err
+			}
 		}
 		if !derived_providerAccAddress.Equals(expectedAddress) {
 			return fmt.Errorf("mismatching %s provider address signature and responseFinazalizationData %s , %s", print_st, derived_providerAccAddress, expectedAddress)
@@ -167,6 +187,119 @@ func (k Keeper) ValidateResponseConflict(ctx sdk.Context, conflictData *types.Re
 	return nil
 }
 
-func (k Keeper) ValidateSameProviderConflict(ctx sdk.Context, conflictData *types.FinalizationConflict, clientAddr sdk.AccAddress) error {
+// ValidateSameProviderConflict verifies a provider signed finalization data with two different hashes for the same block to the client,
+// returns the provider, the block and the epoch of the conflict.
+// only finalization data signed over its hash is accepted, the legacy signature covers just the first 32 bytes of the data and can be forged
+func (k Keeper) ValidateSameProviderConflict(ctx sdk.Context, conflictData *types.FinalizationConflict, clientAddr sdk.AccAddress) (providerAddress sdk.AccAddress, blockNum int64, epochStart uint64, err error) {
+	if conflictData.RelayReply0 == nil || conflictData.RelayReply1 == nil || conflictData.RelaySession0 == nil || conflictData.RelaySession1 == nil {
+		return nil, 0, 0, fmt.Errorf("same provider conflict must have two replies and the sessions they were signed for")
+	}
+	// 1. validate the sessions are of the same chain and epoch
+	chainID := conflictData.RelaySession0.SpecId
+	if chainID != conflictData.RelaySession1.SpecId {
+		return nil, 0, 0, fmt.Errorf("mismatching chainID between the sessions %s, %s", chainID, conflictData.RelaySession1.SpecId)
+	}
+	epochStart, _, err = k.epochstorageKeeper.GetEpochStartForBlock(ctx, uint64(conflictData.RelaySession0.Epoch))
+	if err != nil {
+		return nil, 0, 0, fmt.Errorf("could not find epoch for block %d", conflictData.RelaySession0.Epoch)
+	}
+	epochBlocks, err := k.epochstorageKeeper.EpochBlocks(ctx, epochStart)
+	if err != nil {
+		return nil, 0, 0, fmt.Errorf("could not get EpochBlocks param")
+	}
+	span := k.VoteStartSpan(ctx) * epochBlocks
+	if uint64(ctx.BlockHeight())-epochStart >= span {
+		return nil, 0, 0, fmt.Errorf("conflict was received outside of the allowed span, current: %d, span %d - %d", ctx.BlockHeight(), epochStart, epochStart+span)
+	}
+
+	// 2. validate both finalization data were signed to the client by the same staked provider
+	recoverProviderAddress := func(reply *pairingtypes.RelayReply, session *pairingtypes.RelaySession) (sdk.AccAddress, error) {
+		pubKey, err := sigs.RecoverPubKeyFromResponseFinalizationDataHash(reply, &pairingtypes.RelayRequest{RelaySession: session}, clientAddr)
+		if err != nil {
+			return nil, fmt.Errorf("RecoverPubKey provider ResponseFinalizationData: %w", err)
+		}
+		return sdk.AccAddressFromHex(pubKey.Address().String())
+	}
+	providerAddress, err = recoverProviderAddress(conflictData.RelayReply0, conflictData.RelaySession0)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	providerAddress1, err := recoverProviderAddress(conflictData.RelayReply1, conflictData.RelaySession1)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	if !providerAddress.Equals(providerAddress1) {
+		return nil, 0, 0, fmt.Errorf("finalization data was signed by different providers %s, %s", providerAddress, providerAddress1)
+	}
+	_, err = k.epochstorageKeeper.GetStakeEntryForProviderEpoch(ctx, chainID, providerAddress, epochStart)
+	if err != nil {
+		return nil, 0, 0, fmt.Errorf("did not find a stake entry for provider %s on epoch %d, chainID %s error: %s", providerAddress, epochStart, chainID, err.Error())
+	}
+
+	// 3. validate the finalized hashes conflict
+	finalizedBlocks0 := map[int64]string{}
+	if err := json.Unmarshal(conflictData.RelayReply0.FinalizedBlocksHashes, &finalizedBlocks0); err != nil {
+		return nil, 0, 0, fmt.Errorf("failed unmarshaling first reply finalized blocks hashes: %w", err)
+	}
+	finalizedBlocks1 := map[int64]string{}
+	if err := json.Unmarshal(conflictData.RelayReply1.FinalizedBlocksHashes, &finalizedBlocks1); err != nil {
+		return nil, 0, 0, fmt.Errorf("failed unmarshaling second reply finalized blocks hashes: %w", err)
+	}
+	blockNum = -1
+	for block, hash := range finalizedBlocks0 {
+		if otherHash, ok := finalizedBlocks1[block]; ok && otherHash != hash && (blockNum == -1 || block < blockNum) {
+			blockNum = block
+		}
+	}
+	if blockNum == -1 {
+		return nil, 0, 0, fmt.Errorf("no conflict between the provider's finalized blocks hashes")
+	}
+	if k.sameProviderConflictExists(ctx, epochStart, chainID, providerAddress, blockNum) {
+		return nil, 0, 0, fmt.Errorf("provider %s was already jailed for a conflict on block %d in epoch %d", providerAddress, blockNum, epochStart)
+	}
+	return providerAddress, blockNum, epochStart, nil
+}
+
+// JailSameProviderConflict jails the provider right away for the blocks the chain keeps, signing two hashes for the same block is
+// fraud that needs no vote. the conflict is kept for its epoch so the same evidence can't jail the provider again
+func (k Keeper) JailSameProviderConflict(ctx sdk.Context, chainID string, providerAddress sdk.AccAddress, blockNum int64, epochStart uint64, clientAddr sdk.AccAddress) error {
+	blocksToSave, err := k.epochstorageKeeper.BlocksToSave(ctx, uint64(ctx.BlockHeight()))
+	if err != nil {
+		return err
+	}
+	err = k.pairingKeeper.JailEntry(ctx, providerAddress, true, chainID, uint64(ctx.BlockHeight()), blocksToSave, sdk.NewCoin(epochstoragetypes.TokenDenom, sdk.ZeroInt()))
+	if err != nil {
+		return err
+	}
+	k.setSameProviderConflict(ctx, epochStart, chainID, providerAddress, blockNum)
+	details := map[string]string{"client": clientAddr.String(), "provider": providerAddress.String(), "chainID": chainID, "blockNum": strconv.FormatInt(blockNum, 10), "jailEndBlock": strconv.FormatUint(uint64(ctx.BlockHeight())+blocksToSave, 10)}
+	utils.LogLavaEvent(ctx, k.Logger(ctx), types.ConflictSameProviderJailedEventName, details, "provider jailed for signing two finalized hashes for the same block")
 	return nil
 }
+
+func (k Keeper) setSameProviderConflict(ctx sdk.Context, epochStart uint64, chainID string, providerAddress sdk.AccAddress, blockNum int64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SameProviderConflictKeyPrefix))
+	store.Set(types.SameProviderConflictKey(epochStart, chainID, providerAddress.String(), blockNum), []byte{})
+}
+
+func (k Keeper) sameProviderConflictExists(ctx sdk.Context, epochStart uint64, chainID string, providerAddress sdk.AccAddress, blockNum int64) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SameProviderConflictKeyPrefix))
+	return store.Has(types.SameProviderConflictKey(epochStart, chainID, providerAddress.String(), blockNum))
+}
+
+// RemoveOldSameProviderConflicts deletes the same provider conflicts of epochs the chain no longer keeps, evidence of these epochs is rejected anyway
+func (k Keeper) RemoveOldSameProviderConflicts(ctx sdk.Context) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SameProviderConflictKeyPrefix))
+	for _, epoch := range k.epochstorageKeeper.GetDeletedEpochs(ctx) {
+		epochStore := prefix.NewStore(store, types.SameProviderConflictEpochKey(epoch))
+		iterator := sdk.KVStorePrefixIterator(epochStore, []byte{})
+		keys := [][]byte{}
+		for ; iterator.Valid(); iterator.Next() {
+			keys = append(keys, iterator.Key())
+		}
+		iterator.Close()
+		for _, key := range keys {
+			epochStore.Delete(key)
+		}
+	}
+}
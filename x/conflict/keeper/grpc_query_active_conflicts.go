@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/x/conflict/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) ActiveConflicts(c context.Context, req *types.QueryActiveConflictsRequest) (*types.QueryActiveConflictsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	conflicts := []types.ActiveConflict{}
+	for _, conflictVote := range k.GetAllConflictVote(ctx) {
+		if req.ChainID != "" && conflictVote.ChainID != req.ChainID {
+			continue
+		}
+		if _, isJuror := FindVote(&conflictVote.Votes, req.Juror); req.Juror != "" && !isJuror {
+			continue
+		}
+		conflicts = append(conflicts, activeConflict(conflictVote))
+	}
+
+	return &types.QueryActiveConflictsResponse{Conflicts: conflicts}, nil
+}
+
+// activeConflict summarizes the vote state of a conflict, jurors are pending until they commit in commit state and until they reveal in reveal state
+func activeConflict(conflictVote types.ConflictVote) types.ActiveConflict {
+	conflict := types.ActiveConflict{
+		Index:          conflictVote.Index,
+		ChainID:        conflictVote.ChainID,
+		ClientAddress:  conflictVote.ClientAddress,
+		FirstProvider:  conflictVote.FirstProvider.Account,
+		SecondProvider: conflictVote.SecondProvider.Account,
+		VoteState:      "commit",
+		VoteStartBlock: conflictVote.VoteStartBlock,
+		VoteDeadline:   conflictVote.VoteDeadline,
+		Jurors:         uint64(len(conflictVote.Votes)),
+		PendingJurors:  []string{},
+	}
+	if conflictVote.VoteState == types.StateReveal {
+		conflict.VoteState = "reveal"
+	}
+	for _, vote := range conflictVote.Votes {
+		switch vote.Result {
+		case types.NoVote:
+			// jurors that didn't commit can't reveal anymore
+			if conflictVote.VoteState != types.StateReveal {
+				conflict.PendingJurors = append(conflict.PendingJurors, vote.Address)
+			}
+		case types.Commit:
+			conflict.Committed++
+			if conflictVote.VoteState == types.StateReveal {
+				conflict.PendingJurors = append(conflict.PendingJurors, vote.Address)
+			}
+		default:
+			conflict.Committed++
+			conflict.Revealed++
+		}
+	}
+	return conflict
+}
@@ -0,0 +1,65 @@
+package keeper_test
+
+import (
+	"math/rand"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	testkeeper "github.com/lavanet/lava/testutil/keeper"
+	"github.com/lavanet/lava/utils/sigs"
+	conflicttypes "github.com/lavanet/lava/x/conflict/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestActiveConflictsQuery(t *testing.T) {
+	ts, voteID, detection := setupForCommitTests(t)
+
+	msg := conflicttypes.MsgConflictVoteCommit{Creator: ts.Providers[2].Addr.String(), VoteID: voteID}
+	nonce := rand.Int63()
+	msg.Hash = conflicttypes.CommitVoteData(nonce, sigs.HashMsg(detection.ResponseConflict.ConflictRelayData0.Reply.Data))
+	_, err := ts.servers.ConflictServer.ConflictVoteCommit(ts.ctx, &msg)
+	require.Nil(t, err)
+
+	tests := []struct {
+		name      string
+		chainID   string
+		juror     string
+		conflicts int
+	}{
+		{"All", "", "", 1},
+		{"ChainID", ts.spec.Index, "", 1},
+		{"OtherChainID", "DIFF", "", 0},
+		{"Juror", "", ts.Providers[3].Addr.String(), 1},
+		{"ConflictingProvider", "", ts.Providers[0].Addr.String(), 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := ts.keepers.Conflict.ActiveConflicts(ts.ctx, &conflicttypes.QueryActiveConflictsRequest{ChainID: tt.chainID, Juror: tt.juror})
+			require.Nil(t, err)
+			require.Len(t, res.Conflicts, tt.conflicts)
+		})
+	}
+
+	res, err := ts.keepers.Conflict.ActiveConflicts(ts.ctx, &conflicttypes.QueryActiveConflictsRequest{})
+	require.Nil(t, err)
+	conflict := res.Conflicts[0]
+	require.Equal(t, voteID, conflict.Index)
+	require.Equal(t, "commit", conflict.VoteState)
+	require.Equal(t, uint64(NUM_OF_PROVIDERS-2), conflict.Jurors)
+	require.Equal(t, uint64(1), conflict.Committed)
+	require.Equal(t, uint64(0), conflict.Revealed)
+	require.NotContains(t, conflict.PendingJurors, ts.Providers[2].Addr.String())
+	require.Len(t, conflict.PendingJurors, NUM_OF_PROVIDERS-3)
+
+	for i := 0; i < int(ts.keepers.Conflict.VotePeriod(sdk.UnwrapSDKContext(ts.ctx)))+1; i++ {
+		ts.ctx = testkeeper.AdvanceEpoch(ts.ctx, ts.keepers)
+	}
+
+	// in reveal state only the juror that committed can still act
+	res, err = ts.keepers.Conflict.ActiveConflicts(ts.ctx, &conflicttypes.QueryActiveConflictsRequest{})
+	require.Nil(t, err)
+	conflict = res.Conflicts[0]
+	require.Equal(t, "reveal", conflict.VoteState)
+	require.Equal(t, []string{ts.Providers[2].Addr.String()}, conflict.PendingJurors)
+}
@@ -0,0 +1,79 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/x/conflict/types"
+	spectypes "github.com/lavanet/lava/x/spec/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) DeterministicApiReport(c context.Context, req *types.QueryDeterministicApiReportRequest) (*types.QueryDeterministicApiReportResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	minConflicts := req.MinConflicts
+	if minConflicts == 0 {
+		minConflicts = types.DefaultReportMinConflicts
+	}
+	minBenignPercent := req.MinBenignPercent
+	if minBenignPercent == 0 {
+		minBenignPercent = types.DefaultReportMinBenignPercent
+	}
+	if minBenignPercent > 100 {
+		return nil, status.Error(codes.InvalidArgument, "min benign percent is above 100")
+	}
+
+	apis := []types.ApiConflictStats{}
+	specs := map[string]spectypes.Spec{}
+	for _, stats := range k.GetAllApiConflictStats(ctx) {
+		if req.ChainID != "" && stats.ChainID != req.ChainID {
+			continue
+		}
+		if stats.Conflicts < minConflicts || stats.Benign*100 < minBenignPercent*stats.Conflicts {
+			continue
+		}
+		spec, found := specs[stats.ChainID]
+		if !found {
+			spec, found = k.expandedSpec(ctx, stats.ChainID)
+			if !found {
+				continue
+			}
+			specs[stats.ChainID] = spec
+		}
+		// apis that were recategorized or removed since are already fixed
+		if !isDeterministicApi(spec, stats) {
+			continue
+		}
+		apis = append(apis, stats)
+	}
+
+	return &types.QueryDeterministicApiReportResponse{Apis: apis}, nil
+}
+
+func (k Keeper) expandedSpec(ctx sdk.Context, chainID string) (spectypes.Spec, bool) {
+	spec, found := k.specKeeper.GetSpec(ctx, chainID)
+	if !found {
+		return spec, false
+	}
+	spec, err := k.specKeeper.ExpandSpec(ctx, spec)
+	return spec, err == nil
+}
+
+func isDeterministicApi(spec spectypes.Spec, stats types.ApiConflictStats) bool {
+	for _, api := range spec.Apis {
+		if api.Name != stats.Api {
+			continue
+		}
+		for _, iface := range api.ApiInterfaces {
+			if iface.Interface == stats.ApiInterface {
+				return iface.Category != nil && iface.Category.Deterministic
+			}
+		}
+	}
+	return false
+}
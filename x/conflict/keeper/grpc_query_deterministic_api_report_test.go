@@ -0,0 +1,87 @@
+package keeper_test
+
+import (
+	"math/rand"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	testkeeper "github.com/lavanet/lava/testutil/keeper"
+	"github.com/lavanet/lava/utils/sigs"
+	conflicttypes "github.com/lavanet/lava/x/conflict/types"
+	spectypes "github.com/lavanet/lava/x/spec/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeterministicApiReportQuery(t *testing.T) {
+	ts, voteID, _ := setupForCommitTests(t)
+	ctx := sdk.UnwrapSDKContext(ts.ctx)
+
+	apiCategory := &spectypes.SpecCategory{Deterministic: true}
+	ts.spec.Apis = append(ts.spec.Apis, spectypes.ServiceApi{
+		Name: "eth_gasPrice", ComputeUnits: 10, Enabled: true,
+		ApiInterfaces: []spectypes.ApiInterface{{Interface: spectypes.APIInterfaceJsonRPC, Type: "POST", Category: apiCategory}},
+	})
+	ts.keepers.Spec.SetSpec(ctx, ts.spec)
+	conflictVote, found := ts.keepers.Conflict.GetConflictVote(ctx, voteID)
+	require.True(t, found)
+	conflictVote.ApiInterface = spectypes.APIInterfaceJsonRPC
+	conflictVote.RequestData = []byte(`{"jsonrpc":"2.0","id":1,"method":"eth_gasPrice","params":[]}`)
+	ts.keepers.Conflict.SetConflictVote(ctx, conflictVote)
+
+	// all the jurors got a reply that matches neither provider
+	nonce := rand.Int63()
+	noneDataHash := sigs.HashMsg([]byte("FAKE"))
+	for i := 2; i < NUM_OF_PROVIDERS; i++ {
+		msg := conflicttypes.MsgConflictVoteCommit{Creator: ts.Providers[i].Addr.String(), VoteID: voteID, Hash: conflicttypes.CommitVoteData(nonce, noneDataHash)}
+		_, err := ts.servers.ConflictServer.ConflictVoteCommit(ts.ctx, &msg)
+		require.Nil(t, err)
+	}
+	for i := 0; i < int(ts.keepers.Conflict.VotePeriod(sdk.UnwrapSDKContext(ts.ctx)))+1; i++ {
+		ts.ctx = testkeeper.AdvanceEpoch(ts.ctx, ts.keepers)
+	}
+	for i := 2; i < NUM_OF_PROVIDERS; i++ {
+		msg := conflicttypes.MsgConflictVoteReveal{Creator: ts.Providers[i].Addr.String(), VoteID: voteID, Nonce: nonce, Hash: noneDataHash}
+		_, err := ts.servers.ConflictServer.ConflictVoteReveal(ts.ctx, &msg)
+		require.Nil(t, err)
+	}
+	for i := 0; i < int(ts.keepers.Conflict.VotePeriod(sdk.UnwrapSDKContext(ts.ctx)))+1; i++ {
+		ts.ctx = testkeeper.AdvanceEpoch(ts.ctx, ts.keepers)
+	}
+	_, found = ts.keepers.Conflict.GetConflictVote(sdk.UnwrapSDKContext(ts.ctx), voteID)
+	require.False(t, found)
+
+	stats, found := ts.keepers.Conflict.GetApiConflictStats(sdk.UnwrapSDKContext(ts.ctx), ts.spec.Index, spectypes.APIInterfaceJsonRPC, "eth_gasPrice")
+	require.True(t, found)
+	require.Equal(t, uint64(1), stats.Conflicts)
+	require.Equal(t, uint64(1), stats.Benign)
+
+	tests := []struct {
+		name    string
+		request conflicttypes.QueryDeterministicApiReportRequest
+		apis    int
+	}{
+		{"DefaultMinConflicts", conflicttypes.QueryDeterministicApiReportRequest{}, 0},
+		{"MinConflicts", conflicttypes.QueryDeterministicApiReportRequest{MinConflicts: 1}, 1},
+		{"MinBenignPercent", conflicttypes.QueryDeterministicApiReportRequest{MinConflicts: 1, MinBenignPercent: 100}, 1},
+		{"ChainID", conflicttypes.QueryDeterministicApiReportRequest{ChainID: ts.spec.Index, MinConflicts: 1}, 1},
+		{"OtherChainID", conflicttypes.QueryDeterministicApiReportRequest{ChainID: "DIFF", MinConflicts: 1}, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := ts.keepers.Conflict.DeterministicApiReport(ts.ctx, &tt.request)
+			require.Nil(t, err)
+			require.Len(t, res.Apis, tt.apis)
+		})
+	}
+
+	_, err := ts.keepers.Conflict.DeterministicApiReport(ts.ctx, &conflicttypes.QueryDeterministicApiReportRequest{MinBenignPercent: 101})
+	require.NotNil(t, err)
+
+	// once the spec is fixed the api isn't reported
+	apiCategory.Deterministic = false
+	ts.keepers.Spec.SetSpec(sdk.UnwrapSDKContext(ts.ctx), ts.spec)
+	res, err := ts.keepers.Conflict.DeterministicApiReport(ts.ctx, &conflicttypes.QueryDeterministicApiReportRequest{MinConflicts: 1})
+	require.Nil(t, err)
+	require.Empty(t, res.Apis)
+}
@@ -23,6 +23,7 @@ type (
 		pairingKeeper      types.PairingKeeper
 		epochstorageKeeper types.EpochstorageKeeper
 		specKeeper         types.SpecKeeper
+		distributionKeeper types.DistributionKeeper
 	}
 )
 
@@ -32,7 +33,7 @@ func NewKeeper(
 	memKey sdk.StoreKey,
 	ps paramtypes.Subspace,
 
-	bankKeeper types.BankKeeper, accountKeeper types.AccountKeeper, pairingKeeper types.PairingKeeper, epochstorageKeeper types.EpochstorageKeeper, specKeeper types.SpecKeeper,
+	bankKeeper types.BankKeeper, accountKeeper types.AccountKeeper, pairingKeeper types.PairingKeeper, epochstorageKeeper types.EpochstorageKeeper, specKeeper types.SpecKeeper, distributionKeeper types.DistributionKeeper,
 ) *Keeper {
 	// set KeyTable if it has not already been set
 	if !ps.HasKeyTable() {
@@ -44,7 +45,7 @@ func NewKeeper(
 		storeKey:   storeKey,
 		memKey:     memKey,
 		paramstore: ps,
-		bankKeeper: bankKeeper, accountKeeper: accountKeeper, pairingKeeper: pairingKeeper, epochstorageKeeper: epochstorageKeeper, specKeeper: specKeeper,
+		bankKeeper: bankKeeper, accountKeeper: accountKeeper, pairingKeeper: pairingKeeper, epochstorageKeeper: epochstorageKeeper, specKeeper: specKeeper, distributionKeeper: distributionKeeper,
 	}
 }
 
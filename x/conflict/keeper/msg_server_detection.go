@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"math/big"
 	"strconv"
 	"strings"
 
@@ -29,10 +30,15 @@ func (k msgServer) Detection(goCtx context.Context, msg *types.MsgDetection) (*t
 			return nil, utils.LavaError(ctx, logger, "Finalization_conflict_detection", map[string]string{"client": msg.Creator, "error": err.Error()}, "Simulation: finalization conflict detection error")
 		}
 	} else if msg.FinalizationConflict == nil && msg.ResponseConflict == nil && msg.SameProviderConflict != nil {
-		err := k.Keeper.ValidateSameProviderConflict(ctx, msg.SameProviderConflict, clientAddr)
+		providerAddr, blockNum, epochStart, err := k.Keeper.ValidateSameProviderConflict(ctx, msg.SameProviderConflict, clientAddr)
 		if err != nil {
 			return nil, utils.LavaError(ctx, logger, "same_provider_conflict_detection", map[string]string{"client": msg.Creator, "error": err.Error()}, "Simulation: same provider conflict detection error")
 		}
+		err = k.Keeper.JailSameProviderConflict(ctx, msg.SameProviderConflict.RelaySession0.SpecId, providerAddr, blockNum, epochStart, clientAddr)
+		if err != nil {
+			return nil, utils.LavaError(ctx, logger, "same_provider_conflict_detection", map[string]string{"client": msg.Creator, "provider": providerAddr.String(), "error": err.Error()}, "Simulation: failed jailing provider of same provider conflict")
+		}
+		return &types.MsgDetectionResponse{}, nil
 	} else if msg.FinalizationConflict == nil && msg.ResponseConflict != nil && msg.SameProviderConflict == nil {
 		err := k.Keeper.ValidateResponseConflict(ctx, msg.ResponseConflict, clientAddr)
 		if err != nil {
@@ -73,13 +79,14 @@ func (k msgServer) Detection(goCtx context.Context, msg *types.MsgDetection) (*t
 		conflictVote.ChainID = msg.ResponseConflict.ConflictRelayData0.Request.RelaySession.SpecId
 		conflictVote.RequestBlock = uint64(msg.ResponseConflict.ConflictRelayData0.Request.RelayData.RequestBlock)
 		conflictVote.RequestData = msg.ResponseConflict.ConflictRelayData0.Request.RelayData.Data
+		conflictVote.ApiInterface = msg.ResponseConflict.ConflictRelayData0.Request.RelayData.ApiInterface
 
 		conflictVote.FirstProvider.Account = msg.ResponseConflict.ConflictRelayData0.Request.RelaySession.Provider
 		conflictVote.FirstProvider.Response = tendermintcrypto.Sha256(msg.ResponseConflict.ConflictRelayData0.Reply.Data)
 		conflictVote.SecondProvider.Account = msg.ResponseConflict.ConflictRelayData1.Request.RelaySession.Provider
 		conflictVote.SecondProvider.Response = tendermintcrypto.Sha256(msg.ResponseConflict.ConflictRelayData1.Reply.Data)
 		conflictVote.Votes = []types.Vote{}
-		voters := k.Keeper.LotteryVoters(goCtx, epochStart, conflictVote.ChainID, []string{conflictVote.FirstProvider.Account, conflictVote.SecondProvider.Account})
+		voters := k.Keeper.LotteryVoters(goCtx, epochStart, conflictVote.ChainID, conflictVote.Index, []string{conflictVote.FirstProvider.Account, conflictVote.SecondProvider.Account})
 		for _, voter := range voters {
 			conflictVote.Votes = append(conflictVote.Votes, types.Vote{Address: voter, Hash: []byte{}, Result: types.NoVote})
 		}
@@ -95,7 +102,7 @@ func (k msgServer) Detection(goCtx context.Context, msg *types.MsgDetection) (*t
 		eventData["requestBlock"] = strconv.FormatUint(conflictVote.RequestBlock, 10)
 		eventData["voteDeadline"] = strconv.FormatUint(conflictVote.VoteDeadline, 10)
 		eventData["voters"] = strings.Join(voters, ",")
-		eventData["apiInterface"] = msg.ResponseConflict.ConflictRelayData0.Request.RelayData.ApiInterface
+		eventData["apiInterface"] = conflictVote.ApiInterface
 
 		utils.LogLavaEvent(ctx, logger, types.ConflictVoteDetectionEventName, eventData, "Simulation: Got a new valid conflict detection from consumer, starting new vote")
 		return &types.MsgDetectionResponse{}, nil
@@ -106,18 +113,59 @@ func (k msgServer) Detection(goCtx context.Context, msg *types.MsgDetection) (*t
 	return &types.MsgDetectionResponse{}, nil
 }
 
-func (k Keeper) LotteryVoters(goCtx context.Context, epoch uint64, chainID string, exemptions []string) []string {
+// LotteryVoters randomly selects up to the JurorsCount param jurors out of the epoch's providers, weighted by their stake. qos reports
+// don't weigh in since the clients that send them could steer the jury
+func (k Keeper) LotteryVoters(goCtx context.Context, epoch uint64, chainID string, voteID string, exemptions []string) []string {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	entries, err := k.epochstorageKeeper.GetStakeEntryForAllProvidersEpoch(ctx, chainID, epoch)
 	if err != nil {
 		return make([]string, 0)
 	}
 
-	voters := make([]string, 0)
+	candidates := make([]string, 0)
+	weights := make([]sdk.Int, 0)
 	for _, entry := range *entries {
 		if !slices.Contains(exemptions, entry.Address) {
-			voters = append(voters, entry.Address)
+			candidates = append(candidates, entry.Address)
+			weights = append(weights, entry.Stake.Amount)
+		}
+	}
+
+	jurorsCount := k.JurorsCount(ctx)
+	if jurorsCount == 0 || uint64(len(candidates)) <= jurorsCount {
+		return candidates
+	}
+
+	weightSum := sdk.ZeroInt()
+	for _, weight := range weights {
+		weightSum = weightSum.Add(weight)
+	}
+
+	// the block hash makes the jury unpredictable when the detection is sent, the vote id makes it unique per conflict
+	hashData := append([]byte{}, ctx.HeaderHash()...)
+	hashData = append(hashData, chainID...)
+	hashData = append(hashData, voteID...)
+
+	voters := make([]string, 0)
+	selected := make(map[int]bool)
+	for it := 0; uint64(len(voters)) < jurorsCount && weightSum.IsPositive(); it++ {
+		hash := tendermintcrypto.Sha256(hashData)
+		modRes := sdk.NewIntFromBigInt(new(big.Int).SetBytes(hash)).Mod(weightSum)
+
+		newWeightSum := sdk.ZeroInt()
+		for idx, weight := range weights {
+			if selected[idx] {
+				continue
+			}
+			newWeightSum = newWeightSum.Add(weight)
+			if modRes.LT(newWeightSum) {
+				voters = append(voters, candidates[idx])
+				weightSum = weightSum.Sub(weight) // remove the juror from the pool
+				selected[idx] = true
+				break
+			}
 		}
+		hashData = append(hashData, []byte{uint8(it)}...)
 	}
 
 	return voters
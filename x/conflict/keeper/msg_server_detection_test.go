@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"context"
+	"encoding/json"
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -134,3 +135,98 @@ func TestDetection(t *testing.T) {
 		})
 	}
 }
+
+func TestJurorsCount(t *testing.T) {
+	ts := setupForConflictTests(t, NUM_OF_PROVIDERS)
+	ctx := sdk.UnwrapSDKContext(ts.ctx)
+	params := ts.keepers.Conflict.GetParams(ctx)
+	params.JurorsCount = 2
+	ts.keepers.Conflict.SetParams(ctx, params)
+
+	msg, err := common.CreateMsgDetection(ts.ctx, ts.consumer, ts.Providers[0], ts.Providers[1], ts.spec)
+	require.Nil(t, err)
+	_, err = ts.servers.ConflictServer.Detection(ts.ctx, &msg)
+	require.Nil(t, err)
+
+	conflictVotes := ts.keepers.Conflict.GetAllConflictVote(ctx)
+	require.Len(t, conflictVotes, 1)
+	require.Len(t, conflictVotes[0].Votes, int(params.JurorsCount))
+	jurors := map[string]bool{}
+	for _, vote := range conflictVotes[0].Votes {
+		// the conflicting providers can't be jurors
+		require.NotEqual(t, ts.Providers[0].Addr.String(), vote.Address)
+		require.NotEqual(t, ts.Providers[1].Addr.String(), vote.Address)
+		jurors[vote.Address] = true
+	}
+	require.Len(t, jurors, int(params.JurorsCount))
+}
+
+func TestSameProviderConflict(t *testing.T) {
+	ts := setupForConflictTests(t, NUM_OF_PROVIDERS)
+	ctx := sdk.UnwrapSDKContext(ts.ctx)
+
+	signFinalizationData := func(provider common.Account, relayNum uint64, finalizedBlocks map[int64]string) (*types.RelayReply, *types.RelaySession) {
+		session := &types.RelaySession{Provider: provider.Addr.String(), SpecId: ts.spec.Index, SessionId: 1, Epoch: ctx.BlockHeight(), RelayNum: relayNum}
+		finalizedBlocksHashes, err := json.Marshal(finalizedBlocks)
+		require.Nil(t, err)
+		reply := &types.RelayReply{LatestBlock: 110, FinalizedBlocksHashes: finalizedBlocksHashes}
+		reply.SigBlocks, err = sigs.SignResponseFinalizationDataHash(provider.SK, reply, &types.RelayRequest{RelaySession: session}, ts.consumer.Addr)
+		require.Nil(t, err)
+		return reply, session
+	}
+	sameProviderConflictMsg := func(provider0 common.Account, finalizedBlocks0 map[int64]string, provider1 common.Account, finalizedBlocks1 map[int64]string) *conflicttypes.MsgDetection {
+		reply0, session0 := signFinalizationData(provider0, 1, finalizedBlocks0)
+		reply1, session1 := signFinalizationData(provider1, 2, finalizedBlocks1)
+		return conflicttypes.NewMsgDetection(ts.consumer.Addr.String(), nil, nil, &conflicttypes.FinalizationConflict{RelayReply0: reply0, RelaySession0: session0, RelayReply1: reply1, RelaySession1: session1})
+	}
+
+	// the same hashes are no conflict
+	msg := sameProviderConflictMsg(ts.Providers[0], map[int64]string{100: "a100"}, ts.Providers[0], map[int64]string{100: "a100", 101: "a101"})
+	_, err := ts.servers.ConflictServer.Detection(ts.ctx, msg)
+	require.NotNil(t, err)
+
+	// different hashes signed by different providers are not a same provider conflict
+	msg = sameProviderConflictMsg(ts.Providers[0], map[int64]string{100: "a100"}, ts.Providers[1], map[int64]string{100: "b100"})
+	_, err = ts.servers.ConflictServer.Detection(ts.ctx, msg)
+	require.NotNil(t, err)
+	require.False(t, ts.keepers.Pairing.IsProviderJailed(ctx, ts.spec.Index, ts.Providers[0].Addr, uint64(ctx.BlockHeight())))
+
+	// the provider is jailed right away, without a vote
+	msg = sameProviderConflictMsg(ts.Providers[0], map[int64]string{99: "a99", 100: "a100"}, ts.Providers[0], map[int64]string{99: "a99", 100: "b100"})
+	_, err = ts.servers.ConflictServer.Detection(ts.ctx, msg)
+	require.Nil(t, err)
+	events := ctx.EventManager().Events()
+	require.Equal(t, "lava_"+conflicttypes.ConflictSameProviderJailedEventName, events[len(events)-1].Type)
+	require.True(t, ts.keepers.Pairing.IsProviderJailed(ctx, ts.spec.Index, ts.Providers[0].Addr, uint64(ctx.BlockHeight())))
+	require.Empty(t, ts.keepers.Conflict.GetAllConflictVote(ctx))
+
+	// the same conflict can't be reported again
+	_, err = ts.servers.ConflictServer.Detection(ts.ctx, msg)
+	require.NotNil(t, err)
+}
+
+func TestSameProviderConflictForged(t *testing.T) {
+	ts := setupForConflictTests(t, NUM_OF_PROVIDERS)
+	ctx := sdk.UnwrapSDKContext(ts.ctx)
+	provider := ts.Providers[0]
+
+	// legacy finalization data signatures cover only the first 32 bytes of the data, a consumer can change the hashes after them
+	session := &types.RelaySession{Provider: provider.Addr.String(), SpecId: ts.spec.Index, SessionId: 1, Epoch: ctx.BlockHeight(), RelayNum: 1}
+	honestHashes, err := json.Marshal(map[int64]string{100: "0123456789abcdef0123456789abcdef0123456789abcdef"})
+	require.Nil(t, err)
+	forgedHashes, err := json.Marshal(map[int64]string{100: "0123456789abcdef0123456789abcdef0123456789abcdeg"})
+	require.Nil(t, err)
+	honestReply := &types.RelayReply{LatestBlock: 110, FinalizedBlocksHashes: honestHashes}
+	honestReply.SigBlocks, err = sigs.SignResponseFinalizationData(provider.SK, honestReply, &types.RelayRequest{RelaySession: session}, ts.consumer.Addr)
+	require.Nil(t, err)
+	forgedReply := &types.RelayReply{LatestBlock: 110, FinalizedBlocksHashes: forgedHashes, SigBlocks: honestReply.SigBlocks}
+	pubKey, err := sigs.RecoverPubKeyFromResponseFinalizationData(forgedReply, &types.RelayRequest{RelaySession: session}, ts.consumer.Addr)
+	require.Nil(t, err)
+	require.Equal(t, provider.Addr, sdk.AccAddress(pubKey.Address()))
+
+	// the forged evidence doesn't jail the provider
+	msg := conflicttypes.NewMsgDetection(ts.consumer.Addr.String(), nil, nil, &conflicttypes.FinalizationConflict{RelayReply0: honestReply, RelaySession0: session, RelayReply1: forgedReply, RelaySession1: session})
+	_, err = ts.servers.ConflictServer.Detection(ts.ctx, msg)
+	require.NotNil(t, err)
+	require.False(t, ts.keepers.Pairing.IsProviderJailed(ctx, ts.spec.Index, provider.Addr, uint64(ctx.BlockHeight())))
+}
@@ -12,6 +12,10 @@ func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 		k.VoteStartSpan(ctx),
 		k.VotePeriod(ctx),
 		k.Rewards(ctx),
+		k.RevealWindow(ctx),
+		k.JurorsCount(ctx),
+		k.NonRevealSlashFraction(ctx),
+		k.FraudVoteSlashFraction(ctx),
 	)
 }
 
@@ -40,3 +44,27 @@ func (k Keeper) Rewards(ctx sdk.Context) (res types.Rewards) {
 	k.paramstore.Get(ctx, types.KeyRewards, &res)
 	return
 }
+
+func (k Keeper) RevealWindow(ctx sdk.Context) (res uint64) {
+	k.paramstore.GetIfExists(ctx, types.KeyRevealWindow, &res)
+	return
+}
+
+func (k Keeper) JurorsCount(ctx sdk.Context) (res uint64) {
+	k.paramstore.GetIfExists(ctx, types.KeyJurorsCount, &res)
+	return
+}
+
+// NonRevealSlashFraction returns the NonRevealSlashFraction param, chains that didn't set it keep slashing the default fraction
+func (k Keeper) NonRevealSlashFraction(ctx sdk.Context) sdk.Dec {
+	res := types.DefaultNonRevealSlashFraction
+	k.paramstore.GetIfExists(ctx, types.KeyNonRevealSlashFraction, &res)
+	return res
+}
+
+// FraudVoteSlashFraction returns the FraudVoteSlashFraction param, chains that didn't set it slash the default fraction
+func (k Keeper) FraudVoteSlashFraction(ctx sdk.Context) sdk.Dec {
+	res := types.DefaultFraudVoteSlashFraction
+	k.paramstore.GetIfExists(ctx, types.KeyFraudVoteSlashFraction, &res)
+	return res
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/lavanet/lava/utils"
 	"github.com/lavanet/lava/x/conflict/types"
 	epochstoragetypes "github.com/lavanet/lava/x/epochstorage/types"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
 	"golang.org/x/exp/slices"
 )
 
@@ -15,25 +16,25 @@ const (
 	MajorityDiv  = 2 // 50%
 )
 
-var SlashStakePercent = sdk.NewDecWithPrec(5, 2) // 0.05
-
 func (k Keeper) AllocateNewConflictVote(ctx sdk.Context, key string) bool {
 	_, found := k.GetConflictVote(ctx, key)
 
 	return found
 }
 
+// CheckAndHandleAllVotes moves votes to reveal state at epoch start, and closes votes once their reveal window ended, which the RevealWindow param can set mid epoch
 func (k Keeper) CheckAndHandleAllVotes(ctx sdk.Context) {
-	if k.IsEpochStart(ctx) {
-		conflictVotes := k.GetAllConflictVote(ctx)
-		for _, conflictVote := range conflictVotes {
-			if conflictVote.VoteDeadline <= uint64(ctx.BlockHeight()) {
-				switch conflictVote.VoteState {
-				case types.StateCommit:
+	isEpochStart := k.IsEpochStart(ctx)
+	conflictVotes := k.GetAllConflictVote(ctx)
+	for _, conflictVote := range conflictVotes {
+		if conflictVote.VoteDeadline <= uint64(ctx.BlockHeight()) {
+			switch conflictVote.VoteState {
+			case types.StateCommit:
+				if isEpochStart {
 					k.TransitionVoteToReveal(ctx, conflictVote)
-				case types.StateReveal:
-					k.HandleAndCloseVote(ctx, conflictVote)
 				}
+			case types.StateReveal:
+				k.HandleAndCloseVote(ctx, conflictVote)
 			}
 		}
 	}
@@ -48,9 +49,9 @@ func (k Keeper) HandleAndCloseVote(ctx sdk.Context, conflictVote types.ConflictV
 	// valid only if one of the votes is bigger than 50% from total
 	// punish providers that didnt vote - discipline/jail + bail = 20%stake + slash 5%stake
 	// (dont add jailed providers to voters)
-	// if strong majority punish wrong providers - jail from start of memory to end + slash 100%stake
+	// if strong majority punish wrong providers - unstake + slash FraudVoteSlashFraction of the stake
 	// reward pool is the slashed amount from all punished providers
-	// reward to stake - client 50%, the original provider 10%, 20% the voters
+	// reward to stake - client, the original provider and the voters by the Rewards param, the rest funds the community pool
 	totalVotes := sdk.ZeroInt()
 	firstProviderVotes := sdk.ZeroInt()
 	secondProviderVotes := sdk.ZeroInt()
@@ -105,17 +106,18 @@ func (k Keeper) HandleAndCloseVote(ctx sdk.Context, conflictVote types.ConflictV
 		case types.NoneOfTheProviders:
 			noneProviderVotes = noneProviderVotes.Add(stake)
 		default:
-			// punish providers that didnt vote
+			// punish providers that didnt reveal, whether they committed or not
 			providersWithoutVote = append(providersWithoutVote, vote.Address)
-			bail := stake
-			bail.Quo(sdk.NewIntFromUint64(BailStakeDiv))
+			bail := stake.Quo(sdk.NewIntFromUint64(BailStakeDiv))
 			k.pairingKeeper.JailEntry(ctx, accAddress, true, conflictVote.ChainID, conflictVote.VoteStartBlock, blocksToSave, sdk.NewCoin(epochstoragetypes.TokenDenom, bail))
-			slashed, err := k.pairingKeeper.SlashEntry(ctx, accAddress, true, conflictVote.ChainID, SlashStakePercent)
+			slashed, err := k.pairingKeeper.SlashEntry(ctx, accAddress, true, conflictVote.ChainID, k.NonRevealSlashFraction(ctx))
 			rewardPool = rewardPool.Add(slashed)
 			if err != nil {
 				utils.LavaError(ctx, logger, "slash_failed_vote", map[string]string{"error": err.Error()}, "slashing failed at vote conflict")
 				continue
 			}
+			jurorDetails := map[string]string{"voteID": conflictVote.Index, "juror": vote.Address, "committed": strconv.FormatBool(vote.Result == types.Commit), "slashed": slashed.String()}
+			utils.LogLavaEvent(ctx, logger, types.ConflictJurorNotRevealedEventName, jurorDetails, "juror didn't reveal its vote")
 		}
 	}
 	eventData["NumOfNoVoters"] = strconv.FormatInt(int64(len(providersWithoutVote)), 10)
@@ -159,7 +161,7 @@ func (k Keeper) HandleAndCloseVote(ctx sdk.Context, conflictVote types.ConflictV
 						utils.LavaError(ctx, logger, "invalid_address", map[string]string{"error": err.Error()}, "")
 						continue
 					}
-					slashed, err := k.pairingKeeper.SlashEntry(ctx, accAddress, true, conflictVote.ChainID, sdk.NewDecWithPrec(1, 0))
+					slashed, err := k.pairingKeeper.SlashEntry(ctx, accAddress, true, conflictVote.ChainID, k.FraudVoteSlashFraction(ctx))
 					rewardPool = rewardPool.Add(slashed)
 					if err != nil {
 						utils.LavaError(ctx, logger, "slash_failed_vote", map[string]string{"error": err.Error()}, "slashing failed at vote conflict")
@@ -178,6 +180,11 @@ func (k Keeper) HandleAndCloseVote(ctx sdk.Context, conflictVote types.ConflictV
 		eventData["voteFailed"] = "not_enough_voters"
 	}
 
+	// jurors that revealed replies matching neither provider, or that split between them, point at a non deterministic api
+	if firstProviderVotes.Add(secondProviderVotes).Add(noneProviderVotes).IsPositive() {
+		k.recordApiConflict(ctx, conflictVote, !majorityMet || winner == types.NoneOfTheProviders)
+	}
+
 	// reward client
 	clientRewardPoolPercentage := k.Rewards(ctx).ClientRewardPercent
 	clientReward := clientRewardPoolPercentage.MulInt(rewardPool.Amount)
@@ -260,6 +267,17 @@ func (k Keeper) HandleAndCloseVote(ctx sdk.Context, conflictVote types.ConflictV
 
 	eventData["RewardPool"] = rewardPool.Amount.String()
 
+	// the slashed stake that wasn't rewarded stays in the pairing module otherwise, with no stake entry owning it
+	if leftover := rewardPool.Amount.Sub(rewardCount); leftover.IsPositive() {
+		leftoverCoins := sdk.NewCoins(sdk.NewCoin(epochstoragetypes.TokenDenom, leftover))
+		err := k.distributionKeeper.FundCommunityPool(ctx, leftoverCoins, k.accountKeeper.GetModuleAddress(pairingtypes.ModuleName))
+		if err != nil {
+			utils.LavaError(ctx, logger, "fund_community_pool_failed", map[string]string{"voteID": conflictVote.Index, "amount": leftoverCoins.String(), "error": err.Error()}, "failed to fund the community pool with the rest of the reward pool")
+		} else {
+			eventData["CommunityPoolFund"] = leftover.String()
+		}
+	}
+
 	k.RemoveConflictVote(ctx, conflictVote.Index)
 
 	utils.LogLavaEvent(ctx, logger, eventName, eventData, "conflict detection resolved")
@@ -268,12 +286,16 @@ func (k Keeper) HandleAndCloseVote(ctx sdk.Context, conflictVote types.ConflictV
 func (k Keeper) TransitionVoteToReveal(ctx sdk.Context, conflictVote types.ConflictVote) {
 	logger := k.Logger(ctx)
 	conflictVote.VoteState = types.StateReveal
-	epochBlocks, err := k.epochstorageKeeper.EpochBlocks(ctx, uint64(ctx.BlockHeight()))
-	if err != nil {
-		k.CleanUpVote(ctx, conflictVote.Index)
+	if revealWindow := k.RevealWindow(ctx); revealWindow > 0 {
+		conflictVote.VoteDeadline = uint64(ctx.BlockHeight()) + revealWindow
+	} else {
+		epochBlocks, err := k.epochstorageKeeper.EpochBlocks(ctx, uint64(ctx.BlockHeight()))
+		if err != nil {
+			k.CleanUpVote(ctx, conflictVote.Index)
+			return
+		}
+		conflictVote.VoteDeadline = uint64(ctx.BlockHeight()) + k.VotePeriod(ctx)*epochBlocks
 	}
-
-	conflictVote.VoteDeadline = uint64(ctx.BlockHeight()) + k.VotePeriod(ctx)*epochBlocks
 	k.SetConflictVote(ctx, conflictVote)
 
 	eventData := map[string]string{}
@@ -9,6 +9,7 @@ import (
 	testkeeper "github.com/lavanet/lava/testutil/keeper"
 	"github.com/lavanet/lava/utils/sigs"
 	conflicttypes "github.com/lavanet/lava/x/conflict/types"
+	epochstoragetypes "github.com/lavanet/lava/x/epochstorage/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -483,3 +484,139 @@ func TestNoDecisionVote(t *testing.T) {
 	LastEvent := sdk.UnwrapSDKContext(ts.ctx).EventManager().Events()[len(sdk.UnwrapSDKContext(ts.ctx).EventManager().Events())-1]
 	require.Equal(t, LastEvent.Type, "lava_"+conflicttypes.ConflictVoteUnresolvedEventName)
 }
+
+func TestRevealWindow(t *testing.T) {
+	ts, voteID, detection := setupForCommitTests(t)
+	ctx := sdk.UnwrapSDKContext(ts.ctx)
+	params := ts.keepers.Conflict.GetParams(ctx)
+	params.RevealWindow = 3
+	ts.keepers.Conflict.SetParams(ctx, params)
+
+	msg := conflicttypes.MsgConflictVoteCommit{Creator: ts.Providers[2].Addr.String(), VoteID: voteID}
+	nonce := rand.Int63()
+	msg.Hash = conflicttypes.CommitVoteData(nonce, sigs.HashMsg(detection.ResponseConflict.ConflictRelayData0.Reply.Data))
+	_, err := ts.servers.ConflictServer.ConflictVoteCommit(ts.ctx, &msg)
+	require.Nil(t, err)
+
+	conflictVote, found := ts.keepers.Conflict.GetConflictVote(ctx, voteID)
+	require.True(t, found)
+	for conflictVote.VoteState == conflicttypes.StateCommit {
+		ts.ctx = testkeeper.AdvanceBlock(ts.ctx, ts.keepers)
+		conflictVote, found = ts.keepers.Conflict.GetConflictVote(sdk.UnwrapSDKContext(ts.ctx), voteID)
+		require.True(t, found)
+	}
+	require.Equal(t, uint64(sdk.UnwrapSDKContext(ts.ctx).BlockHeight())+params.RevealWindow, conflictVote.VoteDeadline)
+
+	ts.ctx = testkeeper.AdvanceBlocks(ts.ctx, ts.keepers, int(params.RevealWindow)-1)
+	_, found = ts.keepers.Conflict.GetConflictVote(sdk.UnwrapSDKContext(ts.ctx), voteID)
+	require.True(t, found)
+
+	// the vote closes mid epoch once the reveal window ends
+	ts.ctx = testkeeper.AdvanceBlock(ts.ctx, ts.keepers)
+	require.False(t, ts.keepers.Conflict.IsEpochStart(sdk.UnwrapSDKContext(ts.ctx)))
+	_, found = ts.keepers.Conflict.GetConflictVote(sdk.UnwrapSDKContext(ts.ctx), voteID)
+	require.False(t, found)
+}
+
+func TestNonRevealingJurorSlashed(t *testing.T) {
+	ts, voteID, detection := setupForCommitTests(t)
+
+	msg := conflicttypes.MsgConflictVoteCommit{VoteID: voteID}
+	nonce := rand.Int63()
+	msg.Hash = conflicttypes.CommitVoteData(nonce, sigs.HashMsg(detection.ResponseConflict.ConflictRelayData0.Reply.Data))
+	for i := 2; i < NUM_OF_PROVIDERS; i++ {
+		msg.Creator = ts.Providers[i].Addr.String()
+		_, err := ts.servers.ConflictServer.ConflictVoteCommit(ts.ctx, &msg)
+		require.Nil(t, err)
+	}
+
+	for i := 0; i < int(ts.keepers.Conflict.VotePeriod(sdk.UnwrapSDKContext(ts.ctx)))+1; i++ {
+		ts.ctx = testkeeper.AdvanceEpoch(ts.ctx, ts.keepers)
+	}
+
+	// the last juror committed but doesn't reveal
+	msgReveal := conflicttypes.MsgConflictVoteReveal{VoteID: voteID, Nonce: nonce}
+	msgReveal.Hash = sigs.HashMsg(detection.ResponseConflict.ConflictRelayData0.Reply.Data)
+	for i := 2; i < NUM_OF_PROVIDERS-1; i++ {
+		msgReveal.Creator = ts.Providers[i].Addr.String()
+		_, err := ts.servers.ConflictServer.ConflictVoteReveal(ts.ctx, &msgReveal)
+		require.Nil(t, err)
+	}
+
+	nonRevealer := ts.Providers[NUM_OF_PROVIDERS-1].Addr
+	stakeEntry, found, _ := ts.keepers.Epochstorage.GetStakeEntryByAddressCurrent(sdk.UnwrapSDKContext(ts.ctx), epochstoragetypes.ProviderKey, ts.spec.Index, nonRevealer)
+	require.True(t, found)
+	stake := stakeEntry.Stake.Amount
+
+	for i := 0; i < int(ts.keepers.Conflict.VotePeriod(sdk.UnwrapSDKContext(ts.ctx)))+1; i++ {
+		ts.ctx = testkeeper.AdvanceEpoch(ts.ctx, ts.keepers)
+	}
+
+	_, found = ts.keepers.Conflict.GetConflictVote(sdk.UnwrapSDKContext(ts.ctx), voteID)
+	require.False(t, found)
+
+	slashed := ts.keepers.Conflict.NonRevealSlashFraction(sdk.UnwrapSDKContext(ts.ctx)).MulInt(stake).TruncateInt()
+	stakeEntry, found, _ = ts.keepers.Epochstorage.GetStakeEntryByAddressCurrent(sdk.UnwrapSDKContext(ts.ctx), epochstoragetypes.ProviderKey, ts.spec.Index, nonRevealer)
+	require.True(t, found)
+	require.True(t, slashed.IsPositive())
+	require.Equal(t, stake.Sub(slashed), stakeEntry.Stake.Amount)
+}
+
+func TestFraudVoterSlashed(t *testing.T) {
+	ts, voteID, detection := setupForCommitTests(t)
+
+	// two of the three jurors are a strong enough majority
+	params := ts.keepers.Conflict.GetParams(sdk.UnwrapSDKContext(ts.ctx))
+	params.MajorityPercent = sdk.NewDecWithPrec(6, 1)
+	ts.keepers.Conflict.SetParams(sdk.UnwrapSDKContext(ts.ctx), params)
+
+	nonce := rand.Int63()
+	fraudVoter := ts.Providers[NUM_OF_PROVIDERS-1].Addr
+	replyHash := func(i int) []byte {
+		if ts.Providers[i].Addr.Equals(fraudVoter) {
+			return sigs.HashMsg(detection.ResponseConflict.ConflictRelayData1.Reply.Data)
+		}
+		return sigs.HashMsg(detection.ResponseConflict.ConflictRelayData0.Reply.Data)
+	}
+	for i := 2; i < NUM_OF_PROVIDERS; i++ {
+		msg := conflicttypes.MsgConflictVoteCommit{Creator: ts.Providers[i].Addr.String(), VoteID: voteID, Hash: conflicttypes.CommitVoteData(nonce, replyHash(i))}
+		_, err := ts.servers.ConflictServer.ConflictVoteCommit(ts.ctx, &msg)
+		require.Nil(t, err)
+	}
+
+	for i := 0; i < int(ts.keepers.Conflict.VotePeriod(sdk.UnwrapSDKContext(ts.ctx)))+1; i++ {
+		ts.ctx = testkeeper.AdvanceEpoch(ts.ctx, ts.keepers)
+	}
+
+	for i := 2; i < NUM_OF_PROVIDERS; i++ {
+		msgReveal := conflicttypes.MsgConflictVoteReveal{Creator: ts.Providers[i].Addr.String(), VoteID: voteID, Nonce: nonce, Hash: replyHash(i)}
+		_, err := ts.servers.ConflictServer.ConflictVoteReveal(ts.ctx, &msgReveal)
+		require.Nil(t, err)
+	}
+
+	stakeEntry, found, _ := ts.keepers.Epochstorage.GetStakeEntryByAddressCurrent(sdk.UnwrapSDKContext(ts.ctx), epochstoragetypes.ProviderKey, ts.spec.Index, fraudVoter)
+	require.True(t, found)
+	stake := stakeEntry.Stake.Amount
+
+	for i := 0; i < int(ts.keepers.Conflict.VotePeriod(sdk.UnwrapSDKContext(ts.ctx)))+1; i++ {
+		ts.ctx = testkeeper.AdvanceEpoch(ts.ctx, ts.keepers)
+	}
+
+	_, found = ts.keepers.Conflict.GetConflictVote(sdk.UnwrapSDKContext(ts.ctx), voteID)
+	require.False(t, found)
+
+	// the fraud voter loses the param's fraction of its stake and is unstaked
+	slashed := ts.keepers.Conflict.FraudVoteSlashFraction(sdk.UnwrapSDKContext(ts.ctx)).MulInt(stake).TruncateInt()
+	require.True(t, slashed.IsPositive())
+	require.True(t, slashed.LT(stake))
+	unstakeEntry, found, _ := ts.keepers.Epochstorage.UnstakeEntryByAddress(sdk.UnwrapSDKContext(ts.ctx), epochstoragetypes.ProviderKey, fraudVoter)
+	require.True(t, found)
+	require.Equal(t, stake.Sub(slashed), unstakeEntry.Stake.Amount)
+
+	// the part of the slashed stake that wasn't rewarded funds the community pool
+	rewards := ts.keepers.Conflict.Rewards(sdk.UnwrapSDKContext(ts.ctx))
+	rewardedShare := rewards.ClientRewardPercent.Add(rewards.WinnerRewardPercent).Add(rewards.VotersRewardPercent)
+	communityPool := ts.keepers.DistributionKeeper.GetCommunityPool().AmountOf(epochstoragetypes.TokenDenom)
+	require.True(t, communityPool.IsPositive())
+	require.True(t, communityPool.GTE(sdk.OneDec().Sub(rewardedShare).MulInt(slashed).TruncateInt()))
+}
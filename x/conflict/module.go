@@ -168,6 +168,9 @@ func (AppModule) ConsensusVersion() uint64 { return 2 }
 // BeginBlock executes all ABCI BeginBlock logic respective to the capability module.
 func (am AppModule) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {
 	am.keeper.CheckAndHandleAllVotes(ctx)
+	if am.keeper.IsEpochStart(ctx) {
+		am.keeper.RemoveOldSameProviderConflicts(ctx)
+	}
 }
 
 // EndBlock executes all ABCI EndBlock logic respective to the capability module. It
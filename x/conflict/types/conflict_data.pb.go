@@ -129,8 +129,10 @@ func (m *ConflictRelayData) GetReply() *types.RelayReply {
 }
 
 type FinalizationConflict struct {
-	RelayReply0 *types.RelayReply `protobuf:"bytes,1,opt,name=relayReply0,proto3" json:"relayReply0,omitempty"`
-	RelayReply1 *types.RelayReply `protobuf:"bytes,2,opt,name=relayReply1,proto3" json:"relayReply1,omitempty"`
+	RelayReply0   *types.RelayReply   `protobuf:"bytes,1,opt,name=relayReply0,proto3" json:"relayReply0,omitempty"`
+	RelayReply1   *types.RelayReply   `protobuf:"bytes,2,opt,name=relayReply1,proto3" json:"relayReply1,omitempty"`
+	RelaySession0 *types.RelaySession `protobuf:"bytes,3,opt,name=relaySession0,proto3" json:"relaySession0,omitempty"`
+	RelaySession1 *types.RelaySession `protobuf:"bytes,4,opt,name=relaySession1,proto3" json:"relaySession1,omitempty"`
 }
 
 func (m *FinalizationConflict) Reset()         { *m = FinalizationConflict{} }
@@ -180,6 +182,20 @@ func (m *FinalizationConflict) GetRelayReply1() *types.RelayReply {
 	return nil
 }
 
+func (m *FinalizationConflict) GetRelaySession0() *types.RelaySession {
+	if m != nil {
+		return m.RelaySession0
+	}
+	return nil
+}
+
+func (m *FinalizationConflict) GetRelaySession1() *types.RelaySession {
+	if m != nil {
+		return m.RelaySession1
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ResponseConflict)(nil), "lavanet.lava.conflict.ResponseConflict")
 	proto.RegisterType((*ConflictRelayData)(nil), "lavanet.lava.conflict.ConflictRelayData")
@@ -189,27 +205,28 @@ func init() {
 func init() { proto.RegisterFile("conflict/conflict_data.proto", fileDescriptor_d7f63a98ab02ebfa) }
 
 var fileDescriptor_d7f63a98ab02ebfa = []byte{
-	// 306 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x49, 0xce, 0xcf, 0x4b,
-	0xcb, 0xc9, 0x4c, 0x2e, 0xd1, 0x87, 0x31, 0xe2, 0x53, 0x12, 0x4b, 0x12, 0xf5, 0x0a, 0x8a, 0xf2,
-	0x4b, 0xf2, 0x85, 0x44, 0x73, 0x12, 0xcb, 0x12, 0xf3, 0x52, 0x4b, 0xf4, 0x40, 0xb4, 0x1e, 0x4c,
-	0x85, 0x94, 0x48, 0x7a, 0x7e, 0x7a, 0x3e, 0x58, 0x85, 0x3e, 0x88, 0x05, 0x51, 0x2c, 0x25, 0x5c,
-	0x90, 0x98, 0x59, 0x94, 0x99, 0x97, 0xae, 0x5f, 0x94, 0x9a, 0x93, 0x58, 0x09, 0x11, 0x54, 0x3a,
-	0xc6, 0xc8, 0x25, 0x10, 0x94, 0x5a, 0x5c, 0x90, 0x9f, 0x57, 0x9c, 0xea, 0x0c, 0xd5, 0x2f, 0x14,
-	0xc1, 0x25, 0x04, 0x33, 0x2b, 0x08, 0xa4, 0xd6, 0x25, 0xb1, 0x24, 0xd1, 0x40, 0x82, 0x51, 0x81,
-	0x51, 0x83, 0xdb, 0x48, 0x43, 0x0f, 0xab, 0x9d, 0x7a, 0xce, 0xe8, 0x1a, 0x82, 0xb0, 0x98, 0x81,
-	0xd5, 0x64, 0x43, 0x09, 0x26, 0x8a, 0x4d, 0x36, 0x54, 0xea, 0x64, 0xe4, 0x12, 0xc4, 0x50, 0x29,
-	0x64, 0xc3, 0xc5, 0x5e, 0x94, 0x5a, 0x58, 0x9a, 0x5a, 0x5c, 0x02, 0x75, 0xbe, 0x12, 0xaa, 0x25,
-	0xd0, 0x20, 0xd1, 0x03, 0xeb, 0x08, 0x82, 0xa8, 0x0c, 0x82, 0x69, 0x11, 0x32, 0xe3, 0x62, 0x2d,
-	0x4a, 0x2d, 0xc8, 0xa9, 0x84, 0x3a, 0x50, 0x01, 0xaf, 0xde, 0x82, 0x9c, 0xca, 0x20, 0x88, 0x72,
-	0xa5, 0x79, 0x8c, 0x5c, 0x22, 0x6e, 0x99, 0x79, 0x89, 0x39, 0x99, 0x55, 0x89, 0x25, 0x99, 0xf9,
-	0x79, 0xf0, 0x80, 0x75, 0xe2, 0xe2, 0x2e, 0x82, 0xab, 0x86, 0x85, 0x28, 0x61, 0x63, 0x91, 0x35,
-	0xa1, 0x9a, 0x61, 0x48, 0xb4, 0xd3, 0x90, 0x35, 0x39, 0x39, 0x9d, 0x78, 0x24, 0xc7, 0x78, 0xe1,
-	0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70,
-	0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x46, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae,
-	0x3e, 0xd4, 0x48, 0x30, 0xad, 0x5f, 0x01, 0x4f, 0x80, 0xfa, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49,
-	0x6c, 0xe0, 0x04, 0x64, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0x9b, 0x21, 0x1e, 0x57, 0xa2, 0x02,
-	0x00, 0x00,
+	// 336 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0xc1, 0x4a, 0xfb, 0x30,
+	0x1c, 0xc7, 0x97, 0xfd, 0xff, 0x2a, 0x64, 0x08, 0x5a, 0x27, 0x94, 0x21, 0x65, 0xf4, 0xb4, 0x53,
+	0xb2, 0x2a, 0x78, 0xf2, 0xb4, 0x89, 0x78, 0x8e, 0x17, 0xf1, 0x22, 0xd9, 0x8c, 0x35, 0x10, 0x93,
+	0x98, 0x64, 0x62, 0x7d, 0x03, 0x6f, 0x3e, 0x8b, 0x0f, 0x21, 0x1e, 0x77, 0xf4, 0x28, 0xdb, 0x8b,
+	0xc8, 0xda, 0x74, 0x6c, 0x73, 0x38, 0xd1, 0x53, 0xd2, 0xf2, 0xf9, 0x7e, 0x7e, 0x3f, 0xbe, 0xb4,
+	0x70, 0xaf, 0xaf, 0xe4, 0xb5, 0xe0, 0x7d, 0x87, 0xcb, 0xcb, 0xe5, 0x15, 0x75, 0x14, 0x69, 0xa3,
+	0x9c, 0x0a, 0x76, 0x05, 0xbd, 0xa7, 0x92, 0x39, 0x34, 0x39, 0x51, 0x49, 0x34, 0xea, 0xa9, 0x4a,
+	0x55, 0x4e, 0xe0, 0xc9, 0xad, 0x80, 0x1b, 0x3b, 0x9a, 0x72, 0xc3, 0x65, 0x8a, 0x0d, 0x13, 0x34,
+	0x2b, 0x5e, 0xc6, 0xaf, 0x00, 0x6e, 0x11, 0x66, 0xb5, 0x92, 0x96, 0x75, 0x7d, 0x3e, 0x38, 0x87,
+	0x41, 0xe9, 0x22, 0x13, 0xf6, 0x98, 0x3a, 0xda, 0x0e, 0x41, 0x13, 0xb4, 0x6a, 0xfb, 0x2d, 0xb4,
+	0x74, 0x26, 0xea, 0x2e, 0x06, 0xc8, 0x12, 0xc7, 0x52, 0x73, 0x12, 0x56, 0xff, 0x6c, 0x4e, 0xe2,
+	0x27, 0x00, 0xb7, 0xbf, 0x90, 0xc1, 0x11, 0xdc, 0x30, 0xec, 0x6e, 0xc0, 0xac, 0xf3, 0xeb, 0xc7,
+	0xf3, 0x43, 0x7c, 0x25, 0x28, 0x4f, 0x90, 0x82, 0x24, 0x65, 0x24, 0x38, 0x84, 0x6b, 0x86, 0x69,
+	0x91, 0xf9, 0x05, 0x9b, 0xdf, 0x66, 0xb5, 0xc8, 0x48, 0x81, 0xc7, 0x2f, 0x55, 0x58, 0x3f, 0xe1,
+	0x92, 0x0a, 0xfe, 0x48, 0x1d, 0x57, 0x72, 0x5a, 0x6c, 0x07, 0xd6, 0xcc, 0x94, 0x2e, 0x1b, 0x5d,
+	0xad, 0x9d, 0x0d, 0xcd, 0x3b, 0x92, 0x1f, 0xaf, 0x36, 0x1b, 0x0a, 0x4e, 0xe1, 0x66, 0xfe, 0x78,
+	0xc6, 0xac, 0xe5, 0x4a, 0xb6, 0xc3, 0x7f, 0x2b, 0xcb, 0xf1, 0x28, 0x99, 0x0f, 0x2e, 0x9a, 0x92,
+	0xf0, 0xff, 0xef, 0x4c, 0x49, 0xa7, 0xf3, 0x36, 0x8a, 0xc0, 0x70, 0x14, 0x81, 0x8f, 0x51, 0x04,
+	0x9e, 0xc7, 0x51, 0x65, 0x38, 0x8e, 0x2a, 0xef, 0xe3, 0xa8, 0x72, 0xd1, 0x4a, 0xb9, 0xbb, 0x19,
+	0xf4, 0x50, 0x5f, 0xdd, 0x62, 0xaf, 0xcd, 0x4f, 0xfc, 0x30, 0xfd, 0x29, 0xb0, 0xcb, 0x34, 0xb3,
+	0xbd, 0xf5, 0xfc, 0xa3, 0x3e, 0xf8, 0x1c, 0x00, 0xa1, 0x23, 0xea, 0xaa, 0x36, 0x03, 0x00, 0x00,
 }
 
 func (m *ResponseConflict) Marshal() (dAtA []byte, err error) {
@@ -326,6 +343,30 @@ func (m *FinalizationConflict) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RelaySession1 != nil {
+		{
+			size, err := m.RelaySession1.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintConflictData(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.RelaySession0 != nil {
+		{
+			size, err := m.RelaySession0.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintConflictData(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.RelayReply1 != nil {
 		{
 			size, err := m.RelayReply1.MarshalToSizedBuffer(dAtA[:i])
@@ -412,6 +453,14 @@ func (m *FinalizationConflict) Size() (n int) {
 		l = m.RelayReply1.Size()
 		n += 1 + l + sovConflictData(uint64(l))
 	}
+	if m.RelaySession0 != nil {
+		l = m.RelaySession0.Size()
+		n += 1 + l + sovConflictData(uint64(l))
+	}
+	if m.RelaySession1 != nil {
+		l = m.RelaySession1.Size()
+		n += 1 + l + sovConflictData(uint64(l))
+	}
 	return n
 }
 
@@ -766,6 +815,78 @@ func (m *FinalizationConflict) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelaySession0", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConflictData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConflictData
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConflictData
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RelaySession0 == nil {
+				m.RelaySession0 = &types.RelaySession{}
+			}
+			if err := m.RelaySession0.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelaySession1", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConflictData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConflictData
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConflictData
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RelaySession1 == nil {
+				m.RelaySession1 = &types.RelaySession{}
+			}
+			if err := m.RelaySession1.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipConflictData(dAtA[iNdEx:])
@@ -148,6 +148,7 @@ type ConflictVote struct {
 	FirstProvider  Provider `protobuf:"bytes,10,opt,name=firstProvider,proto3" json:"firstProvider"`
 	SecondProvider Provider `protobuf:"bytes,11,opt,name=secondProvider,proto3" json:"secondProvider"`
 	Votes          []Vote   `protobuf:"bytes,12,rep,name=votes,proto3" json:"votes"`
+	ApiInterface   string   `protobuf:"bytes,13,opt,name=apiInterface,proto3" json:"apiInterface,omitempty"`
 }
 
 func (m *ConflictVote) Reset()         { *m = ConflictVote{} }
@@ -267,45 +268,135 @@ func (m *ConflictVote) GetVotes() []Vote {
 	return nil
 }
 
+func (m *ConflictVote) GetApiInterface() string {
+	if m != nil {
+		return m.ApiInterface
+	}
+	return ""
+}
+
+// ApiConflictStats counts the response conflicts on an api of a spec that jurors decided, for finding apis that are wrongly
+// categorized as deterministic
+type ApiConflictStats struct {
+	ChainID      string `protobuf:"bytes,1,opt,name=chainID,proto3" json:"chainID,omitempty"`
+	ApiInterface string `protobuf:"bytes,2,opt,name=apiInterface,proto3" json:"apiInterface,omitempty"`
+	Api          string `protobuf:"bytes,3,opt,name=api,proto3" json:"api,omitempty"`
+	Conflicts    uint64 `protobuf:"varint,4,opt,name=conflicts,proto3" json:"conflicts,omitempty"`
+	Benign       uint64 `protobuf:"varint,5,opt,name=benign,proto3" json:"benign,omitempty"`
+}
+
+func (m *ApiConflictStats) Reset()         { *m = ApiConflictStats{} }
+func (m *ApiConflictStats) String() string { return proto.CompactTextString(m) }
+func (*ApiConflictStats) ProtoMessage()    {}
+func (*ApiConflictStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5ff6a0d8edaa7f1, []int{3}
+}
+func (m *ApiConflictStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApiConflictStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApiConflictStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ApiConflictStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApiConflictStats.Merge(m, src)
+}
+func (m *ApiConflictStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApiConflictStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApiConflictStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApiConflictStats proto.InternalMessageInfo
+
+func (m *ApiConflictStats) GetChainID() string {
+	if m != nil {
+		return m.ChainID
+	}
+	return ""
+}
+
+func (m *ApiConflictStats) GetApiInterface() string {
+	if m != nil {
+		return m.ApiInterface
+	}
+	return ""
+}
+
+func (m *ApiConflictStats) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *ApiConflictStats) GetConflicts() uint64 {
+	if m != nil {
+		return m.Conflicts
+	}
+	return 0
+}
+
+func (m *ApiConflictStats) GetBenign() uint64 {
+	if m != nil {
+		return m.Benign
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Provider)(nil), "lavanet.lava.conflict.Provider")
 	proto.RegisterType((*Vote)(nil), "lavanet.lava.conflict.Vote")
 	proto.RegisterType((*ConflictVote)(nil), "lavanet.lava.conflict.ConflictVote")
+	proto.RegisterType((*ApiConflictStats)(nil), "lavanet.lava.conflict.ApiConflictStats")
 }
 
 func init() { proto.RegisterFile("conflict/conflict_vote.proto", fileDescriptor_c5ff6a0d8edaa7f1) }
 
 var fileDescriptor_c5ff6a0d8edaa7f1 = []byte{
-	// 450 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xc1, 0x6e, 0x13, 0x31,
-	0x10, 0xcd, 0x36, 0x9b, 0x34, 0x99, 0xa4, 0x3d, 0x58, 0x05, 0x59, 0xa5, 0xda, 0xae, 0x22, 0x84,
-	0xf6, 0xb4, 0x91, 0xca, 0x81, 0x2b, 0x84, 0x1c, 0x40, 0x80, 0x84, 0x16, 0xc1, 0x81, 0x0b, 0x72,
-	0xbd, 0xd3, 0xc4, 0x62, 0xb1, 0x83, 0xed, 0x44, 0xe5, 0xc8, 0x1f, 0xf0, 0x59, 0x3d, 0xf6, 0xc8,
-	0x09, 0xa1, 0xe4, 0x47, 0x90, 0xbd, 0xde, 0xd0, 0x54, 0x70, 0xe8, 0xc9, 0xf3, 0x9e, 0xdf, 0x3c,
-	0xcf, 0x8c, 0x6d, 0x38, 0xe1, 0x4a, 0x5e, 0x54, 0x82, 0xdb, 0x71, 0x13, 0x7c, 0x5a, 0x29, 0x8b,
-	0xf9, 0x42, 0x2b, 0xab, 0xc8, 0xbd, 0x8a, 0xad, 0x98, 0x44, 0x9b, 0xbb, 0x35, 0x6f, 0x14, 0xc7,
-	0x47, 0x33, 0x35, 0x53, 0x5e, 0x31, 0x76, 0x51, 0x2d, 0x1e, 0x3d, 0x85, 0xde, 0x5b, 0xad, 0x56,
-	0xa2, 0x44, 0x4d, 0x28, 0xec, 0x33, 0xce, 0xd5, 0x52, 0x5a, 0x1a, 0xa5, 0x51, 0xd6, 0x2f, 0x1a,
-	0x48, 0x8e, 0xa1, 0xa7, 0xd1, 0x2c, 0x94, 0x34, 0x48, 0xf7, 0xd2, 0x28, 0x1b, 0x16, 0x5b, 0x3c,
-	0x7a, 0x0d, 0xf1, 0x07, 0x65, 0xd1, 0x67, 0x97, 0xa5, 0x46, 0x63, 0xb6, 0xd9, 0x35, 0x24, 0x04,
-	0xe2, 0x17, 0xcc, 0xcc, 0x43, 0xa6, 0x8f, 0xc9, 0x7d, 0xe8, 0x16, 0x68, 0x96, 0x95, 0xa5, 0xed,
-	0x34, 0xca, 0xda, 0x45, 0x40, 0xa3, 0xef, 0x31, 0x0c, 0x9f, 0x87, 0x92, 0xbd, 0xed, 0x11, 0x74,
-	0x84, 0x2c, 0xf1, 0x32, 0x98, 0xd6, 0x80, 0x3c, 0x84, 0x03, 0x5e, 0x09, 0x94, 0xf6, 0x59, 0x38,
-	0x72, 0xcf, 0xef, 0xee, 0x92, 0x64, 0x04, 0x43, 0x37, 0x97, 0x29, 0xb2, 0xb2, 0x12, 0x12, 0xfd,
-	0x51, 0x71, 0xb1, 0xc3, 0x91, 0x47, 0x70, 0xe8, 0xf0, 0x3b, 0xcb, 0xb4, 0x9d, 0x54, 0x8a, 0x7f,
-	0xa6, 0xb1, 0x57, 0xdd, 0x62, 0xc9, 0x09, 0xf4, 0x03, 0x63, 0x91, 0x76, 0x7c, 0xcd, 0x7f, 0x09,
-	0xd7, 0x3c, 0x9f, 0x33, 0x21, 0x5f, 0x4e, 0x69, 0xb7, 0x6e, 0x3e, 0x40, 0xd7, 0x28, 0x5b, 0x88,
-	0xf7, 0xba, 0xa2, 0xfb, 0x7e, 0x23, 0x20, 0x92, 0xc2, 0x40, 0xe3, 0xd7, 0x25, 0x1a, 0x3b, 0x65,
-	0x96, 0xd1, 0x9e, 0x9f, 0xcd, 0x4d, 0xca, 0x55, 0x1f, 0x60, 0x5d, 0x57, 0xbf, 0xae, 0xfe, 0x26,
-	0x47, 0x5e, 0xc1, 0xc1, 0x85, 0xd0, 0xc6, 0x36, 0x77, 0x48, 0x21, 0x8d, 0xb2, 0xc1, 0xd9, 0x69,
-	0xfe, 0xcf, 0x37, 0x90, 0x37, 0xb2, 0x49, 0x7c, 0xf5, 0xeb, 0xb4, 0x55, 0xec, 0xe6, 0x92, 0x37,
-	0x70, 0x68, 0x90, 0x2b, 0x59, 0x6e, 0xdd, 0x06, 0x77, 0x71, 0xbb, 0x95, 0x4c, 0x9e, 0x40, 0xc7,
-	0x0d, 0xc8, 0xd0, 0x61, 0xda, 0xce, 0x06, 0x67, 0x0f, 0xfe, 0xe3, 0xe2, 0x6e, 0x39, 0x38, 0xd4,
-	0xfa, 0xc9, 0xe4, 0x6a, 0x9d, 0x44, 0xd7, 0xeb, 0x24, 0xfa, 0xbd, 0x4e, 0xa2, 0x1f, 0x9b, 0xa4,
-	0x75, 0xbd, 0x49, 0x5a, 0x3f, 0x37, 0x49, 0xeb, 0x63, 0x36, 0x13, 0x76, 0xbe, 0x3c, 0xcf, 0xb9,
-	0xfa, 0x32, 0x0e, 0x6e, 0x7e, 0x1d, 0x5f, 0x6e, 0x7f, 0xc2, 0xd8, 0x7e, 0x5b, 0xa0, 0x39, 0xef,
-	0xfa, 0xe7, 0xfd, 0xf8, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x61, 0x6f, 0x94, 0x8c, 0x2b, 0x03,
-	0x00, 0x00,
+	// 517 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x4f, 0x6f, 0xd3, 0x30,
+	0x14, 0x6f, 0xd6, 0xb4, 0x6b, 0xdd, 0x76, 0x9a, 0xac, 0x81, 0xac, 0x31, 0x65, 0x51, 0x85, 0x50,
+	0x4e, 0xa9, 0x34, 0x0e, 0x5c, 0x59, 0xe9, 0x81, 0x09, 0x90, 0x50, 0x10, 0x1c, 0xb8, 0x20, 0x37,
+	0x79, 0x6d, 0x2d, 0x82, 0x1d, 0x6c, 0xb7, 0x1a, 0xdf, 0x82, 0x23, 0x5f, 0x80, 0xef, 0xb2, 0xe3,
+	0x8e, 0x9c, 0x10, 0x6a, 0xbf, 0x08, 0xb2, 0xe3, 0x74, 0x4d, 0x81, 0x03, 0x27, 0xbf, 0xdf, 0xcf,
+	0xef, 0xfd, 0xfc, 0xfe, 0x19, 0x9d, 0xa5, 0x82, 0xcf, 0x72, 0x96, 0xea, 0x51, 0x65, 0x7c, 0x58,
+	0x09, 0x0d, 0x71, 0x21, 0x85, 0x16, 0xf8, 0x5e, 0x4e, 0x57, 0x94, 0x83, 0x8e, 0xcd, 0x19, 0x57,
+	0x1e, 0xa7, 0x27, 0x73, 0x31, 0x17, 0xd6, 0x63, 0x64, 0xac, 0xd2, 0x79, 0xf8, 0x14, 0x75, 0x5e,
+	0x4b, 0xb1, 0x62, 0x19, 0x48, 0x4c, 0xd0, 0x21, 0x4d, 0x53, 0xb1, 0xe4, 0x9a, 0x78, 0xa1, 0x17,
+	0x75, 0x93, 0x0a, 0xe2, 0x53, 0xd4, 0x91, 0xa0, 0x0a, 0xc1, 0x15, 0x90, 0x83, 0xd0, 0x8b, 0xfa,
+	0xc9, 0x16, 0x0f, 0x5f, 0x22, 0xff, 0x9d, 0xd0, 0x60, 0xa3, 0xb3, 0x4c, 0x82, 0x52, 0xdb, 0xe8,
+	0x12, 0x62, 0x8c, 0xfc, 0xe7, 0x54, 0x2d, 0x5c, 0xa4, 0xb5, 0xf1, 0x7d, 0xd4, 0x4e, 0x40, 0x2d,
+	0x73, 0x4d, 0x9a, 0xa1, 0x17, 0x35, 0x13, 0x87, 0x86, 0xdf, 0x7d, 0xd4, 0x7f, 0xe6, 0x52, 0xb6,
+	0xb2, 0x27, 0xa8, 0xc5, 0x78, 0x06, 0xd7, 0x4e, 0xb4, 0x04, 0xf8, 0x21, 0x1a, 0xa4, 0x39, 0x03,
+	0xae, 0x2f, 0xdd, 0x93, 0x07, 0xf6, 0xb6, 0x4e, 0xe2, 0x21, 0xea, 0x9b, 0xbe, 0x4c, 0x80, 0x66,
+	0x39, 0xe3, 0x60, 0x9f, 0xf2, 0x93, 0x1a, 0x87, 0x1f, 0xa1, 0x23, 0x83, 0xdf, 0x68, 0x2a, 0xf5,
+	0x38, 0x17, 0xe9, 0x47, 0xe2, 0x5b, 0xaf, 0x3d, 0x16, 0x9f, 0xa1, 0xae, 0x63, 0x34, 0x90, 0x96,
+	0xcd, 0xf9, 0x8e, 0x30, 0xc5, 0xa7, 0x0b, 0xca, 0xf8, 0xd5, 0x84, 0xb4, 0xcb, 0xe2, 0x1d, 0x34,
+	0x85, 0xd2, 0x82, 0xbd, 0x95, 0x39, 0x39, 0xb4, 0x17, 0x0e, 0xe1, 0x10, 0xf5, 0x24, 0x7c, 0x5e,
+	0x82, 0xd2, 0x13, 0xaa, 0x29, 0xe9, 0xd8, 0xde, 0xec, 0x52, 0x26, 0x7b, 0x07, 0xcb, 0xbc, 0xba,
+	0x65, 0xf6, 0xbb, 0x1c, 0x7e, 0x81, 0x06, 0x33, 0x26, 0x95, 0xae, 0x66, 0x48, 0x50, 0xe8, 0x45,
+	0xbd, 0x8b, 0xf3, 0xf8, 0xaf, 0x3b, 0x10, 0x57, 0x6e, 0x63, 0xff, 0xe6, 0xe7, 0x79, 0x23, 0xa9,
+	0xc7, 0xe2, 0x57, 0xe8, 0x48, 0x41, 0x2a, 0x78, 0xb6, 0x55, 0xeb, 0xfd, 0x8f, 0xda, 0x5e, 0x30,
+	0x7e, 0x82, 0x5a, 0xa6, 0x41, 0x8a, 0xf4, 0xc3, 0x66, 0xd4, 0xbb, 0x78, 0xf0, 0x0f, 0x15, 0x33,
+	0x65, 0xa7, 0x50, 0xfa, 0x9b, 0xc2, 0x69, 0xc1, 0xae, 0xb8, 0x06, 0x39, 0xa3, 0x29, 0x90, 0x81,
+	0x6d, 0x5c, 0x8d, 0x1b, 0x7e, 0xf3, 0xd0, 0xf1, 0x65, 0xc1, 0xaa, 0x55, 0x31, 0x53, 0x50, 0xbb,
+	0x53, 0xf0, 0xea, 0x53, 0xd8, 0x97, 0x3c, 0xf8, 0x53, 0x12, 0x1f, 0xa3, 0x26, 0x2d, 0x98, 0x5d,
+	0x92, 0x6e, 0x62, 0x4c, 0x33, 0xf3, 0x2a, 0x4d, 0xe5, 0xd6, 0xe2, 0x8e, 0x30, 0x93, 0x9d, 0x02,
+	0x67, 0x73, 0x6e, 0xd7, 0xc1, 0x4f, 0x1c, 0x1a, 0x8f, 0x6f, 0xd6, 0x81, 0x77, 0xbb, 0x0e, 0xbc,
+	0x5f, 0xeb, 0xc0, 0xfb, 0xba, 0x09, 0x1a, 0xb7, 0x9b, 0xa0, 0xf1, 0x63, 0x13, 0x34, 0xde, 0x47,
+	0x73, 0xa6, 0x17, 0xcb, 0x69, 0x9c, 0x8a, 0x4f, 0x23, 0xd7, 0x0c, 0x7b, 0x8e, 0xae, 0xb7, 0x1f,
+	0x79, 0xa4, 0xbf, 0x14, 0xa0, 0xa6, 0x6d, 0xfb, 0x3b, 0x1f, 0xff, 0x1e, 0x00, 0x14, 0x1e, 0xdc,
+	0xa4, 0xea, 0x03, 0x00, 0x00,
 }
 
 func (m *Provider) Marshal() (dAtA []byte, err error) {
@@ -407,6 +498,13 @@ func (m *ConflictVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ApiInterface) > 0 {
+		i -= len(m.ApiInterface)
+		copy(dAtA[i:], m.ApiInterface)
+		i = encodeVarintConflictVote(dAtA, i, uint64(len(m.ApiInterface)))
+		i--
+		dAtA[i] = 0x6a
+	}
 	if len(m.Votes) > 0 {
 		for iNdEx := len(m.Votes) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -499,6 +597,60 @@ func (m *ConflictVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ApiConflictStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApiConflictStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApiConflictStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Benign != 0 {
+		i = encodeVarintConflictVote(dAtA, i, uint64(m.Benign))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Conflicts != 0 {
+		i = encodeVarintConflictVote(dAtA, i, uint64(m.Conflicts))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Api) > 0 {
+		i -= len(m.Api)
+		copy(dAtA[i:], m.Api)
+		i = encodeVarintConflictVote(dAtA, i, uint64(len(m.Api)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ApiInterface) > 0 {
+		i -= len(m.ApiInterface)
+		copy(dAtA[i:], m.ApiInterface)
+		i = encodeVarintConflictVote(dAtA, i, uint64(len(m.ApiInterface)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintConflictVote(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintConflictVote(dAtA []byte, offset int, v uint64) int {
 	offset -= sovConflictVote(v)
 	base := offset
@@ -595,6 +747,37 @@ func (m *ConflictVote) Size() (n int) {
 			n += 1 + l + sovConflictVote(uint64(l))
 		}
 	}
+	l = len(m.ApiInterface)
+	if l > 0 {
+		n += 1 + l + sovConflictVote(uint64(l))
+	}
+	return n
+}
+
+func (m *ApiConflictStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovConflictVote(uint64(l))
+	}
+	l = len(m.ApiInterface)
+	if l > 0 {
+		n += 1 + l + sovConflictVote(uint64(l))
+	}
+	l = len(m.Api)
+	if l > 0 {
+		n += 1 + l + sovConflictVote(uint64(l))
+	}
+	if m.Conflicts != 0 {
+		n += 1 + sovConflictVote(uint64(m.Conflicts))
+	}
+	if m.Benign != 0 {
+		n += 1 + sovConflictVote(uint64(m.Benign))
+	}
 	return n
 }
 
@@ -1222,6 +1405,222 @@ func (m *ConflictVote) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiInterface", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConflictVote
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConflictVote
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConflictVote
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiInterface = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConflictVote(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthConflictVote
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApiConflictStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConflictVote
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApiConflictStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApiConflictStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConflictVote
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConflictVote
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConflictVote
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiInterface", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConflictVote
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConflictVote
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConflictVote
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiInterface = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Api", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConflictVote
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConflictVote
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConflictVote
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Api = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Conflicts", wireType)
+			}
+			m.Conflicts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConflictVote
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Conflicts |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Benign", wireType)
+			}
+			m.Benign = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConflictVote
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Benign |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipConflictVote(dAtA[iNdEx:])
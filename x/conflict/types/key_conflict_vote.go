@@ -7,6 +7,9 @@ var _ binary.ByteOrder
 const (
 	// ConflictVoteKeyPrefix is the prefix to retrieve all ConflictVote
 	ConflictVoteKeyPrefix = "ConflictVote/value/"
+
+	// ApiConflictStatsKeyPrefix is the prefix to retrieve all ApiConflictStats
+	ApiConflictStatsKeyPrefix = "ApiConflictStats/value/"
 )
 
 // ConflictVoteKey returns the store key to retrieve a ConflictVote from the index fields
@@ -21,3 +24,8 @@ func ConflictVoteKey(
 
 	return key
 }
+
+// ApiConflictStatsKey returns the store key to retrieve the ApiConflictStats of an api
+func ApiConflictStatsKey(chainID string, apiInterface string, api string) []byte {
+	return []byte(chainID + "/" + apiInterface + "/" + api + "/")
+}
@@ -0,0 +1,22 @@
+package types
+
+import "encoding/binary"
+
+// SameProviderConflictKeyPrefix is the prefix of the same provider conflicts a provider was jailed for, by epoch
+const SameProviderConflictKeyPrefix = "SameProviderConflict/value/"
+
+// SameProviderConflictEpochKey returns the store key prefix of the same provider conflicts of an epoch
+func SameProviderConflictEpochKey(epoch uint64) []byte {
+	key := make([]byte, 8, 9)
+	binary.BigEndian.PutUint64(key, epoch)
+	return append(key, []byte("/")...)
+}
+
+// SameProviderConflictKey returns the store key of a provider's same provider conflict on a block, under SameProviderConflictKeyPrefix
+func SameProviderConflictKey(epoch uint64, chainID string, providerAddress string, blockNum int64) []byte {
+	key := SameProviderConflictEpochKey(epoch)
+	blockKey := make([]byte, 8)
+	binary.BigEndian.PutUint64(blockKey, uint64(blockNum))
+	key = append(key, []byte(chainID+"/"+providerAddress+"/")...)
+	return append(append(key, blockKey...), []byte("/")...)
+}
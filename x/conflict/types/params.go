@@ -30,6 +30,26 @@ var (
 	DefaultRewards Rewards = Rewards{WinnerRewardPercent: sdk.NewDecWithPrec(15, 2), ClientRewardPercent: sdk.NewDecWithPrec(10, 2), VotersRewardPercent: sdk.NewDecWithPrec(15, 2)}
 )
 
+var (
+	KeyRevealWindow            = []byte("RevealWindow")
+	DefaultRevealWindow uint64 = 0 // 0 = VotePeriod epochs
+)
+
+var (
+	KeyJurorsCount            = []byte("JurorsCount")
+	DefaultJurorsCount uint64 = 10 // 0 = all providers
+)
+
+var (
+	KeyNonRevealSlashFraction             = []byte("NonRevealSlashFraction")
+	DefaultNonRevealSlashFraction sdk.Dec = sdk.NewDecWithPrec(5, 2) // 0.05
+)
+
+var (
+	KeyFraudVoteSlashFraction             = []byte("FraudVoteSlashFraction")
+	DefaultFraudVoteSlashFraction sdk.Dec = sdk.NewDecWithPrec(1, 1) // 0.1
+)
+
 // ParamKeyTable the param key table for launch module
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
@@ -37,13 +57,17 @@ func ParamKeyTable() paramtypes.KeyTable {
 
 // NewParams creates a new Params instance
 func NewParams(
-	majorityPercent sdk.Dec, voteStartSpan uint64, votePeriod uint64, rewards Rewards,
+	majorityPercent sdk.Dec, voteStartSpan uint64, votePeriod uint64, rewards Rewards, revealWindow uint64, jurorsCount uint64, nonRevealSlashFraction sdk.Dec, fraudVoteSlashFraction sdk.Dec,
 ) Params {
 	return Params{
-		MajorityPercent: majorityPercent,
-		VoteStartSpan:   voteStartSpan,
-		VotePeriod:      votePeriod,
-		Rewards:         rewards,
+		MajorityPercent:        majorityPercent,
+		VoteStartSpan:          voteStartSpan,
+		VotePeriod:             votePeriod,
+		Rewards:                rewards,
+		RevealWindow:           revealWindow,
+		JurorsCount:            jurorsCount,
+		NonRevealSlashFraction: nonRevealSlashFraction,
+		FraudVoteSlashFraction: fraudVoteSlashFraction,
 	}
 }
 
@@ -54,6 +78,10 @@ func DefaultParams() Params {
 		DefaultVoteStartSpan,
 		DefaultVotePeriod,
 		DefaultRewards,
+		DefaultRevealWindow,
+		DefaultJurorsCount,
+		DefaultNonRevealSlashFraction,
+		DefaultFraudVoteSlashFraction,
 	)
 }
 
@@ -64,6 +92,10 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyVoteStartSpan, &p.VoteStartSpan, validateVoteStartSpan),
 		paramtypes.NewParamSetPair(KeyVotePeriod, &p.VotePeriod, validateVotePeriod),
 		paramtypes.NewParamSetPair(KeyRewards, &p.Rewards, validateRewards),
+		paramtypes.NewParamSetPair(KeyRevealWindow, &p.RevealWindow, validateRevealWindow),
+		paramtypes.NewParamSetPair(KeyJurorsCount, &p.JurorsCount, validateJurorsCount),
+		paramtypes.NewParamSetPair(KeyNonRevealSlashFraction, &p.NonRevealSlashFraction, validateNonRevealSlashFraction),
+		paramtypes.NewParamSetPair(KeyFraudVoteSlashFraction, &p.FraudVoteSlashFraction, validateFraudVoteSlashFraction),
 	}
 }
 
@@ -85,6 +117,22 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateRevealWindow(p.RevealWindow); err != nil {
+		return err
+	}
+
+	if err := validateJurorsCount(p.JurorsCount); err != nil {
+		return err
+	}
+
+	if err := validateNonRevealSlashFraction(p.NonRevealSlashFraction); err != nil {
+		return err
+	}
+
+	if err := validateFraudVoteSlashFraction(p.FraudVoteSlashFraction); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -156,3 +204,47 @@ func validateRewards(v interface{}) error {
 
 	return nil
 }
+
+func validateRevealWindow(v interface{}) error {
+	_, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+func validateJurorsCount(v interface{}) error {
+	_, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+func validateNonRevealSlashFraction(v interface{}) error {
+	nonRevealSlashFraction, ok := v.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if nonRevealSlashFraction.IsNil() || nonRevealSlashFraction.GT(sdk.OneDec()) || nonRevealSlashFraction.IsNegative() {
+		return fmt.Errorf("invalid parameter nonRevealSlashFraction")
+	}
+
+	return nil
+}
+
+func validateFraudVoteSlashFraction(v interface{}) error {
+	fraudVoteSlashFraction, ok := v.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if fraudVoteSlashFraction.IsNil() || fraudVoteSlashFraction.GT(sdk.OneDec()) || fraudVoteSlashFraction.IsNegative() {
+		return fmt.Errorf("invalid parameter fraudVoteSlashFraction")
+	}
+
+	return nil
+}
@@ -26,10 +26,14 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the parameters for the module.
 type Params struct {
-	MajorityPercent github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=majorityPercent,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"majorityPercent" yaml:"majority_percent"`
-	VoteStartSpan   uint64                                 `protobuf:"varint,2,opt,name=voteStartSpan,proto3" json:"voteStartSpan,omitempty"`
-	VotePeriod      uint64                                 `protobuf:"varint,3,opt,name=votePeriod,proto3" json:"votePeriod,omitempty"`
-	Rewards         Rewards                                `protobuf:"bytes,4,opt,name=Rewards,proto3" json:"Rewards"`
+	MajorityPercent        github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=majorityPercent,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"majorityPercent" yaml:"majority_percent"`
+	VoteStartSpan          uint64                                 `protobuf:"varint,2,opt,name=voteStartSpan,proto3" json:"voteStartSpan,omitempty"`
+	VotePeriod             uint64                                 `protobuf:"varint,3,opt,name=votePeriod,proto3" json:"votePeriod,omitempty"`
+	Rewards                Rewards                                `protobuf:"bytes,4,opt,name=Rewards,proto3" json:"Rewards"`
+	RevealWindow           uint64                                 `protobuf:"varint,5,opt,name=revealWindow,proto3" json:"revealWindow,omitempty"`
+	JurorsCount            uint64                                 `protobuf:"varint,6,opt,name=jurorsCount,proto3" json:"jurorsCount,omitempty"`
+	NonRevealSlashFraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=nonRevealSlashFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"nonRevealSlashFraction" yaml:"non_reveal_slash_fraction"`
+	FraudVoteSlashFraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=fraudVoteSlashFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fraudVoteSlashFraction" yaml:"fraud_vote_slash_fraction"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -85,6 +89,20 @@ func (m *Params) GetRewards() Rewards {
 	return Rewards{}
 }
 
+func (m *Params) GetRevealWindow() uint64 {
+	if m != nil {
+		return m.RevealWindow
+	}
+	return 0
+}
+
+func (m *Params) GetJurorsCount() uint64 {
+	if m != nil {
+		return m.JurorsCount
+	}
+	return 0
+}
+
 type Rewards struct {
 	WinnerRewardPercent github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=winnerRewardPercent,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"winnerRewardPercent" yaml:"winner_reward_percent"`
 	ClientRewardPercent github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=clientRewardPercent,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"clientRewardPercent" yaml:"client_reward_percent"`
@@ -132,31 +150,38 @@ func init() {
 func init() { proto.RegisterFile("conflict/params.proto", fileDescriptor_c0f4d28c7457960e) }
 
 var fileDescriptor_c0f4d28c7457960e = []byte{
-	// 381 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0x31, 0x4b, 0xc3, 0x40,
-	0x1c, 0xc5, 0x73, 0x6d, 0xa8, 0x78, 0x22, 0x42, 0xb4, 0x18, 0x44, 0x2e, 0x25, 0x88, 0x64, 0x31,
-	0x01, 0xdd, 0x3a, 0x38, 0x04, 0x17, 0x17, 0x29, 0xe9, 0xe6, 0x52, 0xae, 0xe9, 0x59, 0xa3, 0x49,
-	0x2e, 0xdc, 0x9d, 0xad, 0xdd, 0x5c, 0xdd, 0xc4, 0xc9, 0xd1, 0x8f, 0xd3, 0xb1, 0xa3, 0x38, 0x14,
-	0x69, 0xbf, 0x81, 0x9f, 0x40, 0x72, 0x69, 0x6b, 0x63, 0xb3, 0x48, 0xa7, 0x7f, 0xf8, 0xf3, 0xee,
-	0xbd, 0x5f, 0x5e, 0x72, 0xb0, 0xea, 0xd3, 0xf8, 0x26, 0x0c, 0x7c, 0xe1, 0x24, 0x98, 0xe1, 0x88,
-	0xdb, 0x09, 0xa3, 0x82, 0x6a, 0xd5, 0x10, 0xf7, 0x70, 0x4c, 0x84, 0x9d, 0x4e, 0x7b, 0xae, 0x39,
-	0xd8, 0xeb, 0xd2, 0x2e, 0x95, 0x0a, 0x27, 0x7d, 0xca, 0xc4, 0xe6, 0x73, 0x09, 0x56, 0x1a, 0xf2,
-	0xb4, 0xc6, 0xe1, 0x4e, 0x84, 0xef, 0x28, 0x0b, 0xc4, 0xa0, 0x41, 0x98, 0x4f, 0x62, 0xa1, 0x83,
-	0x1a, 0xb0, 0x36, 0xdd, 0xcb, 0xe1, 0xd8, 0x50, 0x3e, 0xc7, 0xc6, 0x71, 0x37, 0x10, 0xb7, 0x0f,
-	0x6d, 0xdb, 0xa7, 0x91, 0xe3, 0x53, 0x1e, 0x51, 0x3e, 0x1b, 0x27, 0xbc, 0x73, 0xef, 0x88, 0x41,
-	0x42, 0xb8, 0x7d, 0x41, 0xfc, 0xef, 0xb1, 0xb1, 0x3f, 0xc0, 0x51, 0x58, 0x37, 0xe7, 0x76, 0xad,
-	0x24, 0xf3, 0x33, 0xbd, 0xbf, 0x09, 0xda, 0x11, 0xdc, 0xee, 0x51, 0x41, 0x9a, 0x02, 0x33, 0xd1,
-	0x4c, 0x70, 0xac, 0x97, 0x6a, 0xc0, 0x52, 0xbd, 0xfc, 0x52, 0x43, 0x10, 0xa6, 0x8b, 0x06, 0x61,
-	0x01, 0xed, 0xe8, 0x65, 0x29, 0x59, 0xda, 0x68, 0xe7, 0x70, 0xc3, 0x23, 0x7d, 0xcc, 0x3a, 0x5c,
-	0x57, 0x6b, 0xc0, 0xda, 0x3a, 0x45, 0x76, 0x61, 0x09, 0xf6, 0x4c, 0xe5, 0xaa, 0xe9, 0x2b, 0x79,
-	0xf3, 0x43, 0x75, 0xf5, 0xed, 0xdd, 0x50, 0xcc, 0xd7, 0xf2, 0xc2, 0x46, 0x7b, 0x02, 0x70, 0xb7,
-	0x1f, 0xc4, 0x31, 0x61, 0xd9, 0x26, 0xdf, 0xc8, 0xd5, 0xbf, 0x1b, 0x39, 0xcc, 0x1a, 0xc9, 0x2c,
-	0x5b, 0x4c, 0x7a, 0xfe, 0xd6, 0x52, 0x14, 0x25, 0x11, 0xfc, 0x30, 0x20, 0xb1, 0xc8, 0x23, 0x94,
-	0xd6, 0x43, 0xc8, 0x2c, 0x57, 0x11, 0x0a, 0xa2, 0x24, 0x42, 0x5a, 0x33, 0xe3, 0x79, 0x84, 0xf2,
-	0x7a, 0x08, 0x99, 0xe5, 0x2a, 0x42, 0x41, 0x94, 0xeb, 0x0e, 0x27, 0x08, 0x8c, 0x26, 0x08, 0x7c,
-	0x4d, 0x10, 0x78, 0x99, 0x22, 0x65, 0x34, 0x45, 0xca, 0xc7, 0x14, 0x29, 0xd7, 0xd6, 0x52, 0xec,
-	0xec, 0x6b, 0xcb, 0xe9, 0x3c, 0x3a, 0x8b, 0x8b, 0x21, 0xc3, 0xdb, 0x15, 0xf9, 0xaf, 0x9f, 0xfd,
-	0x04, 0x00, 0x00, 0xff, 0xff, 0xdc, 0x2a, 0xc7, 0xe1, 0x31, 0x03, 0x00, 0x00,
+	// 487 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0x31, 0x8f, 0xd3, 0x30,
+	0x1c, 0xc5, 0xe3, 0x6b, 0xe8, 0x81, 0x0f, 0x84, 0x64, 0x38, 0x88, 0x10, 0x4a, 0xa3, 0x08, 0xa1,
+	0x2e, 0x24, 0x12, 0x6c, 0x37, 0x30, 0x14, 0x84, 0xc4, 0x82, 0xaa, 0x54, 0x02, 0x89, 0x25, 0xf2,
+	0x25, 0x6e, 0x2f, 0x47, 0xe2, 0x7f, 0x64, 0xbb, 0x2d, 0xdd, 0x98, 0x99, 0x10, 0x13, 0x23, 0x1f,
+	0xe7, 0xc6, 0x1b, 0x11, 0x43, 0x85, 0xda, 0x89, 0x95, 0x4f, 0x80, 0x62, 0xb7, 0x47, 0x43, 0x73,
+	0xc3, 0xa9, 0x93, 0xa3, 0xbf, 0x9e, 0xdf, 0xfb, 0xe5, 0xc5, 0x31, 0x3e, 0x4c, 0x80, 0x0f, 0xf3,
+	0x2c, 0x51, 0x61, 0x49, 0x05, 0x2d, 0x64, 0x50, 0x0a, 0x50, 0x40, 0x0e, 0x73, 0x3a, 0xa1, 0x9c,
+	0xa9, 0xa0, 0x5a, 0x83, 0xb5, 0xe6, 0xc1, 0xdd, 0x11, 0x8c, 0x40, 0x2b, 0xc2, 0xea, 0xc9, 0x88,
+	0xfd, 0xdf, 0x36, 0x6e, 0xf7, 0xf5, 0x6e, 0x22, 0xf1, 0xed, 0x82, 0x9e, 0x82, 0xc8, 0xd4, 0xac,
+	0xcf, 0x44, 0xc2, 0xb8, 0x72, 0x90, 0x87, 0xba, 0x37, 0x7a, 0xaf, 0xcf, 0xe6, 0x1d, 0xeb, 0xe7,
+	0xbc, 0xf3, 0x78, 0x94, 0xa9, 0x93, 0xf1, 0x71, 0x90, 0x40, 0x11, 0x26, 0x20, 0x0b, 0x90, 0xab,
+	0xe5, 0x89, 0x4c, 0x3f, 0x84, 0x6a, 0x56, 0x32, 0x19, 0xbc, 0x64, 0xc9, 0x9f, 0x79, 0xe7, 0xfe,
+	0x8c, 0x16, 0xf9, 0x91, 0xbf, 0xb6, 0x8b, 0x4b, 0xe3, 0xe7, 0x47, 0xff, 0x27, 0x90, 0x47, 0xf8,
+	0xd6, 0x04, 0x14, 0x1b, 0x28, 0x2a, 0xd4, 0xa0, 0xa4, 0xdc, 0xd9, 0xf3, 0x50, 0xd7, 0x8e, 0xea,
+	0x43, 0xe2, 0x62, 0x5c, 0x0d, 0xfa, 0x4c, 0x64, 0x90, 0x3a, 0x2d, 0x2d, 0xd9, 0x98, 0x90, 0xe7,
+	0x78, 0x3f, 0x62, 0x53, 0x2a, 0x52, 0xe9, 0xd8, 0x1e, 0xea, 0x1e, 0x3c, 0x75, 0x83, 0xc6, 0x12,
+	0x82, 0x95, 0xaa, 0x67, 0x57, 0xaf, 0x14, 0xad, 0x37, 0x11, 0x1f, 0xdf, 0x14, 0x6c, 0xc2, 0x68,
+	0xfe, 0x2e, 0xe3, 0x29, 0x4c, 0x9d, 0x6b, 0x3a, 0xa1, 0x36, 0x23, 0x1e, 0x3e, 0x38, 0x1d, 0x0b,
+	0x10, 0xf2, 0x05, 0x8c, 0xb9, 0x72, 0xda, 0x5a, 0xb2, 0x39, 0x22, 0x9f, 0x11, 0xbe, 0xc7, 0x81,
+	0x47, 0x7a, 0xd7, 0x20, 0xa7, 0xf2, 0xe4, 0x95, 0xa0, 0x89, 0xca, 0x80, 0x3b, 0xfb, 0xba, 0xc8,
+	0xe8, 0xca, 0x45, 0x7a, 0xa6, 0x48, 0x0e, 0x3c, 0x36, 0x30, 0xb1, 0xac, 0x7c, 0xe3, 0xe1, 0xca,
+	0xd8, 0x8f, 0x2e, 0x49, 0xd4, 0x30, 0x43, 0x41, 0xc7, 0xe9, 0xdb, 0xaa, 0xc9, 0x1a, 0xcc, 0xf5,
+	0xdd, 0x60, 0xb4, 0x6b, 0x5c, 0xb5, 0xbf, 0x0d, 0xd3, 0x9c, 0x78, 0x64, 0x7f, 0xfb, 0xde, 0xb1,
+	0xfc, 0xaf, 0xad, 0x8b, 0xcf, 0x44, 0x3e, 0x21, 0x7c, 0x67, 0x9a, 0x71, 0xce, 0x84, 0x99, 0xd4,
+	0x4f, 0xdc, 0x9b, 0x2b, 0xb3, 0x3d, 0x34, 0x6c, 0xc6, 0x32, 0x16, 0xda, 0xf3, 0xdf, 0xb1, 0x6b,
+	0x8a, 0xd2, 0x08, 0x49, 0x9e, 0x31, 0xae, 0xea, 0x08, 0x7b, 0xbb, 0x21, 0x18, 0xcb, 0x6d, 0x84,
+	0x86, 0x28, 0x8d, 0x50, 0x15, 0x29, 0x64, 0x1d, 0xa1, 0xb5, 0x1b, 0x82, 0xb1, 0xdc, 0x46, 0x68,
+	0x88, 0xea, 0xf5, 0xce, 0x16, 0x2e, 0x3a, 0x5f, 0xb8, 0xe8, 0xd7, 0xc2, 0x45, 0x5f, 0x96, 0xae,
+	0x75, 0xbe, 0x74, 0xad, 0x1f, 0x4b, 0xd7, 0x7a, 0xdf, 0xdd, 0x88, 0x5d, 0xfd, 0x4d, 0x7a, 0x0d,
+	0x3f, 0x86, 0x17, 0x17, 0x8f, 0x0e, 0x3f, 0x6e, 0xeb, 0xbb, 0xe4, 0xd9, 0xdf, 0x01, 0x00, 0x93,
+	0xdb, 0x09, 0xe0, 0x91, 0x04, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -179,6 +204,36 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.FraudVoteSlashFraction.Size()
+		i -= size
+		if _, err := m.FraudVoteSlashFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.NonRevealSlashFraction.Size()
+		i -= size
+		if _, err := m.NonRevealSlashFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.JurorsCount != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.JurorsCount))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.RevealWindow != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.RevealWindow))
+		i--
+		dAtA[i] = 0x28
+	}
 	{
 		size, err := m.Rewards.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -292,6 +347,16 @@ func (m *Params) Size() (n int) {
 	}
 	l = m.Rewards.Size()
 	n += 1 + l + sovParams(uint64(l))
+	if m.RevealWindow != 0 {
+		n += 1 + sovParams(uint64(m.RevealWindow))
+	}
+	if m.JurorsCount != 0 {
+		n += 1 + sovParams(uint64(m.JurorsCount))
+	}
+	l = m.NonRevealSlashFraction.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.FraudVoteSlashFraction.Size()
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -450,6 +515,112 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevealWindow", wireType)
+			}
+			m.RevealWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RevealWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JurorsCount", wireType)
+			}
+			m.JurorsCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JurorsCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NonRevealSlashFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NonRevealSlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FraudVoteSlashFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FraudVoteSlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -297,6 +297,338 @@ func (m *QueryAllConflictVoteResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+type QueryActiveConflictsRequest struct {
+	ChainID string `protobuf:"bytes,1,opt,name=chainID,proto3" json:"chainID,omitempty"`
+	Juror   string `protobuf:"bytes,2,opt,name=juror,proto3" json:"juror,omitempty"`
+}
+
+func (m *QueryActiveConflictsRequest) Reset()         { *m = QueryActiveConflictsRequest{} }
+func (m *QueryActiveConflictsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryActiveConflictsRequest) ProtoMessage()    {}
+func (*QueryActiveConflictsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_538e967c65eea35b, []int{6}
+}
+func (m *QueryActiveConflictsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryActiveConflictsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryActiveConflictsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryActiveConflictsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryActiveConflictsRequest.Merge(m, src)
+}
+func (m *QueryActiveConflictsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryActiveConflictsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryActiveConflictsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryActiveConflictsRequest proto.InternalMessageInfo
+
+func (m *QueryActiveConflictsRequest) GetChainID() string {
+	if m != nil {
+		return m.ChainID
+	}
+	return ""
+}
+
+func (m *QueryActiveConflictsRequest) GetJuror() string {
+	if m != nil {
+		return m.Juror
+	}
+	return ""
+}
+
+type QueryActiveConflictsResponse struct {
+	Conflicts []ActiveConflict `protobuf:"bytes,1,rep,name=conflicts,proto3" json:"conflicts"`
+}
+
+func (m *QueryActiveConflictsResponse) Reset()         { *m = QueryActiveConflictsResponse{} }
+func (m *QueryActiveConflictsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryActiveConflictsResponse) ProtoMessage()    {}
+func (*QueryActiveConflictsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_538e967c65eea35b, []int{7}
+}
+func (m *QueryActiveConflictsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryActiveConflictsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryActiveConflictsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryActiveConflictsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryActiveConflictsResponse.Merge(m, src)
+}
+func (m *QueryActiveConflictsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryActiveConflictsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryActiveConflictsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryActiveConflictsResponse proto.InternalMessageInfo
+
+func (m *QueryActiveConflictsResponse) GetConflicts() []ActiveConflict {
+	if m != nil {
+		return m.Conflicts
+	}
+	return nil
+}
+
+type ActiveConflict struct {
+	Index          string   `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
+	ChainID        string   `protobuf:"bytes,2,opt,name=chainID,proto3" json:"chainID,omitempty"`
+	ClientAddress  string   `protobuf:"bytes,3,opt,name=clientAddress,proto3" json:"clientAddress,omitempty"`
+	FirstProvider  string   `protobuf:"bytes,4,opt,name=firstProvider,proto3" json:"firstProvider,omitempty"`
+	SecondProvider string   `protobuf:"bytes,5,opt,name=secondProvider,proto3" json:"secondProvider,omitempty"`
+	VoteState      string   `protobuf:"bytes,6,opt,name=voteState,proto3" json:"voteState,omitempty"`
+	VoteStartBlock uint64   `protobuf:"varint,7,opt,name=voteStartBlock,proto3" json:"voteStartBlock,omitempty"`
+	VoteDeadline   uint64   `protobuf:"varint,8,opt,name=voteDeadline,proto3" json:"voteDeadline,omitempty"`
+	Jurors         uint64   `protobuf:"varint,9,opt,name=jurors,proto3" json:"jurors,omitempty"`
+	Committed      uint64   `protobuf:"varint,10,opt,name=committed,proto3" json:"committed,omitempty"`
+	Revealed       uint64   `protobuf:"varint,11,opt,name=revealed,proto3" json:"revealed,omitempty"`
+	PendingJurors  []string `protobuf:"bytes,12,rep,name=pendingJurors,proto3" json:"pendingJurors,omitempty"`
+}
+
+func (m *ActiveConflict) Reset()         { *m = ActiveConflict{} }
+func (m *ActiveConflict) String() string { return proto.CompactTextString(m) }
+func (*ActiveConflict) ProtoMessage()    {}
+func (*ActiveConflict) Descriptor() ([]byte, []int) {
+	return fileDescriptor_538e967c65eea35b, []int{8}
+}
+func (m *ActiveConflict) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ActiveConflict) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ActiveConflict.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ActiveConflict) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActiveConflict.Merge(m, src)
+}
+func (m *ActiveConflict) XXX_Size() int {
+	return m.Size()
+}
+func (m *ActiveConflict) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActiveConflict.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActiveConflict proto.InternalMessageInfo
+
+func (m *ActiveConflict) GetIndex() string {
+	if m != nil {
+		return m.Index
+	}
+	return ""
+}
+
+func (m *ActiveConflict) GetChainID() string {
+	if m != nil {
+		return m.ChainID
+	}
+	return ""
+}
+
+func (m *ActiveConflict) GetClientAddress() string {
+	if m != nil {
+		return m.ClientAddress
+	}
+	return ""
+}
+
+func (m *ActiveConflict) GetFirstProvider() string {
+	if m != nil {
+		return m.FirstProvider
+	}
+	return ""
+}
+
+func (m *ActiveConflict) GetSecondProvider() string {
+	if m != nil {
+		return m.SecondProvider
+	}
+	return ""
+}
+
+func (m *ActiveConflict) GetVoteState() string {
+	if m != nil {
+		return m.VoteState
+	}
+	return ""
+}
+
+func (m *ActiveConflict) GetVoteStartBlock() uint64 {
+	if m != nil {
+		return m.VoteStartBlock
+	}
+	return 0
+}
+
+func (m *ActiveConflict) GetVoteDeadline() uint64 {
+	if m != nil {
+		return m.VoteDeadline
+	}
+	return 0
+}
+
+func (m *ActiveConflict) GetJurors() uint64 {
+	if m != nil {
+		return m.Jurors
+	}
+	return 0
+}
+
+func (m *ActiveConflict) GetCommitted() uint64 {
+	if m != nil {
+		return m.Committed
+	}
+	return 0
+}
+
+func (m *ActiveConflict) GetRevealed() uint64 {
+	if m != nil {
+		return m.Revealed
+	}
+	return 0
+}
+
+func (m *ActiveConflict) GetPendingJurors() []string {
+	if m != nil {
+		return m.PendingJurors
+	}
+	return nil
+}
+
+type QueryDeterministicApiReportRequest struct {
+	ChainID          string `protobuf:"bytes,1,opt,name=chainID,proto3" json:"chainID,omitempty"`
+	MinConflicts     uint64 `protobuf:"varint,2,opt,name=minConflicts,proto3" json:"minConflicts,omitempty"`
+	MinBenignPercent uint64 `protobuf:"varint,3,opt,name=minBenignPercent,proto3" json:"minBenignPercent,omitempty"`
+}
+
+func (m *QueryDeterministicApiReportRequest) Reset()         { *m = QueryDeterministicApiReportRequest{} }
+func (m *QueryDeterministicApiReportRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDeterministicApiReportRequest) ProtoMessage()    {}
+func (*QueryDeterministicApiReportRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_538e967c65eea35b, []int{9}
+}
+func (m *QueryDeterministicApiReportRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDeterministicApiReportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDeterministicApiReportRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDeterministicApiReportRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDeterministicApiReportRequest.Merge(m, src)
+}
+func (m *QueryDeterministicApiReportRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDeterministicApiReportRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDeterministicApiReportRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDeterministicApiReportRequest proto.InternalMessageInfo
+
+func (m *QueryDeterministicApiReportRequest) GetChainID() string {
+	if m != nil {
+		return m.ChainID
+	}
+	return ""
+}
+
+func (m *QueryDeterministicApiReportRequest) GetMinConflicts() uint64 {
+	if m != nil {
+		return m.MinConflicts
+	}
+	return 0
+}
+
+func (m *QueryDeterministicApiReportRequest) GetMinBenignPercent() uint64 {
+	if m != nil {
+		return m.MinBenignPercent
+	}
+	return 0
+}
+
+type QueryDeterministicApiReportResponse struct {
+	Apis []ApiConflictStats `protobuf:"bytes,1,rep,name=apis,proto3" json:"apis"`
+}
+
+func (m *QueryDeterministicApiReportResponse) Reset()         { *m = QueryDeterministicApiReportResponse{} }
+func (m *QueryDeterministicApiReportResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDeterministicApiReportResponse) ProtoMessage()    {}
+func (*QueryDeterministicApiReportResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_538e967c65eea35b, []int{10}
+}
+func (m *QueryDeterministicApiReportResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDeterministicApiReportResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDeterministicApiReportResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDeterministicApiReportResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDeterministicApiReportResponse.Merge(m, src)
+}
+func (m *QueryDeterministicApiReportResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDeterministicApiReportResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDeterministicApiReportResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDeterministicApiReportResponse proto.InternalMessageInfo
+
+func (m *QueryDeterministicApiReportResponse) GetApis() []ApiConflictStats {
+	if m != nil {
+		return m.Apis
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "lavanet.lava.conflict.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "lavanet.lava.conflict.QueryParamsResponse")
@@ -304,44 +636,73 @@ func init() {
 	proto.RegisterType((*QueryGetConflictVoteResponse)(nil), "lavanet.lava.conflict.QueryGetConflictVoteResponse")
 	proto.RegisterType((*QueryAllConflictVoteRequest)(nil), "lavanet.lava.conflict.QueryAllConflictVoteRequest")
 	proto.RegisterType((*QueryAllConflictVoteResponse)(nil), "lavanet.lava.conflict.QueryAllConflictVoteResponse")
+	proto.RegisterType((*QueryActiveConflictsRequest)(nil), "lavanet.lava.conflict.QueryActiveConflictsRequest")
+	proto.RegisterType((*QueryActiveConflictsResponse)(nil), "lavanet.lava.conflict.QueryActiveConflictsResponse")
+	proto.RegisterType((*ActiveConflict)(nil), "lavanet.lava.conflict.ActiveConflict")
+	proto.RegisterType((*QueryDeterministicApiReportRequest)(nil), "lavanet.lava.conflict.QueryDeterministicApiReportRequest")
+	proto.RegisterType((*QueryDeterministicApiReportResponse)(nil), "lavanet.lava.conflict.QueryDeterministicApiReportResponse")
 }
 
 func init() { proto.RegisterFile("conflict/query.proto", fileDescriptor_538e967c65eea35b) }
 
 var fileDescriptor_538e967c65eea35b = []byte{
-	// 506 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0x41, 0x6f, 0xd3, 0x30,
-	0x14, 0xc7, 0xeb, 0x8d, 0x55, 0xc2, 0x4c, 0x42, 0x32, 0x9d, 0x84, 0x4a, 0x97, 0x21, 0x03, 0x63,
-	0x4c, 0x93, 0xad, 0xb5, 0xdc, 0x38, 0xad, 0x48, 0xec, 0x84, 0x34, 0x72, 0xe0, 0xc0, 0x05, 0x39,
-	0xc1, 0x84, 0x48, 0x89, 0x9d, 0xd5, 0x6e, 0xb5, 0x09, 0x71, 0xe1, 0xc0, 0x19, 0x89, 0x2f, 0xc1,
-	0x85, 0x2b, 0x9f, 0x61, 0xc7, 0x49, 0x5c, 0x38, 0x21, 0xd4, 0x72, 0xe3, 0x4b, 0xa0, 0xd8, 0xce,
-	0x96, 0xb0, 0xa4, 0x14, 0xed, 0x14, 0xd7, 0x7d, 0xff, 0xff, 0xff, 0xf7, 0xfa, 0x5e, 0x03, 0x3b,
-	0xa1, 0x14, 0xaf, 0x93, 0x38, 0xd4, 0xf4, 0x70, 0xcc, 0x47, 0xc7, 0x24, 0x1b, 0x49, 0x2d, 0xd1,
-	0x5a, 0xc2, 0x26, 0x4c, 0x70, 0x4d, 0xf2, 0x27, 0x29, 0x4a, 0xba, 0xbd, 0x48, 0xca, 0x28, 0xe1,
-	0x94, 0x65, 0x31, 0x65, 0x42, 0x48, 0xcd, 0x74, 0x2c, 0x85, 0xb2, 0xa2, 0xee, 0x76, 0x28, 0x55,
-	0x2a, 0x15, 0x0d, 0x98, 0xe2, 0xd6, 0x8d, 0x4e, 0x76, 0x03, 0xae, 0xd9, 0x2e, 0xcd, 0x58, 0x14,
-	0x0b, 0x53, 0xec, 0x6a, 0xd7, 0xce, 0x62, 0x33, 0x36, 0x62, 0x69, 0x61, 0xd1, 0x3b, 0xbb, 0x2e,
-	0x0e, 0x2f, 0x27, 0x52, 0x73, 0xf7, 0x6d, 0x27, 0x92, 0x91, 0x34, 0x47, 0x9a, 0x9f, 0xec, 0x2d,
-	0xee, 0x40, 0xf4, 0x2c, 0x0f, 0x3b, 0x30, 0x46, 0x3e, 0x3f, 0x1c, 0x73, 0xa5, 0xb1, 0x0f, 0x6f,
-	0x54, 0x6e, 0x55, 0x26, 0x85, 0xe2, 0xe8, 0x11, 0x6c, 0xdb, 0xc0, 0x9b, 0xe0, 0x36, 0xd8, 0xba,
-	0xd6, 0x5f, 0x27, 0xb5, 0x9d, 0x12, 0x2b, 0x1b, 0x5e, 0x39, 0xf9, 0xb1, 0xd1, 0xf2, 0x9d, 0x04,
-	0x0f, 0xe0, 0x2d, 0xe3, 0xb9, 0xcf, 0xf5, 0x63, 0x57, 0xf8, 0x5c, 0x6a, 0xee, 0x22, 0x51, 0x07,
-	0xae, 0xc4, 0xe2, 0x15, 0x3f, 0x32, 0xd6, 0x57, 0x7d, 0xfb, 0x01, 0xa7, 0xb0, 0x57, 0x2f, 0x72,
-	0x44, 0x4f, 0xe1, 0x6a, 0x58, 0xba, 0x77, 0x5c, 0x77, 0x1a, 0xb8, 0xca, 0x16, 0x8e, 0xae, 0x22,
-	0xc7, 0xdc, 0x31, 0xee, 0x25, 0x49, 0x1d, 0xe3, 0x13, 0x08, 0xcf, 0x67, 0xe1, 0xb2, 0x36, 0x89,
-	0x1d, 0x1c, 0xc9, 0x07, 0x47, 0xec, 0x1a, 0xb8, 0xc1, 0x91, 0x03, 0x16, 0x15, 0x5a, 0xbf, 0xa4,
-	0xc4, 0x5f, 0x81, 0x6b, 0xeb, 0x42, 0x4e, 0x63, 0x5b, 0xcb, 0x97, 0x68, 0x0b, 0xed, 0x57, 0xb8,
-	0x97, 0x0c, 0xf7, 0xfd, 0x7f, 0x72, 0x5b, 0x96, 0x32, 0x78, 0xff, 0xf7, 0x32, 0x5c, 0x31, 0xe0,
-	0xe8, 0x03, 0x80, 0x6d, 0x3b, 0x66, 0xf4, 0xa0, 0x01, 0xeb, 0xe2, 0x5e, 0x75, 0xb7, 0x17, 0x29,
-	0xb5, 0xb9, 0xf8, 0xde, 0xfb, 0x6f, 0xbf, 0x3e, 0x2d, 0x6d, 0xa0, 0x75, 0xea, 0x34, 0xe6, 0x49,
-	0xff, 0x5a, 0x7d, 0xf4, 0x05, 0xc0, 0xd5, 0xf2, 0x0f, 0x80, 0xfa, 0xf3, 0x32, 0xea, 0x97, 0xaf,
-	0x3b, 0xf8, 0x2f, 0x8d, 0x03, 0x7c, 0x68, 0x00, 0x09, 0xda, 0x69, 0x00, 0xac, 0xfc, 0x09, 0xe9,
-	0x5b, 0xb3, 0xd0, 0xef, 0xd0, 0x67, 0x00, 0xaf, 0x97, 0xed, 0xf6, 0x92, 0x64, 0x3e, 0x72, 0xfd,
-	0x2e, 0xce, 0x47, 0x6e, 0xd8, 0x2b, 0xbc, 0x63, 0x90, 0x37, 0xd1, 0xdd, 0x45, 0x90, 0x87, 0xc3,
-	0x93, 0xa9, 0x07, 0x4e, 0xa7, 0x1e, 0xf8, 0x39, 0xf5, 0xc0, 0xc7, 0x99, 0xd7, 0x3a, 0x9d, 0x79,
-	0xad, 0xef, 0x33, 0xaf, 0xf5, 0x62, 0x2b, 0x8a, 0xf5, 0x9b, 0x71, 0x40, 0x42, 0x99, 0x56, 0x9d,
-	0x8e, 0xce, 0xbd, 0xf4, 0x71, 0xc6, 0x55, 0xd0, 0x36, 0xaf, 0x99, 0xc1, 0x9f, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x53, 0x85, 0xad, 0x39, 0x2a, 0x05, 0x00, 0x00,
+	// 892 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x41, 0x6f, 0xdc, 0x44,
+	0x14, 0x8e, 0x93, 0xcd, 0xb6, 0xfb, 0xba, 0xb4, 0x68, 0x48, 0x2b, 0xcb, 0x6c, 0xb7, 0x91, 0xdb,
+	0x26, 0x69, 0x54, 0xd9, 0x4a, 0x16, 0x09, 0x01, 0xa7, 0x5d, 0x22, 0xaa, 0x22, 0x55, 0x0a, 0x46,
+	0xe2, 0xc0, 0x25, 0x9a, 0xd8, 0xaf, 0xce, 0x80, 0x3d, 0xe3, 0xda, 0xb3, 0xab, 0x56, 0x88, 0x0b,
+	0x07, 0x8e, 0x08, 0x09, 0x89, 0xdf, 0x80, 0x84, 0xb8, 0xf2, 0x1b, 0x72, 0xac, 0xc4, 0x85, 0x13,
+	0x42, 0x49, 0x7f, 0x08, 0xf2, 0x78, 0xbc, 0xbb, 0xde, 0xda, 0x9b, 0x86, 0x9e, 0xd6, 0xf3, 0xcd,
+	0xfb, 0xbe, 0xf7, 0xbd, 0x79, 0xcf, 0xe3, 0x85, 0x0d, 0x5f, 0xf0, 0xa7, 0x11, 0xf3, 0xa5, 0xfb,
+	0x6c, 0x8c, 0xe9, 0x0b, 0x27, 0x49, 0x85, 0x14, 0xe4, 0x66, 0x44, 0x27, 0x94, 0xa3, 0x74, 0xf2,
+	0x5f, 0xa7, 0x0c, 0xb1, 0x7a, 0xa1, 0x10, 0x61, 0x84, 0x2e, 0x4d, 0x98, 0x4b, 0x39, 0x17, 0x92,
+	0x4a, 0x26, 0x78, 0x56, 0x90, 0xac, 0x5d, 0x5f, 0x64, 0xb1, 0xc8, 0xdc, 0x63, 0x9a, 0x61, 0xa1,
+	0xe6, 0x4e, 0xf6, 0x8e, 0x51, 0xd2, 0x3d, 0x37, 0xa1, 0x21, 0xe3, 0x2a, 0x58, 0xc7, 0xde, 0x9c,
+	0xa6, 0x4d, 0x68, 0x4a, 0xe3, 0x52, 0xa2, 0x37, 0x85, 0xcb, 0x87, 0xa3, 0x89, 0x90, 0xa8, 0x77,
+	0x37, 0x42, 0x11, 0x0a, 0xf5, 0xe8, 0xe6, 0x4f, 0x05, 0x6a, 0x6f, 0x00, 0xf9, 0x22, 0x4f, 0x76,
+	0xa8, 0x84, 0x3c, 0x7c, 0x36, 0xc6, 0x4c, 0xda, 0x1e, 0xbc, 0x57, 0x41, 0xb3, 0x44, 0xf0, 0x0c,
+	0xc9, 0x27, 0xd0, 0x2e, 0x12, 0x9a, 0xc6, 0xa6, 0xb1, 0x73, 0x6d, 0xff, 0xb6, 0x53, 0x5b, 0xa9,
+	0x53, 0xd0, 0x46, 0xad, 0xd3, 0x7f, 0xee, 0xac, 0x78, 0x9a, 0x62, 0x0f, 0xe0, 0x7d, 0xa5, 0xf9,
+	0x08, 0xe5, 0xa7, 0x3a, 0xf0, 0x2b, 0x21, 0x51, 0xa7, 0x24, 0x1b, 0xb0, 0xce, 0x78, 0x80, 0xcf,
+	0x95, 0x74, 0xc7, 0x2b, 0x16, 0x76, 0x0c, 0xbd, 0x7a, 0x92, 0x76, 0xf4, 0x04, 0xba, 0xfe, 0x1c,
+	0xae, 0x7d, 0xdd, 0x6d, 0xf0, 0x35, 0x2f, 0xa1, 0xdd, 0x55, 0xe8, 0x36, 0x6a, 0x8f, 0xc3, 0x28,
+	0xaa, 0xf3, 0xf8, 0x19, 0xc0, 0xac, 0x17, 0x3a, 0xd7, 0x96, 0x53, 0x34, 0xce, 0xc9, 0x1b, 0xe7,
+	0x14, 0x63, 0xa0, 0x1b, 0xe7, 0x1c, 0xd2, 0xb0, 0xe4, 0x7a, 0x73, 0x4c, 0xfb, 0x4f, 0x03, 0x7a,
+	0xf5, 0x79, 0x1a, 0xcb, 0x5a, 0x7b, 0x8b, 0xb2, 0xc8, 0xa3, 0x8a, 0xef, 0x55, 0xe5, 0x7b, 0xfb,
+	0x42, 0xdf, 0x85, 0x97, 0x8a, 0xf1, 0x27, 0xe5, 0xf9, 0xf8, 0x92, 0x4d, 0xb0, 0xcc, 0x5b, 0x8e,
+	0x0d, 0x31, 0xe1, 0x8a, 0x7f, 0x42, 0x19, 0x7f, 0x7c, 0xa0, 0xbb, 0x58, 0x2e, 0xf3, 0xee, 0x7e,
+	0x33, 0x4e, 0x45, 0xaa, 0x92, 0x77, 0xbc, 0x62, 0x61, 0x33, 0xe8, 0xd5, 0xcb, 0xe9, 0x63, 0x78,
+	0x0c, 0x9d, 0xb2, 0x8e, 0x4c, 0x9f, 0xc1, 0xfd, 0x86, 0x33, 0xa8, 0x4a, 0xe8, 0x53, 0x98, 0xb1,
+	0xed, 0x5f, 0xd7, 0xe0, 0x7a, 0x35, 0xa6, 0x7e, 0xe2, 0xe6, 0x6b, 0x58, 0xad, 0xd6, 0x70, 0x0f,
+	0xde, 0xf1, 0x23, 0x86, 0x5c, 0x0e, 0x83, 0x20, 0xc5, 0x2c, 0x33, 0xd7, 0xd4, 0x7e, 0x15, 0xcc,
+	0xa3, 0x9e, 0xb2, 0x34, 0x93, 0x87, 0xa9, 0x98, 0xb0, 0x00, 0x53, 0xb3, 0x55, 0x44, 0x55, 0x40,
+	0xb2, 0x05, 0xd7, 0x33, 0xf4, 0x05, 0x0f, 0xa6, 0x61, 0xeb, 0x2a, 0x6c, 0x01, 0x25, 0x3d, 0xe8,
+	0xe4, 0xaf, 0xf0, 0x97, 0x92, 0x4a, 0x34, 0xdb, 0x2a, 0x64, 0x06, 0xe4, 0x2a, 0x7a, 0x91, 0xca,
+	0x51, 0x24, 0xfc, 0x6f, 0xcd, 0x2b, 0x9b, 0xc6, 0x4e, 0xcb, 0x5b, 0x40, 0x89, 0x0d, 0xdd, 0x1c,
+	0x39, 0x40, 0x1a, 0x44, 0x8c, 0xa3, 0x79, 0x55, 0x45, 0x55, 0x30, 0x72, 0x0b, 0xda, 0xaa, 0x29,
+	0x99, 0xd9, 0x51, 0xbb, 0x7a, 0x95, 0x3b, 0xf0, 0x45, 0x1c, 0x33, 0x29, 0x31, 0x30, 0x41, 0x6d,
+	0xcd, 0x00, 0x62, 0xc1, 0xd5, 0x14, 0x27, 0x48, 0x23, 0x0c, 0xcc, 0x6b, 0x6a, 0x73, 0xba, 0xce,
+	0x4f, 0x22, 0x41, 0x1e, 0x30, 0x1e, 0x7e, 0x5e, 0x08, 0x77, 0x37, 0xd7, 0xf2, 0x93, 0xa8, 0x80,
+	0xf6, 0x4f, 0x06, 0xd8, 0x6a, 0x08, 0x0e, 0x50, 0x62, 0x1a, 0x33, 0xce, 0x32, 0xc9, 0xfc, 0x61,
+	0xc2, 0x3c, 0x4c, 0x44, 0x2a, 0x2f, 0x1e, 0x2d, 0x1b, 0xba, 0x31, 0xe3, 0xd3, 0xe1, 0x51, 0x5d,
+	0x6b, 0x79, 0x15, 0x8c, 0xec, 0xc2, 0xbb, 0x31, 0xe3, 0x23, 0xe4, 0x2c, 0xe4, 0x87, 0x98, 0xfa,
+	0xc8, 0xa5, 0xea, 0x5e, 0xcb, 0x7b, 0x0d, 0xb7, 0x4f, 0xe0, 0xee, 0x52, 0x3f, 0x7a, 0x36, 0x87,
+	0xd0, 0xa2, 0x09, 0x2b, 0xc7, 0x72, 0xbb, 0x69, 0x2c, 0x13, 0x56, 0xba, 0xc8, 0x5b, 0x56, 0xde,
+	0x89, 0x8a, 0xba, 0xff, 0xaa, 0x0d, 0xeb, 0x2a, 0x15, 0xf9, 0xd1, 0x80, 0x76, 0x71, 0x69, 0x92,
+	0x07, 0x0d, 0x4a, 0xaf, 0xdf, 0xd2, 0xd6, 0xee, 0x9b, 0x84, 0x16, 0x76, 0xed, 0xfb, 0x3f, 0xfc,
+	0xf5, 0xea, 0x97, 0xd5, 0x3b, 0xe4, 0xb6, 0xab, 0x39, 0xea, 0xd7, 0x5d, 0xf8, 0x90, 0x90, 0x3f,
+	0x0c, 0xe8, 0xce, 0x5f, 0x27, 0x64, 0x7f, 0x59, 0x8e, 0xfa, 0xab, 0xdc, 0x1a, 0x5c, 0x8a, 0xa3,
+	0x0d, 0x7e, 0xa0, 0x0c, 0x3a, 0xe4, 0x61, 0x83, 0xc1, 0xca, 0x27, 0xcd, 0xfd, 0x4e, 0xbd, 0xac,
+	0xdf, 0x93, 0xdf, 0x0c, 0xb8, 0x31, 0x2f, 0x37, 0x8c, 0xa2, 0xe5, 0x96, 0xeb, 0x6f, 0x76, 0x6b,
+	0x70, 0x29, 0x8e, 0xb6, 0xfc, 0x50, 0x59, 0xde, 0x22, 0xf7, 0xde, 0xc4, 0x32, 0xf9, 0xdd, 0x80,
+	0x1b, 0x0b, 0x17, 0xdd, 0x05, 0x56, 0x6b, 0x2f, 0x59, 0x6b, 0x70, 0x29, 0x8e, 0xb6, 0xea, 0x2a,
+	0xab, 0x0f, 0xc8, 0x76, 0x83, 0x55, 0xaa, 0x78, 0x47, 0xfe, 0xd4, 0xd9, 0xa9, 0x01, 0xb7, 0xea,
+	0xdf, 0x00, 0xf2, 0xd1, 0x32, 0x03, 0x4b, 0xdf, 0x62, 0xeb, 0xe3, 0xff, 0x43, 0xd5, 0x25, 0x7c,
+	0xa8, 0x4a, 0xd8, 0x23, 0x6e, 0x43, 0x09, 0xc1, 0x3c, 0xfd, 0x88, 0x26, 0xec, 0x28, 0x55, 0x02,
+	0xa3, 0xd1, 0xe9, 0x59, 0xdf, 0x78, 0x79, 0xd6, 0x37, 0xfe, 0x3d, 0xeb, 0x1b, 0x3f, 0x9f, 0xf7,
+	0x57, 0x5e, 0x9e, 0xf7, 0x57, 0xfe, 0x3e, 0xef, 0xaf, 0x7c, 0xbd, 0x13, 0x32, 0x79, 0x32, 0x3e,
+	0x76, 0x7c, 0x11, 0x57, 0x45, 0x9f, 0xcf, 0x64, 0xe5, 0x8b, 0x04, 0xb3, 0xe3, 0xb6, 0xfa, 0xb7,
+	0x34, 0xf8, 0x6f, 0x00, 0x5f, 0x08, 0x66, 0xdc, 0xf1, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -362,6 +723,10 @@ type QueryClient interface {
 	ConflictVote(ctx context.Context, in *QueryGetConflictVoteRequest, opts ...grpc.CallOption) (*QueryGetConflictVoteResponse, error)
 	// Queries a list of ConflictVote items.
 	ConflictVoteAll(ctx context.Context, in *QueryAllConflictVoteRequest, opts ...grpc.CallOption) (*QueryAllConflictVoteResponse, error)
+	// Queries the conflicts being voted on with the vote state of their jurors.
+	ActiveConflicts(ctx context.Context, in *QueryActiveConflictsRequest, opts ...grpc.CallOption) (*QueryActiveConflictsResponse, error)
+	// Queries the apis categorized as deterministic whose response conflicts are mostly benign, candidates for a spec fix.
+	DeterministicApiReport(ctx context.Context, in *QueryDeterministicApiReportRequest, opts ...grpc.CallOption) (*QueryDeterministicApiReportResponse, error)
 }
 
 type queryClient struct {
@@ -399,6 +764,24 @@ func (c *queryClient) ConflictVoteAll(ctx context.Context, in *QueryAllConflictV
 	return out, nil
 }
 
+func (c *queryClient) ActiveConflicts(ctx context.Context, in *QueryActiveConflictsRequest, opts ...grpc.CallOption) (*QueryActiveConflictsResponse, error) {
+	out := new(QueryActiveConflictsResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.conflict.Query/ActiveConflicts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DeterministicApiReport(ctx context.Context, in *QueryDeterministicApiReportRequest, opts ...grpc.CallOption) (*QueryDeterministicApiReportResponse, error) {
+	out := new(QueryDeterministicApiReportResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.conflict.Query/DeterministicApiReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
@@ -407,6 +790,10 @@ type QueryServer interface {
 	ConflictVote(context.Context, *QueryGetConflictVoteRequest) (*QueryGetConflictVoteResponse, error)
 	// Queries a list of ConflictVote items.
 	ConflictVoteAll(context.Context, *QueryAllConflictVoteRequest) (*QueryAllConflictVoteResponse, error)
+	// Queries the conflicts being voted on with the vote state of their jurors.
+	ActiveConflicts(context.Context, *QueryActiveConflictsRequest) (*QueryActiveConflictsResponse, error)
+	// Queries the apis categorized as deterministic whose response conflicts are mostly benign, candidates for a spec fix.
+	DeterministicApiReport(context.Context, *QueryDeterministicApiReportRequest) (*QueryDeterministicApiReportResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -422,6 +809,12 @@ func (*UnimplementedQueryServer) ConflictVote(ctx context.Context, req *QueryGet
 func (*UnimplementedQueryServer) ConflictVoteAll(ctx context.Context, req *QueryAllConflictVoteRequest) (*QueryAllConflictVoteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ConflictVoteAll not implemented")
 }
+func (*UnimplementedQueryServer) ActiveConflicts(ctx context.Context, req *QueryActiveConflictsRequest) (*QueryActiveConflictsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActiveConflicts not implemented")
+}
+func (*UnimplementedQueryServer) DeterministicApiReport(ctx context.Context, req *QueryDeterministicApiReportRequest) (*QueryDeterministicApiReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeterministicApiReport not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -481,6 +874,42 @@ func _Query_ConflictVoteAll_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ActiveConflicts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryActiveConflictsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ActiveConflicts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.conflict.Query/ActiveConflicts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ActiveConflicts(ctx, req.(*QueryActiveConflictsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DeterministicApiReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDeterministicApiReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DeterministicApiReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.conflict.Query/DeterministicApiReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DeterministicApiReport(ctx, req.(*QueryDeterministicApiReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "lavanet.lava.conflict.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -497,6 +926,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ConflictVoteAll",
 			Handler:    _Query_ConflictVoteAll_Handler,
 		},
+		{
+			MethodName: "ActiveConflicts",
+			Handler:    _Query_ActiveConflicts_Handler,
+		},
+		{
+			MethodName: "DeterministicApiReport",
+			Handler:    _Query_DeterministicApiReport_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "conflict/query.proto",
@@ -705,23 +1142,273 @@ func (m *QueryAllConflictVoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryActiveConflictsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
+
+func (m *QueryActiveConflictsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryActiveConflictsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Juror) > 0 {
+		i -= len(m.Juror)
+		copy(dAtA[i:], m.Juror)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Juror)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryActiveConflictsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryActiveConflictsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryActiveConflictsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Conflicts) > 0 {
+		for iNdEx := len(m.Conflicts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Conflicts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ActiveConflict) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ActiveConflict) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ActiveConflict) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PendingJurors) > 0 {
+		for iNdEx := len(m.PendingJurors) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PendingJurors[iNdEx])
+			copy(dAtA[i:], m.PendingJurors[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.PendingJurors[iNdEx])))
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if m.Revealed != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Revealed))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.Committed != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Committed))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.Jurors != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Jurors))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.VoteDeadline != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.VoteDeadline))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.VoteStartBlock != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.VoteStartBlock))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.VoteState) > 0 {
+		i -= len(m.VoteState)
+		copy(dAtA[i:], m.VoteState)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.VoteState)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.SecondProvider) > 0 {
+		i -= len(m.SecondProvider)
+		copy(dAtA[i:], m.SecondProvider)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SecondProvider)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.FirstProvider) > 0 {
+		i -= len(m.FirstProvider)
+		copy(dAtA[i:], m.FirstProvider)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FirstProvider)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ClientAddress) > 0 {
+		i -= len(m.ClientAddress)
+		copy(dAtA[i:], m.ClientAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDeterministicApiReportRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDeterministicApiReportRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDeterministicApiReportRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MinBenignPercent != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MinBenignPercent))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MinConflicts != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MinConflicts))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDeterministicApiReportResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDeterministicApiReportResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDeterministicApiReportResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Apis) > 0 {
+		for iNdEx := len(m.Apis) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Apis[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
 	return n
 }
 
@@ -785,20 +1472,758 @@ func (m *QueryAllConflictVoteResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryActiveConflictsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Juror)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryActiveConflictsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Conflicts) > 0 {
+		for _, e := range m.Conflicts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ActiveConflict) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ClientAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.FirstProvider)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.SecondProvider)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.VoteState)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.VoteStartBlock != 0 {
+		n += 1 + sovQuery(uint64(m.VoteStartBlock))
+	}
+	if m.VoteDeadline != 0 {
+		n += 1 + sovQuery(uint64(m.VoteDeadline))
+	}
+	if m.Jurors != 0 {
+		n += 1 + sovQuery(uint64(m.Jurors))
+	}
+	if m.Committed != 0 {
+		n += 1 + sovQuery(uint64(m.Committed))
+	}
+	if m.Revealed != 0 {
+		n += 1 + sovQuery(uint64(m.Revealed))
+	}
+	if len(m.PendingJurors) > 0 {
+		for _, s := range m.PendingJurors {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryDeterministicApiReportRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.MinConflicts != 0 {
+		n += 1 + sovQuery(uint64(m.MinConflicts))
+	}
+	if m.MinBenignPercent != 0 {
+		n += 1 + sovQuery(uint64(m.MinBenignPercent))
+	}
+	return n
+}
+
+func (m *QueryDeterministicApiReportResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Apis) > 0 {
+		for _, e := range m.Apis {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetConflictVoteRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetConflictVoteRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetConflictVoteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetConflictVoteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetConflictVoteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetConflictVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConflictVote", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ConflictVote.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllConflictVoteRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllConflictVoteRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllConflictVoteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllConflictVoteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllConflictVoteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllConflictVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConflictVote", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConflictVote = append(m.ConflictVote, ConflictVote{})
+			if err := m.ConflictVote[len(m.ConflictVote)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryActiveConflictsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryActiveConflictsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryActiveConflictsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Juror", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Juror = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryActiveConflictsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -821,12 +2246,46 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryActiveConflictsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryActiveConflictsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Conflicts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Conflicts = append(m.Conflicts, ActiveConflict{})
+			if err := m.Conflicts[len(m.Conflicts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -848,7 +2307,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *ActiveConflict) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -871,17 +2330,209 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ActiveConflict: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ActiveConflict: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstProvider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FirstProvider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondProvider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SecondProvider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteState", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			var msglen int
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoteState = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteStartBlock", wireType)
+			}
+			m.VoteStartBlock = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -891,78 +2542,90 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.VoteStartBlock |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteDeadline", wireType)
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.VoteDeadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VoteDeadline |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jurors", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			m.Jurors = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Jurors |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Committed", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryGetConflictVoteRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			m.Committed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Committed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revealed", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Revealed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Revealed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetConflictVoteRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetConflictVoteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingJurors", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -990,7 +2653,7 @@ func (m *QueryGetConflictVoteRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Index = string(dAtA[iNdEx:postIndex])
+			m.PendingJurors = append(m.PendingJurors, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1013,7 +2676,7 @@ func (m *QueryGetConflictVoteRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetConflictVoteResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDeterministicApiReportRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1036,17 +2699,17 @@ func (m *QueryGetConflictVoteResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetConflictVoteResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDeterministicApiReportRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetConflictVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDeterministicApiReportRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConflictVote", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1056,80 +2719,29 @@ func (m *QueryGetConflictVoteResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ConflictVote.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryAllConflictVoteRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllConflictVoteRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllConflictVoteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinConflicts", wireType)
 			}
-			var msglen int
+			m.MinConflicts = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1139,28 +2751,30 @@ func (m *QueryAllConflictVoteRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MinConflicts |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBenignPercent", wireType)
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.MinBenignPercent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinBenignPercent |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1182,7 +2796,7 @@ func (m *QueryAllConflictVoteRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllConflictVoteResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDeterministicApiReportResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1205,49 +2819,15 @@ func (m *QueryAllConflictVoteResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllConflictVoteResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDeterministicApiReportResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllConflictVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDeterministicApiReportResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConflictVote", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ConflictVote = append(m.ConflictVote, ConflictVote{})
-			if err := m.ConflictVote[len(m.ConflictVote)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Apis", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1274,10 +2854,8 @@ func (m *QueryAllConflictVoteResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Apis = append(m.Apis, ApiConflictStats{})
+			if err := m.Apis[len(m.Apis)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
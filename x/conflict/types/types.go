@@ -21,14 +21,31 @@ const (
 )
 
 const (
-	ConflictVoteRevealEventName        = "conflict_vote_reveal_started"
-	ConflictDetectionRecievedEventName = "conflict_detection_received"
-	ConflictVoteDetectionEventName     = "response_conflict_detection"
-	ConflictVoteResolvedEventName      = "conflict_detection_vote_resolved"
-	ConflictVoteUnresolvedEventName    = "conflict_detection_vote_unresolved"
-	ConflictVoteGotCommitEventName     = "conflict_vote_got_commit"
-	ConflictVoteGotRevealEventName     = "conflict_vote_got_reveal"
-	ConflictUnstakeFraudVoterEventName = "conflict_unstake_fraud_voter"
+	ConflictVoteRevealEventName         = "conflict_vote_reveal_started"
+	ConflictDetectionRecievedEventName  = "conflict_detection_received"
+	ConflictVoteDetectionEventName      = "response_conflict_detection"
+	ConflictVoteResolvedEventName       = "conflict_detection_vote_resolved"
+	ConflictVoteUnresolvedEventName     = "conflict_detection_vote_unresolved"
+	ConflictVoteGotCommitEventName      = "conflict_vote_got_commit"
+	ConflictVoteGotRevealEventName      = "conflict_vote_got_reveal"
+	ConflictUnstakeFraudVoterEventName  = "conflict_unstake_fraud_voter"
+	ConflictJurorNotRevealedEventName   = "conflict_juror_not_revealed"
+	ConflictSameProviderJailedEventName = "conflict_same_provider_jailed"
+)
+
+// cli flags
+const (
+	FlagSpecID = "spec-id"
+	FlagJuror  = "juror"
+
+	FlagMinConflicts     = "min-conflicts"
+	FlagMinBenignPercent = "min-benign-percent"
+)
+
+// deterministic api report defaults
+const (
+	DefaultReportMinConflicts     = 10
+	DefaultReportMinBenignPercent = 50
 )
 
 // unstake description
@@ -28,8 +28,10 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(CmdListStakeStorage())
 	cmd.AddCommand(CmdShowStakeStorage())
 	cmd.AddCommand(CmdShowEpochDetails())
+	cmd.AddCommand(CmdEstimatedEpochTime())
 	cmd.AddCommand(CmdListFixatedParams())
 	cmd.AddCommand(CmdShowFixatedParams())
+	cmd.AddCommand(CmdShowFixatedParamsAtBlock())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
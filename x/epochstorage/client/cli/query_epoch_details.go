@@ -2,6 +2,7 @@ package cli
 
 import (
 	"context"
+	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -34,3 +35,40 @@ func CmdShowEpochDetails() *cobra.Command {
 
 	return cmd
 }
+
+func CmdEstimatedEpochTime() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "estimated-epoch-time [optional: epochs-ahead]",
+		Short: "shows the estimated start time of an epoch, the next one by default, and the time left in the current epoch",
+		Args:  cobra.RangeArgs(0, 1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			argEpochsAhead := uint64(1)
+			if len(args) > 0 {
+				var err error
+				argEpochsAhead, err = strconv.ParseUint(args[0], 10, 64)
+				if err != nil {
+					return err
+				}
+			}
+
+			params := &types.QueryEstimatedEpochTimeRequest{
+				EpochsAhead: argEpochsAhead,
+			}
+
+			res, err := queryClient.EstimatedEpochTime(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
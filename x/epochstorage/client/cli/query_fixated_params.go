@@ -2,6 +2,7 @@ package cli
 
 import (
 	"context"
+	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -71,3 +72,38 @@ func CmdShowFixatedParams() *cobra.Command {
 
 	return cmd
 }
+
+func CmdShowFixatedParamsAtBlock() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-fixated-params-at-block [fixation-key] [block]",
+		Short: "shows the value a fixated param had at a block",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			argFixationKey := args[0]
+			argBlock, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryFixatedParamsAtBlockRequest{
+				FixationKey: argFixationKey,
+				Block:       argBlock,
+			}
+
+			res, err := queryClient.FixatedParamsAtBlock(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -16,10 +16,11 @@ func (k Keeper) EpochStart(ctx sdk.Context) {
 	// 1. update Epoch start
 	// 2. update the StakeStorage
 	// on epoch start block end: (because other modules need this info) to clear their storages
-	// 3. remove old StakeStorage
+	// 3. queue old StakeStorage for pruning
 	// 4. update earliest epoch start
 
 	k.SetEpochDetailsStart(ctx, block)
+	k.setEpochStartTime(ctx, block, ctx.BlockTime())
 
 	k.StoreCurrentEpochStakeStorage(ctx, block, types.ProviderKey)
 
@@ -27,6 +28,9 @@ func (k Keeper) EpochStart(ctx sdk.Context) {
 
 	k.UpdateEarliestEpochstart(ctx)
 
-	k.RemoveOldEpochData(ctx, types.ProviderKey)
-	k.RemoveOldEpochData(ctx, types.ClientKey)
+	k.removeEpochStartTimes(ctx, k.GetDeletedEpochs(ctx))
+
+	// a limited prune batch leaves the rest of the deleted epochs to the next blocks
+	k.queueEpochsForPruning(ctx, k.GetDeletedEpochs(ctx))
+	k.PruneEpochData(ctx)
 }
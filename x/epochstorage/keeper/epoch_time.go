@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/x/epochstorage/types"
+)
+
+// setEpochStartTime records the block time an epoch started at, used to estimate the time of the next epochs
+func (k Keeper) setEpochStartTime(ctx sdk.Context, epoch uint64, startTime time.Time) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.EpochStartTimeKeyPrefix))
+	store.Set(sdk.Uint64ToBigEndian(epoch), sdk.Uint64ToBigEndian(uint64(startTime.UTC().UnixMilli())))
+}
+
+func (k Keeper) getEpochStartTime(ctx sdk.Context, epoch uint64) (startTime time.Time, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.EpochStartTimeKeyPrefix))
+	b := store.Get(sdk.Uint64ToBigEndian(epoch))
+	if b == nil {
+		return time.Time{}, false
+	}
+	return time.UnixMilli(int64(binary.BigEndian.Uint64(b))).UTC(), true
+}
+
+func (k Keeper) removeEpochStartTimes(ctx sdk.Context, epochs []uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.EpochStartTimeKeyPrefix))
+	for _, epoch := range epochs {
+		store.Delete(sdk.Uint64ToBigEndian(epoch))
+	}
+}
+
+// AverageBlockTime returns the average lava block time since the earliest epoch start time in memory, zero before there is any history
+func (k Keeper) AverageBlockTime(ctx sdk.Context) time.Duration {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.EpochStartTimeKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	if !iterator.Valid() {
+		return 0
+	}
+	// big endian keys iterate from the earliest epoch
+	earliestEpoch := binary.BigEndian.Uint64(iterator.Key())
+	earliestTime := time.UnixMilli(int64(binary.BigEndian.Uint64(iterator.Value()))).UTC()
+	block := uint64(ctx.BlockHeight())
+	if block <= earliestEpoch || !ctx.BlockTime().After(earliestTime) {
+		return 0
+	}
+	return ctx.BlockTime().Sub(earliestTime) / time.Duration(block-earliestEpoch)
+}
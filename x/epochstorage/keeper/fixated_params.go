@@ -3,6 +3,7 @@ package keeper
 import (
 	"bytes"
 	"fmt"
+	"reflect"
 	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/store/prefix"
@@ -139,12 +140,17 @@ func (k Keeper) CleanAllOlderFixatedParams(ctx sdk.Context, startIdx uint64) {
 func (k Keeper) CleanOlderFixatedParams(ctx sdk.Context, fixationKey string, startIdx uint64) {
 	var idx uint64
 	var thisIdxKey string
+	exportData := k.ExportPrunedData(ctx)
 	for idx = startIdx; true; idx++ {
 		thisIdxKey = k.fixatedParamsKey(fixationKey, idx)
-		_, found := k.GetFixatedParams(ctx, thisIdxKey)
+		fixatedParams, found := k.GetFixatedParams(ctx, thisIdxKey)
 		if !found {
 			break
 		}
+		if exportData {
+			details := map[string]string{"fixationKey": fixationKey, "fixationBlock": strconv.FormatUint(fixatedParams.FixationBlock, 10), "value": k.fixatedParamValue(ctx, fixationKey, fixatedParams.Parameter)}
+			utils.LogLavaEvent(ctx, k.Logger(ctx), types.FixatedParamPrunedEventName, details, "fixated params that left memory pruned")
+		}
 		k.RemoveFixatedParams(ctx, thisIdxKey)
 	}
 	utils.LogLavaEvent(ctx, k.Logger(ctx), types.FixatedParamCleanedEventName, map[string]string{"moduleName": types.ModuleName, "fixatedParametersListLen": thisIdxKey}, "fixation cleaned")
@@ -176,6 +182,20 @@ func (k Keeper) GetFixatedParamsForBlock(ctx sdk.Context, fixationKey string, bl
 	return types.FixatedParams{Parameter: utils.Serialize(fixationGetParam(ctx)), FixationBlock: block}, err
 }
 
+// fixatedParamValue returns a readable fixated parameter, deserialized into the type of the current value of its registry
+func (k Keeper) fixatedParamValue(ctx sdk.Context, fixationKey string, parameter []byte) string {
+	fixationGetParam, ok := k.fixationRegistries[fixationKey]
+	if !ok {
+		return fmt.Sprintf("%X", parameter)
+	}
+	value := reflect.New(reflect.TypeOf(fixationGetParam(ctx)))
+	utils.Deserialize(parameter, value.Interface())
+	if stringer, ok := value.Interface().(fmt.Stringer); ok {
+		return stringer.String()
+	}
+	return fmt.Sprint(value.Elem().Interface())
+}
+
 func (k Keeper) GetParamForBlock(ctx sdk.Context, fixationKey string, block uint64, param any) error {
 	fixation, err := k.GetFixatedParamsForBlock(ctx, fixationKey, block)
 	utils.Deserialize(fixation.Parameter, param)
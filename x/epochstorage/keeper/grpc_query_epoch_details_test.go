@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
@@ -47,3 +48,39 @@ func TestEpochDetailsQuery(t *testing.T) {
 		})
 	}
 }
+
+func TestEstimatedEpochTimeQuery(t *testing.T) {
+	_, allkeepers, ctx := keepertest.InitAllKeepers(t)
+	keeper := allkeepers.Epochstorage
+
+	// advance until the genesis epoch leaves memory, the average is taken over the epochs in memory
+	for keeper.GetEarliestEpochStart(sdk.UnwrapSDKContext(ctx)) == 0 {
+		ctx = keepertest.AdvanceEpoch(ctx, allkeepers)
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	currentEpoch := keeper.GetEpochStart(sdkCtx)
+	nextEpoch, err := keeper.GetNextEpoch(sdkCtx, currentEpoch)
+	require.NoError(t, err)
+	epochBlocks, err := keeper.EpochBlocks(sdkCtx, currentEpoch)
+	require.NoError(t, err)
+
+	response, err := keeper.EstimatedEpochTime(ctx, &types.QueryEstimatedEpochTimeRequest{EpochsAhead: 0})
+	require.NoError(t, err)
+	require.Equal(t, currentEpoch, response.Epoch)
+	require.NotZero(t, response.EpochStartTime)
+	require.LessOrEqual(t, response.EpochStartTime, sdkCtx.BlockTime().Unix())
+	require.InDelta(t, keepertest.BLOCK_TIME.Milliseconds(), response.AverageBlockTimeMs, float64(time.Second.Milliseconds()))
+
+	response, err = keeper.EstimatedEpochTime(ctx, &types.QueryEstimatedEpochTimeRequest{EpochsAhead: 3})
+	require.NoError(t, err)
+	require.Equal(t, currentEpoch, response.CurrentEpoch)
+	require.Equal(t, nextEpoch+2*epochBlocks, response.Epoch)
+	require.Equal(t, nextEpoch-uint64(sdkCtx.BlockHeight()), response.BlocksLeftInEpoch)
+	averageBlockTime := time.Duration(response.AverageBlockTimeMs) * time.Millisecond
+	require.InDelta(t, (time.Duration(response.BlocksLeftInEpoch) * averageBlockTime).Seconds(), response.SecondsLeftInEpoch, 1)
+	expectedStart := sdkCtx.BlockTime().Add(time.Duration(response.Epoch-uint64(sdkCtx.BlockHeight())) * averageBlockTime)
+	require.InDelta(t, expectedStart.Unix(), response.EpochStartTime, 1)
+
+	_, err = keeper.EstimatedEpochTime(ctx, nil)
+	require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+}
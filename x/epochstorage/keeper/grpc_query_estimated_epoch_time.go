@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	"context"
+	"math"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/x/epochstorage/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) EstimatedEpochTime(c context.Context, req *types.QueryEstimatedEpochTimeRequest) (*types.QueryEstimatedEpochTimeResponse, error) {
+	if req == nil || req.EpochsAhead > math.MaxUint32 {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	block := uint64(ctx.BlockHeight())
+	currentEpoch := k.GetEpochStart(ctx)
+	nextEpoch, err := k.GetNextEpoch(ctx, currentEpoch)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	epochBlocks, err := k.EpochBlocks(ctx, block)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	averageBlockTime := k.AverageBlockTime(ctx)
+	blocksLeft := nextEpoch - block
+	res := types.QueryEstimatedEpochTimeResponse{
+		CurrentEpoch:       currentEpoch,
+		BlocksLeftInEpoch:  blocksLeft,
+		SecondsLeftInEpoch: uint64((time.Duration(blocksLeft) * averageBlockTime).Seconds()),
+		AverageBlockTimeMs: uint64(averageBlockTime.Milliseconds()),
+	}
+
+	if req.EpochsAhead == 0 {
+		res.Epoch = currentEpoch
+		if startTime, found := k.getEpochStartTime(ctx, currentEpoch); found {
+			res.EpochStartTime = startTime.Unix()
+		}
+		return &res, nil
+	}
+
+	// later epochs are estimated with the current epoch blocks
+	res.Epoch = nextEpoch + (req.EpochsAhead-1)*epochBlocks
+	if averageBlockTime > 0 {
+		res.EpochStartTime = ctx.BlockTime().Add(time.Duration(res.Epoch-block) * averageBlockTime).Unix()
+	}
+	return &res, nil
+}
@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/x/epochstorage/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) FixatedParamsAtBlock(c context.Context, req *types.QueryFixatedParamsAtBlockRequest) (*types.QueryFixatedParamsAtBlockResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if _, ok := k.fixationRegistries[req.FixationKey]; !ok {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("fixation key %s is not registered", req.FixationKey))
+	}
+
+	fixated, err := k.GetFixatedParamsForBlock(ctx, req.FixationKey, req.Block)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	value := k.fixatedParamValue(ctx, req.FixationKey, fixated.Parameter)
+	return &types.QueryFixatedParamsAtBlockResponse{Value: value, Parameter: fixated.Parameter, FixationBlock: fixated.FixationBlock}, nil
+}
@@ -12,6 +12,7 @@ import (
 
 	keepertest "github.com/lavanet/lava/testutil/keeper"
 	"github.com/lavanet/lava/testutil/nullify"
+	"github.com/lavanet/lava/utils"
 	"github.com/lavanet/lava/x/epochstorage/types"
 )
 
@@ -124,3 +125,35 @@ func TestFixatedParamsQueryPaginated(t *testing.T) {
 		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
 	})
 }
+
+func TestFixatedParamsAtBlockQuery(t *testing.T) {
+	keeper, ctx := keepertest.EpochstorageKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	fixationKey := string(types.KeyEpochBlocks)
+	// index 0 holds the latest fixation
+	keeper.SetFixatedParams(ctx, types.FixatedParams{Index: fixationKey + "0", Parameter: utils.Serialize(uint64(20)), FixationBlock: 10})
+	keeper.SetFixatedParams(ctx, types.FixatedParams{Index: fixationKey + "1", Parameter: utils.Serialize(uint64(30)), FixationBlock: 0})
+
+	for _, tc := range []struct {
+		desc          string
+		block         uint64
+		value         string
+		fixationBlock uint64
+	}{
+		{desc: "LatestFixation", block: 15, value: "20", fixationBlock: 10},
+		{desc: "FixationBlock", block: 10, value: "20", fixationBlock: 10},
+		{desc: "OlderFixation", block: 5, value: "30", fixationBlock: 0},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := keeper.FixatedParamsAtBlock(wctx, &types.QueryFixatedParamsAtBlockRequest{FixationKey: fixationKey, Block: tc.block})
+			require.NoError(t, err)
+			require.Equal(t, tc.value, response.Value)
+			require.Equal(t, tc.fixationBlock, response.FixationBlock)
+		})
+	}
+
+	_, err := keeper.FixatedParamsAtBlock(wctx, &types.QueryFixatedParamsAtBlockRequest{FixationKey: "unknown", Block: 15})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	_, err = keeper.FixatedParamsAtBlock(wctx, nil)
+	require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+}
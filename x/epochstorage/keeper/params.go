@@ -16,6 +16,8 @@ func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 		k.EpochsToSaveRaw(ctx),
 		k.LatestParamChange(ctx),
 		k.UnstakeHoldBlocksStaticRaw(ctx),
+		k.PruneBatchSize(ctx),
+		k.ExportPrunedData(ctx),
 	)
 }
 
@@ -50,6 +52,18 @@ func (k Keeper) UnstakeHoldBlocksStaticRaw(ctx sdk.Context) (res uint64) {
 	return
 }
 
+// PruneBatchSize returns the PruneBatchSize param
+func (k Keeper) PruneBatchSize(ctx sdk.Context) (res uint64) {
+	k.paramstore.GetIfExists(ctx, types.KeyPruneBatchSize, &res)
+	return
+}
+
+// ExportPrunedData returns the ExportPrunedData param
+func (k Keeper) ExportPrunedData(ctx sdk.Context) (res bool) {
+	k.paramstore.GetIfExists(ctx, types.KeyExportPrunedData, &res)
+	return
+}
+
 // UnstakeHoldBlocksRaw sets the UnstakeHoldBlocks param
 func (k Keeper) SetUnstakeHoldBlocksStaticRaw(ctx sdk.Context, unstakeHoldBlocksStatic uint64) {
 	k.paramstore.Set(ctx, types.KeyUnstakeHoldBlocksStatic, unstakeHoldBlocksStatic)
@@ -1,11 +1,13 @@
 package keeper
 
 import (
+	"encoding/binary"
 	"fmt"
 	"sort"
 	"strconv"
 	"strings"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/errors"
@@ -67,13 +69,62 @@ func (k Keeper) GetAllStakeStorage(ctx sdk.Context) (list []types.StakeStorage)
 	return
 }
 
-func (k Keeper) RemoveOldEpochData(ctx sdk.Context, storageType string) {
-	for _, block := range k.GetDeletedEpochs(ctx) {
-		allChainIDs := k.specKeeper.GetAllChainIDs(ctx)
-		for _, chainID := range allChainIDs {
-			k.RemoveStakeStorageByBlockAndChain(ctx, storageType, block, chainID)
+func (k Keeper) queueEpochsForPruning(ctx sdk.Context, epochs []uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PendingPruneEpochKeyPrefix))
+	for _, epoch := range epochs {
+		store.Set(sdk.Uint64ToBigEndian(epoch), []byte{})
+	}
+}
+
+// PruneEpochData removes the stake storages of epochs that left memory, at most PruneBatchSize of them in a block
+// so many chains don't spike a single begin block, the rest are pruned in the next blocks
+func (k Keeper) PruneEpochData(ctx sdk.Context) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PendingPruneEpochKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	pendingEpochs := []uint64{}
+	for ; iterator.Valid(); iterator.Next() {
+		pendingEpochs = append(pendingEpochs, binary.BigEndian.Uint64(iterator.Key()))
+	}
+	iterator.Close()
+	if len(pendingEpochs) == 0 {
+		return
+	}
+
+	batchSize := k.PruneBatchSize(ctx)
+	exportData := k.ExportPrunedData(ctx)
+	allChainIDs := k.specKeeper.GetAllChainIDs(ctx)
+	pruned := uint64(0)
+	for _, epoch := range pendingEpochs {
+		for _, storageType := range []string{types.ProviderKey, types.ClientKey} {
+			for _, chainID := range allChainIDs {
+				if batchSize > 0 && pruned >= batchSize {
+					return
+				}
+				key := k.StakeStorageKey(storageType, epoch, chainID)
+				stakeStorage, found := k.GetStakeStorage(ctx, key)
+				if !found {
+					continue
+				}
+				if exportData {
+					k.exportStakeStorage(ctx, epoch, storageType, chainID, stakeStorage)
+				}
+				k.RemoveStakeStorage(ctx, key)
+				pruned++
+			}
 		}
+		store.Delete(sdk.Uint64ToBigEndian(epoch))
+	}
+}
+
+// exportStakeStorage emits a stake storage about to be pruned for indexers that keep the history out of the state
+func (k Keeper) exportStakeStorage(ctx sdk.Context, epoch uint64, storageType string, chainID string, stakeStorage types.StakeStorage) {
+	stakeStorageJSON, err := codec.ProtoMarshalJSON(&stakeStorage, nil)
+	if err != nil {
+		utils.LavaFormatError("failed exporting pruned stake storage", err, utils.Attribute{Key: "index", Value: stakeStorage.Index})
+		return
 	}
+	details := map[string]string{"epoch": strconv.FormatUint(epoch, 10), "storageType": storageType, "chainID": chainID, "stakeStorage": string(stakeStorageJSON)}
+	utils.LogLavaEvent(ctx, k.Logger(ctx), types.StakeStoragePrunedEventName, details, "stake storage of an epoch that left memory pruned")
 }
 
 func (k *Keeper) UpdateEarliestEpochstart(ctx sdk.Context) {
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/testutil/common"
 	testkeeper "github.com/lavanet/lava/testutil/keeper"
 	"github.com/lavanet/lava/testutil/nullify"
 	"github.com/lavanet/lava/x/epochstorage/keeper"
@@ -115,3 +116,49 @@ func TestStakeStorageGetAll(t *testing.T) {
 		nullify.Fill(keeper.GetAllStakeStorage(ctx)),
 	)
 }
+
+func TestPruneEpochDataInBatches(t *testing.T) {
+	_, allkeepers, ctxx := testkeeper.InitAllKeepers(t)
+	keeper := allkeepers.Epochstorage
+	ctx := sdk.UnwrapSDKContext(ctxx)
+
+	chainIDs := []string{"ETH1", "COS3"}
+	for _, chainID := range chainIDs {
+		spec := common.CreateMockSpec()
+		spec.Index = chainID
+		allkeepers.Spec.SetSpec(ctx, spec)
+	}
+	params := keeper.GetParams(ctx)
+	params.PruneBatchSize = 3
+	keeper.SetParams(ctx, params)
+
+	// a stake storage of each type and chain in the first epoch, 4 in total
+	epoch := keeper.GetEpochStart(ctx)
+	keys := []string{}
+	for _, storageType := range []string{epochstoragetypes.ProviderKey, epochstoragetypes.ClientKey} {
+		for _, chainID := range chainIDs {
+			key := keeper.StakeStorageKey(storageType, epoch, chainID)
+			keeper.SetStakeStorage(ctx, epochstoragetypes.StakeStorage{Index: key})
+			keys = append(keys, key)
+		}
+	}
+	storedKeys := func(ctx sdk.Context) (stored int) {
+		for _, key := range keys {
+			if _, found := keeper.GetStakeStorage(ctx, key); found {
+				stored++
+			}
+		}
+		return stored
+	}
+
+	for keeper.GetEarliestEpochStart(sdk.UnwrapSDKContext(ctxx)) <= epoch {
+		require.Equal(t, len(keys), storedKeys(sdk.UnwrapSDKContext(ctxx)))
+		ctxx = testkeeper.AdvanceEpoch(ctxx, allkeepers)
+	}
+
+	// the epoch start pruned a batch, the next block prunes the rest
+	ctx = sdk.UnwrapSDKContext(ctxx)
+	require.Equal(t, len(keys)-3, storedKeys(ctx))
+	keeper.PruneEpochData(ctx)
+	require.Equal(t, 0, storedKeys(ctx))
+}
@@ -177,6 +177,9 @@ func (am AppModule) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {
 		details := map[string]string{"height": fmt.Sprintf("%d", ctx.BlockHeight()), "description": "New Block Epoch Started"}
 		logger := am.keeper.Logger(ctx)
 		utils.LogLavaEvent(ctx, logger, "new_epoch", details, "")
+	} else {
+		// continue pruning epochs the epoch start didn't finish
+		am.keeper.PruneEpochData(ctx)
 	}
 }
 
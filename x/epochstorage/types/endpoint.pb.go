@@ -23,9 +23,10 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type Endpoint struct {
-	IPPORT      string `protobuf:"bytes,1,opt,name=iPPORT,proto3" json:"iPPORT,omitempty"`
-	UseType     string `protobuf:"bytes,2,opt,name=useType,proto3" json:"useType,omitempty"`
-	Geolocation uint64 `protobuf:"varint,3,opt,name=geolocation,proto3" json:"geolocation,omitempty"`
+	IPPORT      string   `protobuf:"bytes,1,opt,name=iPPORT,proto3" json:"iPPORT,omitempty"`
+	UseType     string   `protobuf:"bytes,2,opt,name=useType,proto3" json:"useType,omitempty"`
+	Geolocation uint64   `protobuf:"varint,3,opt,name=geolocation,proto3" json:"geolocation,omitempty"`
+	AddOns      []string `protobuf:"bytes,4,rep,name=add_ons,json=addOns,proto3" json:"add_ons,omitempty"`
 }
 
 func (m *Endpoint) Reset()         { *m = Endpoint{} }
@@ -82,6 +83,13 @@ func (m *Endpoint) GetGeolocation() uint64 {
 	return 0
 }
 
+func (m *Endpoint) GetAddOns() []string {
+	if m != nil {
+		return m.AddOns
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Endpoint)(nil), "lavanet.lava.epochstorage.Endpoint")
 }
@@ -89,20 +97,21 @@ func init() {
 func init() { proto.RegisterFile("epochstorage/endpoint.proto", fileDescriptor_c5b1ebaa0f5cf898) }
 
 var fileDescriptor_c5b1ebaa0f5cf898 = []byte{
-	// 194 bytes of a gzipped FileDescriptorProto
+	// 214 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4e, 0x2d, 0xc8, 0x4f,
 	0xce, 0x28, 0x2e, 0xc9, 0x2f, 0x4a, 0x4c, 0x4f, 0xd5, 0x4f, 0xcd, 0x4b, 0x29, 0xc8, 0xcf, 0xcc,
 	0x2b, 0xd1, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0xcc, 0x49, 0x2c, 0x4b, 0xcc, 0x4b, 0x2d,
-	0xd1, 0x03, 0xd1, 0x7a, 0xc8, 0x2a, 0x95, 0xe2, 0xb8, 0x38, 0x5c, 0xa1, 0x8a, 0x85, 0xc4, 0xb8,
+	0xd1, 0x03, 0xd1, 0x7a, 0xc8, 0x2a, 0x95, 0xca, 0xb9, 0x38, 0x5c, 0xa1, 0x8a, 0x85, 0xc4, 0xb8,
 	0xd8, 0x32, 0x03, 0x02, 0xfc, 0x83, 0x42, 0x24, 0x18, 0x15, 0x18, 0x35, 0x38, 0x83, 0xa0, 0x3c,
 	0x21, 0x09, 0x2e, 0xf6, 0xd2, 0xe2, 0xd4, 0x90, 0xca, 0x82, 0x54, 0x09, 0x26, 0xb0, 0x04, 0x8c,
 	0x2b, 0xa4, 0xc0, 0xc5, 0x9d, 0x9e, 0x9a, 0x9f, 0x93, 0x9f, 0x9c, 0x58, 0x92, 0x99, 0x9f, 0x27,
-	0xc1, 0xac, 0xc0, 0xa8, 0xc1, 0x12, 0x84, 0x2c, 0xe4, 0xe4, 0x76, 0xe2, 0x91, 0x1c, 0xe3, 0x85,
-	0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3,
-	0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x3a, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9,
-	0xfa, 0x50, 0xf7, 0x81, 0x69, 0xfd, 0x0a, 0x7d, 0x14, 0xbf, 0x94, 0x54, 0x16, 0xa4, 0x16, 0x27,
-	0xb1, 0x81, 0x7d, 0x62, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0x4b, 0xfd, 0x5e, 0x7a, 0xe8, 0x00,
-	0x00, 0x00,
+	0xc1, 0xac, 0xc0, 0xa8, 0xc1, 0x12, 0x84, 0x2c, 0x24, 0x24, 0xce, 0xc5, 0x9e, 0x98, 0x92, 0x12,
+	0x9f, 0x9f, 0x57, 0x2c, 0xc1, 0xa2, 0xc0, 0x0c, 0x32, 0x34, 0x31, 0x25, 0xc5, 0x3f, 0xaf, 0xd8,
+	0xc9, 0xed, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0,
+	0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0x74, 0xd2, 0x33, 0x4b,
+	0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0xf5, 0xa1, 0x0e, 0x07, 0xd3, 0xfa, 0x15, 0xfa, 0x28,
+	0x9e, 0x2c, 0xa9, 0x2c, 0x48, 0x2d, 0x4e, 0x62, 0x03, 0x7b, 0xd1, 0x18, 0x30, 0x00, 0xa0, 0xb3,
+	0x48, 0x67, 0x01, 0x01, 0x00, 0x00,
 }
 
 func (m *Endpoint) Marshal() (dAtA []byte, err error) {
@@ -125,6 +134,15 @@ func (m *Endpoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AddOns) > 0 {
+		for iNdEx := len(m.AddOns) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AddOns[iNdEx])
+			copy(dAtA[i:], m.AddOns[iNdEx])
+			i = encodeVarintEndpoint(dAtA, i, uint64(len(m.AddOns[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if m.Geolocation != 0 {
 		i = encodeVarintEndpoint(dAtA, i, uint64(m.Geolocation))
 		i--
@@ -175,6 +193,12 @@ func (m *Endpoint) Size() (n int) {
 	if m.Geolocation != 0 {
 		n += 1 + sovEndpoint(uint64(m.Geolocation))
 	}
+	if len(m.AddOns) > 0 {
+		for _, s := range m.AddOns {
+			l = len(s)
+			n += 1 + l + sovEndpoint(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -296,6 +320,38 @@ func (m *Endpoint) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddOns", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndpoint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEndpoint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndpoint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddOns = append(m.AddOns, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEndpoint(dAtA[iNdEx:])
@@ -27,4 +27,8 @@ func KeyPrefix(p string) []byte {
 
 const (
 	EpochDetailsKey = "EpochDetails-value-"
+
+	PendingPruneEpochKeyPrefix = "PendingPruneEpoch/"
+
+	EpochStartTimeKeyPrefix = "EpochStartTime/"
 )
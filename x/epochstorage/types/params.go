@@ -34,6 +34,16 @@ var (
 	DefaultUnstakeHoldBlocksStatic uint64 = 400
 )
 
+var (
+	KeyPruneBatchSize            = []byte("PruneBatchSize")
+	DefaultPruneBatchSize uint64 = 0
+)
+
+var (
+	KeyExportPrunedData          = []byte("ExportPrunedData")
+	DefaultExportPrunedData bool = false
+)
+
 // ParamKeyTable the param key table for launch module
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
@@ -46,6 +56,8 @@ func NewParams(
 	epochsToSave uint64,
 	latestParamChange uint64,
 	unstakeHoldBlocksStatic uint64,
+	pruneBatchSize uint64,
+	exportPrunedData bool,
 ) Params {
 	return Params{
 		UnstakeHoldBlocks:       unstakeHoldBlocks,
@@ -53,6 +65,8 @@ func NewParams(
 		EpochsToSave:            epochsToSave,
 		LatestParamChange:       latestParamChange,
 		UnstakeHoldBlocksStatic: unstakeHoldBlocksStatic,
+		PruneBatchSize:          pruneBatchSize,
+		ExportPrunedData:        exportPrunedData,
 	}
 }
 
@@ -64,6 +78,8 @@ func DefaultParams() Params {
 		DefaultEpochsToSave,
 		DefaultLatestParamChange,
 		DefaultUnstakeHoldBlocksStatic,
+		DefaultPruneBatchSize,
+		DefaultExportPrunedData,
 	)
 }
 
@@ -75,6 +91,8 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyEpochsToSave, &p.EpochsToSave, validateEpochsToSave),
 		paramtypes.NewParamSetPair(KeyLatestParamChange, &p.LatestParamChange, validateLatestParamChange),
 		paramtypes.NewParamSetPair(KeyUnstakeHoldBlocksStatic, &p.UnstakeHoldBlocksStatic, validateUnstakeHoldBlocksStatic),
+		paramtypes.NewParamSetPair(KeyPruneBatchSize, &p.PruneBatchSize, validatePruneBatchSize),
+		paramtypes.NewParamSetPair(KeyExportPrunedData, &p.ExportPrunedData, validateExportPrunedData),
 	}
 }
 
@@ -96,6 +114,14 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validatePruneBatchSize(p.PruneBatchSize); err != nil {
+		return err
+	}
+
+	if err := validateExportPrunedData(p.ExportPrunedData); err != nil {
+		return err
+	}
+
 	if err := validateBlocksParams(p.UnstakeHoldBlocks, p.UnstakeHoldBlocksStatic, p.EpochBlocks*p.EpochsToSave); err != nil {
 		return err
 	}
@@ -173,6 +199,26 @@ func validateUnstakeHoldBlocksStatic(v interface{}) error {
 	return nil
 }
 
+// validatePruneBatchSize validates the PruneBatchSize param
+func validatePruneBatchSize(v interface{}) error {
+	_, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+// validateExportPrunedData validates the ExportPrunedData param
+func validateExportPrunedData(v interface{}) error {
+	_, ok := v.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
 // validateUnstakeHoldBlocks validates the UnstakeHoldBlocks param
 func validateBlocksParams(unstakeHoldBlocks uint64, unstakeHoldBlocksStatic uint64, blocksToSave uint64) error {
 	if !(unstakeHoldBlocksStatic > unstakeHoldBlocks && unstakeHoldBlocks > blocksToSave) {
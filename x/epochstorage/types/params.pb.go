@@ -30,6 +30,8 @@ type Params struct {
 	EpochsToSave            uint64 `protobuf:"varint,3,opt,name=epochsToSave,proto3" json:"epochsToSave,omitempty" yaml:"epochs_to_save"`
 	LatestParamChange       uint64 `protobuf:"varint,4,opt,name=latestParamChange,proto3" json:"latestParamChange,omitempty" yaml:"latest_param_change"`
 	UnstakeHoldBlocksStatic uint64 `protobuf:"varint,5,opt,name=unstakeHoldBlocksStatic,proto3" json:"unstakeHoldBlocksStatic,omitempty" yaml:"unstake_hold_blocks_static"`
+	PruneBatchSize          uint64 `protobuf:"varint,6,opt,name=pruneBatchSize,proto3" json:"pruneBatchSize,omitempty" yaml:"prune_batch_size"`
+	ExportPrunedData        bool   `protobuf:"varint,7,opt,name=exportPrunedData,proto3" json:"exportPrunedData,omitempty" yaml:"export_pruned_data"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -99,6 +101,20 @@ func (m *Params) GetUnstakeHoldBlocksStatic() uint64 {
 	return 0
 }
 
+func (m *Params) GetPruneBatchSize() uint64 {
+	if m != nil {
+		return m.PruneBatchSize
+	}
+	return 0
+}
+
+func (m *Params) GetExportPrunedData() bool {
+	if m != nil {
+		return m.ExportPrunedData
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "lavanet.lava.epochstorage.Params")
 }
@@ -106,28 +122,33 @@ func init() {
 func init() { proto.RegisterFile("epochstorage/params.proto", fileDescriptor_20f16b5e9ab72ca9) }
 
 var fileDescriptor_20f16b5e9ab72ca9 = []byte{
-	// 335 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0x31, 0x4f, 0xc2, 0x40,
-	0x14, 0xc7, 0x5b, 0x41, 0x86, 0xea, 0x62, 0xd5, 0x50, 0x18, 0xae, 0xda, 0xc4, 0xc4, 0xc1, 0xb4,
-	0x83, 0x93, 0x24, 0x2e, 0x98, 0x18, 0x07, 0x07, 0x53, 0x9c, 0x5c, 0x2e, 0x8f, 0x72, 0x69, 0x09,
-	0x07, 0xaf, 0xe1, 0x0e, 0x22, 0x1f, 0xc0, 0xdd, 0xd1, 0xd1, 0x8f, 0xe3, 0xc8, 0xe8, 0xd4, 0x18,
-	0xf8, 0x06, 0xfd, 0x04, 0x86, 0x57, 0x62, 0xc0, 0x86, 0xe9, 0x9a, 0xf4, 0xf7, 0xff, 0xb5, 0xff,
-	0xf7, 0xce, 0x6a, 0x88, 0x14, 0xa3, 0x44, 0x69, 0x1c, 0x43, 0x2c, 0x82, 0x14, 0xc6, 0x30, 0x54,
-	0x7e, 0x3a, 0x46, 0x8d, 0x76, 0x43, 0xc2, 0x14, 0x46, 0x42, 0xfb, 0xab, 0xd3, 0xdf, 0xe4, 0x9a,
-	0x27, 0x31, 0xc6, 0x48, 0x54, 0xb0, 0x7a, 0x2a, 0x02, 0xde, 0x5b, 0xc5, 0xaa, 0x3d, 0x91, 0xc1,
-	0x7e, 0xb4, 0x8e, 0x26, 0x23, 0xa5, 0x61, 0x20, 0x1e, 0x50, 0xf6, 0xda, 0x12, 0xa3, 0x81, 0x72,
-	0xcc, 0x33, 0xf3, 0xb2, 0xda, 0x66, 0x79, 0xe6, 0x36, 0x67, 0x30, 0x94, 0x2d, 0x6f, 0x8d, 0xf0,
-	0x04, 0x65, 0x8f, 0x77, 0x09, 0xf2, 0xc2, 0x72, 0xd0, 0xbe, 0xb1, 0x0e, 0xe8, 0xf3, 0x6b, 0xcf,
-	0x1e, 0x79, 0xea, 0x79, 0xe6, 0x1e, 0x17, 0x1e, 0x7a, 0xf9, 0x27, 0xd8, 0x64, 0xed, 0x5b, 0xeb,
-	0xb0, 0xf8, 0xf3, 0x67, 0xec, 0xc0, 0x54, 0x38, 0x15, 0xca, 0x36, 0xf2, 0xcc, 0x3d, 0xdd, 0xc8,
-	0x2a, 0xae, 0x91, 0x2b, 0x98, 0x0a, 0x2f, 0xdc, 0xc2, 0x57, 0x3d, 0x24, 0x68, 0xa1, 0x34, 0xf5,
-	0xba, 0x4b, 0x60, 0x14, 0x0b, 0xa7, 0xfa, 0xbf, 0x47, 0x81, 0x70, 0x9a, 0x1e, 0x8f, 0x08, 0xf2,
-	0xc2, 0x72, 0xd0, 0xe6, 0x56, 0xbd, 0x54, 0xae, 0xa3, 0x41, 0xf7, 0x23, 0x67, 0x9f, 0x9c, 0x17,
-	0x79, 0xe6, 0x9e, 0xef, 0x9c, 0x0d, 0x57, 0xc4, 0x7a, 0xe1, 0x2e, 0x4b, 0xab, 0xfa, 0xf1, 0xe9,
-	0x1a, 0xed, 0xfb, 0xaf, 0x05, 0x33, 0xe7, 0x0b, 0x66, 0xfe, 0x2c, 0x98, 0xf9, 0xbe, 0x64, 0xc6,
-	0x7c, 0xc9, 0x8c, 0xef, 0x25, 0x33, 0x5e, 0xae, 0xe2, 0xbe, 0x4e, 0x26, 0x5d, 0x3f, 0xc2, 0x61,
-	0xb0, 0xde, 0x2e, 0x9d, 0xc1, 0x6b, 0xb0, 0x75, 0x0f, 0xf4, 0x2c, 0x15, 0xaa, 0x5b, 0xa3, 0xb5,
-	0x5e, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0x3c, 0x91, 0x70, 0x7a, 0x24, 0x02, 0x00, 0x00,
+	// 410 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xc1, 0x8e, 0x93, 0x40,
+	0x18, 0xc7, 0x41, 0xb1, 0x9a, 0xd1, 0x18, 0x45, 0x4d, 0x61, 0x8d, 0xb0, 0x4e, 0x62, 0xb2, 0x07,
+	0x03, 0x07, 0x4f, 0x6e, 0xe2, 0x85, 0x35, 0x46, 0x13, 0x0f, 0x1b, 0xea, 0xc9, 0xcb, 0xe4, 0x03,
+	0x26, 0x40, 0x96, 0x32, 0x84, 0x99, 0x36, 0xbb, 0x7d, 0x0a, 0x2f, 0x26, 0x1e, 0x7d, 0x1c, 0x8f,
+	0x3d, 0x7a, 0x22, 0xa6, 0x7d, 0x03, 0x9e, 0xc0, 0xf0, 0x41, 0x36, 0x6d, 0x49, 0x4f, 0x43, 0x98,
+	0xdf, 0xff, 0x07, 0xff, 0x2f, 0x1f, 0xb1, 0x79, 0x25, 0xe2, 0x4c, 0x2a, 0x51, 0x43, 0xca, 0xfd,
+	0x0a, 0x6a, 0x98, 0x4b, 0xaf, 0xaa, 0x85, 0x12, 0xa6, 0x5d, 0xc0, 0x12, 0x4a, 0xae, 0xbc, 0xee,
+	0xf4, 0x76, 0xb9, 0x93, 0xe7, 0xa9, 0x48, 0x05, 0x52, 0x7e, 0xf7, 0xd4, 0x07, 0xe8, 0x4f, 0x83,
+	0x4c, 0x2e, 0xd1, 0x60, 0x7e, 0x25, 0x4f, 0x17, 0xa5, 0x54, 0x70, 0xc5, 0x3f, 0x8b, 0x22, 0x09,
+	0x0a, 0x11, 0x5f, 0x49, 0x4b, 0x3f, 0xd5, 0xcf, 0x8c, 0xc0, 0x69, 0x1b, 0xf7, 0xe4, 0x06, 0xe6,
+	0xc5, 0x39, 0x1d, 0x10, 0x96, 0x89, 0x22, 0x61, 0x11, 0x42, 0x34, 0x1c, 0x07, 0xcd, 0xf7, 0xe4,
+	0x21, 0x7e, 0x7e, 0xf0, 0xdc, 0x41, 0xcf, 0xb4, 0x6d, 0xdc, 0x67, 0xbd, 0x07, 0x2f, 0x6f, 0x05,
+	0xbb, 0xac, 0xf9, 0x81, 0x3c, 0xea, 0xff, 0xfc, 0x9b, 0x98, 0xc1, 0x92, 0x5b, 0x77, 0x31, 0x6b,
+	0xb7, 0x8d, 0xfb, 0x62, 0x27, 0x2b, 0x99, 0x12, 0x4c, 0xc2, 0x92, 0xd3, 0x70, 0x0f, 0xef, 0x7a,
+	0x14, 0xa0, 0xb8, 0x54, 0xd8, 0xeb, 0x22, 0x83, 0x32, 0xe5, 0x96, 0x71, 0xd8, 0xa3, 0x47, 0x18,
+	0x4e, 0x8f, 0xc5, 0x08, 0xd1, 0x70, 0x1c, 0x34, 0x19, 0x99, 0x8e, 0xca, 0xcd, 0x14, 0xa8, 0x3c,
+	0xb6, 0xee, 0xa1, 0xf3, 0x4d, 0xdb, 0xb8, 0xaf, 0x8f, 0xce, 0x86, 0x49, 0x64, 0x69, 0x78, 0xcc,
+	0x62, 0x5e, 0x90, 0xc7, 0x55, 0xbd, 0x28, 0x79, 0x00, 0x2a, 0xce, 0x66, 0xf9, 0x8a, 0x5b, 0x13,
+	0xf4, 0xbe, 0x6c, 0x1b, 0x77, 0xda, 0x7b, 0xf1, 0x9e, 0x45, 0x1d, 0xc0, 0x64, 0xbe, 0xe2, 0x34,
+	0x3c, 0x88, 0x98, 0x5f, 0xc8, 0x13, 0x7e, 0x5d, 0x89, 0x5a, 0x5d, 0x76, 0xef, 0x93, 0x8f, 0xa0,
+	0xc0, 0xba, 0x7f, 0xaa, 0x9f, 0x3d, 0x08, 0x5e, 0xb5, 0x8d, 0x6b, 0x0f, 0x63, 0x43, 0x82, 0x61,
+	0x34, 0x61, 0x09, 0x28, 0xa0, 0xe1, 0x28, 0x76, 0x6e, 0xfc, 0xfa, 0xed, 0x6a, 0xc1, 0xa7, 0x3f,
+	0x1b, 0x47, 0x5f, 0x6f, 0x1c, 0xfd, 0xdf, 0xc6, 0xd1, 0x7f, 0x6c, 0x1d, 0x6d, 0xbd, 0x75, 0xb4,
+	0xbf, 0x5b, 0x47, 0xfb, 0xfe, 0x36, 0xcd, 0x55, 0xb6, 0x88, 0xbc, 0x58, 0xcc, 0xfd, 0x61, 0xdb,
+	0xf0, 0xf4, 0xaf, 0xfd, 0xbd, 0xbd, 0x54, 0x37, 0x15, 0x97, 0xd1, 0x04, 0xd7, 0xec, 0xdd, 0xff,
+	0x01, 0x00, 0x5e, 0xd7, 0x90, 0x01, 0xb4, 0x02, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -150,6 +171,21 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ExportPrunedData {
+		i--
+		if m.ExportPrunedData {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.PruneBatchSize != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.PruneBatchSize))
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.UnstakeHoldBlocksStatic != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.UnstakeHoldBlocksStatic))
 		i--
@@ -210,6 +246,12 @@ func (m *Params) Size() (n int) {
 	if m.UnstakeHoldBlocksStatic != 0 {
 		n += 1 + sovParams(uint64(m.UnstakeHoldBlocksStatic))
 	}
+	if m.PruneBatchSize != 0 {
+		n += 1 + sovParams(uint64(m.PruneBatchSize))
+	}
+	if m.ExportPrunedData {
+		n += 2
+	}
 	return n
 }
 
@@ -343,6 +385,45 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PruneBatchSize", wireType)
+			}
+			m.PruneBatchSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PruneBatchSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExportPrunedData", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ExportPrunedData = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -562,6 +562,246 @@ func (m *QueryAllFixatedParamsResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+type QueryFixatedParamsAtBlockRequest struct {
+	FixationKey string `protobuf:"bytes,1,opt,name=fixationKey,proto3" json:"fixationKey,omitempty"`
+	Block       uint64 `protobuf:"varint,2,opt,name=block,proto3" json:"block,omitempty"`
+}
+
+func (m *QueryFixatedParamsAtBlockRequest) Reset()         { *m = QueryFixatedParamsAtBlockRequest{} }
+func (m *QueryFixatedParamsAtBlockRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFixatedParamsAtBlockRequest) ProtoMessage()    {}
+func (*QueryFixatedParamsAtBlockRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a3d6156902cd2447, []int{12}
+}
+func (m *QueryFixatedParamsAtBlockRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFixatedParamsAtBlockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFixatedParamsAtBlockRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFixatedParamsAtBlockRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFixatedParamsAtBlockRequest.Merge(m, src)
+}
+func (m *QueryFixatedParamsAtBlockRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFixatedParamsAtBlockRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFixatedParamsAtBlockRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFixatedParamsAtBlockRequest proto.InternalMessageInfo
+
+func (m *QueryFixatedParamsAtBlockRequest) GetFixationKey() string {
+	if m != nil {
+		return m.FixationKey
+	}
+	return ""
+}
+
+func (m *QueryFixatedParamsAtBlockRequest) GetBlock() uint64 {
+	if m != nil {
+		return m.Block
+	}
+	return 0
+}
+
+type QueryFixatedParamsAtBlockResponse struct {
+	Value         string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Parameter     []byte `protobuf:"bytes,2,opt,name=parameter,proto3" json:"parameter,omitempty"`
+	FixationBlock uint64 `protobuf:"varint,3,opt,name=fixationBlock,proto3" json:"fixationBlock,omitempty"`
+}
+
+func (m *QueryFixatedParamsAtBlockResponse) Reset()         { *m = QueryFixatedParamsAtBlockResponse{} }
+func (m *QueryFixatedParamsAtBlockResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFixatedParamsAtBlockResponse) ProtoMessage()    {}
+func (*QueryFixatedParamsAtBlockResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a3d6156902cd2447, []int{13}
+}
+func (m *QueryFixatedParamsAtBlockResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFixatedParamsAtBlockResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFixatedParamsAtBlockResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFixatedParamsAtBlockResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFixatedParamsAtBlockResponse.Merge(m, src)
+}
+func (m *QueryFixatedParamsAtBlockResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFixatedParamsAtBlockResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFixatedParamsAtBlockResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFixatedParamsAtBlockResponse proto.InternalMessageInfo
+
+func (m *QueryFixatedParamsAtBlockResponse) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *QueryFixatedParamsAtBlockResponse) GetParameter() []byte {
+	if m != nil {
+		return m.Parameter
+	}
+	return nil
+}
+
+func (m *QueryFixatedParamsAtBlockResponse) GetFixationBlock() uint64 {
+	if m != nil {
+		return m.FixationBlock
+	}
+	return 0
+}
+
+type QueryEstimatedEpochTimeRequest struct {
+	EpochsAhead uint64 `protobuf:"varint,1,opt,name=epochsAhead,proto3" json:"epochsAhead,omitempty"`
+}
+
+func (m *QueryEstimatedEpochTimeRequest) Reset()         { *m = QueryEstimatedEpochTimeRequest{} }
+func (m *QueryEstimatedEpochTimeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEstimatedEpochTimeRequest) ProtoMessage()    {}
+func (*QueryEstimatedEpochTimeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a3d6156902cd2447, []int{14}
+}
+func (m *QueryEstimatedEpochTimeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEstimatedEpochTimeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEstimatedEpochTimeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEstimatedEpochTimeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEstimatedEpochTimeRequest.Merge(m, src)
+}
+func (m *QueryEstimatedEpochTimeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEstimatedEpochTimeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEstimatedEpochTimeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEstimatedEpochTimeRequest proto.InternalMessageInfo
+
+func (m *QueryEstimatedEpochTimeRequest) GetEpochsAhead() uint64 {
+	if m != nil {
+		return m.EpochsAhead
+	}
+	return 0
+}
+
+type QueryEstimatedEpochTimeResponse struct {
+	CurrentEpoch       uint64 `protobuf:"varint,1,opt,name=currentEpoch,proto3" json:"currentEpoch,omitempty"`
+	BlocksLeftInEpoch  uint64 `protobuf:"varint,2,opt,name=blocksLeftInEpoch,proto3" json:"blocksLeftInEpoch,omitempty"`
+	SecondsLeftInEpoch uint64 `protobuf:"varint,3,opt,name=secondsLeftInEpoch,proto3" json:"secondsLeftInEpoch,omitempty"`
+	AverageBlockTimeMs uint64 `protobuf:"varint,4,opt,name=averageBlockTimeMs,proto3" json:"averageBlockTimeMs,omitempty"`
+	Epoch              uint64 `protobuf:"varint,5,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	EpochStartTime     int64  `protobuf:"varint,6,opt,name=epochStartTime,proto3" json:"epochStartTime,omitempty"`
+}
+
+func (m *QueryEstimatedEpochTimeResponse) Reset()         { *m = QueryEstimatedEpochTimeResponse{} }
+func (m *QueryEstimatedEpochTimeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEstimatedEpochTimeResponse) ProtoMessage()    {}
+func (*QueryEstimatedEpochTimeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a3d6156902cd2447, []int{15}
+}
+func (m *QueryEstimatedEpochTimeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEstimatedEpochTimeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEstimatedEpochTimeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEstimatedEpochTimeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEstimatedEpochTimeResponse.Merge(m, src)
+}
+func (m *QueryEstimatedEpochTimeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEstimatedEpochTimeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEstimatedEpochTimeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEstimatedEpochTimeResponse proto.InternalMessageInfo
+
+func (m *QueryEstimatedEpochTimeResponse) GetCurrentEpoch() uint64 {
+	if m != nil {
+		return m.CurrentEpoch
+	}
+	return 0
+}
+
+func (m *QueryEstimatedEpochTimeResponse) GetBlocksLeftInEpoch() uint64 {
+	if m != nil {
+		return m.BlocksLeftInEpoch
+	}
+	return 0
+}
+
+func (m *QueryEstimatedEpochTimeResponse) GetSecondsLeftInEpoch() uint64 {
+	if m != nil {
+		return m.SecondsLeftInEpoch
+	}
+	return 0
+}
+
+func (m *QueryEstimatedEpochTimeResponse) GetAverageBlockTimeMs() uint64 {
+	if m != nil {
+		return m.AverageBlockTimeMs
+	}
+	return 0
+}
+
+func (m *QueryEstimatedEpochTimeResponse) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *QueryEstimatedEpochTimeResponse) GetEpochStartTime() int64 {
+	if m != nil {
+		return m.EpochStartTime
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "lavanet.lava.epochstorage.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "lavanet.lava.epochstorage.QueryParamsResponse")
@@ -575,56 +815,79 @@ func init() {
 	proto.RegisterType((*QueryGetFixatedParamsResponse)(nil), "lavanet.lava.epochstorage.QueryGetFixatedParamsResponse")
 	proto.RegisterType((*QueryAllFixatedParamsRequest)(nil), "lavanet.lava.epochstorage.QueryAllFixatedParamsRequest")
 	proto.RegisterType((*QueryAllFixatedParamsResponse)(nil), "lavanet.lava.epochstorage.QueryAllFixatedParamsResponse")
+	proto.RegisterType((*QueryFixatedParamsAtBlockRequest)(nil), "lavanet.lava.epochstorage.QueryFixatedParamsAtBlockRequest")
+	proto.RegisterType((*QueryFixatedParamsAtBlockResponse)(nil), "lavanet.lava.epochstorage.QueryFixatedParamsAtBlockResponse")
+	proto.RegisterType((*QueryEstimatedEpochTimeRequest)(nil), "lavanet.lava.epochstorage.QueryEstimatedEpochTimeRequest")
+	proto.RegisterType((*QueryEstimatedEpochTimeResponse)(nil), "lavanet.lava.epochstorage.QueryEstimatedEpochTimeResponse")
 }
 
 func init() { proto.RegisterFile("epochstorage/query.proto", fileDescriptor_a3d6156902cd2447) }
 
 var fileDescriptor_a3d6156902cd2447 = []byte{
-	// 702 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0x41, 0x4f, 0xd4, 0x40,
-	0x14, 0xc7, 0x77, 0x40, 0x88, 0x8e, 0x10, 0xcd, 0xc8, 0x01, 0x56, 0xa8, 0x50, 0x13, 0x05, 0xd4,
-	0x96, 0x05, 0xa3, 0x78, 0x32, 0x10, 0x85, 0x2b, 0x2c, 0xc6, 0x83, 0x17, 0x32, 0xbb, 0x3b, 0x5b,
-	0x1a, 0xbb, 0x9d, 0xee, 0x76, 0x96, 0x2c, 0x31, 0x5e, 0xfc, 0x04, 0x46, 0x3f, 0x89, 0x89, 0x07,
-	0x35, 0x91, 0x33, 0x47, 0x12, 0x2f, 0x9e, 0x8c, 0xd9, 0xf5, 0x83, 0x98, 0xce, 0x4c, 0xed, 0x4c,
-	0xb6, 0xdd, 0x2d, 0xc2, 0xa9, 0xed, 0xf4, 0xbd, 0xff, 0xfb, 0xbd, 0x37, 0x6f, 0x5e, 0x0b, 0xa7,
-	0x49, 0x40, 0xab, 0x07, 0x21, 0xa3, 0x2d, 0xec, 0x10, 0xbb, 0xd9, 0x26, 0xad, 0x23, 0x2b, 0x68,
-	0x51, 0x46, 0xd1, 0x8c, 0x87, 0x0f, 0xb1, 0x4f, 0x98, 0x15, 0x5d, 0x2d, 0xd5, 0xac, 0x38, 0xeb,
-	0x50, 0xea, 0x78, 0xc4, 0xc6, 0x81, 0x6b, 0x63, 0xdf, 0xa7, 0x0c, 0x33, 0x97, 0xfa, 0xa1, 0x70,
-	0x2c, 0x2e, 0x57, 0x69, 0xd8, 0xa0, 0xa1, 0x5d, 0xc1, 0xa1, 0x54, 0xb4, 0x0f, 0x4b, 0x15, 0xc2,
-	0x70, 0xc9, 0x0e, 0xb0, 0xe3, 0xfa, 0xdc, 0x58, 0xda, 0xce, 0x68, 0xe1, 0x03, 0xdc, 0xc2, 0x8d,
-	0x58, 0x66, 0x5e, 0x7b, 0x15, 0x32, 0xfc, 0x9a, 0xec, 0xcb, 0xa7, 0x54, 0x0b, 0xfe, 0xb0, 0x5f,
-	0x23, 0x0c, 0xbb, 0x5e, 0xac, 0xb1, 0xa0, 0x59, 0xd4, 0xdd, 0x0e, 0x66, 0xa4, 0xb6, 0xaf, 0x85,
-	0x31, 0x54, 0xda, 0x98, 0xb3, 0x4a, 0xdd, 0x98, 0x70, 0xca, 0xa1, 0x0e, 0xe5, 0xb7, 0x76, 0x74,
-	0x27, 0x56, 0xcd, 0x29, 0x88, 0x76, 0xa3, 0xcc, 0x76, 0xb8, 0x54, 0x99, 0x34, 0xdb, 0x24, 0x64,
-	0xe6, 0x4b, 0x78, 0x43, 0x5b, 0x0d, 0x03, 0xea, 0x87, 0x04, 0x3d, 0x85, 0xe3, 0x22, 0xe4, 0x34,
-	0x98, 0x07, 0x8b, 0x57, 0x57, 0x17, 0xac, 0xcc, 0xd2, 0x5a, 0xc2, 0x75, 0xf3, 0xd2, 0xc9, 0xaf,
-	0x5b, 0x85, 0xb2, 0x74, 0x33, 0xd7, 0xe0, 0x4d, 0xae, 0xbb, 0x4d, 0xd8, 0x5e, 0x54, 0x87, 0x3d,
-	0x61, 0x2c, 0xc3, 0xa2, 0x29, 0x38, 0xe6, 0xfa, 0x35, 0xd2, 0xe1, 0xf2, 0x57, 0xca, 0xe2, 0xc1,
-	0x6c, 0xc2, 0xd9, 0x74, 0x27, 0x49, 0xb5, 0x0b, 0x27, 0x42, 0x65, 0x5d, 0xb2, 0xdd, 0x1d, 0xc0,
-	0xa6, 0xca, 0x48, 0x42, 0x4d, 0xc2, 0x24, 0x92, 0x73, 0xc3, 0xf3, 0xd2, 0x38, 0xb7, 0x20, 0x4c,
-	0x1a, 0x40, 0xc6, 0xbb, 0x63, 0x89, 0xfa, 0x5b, 0x51, 0xfd, 0x2d, 0xd1, 0x7f, 0x72, 0x17, 0xac,
-	0x9d, 0xc4, 0xb7, 0xac, 0x78, 0x9a, 0xdf, 0x80, 0x4c, 0xad, 0x2f, 0x4e, 0x66, 0x6a, 0xa3, 0xe7,
-	0x4c, 0x0d, 0x6d, 0x6b, 0xec, 0x23, 0xb2, 0x56, 0xc3, 0xd8, 0x05, 0x8f, 0x06, 0x3f, 0x97, 0xec,
-	0xe5, 0xf3, 0x88, 0xe0, 0x99, 0x68, 0xd8, 0xb8, 0x85, 0x94, 0x5d, 0xd3, 0x5f, 0x27, 0xa9, 0xa9,
-	0xeb, 0x39, 0x76, 0x4d, 0x35, 0x8f, 0x53, 0x53, 0xd7, 0xcc, 0x87, 0x49, 0xc8, 0x2d, 0x71, 0x42,
-	0xb4, 0xae, 0xce, 0x68, 0xaf, 0x36, 0x9c, 0xcb, 0xf0, 0x92, 0xa4, 0x2f, 0xe0, 0x64, 0x5d, 0x7d,
-	0x21, 0x51, 0x17, 0x07, 0xa0, 0x6a, 0x42, 0x92, 0x55, 0x17, 0x31, 0xeb, 0xc9, 0xd6, 0xa7, 0xc2,
-	0x5e, 0x54, 0x8f, 0x1d, 0x03, 0x99, 0x5f, 0x7f, 0xa0, 0xec, 0xfc, 0x46, 0xcf, 0x9d, 0xdf, 0x85,
-	0xf5, 0xd9, 0xea, 0xf1, 0x65, 0x38, 0xc6, 0x13, 0x40, 0x1f, 0x00, 0x1c, 0x97, 0xea, 0x0f, 0x06,
-	0xc0, 0xf5, 0xcf, 0xb3, 0xa2, 0x95, 0xd7, 0x5c, 0xc4, 0x37, 0x97, 0xde, 0xfd, 0xf8, 0xf3, 0x71,
-	0xe4, 0x36, 0x5a, 0xb0, 0xa5, 0x1f, 0xbf, 0xda, 0x29, 0x33, 0x1e, 0x7d, 0x05, 0x70, 0x42, 0x3d,
-	0x74, 0xe8, 0xd1, 0xb0, 0x58, 0xe9, 0xc3, 0xaf, 0xf8, 0xf8, 0xcc, 0x7e, 0x12, 0x76, 0x9d, 0xc3,
-	0xae, 0xa2, 0x95, 0x01, 0xb0, 0xda, 0x57, 0xc7, 0x7e, 0xc3, 0x3b, 0xff, 0x2d, 0xfa, 0x0c, 0xe0,
-	0x35, 0x55, 0x72, 0xc3, 0xf3, 0x86, 0xe3, 0xa7, 0xcf, 0xc4, 0xe1, 0xf8, 0x19, 0x33, 0xce, 0x5c,
-	0xe1, 0xf8, 0xcb, 0x68, 0x31, 0x2f, 0x3e, 0xfa, 0x04, 0xf4, 0xd9, 0x91, 0xab, 0xe4, 0x29, 0x33,
-	0x2a, 0x57, 0xc9, 0xd3, 0x86, 0x57, 0x2e, 0x66, 0xed, 0x33, 0x8e, 0xbe, 0x03, 0x38, 0xa9, 0x9d,
-	0x1a, 0x94, 0x27, 0x78, 0xda, 0x64, 0x28, 0xae, 0x9f, 0xdd, 0x51, 0x62, 0x3f, 0xe1, 0xd8, 0x6b,
-	0xa8, 0x34, 0x00, 0x5b, 0xff, 0xb7, 0xf8, 0xd7, 0x2a, 0x5f, 0x00, 0xbc, 0xae, 0x89, 0x46, 0xbd,
-	0x92, 0x67, 0xcf, 0xff, 0x2f, 0x85, 0xac, 0x61, 0x65, 0x96, 0x78, 0x0a, 0xf7, 0xd0, 0x52, 0xee,
-	0x14, 0x36, 0xb7, 0x4e, 0xba, 0x06, 0x38, 0xed, 0x1a, 0xe0, 0x77, 0xd7, 0x00, 0xef, 0x7b, 0x46,
-	0xe1, 0xb4, 0x67, 0x14, 0x7e, 0xf6, 0x8c, 0xc2, 0xab, 0xfb, 0x8e, 0xcb, 0x0e, 0xda, 0x15, 0xab,
-	0x4a, 0x1b, 0xba, 0x5c, 0x47, 0x17, 0x64, 0x47, 0x01, 0x09, 0x2b, 0xe3, 0xfc, 0x8f, 0x69, 0xed,
-	0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xeb, 0x35, 0x4a, 0xdf, 0x6a, 0x0a, 0x00, 0x00,
+	// 1005 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x97, 0x4b, 0x6f, 0xdb, 0x46,
+	0x10, 0x80, 0xbd, 0x7e, 0x01, 0x19, 0x3b, 0x7d, 0x6c, 0x75, 0x50, 0x58, 0x5b, 0x91, 0xd9, 0x22,
+	0x75, 0xd2, 0x94, 0x8c, 0xed, 0xa2, 0x4e, 0xfa, 0x40, 0x60, 0xa3, 0x76, 0xfa, 0x04, 0x1c, 0x39,
+	0xe8, 0x21, 0x17, 0x61, 0x25, 0xad, 0x65, 0x22, 0x14, 0x57, 0x16, 0x57, 0x86, 0x0d, 0x43, 0x97,
+	0xfe, 0x82, 0x3e, 0x7e, 0x49, 0x81, 0x1e, 0xda, 0x02, 0xed, 0x39, 0xc7, 0xa0, 0xbd, 0xf4, 0x54,
+	0x14, 0x76, 0xff, 0x44, 0x6f, 0x05, 0x67, 0x97, 0xd5, 0x6e, 0x4c, 0xea, 0xe1, 0xf8, 0x24, 0xee,
+	0xec, 0x3c, 0xbe, 0x99, 0x1d, 0xee, 0x50, 0x50, 0xe4, 0x6d, 0x51, 0xdf, 0x8f, 0xa5, 0xe8, 0xb0,
+	0x26, 0xf7, 0x0f, 0xba, 0xbc, 0x73, 0xec, 0xb5, 0x3b, 0x42, 0x0a, 0x7a, 0x2d, 0x64, 0x87, 0x2c,
+	0xe2, 0xd2, 0x4b, 0x7e, 0x3d, 0x53, 0xcd, 0x59, 0x68, 0x0a, 0xd1, 0x0c, 0xb9, 0xcf, 0xda, 0x81,
+	0xcf, 0xa2, 0x48, 0x48, 0x26, 0x03, 0x11, 0xc5, 0xca, 0xd0, 0xb9, 0x55, 0x17, 0x71, 0x4b, 0xc4,
+	0x7e, 0x8d, 0xc5, 0xda, 0xa3, 0x7f, 0xb8, 0x52, 0xe3, 0x92, 0xad, 0xf8, 0x6d, 0xd6, 0x0c, 0x22,
+	0x54, 0xd6, 0xba, 0xd7, 0xac, 0xf0, 0x6d, 0xd6, 0x61, 0xad, 0xd4, 0x4d, 0xd9, 0xda, 0x8a, 0x25,
+	0x7b, 0xc2, 0xab, 0x7a, 0x95, 0xa9, 0x81, 0x8b, 0x6a, 0x83, 0x4b, 0x16, 0x84, 0xa9, 0x8f, 0x25,
+	0x4b, 0x63, 0x2f, 0x38, 0x62, 0x92, 0x37, 0xaa, 0x56, 0x98, 0x92, 0x49, 0x9b, 0x72, 0xd6, 0x45,
+	0x90, 0x12, 0x16, 0x9a, 0xa2, 0x29, 0xf0, 0xd1, 0x4f, 0x9e, 0x94, 0xd4, 0x2d, 0x00, 0x7d, 0x98,
+	0x64, 0xb6, 0x83, 0xae, 0x2a, 0xfc, 0xa0, 0xcb, 0x63, 0xe9, 0x7e, 0x05, 0xaf, 0x59, 0xd2, 0xb8,
+	0x2d, 0xa2, 0x98, 0xd3, 0xfb, 0x30, 0xab, 0x42, 0x16, 0x49, 0x99, 0x2c, 0xcf, 0xad, 0x2e, 0x79,
+	0xb9, 0xa5, 0xf5, 0x94, 0xe9, 0xe6, 0xf4, 0xd3, 0xbf, 0xae, 0x4f, 0x54, 0xb4, 0x99, 0xbb, 0x06,
+	0xaf, 0xa3, 0xdf, 0x07, 0x5c, 0xee, 0x26, 0x75, 0xd8, 0x55, 0xca, 0x3a, 0x2c, 0x2d, 0xc0, 0x4c,
+	0x10, 0x35, 0xf8, 0x11, 0xba, 0xbf, 0x52, 0x51, 0x0b, 0xf7, 0x00, 0x16, 0xb2, 0x8d, 0x34, 0xd5,
+	0x43, 0x98, 0x8f, 0x0d, 0xb9, 0x66, 0x7b, 0x6b, 0x00, 0x9b, 0xe9, 0x46, 0x13, 0x5a, 0x2e, 0x5c,
+	0xae, 0x39, 0x37, 0xc2, 0x30, 0x8b, 0x73, 0x1b, 0xa0, 0xdf, 0x00, 0x3a, 0xde, 0x0d, 0x4f, 0xd5,
+	0xdf, 0x4b, 0xea, 0xef, 0xa9, 0xfe, 0xd3, 0xa7, 0xe0, 0xed, 0xf4, 0x6d, 0x2b, 0x86, 0xa5, 0xfb,
+	0x0b, 0x81, 0x85, 0xec, 0x38, 0xb9, 0xa9, 0x4d, 0xbd, 0x60, 0x6a, 0xf4, 0x81, 0xc5, 0x3e, 0xa9,
+	0x6b, 0x35, 0x8c, 0x5d, 0xf1, 0x58, 0xf0, 0x8b, 0xfd, 0xb3, 0xdc, 0x4a, 0x08, 0x3e, 0x56, 0x0d,
+	0x9b, 0xb6, 0x90, 0x71, 0x6a, 0xf6, 0x76, 0x3f, 0x35, 0x53, 0x3e, 0xc2, 0xa9, 0x99, 0xea, 0x69,
+	0x6a, 0xa6, 0xcc, 0x7d, 0xb7, 0x1f, 0x72, 0x5b, 0xbd, 0x21, 0x56, 0x57, 0xe7, 0xb4, 0x57, 0x17,
+	0x16, 0x73, 0xac, 0x34, 0xe9, 0x23, 0xb8, 0xba, 0x67, 0x6e, 0x68, 0xd4, 0xe5, 0x01, 0xa8, 0x96,
+	0x23, 0xcd, 0x6a, 0x3b, 0x71, 0xf7, 0xfa, 0x47, 0x9f, 0x09, 0x7b, 0x59, 0x3d, 0xf6, 0x1b, 0x81,
+	0xc5, 0x9c, 0x40, 0xf9, 0xf9, 0x4d, 0xbd, 0x70, 0x7e, 0x97, 0xd7, 0x67, 0x8f, 0xa1, 0x8c, 0xfc,
+	0x56, 0xcc, 0x0d, 0xb9, 0x19, 0x8a, 0xfa, 0x93, 0xb4, 0x58, 0x65, 0x98, 0xc3, 0xe8, 0x81, 0x88,
+	0x3e, 0xe7, 0xc7, 0xfa, 0x7c, 0x4d, 0x51, 0x72, 0xf6, 0xb5, 0xc4, 0x02, 0x49, 0xa6, 0x2b, 0x6a,
+	0xe1, 0xf6, 0x60, 0x69, 0x80, 0x6f, 0x5d, 0x9f, 0x02, 0xcc, 0x1c, 0xb2, 0xb0, 0xcb, 0xd3, 0xb6,
+	0xc1, 0x05, 0x5d, 0x80, 0x2b, 0x78, 0xa9, 0x71, 0xc9, 0x3b, 0xe8, 0x74, 0xbe, 0xd2, 0x17, 0xd0,
+	0x37, 0x75, 0x4d, 0x03, 0x11, 0xa1, 0xb3, 0xe2, 0x14, 0x86, 0xb5, 0x85, 0xee, 0x26, 0x94, 0x30,
+	0xfc, 0x56, 0x2c, 0x83, 0x56, 0x02, 0x80, 0xed, 0xfc, 0x28, 0x68, 0x71, 0x23, 0x31, 0x55, 0xf8,
+	0x8d, 0x7d, 0xce, 0x1a, 0x48, 0x30, 0x5d, 0x31, 0x45, 0xee, 0xb7, 0x93, 0x70, 0x3d, 0xd7, 0x89,
+	0xce, 0xc0, 0x85, 0xf9, 0x7a, 0xb7, 0xd3, 0xe1, 0x91, 0x7a, 0x15, 0xb5, 0x1b, 0x4b, 0x46, 0x6f,
+	0xc3, 0xab, 0x58, 0x93, 0xf8, 0x0b, 0xbe, 0x27, 0x3f, 0x8d, 0x94, 0xa2, 0x2a, 0xd6, 0xf9, 0x0d,
+	0xea, 0x01, 0x8d, 0x79, 0x5d, 0x44, 0x0d, 0x4b, 0x5d, 0x25, 0x99, 0xb1, 0x93, 0xe8, 0xb3, 0x43,
+	0x8e, 0x97, 0x52, 0xe2, 0x2b, 0xa1, 0xfb, 0x32, 0x2e, 0x4e, 0x2b, 0xfd, 0xf3, 0x3b, 0x49, 0xcd,
+	0x31, 0xc9, 0xe2, 0x8c, 0x3a, 0x2e, 0x5c, 0xd0, 0x1b, 0xf0, 0x12, 0x3e, 0xec, 0x4a, 0xd6, 0x91,
+	0x89, 0x66, 0x71, 0xb6, 0x4c, 0x96, 0xa7, 0x2a, 0xcf, 0x49, 0x57, 0xff, 0x9d, 0x83, 0x19, 0xac,
+	0x09, 0xfd, 0x8e, 0xc0, 0xac, 0x6e, 0xc8, 0x77, 0x06, 0xf4, 0xf3, 0xf9, 0x11, 0xe8, 0x78, 0xa3,
+	0xaa, 0xab, 0x1a, 0xbb, 0x37, 0xbf, 0xfe, 0xe3, 0x9f, 0xef, 0x27, 0xdf, 0xa0, 0x4b, 0xbe, 0xb6,
+	0xc3, 0x5f, 0x3f, 0xe3, 0xb3, 0x80, 0xfe, 0x4c, 0x60, 0xde, 0xbc, 0xa7, 0xe9, 0x7b, 0xc3, 0x62,
+	0x65, 0xcf, 0x4b, 0x67, 0x7d, 0x6c, 0x3b, 0x0d, 0x7b, 0x17, 0x61, 0x57, 0xe9, 0x9d, 0x01, 0xb0,
+	0xd6, 0x87, 0x8a, 0x7f, 0x82, 0x97, 0x65, 0x8f, 0xfe, 0x48, 0xe0, 0x65, 0xd3, 0xe5, 0x46, 0x18,
+	0x0e, 0xc7, 0xcf, 0x1e, 0xa3, 0xce, 0xfa, 0xd8, 0x76, 0x1a, 0xff, 0x0e, 0xe2, 0xdf, 0xa2, 0xcb,
+	0xa3, 0xe2, 0xd3, 0x1f, 0x88, 0x3d, 0x6e, 0x46, 0x2a, 0x79, 0xc6, 0x58, 0x73, 0xd6, 0xc7, 0xb6,
+	0x1b, 0x83, 0xd9, 0xfa, 0xf2, 0xa3, 0xbf, 0x12, 0xb8, 0x6a, 0x5d, 0x4c, 0x74, 0x94, 0xe0, 0x59,
+	0xc3, 0xc4, 0xb9, 0x3b, 0xbe, 0xa1, 0xc6, 0xbe, 0x87, 0xd8, 0x6b, 0x74, 0x65, 0x00, 0xb6, 0xfd,
+	0x39, 0xfa, 0x7f, 0xab, 0xfc, 0x44, 0xe0, 0x15, 0xfb, 0x62, 0x0d, 0x43, 0x3a, 0xca, 0x99, 0x5f,
+	0x2c, 0x85, 0xbc, 0xf9, 0xe6, 0xae, 0x60, 0x0a, 0x6f, 0xd3, 0x9b, 0x23, 0xa7, 0x40, 0xcf, 0x08,
+	0x14, 0xb2, 0x66, 0x02, 0xfd, 0x60, 0x18, 0xc5, 0x80, 0x29, 0xe5, 0x7c, 0x78, 0x31, 0x63, 0x9d,
+	0xc6, 0x0e, 0xa6, 0xf1, 0x19, 0xfd, 0x64, 0xe4, 0x34, 0xaa, 0x4c, 0x56, 0xf1, 0xea, 0xf6, 0x4f,
+	0x8c, 0x51, 0xd8, 0xf3, 0x4f, 0x50, 0xd8, 0xa3, 0xbf, 0x13, 0xa0, 0xe7, 0xa7, 0x06, 0xbd, 0x37,
+	0x0c, 0x33, 0x77, 0x5c, 0x39, 0xef, 0x5f, 0xc4, 0x54, 0xe7, 0xb7, 0x85, 0xf9, 0xdd, 0xa7, 0x1f,
+	0x0d, 0x7a, 0x41, 0x52, 0xf3, 0xaa, 0x7a, 0x55, 0x64, 0xd0, 0xe2, 0xfe, 0x89, 0x31, 0x0e, 0x7b,
+	0x9b, 0xdb, 0x4f, 0x4f, 0x4b, 0xe4, 0xd9, 0x69, 0x89, 0xfc, 0x7d, 0x5a, 0x22, 0xdf, 0x9c, 0x95,
+	0x26, 0x9e, 0x9d, 0x95, 0x26, 0xfe, 0x3c, 0x2b, 0x4d, 0x3c, 0xbe, 0xdd, 0x0c, 0xe4, 0x7e, 0xb7,
+	0xe6, 0xd5, 0x45, 0xcb, 0x0e, 0x71, 0x64, 0x07, 0x91, 0xc7, 0x6d, 0x1e, 0xd7, 0x66, 0xf1, 0xff,
+	0xd1, 0xda, 0x7f, 0x03, 0x00, 0x8c, 0xd5, 0xe2, 0x01, 0x58, 0x0e, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -651,6 +914,10 @@ type QueryClient interface {
 	FixatedParams(ctx context.Context, in *QueryGetFixatedParamsRequest, opts ...grpc.CallOption) (*QueryGetFixatedParamsResponse, error)
 	// Queries a list of FixatedParams items.
 	FixatedParamsAll(ctx context.Context, in *QueryAllFixatedParamsRequest, opts ...grpc.CallOption) (*QueryAllFixatedParamsResponse, error)
+	// Queries the value a fixated param had at a block.
+	FixatedParamsAtBlock(ctx context.Context, in *QueryFixatedParamsAtBlockRequest, opts ...grpc.CallOption) (*QueryFixatedParamsAtBlockResponse, error)
+	// Queries the estimated start time of an epoch and the blocks and time left in the current epoch.
+	EstimatedEpochTime(ctx context.Context, in *QueryEstimatedEpochTimeRequest, opts ...grpc.CallOption) (*QueryEstimatedEpochTimeResponse, error)
 }
 
 type queryClient struct {
@@ -715,6 +982,24 @@ func (c *queryClient) FixatedParamsAll(ctx context.Context, in *QueryAllFixatedP
 	return out, nil
 }
 
+func (c *queryClient) FixatedParamsAtBlock(ctx context.Context, in *QueryFixatedParamsAtBlockRequest, opts ...grpc.CallOption) (*QueryFixatedParamsAtBlockResponse, error) {
+	out := new(QueryFixatedParamsAtBlockResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.epochstorage.Query/FixatedParamsAtBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EstimatedEpochTime(ctx context.Context, in *QueryEstimatedEpochTimeRequest, opts ...grpc.CallOption) (*QueryEstimatedEpochTimeResponse, error) {
+	out := new(QueryEstimatedEpochTimeResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.epochstorage.Query/EstimatedEpochTime", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
@@ -729,6 +1014,10 @@ type QueryServer interface {
 	FixatedParams(context.Context, *QueryGetFixatedParamsRequest) (*QueryGetFixatedParamsResponse, error)
 	// Queries a list of FixatedParams items.
 	FixatedParamsAll(context.Context, *QueryAllFixatedParamsRequest) (*QueryAllFixatedParamsResponse, error)
+	// Queries the value a fixated param had at a block.
+	FixatedParamsAtBlock(context.Context, *QueryFixatedParamsAtBlockRequest) (*QueryFixatedParamsAtBlockResponse, error)
+	// Queries the estimated start time of an epoch and the blocks and time left in the current epoch.
+	EstimatedEpochTime(context.Context, *QueryEstimatedEpochTimeRequest) (*QueryEstimatedEpochTimeResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -753,6 +1042,12 @@ func (*UnimplementedQueryServer) FixatedParams(ctx context.Context, req *QueryGe
 func (*UnimplementedQueryServer) FixatedParamsAll(ctx context.Context, req *QueryAllFixatedParamsRequest) (*QueryAllFixatedParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FixatedParamsAll not implemented")
 }
+func (*UnimplementedQueryServer) FixatedParamsAtBlock(ctx context.Context, req *QueryFixatedParamsAtBlockRequest) (*QueryFixatedParamsAtBlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FixatedParamsAtBlock not implemented")
+}
+func (*UnimplementedQueryServer) EstimatedEpochTime(ctx context.Context, req *QueryEstimatedEpochTimeRequest) (*QueryEstimatedEpochTimeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimatedEpochTime not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -866,6 +1161,42 @@ func _Query_FixatedParamsAll_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_FixatedParamsAtBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFixatedParamsAtBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FixatedParamsAtBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.epochstorage.Query/FixatedParamsAtBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FixatedParamsAtBlock(ctx, req.(*QueryFixatedParamsAtBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EstimatedEpochTime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEstimatedEpochTimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EstimatedEpochTime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.epochstorage.Query/EstimatedEpochTime",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EstimatedEpochTime(ctx, req.(*QueryEstimatedEpochTimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "lavanet.lava.epochstorage.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -894,6 +1225,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "FixatedParamsAll",
 			Handler:    _Query_FixatedParamsAll_Handler,
 		},
+		{
+			MethodName: "FixatedParamsAtBlock",
+			Handler:    _Query_FixatedParamsAtBlock_Handler,
+		},
+		{
+			MethodName: "EstimatedEpochTime",
+			Handler:    _Query_EstimatedEpochTime_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "epochstorage/query.proto",
@@ -1305,70 +1644,228 @@ func (m *QueryAllFixatedParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryFixatedParamsAtBlockRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryFixatedParamsAtBlockRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetStakeStorageRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryFixatedParamsAtBlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Index)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Block != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Block))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if len(m.FixationKey) > 0 {
+		i -= len(m.FixationKey)
+		copy(dAtA[i:], m.FixationKey)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FixationKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetStakeStorageResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryFixatedParamsAtBlockResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.StakeStorage.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryAllStakeStorageRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryFixatedParamsAtBlockResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFixatedParamsAtBlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.FixationBlock != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FixationBlock))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Parameter) > 0 {
+		i -= len(m.Parameter)
+		copy(dAtA[i:], m.Parameter)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Parameter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEstimatedEpochTimeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEstimatedEpochTimeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEstimatedEpochTimeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EpochsAhead != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochsAhead))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEstimatedEpochTimeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEstimatedEpochTimeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEstimatedEpochTimeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EpochStartTime != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochStartTime))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.AverageBlockTimeMs != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AverageBlockTimeMs))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.SecondsLeftInEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SecondsLeftInEpoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.BlocksLeftInEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlocksLeftInEpoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.CurrentEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CurrentEpoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetStakeStorageRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetStakeStorageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.StakeStorage.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllStakeStorageRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
@@ -1468,6 +1965,81 @@ func (m *QueryAllFixatedParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryFixatedParamsAtBlockRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FixationKey)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Block != 0 {
+		n += 1 + sovQuery(uint64(m.Block))
+	}
+	return n
+}
+
+func (m *QueryFixatedParamsAtBlockResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Parameter)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.FixationBlock != 0 {
+		n += 1 + sovQuery(uint64(m.FixationBlock))
+	}
+	return n
+}
+
+func (m *QueryEstimatedEpochTimeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochsAhead != 0 {
+		n += 1 + sovQuery(uint64(m.EpochsAhead))
+	}
+	return n
+}
+
+func (m *QueryEstimatedEpochTimeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CurrentEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.CurrentEpoch))
+	}
+	if m.BlocksLeftInEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.BlocksLeftInEpoch))
+	}
+	if m.SecondsLeftInEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.SecondsLeftInEpoch))
+	}
+	if m.AverageBlockTimeMs != 0 {
+		n += 1 + sovQuery(uint64(m.AverageBlockTimeMs))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovQuery(uint64(m.Epoch))
+	}
+	if m.EpochStartTime != 0 {
+		n += 1 + sovQuery(uint64(m.EpochStartTime))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2482,6 +3054,475 @@ func (m *QueryAllFixatedParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryFixatedParamsAtBlockRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFixatedParamsAtBlockRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFixatedParamsAtBlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FixationKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FixationKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+			}
+			m.Block = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Block |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFixatedParamsAtBlockResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFixatedParamsAtBlockResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFixatedParamsAtBlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameter", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Parameter = append(m.Parameter[:0], dAtA[iNdEx:postIndex]...)
+			if m.Parameter == nil {
+				m.Parameter = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FixationBlock", wireType)
+			}
+			m.FixationBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FixationBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEstimatedEpochTimeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEstimatedEpochTimeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEstimatedEpochTimeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochsAhead", wireType)
+			}
+			m.EpochsAhead = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochsAhead |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEstimatedEpochTimeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEstimatedEpochTimeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEstimatedEpochTimeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentEpoch", wireType)
+			}
+			m.CurrentEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlocksLeftInEpoch", wireType)
+			}
+			m.BlocksLeftInEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlocksLeftInEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondsLeftInEpoch", wireType)
+			}
+			m.SecondsLeftInEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SecondsLeftInEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AverageBlockTimeMs", wireType)
+			}
+			m.AverageBlockTimeMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AverageBlockTimeMs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochStartTime", wireType)
+			}
+			m.EpochStartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochStartTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
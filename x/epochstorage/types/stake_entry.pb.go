@@ -33,6 +33,8 @@ type StakeEntry struct {
 	Chain             string     `protobuf:"bytes,6,opt,name=chain,proto3" json:"chain,omitempty"`
 	Vrfpk             string     `protobuf:"bytes,7,opt,name=vrfpk,proto3" json:"vrfpk,omitempty"`
 	Moniker           string     `protobuf:"bytes,8,opt,name=moniker,proto3" json:"moniker,omitempty"`
+	DelegateTotal     types.Coin `protobuf:"bytes,9,opt,name=delegate_total,json=delegateTotal,proto3" json:"delegate_total"`
+	CuCapacity        uint64     `protobuf:"varint,10,opt,name=cu_capacity,json=cuCapacity,proto3" json:"cu_capacity,omitempty"`
 }
 
 func (m *StakeEntry) Reset()         { *m = StakeEntry{} }
@@ -124,6 +126,20 @@ func (m *StakeEntry) GetMoniker() string {
 	return ""
 }
 
+func (m *StakeEntry) GetDelegateTotal() types.Coin {
+	if m != nil {
+		return m.DelegateTotal
+	}
+	return types.Coin{}
+}
+
+func (m *StakeEntry) GetCuCapacity() uint64 {
+	if m != nil {
+		return m.CuCapacity
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*StakeEntry)(nil), "lavanet.lava.epochstorage.StakeEntry")
 }
@@ -131,30 +147,33 @@ func init() {
 func init() { proto.RegisterFile("epochstorage/stake_entry.proto", fileDescriptor_1250f7eaa46b63b0) }
 
 var fileDescriptor_1250f7eaa46b63b0 = []byte{
-	// 359 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x51, 0xbd, 0xae, 0xda, 0x30,
-	0x18, 0x4d, 0xf8, 0x2d, 0x66, 0x6a, 0xca, 0x60, 0xa8, 0xe4, 0x46, 0xed, 0x92, 0xa1, 0xb2, 0x05,
-	0x55, 0x1f, 0xa0, 0x54, 0xb4, 0x3b, 0xdd, 0xba, 0x20, 0x27, 0x71, 0x83, 0x95, 0xe0, 0x2f, 0x8a,
-	0x5d, 0x54, 0xde, 0xa2, 0x4f, 0xd4, 0x99, 0x91, 0xb1, 0xd3, 0xd5, 0x15, 0xbc, 0xc8, 0x95, 0xed,
-	0xa0, 0x0b, 0xc3, 0x9d, 0xfc, 0x9d, 0x73, 0xbe, 0xa3, 0x9c, 0x93, 0x0f, 0x11, 0x51, 0x43, 0xb6,
-	0xd5, 0x06, 0x1a, 0x5e, 0x08, 0xa6, 0x0d, 0x2f, 0xc5, 0x46, 0x28, 0xd3, 0x1c, 0x68, 0xdd, 0x80,
-	0x81, 0x68, 0x5a, 0xf1, 0x3d, 0x57, 0xc2, 0x50, 0xfb, 0xd2, 0xdb, 0xe5, 0xd9, 0xdb, 0x3b, 0xab,
-	0x50, 0x79, 0x0d, 0x52, 0x19, 0xef, 0x9b, 0x4d, 0x0a, 0x28, 0xc0, 0x8d, 0xcc, 0x4e, 0x2d, 0x4b,
-	0x32, 0xd0, 0x3b, 0xd0, 0x2c, 0xe5, 0x5a, 0xb0, 0xfd, 0x3c, 0x15, 0x86, 0xcf, 0x59, 0x06, 0x52,
-	0x79, 0xfd, 0xfd, 0xbf, 0x0e, 0x42, 0x3f, 0x6c, 0x86, 0x95, 0x8d, 0x10, 0x7d, 0x46, 0x7d, 0x97,
-	0x08, 0x87, 0x71, 0x98, 0x8c, 0x17, 0x53, 0xea, 0xed, 0xd4, 0xda, 0x69, 0x6b, 0xa7, 0x5f, 0x41,
-	0xaa, 0x65, 0xef, 0xf8, 0xf0, 0x2e, 0x58, 0xfb, 0xed, 0x08, 0xa3, 0x21, 0xcf, 0xf3, 0x46, 0x68,
-	0x8d, 0x3b, 0x71, 0x98, 0x8c, 0xd6, 0x57, 0x18, 0x51, 0xf4, 0xc6, 0x57, 0xe4, 0x75, 0x5d, 0x49,
-	0x91, 0x6f, 0xd2, 0x0a, 0xb2, 0x12, 0x77, 0xe3, 0x30, 0xe9, 0xad, 0x5f, 0x3b, 0xe9, 0x8b, 0x57,
-	0x96, 0x56, 0x88, 0xbe, 0xa3, 0xd1, 0xb5, 0x97, 0xc6, 0xbd, 0xb8, 0x9b, 0x8c, 0x17, 0x1f, 0xe8,
-	0x8b, 0x7f, 0x84, 0xae, 0xda, 0xdd, 0x36, 0xce, 0xb3, 0x37, 0x8a, 0xd1, 0xb8, 0x10, 0x50, 0x41,
-	0xc6, 0x8d, 0x04, 0x85, 0xfb, 0xee, 0x83, 0xb7, 0x54, 0x34, 0x41, 0xfd, 0x6c, 0xcb, 0xa5, 0xc2,
-	0x03, 0x17, 0xd9, 0x03, 0xcb, 0xee, 0x9b, 0x5f, 0x75, 0x89, 0x87, 0x9e, 0x75, 0xc0, 0x16, 0xdc,
-	0x81, 0x92, 0xa5, 0x68, 0xf0, 0x2b, 0x5f, 0xb0, 0x85, 0xcb, 0x6f, 0xc7, 0x33, 0x09, 0x4f, 0x67,
-	0x12, 0x3e, 0x9e, 0x49, 0xf8, 0xf7, 0x42, 0x82, 0xd3, 0x85, 0x04, 0xff, 0x2f, 0x24, 0xf8, 0xf9,
-	0xb1, 0x90, 0x66, 0xfb, 0x3b, 0xa5, 0x19, 0xec, 0x58, 0xdb, 0xc0, 0xbd, 0xec, 0x0f, 0xbb, 0xbb,
-	0xa3, 0x39, 0xd4, 0x42, 0xa7, 0x03, 0x77, 0x8f, 0x4f, 0x4f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x21,
-	0xf8, 0x8d, 0x2f, 0x1f, 0x02, 0x00, 0x00,
+	// 404 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xc1, 0x6e, 0x13, 0x31,
+	0x10, 0x86, 0xb3, 0x24, 0x69, 0x89, 0x23, 0x90, 0x30, 0x3d, 0xb8, 0x45, 0xda, 0xae, 0xe0, 0x92,
+	0x03, 0xb2, 0xd5, 0x22, 0x1e, 0x80, 0x54, 0x2d, 0xf7, 0xc0, 0x89, 0x4b, 0xe4, 0xf5, 0x0e, 0x1b,
+	0x2b, 0x1b, 0x8f, 0xb5, 0x76, 0x22, 0xf2, 0x16, 0xbc, 0x05, 0xaf, 0xd2, 0x63, 0x8f, 0x9c, 0x10,
+	0x4a, 0x5e, 0x04, 0xd9, 0xde, 0x88, 0xe6, 0x80, 0xc4, 0xc9, 0x9e, 0x7f, 0xe6, 0xd3, 0xfe, 0xff,
+	0xac, 0x49, 0x0e, 0x16, 0xd5, 0xc2, 0x79, 0x6c, 0x65, 0x0d, 0xc2, 0x79, 0xb9, 0x84, 0x39, 0x18,
+	0xdf, 0x6e, 0xb9, 0x6d, 0xd1, 0x23, 0x3d, 0x6f, 0xe4, 0x46, 0x1a, 0xf0, 0x3c, 0x9c, 0xfc, 0xf1,
+	0xf0, 0xc5, 0xab, 0x23, 0x14, 0x4c, 0x65, 0x51, 0x1b, 0x9f, 0xb8, 0x8b, 0xb3, 0x1a, 0x6b, 0x8c,
+	0x57, 0x11, 0x6e, 0x9d, 0x9a, 0x2b, 0x74, 0x2b, 0x74, 0xa2, 0x94, 0x0e, 0xc4, 0xe6, 0xaa, 0x04,
+	0x2f, 0xaf, 0x84, 0x42, 0x6d, 0x52, 0xff, 0xf5, 0x8f, 0x3e, 0x21, 0x9f, 0x82, 0x87, 0xdb, 0x60,
+	0x81, 0xbe, 0x27, 0xc3, 0xe8, 0x88, 0x65, 0x45, 0x36, 0x19, 0x5f, 0x9f, 0xf3, 0x84, 0xf3, 0x80,
+	0xf3, 0x0e, 0xe7, 0x37, 0xa8, 0xcd, 0x74, 0x70, 0xff, 0xeb, 0xb2, 0x37, 0x4b, 0xd3, 0x94, 0x91,
+	0x53, 0x59, 0x55, 0x2d, 0x38, 0xc7, 0x9e, 0x14, 0xd9, 0x64, 0x34, 0x3b, 0x94, 0x94, 0x93, 0x97,
+	0x29, 0xa2, 0xb4, 0xb6, 0xd1, 0x50, 0xcd, 0xcb, 0x06, 0xd5, 0x92, 0xf5, 0x8b, 0x6c, 0x32, 0x98,
+	0xbd, 0x88, 0xad, 0x0f, 0xa9, 0x33, 0x0d, 0x0d, 0xfa, 0x91, 0x8c, 0x0e, 0xb9, 0x1c, 0x1b, 0x14,
+	0xfd, 0xc9, 0xf8, 0xfa, 0x0d, 0xff, 0xe7, 0x46, 0xf8, 0x6d, 0x37, 0xdb, 0xd9, 0xf9, 0xcb, 0xd2,
+	0x82, 0x8c, 0x6b, 0xc0, 0x06, 0x95, 0xf4, 0x1a, 0x0d, 0x1b, 0xc6, 0x0f, 0x3e, 0x96, 0xe8, 0x19,
+	0x19, 0xaa, 0x85, 0xd4, 0x86, 0x9d, 0x44, 0xcb, 0xa9, 0x08, 0xea, 0xa6, 0xfd, 0x6a, 0x97, 0xec,
+	0x34, 0xa9, 0xb1, 0x08, 0x01, 0x57, 0x68, 0xf4, 0x12, 0x5a, 0xf6, 0x34, 0x05, 0xec, 0x4a, 0x7a,
+	0x47, 0x9e, 0x57, 0xd0, 0x40, 0x2d, 0x3d, 0xcc, 0x3d, 0x7a, 0xd9, 0xb0, 0xd1, 0xff, 0xad, 0xee,
+	0xd9, 0x01, 0xfb, 0x1c, 0x28, 0x7a, 0x49, 0xc6, 0x6a, 0x3d, 0x57, 0xd2, 0x4a, 0xa5, 0xfd, 0x96,
+	0x91, 0xe8, 0x97, 0xa8, 0xf5, 0x4d, 0xa7, 0x4c, 0xef, 0xee, 0x77, 0x79, 0xf6, 0xb0, 0xcb, 0xb3,
+	0xdf, 0xbb, 0x3c, 0xfb, 0xbe, 0xcf, 0x7b, 0x0f, 0xfb, 0xbc, 0xf7, 0x73, 0x9f, 0xf7, 0xbe, 0xbc,
+	0xad, 0xb5, 0x5f, 0xac, 0x4b, 0xae, 0x70, 0x25, 0xba, 0x55, 0xc5, 0x53, 0x7c, 0x13, 0x47, 0x0f,
+	0xc6, 0x6f, 0x2d, 0xb8, 0xf2, 0x24, 0xfe, 0xf8, 0x77, 0x7f, 0x06, 0x00, 0xaa, 0x04, 0x7e, 0xb7,
+	0x88, 0x02, 0x00, 0x00,
 }
 
 func (m *StakeEntry) Marshal() (dAtA []byte, err error) {
@@ -177,6 +196,21 @@ func (m *StakeEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.CuCapacity != 0 {
+		i = encodeVarintStakeEntry(dAtA, i, uint64(m.CuCapacity))
+		i--
+		dAtA[i] = 0x50
+	}
+	{
+		size, err := m.DelegateTotal.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStakeEntry(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
 	if len(m.Moniker) > 0 {
 		i -= len(m.Moniker)
 		copy(dAtA[i:], m.Moniker)
@@ -289,6 +323,11 @@ func (m *StakeEntry) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovStakeEntry(uint64(l))
 	}
+	l = m.DelegateTotal.Size()
+	n += 1 + l + sovStakeEntry(uint64(l))
+	if m.CuCapacity != 0 {
+		n += 1 + sovStakeEntry(uint64(m.CuCapacity))
+	}
 	return n
 }
 
@@ -560,6 +599,58 @@ func (m *StakeEntry) Unmarshal(dAtA []byte) error {
 			}
 			m.Moniker = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegateTotal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakeEntry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStakeEntry
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStakeEntry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DelegateTotal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CuCapacity", wireType)
+			}
+			m.CuCapacity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakeEntry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CuCapacity |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStakeEntry(dAtA[iNdEx:])
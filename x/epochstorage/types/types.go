@@ -1,5 +1,9 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 const TokenDenom = "ulava"
 
 const (
@@ -7,6 +11,8 @@ const (
 	EarliestEpochEventName       = "earliest_epoch"
 	FixatedParamChangeEventName  = "fixated_params_change"
 	FixatedParamCleanedEventName = "fixated_params_clean"
+	StakeStoragePrunedEventName  = "stake_storage_pruned"
+	FixatedParamPrunedEventName  = "fixated_params_pruned"
 )
 
 // returns a deep copy of the stake storage with the same index
@@ -23,8 +29,18 @@ func (stksto StakeStorage) Copy() (returnedStorage StakeStorage) {
 			Geolocation:       stakeEntry.Geolocation,
 			Chain:             stakeEntry.Chain,
 			Vrfpk:             stakeEntry.Vrfpk,
+			DelegateTotal:     stakeEntry.DelegateTotal,
+			CuCapacity:        stakeEntry.CuCapacity,
 		}
 		returnedStorage.StakeEntries = append(returnedStorage.StakeEntries, newStakeEntry)
 	}
 	return
 }
+
+// EffectiveStake returns the stake of the entry together with the stake delegated to it, entries stored before delegation have no delegate total
+func (stakeEntry StakeEntry) EffectiveStake() sdk.Int {
+	if stakeEntry.DelegateTotal.Amount.IsNil() {
+		return stakeEntry.Stake.Amount
+	}
+	return stakeEntry.Stake.Amount.Add(stakeEntry.DelegateTotal.Amount)
+}
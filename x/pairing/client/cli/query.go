@@ -42,6 +42,15 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(CmdUserMaxCu())
 
 	cmd.AddCommand(CmdStaticProvidersList())
+	cmd.AddCommand(CmdPairingScores())
+	cmd.AddCommand(CmdUnresponsiveReports())
+	cmd.AddCommand(CmdSimulatePairing())
+	cmd.AddCommand(CmdListProviderMetadata())
+	cmd.AddCommand(CmdShowProviderMetadata())
+	cmd.AddCommand(CmdProvidersCapacity())
+	cmd.AddCommand(CmdFrozenProviders())
+	cmd.AddCommand(CmdProviderPerformance())
+	cmd.AddCommand(CmdFilteredEpochPayments())
 
 	// this line is used by starport scaffolding # 1
 
@@ -0,0 +1,77 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/lavanet/lava/x/pairing/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdFilteredEpochPayments() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "filtered-epoch-payments [chain-id]",
+		Short: "Query the relay payments of the stored epochs, optionally of a chain, a provider, a consumer or a project over a range of epochs",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			provider, err := cmd.Flags().GetString(types.FlagProvider)
+			if err != nil {
+				return err
+			}
+			consumer, err := cmd.Flags().GetString(types.FlagConsumer)
+			if err != nil {
+				return err
+			}
+			project, err := cmd.Flags().GetString(types.FlagProject)
+			if err != nil {
+				return err
+			}
+			fromEpoch, err := cmd.Flags().GetUint64(types.FlagFromEpoch)
+			if err != nil {
+				return err
+			}
+			toEpoch, err := cmd.Flags().GetUint64(types.FlagToEpoch)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryFilteredEpochPaymentsRequest{
+				Provider:   provider,
+				Consumer:   consumer,
+				Project:    project,
+				FromEpoch:  fromEpoch,
+				ToEpoch:    toEpoch,
+				Pagination: pageReq,
+			}
+			if len(args) > 0 {
+				params.ChainID = args[0]
+			}
+
+			res, err := queryClient.FilteredEpochPayments(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(types.FlagProvider, "", "Only list the payments of this provider")
+	cmd.Flags().String(types.FlagConsumer, "", "Only list the payments of this consumer")
+	cmd.Flags().String(types.FlagProject, "", "Only list the payments of the developers of this project")
+	cmd.Flags().Uint64(types.FlagFromEpoch, 0, "The first epoch of the range, the earliest stored epoch by default")
+	cmd.Flags().Uint64(types.FlagToEpoch, 0, "The last epoch of the range, the current epoch by default")
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -1,10 +1,12 @@
 package cli
 
 import (
+	"fmt"
 	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/lavanet/lava/x/pairing/types"
 	"github.com/spf13/cobra"
 )
@@ -13,8 +15,9 @@ var _ = strconv.Itoa(0)
 
 func CmdGetPairing() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "get-pairing [chain-id] [client]",
+		Use:   "get-pairing [chain-id] [client] --required-addons [addon,addon] --geolocation [geolocation] --min-stake [amount] --exclude [provider,provider]",
 		Short: "Query getPairing",
+		Long:  "Query the client's pairing on the chain, the filters only return the providers of the pairing that pass all of them",
 		Args:  cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			reqChainID := args[0]
@@ -27,9 +30,15 @@ func CmdGetPairing() *cobra.Command {
 
 			queryClient := types.NewQueryClient(clientCtx)
 
+			filters, err := pairingFiltersFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
 			params := &types.QueryGetPairingRequest{
 				ChainID: reqChainID,
 				Client:  reqClient,
+				Filters: filters,
 			}
 
 			res, err := queryClient.GetPairing(cmd.Context(), params)
@@ -41,7 +50,44 @@ func CmdGetPairing() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().StringSlice(types.FlagRequiredAddons, []string{}, "Only return providers advertising all of these add-ons, comma separated")
+	cmd.Flags().Uint64(types.FlagGeolocation, 0, "Only return providers serving one of these geolocations")
+	cmd.Flags().String(types.FlagMinStake, "", "Only return providers staking at least this amount of ulava")
+	cmd.Flags().StringSlice(types.FlagExclude, []string{}, "Addresses of providers to leave out, comma separated")
 	flags.AddQueryFlagsToCmd(cmd)
 
 	return cmd
 }
+
+// pairingFiltersFromFlags returns nil when no filter is set
+func pairingFiltersFromFlags(cmd *cobra.Command) (*types.PairingFilters, error) {
+	requiredAddons, err := cmd.Flags().GetStringSlice(types.FlagRequiredAddons)
+	if err != nil {
+		return nil, err
+	}
+	geolocation, err := cmd.Flags().GetUint64(types.FlagGeolocation)
+	if err != nil {
+		return nil, err
+	}
+	minStakeArg, err := cmd.Flags().GetString(types.FlagMinStake)
+	if err != nil {
+		return nil, err
+	}
+	exclude, err := cmd.Flags().GetStringSlice(types.FlagExclude)
+	if err != nil {
+		return nil, err
+	}
+	if len(requiredAddons) == 0 && geolocation == 0 && minStakeArg == "" && len(exclude) == 0 {
+		return nil, nil
+	}
+
+	filters := &types.PairingFilters{RequiredAddOns: requiredAddons, Geolocation: geolocation, Exclude: exclude}
+	if minStakeArg != "" {
+		minStake, ok := sdk.NewIntFromString(minStakeArg)
+		if !ok {
+			return nil, fmt.Errorf("invalid min stake %s", minStakeArg)
+		}
+		filters.MinStake = minStake
+	}
+	return filters, filters.Validate()
+}
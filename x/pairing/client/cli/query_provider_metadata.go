@@ -0,0 +1,78 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/lavanet/lava/x/pairing/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdListProviderMetadata() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-provider-metadata",
+		Short: "list all ProviderMetadata, optionally of the providers advertising an add-on",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			addon, err := cmd.Flags().GetString(types.FlagAddon)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryAllProviderMetadataRequest{
+				Addon:      addon,
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.ProviderMetadataAll(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(types.FlagAddon, "", "Only list the providers advertising this add-on")
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdShowProviderMetadata() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-provider-metadata [provider]",
+		Short: "shows a ProviderMetadata",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryGetProviderMetadataRequest{
+				Provider: args[0],
+			}
+
+			res, err := queryClient.ProviderMetadata(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,52 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/lavanet/lava/x/pairing/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdProviderPerformance() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "provider-performance [chain-id] [provider]",
+		Short: "Query the averages of the qos reports clients attached to the relay payments of a provider over a range of epochs",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			fromEpoch, err := cmd.Flags().GetUint64(types.FlagFromEpoch)
+			if err != nil {
+				return err
+			}
+			toEpoch, err := cmd.Flags().GetUint64(types.FlagToEpoch)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryProviderPerformanceRequest{
+				ChainID:   args[0],
+				Provider:  args[1],
+				FromEpoch: fromEpoch,
+				ToEpoch:   toEpoch,
+			}
+
+			res, err := queryClient.ProviderPerformance(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Uint64(types.FlagFromEpoch, 0, "The first epoch of the range, the earliest stored epoch by default")
+	cmd.Flags().Uint64(types.FlagToEpoch, 0, "The last epoch of the range, the current epoch by default")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
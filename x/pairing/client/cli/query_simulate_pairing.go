@@ -0,0 +1,50 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/lavanet/lava/x/pairing/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdSimulatePairing() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "simulate-pairing [chain-id] [client] [epoch-offset]",
+		Short: "Query the pairing a client would get epoch-offset epochs after the current one",
+		Long: `Query the pairing a client would get epoch-offset epochs after the current one. An offset of 0 returns the current pairing,
+a later pairing assumes the providers' current stakes and uses the current epoch hash in place of the future one.`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			reqEpochOffset, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QuerySimulatePairingRequest{
+				ChainID:     args[0],
+				Client:      args[1],
+				EpochOffset: reqEpochOffset,
+			}
+
+			res, err := queryClient.SimulatePairing(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
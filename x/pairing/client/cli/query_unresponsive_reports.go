@@ -0,0 +1,40 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/lavanet/lava/x/pairing/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdUnresponsiveReports() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unresponsive-reports [chain-id] [provider]",
+		Short: "Query the number of consumers that reported a provider as unresponsive in each recent epoch",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryUnresponsiveReportsRequest{
+				ChainID:  args[0],
+				Provider: args[1],
+			}
+
+			res, err := queryClient.UnresponsiveReports(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
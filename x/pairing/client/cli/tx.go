@@ -36,6 +36,10 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdRelayPayment())
 	cmd.AddCommand(CmdFreeze())
 	cmd.AddCommand(CmdUnfreeze())
+	cmd.AddCommand(CmdDelegateToProvider())
+	cmd.AddCommand(CmdUndelegate())
+	cmd.AddCommand(CmdSetProviderMetadata())
+	cmd.AddCommand(CmdAttestEndpointHealth())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
@@ -0,0 +1,53 @@
+package cli
+
+import (
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/lavanet/lava/x/pairing/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdAttestEndpointHealth() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "attest-endpoint-health [chain-id,chain-id] --protocol-version [version] --binary-version [version]",
+		Short: "attest that the provider's endpoints on the given chains are live at the current block",
+		Long:  "attest that the provider's endpoints on the given chains are live at the current block. when the AttestationStaleBlocks param is set, providers whose last attestation on a chain is older than it aren't paired on that chain",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argChainIDs := strings.Split(args[0], ",")
+			protocolVersion, err := cmd.Flags().GetUint32(types.FlagProtocolVersion)
+			if err != nil {
+				return err
+			}
+			binaryVersion, err := cmd.Flags().GetString(types.FlagBinaryVersion)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAttestEndpointHealth(
+				clientCtx.GetFromAddress().String(),
+				argChainIDs,
+				protocolVersion,
+				binaryVersion,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Uint32(types.FlagProtocolVersion, 0, "The protocol version the provider runs")
+	cmd.Flags().String(types.FlagBinaryVersion, "", "The version of the provider's binary")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -18,11 +18,12 @@ func CmdFreeze() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "freeze [chain-ids]",
 		Short: "Freezes a provider",
-		Long:  `The freeze command allows a provider to freeze its service, effective next epoch. This allows providers to pause their services without the impact of bad QoS rating. While frozen, the provider won't be paired with consumers. To unfreeze, the provider must use the unfreeze transaction. Example use case: a provider wishes to halt its services during maintenance.`,
-		Example: `required flags: --from alice. optional flags: --reason
+		Long:  `The freeze command allows a provider to freeze its service, effective next epoch. This allows providers to pause their services without the impact of bad QoS rating. While frozen, the provider won't be paired with consumers. To unfreeze, the provider must use the unfreeze transaction, or schedule an automatic unfreeze with --unfreeze-block or --freeze-epochs. Example use case: a provider wishes to halt its services during maintenance.`,
+		Example: `required flags: --from alice. optional flags: --reason, --unfreeze-block, --freeze-epochs
 		lavad tx pairing freeze [chain-ids] --from <provider_address>
 		lavad tx pairing freeze [chain-ids] --from <provider_address> --reason <freeze_reason>
-		lavad tx pairing freeze ETH1,COS3 --from alice --reason "maintenance"`,
+		lavad tx pairing freeze ETH1,COS3 --from alice --reason "maintenance"
+		lavad tx pairing freeze ETH1,COS3 --from alice --reason "maintenance" --freeze-epochs 3`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			argChainIds := strings.Split(args[0], listSeparator)
@@ -38,10 +39,22 @@ func CmdFreeze() *cobra.Command {
 				utils.LavaFormatFatal("failed to read freeze reason flag", err)
 			}
 
+			// the automatic unfreeze (default value: 0, stays frozen until unfrozen)
+			unfreezeBlock, err := cmd.Flags().GetUint64(types.UnfreezeBlockFlagName)
+			if err != nil {
+				return err
+			}
+			freezeEpochs, err := cmd.Flags().GetUint64(types.FreezeEpochsFlagName)
+			if err != nil {
+				return err
+			}
+
 			msg := types.NewMsgFreeze(
 				clientCtx.GetFromAddress().String(),
 				argChainIds,
 				reason,
+				unfreezeBlock,
+				freezeEpochs,
 			)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -53,6 +66,8 @@ func CmdFreeze() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	cmd.MarkFlagRequired(flags.FlagFrom)
 	cmd.Flags().String(types.ReasonFlagName, "", "reason for freeze")
+	cmd.Flags().Uint64(types.UnfreezeBlockFlagName, 0, "block to unfreeze at automatically, applied at the first epoch starting from it")
+	cmd.Flags().Uint64(types.FreezeEpochsFlagName, 0, "number of epochs to stay frozen before unfreezing automatically")
 
 	return cmd
 }
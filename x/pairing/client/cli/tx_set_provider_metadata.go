@@ -0,0 +1,65 @@
+package cli
+
+import (
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/lavanet/lava/x/pairing/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdSetProviderMetadata() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-provider-metadata [moniker] --website [url] --description [text] --contact [contact] --addons [addon,addon]",
+		Short: "set the identity and the add-ons a staked provider advertises to consumers, replacing its previous metadata",
+		Long:  "set the identity and the add-ons a staked provider advertises to consumers, replacing its previous metadata. supported add-ons: " + strings.Join(types.SupportedAddons, ","),
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argMoniker := args[0]
+			website, err := cmd.Flags().GetString(types.FlagWebsite)
+			if err != nil {
+				return err
+			}
+			description, err := cmd.Flags().GetString(types.FlagDescription)
+			if err != nil {
+				return err
+			}
+			contact, err := cmd.Flags().GetString(types.FlagContact)
+			if err != nil {
+				return err
+			}
+			addons, err := cmd.Flags().GetStringSlice(types.FlagAddons)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetProviderMetadata(
+				clientCtx.GetFromAddress().String(),
+				argMoniker,
+				website,
+				description,
+				contact,
+				addons,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(types.FlagWebsite, "", "The provider's website")
+	cmd.Flags().String(types.FlagDescription, "", "A description of the provider")
+	cmd.Flags().String(types.FlagContact, "", "How to contact the provider")
+	cmd.Flags().StringSlice(types.FlagAddons, []string{}, "The add-ons the provider supports, comma separated")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
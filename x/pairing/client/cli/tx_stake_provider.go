@@ -31,7 +31,7 @@ func CmdStakeProvider() *cobra.Command {
 		Long: `args:
 		[chain-id] is the spec the provider wishes to support
 		[amount] is the ulava amount to be staked
-		[endpoint endpoint ...] are a space separated list of HOST:PORT,useType,geolocation, should be defined within "quotes"
+		[endpoint endpoint ...] are a space separated list of HOST:PORT,useType,geolocation optionally followed by the add-ons served on the endpoint (HOST:PORT,useType,geolocation,archive,trace), should be defined within "quotes"
 		[geolocation] should be the geolocation code to be staked for`,
 		Example: `lavad tx pairing stake-provider "ETH1" 500000ulava "my-provider.com/rpc,jsonrpc,1" 1 -y --from provider-wallet --provider-moniker "my-moniker" --gas-adjustment "1.5" --gas "auto" --gas-prices $GASPRICE`,
 		Args:    cobra.ExactArgs(4),
@@ -45,14 +45,14 @@ func CmdStakeProvider() *cobra.Command {
 			argEndpoints := []epochstoragetypes.Endpoint{}
 			for _, endpointStr := range tmpArg {
 				splitted := strings.Split(endpointStr, ",")
-				if len(splitted) != 3 {
-					return fmt.Errorf("invalid argument format in endpoints, must be: HOST:PORT,useType,geolocation HOST:PORT,useType,geolocation, received: %s", endpointStr)
+				if len(splitted) < 3 {
+					return fmt.Errorf("invalid argument format in endpoints, must be: HOST:PORT,useType,geolocation[,add-on...] HOST:PORT,useType,geolocation[,add-on...], received: %s", endpointStr)
 				}
 				geoloc, err := strconv.ParseUint(splitted[2], 10, 64)
 				if err != nil {
 					return fmt.Errorf("invalid argument format in endpoints, geolocation must be a number")
 				}
-				endpoint := epochstoragetypes.Endpoint{IPPORT: splitted[0], UseType: splitted[1], Geolocation: geoloc}
+				endpoint := epochstoragetypes.Endpoint{IPPORT: splitted[0], UseType: splitted[1], Geolocation: geoloc, AddOns: splitted[3:]}
 				argEndpoints = append(argEndpoints, endpoint)
 			}
 			argGeolocation, err := cast.ToUint64E(args[3])
@@ -69,6 +69,10 @@ func CmdStakeProvider() *cobra.Command {
 			if err != nil {
 				return err
 			}
+			cuCapacity, err := cmd.Flags().GetUint64(types.FlagCuCapacity)
+			if err != nil {
+				return err
+			}
 
 			msg := types.NewMsgStakeProvider(
 				clientCtx.GetFromAddress().String(),
@@ -77,6 +81,7 @@ func CmdStakeProvider() *cobra.Command {
 				argEndpoints,
 				argGeolocation,
 				moniker,
+				cuCapacity,
 			)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -86,6 +91,7 @@ func CmdStakeProvider() *cobra.Command {
 	}
 	cmd.Flags().String(types.FlagMoniker, "", "The provider's moniker (non-unique name)")
 	cmd.MarkFlagRequired(types.FlagMoniker)
+	cmd.Flags().Uint64(types.FlagCuCapacity, 0, "The cu the provider can serve in an epoch, pairing weighs providers that declare a capacity by it")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -119,6 +125,10 @@ func CmdBulkStakeProvider() *cobra.Command {
 			if err != nil {
 				return err
 			}
+			cuCapacity, err := cmd.Flags().GetUint64(types.FlagCuCapacity)
+			if err != nil {
+				return err
+			}
 			specQuerier := spectypes.NewQueryClient(clientCtx)
 			allChains, err := specQuerier.ShowAllChains(context.Background(), &spectypes.QueryShowAllChainsRequest{})
 			if err != nil {
@@ -179,6 +189,7 @@ func CmdBulkStakeProvider() *cobra.Command {
 						allEndpoints,
 						argGeolocation,
 						moniker,
+						cuCapacity,
 					)
 					if err := msg.ValidateBasic(); err != nil {
 						return nil, err
@@ -203,6 +214,7 @@ func CmdBulkStakeProvider() *cobra.Command {
 	}
 	cmd.Flags().String(types.FlagMoniker, "", "The provider's moniker (non-unique name)")
 	cmd.MarkFlagRequired(types.FlagMoniker)
+	cmd.Flags().Uint64(types.FlagCuCapacity, 0, "The cu the provider can serve in an epoch, pairing weighs providers that declare a capacity by it")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -21,6 +21,14 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	for _, elem := range genState.EpochPaymentsList {
 		k.SetEpochPayments(ctx, elem)
 	}
+	// Set all the delegation
+	for _, elem := range genState.DelegationList {
+		k.SetDelegation(ctx, elem)
+	}
+	// Set all the providerMetadata
+	for _, elem := range genState.ProviderMetadataList {
+		k.SetProviderMetadata(ctx, elem)
+	}
 	// this line is used by starport scaffolding # genesis/module/init
 	k.SetParams(ctx, genState.Params)
 }
@@ -33,6 +41,8 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis.UniquePaymentStorageClientProviderList = k.GetAllUniquePaymentStorageClientProvider(ctx)
 	genesis.ProviderPaymentStorageList = k.GetAllProviderPaymentStorage(ctx)
 	genesis.EpochPaymentsList = k.GetAllEpochPayments(ctx)
+	genesis.DelegationList = k.GetAllDelegation(ctx)
+	genesis.ProviderMetadataList = k.GetAllProviderMetadata(ctx)
 	// this line is used by starport scaffolding # genesis/module/export
 
 	return genesis
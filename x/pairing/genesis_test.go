@@ -3,8 +3,10 @@ package pairing_test
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	keepertest "github.com/lavanet/lava/testutil/keeper"
 	"github.com/lavanet/lava/testutil/nullify"
+	epochstoragetypes "github.com/lavanet/lava/x/epochstorage/types"
 	"github.com/lavanet/lava/x/pairing"
 	"github.com/lavanet/lava/x/pairing/types"
 	"github.com/stretchr/testify/require"
@@ -38,6 +40,28 @@ func TestGenesis(t *testing.T) {
 				Index: "1",
 			},
 		},
+		DelegationList: []types.Delegation{
+			{
+				Provider:  "0",
+				ChainID:   "0",
+				Delegator: "0",
+				Amount:    sdk.NewCoin(epochstoragetypes.TokenDenom, sdk.NewInt(1)),
+			},
+			{
+				Provider:  "0",
+				ChainID:   "0",
+				Delegator: "1",
+				Amount:    sdk.NewCoin(epochstoragetypes.TokenDenom, sdk.NewInt(1)),
+			},
+		},
+		ProviderMetadataList: []types.ProviderMetadata{
+			{
+				Provider: "0",
+			},
+			{
+				Provider: "1",
+			},
+		},
 		// this line is used by starport scaffolding # genesis/test/state
 	}
 
@@ -52,5 +76,7 @@ func TestGenesis(t *testing.T) {
 	require.ElementsMatch(t, genesisState.UniquePaymentStorageClientProviderList, got.UniquePaymentStorageClientProviderList)
 	require.ElementsMatch(t, genesisState.ProviderPaymentStorageList, got.ProviderPaymentStorageList)
 	require.ElementsMatch(t, genesisState.EpochPaymentsList, got.EpochPaymentsList)
+	require.ElementsMatch(t, genesisState.DelegationList, got.DelegationList)
+	require.ElementsMatch(t, genesisState.ProviderMetadataList, got.ProviderMetadataList)
 	// this line is used by starport scaffolding # genesis/test/assert
 }
@@ -38,6 +38,18 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		case *types.MsgUnfreezeProvider:
 			res, err := msgServer.UnfreezeProvider(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgDelegateToProvider:
+			res, err := msgServer.DelegateToProvider(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgUndelegate:
+			res, err := msgServer.Undelegate(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgSetProviderMetadata:
+			res, err := msgServer.SetProviderMetadata(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgAttestEndpointHealth:
+			res, err := msgServer.AttestEndpointHealth(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 			// this line is used by starport scaffolding # 1
 		default:
 			errMsg := fmt.Sprintf("unrecognized %s message type: %T", types.ModuleName, msg)
@@ -0,0 +1,27 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/x/pairing/types"
+)
+
+// AddBadgeUsedCu adds the cu of a relay of a badge user to what the provider was paid for the badge in the epoch,
+// it fails without adding when the total would be over the cu allocation of the badge
+func (k Keeper) AddBadgeUsedCu(ctx sdk.Context, epoch uint64, badgeUserAddr sdk.AccAddress, providerAddr sdk.AccAddress, cu uint64, cuAllocation uint64) (uint64, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BadgeUsedCuKeyPrefix))
+	key := types.BadgeUsedCuKey(epoch, badgeUserAddr.String(), providerAddr.String())
+	var usedCu uint64
+	if b := store.Get(key); b != nil {
+		usedCu = binary.BigEndian.Uint64(b)
+	}
+	if usedCu+cu > cuAllocation {
+		return usedCu, fmt.Errorf("badge cu allocation %d exceeded, used %d and relay cu %d", cuAllocation, usedCu, cu)
+	}
+	usedCu += cu
+	store.Set(key, sdk.Uint64ToBigEndian(usedCu))
+	return usedCu, nil
+}
@@ -0,0 +1,164 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/utils"
+	epochstoragetypes "github.com/lavanet/lava/x/epochstorage/types"
+	"github.com/lavanet/lava/x/pairing/types"
+)
+
+// LimitClientPairingsAndMarkForPenalty records a cu overuse incident of a client in the epoch of its relay,
+// a client reaching the ClientOveruseJailThreshold param in an epoch is jailed and a legacy staked client is also slashed
+func (k Keeper) LimitClientPairingsAndMarkForPenalty(ctx sdk.Context, clientAddr sdk.AccAddress, chainID string, epoch uint64, legacyStake bool) error {
+	threshold := k.ClientOveruseJailThreshold(ctx)
+	if threshold == 0 {
+		return nil
+	}
+	incidents := k.addClientOveruseIncident(ctx, epoch, chainID, clientAddr.String())
+	details := map[string]string{"client": clientAddr.String(), "chainID": chainID, "epoch": strconv.FormatUint(epoch, 10), "incidents": strconv.FormatUint(incidents, 10), "jailThreshold": strconv.FormatUint(threshold, 10)}
+	utils.LogLavaEvent(ctx, k.Logger(ctx), types.ClientCuOveruseEventName, details, "client exceeded its allowed cu in the epoch")
+	if incidents != threshold {
+		// jail once when reaching the threshold, later incidents of the epoch don't extend the jail
+		return nil
+	}
+	return k.jailClient(ctx, clientAddr, chainID, k.ClientJailEpochs(ctx), legacyStake, "client jailed for exceeding its allowed cu")
+}
+
+// DetectClientSessionDoubleSpend records the provider paid for a client's session in an epoch, a client that signed the same session
+// to another provider is jailed for the DoubleSpendJailEpochs param epochs and a legacy staked client is also slashed.
+// data reliability relays all share one session id, so they aren't checked
+func (k Keeper) DetectClientSessionDoubleSpend(ctx sdk.Context, clientAddr sdk.AccAddress, providerAddr sdk.AccAddress, chainID string, epoch uint64, sessionID uint64, legacyStake bool) error {
+	jailEpochs := k.DoubleSpendJailEpochs(ctx)
+	if jailEpochs == 0 || sessionID == types.DataReliabilitySessionId {
+		return nil
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ClientSessionKeyPrefix))
+	key := types.ClientSessionKey(epoch, chainID, clientAddr.String(), sessionID)
+	paidProvider := store.Get(key)
+	if paidProvider == nil {
+		store.Set(key, []byte(providerAddr.String()))
+		return nil
+	}
+	if string(paidProvider) == providerAddr.String() {
+		// a provider claiming a session twice is rejected by the epoch payments
+		return nil
+	}
+	details := map[string]string{"client": clientAddr.String(), "chainID": chainID, "epoch": strconv.FormatUint(epoch, 10), "sessionID": strconv.FormatUint(sessionID, 10), "provider": providerAddr.String(), "paidProvider": string(paidProvider)}
+	utils.LogLavaEvent(ctx, k.Logger(ctx), types.ClientSessionDoubleSpendEventName, details, "client signed the same session to several providers")
+	return k.jailClient(ctx, clientAddr, chainID, jailEpochs, legacyStake, "client jailed for signing the same session to several providers")
+}
+
+func (k Keeper) addClientOveruseIncident(ctx sdk.Context, epoch uint64, chainID string, clientAddress string) (incidents uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ClientOveruseKeyPrefix))
+	key := types.ClientOveruseKey(epoch, chainID, clientAddress)
+	if b := store.Get(key); b != nil {
+		incidents = binary.BigEndian.Uint64(b)
+	}
+	incidents++
+	store.Set(key, sdk.Uint64ToBigEndian(incidents))
+	return incidents
+}
+
+// jailClient excludes the client from pairing for the rest of the epoch and jailEpochs epochs after it
+func (k Keeper) jailClient(ctx sdk.Context, clientAddr sdk.AccAddress, chainID string, jailEpochs uint64, legacyStake bool, description string) error {
+	epochBlocks, err := k.epochStorageKeeper.EpochBlocks(ctx, uint64(ctx.BlockHeight()))
+	if err != nil {
+		return err
+	}
+	jailStart := uint64(ctx.BlockHeight())
+	jailEnd := k.epochStorageKeeper.GetEpochStart(ctx) + (jailEpochs+1)*epochBlocks
+	if existingJailStart, existingJailEnd, found := k.getClientJail(ctx, chainID, clientAddr.String()); found && existingJailEnd > jailStart {
+		// a client jailed again during its jail stays jailed from the start of it
+		jailStart = existingJailStart
+		if existingJailEnd > jailEnd {
+			jailEnd = existingJailEnd
+		}
+	}
+	k.setClientJail(ctx, chainID, clientAddr.String(), jailStart, jailEnd)
+
+	slashed := sdk.NewCoin(epochstoragetypes.TokenDenom, sdk.ZeroInt())
+	slashFraction := k.ClientOveruseSlashFraction(ctx)
+	// clients of projects have no stake in the pairing module, their jail is the penalty
+	if legacyStake && slashFraction.IsPositive() {
+		clientEntry, found, _ := k.epochStorageKeeper.GetStakeEntryByAddressCurrent(ctx, epochstoragetypes.ClientKey, chainID, clientAddr)
+		if found {
+			slashed.Amount = slashFraction.MulInt(clientEntry.Stake.Amount).TruncateInt()
+		}
+		if slashed.IsPositive() {
+			_, err = k.BurnClientStake(ctx, chainID, clientAddr, slashed, false)
+			if err != nil {
+				return utils.LavaFormatError("failed slashing jailed client", err, utils.Attribute{Key: "client", Value: clientAddr}, utils.Attribute{Key: "chainID", Value: chainID}, utils.Attribute{Key: "slash", Value: slashed})
+			}
+		}
+	}
+
+	details := map[string]string{"client": clientAddr.String(), "chainID": chainID, "jailStartBlock": strconv.FormatUint(jailStart, 10), "jailEndBlock": strconv.FormatUint(jailEnd, 10), "slashed": slashed.String()}
+	utils.LogLavaEvent(ctx, k.Logger(ctx), types.ClientJailedEventName, details, description)
+	return nil
+}
+
+func (k Keeper) setClientJail(ctx sdk.Context, chainID string, clientAddress string, jailStart uint64, jailEnd uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ClientJailKeyPrefix))
+	store.Set(types.ClientJailKey(chainID, clientAddress), append(sdk.Uint64ToBigEndian(jailStart), sdk.Uint64ToBigEndian(jailEnd)...))
+}
+
+func (k Keeper) getClientJail(ctx sdk.Context, chainID string, clientAddress string) (jailStart uint64, jailEnd uint64, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ClientJailKeyPrefix))
+	b := store.Get(types.ClientJailKey(chainID, clientAddress))
+	if b == nil {
+		return 0, 0, false
+	}
+	return binary.BigEndian.Uint64(b[:8]), binary.BigEndian.Uint64(b[8:]), true
+}
+
+// IsClientJailed returns whether the client was jailed at block
+func (k Keeper) IsClientJailed(ctx sdk.Context, chainID string, clientAddr sdk.AccAddress, block uint64) bool {
+	jailStart, jailEnd, found := k.getClientJail(ctx, chainID, clientAddr.String())
+	return found && jailStart <= block && block < jailEnd
+}
+
+// RemoveOldClientPenalties deletes the overuse incidents, paid sessions and badge cu of epochs that can't be paid for anymore and jails that ended before them
+func (k Keeper) RemoveOldClientPenalties(ctx sdk.Context) {
+	overuseStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ClientOveruseKeyPrefix))
+	sessionStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ClientSessionKeyPrefix))
+	badgeStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BadgeUsedCuKeyPrefix))
+	for _, epoch := range k.epochStorageKeeper.GetDeletedEpochs(ctx) {
+		deleteAllKeys(prefix.NewStore(overuseStore, types.ClientOveruseEpochKey(epoch)))
+		deleteAllKeys(prefix.NewStore(sessionStore, types.ClientOveruseEpochKey(epoch)))
+		deleteAllKeys(prefix.NewStore(badgeStore, types.ClientOveruseEpochKey(epoch)))
+	}
+
+	jailStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ClientJailKeyPrefix))
+	deleteEndedJails(jailStore, k.epochStorageKeeper.GetEarliestEpochStart(ctx), func(jail []byte) uint64 { return binary.BigEndian.Uint64(jail[8:]) })
+}
+
+// deleteEndedJails deletes the jails in the store that ended by block, jailEnd reads the end block of a stored jail
+func deleteEndedJails(jailStore prefix.Store, block uint64, jailEnd func(jail []byte) uint64) {
+	iterator := sdk.KVStorePrefixIterator(jailStore, []byte{})
+	endedJails := [][]byte{}
+	for ; iterator.Valid(); iterator.Next() {
+		if jailEnd(iterator.Value()) <= block {
+			endedJails = append(endedJails, iterator.Key())
+		}
+	}
+	iterator.Close()
+	for _, key := range endedJails {
+		jailStore.Delete(key)
+	}
+}
+
+func deleteAllKeys(store prefix.Store) {
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	keys := [][]byte{}
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
@@ -0,0 +1,305 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/utils"
+	epochstoragetypes "github.com/lavanet/lava/x/epochstorage/types"
+	"github.com/lavanet/lava/x/pairing/types"
+)
+
+// SetDelegation set a specific delegation in the store from its index
+func (k Keeper) SetDelegation(ctx sdk.Context, delegation types.Delegation) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DelegationKeyPrefix))
+	b := k.cdc.MustMarshal(&delegation)
+	store.Set(types.DelegationKey(delegation.ChainID, delegation.Provider, delegation.Delegator), b)
+}
+
+// GetDelegation returns a delegation from its index
+func (k Keeper) GetDelegation(ctx sdk.Context, chainID string, provider string, delegator string) (val types.Delegation, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DelegationKeyPrefix))
+
+	b := store.Get(types.DelegationKey(chainID, provider, delegator))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveDelegation removes a delegation from the store
+func (k Keeper) RemoveDelegation(ctx sdk.Context, chainID string, provider string, delegator string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DelegationKeyPrefix))
+	store.Delete(types.DelegationKey(chainID, provider, delegator))
+}
+
+// GetAllDelegation returns all delegation
+func (k Keeper) GetAllDelegation(ctx sdk.Context) (list []types.Delegation) {
+	return k.getDelegations(ctx, []byte{})
+}
+
+// GetProviderDelegations returns the delegations to a provider on a chain
+func (k Keeper) GetProviderDelegations(ctx sdk.Context, chainID string, provider string) (list []types.Delegation) {
+	return k.getDelegations(ctx, types.ProviderDelegationsKey(chainID, provider))
+}
+
+func (k Keeper) getDelegations(ctx sdk.Context, keyPrefix []byte) (list []types.Delegation) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DelegationKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, keyPrefix)
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Delegation
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// providerDelegateTotal sums the delegations to a provider, delegations outlive the provider's stake entry so a provider staking again gets them back
+func (k Keeper) providerDelegateTotal(ctx sdk.Context, chainID string, provider string) sdk.Coin {
+	total := sdk.NewCoin(epochstoragetypes.TokenDenom, sdk.ZeroInt())
+	for _, delegation := range k.GetProviderDelegations(ctx, chainID, provider) {
+		total = total.Add(delegation.Amount)
+	}
+	return total
+}
+
+// DelegateToProvider moves amount from the delegator to the module and adds it to the delegate total of the provider's current stake entry
+func (k Keeper) DelegateToProvider(ctx sdk.Context, delegator string, provider string, chainID string, amount sdk.Coin) error {
+	delegatorAddr, err := sdk.AccAddressFromBech32(delegator)
+	if err != nil {
+		return utils.LavaFormatWarning("invalid delegator address", err, utils.Attribute{Key: "delegator", Value: delegator})
+	}
+	providerAddr, err := sdk.AccAddressFromBech32(provider)
+	if err != nil {
+		return utils.LavaFormatWarning("invalid provider address", err, utils.Attribute{Key: "provider", Value: provider})
+	}
+	stakeEntry, found, indexInStakeStorage := k.epochStorageKeeper.GetStakeEntryByAddressCurrent(ctx, epochstoragetypes.ProviderKey, chainID, providerAddr)
+	if !found {
+		return utils.LavaFormatWarning("can't delegate to a provider that isn't staked", fmt.Errorf("provider stake entry not found"), utils.Attribute{Key: "provider", Value: provider}, utils.Attribute{Key: "chainID", Value: chainID})
+	}
+
+	if k.bankKeeper.GetBalance(ctx, delegatorAddr, epochstoragetypes.TokenDenom).IsLT(amount) {
+		return utils.LavaFormatWarning("insufficient balance for delegation", fmt.Errorf("current balance: %s", k.bankKeeper.GetBalance(ctx, delegatorAddr, epochstoragetypes.TokenDenom)), utils.Attribute{Key: "delegator", Value: delegator}, utils.Attribute{Key: "amount", Value: amount})
+	}
+	err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, delegatorAddr, types.ModuleName, []sdk.Coin{amount})
+	if err != nil {
+		return utils.LavaFormatError("invalid transfer coins to module", err, utils.Attribute{Key: "delegator", Value: delegator}, utils.Attribute{Key: "amount", Value: amount})
+	}
+
+	k.snapshotDelegation(ctx, chainID, provider, delegator)
+	delegation, found := k.GetDelegation(ctx, chainID, provider, delegator)
+	if !found {
+		delegation = types.Delegation{Provider: provider, ChainID: chainID, Delegator: delegator, Amount: sdk.NewCoin(epochstoragetypes.TokenDenom, sdk.ZeroInt())}
+	}
+	delegation.Amount = delegation.Amount.Add(amount)
+	k.SetDelegation(ctx, delegation)
+
+	stakeEntry.DelegateTotal = sdk.NewCoin(epochstoragetypes.TokenDenom, stakeEntry.EffectiveStake().Sub(stakeEntry.Stake.Amount).Add(amount.Amount))
+	k.epochStorageKeeper.ModifyStakeEntryCurrent(ctx, epochstoragetypes.ProviderKey, chainID, stakeEntry, indexInStakeStorage)
+
+	details := map[string]string{"delegator": delegator, "provider": provider, "chainID": chainID, "amount": amount.String(), "delegation": delegation.Amount.String(), "delegateTotal": stakeEntry.DelegateTotal.String()}
+	utils.LogLavaEvent(ctx, k.Logger(ctx), types.DelegateToProviderEventName, details, "Delegated to provider")
+	return nil
+}
+
+// Undelegate removes amount from a delegation and returns it to the delegator once the unbonding period (the UnstakeHoldBlocks param) ends,
+// the provider doesn't have to be staked anymore
+func (k Keeper) Undelegate(ctx sdk.Context, delegator string, provider string, chainID string, amount sdk.Coin) error {
+	_, err := sdk.AccAddressFromBech32(delegator)
+	if err != nil {
+		return utils.LavaFormatWarning("invalid delegator address", err, utils.Attribute{Key: "delegator", Value: delegator})
+	}
+	providerAddr, err := sdk.AccAddressFromBech32(provider)
+	if err != nil {
+		return utils.LavaFormatWarning("invalid provider address", err, utils.Attribute{Key: "provider", Value: provider})
+	}
+	delegation, found := k.GetDelegation(ctx, chainID, provider, delegator)
+	if !found || delegation.Amount.IsLT(amount) {
+		return utils.LavaFormatWarning("can't undelegate more than was delegated", fmt.Errorf("insufficient delegation"), utils.Attribute{Key: "delegator", Value: delegator}, utils.Attribute{Key: "provider", Value: provider}, utils.Attribute{Key: "chainID", Value: chainID}, utils.Attribute{Key: "amount", Value: amount})
+	}
+
+	k.snapshotDelegation(ctx, chainID, provider, delegator)
+	delegation.Amount = delegation.Amount.Sub(amount)
+	if delegation.Amount.IsZero() {
+		k.RemoveDelegation(ctx, chainID, provider, delegator)
+	} else {
+		k.SetDelegation(ctx, delegation)
+	}
+
+	stakeEntry, found, indexInStakeStorage := k.epochStorageKeeper.GetStakeEntryByAddressCurrent(ctx, epochstoragetypes.ProviderKey, chainID, providerAddr)
+	if found {
+		delegateTotal := stakeEntry.EffectiveStake().Sub(stakeEntry.Stake.Amount).Sub(amount.Amount)
+		if delegateTotal.IsNegative() {
+			delegateTotal = sdk.ZeroInt()
+		}
+		stakeEntry.DelegateTotal = sdk.NewCoin(epochstoragetypes.TokenDenom, delegateTotal)
+		k.epochStorageKeeper.ModifyStakeEntryCurrent(ctx, epochstoragetypes.ProviderKey, chainID, stakeEntry, indexInStakeStorage)
+	}
+
+	// the amount unbonds like an unstaked entry, so a delegator can't leave a provider right before its misbehaviour is punished
+	unbondingEntry := epochstoragetypes.StakeEntry{Address: delegator, Chain: chainID, Stake: amount}
+	err = k.epochStorageKeeper.AppendUnstakeEntry(ctx, types.UndelegationStorageType, unbondingEntry, k.epochStorageKeeper.UnstakeHoldBlocks(ctx, uint64(ctx.BlockHeight())))
+	if err != nil {
+		return utils.LavaFormatError("failed to unbond undelegation", err, utils.Attribute{Key: "delegator", Value: delegator}, utils.Attribute{Key: "amount", Value: amount})
+	}
+
+	details := map[string]string{"delegator": delegator, "provider": provider, "chainID": chainID, "amount": amount.String(), "delegation": delegation.Amount.String()}
+	utils.LogLavaEvent(ctx, k.Logger(ctx), types.UndelegateEventName, details, "Undelegated from provider")
+	return nil
+}
+
+// creditUndelegations returns the undelegated amounts that finished unbonding to their delegators
+func (k Keeper) creditUndelegations(ctx sdk.Context, undelegations []epochstoragetypes.StakeEntry) error {
+	for _, undelegation := range undelegations {
+		delegatorAddr, err := sdk.AccAddressFromBech32(undelegation.Address)
+		if err != nil {
+			return utils.LavaFormatError("invalid delegator address in unbonding undelegation", err, utils.Attribute{Key: "delegator", Value: undelegation.Address})
+		}
+		err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, delegatorAddr, []sdk.Coin{undelegation.Stake})
+		if err != nil {
+			return utils.LavaFormatError("invalid transfer coins from module", err, utils.Attribute{Key: "delegator", Value: undelegation.Address}, utils.Attribute{Key: "amount", Value: undelegation.Stake})
+		}
+
+		details := map[string]string{"delegator": undelegation.Address, "chainID": undelegation.Chain, "amount": undelegation.Stake.String()}
+		utils.LogLavaEvent(ctx, k.Logger(ctx), types.UndelegateCommitEventName, details, "Undelegation unbonded")
+	}
+	return nil
+}
+
+// snapshotDelegation stores the delegation as it was at the start of the current epoch before its first change in the epoch,
+// rewards for relays of the epoch are paid by it. a delegation that didn't exist is stored with a zero amount
+func (k Keeper) snapshotDelegation(ctx sdk.Context, chainID string, provider string, delegator string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DelegationSnapshotKeyPrefix))
+	key := types.DelegationSnapshotKey(chainID, provider, delegator, k.epochStorageKeeper.GetEpochStart(ctx))
+	if store.Has(key) {
+		return
+	}
+	delegation, found := k.GetDelegation(ctx, chainID, provider, delegator)
+	if !found {
+		delegation = types.Delegation{Provider: provider, ChainID: chainID, Delegator: delegator, Amount: sdk.NewCoin(epochstoragetypes.TokenDenom, sdk.ZeroInt())}
+	}
+	store.Set(key, k.cdc.MustMarshal(&delegation))
+}
+
+// GetProviderDelegationsForEpoch returns the delegations to a provider on a chain at the start of the epoch. a delegation that changed since
+// is the snapshot of its first change from the epoch on, the others didn't change
+func (k Keeper) GetProviderDelegationsForEpoch(ctx sdk.Context, chainID string, provider string, epoch uint64) (list []types.Delegation) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DelegationSnapshotKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, types.ProviderDelegationsKey(chainID, provider))
+
+	defer iterator.Close()
+
+	snapshots := map[string]types.Delegation{}
+	for ; iterator.Valid(); iterator.Next() {
+		if types.DelegationSnapshotEpoch(iterator.Key()) < epoch {
+			continue
+		}
+		var val types.Delegation
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		if _, found := snapshots[val.Delegator]; !found {
+			snapshots[val.Delegator] = val
+		}
+	}
+
+	for _, delegation := range k.GetProviderDelegations(ctx, chainID, provider) {
+		if _, found := snapshots[delegation.Delegator]; !found {
+			snapshots[delegation.Delegator] = delegation
+		}
+	}
+	// sorted so the rewards are paid in the same order on every node
+	delegators := make([]string, 0, len(snapshots))
+	for delegator := range snapshots {
+		delegators = append(delegators, delegator)
+	}
+	sort.Strings(delegators)
+	for _, delegator := range delegators {
+		if delegation := snapshots[delegator]; delegation.Amount.IsPositive() {
+			list = append(list, delegation)
+		}
+	}
+
+	return
+}
+
+// RemoveOldDelegationSnapshots removes the delegation snapshots of epochs that left the memory, the rewards of the epochs
+// in memory are paid by the snapshots of their epoch or later ones
+func (k Keeper) RemoveOldDelegationSnapshots(ctx sdk.Context) {
+	earliestEpoch := k.epochStorageKeeper.GetEarliestEpochStart(ctx)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DelegationSnapshotKeyPrefix))
+	iterator := store.Iterator(nil, nil)
+	oldKeys := [][]byte{}
+	for ; iterator.Valid(); iterator.Next() {
+		if types.DelegationSnapshotEpoch(iterator.Key()) < earliestEpoch {
+			oldKeys = append(oldKeys, iterator.Key())
+		}
+	}
+	iterator.Close()
+	for _, key := range oldKeys {
+		store.Delete(key)
+	}
+}
+
+// distributeProviderReward sends a reward minted to the module to the provider and its delegators. the delegators get the part of the reward
+// their delegate total weighs in the provider's effective stake of the epoch, minus the DelegationCommission param, split pro-rata to their
+// delegations at the start of the epoch
+func (k Keeper) distributeProviderReward(ctx sdk.Context, chainID string, providerAddr sdk.AccAddress, epoch uint64, reward sdk.Coin) (delegatorsReward sdk.Int, err error) {
+	return k.payProviderReward(ctx, chainID, providerAddr, epoch, reward, k.providerDelegatorsShare(ctx, chainID, providerAddr, epoch))
+}
+
+// providerDelegatorsShare is the part of the provider's rewards in the epoch its delegators get, zero when the provider's stake entry of the epoch isn't found
+func (k Keeper) providerDelegatorsShare(ctx sdk.Context, chainID string, providerAddr sdk.AccAddress, epoch uint64) sdk.Dec {
+	stakeEntry, err := k.epochStorageKeeper.GetStakeEntryForProviderEpoch(ctx, chainID, providerAddr, epoch)
+	if err != nil || !stakeEntry.EffectiveStake().GT(stakeEntry.Stake.Amount) {
+		return sdk.ZeroDec()
+	}
+	return sdk.NewDecFromInt(stakeEntry.DelegateTotal.Amount).QuoInt(stakeEntry.EffectiveStake()).Mul(sdk.OneDec().Sub(k.DelegationCommission(ctx)))
+}
+
+// payProviderReward sends a reward minted to the module to the provider and delegatorsShare of it to the delegators, split pro-rata to their
+// delegations at the start of the epoch
+func (k Keeper) payProviderReward(ctx sdk.Context, chainID string, providerAddr sdk.AccAddress, epoch uint64, reward sdk.Coin, delegatorsShare sdk.Dec) (delegatorsReward sdk.Int, err error) {
+	delegatorsReward = sdk.ZeroInt()
+	if delegatorsShare.IsPositive() {
+		delegations := k.GetProviderDelegationsForEpoch(ctx, chainID, providerAddr.String(), epoch)
+		delegationsSum := sdk.ZeroInt()
+		for _, delegation := range delegations {
+			delegationsSum = delegationsSum.Add(delegation.Amount.Amount)
+		}
+		if delegationsSum.IsPositive() {
+			delegatorsRewardDec := delegatorsShare.MulInt(reward.Amount)
+			for _, delegation := range delegations {
+				delegatorReward := delegatorsRewardDec.MulInt(delegation.Amount.Amount).QuoInt(delegationsSum).TruncateInt()
+				if !delegatorReward.IsPositive() {
+					continue
+				}
+				delegatorAddr, err := sdk.AccAddressFromBech32(delegation.Delegator)
+				if err != nil {
+					return delegatorsReward, err
+				}
+				err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, delegatorAddr, sdk.Coins{sdk.NewCoin(reward.Denom, delegatorReward)})
+				if err != nil {
+					return delegatorsReward, err
+				}
+				delegatorsReward = delegatorsReward.Add(delegatorReward)
+			}
+		}
+	}
+
+	// the provider also gets the rounding of the delegators' rewards
+	providerReward := reward.Amount.Sub(delegatorsReward)
+	if providerReward.IsPositive() {
+		err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, providerAddr, sdk.Coins{sdk.NewCoin(reward.Denom, providerReward)})
+		if err != nil {
+			return delegatorsReward, err
+		}
+	}
+	return delegatorsReward, nil
+}
@@ -0,0 +1,121 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/testutil/common"
+	testkeeper "github.com/lavanet/lava/testutil/keeper"
+	"github.com/lavanet/lava/utils/sigs"
+	epochstoragetypes "github.com/lavanet/lava/x/epochstorage/types"
+	"github.com/lavanet/lava/x/pairing/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDelegateToProvider(t *testing.T) {
+	ts := setupForPaymentTest(t)
+	delegator := common.CreateNewAccount(ts.ctx, *ts.keepers, balance)
+	provider := ts.providers[0].Addr
+	delegation := sdk.NewCoin(epochstoragetypes.TokenDenom, sdk.NewInt(stake))
+	getBalance := func(addr sdk.AccAddress) int64 {
+		return ts.keepers.BankKeeper.GetBalance(sdk.UnwrapSDKContext(ts.ctx), addr, epochstoragetypes.TokenDenom).Amount.Int64()
+	}
+
+	// only staked providers take delegations
+	_, err := ts.servers.PairingServer.DelegateToProvider(ts.ctx, &types.MsgDelegateToProvider{Creator: delegator.Addr.String(), Provider: ts.clients[0].Addr.String(), ChainID: ts.spec.Index, Amount: delegation})
+	require.NotNil(t, err)
+
+	// delegate as much as the provider's own stake
+	_, err = ts.servers.PairingServer.DelegateToProvider(ts.ctx, &types.MsgDelegateToProvider{Creator: delegator.Addr.String(), Provider: provider.String(), ChainID: ts.spec.Index, Amount: delegation})
+	require.Nil(t, err)
+	require.Equal(t, balance-stake, getBalance(delegator.Addr))
+	stakeEntry, found, _ := ts.keepers.Epochstorage.GetStakeEntryByAddressCurrent(sdk.UnwrapSDKContext(ts.ctx), epochstoragetypes.ProviderKey, ts.spec.Index, provider)
+	require.True(t, found)
+	require.Equal(t, 2*stake, stakeEntry.EffectiveStake().Int64())
+
+	ts.ctx = testkeeper.AdvanceEpoch(ts.ctx, ts.keepers)
+
+	// a delegation made during the epoch doesn't share the rewards of the epoch's relays
+	lateDelegator := common.CreateNewAccount(ts.ctx, *ts.keepers, balance)
+	_, err = ts.servers.PairingServer.DelegateToProvider(ts.ctx, &types.MsgDelegateToProvider{Creator: lateDelegator.Addr.String(), Provider: provider.String(), ChainID: ts.spec.Index, Amount: delegation})
+	require.Nil(t, err)
+
+	// the delegator gets half of the reward minus the provider's commission
+	cuSum := ts.spec.GetApis()[0].ComputeUnits * 10
+	relaySession := common.BuildRelayRequest(ts.ctx, provider.String(), []byte(ts.spec.Apis[0].Name), cuSum, ts.spec.Name, nil)
+	relaySession.Sig, err = sigs.SignRelay(ts.clients[0].SK, *relaySession)
+	require.Nil(t, err)
+	providerBalance := getBalance(provider)
+	_, err = ts.servers.PairingServer.RelayPayment(ts.ctx, &types.MsgRelayPayment{Creator: provider.String(), Relays: []*types.RelaySession{relaySession}})
+	require.Nil(t, err)
+
+	reward := ts.keepers.Pairing.MintCoinsPerCU(sdk.UnwrapSDKContext(ts.ctx)).MulInt64(int64(cuSum)).TruncateInt()
+	delegatorReward := sdk.NewDecWithPrec(5, 1).Mul(sdk.OneDec().Sub(ts.keepers.Pairing.DelegationCommission(sdk.UnwrapSDKContext(ts.ctx)))).MulInt(reward).TruncateInt64()
+	require.Positive(t, delegatorReward)
+	require.Equal(t, balance-stake+delegatorReward, getBalance(delegator.Addr))
+	require.Equal(t, providerBalance+reward.Int64()-delegatorReward, getBalance(provider))
+	require.Equal(t, balance-stake, getBalance(lateDelegator.Addr))
+
+	// undelegating removes the delegation at once, can't exceed it and returns it after the unbonding period
+	_, err = ts.servers.PairingServer.Undelegate(ts.ctx, &types.MsgUndelegate{Creator: delegator.Addr.String(), Provider: provider.String(), ChainID: ts.spec.Index, Amount: delegation})
+	require.Nil(t, err)
+	require.Equal(t, balance-stake+delegatorReward, getBalance(delegator.Addr))
+	stakeEntry, found, _ = ts.keepers.Epochstorage.GetStakeEntryByAddressCurrent(sdk.UnwrapSDKContext(ts.ctx), epochstoragetypes.ProviderKey, ts.spec.Index, provider)
+	require.True(t, found)
+	require.Equal(t, 2*stake, stakeEntry.EffectiveStake().Int64())
+	_, err = ts.servers.PairingServer.Undelegate(ts.ctx, &types.MsgUndelegate{Creator: delegator.Addr.String(), Provider: provider.String(), ChainID: ts.spec.Index, Amount: delegation})
+	require.NotNil(t, err)
+
+	unstakeHoldBlocks := ts.keepers.Epochstorage.UnstakeHoldBlocks(sdk.UnwrapSDKContext(ts.ctx), uint64(sdk.UnwrapSDKContext(ts.ctx).BlockHeight()))
+	ts.ctx = testkeeper.AdvanceBlocks(ts.ctx, ts.keepers, int(unstakeHoldBlocks)-1)
+	require.Equal(t, balance-stake+delegatorReward, getBalance(delegator.Addr))
+	ts.ctx = testkeeper.AdvanceBlocks(ts.ctx, ts.keepers, 1)
+	ts.ctx = testkeeper.AdvanceEpoch(ts.ctx, ts.keepers)
+	require.Equal(t, balance+delegatorReward, getBalance(delegator.Addr))
+}
+
+func TestDelegationSnapshots(t *testing.T) {
+	ts := setupForPaymentTest(t)
+	delegator := common.CreateNewAccount(ts.ctx, *ts.keepers, balance)
+	provider := ts.providers[0].Addr.String()
+	delegation := sdk.NewCoin(epochstoragetypes.TokenDenom, sdk.NewInt(stake))
+	getDelegations := func(epoch uint64) []types.Delegation {
+		return ts.keepers.Pairing.GetProviderDelegationsForEpoch(sdk.UnwrapSDKContext(ts.ctx), ts.spec.Index, provider, epoch)
+	}
+
+	_, err := ts.servers.PairingServer.DelegateToProvider(ts.ctx, &types.MsgDelegateToProvider{Creator: delegator.Addr.String(), Provider: provider, ChainID: ts.spec.Index, Amount: delegation})
+	require.Nil(t, err)
+	ts.ctx = testkeeper.AdvanceEpoch(ts.ctx, ts.keepers)
+	firstEpoch := ts.keepers.Epochstorage.GetEpochStart(sdk.UnwrapSDKContext(ts.ctx))
+
+	// undelegating during the epoch doesn't change the delegation the epoch's rewards are paid to
+	_, err = ts.servers.PairingServer.Undelegate(ts.ctx, &types.MsgUndelegate{Creator: delegator.Addr.String(), Provider: provider, ChainID: ts.spec.Index, Amount: delegation.SubAmount(sdk.NewInt(stake / 2))})
+	require.Nil(t, err)
+	delegations := getDelegations(firstEpoch)
+	require.Len(t, delegations, 1)
+	require.Equal(t, delegation, delegations[0].Amount)
+
+	ts.ctx = testkeeper.AdvanceEpoch(ts.ctx, ts.keepers)
+	secondEpoch := ts.keepers.Epochstorage.GetEpochStart(sdk.UnwrapSDKContext(ts.ctx))
+	delegations = getDelegations(secondEpoch)
+	require.Len(t, delegations, 1)
+	require.Equal(t, int64(stake/2), delegations[0].Amount.Amount.Int64())
+	require.Equal(t, delegation, getDelegations(firstEpoch)[0].Amount)
+
+	// a delegation removed during the epoch is still paid the epoch's rewards
+	_, err = ts.servers.PairingServer.Undelegate(ts.ctx, &types.MsgUndelegate{Creator: delegator.Addr.String(), Provider: provider, ChainID: ts.spec.Index, Amount: sdk.NewCoin(epochstoragetypes.TokenDenom, sdk.NewInt(stake/2))})
+	require.Nil(t, err)
+	require.Empty(t, ts.keepers.Pairing.GetProviderDelegations(sdk.UnwrapSDKContext(ts.ctx), ts.spec.Index, provider))
+	delegations = getDelegations(secondEpoch)
+	require.Len(t, delegations, 1)
+	require.Equal(t, int64(stake/2), delegations[0].Amount.Amount.Int64())
+
+	// the snapshots are removed once their epochs leave the memory
+	epochsToSave, err := ts.keepers.Epochstorage.EpochsToSave(sdk.UnwrapSDKContext(ts.ctx), uint64(sdk.UnwrapSDKContext(ts.ctx).BlockHeight()))
+	require.Nil(t, err)
+	for i := uint64(0); i <= epochsToSave; i++ {
+		ts.ctx = testkeeper.AdvanceEpoch(ts.ctx, ts.keepers)
+	}
+	require.Empty(t, getDelegations(firstEpoch))
+	require.Empty(t, getDelegations(secondEpoch))
+}
@@ -1,12 +1,25 @@
 package keeper
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	epochstoragetypes "github.com/lavanet/lava/x/epochstorage/types"
 )
 
+// JailEntry freezes a provider until jailBlocks blocks after jailStartBlock, the same way providers are jailed for unresponsiveness
 func (k Keeper) JailEntry(ctx sdk.Context, account sdk.AccAddress, isProvider bool, chainID string, jailStartBlock uint64, jailBlocks uint64, bail sdk.Coin) error {
-	// todo - provider will not get pairing and payment for this period
+	// todo - consumers aren't jailed and the bail isn't collected
+	if !isProvider {
+		return nil
+	}
+	jailEnd := jailStartBlock + jailBlocks
+	if jailEnd <= uint64(ctx.BlockHeight()) {
+		return nil
+	}
+	if _, found := k.jailProvider(ctx, chainID, account, jailEnd); !found {
+		return fmt.Errorf("can't jail %s, no provider stake entry on chain %s", account, chainID)
+	}
 	return nil
 }
 
@@ -15,7 +28,26 @@ func (k Keeper) BailEntry(ctx sdk.Context, account sdk.AccAddress, isProvider bo
 	return nil
 }
 
+// SlashEntry takes percentage of the entry's current stake, the slashed coins stay in the module for the caller to distribute
 func (k Keeper) SlashEntry(ctx sdk.Context, account sdk.AccAddress, isProvider bool, chainID string, percentage sdk.Dec) (sdk.Coin, error) {
 	// TODO: jail user, and count problems
-	return sdk.NewCoin(epochstoragetypes.TokenDenom, sdk.ZeroInt()), nil
+	slashed := sdk.NewCoin(epochstoragetypes.TokenDenom, sdk.ZeroInt())
+	storageType := epochstoragetypes.ClientKey
+	if isProvider {
+		storageType = epochstoragetypes.ProviderKey
+	}
+	stakeEntry, found, index := k.epochStorageKeeper.GetStakeEntryByAddressCurrent(ctx, storageType, chainID, account)
+	if !found {
+		return slashed, fmt.Errorf("can't slash %s, no %s stake entry on chain %s", account, storageType, chainID)
+	}
+	slashed.Amount = percentage.MulInt(stakeEntry.Stake.Amount).TruncateInt()
+	if !slashed.IsPositive() {
+		return slashed, nil
+	}
+	if stakeEntry.Stake.IsLT(slashed) {
+		slashed = stakeEntry.Stake
+	}
+	stakeEntry.Stake = stakeEntry.Stake.Sub(slashed)
+	k.epochStorageKeeper.ModifyStakeEntryCurrent(ctx, storageType, chainID, stakeEntry, index)
+	return slashed, nil
 }
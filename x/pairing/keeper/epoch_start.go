@@ -19,6 +19,14 @@ func (k Keeper) EpochStart(ctx sdk.Context, epochsNumToCheckCuForUnresponsivePro
 	// 2. unstake any unstaking providers
 	// 3. unstake any unstaking users
 	// 4. unstake/jail unresponsive providers
+	// 5. remove old client penalties
+	// 6. report providers excluded from pairing by their spec
+	// 7. remove old unresponsiveness reports
+	// 8. remove ended provider freezes
+	// 9. pay the rewards withheld in old epochs by the providers' final qos scores and remove old provider qos reports
+	// 10. leave providers with stale endpoint attestations out of the new epoch's pairing
+	// 11. remove old stale providers and the attestations of unstaked providers
+	// 12. remove old delegation snapshots
 
 	// 1.
 	err := k.RemoveOldEpochPayment(ctx)
@@ -31,4 +39,30 @@ func (k Keeper) EpochStart(ctx sdk.Context, epochsNumToCheckCuForUnresponsivePro
 	// 4. unstake unresponsive providers
 	err = k.UnstakeUnresponsiveProviders(ctx, epochsNumToCheckCuForUnresponsiveProvider, epochsNumToCheckForComplainers)
 	logOnErr(err, "UnstakeUnresponsiveProviders")
+
+	// 5.
+	k.RemoveOldClientPenalties(ctx)
+
+	// 6.
+	k.LogPairingExclusions(ctx)
+
+	// 7.
+	k.RemoveOldUnresponsiveReports(ctx)
+
+	// 8.
+	k.RemoveEndedProviderFreezes(ctx)
+
+	// 9. the withheld rewards are paid by the qos reports and to the delegation snapshots, so before both are removed
+	k.PayWithheldProviderRewards(ctx)
+	k.RemoveOldProviderQoSReports(ctx)
+
+	// 10.
+	k.MarkStaleProviders(ctx)
+
+	// 11.
+	k.RemoveOldStaleProviders(ctx)
+	k.RemoveUnstakedProviderAttestations(ctx)
+
+	// 12.
+	k.RemoveOldDelegationSnapshots(ctx)
 }
@@ -0,0 +1,137 @@
+package keeper
+
+import (
+	"context"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/lavanet/lava/x/pairing/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) FilteredEpochPayments(goCtx context.Context, req *types.QueryFilteredEpochPaymentsRequest) (*types.QueryFilteredEpochPaymentsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if req.GetProvider() != "" {
+		if _, err := sdk.AccAddressFromBech32(req.GetProvider()); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid provider address")
+		}
+	}
+	if req.GetConsumer() != "" {
+		if _, err := sdk.AccAddressFromBech32(req.GetConsumer()); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid consumer address")
+		}
+	}
+	toEpoch := req.GetToEpoch()
+	if toEpoch == 0 {
+		toEpoch = k.epochStorageKeeper.GetEpochStart(ctx)
+	}
+	if req.GetFromEpoch() > toEpoch {
+		return nil, status.Error(codes.InvalidArgument, "fromEpoch is after toEpoch")
+	}
+
+	// the storage keys start with the chain, so a chain filter narrows the iteration
+	keyPrefix := types.KeyPrefix(types.ProviderPaymentStorageKeyPrefix)
+	if req.GetChainID() != "" {
+		keyPrefix = append(keyPrefix, []byte(req.GetChainID()+"_")...)
+	}
+	providerPaymentStorageStore := prefix.NewStore(ctx.KVStore(k.storeKey), keyPrefix)
+
+	// the project of a consumer is looked up once per epoch
+	type consumerInEpoch struct {
+		consumer string
+		epoch    uint64
+	}
+	projects := map[consumerInEpoch]string{}
+	consumerProject := func(consumer string, epoch uint64) string {
+		key := consumerInEpoch{consumer: consumer, epoch: epoch}
+		project, ok := projects[key]
+		if !ok {
+			if proj, _, err := k.projectsKeeper.GetProjectForDeveloper(ctx, consumer, epoch); err == nil {
+				project = proj.Index
+			}
+			projects[key] = project
+		}
+		return project
+	}
+
+	res := &types.QueryFilteredEpochPaymentsResponse{}
+	pageRes, err := query.FilteredPaginate(providerPaymentStorageStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		var providerPaymentStorage types.ProviderPaymentStorage
+		if err := k.cdc.Unmarshal(value, &providerPaymentStorage); err != nil {
+			return false, err
+		}
+
+		epoch := providerPaymentStorage.GetEpoch()
+		if epoch < req.GetFromEpoch() || epoch > toEpoch {
+			return false, nil
+		}
+		chainID, provider := decodeProviderPaymentStorageKey(providerPaymentStorage.GetIndex())
+		if (req.GetChainID() != "" && chainID != req.GetChainID()) || (req.GetProvider() != "" && provider != req.GetProvider()) {
+			return false, nil
+		}
+
+		payment := types.ProviderEpochPayment{ChainID: chainID, Epoch: epoch, Provider: provider, ComplainersTotalCu: providerPaymentStorage.GetComplainersTotalCu()}
+		consumerIndexes := map[string]int{}
+		for _, uniquePaymentKey := range providerPaymentStorage.GetUniquePaymentStorageClientProviderKeys() {
+			uniquePayment, found := k.GetUniquePaymentStorageClientProvider(ctx, uniquePaymentKey)
+			if !found {
+				continue
+			}
+			consumer := k.GetConsumerFromUniquePayment(&uniquePayment)
+			if req.GetConsumer() != "" && consumer != req.GetConsumer() {
+				continue
+			}
+			project := consumerProject(consumer, epoch)
+			if req.GetProject() != "" && project != req.GetProject() {
+				continue
+			}
+
+			idx, ok := consumerIndexes[consumer]
+			if !ok {
+				idx = len(payment.ConsumerPayments)
+				consumerIndexes[consumer] = idx
+				payment.ConsumerPayments = append(payment.ConsumerPayments, types.ConsumerEpochPayment{Consumer: consumer, Project: project})
+			}
+			payment.ConsumerPayments[idx].Cu += uniquePayment.GetUsedCU()
+			payment.ConsumerPayments[idx].Sessions++
+			payment.Cu += uniquePayment.GetUsedCU()
+		}
+		if len(payment.ConsumerPayments) == 0 {
+			return false, nil
+		}
+
+		if accumulate {
+			res.Payments = append(res.Payments, payment)
+			res.TotalCu += payment.Cu
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	res.Pagination = pageRes
+
+	return res, nil
+}
+
+// decodeProviderPaymentStorageKey returns the chain and the provider of a providerPaymentStorage key (chainID_epoch_providerAddress)
+func decodeProviderPaymentStorageKey(key string) (chainID string, provider string) {
+	providerIdx := strings.LastIndex(key, "_")
+	if providerIdx == -1 {
+		return "", key
+	}
+	provider = key[providerIdx+1:]
+	epochIdx := strings.LastIndex(key[:providerIdx], "_")
+	if epochIdx == -1 {
+		return "", provider
+	}
+	return key[:epochIdx], provider
+}
@@ -0,0 +1,87 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/lavanet/lava/testutil/common"
+	testkeeper "github.com/lavanet/lava/testutil/keeper"
+	"github.com/lavanet/lava/utils/sigs"
+	"github.com/lavanet/lava/x/pairing/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFilteredEpochPayments(t *testing.T) {
+	ts := setupForPaymentTest(t)
+	err := ts.addClient(1)
+	require.Nil(t, err)
+	ts.ctx = testkeeper.AdvanceEpoch(ts.ctx, ts.keepers)
+
+	cu := ts.spec.Apis[0].ComputeUnits
+	payRelay := func(client *common.Account, sessionID uint64) {
+		relaySession := common.BuildRelayRequest(ts.ctx, ts.providers[0].Addr.String(), []byte(ts.spec.Apis[0].Name), cu, ts.spec.Name, nil)
+		relaySession.SessionId = sessionID
+		sig, err := sigs.SignRelay(client.SK, *relaySession)
+		require.Nil(t, err)
+		relaySession.Sig = sig
+		_, err = ts.servers.PairingServer.RelayPayment(ts.ctx, &types.MsgRelayPayment{Creator: ts.providers[0].Addr.String(), Relays: []*types.RelaySession{relaySession}})
+		require.Nil(t, err)
+	}
+	queryPayments := func(req *types.QueryFilteredEpochPaymentsRequest) *types.QueryFilteredEpochPaymentsResponse {
+		res, err := ts.keepers.Pairing.FilteredEpochPayments(ts.ctx, req)
+		require.Nil(t, err)
+		return res
+	}
+
+	firstEpoch := ts.keepers.Epochstorage.GetEpochStart(sdk.UnwrapSDKContext(ts.ctx))
+	payRelay(ts.clients[0], 1)
+	payRelay(ts.clients[0], 2)
+	payRelay(ts.clients[1], 3)
+
+	ts.ctx = testkeeper.AdvanceEpoch(ts.ctx, ts.keepers)
+	secondEpoch := ts.keepers.Epochstorage.GetEpochStart(sdk.UnwrapSDKContext(ts.ctx))
+	payRelay(ts.clients[1], 1)
+
+	res := queryPayments(&types.QueryFilteredEpochPaymentsRequest{})
+	require.Len(t, res.Payments, 2)
+	require.Equal(t, 4*cu, res.TotalCu)
+
+	res = queryPayments(&types.QueryFilteredEpochPaymentsRequest{ChainID: ts.spec.Index, Provider: ts.providers[0].Addr.String(), FromEpoch: firstEpoch, ToEpoch: firstEpoch})
+	require.Len(t, res.Payments, 1)
+	payment := res.Payments[0]
+	require.Equal(t, firstEpoch, payment.Epoch)
+	require.Equal(t, ts.spec.Index, payment.ChainID)
+	require.Equal(t, ts.providers[0].Addr.String(), payment.Provider)
+	require.Equal(t, 3*cu, payment.Cu)
+	require.Len(t, payment.ConsumerPayments, 2)
+	require.Equal(t, ts.clients[0].Addr.String(), payment.ConsumerPayments[0].Consumer)
+	require.Equal(t, 2*cu, payment.ConsumerPayments[0].Cu)
+	require.Equal(t, uint64(2), payment.ConsumerPayments[0].Sessions)
+
+	// only the consumer's payments are listed
+	res = queryPayments(&types.QueryFilteredEpochPaymentsRequest{Consumer: ts.clients[1].Addr.String()})
+	require.Len(t, res.Payments, 2)
+	for _, payment := range res.Payments {
+		require.Len(t, payment.ConsumerPayments, 1)
+		require.Equal(t, cu, payment.Cu)
+	}
+	res = queryPayments(&types.QueryFilteredEpochPaymentsRequest{Consumer: ts.clients[0].Addr.String(), FromEpoch: secondEpoch})
+	require.Empty(t, res.Payments)
+
+	// the staked clients aren't developers of a project
+	res = queryPayments(&types.QueryFilteredEpochPaymentsRequest{Project: "unknown"})
+	require.Empty(t, res.Payments)
+	res = queryPayments(&types.QueryFilteredEpochPaymentsRequest{ChainID: "unknown"})
+	require.Empty(t, res.Payments)
+
+	// paginated by provider payments
+	res = queryPayments(&types.QueryFilteredEpochPaymentsRequest{Pagination: &query.PageRequest{Limit: 1, CountTotal: true}})
+	require.Len(t, res.Payments, 1)
+	require.Equal(t, uint64(2), res.Pagination.Total)
+
+	_, err = ts.keepers.Pairing.FilteredEpochPayments(ts.ctx, &types.QueryFilteredEpochPaymentsRequest{FromEpoch: secondEpoch, ToEpoch: firstEpoch})
+	require.NotNil(t, err)
+	_, err = ts.keepers.Pairing.FilteredEpochPayments(ts.ctx, &types.QueryFilteredEpochPaymentsRequest{Provider: "invalid"})
+	require.NotNil(t, err)
+}
@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	epochstoragetypes "github.com/lavanet/lava/x/epochstorage/types"
+	"github.com/lavanet/lava/x/pairing/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const unresponsiveJailFreezeReason = "jailed for unresponsiveness"
+
+func (k Keeper) FrozenProviders(goCtx context.Context, req *types.QueryFrozenProvidersRequest) (*types.QueryFrozenProvidersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	foundAndActive, _ := k.specKeeper.IsSpecFoundAndActive(ctx, req.GetChainID())
+	if !foundAndActive {
+		return nil, fmt.Errorf("spec %s is not found or not enabled", req.GetChainID())
+	}
+
+	stakeStorage, found := k.epochStorageKeeper.GetStakeStorageCurrent(ctx, epochstoragetypes.ProviderKey, req.GetChainID())
+	if !found {
+		return &types.QueryFrozenProvidersResponse{}, nil
+	}
+
+	currentBlock := uint64(ctx.BlockHeight())
+	frozenProviders := []types.ProviderFreeze{}
+	for _, stakeEntry := range stakeStorage.GetStakeEntries() {
+		if stakeEntry.StakeAppliedBlock <= currentBlock {
+			continue
+		}
+		// the stake applied block is where the provider returns, whatever set it last (freeze, unfreeze or jail)
+		unfreezeBlock := stakeEntry.StakeAppliedBlock
+		if unfreezeBlock == math.MaxInt64 {
+			unfreezeBlock = 0
+		}
+		providerFreeze, found := k.GetProviderFreeze(ctx, req.GetChainID(), stakeEntry.Address)
+		if !found {
+			providerAddr, err := sdk.AccAddressFromBech32(stakeEntry.Address)
+			if err != nil || !k.IsProviderJailed(ctx, req.GetChainID(), providerAddr, currentBlock) {
+				// a new stake that isn't applied yet
+				continue
+			}
+			providerFreeze = types.ProviderFreeze{
+				Provider: stakeEntry.Address,
+				ChainID:  req.GetChainID(),
+				Reason:   unresponsiveJailFreezeReason,
+			}
+		}
+		providerFreeze.UnfreezeBlock = unfreezeBlock
+		frozenProviders = append(frozenProviders, providerFreeze)
+	}
+
+	return &types.QueryFrozenProvidersResponse{FrozenProviders: frozenProviders}, nil
+}
@@ -12,7 +12,7 @@ import (
 	"google.golang.org/grpc/status"
 )
 
-// Gets a client's provider list in a specific chain. Also returns the start block of the current epoch, time (in seconds) until there's a new pairing, the block that the chain in the request's spec was changed
+// Gets a client's provider list in a specific chain, narrowed down by the request's filters. Also returns the start block of the current epoch, time (in seconds) until there's a new pairing, the block that the chain in the request's spec was changed
 func (k Keeper) GetPairing(goCtx context.Context, req *types.QueryGetPairingRequest) (*types.QueryGetPairingResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
@@ -25,6 +25,9 @@ func (k Keeper) GetPairing(goCtx context.Context, req *types.QueryGetPairingRequ
 	if err != nil {
 		return nil, fmt.Errorf("invalid creator address %s error: %s", req.Client, err)
 	}
+	if err := req.Filters.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 
 	// Make sure the chain ID exists and the chain's functional
 	foundAndActive, _ := k.specKeeper.IsSpecFoundAndActive(ctx, req.ChainID)
@@ -38,6 +41,7 @@ func (k Keeper) GetPairing(goCtx context.Context, req *types.QueryGetPairingRequ
 	if err != nil {
 		return nil, fmt.Errorf("could not get pairing for chainID: %s, client addr: %s, blockHeight: %d, err: %s", req.ChainID, clientAddr, ctx.BlockHeight(), err)
 	}
+	providers = k.filterPairing(ctx, providers, req.Filters)
 
 	// Calculate the time left until the new epoch (when epoch changes, new pairing is generated)
 	timeLeftToNextPairing, nextPairingBlock, err := k.calculateNextEpochTimeAndBlock(ctx)
@@ -57,5 +61,15 @@ func (k Keeper) GetPairing(goCtx context.Context, req *types.QueryGetPairingRequ
 	}
 	specLastUpdatedBlock := spec.BlockLastUpdated
 
-	return &types.QueryGetPairingResponse{Providers: providers, CurrentEpoch: currentEpoch, TimeLeftToNextPairing: timeLeftToNextPairing, SpecLastUpdatedBlock: specLastUpdatedBlock, BlockOfNextPairing: nextPairingBlock}, nil
+	// consumers enforce the allowed apis and add-ons of their project before relaying, relays of other apis aren't paid
+	allowedApis, addOns, err := k.getClientAllowedApis(ctx, clientAddr, req.ChainID, uint64(ctx.BlockHeight()))
+	if err != nil {
+		return nil, fmt.Errorf("could not get allowed apis for chainID: %s, client addr: %s, err: %s", req.ChainID, clientAddr, err)
+	}
+	if addOns == nil {
+		// legacy staked clients can relay the apis of every add-on
+		addOns = spec.GetAddOns()
+	}
+
+	return &types.QueryGetPairingResponse{Providers: providers, CurrentEpoch: currentEpoch, TimeLeftToNextPairing: timeLeftToNextPairing, SpecLastUpdatedBlock: specLastUpdatedBlock, BlockOfNextPairing: nextPairingBlock, AllowedApis: allowedApis, AddOns: addOns}, nil
 }
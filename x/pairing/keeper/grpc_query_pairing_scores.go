@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	epochstoragetypes "github.com/lavanet/lava/x/epochstorage/types"
+	"github.com/lavanet/lava/x/pairing/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Previews the pairing score of each provider of a chain in the current epoch for a consumer of the given geolocation
+func (k Keeper) PairingScores(goCtx context.Context, req *types.QueryPairingScoresRequest) (*types.QueryPairingScoresResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	spec, found := k.specKeeper.GetSpec(ctx, req.GetChainID())
+	if !found || !spec.Enabled {
+		return nil, fmt.Errorf("spec %s is not found or not enabled", req.GetChainID())
+	}
+
+	epoch := k.epochStorageKeeper.GetEpochStart(ctx)
+	stakes, found, _ := k.epochStorageKeeper.GetEpochStakeEntries(ctx, epoch, epochstoragetypes.ProviderKey, req.GetChainID())
+	if !found {
+		return &types.QueryPairingScoresResponse{}, nil
+	}
+
+	scores, err := k.getProvidersPairingScores(ctx, spec, stakes, req.GetGeolocation(), epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryPairingScoresResponse{Scores: scores}, nil
+}
@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/lavanet/lava/x/pairing/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) ProviderMetadataAll(c context.Context, req *types.QueryAllProviderMetadataRequest) (*types.QueryAllProviderMetadataResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var providerMetadatas []types.ProviderMetadata
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	providerMetadataStore := prefix.NewStore(store, types.KeyPrefix(types.ProviderMetadataKeyPrefix))
+
+	pageRes, err := query.FilteredPaginate(providerMetadataStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		var providerMetadata types.ProviderMetadata
+		if err := k.cdc.Unmarshal(value, &providerMetadata); err != nil {
+			return false, err
+		}
+
+		if req.Addon != "" && !providerMetadata.HasAddon(req.Addon) {
+			return false, nil
+		}
+
+		if accumulate {
+			providerMetadatas = append(providerMetadatas, providerMetadata)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllProviderMetadataResponse{ProviderMetadata: providerMetadatas, Pagination: pageRes}, nil
+}
+
+func (k Keeper) ProviderMetadata(c context.Context, req *types.QueryGetProviderMetadataRequest) (*types.QueryGetProviderMetadataResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	val, found := k.GetProviderMetadata(
+		ctx,
+		req.Provider,
+	)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetProviderMetadataResponse{ProviderMetadata: val}, nil
+}
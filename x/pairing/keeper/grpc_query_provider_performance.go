@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/x/pairing/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) ProviderPerformance(goCtx context.Context, req *types.QueryProviderPerformanceRequest) (*types.QueryProviderPerformanceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := sdk.AccAddressFromBech32(req.GetProvider()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid provider address")
+	}
+	if req.GetToEpoch() != 0 && req.GetFromEpoch() > req.GetToEpoch() {
+		return nil, status.Error(codes.InvalidArgument, "fromEpoch is after toEpoch")
+	}
+
+	res := &types.QueryProviderPerformanceResponse{Latency: sdk.ZeroDec(), Availability: sdk.ZeroDec(), Sync: sdk.ZeroDec()}
+	res.Epochs = k.GetProviderQoSReports(ctx, req.GetChainID(), req.GetProvider(), req.GetFromEpoch(), req.GetToEpoch())
+	for _, epoch := range res.Epochs {
+		res.Reports += epoch.Reports
+		res.Latency = res.Latency.Add(epoch.LatencySum)
+		res.Availability = res.Availability.Add(epoch.AvailabilitySum)
+		res.Sync = res.Sync.Add(epoch.SyncSum)
+	}
+	if res.Reports > 0 {
+		reports := sdk.NewDec(int64(res.Reports))
+		res.Latency = res.Latency.Quo(reports)
+		res.Availability = res.Availability.Quo(reports)
+		res.Sync = res.Sync.Quo(reports)
+	}
+	return res, nil
+}
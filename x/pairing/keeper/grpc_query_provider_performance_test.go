@@ -0,0 +1,73 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/testutil/common"
+	testkeeper "github.com/lavanet/lava/testutil/keeper"
+	"github.com/lavanet/lava/utils/sigs"
+	"github.com/lavanet/lava/x/pairing/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProviderPerformance(t *testing.T) {
+	ts := setupForPaymentTest(t)
+	ts.ctx = testkeeper.AdvanceEpoch(ts.ctx, ts.keepers)
+
+	payRelay := func(sessionID uint64, qos *types.QualityOfServiceReport) {
+		relaySession := common.BuildRelayRequest(ts.ctx, ts.providers[0].Addr.String(), []byte(ts.spec.Apis[0].Name), ts.spec.Apis[0].ComputeUnits, ts.spec.Name, qos)
+		relaySession.SessionId = sessionID
+		sig, err := sigs.SignRelay(ts.clients[0].SK, *relaySession)
+		require.Nil(t, err)
+		relaySession.Sig = sig
+		_, err = ts.servers.PairingServer.RelayPayment(ts.ctx, &types.MsgRelayPayment{Creator: ts.providers[0].Addr.String(), Relays: []*types.RelaySession{relaySession}})
+		require.Nil(t, err)
+	}
+	queryPerformance := func(fromEpoch uint64, toEpoch uint64) *types.QueryProviderPerformanceResponse {
+		res, err := ts.keepers.Pairing.ProviderPerformance(ts.ctx, &types.QueryProviderPerformanceRequest{ChainID: ts.spec.Index, Provider: ts.providers[0].Addr.String(), FromEpoch: fromEpoch, ToEpoch: toEpoch})
+		require.Nil(t, err)
+		return res
+	}
+
+	// no reports yet
+	res := queryPerformance(0, 0)
+	require.Zero(t, res.Reports)
+	require.True(t, res.Availability.IsZero())
+	require.Empty(t, res.Epochs)
+
+	firstEpoch := ts.keepers.Epochstorage.GetEpochStart(sdk.UnwrapSDKContext(ts.ctx))
+	payRelay(1, &types.QualityOfServiceReport{Latency: sdk.OneDec(), Availability: sdk.OneDec(), Sync: sdk.OneDec()})
+	payRelay(2, &types.QualityOfServiceReport{Latency: sdk.NewDecWithPrec(5, 1), Availability: sdk.OneDec(), Sync: sdk.ZeroDec()})
+	// relays without a qos report aren't counted
+	payRelay(3, nil)
+
+	ts.ctx = testkeeper.AdvanceEpoch(ts.ctx, ts.keepers)
+	secondEpoch := ts.keepers.Epochstorage.GetEpochStart(sdk.UnwrapSDKContext(ts.ctx))
+	// session ids are unique for the client and the provider across epochs
+	payRelay(4, &types.QualityOfServiceReport{Latency: sdk.ZeroDec(), Availability: sdk.NewDecWithPrec(7, 1), Sync: sdk.OneDec()})
+
+	res = queryPerformance(0, 0)
+	require.Equal(t, uint64(3), res.Reports)
+	require.True(t, sdk.NewDecWithPrec(5, 1).Equal(res.Latency))
+	require.True(t, sdk.NewDecWithPrec(9, 1).Equal(res.Availability))
+	require.True(t, sdk.NewDecWithPrec(2, 0).Quo(sdk.NewDec(3)).Equal(res.Sync))
+	require.Len(t, res.Epochs, 2)
+	require.Equal(t, secondEpoch, res.Epochs[0].Epoch)
+	require.Equal(t, firstEpoch, res.Epochs[1].Epoch)
+	require.Equal(t, uint64(2), res.Epochs[1].Reports)
+
+	// a range of the first epoch only
+	res = queryPerformance(firstEpoch, firstEpoch)
+	require.Equal(t, uint64(2), res.Reports)
+	require.True(t, sdk.NewDecWithPrec(75, 2).Equal(res.Latency))
+	require.True(t, sdk.OneDec().Equal(res.Availability))
+
+	// a range of the second epoch only
+	res = queryPerformance(secondEpoch, 0)
+	require.Equal(t, uint64(1), res.Reports)
+	require.True(t, sdk.NewDecWithPrec(7, 1).Equal(res.Availability))
+
+	_, err := ts.keepers.Pairing.ProviderPerformance(ts.ctx, &types.QueryProviderPerformanceRequest{ChainID: ts.spec.Index, Provider: ts.providers[0].Addr.String(), FromEpoch: secondEpoch, ToEpoch: firstEpoch})
+	require.NotNil(t, err)
+}
@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	epochstoragetypes "github.com/lavanet/lava/x/epochstorage/types"
+	"github.com/lavanet/lava/x/pairing/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Sums the cu capacity declared by the providers paired in the current epoch and the part of it that wasn't paid for yet
+func (k Keeper) ProvidersCapacity(goCtx context.Context, req *types.QueryProvidersCapacityRequest) (*types.QueryProvidersCapacityResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	foundAndActive, _ := k.specKeeper.IsSpecFoundAndActive(ctx, req.GetChainID())
+	if !foundAndActive {
+		return nil, fmt.Errorf("spec %s is not found or not enabled", req.GetChainID())
+	}
+
+	epoch := k.epochStorageKeeper.GetEpochStart(ctx)
+	res := &types.QueryProvidersCapacityResponse{Epoch: epoch}
+	stakes, found, _ := k.epochStorageKeeper.GetEpochStakeEntries(ctx, epoch, epochstoragetypes.ProviderKey, req.GetChainID())
+	if !found {
+		return res, nil
+	}
+
+	for _, stakeEntry := range stakes {
+		if stakeEntry.StakeAppliedBlock > uint64(ctx.BlockHeight()) {
+			// not in the pairing of this epoch
+			continue
+		}
+		if stakeEntry.CuCapacity == 0 {
+			res.ProvidersWithoutCapacity++
+			continue
+		}
+		res.ProvidersWithCapacity++
+		res.TotalCapacity += stakeEntry.CuCapacity
+
+		providerAddr, err := sdk.AccAddressFromBech32(stakeEntry.Address)
+		if err != nil {
+			continue
+		}
+		usedCu := uint64(0)
+		providerPaymentStorage, found := k.GetProviderPaymentStorage(ctx, k.GetProviderPaymentStorageKey(ctx, req.GetChainID(), epoch, providerAddr))
+		if found {
+			usedCu = k.getProviderServicedCu(ctx, providerPaymentStorage)
+		}
+		res.UsedCu += usedCu
+		if usedCu < stakeEntry.CuCapacity {
+			res.RemainingCapacity += stakeEntry.CuCapacity - usedCu
+		}
+	}
+
+	return res, nil
+}
@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/x/pairing/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Simulates a client's provider list in a specific chain for the epoch that starts epochOffset epochs after the current one
+func (k Keeper) SimulatePairing(goCtx context.Context, req *types.QuerySimulatePairingRequest) (*types.QuerySimulatePairingResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	clientAddr, err := sdk.AccAddressFromBech32(req.Client)
+	if err != nil {
+		return nil, fmt.Errorf("invalid client address %s error: %s", req.Client, err)
+	}
+
+	foundAndActive, _ := k.specKeeper.IsSpecFoundAndActive(ctx, req.ChainID)
+	if !foundAndActive {
+		return nil, errors.New("spec not found or not enabled")
+	}
+
+	providers, epoch, err := k.SimulatePairingForClient(ctx, req.ChainID, clientAddr, req.EpochOffset)
+	if err != nil {
+		return nil, fmt.Errorf("could not simulate pairing for chainID: %s, client addr: %s, epoch: %d, err: %s", req.ChainID, clientAddr, epoch, err)
+	}
+
+	return &types.QuerySimulatePairingResponse{Providers: providers, Epoch: epoch, Exact: req.EpochOffset == 0}, nil
+}
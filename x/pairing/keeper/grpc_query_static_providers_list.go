@@ -40,11 +40,22 @@ func (k Keeper) StaticProvidersList(goCtx context.Context, req *types.QueryStati
 	}
 
 	finalProviders := []epochstoragetypes.StakeEntry{}
+	// with a geolocation fallback score a provider can be chosen for several geolocations, list it once
+	listed := map[string]struct{}{}
 	geolocation := uint64(1)
 	for i := uint64(0); i < k.specKeeper.GeolocationCount(ctx); i++ {
-		validProviders := k.getGeolocationProviders(ctx, stakes, geolocation)
-		validProviders = k.returnSubsetOfProvidersByHighestStake(ctx, validProviders, servicersToPairCount)
-		finalProviders = append(finalProviders, validProviders...)
+		providerScores, err := k.getProvidersPairingScores(ctx, spec, stakes, geolocation, epoch)
+		if err != nil {
+			return nil, err
+		}
+		validProviders := k.returnSubsetOfProvidersByHighestStake(ctx, providerScores, servicersToPairCount)
+		for _, provider := range validProviders {
+			if _, ok := listed[provider.Address]; ok {
+				continue
+			}
+			listed[provider.Address] = struct{}{}
+			finalProviders = append(finalProviders, provider)
+		}
 		geolocation <<= 1
 	}
 
@@ -0,0 +1,25 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/x/pairing/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) UnresponsiveReports(goCtx context.Context, req *types.QueryUnresponsiveReportsRequest) (*types.QueryUnresponsiveReportsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := sdk.AccAddressFromBech32(req.GetProvider()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid provider address")
+	}
+
+	jailEnd, _ := k.getProviderJail(ctx, req.GetChainID(), req.GetProvider())
+	return &types.QueryUnresponsiveReportsResponse{Reports: k.GetUnresponsiveReports(ctx, req.GetChainID(), req.GetProvider()), JailEndBlock: jailEnd}, nil
+}
@@ -41,13 +41,16 @@ func (k Keeper) UserEntry(goCtx context.Context, req *types.QueryUserEntryReques
 		planPolicy := plan.GetPlanPolicy()
 		policies := []*projectstypes.Policy{&planPolicy, project.AdminPolicy, project.SubscriptionPolicy}
 		// geolocation is a bitmap. common denominator can be calculated with logical AND
-		geolocation := k.CalculateEffectiveGeolocationFromPolicies(policies)
+		geolocation := projectstypes.GetChainGeolocation(req.ChainID, policies, k.CalculateEffectiveGeolocationFromPolicies(policies))
 
 		sub, found := k.subscriptionKeeper.GetSubscription(ctx, project.GetSubscription())
 		if !found {
 			return nil, fmt.Errorf("could not find subscription with address %s", project.GetSubscription())
 		}
-		allowedCU := k.CalculateEffectiveAllowedCuPerEpochFromPolicies(policies, project.GetUsedCu(), sub.GetMonthCuLeft())
+		allowedCU := k.CalculateEffectiveAllowedCuPerEpochFromPolicies(policies, project.GetUsedCu(), sub.ProjectCuLeft(project.Index, project.GetUsedCu()))
+		if chainEpochCuLimit, found := projectstypes.GetChainEpochCuLimit(req.ChainID, policies); found && chainEpochCuLimit < allowedCU {
+			allowedCU = chainEpochCuLimit
+		}
 
 		if !projectstypes.VerifyTotalCuUsage(policies, project.GetUsedCu()) {
 			allowedCU = 0
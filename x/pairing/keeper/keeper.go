@@ -49,6 +49,7 @@ func NewKeeper(
 	}
 	epochStorageKeeper.AddFixationRegistry(string(types.KeyServicersToPairCount), func(ctx sdk.Context) any { return keeper.ServicersToPairCountRaw(ctx) })
 	epochStorageKeeper.AddFixationRegistry(string(types.KeyStakeToMaxCUList), func(ctx sdk.Context) any { return keeper.StakeToMaxCUListRaw(ctx) })
+	epochStorageKeeper.AddFixationRegistry(string(types.KeyGeolocationFallbackScore), func(ctx sdk.Context) any { return keeper.GeolocationFallbackScoreRaw(ctx) })
 
 	return keeper
 }
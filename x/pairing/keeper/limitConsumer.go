@@ -27,7 +27,7 @@ func (k Keeper) GetAllowedCUForBlock(ctx sdk.Context, blockHeight uint64, entry
 	return allowedCU, nil
 }
 
-func (k Keeper) EnforceClientCUsUsageInEpoch(ctx sdk.Context, allowedCU uint64, totalCUInEpochForUserProvider uint64, clientAddr sdk.AccAddress, chainID string, epoch uint64) error {
+func (k Keeper) EnforceClientCUsUsageInEpoch(ctx sdk.Context, allowedCU uint64, totalCUInEpochForUserProvider uint64, relayCU uint64, clientAddr sdk.AccAddress, chainID string, epoch uint64) error {
 	project, _, err := k.GetProjectData(ctx, clientAddr, chainID, epoch)
 	// if client is not legacy (works through a project), the CU verification is different
 	if err == nil {
@@ -42,6 +42,18 @@ func (k Keeper) EnforceClientCUsUsageInEpoch(ctx sdk.Context, allowedCU uint64,
 			return utils.LavaFormatError("total cu in epoch for consumer exceeded the allowed amount for the project", fmt.Errorf("consumer CU limit exceeded for project"), []utils.Attribute{{Key: "projectUsedCu", Value: project.GetUsedCu()}}...)
 		}
 
+		// the chain's cu limit is shared by all the developer keys of the project, across all of their providers
+		if chainEpochCuLimit, found := projectstypes.GetChainEpochCuLimit(chainID, policies); found {
+			epochStart, _, err := k.epochStorageKeeper.GetEpochStartForBlock(ctx, epoch)
+			if err != nil {
+				return err
+			}
+			chainUsedCu := k.projectsKeeper.GetProjectChainUsage(ctx, project.Index, chainID, epochStart)
+			if chainUsedCu+relayCU > chainEpochCuLimit {
+				return utils.LavaFormatError("total cu in epoch for project exceeded the chain policy's limit", fmt.Errorf("consumer CU limit exceeded for chain"), []utils.Attribute{{Key: "chainID", Value: chainID}, {Key: "chainUsedCu", Value: chainUsedCu}, {Key: "chainEpochCuLimit", Value: chainEpochCuLimit}}...)
+			}
+		}
+
 		sub, found := k.subscriptionKeeper.GetSubscription(ctx, project.GetSubscription())
 		if !found {
 			return utils.LavaFormatError("can't find subscription", fmt.Errorf("EnforceClientCUsUsageInEpoch_cant_find_subscription"), utils.Attribute{Key: "subscriptionKey", Value: project.GetSubscription()})
@@ -49,6 +61,10 @@ func (k Keeper) EnforceClientCUsUsageInEpoch(ctx sdk.Context, allowedCU uint64,
 		if sub.GetMonthCuLeft() == 0 {
 			return utils.LavaFormatError("total cu in epoch for consumer exceeded the amount of CU left in the subscription", fmt.Errorf("consumer CU limit exceeded for subscription"), []utils.Attribute{{Key: "subscriptionCuLeft", Value: sub.GetMonthCuLeft()}}...)
 		}
+		// the subscription may budget its cu across its projects
+		if projectCu, found := sub.ProjectCuAllocation(project.Index); found && project.GetUsedCu()+relayCU > projectCu {
+			return utils.LavaFormatError("total cu in month for project exceeded its allocation in the subscription", fmt.Errorf("consumer CU limit exceeded for project allocation"), []utils.Attribute{{Key: "project", Value: project.Index}, {Key: "projectUsedCu", Value: project.GetUsedCu()}, {Key: "projectAllocationCu", Value: projectCu}}...)
+		}
 	}
 
 	if totalCUInEpochForUserProvider > allowedCU {
@@ -0,0 +1,15 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/x/pairing/types"
+)
+
+func (k msgServer) AttestEndpointHealth(goCtx context.Context, msg *types.MsgAttestEndpointHealth) (*types.MsgAttestEndpointHealthResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := k.Keeper.AttestEndpointHealth(ctx, msg.Creator, msg.ChainIDs, msg.ProtocolVersion, msg.BinaryVersion)
+	return &types.MsgAttestEndpointHealthResponse{}, err
+}
@@ -0,0 +1,15 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/x/pairing/types"
+)
+
+func (k msgServer) DelegateToProvider(goCtx context.Context, msg *types.MsgDelegateToProvider) (*types.MsgDelegateToProviderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := k.Keeper.DelegateToProvider(ctx, msg.Creator, msg.Provider, msg.ChainID, msg.Amount)
+	return &types.MsgDelegateToProviderResponse{}, err
+}
@@ -15,29 +15,72 @@ import (
 func (k msgServer) FreezeProvider(goCtx context.Context, msg *types.MsgFreezeProvider) (*types.MsgFreezeProviderResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	err := k.Keeper.FreezeProvider(ctx, msg.GetCreator(), msg.GetChainIds(), msg.Reason)
+	unfreezeBlock := msg.GetUnfreezeBlock()
+	if msg.GetFreezeEpochs() != 0 {
+		epochBlocks, err := k.epochStorageKeeper.EpochBlocks(ctx, uint64(ctx.BlockHeight()))
+		if err != nil {
+			return nil, err
+		}
+		// the freeze is effective from the next epoch
+		unfreezeBlock = k.epochStorageKeeper.GetEpochStart(ctx) + (msg.GetFreezeEpochs()+1)*epochBlocks
+	}
+
+	err := k.Keeper.FreezeProviderUntil(ctx, msg.GetCreator(), msg.GetChainIds(), msg.Reason, unfreezeBlock)
 
 	return &types.MsgFreezeProviderResponse{}, err
 }
 
 func (k Keeper) FreezeProvider(ctx sdk.Context, provider string, chainIDs []string, reason string) error {
+	return k.FreezeProviderUntil(ctx, provider, chainIDs, reason, 0)
+}
+
+// FreezeProviderUntil freezes the provider on the chains, a non zero unfreezeBlock unfreezes it automatically in the first epoch starting from it
+func (k Keeper) FreezeProviderUntil(ctx sdk.Context, provider string, chainIDs []string, reason string, unfreezeBlock uint64) error {
 	providerAddr, err := sdk.AccAddressFromBech32(provider)
 	if err != nil {
 		return utils.LavaFormatError("Freeze_get_provider_address", err, utils.Attribute{Key: "providerAddress", Value: provider})
 	}
 
+	currentBlock := uint64(ctx.BlockHeight())
+	// freeze the provider by making the StakeAppliedBlock be max. This will remove the provider from the pairing list in the next epoch
+	stakeAppliedBlock := uint64(math.MaxInt64)
+	if unfreezeBlock != 0 {
+		if unfreezeBlock <= currentBlock {
+			return utils.LavaFormatWarning("Freeze_unfreeze_block_passed", types.FreezeUnfreezeBlockError, utils.Attribute{Key: "unfreezeBlock", Value: unfreezeBlock}, utils.Attribute{Key: "currentBlock", Value: currentBlock})
+		}
+		// a scheduled unfreeze applies at an epoch start so the pairing doesn't change in the middle of an epoch
+		epochStart := k.epochStorageKeeper.GetEpochStart(ctx)
+		epochBlocks, err := k.epochStorageKeeper.EpochBlocks(ctx, currentBlock)
+		if err != nil {
+			return err
+		}
+		epochsToUnfreeze := (unfreezeBlock - epochStart + epochBlocks - 1) / epochBlocks
+		unfreezeBlock = epochStart + epochsToUnfreeze*epochBlocks
+		stakeAppliedBlock = unfreezeBlock
+	}
+
 	for _, chainId := range chainIDs {
 		stakeEntry, found, index := k.epochStorageKeeper.GetStakeEntryByAddressCurrent(ctx, epochstoragetypes.ProviderKey, chainId, providerAddr)
 		if !found {
 			return utils.LavaFormatError("Freeze_cant_get_stake_entry", types.FreezeStakeEntryNotFoundError, []utils.Attribute{{Key: "chainID", Value: chainId}, {Key: "providerAddress", Value: provider}}...)
 		}
 
-		// freeze the provider by making the StakeAppliedBlock be max. This will remove the provider from the pairing list in the next epoch
-		stakeEntry.StakeAppliedBlock = math.MaxInt64
+		stakeEntry.StakeAppliedBlock = stakeAppliedBlock
+		if jailEnd, jailed := k.getProviderJail(ctx, chainId, provider); jailed && jailEnd > stakeEntry.StakeAppliedBlock {
+			// a scheduled unfreeze doesn't shorten an unresponsiveness jail
+			stakeEntry.StakeAppliedBlock = jailEnd
+		}
 		k.epochStorageKeeper.ModifyStakeEntryCurrent(ctx, epochstoragetypes.ProviderKey, chainId, stakeEntry, index)
+		k.SetProviderFreeze(ctx, types.ProviderFreeze{
+			Provider:      provider,
+			ChainID:       chainId,
+			FreezeBlock:   currentBlock,
+			UnfreezeBlock: unfreezeBlock,
+			Reason:        reason,
+		})
 	}
 
-	utils.LogLavaEvent(ctx, ctx.Logger(), "freeze_provider", map[string]string{"providerAddress": providerAddr.String(), "chainIDs": strings.Join(chainIDs, ","), "freezeRequestBlock": strconv.FormatInt(ctx.BlockHeight(), 10), "freezeReason": reason}, "Provider Freeze")
+	utils.LogLavaEvent(ctx, ctx.Logger(), types.ProviderFreezeEventName, map[string]string{"providerAddress": providerAddr.String(), "chainIDs": strings.Join(chainIDs, ","), "freezeRequestBlock": strconv.FormatInt(ctx.BlockHeight(), 10), "freezeReason": reason, "unfreezeBlock": strconv.FormatUint(unfreezeBlock, 10)}, "Provider Freeze")
 
 	return nil
 }
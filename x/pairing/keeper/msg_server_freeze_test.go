@@ -261,3 +261,68 @@ func TestPaymentFrozen(t *testing.T) {
 	_, err = ts.servers.PairingServer.RelayPayment(ts.ctx, &types.MsgRelayPayment{Creator: providerToFreeze.Address, Relays: Relays})
 	require.Nil(t, err)
 }
+
+// Test a freeze with a scheduled unfreeze and the frozen providers query
+func TestScheduledUnfreeze(t *testing.T) {
+	providersNum := 2
+	clientsNum := 1
+	ts := setupClientsAndProvidersForUnresponsiveness(t, clientsNum, providersNum)
+
+	// advance epoch
+	ts.ctx = testkeeper.AdvanceEpoch(ts.ctx, ts.keepers)
+	ctx := sdk.UnwrapSDKContext(ts.ctx)
+
+	pairingList, err := ts.keepers.Pairing.GetPairingForClient(ctx, ts.spec.GetIndex(), ts.clients[0].Addr)
+	require.Nil(t, err)
+	require.Equal(t, providersNum, len(pairingList))
+	providerToFreeze := pairingList[0]
+
+	// an unfreeze block that passed is rejected
+	_, err = ts.servers.PairingServer.FreezeProvider(ts.ctx, &types.MsgFreezeProvider{
+		Creator:       providerToFreeze.Address,
+		ChainIds:      []string{ts.spec.GetIndex()},
+		Reason:        "maintenance",
+		UnfreezeBlock: uint64(ctx.BlockHeight()),
+	})
+	require.NotNil(t, err)
+
+	// freeze the provider for 2 epochs
+	freezeEpochs := uint64(2)
+	_, err = ts.servers.PairingServer.FreezeProvider(ts.ctx, &types.MsgFreezeProvider{
+		Creator:      providerToFreeze.Address,
+		ChainIds:     []string{ts.spec.GetIndex()},
+		Reason:       "maintenance",
+		FreezeEpochs: freezeEpochs,
+	})
+	require.Nil(t, err)
+
+	expectedReturn := ts.keepers.Epochstorage.GetEpochStart(ctx) + (freezeEpochs+1)*ts.keepers.Epochstorage.EpochBlocksRaw(ctx)
+	frozenProviders, err := ts.keepers.Pairing.FrozenProviders(ts.ctx, &types.QueryFrozenProvidersRequest{ChainID: ts.spec.GetIndex()})
+	require.Nil(t, err)
+	require.Len(t, frozenProviders.FrozenProviders, 1)
+	require.Equal(t, providerToFreeze.Address, frozenProviders.FrozenProviders[0].Provider)
+	require.Equal(t, "maintenance", frozenProviders.FrozenProviders[0].Reason)
+	require.Equal(t, expectedReturn, frozenProviders.FrozenProviders[0].UnfreezeBlock)
+
+	// the provider is out of the pairing for the freeze epochs
+	for i := uint64(0); i < freezeEpochs; i++ {
+		ts.ctx = testkeeper.AdvanceEpoch(ts.ctx, ts.keepers)
+		pairingList, err = ts.keepers.Pairing.GetPairingForClient(sdk.UnwrapSDKContext(ts.ctx), ts.spec.GetIndex(), ts.clients[0].Addr)
+		require.Nil(t, err)
+		require.Equal(t, providersNum-1, len(pairingList))
+		require.NotEqual(t, providerToFreeze.Address, pairingList[0].Address)
+	}
+
+	// and returns without an unfreeze transaction
+	ts.ctx = testkeeper.AdvanceEpoch(ts.ctx, ts.keepers)
+	require.Equal(t, expectedReturn, uint64(sdk.UnwrapSDKContext(ts.ctx).BlockHeight()))
+	pairingList, err = ts.keepers.Pairing.GetPairingForClient(sdk.UnwrapSDKContext(ts.ctx), ts.spec.GetIndex(), ts.clients[0].Addr)
+	require.Nil(t, err)
+	require.Equal(t, providersNum, len(pairingList))
+
+	frozenProviders, err = ts.keepers.Pairing.FrozenProviders(ts.ctx, &types.QueryFrozenProvidersRequest{ChainID: ts.spec.GetIndex()})
+	require.Nil(t, err)
+	require.Len(t, frozenProviders.FrozenProviders, 0)
+	_, found := ts.keepers.Pairing.GetProviderFreeze(sdk.UnwrapSDKContext(ts.ctx), ts.spec.GetIndex(), providerToFreeze.Address)
+	require.False(t, found)
+}
@@ -2,7 +2,6 @@ package keeper
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"strconv"
 
@@ -56,6 +55,16 @@ func (k msgServer) RelayPayment(goCtx context.Context, msg *types.MsgRelayPaymen
 		if !providerAddr.Equals(creator) {
 			return errorLogAndFormat("relay_payment_addr", map[string]string{"provider": relay.Provider, "creator": msg.Creator}, "invalid provider address in relay msg, creator and signed provider mismatch")
 		}
+		var badgeUserAddr sdk.AccAddress
+		if relay.Badge != nil {
+			// relays of a badge are paired and paid as relays of the project developer that granted it
+			badgeUserAddr = clientAddr
+			clientAddr, err = sigs.VerifyRelayBadge(*relay, badgeUserAddr)
+			if err != nil {
+				details := map[string]string{"badgeUser": badgeUserAddr.String(), "provider": providerAddr.String(), "error": err.Error()}
+				return errorLogAndFormat("relay_payment_badge", details, "invalid badge on proof of relay")
+			}
+		}
 
 		// TODO: add support for spec changes
 		spec, found := k.specKeeper.GetSpec(ctx, relay.SpecId)
@@ -79,6 +88,16 @@ func (k msgServer) RelayPayment(goCtx context.Context, msg *types.MsgRelayPaymen
 			return errorLogAndFormat("relay_payment_pairing", details, "invalid pairing claim on proof of relay")
 		}
 
+		allowedApis, addOns, err := k.getClientAllowedApis(ctx, clientAddr, relay.SpecId, uint64(relay.Epoch))
+		if err != nil {
+			details := map[string]string{"client": clientAddr.String(), "provider": providerAddr.String(), "error": err.Error()}
+			return errorLogAndFormat("relay_payment_allowed_apis", details, "failed getting the allowed apis of the client")
+		}
+		if !isRelayApiAllowed(spec, relay.ApiName, relay.ProtocolVersion, allowedApis, addOns) {
+			details := map[string]string{"client": clientAddr.String(), "provider": providerAddr.String(), "chainID": relay.SpecId, "api": relay.ApiName}
+			return errorLogAndFormat("relay_payment_api_not_allowed", details, "relay api is not allowed by the policies or the add-ons of the client's project")
+		}
+
 		epochStart, _, err := k.epochStorageKeeper.GetEpochStartForBlock(ctx, uint64(relay.Epoch))
 		if err != nil {
 			details := map[string]string{"epoch": strconv.FormatUint(epochStart, 10), "block": strconv.FormatUint(uint64(relay.Epoch), 10), "error": err.Error()}
@@ -171,7 +190,22 @@ func (k msgServer) RelayPayment(goCtx context.Context, msg *types.MsgRelayPaymen
 			return errorLogAndFormat("relay_payment_claim", details, "double spending detected")
 		}
 
-		err = k.Keeper.EnforceClientCUsUsageInEpoch(ctx, allowedCU, totalCUInEpochForUserProvider, clientAddr, relay.SpecId, uint64(relay.Epoch))
+		if badgeUserAddr != nil {
+			_, err = k.Keeper.AddBadgeUsedCu(ctx, epochStart, badgeUserAddr, providerAddr, relay.CuSum, relay.Badge.CuAllocation)
+			if err != nil {
+				details := map[string]string{"epoch": strconv.FormatUint(epochStart, 10), "client": clientAddr.String(), "badgeUser": badgeUserAddr.String(), "provider": providerAddr.String(), "error": err.Error()}
+				return errorLogAndFormat("relay_payment_badge_cu", details, "badge user bypassed the badge cu allocation")
+			}
+		}
+
+		// a consumer that signed this session to another provider too is penalized, the relay is still paid as the provider served it
+		err = k.Keeper.DetectClientSessionDoubleSpend(ctx, clientAddr, providerAddr, relay.SpecId, epochStart, relay.SessionId, legacy)
+		if err != nil {
+			details := map[string]string{"epoch": strconv.FormatUint(epochStart, 10), "client": clientAddr.String(), "provider": providerAddr.String(), "error": err.Error(), "unique_ID": strconv.FormatUint(relay.SessionId, 16)}
+			return errorLogAndFormat("relay_payment_session_double_spend", details, "failed penalizing user that signed a session to several providers")
+		}
+
+		err = k.Keeper.EnforceClientCUsUsageInEpoch(ctx, allowedCU, totalCUInEpochForUserProvider, relay.CuSum, clientAddr, relay.SpecId, uint64(relay.Epoch))
 		if err != nil {
 			// TODO: maybe give provider money but burn user, colluding?
 			// TODO: display correct totalCU and usedCU for provider
@@ -184,7 +218,16 @@ func (k msgServer) RelayPayment(goCtx context.Context, msg *types.MsgRelayPaymen
 				"cuToPay":                       strconv.FormatUint(relay.CuSum, 10),
 				"totalCUInEpochForUserProvider": strconv.FormatUint(totalCUInEpochForUserProvider, 10),
 			}
-			return errorLogAndFormat("relay_payment_user_limit", details, "user bypassed CU limit")
+			if k.Keeper.ClientOveruseJailThreshold(ctx) == 0 {
+				return errorLogAndFormat("relay_payment_user_limit", details, "user bypassed CU limit")
+			}
+			// when clients are jailed for overuse the relay isn't paid and the client is penalized, the other relays of the provider are still paid
+			err = k.Keeper.LimitClientPairingsAndMarkForPenalty(ctx, clientAddr, relay.SpecId, epochStart, legacy)
+			if err != nil {
+				details["error"] = err.Error()
+				return errorLogAndFormat("relay_payment_user_limit", details, "failed penalizing user that bypassed CU limit")
+			}
+			continue
 		}
 
 		// pairing is valid, we can pay provider for work
@@ -208,6 +251,7 @@ func (k msgServer) RelayPayment(goCtx context.Context, msg *types.MsgRelayPaymen
 			}
 			details["QoSReport"] = "Latency: " + relay.QosReport.Latency.String() + ", Availability: " + relay.QosReport.Availability.String() + ", Sync: " + relay.QosReport.Sync.String()
 			details["QoSScore"] = QoS.String()
+			k.addProviderQoSReport(ctx, epochStart, relay.SpecId, providerAddr.String(), relay.QosReport)
 
 			reward = reward.Mul(QoS.Mul(k.QoSWeight(ctx)).Add(sdk.OneDec().Sub(k.QoSWeight(ctx)))) // reward*QOSScore*QOSWeight + reward*(1-QOSWeight) = reward*(QOSScore*QOSWeight + (1-QOSWeight))
 			rewardCoins = sdk.Coins{sdk.Coin{Denom: epochstoragetypes.TokenDenom, Amount: reward.TruncateInt()}}
@@ -244,8 +288,14 @@ func (k msgServer) RelayPayment(goCtx context.Context, msg *types.MsgRelayPaymen
 			details["Mint"] = details["BasePay"]
 		}
 
-		// Mint to module
-		if !rewardCoins.AmountOf(epochstoragetypes.TokenDenom).IsZero() {
+		if withholdsProviderReward(spec, providerAddr.String()) {
+			// the provider's qos score in the epoch is final only once the epoch can't be claimed anymore, the reward is paid then
+			// in proportion to the score when it is below the spec's minimum
+			k.withholdProviderReward(ctx, epochStart, relay.SpecId, providerAddr, reward)
+			details["withheldReward"] = rewardCoins.String()
+			details["Mint"] = sdk.Coins{}.String()
+		} else if !rewardCoins.AmountOf(epochstoragetypes.TokenDenom).IsZero() {
+			// Mint to module
 			err = k.Keeper.bankKeeper.MintCoins(ctx, types.ModuleName, rewardCoins)
 			if err != nil {
 				details["error"] = err.Error()
@@ -253,20 +303,21 @@ func (k msgServer) RelayPayment(goCtx context.Context, msg *types.MsgRelayPaymen
 				panic(fmt.Sprintf("module failed to mint coins to give to provider: %s", err))
 			}
 			//
-			// Send to provider
-			err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, providerAddr, rewardCoins)
+			// Send to provider and its delegators
+			delegatorsReward, err := k.distributeProviderReward(ctx, relay.SpecId, providerAddr, epochStart, rewardCoins[0])
 			if err != nil {
 				details["error"] = err.Error()
 				utils.LavaError(ctx, logger, types.RelayPaymentEventName, details, "SendCoinsFromModuleToAccount Failed,")
 				panic(fmt.Sprintf("failed to transfer minted new coins to provider, %s account: %s", err, providerAddr))
 			}
+			details["delegatorsReward"] = delegatorsReward.String()
 		}
 
 		details["relayNumber"] = strconv.FormatUint(relay.RelayNum, 10)
 		utils.LogLavaEvent(ctx, logger, types.RelayPaymentEventName, details, "New Proof Of Work Was Accepted")
 
 		if !legacy {
-			err = k.chargeComputeUnitsToProjectAndSubscription(ctx, clientAddr, relay)
+			err = k.chargeComputeUnitsToProjectAndSubscription(ctx, clientAddr, relay, epochStart)
 			if err != nil {
 				details["error"] = err.Error()
 				return errorLogAndFormat("relay_payment_failed", details, "")
@@ -292,8 +343,6 @@ func (k msgServer) RelayPayment(goCtx context.Context, msg *types.MsgRelayPaymen
 }
 
 func (k msgServer) updateProviderPaymentStorageWithComplainerCU(ctx sdk.Context, unresponsiveData []byte, logger log.Logger, epoch uint64, chainID string, cuSum uint64, servicersToPair uint64, clientAddr sdk.AccAddress) error {
-	var unresponsiveProviders []string
-
 	// check that unresponsiveData exists
 	if len(unresponsiveData) == 0 {
 		return nil
@@ -305,7 +354,7 @@ func (k msgServer) updateProviderPaymentStorageWithComplainerCU(ctx sdk.Context,
 	}
 
 	// unmarshal the byte array unresponsiveData to get a list of unresponsive providers Bech32 addresses
-	err := json.Unmarshal(unresponsiveData, &unresponsiveProviders)
+	unresponsiveProviders, err := types.ParseUnresponsiveProviders(unresponsiveData)
 	if err != nil {
 		return utils.LavaFormatError("unable to unmarshal unresponsive providers", err, []utils.Attribute{{Key: "UnresponsiveProviders", Value: unresponsiveData}, {Key: "dataLength", Value: len(unresponsiveData)}}...)
 	}
@@ -322,9 +371,9 @@ func (k msgServer) updateProviderPaymentStorageWithComplainerCU(ctx sdk.Context,
 	// iterate over the unresponsive providers list and update their complainers_total_cu
 	for _, unresponsiveProvider := range unresponsiveProviders {
 		// get provider address
-		sdkUnresponsiveProviderAddress, err := sdk.AccAddressFromBech32(unresponsiveProvider)
+		sdkUnresponsiveProviderAddress, err := sdk.AccAddressFromBech32(unresponsiveProvider.Address)
 		if err != nil { // if bad data was given, we cant parse it so we ignote it and continue this protects from spamming wrong information.
-			utils.LavaFormatError("unable to sdk.AccAddressFromBech32(unresponsive_provider)", err, utils.Attribute{Key: "unresponsive_provider_address", Value: unresponsiveProvider})
+			utils.LavaFormatError("unable to sdk.AccAddressFromBech32(unresponsive_provider)", err, utils.Attribute{Key: "unresponsive_provider_address", Value: unresponsiveProvider.Address})
 			continue
 		}
 
@@ -362,6 +411,24 @@ func (k msgServer) updateProviderPaymentStorageWithComplainerCU(ctx sdk.Context,
 
 		// set the final provider payment storage state including the complaints
 		k.SetProviderPaymentStorage(ctx, providerPaymentStorage)
+
+		// aggregate the complaint with the other consumers' complaints on the provider in this epoch
+		err = k.ReportUnresponsiveProvider(ctx, epoch, chainID, sdkUnresponsiveProviderAddress, clientAddr)
+		if err != nil {
+			utils.LavaFormatError("failed reporting unresponsive provider", err, utils.Attribute{Key: "unresponsive_provider_address", Value: unresponsiveProvider.Address}, utils.Attribute{Key: "chainID", Value: chainID})
+		}
+
+		details := map[string]string{
+			"provider":            unresponsiveProvider.Address,
+			"client":              clientAddr.String(),
+			"chainID":             chainID,
+			"epoch":               strconv.FormatUint(epoch, 10),
+			"max_cu_exceeded":     strconv.FormatUint(unresponsiveProvider.Errors.MaxComputeUnitsExceeded, 10),
+			"connection_failures": strconv.FormatUint(unresponsiveProvider.Errors.ConnectionFailures, 10),
+			"session_out_of_sync": strconv.FormatUint(unresponsiveProvider.Errors.SessionOutOfSync, 10),
+			"timeouts":            strconv.FormatUint(unresponsiveProvider.Errors.Timeouts, 10),
+		}
+		utils.LogLavaEvent(ctx, logger, types.ProviderUnresponsiveReportEventName, details, "Client reported an unresponsive provider")
 	}
 
 	return nil
@@ -392,7 +459,7 @@ func dataReliabilityByConsumer(vrfs []*types.VRFData) (dataReliabilityByConsumer
 	return dataReliabilityByConsumer, nil
 }
 
-func (k Keeper) chargeComputeUnitsToProjectAndSubscription(ctx sdk.Context, clientAddr sdk.AccAddress, relay *types.RelaySession) error {
+func (k Keeper) chargeComputeUnitsToProjectAndSubscription(ctx sdk.Context, clientAddr sdk.AccAddress, relay *types.RelaySession, epochStart uint64) error {
 	project, _, err := k.projectsKeeper.GetProjectForDeveloper(ctx, clientAddr.String(), uint64(relay.Epoch))
 	if err != nil {
 		return fmt.Errorf("failed to get project for client")
@@ -402,6 +469,7 @@ func (k Keeper) chargeComputeUnitsToProjectAndSubscription(ctx sdk.Context, clie
 	if err != nil {
 		return fmt.Errorf("failed to add CU to the project")
 	}
+	k.projectsKeeper.AddProjectUsage(ctx, project.Index, clientAddr.String(), relay.SpecId, epochStart, relay.CuSum)
 
 	err = k.subscriptionKeeper.ChargeComputeUnitsToSubscription(ctx, project.GetSubscription(), relay.CuSum)
 	if err != nil {
@@ -276,6 +276,167 @@ func TestRelayPaymentOverUse(t *testing.T) {
 	// require.Zero(t, balance)
 }
 
+func TestRelayPaymentOverUseJailsClient(t *testing.T) {
+	ts := setupForPaymentTest(t)
+
+	ts.spec = common.CreateMockSpec()
+	ts.keepers.Spec.SetSpec(sdk.UnwrapSDKContext(ts.ctx), ts.spec)
+	err := ts.addClient(1)
+	require.Nil(t, err)
+	err = ts.addProvider(1)
+	require.Nil(t, err)
+
+	// jail on the second overuse in an epoch and slash half of the client's stake
+	err = testkeeper.SimulateParamChange(sdk.UnwrapSDKContext(ts.ctx), ts.keepers.ParamsKeeper, types.ModuleName, string(types.KeyClientOveruseJailThreshold), "\"2\"")
+	require.Nil(t, err)
+	slashFractionBytes, _ := sdk.NewDecWithPrec(5, 1).MarshalJSON()
+	err = testkeeper.SimulateParamChange(sdk.UnwrapSDKContext(ts.ctx), ts.keepers.ParamsKeeper, types.ModuleName, string(types.KeyClientOveruseSlashFraction), string(slashFractionBytes))
+	require.Nil(t, err)
+	ts.ctx = testkeeper.AdvanceEpoch(ts.ctx, ts.keepers)
+
+	epoch := ts.keepers.Epochstorage.GetEpochStart(sdk.UnwrapSDKContext(ts.ctx))
+	entry, err := ts.keepers.Epochstorage.GetStakeEntryForClientEpoch(sdk.UnwrapSDKContext(ts.ctx), ts.spec.Name, ts.clients[0].Addr, epoch)
+	require.Nil(t, err)
+
+	maxcu, err := ts.keepers.Pairing.GetAllowedCUForBlock(sdk.UnwrapSDKContext(ts.ctx), uint64(sdk.UnwrapSDKContext(ts.ctx).BlockHeight()), entry)
+	require.Nil(t, err)
+
+	balance := ts.keepers.BankKeeper.GetBalance(sdk.UnwrapSDKContext(ts.ctx), ts.providers[0].Addr, epochstoragetypes.TokenDenom).Amount.Int64()
+	for i := 0; i < 2; i++ {
+		relaySession := common.BuildRelayRequest(ts.ctx, ts.providers[0].Addr.String(), []byte(ts.spec.Apis[0].Name), maxcu*2, ts.spec.Name, nil)
+		relaySession.SessionId = uint64(i + 1)
+		relaySession.Sig, err = sigs.SignRelay(ts.clients[0].SK, *relaySession)
+		require.Nil(t, err)
+
+		// an overusing relay isn't paid but doesn't fail the provider's tx
+		_, err = ts.servers.PairingServer.RelayPayment(ts.ctx, &types.MsgRelayPayment{Creator: ts.providers[0].Addr.String(), Relays: []*types.RelaySession{relaySession}})
+		require.Nil(t, err)
+		jailed := ts.keepers.Pairing.IsClientJailed(sdk.UnwrapSDKContext(ts.ctx), ts.spec.Name, ts.clients[0].Addr, uint64(sdk.UnwrapSDKContext(ts.ctx).BlockHeight()))
+		require.Equal(t, i == 1, jailed)
+	}
+	require.Equal(t, balance, ts.keepers.BankKeeper.GetBalance(sdk.UnwrapSDKContext(ts.ctx), ts.providers[0].Addr, epochstoragetypes.TokenDenom).Amount.Int64())
+
+	clientEntry, found, _ := ts.keepers.Epochstorage.GetStakeEntryByAddressCurrent(sdk.UnwrapSDKContext(ts.ctx), epochstoragetypes.ClientKey, ts.spec.Name, ts.clients[0].Addr)
+	require.True(t, found)
+	require.Equal(t, stake/2, clientEntry.Stake.Amount.Int64())
+
+	// the jailed client gets no pairing until the jail ends
+	_, err = ts.keepers.Pairing.GetPairingForClient(sdk.UnwrapSDKContext(ts.ctx), ts.spec.Name, ts.clients[0].Addr)
+	require.NotNil(t, err)
+	for i := uint64(0); i <= types.DefaultClientJailEpochs; i++ {
+		ts.ctx = testkeeper.AdvanceEpoch(ts.ctx, ts.keepers)
+	}
+	_, err = ts.keepers.Pairing.GetPairingForClient(sdk.UnwrapSDKContext(ts.ctx), ts.spec.Name, ts.clients[0].Addr)
+	require.Nil(t, err)
+}
+
+func TestRelayPaymentSessionDoubleSpendJailsClient(t *testing.T) {
+	ts := setupForPaymentTest(t)
+
+	// a second provider, both are paired with the client
+	err := ts.addProvider(1)
+	require.Nil(t, err)
+
+	// detect sessions signed to several providers and slash half of the client's stake
+	err = testkeeper.SimulateParamChange(sdk.UnwrapSDKContext(ts.ctx), ts.keepers.ParamsKeeper, types.ModuleName, string(types.KeyDoubleSpendJailEpochs), "\"1\"")
+	require.Nil(t, err)
+	slashFractionBytes, _ := sdk.NewDecWithPrec(5, 1).MarshalJSON()
+	err = testkeeper.SimulateParamChange(sdk.UnwrapSDKContext(ts.ctx), ts.keepers.ParamsKeeper, types.ModuleName, string(types.KeyClientOveruseSlashFraction), string(slashFractionBytes))
+	require.Nil(t, err)
+	ts.ctx = testkeeper.AdvanceEpoch(ts.ctx, ts.keepers)
+
+	cuSum := ts.spec.GetApis()[0].ComputeUnits * 10
+	burn := ts.keepers.Pairing.BurnCoinsPerCU(sdk.UnwrapSDKContext(ts.ctx)).MulInt64(int64(cuSum)).TruncateInt64()
+	for i, provider := range ts.providers {
+		balance := ts.keepers.BankKeeper.GetBalance(sdk.UnwrapSDKContext(ts.ctx), provider.Addr, epochstoragetypes.TokenDenom).Amount.Int64()
+
+		// the client signs the same session to both providers
+		relaySession := common.BuildRelayRequest(ts.ctx, provider.Addr.String(), []byte(ts.spec.Apis[0].Name), cuSum, ts.spec.Name, nil)
+		relaySession.Sig, err = sigs.SignRelay(ts.clients[0].SK, *relaySession)
+		require.Nil(t, err)
+
+		// the relay is paid either way
+		_, err = ts.servers.PairingServer.RelayPayment(ts.ctx, &types.MsgRelayPayment{Creator: provider.Addr.String(), Relays: []*types.RelaySession{relaySession}})
+		require.Nil(t, err)
+		require.Less(t, balance, ts.keepers.BankKeeper.GetBalance(sdk.UnwrapSDKContext(ts.ctx), provider.Addr, epochstoragetypes.TokenDenom).Amount.Int64())
+
+		jailed := ts.keepers.Pairing.IsClientJailed(sdk.UnwrapSDKContext(ts.ctx), ts.spec.Name, ts.clients[0].Addr, uint64(sdk.UnwrapSDKContext(ts.ctx).BlockHeight()))
+		require.Equal(t, i == 1, jailed)
+	}
+
+	// the client was slashed when the second provider claimed the session, before paying for it
+	clientEntry, found, _ := ts.keepers.Epochstorage.GetStakeEntryByAddressCurrent(sdk.UnwrapSDKContext(ts.ctx), epochstoragetypes.ClientKey, ts.spec.Name, ts.clients[0].Addr)
+	require.True(t, found)
+	require.Equal(t, (stake-burn)-(stake-burn)/2-burn, clientEntry.Stake.Amount.Int64())
+}
+
+func TestRelayPaymentDataReliabilitySessionsDontJailClient(t *testing.T) {
+	ts := setupForPaymentTest(t)
+
+	// a second provider, both are paired with the client
+	err := ts.addProvider(1)
+	require.Nil(t, err)
+
+	err = testkeeper.SimulateParamChange(sdk.UnwrapSDKContext(ts.ctx), ts.keepers.ParamsKeeper, types.ModuleName, string(types.KeyDoubleSpendJailEpochs), "\"1\"")
+	require.Nil(t, err)
+	ts.ctx = testkeeper.AdvanceEpoch(ts.ctx, ts.keepers)
+
+	// the client sends a data reliability relay to each provider in the same epoch, both under the data reliability session
+	cuSum := ts.spec.GetApis()[0].ComputeUnits * 10
+	for _, provider := range ts.providers {
+		relaySession := common.BuildRelayRequest(ts.ctx, provider.Addr.String(), []byte(ts.spec.Apis[0].Name), cuSum, ts.spec.Name, nil)
+		relaySession.SessionId = types.DataReliabilitySessionId
+		relaySession.Sig, err = sigs.SignRelay(ts.clients[0].SK, *relaySession)
+		require.Nil(t, err)
+
+		_, err = ts.servers.PairingServer.RelayPayment(ts.ctx, &types.MsgRelayPayment{Creator: provider.Addr.String(), Relays: []*types.RelaySession{relaySession}})
+		require.Nil(t, err)
+		require.False(t, ts.keepers.Pairing.IsClientJailed(sdk.UnwrapSDKContext(ts.ctx), ts.spec.Name, ts.clients[0].Addr, uint64(sdk.UnwrapSDKContext(ts.ctx).BlockHeight())))
+	}
+}
+
+func TestRelayPaymentJailedClientKeepsJailStart(t *testing.T) {
+	ts := setupForPaymentTest(t)
+
+	// a second provider, both are paired with the client
+	err := ts.addProvider(1)
+	require.Nil(t, err)
+
+	err = testkeeper.SimulateParamChange(sdk.UnwrapSDKContext(ts.ctx), ts.keepers.ParamsKeeper, types.ModuleName, string(types.KeyDoubleSpendJailEpochs), "\"2\"")
+	require.Nil(t, err)
+	ts.ctx = testkeeper.AdvanceEpoch(ts.ctx, ts.keepers)
+
+	// the client signs two sessions to both providers before it's jailed
+	cuSum := ts.spec.GetApis()[0].ComputeUnits * 10
+	relaySessions := map[uint64][]*types.RelaySession{}
+	for sessionID := uint64(1); sessionID <= 2; sessionID++ {
+		for _, provider := range ts.providers {
+			relaySession := common.BuildRelayRequest(ts.ctx, provider.Addr.String(), []byte(ts.spec.Apis[0].Name), cuSum, ts.spec.Name, nil)
+			relaySession.SessionId = sessionID
+			relaySession.Sig, err = sigs.SignRelay(ts.clients[0].SK, *relaySession)
+			require.Nil(t, err)
+			relaySessions[sessionID] = append(relaySessions[sessionID], relaySession)
+		}
+	}
+	payRelays := func(sessionID uint64) {
+		for i, provider := range ts.providers {
+			_, err = ts.servers.PairingServer.RelayPayment(ts.ctx, &types.MsgRelayPayment{Creator: provider.Addr.String(), Relays: []*types.RelaySession{relaySessions[sessionID][i]}})
+			require.Nil(t, err)
+		}
+	}
+
+	ts.ctx = testkeeper.AdvanceBlock(ts.ctx, ts.keepers)
+	jailBlock := uint64(sdk.UnwrapSDKContext(ts.ctx).BlockHeight())
+	payRelays(1)
+	require.True(t, ts.keepers.Pairing.IsClientJailed(sdk.UnwrapSDKContext(ts.ctx), ts.spec.Name, ts.clients[0].Addr, jailBlock))
+
+	// jailed again during its jail, the client is still jailed from the first jail's block
+	ts.ctx = testkeeper.AdvanceBlock(ts.ctx, ts.keepers)
+	payRelays(2)
+	require.True(t, ts.keepers.Pairing.IsClientJailed(sdk.UnwrapSDKContext(ts.ctx), ts.spec.Name, ts.clients[0].Addr, jailBlock))
+	require.True(t, ts.keepers.Pairing.IsClientJailed(sdk.UnwrapSDKContext(ts.ctx), ts.spec.Name, ts.clients[0].Addr, uint64(sdk.UnwrapSDKContext(ts.ctx).BlockHeight())))
+}
+
 func setupClientsAndProvidersForUnresponsiveness(t *testing.T, amountOfClients int, amountOfProviders int) (ts *testStruct) {
 	ts = &testStruct{
 		providers: make([]*common.Account, 0),
@@ -672,7 +833,6 @@ func TestRelayPaymentQoS(t *testing.T) {
 				burn := ts.keepers.Pairing.BurnCoinsPerCU(sdk.UnwrapSDKContext(ts.ctx)).MulInt64(int64(cuSum))
 				newStakeClient, _, _ := ts.keepers.Epochstorage.GetStakeEntryByAddressCurrent(sdk.UnwrapSDKContext(ts.ctx), epochstoragetypes.ClientKey, ts.spec.Index, ts.clients[0].Addr)
 				require.Equal(t, stakeClient.Stake.Amount.Int64()-burn.TruncateInt64(), newStakeClient.Stake.Amount.Int64())
-
 			} else {
 				require.NotNil(t, err)
 			}
@@ -680,6 +840,83 @@ func TestRelayPaymentQoS(t *testing.T) {
 	}
 }
 
+// Test that the relays of a provider on a spec with a minimum qos score are paid once the epoch can't be claimed anymore, in proportion
+// to the provider's qos score in the whole epoch when it is below the minimum, and that exempt providers are paid on claim
+func TestRelayPaymentMinQoS(t *testing.T) {
+	tests := []struct {
+		name           string
+		availabilities []sdk.Dec // of the relays claimed one after the other in the epoch
+		exempt         bool
+		reduced        bool
+	}{
+		{"AboveMinimum", []sdk.Dec{sdk.OneDec()}, false, false},
+		{"BelowMinimum", []sdk.Dec{sdk.NewDecWithPrec(5, 1)}, false, true},
+		{"BelowMinimumExempt", []sdk.Dec{sdk.NewDecWithPrec(5, 1)}, true, false},
+		{"ClaimedBeforeGoodReports", []sdk.Dec{sdk.NewDecWithPrec(5, 1), sdk.OneDec(), sdk.OneDec(), sdk.OneDec()}, false, false},
+		{"ClaimedBeforeBadReports", []sdk.Dec{sdk.OneDec(), sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(1, 1)}, false, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ts := setupForPaymentTest(t)
+			ts.spec = common.CreateMockSpec()
+			ts.spec.MinQosScorePercent = 80
+			err := ts.addClient(1)
+			require.Nil(t, err)
+			err = ts.addProvider(1)
+			require.Nil(t, err)
+			if tt.exempt {
+				ts.spec.QosExemptProviders = []string{ts.providers[0].Addr.String()}
+			}
+			ts.keepers.Spec.SetSpec(sdk.UnwrapSDKContext(ts.ctx), ts.spec)
+			ts.ctx = testkeeper.AdvanceEpoch(ts.ctx, ts.keepers)
+
+			balance := func() int64 {
+				return ts.keepers.BankKeeper.GetBalance(sdk.UnwrapSDKContext(ts.ctx), ts.providers[0].Addr, epochstoragetypes.TokenDenom).Amount.Int64()
+			}
+			initialBalance := balance()
+			cuSum := ts.spec.Apis[0].ComputeUnits * 10
+			qosWeight := ts.keepers.Pairing.QoSWeight(sdk.UnwrapSDKContext(ts.ctx))
+			want := sdk.ZeroDec()
+			availabilitySum := sdk.ZeroDec()
+			for i, availability := range tt.availabilities {
+				QoS := &types.QualityOfServiceReport{Latency: sdk.OneDec(), Availability: availability, Sync: sdk.OneDec()}
+				relaySession := common.BuildRelayRequest(ts.ctx, ts.providers[0].Addr.String(), []byte(ts.spec.Apis[0].Name), cuSum, ts.spec.Name, QoS)
+				relaySession.SessionId = uint64(i + 1)
+				relaySession.Sig, err = sigs.SignRelay(ts.clients[0].SK, *relaySession)
+				require.Nil(t, err)
+				_, err = ts.servers.PairingServer.RelayPayment(ts.ctx, &types.MsgRelayPayment{Creator: ts.providers[0].Addr.String(), Relays: []*types.RelaySession{relaySession}})
+				require.Nil(t, err)
+
+				score, err := QoS.ComputeQoS()
+				require.Nil(t, err)
+				relayReward := ts.keepers.Pairing.MintCoinsPerCU(sdk.UnwrapSDKContext(ts.ctx)).MulInt64(int64(cuSum))
+				want = want.Add(relayReward.Mul(score.Mul(qosWeight).Add(sdk.OneDec().Sub(qosWeight))))
+				availabilitySum = availabilitySum.Add(availability)
+			}
+
+			if tt.exempt {
+				require.Equal(t, initialBalance+want.TruncateInt64(), balance())
+				return
+			}
+			// the rewards are withheld while the epoch can be claimed, and paid once it is out of the claim window
+			claimedEpoch := ts.keepers.Epochstorage.GetEpochStart(sdk.UnwrapSDKContext(ts.ctx))
+			for ts.keepers.Epochstorage.GetEarliestEpochStart(sdk.UnwrapSDKContext(ts.ctx)) <= claimedEpoch {
+				require.Equal(t, initialBalance, balance())
+				ts.ctx = testkeeper.AdvanceEpoch(ts.ctx, ts.keepers)
+			}
+
+			if tt.reduced {
+				averageReport := types.QualityOfServiceReport{Latency: sdk.OneDec(), Availability: availabilitySum.QuoInt64(int64(len(tt.availabilities))), Sync: sdk.OneDec()}
+				epochScore, err := averageReport.ComputeQoS()
+				require.Nil(t, err)
+				want = want.Mul(epochScore.Quo(sdk.NewDecWithPrec(80, 2)))
+			}
+			require.Equal(t, initialBalance+want.TruncateInt64(), balance())
+		})
+	}
+}
+
 // Data Reliability test for field corruption
 func TestRelayPaymentDataReliability(t *testing.T) {
 	tests := []struct {
@@ -1239,7 +1476,7 @@ func TestCuUsageInProjectsAndSubscription(t *testing.T) {
 	projectAdmin1 := ts.clients[0].Addr.String()
 	projectAdmin2 := ts.clients[1].Addr.String()
 
-	err = subkeeper.CreateSubscription(_ctx, subscriptionOwner, subscriptionOwner, ts.plan.Index, 1, "")
+	err = subkeeper.CreateSubscription(_ctx, subscriptionOwner, subscriptionOwner, ts.plan.Index, 1, "", false)
 	require.Nil(t, err)
 
 	ts.ctx = testkeeper.AdvanceEpoch(ts.ctx, ts.keepers)
@@ -1297,8 +1534,215 @@ func TestCuUsageInProjectsAndSubscription(t *testing.T) {
 
 	require.Equal(t, sub.MonthCuTotal-sub.MonthCuLeft, proj1.UsedCu)
 
+	// the relay's cu is reported for the developer key and chain in the project's usage
+	usage, err := projKeeper.ProjectUsage(ts.ctx, &projecttypes.QueryProjectUsageRequest{Project: proj1.Index})
+	require.Nil(t, err)
+	require.Equal(t, proj1.UsedCu, usage.TotalCu)
+	require.Equal(t, []projecttypes.UsageSummary{{Id: projectAdmin1, UsedCu: cuSum}}, usage.DeveloperKeys)
+	require.Equal(t, []projecttypes.UsageSummary{{Id: ts.spec.Name, UsedCu: cuSum}}, usage.Chains)
+
 	proj2, _, err := projKeeper.GetProjectForDeveloper(_ctx, projectAdmin2, uint64(_ctx.BlockHeight()))
 	require.Nil(t, err)
 
 	require.NotEqual(t, sub.MonthCuTotal-sub.MonthCuLeft, proj2.UsedCu)
 }
+
+func TestChainPolicyCuLimitInProjects(t *testing.T) {
+	ts := setupForPaymentTest(t)
+	_ctx := sdk.UnwrapSDKContext(ts.ctx)
+	subkeeper := ts.keepers.Subscription
+
+	err := ts.addProvider(1)
+	require.Nil(t, err)
+
+	subscriptionOwner := ts.providers[0].Addr.String()
+	err = subkeeper.CreateSubscription(_ctx, subscriptionOwner, subscriptionOwner, ts.plan.Index, 1, "", false)
+	require.Nil(t, err)
+
+	ts.ctx = testkeeper.AdvanceEpoch(ts.ctx, ts.keepers)
+
+	// the project can use 10 cu per epoch on the chain, out of the 100 cu per epoch of its policy
+	projectData := projecttypes.ProjectData{
+		Name:        "proj1",
+		Description: "description",
+		Enabled:     true,
+		ProjectKeys: []projecttypes.ProjectKey{{
+			Key:   ts.clients[0].Addr.String(),
+			Types: []projecttypes.ProjectKey_KEY_TYPE{projecttypes.ProjectKey_DEVELOPER},
+		}},
+		Policy: &projecttypes.Policy{
+			ChainPolicies:      []projecttypes.ChainPolicy{{ChainId: ts.spec.Index, EpochCuLimit: 10}},
+			GeolocationProfile: uint64(1),
+			MaxProvidersToPair: 3,
+			TotalCuLimit:       1000,
+			EpochCuLimit:       100,
+		},
+	}
+	err = subkeeper.AddProjectToSubscription(sdk.UnwrapSDKContext(ts.ctx), subscriptionOwner, projectData)
+	require.Nil(t, err)
+
+	// each provider is within the chain's limit, together they exceed it
+	for i, provider := range ts.providers {
+		relaySession := common.BuildRelayRequest(ts.ctx, provider.Addr.String(), []byte(ts.spec.Apis[0].Name), 6, ts.spec.Name, nil)
+		relaySession.SessionId = uint64(i + 1)
+		relaySession.Sig, err = sigs.SignRelay(ts.clients[0].SK, *relaySession)
+		require.Nil(t, err)
+
+		relayPaymentMessage := types.MsgRelayPayment{Creator: provider.Addr.String(), Relays: []*types.RelaySession{relaySession}}
+		payAndVerifyBalance(t, ts, relayPaymentMessage, i == 0, ts.clients[0].Addr, provider.Addr)
+	}
+
+	// the chain's limit is per epoch
+	ts.ctx = testkeeper.AdvanceEpoch(ts.ctx, ts.keepers)
+	relaySession := common.BuildRelayRequest(ts.ctx, ts.providers[1].Addr.String(), []byte(ts.spec.Apis[0].Name), 6, ts.spec.Name, nil)
+	relaySession.Sig, err = sigs.SignRelay(ts.clients[0].SK, *relaySession)
+	require.Nil(t, err)
+	relayPaymentMessage := types.MsgRelayPayment{Creator: ts.providers[1].Addr.String(), Relays: []*types.RelaySession{relaySession}}
+	payAndVerifyBalance(t, ts, relayPaymentMessage, true, ts.clients[0].Addr, ts.providers[1].Addr)
+}
+
+func TestAllowedApisInProjects(t *testing.T) {
+	ts := setupForPaymentTest(t)
+	_ctx := sdk.UnwrapSDKContext(ts.ctx)
+	subkeeper := ts.keepers.Subscription
+
+	subscriptionOwner := ts.providers[0].Addr.String()
+	err := subkeeper.CreateSubscription(_ctx, subscriptionOwner, subscriptionOwner, ts.plan.Index, 1, "", false)
+	require.Nil(t, err)
+
+	ts.ctx = testkeeper.AdvanceEpoch(ts.ctx, ts.keepers)
+
+	allowedApi := ts.spec.Apis[0].Name
+	projectData := projecttypes.ProjectData{
+		Name:        "proj1",
+		Description: "description",
+		Enabled:     true,
+		ProjectKeys: []projecttypes.ProjectKey{{
+			Key:   ts.clients[0].Addr.String(),
+			Types: []projecttypes.ProjectKey_KEY_TYPE{projecttypes.ProjectKey_DEVELOPER},
+		}},
+		Policy: &projecttypes.Policy{
+			GeolocationProfile: uint64(1),
+			MaxProvidersToPair: 3,
+			TotalCuLimit:       1000,
+			EpochCuLimit:       100,
+			AllowedApis:        []string{allowedApi},
+		},
+	}
+	err = subkeeper.AddProjectToSubscription(sdk.UnwrapSDKContext(ts.ctx), subscriptionOwner, projectData)
+	require.Nil(t, err)
+
+	pairing, err := ts.keepers.Pairing.GetPairing(ts.ctx, &types.QueryGetPairingRequest{ChainID: ts.spec.Index, Client: ts.clients[0].Addr.String()})
+	require.Nil(t, err)
+	require.Equal(t, []string{allowedApi}, pairing.AllowedApis)
+
+	for i, tt := range []struct {
+		name            string
+		apiName         string
+		protocolVersion uint32
+		valid           bool
+	}{
+		{name: "unsigned api of a legacy consumer", apiName: "", protocolVersion: 0, valid: true},
+		{name: "unsigned api of an older protocol version", apiName: "", protocolVersion: types.RelayApiNameProtocolVersion - 1, valid: true},
+		{name: "unsigned api", apiName: "", protocolVersion: types.RelayApiNameProtocolVersion, valid: false},
+		{name: "api not in the allowed apis", apiName: "otherAPI", protocolVersion: types.RelayApiNameProtocolVersion, valid: false},
+		{name: "allowed api", apiName: allowedApi, protocolVersion: types.RelayApiNameProtocolVersion, valid: true},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			relaySession := common.BuildRelayRequest(ts.ctx, ts.providers[0].Addr.String(), []byte(allowedApi), 6, ts.spec.Name, nil)
+			relaySession.SessionId = uint64(i + 1)
+			relaySession.ApiName = tt.apiName
+			relaySession.ProtocolVersion = tt.protocolVersion
+			relaySession.Sig, err = sigs.SignRelay(ts.clients[0].SK, *relaySession)
+			require.Nil(t, err)
+
+			relayPaymentMessage := types.MsgRelayPayment{Creator: ts.providers[0].Addr.String(), Relays: []*types.RelaySession{relaySession}}
+			payAndVerifyBalance(t, ts, relayPaymentMessage, tt.valid, ts.clients[0].Addr, ts.providers[0].Addr)
+		})
+	}
+}
+
+func TestAddOnsInProjects(t *testing.T) {
+	ts := setupForPaymentTest(t)
+	subkeeper := ts.keepers.Subscription
+
+	// an api of the spec is only granted by the archive add-on of the plan
+	archiveApi := ts.spec.Apis[0]
+	archiveApi.Name = "archiveAPI"
+	archiveApi.AddOn = "archive"
+	ts.spec.Apis = append(ts.spec.Apis, archiveApi)
+	ts.keepers.Spec.SetSpec(sdk.UnwrapSDKContext(ts.ctx), ts.spec)
+
+	ts.plan.AddOns = []plantypes.AddOn{{Name: "archive", Price: sdk.NewCoin(epochstoragetypes.TokenDenom, sdk.NewInt(10))}}
+	err := ts.keepers.Plans.AddPlan(sdk.UnwrapSDKContext(ts.ctx), ts.plan)
+	require.Nil(t, err)
+
+	subscriptionOwner := ts.providers[0].Addr.String()
+	err = subkeeper.CreateSubscription(sdk.UnwrapSDKContext(ts.ctx), subscriptionOwner, subscriptionOwner, ts.plan.Index, 1, "", false)
+	require.Nil(t, err)
+
+	ts.ctx = testkeeper.AdvanceEpoch(ts.ctx, ts.keepers)
+
+	projectData := projecttypes.ProjectData{
+		Name:        "proj1",
+		Description: "description",
+		Enabled:     true,
+		ProjectKeys: []projecttypes.ProjectKey{{
+			Key:   ts.clients[0].Addr.String(),
+			Types: []projecttypes.ProjectKey_KEY_TYPE{projecttypes.ProjectKey_DEVELOPER},
+		}},
+		Policy: &projecttypes.Policy{
+			GeolocationProfile: uint64(1),
+			MaxProvidersToPair: 3,
+			TotalCuLimit:       1000,
+			EpochCuLimit:       100,
+		},
+	}
+	err = subkeeper.AddProjectToSubscription(sdk.UnwrapSDKContext(ts.ctx), subscriptionOwner, projectData)
+	require.Nil(t, err)
+
+	relayAndPay := func(sessionID uint64, apiName string, valid bool) {
+		relaySession := common.BuildRelayRequest(ts.ctx, ts.providers[0].Addr.String(), []byte(apiName), 6, ts.spec.Name, nil)
+		relaySession.SessionId = sessionID
+		relaySession.ApiName = apiName
+		relaySession.ProtocolVersion = types.RelayApiNameProtocolVersion
+		relaySession.Sig, err = sigs.SignRelay(ts.clients[0].SK, *relaySession)
+		require.Nil(t, err)
+
+		relayPaymentMessage := types.MsgRelayPayment{Creator: ts.providers[0].Addr.String(), Relays: []*types.RelaySession{relaySession}}
+		payAndVerifyBalance(t, ts, relayPaymentMessage, valid, ts.clients[0].Addr, ts.providers[0].Addr)
+	}
+
+	pairing, err := ts.keepers.Pairing.GetPairing(ts.ctx, &types.QueryGetPairingRequest{ChainID: ts.spec.Index, Client: ts.clients[0].Addr.String()})
+	require.Nil(t, err)
+	require.Empty(t, pairing.AddOns)
+
+	// base apis are paid, apis of an add-on the subscription didn't buy aren't
+	relayAndPay(1, ts.spec.Apis[0].Name, true)
+	relayAndPay(2, archiveApi.Name, false)
+
+	err = subkeeper.BuyAddOn(sdk.UnwrapSDKContext(ts.ctx), subscriptionOwner, subscriptionOwner, "archive")
+	require.Nil(t, err)
+
+	// the provider doesn't serve the add-on so it's not paired anymore
+	pairing, err = ts.keepers.Pairing.GetPairing(ts.ctx, &types.QueryGetPairingRequest{ChainID: ts.spec.Index, Client: ts.clients[0].Addr.String()})
+	require.Nil(t, err)
+	require.Equal(t, []string{"archive"}, pairing.AddOns)
+	require.Empty(t, pairing.Providers)
+	relayAndPay(3, archiveApi.Name, false)
+
+	// the provider serves the add-on from the next epoch
+	providerAddr := ts.providers[0].Addr
+	stakeEntry, found, index := ts.keepers.Epochstorage.GetStakeEntryByAddressCurrent(sdk.UnwrapSDKContext(ts.ctx), epochstoragetypes.ProviderKey, ts.spec.Index, providerAddr)
+	require.True(t, found)
+	stakeEntry.Endpoints[0].AddOns = []string{"archive"}
+	ts.keepers.Epochstorage.ModifyStakeEntryCurrent(sdk.UnwrapSDKContext(ts.ctx), epochstoragetypes.ProviderKey, ts.spec.Index, stakeEntry, index)
+	ts.ctx = testkeeper.AdvanceEpoch(ts.ctx, ts.keepers)
+
+	pairing, err = ts.keepers.Pairing.GetPairing(ts.ctx, &types.QueryGetPairingRequest{ChainID: ts.spec.Index, Client: ts.clients[0].Addr.String()})
+	require.Nil(t, err)
+	require.Len(t, pairing.Providers, 1)
+	require.Equal(t, providerAddr.String(), pairing.Providers[0].Address)
+
+	relayAndPay(4, archiveApi.Name, true)
+}
@@ -0,0 +1,23 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/x/pairing/types"
+)
+
+func (k msgServer) SetProviderMetadata(goCtx context.Context, msg *types.MsgSetProviderMetadata) (*types.MsgSetProviderMetadataResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	metadata := types.ProviderMetadata{
+		Provider:    msg.Creator,
+		Moniker:     msg.Moniker,
+		Website:     msg.Website,
+		Description: msg.Description,
+		Contact:     msg.Contact,
+		Addons:      msg.Addons,
+	}
+	err := k.Keeper.UpdateProviderMetadata(ctx, metadata)
+	return &types.MsgSetProviderMetadataResponse{}, err
+}
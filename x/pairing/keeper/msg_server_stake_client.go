@@ -11,7 +11,7 @@ func (k msgServer) StakeClient(goCtx context.Context, msg *types.MsgStakeClient)
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
 	// stakes a new client entry
-	err := k.Keeper.StakeNewEntry(ctx, false, msg.Creator, msg.ChainID, msg.Amount, nil, msg.Geolocation, msg.Vrfpk, "")
+	err := k.Keeper.StakeNewEntry(ctx, false, msg.Creator, msg.ChainID, msg.Amount, nil, msg.Geolocation, msg.Vrfpk, "", 0)
 
 	return &types.MsgStakeClientResponse{}, err
 }
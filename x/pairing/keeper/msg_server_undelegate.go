@@ -0,0 +1,15 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/x/pairing/types"
+)
+
+func (k msgServer) Undelegate(goCtx context.Context, msg *types.MsgUndelegate) (*types.MsgUndelegateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := k.Keeper.Undelegate(ctx, msg.Creator, msg.Provider, msg.ChainID, msg.Amount)
+	return &types.MsgUndelegateResponse{}, err
+}
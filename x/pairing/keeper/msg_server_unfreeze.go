@@ -25,10 +25,16 @@ func (k msgServer) UnfreezeProvider(goCtx context.Context, msg *types.MsgUnfreez
 			return nil, utils.LavaFormatError("Unfreeze_cant_get_stake_entry", types.FreezeStakeEntryNotFoundError, []utils.Attribute{{Key: "chainID", Value: chainId}, {Key: "providerAddress", Value: msg.GetCreator()}}...)
 		}
 
+		if k.IsProviderJailed(ctx, chainId, providerAddr, current_block) {
+			// a jailed provider stays frozen until its jail ends
+			continue
+		}
+
 		if stakeEntry.StakeAppliedBlock > current_block {
 			// unfreeze the provider by making the StakeAppliedBlock the current block. This will let the provider be added to the pairing list in the next epoch, when current entries becomes the front of epochStorage
 			stakeEntry.StakeAppliedBlock = current_block
 			k.epochStorageKeeper.ModifyStakeEntryCurrent(ctx, epochstoragetypes.ProviderKey, chainId, stakeEntry, index)
+			k.RemoveProviderFreeze(ctx, chainId, msg.GetCreator())
 			unfrozen_chains = append(unfrozen_chains, chainId)
 		}
 		// else case does not throw an error because we don't want to fail unfreezing other chains
@@ -10,9 +10,11 @@ import (
 	commontypes "github.com/lavanet/lava/common/types"
 	"github.com/lavanet/lava/utils"
 	epochstoragetypes "github.com/lavanet/lava/x/epochstorage/types"
+	"github.com/lavanet/lava/x/pairing/types"
 	projectstypes "github.com/lavanet/lava/x/projects/types"
 	spectypes "github.com/lavanet/lava/x/spec/types"
 	tendermintcrypto "github.com/tendermint/tendermint/crypto"
+	"golang.org/x/exp/slices"
 )
 
 const INVALID_INDEX = -2
@@ -98,86 +100,186 @@ func (k Keeper) GetPairingForClient(ctx sdk.Context, chainID string, clientAddre
 	return providers, err
 }
 
+// SimulatePairingForClient returns the pairing of the client epochOffset epochs after the current epoch and that epoch's start block.
+// The pairing of the current epoch (offset 0) is exact, a later pairing is computed from the current provider stakes with the current
+// epoch hash standing in for the future one, so it holds only if the stakes don't change and the hash-based selection matches
+func (k Keeper) SimulatePairingForClient(ctx sdk.Context, chainID string, clientAddress sdk.AccAddress, epochOffset uint64) (providers []epochstoragetypes.StakeEntry, epoch uint64, errorRet error) {
+	currentEpoch := k.epochStorageKeeper.GetEpochStart(ctx)
+	if epochOffset == 0 {
+		providers, err := k.GetPairingForClient(ctx, chainID, clientAddress)
+		return providers, currentEpoch, err
+	}
+
+	block := uint64(ctx.BlockHeight())
+	epochBlocks, err := k.epochStorageKeeper.EpochBlocks(ctx, block)
+	if err != nil {
+		return nil, 0, err
+	}
+	// the offset comes from the query so the simulated epoch's block may not fit a block height
+	simulatedEpoch := sdk.NewIntFromUint64(epochOffset).Mul(sdk.NewIntFromUint64(epochBlocks)).Add(sdk.NewIntFromUint64(currentEpoch))
+	if !simulatedEpoch.IsInt64() {
+		return nil, 0, fmt.Errorf("epoch offset %d is too far in the future", epochOffset)
+	}
+	epoch = simulatedEpoch.Uint64()
+
+	if k.IsClientJailed(ctx, chainID, clientAddress, epoch) {
+		return nil, epoch, fmt.Errorf("client %s is jailed for exceeding its allowed cu on %s at block %d", clientAddress, chainID, epoch)
+	}
+
+	geolocation, providersToPair, projectToPair, apiInterfaces, addOns, _, _, _, err := k.getClientPairingPolicy(ctx, chainID, clientAddress, block, currentEpoch)
+	if err != nil {
+		return nil, epoch, err
+	}
+
+	_, _, epochHash := k.epochStorageKeeper.GetEpochStakeEntries(ctx, currentEpoch, epochstoragetypes.ProviderKey, chainID)
+	stakeStorage, found := k.epochStorageKeeper.GetStakeStorageCurrent(ctx, epochstoragetypes.ProviderKey, chainID)
+	if !found {
+		return nil, epoch, fmt.Errorf("did not find providers for pairing: chainID: %s", chainID)
+	}
+
+	// pair as if at the start of the simulated epoch so entries whose stake applies by then are considered
+	simulationCtx := ctx.WithBlockHeight(int64(epoch))
+	providers, err = k.calculatePairingForClient(simulationCtx, stakeStorage.GetStakeEntries(), projectToPair, epoch, chainID, geolocation, apiInterfaces, addOns, epochHash, providersToPair)
+	return providers, epoch, err
+}
+
 // function used to get a new pairing from provider and client
 // first argument has all metadata, second argument is only the addresses
 func (k Keeper) getPairingForClient(ctx sdk.Context, chainID string, clientAddress sdk.AccAddress, block uint64) (providers []epochstoragetypes.StakeEntry, vrfk string, allowedCU uint64, legacyStake bool, errorRet error) {
-	var geolocation uint64
-	var providersToPair uint64
-	var projectToPair string
-
 	epoch, err := k.VerifyPairingData(ctx, chainID, clientAddress, block)
 	if err != nil {
 		return nil, "", 0, false, fmt.Errorf("invalid pairing data: %s", err)
 	}
 
+	if k.IsClientJailed(ctx, chainID, clientAddress, block) {
+		return nil, "", 0, false, fmt.Errorf("client %s is jailed for exceeding its allowed cu on %s at block %d", clientAddress, chainID, block)
+	}
+
+	geolocation, providersToPair, projectToPair, apiInterfaces, addOns, vrfk, allowedCU, legacyStake, err := k.getClientPairingPolicy(ctx, chainID, clientAddress, block, epoch)
+	if err != nil {
+		return nil, "", 0, false, err
+	}
+
+	possibleProviders, found, epochHash := k.epochStorageKeeper.GetEpochStakeEntries(ctx, epoch, epochstoragetypes.ProviderKey, chainID)
+	if !found {
+		return nil, "", 0, false, fmt.Errorf("did not find providers for pairing: epoch:%d, chainID: %s", block, chainID)
+	}
+
+	providers, err = k.calculatePairingForClient(ctx, possibleProviders, projectToPair, block, chainID, geolocation, apiInterfaces, addOns, epochHash, providersToPair)
+
+	return providers, vrfk, allowedCU, legacyStake, err
+}
+
+// getClientPairingPolicy returns the pairing requirements of a client, from its project's policies or from its legacy stake entry,
+// apiInterfaces is nil when the providers aren't restricted by api interface and addOns are the add-ons the providers must serve
+func (k Keeper) getClientPairingPolicy(ctx sdk.Context, chainID string, clientAddress sdk.AccAddress, block uint64, epoch uint64) (geolocation uint64, providersToPair uint64, projectToPair string, apiInterfaces []string, addOns []string, vrfk string, allowedCU uint64, legacyStake bool, errorRet error) {
 	project, vrfpk_proj, err := k.GetProjectData(ctx, clientAddress, chainID, block)
 	if err == nil {
 		vrfk = vrfpk_proj
 		legacyStake = false
-		geolocation, providersToPair, projectToPair, allowedCU, err = k.getProjectStrictestPolicy(ctx, project, chainID)
-		if err != nil {
-			return nil, "", 0, false, fmt.Errorf("invalid user for pairing: %s", err.Error())
-		}
-	} else {
-		// legacy staked client
-		clientStakeEntry, err2 := k.VerifyClientStake(ctx, chainID, clientAddress, block, epoch)
-		if err2 != nil {
-			// user is not valid for pairing
-			return nil, "", 0, false, fmt.Errorf("invalid user for pairing: 1) %s 2) %s", err.Error(), err2.Error())
-		}
-		geolocation = clientStakeEntry.Geolocation
-
-		servicersToPairCount, err := k.ServicersToPairCount(ctx, block)
-		if err != nil {
-			return nil, "", 0, false, err
-		}
-
-		providersToPair = servicersToPairCount
-		projectToPair = clientAddress.String()
-		vrfk = clientStakeEntry.Vrfpk
-
-		allowedCU, err = k.ClientMaxCUProviderForBlock(ctx, block, clientStakeEntry)
+		geolocation, providersToPair, projectToPair, apiInterfaces, addOns, allowedCU, err = k.getProjectStrictestPolicy(ctx, project, chainID)
 		if err != nil {
-			return nil, "", 0, false, err
+			return 0, 0, "", nil, nil, "", 0, false, fmt.Errorf("invalid user for pairing: %s", err.Error())
 		}
+		return geolocation, providersToPair, projectToPair, apiInterfaces, addOns, vrfk, allowedCU, legacyStake, nil
+	}
 
-		legacyStake = true
+	// legacy staked client
+	clientStakeEntry, err2 := k.VerifyClientStake(ctx, chainID, clientAddress, block, epoch)
+	if err2 != nil {
+		// user is not valid for pairing
+		return 0, 0, "", nil, nil, "", 0, false, fmt.Errorf("invalid user for pairing: 1) %s 2) %s", err.Error(), err2.Error())
 	}
+	geolocation = clientStakeEntry.Geolocation
 
-	possibleProviders, found, epochHash := k.epochStorageKeeper.GetEpochStakeEntries(ctx, epoch, epochstoragetypes.ProviderKey, chainID)
-	if !found {
-		return nil, "", 0, false, fmt.Errorf("did not find providers for pairing: epoch:%d, chainID: %s", block, chainID)
+	servicersToPairCount, err := k.ServicersToPairCount(ctx, block)
+	if err != nil {
+		return 0, 0, "", nil, nil, "", 0, false, err
 	}
 
-	providers, err = k.calculatePairingForClient(ctx, possibleProviders, projectToPair, block, chainID, geolocation, epochHash, providersToPair)
+	providersToPair = servicersToPairCount
+	projectToPair = clientAddress.String()
+	vrfk = clientStakeEntry.Vrfpk
 
-	return providers, vrfk, allowedCU, legacyStake, err
+	allowedCU, err = k.ClientMaxCUProviderForBlock(ctx, block, clientStakeEntry)
+	if err != nil {
+		return 0, 0, "", nil, nil, "", 0, false, err
+	}
+
+	legacyStake = true
+	return geolocation, providersToPair, projectToPair, nil, nil, vrfk, allowedCU, legacyStake, nil
 }
 
-func (k Keeper) getProjectStrictestPolicy(ctx sdk.Context, project projectstypes.Project, chainID string) (uint64, uint64, string, uint64, error) {
+func (k Keeper) getProjectStrictestPolicy(ctx sdk.Context, project projectstypes.Project, chainID string) (uint64, uint64, string, []string, []string, uint64, error) {
 	plan, err := k.subscriptionKeeper.GetPlanFromSubscription(ctx, project.GetSubscription())
 	if err != nil {
-		return 0, 0, "", 0, err
+		return 0, 0, "", nil, nil, 0, err
 	}
 
 	planPolicy := plan.GetPlanPolicy()
 	policies := []*projectstypes.Policy{project.AdminPolicy, project.SubscriptionPolicy, &planPolicy}
 	if !projectstypes.CheckChainIdExistsInPolicies(chainID, policies) {
-		return 0, 0, "", 0, fmt.Errorf("chain ID not found in any of the policies")
+		return 0, 0, "", nil, nil, 0, fmt.Errorf("chain ID not found in any of the policies")
 	}
 
-	geolocation := k.CalculateEffectiveGeolocationFromPolicies(policies)
+	geolocation := projectstypes.GetChainGeolocation(chainID, policies, k.CalculateEffectiveGeolocationFromPolicies(policies))
 
 	providersToPair := k.CalculateEffectiveProvidersToPairFromPolicies(policies)
 
 	sub, found := k.subscriptionKeeper.GetSubscription(ctx, project.GetSubscription())
 	if !found {
-		return 0, 0, "", 0, fmt.Errorf("could not find subscription with address %s", project.GetSubscription())
+		return 0, 0, "", nil, nil, 0, fmt.Errorf("could not find subscription with address %s", project.GetSubscription())
+	}
+	allowedCU := k.CalculateEffectiveAllowedCuPerEpochFromPolicies(policies, project.GetUsedCu(), sub.ProjectCuLeft(project.Index, project.GetUsedCu()))
+	if chainEpochCuLimit, found := projectstypes.GetChainEpochCuLimit(chainID, policies); found && chainEpochCuLimit < allowedCU {
+		allowedCU = chainEpochCuLimit
 	}
-	allowedCU := k.CalculateEffectiveAllowedCuPerEpochFromPolicies(policies, project.GetUsedCu(), sub.GetMonthCuLeft())
+
+	apiInterfaces := projectstypes.GetChainApiInterfaces(chainID, policies)
 
 	projectToPair := project.Index
-	return geolocation, providersToPair, projectToPair, allowedCU, nil
+	return geolocation, providersToPair, projectToPair, apiInterfaces, sub.AddOns, allowedCU, nil
+}
+
+// getClientAllowedApis returns the apis the policies of the client's project allow it to relay, nil when the apis aren't restricted,
+// and the add-ons bought for the project's subscription. legacy staked clients aren't restricted and have nil add-ons
+func (k Keeper) getClientAllowedApis(ctx sdk.Context, clientAddress sdk.AccAddress, chainID string, block uint64) (allowedApis []string, addOns []string, err error) {
+	project, _, err := k.GetProjectData(ctx, clientAddress, chainID, block)
+	if err != nil {
+		return nil, nil, nil
+	}
+	plan, err := k.subscriptionKeeper.GetPlanFromSubscription(ctx, project.GetSubscription())
+	if err != nil {
+		return nil, nil, err
+	}
+	sub, found := k.subscriptionKeeper.GetSubscription(ctx, project.GetSubscription())
+	if !found {
+		return nil, nil, fmt.Errorf("could not find subscription with address %s", project.GetSubscription())
+	}
+	addOns = sub.AddOns
+	if addOns == nil {
+		addOns = []string{}
+	}
+	planPolicy := plan.GetPlanPolicy()
+	return projectstypes.GetAllowedApis([]*projectstypes.Policy{project.AdminPolicy, project.SubscriptionPolicy, &planPolicy}), addOns, nil
+}
+
+// isRelayApiAllowed returns whether the api a relay session was signed for is allowed, a session of a restricted client must name an api
+// of the spec and an api of an add-on needs the add-on (addOns is nil when they aren't restricted). consumers from before sessions
+// named their api sign neither the api nor their protocol version, only their sessions are allowed without an api name
+func isRelayApiAllowed(spec spectypes.Spec, apiName string, protocolVersion uint32, allowedApis []string, addOns []string) bool {
+	if apiName == "" {
+		return protocolVersion < types.RelayApiNameProtocolVersion || (allowedApis == nil && addOns == nil)
+	}
+	for _, api := range spec.Apis {
+		if api.Name == apiName {
+			if api.AddOn != "" && addOns != nil && !slices.Contains(addOns, api.AddOn) {
+				return false
+			}
+			return allowedApis == nil || projectstypes.IsApiAllowed(allowedApis, api.Name, api.Parsing.FunctionTag)
+		}
+	}
+	return allowedApis == nil
 }
 
 func (k Keeper) CalculateEffectiveGeolocationFromPolicies(policies []*projectstypes.Policy) uint64 {
@@ -248,7 +350,7 @@ func (k Keeper) ValidatePairingForClient(ctx sdk.Context, chainID string, client
 	return false, vrfk, INVALID_INDEX, allowedCU, 0, legacyStake, nil
 }
 
-func (k Keeper) calculatePairingForClient(ctx sdk.Context, providers []epochstoragetypes.StakeEntry, developerAddress string, epochStartBlock uint64, chainID string, geolocation uint64, epochHash []byte, providersToPair uint64) (validProviders []epochstoragetypes.StakeEntry, err error) {
+func (k Keeper) calculatePairingForClient(ctx sdk.Context, providers []epochstoragetypes.StakeEntry, developerAddress string, epochStartBlock uint64, chainID string, geolocation uint64, apiInterfaces []string, addOns []string, epochHash []byte, providersToPair uint64) (validProviders []epochstoragetypes.StakeEntry, err error) {
 	if epochStartBlock > uint64(ctx.BlockHeight()) {
 		k.Logger(ctx).Error("\ninvalid session start\n")
 		panic(fmt.Sprintf("invalid session start saved in keeper %d, current block was %d", epochStartBlock, uint64(ctx.BlockHeight())))
@@ -259,45 +361,192 @@ func (k Keeper) calculatePairingForClient(ctx sdk.Context, providers []epochstor
 		return nil, fmt.Errorf("spec not found or not enabled")
 	}
 
-	validProviders = k.getGeolocationProviders(ctx, providers, geolocation)
+	if apiInterfaces != nil {
+		providers = filterProvidersByApiInterfaces(providers, apiInterfaces)
+	}
+	if len(addOns) > 0 {
+		providers = filterProvidersByAddOns(providers, addOns)
+	}
+	providers = k.filterStaleProviders(ctx, providers, epochStartBlock, chainID)
+	providerScores, err := k.getProvidersPairingScores(ctx, spec, providers, geolocation, epochStartBlock)
+	if err != nil {
+		return nil, err
+	}
 
 	if spec.ProvidersTypes == spectypes.Spec_dynamic {
 		// calculates a hash and randomly chooses the providers
 
-		validProviders = k.returnSubsetOfProvidersByStake(ctx, developerAddress, validProviders, providersToPair, epochStartBlock, chainID, epochHash)
+		validProviders = k.returnSubsetOfProvidersByScore(ctx, developerAddress, providerScores, providersToPair, epochStartBlock, chainID, epochHash)
 	} else {
-		validProviders = k.returnSubsetOfProvidersByHighestStake(ctx, validProviders, providersToPair)
+		validProviders = k.returnSubsetOfProvidersByHighestStake(ctx, providerScores, providersToPair)
 	}
 
 	return validProviders, nil
 }
 
-func (k Keeper) getGeolocationProviders(ctx sdk.Context, providers []epochstoragetypes.StakeEntry, geolocation uint64) []epochstoragetypes.StakeEntry {
-	validProviders := []epochstoragetypes.StakeEntry{}
+// getProvidersPairingScores scores the providers that can be paired, a provider in the geolocation scores its effective stake (own and delegated)
+// capped by the spec's max pairing stake and a provider outside of it scores that times the GeolocationFallbackScore param of the epoch, providers
+// below the spec's min self stake or scoring zero can't be paired
+func (k Keeper) getProvidersPairingScores(ctx sdk.Context, spec spectypes.Spec, providers []epochstoragetypes.StakeEntry, geolocation uint64, epoch uint64) ([]types.ProviderPairingScore, error) {
+	fallbackScore, err := k.GeolocationFallbackScore(ctx, epoch)
+	if err != nil {
+		return nil, err
+	}
+	providerScores := []types.ProviderPairingScore{}
 	// create a list of valid providers (stakeAppliedBlock reached)
 	for _, stakeEntry := range providers {
 		if stakeEntry.StakeAppliedBlock > uint64(ctx.BlockHeight()) {
 			// provider stakeAppliedBlock wasn't reached yet
 			continue
 		}
-		geolocationSupported := stakeEntry.Geolocation & geolocation
-		if geolocationSupported == 0 {
+		if isBelowMinSelfStake(spec, stakeEntry) {
+			continue
+		}
+		providerScore := types.ProviderPairingScore{Provider: stakeEntry, GeolocationMatch: stakeEntry.Geolocation&geolocation != 0, GeolocationScore: sdk.OneDec()}
+		if !providerScore.GeolocationMatch {
 			// no match in geolocation bitmap
+			providerScore.GeolocationScore = fallbackScore
+		}
+		pairingStake := stakeEntry.EffectiveStake()
+		if stakeCap := spec.PairingStakeCap(); stakeCap.IsPositive() && pairingStake.GT(stakeCap) {
+			pairingStake = stakeCap
+		}
+		providerScore.Score = providerScore.GeolocationScore.MulInt(pairingStake).TruncateInt()
+		if !providerScore.Score.IsPositive() {
+			continue
+		}
+		providerScores = append(providerScores, providerScore)
+	}
+	return capScoresByCapacity(providerScores), nil
+}
+
+// capScoresByCapacity caps the score of each provider that declared a cu capacity to its share of the declared capacities times the
+// total score of the declaring providers, so a small provider isn't paired with a larger part of the consumers than it can serve.
+// providers that didn't declare a capacity keep their score
+func capScoresByCapacity(providerScores []types.ProviderPairingScore) []types.ProviderPairingScore {
+	declaredScore := sdk.ZeroInt()
+	declaredCapacity := sdk.ZeroInt()
+	for _, providerScore := range providerScores {
+		if providerScore.Provider.CuCapacity > 0 {
+			declaredScore = declaredScore.Add(providerScore.Score)
+			declaredCapacity = declaredCapacity.Add(sdk.NewIntFromUint64(providerScore.Provider.CuCapacity))
+		}
+	}
+	if declaredCapacity.IsZero() {
+		return providerScores
+	}
+
+	cappedScores := []types.ProviderPairingScore{}
+	for _, providerScore := range providerScores {
+		if providerScore.Provider.CuCapacity > 0 {
+			capacityScore := declaredScore.Mul(sdk.NewIntFromUint64(providerScore.Provider.CuCapacity)).Quo(declaredCapacity)
+			if providerScore.Score.GT(capacityScore) {
+				providerScore.Score = capacityScore
+			}
+			if !providerScore.Score.IsPositive() {
+				continue
+			}
+		}
+		cappedScores = append(cappedScores, providerScore)
+	}
+	return cappedScores
+}
+
+// filterProvidersByApiInterfaces returns the providers that have an endpoint of one of the api interfaces
+func filterProvidersByApiInterfaces(providers []epochstoragetypes.StakeEntry, apiInterfaces []string) []epochstoragetypes.StakeEntry {
+	filtered := []epochstoragetypes.StakeEntry{}
+	for _, stakeEntry := range providers {
+		for _, endpoint := range stakeEntry.Endpoints {
+			if slices.Contains(apiInterfaces, endpoint.UseType) {
+				filtered = append(filtered, stakeEntry)
+				break
+			}
+		}
+	}
+	return filtered
+}
+
+// filterProvidersByAddOns returns the providers that serve every one of the add-ons on an endpoint
+func filterProvidersByAddOns(providers []epochstoragetypes.StakeEntry, addOns []string) []epochstoragetypes.StakeEntry {
+	filtered := []epochstoragetypes.StakeEntry{}
+	for _, stakeEntry := range providers {
+		servesAll := true
+		for _, addOn := range addOns {
+			served := false
+			for _, endpoint := range stakeEntry.Endpoints {
+				if slices.Contains(endpoint.AddOns, addOn) {
+					served = true
+					break
+				}
+			}
+			if !served {
+				servesAll = false
+				break
+			}
+		}
+		if servesAll {
+			filtered = append(filtered, stakeEntry)
+		}
+	}
+	return filtered
+}
+
+// filterPairing keeps the providers of a pairing that pass the filters, they're a subset of the pairing so relays to them are still valid
+func (k Keeper) filterPairing(ctx sdk.Context, providers []epochstoragetypes.StakeEntry, filters *types.PairingFilters) []epochstoragetypes.StakeEntry {
+	if filters == nil {
+		return providers
+	}
+	filtered := []epochstoragetypes.StakeEntry{}
+	for _, stakeEntry := range providers {
+		var metadata *types.ProviderMetadata
+		if providerMetadata, found := k.GetProviderMetadata(ctx, stakeEntry.Address); found {
+			metadata = &providerMetadata
+		}
+		if filters.Match(stakeEntry, metadata) {
+			filtered = append(filtered, stakeEntry)
+		}
+	}
+	return filtered
+}
+
+// isBelowMinSelfStake returns whether the provider's own stake doesn't meet the spec's min self stake, providers
+// staked before the min self stake was set or last changed are grandfathered
+func isBelowMinSelfStake(spec spectypes.Spec, stakeEntry epochstoragetypes.StakeEntry) bool {
+	if stakeEntry.StakeAppliedBlock <= spec.MinSelfStakeProviderBlock {
+		return false
+	}
+	return stakeEntry.Stake.Amount.LT(spec.MinSelfStake())
+}
+
+// LogPairingExclusions emits an event for every provider of the new epoch that its spec's min self stake keeps out of pairing
+func (k Keeper) LogPairingExclusions(ctx sdk.Context) {
+	epoch := k.epochStorageKeeper.GetEpochStart(ctx)
+	for _, chainID := range k.specKeeper.GetAllChainIDs(ctx) {
+		spec, found := k.specKeeper.GetSpec(ctx, chainID)
+		if !found || !spec.MinSelfStake().IsPositive() {
+			continue
+		}
+		providers, found, _ := k.epochStorageKeeper.GetEpochStakeEntries(ctx, epoch, epochstoragetypes.ProviderKey, chainID)
+		if !found {
 			continue
 		}
-		validProviders = append(validProviders, stakeEntry)
+		for _, stakeEntry := range providers {
+			if isBelowMinSelfStake(spec, stakeEntry) {
+				details := map[string]string{"provider": stakeEntry.Address, "chainID": chainID, "epoch": strconv.FormatUint(epoch, 10), "selfStake": stakeEntry.Stake.String(), "minSelfStake": spec.MinSelfStakeProvider.String()}
+				utils.LogLavaEvent(ctx, k.Logger(ctx), types.ProviderExcludedFromPairingEventName, details, "provider stake is below the spec's min self stake")
+			}
+		}
 	}
-	return validProviders
 }
 
-// this function randomly chooses count providers by weight
-func (k Keeper) returnSubsetOfProvidersByStake(ctx sdk.Context, clientAddress string, providersMaps []epochstoragetypes.StakeEntry, count uint64, block uint64, chainID string, epochHash []byte) (returnedProviders []epochstoragetypes.StakeEntry) {
-	stakeSum := sdk.NewCoin(epochstoragetypes.TokenDenom, sdk.NewInt(0))
+// this function randomly chooses count providers weighted by their pairing score
+func (k Keeper) returnSubsetOfProvidersByScore(ctx sdk.Context, clientAddress string, providerScores []types.ProviderPairingScore, count uint64, block uint64, chainID string, epochHash []byte) (returnedProviders []epochstoragetypes.StakeEntry) {
+	scoreSum := sdk.ZeroInt()
 	hashData := make([]byte, 0)
-	for _, stakedProvider := range providersMaps {
-		stakeSum = stakeSum.Add(stakedProvider.Stake)
+	for _, providerScore := range providerScores {
+		scoreSum = scoreSum.Add(providerScore.Score)
 	}
-	if stakeSum.IsZero() {
+	if scoreSum.IsZero() {
 		// list is empty
 		return
 	}
@@ -312,23 +561,23 @@ func (k Keeper) returnSubsetOfProvidersByStake(ctx sdk.Context, clientAddress st
 		hash := tendermintcrypto.Sha256(hashData) // TODO: we use cheaper algo for speed
 		bigIntNum := new(big.Int).SetBytes(hash)
 		hashAsNumber := sdk.NewIntFromBigInt(bigIntNum)
-		modRes := hashAsNumber.Mod(stakeSum.Amount)
+		modRes := hashAsNumber.Mod(scoreSum)
 
-		newStakeSum := sdk.NewCoin(epochstoragetypes.TokenDenom, sdk.NewInt(0))
+		newScoreSum := sdk.ZeroInt()
 		// we loop the servicers list form the end because the list is sorted, biggest is last,
 		// and statistically this will have less iterations
 
-		for idx := len(providersMaps) - 1; idx >= 0; idx-- {
-			stakedProvider := providersMaps[idx]
+		for idx := len(providerScores) - 1; idx >= 0; idx-- {
+			providerScore := providerScores[idx]
 			if indexToSkip[idx] {
 				// this is an index we added
 				continue
 			}
-			newStakeSum = newStakeSum.Add(stakedProvider.Stake)
-			if modRes.LT(newStakeSum.Amount) {
+			newScoreSum = newScoreSum.Add(providerScore.Score)
+			if modRes.LT(newScoreSum) {
 				// we hit our chosen provider
-				returnedProviders = append(returnedProviders, stakedProvider)
-				stakeSum = stakeSum.Sub(stakedProvider.Stake) // we remove this provider from the random pool, so the sum is lower now
+				returnedProviders = append(returnedProviders, providerScore.Provider)
+				scoreSum = scoreSum.Sub(providerScore.Score) // we remove this provider from the random pool, so the sum is lower now
 				indexToSkip[idx] = true
 				break
 			}
@@ -336,7 +585,7 @@ func (k Keeper) returnSubsetOfProvidersByStake(ctx sdk.Context, clientAddress st
 		if uint64(len(returnedProviders)) >= count {
 			return returnedProviders
 		}
-		if stakeSum.IsZero() {
+		if scoreSum.IsZero() {
 			break
 		}
 		hashData = append(hashData, []byte{uint8(it)}...)
@@ -344,10 +593,19 @@ func (k Keeper) returnSubsetOfProvidersByStake(ctx sdk.Context, clientAddress st
 	return returnedProviders
 }
 
-func (k Keeper) returnSubsetOfProvidersByHighestStake(ctx sdk.Context, providersEntries []epochstoragetypes.StakeEntry, count uint64) (returnedProviders []epochstoragetypes.StakeEntry) {
-	if uint64(len(providersEntries)) <= count {
-		return providersEntries
-	} else {
-		return providersEntries[0:count]
+// static providers in the consumer's geolocation come first, providers outside of it only fill the remaining slots
+func (k Keeper) returnSubsetOfProvidersByHighestStake(ctx sdk.Context, providerScores []types.ProviderPairingScore, count uint64) (returnedProviders []epochstoragetypes.StakeEntry) {
+	fallbackProviders := []epochstoragetypes.StakeEntry{}
+	for _, providerScore := range providerScores {
+		if providerScore.GeolocationMatch {
+			returnedProviders = append(returnedProviders, providerScore.Provider)
+		} else {
+			fallbackProviders = append(fallbackProviders, providerScore.Provider)
+		}
+	}
+	returnedProviders = append(returnedProviders, fallbackProviders...)
+	if uint64(len(returnedProviders)) <= count {
+		return returnedProviders
 	}
+	return returnedProviders[0:count]
 }
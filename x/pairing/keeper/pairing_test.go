@@ -1,12 +1,16 @@
 package keeper_test
 
 import (
+	"math"
 	"testing"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/lavanet/lava/testutil/common"
 	testkeeper "github.com/lavanet/lava/testutil/keeper"
+	"github.com/lavanet/lava/utils"
+	"github.com/lavanet/lava/utils/sigs"
+	epochstoragetypes "github.com/lavanet/lava/x/epochstorage/types"
 	"github.com/lavanet/lava/x/pairing/types"
 	spectypes "github.com/lavanet/lava/x/spec/types"
 	"github.com/stretchr/testify/require"
@@ -308,3 +312,298 @@ func TestPairingStatic(t *testing.T) {
 		require.Equal(t, provider.Stake.Amount.Int64(), stake+int64(i))
 	}
 }
+
+func TestStaticProvidersListUnique(t *testing.T) {
+	servers, keepers, ctx := testkeeper.InitAllKeepers(t)
+
+	spec := common.CreateMockSpec()
+	spec.ProvidersTypes = spectypes.Spec_static
+	keepers.Spec.SetSpec(sdk.UnwrapSDKContext(ctx), spec)
+
+	ctx = testkeeper.AdvanceEpoch(ctx, keepers)
+
+	// all the providers are in geolocation 1, the fallback score lists them for the other geolocations too
+	providersCount := keepers.Pairing.ServicersToPairCountRaw(sdk.UnwrapSDKContext(ctx))
+	for i := uint64(0); i < providersCount; i++ {
+		provider := common.CreateNewAccount(ctx, *keepers, balance)
+		common.StakeAccount(t, ctx, *keepers, *servers, provider, spec, stake, true)
+	}
+
+	ctx = testkeeper.AdvanceEpoch(ctx, keepers)
+
+	res, err := keepers.Pairing.StaticProvidersList(ctx, &types.QueryStaticProvidersListRequest{ChainID: spec.Index})
+	require.Nil(t, err)
+	require.Len(t, res.Providers, int(providersCount))
+	listed := map[string]struct{}{}
+	for _, provider := range res.Providers {
+		listed[provider.Address] = struct{}{}
+	}
+	require.Len(t, listed, int(providersCount))
+}
+
+func TestPairingGeolocationScores(t *testing.T) {
+	servers, keepers, ctx := testkeeper.InitAllKeepers(t)
+
+	// init keepers state
+	spec := common.CreateMockSpec()
+	keepers.Spec.SetSpec(sdk.UnwrapSDKContext(ctx), spec)
+
+	ctx = testkeeper.AdvanceEpoch(ctx, keepers)
+
+	// the consumer is in geolocation 1, only one provider is there and the others are in geolocation 2
+	consumer := common.CreateNewAccount(ctx, *keepers, balance)
+	common.StakeAccount(t, ctx, *keepers, *servers, consumer, spec, stake, false)
+	localProvider := common.CreateNewAccount(ctx, *keepers, balance)
+	common.StakeAccount(t, ctx, *keepers, *servers, localProvider, spec, stake, true)
+	for i := 0; i < 3; i++ {
+		provider := common.CreateNewAccount(ctx, *keepers, balance)
+		endpoints := []epochstoragetypes.Endpoint{{IPPORT: "123", UseType: spec.GetApis()[0].ApiInterfaces[0].Interface, Geolocation: 2}}
+		_, err := servers.PairingServer.StakeProvider(ctx, &types.MsgStakeProvider{Creator: provider.Addr.String(), ChainID: spec.Name, Amount: sdk.NewCoin(epochstoragetypes.TokenDenom, sdk.NewInt(stake)), Geolocation: 2, Endpoints: endpoints})
+		require.Nil(t, err)
+	}
+
+	ctx = testkeeper.AdvanceEpoch(ctx, keepers)
+
+	res, err := keepers.Pairing.PairingScores(ctx, &types.QueryPairingScoresRequest{ChainID: spec.Index, Geolocation: 1})
+	require.Nil(t, err)
+	require.Len(t, res.Scores, 4)
+	for _, providerScore := range res.Scores {
+		require.Equal(t, providerScore.Provider.Address == localProvider.Addr.String(), providerScore.GeolocationMatch)
+		if providerScore.GeolocationMatch {
+			require.Equal(t, stake, providerScore.Score.Int64())
+		} else {
+			require.True(t, types.DefaultGeolocationFallbackScore.Equal(providerScore.GeolocationScore))
+			require.Equal(t, stake/10, providerScore.Score.Int64())
+		}
+	}
+
+	// providers outside the geolocation fill the pairing
+	providers, err := keepers.Pairing.GetPairingForClient(sdk.UnwrapSDKContext(ctx), spec.Index, consumer.Addr)
+	require.Nil(t, err)
+	require.Len(t, providers, int(keepers.Pairing.ServicersToPairCountRaw(sdk.UnwrapSDKContext(ctx))))
+
+	// without a fallback score geolocation is a strict filter
+	fallbackScoreBytes, _ := sdk.ZeroDec().MarshalJSON()
+	err = testkeeper.SimulateParamChange(sdk.UnwrapSDKContext(ctx), keepers.ParamsKeeper, types.ModuleName, string(types.KeyGeolocationFallbackScore), string(fallbackScoreBytes))
+	require.Nil(t, err)
+	ctx = testkeeper.AdvanceEpoch(ctx, keepers)
+
+	providers, err = keepers.Pairing.GetPairingForClient(sdk.UnwrapSDKContext(ctx), spec.Index, consumer.Addr)
+	require.Nil(t, err)
+	require.Len(t, providers, 1)
+	require.Equal(t, localProvider.Addr.String(), providers[0].Address)
+}
+
+func TestPairingMinSelfStakeAndStakeCap(t *testing.T) {
+	servers, keepers, ctx := testkeeper.InitAllKeepers(t)
+
+	spec := common.CreateMockSpec()
+	keepers.Spec.SetSpec(sdk.UnwrapSDKContext(ctx), spec)
+	ctx = testkeeper.AdvanceEpoch(ctx, keepers)
+
+	// staked before the spec requires a min self stake, so it's grandfathered
+	grandfathered := common.CreateNewAccount(ctx, *keepers, balance)
+	common.StakeAccount(t, ctx, *keepers, *servers, grandfathered, spec, stake/2, true)
+	ctx = testkeeper.AdvanceEpoch(ctx, keepers)
+
+	spec.MinSelfStakeProvider = sdk.NewCoin(epochstoragetypes.TokenDenom, sdk.NewInt(stake))
+	spec.MinSelfStakeProviderBlock = uint64(sdk.UnwrapSDKContext(ctx).BlockHeight())
+	spec.MaxPairingStake = sdk.NewCoin(epochstoragetypes.TokenDenom, sdk.NewInt(2*stake))
+	keepers.Spec.SetSpec(sdk.UnwrapSDKContext(ctx), spec)
+
+	excluded := common.CreateNewAccount(ctx, *keepers, balance)
+	common.StakeAccount(t, ctx, *keepers, *servers, excluded, spec, stake/2, true)
+	capped := common.CreateNewAccount(ctx, *keepers, balance)
+	common.StakeAccount(t, ctx, *keepers, *servers, capped, spec, 10*stake, true)
+	ctx = testkeeper.AdvanceEpoch(ctx, keepers)
+
+	res, err := keepers.Pairing.PairingScores(ctx, &types.QueryPairingScoresRequest{ChainID: spec.Index, Geolocation: 1})
+	require.Nil(t, err)
+	scores := map[string]int64{}
+	for _, providerScore := range res.Scores {
+		scores[providerScore.Provider.Address] = providerScore.Score.Int64()
+	}
+	require.Equal(t, map[string]int64{grandfathered.Addr.String(): stake / 2, capped.Addr.String(): 2 * stake}, scores)
+
+	// the excluded provider is reported at epoch start
+	events := sdk.UnwrapSDKContext(ctx).EventManager().Events()
+	reported := false
+	for _, event := range events {
+		if event.Type != utils.EventPrefix+types.ProviderExcludedFromPairingEventName {
+			continue
+		}
+		for _, attribute := range event.Attributes {
+			if string(attribute.Key) == "provider" {
+				require.Equal(t, excluded.Addr.String(), string(attribute.Value))
+				reported = true
+			}
+		}
+	}
+	require.True(t, reported)
+}
+
+func TestSimulatePairing(t *testing.T) {
+	servers, keepers, ctx := testkeeper.InitAllKeepers(t)
+
+	spec := common.CreateMockSpec()
+	keepers.Spec.SetSpec(sdk.UnwrapSDKContext(ctx), spec)
+
+	ctx = testkeeper.AdvanceEpoch(ctx, keepers)
+
+	var balance int64 = 10000
+	stake := balance / 10
+
+	consumer := common.CreateNewAccount(ctx, *keepers, balance)
+	common.StakeAccount(t, ctx, *keepers, *servers, consumer, spec, stake, false)
+	provider1 := common.CreateNewAccount(ctx, *keepers, balance)
+	common.StakeAccount(t, ctx, *keepers, *servers, provider1, spec, stake, true)
+
+	ctx = testkeeper.AdvanceEpoch(ctx, keepers)
+
+	// the current epoch's pairing is exact
+	res, err := keepers.Pairing.SimulatePairing(ctx, &types.QuerySimulatePairingRequest{ChainID: spec.Index, Client: consumer.Addr.String(), EpochOffset: 0})
+	require.Nil(t, err)
+	require.True(t, res.Exact)
+	require.Equal(t, keepers.Epochstorage.GetEpochStart(sdk.UnwrapSDKContext(ctx)), res.Epoch)
+	pairing, err := keepers.Pairing.GetPairingForClient(sdk.UnwrapSDKContext(ctx), spec.Index, consumer.Addr)
+	require.Nil(t, err)
+	require.Equal(t, pairing, res.Providers)
+	require.Len(t, res.Providers, 1)
+
+	// a provider staking now is paired from the next epoch
+	provider2 := common.CreateNewAccount(ctx, *keepers, balance)
+	common.StakeAccount(t, ctx, *keepers, *servers, provider2, spec, stake, true)
+
+	res, err = keepers.Pairing.SimulatePairing(ctx, &types.QuerySimulatePairingRequest{ChainID: spec.Index, Client: consumer.Addr.String(), EpochOffset: 0})
+	require.Nil(t, err)
+	require.Len(t, res.Providers, 1)
+
+	res, err = keepers.Pairing.SimulatePairing(ctx, &types.QuerySimulatePairingRequest{ChainID: spec.Index, Client: consumer.Addr.String(), EpochOffset: 1})
+	require.Nil(t, err)
+	require.False(t, res.Exact)
+	require.Len(t, res.Providers, 2)
+	simulatedEpoch := res.Epoch
+
+	ctx = testkeeper.AdvanceEpoch(ctx, keepers)
+	require.Equal(t, simulatedEpoch, keepers.Epochstorage.GetEpochStart(sdk.UnwrapSDKContext(ctx)))
+	pairing, err = keepers.Pairing.GetPairingForClient(sdk.UnwrapSDKContext(ctx), spec.Index, consumer.Addr)
+	require.Nil(t, err)
+	pairedAddresses := []string{}
+	for _, entry := range pairing {
+		pairedAddresses = append(pairedAddresses, entry.Address)
+	}
+	require.ElementsMatch(t, []string{provider1.Addr.String(), provider2.Addr.String()}, pairedAddresses)
+
+	_, err = keepers.Pairing.SimulatePairing(ctx, &types.QuerySimulatePairingRequest{ChainID: spec.Index, Client: "invalid", EpochOffset: 1})
+	require.NotNil(t, err)
+
+	// an offset past the largest block height is rejected instead of wrapping around
+	_, err = keepers.Pairing.SimulatePairing(ctx, &types.QuerySimulatePairingRequest{ChainID: spec.Index, Client: consumer.Addr.String(), EpochOffset: math.MaxUint64})
+	require.NotNil(t, err)
+}
+
+func TestPairingCuCapacity(t *testing.T) {
+	ts := setupForPaymentTest(t)
+
+	// stake two providers that declare a capacity next to the provider that didn't
+	endpoints := []epochstoragetypes.Endpoint{{IPPORT: "123", UseType: ts.spec.GetApis()[0].ApiInterfaces[0].Interface, Geolocation: 1}}
+	capacities := []uint64{100, 900}
+	for _, capacity := range capacities {
+		provider := common.CreateNewAccount(ts.ctx, *ts.keepers, balance)
+		_, err := ts.servers.PairingServer.StakeProvider(ts.ctx, &types.MsgStakeProvider{Creator: provider.Addr.String(), ChainID: ts.spec.Name, Amount: sdk.NewCoin(epochstoragetypes.TokenDenom, sdk.NewInt(stake)), Geolocation: 1, Endpoints: endpoints, Moniker: "capacity", CuCapacity: capacity})
+		require.Nil(t, err)
+		ts.providers = append(ts.providers, &provider)
+	}
+
+	ts.ctx = testkeeper.AdvanceEpoch(ts.ctx, ts.keepers)
+
+	res, err := ts.keepers.Pairing.PairingScores(ts.ctx, &types.QueryPairingScoresRequest{ChainID: ts.spec.Name, Geolocation: 1})
+	require.Nil(t, err)
+	scores := map[string]sdk.Int{}
+	for _, providerScore := range res.Scores {
+		scores[providerScore.Provider.Address] = providerScore.Score
+	}
+	require.Len(t, scores, 3)
+	// the small provider's score is capped to its share of the declared capacity
+	require.True(t, scores[ts.providers[0].Addr.String()].Equal(sdk.NewInt(stake)))
+	require.True(t, scores[ts.providers[1].Addr.String()].Equal(sdk.NewInt(2*stake*100/1000)))
+	require.True(t, scores[ts.providers[2].Addr.String()].Equal(sdk.NewInt(stake)))
+
+	// pay a relay to a provider that declared a capacity
+	pairing, err := ts.keepers.Pairing.GetPairingForClient(sdk.UnwrapSDKContext(ts.ctx), ts.spec.Name, ts.clients[0].Addr)
+	require.Nil(t, err)
+	var paidProvider string
+	for _, entry := range pairing {
+		if entry.CuCapacity > 0 {
+			paidProvider = entry.Address
+			break
+		}
+	}
+	require.NotEmpty(t, paidProvider)
+	cuSum := ts.spec.GetApis()[0].ComputeUnits
+	relaySession := common.BuildRelayRequest(ts.ctx, paidProvider, []byte(ts.spec.Apis[0].Name), cuSum, ts.spec.Name, nil)
+	relaySession.Sig, err = sigs.SignRelay(ts.clients[0].SK, *relaySession)
+	require.Nil(t, err)
+	_, err = ts.servers.PairingServer.RelayPayment(ts.ctx, &types.MsgRelayPayment{Creator: paidProvider, Relays: []*types.RelaySession{relaySession}})
+	require.Nil(t, err)
+
+	capacity, err := ts.keepers.Pairing.ProvidersCapacity(ts.ctx, &types.QueryProvidersCapacityRequest{ChainID: ts.spec.Name})
+	require.Nil(t, err)
+	require.Equal(t, uint64(1000), capacity.TotalCapacity)
+	require.Equal(t, cuSum, capacity.UsedCu)
+	require.Equal(t, 1000-cuSum, capacity.RemainingCapacity)
+	require.Equal(t, uint64(2), capacity.ProvidersWithCapacity)
+	require.Equal(t, uint64(1), capacity.ProvidersWithoutCapacity)
+}
+
+func TestGetPairingFilters(t *testing.T) {
+	ts := setupClientsAndProvidersForUnresponsiveness(t, 1, 2)
+	ts.ctx = testkeeper.AdvanceEpoch(ts.ctx, ts.keepers)
+	_, err := ts.servers.PairingServer.SetProviderMetadata(ts.ctx, &types.MsgSetProviderMetadata{Creator: ts.providers[1].Addr.String(), Moniker: "archiver", Addons: []string{types.AddonArchive}})
+	require.Nil(t, err)
+
+	getPairing := func(filters *types.PairingFilters) []string {
+		res, err := ts.keepers.Pairing.GetPairing(ts.ctx, &types.QueryGetPairingRequest{ChainID: ts.spec.Index, Client: ts.clients[0].Addr.String(), Filters: filters})
+		require.Nil(t, err)
+		addresses := []string{}
+		for _, stakeEntry := range res.Providers {
+			addresses = append(addresses, stakeEntry.Address)
+		}
+		return addresses
+	}
+	pairing := getPairing(nil)
+	require.Len(t, pairing, 2)
+
+	tests := []struct {
+		name     string
+		filters  types.PairingFilters
+		expected []string
+	}{
+		{"no filters", types.PairingFilters{}, pairing},
+		{"geolocation", types.PairingFilters{Geolocation: 1}, pairing},
+		{"other geolocation", types.PairingFilters{Geolocation: 2}, []string{}},
+		{"min stake", types.PairingFilters{MinStake: sdk.NewInt(stake)}, pairing},
+		{"min stake above the stake", types.PairingFilters{MinStake: sdk.NewInt(stake + 1)}, []string{}},
+		{"required add-on", types.PairingFilters{RequiredAddOns: []string{types.AddonArchive}}, []string{ts.providers[1].Addr.String()}},
+		{"add-on nobody advertises", types.PairingFilters{RequiredAddOns: []string{types.AddonArchive, types.AddonTrace}}, []string{}},
+		{"exclude", types.PairingFilters{Exclude: []string{pairing[0]}}, pairing[1:]},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filters := tt.filters
+			// the filtered providers keep the pairing's order
+			require.Equal(t, tt.expected, getPairing(&filters))
+		})
+	}
+
+	// invalid filters fail the query
+	for _, filters := range []types.PairingFilters{
+		{RequiredAddOns: []string{"unsupported"}},
+		{MinStake: sdk.NewInt(-1)},
+		{Exclude: []string{"invalid"}},
+	} {
+		filters := filters
+		_, err = ts.keepers.Pairing.GetPairing(ts.ctx, &types.QueryGetPairingRequest{ChainID: ts.spec.Index, Client: ts.clients[0].Addr.String(), Filters: &filters})
+		require.NotNil(t, err)
+	}
+}
@@ -20,6 +20,15 @@ func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 		k.DataReliabilityReward(ctx),
 		k.QoSWeight(ctx),
 		k.RecommendedEpochNumToCollectPayment(ctx),
+		k.ClientOveruseJailThreshold(ctx),
+		k.ClientJailEpochs(ctx),
+		k.ClientOveruseSlashFraction(ctx),
+		k.GeolocationFallbackScoreRaw(ctx),
+		k.DelegationCommission(ctx),
+		k.UnresponsiveReportersThreshold(ctx),
+		k.UnresponsiveJailEpochs(ctx),
+		k.DoubleSpendJailEpochs(ctx),
+		k.AttestationStaleBlocks(ctx),
 	)
 }
 
@@ -107,3 +116,77 @@ func (k Keeper) RecommendedEpochNumToCollectPayment(ctx sdk.Context) (res uint64
 func (k Keeper) SetRecommendedEpochNumToCollectPayment(ctx sdk.Context, val uint64) {
 	k.paramstore.Set(ctx, types.KeyRecommendedEpochNumToCollectPayment, val)
 }
+
+// the client jailing params were added after launch, chains that didn't set them have jailing disabled
+
+// ClientOveruseJailThreshold returns the ClientOveruseJailThreshold param
+func (k Keeper) ClientOveruseJailThreshold(ctx sdk.Context) (res uint64) {
+	k.paramstore.GetIfExists(ctx, types.KeyClientOveruseJailThreshold, &res)
+	return
+}
+
+// ClientJailEpochs returns the ClientJailEpochs param
+func (k Keeper) ClientJailEpochs(ctx sdk.Context) (res uint64) {
+	res = types.DefaultClientJailEpochs
+	k.paramstore.GetIfExists(ctx, types.KeyClientJailEpochs, &res)
+	return
+}
+
+// ClientOveruseSlashFraction returns the ClientOveruseSlashFraction param
+func (k Keeper) ClientOveruseSlashFraction(ctx sdk.Context) (res sdk.Dec) {
+	res = types.DefaultClientOveruseSlashFraction
+	k.paramstore.GetIfExists(ctx, types.KeyClientOveruseSlashFraction, &res)
+	return
+}
+
+// GeolocationFallbackScore returns the GeolocationFallbackScore param fixated for the block
+func (k Keeper) GeolocationFallbackScore(ctx sdk.Context, block uint64) (res sdk.Dec, err error) {
+	if _, found := k.epochStorageKeeper.LatestFixatedParams(ctx, string(types.KeyGeolocationFallbackScore)); !found {
+		// the param wasn't changed since it was fixated, so the current value holds for every block
+		return k.GeolocationFallbackScoreRaw(ctx), nil
+	}
+	err = k.epochStorageKeeper.GetParamForBlock(ctx, string(types.KeyGeolocationFallbackScore), block, &res)
+	return
+}
+
+// GeolocationFallbackScoreRaw returns the GeolocationFallbackScore param
+func (k Keeper) GeolocationFallbackScoreRaw(ctx sdk.Context) (res sdk.Dec) {
+	res = types.DefaultGeolocationFallbackScore
+	k.paramstore.GetIfExists(ctx, types.KeyGeolocationFallbackScore, &res)
+	return
+}
+
+// DelegationCommission returns the DelegationCommission param
+func (k Keeper) DelegationCommission(ctx sdk.Context) (res sdk.Dec) {
+	res = types.DefaultDelegationCommission
+	k.paramstore.GetIfExists(ctx, types.KeyDelegationCommission, &res)
+	return
+}
+
+// UnresponsiveReportersThreshold returns the UnresponsiveReportersThreshold param
+func (k Keeper) UnresponsiveReportersThreshold(ctx sdk.Context) (res uint64) {
+	res = types.DefaultUnresponsiveReportersThreshold
+	k.paramstore.GetIfExists(ctx, types.KeyUnresponsiveReportersThreshold, &res)
+	return
+}
+
+// UnresponsiveJailEpochs returns the UnresponsiveJailEpochs param
+func (k Keeper) UnresponsiveJailEpochs(ctx sdk.Context) (res uint64) {
+	res = types.DefaultUnresponsiveJailEpochs
+	k.paramstore.GetIfExists(ctx, types.KeyUnresponsiveJailEpochs, &res)
+	return
+}
+
+// DoubleSpendJailEpochs returns the DoubleSpendJailEpochs param
+func (k Keeper) DoubleSpendJailEpochs(ctx sdk.Context) (res uint64) {
+	res = types.DefaultDoubleSpendJailEpochs
+	k.paramstore.GetIfExists(ctx, types.KeyDoubleSpendJailEpochs, &res)
+	return
+}
+
+// AttestationStaleBlocks returns the AttestationStaleBlocks param
+func (k Keeper) AttestationStaleBlocks(ctx sdk.Context) (res uint64) {
+	res = types.DefaultAttestationStaleBlocks
+	k.paramstore.GetIfExists(ctx, types.KeyAttestationStaleBlocks, &res)
+	return
+}
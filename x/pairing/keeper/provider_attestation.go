@@ -0,0 +1,154 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/utils"
+	epochstoragetypes "github.com/lavanet/lava/x/epochstorage/types"
+	"github.com/lavanet/lava/x/pairing/types"
+)
+
+// SetProviderAttestation set a specific providerAttestation in the store from its index
+func (k Keeper) SetProviderAttestation(ctx sdk.Context, providerAttestation types.ProviderAttestation) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ProviderAttestationKeyPrefix))
+	b := k.cdc.MustMarshal(&providerAttestation)
+	store.Set(types.ProviderAttestationKey(providerAttestation.ChainID, providerAttestation.Provider), b)
+}
+
+// GetProviderAttestation returns a providerAttestation from its index
+func (k Keeper) GetProviderAttestation(ctx sdk.Context, chainID string, provider string) (val types.ProviderAttestation, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ProviderAttestationKeyPrefix))
+
+	b := store.Get(types.ProviderAttestationKey(chainID, provider))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllProviderAttestation returns all providerAttestation
+func (k Keeper) GetAllProviderAttestation(ctx sdk.Context) (list []types.ProviderAttestation) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ProviderAttestationKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.ProviderAttestation
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// AttestEndpointHealth records that a provider's endpoints on the given chains are live at the current block, the provider
+// must be staked on all of them
+func (k Keeper) AttestEndpointHealth(ctx sdk.Context, provider string, chainIDs []string, protocolVersion uint32, binaryVersion string) error {
+	providerAddr, err := sdk.AccAddressFromBech32(provider)
+	if err != nil {
+		return utils.LavaFormatWarning("invalid provider address", err, utils.Attribute{Key: "provider", Value: provider})
+	}
+
+	for _, chainID := range chainIDs {
+		if _, found, _ := k.epochStorageKeeper.GetStakeEntryByAddressCurrent(ctx, epochstoragetypes.ProviderKey, chainID, providerAddr); !found {
+			return utils.LavaFormatWarning("endpoint health can only be attested by a staked provider", fmt.Errorf("provider isn't staked on the chain"), utils.Attribute{Key: "provider", Value: provider}, utils.Attribute{Key: "chainID", Value: chainID})
+		}
+	}
+
+	lastSeenBlock := uint64(ctx.BlockHeight())
+	for _, chainID := range chainIDs {
+		k.SetProviderAttestation(ctx, types.ProviderAttestation{
+			Provider:        provider,
+			ChainID:         chainID,
+			LastSeenBlock:   lastSeenBlock,
+			ProtocolVersion: protocolVersion,
+			BinaryVersion:   binaryVersion,
+		})
+	}
+
+	details := map[string]string{"provider": provider, "chainIDs": strings.Join(chainIDs, ","), "protocolVersion": strconv.FormatUint(uint64(protocolVersion), 10), "binaryVersion": binaryVersion}
+	utils.LogLavaEvent(ctx, k.Logger(ctx), types.ProviderAttestationEventName, details, "provider endpoints attested")
+	return nil
+}
+
+// MarkStaleProviders records the providers of the new epoch whose last attestation on a chain is more than AttestationStaleBlocks
+// blocks before the epoch start, they aren't paired on that chain for the whole epoch. attesting is optional, providers that never
+// attested on a chain are paired as usual
+func (k Keeper) MarkStaleProviders(ctx sdk.Context) {
+	staleBlocks := k.AttestationStaleBlocks(ctx)
+	if staleBlocks == 0 {
+		return
+	}
+
+	epoch := k.epochStorageKeeper.GetEpochStart(ctx)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.StaleProviderKeyPrefix))
+	for _, chainID := range k.specKeeper.GetAllChainIDs(ctx) {
+		providers, found, _ := k.epochStorageKeeper.GetEpochStakeEntries(ctx, epoch, epochstoragetypes.ProviderKey, chainID)
+		if !found {
+			continue
+		}
+		for _, stakeEntry := range providers {
+			attestation, found := k.GetProviderAttestation(ctx, chainID, stakeEntry.Address)
+			if !found || attestation.LastSeenBlock+staleBlocks >= epoch {
+				continue
+			}
+			store.Set(types.StaleProviderKey(epoch, chainID, stakeEntry.Address), []byte{})
+			details := map[string]string{"provider": stakeEntry.Address, "chainID": chainID, "epoch": strconv.FormatUint(epoch, 10), "lastSeenBlock": strconv.FormatUint(attestation.LastSeenBlock, 10)}
+			utils.LogLavaEvent(ctx, k.Logger(ctx), types.ProviderAttestationStaleEventName, details, "provider endpoint attestation is stale, provider is left out of pairing")
+		}
+	}
+}
+
+func (k Keeper) isStaleProvider(ctx sdk.Context, epoch uint64, chainID string, providerAddress string) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.StaleProviderKeyPrefix))
+	return store.Has(types.StaleProviderKey(epoch, chainID, providerAddress))
+}
+
+// filterStaleProviders drops the providers marked stale on the chain at the epoch
+func (k Keeper) filterStaleProviders(ctx sdk.Context, providers []epochstoragetypes.StakeEntry, epoch uint64, chainID string) []epochstoragetypes.StakeEntry {
+	filtered := []epochstoragetypes.StakeEntry{}
+	for _, stakeEntry := range providers {
+		if !k.isStaleProvider(ctx, epoch, chainID, stakeEntry.Address) {
+			filtered = append(filtered, stakeEntry)
+		}
+	}
+	return filtered
+}
+
+// RemoveOldStaleProviders removes the stale providers of epochs that are no longer kept
+func (k Keeper) RemoveOldStaleProviders(ctx sdk.Context) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.StaleProviderKeyPrefix))
+	for _, epoch := range k.epochStorageKeeper.GetDeletedEpochs(ctx) {
+		deleteAllKeys(prefix.NewStore(store, types.StaleProviderEpochKey(epoch)))
+	}
+}
+
+// RemoveUnstakedProviderAttestations removes the attestations of providers no longer staked on their chain at the new epoch. attestations
+// of staked providers are kept however old they are, dropping one would pair a stale provider again as one that never attested
+func (k Keeper) RemoveUnstakedProviderAttestations(ctx sdk.Context) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ProviderAttestationKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	keys := [][]byte{}
+	for ; iterator.Valid(); iterator.Next() {
+		var attestation types.ProviderAttestation
+		k.cdc.MustUnmarshal(iterator.Value(), &attestation)
+		providerAddr, err := sdk.AccAddressFromBech32(attestation.Provider)
+		if err == nil {
+			if _, found, _ := k.epochStorageKeeper.GetStakeEntryByAddressCurrent(ctx, epochstoragetypes.ProviderKey, attestation.ChainID, providerAddr); found {
+				continue
+			}
+		}
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
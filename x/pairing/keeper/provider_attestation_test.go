@@ -0,0 +1,70 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/testutil/common"
+	testkeeper "github.com/lavanet/lava/testutil/keeper"
+	"github.com/lavanet/lava/x/pairing/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAttestEndpointHealthStaleProviders(t *testing.T) {
+	providersNum := 2
+	ts := setupClientsAndProvidersForUnresponsiveness(t, 1, providersNum)
+	ts.ctx = testkeeper.AdvanceEpoch(ts.ctx, ts.keepers)
+	blocksInEpoch := ts.keepers.Epochstorage.EpochBlocksRaw(sdk.UnwrapSDKContext(ts.ctx))
+	params := ts.keepers.Pairing.GetParams(sdk.UnwrapSDKContext(ts.ctx))
+	params.AttestationStaleBlocks = blocksInEpoch
+	ts.keepers.Pairing.SetParams(sdk.UnwrapSDKContext(ts.ctx), params)
+
+	pairedProviders := func() []string {
+		pairingList, err := ts.keepers.Pairing.GetPairingForClient(sdk.UnwrapSDKContext(ts.ctx), ts.spec.Index, ts.clients[0].Addr)
+		require.Nil(t, err)
+		addresses := []string{}
+		for _, stakeEntry := range pairingList {
+			addresses = append(addresses, stakeEntry.Address)
+		}
+		return addresses
+	}
+
+	// only staked providers attest, and only on the chains they're staked on
+	notStaked := common.CreateNewAccount(ts.ctx, *ts.keepers, balance)
+	_, err := ts.servers.PairingServer.AttestEndpointHealth(ts.ctx, types.NewMsgAttestEndpointHealth(notStaked.Addr.String(), []string{ts.spec.Index}, 1, "v0.1.0"))
+	require.NotNil(t, err)
+	_, err = ts.servers.PairingServer.AttestEndpointHealth(ts.ctx, types.NewMsgAttestEndpointHealth(ts.providers[0].Addr.String(), []string{ts.spec.Index, "not-staked"}, 1, "v0.1.0"))
+	require.NotNil(t, err)
+	_, found := ts.keepers.Pairing.GetProviderAttestation(sdk.UnwrapSDKContext(ts.ctx), ts.spec.Index, ts.providers[0].Addr.String())
+	require.False(t, found)
+
+	_, err = ts.servers.PairingServer.AttestEndpointHealth(ts.ctx, types.NewMsgAttestEndpointHealth(ts.providers[0].Addr.String(), []string{ts.spec.Index}, 1, "v0.1.0"))
+	require.Nil(t, err)
+	attestation, found := ts.keepers.Pairing.GetProviderAttestation(sdk.UnwrapSDKContext(ts.ctx), ts.spec.Index, ts.providers[0].Addr.String())
+	require.True(t, found)
+	require.Equal(t, uint64(sdk.UnwrapSDKContext(ts.ctx).BlockHeight()), attestation.LastSeenBlock)
+	require.Equal(t, "v0.1.0", attestation.BinaryVersion)
+
+	// the attestation is fresh for the next epoch
+	ts.ctx = testkeeper.AdvanceEpoch(ts.ctx, ts.keepers)
+	require.Len(t, pairedProviders(), providersNum)
+
+	// and stale for the one after it, the provider that never attested is still paired
+	ts.ctx = testkeeper.AdvanceEpoch(ts.ctx, ts.keepers)
+	require.Equal(t, []string{ts.providers[1].Addr.String()}, pairedProviders())
+
+	// attesting again brings the provider back from the next epoch, the pairing of the current one doesn't change
+	_, err = ts.servers.PairingServer.AttestEndpointHealth(ts.ctx, types.NewMsgAttestEndpointHealth(ts.providers[0].Addr.String(), []string{ts.spec.Index}, 1, "v0.1.0"))
+	require.Nil(t, err)
+	require.Equal(t, []string{ts.providers[1].Addr.String()}, pairedProviders())
+	ts.ctx = testkeeper.AdvanceEpoch(ts.ctx, ts.keepers)
+	require.Len(t, pairedProviders(), providersNum)
+
+	// the attestation of a provider that unstaked is removed at the next epoch
+	_, err = ts.servers.PairingServer.UnstakeProvider(ts.ctx, &types.MsgUnstakeProvider{Creator: ts.providers[0].Addr.String(), ChainID: ts.spec.Index})
+	require.Nil(t, err)
+	ts.ctx = testkeeper.AdvanceEpoch(ts.ctx, ts.keepers)
+	_, found = ts.keepers.Pairing.GetProviderAttestation(sdk.UnwrapSDKContext(ts.ctx), ts.spec.Index, ts.providers[0].Addr.String())
+	require.False(t, found)
+	require.Empty(t, ts.keepers.Pairing.GetAllProviderAttestation(sdk.UnwrapSDKContext(ts.ctx)))
+}
@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	epochstoragetypes "github.com/lavanet/lava/x/epochstorage/types"
+	"github.com/lavanet/lava/x/pairing/types"
+)
+
+// SetProviderFreeze set a provider's freeze on a chain in the store
+func (k Keeper) SetProviderFreeze(ctx sdk.Context, providerFreeze types.ProviderFreeze) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ProviderFreezeKeyPrefix))
+	b := k.cdc.MustMarshal(&providerFreeze)
+	store.Set(types.ProviderFreezeKey(providerFreeze.ChainID, providerFreeze.Provider), b)
+}
+
+// GetProviderFreeze returns a provider's freeze on a chain
+func (k Keeper) GetProviderFreeze(ctx sdk.Context, chainID string, provider string) (val types.ProviderFreeze, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ProviderFreezeKeyPrefix))
+
+	b := store.Get(types.ProviderFreezeKey(chainID, provider))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveProviderFreeze removes a provider's freeze on a chain from the store
+func (k Keeper) RemoveProviderFreeze(ctx sdk.Context, chainID string, provider string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ProviderFreezeKeyPrefix))
+	store.Delete(types.ProviderFreezeKey(chainID, provider))
+}
+
+// RemoveEndedProviderFreezes deletes the freezes whose scheduled unfreeze was reached and the freezes of providers that unstaked
+func (k Keeper) RemoveEndedProviderFreezes(ctx sdk.Context) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ProviderFreezeKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	endedFreezes := [][]byte{}
+	for ; iterator.Valid(); iterator.Next() {
+		var providerFreeze types.ProviderFreeze
+		k.cdc.MustUnmarshal(iterator.Value(), &providerFreeze)
+		if providerFreeze.UnfreezeBlock != 0 && providerFreeze.UnfreezeBlock <= uint64(ctx.BlockHeight()) {
+			endedFreezes = append(endedFreezes, iterator.Key())
+			continue
+		}
+		providerAddr, err := sdk.AccAddressFromBech32(providerFreeze.Provider)
+		if err != nil {
+			endedFreezes = append(endedFreezes, iterator.Key())
+			continue
+		}
+		if _, found, _ := k.epochStorageKeeper.GetStakeEntryByAddressCurrent(ctx, epochstoragetypes.ProviderKey, providerFreeze.ChainID, providerAddr); !found {
+			endedFreezes = append(endedFreezes, iterator.Key())
+		}
+	}
+	iterator.Close()
+	for _, key := range endedFreezes {
+		store.Delete(key)
+	}
+}
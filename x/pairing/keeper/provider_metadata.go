@@ -0,0 +1,79 @@
+package keeper
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/utils"
+	epochstoragetypes "github.com/lavanet/lava/x/epochstorage/types"
+	"github.com/lavanet/lava/x/pairing/types"
+)
+
+// SetProviderMetadata set a specific providerMetadata in the store from its index
+func (k Keeper) SetProviderMetadata(ctx sdk.Context, providerMetadata types.ProviderMetadata) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ProviderMetadataKeyPrefix))
+	b := k.cdc.MustMarshal(&providerMetadata)
+	store.Set(types.ProviderMetadataKey(providerMetadata.Provider), b)
+}
+
+// GetProviderMetadata returns a providerMetadata from its index
+func (k Keeper) GetProviderMetadata(ctx sdk.Context, provider string) (val types.ProviderMetadata, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ProviderMetadataKeyPrefix))
+
+	b := store.Get(types.ProviderMetadataKey(provider))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveProviderMetadata removes a providerMetadata from the store
+func (k Keeper) RemoveProviderMetadata(ctx sdk.Context, provider string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ProviderMetadataKeyPrefix))
+	store.Delete(types.ProviderMetadataKey(provider))
+}
+
+// GetAllProviderMetadata returns all providerMetadata
+func (k Keeper) GetAllProviderMetadata(ctx sdk.Context) (list []types.ProviderMetadata) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ProviderMetadataKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.ProviderMetadata
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// UpdateProviderMetadata replaces the metadata of a provider, only a provider staked on some chain can advertise metadata
+func (k Keeper) UpdateProviderMetadata(ctx sdk.Context, metadata types.ProviderMetadata) error {
+	providerAddr, err := sdk.AccAddressFromBech32(metadata.Provider)
+	if err != nil {
+		return utils.LavaFormatWarning("invalid provider address", err, utils.Attribute{Key: "provider", Value: metadata.Provider})
+	}
+
+	staked := false
+	for _, chainID := range k.specKeeper.GetAllChainIDs(ctx) {
+		if _, found, _ := k.epochStorageKeeper.GetStakeEntryByAddressCurrent(ctx, epochstoragetypes.ProviderKey, chainID, providerAddr); found {
+			staked = true
+			break
+		}
+	}
+	if !staked {
+		return utils.LavaFormatWarning("provider metadata can only be set by a staked provider", fmt.Errorf("provider isn't staked on any chain"), utils.Attribute{Key: "provider", Value: metadata.Provider})
+	}
+
+	k.SetProviderMetadata(ctx, metadata)
+
+	details := map[string]string{"provider": metadata.Provider, "moniker": metadata.Moniker, "addons": strings.Join(metadata.Addons, ",")}
+	utils.LogLavaEvent(ctx, k.Logger(ctx), types.ProviderMetadataEventName, details, "provider metadata updated")
+	return nil
+}
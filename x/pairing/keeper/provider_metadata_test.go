@@ -0,0 +1,54 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/lavanet/lava/testutil/common"
+	"github.com/lavanet/lava/x/pairing/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetProviderMetadata(t *testing.T) {
+	ts := setupForPaymentTest(t)
+	err := ts.addProvider(1)
+	require.Nil(t, err)
+
+	// only staked providers advertise metadata
+	notStaked := common.CreateNewAccount(ts.ctx, *ts.keepers, balance)
+	_, err = ts.servers.PairingServer.SetProviderMetadata(ts.ctx, &types.MsgSetProviderMetadata{Creator: notStaked.Addr.String(), Moniker: "moniker"})
+	require.NotNil(t, err)
+
+	archive := types.MsgSetProviderMetadata{Creator: ts.providers[0].Addr.String(), Moniker: "archiver", Website: "https://archiver.example", Addons: []string{types.AddonArchive, types.AddonTrace}}
+	_, err = ts.servers.PairingServer.SetProviderMetadata(ts.ctx, &archive)
+	require.Nil(t, err)
+	websocket := types.MsgSetProviderMetadata{Creator: ts.providers[1].Addr.String(), Moniker: "streamer", Addons: []string{types.AddonWebsocket}}
+	_, err = ts.servers.PairingServer.SetProviderMetadata(ts.ctx, &websocket)
+	require.Nil(t, err)
+
+	res, err := ts.keepers.Pairing.ProviderMetadata(ts.ctx, &types.QueryGetProviderMetadataRequest{Provider: ts.providers[0].Addr.String()})
+	require.Nil(t, err)
+	require.Equal(t, "archiver", res.ProviderMetadata.Moniker)
+	require.Equal(t, "https://archiver.example", res.ProviderMetadata.Website)
+
+	all, err := ts.keepers.Pairing.ProviderMetadataAll(ts.ctx, &types.QueryAllProviderMetadataRequest{Pagination: &query.PageRequest{CountTotal: true}})
+	require.Nil(t, err)
+	require.Len(t, all.ProviderMetadata, 2)
+
+	// consumers can filter the providers by add-on
+	filtered, err := ts.keepers.Pairing.ProviderMetadataAll(ts.ctx, &types.QueryAllProviderMetadataRequest{Addon: types.AddonArchive})
+	require.Nil(t, err)
+	require.Len(t, filtered.ProviderMetadata, 1)
+	require.Equal(t, ts.providers[0].Addr.String(), filtered.ProviderMetadata[0].Provider)
+
+	// setting the metadata again replaces it
+	archive.Addons = []string{types.AddonWebsocket}
+	_, err = ts.servers.PairingServer.SetProviderMetadata(ts.ctx, &archive)
+	require.Nil(t, err)
+	filtered, err = ts.keepers.Pairing.ProviderMetadataAll(ts.ctx, &types.QueryAllProviderMetadataRequest{Addon: types.AddonWebsocket})
+	require.Nil(t, err)
+	require.Len(t, filtered.ProviderMetadata, 2)
+	filtered, err = ts.keepers.Pairing.ProviderMetadataAll(ts.ctx, &types.QueryAllProviderMetadataRequest{Addon: types.AddonArchive})
+	require.Nil(t, err)
+	require.Len(t, filtered.ProviderMetadata, 0)
+}
@@ -121,3 +121,12 @@ func (k Keeper) GetTotalUsedCUForConsumerPerEpoch(ctx sdk.Context, consumerAddre
 	}
 	return usedCUProviderTotal, nil
 }
+
+// getProviderServicedCu counts the CU paid to the provider in the epoch of the providerPaymentStorage by iterating through its uniquePaymentStorageClientProvider objects
+func (k Keeper) getProviderServicedCu(ctx sdk.Context, providerPaymentStorage types.ProviderPaymentStorage) (servicedCu uint64) {
+	for _, uniquePaymentKey := range providerPaymentStorage.GetUniquePaymentStorageClientProviderKeys() {
+		uniquePayment, _ := k.GetUniquePaymentStorageClientProvider(ctx, uniquePaymentKey)
+		servicedCu += uniquePayment.GetUsedCU()
+	}
+	return servicedCu
+}
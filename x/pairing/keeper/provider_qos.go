@@ -0,0 +1,192 @@
+package keeper
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/utils"
+	epochstoragetypes "github.com/lavanet/lava/x/epochstorage/types"
+	"github.com/lavanet/lava/x/pairing/types"
+	spectypes "github.com/lavanet/lava/x/spec/types"
+	"golang.org/x/exp/slices"
+)
+
+// addProviderQoSReport adds a qos report a client attached to a relay payment to the provider's sums of the epoch
+func (k Keeper) addProviderQoSReport(ctx sdk.Context, epoch uint64, chainID string, providerAddress string, report *types.QualityOfServiceReport) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ProviderQoSReportsKeyPrefix))
+	key := types.ProviderQoSReportsKey(epoch, chainID, providerAddress)
+	reports, found := k.getProviderQoSReports(ctx, epoch, chainID, providerAddress)
+	if !found {
+		reports = types.ProviderQoSReports{Epoch: epoch, LatencySum: sdk.ZeroDec(), AvailabilitySum: sdk.ZeroDec(), SyncSum: sdk.ZeroDec()}
+	}
+	reports.Reports++
+	reports.LatencySum = reports.LatencySum.Add(report.Latency)
+	reports.AvailabilitySum = reports.AvailabilitySum.Add(report.Availability)
+	reports.SyncSum = reports.SyncSum.Add(report.Sync)
+	store.Set(key, k.cdc.MustMarshal(&reports))
+}
+
+// epochQoSScore is the qos score of the provider's average qos report in the epoch, found is false when no client reported its qos in it
+func (k Keeper) epochQoSScore(ctx sdk.Context, epoch uint64, chainID string, providerAddress string) (score sdk.Dec, found bool) {
+	reports, found := k.getProviderQoSReports(ctx, epoch, chainID, providerAddress)
+	if !found || reports.Reports == 0 {
+		return sdk.ZeroDec(), false
+	}
+	count := sdk.NewDec(int64(reports.Reports))
+	average := types.QualityOfServiceReport{Latency: reports.LatencySum.Quo(count), Availability: reports.AvailabilitySum.Quo(count), Sync: reports.SyncSum.Quo(count)}
+	score, err := average.ComputeQoS()
+	return score, err == nil
+}
+
+// withholdsProviderReward is whether the provider's rewards on the spec are paid only once its qos score in the epoch is final,
+// on specs with a minimum qos score that don't exempt the provider
+func withholdsProviderReward(spec spectypes.Spec, providerAddress string) bool {
+	return spec.MinQosScorePercent > 0 && !slices.Contains(spec.QosExemptProviders, providerAddress)
+}
+
+// minQoSRewardFactor is the share of its reward a provider is paid when its qos score in the epoch is below the minimum of the spec,
+// its score over the minimum. providers the spec exempts are paid in full
+func (k Keeper) minQoSRewardFactor(ctx sdk.Context, spec spectypes.Spec, epoch uint64, providerAddress string) (factor sdk.Dec, epochScore sdk.Dec, below bool) {
+	if !withholdsProviderReward(spec, providerAddress) {
+		return sdk.OneDec(), sdk.ZeroDec(), false
+	}
+	epochScore, found := k.epochQoSScore(ctx, epoch, spec.Index, providerAddress)
+	minScore := sdk.NewDecWithPrec(int64(spec.MinQosScorePercent), 2)
+	if !found || epochScore.GTE(minScore) {
+		return sdk.OneDec(), epochScore, false
+	}
+	return epochScore.Quo(minScore), epochScore, true
+}
+
+// withholdProviderReward adds a reward of the provider's relays in the epoch to its withheld rewards, they are paid by
+// PayWithheldProviderRewards once the epoch can't be claimed anymore
+func (k Keeper) withholdProviderReward(ctx sdk.Context, epoch uint64, chainID string, providerAddr sdk.AccAddress, reward sdk.Dec) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ProviderWithheldRewardKeyPrefix))
+	key := types.ProviderQoSReportsKey(epoch, chainID, providerAddr.String())
+	withheld := types.ProviderWithheldReward{
+		Epoch:           epoch,
+		ChainID:         chainID,
+		Provider:        providerAddr.String(),
+		Reward:          sdk.ZeroDec(),
+		DelegatorsShare: k.providerDelegatorsShare(ctx, chainID, providerAddr, epoch),
+	}
+	if b := store.Get(key); b != nil {
+		k.cdc.MustUnmarshal(b, &withheld)
+	}
+	withheld.Reward = withheld.Reward.Add(reward)
+	store.Set(key, k.cdc.MustMarshal(&withheld))
+}
+
+// PayWithheldProviderRewards pays the rewards withheld in the epochs that can't be claimed anymore, scaled by minQoSRewardFactor with the
+// providers' final qos scores in the epochs. it runs before the qos reports and the delegation snapshots of the epochs are removed
+func (k Keeper) PayWithheldProviderRewards(ctx sdk.Context) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ProviderWithheldRewardKeyPrefix))
+	for _, epoch := range k.epochStorageKeeper.GetDeletedEpochs(ctx) {
+		epochStore := prefix.NewStore(store, types.ProviderQoSReportsEpochKey(epoch))
+		iterator := sdk.KVStorePrefixIterator(epochStore, []byte{})
+		withheldRewards := []types.ProviderWithheldReward{}
+		for ; iterator.Valid(); iterator.Next() {
+			var withheld types.ProviderWithheldReward
+			k.cdc.MustUnmarshal(iterator.Value(), &withheld)
+			withheldRewards = append(withheldRewards, withheld)
+		}
+		iterator.Close()
+		for _, withheld := range withheldRewards {
+			k.payWithheldProviderReward(ctx, withheld)
+		}
+		deleteAllKeys(epochStore)
+	}
+}
+
+func (k Keeper) payWithheldProviderReward(ctx sdk.Context, withheld types.ProviderWithheldReward) {
+	logger := k.Logger(ctx)
+	details := map[string]string{
+		"epoch":          strconv.FormatUint(withheld.Epoch, 10),
+		"chainID":        withheld.ChainID,
+		"provider":       withheld.Provider,
+		"withheldReward": withheld.Reward.TruncateInt().String(),
+	}
+	providerAddr, err := sdk.AccAddressFromBech32(withheld.Provider)
+	if err != nil {
+		details["error"] = err.Error()
+		utils.LavaError(ctx, logger, types.ProviderWithheldRewardEventName, details, "invalid provider address of withheld reward")
+		return
+	}
+	reward := withheld.Reward
+	// a spec removed since the relays were paid can't lower the reward, it is paid in full
+	if spec, found := k.specKeeper.GetSpec(ctx, withheld.ChainID); found {
+		if factor, epochScore, below := k.minQoSRewardFactor(ctx, spec, withheld.Epoch, withheld.Provider); below {
+			details["epochQoSScore"] = epochScore.String()
+			details["minQoSScore"] = sdk.NewDecWithPrec(int64(spec.MinQosScorePercent), 2).String()
+			reward = reward.Mul(factor)
+		}
+	}
+	rewardCoins := sdk.Coins{sdk.Coin{Denom: epochstoragetypes.TokenDenom, Amount: reward.TruncateInt()}}
+	details["Mint"] = rewardCoins.String()
+	if !rewardCoins.AmountOf(epochstoragetypes.TokenDenom).IsZero() {
+		err = k.bankKeeper.MintCoins(ctx, types.ModuleName, rewardCoins)
+		if err != nil {
+			details["error"] = err.Error()
+			utils.LavaError(ctx, logger, types.ProviderWithheldRewardEventName, details, "MintCoins Failed,")
+			return
+		}
+		delegatorsReward, err := k.payProviderReward(ctx, withheld.ChainID, providerAddr, withheld.Epoch, rewardCoins[0], withheld.DelegatorsShare)
+		if err != nil {
+			details["error"] = err.Error()
+			utils.LavaError(ctx, logger, types.ProviderWithheldRewardEventName, details, "SendCoinsFromModuleToAccount Failed,")
+			return
+		}
+		details["delegatorsReward"] = delegatorsReward.String()
+	}
+	utils.LogLavaEvent(ctx, logger, types.ProviderWithheldRewardEventName, details, "Withheld Provider Reward Was Paid")
+}
+
+func (k Keeper) getProviderQoSReports(ctx sdk.Context, epoch uint64, chainID string, providerAddress string) (reports types.ProviderQoSReports, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ProviderQoSReportsKeyPrefix))
+	b := store.Get(types.ProviderQoSReportsKey(epoch, chainID, providerAddress))
+	if b == nil {
+		return reports, false
+	}
+	k.cdc.MustUnmarshal(b, &reports)
+	return reports, true
+}
+
+// GetProviderQoSReports returns the provider's qos reports sums of the stored epochs between fromEpoch and toEpoch that had reports, latest first
+func (k Keeper) GetProviderQoSReports(ctx sdk.Context, chainID string, providerAddress string, fromEpoch uint64, toEpoch uint64) (epochs []types.ProviderQoSReports) {
+	epochs = []types.ProviderQoSReports{}
+	earliestEpochStart := k.epochStorageKeeper.GetEarliestEpochStart(ctx)
+	if fromEpoch < earliestEpochStart {
+		fromEpoch = earliestEpochStart
+	}
+	epoch := k.epochStorageKeeper.GetEpochStart(ctx)
+	if toEpoch != 0 && toEpoch < epoch {
+		toEpochStart, _, err := k.epochStorageKeeper.GetEpochStartForBlock(ctx, toEpoch)
+		if err != nil {
+			return epochs
+		}
+		epoch = toEpochStart
+	}
+	for epoch >= fromEpoch {
+		if reports, found := k.getProviderQoSReports(ctx, epoch, chainID, providerAddress); found {
+			epochs = append(epochs, reports)
+		}
+		if epoch <= earliestEpochStart {
+			return epochs
+		}
+		previousEpoch, err := k.epochStorageKeeper.GetPreviousEpochStartForBlock(ctx, epoch)
+		if err != nil {
+			return epochs
+		}
+		epoch = previousEpoch
+	}
+	return epochs
+}
+
+// RemoveOldProviderQoSReports deletes the qos reports sums of epochs that can't be paid for anymore
+func (k Keeper) RemoveOldProviderQoSReports(ctx sdk.Context) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ProviderQoSReportsKeyPrefix))
+	for _, epoch := range k.epochStorageKeeper.GetDeletedEpochs(ctx) {
+		deleteAllKeys(prefix.NewStore(store, types.ProviderQoSReportsEpochKey(epoch)))
+	}
+}
@@ -10,7 +10,7 @@ import (
 	"github.com/lavanet/lava/x/pairing/types"
 )
 
-func (k Keeper) StakeNewEntry(ctx sdk.Context, provider bool, creator string, chainID string, amount sdk.Coin, endpoints []epochstoragetypes.Endpoint, geolocation uint64, vrfpk string, moniker string) error {
+func (k Keeper) StakeNewEntry(ctx sdk.Context, provider bool, creator string, chainID string, amount sdk.Coin, endpoints []epochstoragetypes.Endpoint, geolocation uint64, vrfpk string, moniker string, cuCapacity uint64) error {
 	logger := k.Logger(ctx)
 	var stake_type string
 	if provider {
@@ -79,6 +79,12 @@ func (k Keeper) StakeNewEntry(ctx sdk.Context, provider bool, creator string, ch
 
 	// new staking takes effect from the next block
 	stakeAppliedBlock := uint64(ctx.BlockHeight()) + 1
+	if provider {
+		// a provider jailed for unresponsiveness that unstaked and stakes again stays frozen until its jail ends
+		if jailEnd, found := k.getProviderJail(ctx, chainID, creator); found && jailEnd > stakeAppliedBlock {
+			stakeAppliedBlock = jailEnd
+		}
+	}
 
 	if len(moniker) > 50 {
 		moniker = moniker[:50]
@@ -112,6 +118,7 @@ func (k Keeper) StakeNewEntry(ctx sdk.Context, provider bool, creator string, ch
 			existingEntry.Geolocation = geolocation
 			existingEntry.Endpoints = endpoints
 			existingEntry.Moniker = moniker
+			existingEntry.CuCapacity = cuCapacity
 			k.epochStorageKeeper.ModifyStakeEntryCurrent(ctx, stake_type, chainID, existingEntry, indexInStakeStorage)
 			utils.LogLavaEvent(ctx, logger, types.StakeUpdateEventName(provider), details, "Changing Staked "+stake_type)
 			return nil
@@ -128,7 +135,10 @@ func (k Keeper) StakeNewEntry(ctx sdk.Context, provider bool, creator string, ch
 		return utils.LavaError(ctx, logger, "stake_"+stake_type+"_new_amount", details, "insufficient amount to pay for stake")
 	}
 
-	stakeEntry := epochstoragetypes.StakeEntry{Stake: amount, Address: creator, StakeAppliedBlock: stakeAppliedBlock, Endpoints: endpoints, Geolocation: geolocation, Chain: chainID, Vrfpk: vrfpk, Moniker: moniker}
+	stakeEntry := epochstoragetypes.StakeEntry{Stake: amount, Address: creator, StakeAppliedBlock: stakeAppliedBlock, Endpoints: endpoints, Geolocation: geolocation, Chain: chainID, Vrfpk: vrfpk, Moniker: moniker, DelegateTotal: sdk.NewCoin(epochstoragetypes.TokenDenom, sdk.ZeroInt()), CuCapacity: cuCapacity}
+	if provider {
+		stakeEntry.DelegateTotal = k.providerDelegateTotal(ctx, chainID, creator)
+	}
 	k.epochStorageKeeper.AppendStakeEntryCurrent(ctx, stake_type, chainID, stakeEntry)
 	appended := false
 	if !provider {
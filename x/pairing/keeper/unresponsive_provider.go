@@ -134,11 +134,7 @@ func (k Keeper) countCuForUnresponsiveness(ctx sdk.Context, epoch uint64, epochs
 		if found {
 			// counter is smaller than epochsNumToCheckCUForUnresponsiveProvider -> count CU serviced by the provider in the epoch
 			if counter < epochsNumToCheckCUForUnresponsiveProvider {
-				// count the CU by iterating through the uniquePaymentStorageClientProvider objects
-				for _, uniquePaymentKey := range providerPaymentStorage.GetUniquePaymentStorageClientProviderKeys() {
-					uniquePayment, _ := k.GetUniquePaymentStorageClientProvider(ctx, uniquePaymentKey)
-					providerServicedCu += uniquePayment.GetUsedCU()
-				}
+				providerServicedCu += k.getProviderServicedCu(ctx, providerPaymentStorage)
 			}
 
 			// counter is smaller than epochsNumToCheckCUForComplainers -> count complainer CU
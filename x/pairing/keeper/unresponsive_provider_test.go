@@ -6,7 +6,9 @@ import (
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/testutil/common"
 	testkeeper "github.com/lavanet/lava/testutil/keeper"
+	"github.com/lavanet/lava/utils"
 	"github.com/lavanet/lava/utils/sigs"
 	epochstoragetypes "github.com/lavanet/lava/x/epochstorage/types"
 	"github.com/lavanet/lava/x/pairing"
@@ -407,3 +409,76 @@ func TestNotUnstakingProviderForUnresponsivenessWithMinProviders(t *testing.T) {
 	require.True(t, stakeStorageFound)
 
 }
+
+func TestUnresponsiveReportsJailProvider(t *testing.T) {
+	testClientAmount := 3
+	testProviderAmount := 2
+	ts := setupClientsAndProvidersForUnresponsiveness(t, testClientAmount, testProviderAmount)
+
+	// jail a provider reported by two distinct consumers in an epoch
+	err := testkeeper.SimulateParamChange(sdk.UnwrapSDKContext(ts.ctx), ts.keepers.ParamsKeeper, types.ModuleName, string(types.KeyUnresponsiveReportersThreshold), "\"2\"")
+	require.Nil(t, err)
+	ts.ctx = testkeeper.AdvanceEpoch(ts.ctx, ts.keepers)
+
+	unresponsiveProvider := ts.providers[0].Addr
+	unresponsiveData, err := json.Marshal([]string{unresponsiveProvider.String()})
+	require.Nil(t, err)
+
+	pairing, err := ts.keepers.Pairing.GetPairingForClient(sdk.UnwrapSDKContext(ts.ctx), ts.spec.Name, ts.clients[0].Addr)
+	require.Nil(t, err)
+	require.Len(t, pairing, testProviderAmount)
+
+	report := func(client *common.Account, sessionID uint64) {
+		relaySession := common.BuildRelayRequest(ts.ctx, ts.providers[1].Addr.String(), []byte(ts.spec.Apis[0].Name), ts.spec.Apis[0].ComputeUnits, ts.spec.Name, nil)
+		relaySession.SessionId = sessionID
+		relaySession.UnresponsiveProviders = unresponsiveData
+		relaySession.Sig, err = sigs.SignRelay(client.SK, *relaySession)
+		require.Nil(t, err)
+		_, err = ts.servers.PairingServer.RelayPayment(ts.ctx, &types.MsgRelayPayment{Creator: ts.providers[1].Addr.String(), Relays: []*types.RelaySession{relaySession}})
+		require.Nil(t, err)
+	}
+
+	// repeated reports of a consumer count once
+	report(ts.clients[0], 1)
+	report(ts.clients[0], 2)
+	block := uint64(sdk.UnwrapSDKContext(ts.ctx).BlockHeight())
+	require.False(t, ts.keepers.Pairing.IsProviderJailed(sdk.UnwrapSDKContext(ts.ctx), ts.spec.Name, unresponsiveProvider, block))
+
+	report(ts.clients[1], 1)
+	require.True(t, ts.keepers.Pairing.IsProviderJailed(sdk.UnwrapSDKContext(ts.ctx), ts.spec.Name, unresponsiveProvider, block))
+
+	jailedEvent := false
+	for _, event := range sdk.UnwrapSDKContext(ts.ctx).EventManager().Events() {
+		if event.Type == utils.EventPrefix+types.ProviderUnresponsiveJailedEventName {
+			jailedEvent = true
+		}
+	}
+	require.True(t, jailedEvent)
+
+	epoch := ts.keepers.Epochstorage.GetEpochStart(sdk.UnwrapSDKContext(ts.ctx))
+	res, err := ts.keepers.Pairing.UnresponsiveReports(ts.ctx, &types.QueryUnresponsiveReportsRequest{ChainID: ts.spec.Name, Provider: unresponsiveProvider.String()})
+	require.Nil(t, err)
+	require.Equal(t, []types.UnresponsiveReportCount{{Epoch: epoch, Reporters: 2}}, res.Reports)
+	require.NotZero(t, res.JailEndBlock)
+
+	// a jailed provider can't unfreeze itself
+	_, err = ts.servers.PairingServer.UnfreezeProvider(ts.ctx, &types.MsgUnfreezeProvider{Creator: unresponsiveProvider.String(), ChainIds: []string{ts.spec.Name}})
+	require.Nil(t, err)
+	stakeEntry, found, _ := ts.keepers.Epochstorage.GetStakeEntryByAddressCurrent(sdk.UnwrapSDKContext(ts.ctx), epochstoragetypes.ProviderKey, ts.spec.Name, unresponsiveProvider)
+	require.True(t, found)
+	require.Equal(t, res.JailEndBlock, stakeEntry.StakeAppliedBlock)
+
+	// the provider is out of the pairing for the jail epochs
+	for i := uint64(0); i < types.DefaultUnresponsiveJailEpochs; i++ {
+		ts.ctx = testkeeper.AdvanceEpoch(ts.ctx, ts.keepers)
+		pairing, err = ts.keepers.Pairing.GetPairingForClient(sdk.UnwrapSDKContext(ts.ctx), ts.spec.Name, ts.clients[2].Addr)
+		require.Nil(t, err)
+		require.Len(t, pairing, 1)
+		require.Equal(t, ts.providers[1].Addr.String(), pairing[0].Address)
+	}
+
+	ts.ctx = testkeeper.AdvanceEpoch(ts.ctx, ts.keepers)
+	pairing, err = ts.keepers.Pairing.GetPairingForClient(sdk.UnwrapSDKContext(ts.ctx), ts.spec.Name, ts.clients[2].Addr)
+	require.Nil(t, err)
+	require.Len(t, pairing, testProviderAmount)
+}
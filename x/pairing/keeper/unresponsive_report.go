@@ -0,0 +1,133 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/utils"
+	epochstoragetypes "github.com/lavanet/lava/x/epochstorage/types"
+	"github.com/lavanet/lava/x/pairing/types"
+)
+
+// ReportUnresponsiveProvider records a consumer's unresponsiveness report on a provider in an epoch, a provider reported by
+// UnresponsiveReportersThreshold distinct consumers in an epoch is jailed. Repeated reports of the same consumer count once
+func (k Keeper) ReportUnresponsiveProvider(ctx sdk.Context, epoch uint64, chainID string, providerAddr sdk.AccAddress, consumerAddr sdk.AccAddress) error {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.UnresponsiveReportKeyPrefix))
+	key := types.UnresponsiveReportKey(epoch, chainID, providerAddr.String(), consumerAddr.String())
+	if store.Has(key) {
+		return nil
+	}
+	store.Set(key, []byte{})
+
+	threshold := k.UnresponsiveReportersThreshold(ctx)
+	if threshold == 0 {
+		return nil
+	}
+	reporters := k.countUnresponsiveReporters(ctx, epoch, chainID, providerAddr.String())
+	if reporters != threshold {
+		// jail once when reaching the threshold, later reporters of the epoch don't extend the jail
+		return nil
+	}
+	return k.jailUnresponsiveProvider(ctx, epoch, chainID, providerAddr, reporters)
+}
+
+func (k Keeper) countUnresponsiveReporters(ctx sdk.Context, epoch uint64, chainID string, providerAddress string) (reporters uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.UnresponsiveReportKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, types.UnresponsiveReportProviderKey(epoch, chainID, providerAddress))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		reporters++
+	}
+	return reporters
+}
+
+// jailUnresponsiveProvider freezes the provider from the next epoch for the UnresponsiveJailEpochs param epochs, the provider
+// can't unfreeze itself before the jail ends
+func (k Keeper) jailUnresponsiveProvider(ctx sdk.Context, epoch uint64, chainID string, providerAddr sdk.AccAddress, reporters uint64) error {
+	epochBlocks, err := k.epochStorageKeeper.EpochBlocks(ctx, uint64(ctx.BlockHeight()))
+	if err != nil {
+		return err
+	}
+	jailEnd, found := k.jailProvider(ctx, chainID, providerAddr, k.epochStorageKeeper.GetEpochStart(ctx)+(k.UnresponsiveJailEpochs(ctx)+1)*epochBlocks)
+	if !found {
+		// the provider already unstaked, nothing to jail
+		return nil
+	}
+
+	details := map[string]string{"provider": providerAddr.String(), "chainID": chainID, "epoch": strconv.FormatUint(epoch, 10), "reporters": strconv.FormatUint(reporters, 10), "jailEndBlock": strconv.FormatUint(jailEnd, 10)}
+	utils.LogLavaEvent(ctx, k.Logger(ctx), types.ProviderUnresponsiveJailedEventName, details, "provider jailed for being reported unresponsive by consumers")
+	return nil
+}
+
+// jailProvider freezes a staked provider until jailEnd, a provider already jailed or frozen for longer (e.g. by itself) stays so.
+// returns the provider's jail end, found is false when the provider isn't staked
+func (k Keeper) jailProvider(ctx sdk.Context, chainID string, providerAddr sdk.AccAddress, jailEnd uint64) (providerJailEnd uint64, found bool) {
+	stakeEntry, found, index := k.epochStorageKeeper.GetStakeEntryByAddressCurrent(ctx, epochstoragetypes.ProviderKey, chainID, providerAddr)
+	if !found {
+		return 0, false
+	}
+
+	if existingJailEnd, found := k.getProviderJail(ctx, chainID, providerAddr.String()); found && existingJailEnd > jailEnd {
+		jailEnd = existingJailEnd
+	}
+	k.setProviderJail(ctx, chainID, providerAddr.String(), jailEnd)
+
+	if stakeEntry.StakeAppliedBlock < jailEnd {
+		stakeEntry.StakeAppliedBlock = jailEnd
+		k.epochStorageKeeper.ModifyStakeEntryCurrent(ctx, epochstoragetypes.ProviderKey, chainID, stakeEntry, index)
+	}
+	return jailEnd, true
+}
+
+func (k Keeper) setProviderJail(ctx sdk.Context, chainID string, providerAddress string, jailEnd uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ProviderJailKeyPrefix))
+	store.Set(types.ProviderJailKey(chainID, providerAddress), sdk.Uint64ToBigEndian(jailEnd))
+}
+
+func (k Keeper) getProviderJail(ctx sdk.Context, chainID string, providerAddress string) (jailEnd uint64, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ProviderJailKeyPrefix))
+	b := store.Get(types.ProviderJailKey(chainID, providerAddress))
+	if b == nil {
+		return 0, false
+	}
+	return binary.BigEndian.Uint64(b), true
+}
+
+// IsProviderJailed returns whether the provider's jail didn't end by block
+func (k Keeper) IsProviderJailed(ctx sdk.Context, chainID string, providerAddr sdk.AccAddress, block uint64) bool {
+	jailEnd, found := k.getProviderJail(ctx, chainID, providerAddr.String())
+	return found && block < jailEnd
+}
+
+// GetUnresponsiveReports returns the distinct reporters on the provider in each of the stored epochs that had reports, latest first
+func (k Keeper) GetUnresponsiveReports(ctx sdk.Context, chainID string, providerAddress string) (reports []types.UnresponsiveReportCount) {
+	reports = []types.UnresponsiveReportCount{}
+	earliestEpochStart := k.epochStorageKeeper.GetEarliestEpochStart(ctx)
+	epoch := k.epochStorageKeeper.GetEpochStart(ctx)
+	for {
+		if reporters := k.countUnresponsiveReporters(ctx, epoch, chainID, providerAddress); reporters > 0 {
+			reports = append(reports, types.UnresponsiveReportCount{Epoch: epoch, Reporters: reporters})
+		}
+		if epoch <= earliestEpochStart {
+			return reports
+		}
+		previousEpoch, err := k.epochStorageKeeper.GetPreviousEpochStartForBlock(ctx, epoch)
+		if err != nil {
+			return reports
+		}
+		epoch = previousEpoch
+	}
+}
+
+// RemoveOldUnresponsiveReports deletes the unresponsiveness reports of epochs that can't be paid for anymore and jails that ended before them
+func (k Keeper) RemoveOldUnresponsiveReports(ctx sdk.Context) {
+	reportStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.UnresponsiveReportKeyPrefix))
+	for _, epoch := range k.epochStorageKeeper.GetDeletedEpochs(ctx) {
+		deleteAllKeys(prefix.NewStore(reportStore, types.ClientOveruseEpochKey(epoch)))
+	}
+
+	jailStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ProviderJailKeyPrefix))
+	deleteEndedJails(jailStore, k.epochStorageKeeper.GetEarliestEpochStart(ctx), func(jail []byte) uint64 { return binary.BigEndian.Uint64(jail) })
+}
@@ -78,7 +78,8 @@ func (k Keeper) CheckUnstakingForCommit(ctx sdk.Context) error {
 			panic(err.Error())
 		}
 	}
-	return nil
+	// and undelegations that finished unbonding
+	return k.creditUndelegations(ctx, k.epochStorageKeeper.PopUnstakeEntries(ctx, types.UndelegationStorageType, uint64(ctx.BlockHeight())))
 }
 
 func (k Keeper) creditUnstakingEntries(ctx sdk.Context, provider bool, entriesToUnstake []epochstoragetypes.StakeEntry) error {
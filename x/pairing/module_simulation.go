@@ -52,6 +52,22 @@ const (
 	// TODO: Determine the simulation weight value
 	defaultWeightMsgUnfreeze int = 100
 
+	opWeightMsgDelegateToProvider = "op_weight_msg_delegate_to_provider"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgDelegateToProvider int = 100
+
+	opWeightMsgUndelegate = "op_weight_msg_undelegate"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgUndelegate int = 100
+
+	opWeightMsgSetProviderMetadata = "op_weight_msg_set_provider_metadata"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgSetProviderMetadata int = 100
+
+	opWeightMsgAttestEndpointHealth = "op_weight_msg_attest_endpoint_health"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgAttestEndpointHealth int = 100
+
 	// this line is used by starport scaffolding # simapp/module/const
 )
 
@@ -185,6 +201,50 @@ func (am AppModule) WeightedOperations(simState module.SimulationState) []simtyp
 		pairingsimulation.SimulateMsgUnfreeze(am.accountKeeper, am.bankKeeper, am.keeper),
 	))
 
+	var weightMsgDelegateToProvider int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgDelegateToProvider, &weightMsgDelegateToProvider, nil,
+		func(_ *rand.Rand) {
+			weightMsgDelegateToProvider = defaultWeightMsgDelegateToProvider
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgDelegateToProvider,
+		pairingsimulation.SimulateMsgDelegateToProvider(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgUndelegate int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgUndelegate, &weightMsgUndelegate, nil,
+		func(_ *rand.Rand) {
+			weightMsgUndelegate = defaultWeightMsgUndelegate
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgUndelegate,
+		pairingsimulation.SimulateMsgUndelegate(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgSetProviderMetadata int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgSetProviderMetadata, &weightMsgSetProviderMetadata, nil,
+		func(_ *rand.Rand) {
+			weightMsgSetProviderMetadata = defaultWeightMsgSetProviderMetadata
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgSetProviderMetadata,
+		pairingsimulation.SimulateMsgSetProviderMetadata(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgAttestEndpointHealth int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgAttestEndpointHealth, &weightMsgAttestEndpointHealth, nil,
+		func(_ *rand.Rand) {
+			weightMsgAttestEndpointHealth = defaultWeightMsgAttestEndpointHealth
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgAttestEndpointHealth,
+		pairingsimulation.SimulateMsgAttestEndpointHealth(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
 	// this line is used by starport scaffolding # simapp/module/operation
 
 	return operations
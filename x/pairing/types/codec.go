@@ -15,6 +15,10 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgRelayPayment{}, "pairing/RelayPayment", nil)
 	cdc.RegisterConcrete(&MsgFreezeProvider{}, "pairing/Freeze", nil)
 	cdc.RegisterConcrete(&MsgUnfreezeProvider{}, "pairing/Unfreeze", nil)
+	cdc.RegisterConcrete(&MsgDelegateToProvider{}, "pairing/DelegateToProvider", nil)
+	cdc.RegisterConcrete(&MsgUndelegate{}, "pairing/Undelegate", nil)
+	cdc.RegisterConcrete(&MsgSetProviderMetadata{}, "pairing/SetProviderMetadata", nil)
+	cdc.RegisterConcrete(&MsgAttestEndpointHealth{}, "pairing/AttestEndpointHealth", nil)
 	// this line is used by starport scaffolding # 2
 }
 
@@ -40,6 +44,18 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgUnfreezeProvider{},
 	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgDelegateToProvider{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgUndelegate{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSetProviderMetadata{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgAttestEndpointHealth{},
+	)
 	// this line is used by starport scaffolding # 3
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
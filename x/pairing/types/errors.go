@@ -17,4 +17,9 @@ var (
 	FreezeStakeEntryNotFoundError                      = sdkerrors.New("FreezeStakeEntryNotFoundError Error", 690, "can't get stake entry to freeze")
 	MonikerTooLongError                                = sdkerrors.New("MonikerTooLongError Error", 691, "The provider's moniker is too long. Keep it less than 50 characters")
 	MonikerEmptyError                                  = sdkerrors.New("MonikerEmptyError Error", 692, "The provider's moniker cannot be empty")
+	ProviderMetadataTooLongError                       = sdkerrors.New("ProviderMetadataTooLongError Error", 693, "A provider metadata field is too long. Keep the website and contact less than 100 characters and the description less than 300")
+	UnsupportedAddonError                              = sdkerrors.New("UnsupportedAddonError Error", 694, "The provider advertised an unsupported or duplicated add-on")
+	FreezeUnfreezeBlockError                           = sdkerrors.New("FreezeUnfreezeBlockError Error", 695, "The automatic unfreeze of a freeze must be after the current block and set either by block or by epochs, not both")
+	AttestationChainIDsError                           = sdkerrors.New("AttestationChainIDsError Error", 696, "An endpoint attestation must list at least one chain and no chain twice")
+	AttestationVersionTooLongError                     = sdkerrors.New("AttestationVersionTooLongError Error", 697, "The attested binary version is too long. Keep it less than 50 characters")
 )
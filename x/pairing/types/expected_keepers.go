@@ -23,6 +23,7 @@ type EpochstorageKeeper interface {
 	// Methods imported from epochStorage should be defined here
 	// Methods imported from bank should be defined here
 	GetParamForBlock(ctx sdk.Context, fixationKey string, block uint64, param any) error
+	LatestFixatedParams(ctx sdk.Context, fixationKey string) (fixation epochstoragetypes.FixatedParams, found bool)
 	GetEpochStart(ctx sdk.Context) uint64
 	GetEarliestEpochStart(ctx sdk.Context) uint64
 	UnstakeHoldBlocks(ctx sdk.Context, block uint64) (res uint64)
@@ -45,6 +46,7 @@ type EpochstorageKeeper interface {
 	GetStakeEntryByAddressFromStorage(ctx sdk.Context, stakeStorage epochstoragetypes.StakeStorage, address sdk.AccAddress) (value epochstoragetypes.StakeEntry, found bool, index uint64)
 	GetNextEpoch(ctx sdk.Context, block uint64) (nextEpoch uint64, erro error)
 	GetStakeEntryForClientEpoch(ctx sdk.Context, chainID string, selectedClient sdk.AccAddress, epoch uint64) (entry *epochstoragetypes.StakeEntry, err error)
+	GetStakeEntryForProviderEpoch(ctx sdk.Context, chainID string, selectedProvider sdk.AccAddress, epoch uint64) (entry *epochstoragetypes.StakeEntry, err error)
 	BypassCurrentAndAppendNewEpochStakeEntry(ctx sdk.Context, storageType string, chainID string, stakeEntry epochstoragetypes.StakeEntry) (added bool, err error)
 	AddFixationRegistry(fixationKey string, getParamFunction func(sdk.Context) any)
 	GetDeletedEpochs(ctx sdk.Context) []uint64
@@ -69,6 +71,8 @@ type BankKeeper interface {
 
 type ProjectsKeeper interface {
 	ChargeComputeUnitsToProject(ctx sdk.Context, project projectstypes.Project, cu uint64) (err error)
+	AddProjectUsage(ctx sdk.Context, projectID string, developerKey string, chainID string, epoch uint64, cu uint64)
+	GetProjectChainUsage(ctx sdk.Context, projectID string, chainID string, epoch uint64) (usedCu uint64)
 	GetProjectForDeveloper(ctx sdk.Context, developerKey string, blockHeight uint64) (proj projectstypes.Project, vrfpk string, errRet error)
 }
 
@@ -13,6 +13,8 @@ func DefaultGenesis() *GenesisState {
 		UniquePaymentStorageClientProviderList: []UniquePaymentStorageClientProvider{},
 		ProviderPaymentStorageList:             []ProviderPaymentStorage{},
 		EpochPaymentsList:                      []EpochPayments{},
+		DelegationList:                         []Delegation{},
+		ProviderMetadataList:                   []ProviderMetadata{},
 		// this line is used by starport scaffolding # genesis/types/default
 		Params: DefaultParams(),
 	}
@@ -51,6 +53,26 @@ func (gs GenesisState) Validate() error {
 		}
 		epochPaymentsIndexMap[index] = struct{}{}
 	}
+	// Check for duplicated index in delegation
+	delegationIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.DelegationList {
+		index := string(DelegationKey(elem.ChainID, elem.Provider, elem.Delegator))
+		if _, ok := delegationIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for delegation")
+		}
+		delegationIndexMap[index] = struct{}{}
+	}
+	// Check for duplicated index in providerMetadata
+	providerMetadataIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.ProviderMetadataList {
+		index := string(ProviderMetadataKey(elem.Provider))
+		if _, ok := providerMetadataIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for providerMetadata")
+		}
+		providerMetadataIndexMap[index] = struct{}{}
+	}
 	// this line is used by starport scaffolding # genesis/types/validate
 
 	return gs.Params.Validate()
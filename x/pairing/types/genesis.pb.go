@@ -29,6 +29,8 @@ type GenesisState struct {
 	UniquePaymentStorageClientProviderList []UniquePaymentStorageClientProvider `protobuf:"bytes,2,rep,name=uniquePaymentStorageClientProviderList,proto3" json:"uniquePaymentStorageClientProviderList"`
 	ProviderPaymentStorageList             []ProviderPaymentStorage             `protobuf:"bytes,3,rep,name=providerPaymentStorageList,proto3" json:"providerPaymentStorageList"`
 	EpochPaymentsList                      []EpochPayments                      `protobuf:"bytes,4,rep,name=epochPaymentsList,proto3" json:"epochPaymentsList"`
+	DelegationList                         []Delegation                         `protobuf:"bytes,5,rep,name=delegationList,proto3" json:"delegationList"`
+	ProviderMetadataList                   []ProviderMetadata                   `protobuf:"bytes,6,rep,name=providerMetadataList,proto3" json:"providerMetadataList"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -92,6 +94,20 @@ func (m *GenesisState) GetEpochPaymentsList() []EpochPayments {
 	return nil
 }
 
+func (m *GenesisState) GetDelegationList() []Delegation {
+	if m != nil {
+		return m.DelegationList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetProviderMetadataList() []ProviderMetadata {
+	if m != nil {
+		return m.ProviderMetadataList
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "lavanet.lava.pairing.GenesisState")
 }
@@ -99,29 +115,33 @@ func init() {
 func init() { proto.RegisterFile("pairing/genesis.proto", fileDescriptor_9f33c5159def4248) }
 
 var fileDescriptor_9f33c5159def4248 = []byte{
-	// 345 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x2d, 0x48, 0xcc, 0x2c,
-	0xca, 0xcc, 0x4b, 0xd7, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f,
-	0xc9, 0x17, 0x12, 0xc9, 0x49, 0x2c, 0x4b, 0xcc, 0x4b, 0x2d, 0xd1, 0x03, 0xd1, 0x7a, 0x50, 0x35,
-	0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0x05, 0xfa, 0x20, 0x16, 0x44, 0xad, 0x94, 0x08, 0xcc,
-	0x88, 0x82, 0xc4, 0xa2, 0xc4, 0x5c, 0xa8, 0x09, 0x52, 0x26, 0x30, 0xd1, 0xd2, 0xbc, 0xcc, 0xc2,
-	0xd2, 0xd4, 0xf8, 0x82, 0xc4, 0xca, 0xdc, 0xd4, 0xbc, 0x92, 0xf8, 0xe2, 0x92, 0xfc, 0xa2, 0xc4,
-	0xf4, 0xd4, 0xf8, 0xe4, 0x9c, 0x4c, 0x10, 0xb7, 0xa0, 0x28, 0xbf, 0x2c, 0x33, 0x25, 0xb5, 0x08,
-	0xaa, 0x4b, 0x0d, 0x6e, 0x16, 0x54, 0x1c, 0x5d, 0x1f, 0x54, 0x9d, 0x0c, 0x4c, 0x5d, 0x6a, 0x41,
-	0x7e, 0x72, 0x06, 0x4c, 0x11, 0xd4, 0x6e, 0xa5, 0x0d, 0xcc, 0x5c, 0x3c, 0xee, 0x10, 0xff, 0x04,
-	0x97, 0x24, 0x96, 0xa4, 0x0a, 0x59, 0x71, 0xb1, 0x41, 0x1c, 0x27, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1,
-	0x6d, 0x24, 0xa3, 0x87, 0xcd, 0x7f, 0x7a, 0x01, 0x60, 0x35, 0x4e, 0x2c, 0x27, 0xee, 0xc9, 0x33,
-	0x04, 0x41, 0x75, 0x08, 0x4d, 0x63, 0xe4, 0x52, 0x83, 0xf8, 0x21, 0x00, 0x62, 0x4b, 0x30, 0xc4,
-	0x25, 0xce, 0x60, 0x0f, 0x04, 0x40, 0xdd, 0xe9, 0x93, 0x59, 0x5c, 0x22, 0xc1, 0xa4, 0xc0, 0xac,
-	0xc1, 0x6d, 0x64, 0x81, 0xdd, 0xf0, 0x50, 0x82, 0x66, 0x40, 0x2d, 0x26, 0xd2, 0x36, 0xa1, 0x22,
-	0x2e, 0x29, 0x58, 0x28, 0xa1, 0xaa, 0x05, 0xbb, 0x85, 0x19, 0xec, 0x16, 0x1d, 0x1c, 0x1e, 0xc5,
-	0xaa, 0x0f, 0x6a, 0x3f, 0x1e, 0x53, 0x85, 0xc2, 0xb9, 0x04, 0xc1, 0x21, 0x0e, 0x95, 0x2a, 0x06,
-	0x5b, 0xc5, 0x02, 0xb6, 0x4a, 0x19, 0xbb, 0x55, 0xae, 0xc8, 0xca, 0xa1, 0x36, 0x60, 0x9a, 0xe1,
-	0xe4, 0x78, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78,
-	0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0xea, 0xe9, 0x99, 0x25,
-	0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0x50, 0x1b, 0xc0, 0xb4, 0x7e, 0x85, 0x3e, 0x2c,
-	0x11, 0x94, 0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81, 0x23, 0xdf, 0x18, 0x10, 0x00, 0x00, 0xff,
-	0xff, 0x6d, 0x04, 0x57, 0x99, 0xd3, 0x02, 0x00, 0x00,
+	// 409 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xc1, 0x4e, 0xea, 0x40,
+	0x14, 0x86, 0xdb, 0x0b, 0x97, 0xc5, 0x70, 0x73, 0x93, 0xdb, 0xf4, 0x26, 0xa4, 0x21, 0x85, 0x68,
+	0x82, 0x2c, 0x4c, 0x9b, 0xa0, 0x0b, 0xe3, 0x4e, 0xd4, 0xb8, 0x51, 0x43, 0x24, 0xc6, 0xc4, 0x0d,
+	0x0e, 0x30, 0x29, 0x93, 0xd0, 0x4e, 0x6d, 0x07, 0x22, 0x6f, 0xe1, 0xca, 0x67, 0x62, 0xc9, 0xd2,
+	0x95, 0x31, 0xe5, 0x45, 0x0c, 0xa7, 0x67, 0x50, 0xa1, 0xa2, 0xab, 0x69, 0x67, 0xfe, 0xf3, 0xfd,
+	0xe7, 0x3f, 0x33, 0xe4, 0x7f, 0x48, 0x79, 0xc4, 0x03, 0xcf, 0xf5, 0x58, 0xc0, 0x62, 0x1e, 0x3b,
+	0x61, 0x24, 0xa4, 0x30, 0xcc, 0x21, 0x1d, 0xd3, 0x80, 0x49, 0x67, 0xb1, 0x3a, 0xa8, 0xb1, 0x4c,
+	0x4f, 0x78, 0x02, 0x04, 0xee, 0xe2, 0x2b, 0xd5, 0x5a, 0xa6, 0x42, 0x84, 0x34, 0xa2, 0x3e, 0x12,
+	0xac, 0x7d, 0xb5, 0x3b, 0x0a, 0xf8, 0xfd, 0x88, 0x75, 0x42, 0x3a, 0xf1, 0x59, 0x20, 0x3b, 0xb1,
+	0x14, 0x11, 0xf5, 0x58, 0xa7, 0x37, 0xe4, 0x8b, 0xdf, 0x30, 0x12, 0x63, 0xde, 0x67, 0x11, 0x56,
+	0xd5, 0x96, 0x2c, 0xdc, 0x5f, 0xad, 0x43, 0x5d, 0x59, 0xe9, 0x58, 0x28, 0x7a, 0x03, 0x25, 0x52,
+	0xde, 0x25, 0x75, 0xda, 0x67, 0x43, 0xe6, 0x51, 0xc9, 0x45, 0x80, 0x27, 0x95, 0x35, 0xbe, 0xcf,
+	0x24, 0xed, 0x53, 0x49, 0x53, 0xc1, 0x56, 0x92, 0x27, 0x7f, 0xce, 0xd2, 0x51, 0xb4, 0x25, 0x95,
+	0xcc, 0x38, 0x24, 0x85, 0x34, 0x57, 0x49, 0xaf, 0xea, 0xf5, 0x62, 0xa3, 0xec, 0x64, 0x8d, 0xc6,
+	0x69, 0x81, 0xa6, 0x99, 0x9f, 0xbe, 0x54, 0xb4, 0x2b, 0xac, 0x30, 0x9e, 0x74, 0x52, 0x4b, 0xe3,
+	0xb7, 0xd2, 0x06, 0xdb, 0x69, 0x88, 0x63, 0xc8, 0xde, 0xc2, 0x16, 0xce, 0x79, 0x2c, 0x4b, 0xbf,
+	0xaa, 0xb9, 0x7a, 0xb1, 0x71, 0x90, 0x0d, 0xbf, 0xfe, 0x96, 0x81, 0xc6, 0x3f, 0x74, 0x33, 0x22,
+	0x62, 0xa9, 0x01, 0x7c, 0xd6, 0x42, 0x2f, 0x39, 0xe8, 0x65, 0xf7, 0x8b, 0xa0, 0x99, 0x75, 0xe8,
+	0xbf, 0x81, 0x6a, 0xdc, 0x90, 0x7f, 0x70, 0x59, 0x78, 0x14, 0x83, 0x55, 0x1e, 0xac, 0xb6, 0xb3,
+	0xad, 0x4e, 0x3f, 0xca, 0xd1, 0x61, 0x9d, 0x61, 0x5c, 0x92, 0xbf, 0xef, 0xf7, 0x0c, 0xd4, 0xdf,
+	0x40, 0xad, 0x66, 0x53, 0x4f, 0x96, 0x5a, 0x44, 0xae, 0x54, 0x1b, 0x77, 0xc4, 0x54, 0x31, 0x2e,
+	0xf0, 0x71, 0x00, 0xb5, 0x00, 0xd4, 0xda, 0xe6, 0xb1, 0xa8, 0x0a, 0x64, 0x67, 0x92, 0x9a, 0x47,
+	0xd3, 0xc4, 0xd6, 0x67, 0x89, 0xad, 0xbf, 0x26, 0xb6, 0xfe, 0x38, 0xb7, 0xb5, 0xd9, 0xdc, 0xd6,
+	0x9e, 0xe7, 0xb6, 0x76, 0xbb, 0xe3, 0x71, 0x39, 0x18, 0x75, 0x9d, 0x9e, 0xf0, 0x5d, 0xf4, 0x81,
+	0xd5, 0x7d, 0x70, 0xd5, 0xcb, 0x95, 0x93, 0x90, 0xc5, 0xdd, 0x02, 0x3c, 0xd7, 0xbd, 0xb7, 0x01,
+	0x00, 0xa2, 0xf6, 0x18, 0x99, 0xc0, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -144,6 +164,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ProviderMetadataList) > 0 {
+		for iNdEx := len(m.ProviderMetadataList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProviderMetadataList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.DelegationList) > 0 {
+		for iNdEx := len(m.DelegationList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegationList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.EpochPaymentsList) > 0 {
 		for iNdEx := len(m.EpochPaymentsList) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -236,6 +284,18 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.DelegationList) > 0 {
+		for _, e := range m.DelegationList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ProviderMetadataList) > 0 {
+		for _, e := range m.ProviderMetadataList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -409,6 +469,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegationList = append(m.DelegationList, Delegation{})
+			if err := m.DelegationList[len(m.DelegationList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderMetadataList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderMetadataList = append(m.ProviderMetadataList, ProviderMetadata{})
+			if err := m.ProviderMetadataList[len(m.ProviderMetadataList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -95,6 +95,40 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "duplicated delegation",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				DelegationList: []types.Delegation{
+					{
+						Provider:  "0",
+						ChainID:   "0",
+						Delegator: "0",
+					},
+					{
+						Provider:  "0",
+						ChainID:   "0",
+						Delegator: "0",
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated providerMetadata",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				ProviderMetadataList: []types.ProviderMetadata{
+					{
+						Provider: "0",
+					},
+					{
+						Provider: "0",
+					},
+				},
+			},
+			valid: false,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
@@ -0,0 +1,13 @@
+package types
+
+const (
+	// BadgeUsedCuKeyPrefix is the prefix of the cu paid to providers for the relays of badges, by epoch
+	BadgeUsedCuKeyPrefix = "BadgeUsedCu/value/"
+)
+
+// BadgeUsedCuKey returns the store key of the cu a provider was paid for the relays of a badge user in an epoch,
+// under BadgeUsedCuKeyPrefix. the epoch prefix is the one of the client penalties so they are removed the same way
+func BadgeUsedCuKey(epoch uint64, badgeUserAddress string, providerAddress string) []byte {
+	key := ClientOveruseEpochKey(epoch)
+	return append(key, []byte(badgeUserAddress+"/"+providerAddress+"/")...)
+}
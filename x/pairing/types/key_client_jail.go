@@ -0,0 +1,43 @@
+package types
+
+import (
+	"encoding/binary"
+)
+
+const (
+	// ClientOveruseKeyPrefix is the prefix of the cu overuse incidents of clients, by epoch
+	ClientOveruseKeyPrefix = "ClientOveruse/value/"
+	// ClientJailKeyPrefix is the prefix of the jailed clients
+	ClientJailKeyPrefix = "ClientJail/value/"
+	// ClientSessionKeyPrefix is the prefix of the providers paid for the sessions of clients, by epoch
+	ClientSessionKeyPrefix = "ClientSession/value/"
+)
+
+// ClientOveruseEpochKey returns the store key prefix of the cu overuse incidents of an epoch
+func ClientOveruseEpochKey(epoch uint64) []byte {
+	key := make([]byte, 8, 9)
+	binary.BigEndian.PutUint64(key, epoch)
+	return append(key, []byte("/")...)
+}
+
+// ClientOveruseKey returns the store key of the cu overuse incidents of a client in an epoch, under ClientOveruseKeyPrefix
+func ClientOveruseKey(epoch uint64, chainID string, clientAddress string) []byte {
+	key := ClientOveruseEpochKey(epoch)
+	key = append(key, []byte(chainID+"/"+clientAddress+"/")...)
+	return key
+}
+
+// ClientSessionKey returns the store key of the provider paid for a client's session in an epoch, under ClientSessionKeyPrefix
+func ClientSessionKey(epoch uint64, chainID string, clientAddress string, sessionID uint64) []byte {
+	// the epoch prefix is shared with the overuse incidents so both are removed the same way
+	key := ClientOveruseEpochKey(epoch)
+	key = append(key, []byte(chainID+"/"+clientAddress+"/")...)
+	sessionKey := make([]byte, 8)
+	binary.BigEndian.PutUint64(sessionKey, sessionID)
+	return append(append(key, sessionKey...), []byte("/")...)
+}
+
+// ClientJailKey returns the store key of a jailed client, under ClientJailKeyPrefix
+func ClientJailKey(chainID string, clientAddress string) []byte {
+	return []byte(chainID + "/" + clientAddress + "/")
+}
@@ -0,0 +1,39 @@
+package types
+
+import "encoding/binary"
+
+const (
+	// DelegationKeyPrefix is the prefix to retrieve all Delegation
+	DelegationKeyPrefix = "Delegation/value/"
+
+	// DelegationSnapshotKeyPrefix is the prefix of the delegations as they were at the start of the epochs they changed in
+	DelegationSnapshotKeyPrefix = "DelegationSnapshot/value/"
+
+	// UndelegationStorageType is the epochstorage unstake storage holding undelegated amounts until their unbonding ends
+	UndelegationStorageType = "undelegation"
+)
+
+// ProviderDelegationsKey returns the store key prefix of the delegations to a provider on a chain
+func ProviderDelegationsKey(chainID string, provider string) []byte {
+	return []byte(chainID + "/" + provider + "/")
+}
+
+// DelegationKey returns the store key to retrieve a Delegation from the index fields
+func DelegationKey(chainID string, provider string, delegator string) []byte {
+	key := ProviderDelegationsKey(chainID, provider)
+	key = append(key, []byte(delegator+"/")...)
+	return key
+}
+
+// DelegationSnapshotKey returns the store key of a delegation as it was at the start of an epoch it changed in, under DelegationSnapshotKeyPrefix.
+// the snapshots of a delegation are ordered by their epoch
+func DelegationSnapshotKey(chainID string, provider string, delegator string, epoch uint64) []byte {
+	epochBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(epochBytes, epoch)
+	return append(DelegationKey(chainID, provider, delegator), epochBytes...)
+}
+
+// DelegationSnapshotEpoch returns the epoch of a delegation snapshot from its store key
+func DelegationSnapshotEpoch(key []byte) uint64 {
+	return binary.BigEndian.Uint64(key[len(key)-8:])
+}
@@ -0,0 +1,30 @@
+package types
+
+import (
+	"encoding/binary"
+)
+
+const (
+	// ProviderAttestationKeyPrefix is the prefix of the latest endpoint attestations of providers
+	ProviderAttestationKeyPrefix = "ProviderAttestation/value/"
+	// StaleProviderKeyPrefix is the prefix of the providers left out of the pairing for a stale attestation, by epoch
+	StaleProviderKeyPrefix = "StaleProvider/value/"
+)
+
+// ProviderAttestationKey returns the store key of a provider's attestation on a chain, under ProviderAttestationKeyPrefix
+func ProviderAttestationKey(chainID string, providerAddress string) []byte {
+	return []byte(chainID + "/" + providerAddress + "/")
+}
+
+// StaleProviderEpochKey returns the store key prefix of the stale providers of an epoch
+func StaleProviderEpochKey(epoch uint64) []byte {
+	key := make([]byte, 8, 9)
+	binary.BigEndian.PutUint64(key, epoch)
+	return append(key, []byte("/")...)
+}
+
+// StaleProviderKey returns the store key of a provider with a stale attestation on a chain in an epoch, under StaleProviderKeyPrefix
+func StaleProviderKey(epoch uint64, chainID string, providerAddress string) []byte {
+	key := StaleProviderEpochKey(epoch)
+	return append(key, []byte(chainID+"/"+providerAddress+"/")...)
+}
@@ -0,0 +1,11 @@
+package types
+
+const (
+	// ProviderFreezeKeyPrefix is the prefix of the providers' freezes, by chain
+	ProviderFreezeKeyPrefix = "ProviderFreeze/value/"
+)
+
+// ProviderFreezeKey returns the store key of a provider's freeze on a chain, under ProviderFreezeKeyPrefix
+func ProviderFreezeKey(chainID string, providerAddress string) []byte {
+	return []byte(chainID + "/" + providerAddress + "/")
+}
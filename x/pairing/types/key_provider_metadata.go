@@ -0,0 +1,11 @@
+package types
+
+const (
+	// ProviderMetadataKeyPrefix is the prefix to retrieve all ProviderMetadata
+	ProviderMetadataKeyPrefix = "ProviderMetadata/value/"
+)
+
+// ProviderMetadataKey returns the store key to retrieve a ProviderMetadata from the provider field
+func ProviderMetadataKey(provider string) []byte {
+	return []byte(provider + "/")
+}
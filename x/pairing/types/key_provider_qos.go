@@ -0,0 +1,26 @@
+package types
+
+import (
+	"encoding/binary"
+)
+
+const (
+	// ProviderQoSReportsKeyPrefix is the prefix of the sums of the qos reports on providers, by epoch
+	ProviderQoSReportsKeyPrefix = "ProviderQoSReports/value/"
+	// ProviderWithheldRewardKeyPrefix is the prefix of the rewards withheld until the providers' qos scores are final, keyed like the qos reports sums
+	ProviderWithheldRewardKeyPrefix = "ProviderWithheldReward/value/"
+)
+
+// ProviderQoSReportsEpochKey returns the store key prefix of the qos reports sums of an epoch
+func ProviderQoSReportsEpochKey(epoch uint64) []byte {
+	key := make([]byte, 8, 9)
+	binary.BigEndian.PutUint64(key, epoch)
+	return append(key, []byte("/")...)
+}
+
+// ProviderQoSReportsKey returns the store key of the qos reports sums of a provider in an epoch, under ProviderQoSReportsKeyPrefix
+func ProviderQoSReportsKey(epoch uint64, chainID string, providerAddress string) []byte {
+	key := ProviderQoSReportsEpochKey(epoch)
+	key = append(key, []byte(chainID+"/"+providerAddress+"/")...)
+	return key
+}
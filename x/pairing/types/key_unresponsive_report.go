@@ -0,0 +1,28 @@
+package types
+
+const (
+	// UnresponsiveReportKeyPrefix is the prefix of the consumers' unresponsiveness reports on providers, by epoch
+	UnresponsiveReportKeyPrefix = "UnresponsiveReport/value/"
+	// ProviderJailKeyPrefix is the prefix of the providers jailed for unresponsiveness
+	ProviderJailKeyPrefix = "ProviderJail/value/"
+)
+
+// UnresponsiveReportProviderKey returns the store key prefix of the unresponsiveness reports on a provider in an epoch, under UnresponsiveReportKeyPrefix.
+// the epoch prefix is the one of the client penalties so they are removed the same way
+func UnresponsiveReportProviderKey(epoch uint64, chainID string, providerAddress string) []byte {
+	key := ClientOveruseEpochKey(epoch)
+	key = append(key, []byte(chainID+"/"+providerAddress+"/")...)
+	return key
+}
+
+// UnresponsiveReportKey returns the store key of a consumer's unresponsiveness report on a provider in an epoch, under UnresponsiveReportKeyPrefix
+func UnresponsiveReportKey(epoch uint64, chainID string, providerAddress string, consumerAddress string) []byte {
+	key := UnresponsiveReportProviderKey(epoch, chainID, providerAddress)
+	key = append(key, []byte(consumerAddress+"/")...)
+	return key
+}
+
+// ProviderJailKey returns the store key of a jailed provider, under ProviderJailKeyPrefix
+func ProviderJailKey(chainID string, providerAddress string) []byte {
+	return []byte(chainID + "/" + providerAddress + "/")
+}
@@ -0,0 +1,61 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgAttestEndpointHealth = "attest_endpoint_health"
+
+var _ sdk.Msg = &MsgAttestEndpointHealth{}
+
+func NewMsgAttestEndpointHealth(creator string, chainIDs []string, protocolVersion uint32, binaryVersion string) *MsgAttestEndpointHealth {
+	return &MsgAttestEndpointHealth{
+		Creator:         creator,
+		ChainIDs:        chainIDs,
+		ProtocolVersion: protocolVersion,
+		BinaryVersion:   binaryVersion,
+	}
+}
+
+func (msg *MsgAttestEndpointHealth) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgAttestEndpointHealth) Type() string {
+	return TypeMsgAttestEndpointHealth
+}
+
+func (msg *MsgAttestEndpointHealth) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgAttestEndpointHealth) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgAttestEndpointHealth) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if len(msg.ChainIDs) == 0 {
+		return sdkerrors.Wrapf(AttestationChainIDsError, "no chains attested")
+	}
+	seen := map[string]struct{}{}
+	for _, chainID := range msg.ChainIDs {
+		if _, ok := seen[chainID]; ok || chainID == "" {
+			return sdkerrors.Wrapf(AttestationChainIDsError, "invalid chain (%s)", chainID)
+		}
+		seen[chainID] = struct{}{}
+	}
+	if len(msg.BinaryVersion) > MAX_LEN_ATTESTATION_VERSION {
+		return sdkerrors.Wrapf(AttestationVersionTooLongError, "invalid binary version (%s)", msg.BinaryVersion)
+	}
+	return nil
+}
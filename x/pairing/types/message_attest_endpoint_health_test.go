@@ -0,0 +1,66 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/lavanet/lava/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgAttestEndpointHealth_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgAttestEndpointHealth
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgAttestEndpointHealth{
+				Creator:  "invalid_address",
+				ChainIDs: []string{"LAV1"},
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "no chains",
+			msg: MsgAttestEndpointHealth{
+				Creator: sample.AccAddress(),
+			},
+			err: AttestationChainIDsError,
+		}, {
+			name: "duplicated chain",
+			msg: MsgAttestEndpointHealth{
+				Creator:  sample.AccAddress(),
+				ChainIDs: []string{"LAV1", "LAV1"},
+			},
+			err: AttestationChainIDsError,
+		}, {
+			name: "version too long",
+			msg: MsgAttestEndpointHealth{
+				Creator:       sample.AccAddress(),
+				ChainIDs:      []string{"LAV1"},
+				BinaryVersion: strings.Repeat("v", MAX_LEN_ATTESTATION_VERSION+1),
+			},
+			err: AttestationVersionTooLongError,
+		}, {
+			name: "valid attestation",
+			msg: MsgAttestEndpointHealth{
+				Creator:         sample.AccAddress(),
+				ChainIDs:        []string{"LAV1", "ETH1"},
+				ProtocolVersion: 4,
+				BinaryVersion:   "v0.9.0",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	epochstoragetypes "github.com/lavanet/lava/x/epochstorage/types"
+)
+
+const TypeMsgDelegateToProvider = "delegate_to_provider"
+
+var _ sdk.Msg = &MsgDelegateToProvider{}
+
+func NewMsgDelegateToProvider(creator string, provider string, chainID string, amount sdk.Coin) *MsgDelegateToProvider {
+	return &MsgDelegateToProvider{
+		Creator:  creator,
+		Provider: provider,
+		ChainID:  chainID,
+		Amount:   amount,
+	}
+}
+
+func (msg *MsgDelegateToProvider) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgDelegateToProvider) Type() string {
+	return TypeMsgDelegateToProvider
+}
+
+func (msg *MsgDelegateToProvider) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgDelegateToProvider) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgDelegateToProvider) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	_, err = sdk.AccAddressFromBech32(msg.Provider)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid provider address (%s)", err)
+	}
+	if !msg.Amount.IsValid() || !msg.Amount.IsPositive() || msg.Amount.Denom != epochstoragetypes.TokenDenom {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid amount (%s)", msg.Amount)
+	}
+	return nil
+}
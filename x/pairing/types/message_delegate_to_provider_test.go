@@ -0,0 +1,62 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/lavanet/lava/testutil/sample"
+	epochstoragetypes "github.com/lavanet/lava/x/epochstorage/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgDelegateToProvider_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgDelegateToProvider
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgDelegateToProvider{
+				Creator:  "invalid_address",
+				Provider: sample.AccAddress(),
+				Amount:   sdk.NewCoin(epochstoragetypes.TokenDenom, sdk.NewInt(100)),
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid provider address",
+			msg: MsgDelegateToProvider{
+				Creator:  sample.AccAddress(),
+				Provider: "invalid_address",
+				Amount:   sdk.NewCoin(epochstoragetypes.TokenDenom, sdk.NewInt(100)),
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "zero amount",
+			msg: MsgDelegateToProvider{
+				Creator:  sample.AccAddress(),
+				Provider: sample.AccAddress(),
+				Amount:   sdk.NewCoin(epochstoragetypes.TokenDenom, sdk.ZeroInt()),
+			},
+			err: sdkerrors.ErrInvalidCoins,
+		}, {
+			name: "valid address",
+			msg: MsgDelegateToProvider{
+				Creator:  sample.AccAddress(),
+				Provider: sample.AccAddress(),
+				Amount:   sdk.NewCoin(epochstoragetypes.TokenDenom, sdk.NewInt(100)),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
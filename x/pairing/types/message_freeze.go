@@ -6,18 +6,22 @@ import (
 )
 
 const (
-	TypeMsgFreeze   = "freeze"
-	ReasonFlagName  = "reason"
-	ReasonMaxLength = 50
+	TypeMsgFreeze         = "freeze"
+	ReasonFlagName        = "reason"
+	ReasonMaxLength       = 50
+	UnfreezeBlockFlagName = "unfreeze-block"
+	FreezeEpochsFlagName  = "freeze-epochs"
 )
 
 var _ sdk.Msg = &MsgFreezeProvider{}
 
-func NewMsgFreeze(creator string, chainIds []string, reason string) *MsgFreezeProvider {
+func NewMsgFreeze(creator string, chainIds []string, reason string, unfreezeBlock uint64, freezeEpochs uint64) *MsgFreezeProvider {
 	return &MsgFreezeProvider{
-		Creator:  creator,
-		ChainIds: chainIds,
-		Reason:   reason,
+		Creator:       creator,
+		ChainIds:      chainIds,
+		Reason:        reason,
+		UnfreezeBlock: unfreezeBlock,
+		FreezeEpochs:  freezeEpochs,
 	}
 }
 
@@ -50,5 +54,8 @@ func (msg *MsgFreezeProvider) ValidateBasic() error {
 	if len(msg.GetReason()) > ReasonMaxLength {
 		return sdkerrors.Wrapf(FreezeReasonTooLongError, "invalid freeze reason error (%s) ", FreezeReasonTooLongError.Error())
 	}
+	if msg.GetUnfreezeBlock() != 0 && msg.GetFreezeEpochs() != 0 {
+		return sdkerrors.Wrapf(FreezeUnfreezeBlockError, "both unfreeze block (%d) and freeze epochs (%d) are set", msg.GetUnfreezeBlock(), msg.GetFreezeEpochs())
+	}
 	return nil
 }
@@ -25,6 +25,20 @@ func TestMsgFreeze_ValidateBasic(t *testing.T) {
 			msg: MsgFreezeProvider{
 				Creator: sample.AccAddress(),
 			},
+		}, {
+			name: "scheduled unfreeze",
+			msg: MsgFreezeProvider{
+				Creator:       sample.AccAddress(),
+				UnfreezeBlock: 100,
+			},
+		}, {
+			name: "unfreeze block and freeze epochs",
+			msg: MsgFreezeProvider{
+				Creator:       sample.AccAddress(),
+				UnfreezeBlock: 100,
+				FreezeEpochs:  2,
+			},
+			err: FreezeUnfreezeBlockError,
 		},
 	}
 	for _, tt := range tests {
@@ -0,0 +1,64 @@
+package types
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgSetProviderMetadata = "set_provider_metadata"
+
+var _ sdk.Msg = &MsgSetProviderMetadata{}
+
+func NewMsgSetProviderMetadata(creator string, moniker string, website string, description string, contact string, addons []string) *MsgSetProviderMetadata {
+	return &MsgSetProviderMetadata{
+		Creator:     creator,
+		Moniker:     moniker,
+		Website:     website,
+		Description: description,
+		Contact:     contact,
+		Addons:      addons,
+	}
+}
+
+func (msg *MsgSetProviderMetadata) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetProviderMetadata) Type() string {
+	return TypeMsgSetProviderMetadata
+}
+
+func (msg *MsgSetProviderMetadata) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgSetProviderMetadata) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSetProviderMetadata) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if strings.TrimSpace(msg.Moniker) == "" {
+		return sdkerrors.Wrapf(MonikerEmptyError, "invalid moniker (%s)", msg.Moniker)
+	}
+	if len(msg.Moniker) > MAX_LEN_MONIKER {
+		return sdkerrors.Wrapf(MonikerTooLongError, "invalid moniker (%s)", msg.Moniker)
+	}
+	if len(msg.Website) > MAX_LEN_PROVIDER_METADATA_FIELD || len(msg.Contact) > MAX_LEN_PROVIDER_METADATA_FIELD || len(msg.Description) > MAX_LEN_PROVIDER_DESCRIPTION {
+		return sdkerrors.Wrapf(ProviderMetadataTooLongError, "invalid provider metadata")
+	}
+	if err := ValidateAddons(msg.Addons); err != nil {
+		return sdkerrors.Wrapf(err, "invalid addons (%s)", strings.Join(msg.Addons, ","))
+	}
+	return nil
+}
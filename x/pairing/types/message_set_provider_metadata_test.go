@@ -0,0 +1,77 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/lavanet/lava/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgSetProviderMetadata_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgSetProviderMetadata
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgSetProviderMetadata{
+				Creator: "invalid_address",
+				Moniker: "moniker",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "empty moniker",
+			msg: MsgSetProviderMetadata{
+				Creator: sample.AccAddress(),
+			},
+			err: MonikerEmptyError,
+		}, {
+			name: "website too long",
+			msg: MsgSetProviderMetadata{
+				Creator: sample.AccAddress(),
+				Moniker: "moniker",
+				Website: strings.Repeat("w", MAX_LEN_PROVIDER_METADATA_FIELD+1),
+			},
+			err: ProviderMetadataTooLongError,
+		}, {
+			name: "unsupported addon",
+			msg: MsgSetProviderMetadata{
+				Creator: sample.AccAddress(),
+				Moniker: "moniker",
+				Addons:  []string{AddonArchive, "debug"},
+			},
+			err: UnsupportedAddonError,
+		}, {
+			name: "duplicated addon",
+			msg: MsgSetProviderMetadata{
+				Creator: sample.AccAddress(),
+				Moniker: "moniker",
+				Addons:  []string{AddonTrace, AddonTrace},
+			},
+			err: UnsupportedAddonError,
+		}, {
+			name: "valid metadata",
+			msg: MsgSetProviderMetadata{
+				Creator:     sample.AccAddress(),
+				Moniker:     "moniker",
+				Website:     "https://provider.example",
+				Description: "archive node operator",
+				Contact:     "ops@provider.example",
+				Addons:      []string{AddonArchive, AddonWebsocket},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
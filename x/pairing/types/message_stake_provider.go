@@ -10,7 +10,7 @@ const TypeMsgStakeProvider = "stake_provider"
 
 var _ sdk.Msg = &MsgStakeProvider{}
 
-func NewMsgStakeProvider(creator string, chainID string, amount sdk.Coin, endpoints []epochstoragetypes.Endpoint, geolocation uint64, moniker string) *MsgStakeProvider {
+func NewMsgStakeProvider(creator string, chainID string, amount sdk.Coin, endpoints []epochstoragetypes.Endpoint, geolocation uint64, moniker string, cuCapacity uint64) *MsgStakeProvider {
 	return &MsgStakeProvider{
 		Creator:     creator,
 		ChainID:     chainID,
@@ -18,6 +18,7 @@ func NewMsgStakeProvider(creator string, chainID string, amount sdk.Coin, endpoi
 		Endpoints:   endpoints,
 		Geolocation: geolocation,
 		Moniker:     moniker,
+		CuCapacity:  cuCapacity,
 	}
 }
 
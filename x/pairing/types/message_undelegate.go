@@ -0,0 +1,56 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	epochstoragetypes "github.com/lavanet/lava/x/epochstorage/types"
+)
+
+const TypeMsgUndelegate = "undelegate"
+
+var _ sdk.Msg = &MsgUndelegate{}
+
+func NewMsgUndelegate(creator string, provider string, chainID string, amount sdk.Coin) *MsgUndelegate {
+	return &MsgUndelegate{
+		Creator:  creator,
+		Provider: provider,
+		ChainID:  chainID,
+		Amount:   amount,
+	}
+}
+
+func (msg *MsgUndelegate) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUndelegate) Type() string {
+	return TypeMsgUndelegate
+}
+
+func (msg *MsgUndelegate) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgUndelegate) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgUndelegate) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	_, err = sdk.AccAddressFromBech32(msg.Provider)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid provider address (%s)", err)
+	}
+	if !msg.Amount.IsValid() || !msg.Amount.IsPositive() || msg.Amount.Denom != epochstoragetypes.TokenDenom {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid amount (%s)", msg.Amount)
+	}
+	return nil
+}
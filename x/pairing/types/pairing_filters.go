@@ -0,0 +1,52 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	epochstoragetypes "github.com/lavanet/lava/x/epochstorage/types"
+)
+
+// Validate checks the filters of a pairing query, a nil filter is valid
+func (filters *PairingFilters) Validate() error {
+	if filters == nil {
+		return nil
+	}
+	if err := ValidateAddons(filters.RequiredAddOns); err != nil {
+		return sdkerrors.Wrapf(err, "invalid required add-ons (%v)", filters.RequiredAddOns)
+	}
+	if !filters.MinStake.IsNil() && filters.MinStake.IsNegative() {
+		return fmt.Errorf("negative min stake %s", filters.MinStake)
+	}
+	for _, provider := range filters.Exclude {
+		if _, err := sdk.AccAddressFromBech32(provider); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid excluded provider address (%s)", err)
+		}
+	}
+	return nil
+}
+
+// Match returns whether a provider passes the filters, metadata is the provider's metadata when it set one
+func (filters *PairingFilters) Match(stakeEntry epochstoragetypes.StakeEntry, metadata *ProviderMetadata) bool {
+	if filters == nil {
+		return true
+	}
+	for _, addon := range filters.RequiredAddOns {
+		if metadata == nil || !metadata.HasAddon(addon) {
+			return false
+		}
+	}
+	if filters.Geolocation != 0 && stakeEntry.Geolocation&filters.Geolocation == 0 {
+		return false
+	}
+	if !filters.MinStake.IsNil() && stakeEntry.Stake.Amount.LT(filters.MinStake) {
+		return false
+	}
+	for _, provider := range filters.Exclude {
+		if stakeEntry.Address == provider {
+			return false
+		}
+	}
+	return true
+}
@@ -83,6 +83,51 @@ var (
 	DefaultRecommendedEpochNumToCollectPayment uint64 = 3
 )
 
+var (
+	KeyClientOveruseJailThreshold            = []byte("ClientOveruseJailThreshold") // cu overuse incidents of a client in an epoch that jail it, 0 disables jailing
+	DefaultClientOveruseJailThreshold uint64 = 0
+)
+
+var (
+	KeyClientJailEpochs            = []byte("ClientJailEpochs")
+	DefaultClientJailEpochs uint64 = 2
+)
+
+var (
+	KeyClientOveruseSlashFraction             = []byte("ClientOveruseSlashFraction")
+	DefaultClientOveruseSlashFraction sdk.Dec = sdk.NewDecWithPrec(0, 0) // 0
+)
+
+var (
+	KeyGeolocationFallbackScore             = []byte("GeolocationFallbackScore") // pairing weight of a provider outside the consumer's geolocation relative to its stake, 0 pairs only providers in the geolocation
+	DefaultGeolocationFallbackScore sdk.Dec = sdk.NewDecWithPrec(1, 1)           // 0.1
+)
+
+var (
+	KeyDelegationCommission             = []byte("DelegationCommission") // the part of the delegators' share of a relay reward that goes to the provider
+	DefaultDelegationCommission sdk.Dec = sdk.NewDecWithPrec(1, 1)       // 0.1
+)
+
+var (
+	KeyUnresponsiveReportersThreshold            = []byte("UnresponsiveReportersThreshold") // distinct consumers reporting a provider as unresponsive in an epoch that jail it, 0 disables jailing
+	DefaultUnresponsiveReportersThreshold uint64 = 0
+)
+
+var (
+	KeyUnresponsiveJailEpochs            = []byte("UnresponsiveJailEpochs")
+	DefaultUnresponsiveJailEpochs uint64 = 2
+)
+
+var (
+	KeyDoubleSpendJailEpochs            = []byte("DoubleSpendJailEpochs") // epochs a consumer that signed a session to several providers is jailed for, 0 disables the detection
+	DefaultDoubleSpendJailEpochs uint64 = 0
+)
+
+var (
+	KeyAttestationStaleBlocks            = []byte("AttestationStaleBlocks") // blocks after its last endpoint attestation on a chain that a provider isn't paired on it, 0 disables the exclusion
+	DefaultAttestationStaleBlocks uint64 = 0
+)
+
 // ParamKeyTable the param key table for launch module
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
@@ -102,6 +147,15 @@ func NewParams(
 	dataReliabilityReward sdk.Dec,
 	qoSWeight sdk.Dec,
 	recommendedEpochNumToCollectPayment uint64,
+	clientOveruseJailThreshold uint64,
+	clientJailEpochs uint64,
+	clientOveruseSlashFraction sdk.Dec,
+	geolocationFallbackScore sdk.Dec,
+	delegationCommission sdk.Dec,
+	unresponsiveReportersThreshold uint64,
+	unresponsiveJailEpochs uint64,
+	doubleSpendJailEpochs uint64,
+	attestationStaleBlocks uint64,
 ) Params {
 	return Params{
 		MintCoinsPerCU:                      mintCoinsPerCU,
@@ -116,6 +170,15 @@ func NewParams(
 		DataReliabilityReward:               dataReliabilityReward,
 		QoSWeight:                           qoSWeight,
 		RecommendedEpochNumToCollectPayment: recommendedEpochNumToCollectPayment,
+		ClientOveruseJailThreshold:          clientOveruseJailThreshold,
+		ClientJailEpochs:                    clientJailEpochs,
+		ClientOveruseSlashFraction:          clientOveruseSlashFraction,
+		GeolocationFallbackScore:            geolocationFallbackScore,
+		DelegationCommission:                delegationCommission,
+		UnresponsiveReportersThreshold:      unresponsiveReportersThreshold,
+		UnresponsiveJailEpochs:              unresponsiveJailEpochs,
+		DoubleSpendJailEpochs:               doubleSpendJailEpochs,
+		AttestationStaleBlocks:              attestationStaleBlocks,
 	}
 }
 
@@ -134,6 +197,15 @@ func DefaultParams() Params {
 		DefaultDataReliabilityReward,
 		DefaultQoSWeight,
 		DefaultRecommendedEpochNumToCollectPayment,
+		DefaultClientOveruseJailThreshold,
+		DefaultClientJailEpochs,
+		DefaultClientOveruseSlashFraction,
+		DefaultGeolocationFallbackScore,
+		DefaultDelegationCommission,
+		DefaultUnresponsiveReportersThreshold,
+		DefaultUnresponsiveJailEpochs,
+		DefaultDoubleSpendJailEpochs,
+		DefaultAttestationStaleBlocks,
 	)
 }
 
@@ -152,6 +224,15 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyDataReliabilityReward, &p.DataReliabilityReward, validateDataReliabilityReward),
 		paramtypes.NewParamSetPair(KeyQoSWeight, &p.QoSWeight, validateQoSWeight),
 		paramtypes.NewParamSetPair(KeyRecommendedEpochNumToCollectPayment, &p.RecommendedEpochNumToCollectPayment, validateRecommendedEpochNumToCollectPayment),
+		paramtypes.NewParamSetPair(KeyClientOveruseJailThreshold, &p.ClientOveruseJailThreshold, validateClientOveruseJailThreshold),
+		paramtypes.NewParamSetPair(KeyClientJailEpochs, &p.ClientJailEpochs, validateClientJailEpochs),
+		paramtypes.NewParamSetPair(KeyClientOveruseSlashFraction, &p.ClientOveruseSlashFraction, validateClientOveruseSlashFraction),
+		paramtypes.NewParamSetPair(KeyGeolocationFallbackScore, &p.GeolocationFallbackScore, validateGeolocationFallbackScore),
+		paramtypes.NewParamSetPair(KeyDelegationCommission, &p.DelegationCommission, validateDelegationCommission),
+		paramtypes.NewParamSetPair(KeyUnresponsiveReportersThreshold, &p.UnresponsiveReportersThreshold, validateUnresponsiveReportersThreshold),
+		paramtypes.NewParamSetPair(KeyUnresponsiveJailEpochs, &p.UnresponsiveJailEpochs, validateUnresponsiveJailEpochs),
+		paramtypes.NewParamSetPair(KeyDoubleSpendJailEpochs, &p.DoubleSpendJailEpochs, validateDoubleSpendJailEpochs),
+		paramtypes.NewParamSetPair(KeyAttestationStaleBlocks, &p.AttestationStaleBlocks, validateAttestationStaleBlocks),
 	}
 }
 
@@ -198,6 +279,33 @@ func (p Params) Validate() error {
 	if err := validateRecommendedEpochNumToCollectPayment(p.RecommendedEpochNumToCollectPayment); err != nil {
 		return err
 	}
+	if err := validateClientOveruseJailThreshold(p.ClientOveruseJailThreshold); err != nil {
+		return err
+	}
+	if err := validateClientJailEpochs(p.ClientJailEpochs); err != nil {
+		return err
+	}
+	if err := validateClientOveruseSlashFraction(p.ClientOveruseSlashFraction); err != nil {
+		return err
+	}
+	if err := validateGeolocationFallbackScore(p.GeolocationFallbackScore); err != nil {
+		return err
+	}
+	if err := validateDelegationCommission(p.DelegationCommission); err != nil {
+		return err
+	}
+	if err := validateUnresponsiveReportersThreshold(p.UnresponsiveReportersThreshold); err != nil {
+		return err
+	}
+	if err := validateUnresponsiveJailEpochs(p.UnresponsiveJailEpochs); err != nil {
+		return err
+	}
+	if err := validateDoubleSpendJailEpochs(p.DoubleSpendJailEpochs); err != nil {
+		return err
+	}
+	if err := validateAttestationStaleBlocks(p.AttestationStaleBlocks); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -379,3 +487,114 @@ func validateRecommendedEpochNumToCollectPayment(v interface{}) error {
 
 	return nil
 }
+
+// validateClientOveruseJailThreshold validates the ClientOveruseJailThreshold param
+func validateClientOveruseJailThreshold(v interface{}) error {
+	_, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+// validateClientJailEpochs validates the ClientJailEpochs param
+func validateClientJailEpochs(v interface{}) error {
+	clientJailEpochs, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if clientJailEpochs == 0 {
+		return fmt.Errorf("invalid parameter, clientJailEpochs can't be zero")
+	}
+
+	return nil
+}
+
+// validateClientOveruseSlashFraction validates the ClientOveruseSlashFraction param
+func validateClientOveruseSlashFraction(v interface{}) error {
+	clientOveruseSlashFraction, ok := v.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if clientOveruseSlashFraction.IsNil() || clientOveruseSlashFraction.GT(sdk.OneDec()) || clientOveruseSlashFraction.LT(sdk.ZeroDec()) {
+		return fmt.Errorf("invalid parameter clientOveruseSlashFraction")
+	}
+
+	return nil
+}
+
+// validateGeolocationFallbackScore validates the GeolocationFallbackScore param
+func validateGeolocationFallbackScore(v interface{}) error {
+	geolocationFallbackScore, ok := v.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	// a provider outside the geolocation can't weigh more than one inside it
+	if geolocationFallbackScore.IsNil() || geolocationFallbackScore.GT(sdk.OneDec()) || geolocationFallbackScore.LT(sdk.ZeroDec()) {
+		return fmt.Errorf("invalid parameter geolocationFallbackScore")
+	}
+
+	return nil
+}
+
+// validateDelegationCommission validates the DelegationCommission param
+func validateDelegationCommission(v interface{}) error {
+	delegationCommission, ok := v.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if delegationCommission.IsNil() || delegationCommission.GT(sdk.OneDec()) || delegationCommission.LT(sdk.ZeroDec()) {
+		return fmt.Errorf("invalid parameter delegationCommission")
+	}
+
+	return nil
+}
+
+// validateUnresponsiveReportersThreshold validates the UnresponsiveReportersThreshold param
+func validateUnresponsiveReportersThreshold(v interface{}) error {
+	_, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+// validateUnresponsiveJailEpochs validates the UnresponsiveJailEpochs param
+func validateUnresponsiveJailEpochs(v interface{}) error {
+	unresponsiveJailEpochs, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if unresponsiveJailEpochs == 0 {
+		return fmt.Errorf("invalid parameter, unresponsiveJailEpochs can't be zero")
+	}
+
+	return nil
+}
+
+// validateDoubleSpendJailEpochs validates the DoubleSpendJailEpochs param
+func validateDoubleSpendJailEpochs(v interface{}) error {
+	_, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+// validateAttestationStaleBlocks validates the AttestationStaleBlocks param
+func validateAttestationStaleBlocks(v interface{}) error {
+	_, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
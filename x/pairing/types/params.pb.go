@@ -38,6 +38,15 @@ type Params struct {
 	DataReliabilityReward               github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,12,opt,name=dataReliabilityReward,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"dataReliabilityReward" yaml:"data_reliability_reward"`
 	QoSWeight                           github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,13,opt,name=QoSWeight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"QoSWeight" yaml:"data_reliability_reward"`
 	RecommendedEpochNumToCollectPayment uint64                                 `protobuf:"varint,14,opt,name=recommendedEpochNumToCollectPayment,proto3" json:"recommendedEpochNumToCollectPayment,omitempty" yaml:"recommended_epoch_num_to_collect_payment"`
+	ClientOveruseJailThreshold          uint64                                 `protobuf:"varint,15,opt,name=clientOveruseJailThreshold,proto3" json:"clientOveruseJailThreshold,omitempty" yaml:"client_overuse_jail_threshold"`
+	ClientJailEpochs                    uint64                                 `protobuf:"varint,16,opt,name=clientJailEpochs,proto3" json:"clientJailEpochs,omitempty" yaml:"client_jail_epochs"`
+	ClientOveruseSlashFraction          github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,17,opt,name=clientOveruseSlashFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"clientOveruseSlashFraction" yaml:"client_overuse_slash_fraction"`
+	GeolocationFallbackScore            github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,18,opt,name=geolocationFallbackScore,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"geolocationFallbackScore" yaml:"geolocation_fallback_score"`
+	DelegationCommission                github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,19,opt,name=delegationCommission,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"delegationCommission" yaml:"delegation_commission"`
+	UnresponsiveReportersThreshold      uint64                                 `protobuf:"varint,20,opt,name=unresponsiveReportersThreshold,proto3" json:"unresponsiveReportersThreshold,omitempty" yaml:"unresponsive_reporters_threshold"`
+	UnresponsiveJailEpochs              uint64                                 `protobuf:"varint,21,opt,name=unresponsiveJailEpochs,proto3" json:"unresponsiveJailEpochs,omitempty" yaml:"unresponsive_jail_epochs"`
+	DoubleSpendJailEpochs               uint64                                 `protobuf:"varint,22,opt,name=doubleSpendJailEpochs,proto3" json:"doubleSpendJailEpochs,omitempty" yaml:"double_spend_jail_epochs"`
+	AttestationStaleBlocks              uint64                                 `protobuf:"varint,23,opt,name=attestationStaleBlocks,proto3" json:"attestationStaleBlocks,omitempty" yaml:"attestation_stale_blocks"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -100,6 +109,48 @@ func (m *Params) GetRecommendedEpochNumToCollectPayment() uint64 {
 	return 0
 }
 
+func (m *Params) GetClientOveruseJailThreshold() uint64 {
+	if m != nil {
+		return m.ClientOveruseJailThreshold
+	}
+	return 0
+}
+
+func (m *Params) GetClientJailEpochs() uint64 {
+	if m != nil {
+		return m.ClientJailEpochs
+	}
+	return 0
+}
+
+func (m *Params) GetUnresponsiveReportersThreshold() uint64 {
+	if m != nil {
+		return m.UnresponsiveReportersThreshold
+	}
+	return 0
+}
+
+func (m *Params) GetUnresponsiveJailEpochs() uint64 {
+	if m != nil {
+		return m.UnresponsiveJailEpochs
+	}
+	return 0
+}
+
+func (m *Params) GetDoubleSpendJailEpochs() uint64 {
+	if m != nil {
+		return m.DoubleSpendJailEpochs
+	}
+	return 0
+}
+
+func (m *Params) GetAttestationStaleBlocks() uint64 {
+	if m != nil {
+		return m.AttestationStaleBlocks
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "lavanet.lava.pairing.Params")
 }
@@ -107,48 +158,67 @@ func init() {
 func init() { proto.RegisterFile("pairing/params.proto", fileDescriptor_72cc734580d3bc3a) }
 
 var fileDescriptor_72cc734580d3bc3a = []byte{
-	// 650 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x94, 0x41, 0x6f, 0xd3, 0x3c,
-	0x18, 0xc7, 0x9b, 0xad, 0xef, 0xde, 0xcd, 0xef, 0xcb, 0x54, 0x85, 0x22, 0x45, 0x80, 0x92, 0x11,
-	0x24, 0xd8, 0x85, 0xe6, 0xb0, 0xdb, 0x24, 0x0e, 0x6b, 0x07, 0x87, 0x69, 0xb0, 0x92, 0x76, 0x20,
-	0x71, 0xb1, 0x5c, 0xc7, 0x6b, 0xad, 0xc5, 0x76, 0x64, 0x3b, 0x63, 0xfd, 0x00, 0x70, 0xde, 0x91,
-	0x23, 0x1f, 0x67, 0xe2, 0xb4, 0x23, 0xe2, 0x10, 0xa1, 0xed, 0x1b, 0xf4, 0x13, 0xa0, 0xd8, 0xd9,
-	0xd6, 0x8d, 0x22, 0x31, 0x4d, 0x9c, 0x5c, 0xd5, 0xff, 0xe7, 0xf7, 0x8b, 0x9e, 0xe7, 0x91, 0x41,
-	0x33, 0x43, 0x54, 0x52, 0x3e, 0x8c, 0x32, 0x24, 0x11, 0x53, 0xad, 0x4c, 0x0a, 0x2d, 0xdc, 0x66,
-	0x8a, 0x0e, 0x10, 0x27, 0xba, 0x55, 0x9e, 0xad, 0x2a, 0x72, 0xbf, 0x39, 0x14, 0x43, 0x61, 0x02,
-	0x51, 0xf9, 0xcb, 0x66, 0xc3, 0xaf, 0x00, 0x2c, 0x74, 0x4d, 0xb1, 0x2b, 0xc1, 0x32, 0xa3, 0x5c,
-	0x77, 0x04, 0xe5, 0xaa, 0x4b, 0x64, 0x67, 0xd7, 0x9b, 0x5f, 0x71, 0x56, 0x97, 0xda, 0x5b, 0xc7,
-	0x45, 0x50, 0xfb, 0x5e, 0x04, 0x4f, 0x86, 0x54, 0x8f, 0xf2, 0x41, 0x0b, 0x0b, 0x16, 0x61, 0xa1,
-	0x98, 0x50, 0xd5, 0xf1, 0x4c, 0x25, 0xfb, 0x91, 0x1e, 0x67, 0x44, 0xb5, 0x36, 0x09, 0x9e, 0x14,
-	0x81, 0x37, 0x46, 0x2c, 0x5d, 0x0f, 0x4b, 0x1a, 0xc4, 0x25, 0x0e, 0x66, 0x44, 0x42, 0x9c, 0x87,
-	0xf1, 0x35, 0x43, 0xe9, 0x1c, 0xe4, 0x92, 0x4f, 0x39, 0xeb, 0xb7, 0x73, 0x96, 0xb4, 0xeb, 0xce,
-	0xab, 0x06, 0xf7, 0xc8, 0x01, 0xde, 0x9e, 0x44, 0x79, 0xd2, 0xd3, 0x68, 0x9f, 0xf4, 0x52, 0xa4,
-	0x46, 0x94, 0x0f, 0x5f, 0x22, 0xac, 0x85, 0xf4, 0xfe, 0x31, 0xfa, 0xfe, 0x8d, 0xf5, 0xa1, 0xd5,
-	0x1b, 0x2e, 0x54, 0x25, 0x18, 0xaa, 0x8a, 0x0c, 0xf7, 0x0c, 0x3a, 0x8c, 0x7f, 0x6b, 0x75, 0x63,
-	0x70, 0xd7, 0xde, 0x55, 0x7f, 0x6f, 0x30, 0x91, 0x73, 0xed, 0x2d, 0xac, 0x38, 0xab, 0xf5, 0xf6,
-	0xca, 0xa4, 0x08, 0x1e, 0x5e, 0xc1, 0x9f, 0x83, 0x91, 0x89, 0x85, 0xf1, 0xac, 0x62, 0xf7, 0x2d,
-	0x68, 0x2a, 0x22, 0x0f, 0x28, 0x26, 0x52, 0xf5, 0x45, 0x17, 0x51, 0xd9, 0x31, 0xd0, 0x7f, 0x0d,
-	0x34, 0x9c, 0x14, 0x81, 0x6f, 0xa1, 0x17, 0x29, 0xa8, 0x05, 0x2c, 0xb7, 0x05, 0x62, 0x8b, 0x9d,
-	0x59, 0xef, 0xee, 0x00, 0x97, 0x64, 0x02, 0x8f, 0xda, 0xa9, 0xc0, 0xfb, 0x6a, 0xe7, 0x80, 0xc8,
-	0x14, 0x65, 0xde, 0xa2, 0xa1, 0x06, 0x93, 0x22, 0x78, 0x60, 0xa9, 0x26, 0x03, 0x07, 0x26, 0x04,
-	0x85, 0x4d, 0x85, 0xf1, 0x8c, 0x52, 0x97, 0x82, 0x86, 0x69, 0x58, 0x5f, 0xbc, 0x42, 0x87, 0x9d,
-	0xdd, 0x6d, 0xaa, 0xb4, 0xb7, 0x64, 0xc6, 0xf0, 0xbc, 0x1a, 0x43, 0xa3, 0x77, 0xed, 0x7e, 0x52,
-	0x04, 0x8f, 0xaa, 0x8f, 0x37, 0xad, 0xd6, 0x02, 0x62, 0xc1, 0xb2, 0x5c, 0x93, 0x9c, 0x53, 0xad,
-	0x60, 0x4a, 0x95, 0x0e, 0xe3, 0x5f, 0xb0, 0x6e, 0x02, 0x40, 0xce, 0x33, 0x34, 0xde, 0xa6, 0x8c,
-	0x6a, 0x0f, 0x18, 0xc9, 0xe6, 0x8d, 0x67, 0xed, 0x5a, 0xb5, 0x21, 0xc1, 0xb4, 0x44, 0x85, 0xf1,
-	0x14, 0xb7, 0xb4, 0x98, 0x11, 0x59, 0xcb, 0x7f, 0xb7, 0xb3, 0x18, 0xd2, 0x85, 0xe5, 0x92, 0xeb,
-	0x7e, 0x72, 0xc0, 0xbd, 0x04, 0x69, 0x14, 0x93, 0x94, 0xa2, 0x01, 0x4d, 0xa9, 0x1e, 0xc7, 0xe4,
-	0x03, 0x92, 0x89, 0xf7, 0xbf, 0x31, 0x76, 0x6f, 0x6c, 0xac, 0xf6, 0xa1, 0x84, 0x42, 0x79, 0x49,
-	0x85, 0xd2, 0x60, 0xc3, 0x78, 0xb6, 0xce, 0xe5, 0x60, 0xe9, 0x8d, 0xe8, 0xbd, 0x23, 0x74, 0x38,
-	0xd2, 0xde, 0x9d, 0xbf, 0xe4, 0xbe, 0x54, 0xb8, 0x1f, 0x1d, 0xf0, 0x58, 0x12, 0x2c, 0x18, 0x23,
-	0x3c, 0x21, 0xc9, 0x8b, 0x72, 0xa3, 0x5e, 0xe7, 0xac, 0x2f, 0x3a, 0x22, 0x4d, 0x09, 0xd6, 0x5d,
-	0x34, 0x66, 0x84, 0x6b, 0x6f, 0xd9, 0xac, 0xe4, 0xda, 0xa4, 0x08, 0x22, 0x0b, 0x9f, 0x2a, 0x82,
-	0x76, 0x3d, 0x79, 0xce, 0xec, 0xee, 0x98, 0x42, 0x98, 0xd9, 0xca, 0x30, 0xfe, 0x13, 0xfe, 0x7a,
-	0xfd, 0xf3, 0x97, 0xa0, 0xb6, 0x55, 0x5f, 0x74, 0x1a, 0x73, 0x5b, 0xf5, 0xc5, 0xb9, 0xc6, 0x7c,
-	0x7b, 0xe3, 0xf8, 0xd4, 0x77, 0x4e, 0x4e, 0x7d, 0xe7, 0xc7, 0xa9, 0xef, 0x1c, 0x9d, 0xf9, 0xb5,
-	0x93, 0x33, 0xbf, 0xf6, 0xed, 0xcc, 0xaf, 0xbd, 0x7f, 0x3a, 0xd5, 0x88, 0xea, 0x75, 0x36, 0x67,
-	0x74, 0x18, 0x9d, 0x3f, 0xe1, 0xa6, 0x1b, 0x83, 0x05, 0xf3, 0x2c, 0xaf, 0xfd, 0x0c, 0x00, 0x00,
-	0xff, 0xff, 0xa8, 0x01, 0x54, 0x8a, 0xda, 0x05, 0x00, 0x00,
+	// 955 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x96, 0xc1, 0x8e, 0xdb, 0x44,
+	0x18, 0xc7, 0xd7, 0x6d, 0x58, 0x76, 0x07, 0x28, 0x61, 0x9a, 0x2d, 0xa6, 0x40, 0xbc, 0xb8, 0x88,
+	0xae, 0x84, 0x48, 0x0e, 0xbd, 0x55, 0xe2, 0xd0, 0x4d, 0x59, 0x89, 0x55, 0x69, 0x83, 0x93, 0x16,
+	0x01, 0x87, 0xd1, 0xc4, 0x9e, 0x4d, 0x86, 0x1d, 0x7b, 0xac, 0x99, 0xf1, 0xd2, 0x5c, 0x91, 0xe0,
+	0x4a, 0x4f, 0x88, 0x23, 0xaf, 0xc1, 0x1b, 0xf4, 0xd8, 0x23, 0xe2, 0x60, 0xa1, 0xdd, 0x37, 0xf0,
+	0x13, 0xa0, 0xf9, 0xec, 0x6c, 0x9c, 0x34, 0x5b, 0x88, 0xaa, 0x9e, 0xbc, 0xeb, 0xf9, 0x7f, 0xbf,
+	0xff, 0xe7, 0xf9, 0xfe, 0x19, 0x1b, 0xb5, 0x52, 0xca, 0x15, 0x4f, 0xc6, 0xdd, 0x94, 0x2a, 0x1a,
+	0xeb, 0x4e, 0xaa, 0xa4, 0x91, 0xb8, 0x25, 0xe8, 0x09, 0x4d, 0x98, 0xe9, 0xd8, 0x6b, 0xa7, 0x92,
+	0x5c, 0x6f, 0x8d, 0xe5, 0x58, 0x82, 0xa0, 0x6b, 0xff, 0x2a, 0xb5, 0xfe, 0x9f, 0x18, 0x6d, 0xf6,
+	0xa1, 0x18, 0x2b, 0x74, 0x25, 0xe6, 0x89, 0xe9, 0x49, 0x9e, 0xe8, 0x3e, 0x53, 0xbd, 0x87, 0xee,
+	0xe5, 0x5d, 0x67, 0x6f, 0x7b, 0xff, 0xf0, 0x69, 0xee, 0x6d, 0xfc, 0x9d, 0x7b, 0x9f, 0x8c, 0xb9,
+	0x99, 0x64, 0xa3, 0x4e, 0x28, 0xe3, 0x6e, 0x28, 0x75, 0x2c, 0x75, 0x75, 0xf9, 0x4c, 0x47, 0xc7,
+	0x5d, 0x33, 0x4d, 0x99, 0xee, 0xdc, 0x65, 0x61, 0x91, 0x7b, 0xee, 0x94, 0xc6, 0xe2, 0xb6, 0x6f,
+	0x69, 0x24, 0xb4, 0x38, 0x92, 0x32, 0x45, 0xc2, 0xcc, 0x0f, 0x96, 0x1c, 0xac, 0xe7, 0x28, 0x53,
+	0x49, 0xcd, 0xb3, 0xf1, 0x72, 0x9e, 0x96, 0xb6, 0xec, 0xb9, 0xe8, 0x80, 0x9f, 0x38, 0xc8, 0x3d,
+	0x52, 0x34, 0x8b, 0x06, 0x86, 0x1e, 0xb3, 0x81, 0xa0, 0x7a, 0xc2, 0x93, 0xf1, 0x01, 0x0d, 0x8d,
+	0x54, 0xee, 0x6b, 0x60, 0x3f, 0x5c, 0xdb, 0xde, 0x2f, 0xed, 0x81, 0x4b, 0xb4, 0x05, 0x13, 0x5d,
+	0x91, 0xc9, 0x11, 0xa0, 0xfd, 0xe0, 0x42, 0x57, 0x1c, 0xa0, 0xab, 0xe5, 0x5a, 0x75, 0xfb, 0x4e,
+	0x2c, 0xb3, 0xc4, 0xb8, 0x9b, 0xbb, 0xce, 0x5e, 0x63, 0x7f, 0xb7, 0xc8, 0xbd, 0x0f, 0x16, 0xf0,
+	0x33, 0x30, 0x05, 0x99, 0x1f, 0xac, 0x2a, 0xc6, 0x8f, 0x50, 0x4b, 0x33, 0x75, 0xc2, 0x43, 0xa6,
+	0xf4, 0x50, 0xf6, 0x29, 0x57, 0x3d, 0x80, 0xbe, 0x0e, 0x50, 0xbf, 0xc8, 0xbd, 0x76, 0x09, 0x3d,
+	0x57, 0x11, 0x23, 0x89, 0x4d, 0x0b, 0x09, 0x4b, 0xec, 0xca, 0x7a, 0xfc, 0x00, 0x61, 0x96, 0xca,
+	0x70, 0xb2, 0x2f, 0x64, 0x78, 0xac, 0x1f, 0x9c, 0x30, 0x25, 0x68, 0xea, 0x6e, 0x01, 0xd5, 0x2b,
+	0x72, 0xef, 0xfd, 0x92, 0x0a, 0x1a, 0x32, 0x02, 0x11, 0x91, 0xa5, 0xca, 0x0f, 0x56, 0x94, 0x62,
+	0x8e, 0x9a, 0xb0, 0x61, 0x43, 0xf9, 0x15, 0x7d, 0xdc, 0x7b, 0x78, 0x8f, 0x6b, 0xe3, 0x6e, 0xc3,
+	0x18, 0x3e, 0xaf, 0xc6, 0xd0, 0x1c, 0x2c, 0xad, 0x17, 0xb9, 0xf7, 0x51, 0xd5, 0x3c, 0x6c, 0xb5,
+	0x91, 0x24, 0x94, 0x71, 0x9a, 0x19, 0x96, 0x25, 0xdc, 0x68, 0x22, 0xb8, 0x36, 0x7e, 0xf0, 0x1c,
+	0x16, 0x47, 0x08, 0x65, 0x49, 0x4a, 0xa7, 0xf7, 0x78, 0xcc, 0x8d, 0x8b, 0xc0, 0xe4, 0xee, 0xda,
+	0xb3, 0xc6, 0xa5, 0x35, 0x90, 0x88, 0xb0, 0x28, 0x3f, 0xa8, 0x71, 0xad, 0x0b, 0x8c, 0xa8, 0x74,
+	0x79, 0xe3, 0xe5, 0x5c, 0x80, 0x74, 0xee, 0x32, 0xe7, 0xe2, 0x5f, 0x1c, 0xb4, 0x13, 0x51, 0x43,
+	0x03, 0x26, 0x38, 0x1d, 0x71, 0xc1, 0xcd, 0x34, 0x60, 0x3f, 0x52, 0x15, 0xb9, 0x6f, 0x82, 0x63,
+	0x7f, 0x6d, 0xc7, 0x2a, 0x0f, 0x16, 0x4a, 0xd4, 0x9c, 0x4a, 0x14, 0x60, 0xfd, 0x60, 0xb5, 0x1d,
+	0x4e, 0xd0, 0xf6, 0xd7, 0x72, 0xf0, 0x0d, 0xe3, 0xe3, 0x89, 0x71, 0xdf, 0x7a, 0x45, 0xde, 0x73,
+	0x0b, 0xfc, 0xb3, 0x83, 0x6e, 0x28, 0x16, 0xca, 0x38, 0x66, 0x49, 0xc4, 0xa2, 0x2f, 0x6c, 0xa2,
+	0xee, 0x67, 0xf1, 0x50, 0xf6, 0xa4, 0x10, 0x2c, 0x34, 0x7d, 0x3a, 0x8d, 0x59, 0x62, 0xdc, 0x2b,
+	0x10, 0xc9, 0x5b, 0x45, 0xee, 0x75, 0x4b, 0x78, 0xad, 0x88, 0x94, 0xf1, 0x4c, 0xb2, 0xb8, 0xcc,
+	0x0e, 0x14, 0x92, 0xb4, 0xac, 0xf4, 0x83, 0xff, 0xc3, 0xc7, 0x13, 0x74, 0x3d, 0x14, 0x9c, 0x25,
+	0xc6, 0x06, 0x39, 0xd3, 0xec, 0x90, 0x72, 0x31, 0x9c, 0x28, 0xa6, 0x27, 0x52, 0x44, 0xee, 0xdb,
+	0xe0, 0xbe, 0x57, 0xe4, 0xde, 0xc7, 0xa5, 0x7b, 0xa9, 0x85, 0x9f, 0x42, 0xa6, 0x19, 0xf9, 0x81,
+	0x72, 0x41, 0xcc, 0x4c, 0xee, 0x07, 0x2f, 0x60, 0xe1, 0x2f, 0x51, 0xb3, 0x5c, 0xb5, 0xb7, 0xa1,
+	0x1f, 0xed, 0x36, 0x81, 0xff, 0x61, 0x91, 0x7b, 0xef, 0x2d, 0xf0, 0x81, 0x0b, 0x4f, 0xa7, 0xfd,
+	0xe0, 0xb9, 0x32, 0xfc, 0x9b, 0xb3, 0xd4, 0x35, 0x9c, 0x1a, 0x07, 0x8a, 0x86, 0x86, 0xcb, 0xc4,
+	0x7d, 0x07, 0xc6, 0xf7, 0x68, 0xed, 0xf1, 0xad, 0x7e, 0xc6, 0x32, 0xbb, 0x47, 0x15, 0xdc, 0x0f,
+	0x5e, 0xe0, 0x8c, 0x7f, 0x75, 0x90, 0x3b, 0x66, 0x52, 0xc8, 0x90, 0xda, 0xff, 0x0f, 0xa8, 0x10,
+	0x23, 0x1a, 0x1e, 0x0f, 0x42, 0xa9, 0x98, 0x8b, 0xa1, 0xad, 0xc1, 0xda, 0x6d, 0x55, 0x87, 0x44,
+	0x8d, 0x4b, 0x8e, 0x2a, 0x30, 0xd1, 0x96, 0xec, 0x07, 0x17, 0x9a, 0xe2, 0x9f, 0x1c, 0xd4, 0x8a,
+	0x98, 0x60, 0x63, 0x58, 0xeb, 0xc9, 0x38, 0xe6, 0x5a, 0xdb, 0x4d, 0xba, 0x0a, 0xdd, 0xdc, 0x5f,
+	0xbb, 0x9b, 0xea, 0x10, 0x9f, 0x33, 0x49, 0x78, 0x0e, 0xf5, 0x83, 0x95, 0x5e, 0x58, 0xa3, 0x76,
+	0x96, 0x28, 0xa6, 0x53, 0x99, 0x68, 0x7e, 0xc2, 0x02, 0x96, 0x4a, 0x65, 0xec, 0x89, 0x7c, 0x1e,
+	0xb4, 0x16, 0x04, 0xe1, 0xd3, 0x22, 0xf7, 0x6e, 0xce, 0xce, 0xa5, 0xb9, 0x9e, 0xa8, 0x59, 0x41,
+	0x3d, 0x6b, 0xff, 0x81, 0xc4, 0xdf, 0xa3, 0x6b, 0x75, 0x45, 0x2d, 0x75, 0x3b, 0x60, 0x76, 0xa3,
+	0xc8, 0x3d, 0x6f, 0x85, 0xd9, 0x42, 0xf6, 0x2e, 0x40, 0xe0, 0x6f, 0xd1, 0x4e, 0x24, 0xb3, 0x91,
+	0x60, 0x83, 0x94, 0x25, 0x51, 0x8d, 0x7d, 0x6d, 0x99, 0x5d, 0xca, 0x88, 0xb6, 0xba, 0x45, 0xf6,
+	0x6a, 0x82, 0xed, 0x9b, 0x1a, 0xc3, 0xb4, 0x81, 0x5d, 0x1c, 0x18, 0x2a, 0x58, 0xf9, 0xaa, 0x71,
+	0xdf, 0x5d, 0x66, 0xd7, 0x74, 0xf6, 0x75, 0x2d, 0x58, 0xf5, 0xaa, 0xf2, 0x83, 0x0b, 0x10, 0xb7,
+	0x1b, 0xbf, 0xff, 0xe1, 0x6d, 0x1c, 0x36, 0xb6, 0x9c, 0xe6, 0xa5, 0xc3, 0xc6, 0xd6, 0xa5, 0xe6,
+	0xe5, 0xfd, 0x3b, 0x4f, 0x4f, 0xdb, 0xce, 0xb3, 0xd3, 0xb6, 0xf3, 0xcf, 0x69, 0xdb, 0x79, 0x72,
+	0xd6, 0xde, 0x78, 0x76, 0xd6, 0xde, 0xf8, 0xeb, 0xac, 0xbd, 0xf1, 0xdd, 0xcd, 0x5a, 0x26, 0xaa,
+	0x8f, 0x31, 0xb8, 0x76, 0x1f, 0x77, 0x67, 0x5f, 0x6c, 0x10, 0x8c, 0xd1, 0x26, 0x7c, 0x85, 0xdd,
+	0xfa, 0x77, 0x00, 0x66, 0xa8, 0x70, 0x03, 0xc9, 0x09, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -171,6 +241,82 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AttestationStaleBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.AttestationStaleBlocks))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
+	}
+	if m.DoubleSpendJailEpochs != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DoubleSpendJailEpochs))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	if m.UnresponsiveJailEpochs != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.UnresponsiveJailEpochs))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if m.UnresponsiveReportersThreshold != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.UnresponsiveReportersThreshold))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	{
+		size := m.DelegationCommission.Size()
+		i -= size
+		if _, err := m.DelegationCommission.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x9a
+	{
+		size := m.GeolocationFallbackScore.Size()
+		i -= size
+		if _, err := m.GeolocationFallbackScore.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x92
+	{
+		size := m.ClientOveruseSlashFraction.Size()
+		i -= size
+		if _, err := m.ClientOveruseSlashFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x8a
+	if m.ClientJailEpochs != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ClientJailEpochs))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.ClientOveruseJailThreshold != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ClientOveruseJailThreshold))
+		i--
+		dAtA[i] = 0x78
+	}
 	if m.RecommendedEpochNumToCollectPayment != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.RecommendedEpochNumToCollectPayment))
 		i--
@@ -319,6 +465,30 @@ func (m *Params) Size() (n int) {
 	if m.RecommendedEpochNumToCollectPayment != 0 {
 		n += 1 + sovParams(uint64(m.RecommendedEpochNumToCollectPayment))
 	}
+	if m.ClientOveruseJailThreshold != 0 {
+		n += 1 + sovParams(uint64(m.ClientOveruseJailThreshold))
+	}
+	if m.ClientJailEpochs != 0 {
+		n += 2 + sovParams(uint64(m.ClientJailEpochs))
+	}
+	l = m.ClientOveruseSlashFraction.Size()
+	n += 2 + l + sovParams(uint64(l))
+	l = m.GeolocationFallbackScore.Size()
+	n += 2 + l + sovParams(uint64(l))
+	l = m.DelegationCommission.Size()
+	n += 2 + l + sovParams(uint64(l))
+	if m.UnresponsiveReportersThreshold != 0 {
+		n += 2 + sovParams(uint64(m.UnresponsiveReportersThreshold))
+	}
+	if m.UnresponsiveJailEpochs != 0 {
+		n += 2 + sovParams(uint64(m.UnresponsiveJailEpochs))
+	}
+	if m.DoubleSpendJailEpochs != 0 {
+		n += 2 + sovParams(uint64(m.DoubleSpendJailEpochs))
+	}
+	if m.AttestationStaleBlocks != 0 {
+		n += 2 + sovParams(uint64(m.AttestationStaleBlocks))
+	}
 	return n
 }
 
@@ -705,6 +875,222 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientOveruseJailThreshold", wireType)
+			}
+			m.ClientOveruseJailThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClientOveruseJailThreshold |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientJailEpochs", wireType)
+			}
+			m.ClientJailEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClientJailEpochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientOveruseSlashFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ClientOveruseSlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GeolocationFallbackScore", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GeolocationFallbackScore.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationCommission", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DelegationCommission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnresponsiveReportersThreshold", wireType)
+			}
+			m.UnresponsiveReportersThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnresponsiveReportersThreshold |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnresponsiveJailEpochs", wireType)
+			}
+			m.UnresponsiveJailEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnresponsiveJailEpochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoubleSpendJailEpochs", wireType)
+			}
+			m.DoubleSpendJailEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DoubleSpendJailEpochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestationStaleBlocks", wireType)
+			}
+			m.AttestationStaleBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AttestationStaleBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
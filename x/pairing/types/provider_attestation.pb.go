@@ -0,0 +1,496 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: pairing/provider_attestation.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// the latest liveness attestation of a provider's endpoints on a chain
+type ProviderAttestation struct {
+	Provider        string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	ChainID         string `protobuf:"bytes,2,opt,name=chainID,proto3" json:"chainID,omitempty"`
+	LastSeenBlock   uint64 `protobuf:"varint,3,opt,name=last_seen_block,json=lastSeenBlock,proto3" json:"last_seen_block,omitempty"`
+	ProtocolVersion uint32 `protobuf:"varint,4,opt,name=protocol_version,json=protocolVersion,proto3" json:"protocol_version,omitempty"`
+	BinaryVersion   string `protobuf:"bytes,5,opt,name=binary_version,json=binaryVersion,proto3" json:"binary_version,omitempty"`
+}
+
+func (m *ProviderAttestation) Reset()         { *m = ProviderAttestation{} }
+func (m *ProviderAttestation) String() string { return proto.CompactTextString(m) }
+func (*ProviderAttestation) ProtoMessage()    {}
+func (*ProviderAttestation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c0620f4128d0557f, []int{0}
+}
+func (m *ProviderAttestation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProviderAttestation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProviderAttestation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProviderAttestation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProviderAttestation.Merge(m, src)
+}
+func (m *ProviderAttestation) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProviderAttestation) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProviderAttestation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProviderAttestation proto.InternalMessageInfo
+
+func (m *ProviderAttestation) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+func (m *ProviderAttestation) GetChainID() string {
+	if m != nil {
+		return m.ChainID
+	}
+	return ""
+}
+
+func (m *ProviderAttestation) GetLastSeenBlock() uint64 {
+	if m != nil {
+		return m.LastSeenBlock
+	}
+	return 0
+}
+
+func (m *ProviderAttestation) GetProtocolVersion() uint32 {
+	if m != nil {
+		return m.ProtocolVersion
+	}
+	return 0
+}
+
+func (m *ProviderAttestation) GetBinaryVersion() string {
+	if m != nil {
+		return m.BinaryVersion
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*ProviderAttestation)(nil), "lavanet.lava.pairing.ProviderAttestation")
+}
+
+func init() {
+	proto.RegisterFile("pairing/provider_attestation.proto", fileDescriptor_c0620f4128d0557f)
+}
+
+var fileDescriptor_c0620f4128d0557f = []byte{
+	// 260 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x2a, 0x48, 0xcc, 0x2c,
+	0xca, 0xcc, 0x4b, 0xd7, 0x2f, 0x28, 0xca, 0x2f, 0xcb, 0x4c, 0x49, 0x2d, 0x8a, 0x4f, 0x2c, 0x29,
+	0x49, 0x2d, 0x2e, 0x49, 0x2c, 0xc9, 0xcc, 0xcf, 0xd3, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12,
+	0xc9, 0x49, 0x2c, 0x4b, 0xcc, 0x4b, 0x2d, 0xd1, 0x03, 0xd1, 0x7a, 0x50, 0x0d, 0x4a, 0x47, 0x19,
+	0xb9, 0x84, 0x03, 0xa0, 0x9a, 0x1c, 0x11, 0x7a, 0x84, 0xa4, 0xb8, 0x38, 0x60, 0x66, 0x49, 0x30,
+	0x2a, 0x30, 0x6a, 0x70, 0x06, 0xc1, 0xf9, 0x42, 0x12, 0x5c, 0xec, 0xc9, 0x19, 0x89, 0x99, 0x79,
+	0x9e, 0x2e, 0x12, 0x4c, 0x60, 0x29, 0x18, 0x57, 0x48, 0x8d, 0x8b, 0x3f, 0x27, 0xb1, 0xb8, 0x24,
+	0xbe, 0x38, 0x35, 0x35, 0x2f, 0x3e, 0x29, 0x27, 0x3f, 0x39, 0x5b, 0x82, 0x59, 0x81, 0x51, 0x83,
+	0x25, 0x88, 0x17, 0x24, 0x1c, 0x9c, 0x9a, 0x9a, 0xe7, 0x04, 0x12, 0x14, 0xd2, 0xe4, 0x12, 0x00,
+	0x3b, 0x2a, 0x39, 0x3f, 0x27, 0xbe, 0x2c, 0xb5, 0xa8, 0x38, 0x33, 0x3f, 0x4f, 0x82, 0x45, 0x81,
+	0x51, 0x83, 0x37, 0x88, 0x1f, 0x26, 0x1e, 0x06, 0x11, 0x16, 0x52, 0xe5, 0xe2, 0x4b, 0xca, 0xcc,
+	0x4b, 0x2c, 0xaa, 0x84, 0x2b, 0x64, 0x05, 0xdb, 0xc9, 0x0b, 0x11, 0x85, 0x2a, 0x73, 0x72, 0x3c,
+	0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63,
+	0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0xf5, 0xf4, 0xcc, 0x92, 0x8c, 0xd2,
+	0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0x7d, 0x68, 0x10, 0x80, 0x69, 0xfd, 0x0a, 0x7d, 0x58, 0xa8, 0x95,
+	0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81, 0xad, 0x36, 0x06, 0x0c, 0x00, 0xdf, 0x3c, 0x6a, 0x66,
+	0x4d, 0x01, 0x00, 0x00,
+}
+
+func (m *ProviderAttestation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProviderAttestation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProviderAttestation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BinaryVersion) > 0 {
+		i -= len(m.BinaryVersion)
+		copy(dAtA[i:], m.BinaryVersion)
+		i = encodeVarintProviderAttestation(dAtA, i, uint64(len(m.BinaryVersion)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ProtocolVersion != 0 {
+		i = encodeVarintProviderAttestation(dAtA, i, uint64(m.ProtocolVersion))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.LastSeenBlock != 0 {
+		i = encodeVarintProviderAttestation(dAtA, i, uint64(m.LastSeenBlock))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintProviderAttestation(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintProviderAttestation(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintProviderAttestation(dAtA []byte, offset int, v uint64) int {
+	offset -= sovProviderAttestation(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ProviderAttestation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovProviderAttestation(uint64(l))
+	}
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovProviderAttestation(uint64(l))
+	}
+	if m.LastSeenBlock != 0 {
+		n += 1 + sovProviderAttestation(uint64(m.LastSeenBlock))
+	}
+	if m.ProtocolVersion != 0 {
+		n += 1 + sovProviderAttestation(uint64(m.ProtocolVersion))
+	}
+	l = len(m.BinaryVersion)
+	if l > 0 {
+		n += 1 + l + sovProviderAttestation(uint64(l))
+	}
+	return n
+}
+
+func sovProviderAttestation(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozProviderAttestation(x uint64) (n int) {
+	return sovProviderAttestation(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ProviderAttestation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProviderAttestation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProviderAttestation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProviderAttestation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProviderAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProviderAttestation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProviderAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProviderAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProviderAttestation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProviderAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSeenBlock", wireType)
+			}
+			m.LastSeenBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProviderAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastSeenBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolVersion", wireType)
+			}
+			m.ProtocolVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProviderAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProtocolVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BinaryVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProviderAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProviderAttestation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProviderAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BinaryVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProviderAttestation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProviderAttestation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipProviderAttestation(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowProviderAttestation
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowProviderAttestation
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowProviderAttestation
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthProviderAttestation
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupProviderAttestation
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthProviderAttestation
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthProviderAttestation        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowProviderAttestation          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupProviderAttestation = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,46 @@
+package types
+
+const (
+	AddonArchive   = "archive"
+	AddonTrace     = "trace"
+	AddonWebsocket = "websocket"
+)
+
+const (
+	MAX_LEN_PROVIDER_METADATA_FIELD = 100
+	MAX_LEN_PROVIDER_DESCRIPTION    = 300
+)
+
+// SupportedAddons are the add-ons a provider can advertise in its metadata
+var SupportedAddons = []string{AddonArchive, AddonTrace, AddonWebsocket}
+
+// ValidateAddons checks that the add-ons are supported and not repeated
+func ValidateAddons(addons []string) error {
+	seen := map[string]struct{}{}
+	for _, addon := range addons {
+		if _, ok := seen[addon]; ok || !IsSupportedAddon(addon) {
+			return UnsupportedAddonError
+		}
+		seen[addon] = struct{}{}
+	}
+	return nil
+}
+
+func IsSupportedAddon(addon string) bool {
+	for _, supported := range SupportedAddons {
+		if addon == supported {
+			return true
+		}
+	}
+	return false
+}
+
+// HasAddon returns whether the provider advertises the add-on
+func (metadata ProviderMetadata) HasAddon(addon string) bool {
+	for _, advertised := range metadata.Addons {
+		if advertised == addon {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,841 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: pairing/provider_qos.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// the sums of the qos reports clients attached to the relay payments of a provider in an epoch
+type ProviderQoSReports struct {
+	Epoch           uint64                                 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Reports         uint64                                 `protobuf:"varint,2,opt,name=reports,proto3" json:"reports,omitempty"`
+	LatencySum      github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=latencySum,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"latencySum"`
+	AvailabilitySum github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=availabilitySum,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"availabilitySum"`
+	SyncSum         github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=syncSum,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"syncSum"`
+}
+
+func (m *ProviderQoSReports) Reset()         { *m = ProviderQoSReports{} }
+func (m *ProviderQoSReports) String() string { return proto.CompactTextString(m) }
+func (*ProviderQoSReports) ProtoMessage()    {}
+func (*ProviderQoSReports) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82e057664e4ac1fd, []int{0}
+}
+func (m *ProviderQoSReports) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProviderQoSReports) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProviderQoSReports.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProviderQoSReports) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProviderQoSReports.Merge(m, src)
+}
+func (m *ProviderQoSReports) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProviderQoSReports) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProviderQoSReports.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProviderQoSReports proto.InternalMessageInfo
+
+func (m *ProviderQoSReports) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *ProviderQoSReports) GetReports() uint64 {
+	if m != nil {
+		return m.Reports
+	}
+	return 0
+}
+
+// the rewards of a provider's relays in an epoch on a spec with a minimum qos score, withheld until the epoch can't be claimed anymore
+// and the provider's qos score in it is final
+type ProviderWithheldReward struct {
+	Epoch           uint64                                 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	ChainID         string                                 `protobuf:"bytes,2,opt,name=chainID,proto3" json:"chainID,omitempty"`
+	Provider        string                                 `protobuf:"bytes,3,opt,name=provider,proto3" json:"provider,omitempty"`
+	Reward          github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=reward,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reward"`
+	DelegatorsShare github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=delegatorsShare,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"delegatorsShare"`
+}
+
+func (m *ProviderWithheldReward) Reset()         { *m = ProviderWithheldReward{} }
+func (m *ProviderWithheldReward) String() string { return proto.CompactTextString(m) }
+func (*ProviderWithheldReward) ProtoMessage()    {}
+func (*ProviderWithheldReward) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82e057664e4ac1fd, []int{1}
+}
+func (m *ProviderWithheldReward) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProviderWithheldReward) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProviderWithheldReward.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProviderWithheldReward) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProviderWithheldReward.Merge(m, src)
+}
+func (m *ProviderWithheldReward) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProviderWithheldReward) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProviderWithheldReward.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProviderWithheldReward proto.InternalMessageInfo
+
+func (m *ProviderWithheldReward) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *ProviderWithheldReward) GetChainID() string {
+	if m != nil {
+		return m.ChainID
+	}
+	return ""
+}
+
+func (m *ProviderWithheldReward) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*ProviderQoSReports)(nil), "lavanet.lava.pairing.ProviderQoSReports")
+	proto.RegisterType((*ProviderWithheldReward)(nil), "lavanet.lava.pairing.ProviderWithheldReward")
+}
+
+func init() { proto.RegisterFile("pairing/provider_qos.proto", fileDescriptor_82e057664e4ac1fd) }
+
+var fileDescriptor_82e057664e4ac1fd = []byte{
+	// 352 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0x3f, 0x4e, 0xc3, 0x30,
+	0x14, 0xc6, 0x93, 0xd2, 0x3f, 0xd4, 0x0b, 0x92, 0x55, 0xa1, 0xa8, 0x43, 0x5a, 0x75, 0x80, 0x2e,
+	0x24, 0x03, 0x27, 0xa0, 0xaa, 0x10, 0x2c, 0x08, 0xd2, 0x01, 0xc4, 0x82, 0x5c, 0xc7, 0x4a, 0x2c,
+	0xd2, 0xbc, 0x60, 0xbb, 0x85, 0x5e, 0x80, 0x99, 0xe3, 0x70, 0x84, 0x8e, 0x1d, 0x11, 0x43, 0x85,
+	0x9a, 0x8b, 0xa0, 0x38, 0x09, 0xaa, 0x2a, 0xb1, 0x64, 0x7a, 0xf9, 0xa2, 0xef, 0xfd, 0x86, 0x9f,
+	0x1f, 0xea, 0x26, 0x84, 0x0b, 0x1e, 0x07, 0x6e, 0x22, 0x60, 0xc1, 0x7d, 0x26, 0x9e, 0x5e, 0x40,
+	0x3a, 0x89, 0x00, 0x05, 0xb8, 0x13, 0x91, 0x05, 0x89, 0x99, 0x72, 0xb2, 0xe9, 0x14, 0xc5, 0x6e,
+	0x27, 0x80, 0x00, 0x74, 0xc1, 0xcd, 0xbe, 0xf2, 0xee, 0xe0, 0xb3, 0x86, 0xf0, 0x6d, 0x81, 0xb8,
+	0x83, 0x89, 0xc7, 0x12, 0x10, 0x4a, 0xe2, 0x0e, 0x6a, 0xb0, 0x04, 0x68, 0x68, 0x99, 0x7d, 0x73,
+	0x58, 0xf7, 0xf2, 0x80, 0x2d, 0xd4, 0x12, 0x79, 0xc1, 0xaa, 0xe9, 0xff, 0x65, 0xc4, 0x37, 0x08,
+	0x45, 0x44, 0xb1, 0x98, 0x2e, 0x27, 0xf3, 0x99, 0x75, 0xd0, 0x37, 0x87, 0xed, 0x91, 0xb3, 0xda,
+	0xf4, 0x8c, 0xef, 0x4d, 0xef, 0x24, 0xe0, 0x2a, 0x9c, 0x4f, 0x1d, 0x0a, 0x33, 0x97, 0x82, 0x9c,
+	0x81, 0x2c, 0xc6, 0x99, 0xf4, 0x9f, 0x5d, 0xb5, 0x4c, 0x98, 0x74, 0xc6, 0x8c, 0x7a, 0x3b, 0x04,
+	0xfc, 0x80, 0x8e, 0xc8, 0x82, 0xf0, 0x88, 0x4c, 0x79, 0xc4, 0x95, 0x86, 0xd6, 0x2b, 0x41, 0xf7,
+	0x31, 0xf8, 0x0a, 0xb5, 0xe4, 0x32, 0xa6, 0x19, 0xb1, 0x51, 0x89, 0x58, 0xae, 0x0f, 0xde, 0x6b,
+	0xe8, 0xb8, 0x54, 0x77, 0xcf, 0x55, 0x18, 0xb2, 0xc8, 0xf7, 0xd8, 0x2b, 0x11, 0xfe, 0xff, 0xfa,
+	0x68, 0x48, 0x78, 0x7c, 0x3d, 0xd6, 0xfa, 0xda, 0x5e, 0x19, 0x71, 0x17, 0x1d, 0x96, 0xef, 0x98,
+	0xcb, 0xf3, 0xfe, 0x32, 0xbe, 0x44, 0x4d, 0xa1, 0xa9, 0x15, 0x0d, 0x14, 0xdb, 0x99, 0x52, 0x9f,
+	0x45, 0x2c, 0x20, 0x0a, 0x84, 0x9c, 0x84, 0x44, 0xb0, 0x8a, 0x02, 0xf6, 0x31, 0xa3, 0x8b, 0xd5,
+	0xd6, 0x36, 0xd7, 0x5b, 0xdb, 0xfc, 0xd9, 0xda, 0xe6, 0x47, 0x6a, 0x1b, 0xeb, 0xd4, 0x36, 0xbe,
+	0x52, 0xdb, 0x78, 0x3c, 0xdd, 0x41, 0x16, 0x47, 0xa9, 0xa7, 0xfb, 0xe6, 0x96, 0xf7, 0xab, 0xb9,
+	0xd3, 0xa6, 0xbe, 0xc6, 0xf3, 0xdf, 0x01, 0x00, 0x12, 0xac, 0x30, 0x4d, 0xd7, 0x02, 0x00, 0x00,
+}
+
+func (m *ProviderQoSReports) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProviderQoSReports) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProviderQoSReports) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.SyncSum.Size()
+		i -= size
+		if _, err := m.SyncSum.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintProviderQos(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.AvailabilitySum.Size()
+		i -= size
+		if _, err := m.AvailabilitySum.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintProviderQos(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.LatencySum.Size()
+		i -= size
+		if _, err := m.LatencySum.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintProviderQos(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Reports != 0 {
+		i = encodeVarintProviderQos(dAtA, i, uint64(m.Reports))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintProviderQos(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProviderWithheldReward) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProviderWithheldReward) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProviderWithheldReward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.DelegatorsShare.Size()
+		i -= size
+		if _, err := m.DelegatorsShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintProviderQos(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Reward.Size()
+		i -= size
+		if _, err := m.Reward.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintProviderQos(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintProviderQos(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintProviderQos(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintProviderQos(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintProviderQos(dAtA []byte, offset int, v uint64) int {
+	offset -= sovProviderQos(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ProviderQoSReports) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovProviderQos(uint64(m.Epoch))
+	}
+	if m.Reports != 0 {
+		n += 1 + sovProviderQos(uint64(m.Reports))
+	}
+	l = m.LatencySum.Size()
+	n += 1 + l + sovProviderQos(uint64(l))
+	l = m.AvailabilitySum.Size()
+	n += 1 + l + sovProviderQos(uint64(l))
+	l = m.SyncSum.Size()
+	n += 1 + l + sovProviderQos(uint64(l))
+	return n
+}
+
+func (m *ProviderWithheldReward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovProviderQos(uint64(m.Epoch))
+	}
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovProviderQos(uint64(l))
+	}
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovProviderQos(uint64(l))
+	}
+	l = m.Reward.Size()
+	n += 1 + l + sovProviderQos(uint64(l))
+	l = m.DelegatorsShare.Size()
+	n += 1 + l + sovProviderQos(uint64(l))
+	return n
+}
+
+func sovProviderQos(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozProviderQos(x uint64) (n int) {
+	return sovProviderQos(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ProviderQoSReports) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProviderQos
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProviderQoSReports: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProviderQoSReports: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProviderQos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reports", wireType)
+			}
+			m.Reports = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProviderQos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reports |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatencySum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProviderQos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProviderQos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProviderQos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LatencySum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AvailabilitySum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProviderQos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProviderQos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProviderQos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AvailabilitySum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncSum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProviderQos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProviderQos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProviderQos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SyncSum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProviderQos(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProviderQos
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProviderWithheldReward) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProviderQos
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProviderWithheldReward: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProviderWithheldReward: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProviderQos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProviderQos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProviderQos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProviderQos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProviderQos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProviderQos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProviderQos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reward", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProviderQos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProviderQos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProviderQos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Reward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorsShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProviderQos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProviderQos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProviderQos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DelegatorsShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProviderQos(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProviderQos
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipProviderQos(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowProviderQos
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowProviderQos
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowProviderQos
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthProviderQos
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupProviderQos
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthProviderQos
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthProviderQos        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowProviderQos          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupProviderQos = fmt.Errorf("proto: unexpected end of group")
+)
@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
@@ -315,8 +316,9 @@ func (m *QueryClientsResponse) GetOutput() string {
 }
 
 type QueryGetPairingRequest struct {
-	ChainID string `protobuf:"bytes,1,opt,name=chainID,proto3" json:"chainID,omitempty"`
-	Client  string `protobuf:"bytes,2,opt,name=client,proto3" json:"client,omitempty"`
+	ChainID string          `protobuf:"bytes,1,opt,name=chainID,proto3" json:"chainID,omitempty"`
+	Client  string          `protobuf:"bytes,2,opt,name=client,proto3" json:"client,omitempty"`
+	Filters *PairingFilters `protobuf:"bytes,3,opt,name=filters,proto3" json:"filters,omitempty"`
 }
 
 func (m *QueryGetPairingRequest) Reset()         { *m = QueryGetPairingRequest{} }
@@ -366,19 +368,91 @@ func (m *QueryGetPairingRequest) GetClient() string {
 	return ""
 }
 
+func (m *QueryGetPairingRequest) GetFilters() *PairingFilters {
+	if m != nil {
+		return m.Filters
+	}
+	return nil
+}
+
+// PairingFilters narrow down a client's pairing by on chain data, the filtered providers are still a subset of the pairing
+// so relays to them are paid as usual
+type PairingFilters struct {
+	RequiredAddOns []string                               `protobuf:"bytes,1,rep,name=required_add_ons,json=requiredAddOns,proto3" json:"required_add_ons,omitempty"`
+	Geolocation    uint64                                 `protobuf:"varint,2,opt,name=geolocation,proto3" json:"geolocation,omitempty"`
+	MinStake       github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=min_stake,json=minStake,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_stake"`
+	Exclude        []string                               `protobuf:"bytes,4,rep,name=exclude,proto3" json:"exclude,omitempty"`
+}
+
+func (m *PairingFilters) Reset()         { *m = PairingFilters{} }
+func (m *PairingFilters) String() string { return proto.CompactTextString(m) }
+func (*PairingFilters) ProtoMessage()    {}
+func (*PairingFilters) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6bd8a3cd41a2a1ee, []int{7}
+}
+func (m *PairingFilters) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PairingFilters) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PairingFilters.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PairingFilters) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PairingFilters.Merge(m, src)
+}
+func (m *PairingFilters) XXX_Size() int {
+	return m.Size()
+}
+func (m *PairingFilters) XXX_DiscardUnknown() {
+	xxx_messageInfo_PairingFilters.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PairingFilters proto.InternalMessageInfo
+
+func (m *PairingFilters) GetRequiredAddOns() []string {
+	if m != nil {
+		return m.RequiredAddOns
+	}
+	return nil
+}
+
+func (m *PairingFilters) GetGeolocation() uint64 {
+	if m != nil {
+		return m.Geolocation
+	}
+	return 0
+}
+
+func (m *PairingFilters) GetExclude() []string {
+	if m != nil {
+		return m.Exclude
+	}
+	return nil
+}
+
 type QueryGetPairingResponse struct {
 	Providers             []types.StakeEntry `protobuf:"bytes,1,rep,name=providers,proto3" json:"providers"`
 	CurrentEpoch          uint64             `protobuf:"varint,2,opt,name=current_epoch,json=currentEpoch,proto3" json:"current_epoch,omitempty"`
 	TimeLeftToNextPairing uint64             `protobuf:"varint,3,opt,name=time_left_to_next_pairing,json=timeLeftToNextPairing,proto3" json:"time_left_to_next_pairing,omitempty"`
 	SpecLastUpdatedBlock  uint64             `protobuf:"varint,4,opt,name=spec_last_updated_block,json=specLastUpdatedBlock,proto3" json:"spec_last_updated_block,omitempty"`
 	BlockOfNextPairing    uint64             `protobuf:"varint,5,opt,name=block_of_next_pairing,json=blockOfNextPairing,proto3" json:"block_of_next_pairing,omitempty"`
+	AllowedApis           []string           `protobuf:"bytes,6,rep,name=allowed_apis,json=allowedApis,proto3" json:"allowed_apis,omitempty"`
+	AddOns                []string           `protobuf:"bytes,7,rep,name=add_ons,json=addOns,proto3" json:"add_ons,omitempty"`
 }
 
 func (m *QueryGetPairingResponse) Reset()         { *m = QueryGetPairingResponse{} }
 func (m *QueryGetPairingResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetPairingResponse) ProtoMessage()    {}
 func (*QueryGetPairingResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6bd8a3cd41a2a1ee, []int{7}
+	return fileDescriptor_6bd8a3cd41a2a1ee, []int{8}
 }
 func (m *QueryGetPairingResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -442,6 +516,20 @@ func (m *QueryGetPairingResponse) GetBlockOfNextPairing() uint64 {
 	return 0
 }
 
+func (m *QueryGetPairingResponse) GetAllowedApis() []string {
+	if m != nil {
+		return m.AllowedApis
+	}
+	return nil
+}
+
+func (m *QueryGetPairingResponse) GetAddOns() []string {
+	if m != nil {
+		return m.AddOns
+	}
+	return nil
+}
+
 type QueryVerifyPairingRequest struct {
 	ChainID  string `protobuf:"bytes,1,opt,name=chainID,proto3" json:"chainID,omitempty"`
 	Client   string `protobuf:"bytes,2,opt,name=client,proto3" json:"client,omitempty"`
@@ -453,7 +541,7 @@ func (m *QueryVerifyPairingRequest) Reset()         { *m = QueryVerifyPairingReq
 func (m *QueryVerifyPairingRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryVerifyPairingRequest) ProtoMessage()    {}
 func (*QueryVerifyPairingRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6bd8a3cd41a2a1ee, []int{8}
+	return fileDescriptor_6bd8a3cd41a2a1ee, []int{9}
 }
 func (m *QueryVerifyPairingRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -521,7 +609,7 @@ func (m *QueryVerifyPairingResponse) Reset()         { *m = QueryVerifyPairingRe
 func (m *QueryVerifyPairingResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryVerifyPairingResponse) ProtoMessage()    {}
 func (*QueryVerifyPairingResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6bd8a3cd41a2a1ee, []int{9}
+	return fileDescriptor_6bd8a3cd41a2a1ee, []int{10}
 }
 func (m *QueryVerifyPairingResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -590,7 +678,7 @@ func (m *QueryGetUniquePaymentStorageClientProviderRequest) String() string {
 }
 func (*QueryGetUniquePaymentStorageClientProviderRequest) ProtoMessage() {}
 func (*QueryGetUniquePaymentStorageClientProviderRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6bd8a3cd41a2a1ee, []int{10}
+	return fileDescriptor_6bd8a3cd41a2a1ee, []int{11}
 }
 func (m *QueryGetUniquePaymentStorageClientProviderRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -638,7 +726,7 @@ func (m *QueryGetUniquePaymentStorageClientProviderResponse) String() string {
 }
 func (*QueryGetUniquePaymentStorageClientProviderResponse) ProtoMessage() {}
 func (*QueryGetUniquePaymentStorageClientProviderResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6bd8a3cd41a2a1ee, []int{11}
+	return fileDescriptor_6bd8a3cd41a2a1ee, []int{12}
 }
 func (m *QueryGetUniquePaymentStorageClientProviderResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -686,7 +774,7 @@ func (m *QueryAllUniquePaymentStorageClientProviderRequest) String() string {
 }
 func (*QueryAllUniquePaymentStorageClientProviderRequest) ProtoMessage() {}
 func (*QueryAllUniquePaymentStorageClientProviderRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6bd8a3cd41a2a1ee, []int{12}
+	return fileDescriptor_6bd8a3cd41a2a1ee, []int{13}
 }
 func (m *QueryAllUniquePaymentStorageClientProviderRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -735,7 +823,7 @@ func (m *QueryAllUniquePaymentStorageClientProviderResponse) String() string {
 }
 func (*QueryAllUniquePaymentStorageClientProviderResponse) ProtoMessage() {}
 func (*QueryAllUniquePaymentStorageClientProviderResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6bd8a3cd41a2a1ee, []int{13}
+	return fileDescriptor_6bd8a3cd41a2a1ee, []int{14}
 }
 func (m *QueryAllUniquePaymentStorageClientProviderResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -786,7 +874,7 @@ func (m *QueryGetProviderPaymentStorageRequest) Reset()         { *m = QueryGetP
 func (m *QueryGetProviderPaymentStorageRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetProviderPaymentStorageRequest) ProtoMessage()    {}
 func (*QueryGetProviderPaymentStorageRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6bd8a3cd41a2a1ee, []int{14}
+	return fileDescriptor_6bd8a3cd41a2a1ee, []int{15}
 }
 func (m *QueryGetProviderPaymentStorageRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -832,7 +920,7 @@ func (m *QueryGetProviderPaymentStorageResponse) Reset() {
 func (m *QueryGetProviderPaymentStorageResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetProviderPaymentStorageResponse) ProtoMessage()    {}
 func (*QueryGetProviderPaymentStorageResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6bd8a3cd41a2a1ee, []int{15}
+	return fileDescriptor_6bd8a3cd41a2a1ee, []int{16}
 }
 func (m *QueryGetProviderPaymentStorageResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -876,7 +964,7 @@ func (m *QueryAllProviderPaymentStorageRequest) Reset()         { *m = QueryAllP
 func (m *QueryAllProviderPaymentStorageRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAllProviderPaymentStorageRequest) ProtoMessage()    {}
 func (*QueryAllProviderPaymentStorageRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6bd8a3cd41a2a1ee, []int{16}
+	return fileDescriptor_6bd8a3cd41a2a1ee, []int{17}
 }
 func (m *QueryAllProviderPaymentStorageRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -923,7 +1011,7 @@ func (m *QueryAllProviderPaymentStorageResponse) Reset() {
 func (m *QueryAllProviderPaymentStorageResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAllProviderPaymentStorageResponse) ProtoMessage()    {}
 func (*QueryAllProviderPaymentStorageResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6bd8a3cd41a2a1ee, []int{17}
+	return fileDescriptor_6bd8a3cd41a2a1ee, []int{18}
 }
 func (m *QueryAllProviderPaymentStorageResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -974,7 +1062,7 @@ func (m *QueryGetEpochPaymentsRequest) Reset()         { *m = QueryGetEpochPayme
 func (m *QueryGetEpochPaymentsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetEpochPaymentsRequest) ProtoMessage()    {}
 func (*QueryGetEpochPaymentsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6bd8a3cd41a2a1ee, []int{18}
+	return fileDescriptor_6bd8a3cd41a2a1ee, []int{19}
 }
 func (m *QueryGetEpochPaymentsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1018,7 +1106,7 @@ func (m *QueryGetEpochPaymentsResponse) Reset()         { *m = QueryGetEpochPaym
 func (m *QueryGetEpochPaymentsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetEpochPaymentsResponse) ProtoMessage()    {}
 func (*QueryGetEpochPaymentsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6bd8a3cd41a2a1ee, []int{19}
+	return fileDescriptor_6bd8a3cd41a2a1ee, []int{20}
 }
 func (m *QueryGetEpochPaymentsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1062,7 +1150,7 @@ func (m *QueryAllEpochPaymentsRequest) Reset()         { *m = QueryAllEpochPayme
 func (m *QueryAllEpochPaymentsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAllEpochPaymentsRequest) ProtoMessage()    {}
 func (*QueryAllEpochPaymentsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6bd8a3cd41a2a1ee, []int{20}
+	return fileDescriptor_6bd8a3cd41a2a1ee, []int{21}
 }
 func (m *QueryAllEpochPaymentsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1107,7 +1195,7 @@ func (m *QueryAllEpochPaymentsResponse) Reset()         { *m = QueryAllEpochPaym
 func (m *QueryAllEpochPaymentsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAllEpochPaymentsResponse) ProtoMessage()    {}
 func (*QueryAllEpochPaymentsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6bd8a3cd41a2a1ee, []int{21}
+	return fileDescriptor_6bd8a3cd41a2a1ee, []int{22}
 }
 func (m *QueryAllEpochPaymentsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1160,7 +1248,7 @@ func (m *QueryUserEntryRequest) Reset()         { *m = QueryUserEntryRequest{} }
 func (m *QueryUserEntryRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryUserEntryRequest) ProtoMessage()    {}
 func (*QueryUserEntryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6bd8a3cd41a2a1ee, []int{22}
+	return fileDescriptor_6bd8a3cd41a2a1ee, []int{23}
 }
 func (m *QueryUserEntryRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1219,7 +1307,7 @@ func (m *QueryUserEntryResponse) Reset()         { *m = QueryUserEntryResponse{}
 func (m *QueryUserEntryResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryUserEntryResponse) ProtoMessage()    {}
 func (*QueryUserEntryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6bd8a3cd41a2a1ee, []int{23}
+	return fileDescriptor_6bd8a3cd41a2a1ee, []int{24}
 }
 func (m *QueryUserEntryResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1270,7 +1358,7 @@ func (m *QueryStaticProvidersListRequest) Reset()         { *m = QueryStaticProv
 func (m *QueryStaticProvidersListRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryStaticProvidersListRequest) ProtoMessage()    {}
 func (*QueryStaticProvidersListRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6bd8a3cd41a2a1ee, []int{24}
+	return fileDescriptor_6bd8a3cd41a2a1ee, []int{25}
 }
 func (m *QueryStaticProvidersListRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1314,7 +1402,7 @@ func (m *QueryStaticProvidersListResponse) Reset()         { *m = QueryStaticPro
 func (m *QueryStaticProvidersListResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryStaticProvidersListResponse) ProtoMessage()    {}
 func (*QueryStaticProvidersListResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6bd8a3cd41a2a1ee, []int{25}
+	return fileDescriptor_6bd8a3cd41a2a1ee, []int{26}
 }
 func (m *QueryStaticProvidersListResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1350,2090 +1438,8256 @@ func (m *QueryStaticProvidersListResponse) GetProviders() []types.StakeEntry {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "lavanet.lava.pairing.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "lavanet.lava.pairing.QueryParamsResponse")
-	proto.RegisterType((*QueryProvidersRequest)(nil), "lavanet.lava.pairing.QueryProvidersRequest")
-	proto.RegisterType((*QueryProvidersResponse)(nil), "lavanet.lava.pairing.QueryProvidersResponse")
-	proto.RegisterType((*QueryClientsRequest)(nil), "lavanet.lava.pairing.QueryClientsRequest")
-	proto.RegisterType((*QueryClientsResponse)(nil), "lavanet.lava.pairing.QueryClientsResponse")
-	proto.RegisterType((*QueryGetPairingRequest)(nil), "lavanet.lava.pairing.QueryGetPairingRequest")
-	proto.RegisterType((*QueryGetPairingResponse)(nil), "lavanet.lava.pairing.QueryGetPairingResponse")
-	proto.RegisterType((*QueryVerifyPairingRequest)(nil), "lavanet.lava.pairing.QueryVerifyPairingRequest")
-	proto.RegisterType((*QueryVerifyPairingResponse)(nil), "lavanet.lava.pairing.QueryVerifyPairingResponse")
-	proto.RegisterType((*QueryGetUniquePaymentStorageClientProviderRequest)(nil), "lavanet.lava.pairing.QueryGetUniquePaymentStorageClientProviderRequest")
-	proto.RegisterType((*QueryGetUniquePaymentStorageClientProviderResponse)(nil), "lavanet.lava.pairing.QueryGetUniquePaymentStorageClientProviderResponse")
-	proto.RegisterType((*QueryAllUniquePaymentStorageClientProviderRequest)(nil), "lavanet.lava.pairing.QueryAllUniquePaymentStorageClientProviderRequest")
-	proto.RegisterType((*QueryAllUniquePaymentStorageClientProviderResponse)(nil), "lavanet.lava.pairing.QueryAllUniquePaymentStorageClientProviderResponse")
-	proto.RegisterType((*QueryGetProviderPaymentStorageRequest)(nil), "lavanet.lava.pairing.QueryGetProviderPaymentStorageRequest")
-	proto.RegisterType((*QueryGetProviderPaymentStorageResponse)(nil), "lavanet.lava.pairing.QueryGetProviderPaymentStorageResponse")
-	proto.RegisterType((*QueryAllProviderPaymentStorageRequest)(nil), "lavanet.lava.pairing.QueryAllProviderPaymentStorageRequest")
-	proto.RegisterType((*QueryAllProviderPaymentStorageResponse)(nil), "lavanet.lava.pairing.QueryAllProviderPaymentStorageResponse")
-	proto.RegisterType((*QueryGetEpochPaymentsRequest)(nil), "lavanet.lava.pairing.QueryGetEpochPaymentsRequest")
-	proto.RegisterType((*QueryGetEpochPaymentsResponse)(nil), "lavanet.lava.pairing.QueryGetEpochPaymentsResponse")
-	proto.RegisterType((*QueryAllEpochPaymentsRequest)(nil), "lavanet.lava.pairing.QueryAllEpochPaymentsRequest")
-	proto.RegisterType((*QueryAllEpochPaymentsResponse)(nil), "lavanet.lava.pairing.QueryAllEpochPaymentsResponse")
-	proto.RegisterType((*QueryUserEntryRequest)(nil), "lavanet.lava.pairing.QueryUserEntryRequest")
-	proto.RegisterType((*QueryUserEntryResponse)(nil), "lavanet.lava.pairing.QueryUserEntryResponse")
-	proto.RegisterType((*QueryStaticProvidersListRequest)(nil), "lavanet.lava.pairing.QueryStaticProvidersListRequest")
-	proto.RegisterType((*QueryStaticProvidersListResponse)(nil), "lavanet.lava.pairing.QueryStaticProvidersListResponse")
+type QueryPairingScoresRequest struct {
+	ChainID     string `protobuf:"bytes,1,opt,name=chainID,proto3" json:"chainID,omitempty"`
+	Geolocation uint64 `protobuf:"varint,2,opt,name=geolocation,proto3" json:"geolocation,omitempty"`
 }
 
-func init() { proto.RegisterFile("pairing/query.proto", fileDescriptor_6bd8a3cd41a2a1ee) }
-
-var fileDescriptor_6bd8a3cd41a2a1ee = []byte{
-	// 1492 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xcb, 0x6f, 0xdc, 0xd4,
-	0x17, 0x8e, 0x67, 0x9a, 0x34, 0x39, 0x6d, 0xf4, 0xab, 0x6e, 0xa6, 0x69, 0x6a, 0xe5, 0x37, 0x8d,
-	0xdc, 0x36, 0x4d, 0xda, 0x60, 0x37, 0x69, 0x1a, 0x2a, 0xfa, 0x90, 0xd2, 0x27, 0x2d, 0x11, 0x4d,
-	0xa7, 0x84, 0x05, 0x1b, 0xcb, 0x99, 0xb9, 0x99, 0x98, 0x7a, 0x6c, 0xd7, 0x8f, 0x34, 0x61, 0x34,
-	0x02, 0x81, 0xd8, 0x56, 0x20, 0x60, 0xc1, 0x1e, 0x09, 0x21, 0x01, 0x7b, 0xd6, 0x08, 0xd4, 0x15,
-	0xaa, 0xd4, 0x0d, 0x1b, 0x10, 0x6a, 0xf9, 0x43, 0x90, 0xef, 0x3d, 0xf6, 0xd8, 0x89, 0xc7, 0xe3,
-	0x49, 0xa2, 0xae, 0x92, 0x6b, 0x9f, 0xc7, 0xf7, 0x7d, 0xe7, 0xce, 0x3d, 0xe7, 0x1a, 0x46, 0x6c,
-	0x4d, 0x77, 0x74, 0xb3, 0xae, 0x3c, 0xf6, 0xa9, 0xb3, 0x25, 0xdb, 0x8e, 0xe5, 0x59, 0xa4, 0x64,
-	0x68, 0x1b, 0x9a, 0x49, 0x3d, 0x39, 0xf8, 0x2b, 0xa3, 0x85, 0x58, 0xaa, 0x5b, 0x75, 0x8b, 0x19,
-	0x28, 0xc1, 0x7f, 0xdc, 0x56, 0x1c, 0xaf, 0x5b, 0x56, 0xdd, 0xa0, 0x8a, 0x66, 0xeb, 0x8a, 0x66,
-	0x9a, 0x96, 0xa7, 0x79, 0xba, 0x65, 0xba, 0xf8, 0xf6, 0x6c, 0xd5, 0x72, 0x1b, 0x96, 0xab, 0xac,
-	0x6a, 0x2e, 0xe5, 0x29, 0x94, 0x8d, 0xd9, 0x55, 0xea, 0x69, 0xb3, 0x8a, 0xad, 0xd5, 0x75, 0x93,
-	0x19, 0xa3, 0x6d, 0x29, 0x84, 0x62, 0x6b, 0x8e, 0xd6, 0x08, 0x23, 0x8c, 0x87, 0x4f, 0xa9, 0x6d,
-	0x55, 0xd7, 0x55, 0x5b, 0xdb, 0x6a, 0x50, 0xd3, 0x0b, 0xdf, 0x4e, 0x46, 0x3e, 0x8e, 0xb5, 0xa1,
-	0xd7, 0xa8, 0x13, 0x1a, 0xa8, 0xae, 0x67, 0x39, 0x5a, 0x9d, 0xa2, 0xdd, 0x7c, 0x68, 0xe7, 0x9b,
-	0xfa, 0x63, 0x9f, 0x6e, 0xb7, 0x52, 0xab, 0x86, 0x1e, 0x2c, 0xc3, 0x28, 0xe8, 0x55, 0x66, 0x39,
-	0xd1, 0x46, 0x71, 0x3d, 0xed, 0x11, 0x55, 0xa9, 0xe9, 0x85, 0x3a, 0x49, 0x25, 0x20, 0x0f, 0x02,
-	0x4e, 0xcb, 0x0c, 0x70, 0x85, 0x3e, 0xf6, 0xa9, 0xeb, 0x49, 0x0f, 0x60, 0x24, 0xf1, 0xd4, 0xb5,
-	0x2d, 0xd3, 0xa5, 0xe4, 0x2d, 0x18, 0xe0, 0xc4, 0xc6, 0x84, 0x09, 0x61, 0xea, 0xd0, 0xdc, 0xb8,
-	0x9c, 0xa6, 0xb2, 0xcc, 0xbd, 0xae, 0x1f, 0x78, 0xf6, 0xf7, 0x89, 0xbe, 0x0a, 0x7a, 0x48, 0x0f,
-	0xe0, 0x28, 0x0f, 0x89, 0xf8, 0xc2, 0x5c, 0x64, 0x0c, 0x0e, 0x56, 0xd7, 0x35, 0xdd, 0xbc, 0x7b,
-	0x93, 0x45, 0x1d, 0xaa, 0x84, 0x4b, 0x52, 0x06, 0x70, 0xd7, 0xad, 0x27, 0xb7, 0x1d, 0xeb, 0x23,
-	0x6a, 0x8e, 0x15, 0x26, 0x84, 0xa9, 0xc1, 0x4a, 0xec, 0x89, 0xd4, 0x82, 0xd1, 0xed, 0x21, 0x11,
-	0xe8, 0x3b, 0x00, 0x8c, 0xea, 0xad, 0x80, 0xe9, 0x98, 0x30, 0x51, 0x9c, 0x3a, 0x34, 0x77, 0x3a,
-	0x09, 0x36, 0xae, 0x8b, 0xfc, 0x30, 0x32, 0x46, 0xd4, 0x31, 0x77, 0x32, 0x0a, 0x03, 0x96, 0xef,
-	0xd9, 0xbe, 0xc7, 0x20, 0x0c, 0x55, 0x70, 0x25, 0x29, 0x28, 0xd2, 0x0d, 0x26, 0x7c, 0x77, 0x3e,
-	0x52, 0x13, 0x4a, 0x49, 0x87, 0xd7, 0x89, 0xf6, 0x1e, 0x8a, 0x75, 0x87, 0x7a, 0xcb, 0xbc, 0x4e,
-	0xdd, 0x0b, 0x30, 0x0a, 0x03, 0x7c, 0x57, 0x85, 0xb1, 0xf8, 0x4a, 0xfa, 0xa9, 0x00, 0xc7, 0x76,
-	0x04, 0x43, 0x32, 0x77, 0x61, 0x28, 0xdc, 0x82, 0xee, 0x6e, 0xb8, 0xb4, 0xbd, 0xc9, 0x49, 0x18,
-	0xae, 0xfa, 0x8e, 0x13, 0xec, 0x6a, 0xe6, 0xc3, 0x50, 0x1c, 0xa8, 0x1c, 0xc6, 0x87, 0xb7, 0x82,
-	0x67, 0xe4, 0x12, 0x1c, 0xf7, 0xf4, 0x06, 0x55, 0x0d, 0xba, 0xe6, 0xa9, 0x9e, 0xa5, 0x9a, 0x74,
-	0xd3, 0x53, 0x71, 0x27, 0x8e, 0x15, 0x99, 0xc3, 0xd1, 0xc0, 0x60, 0x89, 0xae, 0x79, 0xef, 0x59,
-	0xef, 0xd2, 0xcd, 0x10, 0x31, 0xb9, 0x08, 0xc7, 0x5c, 0x9b, 0x56, 0x55, 0x43, 0x73, 0x3d, 0xd5,
-	0xb7, 0x6b, 0x9a, 0x47, 0x6b, 0xea, 0xaa, 0x61, 0x55, 0x1f, 0x8d, 0x1d, 0x60, 0x7e, 0xa5, 0xe0,
-	0xf5, 0x92, 0xe6, 0x7a, 0x2b, 0xfc, 0xe5, 0xf5, 0xe0, 0x1d, 0x99, 0x85, 0xa3, 0xcc, 0x48, 0xb5,
-	0xd6, 0x92, 0xc9, 0xfa, 0x99, 0x13, 0x61, 0x2f, 0xef, 0xaf, 0xc5, 0x32, 0x49, 0x1f, 0xc3, 0x71,
-	0x26, 0xd7, 0xfb, 0xd4, 0xd1, 0xd7, 0xb6, 0xf6, 0x2a, 0x3f, 0x11, 0x61, 0x30, 0x14, 0x89, 0x31,
-	0x1c, 0xaa, 0x44, 0x6b, 0x52, 0x82, 0xfe, 0x38, 0x05, 0xbe, 0x90, 0xbe, 0x11, 0x40, 0x4c, 0x43,
-	0x80, 0x35, 0x2b, 0x41, 0xff, 0x86, 0x66, 0xe8, 0x35, 0x06, 0x60, 0xb0, 0xc2, 0x17, 0xc1, 0x53,
-	0xdd, 0xac, 0xd1, 0x4d, 0x96, 0xbd, 0x58, 0xe1, 0x0b, 0x32, 0x0d, 0x47, 0x02, 0xc2, 0xb4, 0xa6,
-	0xb6, 0xcb, 0xcc, 0x65, 0xfe, 0x1f, 0x7f, 0x1e, 0xfd, 0x1a, 0xc9, 0x04, 0x1c, 0xae, 0xfa, 0xaa,
-	0x4d, 0x1d, 0x2c, 0x1f, 0x87, 0x04, 0x55, 0x7f, 0x99, 0x3a, 0xac, 0x78, 0xd2, 0x5d, 0x98, 0x0d,
-	0xf7, 0xd1, 0x0a, 0x3b, 0xd5, 0x96, 0xf9, 0xa1, 0xf6, 0x90, 0xef, 0x0e, 0xfe, 0x43, 0x09, 0x03,
-	0x86, 0x82, 0x45, 0xb8, 0xb8, 0x5c, 0x7c, 0x21, 0xfd, 0x26, 0xc0, 0x5c, 0x2f, 0xb1, 0x90, 0xfa,
-	0x53, 0x01, 0x24, 0xbf, 0xab, 0x39, 0x9e, 0x77, 0x97, 0xd2, 0xcf, 0xbb, 0xee, 0xe9, 0x70, 0x6f,
-	0xe7, 0xc8, 0x24, 0x35, 0x51, 0x92, 0x45, 0xc3, 0xc8, 0x2f, 0xc9, 0x6d, 0x80, 0x76, 0x2f, 0x42,
-	0xb0, 0x93, 0x32, 0x6f, 0x5c, 0x72, 0xd0, 0xb8, 0x64, 0xde, 0x1b, 0xb1, 0x71, 0xc9, 0xcb, 0x5a,
-	0x9d, 0xa2, 0x6f, 0x25, 0xe6, 0x29, 0x3d, 0x2d, 0xa0, 0x88, 0x39, 0xb3, 0xf7, 0x2a, 0x62, 0xf1,
-	0xf5, 0x88, 0x48, 0xee, 0x24, 0xf4, 0x28, 0x30, 0x3d, 0xce, 0x74, 0xd5, 0x83, 0xb3, 0x49, 0x08,
-	0x72, 0x15, 0x4e, 0x47, 0x07, 0x1d, 0x06, 0x4f, 0x26, 0xce, 0xde, 0x94, 0x5f, 0x0b, 0x30, 0xd9,
-	0xcd, 0x1f, 0x35, 0xfc, 0x10, 0x46, 0xed, 0x54, 0x0b, 0x2c, 0xe7, 0x4c, 0x87, 0x5e, 0x9b, 0xea,
-	0x83, 0x52, 0x75, 0x88, 0x28, 0x59, 0xc8, 0x6a, 0xd1, 0x30, 0xb2, 0x59, 0xed, 0xd7, 0xbe, 0xfa,
-	0x2b, 0xd4, 0x21, 0x23, 0x63, 0x0e, 0x1d, 0x8a, 0xfb, 0xab, 0xc3, 0xfe, 0x6d, 0x93, 0x79, 0x18,
-	0x0f, 0xcb, 0xcc, 0x0e, 0x36, 0xcc, 0xe3, 0x66, 0xef, 0x0e, 0x1b, 0xfe, 0xdf, 0xc1, 0x0b, 0xb5,
-	0xb8, 0x0f, 0xc3, 0x34, 0xfe, 0x02, 0x2b, 0x70, 0x32, 0x5d, 0x82, 0x44, 0x0c, 0x64, 0x9e, 0xf4,
-	0x97, 0xd6, 0x10, 0xe7, 0xa2, 0x61, 0xa4, 0xe2, 0xdc, 0xaf, 0x7a, 0xff, 0x22, 0x20, 0xb5, 0x9d,
-	0x89, 0x3a, 0x53, 0x2b, 0xee, 0x85, 0xda, 0xfe, 0xd5, 0x52, 0xc3, 0x41, 0x75, 0xc5, 0xa5, 0x0e,
-	0x1b, 0x4c, 0x62, 0x8d, 0x5a, 0xab, 0xd5, 0x1c, 0xea, 0xba, 0x61, 0xa3, 0xc6, 0x65, 0xbc, 0x85,
-	0x17, 0x92, 0x2d, 0x3c, 0x6a, 0xc7, 0xc5, 0x78, 0x3b, 0x7e, 0x82, 0xb3, 0x58, 0x2c, 0x05, 0xca,
-	0x72, 0x07, 0x06, 0xab, 0x96, 0xe9, 0xfa, 0x8d, 0xa8, 0xe7, 0xf4, 0x34, 0x3c, 0x45, 0xce, 0x41,
-	0xe2, 0x86, 0xb6, 0x79, 0x63, 0x05, 0x67, 0x26, 0xbe, 0x90, 0x2e, 0xc3, 0x09, 0x96, 0xf8, 0x61,
-	0x70, 0xc5, 0xa9, 0x46, 0x9d, 0x7a, 0x49, 0x77, 0xbd, 0xee, 0xe3, 0x6b, 0x03, 0x26, 0x3a, 0x3b,
-	0xef, 0xfb, 0xf4, 0x37, 0xf7, 0xe3, 0x08, 0xf4, 0xb3, 0x7c, 0xe4, 0x33, 0x01, 0x06, 0xf8, 0x9d,
-	0x82, 0x4c, 0xa5, 0xef, 0x8f, 0x9d, 0x57, 0x18, 0x71, 0x3a, 0x87, 0x25, 0x07, 0x2d, 0x9d, 0xfa,
-	0xf4, 0xc5, 0xbf, 0x5f, 0x15, 0xca, 0x64, 0x5c, 0x41, 0x17, 0xf6, 0x57, 0x49, 0xde, 0xe5, 0xc8,
-	0xb7, 0x02, 0x0c, 0xb5, 0x67, 0x9b, 0x73, 0x59, 0xe1, 0xb7, 0x5d, 0x71, 0xc4, 0x99, 0x7c, 0xc6,
-	0x08, 0x67, 0x96, 0xc1, 0x39, 0x47, 0xa6, 0x3b, 0xc0, 0x09, 0x1d, 0x94, 0x26, 0x56, 0xa6, 0x45,
-	0xbe, 0x14, 0xe0, 0x20, 0xde, 0x2a, 0x48, 0x16, 0xf1, 0xe4, 0x55, 0x45, 0x3c, 0x9b, 0xc7, 0x14,
-	0x51, 0x29, 0x0c, 0xd5, 0x34, 0x39, 0x93, 0x8e, 0x8a, 0x8f, 0xa6, 0x71, 0x4c, 0xdf, 0x0b, 0x00,
-	0xed, 0xfb, 0x01, 0xc9, 0xd2, 0x60, 0xc7, 0x9d, 0x44, 0x7c, 0x23, 0xa7, 0x35, 0x82, 0xbb, 0xc2,
-	0xc0, 0x2d, 0x90, 0xf9, 0x74, 0x70, 0x75, 0x1a, 0x4d, 0xe9, 0x6d, 0x80, 0x4a, 0x93, 0x63, 0x6e,
-	0x91, 0xdf, 0x05, 0x18, 0x4e, 0x0c, 0xc6, 0x44, 0xc9, 0x48, 0x9f, 0x36, 0xc4, 0x8b, 0xe7, 0xf3,
-	0x3b, 0x20, 0xe4, 0x0a, 0x83, 0xbc, 0x44, 0xee, 0xa5, 0x43, 0xde, 0x60, 0x4e, 0x19, 0xa8, 0x95,
-	0x66, 0xb8, 0x11, 0x5a, 0x4a, 0x93, 0x1d, 0x2b, 0x2d, 0xf2, 0x79, 0x01, 0xa4, 0x95, 0x1c, 0xd3,
-	0x51, 0xb6, 0xb8, 0xb9, 0xc7, 0x4e, 0xf1, 0xed, 0xbd, 0x07, 0x42, 0x35, 0x96, 0x98, 0x1a, 0xb7,
-	0xc9, 0xcd, 0x74, 0x35, 0xf2, 0x7d, 0xf2, 0x50, 0x9a, 0xac, 0xaf, 0xb6, 0xc8, 0x27, 0x05, 0x38,
-	0xdd, 0x3d, 0xf9, 0xa2, 0x61, 0x64, 0x4a, 0xd1, 0xcb, 0x04, 0x9e, 0x29, 0x45, 0x4f, 0xc3, 0xb4,
-	0x74, 0x93, 0x49, 0x71, 0x8d, 0x5c, 0xd9, 0x8b, 0x14, 0xe4, 0x85, 0x00, 0xa3, 0xe9, 0x33, 0x11,
-	0xb9, 0xdc, 0xe5, 0xb7, 0x95, 0x35, 0x11, 0x8a, 0x57, 0x76, 0xe7, 0x8c, 0xdc, 0xae, 0x31, 0x6e,
-	0x97, 0xc8, 0x42, 0xf6, 0xd1, 0xb6, 0x9d, 0x5d, 0x54, 0xd8, 0x3f, 0x04, 0x38, 0x9e, 0x9e, 0x22,
-	0x28, 0xe6, 0xe5, 0xec, 0x1a, 0xec, 0x9e, 0x58, 0xd7, 0xa9, 0x55, 0x5a, 0x60, 0xc4, 0xce, 0x13,
-	0xb9, 0x37, 0x62, 0xe4, 0x67, 0x01, 0x86, 0x13, 0xc3, 0x0d, 0x99, 0xcb, 0x16, 0x38, 0x6d, 0x6c,
-	0x13, 0x2f, 0xf4, 0xe4, 0x83, 0x90, 0xe7, 0x19, 0x64, 0x99, 0xcc, 0xa4, 0x43, 0x4e, 0x7e, 0xab,
-	0x8c, 0x2a, 0xf0, 0x83, 0x00, 0x47, 0x12, 0xf1, 0x02, 0xe1, 0xe7, 0xb2, 0xb5, 0xeb, 0x19, 0x73,
-	0xa7, 0xa9, 0x51, 0x9a, 0x61, 0x98, 0x27, 0xc9, 0xa9, 0x3c, 0x98, 0xc9, 0x77, 0x02, 0x0c, 0x45,
-	0x23, 0x56, 0x66, 0xc7, 0xde, 0x3e, 0xeb, 0x65, 0x76, 0xec, 0x1d, 0x53, 0x5b, 0xb7, 0xf6, 0xe3,
-	0xbb, 0xd4, 0xe1, 0x1f, 0x5d, 0x95, 0x26, 0x8e, 0x8c, 0xad, 0x58, 0xa3, 0xfc, 0x55, 0x80, 0x91,
-	0x94, 0x99, 0x8a, 0x5c, 0xcc, 0xc0, 0xd0, 0x79, 0x80, 0x13, 0x17, 0x7a, 0x75, 0x43, 0x12, 0x57,
-	0x19, 0x89, 0x37, 0xc9, 0xc5, 0x74, 0x12, 0x2e, 0x73, 0x6d, 0x7f, 0xf4, 0x51, 0x0d, 0xdd, 0xf5,
-	0xda, 0x2c, 0xae, 0x2f, 0x3e, 0x7b, 0x59, 0x16, 0x9e, 0xbf, 0x2c, 0x0b, 0xff, 0xbc, 0x2c, 0x0b,
-	0x5f, 0xbc, 0x2a, 0xf7, 0x3d, 0x7f, 0x55, 0xee, 0xfb, 0xf3, 0x55, 0xb9, 0xef, 0x83, 0x33, 0x75,
-	0xdd, 0x5b, 0xf7, 0x57, 0xe5, 0xaa, 0xd5, 0x48, 0x86, 0xde, 0x8c, 0x82, 0x7b, 0x5b, 0x36, 0x75,
-	0x57, 0x07, 0xd8, 0x27, 0xe9, 0x0b, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0x0f, 0xfa, 0xda, 0xd9,
-	0xd1, 0x17, 0x00, 0x00,
+func (m *QueryPairingScoresRequest) Reset()         { *m = QueryPairingScoresRequest{} }
+func (m *QueryPairingScoresRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPairingScoresRequest) ProtoMessage()    {}
+func (*QueryPairingScoresRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6bd8a3cd41a2a1ee, []int{27}
+}
+func (m *QueryPairingScoresRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPairingScoresRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPairingScoresRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPairingScoresRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPairingScoresRequest.Merge(m, src)
+}
+func (m *QueryPairingScoresRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPairingScoresRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPairingScoresRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryPairingScoresRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Queries a list of Providers items.
-	Providers(ctx context.Context, in *QueryProvidersRequest, opts ...grpc.CallOption) (*QueryProvidersResponse, error)
-	// Queries a list of Clients items.
-	Clients(ctx context.Context, in *QueryClientsRequest, opts ...grpc.CallOption) (*QueryClientsResponse, error)
-	// Queries a list of GetPairing items.
-	GetPairing(ctx context.Context, in *QueryGetPairingRequest, opts ...grpc.CallOption) (*QueryGetPairingResponse, error)
-	// Queries a list of VerifyPairing items.
-	VerifyPairing(ctx context.Context, in *QueryVerifyPairingRequest, opts ...grpc.CallOption) (*QueryVerifyPairingResponse, error)
-	// Queries a UniquePaymentStorageClientProvider by index.
-	UniquePaymentStorageClientProvider(ctx context.Context, in *QueryGetUniquePaymentStorageClientProviderRequest, opts ...grpc.CallOption) (*QueryGetUniquePaymentStorageClientProviderResponse, error)
-	// Queries a list of UniquePaymentStorageClientProvider items.
-	UniquePaymentStorageClientProviderAll(ctx context.Context, in *QueryAllUniquePaymentStorageClientProviderRequest, opts ...grpc.CallOption) (*QueryAllUniquePaymentStorageClientProviderResponse, error)
-	// Queries a ProviderPaymentStorage by index.
-	ProviderPaymentStorage(ctx context.Context, in *QueryGetProviderPaymentStorageRequest, opts ...grpc.CallOption) (*QueryGetProviderPaymentStorageResponse, error)
-	// Queries a list of ProviderPaymentStorage items.
-	ProviderPaymentStorageAll(ctx context.Context, in *QueryAllProviderPaymentStorageRequest, opts ...grpc.CallOption) (*QueryAllProviderPaymentStorageResponse, error)
-	// Queries a EpochPayments by index.
-	EpochPayments(ctx context.Context, in *QueryGetEpochPaymentsRequest, opts ...grpc.CallOption) (*QueryGetEpochPaymentsResponse, error)
-	// Queries a list of EpochPayments items.
-	EpochPaymentsAll(ctx context.Context, in *QueryAllEpochPaymentsRequest, opts ...grpc.CallOption) (*QueryAllEpochPaymentsResponse, error)
-	// Queries a UserEntry items.
-	UserEntry(ctx context.Context, in *QueryUserEntryRequest, opts ...grpc.CallOption) (*QueryUserEntryResponse, error)
-	// Queries a list of StaticProvidersList items.
-	StaticProvidersList(ctx context.Context, in *QueryStaticProvidersListRequest, opts ...grpc.CallOption) (*QueryStaticProvidersListResponse, error)
+func (m *QueryPairingScoresRequest) GetChainID() string {
+	if m != nil {
+		return m.ChainID
+	}
+	return ""
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryPairingScoresRequest) GetGeolocation() uint64 {
+	if m != nil {
+		return m.Geolocation
+	}
+	return 0
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+type QueryPairingScoresResponse struct {
+	Scores []ProviderPairingScore `protobuf:"bytes,1,rep,name=scores,proto3" json:"scores"`
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryPairingScoresResponse) Reset()         { *m = QueryPairingScoresResponse{} }
+func (m *QueryPairingScoresResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPairingScoresResponse) ProtoMessage()    {}
+func (*QueryPairingScoresResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6bd8a3cd41a2a1ee, []int{28}
+}
+func (m *QueryPairingScoresResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPairingScoresResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPairingScoresResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryPairingScoresResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPairingScoresResponse.Merge(m, src)
+}
+func (m *QueryPairingScoresResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPairingScoresResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPairingScoresResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) Providers(ctx context.Context, in *QueryProvidersRequest, opts ...grpc.CallOption) (*QueryProvidersResponse, error) {
-	out := new(QueryProvidersResponse)
-	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.Query/Providers", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryPairingScoresResponse proto.InternalMessageInfo
+
+func (m *QueryPairingScoresResponse) GetScores() []ProviderPairingScore {
+	if m != nil {
+		return m.Scores
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) Clients(ctx context.Context, in *QueryClientsRequest, opts ...grpc.CallOption) (*QueryClientsResponse, error) {
-	out := new(QueryClientsResponse)
-	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.Query/Clients", in, out, opts...)
-	if err != nil {
-		return nil, err
+// the weight of a provider when pairing a consumer, providers outside the consumer's geolocation get the GeolocationFallbackScore param of their stake
+type ProviderPairingScore struct {
+	Provider         types.StakeEntry                       `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider"`
+	GeolocationMatch bool                                   `protobuf:"varint,2,opt,name=geolocationMatch,proto3" json:"geolocationMatch,omitempty"`
+	GeolocationScore github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=geolocationScore,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"geolocationScore"`
+	Score            github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=score,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"score"`
+}
+
+func (m *ProviderPairingScore) Reset()         { *m = ProviderPairingScore{} }
+func (m *ProviderPairingScore) String() string { return proto.CompactTextString(m) }
+func (*ProviderPairingScore) ProtoMessage()    {}
+func (*ProviderPairingScore) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6bd8a3cd41a2a1ee, []int{29}
+}
+func (m *ProviderPairingScore) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProviderPairingScore) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProviderPairingScore.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *ProviderPairingScore) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProviderPairingScore.Merge(m, src)
+}
+func (m *ProviderPairingScore) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProviderPairingScore) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProviderPairingScore.DiscardUnknown(m)
 }
 
-func (c *queryClient) GetPairing(ctx context.Context, in *QueryGetPairingRequest, opts ...grpc.CallOption) (*QueryGetPairingResponse, error) {
-	out := new(QueryGetPairingResponse)
-	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.Query/GetPairing", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ProviderPairingScore proto.InternalMessageInfo
+
+func (m *ProviderPairingScore) GetProvider() types.StakeEntry {
+	if m != nil {
+		return m.Provider
 	}
-	return out, nil
+	return types.StakeEntry{}
 }
 
-func (c *queryClient) VerifyPairing(ctx context.Context, in *QueryVerifyPairingRequest, opts ...grpc.CallOption) (*QueryVerifyPairingResponse, error) {
-	out := new(QueryVerifyPairingResponse)
-	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.Query/VerifyPairing", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ProviderPairingScore) GetGeolocationMatch() bool {
+	if m != nil {
+		return m.GeolocationMatch
 	}
-	return out, nil
+	return false
 }
 
-func (c *queryClient) UniquePaymentStorageClientProvider(ctx context.Context, in *QueryGetUniquePaymentStorageClientProviderRequest, opts ...grpc.CallOption) (*QueryGetUniquePaymentStorageClientProviderResponse, error) {
-	out := new(QueryGetUniquePaymentStorageClientProviderResponse)
-	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.Query/UniquePaymentStorageClientProvider", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryUnresponsiveReportsRequest struct {
+	ChainID  string `protobuf:"bytes,1,opt,name=chainID,proto3" json:"chainID,omitempty"`
+	Provider string `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
 }
 
-func (c *queryClient) UniquePaymentStorageClientProviderAll(ctx context.Context, in *QueryAllUniquePaymentStorageClientProviderRequest, opts ...grpc.CallOption) (*QueryAllUniquePaymentStorageClientProviderResponse, error) {
-	out := new(QueryAllUniquePaymentStorageClientProviderResponse)
-	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.Query/UniquePaymentStorageClientProviderAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryUnresponsiveReportsRequest) Reset()         { *m = QueryUnresponsiveReportsRequest{} }
+func (m *QueryUnresponsiveReportsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryUnresponsiveReportsRequest) ProtoMessage()    {}
+func (*QueryUnresponsiveReportsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6bd8a3cd41a2a1ee, []int{30}
+}
+func (m *QueryUnresponsiveReportsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUnresponsiveReportsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUnresponsiveReportsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryUnresponsiveReportsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUnresponsiveReportsRequest.Merge(m, src)
+}
+func (m *QueryUnresponsiveReportsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUnresponsiveReportsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUnresponsiveReportsRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) ProviderPaymentStorage(ctx context.Context, in *QueryGetProviderPaymentStorageRequest, opts ...grpc.CallOption) (*QueryGetProviderPaymentStorageResponse, error) {
-	out := new(QueryGetProviderPaymentStorageResponse)
-	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.Query/ProviderPaymentStorage", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryUnresponsiveReportsRequest proto.InternalMessageInfo
+
+func (m *QueryUnresponsiveReportsRequest) GetChainID() string {
+	if m != nil {
+		return m.ChainID
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) ProviderPaymentStorageAll(ctx context.Context, in *QueryAllProviderPaymentStorageRequest, opts ...grpc.CallOption) (*QueryAllProviderPaymentStorageResponse, error) {
-	out := new(QueryAllProviderPaymentStorageResponse)
-	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.Query/ProviderPaymentStorageAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryUnresponsiveReportsRequest) GetProvider() string {
+	if m != nil {
+		return m.Provider
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) EpochPayments(ctx context.Context, in *QueryGetEpochPaymentsRequest, opts ...grpc.CallOption) (*QueryGetEpochPaymentsResponse, error) {
-	out := new(QueryGetEpochPaymentsResponse)
-	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.Query/EpochPayments", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryUnresponsiveReportsResponse struct {
+	Reports      []UnresponsiveReportCount `protobuf:"bytes,1,rep,name=reports,proto3" json:"reports"`
+	JailEndBlock uint64                    `protobuf:"varint,2,opt,name=jailEndBlock,proto3" json:"jailEndBlock,omitempty"`
 }
 
-func (c *queryClient) EpochPaymentsAll(ctx context.Context, in *QueryAllEpochPaymentsRequest, opts ...grpc.CallOption) (*QueryAllEpochPaymentsResponse, error) {
-	out := new(QueryAllEpochPaymentsResponse)
-	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.Query/EpochPaymentsAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryUnresponsiveReportsResponse) Reset()         { *m = QueryUnresponsiveReportsResponse{} }
+func (m *QueryUnresponsiveReportsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryUnresponsiveReportsResponse) ProtoMessage()    {}
+func (*QueryUnresponsiveReportsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6bd8a3cd41a2a1ee, []int{31}
+}
+func (m *QueryUnresponsiveReportsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUnresponsiveReportsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUnresponsiveReportsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryUnresponsiveReportsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUnresponsiveReportsResponse.Merge(m, src)
+}
+func (m *QueryUnresponsiveReportsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUnresponsiveReportsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUnresponsiveReportsResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) UserEntry(ctx context.Context, in *QueryUserEntryRequest, opts ...grpc.CallOption) (*QueryUserEntryResponse, error) {
-	out := new(QueryUserEntryResponse)
-	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.Query/UserEntry", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryUnresponsiveReportsResponse proto.InternalMessageInfo
+
+func (m *QueryUnresponsiveReportsResponse) GetReports() []UnresponsiveReportCount {
+	if m != nil {
+		return m.Reports
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) StaticProvidersList(ctx context.Context, in *QueryStaticProvidersListRequest, opts ...grpc.CallOption) (*QueryStaticProvidersListResponse, error) {
-	out := new(QueryStaticProvidersListResponse)
-	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.Query/StaticProvidersList", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryUnresponsiveReportsResponse) GetJailEndBlock() uint64 {
+	if m != nil {
+		return m.JailEndBlock
 	}
-	return out, nil
+	return 0
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Queries a list of Providers items.
-	Providers(context.Context, *QueryProvidersRequest) (*QueryProvidersResponse, error)
-	// Queries a list of Clients items.
-	Clients(context.Context, *QueryClientsRequest) (*QueryClientsResponse, error)
-	// Queries a list of GetPairing items.
-	GetPairing(context.Context, *QueryGetPairingRequest) (*QueryGetPairingResponse, error)
-	// Queries a list of VerifyPairing items.
-	VerifyPairing(context.Context, *QueryVerifyPairingRequest) (*QueryVerifyPairingResponse, error)
-	// Queries a UniquePaymentStorageClientProvider by index.
-	UniquePaymentStorageClientProvider(context.Context, *QueryGetUniquePaymentStorageClientProviderRequest) (*QueryGetUniquePaymentStorageClientProviderResponse, error)
-	// Queries a list of UniquePaymentStorageClientProvider items.
-	UniquePaymentStorageClientProviderAll(context.Context, *QueryAllUniquePaymentStorageClientProviderRequest) (*QueryAllUniquePaymentStorageClientProviderResponse, error)
-	// Queries a ProviderPaymentStorage by index.
-	ProviderPaymentStorage(context.Context, *QueryGetProviderPaymentStorageRequest) (*QueryGetProviderPaymentStorageResponse, error)
-	// Queries a list of ProviderPaymentStorage items.
-	ProviderPaymentStorageAll(context.Context, *QueryAllProviderPaymentStorageRequest) (*QueryAllProviderPaymentStorageResponse, error)
-	// Queries a EpochPayments by index.
-	EpochPayments(context.Context, *QueryGetEpochPaymentsRequest) (*QueryGetEpochPaymentsResponse, error)
-	// Queries a list of EpochPayments items.
-	EpochPaymentsAll(context.Context, *QueryAllEpochPaymentsRequest) (*QueryAllEpochPaymentsResponse, error)
-	// Queries a UserEntry items.
-	UserEntry(context.Context, *QueryUserEntryRequest) (*QueryUserEntryResponse, error)
-	// Queries a list of StaticProvidersList items.
-	StaticProvidersList(context.Context, *QueryStaticProvidersListRequest) (*QueryStaticProvidersListResponse, error)
+// the number of distinct consumers that reported a provider as unresponsive in an epoch
+type UnresponsiveReportCount struct {
+	Epoch     uint64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Reporters uint64 `protobuf:"varint,2,opt,name=reporters,proto3" json:"reporters,omitempty"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *UnresponsiveReportCount) Reset()         { *m = UnresponsiveReportCount{} }
+func (m *UnresponsiveReportCount) String() string { return proto.CompactTextString(m) }
+func (*UnresponsiveReportCount) ProtoMessage()    {}
+func (*UnresponsiveReportCount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6bd8a3cd41a2a1ee, []int{32}
 }
-
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *UnresponsiveReportCount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) Providers(ctx context.Context, req *QueryProvidersRequest) (*QueryProvidersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Providers not implemented")
+func (m *UnresponsiveReportCount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnresponsiveReportCount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) Clients(ctx context.Context, req *QueryClientsRequest) (*QueryClientsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Clients not implemented")
+func (m *UnresponsiveReportCount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnresponsiveReportCount.Merge(m, src)
 }
-func (*UnimplementedQueryServer) GetPairing(ctx context.Context, req *QueryGetPairingRequest) (*QueryGetPairingResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPairing not implemented")
+func (m *UnresponsiveReportCount) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) VerifyPairing(ctx context.Context, req *QueryVerifyPairingRequest) (*QueryVerifyPairingResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method VerifyPairing not implemented")
+func (m *UnresponsiveReportCount) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnresponsiveReportCount.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) UniquePaymentStorageClientProvider(ctx context.Context, req *QueryGetUniquePaymentStorageClientProviderRequest) (*QueryGetUniquePaymentStorageClientProviderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UniquePaymentStorageClientProvider not implemented")
+
+var xxx_messageInfo_UnresponsiveReportCount proto.InternalMessageInfo
+
+func (m *UnresponsiveReportCount) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
 }
-func (*UnimplementedQueryServer) UniquePaymentStorageClientProviderAll(ctx context.Context, req *QueryAllUniquePaymentStorageClientProviderRequest) (*QueryAllUniquePaymentStorageClientProviderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UniquePaymentStorageClientProviderAll not implemented")
+
+func (m *UnresponsiveReportCount) GetReporters() uint64 {
+	if m != nil {
+		return m.Reporters
+	}
+	return 0
 }
-func (*UnimplementedQueryServer) ProviderPaymentStorage(ctx context.Context, req *QueryGetProviderPaymentStorageRequest) (*QueryGetProviderPaymentStorageResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ProviderPaymentStorage not implemented")
+
+type QuerySimulatePairingRequest struct {
+	ChainID     string `protobuf:"bytes,1,opt,name=chainID,proto3" json:"chainID,omitempty"`
+	Client      string `protobuf:"bytes,2,opt,name=client,proto3" json:"client,omitempty"`
+	EpochOffset uint64 `protobuf:"varint,3,opt,name=epochOffset,proto3" json:"epochOffset,omitempty"`
 }
-func (*UnimplementedQueryServer) ProviderPaymentStorageAll(ctx context.Context, req *QueryAllProviderPaymentStorageRequest) (*QueryAllProviderPaymentStorageResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ProviderPaymentStorageAll not implemented")
+
+func (m *QuerySimulatePairingRequest) Reset()         { *m = QuerySimulatePairingRequest{} }
+func (m *QuerySimulatePairingRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulatePairingRequest) ProtoMessage()    {}
+func (*QuerySimulatePairingRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6bd8a3cd41a2a1ee, []int{33}
 }
-func (*UnimplementedQueryServer) EpochPayments(ctx context.Context, req *QueryGetEpochPaymentsRequest) (*QueryGetEpochPaymentsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EpochPayments not implemented")
+func (m *QuerySimulatePairingRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) EpochPaymentsAll(ctx context.Context, req *QueryAllEpochPaymentsRequest) (*QueryAllEpochPaymentsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EpochPaymentsAll not implemented")
+func (m *QuerySimulatePairingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulatePairingRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) UserEntry(ctx context.Context, req *QueryUserEntryRequest) (*QueryUserEntryResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UserEntry not implemented")
+func (m *QuerySimulatePairingRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulatePairingRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) StaticProvidersList(ctx context.Context, req *QueryStaticProvidersListRequest) (*QueryStaticProvidersListResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method StaticProvidersList not implemented")
+func (m *QuerySimulatePairingRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QuerySimulatePairingRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulatePairingRequest.DiscardUnknown(m)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lavanet.lava.pairing.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+var xxx_messageInfo_QuerySimulatePairingRequest proto.InternalMessageInfo
+
+func (m *QuerySimulatePairingRequest) GetChainID() string {
+	if m != nil {
+		return m.ChainID
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_Providers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryProvidersRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Providers(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lavanet.lava.pairing.Query/Providers",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Providers(ctx, req.(*QueryProvidersRequest))
+func (m *QuerySimulatePairingRequest) GetClient() string {
+	if m != nil {
+		return m.Client
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_Clients_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryClientsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Clients(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lavanet.lava.pairing.Query/Clients",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Clients(ctx, req.(*QueryClientsRequest))
+func (m *QuerySimulatePairingRequest) GetEpochOffset() uint64 {
+	if m != nil {
+		return m.EpochOffset
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_GetPairing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetPairingRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetPairing(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lavanet.lava.pairing.Query/GetPairing",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetPairing(ctx, req.(*QueryGetPairingRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QuerySimulatePairingResponse struct {
+	Providers []types.StakeEntry `protobuf:"bytes,1,rep,name=providers,proto3" json:"providers"`
+	Epoch     uint64             `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Exact     bool               `protobuf:"varint,3,opt,name=exact,proto3" json:"exact,omitempty"`
 }
 
-func _Query_VerifyPairing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryVerifyPairingRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).VerifyPairing(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lavanet.lava.pairing.Query/VerifyPairing",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).VerifyPairing(ctx, req.(*QueryVerifyPairingRequest))
+func (m *QuerySimulatePairingResponse) Reset()         { *m = QuerySimulatePairingResponse{} }
+func (m *QuerySimulatePairingResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulatePairingResponse) ProtoMessage()    {}
+func (*QuerySimulatePairingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6bd8a3cd41a2a1ee, []int{34}
+}
+func (m *QuerySimulatePairingResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulatePairingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulatePairingResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QuerySimulatePairingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulatePairingResponse.Merge(m, src)
+}
+func (m *QuerySimulatePairingResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulatePairingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulatePairingResponse.DiscardUnknown(m)
 }
 
-func _Query_UniquePaymentStorageClientProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetUniquePaymentStorageClientProviderRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).UniquePaymentStorageClientProvider(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lavanet.lava.pairing.Query/UniquePaymentStorageClientProvider",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).UniquePaymentStorageClientProvider(ctx, req.(*QueryGetUniquePaymentStorageClientProviderRequest))
+var xxx_messageInfo_QuerySimulatePairingResponse proto.InternalMessageInfo
+
+func (m *QuerySimulatePairingResponse) GetProviders() []types.StakeEntry {
+	if m != nil {
+		return m.Providers
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_UniquePaymentStorageClientProviderAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllUniquePaymentStorageClientProviderRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).UniquePaymentStorageClientProviderAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lavanet.lava.pairing.Query/UniquePaymentStorageClientProviderAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).UniquePaymentStorageClientProviderAll(ctx, req.(*QueryAllUniquePaymentStorageClientProviderRequest))
+func (m *QuerySimulatePairingResponse) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_ProviderPaymentStorage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetProviderPaymentStorageRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ProviderPaymentStorage(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lavanet.lava.pairing.Query/ProviderPaymentStorage",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ProviderPaymentStorage(ctx, req.(*QueryGetProviderPaymentStorageRequest))
+func (m *QuerySimulatePairingResponse) GetExact() bool {
+	if m != nil {
+		return m.Exact
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-func _Query_ProviderPaymentStorageAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllProviderPaymentStorageRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ProviderPaymentStorageAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lavanet.lava.pairing.Query/ProviderPaymentStorageAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ProviderPaymentStorageAll(ctx, req.(*QueryAllProviderPaymentStorageRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryGetProviderMetadataRequest struct {
+	Provider string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
 }
 
-func _Query_EpochPayments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetEpochPaymentsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).EpochPayments(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lavanet.lava.pairing.Query/EpochPayments",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).EpochPayments(ctx, req.(*QueryGetEpochPaymentsRequest))
+func (m *QueryGetProviderMetadataRequest) Reset()         { *m = QueryGetProviderMetadataRequest{} }
+func (m *QueryGetProviderMetadataRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetProviderMetadataRequest) ProtoMessage()    {}
+func (*QueryGetProviderMetadataRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6bd8a3cd41a2a1ee, []int{35}
+}
+func (m *QueryGetProviderMetadataRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetProviderMetadataRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetProviderMetadataRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryGetProviderMetadataRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetProviderMetadataRequest.Merge(m, src)
+}
+func (m *QueryGetProviderMetadataRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetProviderMetadataRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetProviderMetadataRequest.DiscardUnknown(m)
 }
 
-func _Query_EpochPaymentsAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllEpochPaymentsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).EpochPaymentsAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lavanet.lava.pairing.Query/EpochPaymentsAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).EpochPaymentsAll(ctx, req.(*QueryAllEpochPaymentsRequest))
+var xxx_messageInfo_QueryGetProviderMetadataRequest proto.InternalMessageInfo
+
+func (m *QueryGetProviderMetadataRequest) GetProvider() string {
+	if m != nil {
+		return m.Provider
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_UserEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryUserEntryRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+type QueryGetProviderMetadataResponse struct {
+	ProviderMetadata ProviderMetadata `protobuf:"bytes,1,opt,name=providerMetadata,proto3" json:"providerMetadata"`
+}
+
+func (m *QueryGetProviderMetadataResponse) Reset()         { *m = QueryGetProviderMetadataResponse{} }
+func (m *QueryGetProviderMetadataResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetProviderMetadataResponse) ProtoMessage()    {}
+func (*QueryGetProviderMetadataResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6bd8a3cd41a2a1ee, []int{36}
+}
+func (m *QueryGetProviderMetadataResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetProviderMetadataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetProviderMetadataResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).UserEntry(ctx, in)
+}
+func (m *QueryGetProviderMetadataResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetProviderMetadataResponse.Merge(m, src)
+}
+func (m *QueryGetProviderMetadataResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetProviderMetadataResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetProviderMetadataResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetProviderMetadataResponse proto.InternalMessageInfo
+
+func (m *QueryGetProviderMetadataResponse) GetProviderMetadata() ProviderMetadata {
+	if m != nil {
+		return m.ProviderMetadata
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lavanet.lava.pairing.Query/UserEntry",
+	return ProviderMetadata{}
+}
+
+type QueryAllProviderMetadataRequest struct {
+	Addon      string             `protobuf:"bytes,1,opt,name=addon,proto3" json:"addon,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllProviderMetadataRequest) Reset()         { *m = QueryAllProviderMetadataRequest{} }
+func (m *QueryAllProviderMetadataRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllProviderMetadataRequest) ProtoMessage()    {}
+func (*QueryAllProviderMetadataRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6bd8a3cd41a2a1ee, []int{37}
+}
+func (m *QueryAllProviderMetadataRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllProviderMetadataRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllProviderMetadataRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).UserEntry(ctx, req.(*QueryUserEntryRequest))
+}
+func (m *QueryAllProviderMetadataRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllProviderMetadataRequest.Merge(m, src)
+}
+func (m *QueryAllProviderMetadataRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllProviderMetadataRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllProviderMetadataRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllProviderMetadataRequest proto.InternalMessageInfo
+
+func (m *QueryAllProviderMetadataRequest) GetAddon() string {
+	if m != nil {
+		return m.Addon
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_StaticProvidersList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryStaticProvidersListRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *QueryAllProviderMetadataRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).StaticProvidersList(ctx, in)
+	return nil
+}
+
+type QueryAllProviderMetadataResponse struct {
+	ProviderMetadata []ProviderMetadata  `protobuf:"bytes,1,rep,name=providerMetadata,proto3" json:"providerMetadata"`
+	Pagination       *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllProviderMetadataResponse) Reset()         { *m = QueryAllProviderMetadataResponse{} }
+func (m *QueryAllProviderMetadataResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllProviderMetadataResponse) ProtoMessage()    {}
+func (*QueryAllProviderMetadataResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6bd8a3cd41a2a1ee, []int{38}
+}
+func (m *QueryAllProviderMetadataResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllProviderMetadataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllProviderMetadataResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lavanet.lava.pairing.Query/StaticProvidersList",
+}
+func (m *QueryAllProviderMetadataResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllProviderMetadataResponse.Merge(m, src)
+}
+func (m *QueryAllProviderMetadataResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllProviderMetadataResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllProviderMetadataResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllProviderMetadataResponse proto.InternalMessageInfo
+
+func (m *QueryAllProviderMetadataResponse) GetProviderMetadata() []ProviderMetadata {
+	if m != nil {
+		return m.ProviderMetadata
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).StaticProvidersList(ctx, req.(*QueryStaticProvidersListRequest))
+	return nil
+}
+
+func (m *QueryAllProviderMetadataResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "lavanet.lava.pairing.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "Providers",
-			Handler:    _Query_Providers_Handler,
-		},
-		{
-			MethodName: "Clients",
-			Handler:    _Query_Clients_Handler,
-		},
-		{
-			MethodName: "GetPairing",
-			Handler:    _Query_GetPairing_Handler,
-		},
-		{
-			MethodName: "VerifyPairing",
-			Handler:    _Query_VerifyPairing_Handler,
-		},
-		{
-			MethodName: "UniquePaymentStorageClientProvider",
-			Handler:    _Query_UniquePaymentStorageClientProvider_Handler,
-		},
-		{
-			MethodName: "UniquePaymentStorageClientProviderAll",
-			Handler:    _Query_UniquePaymentStorageClientProviderAll_Handler,
-		},
-		{
-			MethodName: "ProviderPaymentStorage",
-			Handler:    _Query_ProviderPaymentStorage_Handler,
-		},
-		{
-			MethodName: "ProviderPaymentStorageAll",
-			Handler:    _Query_ProviderPaymentStorageAll_Handler,
-		},
-		{
-			MethodName: "EpochPayments",
-			Handler:    _Query_EpochPayments_Handler,
-		},
-		{
-			MethodName: "EpochPaymentsAll",
-			Handler:    _Query_EpochPaymentsAll_Handler,
-		},
-		{
-			MethodName: "UserEntry",
-			Handler:    _Query_UserEntry_Handler,
-		},
-		{
-			MethodName: "StaticProvidersList",
-			Handler:    _Query_StaticProvidersList_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "pairing/query.proto",
+type QueryProvidersCapacityRequest struct {
+	ChainID string `protobuf:"bytes,1,opt,name=chainID,proto3" json:"chainID,omitempty"`
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryProvidersCapacityRequest) Reset()         { *m = QueryProvidersCapacityRequest{} }
+func (m *QueryProvidersCapacityRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProvidersCapacityRequest) ProtoMessage()    {}
+func (*QueryProvidersCapacityRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6bd8a3cd41a2a1ee, []int{39}
+}
+func (m *QueryProvidersCapacityRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProvidersCapacityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProvidersCapacityRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryProvidersCapacityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProvidersCapacityRequest.Merge(m, src)
 }
-
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryProvidersCapacityRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProvidersCapacityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProvidersCapacityRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryProvidersCapacityRequest proto.InternalMessageInfo
+
+func (m *QueryProvidersCapacityRequest) GetChainID() string {
+	if m != nil {
+		return m.ChainID
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// the capacities count only the providers that declared one, the used cu is the cu paid so far for relays of the current epoch
+type QueryProvidersCapacityResponse struct {
+	Epoch                    uint64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	TotalCapacity            uint64 `protobuf:"varint,2,opt,name=totalCapacity,proto3" json:"totalCapacity,omitempty"`
+	UsedCu                   uint64 `protobuf:"varint,3,opt,name=usedCu,proto3" json:"usedCu,omitempty"`
+	RemainingCapacity        uint64 `protobuf:"varint,4,opt,name=remainingCapacity,proto3" json:"remainingCapacity,omitempty"`
+	ProvidersWithCapacity    uint64 `protobuf:"varint,5,opt,name=providersWithCapacity,proto3" json:"providersWithCapacity,omitempty"`
+	ProvidersWithoutCapacity uint64 `protobuf:"varint,6,opt,name=providersWithoutCapacity,proto3" json:"providersWithoutCapacity,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryProvidersCapacityResponse) Reset()         { *m = QueryProvidersCapacityResponse{} }
+func (m *QueryProvidersCapacityResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProvidersCapacityResponse) ProtoMessage()    {}
+func (*QueryProvidersCapacityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6bd8a3cd41a2a1ee, []int{40}
+}
+func (m *QueryProvidersCapacityResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProvidersCapacityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProvidersCapacityResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryProvidersCapacityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProvidersCapacityResponse.Merge(m, src)
+}
+func (m *QueryProvidersCapacityResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProvidersCapacityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProvidersCapacityResponse.DiscardUnknown(m)
 }
 
-func (m *QueryProvidersRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryProvidersCapacityResponse proto.InternalMessageInfo
+
+func (m *QueryProvidersCapacityResponse) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryProvidersRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryProvidersCapacityResponse) GetTotalCapacity() uint64 {
+	if m != nil {
+		return m.TotalCapacity
+	}
+	return 0
 }
 
-func (m *QueryProvidersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ShowFrozen {
-		i--
-		if m.ShowFrozen {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.ChainID) > 0 {
-		i -= len(m.ChainID)
-		copy(dAtA[i:], m.ChainID)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainID)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryProvidersCapacityResponse) GetUsedCu() uint64 {
+	if m != nil {
+		return m.UsedCu
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryProvidersResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryProvidersCapacityResponse) GetRemainingCapacity() uint64 {
+	if m != nil {
+		return m.RemainingCapacity
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryProvidersResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryProvidersCapacityResponse) GetProvidersWithCapacity() uint64 {
+	if m != nil {
+		return m.ProvidersWithCapacity
+	}
+	return 0
 }
 
-func (m *QueryProvidersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Output) > 0 {
-		i -= len(m.Output)
-		copy(dAtA[i:], m.Output)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Output)))
-		i--
-		dAtA[i] = 0x12
+func (m *QueryProvidersCapacityResponse) GetProvidersWithoutCapacity() uint64 {
+	if m != nil {
+		return m.ProvidersWithoutCapacity
 	}
-	if len(m.StakeEntry) > 0 {
-		for iNdEx := len(m.StakeEntry) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.StakeEntry[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	return 0
+}
+
+type QueryFrozenProvidersRequest struct {
+	ChainID string `protobuf:"bytes,1,opt,name=chainID,proto3" json:"chainID,omitempty"`
+}
+
+func (m *QueryFrozenProvidersRequest) Reset()         { *m = QueryFrozenProvidersRequest{} }
+func (m *QueryFrozenProvidersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFrozenProvidersRequest) ProtoMessage()    {}
+func (*QueryFrozenProvidersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6bd8a3cd41a2a1ee, []int{41}
+}
+func (m *QueryFrozenProvidersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFrozenProvidersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFrozenProvidersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryFrozenProvidersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFrozenProvidersRequest.Merge(m, src)
+}
+func (m *QueryFrozenProvidersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFrozenProvidersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFrozenProvidersRequest.DiscardUnknown(m)
 }
 
-func (m *QueryClientsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryFrozenProvidersRequest proto.InternalMessageInfo
+
+func (m *QueryFrozenProvidersRequest) GetChainID() string {
+	if m != nil {
+		return m.ChainID
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryClientsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// providers jailed for unresponsiveness are listed with the jail as their reason
+type QueryFrozenProvidersResponse struct {
+	FrozenProviders []ProviderFreeze `protobuf:"bytes,1,rep,name=frozenProviders,proto3" json:"frozenProviders"`
 }
 
-func (m *QueryClientsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ChainID) > 0 {
-		i -= len(m.ChainID)
-		copy(dAtA[i:], m.ChainID)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainID)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *QueryFrozenProvidersResponse) Reset()         { *m = QueryFrozenProvidersResponse{} }
+func (m *QueryFrozenProvidersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFrozenProvidersResponse) ProtoMessage()    {}
+func (*QueryFrozenProvidersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6bd8a3cd41a2a1ee, []int{42}
 }
-
-func (m *QueryClientsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryFrozenProvidersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFrozenProvidersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFrozenProvidersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryClientsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryFrozenProvidersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFrozenProvidersResponse.Merge(m, src)
+}
+func (m *QueryFrozenProvidersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFrozenProvidersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFrozenProvidersResponse.DiscardUnknown(m)
 }
 
-func (m *QueryClientsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Output) > 0 {
-		i -= len(m.Output)
-		copy(dAtA[i:], m.Output)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Output)))
-		i--
-		dAtA[i] = 0x12
+var xxx_messageInfo_QueryFrozenProvidersResponse proto.InternalMessageInfo
+
+func (m *QueryFrozenProvidersResponse) GetFrozenProviders() []ProviderFreeze {
+	if m != nil {
+		return m.FrozenProviders
 	}
-	if len(m.StakeEntry) > 0 {
-		for iNdEx := len(m.StakeEntry) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.StakeEntry[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	return nil
+}
+
+type QueryProviderPerformanceRequest struct {
+	ChainID   string `protobuf:"bytes,1,opt,name=chainID,proto3" json:"chainID,omitempty"`
+	Provider  string `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+	FromEpoch uint64 `protobuf:"varint,3,opt,name=fromEpoch,proto3" json:"fromEpoch,omitempty"`
+	ToEpoch   uint64 `protobuf:"varint,4,opt,name=toEpoch,proto3" json:"toEpoch,omitempty"`
+}
+
+func (m *QueryProviderPerformanceRequest) Reset()         { *m = QueryProviderPerformanceRequest{} }
+func (m *QueryProviderPerformanceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProviderPerformanceRequest) ProtoMessage()    {}
+func (*QueryProviderPerformanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6bd8a3cd41a2a1ee, []int{43}
+}
+func (m *QueryProviderPerformanceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProviderPerformanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProviderPerformanceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryProviderPerformanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProviderPerformanceRequest.Merge(m, src)
+}
+func (m *QueryProviderPerformanceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProviderPerformanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProviderPerformanceRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetPairingRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryProviderPerformanceRequest proto.InternalMessageInfo
+
+func (m *QueryProviderPerformanceRequest) GetChainID() string {
+	if m != nil {
+		return m.ChainID
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryGetPairingRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryProviderPerformanceRequest) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
 }
 
-func (m *QueryGetPairingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Client) > 0 {
-		i -= len(m.Client)
-		copy(dAtA[i:], m.Client)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Client)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ChainID) > 0 {
-		i -= len(m.ChainID)
-		copy(dAtA[i:], m.ChainID)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainID)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryProviderPerformanceRequest) GetFromEpoch() uint64 {
+	if m != nil {
+		return m.FromEpoch
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryGetPairingResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryProviderPerformanceRequest) GetToEpoch() uint64 {
+	if m != nil {
+		return m.ToEpoch
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryGetPairingResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// averages are zero when no qos was reported in the range
+type QueryProviderPerformanceResponse struct {
+	Reports      uint64                                 `protobuf:"varint,1,opt,name=reports,proto3" json:"reports,omitempty"`
+	Latency      github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=latency,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"latency"`
+	Availability github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=availability,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"availability"`
+	Sync         github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=sync,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"sync"`
+	Epochs       []ProviderQoSReports                   `protobuf:"bytes,5,rep,name=epochs,proto3" json:"epochs"`
 }
 
-func (m *QueryGetPairingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BlockOfNextPairing != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.BlockOfNextPairing))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.SpecLastUpdatedBlock != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.SpecLastUpdatedBlock))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.TimeLeftToNextPairing != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.TimeLeftToNextPairing))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.CurrentEpoch != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.CurrentEpoch))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Providers) > 0 {
-		for iNdEx := len(m.Providers) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Providers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryProviderPerformanceResponse) Reset()         { *m = QueryProviderPerformanceResponse{} }
+func (m *QueryProviderPerformanceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProviderPerformanceResponse) ProtoMessage()    {}
+func (*QueryProviderPerformanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6bd8a3cd41a2a1ee, []int{44}
+}
+func (m *QueryProviderPerformanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProviderPerformanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProviderPerformanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryProviderPerformanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProviderPerformanceResponse.Merge(m, src)
+}
+func (m *QueryProviderPerformanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProviderPerformanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProviderPerformanceResponse.DiscardUnknown(m)
 }
 
-func (m *QueryVerifyPairingRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryProviderPerformanceResponse proto.InternalMessageInfo
+
+func (m *QueryProviderPerformanceResponse) GetReports() uint64 {
+	if m != nil {
+		return m.Reports
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryVerifyPairingRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryProviderPerformanceResponse) GetEpochs() []ProviderQoSReports {
+	if m != nil {
+		return m.Epochs
+	}
+	return nil
 }
 
-func (m *QueryVerifyPairingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Block != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Block))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.Provider) > 0 {
-		i -= len(m.Provider)
-		copy(dAtA[i:], m.Provider)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Provider)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Client) > 0 {
-		i -= len(m.Client)
-		copy(dAtA[i:], m.Client)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Client)))
-		i--
-		dAtA[i] = 0x12
+// every filter is optional
+type QueryFilteredEpochPaymentsRequest struct {
+	ChainID    string             `protobuf:"bytes,1,opt,name=chainID,proto3" json:"chainID,omitempty"`
+	Provider   string             `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+	Consumer   string             `protobuf:"bytes,3,opt,name=consumer,proto3" json:"consumer,omitempty"`
+	Project    string             `protobuf:"bytes,4,opt,name=project,proto3" json:"project,omitempty"`
+	FromEpoch  uint64             `protobuf:"varint,5,opt,name=fromEpoch,proto3" json:"fromEpoch,omitempty"`
+	ToEpoch    uint64             `protobuf:"varint,6,opt,name=toEpoch,proto3" json:"toEpoch,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,7,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryFilteredEpochPaymentsRequest) Reset()         { *m = QueryFilteredEpochPaymentsRequest{} }
+func (m *QueryFilteredEpochPaymentsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFilteredEpochPaymentsRequest) ProtoMessage()    {}
+func (*QueryFilteredEpochPaymentsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6bd8a3cd41a2a1ee, []int{45}
+}
+func (m *QueryFilteredEpochPaymentsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFilteredEpochPaymentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFilteredEpochPaymentsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.ChainID) > 0 {
-		i -= len(m.ChainID)
-		copy(dAtA[i:], m.ChainID)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainID)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *QueryFilteredEpochPaymentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFilteredEpochPaymentsRequest.Merge(m, src)
+}
+func (m *QueryFilteredEpochPaymentsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFilteredEpochPaymentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFilteredEpochPaymentsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFilteredEpochPaymentsRequest proto.InternalMessageInfo
+
+func (m *QueryFilteredEpochPaymentsRequest) GetChainID() string {
+	if m != nil {
+		return m.ChainID
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryVerifyPairingResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryFilteredEpochPaymentsRequest) GetProvider() string {
+	if m != nil {
+		return m.Provider
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryVerifyPairingResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryFilteredEpochPaymentsRequest) GetConsumer() string {
+	if m != nil {
+		return m.Consumer
+	}
+	return ""
 }
 
-func (m *QueryVerifyPairingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.CuPerEpoch != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.CuPerEpoch))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.PairedProviders != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PairedProviders))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.Index != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Index))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Valid {
-		i--
-		if m.Valid {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
+func (m *QueryFilteredEpochPaymentsRequest) GetProject() string {
+	if m != nil {
+		return m.Project
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryGetUniquePaymentStorageClientProviderRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryFilteredEpochPaymentsRequest) GetFromEpoch() uint64 {
+	if m != nil {
+		return m.FromEpoch
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryGetUniquePaymentStorageClientProviderRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return 0
 }
 
-func (m *QueryGetUniquePaymentStorageClientProviderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Index) > 0 {
-		i -= len(m.Index)
-		copy(dAtA[i:], m.Index)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Index)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryFilteredEpochPaymentsRequest) GetToEpoch() uint64 {
+	if m != nil {
+		return m.ToEpoch
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryGetUniquePaymentStorageClientProviderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryFilteredEpochPaymentsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryGetUniquePaymentStorageClientProviderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type ConsumerEpochPayment struct {
+	Consumer string `protobuf:"bytes,1,opt,name=consumer,proto3" json:"consumer,omitempty"`
+	Project  string `protobuf:"bytes,2,opt,name=project,proto3" json:"project,omitempty"`
+	Cu       uint64 `protobuf:"varint,3,opt,name=cu,proto3" json:"cu,omitempty"`
+	Sessions uint64 `protobuf:"varint,4,opt,name=sessions,proto3" json:"sessions,omitempty"`
 }
 
-func (m *QueryGetUniquePaymentStorageClientProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.UniquePaymentStorageClientProvider.MarshalToSizedBuffer(dAtA[:i])
+func (m *ConsumerEpochPayment) Reset()         { *m = ConsumerEpochPayment{} }
+func (m *ConsumerEpochPayment) String() string { return proto.CompactTextString(m) }
+func (*ConsumerEpochPayment) ProtoMessage()    {}
+func (*ConsumerEpochPayment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6bd8a3cd41a2a1ee, []int{46}
+}
+func (m *ConsumerEpochPayment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsumerEpochPayment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsumerEpochPayment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *ConsumerEpochPayment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerEpochPayment.Merge(m, src)
+}
+func (m *ConsumerEpochPayment) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsumerEpochPayment) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerEpochPayment.DiscardUnknown(m)
 }
 
-func (m *QueryAllUniquePaymentStorageClientProviderRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ConsumerEpochPayment proto.InternalMessageInfo
+
+func (m *ConsumerEpochPayment) GetConsumer() string {
+	if m != nil {
+		return m.Consumer
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryAllUniquePaymentStorageClientProviderRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ConsumerEpochPayment) GetProject() string {
+	if m != nil {
+		return m.Project
+	}
+	return ""
 }
 
-func (m *QueryAllUniquePaymentStorageClientProviderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (m *ConsumerEpochPayment) GetCu() uint64 {
+	if m != nil {
+		return m.Cu
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryAllUniquePaymentStorageClientProviderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ConsumerEpochPayment) GetSessions() uint64 {
+	if m != nil {
+		return m.Sessions
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryAllUniquePaymentStorageClientProviderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// the payments of a provider on a chain in an epoch, only the consumer payments matching the filters are listed
+type ProviderEpochPayment struct {
+	ChainID            string                 `protobuf:"bytes,1,opt,name=chainID,proto3" json:"chainID,omitempty"`
+	Epoch              uint64                 `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Provider           string                 `protobuf:"bytes,3,opt,name=provider,proto3" json:"provider,omitempty"`
+	Cu                 uint64                 `protobuf:"varint,4,opt,name=cu,proto3" json:"cu,omitempty"`
+	ComplainersTotalCu uint64                 `protobuf:"varint,5,opt,name=complainersTotalCu,proto3" json:"complainersTotalCu,omitempty"`
+	ConsumerPayments   []ConsumerEpochPayment `protobuf:"bytes,6,rep,name=consumerPayments,proto3" json:"consumerPayments"`
 }
 
-func (m *QueryAllUniquePaymentStorageClientProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.UniquePaymentStorageClientProvider) > 0 {
-		for iNdEx := len(m.UniquePaymentStorageClientProvider) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.UniquePaymentStorageClientProvider[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *ProviderEpochPayment) Reset()         { *m = ProviderEpochPayment{} }
+func (m *ProviderEpochPayment) String() string { return proto.CompactTextString(m) }
+func (*ProviderEpochPayment) ProtoMessage()    {}
+func (*ProviderEpochPayment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6bd8a3cd41a2a1ee, []int{47}
+}
+func (m *ProviderEpochPayment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProviderEpochPayment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProviderEpochPayment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ProviderEpochPayment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProviderEpochPayment.Merge(m, src)
+}
+func (m *ProviderEpochPayment) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProviderEpochPayment) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProviderEpochPayment.DiscardUnknown(m)
 }
 
-func (m *QueryGetProviderPaymentStorageRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ProviderEpochPayment proto.InternalMessageInfo
+
+func (m *ProviderEpochPayment) GetChainID() string {
+	if m != nil {
+		return m.ChainID
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryGetProviderPaymentStorageRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ProviderEpochPayment) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
 }
 
-func (m *QueryGetProviderPaymentStorageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Index) > 0 {
-		i -= len(m.Index)
-		copy(dAtA[i:], m.Index)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Index)))
-		i--
-		dAtA[i] = 0xa
+func (m *ProviderEpochPayment) GetProvider() string {
+	if m != nil {
+		return m.Provider
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryGetProviderPaymentStorageResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ProviderEpochPayment) GetCu() uint64 {
+	if m != nil {
+		return m.Cu
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryGetProviderPaymentStorageResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ProviderEpochPayment) GetComplainersTotalCu() uint64 {
+	if m != nil {
+		return m.ComplainersTotalCu
+	}
+	return 0
 }
 
-func (m *QueryGetProviderPaymentStorageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.ProviderPaymentStorage.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *ProviderEpochPayment) GetConsumerPayments() []ConsumerEpochPayment {
+	if m != nil {
+		return m.ConsumerPayments
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryAllProviderPaymentStorageRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type QueryFilteredEpochPaymentsResponse struct {
+	Payments   []ProviderEpochPayment `protobuf:"bytes,1,rep,name=payments,proto3" json:"payments"`
+	TotalCu    uint64                 `protobuf:"varint,2,opt,name=totalCu,proto3" json:"totalCu,omitempty"`
+	Pagination *query.PageResponse    `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAllProviderPaymentStorageRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryFilteredEpochPaymentsResponse) Reset()         { *m = QueryFilteredEpochPaymentsResponse{} }
+func (m *QueryFilteredEpochPaymentsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFilteredEpochPaymentsResponse) ProtoMessage()    {}
+func (*QueryFilteredEpochPaymentsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6bd8a3cd41a2a1ee, []int{48}
 }
-
-func (m *QueryAllProviderPaymentStorageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryFilteredEpochPaymentsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFilteredEpochPaymentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFilteredEpochPaymentsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryFilteredEpochPaymentsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFilteredEpochPaymentsResponse.Merge(m, src)
+}
+func (m *QueryFilteredEpochPaymentsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFilteredEpochPaymentsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFilteredEpochPaymentsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAllProviderPaymentStorageResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryFilteredEpochPaymentsResponse proto.InternalMessageInfo
+
+func (m *QueryFilteredEpochPaymentsResponse) GetPayments() []ProviderEpochPayment {
+	if m != nil {
+		return m.Payments
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAllProviderPaymentStorageResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryFilteredEpochPaymentsResponse) GetTotalCu() uint64 {
+	if m != nil {
+		return m.TotalCu
+	}
+	return 0
 }
 
-func (m *QueryAllProviderPaymentStorageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ProviderPaymentStorage) > 0 {
-		for iNdEx := len(m.ProviderPaymentStorage) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ProviderPaymentStorage[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (m *QueryFilteredEpochPaymentsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryGetEpochPaymentsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "lavanet.lava.pairing.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "lavanet.lava.pairing.QueryParamsResponse")
+	proto.RegisterType((*QueryProvidersRequest)(nil), "lavanet.lava.pairing.QueryProvidersRequest")
+	proto.RegisterType((*QueryProvidersResponse)(nil), "lavanet.lava.pairing.QueryProvidersResponse")
+	proto.RegisterType((*QueryClientsRequest)(nil), "lavanet.lava.pairing.QueryClientsRequest")
+	proto.RegisterType((*QueryClientsResponse)(nil), "lavanet.lava.pairing.QueryClientsResponse")
+	proto.RegisterType((*QueryGetPairingRequest)(nil), "lavanet.lava.pairing.QueryGetPairingRequest")
+	proto.RegisterType((*PairingFilters)(nil), "lavanet.lava.pairing.PairingFilters")
+	proto.RegisterType((*QueryGetPairingResponse)(nil), "lavanet.lava.pairing.QueryGetPairingResponse")
+	proto.RegisterType((*QueryVerifyPairingRequest)(nil), "lavanet.lava.pairing.QueryVerifyPairingRequest")
+	proto.RegisterType((*QueryVerifyPairingResponse)(nil), "lavanet.lava.pairing.QueryVerifyPairingResponse")
+	proto.RegisterType((*QueryGetUniquePaymentStorageClientProviderRequest)(nil), "lavanet.lava.pairing.QueryGetUniquePaymentStorageClientProviderRequest")
+	proto.RegisterType((*QueryGetUniquePaymentStorageClientProviderResponse)(nil), "lavanet.lava.pairing.QueryGetUniquePaymentStorageClientProviderResponse")
+	proto.RegisterType((*QueryAllUniquePaymentStorageClientProviderRequest)(nil), "lavanet.lava.pairing.QueryAllUniquePaymentStorageClientProviderRequest")
+	proto.RegisterType((*QueryAllUniquePaymentStorageClientProviderResponse)(nil), "lavanet.lava.pairing.QueryAllUniquePaymentStorageClientProviderResponse")
+	proto.RegisterType((*QueryGetProviderPaymentStorageRequest)(nil), "lavanet.lava.pairing.QueryGetProviderPaymentStorageRequest")
+	proto.RegisterType((*QueryGetProviderPaymentStorageResponse)(nil), "lavanet.lava.pairing.QueryGetProviderPaymentStorageResponse")
+	proto.RegisterType((*QueryAllProviderPaymentStorageRequest)(nil), "lavanet.lava.pairing.QueryAllProviderPaymentStorageRequest")
+	proto.RegisterType((*QueryAllProviderPaymentStorageResponse)(nil), "lavanet.lava.pairing.QueryAllProviderPaymentStorageResponse")
+	proto.RegisterType((*QueryGetEpochPaymentsRequest)(nil), "lavanet.lava.pairing.QueryGetEpochPaymentsRequest")
+	proto.RegisterType((*QueryGetEpochPaymentsResponse)(nil), "lavanet.lava.pairing.QueryGetEpochPaymentsResponse")
+	proto.RegisterType((*QueryAllEpochPaymentsRequest)(nil), "lavanet.lava.pairing.QueryAllEpochPaymentsRequest")
+	proto.RegisterType((*QueryAllEpochPaymentsResponse)(nil), "lavanet.lava.pairing.QueryAllEpochPaymentsResponse")
+	proto.RegisterType((*QueryUserEntryRequest)(nil), "lavanet.lava.pairing.QueryUserEntryRequest")
+	proto.RegisterType((*QueryUserEntryResponse)(nil), "lavanet.lava.pairing.QueryUserEntryResponse")
+	proto.RegisterType((*QueryStaticProvidersListRequest)(nil), "lavanet.lava.pairing.QueryStaticProvidersListRequest")
+	proto.RegisterType((*QueryStaticProvidersListResponse)(nil), "lavanet.lava.pairing.QueryStaticProvidersListResponse")
+	proto.RegisterType((*QueryPairingScoresRequest)(nil), "lavanet.lava.pairing.QueryPairingScoresRequest")
+	proto.RegisterType((*QueryPairingScoresResponse)(nil), "lavanet.lava.pairing.QueryPairingScoresResponse")
+	proto.RegisterType((*ProviderPairingScore)(nil), "lavanet.lava.pairing.ProviderPairingScore")
+	proto.RegisterType((*QueryUnresponsiveReportsRequest)(nil), "lavanet.lava.pairing.QueryUnresponsiveReportsRequest")
+	proto.RegisterType((*QueryUnresponsiveReportsResponse)(nil), "lavanet.lava.pairing.QueryUnresponsiveReportsResponse")
+	proto.RegisterType((*UnresponsiveReportCount)(nil), "lavanet.lava.pairing.UnresponsiveReportCount")
+	proto.RegisterType((*QuerySimulatePairingRequest)(nil), "lavanet.lava.pairing.QuerySimulatePairingRequest")
+	proto.RegisterType((*QuerySimulatePairingResponse)(nil), "lavanet.lava.pairing.QuerySimulatePairingResponse")
+	proto.RegisterType((*QueryGetProviderMetadataRequest)(nil), "lavanet.lava.pairing.QueryGetProviderMetadataRequest")
+	proto.RegisterType((*QueryGetProviderMetadataResponse)(nil), "lavanet.lava.pairing.QueryGetProviderMetadataResponse")
+	proto.RegisterType((*QueryAllProviderMetadataRequest)(nil), "lavanet.lava.pairing.QueryAllProviderMetadataRequest")
+	proto.RegisterType((*QueryAllProviderMetadataResponse)(nil), "lavanet.lava.pairing.QueryAllProviderMetadataResponse")
+	proto.RegisterType((*QueryProvidersCapacityRequest)(nil), "lavanet.lava.pairing.QueryProvidersCapacityRequest")
+	proto.RegisterType((*QueryProvidersCapacityResponse)(nil), "lavanet.lava.pairing.QueryProvidersCapacityResponse")
+	proto.RegisterType((*QueryFrozenProvidersRequest)(nil), "lavanet.lava.pairing.QueryFrozenProvidersRequest")
+	proto.RegisterType((*QueryFrozenProvidersResponse)(nil), "lavanet.lava.pairing.QueryFrozenProvidersResponse")
+	proto.RegisterType((*QueryProviderPerformanceRequest)(nil), "lavanet.lava.pairing.QueryProviderPerformanceRequest")
+	proto.RegisterType((*QueryProviderPerformanceResponse)(nil), "lavanet.lava.pairing.QueryProviderPerformanceResponse")
+	proto.RegisterType((*QueryFilteredEpochPaymentsRequest)(nil), "lavanet.lava.pairing.QueryFilteredEpochPaymentsRequest")
+	proto.RegisterType((*ConsumerEpochPayment)(nil), "lavanet.lava.pairing.ConsumerEpochPayment")
+	proto.RegisterType((*ProviderEpochPayment)(nil), "lavanet.lava.pairing.ProviderEpochPayment")
+	proto.RegisterType((*QueryFilteredEpochPaymentsResponse)(nil), "lavanet.lava.pairing.QueryFilteredEpochPaymentsResponse")
+}
+
+func init() { proto.RegisterFile("pairing/query.proto", fileDescriptor_6bd8a3cd41a2a1ee) }
+
+var fileDescriptor_6bd8a3cd41a2a1ee = []byte{
+	// 2749 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x5a, 0xdf, 0x6f, 0xdc, 0xc6,
+	0xf1, 0x37, 0x4f, 0xd2, 0x49, 0x1a, 0x5b, 0xb1, 0xb2, 0x96, 0xe5, 0x0b, 0xbf, 0x8a, 0xa4, 0x30,
+	0xfe, 0x1d, 0xf9, 0xce, 0x92, 0x25, 0xd9, 0x5f, 0xff, 0x2a, 0x64, 0xd9, 0xb2, 0xdd, 0xc8, 0xb5,
+	0x7c, 0xb6, 0xea, 0x22, 0x28, 0x70, 0xa0, 0x78, 0x7b, 0x67, 0xda, 0x3c, 0x92, 0x22, 0x79, 0xb2,
+	0x14, 0x55, 0x48, 0xda, 0xa2, 0x2f, 0x7d, 0x48, 0x5a, 0x34, 0x7d, 0xe8, 0x7b, 0x81, 0xa2, 0x0f,
+	0xed, 0x43, 0x81, 0x02, 0x6d, 0x81, 0x3e, 0x14, 0x41, 0x8a, 0xf4, 0x21, 0x45, 0x80, 0xa0, 0x40,
+	0x51, 0xa0, 0x41, 0x61, 0x17, 0x05, 0xfa, 0x5f, 0x14, 0xdc, 0x9d, 0xe5, 0x91, 0x77, 0x24, 0xef,
+	0x4e, 0x12, 0xf2, 0x74, 0xda, 0xdd, 0x99, 0xd9, 0x99, 0xcf, 0xcc, 0xee, 0xcc, 0x0e, 0x05, 0x47,
+	0x6c, 0x55, 0x77, 0x74, 0xb3, 0x5a, 0x58, 0xaf, 0x53, 0x67, 0x2b, 0x6f, 0x3b, 0x96, 0x67, 0x91,
+	0x11, 0x43, 0xdd, 0x50, 0x4d, 0xea, 0xe5, 0xfd, 0xdf, 0x3c, 0x52, 0xc8, 0x23, 0x55, 0xab, 0x6a,
+	0x31, 0x82, 0x82, 0xff, 0x17, 0xa7, 0x95, 0xc7, 0xaa, 0x96, 0x55, 0x35, 0x68, 0x41, 0xb5, 0xf5,
+	0x82, 0x6a, 0x9a, 0x96, 0xa7, 0x7a, 0xba, 0x65, 0xba, 0xb8, 0x7a, 0x56, 0xb3, 0xdc, 0x9a, 0xe5,
+	0x16, 0xd6, 0x54, 0x97, 0xf2, 0x2d, 0x0a, 0x1b, 0xd3, 0x6b, 0xd4, 0x53, 0xa7, 0x0b, 0xb6, 0x5a,
+	0xd5, 0x4d, 0x46, 0x8c, 0xb4, 0x23, 0x42, 0x15, 0x5b, 0x75, 0xd4, 0x9a, 0x90, 0x30, 0x26, 0x66,
+	0xa9, 0x6d, 0x69, 0x4f, 0x4a, 0xb6, 0xba, 0x55, 0xa3, 0xa6, 0x27, 0x56, 0x4f, 0x06, 0x3c, 0x8e,
+	0xb5, 0xa1, 0x97, 0xa9, 0x23, 0x08, 0x4a, 0xae, 0x67, 0x39, 0x6a, 0x95, 0x22, 0xdd, 0xac, 0xa0,
+	0xab, 0x9b, 0xfa, 0x7a, 0x9d, 0x36, 0x53, 0x95, 0x34, 0x43, 0xf7, 0x87, 0x42, 0x0a, 0x72, 0x8d,
+	0xb3, 0x3d, 0x91, 0xa6, 0xe0, 0x7a, 0xea, 0x33, 0x5a, 0xa2, 0xa6, 0x27, 0x70, 0x92, 0x27, 0x5a,
+	0x76, 0xaf, 0x51, 0x4f, 0x2d, 0xab, 0x9e, 0x8a, 0x04, 0xaf, 0xb7, 0x10, 0x54, 0x1c, 0x4a, 0xdf,
+	0x15, 0x5a, 0xc9, 0x2d, 0xcb, 0xeb, 0x16, 0x5a, 0xa6, 0x8c, 0x00, 0x79, 0xe0, 0xe3, 0xb5, 0xc2,
+	0xc0, 0x28, 0xd2, 0xf5, 0x3a, 0x75, 0x3d, 0xe5, 0x01, 0x1c, 0x89, 0xcc, 0xba, 0xb6, 0x65, 0xba,
+	0x94, 0x5c, 0x86, 0x2c, 0x07, 0x2d, 0x27, 0x4d, 0x4a, 0xa7, 0x0f, 0xce, 0x8c, 0xe5, 0xe3, 0x3c,
+	0x98, 0xe7, 0x5c, 0x37, 0x7a, 0x3f, 0xfd, 0x72, 0xe2, 0x40, 0x11, 0x39, 0x94, 0x07, 0x70, 0x94,
+	0x8b, 0x44, 0x1d, 0xc4, 0x5e, 0x24, 0x07, 0xfd, 0xda, 0x13, 0x55, 0x37, 0xef, 0xde, 0x64, 0x52,
+	0x07, 0x8b, 0x62, 0x48, 0xc6, 0x01, 0xdc, 0x27, 0xd6, 0xf3, 0x25, 0xc7, 0x7a, 0x97, 0x9a, 0xb9,
+	0xcc, 0xa4, 0x74, 0x7a, 0xa0, 0x18, 0x9a, 0x51, 0x76, 0x60, 0xb4, 0x59, 0x24, 0x2a, 0xfa, 0x36,
+	0x00, 0x83, 0xf1, 0x96, 0x8f, 0x62, 0x4e, 0x9a, 0xec, 0x39, 0x7d, 0x70, 0xe6, 0x44, 0x54, 0xd9,
+	0x30, 0xe6, 0xf9, 0x87, 0x01, 0x31, 0x6a, 0x1d, 0x62, 0x27, 0xa3, 0x90, 0xb5, 0xea, 0x9e, 0x5d,
+	0xf7, 0x98, 0x0a, 0x83, 0x45, 0x1c, 0x29, 0x05, 0x04, 0x69, 0x91, 0x39, 0xb5, 0xbd, 0x3d, 0xca,
+	0x36, 0x8c, 0x44, 0x19, 0xbe, 0x4a, 0x6d, 0x7f, 0x28, 0x21, 0x5a, 0xb7, 0xa9, 0xb7, 0xc2, 0x1d,
+	0xd5, 0xde, 0x03, 0xa3, 0x90, 0xe5, 0x21, 0x2b, 0x84, 0xf1, 0x11, 0xb9, 0x0e, 0xfd, 0x15, 0xdd,
+	0xf0, 0xa8, 0xe3, 0xe6, 0x7a, 0x58, 0x24, 0x1c, 0x4f, 0x8a, 0x04, 0xf6, 0xbb, 0xc4, 0x69, 0x8b,
+	0x82, 0x49, 0xf9, 0x58, 0x82, 0x57, 0xa2, 0x6b, 0xe4, 0x34, 0x0c, 0x3b, 0x74, 0xbd, 0xae, 0x3b,
+	0xb4, 0x5c, 0x52, 0xcb, 0xe5, 0x92, 0x65, 0xba, 0x0c, 0x8a, 0xc1, 0xe2, 0x2b, 0x62, 0x7e, 0xa1,
+	0x5c, 0xbe, 0x6f, 0xba, 0x64, 0x12, 0x0e, 0x56, 0xa9, 0x65, 0x58, 0x1a, 0x3b, 0xd5, 0x4c, 0xb3,
+	0xde, 0x62, 0x78, 0x8a, 0xbc, 0x0d, 0x83, 0x35, 0xdd, 0x2c, 0x31, 0x54, 0x98, 0x82, 0x83, 0x37,
+	0xf2, 0x3e, 0x50, 0xff, 0xf8, 0x72, 0xe2, 0x64, 0x55, 0xf7, 0x9e, 0xd4, 0xd7, 0xf2, 0x9a, 0x55,
+	0x2b, 0xe0, 0xa5, 0xc1, 0x7f, 0xce, 0xb9, 0xe5, 0x67, 0x05, 0x6f, 0xcb, 0xa6, 0x6e, 0xfe, 0xae,
+	0xe9, 0x15, 0x07, 0x6a, 0xba, 0xc9, 0x70, 0xf6, 0xd1, 0xa1, 0x9b, 0x9a, 0x51, 0x2f, 0xd3, 0x5c,
+	0x2f, 0xd3, 0x47, 0x0c, 0x95, 0xff, 0x66, 0xe0, 0x58, 0x0b, 0xa4, 0xe8, 0xd3, 0xbb, 0x30, 0x28,
+	0x4e, 0x9b, 0xbb, 0x1b, 0x97, 0x36, 0xb8, 0xc9, 0x9b, 0x30, 0xa4, 0xd5, 0x1d, 0xc7, 0xbf, 0x38,
+	0x18, 0x0f, 0x5a, 0x7c, 0x08, 0x27, 0x6f, 0xf9, 0x73, 0xe4, 0x12, 0xbc, 0xe6, 0xe9, 0x35, 0x5a,
+	0x32, 0x68, 0xc5, 0x2b, 0x79, 0x56, 0xc9, 0xa4, 0x9b, 0x5e, 0x09, 0xdd, 0xc0, 0x20, 0xe8, 0x2d,
+	0x1e, 0xf5, 0x09, 0x96, 0x69, 0xc5, 0x7b, 0x64, 0x7d, 0x83, 0x6e, 0x0a, 0x8d, 0xc9, 0x1c, 0x1c,
+	0x73, 0x6d, 0xaa, 0x95, 0x0c, 0xd5, 0xf5, 0x4a, 0x75, 0xbb, 0xac, 0x7a, 0xb4, 0x5c, 0x5a, 0x33,
+	0x2c, 0xed, 0x59, 0xae, 0x97, 0xf1, 0x8d, 0xf8, 0xcb, 0xcb, 0xaa, 0xeb, 0xad, 0xf2, 0xc5, 0x1b,
+	0xfe, 0x1a, 0x99, 0x86, 0xa3, 0x8c, 0xa8, 0x64, 0x55, 0xa2, 0x9b, 0xf5, 0x31, 0x26, 0xc2, 0x16,
+	0xef, 0x57, 0xc2, 0x3b, 0xbd, 0x01, 0x87, 0x54, 0xc3, 0xb0, 0x9e, 0xfb, 0x1e, 0xb6, 0x75, 0x37,
+	0x97, 0x65, 0x70, 0x1e, 0xc4, 0xb9, 0x05, 0x5b, 0x77, 0xc9, 0x31, 0xe8, 0x17, 0xce, 0xef, 0x67,
+	0xab, 0x59, 0x95, 0x39, 0x5d, 0x79, 0x0f, 0x5e, 0x63, 0x50, 0x7f, 0x93, 0x3a, 0x7a, 0x65, 0x6b,
+	0xcf, 0x01, 0x2c, 0xc3, 0x80, 0x00, 0x98, 0x07, 0x48, 0x31, 0x18, 0x93, 0x11, 0xe8, 0x0b, 0x9b,
+	0xcf, 0x07, 0xca, 0x4f, 0x25, 0x90, 0xe3, 0x34, 0x40, 0x7f, 0x8f, 0x40, 0xdf, 0x86, 0x6a, 0xe8,
+	0x65, 0xa6, 0xc0, 0x40, 0x91, 0x0f, 0xfc, 0x59, 0xdd, 0x2c, 0xd3, 0x4d, 0xb6, 0x7b, 0x4f, 0x91,
+	0x0f, 0xc8, 0x19, 0x18, 0xf6, 0xc1, 0xa2, 0xe5, 0x52, 0x23, 0x44, 0xb8, 0x8b, 0x0e, 0xf3, 0xf9,
+	0xe0, 0x42, 0x23, 0x93, 0x70, 0x48, 0xab, 0x97, 0x6c, 0xea, 0xa0, 0xeb, 0xb9, 0x4a, 0xa0, 0xd5,
+	0x57, 0xa8, 0xc3, 0x1c, 0xaf, 0xdc, 0x85, 0x69, 0x11, 0x83, 0xab, 0x2c, 0xe9, 0xac, 0xf0, 0x9c,
+	0xf3, 0x90, 0x47, 0x16, 0xbf, 0x6b, 0x84, 0x40, 0x01, 0x58, 0xa0, 0x17, 0x87, 0x8b, 0x0f, 0x94,
+	0x4f, 0x24, 0x98, 0xe9, 0x46, 0x16, 0x9a, 0xfe, 0x81, 0x04, 0x4a, 0xbd, 0x2d, 0x39, 0xa6, 0x8c,
+	0x4b, 0xf1, 0x17, 0x45, 0xfb, 0xed, 0xf0, 0x5c, 0x74, 0xb0, 0x93, 0xb2, 0x8d, 0x90, 0x2c, 0x18,
+	0x46, 0xe7, 0x90, 0x2c, 0x01, 0x34, 0x4a, 0x05, 0x54, 0xf6, 0x64, 0x9e, 0xdf, 0x0d, 0x79, 0xbf,
+	0xae, 0xc8, 0xf3, 0xd2, 0x05, 0xeb, 0x8a, 0xfc, 0x8a, 0x5a, 0xa5, 0xc8, 0x5b, 0x0c, 0x71, 0x2a,
+	0x1f, 0x64, 0x60, 0xa6, 0x9b, 0xdd, 0xbb, 0x05, 0xb1, 0xe7, 0xab, 0x01, 0x91, 0xdc, 0x8e, 0xe0,
+	0x91, 0x61, 0x78, 0x9c, 0x6a, 0x8b, 0x07, 0xb7, 0x26, 0x02, 0xc8, 0x35, 0x38, 0x11, 0x5c, 0x92,
+	0x28, 0x3c, 0xba, 0x71, 0x7a, 0x50, 0x7e, 0x24, 0xc1, 0xc9, 0x76, 0xfc, 0x88, 0xe1, 0x53, 0x18,
+	0xb5, 0x63, 0x29, 0xd0, 0x9d, 0x53, 0x09, 0x49, 0x2a, 0x96, 0x07, 0xa1, 0x4a, 0x90, 0xa8, 0x58,
+	0x68, 0xd5, 0x82, 0x61, 0xa4, 0x5b, 0xb5, 0x5f, 0x71, 0xf5, 0x4f, 0x81, 0x43, 0xca, 0x8e, 0x1d,
+	0xe0, 0xd0, 0xb3, 0xbf, 0x38, 0xec, 0x5f, 0x98, 0xcc, 0xc2, 0x98, 0x70, 0x33, 0xbb, 0xd8, 0x70,
+	0x1f, 0x37, 0x3d, 0x3a, 0x6c, 0x78, 0x3d, 0x81, 0x0b, 0xb1, 0xb8, 0x0f, 0x43, 0x34, 0xbc, 0x80,
+	0x1e, 0x78, 0x33, 0x1e, 0x82, 0x88, 0x0c, 0xb4, 0x3c, 0xca, 0xaf, 0x54, 0x50, 0xcf, 0x05, 0xc3,
+	0x88, 0xd5, 0x73, 0xbf, 0xfc, 0xfd, 0x7b, 0x09, 0x5e, 0x4f, 0xd8, 0x28, 0xd9, 0xb4, 0x9e, 0xbd,
+	0x98, 0xb6, 0x7f, 0xbe, 0x54, 0xb1, 0xd6, 0x5f, 0x75, 0xa9, 0xc3, 0x8a, 0x9a, 0x50, 0xa2, 0x56,
+	0xcb, 0x65, 0x87, 0xba, 0xae, 0x48, 0xd4, 0x38, 0x0c, 0xa7, 0xf0, 0x4c, 0x34, 0x85, 0x07, 0xe9,
+	0xb8, 0x27, 0x9c, 0x8e, 0x9f, 0xc3, 0x68, 0xf3, 0x16, 0x08, 0xcb, 0x6d, 0x18, 0xd0, 0x2c, 0xd3,
+	0xad, 0xd7, 0x82, 0x9c, 0xd3, 0x55, 0xe1, 0x15, 0x30, 0xfb, 0x1b, 0xd7, 0xd4, 0xcd, 0xc5, 0x55,
+	0xac, 0xb7, 0xf8, 0x40, 0xb9, 0x02, 0x13, 0x6c, 0xe3, 0x87, 0x9e, 0xea, 0xe9, 0x5a, 0x90, 0xa9,
+	0x97, 0x75, 0xd7, 0x6b, 0xff, 0x02, 0xa8, 0xc1, 0x64, 0x32, 0xf3, 0xbe, 0x57, 0x8e, 0xca, 0x63,
+	0x2c, 0x9a, 0xb0, 0x58, 0x79, 0xa8, 0x59, 0x0e, 0xed, 0xe0, 0xdd, 0xd5, 0xb6, 0xc0, 0x56, 0x2a,
+	0x20, 0xc7, 0x09, 0x46, 0x0b, 0xee, 0x40, 0xd6, 0x65, 0x33, 0xa8, 0xfe, 0xd9, 0x76, 0xf7, 0x4d,
+	0x43, 0x88, 0x78, 0x34, 0x72, 0x7e, 0xe5, 0x57, 0x19, 0x18, 0x89, 0x23, 0xf3, 0x9d, 0x6c, 0x47,
+	0x0b, 0x8b, 0xee, 0x9c, 0x2c, 0x98, 0xc9, 0x59, 0x18, 0x0e, 0x19, 0x76, 0x4f, 0xf5, 0xb0, 0xbe,
+	0x1e, 0x28, 0xb6, 0xcc, 0x93, 0x77, 0x22, 0xb4, 0x4c, 0x91, 0x5d, 0xbc, 0x2e, 0x6e, 0x52, 0xad,
+	0xd8, 0x22, 0x87, 0xdc, 0x84, 0x3e, 0x66, 0x73, 0xae, 0xb7, 0x6b, 0x81, 0xfe, 0x73, 0x85, 0x33,
+	0x2b, 0x8f, 0x31, 0x38, 0x57, 0x4d, 0x87, 0x3b, 0x43, 0xdf, 0xa0, 0x45, 0x6a, 0x5b, 0x4e, 0x07,
+	0xcf, 0xd3, 0x48, 0x4d, 0x9c, 0x89, 0xd6, 0xc4, 0x7e, 0xf5, 0x3b, 0x99, 0x2c, 0x19, 0xfd, 0x7e,
+	0x0f, 0xfa, 0x1d, 0x3e, 0x85, 0x8e, 0x3f, 0x97, 0x54, 0xa7, 0x34, 0xcb, 0x58, 0xb4, 0xea, 0xa6,
+	0x87, 0xbe, 0x11, 0x32, 0x88, 0x02, 0x87, 0x9e, 0xaa, 0xba, 0x71, 0xcb, 0xe4, 0x2f, 0x0e, 0xf1,
+	0xec, 0x09, 0xcf, 0x29, 0xf7, 0xe0, 0x58, 0x82, 0x34, 0xff, 0xf8, 0xf2, 0x9a, 0x59, 0xe2, 0xc7,
+	0x97, 0x0d, 0xc8, 0x18, 0x0c, 0x72, 0xf9, 0xfe, 0xe9, 0xe2, 0x12, 0x1b, 0x13, 0xca, 0x3a, 0xfc,
+	0x1f, 0x3f, 0x9f, 0x7a, 0xad, 0x6e, 0xa8, 0x1e, 0xdd, 0xf3, 0x3b, 0x63, 0x12, 0x0e, 0xb2, 0x7d,
+	0xef, 0x57, 0x2a, 0x2e, 0xf5, 0xf0, 0x0a, 0x0b, 0x4f, 0xf9, 0xc8, 0x8e, 0xc5, 0xef, 0xb9, 0xff,
+	0x2f, 0xc9, 0x00, 0x92, 0x4c, 0x18, 0x12, 0x7f, 0x76, 0x53, 0xd5, 0xb8, 0x76, 0x03, 0x45, 0x3e,
+	0x50, 0xae, 0xc1, 0x44, 0x73, 0xd9, 0x75, 0x0f, 0xbb, 0x4e, 0x02, 0x0e, 0xb9, 0xe9, 0x10, 0x86,
+	0x03, 0xe6, 0x3b, 0x30, 0x99, 0xcc, 0x8e, 0x96, 0x7d, 0x0b, 0x86, 0xed, 0xa6, 0xb5, 0x20, 0x61,
+	0xa6, 0xde, 0x18, 0x82, 0x1a, 0x2d, 0x6c, 0x91, 0xa2, 0xbc, 0x07, 0x13, 0xcd, 0xb5, 0x52, 0xb3,
+	0xf2, 0x23, 0xd0, 0xa7, 0x96, 0xcb, 0x98, 0xa2, 0x07, 0x8b, 0x7c, 0xd0, 0x94, 0xbd, 0x33, 0xbb,
+	0xce, 0xde, 0x9f, 0x88, 0xf3, 0x12, 0xab, 0x41, 0xaa, 0xfd, 0x3d, 0x7b, 0xb7, 0x7f, 0xff, 0x32,
+	0xf9, 0xff, 0x63, 0x11, 0x12, 0xa4, 0xaa, 0x45, 0xd5, 0x56, 0x35, 0xdd, 0xdb, 0x6a, 0x9f, 0xeb,
+	0x3e, 0xca, 0xc0, 0x78, 0x12, 0x6f, 0xe3, 0xd1, 0x1c, 0x73, 0x44, 0x8f, 0xc3, 0x90, 0x67, 0x79,
+	0xaa, 0x21, 0xc8, 0x31, 0x5a, 0xa3, 0x93, 0xfe, 0x89, 0xab, 0xbb, 0xb4, 0xbc, 0x58, 0xc7, 0x43,
+	0x85, 0x23, 0x32, 0x05, 0xaf, 0x3a, 0xb4, 0xa6, 0xea, 0xa6, 0x6e, 0x56, 0x03, 0x09, 0xfc, 0xd9,
+	0xdc, 0xba, 0x40, 0x66, 0xe1, 0x68, 0x70, 0x3c, 0x1e, 0xeb, 0xde, 0x93, 0x80, 0x83, 0x77, 0x31,
+	0xe2, 0x17, 0xc9, 0x65, 0xc8, 0x45, 0x16, 0xac, 0xba, 0x17, 0x30, 0x66, 0x19, 0x63, 0xe2, 0xba,
+	0x72, 0x11, 0xaf, 0x18, 0xde, 0xc3, 0xec, 0xbc, 0x1b, 0xaa, 0x78, 0x30, 0x16, 0xcf, 0x88, 0x60,
+	0x3e, 0x82, 0xc3, 0x95, 0xe8, 0x12, 0x06, 0xd3, 0xf1, 0xf4, 0x60, 0x5a, 0x62, 0xad, 0x62, 0x0c,
+	0xa5, 0x66, 0x11, 0xca, 0x87, 0x12, 0x1e, 0x25, 0x31, 0xb5, 0x42, 0x9d, 0x8a, 0xe5, 0xd4, 0x54,
+	0x53, 0xa3, 0x7b, 0x4a, 0x29, 0xfe, 0x4d, 0x5c, 0x71, 0xac, 0x1a, 0xab, 0x4b, 0xd1, 0x87, 0x8d,
+	0x09, 0x5f, 0xa6, 0x67, 0xdd, 0x0a, 0xf5, 0x3c, 0xc4, 0x50, 0xf9, 0x4f, 0x06, 0x26, 0x93, 0x35,
+	0x42, 0x30, 0x72, 0xe1, 0x54, 0xc4, 0xd8, 0x71, 0x48, 0xee, 0x40, 0xbf, 0x7f, 0xcb, 0x9a, 0x1a,
+	0x8f, 0xab, 0xee, 0x73, 0xb7, 0x60, 0x27, 0x45, 0x38, 0xa4, 0x6e, 0xa8, 0xba, 0xa1, 0xae, 0xe9,
+	0x86, 0xef, 0xf9, 0xdd, 0x95, 0x02, 0x11, 0x19, 0xe4, 0x06, 0xf4, 0xba, 0x5b, 0xa6, 0x96, 0xeb,
+	0xdd, 0x95, 0x2c, 0xc6, 0x4b, 0x96, 0x20, 0xcb, 0x33, 0x42, 0xae, 0x8f, 0xf9, 0xff, 0x74, 0xba,
+	0xff, 0x1f, 0x58, 0x0f, 0x31, 0x91, 0x8b, 0xe2, 0x8b, 0x73, 0x2b, 0x1f, 0x66, 0xe0, 0x0d, 0x1e,
+	0x71, 0xac, 0x45, 0x4b, 0xcb, 0xb1, 0xef, 0x9d, 0xdd, 0x39, 0x5f, 0x0e, 0x15, 0xe9, 0xd8, 0x7f,
+	0x13, 0x63, 0x5f, 0xa2, 0xed, 0x58, 0x4f, 0xa9, 0xe6, 0x71, 0x18, 0x8a, 0x62, 0x18, 0x0d, 0x99,
+	0xbe, 0x94, 0x90, 0xc9, 0x46, 0x42, 0xa6, 0xe9, 0x56, 0xef, 0xdf, 0xf5, 0xad, 0xbe, 0x09, 0x23,
+	0x8b, 0xa8, 0x65, 0x18, 0x8b, 0x88, 0x35, 0x52, 0xb2, 0x35, 0x99, 0xa8, 0x35, 0xaf, 0x40, 0x46,
+	0x13, 0xb7, 0x57, 0x46, 0xab, 0xfb, 0x52, 0x5c, 0xea, 0xba, 0xba, 0xdf, 0xfc, 0xe4, 0x31, 0x1f,
+	0x8c, 0x95, 0xf7, 0x43, 0x85, 0x70, 0x64, 0xeb, 0x64, 0xf8, 0xe3, 0x93, 0x7d, 0x5a, 0xe3, 0x93,
+	0x2b, 0xd4, 0x1b, 0x28, 0x94, 0x07, 0xa2, 0x59, 0x35, 0xdb, 0x50, 0x75, 0x93, 0x3a, 0xee, 0x23,
+	0x76, 0xfd, 0xd6, 0x45, 0x7f, 0xb7, 0x75, 0x85, 0x7c, 0x1b, 0x86, 0x85, 0xd9, 0xc1, 0x9b, 0x34,
+	0x9b, 0xf6, 0x02, 0x88, 0x03, 0x53, 0xe4, 0xb4, 0x66, 0x49, 0xca, 0xdf, 0x24, 0x50, 0xd2, 0xc2,
+	0x11, 0x4f, 0xfe, 0x32, 0x0c, 0xd8, 0xd1, 0x07, 0x71, 0x9b, 0xe7, 0x47, 0xcc, 0xe6, 0x81, 0x04,
+	0x1e, 0x53, 0xdc, 0xee, 0x8c, 0x88, 0x29, 0x6e, 0x6c, 0x34, 0xc5, 0xf6, 0xec, 0x3a, 0xc5, 0xce,
+	0x7c, 0xf7, 0x0d, 0xe8, 0x63, 0x76, 0x91, 0xef, 0x4b, 0x90, 0xe5, 0xdf, 0xce, 0x48, 0xc2, 0x99,
+	0x6d, 0xfd, 0x54, 0x27, 0x9f, 0xe9, 0x80, 0x92, 0xef, 0xaa, 0x1c, 0xff, 0xde, 0x17, 0xff, 0xfe,
+	0x49, 0x66, 0x9c, 0x8c, 0x15, 0x90, 0x85, 0xfd, 0x16, 0xa2, 0xdf, 0x43, 0xc9, 0xcf, 0x24, 0x18,
+	0x6c, 0x34, 0xa0, 0xdf, 0x4a, 0x13, 0xdf, 0x94, 0xbc, 0xe4, 0xa9, 0xce, 0x88, 0x51, 0x9d, 0x69,
+	0xa6, 0xce, 0x5b, 0xe4, 0x4c, 0x82, 0x3a, 0x82, 0xa1, 0xb0, 0x8d, 0x21, 0xbd, 0x43, 0x7e, 0x2c,
+	0x41, 0x3f, 0x7e, 0x3d, 0x23, 0x69, 0x86, 0x47, 0x3f, 0xc9, 0xc9, 0x67, 0x3b, 0x21, 0x45, 0xad,
+	0x0a, 0x4c, 0xab, 0x33, 0xe4, 0x54, 0xbc, 0x56, 0xbc, 0xae, 0x0f, 0xeb, 0xf4, 0x0b, 0x09, 0xa0,
+	0xf1, 0x01, 0x88, 0xa4, 0x61, 0xd0, 0xf2, 0xe9, 0x4d, 0x3e, 0xd7, 0x21, 0x35, 0x2a, 0x77, 0x95,
+	0x29, 0x37, 0x4f, 0x66, 0xe3, 0x95, 0xab, 0xd2, 0xe0, 0x33, 0x4c, 0x43, 0xc1, 0xc2, 0x36, 0xd7,
+	0x79, 0x87, 0xfc, 0x59, 0x82, 0xa1, 0xc8, 0xd7, 0x0b, 0x52, 0x48, 0xd9, 0x3e, 0xee, 0x4b, 0x8b,
+	0x7c, 0xbe, 0x73, 0x06, 0x54, 0xb9, 0xc8, 0x54, 0x5e, 0x26, 0x5f, 0x8f, 0x57, 0x79, 0x83, 0x31,
+	0xa5, 0x68, 0x5d, 0xd8, 0x16, 0x81, 0xb0, 0x53, 0xd8, 0x66, 0xbd, 0x9f, 0x1d, 0xf2, 0x83, 0x0c,
+	0x28, 0xab, 0x1d, 0xb4, 0xb0, 0xd3, 0xc1, 0xed, 0xf8, 0xdb, 0x80, 0x7c, 0x67, 0xef, 0x82, 0x10,
+	0x8d, 0x65, 0x86, 0xc6, 0x12, 0xb9, 0x19, 0x8f, 0x46, 0x67, 0xff, 0x36, 0x50, 0xd8, 0x66, 0xcd,
+	0xcf, 0x1d, 0xf2, 0x7e, 0x06, 0x4e, 0xb4, 0xdf, 0x7c, 0xc1, 0x30, 0x52, 0xa1, 0xe8, 0xe6, 0x33,
+	0x89, 0x7c, 0x67, 0xef, 0x82, 0x10, 0x8a, 0x9b, 0x0c, 0x8a, 0xeb, 0xe4, 0xea, 0x5e, 0xa0, 0x20,
+	0x5f, 0x48, 0x30, 0x1a, 0xdf, 0xb8, 0x26, 0x57, 0xda, 0x9c, 0xad, 0xb4, 0xb6, 0xbd, 0x7c, 0x75,
+	0x77, 0xcc, 0x68, 0xdb, 0x75, 0x66, 0xdb, 0x25, 0x32, 0x9f, 0x7e, 0xb5, 0x35, 0x5b, 0x17, 0x38,
+	0xf6, 0xaf, 0x12, 0xbc, 0x16, 0xbf, 0x85, 0xef, 0xcc, 0x2b, 0xe9, 0x3e, 0xd8, 0xbd, 0x61, 0x6d,
+	0x3f, 0x2d, 0x28, 0xf3, 0xcc, 0xb0, 0xf3, 0x24, 0xdf, 0x9d, 0x61, 0xe4, 0xd7, 0x12, 0x0c, 0x45,
+	0xf2, 0x35, 0x99, 0x49, 0x07, 0x38, 0xae, 0xd6, 0x94, 0x2f, 0x74, 0xc5, 0x83, 0x2a, 0xcf, 0x32,
+	0x95, 0xf3, 0x64, 0x2a, 0x5e, 0xe5, 0xe8, 0xff, 0xfb, 0x04, 0x1e, 0xf8, 0xa5, 0x04, 0xc3, 0x11,
+	0x79, 0x3e, 0xf0, 0x33, 0xe9, 0xd8, 0x75, 0xad, 0x73, 0x52, 0x6b, 0x5f, 0x99, 0x62, 0x3a, 0x9f,
+	0x24, 0xc7, 0x3b, 0xd1, 0x99, 0xfc, 0x5c, 0x82, 0xc1, 0xa0, 0x0f, 0x9e, 0x9a, 0xb1, 0x9b, 0x1b,
+	0xf2, 0xf2, 0x54, 0x67, 0xc4, 0x9d, 0xa5, 0x9f, 0xba, 0xeb, 0x7f, 0xa7, 0xf6, 0x39, 0x0a, 0xdb,
+	0xd8, 0xd7, 0xdf, 0x09, 0x25, 0xca, 0x8f, 0x25, 0x38, 0x12, 0xd3, 0xf8, 0x26, 0x73, 0x29, 0x3a,
+	0x24, 0x77, 0xd9, 0xe5, 0xf9, 0x6e, 0xd9, 0xd0, 0x88, 0x6b, 0xcc, 0x88, 0x8b, 0x64, 0x2e, 0xde,
+	0x08, 0x97, 0xb1, 0x36, 0xbe, 0xcc, 0x97, 0x0c, 0xdd, 0xf5, 0x42, 0x56, 0xfc, 0x4e, 0x82, 0xa1,
+	0x48, 0xdb, 0x3b, 0x35, 0x89, 0xc6, 0x75, 0xde, 0xe5, 0xf3, 0x9d, 0x33, 0x74, 0x76, 0x57, 0xe2,
+	0x6f, 0x89, 0x77, 0xcd, 0xc3, 0x49, 0x34, 0xd4, 0x67, 0xde, 0x21, 0x9f, 0x49, 0x70, 0x24, 0xa6,
+	0x7f, 0x9b, 0xea, 0x80, 0xe4, 0x4e, 0xb2, 0x3c, 0xdf, 0x2d, 0x1b, 0x1a, 0x73, 0x9b, 0x19, 0xb3,
+	0x40, 0xbe, 0x96, 0x74, 0xf1, 0x37, 0x58, 0x4b, 0xf8, 0x6a, 0x0f, 0x9b, 0x14, 0x94, 0x03, 0xe4,
+	0x2f, 0x12, 0x1c, 0x6e, 0xea, 0x9a, 0x92, 0xe9, 0xb4, 0xa8, 0x88, 0xed, 0xea, 0xca, 0x33, 0xdd,
+	0xb0, 0xa0, 0x0d, 0xf7, 0x99, 0x0d, 0x77, 0xc9, 0xed, 0x84, 0x20, 0x42, 0xb6, 0xd4, 0xba, 0x26,
+	0xd4, 0x05, 0xde, 0x21, 0x7f, 0x94, 0x60, 0xb8, 0xb9, 0xbd, 0x47, 0xe6, 0x3a, 0x4b, 0x42, 0x4d,
+	0xad, 0x4d, 0x79, 0xbe, 0x5b, 0x36, 0x34, 0xea, 0x32, 0x33, 0x6a, 0x96, 0xcc, 0xb4, 0xb9, 0xdc,
+	0xc5, 0x7f, 0x1f, 0x86, 0x7d, 0xf1, 0x5b, 0x09, 0x8e, 0x34, 0x0b, 0xf6, 0xaf, 0xcc, 0xb9, 0xce,
+	0xd2, 0x4d, 0x37, 0x26, 0xa4, 0xb4, 0x54, 0xdb, 0x55, 0xef, 0x2d, 0x26, 0x90, 0x3f, 0x48, 0xf0,
+	0x6a, 0x4b, 0x83, 0x92, 0x5c, 0xe8, 0xe4, 0x21, 0xd3, 0xd4, 0x0a, 0x95, 0x67, 0xbb, 0x63, 0xea,
+	0x0e, 0x74, 0xb7, 0xa4, 0x21, 0x67, 0xe8, 0x2e, 0xfa, 0x8d, 0x04, 0x87, 0x9b, 0xda, 0x81, 0xa9,
+	0x07, 0x20, 0xbe, 0xe7, 0x28, 0xcf, 0x74, 0xc3, 0x82, 0x6a, 0x5f, 0x62, 0x6a, 0xcf, 0x90, 0xf3,
+	0xf1, 0x6a, 0xf3, 0x36, 0x62, 0x29, 0xee, 0x0d, 0xf7, 0x59, 0x28, 0x52, 0x42, 0xad, 0xbb, 0xd4,
+	0x48, 0x49, 0x6e, 0x3e, 0xca, 0xf3, 0xdd, 0xb2, 0x75, 0x76, 0x0b, 0x35, 0x2a, 0x99, 0x06, 0x6f,
+	0xfc, 0x2d, 0xf4, 0x27, 0x09, 0x8e, 0xc6, 0xb6, 0x24, 0xc8, 0xc5, 0x34, 0x5c, 0x53, 0x7a, 0x6a,
+	0xf2, 0xa5, 0xee, 0x19, 0xd1, 0xaa, 0x39, 0x66, 0x55, 0x81, 0x9c, 0x4b, 0x70, 0x0b, 0x32, 0x97,
+	0xa2, 0x15, 0xc4, 0x8d, 0x85, 0x4f, 0x5f, 0x8c, 0x4b, 0x9f, 0xbf, 0x18, 0x97, 0xfe, 0xf5, 0x62,
+	0x5c, 0xfa, 0xd1, 0xcb, 0xf1, 0x03, 0x9f, 0xbf, 0x1c, 0x3f, 0xf0, 0xf7, 0x97, 0xe3, 0x07, 0xde,
+	0x39, 0x15, 0x6a, 0x3d, 0x46, 0x44, 0x6e, 0x06, 0x42, 0x59, 0xff, 0x71, 0x2d, 0xcb, 0xfe, 0x9f,
+	0xf8, 0xc2, 0xff, 0x06, 0x00, 0x98, 0x65, 0x45, 0xea, 0xea, 0x2d, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Queries a list of Providers items.
+	Providers(ctx context.Context, in *QueryProvidersRequest, opts ...grpc.CallOption) (*QueryProvidersResponse, error)
+	// Queries a list of Clients items.
+	Clients(ctx context.Context, in *QueryClientsRequest, opts ...grpc.CallOption) (*QueryClientsResponse, error)
+	// Queries a list of GetPairing items.
+	GetPairing(ctx context.Context, in *QueryGetPairingRequest, opts ...grpc.CallOption) (*QueryGetPairingResponse, error)
+	// Queries a list of VerifyPairing items.
+	VerifyPairing(ctx context.Context, in *QueryVerifyPairingRequest, opts ...grpc.CallOption) (*QueryVerifyPairingResponse, error)
+	// Queries a UniquePaymentStorageClientProvider by index.
+	UniquePaymentStorageClientProvider(ctx context.Context, in *QueryGetUniquePaymentStorageClientProviderRequest, opts ...grpc.CallOption) (*QueryGetUniquePaymentStorageClientProviderResponse, error)
+	// Queries a list of UniquePaymentStorageClientProvider items.
+	UniquePaymentStorageClientProviderAll(ctx context.Context, in *QueryAllUniquePaymentStorageClientProviderRequest, opts ...grpc.CallOption) (*QueryAllUniquePaymentStorageClientProviderResponse, error)
+	// Queries a ProviderPaymentStorage by index.
+	ProviderPaymentStorage(ctx context.Context, in *QueryGetProviderPaymentStorageRequest, opts ...grpc.CallOption) (*QueryGetProviderPaymentStorageResponse, error)
+	// Queries a list of ProviderPaymentStorage items.
+	ProviderPaymentStorageAll(ctx context.Context, in *QueryAllProviderPaymentStorageRequest, opts ...grpc.CallOption) (*QueryAllProviderPaymentStorageResponse, error)
+	// Queries a EpochPayments by index.
+	EpochPayments(ctx context.Context, in *QueryGetEpochPaymentsRequest, opts ...grpc.CallOption) (*QueryGetEpochPaymentsResponse, error)
+	// Queries a list of EpochPayments items.
+	EpochPaymentsAll(ctx context.Context, in *QueryAllEpochPaymentsRequest, opts ...grpc.CallOption) (*QueryAllEpochPaymentsResponse, error)
+	// Queries a UserEntry items.
+	UserEntry(ctx context.Context, in *QueryUserEntryRequest, opts ...grpc.CallOption) (*QueryUserEntryResponse, error)
+	// Queries a list of StaticProvidersList items.
+	StaticProvidersList(ctx context.Context, in *QueryStaticProvidersListRequest, opts ...grpc.CallOption) (*QueryStaticProvidersListResponse, error)
+	// Queries the pairing scores of the providers of a chain for a consumer geolocation.
+	PairingScores(ctx context.Context, in *QueryPairingScoresRequest, opts ...grpc.CallOption) (*QueryPairingScoresResponse, error)
+	// Queries the distinct consumers that reported a provider as unresponsive in the recent epochs and its unresponsiveness jail.
+	UnresponsiveReports(ctx context.Context, in *QueryUnresponsiveReportsRequest, opts ...grpc.CallOption) (*QueryUnresponsiveReportsResponse, error)
+	// Queries the pairing a client would get a number of epochs from the current one.
+	SimulatePairing(ctx context.Context, in *QuerySimulatePairingRequest, opts ...grpc.CallOption) (*QuerySimulatePairingResponse, error)
+	// Queries a ProviderMetadata by provider.
+	ProviderMetadata(ctx context.Context, in *QueryGetProviderMetadataRequest, opts ...grpc.CallOption) (*QueryGetProviderMetadataResponse, error)
+	// Queries a list of ProviderMetadata items, optionally only of providers advertising an add-on.
+	ProviderMetadataAll(ctx context.Context, in *QueryAllProviderMetadataRequest, opts ...grpc.CallOption) (*QueryAllProviderMetadataResponse, error)
+	// Queries the cu capacity the providers of a chain declared and how much of it is left in the current epoch.
+	ProvidersCapacity(ctx context.Context, in *QueryProvidersCapacityRequest, opts ...grpc.CallOption) (*QueryProvidersCapacityResponse, error)
+	// Queries the frozen providers of a chain and the block each of them is expected to return at.
+	FrozenProviders(ctx context.Context, in *QueryFrozenProvidersRequest, opts ...grpc.CallOption) (*QueryFrozenProvidersResponse, error)
+	// Queries the averages of the qos reports clients attached to the relay payments of a provider over a range of epochs.
+	ProviderPerformance(ctx context.Context, in *QueryProviderPerformanceRequest, opts ...grpc.CallOption) (*QueryProviderPerformanceResponse, error)
+	// Queries the relay payments of the stored epochs, optionally filtered by chain, provider, consumer or project over a range of epochs.
+	FilteredEpochPayments(ctx context.Context, in *QueryFilteredEpochPaymentsRequest, opts ...grpc.CallOption) (*QueryFilteredEpochPaymentsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.Query/Params", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryGetEpochPaymentsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Providers(ctx context.Context, in *QueryProvidersRequest, opts ...grpc.CallOption) (*QueryProvidersResponse, error) {
+	out := new(QueryProvidersResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.Query/Providers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryGetEpochPaymentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Index) > 0 {
-		i -= len(m.Index)
-		copy(dAtA[i:], m.Index)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Index)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) Clients(ctx context.Context, in *QueryClientsRequest, opts ...grpc.CallOption) (*QueryClientsResponse, error) {
+	out := new(QueryClientsResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.Query/Clients", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryGetEpochPaymentsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetPairing(ctx context.Context, in *QueryGetPairingRequest, opts ...grpc.CallOption) (*QueryGetPairingResponse, error) {
+	out := new(QueryGetPairingResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.Query/GetPairing", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryGetEpochPaymentsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) VerifyPairing(ctx context.Context, in *QueryVerifyPairingRequest, opts ...grpc.CallOption) (*QueryVerifyPairingResponse, error) {
+	out := new(QueryVerifyPairingResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.Query/VerifyPairing", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryGetEpochPaymentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.EpochPayments.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) UniquePaymentStorageClientProvider(ctx context.Context, in *QueryGetUniquePaymentStorageClientProviderRequest, opts ...grpc.CallOption) (*QueryGetUniquePaymentStorageClientProviderResponse, error) {
+	out := new(QueryGetUniquePaymentStorageClientProviderResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.Query/UniquePaymentStorageClientProvider", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryAllEpochPaymentsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) UniquePaymentStorageClientProviderAll(ctx context.Context, in *QueryAllUniquePaymentStorageClientProviderRequest, opts ...grpc.CallOption) (*QueryAllUniquePaymentStorageClientProviderResponse, error) {
+	out := new(QueryAllUniquePaymentStorageClientProviderResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.Query/UniquePaymentStorageClientProviderAll", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryAllEpochPaymentsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ProviderPaymentStorage(ctx context.Context, in *QueryGetProviderPaymentStorageRequest, opts ...grpc.CallOption) (*QueryGetProviderPaymentStorageResponse, error) {
+	out := new(QueryGetProviderPaymentStorageResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.Query/ProviderPaymentStorage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryAllEpochPaymentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) ProviderPaymentStorageAll(ctx context.Context, in *QueryAllProviderPaymentStorageRequest, opts ...grpc.CallOption) (*QueryAllProviderPaymentStorageResponse, error) {
+	out := new(QueryAllProviderPaymentStorageResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.Query/ProviderPaymentStorageAll", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryAllEpochPaymentsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) EpochPayments(ctx context.Context, in *QueryGetEpochPaymentsRequest, opts ...grpc.CallOption) (*QueryGetEpochPaymentsResponse, error) {
+	out := new(QueryGetEpochPaymentsResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.Query/EpochPayments", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryAllEpochPaymentsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) EpochPaymentsAll(ctx context.Context, in *QueryAllEpochPaymentsRequest, opts ...grpc.CallOption) (*QueryAllEpochPaymentsResponse, error) {
+	out := new(QueryAllEpochPaymentsResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.Query/EpochPaymentsAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryAllEpochPaymentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.EpochPayments) > 0 {
-		for iNdEx := len(m.EpochPayments) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.EpochPayments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) UserEntry(ctx context.Context, in *QueryUserEntryRequest, opts ...grpc.CallOption) (*QueryUserEntryResponse, error) {
+	out := new(QueryUserEntryResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.Query/UserEntry", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryUserEntryRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) StaticProvidersList(ctx context.Context, in *QueryStaticProvidersListRequest, opts ...grpc.CallOption) (*QueryStaticProvidersListResponse, error) {
+	out := new(QueryStaticProvidersListResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.Query/StaticProvidersList", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryUserEntryRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) PairingScores(ctx context.Context, in *QueryPairingScoresRequest, opts ...grpc.CallOption) (*QueryPairingScoresResponse, error) {
+	out := new(QueryPairingScoresResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.Query/PairingScores", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryUserEntryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Block != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Block))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.ChainID) > 0 {
-		i -= len(m.ChainID)
-		copy(dAtA[i:], m.ChainID)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainID)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) UnresponsiveReports(ctx context.Context, in *QueryUnresponsiveReportsRequest, opts ...grpc.CallOption) (*QueryUnresponsiveReportsResponse, error) {
+	out := new(QueryUnresponsiveReportsResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.Query/UnresponsiveReports", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryUserEntryResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) SimulatePairing(ctx context.Context, in *QuerySimulatePairingRequest, opts ...grpc.CallOption) (*QuerySimulatePairingResponse, error) {
+	out := new(QuerySimulatePairingResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.Query/SimulatePairing", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryUserEntryResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ProviderMetadata(ctx context.Context, in *QueryGetProviderMetadataRequest, opts ...grpc.CallOption) (*QueryGetProviderMetadataResponse, error) {
+	out := new(QueryGetProviderMetadataResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.Query/ProviderMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryUserEntryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.MaxCU != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.MaxCU))
-		i--
-		dAtA[i] = 0x10
-	}
-	{
-		size, err := m.Consumer.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) ProviderMetadataAll(ctx context.Context, in *QueryAllProviderMetadataRequest, opts ...grpc.CallOption) (*QueryAllProviderMetadataResponse, error) {
+	out := new(QueryAllProviderMetadataResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.Query/ProviderMetadataAll", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryStaticProvidersListRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ProvidersCapacity(ctx context.Context, in *QueryProvidersCapacityRequest, opts ...grpc.CallOption) (*QueryProvidersCapacityResponse, error) {
+	out := new(QueryProvidersCapacityResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.Query/ProvidersCapacity", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryStaticProvidersListRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) FrozenProviders(ctx context.Context, in *QueryFrozenProvidersRequest, opts ...grpc.CallOption) (*QueryFrozenProvidersResponse, error) {
+	out := new(QueryFrozenProvidersResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.Query/FrozenProviders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryStaticProvidersListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ChainID) > 0 {
-		i -= len(m.ChainID)
-		copy(dAtA[i:], m.ChainID)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainID)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) ProviderPerformance(ctx context.Context, in *QueryProviderPerformanceRequest, opts ...grpc.CallOption) (*QueryProviderPerformanceResponse, error) {
+	out := new(QueryProviderPerformanceResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.Query/ProviderPerformance", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryStaticProvidersListResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) FilteredEpochPayments(ctx context.Context, in *QueryFilteredEpochPaymentsRequest, opts ...grpc.CallOption) (*QueryFilteredEpochPaymentsResponse, error) {
+	out := new(QueryFilteredEpochPaymentsResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.Query/FilteredEpochPayments", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryStaticProvidersListResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Queries a list of Providers items.
+	Providers(context.Context, *QueryProvidersRequest) (*QueryProvidersResponse, error)
+	// Queries a list of Clients items.
+	Clients(context.Context, *QueryClientsRequest) (*QueryClientsResponse, error)
+	// Queries a list of GetPairing items.
+	GetPairing(context.Context, *QueryGetPairingRequest) (*QueryGetPairingResponse, error)
+	// Queries a list of VerifyPairing items.
+	VerifyPairing(context.Context, *QueryVerifyPairingRequest) (*QueryVerifyPairingResponse, error)
+	// Queries a UniquePaymentStorageClientProvider by index.
+	UniquePaymentStorageClientProvider(context.Context, *QueryGetUniquePaymentStorageClientProviderRequest) (*QueryGetUniquePaymentStorageClientProviderResponse, error)
+	// Queries a list of UniquePaymentStorageClientProvider items.
+	UniquePaymentStorageClientProviderAll(context.Context, *QueryAllUniquePaymentStorageClientProviderRequest) (*QueryAllUniquePaymentStorageClientProviderResponse, error)
+	// Queries a ProviderPaymentStorage by index.
+	ProviderPaymentStorage(context.Context, *QueryGetProviderPaymentStorageRequest) (*QueryGetProviderPaymentStorageResponse, error)
+	// Queries a list of ProviderPaymentStorage items.
+	ProviderPaymentStorageAll(context.Context, *QueryAllProviderPaymentStorageRequest) (*QueryAllProviderPaymentStorageResponse, error)
+	// Queries a EpochPayments by index.
+	EpochPayments(context.Context, *QueryGetEpochPaymentsRequest) (*QueryGetEpochPaymentsResponse, error)
+	// Queries a list of EpochPayments items.
+	EpochPaymentsAll(context.Context, *QueryAllEpochPaymentsRequest) (*QueryAllEpochPaymentsResponse, error)
+	// Queries a UserEntry items.
+	UserEntry(context.Context, *QueryUserEntryRequest) (*QueryUserEntryResponse, error)
+	// Queries a list of StaticProvidersList items.
+	StaticProvidersList(context.Context, *QueryStaticProvidersListRequest) (*QueryStaticProvidersListResponse, error)
+	// Queries the pairing scores of the providers of a chain for a consumer geolocation.
+	PairingScores(context.Context, *QueryPairingScoresRequest) (*QueryPairingScoresResponse, error)
+	// Queries the distinct consumers that reported a provider as unresponsive in the recent epochs and its unresponsiveness jail.
+	UnresponsiveReports(context.Context, *QueryUnresponsiveReportsRequest) (*QueryUnresponsiveReportsResponse, error)
+	// Queries the pairing a client would get a number of epochs from the current one.
+	SimulatePairing(context.Context, *QuerySimulatePairingRequest) (*QuerySimulatePairingResponse, error)
+	// Queries a ProviderMetadata by provider.
+	ProviderMetadata(context.Context, *QueryGetProviderMetadataRequest) (*QueryGetProviderMetadataResponse, error)
+	// Queries a list of ProviderMetadata items, optionally only of providers advertising an add-on.
+	ProviderMetadataAll(context.Context, *QueryAllProviderMetadataRequest) (*QueryAllProviderMetadataResponse, error)
+	// Queries the cu capacity the providers of a chain declared and how much of it is left in the current epoch.
+	ProvidersCapacity(context.Context, *QueryProvidersCapacityRequest) (*QueryProvidersCapacityResponse, error)
+	// Queries the frozen providers of a chain and the block each of them is expected to return at.
+	FrozenProviders(context.Context, *QueryFrozenProvidersRequest) (*QueryFrozenProvidersResponse, error)
+	// Queries the averages of the qos reports clients attached to the relay payments of a provider over a range of epochs.
+	ProviderPerformance(context.Context, *QueryProviderPerformanceRequest) (*QueryProviderPerformanceResponse, error)
+	// Queries the relay payments of the stored epochs, optionally filtered by chain, provider, consumer or project over a range of epochs.
+	FilteredEpochPayments(context.Context, *QueryFilteredEpochPaymentsRequest) (*QueryFilteredEpochPaymentsResponse, error)
 }
 
-func (m *QueryStaticProvidersListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Providers) > 0 {
-		for iNdEx := len(m.Providers) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Providers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (*UnimplementedQueryServer) Providers(ctx context.Context, req *QueryProvidersRequest) (*QueryProvidersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Providers not implemented")
+}
+func (*UnimplementedQueryServer) Clients(ctx context.Context, req *QueryClientsRequest) (*QueryClientsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Clients not implemented")
+}
+func (*UnimplementedQueryServer) GetPairing(ctx context.Context, req *QueryGetPairingRequest) (*QueryGetPairingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPairing not implemented")
+}
+func (*UnimplementedQueryServer) VerifyPairing(ctx context.Context, req *QueryVerifyPairingRequest) (*QueryVerifyPairingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyPairing not implemented")
+}
+func (*UnimplementedQueryServer) UniquePaymentStorageClientProvider(ctx context.Context, req *QueryGetUniquePaymentStorageClientProviderRequest) (*QueryGetUniquePaymentStorageClientProviderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UniquePaymentStorageClientProvider not implemented")
+}
+func (*UnimplementedQueryServer) UniquePaymentStorageClientProviderAll(ctx context.Context, req *QueryAllUniquePaymentStorageClientProviderRequest) (*QueryAllUniquePaymentStorageClientProviderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UniquePaymentStorageClientProviderAll not implemented")
+}
+func (*UnimplementedQueryServer) ProviderPaymentStorage(ctx context.Context, req *QueryGetProviderPaymentStorageRequest) (*QueryGetProviderPaymentStorageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProviderPaymentStorage not implemented")
+}
+func (*UnimplementedQueryServer) ProviderPaymentStorageAll(ctx context.Context, req *QueryAllProviderPaymentStorageRequest) (*QueryAllProviderPaymentStorageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProviderPaymentStorageAll not implemented")
+}
+func (*UnimplementedQueryServer) EpochPayments(ctx context.Context, req *QueryGetEpochPaymentsRequest) (*QueryGetEpochPaymentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EpochPayments not implemented")
+}
+func (*UnimplementedQueryServer) EpochPaymentsAll(ctx context.Context, req *QueryAllEpochPaymentsRequest) (*QueryAllEpochPaymentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EpochPaymentsAll not implemented")
+}
+func (*UnimplementedQueryServer) UserEntry(ctx context.Context, req *QueryUserEntryRequest) (*QueryUserEntryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserEntry not implemented")
+}
+func (*UnimplementedQueryServer) StaticProvidersList(ctx context.Context, req *QueryStaticProvidersListRequest) (*QueryStaticProvidersListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StaticProvidersList not implemented")
+}
+func (*UnimplementedQueryServer) PairingScores(ctx context.Context, req *QueryPairingScoresRequest) (*QueryPairingScoresResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PairingScores not implemented")
+}
+func (*UnimplementedQueryServer) UnresponsiveReports(ctx context.Context, req *QueryUnresponsiveReportsRequest) (*QueryUnresponsiveReportsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnresponsiveReports not implemented")
+}
+func (*UnimplementedQueryServer) SimulatePairing(ctx context.Context, req *QuerySimulatePairingRequest) (*QuerySimulatePairingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulatePairing not implemented")
+}
+func (*UnimplementedQueryServer) ProviderMetadata(ctx context.Context, req *QueryGetProviderMetadataRequest) (*QueryGetProviderMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProviderMetadata not implemented")
+}
+func (*UnimplementedQueryServer) ProviderMetadataAll(ctx context.Context, req *QueryAllProviderMetadataRequest) (*QueryAllProviderMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProviderMetadataAll not implemented")
+}
+func (*UnimplementedQueryServer) ProvidersCapacity(ctx context.Context, req *QueryProvidersCapacityRequest) (*QueryProvidersCapacityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProvidersCapacity not implemented")
+}
+func (*UnimplementedQueryServer) FrozenProviders(ctx context.Context, req *QueryFrozenProvidersRequest) (*QueryFrozenProvidersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FrozenProviders not implemented")
+}
+func (*UnimplementedQueryServer) ProviderPerformance(ctx context.Context, req *QueryProviderPerformanceRequest) (*QueryProviderPerformanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProviderPerformance not implemented")
+}
+func (*UnimplementedQueryServer) FilteredEpochPayments(ctx context.Context, req *QueryFilteredEpochPaymentsRequest) (*QueryFilteredEpochPaymentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FilteredEpochPayments not implemented")
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
-func (m *QueryProvidersRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.ChainID)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
 	}
-	if m.ShowFrozen {
-		n += 2
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.pairing.Query/Params",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryProvidersResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.StakeEntry) > 0 {
-		for _, e := range m.StakeEntry {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+func _Query_Providers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProvidersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.Output)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).Providers(ctx, in)
 	}
-	return n
-}
-
-func (m *QueryClientsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.pairing.Query/Providers",
 	}
-	var l int
-	_ = l
-	l = len(m.ChainID)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Providers(ctx, req.(*QueryProvidersRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryClientsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_Clients_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClientsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.StakeEntry) > 0 {
-		for _, e := range m.StakeEntry {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).Clients(ctx, in)
 	}
-	l = len(m.Output)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.pairing.Query/Clients",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Clients(ctx, req.(*QueryClientsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetPairingRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_GetPairing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetPairingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.ChainID)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).GetPairing(ctx, in)
 	}
-	l = len(m.Client)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.pairing.Query/GetPairing",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetPairing(ctx, req.(*QueryGetPairingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetPairingResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Providers) > 0 {
-		for _, e := range m.Providers {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.CurrentEpoch != 0 {
-		n += 1 + sovQuery(uint64(m.CurrentEpoch))
+func _Query_VerifyPairing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVerifyPairingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.TimeLeftToNextPairing != 0 {
-		n += 1 + sovQuery(uint64(m.TimeLeftToNextPairing))
+	if interceptor == nil {
+		return srv.(QueryServer).VerifyPairing(ctx, in)
 	}
-	if m.SpecLastUpdatedBlock != 0 {
-		n += 1 + sovQuery(uint64(m.SpecLastUpdatedBlock))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.pairing.Query/VerifyPairing",
 	}
-	if m.BlockOfNextPairing != 0 {
-		n += 1 + sovQuery(uint64(m.BlockOfNextPairing))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VerifyPairing(ctx, req.(*QueryVerifyPairingRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryVerifyPairingRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ChainID)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func _Query_UniquePaymentStorageClientProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetUniquePaymentStorageClientProviderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.Client)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).UniquePaymentStorageClientProvider(ctx, in)
 	}
-	l = len(m.Provider)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.pairing.Query/UniquePaymentStorageClientProvider",
 	}
-	if m.Block != 0 {
-		n += 1 + sovQuery(uint64(m.Block))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UniquePaymentStorageClientProvider(ctx, req.(*QueryGetUniquePaymentStorageClientProviderRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryVerifyPairingResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Valid {
-		n += 2
+func _Query_UniquePaymentStorageClientProviderAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllUniquePaymentStorageClientProviderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Index != 0 {
-		n += 1 + sovQuery(uint64(m.Index))
+	if interceptor == nil {
+		return srv.(QueryServer).UniquePaymentStorageClientProviderAll(ctx, in)
 	}
-	if m.PairedProviders != 0 {
-		n += 1 + sovQuery(uint64(m.PairedProviders))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.pairing.Query/UniquePaymentStorageClientProviderAll",
 	}
-	if m.CuPerEpoch != 0 {
-		n += 1 + sovQuery(uint64(m.CuPerEpoch))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UniquePaymentStorageClientProviderAll(ctx, req.(*QueryAllUniquePaymentStorageClientProviderRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetUniquePaymentStorageClientProviderRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_ProviderPaymentStorage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetProviderPaymentStorageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Index)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).ProviderPaymentStorage(ctx, in)
 	}
-	return n
-}
-
-func (m *QueryGetUniquePaymentStorageClientProviderResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.pairing.Query/ProviderPaymentStorage",
 	}
-	var l int
-	_ = l
-	l = m.UniquePaymentStorageClientProvider.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProviderPaymentStorage(ctx, req.(*QueryGetProviderPaymentStorageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllUniquePaymentStorageClientProviderRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_ProviderPaymentStorageAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllProviderPaymentStorageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).ProviderPaymentStorageAll(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.pairing.Query/ProviderPaymentStorageAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProviderPaymentStorageAll(ctx, req.(*QueryAllProviderPaymentStorageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllUniquePaymentStorageClientProviderResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_EpochPayments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetEpochPaymentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.UniquePaymentStorageClientProvider) > 0 {
-		for _, e := range m.UniquePaymentStorageClientProvider {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).EpochPayments(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.pairing.Query/EpochPayments",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EpochPayments(ctx, req.(*QueryGetEpochPaymentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetProviderPaymentStorageRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_EpochPaymentsAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllEpochPaymentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Index)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).EpochPaymentsAll(ctx, in)
 	}
-	return n
-}
-
-func (m *QueryGetProviderPaymentStorageResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.pairing.Query/EpochPaymentsAll",
 	}
-	var l int
-	_ = l
-	l = m.ProviderPaymentStorage.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EpochPaymentsAll(ctx, req.(*QueryAllEpochPaymentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllProviderPaymentStorageRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_UserEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUserEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).UserEntry(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.pairing.Query/UserEntry",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UserEntry(ctx, req.(*QueryUserEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllProviderPaymentStorageResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_StaticProvidersList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStaticProvidersListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.ProviderPaymentStorage) > 0 {
-		for _, e := range m.ProviderPaymentStorage {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).StaticProvidersList(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.pairing.Query/StaticProvidersList",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StaticProvidersList(ctx, req.(*QueryStaticProvidersListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetEpochPaymentsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_PairingScores_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPairingScoresRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Index)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).PairingScores(ctx, in)
 	}
-	return n
-}
-
-func (m *QueryGetEpochPaymentsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.pairing.Query/PairingScores",
 	}
-	var l int
-	_ = l
-	l = m.EpochPayments.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PairingScores(ctx, req.(*QueryPairingScoresRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllEpochPaymentsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_UnresponsiveReports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUnresponsiveReportsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).UnresponsiveReports(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.pairing.Query/UnresponsiveReports",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UnresponsiveReports(ctx, req.(*QueryUnresponsiveReportsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllEpochPaymentsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_SimulatePairing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulatePairingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.EpochPayments) > 0 {
-		for _, e := range m.EpochPayments {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulatePairing(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.pairing.Query/SimulatePairing",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulatePairing(ctx, req.(*QuerySimulatePairingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryUserEntryRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func _Query_ProviderMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetProviderMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProviderMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.pairing.Query/ProviderMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProviderMetadata(ctx, req.(*QueryGetProviderMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProviderMetadataAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllProviderMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProviderMetadataAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.pairing.Query/ProviderMetadataAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProviderMetadataAll(ctx, req.(*QueryAllProviderMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProvidersCapacity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProvidersCapacityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProvidersCapacity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.pairing.Query/ProvidersCapacity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProvidersCapacity(ctx, req.(*QueryProvidersCapacityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FrozenProviders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFrozenProvidersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FrozenProviders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.pairing.Query/FrozenProviders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FrozenProviders(ctx, req.(*QueryFrozenProvidersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProviderPerformance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProviderPerformanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProviderPerformance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.pairing.Query/ProviderPerformance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProviderPerformance(ctx, req.(*QueryProviderPerformanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FilteredEpochPayments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFilteredEpochPaymentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FilteredEpochPayments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.pairing.Query/FilteredEpochPayments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FilteredEpochPayments(ctx, req.(*QueryFilteredEpochPaymentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "lavanet.lava.pairing.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "Providers",
+			Handler:    _Query_Providers_Handler,
+		},
+		{
+			MethodName: "Clients",
+			Handler:    _Query_Clients_Handler,
+		},
+		{
+			MethodName: "GetPairing",
+			Handler:    _Query_GetPairing_Handler,
+		},
+		{
+			MethodName: "VerifyPairing",
+			Handler:    _Query_VerifyPairing_Handler,
+		},
+		{
+			MethodName: "UniquePaymentStorageClientProvider",
+			Handler:    _Query_UniquePaymentStorageClientProvider_Handler,
+		},
+		{
+			MethodName: "UniquePaymentStorageClientProviderAll",
+			Handler:    _Query_UniquePaymentStorageClientProviderAll_Handler,
+		},
+		{
+			MethodName: "ProviderPaymentStorage",
+			Handler:    _Query_ProviderPaymentStorage_Handler,
+		},
+		{
+			MethodName: "ProviderPaymentStorageAll",
+			Handler:    _Query_ProviderPaymentStorageAll_Handler,
+		},
+		{
+			MethodName: "EpochPayments",
+			Handler:    _Query_EpochPayments_Handler,
+		},
+		{
+			MethodName: "EpochPaymentsAll",
+			Handler:    _Query_EpochPaymentsAll_Handler,
+		},
+		{
+			MethodName: "UserEntry",
+			Handler:    _Query_UserEntry_Handler,
+		},
+		{
+			MethodName: "StaticProvidersList",
+			Handler:    _Query_StaticProvidersList_Handler,
+		},
+		{
+			MethodName: "PairingScores",
+			Handler:    _Query_PairingScores_Handler,
+		},
+		{
+			MethodName: "UnresponsiveReports",
+			Handler:    _Query_UnresponsiveReports_Handler,
+		},
+		{
+			MethodName: "SimulatePairing",
+			Handler:    _Query_SimulatePairing_Handler,
+		},
+		{
+			MethodName: "ProviderMetadata",
+			Handler:    _Query_ProviderMetadata_Handler,
+		},
+		{
+			MethodName: "ProviderMetadataAll",
+			Handler:    _Query_ProviderMetadataAll_Handler,
+		},
+		{
+			MethodName: "ProvidersCapacity",
+			Handler:    _Query_ProvidersCapacity_Handler,
+		},
+		{
+			MethodName: "FrozenProviders",
+			Handler:    _Query_FrozenProviders_Handler,
+		},
+		{
+			MethodName: "ProviderPerformance",
+			Handler:    _Query_ProviderPerformance_Handler,
+		},
+		{
+			MethodName: "FilteredEpochPayments",
+			Handler:    _Query_FilteredEpochPayments_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pairing/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.ChainID)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.Block != 0 {
-		n += 1 + sovQuery(uint64(m.Block))
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryUserEntryResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryProvidersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProvidersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProvidersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Consumer.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	if m.MaxCU != 0 {
-		n += 1 + sovQuery(uint64(m.MaxCU))
+	if m.ShowFrozen {
+		i--
+		if m.ShowFrozen {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProvidersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProvidersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProvidersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Output) > 0 {
+		i -= len(m.Output)
+		copy(dAtA[i:], m.Output)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Output)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.StakeEntry) > 0 {
+		for iNdEx := len(m.StakeEntry) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StakeEntry[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClientsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClientsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClientsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClientsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClientsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClientsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Output) > 0 {
+		i -= len(m.Output)
+		copy(dAtA[i:], m.Output)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Output)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.StakeEntry) > 0 {
+		for iNdEx := len(m.StakeEntry) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StakeEntry[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetPairingRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPairingRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPairingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Filters != nil {
+		{
+			size, err := m.Filters.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Client) > 0 {
+		i -= len(m.Client)
+		copy(dAtA[i:], m.Client)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Client)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PairingFilters) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PairingFilters) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PairingFilters) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Exclude) > 0 {
+		for iNdEx := len(m.Exclude) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Exclude[iNdEx])
+			copy(dAtA[i:], m.Exclude[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Exclude[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size := m.MinStake.Size()
+		i -= size
+		if _, err := m.MinStake.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Geolocation != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Geolocation))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.RequiredAddOns) > 0 {
+		for iNdEx := len(m.RequiredAddOns) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RequiredAddOns[iNdEx])
+			copy(dAtA[i:], m.RequiredAddOns[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.RequiredAddOns[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetPairingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPairingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPairingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AddOns) > 0 {
+		for iNdEx := len(m.AddOns) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AddOns[iNdEx])
+			copy(dAtA[i:], m.AddOns[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.AddOns[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.AllowedApis) > 0 {
+		for iNdEx := len(m.AllowedApis) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedApis[iNdEx])
+			copy(dAtA[i:], m.AllowedApis[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.AllowedApis[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.BlockOfNextPairing != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockOfNextPairing))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.SpecLastUpdatedBlock != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SpecLastUpdatedBlock))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TimeLeftToNextPairing != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TimeLeftToNextPairing))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.CurrentEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CurrentEpoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Providers) > 0 {
+		for iNdEx := len(m.Providers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Providers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVerifyPairingRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVerifyPairingRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVerifyPairingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Block != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Block))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Client) > 0 {
+		i -= len(m.Client)
+		copy(dAtA[i:], m.Client)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Client)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVerifyPairingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVerifyPairingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVerifyPairingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CuPerEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CuPerEpoch))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.PairedProviders != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PairedProviders))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Index != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Valid {
+		i--
+		if m.Valid {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetUniquePaymentStorageClientProviderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetUniquePaymentStorageClientProviderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetUniquePaymentStorageClientProviderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetUniquePaymentStorageClientProviderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetUniquePaymentStorageClientProviderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetUniquePaymentStorageClientProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.UniquePaymentStorageClientProvider.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllUniquePaymentStorageClientProviderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllUniquePaymentStorageClientProviderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllUniquePaymentStorageClientProviderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllUniquePaymentStorageClientProviderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllUniquePaymentStorageClientProviderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllUniquePaymentStorageClientProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.UniquePaymentStorageClientProvider) > 0 {
+		for iNdEx := len(m.UniquePaymentStorageClientProvider) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UniquePaymentStorageClientProvider[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProviderPaymentStorageRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProviderPaymentStorageRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProviderPaymentStorageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProviderPaymentStorageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProviderPaymentStorageResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProviderPaymentStorageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ProviderPaymentStorage.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllProviderPaymentStorageRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllProviderPaymentStorageRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllProviderPaymentStorageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllProviderPaymentStorageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllProviderPaymentStorageResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllProviderPaymentStorageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ProviderPaymentStorage) > 0 {
+		for iNdEx := len(m.ProviderPaymentStorage) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProviderPaymentStorage[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetEpochPaymentsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetEpochPaymentsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetEpochPaymentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetEpochPaymentsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetEpochPaymentsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetEpochPaymentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.EpochPayments.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllEpochPaymentsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllEpochPaymentsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllEpochPaymentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllEpochPaymentsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllEpochPaymentsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllEpochPaymentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.EpochPayments) > 0 {
+		for iNdEx := len(m.EpochPayments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EpochPayments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUserEntryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUserEntryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUserEntryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Block != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Block))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUserEntryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUserEntryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUserEntryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxCU != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxCU))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Consumer.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStaticProvidersListRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStaticProvidersListRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStaticProvidersListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStaticProvidersListResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStaticProvidersListResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStaticProvidersListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Providers) > 0 {
+		for iNdEx := len(m.Providers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Providers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPairingScoresRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPairingScoresRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPairingScoresRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Geolocation != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Geolocation))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPairingScoresResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPairingScoresResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPairingScoresResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Scores) > 0 {
+		for iNdEx := len(m.Scores) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Scores[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProviderPairingScore) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProviderPairingScore) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProviderPairingScore) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Score.Size()
+		i -= size
+		if _, err := m.Score.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.GeolocationScore.Size()
+		i -= size
+		if _, err := m.GeolocationScore.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.GeolocationMatch {
+		i--
+		if m.GeolocationMatch {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Provider.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUnresponsiveReportsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnresponsiveReportsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnresponsiveReportsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUnresponsiveReportsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnresponsiveReportsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnresponsiveReportsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.JailEndBlock != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.JailEndBlock))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Reports) > 0 {
+		for iNdEx := len(m.Reports) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Reports[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UnresponsiveReportCount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnresponsiveReportCount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnresponsiveReportCount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Reporters != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Reporters))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulatePairingRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulatePairingRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulatePairingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EpochOffset != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochOffset))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Client) > 0 {
+		i -= len(m.Client)
+		copy(dAtA[i:], m.Client)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Client)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulatePairingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulatePairingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulatePairingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Exact {
+		i--
+		if m.Exact {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Providers) > 0 {
+		for iNdEx := len(m.Providers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Providers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProviderMetadataRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProviderMetadataRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProviderMetadataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProviderMetadataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProviderMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProviderMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ProviderMetadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllProviderMetadataRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllProviderMetadataRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllProviderMetadataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Addon) > 0 {
+		i -= len(m.Addon)
+		copy(dAtA[i:], m.Addon)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Addon)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllProviderMetadataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllProviderMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllProviderMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ProviderMetadata) > 0 {
+		for iNdEx := len(m.ProviderMetadata) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProviderMetadata[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProvidersCapacityRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProvidersCapacityRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProvidersCapacityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProvidersCapacityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProvidersCapacityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProvidersCapacityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProvidersWithoutCapacity != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProvidersWithoutCapacity))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ProvidersWithCapacity != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProvidersWithCapacity))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.RemainingCapacity != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RemainingCapacity))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.UsedCu != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.UsedCu))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TotalCapacity != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalCapacity))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFrozenProvidersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFrozenProvidersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFrozenProvidersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFrozenProvidersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFrozenProvidersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFrozenProvidersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FrozenProviders) > 0 {
+		for iNdEx := len(m.FrozenProviders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FrozenProviders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProviderPerformanceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProviderPerformanceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProviderPerformanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ToEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ToEpoch))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.FromEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FromEpoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProviderPerformanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProviderPerformanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProviderPerformanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Epochs) > 0 {
+		for iNdEx := len(m.Epochs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Epochs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	{
+		size := m.Sync.Size()
+		i -= size
+		if _, err := m.Sync.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Availability.Size()
+		i -= size
+		if _, err := m.Availability.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Latency.Size()
+		i -= size
+		if _, err := m.Latency.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Reports != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Reports))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFilteredEpochPaymentsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFilteredEpochPaymentsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFilteredEpochPaymentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.ToEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ToEpoch))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.FromEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FromEpoch))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Project) > 0 {
+		i -= len(m.Project)
+		copy(dAtA[i:], m.Project)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Project)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Consumer) > 0 {
+		i -= len(m.Consumer)
+		copy(dAtA[i:], m.Consumer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Consumer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConsumerEpochPayment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerEpochPayment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerEpochPayment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sessions != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Sessions))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Cu != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Cu))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Project) > 0 {
+		i -= len(m.Project)
+		copy(dAtA[i:], m.Project)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Project)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Consumer) > 0 {
+		i -= len(m.Consumer)
+		copy(dAtA[i:], m.Consumer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Consumer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProviderEpochPayment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProviderEpochPayment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProviderEpochPayment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsumerPayments) > 0 {
+		for iNdEx := len(m.ConsumerPayments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConsumerPayments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.ComplainersTotalCu != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ComplainersTotalCu))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Cu != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Cu))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFilteredEpochPaymentsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFilteredEpochPaymentsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFilteredEpochPaymentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.TotalCu != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalCu))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Payments) > 0 {
+		for iNdEx := len(m.Payments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Payments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryProvidersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ShowFrozen {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryProvidersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.StakeEntry) > 0 {
+		for _, e := range m.StakeEntry {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.Output)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryClientsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryClientsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.StakeEntry) > 0 {
+		for _, e := range m.StakeEntry {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.Output)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetPairingRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Client)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Filters != nil {
+		l = m.Filters.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *PairingFilters) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RequiredAddOns) > 0 {
+		for _, s := range m.RequiredAddOns {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Geolocation != 0 {
+		n += 1 + sovQuery(uint64(m.Geolocation))
+	}
+	l = m.MinStake.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Exclude) > 0 {
+		for _, s := range m.Exclude {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGetPairingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Providers) > 0 {
+		for _, e := range m.Providers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.CurrentEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.CurrentEpoch))
+	}
+	if m.TimeLeftToNextPairing != 0 {
+		n += 1 + sovQuery(uint64(m.TimeLeftToNextPairing))
+	}
+	if m.SpecLastUpdatedBlock != 0 {
+		n += 1 + sovQuery(uint64(m.SpecLastUpdatedBlock))
+	}
+	if m.BlockOfNextPairing != 0 {
+		n += 1 + sovQuery(uint64(m.BlockOfNextPairing))
+	}
+	if len(m.AllowedApis) > 0 {
+		for _, s := range m.AllowedApis {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.AddOns) > 0 {
+		for _, s := range m.AddOns {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryVerifyPairingRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Client)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Block != 0 {
+		n += 1 + sovQuery(uint64(m.Block))
+	}
+	return n
+}
+
+func (m *QueryVerifyPairingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Valid {
+		n += 2
+	}
+	if m.Index != 0 {
+		n += 1 + sovQuery(uint64(m.Index))
+	}
+	if m.PairedProviders != 0 {
+		n += 1 + sovQuery(uint64(m.PairedProviders))
+	}
+	if m.CuPerEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.CuPerEpoch))
+	}
+	return n
+}
+
+func (m *QueryGetUniquePaymentStorageClientProviderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetUniquePaymentStorageClientProviderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.UniquePaymentStorageClientProvider.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllUniquePaymentStorageClientProviderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllUniquePaymentStorageClientProviderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.UniquePaymentStorageClientProvider) > 0 {
+		for _, e := range m.UniquePaymentStorageClientProvider {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetProviderPaymentStorageRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetProviderPaymentStorageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ProviderPaymentStorage.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllProviderPaymentStorageRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllProviderPaymentStorageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ProviderPaymentStorage) > 0 {
+		for _, e := range m.ProviderPaymentStorage {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetEpochPaymentsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetEpochPaymentsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.EpochPayments.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllEpochPaymentsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllEpochPaymentsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.EpochPayments) > 0 {
+		for _, e := range m.EpochPayments {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUserEntryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Block != 0 {
+		n += 1 + sovQuery(uint64(m.Block))
+	}
+	return n
+}
+
+func (m *QueryUserEntryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Consumer.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.MaxCU != 0 {
+		n += 1 + sovQuery(uint64(m.MaxCU))
+	}
+	return n
+}
+
+func (m *QueryStaticProvidersListRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStaticProvidersListResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Providers) > 0 {
+		for _, e := range m.Providers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPairingScoresRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Geolocation != 0 {
+		n += 1 + sovQuery(uint64(m.Geolocation))
+	}
+	return n
+}
+
+func (m *QueryPairingScoresResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Scores) > 0 {
+		for _, e := range m.Scores {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ProviderPairingScore) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Provider.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.GeolocationMatch {
+		n += 2
+	}
+	l = m.GeolocationScore.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Score.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryUnresponsiveReportsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUnresponsiveReportsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Reports) > 0 {
+		for _, e := range m.Reports {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.JailEndBlock != 0 {
+		n += 1 + sovQuery(uint64(m.JailEndBlock))
+	}
+	return n
+}
+
+func (m *UnresponsiveReportCount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovQuery(uint64(m.Epoch))
+	}
+	if m.Reporters != 0 {
+		n += 1 + sovQuery(uint64(m.Reporters))
+	}
+	return n
+}
+
+func (m *QuerySimulatePairingRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Client)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.EpochOffset != 0 {
+		n += 1 + sovQuery(uint64(m.EpochOffset))
+	}
+	return n
+}
+
+func (m *QuerySimulatePairingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Providers) > 0 {
+		for _, e := range m.Providers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovQuery(uint64(m.Epoch))
+	}
+	if m.Exact {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryGetProviderMetadataRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetProviderMetadataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ProviderMetadata.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllProviderMetadataRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Addon)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllProviderMetadataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ProviderMetadata) > 0 {
+		for _, e := range m.ProviderMetadata {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProvidersCapacityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProvidersCapacityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovQuery(uint64(m.Epoch))
+	}
+	if m.TotalCapacity != 0 {
+		n += 1 + sovQuery(uint64(m.TotalCapacity))
+	}
+	if m.UsedCu != 0 {
+		n += 1 + sovQuery(uint64(m.UsedCu))
+	}
+	if m.RemainingCapacity != 0 {
+		n += 1 + sovQuery(uint64(m.RemainingCapacity))
+	}
+	if m.ProvidersWithCapacity != 0 {
+		n += 1 + sovQuery(uint64(m.ProvidersWithCapacity))
+	}
+	if m.ProvidersWithoutCapacity != 0 {
+		n += 1 + sovQuery(uint64(m.ProvidersWithoutCapacity))
+	}
+	return n
+}
+
+func (m *QueryFrozenProvidersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFrozenProvidersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FrozenProviders) > 0 {
+		for _, e := range m.FrozenProviders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryProviderPerformanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.FromEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.FromEpoch))
+	}
+	if m.ToEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.ToEpoch))
+	}
+	return n
+}
+
+func (m *QueryProviderPerformanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Reports != 0 {
+		n += 1 + sovQuery(uint64(m.Reports))
+	}
+	l = m.Latency.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Availability.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Sync.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Epochs) > 0 {
+		for _, e := range m.Epochs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryFilteredEpochPaymentsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Consumer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Project)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.FromEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.FromEpoch))
+	}
+	if m.ToEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.ToEpoch))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ConsumerEpochPayment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Consumer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Project)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Cu != 0 {
+		n += 1 + sovQuery(uint64(m.Cu))
+	}
+	if m.Sessions != 0 {
+		n += 1 + sovQuery(uint64(m.Sessions))
+	}
+	return n
+}
+
+func (m *ProviderEpochPayment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovQuery(uint64(m.Epoch))
+	}
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Cu != 0 {
+		n += 1 + sovQuery(uint64(m.Cu))
+	}
+	if m.ComplainersTotalCu != 0 {
+		n += 1 + sovQuery(uint64(m.ComplainersTotalCu))
+	}
+	if len(m.ConsumerPayments) > 0 {
+		for _, e := range m.ConsumerPayments {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryFilteredEpochPaymentsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Payments) > 0 {
+		for _, e := range m.Payments {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.TotalCu != 0 {
+		n += 1 + sovQuery(uint64(m.TotalCu))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProvidersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProvidersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProvidersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShowFrozen", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ShowFrozen = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProvidersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProvidersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProvidersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakeEntry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakeEntry = append(m.StakeEntry, types.StakeEntry{})
+			if err := m.StakeEntry[len(m.StakeEntry)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Output", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Output = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClientsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClientsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClientsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClientsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClientsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClientsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakeEntry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakeEntry = append(m.StakeEntry, types.StakeEntry{})
+			if err := m.StakeEntry[len(m.StakeEntry)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Output", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Output = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetPairingRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetPairingRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetPairingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Client", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Client = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Filters == nil {
+				m.Filters = &PairingFilters{}
+			}
+			if err := m.Filters.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PairingFilters) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PairingFilters: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PairingFilters: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredAddOns", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequiredAddOns = append(m.RequiredAddOns, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Geolocation", wireType)
+			}
+			m.Geolocation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Geolocation |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinStake", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinStake.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exclude", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Exclude = append(m.Exclude, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetPairingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetPairingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetPairingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Providers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Providers = append(m.Providers, types.StakeEntry{})
+			if err := m.Providers[len(m.Providers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentEpoch", wireType)
+			}
+			m.CurrentEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeLeftToNextPairing", wireType)
+			}
+			m.TimeLeftToNextPairing = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeLeftToNextPairing |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecLastUpdatedBlock", wireType)
+			}
+			m.SpecLastUpdatedBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpecLastUpdatedBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockOfNextPairing", wireType)
+			}
+			m.BlockOfNextPairing = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockOfNextPairing |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedApis", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedApis = append(m.AllowedApis, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddOns", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddOns = append(m.AddOns, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVerifyPairingRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVerifyPairingRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVerifyPairingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Client", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Client = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+			}
+			m.Block = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Block |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVerifyPairingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVerifyPairingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVerifyPairingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Valid", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Valid = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairedProviders", wireType)
+			}
+			m.PairedProviders = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PairedProviders |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CuPerEpoch", wireType)
+			}
+			m.CuPerEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CuPerEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetUniquePaymentStorageClientProviderRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetUniquePaymentStorageClientProviderRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetUniquePaymentStorageClientProviderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetUniquePaymentStorageClientProviderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetUniquePaymentStorageClientProviderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetUniquePaymentStorageClientProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UniquePaymentStorageClientProvider", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UniquePaymentStorageClientProvider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllUniquePaymentStorageClientProviderRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllUniquePaymentStorageClientProviderRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllUniquePaymentStorageClientProviderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllUniquePaymentStorageClientProviderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllUniquePaymentStorageClientProviderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllUniquePaymentStorageClientProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UniquePaymentStorageClientProvider", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UniquePaymentStorageClientProvider = append(m.UniquePaymentStorageClientProvider, UniquePaymentStorageClientProvider{})
+			if err := m.UniquePaymentStorageClientProvider[len(m.UniquePaymentStorageClientProvider)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetProviderPaymentStorageRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetProviderPaymentStorageRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetProviderPaymentStorageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetProviderPaymentStorageResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetProviderPaymentStorageResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetProviderPaymentStorageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderPaymentStorage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProviderPaymentStorage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllProviderPaymentStorageRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllProviderPaymentStorageRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllProviderPaymentStorageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllProviderPaymentStorageResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllProviderPaymentStorageResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllProviderPaymentStorageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderPaymentStorage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderPaymentStorage = append(m.ProviderPaymentStorage, ProviderPaymentStorage{})
+			if err := m.ProviderPaymentStorage[len(m.ProviderPaymentStorage)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetEpochPaymentsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetEpochPaymentsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetEpochPaymentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetEpochPaymentsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetEpochPaymentsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetEpochPaymentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochPayments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EpochPayments.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllEpochPaymentsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllEpochPaymentsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllEpochPaymentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllEpochPaymentsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllEpochPaymentsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllEpochPaymentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochPayments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochPayments = append(m.EpochPayments, EpochPayments{})
+			if err := m.EpochPayments[len(m.EpochPayments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUserEntryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUserEntryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUserEntryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+			}
+			m.Block = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Block |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUserEntryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUserEntryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUserEntryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Consumer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Consumer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCU", wireType)
+			}
+			m.MaxCU = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxCU |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStaticProvidersListRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStaticProvidersListRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStaticProvidersListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *QueryStaticProvidersListRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ChainID)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *QueryStaticProvidersListResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Providers) > 0 {
-		for _, e := range m.Providers {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QueryStaticProvidersListResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStaticProvidersListResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStaticProvidersListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Providers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Providers = append(m.Providers, types.StakeEntry{})
+			if err := m.Providers[len(m.Providers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPairingScoresRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3456,12 +9710,63 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPairingScoresRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPairingScoresRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Geolocation", wireType)
+			}
+			m.Geolocation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Geolocation |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3483,7 +9788,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPairingScoresResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3506,15 +9811,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPairingScoresResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPairingScoresResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Scores", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3541,7 +9846,8 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Scores = append(m.Scores, ProviderPairingScore{})
+			if err := m.Scores[len(m.Scores)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3566,7 +9872,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryProvidersRequest) Unmarshal(dAtA []byte) error {
+func (m *ProviderPairingScore) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3589,15 +9895,68 @@ func (m *QueryProvidersRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProvidersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProviderPairingScore: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProvidersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProviderPairingScore: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Provider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GeolocationMatch", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.GeolocationMatch = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GeolocationScore", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3625,13 +9984,15 @@ func (m *QueryProvidersRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainID = string(dAtA[iNdEx:postIndex])
+			if err := m.GeolocationScore.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShowFrozen", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3641,12 +10002,26 @@ func (m *QueryProvidersRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ShowFrozen = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Score.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3668,7 +10043,7 @@ func (m *QueryProvidersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryProvidersResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryUnresponsiveReportsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3691,17 +10066,17 @@ func (m *QueryProvidersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProvidersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryUnresponsiveReportsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProvidersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryUnresponsiveReportsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakeEntry", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3711,29 +10086,27 @@ func (m *QueryProvidersResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StakeEntry = append(m.StakeEntry, types.StakeEntry{})
-			if err := m.StakeEntry[len(m.StakeEntry)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Output", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3761,7 +10134,7 @@ func (m *QueryProvidersResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Output = string(dAtA[iNdEx:postIndex])
+			m.Provider = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3784,7 +10157,7 @@ func (m *QueryProvidersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryClientsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryUnresponsiveReportsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3807,17 +10180,17 @@ func (m *QueryClientsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryClientsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryUnresponsiveReportsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryClientsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryUnresponsiveReportsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reports", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3827,24 +10200,45 @@ func (m *QueryClientsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainID = string(dAtA[iNdEx:postIndex])
+			m.Reports = append(m.Reports, UnresponsiveReportCount{})
+			if err := m.Reports[len(m.Reports)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailEndBlock", wireType)
+			}
+			m.JailEndBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JailEndBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3866,7 +10260,7 @@ func (m *QueryClientsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryClientsResponse) Unmarshal(dAtA []byte) error {
+func (m *UnresponsiveReportCount) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3889,17 +10283,17 @@ func (m *QueryClientsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryClientsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: UnresponsiveReportCount: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryClientsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UnresponsiveReportCount: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakeEntry", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
 			}
-			var msglen int
+			m.Epoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3909,31 +10303,16 @@ func (m *QueryClientsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Epoch |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.StakeEntry = append(m.StakeEntry, types.StakeEntry{})
-			if err := m.StakeEntry[len(m.StakeEntry)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Output", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reporters", wireType)
 			}
-			var stringLen uint64
+			m.Reporters = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3943,24 +10322,11 @@ func (m *QueryClientsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Reporters |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Output = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3982,7 +10348,7 @@ func (m *QueryClientsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetPairingRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySimulatePairingRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4005,10 +10371,10 @@ func (m *QueryGetPairingRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetPairingRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySimulatePairingRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetPairingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySimulatePairingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4075,6 +10441,25 @@ func (m *QueryGetPairingRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Client = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochOffset", wireType)
+			}
+			m.EpochOffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochOffset |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4096,7 +10481,7 @@ func (m *QueryGetPairingRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetPairingResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySimulatePairingResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4119,10 +10504,10 @@ func (m *QueryGetPairingResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetPairingResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySimulatePairingResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetPairingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySimulatePairingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4161,9 +10546,9 @@ func (m *QueryGetPairingResponse) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentEpoch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
 			}
-			m.CurrentEpoch = 0
+			m.Epoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4173,16 +10558,16 @@ func (m *QueryGetPairingResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CurrentEpoch |= uint64(b&0x7F) << shift
+				m.Epoch |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimeLeftToNextPairing", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Exact", wireType)
 			}
-			m.TimeLeftToNextPairing = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4192,16 +10577,67 @@ func (m *QueryGetPairingResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TimeLeftToNextPairing |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpecLastUpdatedBlock", wireType)
+			m.Exact = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.SpecLastUpdatedBlock = 0
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetProviderMetadataRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetProviderMetadataRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetProviderMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4211,16 +10647,79 @@ func (m *QueryGetPairingResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SpecLastUpdatedBlock |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockOfNextPairing", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			m.BlockOfNextPairing = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetProviderMetadataResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetProviderMetadataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetProviderMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderMetadata", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4230,11 +10729,25 @@ func (m *QueryGetPairingResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockOfNextPairing |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProviderMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4256,7 +10769,7 @@ func (m *QueryGetPairingResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVerifyPairingRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllProviderMetadataRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4279,15 +10792,15 @@ func (m *QueryVerifyPairingRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVerifyPairingRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllProviderMetadataRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVerifyPairingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllProviderMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Addon", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4315,13 +10828,13 @@ func (m *QueryVerifyPairingRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainID = string(dAtA[iNdEx:postIndex])
+			m.Addon = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Client", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4331,75 +10844,28 @@ func (m *QueryVerifyPairingRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Client = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Provider = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
-			}
-			m.Block = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Block |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4421,7 +10887,7 @@ func (m *QueryVerifyPairingRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVerifyPairingResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllProviderMetadataResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4444,17 +10910,17 @@ func (m *QueryVerifyPairingResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVerifyPairingResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllProviderMetadataResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVerifyPairingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllProviderMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Valid", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderMetadata", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4464,17 +10930,31 @@ func (m *QueryVerifyPairingResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Valid = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderMetadata = append(m.ProviderMetadata, ProviderMetadata{})
+			if err := m.ProviderMetadata[len(m.ProviderMetadata)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			m.Index = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4484,49 +10964,28 @@ func (m *QueryVerifyPairingResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Index |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PairedProviders", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			m.PairedProviders = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PairedProviders |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CuPerEpoch", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.CuPerEpoch = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CuPerEpoch |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4548,7 +11007,7 @@ func (m *QueryVerifyPairingResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetUniquePaymentStorageClientProviderRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryProvidersCapacityRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4571,15 +11030,15 @@ func (m *QueryGetUniquePaymentStorageClientProviderRequest) Unmarshal(dAtA []byt
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetUniquePaymentStorageClientProviderRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProvidersCapacityRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetUniquePaymentStorageClientProviderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProvidersCapacityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4607,7 +11066,7 @@ func (m *QueryGetUniquePaymentStorageClientProviderRequest) Unmarshal(dAtA []byt
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Index = string(dAtA[iNdEx:postIndex])
+			m.ChainID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4630,7 +11089,7 @@ func (m *QueryGetUniquePaymentStorageClientProviderRequest) Unmarshal(dAtA []byt
 	}
 	return nil
 }
-func (m *QueryGetUniquePaymentStorageClientProviderResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryProvidersCapacityResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4653,17 +11112,17 @@ func (m *QueryGetUniquePaymentStorageClientProviderResponse) Unmarshal(dAtA []by
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetUniquePaymentStorageClientProviderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProvidersCapacityResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetUniquePaymentStorageClientProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProvidersCapacityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UniquePaymentStorageClientProvider", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
 			}
-			var msglen int
+			m.Epoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4673,25 +11132,106 @@ func (m *QueryGetUniquePaymentStorageClientProviderResponse) Unmarshal(dAtA []by
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Epoch |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalCapacity", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			m.TotalCapacity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalCapacity |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsedCu", wireType)
 			}
-			if err := m.UniquePaymentStorageClientProvider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.UsedCu = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UsedCu |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingCapacity", wireType)
+			}
+			m.RemainingCapacity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RemainingCapacity |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProvidersWithCapacity", wireType)
+			}
+			m.ProvidersWithCapacity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProvidersWithCapacity |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProvidersWithoutCapacity", wireType)
+			}
+			m.ProvidersWithoutCapacity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProvidersWithoutCapacity |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4713,7 +11253,7 @@ func (m *QueryGetUniquePaymentStorageClientProviderResponse) Unmarshal(dAtA []by
 	}
 	return nil
 }
-func (m *QueryAllUniquePaymentStorageClientProviderRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFrozenProvidersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4736,17 +11276,17 @@ func (m *QueryAllUniquePaymentStorageClientProviderRequest) Unmarshal(dAtA []byt
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllUniquePaymentStorageClientProviderRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFrozenProvidersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllUniquePaymentStorageClientProviderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFrozenProvidersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4756,27 +11296,23 @@ func (m *QueryAllUniquePaymentStorageClientProviderRequest) Unmarshal(dAtA []byt
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4799,7 +11335,7 @@ func (m *QueryAllUniquePaymentStorageClientProviderRequest) Unmarshal(dAtA []byt
 	}
 	return nil
 }
-func (m *QueryAllUniquePaymentStorageClientProviderResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFrozenProvidersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4822,49 +11358,15 @@ func (m *QueryAllUniquePaymentStorageClientProviderResponse) Unmarshal(dAtA []by
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllUniquePaymentStorageClientProviderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFrozenProvidersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllUniquePaymentStorageClientProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFrozenProvidersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UniquePaymentStorageClientProvider", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.UniquePaymentStorageClientProvider = append(m.UniquePaymentStorageClientProvider, UniquePaymentStorageClientProvider{})
-			if err := m.UniquePaymentStorageClientProvider[len(m.UniquePaymentStorageClientProvider)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FrozenProviders", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4891,10 +11393,8 @@ func (m *QueryAllUniquePaymentStorageClientProviderResponse) Unmarshal(dAtA []by
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.FrozenProviders = append(m.FrozenProviders, ProviderFreeze{})
+			if err := m.FrozenProviders[len(m.FrozenProviders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4919,7 +11419,7 @@ func (m *QueryAllUniquePaymentStorageClientProviderResponse) Unmarshal(dAtA []by
 	}
 	return nil
 }
-func (m *QueryGetProviderPaymentStorageRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryProviderPerformanceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4942,15 +11442,15 @@ func (m *QueryGetProviderPaymentStorageRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProviderPaymentStorageRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProviderPerformanceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProviderPaymentStorageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProviderPerformanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4978,63 +11478,13 @@ func (m *QueryGetProviderPaymentStorageRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Index = string(dAtA[iNdEx:postIndex])
+			m.ChainID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryGetProviderPaymentStorageResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProviderPaymentStorageResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProviderPaymentStorageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProviderPaymentStorage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5044,25 +11494,62 @@ func (m *QueryGetProviderPaymentStorageResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ProviderPaymentStorage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromEpoch", wireType)
+			}
+			m.FromEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToEpoch", wireType)
+			}
+			m.ToEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5084,7 +11571,7 @@ func (m *QueryGetProviderPaymentStorageResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllProviderPaymentStorageRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryProviderPerformanceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5107,17 +11594,36 @@ func (m *QueryAllProviderPaymentStorageRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllProviderPaymentStorageRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProviderPerformanceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllProviderPaymentStorageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProviderPerformanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reports", wireType)
+			}
+			m.Reports = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reports |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Latency", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5127,83 +11633,65 @@ func (m *QueryAllProviderPaymentStorageRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Latency.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Availability", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryAllProviderPaymentStorageResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Availability.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllProviderPaymentStorageResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllProviderPaymentStorageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProviderPaymentStorage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sync", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5213,29 +11701,29 @@ func (m *QueryAllProviderPaymentStorageResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProviderPaymentStorage = append(m.ProviderPaymentStorage, ProviderPaymentStorage{})
-			if err := m.ProviderPaymentStorage[len(m.ProviderPaymentStorage)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Sync.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Epochs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5262,10 +11750,8 @@ func (m *QueryAllProviderPaymentStorageResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Epochs = append(m.Epochs, ProviderQoSReports{})
+			if err := m.Epochs[len(m.Epochs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5290,7 +11776,7 @@ func (m *QueryAllProviderPaymentStorageResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetEpochPaymentsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFilteredEpochPaymentsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5313,15 +11799,15 @@ func (m *QueryGetEpochPaymentsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetEpochPaymentsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFilteredEpochPaymentsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetEpochPaymentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFilteredEpochPaymentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5349,63 +11835,13 @@ func (m *QueryGetEpochPaymentsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Index = string(dAtA[iNdEx:postIndex])
+			m.ChainID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryGetEpochPaymentsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetEpochPaymentsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetEpochPaymentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochPayments", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5415,80 +11851,29 @@ func (m *QueryGetEpochPaymentsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.EpochPayments.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryAllEpochPaymentsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllEpochPaymentsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllEpochPaymentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Consumer", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5498,83 +11883,61 @@ func (m *QueryAllEpochPaymentsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Consumer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryAllEpochPaymentsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllEpochPaymentsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllEpochPaymentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochPayments", wireType)
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			var msglen int
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Project = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromEpoch", wireType)
+			}
+			m.FromEpoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5584,27 +11947,31 @@ func (m *QueryAllEpochPaymentsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.FromEpoch |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToEpoch", wireType)
 			}
-			m.EpochPayments = append(m.EpochPayments, EpochPayments{})
-			if err := m.EpochPayments[len(m.EpochPayments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ToEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -5634,7 +12001,7 @@ func (m *QueryAllEpochPaymentsResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+				m.Pagination = &query.PageRequest{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -5661,7 +12028,7 @@ func (m *QueryAllEpochPaymentsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryUserEntryRequest) Unmarshal(dAtA []byte) error {
+func (m *ConsumerEpochPayment) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5684,15 +12051,15 @@ func (m *QueryUserEntryRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryUserEntryRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConsumerEpochPayment: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryUserEntryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConsumerEpochPayment: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Consumer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5720,11 +12087,11 @@ func (m *QueryUserEntryRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Consumer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5752,13 +12119,13 @@ func (m *QueryUserEntryRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainID = string(dAtA[iNdEx:postIndex])
+			m.Project = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cu", wireType)
 			}
-			m.Block = 0
+			m.Cu = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5768,7 +12135,26 @@ func (m *QueryUserEntryRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Block |= uint64(b&0x7F) << shift
+				m.Cu |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sessions", wireType)
+			}
+			m.Sessions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sessions |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -5794,7 +12180,7 @@ func (m *QueryUserEntryRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryUserEntryResponse) Unmarshal(dAtA []byte) error {
+func (m *ProviderEpochPayment) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5817,17 +12203,17 @@ func (m *QueryUserEntryResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryUserEntryResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProviderEpochPayment: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryUserEntryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProviderEpochPayment: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Consumer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5837,30 +12223,29 @@ func (m *QueryUserEntryResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Consumer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxCU", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
 			}
-			m.MaxCU = 0
+			m.Epoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5870,66 +12255,86 @@ func (m *QueryUserEntryResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxCU |= uint64(b&0x7F) << shift
+				m.Epoch |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryStaticProvidersListRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cu", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.Cu = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Cu |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ComplainersTotalCu", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStaticProvidersListRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStaticProvidersListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.ComplainersTotalCu = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ComplainersTotalCu |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerPayments", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5939,23 +12344,25 @@ func (m *QueryStaticProvidersListRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainID = string(dAtA[iNdEx:postIndex])
+			m.ConsumerPayments = append(m.ConsumerPayments, ConsumerEpochPayment{})
+			if err := m.ConsumerPayments[len(m.ConsumerPayments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5978,7 +12385,7 @@ func (m *QueryStaticProvidersListRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStaticProvidersListResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFilteredEpochPaymentsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6001,15 +12408,15 @@ func (m *QueryStaticProvidersListResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStaticProvidersListResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFilteredEpochPaymentsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStaticProvidersListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFilteredEpochPaymentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Providers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Payments", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6036,8 +12443,63 @@ func (m *QueryStaticProvidersListResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Providers = append(m.Providers, types.StakeEntry{})
-			if err := m.Providers[len(m.Providers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Payments = append(m.Payments, ProviderEpochPayment{})
+			if err := m.Payments[len(m.Payments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalCu", wireType)
+			}
+			m.TotalCu = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalCu |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -13,7 +13,6 @@ import (
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
-	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -43,6 +42,8 @@ type RelaySession struct {
 	LavaChainId           string                  `protobuf:"bytes,10,opt,name=lava_chain_id,json=lavaChainId,proto3" json:"lava_chain_id,omitempty"`
 	Sig                   []byte                  `protobuf:"bytes,11,opt,name=sig,proto3" json:"sig,omitempty"`
 	Badge                 *Badge                  `protobuf:"bytes,12,opt,name=badge,proto3" json:"badge,omitempty"`
+	ApiName               string                  `protobuf:"bytes,13,opt,name=api_name,json=apiName,proto3" json:"api_name,omitempty"`
+	ProtocolVersion       uint32                  `protobuf:"varint,14,opt,name=protocol_version,json=protocolVersion,proto3" json:"protocol_version,omitempty"`
 }
 
 func (m *RelaySession) Reset()         { *m = RelaySession{} }
@@ -162,6 +163,20 @@ func (m *RelaySession) GetBadge() *Badge {
 	return nil
 }
 
+func (m *RelaySession) GetApiName() string {
+	if m != nil {
+		return m.ApiName
+	}
+	return ""
+}
+
+func (m *RelaySession) GetProtocolVersion() uint32 {
+	if m != nil {
+		return m.ProtocolVersion
+	}
+	return 0
+}
+
 type RelayPrivateData struct {
 	ConnectionType string `protobuf:"bytes,1,opt,name=connection_type,json=connectionType,proto3" json:"connection_type,omitempty"`
 	ApiUrl         string `protobuf:"bytes,2,opt,name=api_url,json=apiUrl,proto3" json:"api_url,omitempty"`
@@ -250,6 +265,7 @@ type RelayRequest struct {
 	RelaySession    *RelaySession     `protobuf:"bytes,1,opt,name=relay_session,json=relaySession,proto3" json:"relay_session,omitempty"`
 	RelayData       *RelayPrivateData `protobuf:"bytes,2,opt,name=relay_data,json=relayData,proto3" json:"relay_data,omitempty"`
 	DataReliability *VRFData          `protobuf:"bytes,3,opt,name=data_reliability,json=dataReliability,proto3" json:"data_reliability,omitempty"`
+	ProtocolVersion uint32            `protobuf:"varint,4,opt,name=protocol_version,json=protocolVersion,proto3" json:"protocol_version,omitempty"`
 }
 
 func (m *RelayRequest) Reset()         { *m = RelayRequest{} }
@@ -306,6 +322,150 @@ func (m *RelayRequest) GetDataReliability() *VRFData {
 	return nil
 }
 
+func (m *RelayRequest) GetProtocolVersion() uint32 {
+	if m != nil {
+		return m.ProtocolVersion
+	}
+	return 0
+}
+
+// wire compatible with google.protobuf.UInt64Value, which peers from before versions were exchanged send
+type ProbeRequest struct {
+	Guid            uint64 `protobuf:"varint,1,opt,name=guid,proto3" json:"guid,omitempty"`
+	ProtocolVersion uint32 `protobuf:"varint,2,opt,name=protocol_version,json=protocolVersion,proto3" json:"protocol_version,omitempty"`
+	SpecId          string `protobuf:"bytes,3,opt,name=spec_id,json=specId,proto3" json:"spec_id,omitempty"`
+	ApiInterface    string `protobuf:"bytes,4,opt,name=api_interface,json=apiInterface,proto3" json:"api_interface,omitempty"`
+}
+
+func (m *ProbeRequest) Reset()         { *m = ProbeRequest{} }
+func (m *ProbeRequest) String() string { return proto.CompactTextString(m) }
+func (*ProbeRequest) ProtoMessage()    {}
+func (*ProbeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10cd1bfeb9978acf, []int{3}
+}
+func (m *ProbeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProbeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProbeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProbeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProbeRequest.Merge(m, src)
+}
+func (m *ProbeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProbeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProbeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProbeRequest proto.InternalMessageInfo
+
+func (m *ProbeRequest) GetGuid() uint64 {
+	if m != nil {
+		return m.Guid
+	}
+	return 0
+}
+
+func (m *ProbeRequest) GetProtocolVersion() uint32 {
+	if m != nil {
+		return m.ProtocolVersion
+	}
+	return 0
+}
+
+func (m *ProbeRequest) GetSpecId() string {
+	if m != nil {
+		return m.SpecId
+	}
+	return ""
+}
+
+func (m *ProbeRequest) GetApiInterface() string {
+	if m != nil {
+		return m.ApiInterface
+	}
+	return ""
+}
+
+type ProbeReply struct {
+	Guid            uint64 `protobuf:"varint,1,opt,name=guid,proto3" json:"guid,omitempty"`
+	ProtocolVersion uint32 `protobuf:"varint,2,opt,name=protocol_version,json=protocolVersion,proto3" json:"protocol_version,omitempty"`
+	Timestamp       int64  `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	LatestBlock     int64  `protobuf:"varint,4,opt,name=latest_block,json=latestBlock,proto3" json:"latest_block,omitempty"`
+}
+
+func (m *ProbeReply) Reset()         { *m = ProbeReply{} }
+func (m *ProbeReply) String() string { return proto.CompactTextString(m) }
+func (*ProbeReply) ProtoMessage()    {}
+func (*ProbeReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10cd1bfeb9978acf, []int{4}
+}
+func (m *ProbeReply) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProbeReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProbeReply.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProbeReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProbeReply.Merge(m, src)
+}
+func (m *ProbeReply) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProbeReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProbeReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProbeReply proto.InternalMessageInfo
+
+func (m *ProbeReply) GetGuid() uint64 {
+	if m != nil {
+		return m.Guid
+	}
+	return 0
+}
+
+func (m *ProbeReply) GetProtocolVersion() uint32 {
+	if m != nil {
+		return m.ProtocolVersion
+	}
+	return 0
+}
+
+func (m *ProbeReply) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *ProbeReply) GetLatestBlock() int64 {
+	if m != nil {
+		return m.LatestBlock
+	}
+	return 0
+}
+
 type Badge struct {
 	CuAllocation uint64 `protobuf:"varint,1,opt,name=cu_allocation,json=cuAllocation,proto3" json:"cu_allocation,omitempty"`
 	Epoch        int64  `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
@@ -318,7 +478,7 @@ func (m *Badge) Reset()         { *m = Badge{} }
 func (m *Badge) String() string { return proto.CompactTextString(m) }
 func (*Badge) ProtoMessage()    {}
 func (*Badge) Descriptor() ([]byte, []int) {
-	return fileDescriptor_10cd1bfeb9978acf, []int{3}
+	return fileDescriptor_10cd1bfeb9978acf, []int{5}
 }
 func (m *Badge) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -389,13 +549,15 @@ type RelayReply struct {
 	LatestBlock           int64  `protobuf:"varint,4,opt,name=latest_block,json=latestBlock,proto3" json:"latest_block,omitempty"`
 	FinalizedBlocksHashes []byte `protobuf:"bytes,5,opt,name=finalized_blocks_hashes,json=finalizedBlocksHashes,proto3" json:"finalized_blocks_hashes,omitempty"`
 	SigBlocks             []byte `protobuf:"bytes,6,opt,name=sig_blocks,json=sigBlocks,proto3" json:"sig_blocks,omitempty"`
+	Timestamp             int64  `protobuf:"varint,7,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	EarliestBlock         int64  `protobuf:"varint,8,opt,name=earliest_block,json=earliestBlock,proto3" json:"earliest_block,omitempty"`
 }
 
 func (m *RelayReply) Reset()         { *m = RelayReply{} }
 func (m *RelayReply) String() string { return proto.CompactTextString(m) }
 func (*RelayReply) ProtoMessage()    {}
 func (*RelayReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_10cd1bfeb9978acf, []int{4}
+	return fileDescriptor_10cd1bfeb9978acf, []int{6}
 }
 func (m *RelayReply) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -466,6 +628,20 @@ func (m *RelayReply) GetSigBlocks() []byte {
 	return nil
 }
 
+func (m *RelayReply) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *RelayReply) GetEarliestBlock() int64 {
+	if m != nil {
+		return m.EarliestBlock
+	}
+	return 0
+}
+
 type VRFData struct {
 	ChainId        string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 	Epoch          int64  `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
@@ -482,7 +658,7 @@ func (m *VRFData) Reset()         { *m = VRFData{} }
 func (m *VRFData) String() string { return proto.CompactTextString(m) }
 func (*VRFData) ProtoMessage()    {}
 func (*VRFData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_10cd1bfeb9978acf, []int{5}
+	return fileDescriptor_10cd1bfeb9978acf, []int{7}
 }
 func (m *VRFData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -584,7 +760,7 @@ func (m *QualityOfServiceReport) Reset()         { *m = QualityOfServiceReport{}
 func (m *QualityOfServiceReport) String() string { return proto.CompactTextString(m) }
 func (*QualityOfServiceReport) ProtoMessage()    {}
 func (*QualityOfServiceReport) Descriptor() ([]byte, []int) {
-	return fileDescriptor_10cd1bfeb9978acf, []int{6}
+	return fileDescriptor_10cd1bfeb9978acf, []int{8}
 }
 func (m *QualityOfServiceReport) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -617,6 +793,8 @@ func init() {
 	proto.RegisterType((*RelaySession)(nil), "lavanet.lava.pairing.RelaySession")
 	proto.RegisterType((*RelayPrivateData)(nil), "lavanet.lava.pairing.RelayPrivateData")
 	proto.RegisterType((*RelayRequest)(nil), "lavanet.lava.pairing.RelayRequest")
+	proto.RegisterType((*ProbeRequest)(nil), "lavanet.lava.pairing.ProbeRequest")
+	proto.RegisterType((*ProbeReply)(nil), "lavanet.lava.pairing.ProbeReply")
 	proto.RegisterType((*Badge)(nil), "lavanet.lava.pairing.Badge")
 	proto.RegisterType((*RelayReply)(nil), "lavanet.lava.pairing.RelayReply")
 	proto.RegisterType((*VRFData)(nil), "lavanet.lava.pairing.VRFData")
@@ -626,75 +804,82 @@ func init() {
 func init() { proto.RegisterFile("pairing/relay.proto", fileDescriptor_10cd1bfeb9978acf) }
 
 var fileDescriptor_10cd1bfeb9978acf = []byte{
-	// 1084 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0x3a, 0x76, 0x6c, 0x8f, 0xb7, 0x69, 0x34, 0x4d, 0x5a, 0x93, 0x52, 0xc7, 0x2c, 0x52,
-	0x9a, 0x03, 0xd8, 0x10, 0xa0, 0x07, 0x24, 0x24, 0x6a, 0x1a, 0x48, 0x04, 0xa2, 0xe9, 0x84, 0xf6,
-	0x90, 0xcb, 0x6a, 0x3c, 0x1e, 0xaf, 0x87, 0xac, 0x77, 0x36, 0x33, 0xbb, 0x06, 0xf3, 0x57, 0x70,
-	0x40, 0xe2, 0xff, 0xe0, 0xcc, 0x89, 0x03, 0xea, 0xb1, 0x47, 0xc4, 0x21, 0x42, 0xc9, 0x85, 0x33,
-	0x77, 0x24, 0x34, 0x6f, 0x66, 0x1d, 0xb7, 0xb2, 0x22, 0x55, 0xe2, 0xb4, 0x33, 0xef, 0xc7, 0x37,
-	0xf3, 0xde, 0xf7, 0xcd, 0xd3, 0xa2, 0x5b, 0x29, 0x15, 0x4a, 0x24, 0x51, 0x4f, 0xf1, 0x98, 0xce,
-	0xba, 0xa9, 0x92, 0x99, 0xc4, 0x1b, 0x31, 0x9d, 0xd2, 0x84, 0x67, 0x5d, 0xf3, 0xed, 0xba, 0x88,
-	0xad, 0x8d, 0x48, 0x46, 0x12, 0x02, 0x7a, 0x66, 0x65, 0x63, 0xb7, 0xda, 0x91, 0x94, 0x51, 0xcc,
-	0x7b, 0xb0, 0x1b, 0xe4, 0xa3, 0xde, 0x77, 0x8a, 0xa6, 0x29, 0x57, 0xda, 0xfa, 0x83, 0x5f, 0x57,
-	0x90, 0x4f, 0x0c, 0xf6, 0x31, 0xd7, 0x5a, 0xc8, 0x04, 0xdf, 0x41, 0x35, 0x9d, 0x72, 0x16, 0x8a,
-	0x61, 0xcb, 0xeb, 0x78, 0xbb, 0x0d, 0xb2, 0x6a, 0xb6, 0x87, 0x43, 0xfc, 0x16, 0xf2, 0x99, 0x4c,
-	0x32, 0x9e, 0x64, 0xe1, 0x98, 0xea, 0x71, 0xab, 0xdc, 0xf1, 0x76, 0x7d, 0xd2, 0x74, 0xb6, 0x03,
-	0xaa, 0xc7, 0xf8, 0x1e, 0x42, 0xda, 0xc2, 0x98, 0xf4, 0x95, 0x8e, 0xb7, 0x5b, 0x21, 0x0d, 0x67,
-	0x39, 0x1c, 0xe2, 0x4d, 0xb4, 0xca, 0xf2, 0x50, 0xe7, 0x93, 0x56, 0x05, 0x5c, 0x55, 0x96, 0x1f,
-	0xe7, 0x13, 0xbc, 0x85, 0xea, 0xa9, 0x92, 0x53, 0x31, 0xe4, 0xaa, 0x55, 0x85, 0x23, 0xe7, 0x7b,
-	0x7c, 0x17, 0x35, 0xa0, 0xf2, 0x30, 0xc9, 0x27, 0xad, 0x55, 0xc8, 0xaa, 0x83, 0xe1, 0xeb, 0x7c,
-	0x82, 0xbf, 0x44, 0xe8, 0x4c, 0xea, 0x50, 0xf1, 0x54, 0xaa, 0xac, 0x55, 0xeb, 0x78, 0xbb, 0xcd,
-	0xbd, 0x77, 0xba, 0xcb, 0x9a, 0xd3, 0x7d, 0x92, 0xd3, 0x58, 0x64, 0xb3, 0xc7, 0xa3, 0x63, 0xae,
-	0xa6, 0x82, 0x71, 0x02, 0x39, 0xa4, 0x71, 0x26, 0xb5, 0x5d, 0xe2, 0x0d, 0x54, 0xe5, 0xa9, 0x64,
-	0xe3, 0x56, 0xbd, 0xe3, 0xed, 0xae, 0x10, 0xbb, 0xc1, 0x1f, 0xa1, 0xdb, 0x79, 0xa2, 0xb8, 0x4e,
-	0x65, 0xa2, 0xc5, 0x94, 0x87, 0xc5, 0xc5, 0x74, 0xab, 0x01, 0xe5, 0x6f, 0x2e, 0x7a, 0x8f, 0x0a,
-	0x27, 0x0e, 0xd0, 0x0d, 0x73, 0x7c, 0xc8, 0xc6, 0x54, 0x40, 0x2f, 0x10, 0xd4, 0xd5, 0x34, 0xc6,
-	0xcf, 0x8c, 0xed, 0x70, 0x88, 0xd7, 0xd1, 0x8a, 0x16, 0x51, 0xab, 0x09, 0x38, 0x66, 0x89, 0xdf,
-	0x47, 0xd5, 0x01, 0x1d, 0x46, 0xbc, 0xe5, 0x43, 0x29, 0x77, 0x97, 0x97, 0xd2, 0x37, 0x21, 0xc4,
-	0x46, 0x06, 0xbf, 0x7b, 0x68, 0x1d, 0xe8, 0x3b, 0x52, 0x62, 0x4a, 0x33, 0xfe, 0x88, 0x66, 0x14,
-	0xdf, 0x47, 0x37, 0x99, 0x4c, 0x12, 0xce, 0x32, 0xc3, 0x44, 0x36, 0x4b, 0xb9, 0xa3, 0x72, 0xed,
-	0xca, 0xfc, 0xcd, 0x2c, 0xe5, 0x86, 0x6b, 0x9a, 0x8a, 0x30, 0x57, 0x31, 0xb0, 0xd9, 0x20, 0xab,
-	0x34, 0x15, 0x4f, 0x55, 0x8c, 0x31, 0xaa, 0x0c, 0x69, 0x46, 0x81, 0x42, 0x9f, 0xc0, 0x1a, 0xbf,
-	0x8d, 0x6e, 0x28, 0x7e, 0x96, 0x73, 0x9d, 0x85, 0x83, 0x58, 0xb2, 0x53, 0x20, 0x71, 0x85, 0xf8,
-	0xce, 0xd8, 0x37, 0x36, 0x13, 0x64, 0x10, 0x45, 0x92, 0x71, 0x35, 0xa2, 0x8c, 0x3b, 0x42, 0x7d,
-	0x9a, 0x8a, 0xc3, 0xc2, 0x66, 0xd0, 0x35, 0x8d, 0x33, 0xe0, 0xd3, 0x27, 0xb0, 0x0e, 0xfe, 0xf6,
-	0x9c, 0x0e, 0x89, 0x85, 0xc3, 0x5f, 0x98, 0xe3, 0x0c, 0xf3, 0x4e, 0x3f, 0x50, 0x42, 0x73, 0x2f,
-	0x58, 0xde, 0x94, 0x45, 0x09, 0x9b, 0x2b, 0x2d, 0x08, 0x7a, 0x1f, 0x21, 0x0b, 0x04, 0x15, 0x95,
-	0x01, 0x65, 0xe7, 0x1a, 0x94, 0x85, 0x4e, 0x12, 0x2b, 0x3e, 0x68, 0xea, 0x01, 0x5a, 0x37, 0x00,
-	0xa1, 0xe2, 0xb1, 0xa0, 0x03, 0x61, 0xd4, 0x04, 0xed, 0x69, 0xee, 0xdd, 0x5b, 0x0e, 0xf6, 0x8c,
-	0x7c, 0x0e, 0x18, 0x37, 0x4d, 0x1a, 0xb9, 0xca, 0x0a, 0x7e, 0xf6, 0x50, 0x15, 0x48, 0x34, 0xdd,
-	0x62, 0x79, 0x48, 0xe3, 0x58, 0x32, 0x9a, 0x15, 0x35, 0x56, 0x88, 0xcf, 0xf2, 0x87, 0x73, 0xdb,
-	0x95, 0x30, 0xcb, 0x8b, 0xc2, 0x7c, 0x03, 0xd5, 0x41, 0x01, 0x61, 0x7a, 0xea, 0x58, 0xaa, 0xc1,
-	0xfe, 0xe8, 0x74, 0xf1, 0x05, 0x57, 0x5e, 0x7a, 0xc1, 0xdb, 0xa8, 0x99, 0x2a, 0xf9, 0x2d, 0x67,
-	0x59, 0x68, 0x94, 0x57, 0x85, 0x34, 0xe4, 0x4c, 0xc7, 0x22, 0x0a, 0x7e, 0xf3, 0x10, 0x72, 0x24,
-	0xa4, 0xf1, 0x6c, 0xae, 0x02, 0x6f, 0x41, 0x05, 0x4e, 0xb5, 0xe5, 0x2b, 0xd5, 0x6e, 0xa0, 0x6a,
-	0x22, 0x13, 0xc6, 0xe1, 0x1a, 0x37, 0x88, 0xdd, 0x98, 0x69, 0x11, 0xd3, 0xec, 0x55, 0xb1, 0x34,
-	0xad, 0xcd, 0x6a, 0xe5, 0x01, 0xba, 0x33, 0x12, 0x09, 0x8d, 0xc5, 0x0f, 0x7c, 0x68, 0xa3, 0x34,
-	0x4c, 0x16, 0xae, 0xdd, 0xd5, 0x36, 0xe7, 0x6e, 0x48, 0xd0, 0x07, 0xe0, 0x84, 0x29, 0x23, 0x22,
-	0x97, 0xe1, 0x44, 0xd4, 0xd0, 0x22, 0xb2, 0x41, 0xc1, 0x4f, 0x65, 0x54, 0x73, 0xbd, 0x37, 0x5d,
-	0x9a, 0x3f, 0x41, 0xfb, 0x04, 0x6a, 0xcc, 0x3d, 0xbf, 0xe5, 0x6d, 0xdd, 0x41, 0x6b, 0x43, 0x31,
-	0x1a, 0x71, 0xc5, 0x93, 0x4c, 0xd0, 0x4c, 0x2a, 0xa8, 0xaa, 0x4e, 0x5e, 0xb1, 0x9a, 0xb9, 0x34,
-	0x55, 0xa3, 0x70, 0x4a, 0xe3, 0x9c, 0x43, 0x6d, 0x3e, 0xa9, 0x4f, 0xd5, 0xe8, 0x99, 0xd9, 0x17,
-	0xce, 0x54, 0x49, 0x39, 0x72, 0xa5, 0x18, 0xe7, 0x91, 0xd9, 0x9b, 0xc6, 0x14, 0x43, 0x04, 0x58,
-	0xb0, 0xf7, 0x6f, 0x16, 0xb6, 0x63, 0x11, 0x99, 0xe9, 0x41, 0xe3, 0x18, 0xf4, 0x6a, 0x47, 0x6d,
-	0xcd, 0xc6, 0xd0, 0x38, 0x36, 0x55, 0x15, 0xa3, 0xf6, 0x2c, 0xe7, 0x6a, 0x66, 0x03, 0xea, 0xb6,
-	0x09, 0x60, 0x01, 0xb7, 0xa3, 0xa9, 0x31, 0xa7, 0x29, 0xf8, 0xa5, 0x8c, 0x6e, 0x2f, 0x9f, 0x82,
-	0xf8, 0x04, 0xd5, 0x0c, 0x2f, 0x09, 0x9b, 0xd9, 0x26, 0xf5, 0x3f, 0x7d, 0x7e, 0xbe, 0x5d, 0xfa,
-	0xf3, 0x7c, 0x7b, 0x27, 0x12, 0xd9, 0x38, 0x1f, 0x74, 0x99, 0x9c, 0xf4, 0x98, 0xd4, 0x13, 0xa9,
-	0xdd, 0xe7, 0x5d, 0x3d, 0x3c, 0xed, 0x99, 0xc1, 0xa2, 0xbb, 0x8f, 0x38, 0xfb, 0xe7, 0x7c, 0x7b,
-	0x6d, 0x46, 0x27, 0xf1, 0xc7, 0xc1, 0x57, 0x16, 0x26, 0x20, 0x05, 0x20, 0x16, 0xc8, 0xa7, 0x53,
-	0x2a, 0xe2, 0xe2, 0xc9, 0xc0, 0x9c, 0xe9, 0xef, 0xbf, 0xf6, 0x01, 0xb7, 0xec, 0x01, 0x8b, 0x58,
-	0x01, 0x79, 0x09, 0x1a, 0x3f, 0x41, 0x15, 0x3d, 0x4b, 0x18, 0x30, 0xd6, 0xe8, 0x7f, 0xf2, 0xda,
-	0x47, 0x34, 0xed, 0x11, 0x06, 0x23, 0x20, 0x00, 0xb5, 0xf7, 0xaf, 0x87, 0x6a, 0xf0, 0x20, 0xb8,
-	0xc2, 0x8f, 0x51, 0x15, 0x96, 0xf8, 0xba, 0x11, 0xe4, 0xa6, 0xd7, 0x56, 0xe7, 0xda, 0x98, 0x34,
-	0x9e, 0x05, 0x25, 0x7c, 0x82, 0xd6, 0xec, 0xd8, 0xca, 0x07, 0x9a, 0x29, 0x31, 0xe0, 0xff, 0x17,
-	0xf2, 0x7b, 0x1e, 0xde, 0x47, 0xd5, 0x23, 0x25, 0x07, 0x1c, 0xbf, 0xd9, 0xb5, 0x3f, 0x00, 0xdd,
-	0xe2, 0x07, 0xa0, 0xfb, 0xf4, 0x30, 0xc9, 0x1e, 0x7c, 0x08, 0x4a, 0xdd, 0xba, 0xd6, 0x1b, 0x94,
-	0xfa, 0x0f, 0x9f, 0x5f, 0xb4, 0xbd, 0x17, 0x17, 0x6d, 0xef, 0xaf, 0x8b, 0xb6, 0xf7, 0xe3, 0x65,
-	0xbb, 0xf4, 0xe2, 0xb2, 0x5d, 0xfa, 0xe3, 0xb2, 0x5d, 0x3a, 0xb9, 0xbf, 0xd0, 0x56, 0x77, 0x21,
-	0xf8, 0xf6, 0xbe, 0xef, 0x15, 0xbf, 0x2c, 0xd0, 0xdb, 0xc1, 0x2a, 0x40, 0x7f, 0xf0, 0x5f, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x17, 0xd6, 0x37, 0xc8, 0xca, 0x08, 0x00, 0x00,
+	// 1186 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4f, 0x6f, 0x1b, 0xc5,
+	0x1b, 0xce, 0x3a, 0x76, 0x6c, 0xbf, 0x5e, 0xa7, 0xd1, 0x34, 0x6d, 0xb7, 0xe9, 0xaf, 0x89, 0x7f,
+	0x8b, 0x68, 0x83, 0x04, 0x0e, 0x04, 0xc1, 0x01, 0x09, 0x89, 0x86, 0x16, 0x1a, 0x81, 0xda, 0x74,
+	0x02, 0x3d, 0xf4, 0xb2, 0x1a, 0xaf, 0xc7, 0xce, 0x90, 0xf5, 0xce, 0x66, 0x66, 0xd7, 0xc2, 0x1c,
+	0xb9, 0xf4, 0x00, 0x07, 0x24, 0x90, 0xf8, 0x1e, 0x7c, 0x08, 0xd4, 0x63, 0x8f, 0x88, 0x43, 0x85,
+	0xda, 0x6f, 0xc0, 0x27, 0x40, 0xf3, 0xce, 0xac, 0xed, 0xa4, 0x26, 0xa2, 0x82, 0xd3, 0xce, 0xbc,
+	0xf3, 0xce, 0x33, 0xef, 0x9f, 0x67, 0x9e, 0x59, 0xb8, 0x98, 0x31, 0xa1, 0x44, 0x3a, 0xdc, 0x51,
+	0x3c, 0x61, 0x93, 0x6e, 0xa6, 0x64, 0x2e, 0xc9, 0x7a, 0xc2, 0xc6, 0x2c, 0xe5, 0x79, 0xd7, 0x7c,
+	0xbb, 0xce, 0x63, 0x63, 0x7d, 0x28, 0x87, 0x12, 0x1d, 0x76, 0xcc, 0xc8, 0xfa, 0x86, 0xdf, 0x56,
+	0xc1, 0xa7, 0x66, 0xef, 0x21, 0xd7, 0x5a, 0xc8, 0x94, 0x5c, 0x81, 0xba, 0xce, 0x78, 0x1c, 0x89,
+	0x7e, 0xe0, 0x75, 0xbc, 0xed, 0x26, 0x5d, 0x31, 0xd3, 0xfd, 0x3e, 0xf9, 0x3f, 0xf8, 0xb1, 0x4c,
+	0x73, 0x9e, 0xe6, 0xd1, 0x11, 0xd3, 0x47, 0x41, 0xa5, 0xe3, 0x6d, 0xfb, 0xb4, 0xe5, 0x6c, 0x77,
+	0x99, 0x3e, 0x22, 0xd7, 0x01, 0xb4, 0x85, 0x31, 0xdb, 0x97, 0x3b, 0xde, 0x76, 0x95, 0x36, 0x9d,
+	0x65, 0xbf, 0x4f, 0x2e, 0xc1, 0x4a, 0x5c, 0x44, 0xba, 0x18, 0x05, 0x55, 0x5c, 0xaa, 0xc5, 0xc5,
+	0x61, 0x31, 0x22, 0x1b, 0xd0, 0xc8, 0x94, 0x1c, 0x8b, 0x3e, 0x57, 0x41, 0x0d, 0x8f, 0x9c, 0xce,
+	0xc9, 0x35, 0x68, 0x62, 0x66, 0x51, 0x5a, 0x8c, 0x82, 0x15, 0xdc, 0xd5, 0x40, 0xc3, 0xbd, 0x62,
+	0x44, 0x3e, 0x03, 0x38, 0x91, 0x3a, 0x52, 0x3c, 0x93, 0x2a, 0x0f, 0xea, 0x1d, 0x6f, 0xbb, 0xb5,
+	0xfb, 0x66, 0x77, 0x51, 0xf2, 0xdd, 0x07, 0x05, 0x4b, 0x44, 0x3e, 0xb9, 0x3f, 0x38, 0xe4, 0x6a,
+	0x2c, 0x62, 0x4e, 0x71, 0x0f, 0x6d, 0x9e, 0x48, 0x6d, 0x87, 0x64, 0x1d, 0x6a, 0x3c, 0x93, 0xf1,
+	0x51, 0xd0, 0xe8, 0x78, 0xdb, 0xcb, 0xd4, 0x4e, 0xc8, 0x7b, 0x70, 0xb9, 0x48, 0x15, 0xd7, 0x99,
+	0x4c, 0xb5, 0x18, 0xf3, 0xa8, 0x0c, 0x4c, 0x07, 0x4d, 0x4c, 0xff, 0xd2, 0xfc, 0xea, 0x41, 0xb9,
+	0x48, 0x42, 0x68, 0x9b, 0xe3, 0xa3, 0xf8, 0x88, 0x09, 0xac, 0x05, 0x60, 0x5e, 0x2d, 0x63, 0xfc,
+	0xd8, 0xd8, 0xf6, 0xfb, 0x64, 0x0d, 0x96, 0xb5, 0x18, 0x06, 0x2d, 0xc4, 0x31, 0x43, 0xf2, 0x0e,
+	0xd4, 0x7a, 0xac, 0x3f, 0xe4, 0x81, 0x8f, 0xa9, 0x5c, 0x5b, 0x9c, 0xca, 0x9e, 0x71, 0xa1, 0xd6,
+	0x93, 0x5c, 0x85, 0x06, 0xcb, 0x44, 0x94, 0xb2, 0x11, 0x0f, 0xda, 0x78, 0x46, 0x9d, 0x65, 0xe2,
+	0x1e, 0x1b, 0x71, 0xf2, 0x06, 0xac, 0x61, 0x8b, 0x63, 0x99, 0x44, 0x63, 0xae, 0x4c, 0x0f, 0x82,
+	0xd5, 0x8e, 0xb7, 0xdd, 0xa6, 0x17, 0x4a, 0xfb, 0x43, 0x6b, 0x0e, 0x7f, 0xf5, 0x60, 0x0d, 0x49,
+	0x70, 0xa0, 0xc4, 0x98, 0xe5, 0xfc, 0x36, 0xcb, 0x19, 0xb9, 0x09, 0x17, 0x62, 0x99, 0xa6, 0x3c,
+	0xce, 0x4d, 0x3f, 0xf3, 0x49, 0xc6, 0x1d, 0x21, 0x56, 0x67, 0xe6, 0x2f, 0x26, 0x19, 0x37, 0x8c,
+	0x31, 0x31, 0x14, 0x2a, 0x41, 0x4e, 0x34, 0xe9, 0x0a, 0xcb, 0xc4, 0x97, 0x2a, 0x21, 0x04, 0xaa,
+	0x7d, 0x96, 0x33, 0x24, 0x82, 0x4f, 0x71, 0x4c, 0x5e, 0x83, 0xb6, 0xe2, 0x27, 0x05, 0xd7, 0x79,
+	0xd4, 0x4b, 0x64, 0x7c, 0x8c, 0x54, 0x58, 0xa6, 0xbe, 0x33, 0xee, 0x19, 0x9b, 0x71, 0x32, 0x88,
+	0x22, 0xcd, 0xb9, 0x1a, 0xb0, 0x98, 0x3b, 0x5a, 0xf8, 0x2c, 0x13, 0xfb, 0xa5, 0xcd, 0xa0, 0x6b,
+	0x96, 0xe4, 0xc8, 0x0a, 0x9f, 0xe2, 0x38, 0xfc, 0xb1, 0xe2, 0xd8, 0x4c, 0x2d, 0x1c, 0xf9, 0x14,
+	0xda, 0x96, 0x3f, 0x8e, 0x85, 0x98, 0x42, 0x6b, 0x37, 0x5c, 0x5c, 0xda, 0xf9, 0x8b, 0x60, 0x42,
+	0x9a, 0xcd, 0xc8, 0x1d, 0x00, 0x0b, 0x84, 0x19, 0x55, 0x10, 0xe5, 0xc6, 0x39, 0x28, 0x73, 0x95,
+	0xa4, 0x96, 0xc2, 0x66, 0x48, 0xee, 0xc2, 0x9a, 0x01, 0x88, 0x14, 0x4f, 0x04, 0xeb, 0x09, 0xc3,
+	0x49, 0x2c, 0x4f, 0x6b, 0xf7, 0xfa, 0x62, 0xb0, 0x87, 0xf4, 0x13, 0xc4, 0xb8, 0x60, 0xb6, 0xd1,
+	0xd9, 0xae, 0x85, 0xed, 0xad, 0x2e, 0x6e, 0xef, 0x77, 0x1e, 0xf8, 0x07, 0x4a, 0xf6, 0x78, 0x59,
+	0x15, 0x02, 0xd5, 0x61, 0xe1, 0x2e, 0x78, 0x95, 0xe2, 0x78, 0x21, 0x5e, 0x65, 0x21, 0xde, 0xbc,
+	0x44, 0x2c, 0x9f, 0x92, 0x88, 0x97, 0xfa, 0x56, 0x7d, 0xb9, 0x6f, 0xe1, 0xf7, 0x1e, 0x80, 0x8b,
+	0x26, 0x4b, 0x26, 0xff, 0x36, 0x96, 0xff, 0x41, 0x33, 0x17, 0x23, 0xae, 0x73, 0x36, 0xca, 0x30,
+	0x9a, 0x65, 0x3a, 0x33, 0x18, 0xcd, 0x4a, 0x58, 0x7e, 0x96, 0x6c, 0x2d, 0x6b, 0x43, 0xae, 0x85,
+	0x3f, 0x7b, 0x50, 0xc3, 0x2b, 0x65, 0xa2, 0x8f, 0x8b, 0x88, 0x25, 0x89, 0x8c, 0x59, 0x5e, 0x72,
+	0xa5, 0x4a, 0xfd, 0xb8, 0xb8, 0x35, 0xb5, 0xcd, 0x64, 0xa2, 0x32, 0x2f, 0x13, 0x57, 0xa1, 0x81,
+	0xf7, 0x31, 0xca, 0x8e, 0x1d, 0xdb, 0xeb, 0x38, 0x3f, 0x38, 0x9e, 0x2f, 0x56, 0xf5, 0x54, 0xb1,
+	0xb6, 0xa0, 0x95, 0x29, 0xf9, 0x15, 0x8f, 0xf3, 0xc8, 0xe8, 0x40, 0x0d, 0xb7, 0x81, 0x33, 0x1d,
+	0x8a, 0x61, 0xf8, 0xb8, 0x02, 0xe0, 0xc8, 0xec, 0x0a, 0x85, 0xdc, 0xf3, 0xe6, 0x6e, 0x93, 0xd3,
+	0x90, 0xca, 0x4c, 0x43, 0xd6, 0xa1, 0x96, 0xca, 0x34, 0xe6, 0x18, 0x46, 0x9b, 0xda, 0xc9, 0x3f,
+	0xa8, 0x03, 0x79, 0x1f, 0xae, 0x0c, 0x44, 0xca, 0x12, 0xf1, 0x0d, 0xef, 0x5b, 0x2f, 0x8d, 0x3a,
+	0xcf, 0xb5, 0x0b, 0xed, 0xd2, 0x74, 0x19, 0x37, 0xe8, 0xbb, 0xb8, 0x88, 0x9a, 0x2f, 0x86, 0x6e,
+	0x87, 0xbb, 0x8c, 0x4d, 0x2d, 0x86, 0xd6, 0xe9, 0x74, 0x7f, 0xea, 0x67, 0xfb, 0xf3, 0x3a, 0xac,
+	0x72, 0xa6, 0x12, 0x31, 0x8b, 0xcc, 0xaa, 0x6f, 0xbb, 0xb4, 0xda, 0x1e, 0xfd, 0x54, 0x81, 0xba,
+	0xbb, 0x08, 0xa6, 0xd4, 0x53, 0x55, 0xb5, 0x7a, 0x54, 0x8f, 0x9d, 0xa2, 0x2e, 0xee, 0xcd, 0x0d,
+	0x58, 0xed, 0x8b, 0xc1, 0x80, 0x2b, 0x9e, 0xe6, 0x82, 0xe5, 0x52, 0x61, 0x69, 0x1a, 0xf4, 0x8c,
+	0xd5, 0x3c, 0x35, 0x63, 0x35, 0x88, 0xc6, 0x2c, 0x29, 0x2c, 0x71, 0x7d, 0xda, 0x18, 0xab, 0xc1,
+	0x43, 0x33, 0x2f, 0x17, 0x33, 0x25, 0xe5, 0x20, 0xa8, 0x4d, 0x17, 0x0f, 0xcc, 0xdc, 0x54, 0xb7,
+	0x7c, 0x17, 0xb0, 0x95, 0xb6, 0x08, 0xad, 0xd2, 0x76, 0x28, 0x86, 0xe6, 0x41, 0x60, 0x49, 0x82,
+	0xe2, 0x61, 0x5f, 0xcf, 0xba, 0xf5, 0x61, 0x49, 0x62, 0xb2, 0x2a, 0x5f, 0xcf, 0x93, 0x82, 0xab,
+	0x89, 0x75, 0x68, 0xd8, 0x4a, 0xa2, 0x05, 0x97, 0x5d, 0xaf, 0x9b, 0xd3, 0x5e, 0x87, 0xbf, 0x54,
+	0xe0, 0xf2, 0xe2, 0x87, 0x8d, 0x3c, 0x82, 0xba, 0x69, 0x6e, 0x1a, 0x4f, 0x6c, 0x91, 0xf6, 0x3e,
+	0x7a, 0xf2, 0x6c, 0x6b, 0xe9, 0xf7, 0x67, 0x5b, 0x37, 0x86, 0x22, 0x3f, 0x2a, 0x7a, 0xdd, 0x58,
+	0x8e, 0x76, 0x62, 0xa9, 0x47, 0x52, 0xbb, 0xcf, 0x5b, 0xba, 0x7f, 0xbc, 0x63, 0x54, 0x5e, 0x77,
+	0x6f, 0xf3, 0xf8, 0xcf, 0x67, 0x5b, 0xab, 0x13, 0x36, 0x4a, 0x3e, 0x08, 0x3f, 0xb7, 0x30, 0x21,
+	0x2d, 0x01, 0x89, 0x00, 0x9f, 0x8d, 0x99, 0x48, 0x4a, 0xfd, 0x42, 0xd1, 0xdf, 0xbb, 0xf3, 0xca,
+	0x07, 0x5c, 0xb4, 0x07, 0xcc, 0x63, 0x85, 0xf4, 0x14, 0x34, 0x79, 0x00, 0x55, 0x3d, 0x49, 0x63,
+	0x2b, 0x33, 0x7b, 0x1f, 0xbe, 0xf2, 0x11, 0x2d, 0x7b, 0x84, 0xc1, 0x08, 0x29, 0x42, 0xed, 0x3e,
+	0xae, 0x40, 0x1d, 0x6f, 0x15, 0x57, 0xe4, 0x3e, 0xd4, 0x70, 0x48, 0xce, 0x7b, 0x0f, 0x9c, 0x68,
+	0x6e, 0x74, 0xce, 0xf5, 0xc9, 0x92, 0x49, 0xb8, 0x44, 0x1e, 0xc1, 0xaa, 0x7d, 0x43, 0x8a, 0x9e,
+	0x8e, 0x95, 0xe8, 0xf1, 0xff, 0x0a, 0xf9, 0x6d, 0xcf, 0x04, 0x8b, 0xb2, 0xf9, 0x77, 0x90, 0xf3,
+	0x0a, 0xbf, 0xd1, 0x39, 0xd7, 0x07, 0x21, 0xf7, 0x6e, 0x3d, 0x79, 0xbe, 0xe9, 0x3d, 0x7d, 0xbe,
+	0xe9, 0xfd, 0xf1, 0x7c, 0xd3, 0xfb, 0xe1, 0xc5, 0xe6, 0xd2, 0xd3, 0x17, 0x9b, 0x4b, 0xbf, 0xbd,
+	0xd8, 0x5c, 0x7a, 0x74, 0x73, 0xae, 0xc0, 0x0e, 0x07, 0xbf, 0x3b, 0x5f, 0xef, 0x94, 0xff, 0x9b,
+	0x58, 0xe5, 0xde, 0x0a, 0xca, 0xf1, 0xbb, 0x7f, 0x0d, 0x00, 0xc4, 0xa7, 0xf8, 0x3b, 0x87, 0x0a,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -711,7 +896,7 @@ const _ = grpc.SupportPackageIsVersion4
 type RelayerClient interface {
 	Relay(ctx context.Context, in *RelayRequest, opts ...grpc.CallOption) (*RelayReply, error)
 	RelaySubscribe(ctx context.Context, in *RelayRequest, opts ...grpc.CallOption) (Relayer_RelaySubscribeClient, error)
-	Probe(ctx context.Context, in *wrapperspb.UInt64Value, opts ...grpc.CallOption) (*wrapperspb.UInt64Value, error)
+	Probe(ctx context.Context, in *ProbeRequest, opts ...grpc.CallOption) (*ProbeReply, error)
 }
 
 type relayerClient struct {
@@ -763,8 +948,8 @@ func (x *relayerRelaySubscribeClient) Recv() (*RelayReply, error) {
 	return m, nil
 }
 
-func (c *relayerClient) Probe(ctx context.Context, in *wrapperspb.UInt64Value, opts ...grpc.CallOption) (*wrapperspb.UInt64Value, error) {
-	out := new(wrapperspb.UInt64Value)
+func (c *relayerClient) Probe(ctx context.Context, in *ProbeRequest, opts ...grpc.CallOption) (*ProbeReply, error) {
+	out := new(ProbeReply)
 	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.Relayer/Probe", in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -776,7 +961,7 @@ func (c *relayerClient) Probe(ctx context.Context, in *wrapperspb.UInt64Value, o
 type RelayerServer interface {
 	Relay(context.Context, *RelayRequest) (*RelayReply, error)
 	RelaySubscribe(*RelayRequest, Relayer_RelaySubscribeServer) error
-	Probe(context.Context, *wrapperspb.UInt64Value) (*wrapperspb.UInt64Value, error)
+	Probe(context.Context, *ProbeRequest) (*ProbeReply, error)
 }
 
 // UnimplementedRelayerServer can be embedded to have forward compatible implementations.
@@ -789,7 +974,7 @@ func (*UnimplementedRelayerServer) Relay(ctx context.Context, req *RelayRequest)
 func (*UnimplementedRelayerServer) RelaySubscribe(req *RelayRequest, srv Relayer_RelaySubscribeServer) error {
 	return status.Errorf(codes.Unimplemented, "method RelaySubscribe not implemented")
 }
-func (*UnimplementedRelayerServer) Probe(ctx context.Context, req *wrapperspb.UInt64Value) (*wrapperspb.UInt64Value, error) {
+func (*UnimplementedRelayerServer) Probe(ctx context.Context, req *ProbeRequest) (*ProbeReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Probe not implemented")
 }
 
@@ -837,7 +1022,7 @@ func (x *relayerRelaySubscribeServer) Send(m *RelayReply) error {
 }
 
 func _Relayer_Probe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(wrapperspb.UInt64Value)
+	in := new(ProbeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -849,7 +1034,7 @@ func _Relayer_Probe_Handler(srv interface{}, ctx context.Context, dec func(inter
 		FullMethod: "/lavanet.lava.pairing.Relayer/Probe",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RelayerServer).Probe(ctx, req.(*wrapperspb.UInt64Value))
+		return srv.(RelayerServer).Probe(ctx, req.(*ProbeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -897,6 +1082,18 @@ func (m *RelaySession) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ProtocolVersion != 0 {
+		i = encodeVarintRelay(dAtA, i, uint64(m.ProtocolVersion))
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.ApiName) > 0 {
+		i -= len(m.ApiName)
+		copy(dAtA[i:], m.ApiName)
+		i = encodeVarintRelay(dAtA, i, uint64(len(m.ApiName)))
+		i--
+		dAtA[i] = 0x6a
+	}
 	if m.Badge != nil {
 		{
 			size, err := m.Badge.MarshalToSizedBuffer(dAtA[:i])
@@ -1069,6 +1266,11 @@ func (m *RelayRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ProtocolVersion != 0 {
+		i = encodeVarintRelay(dAtA, i, uint64(m.ProtocolVersion))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.DataReliability != nil {
 		{
 			size, err := m.DataReliability.MarshalToSizedBuffer(dAtA[:i])
@@ -1108,6 +1310,96 @@ func (m *RelayRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ProbeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProbeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProbeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ApiInterface) > 0 {
+		i -= len(m.ApiInterface)
+		copy(dAtA[i:], m.ApiInterface)
+		i = encodeVarintRelay(dAtA, i, uint64(len(m.ApiInterface)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.SpecId) > 0 {
+		i -= len(m.SpecId)
+		copy(dAtA[i:], m.SpecId)
+		i = encodeVarintRelay(dAtA, i, uint64(len(m.SpecId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ProtocolVersion != 0 {
+		i = encodeVarintRelay(dAtA, i, uint64(m.ProtocolVersion))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Guid != 0 {
+		i = encodeVarintRelay(dAtA, i, uint64(m.Guid))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProbeReply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProbeReply) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProbeReply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LatestBlock != 0 {
+		i = encodeVarintRelay(dAtA, i, uint64(m.LatestBlock))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Timestamp != 0 {
+		i = encodeVarintRelay(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ProtocolVersion != 0 {
+		i = encodeVarintRelay(dAtA, i, uint64(m.ProtocolVersion))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Guid != 0 {
+		i = encodeVarintRelay(dAtA, i, uint64(m.Guid))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Badge) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1182,6 +1474,16 @@ func (m *RelayReply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.EarliestBlock != 0 {
+		i = encodeVarintRelay(dAtA, i, uint64(m.EarliestBlock))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Timestamp != 0 {
+		i = encodeVarintRelay(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x38
+	}
 	if len(m.SigBlocks) > 0 {
 		i -= len(m.SigBlocks)
 		copy(dAtA[i:], m.SigBlocks)
@@ -1424,6 +1726,13 @@ func (m *RelaySession) Size() (n int) {
 		l = m.Badge.Size()
 		n += 1 + l + sovRelay(uint64(l))
 	}
+	l = len(m.ApiName)
+	if l > 0 {
+		n += 1 + l + sovRelay(uint64(l))
+	}
+	if m.ProtocolVersion != 0 {
+		n += 1 + sovRelay(uint64(m.ProtocolVersion))
+	}
 	return n
 }
 
@@ -1477,6 +1786,53 @@ func (m *RelayRequest) Size() (n int) {
 		l = m.DataReliability.Size()
 		n += 1 + l + sovRelay(uint64(l))
 	}
+	if m.ProtocolVersion != 0 {
+		n += 1 + sovRelay(uint64(m.ProtocolVersion))
+	}
+	return n
+}
+
+func (m *ProbeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Guid != 0 {
+		n += 1 + sovRelay(uint64(m.Guid))
+	}
+	if m.ProtocolVersion != 0 {
+		n += 1 + sovRelay(uint64(m.ProtocolVersion))
+	}
+	l = len(m.SpecId)
+	if l > 0 {
+		n += 1 + l + sovRelay(uint64(l))
+	}
+	l = len(m.ApiInterface)
+	if l > 0 {
+		n += 1 + l + sovRelay(uint64(l))
+	}
+	return n
+}
+
+func (m *ProbeReply) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Guid != 0 {
+		n += 1 + sovRelay(uint64(m.Guid))
+	}
+	if m.ProtocolVersion != 0 {
+		n += 1 + sovRelay(uint64(m.ProtocolVersion))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovRelay(uint64(m.Timestamp))
+	}
+	if m.LatestBlock != 0 {
+		n += 1 + sovRelay(uint64(m.LatestBlock))
+	}
 	return n
 }
 
@@ -1535,6 +1891,12 @@ func (m *RelayReply) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovRelay(uint64(l))
 	}
+	if m.Timestamp != 0 {
+		n += 1 + sovRelay(uint64(m.Timestamp))
+	}
+	if m.EarliestBlock != 0 {
+		n += 1 + sovRelay(uint64(m.EarliestBlock))
+	}
 	return n
 }
 
@@ -1977,19 +2339,70 @@ func (m *RelaySession) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRelay(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthRelay
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiName", wireType)
 			}
-			iNdEx += skippy
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRelay
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRelay
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolVersion", wireType)
+			}
+			m.ProtocolVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProtocolVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRelay(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRelay
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
 
@@ -2368,6 +2781,303 @@ func (m *RelayRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolVersion", wireType)
+			}
+			m.ProtocolVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProtocolVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRelay(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRelay
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProbeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRelay
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProbeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProbeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Guid", wireType)
+			}
+			m.Guid = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Guid |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolVersion", wireType)
+			}
+			m.ProtocolVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProtocolVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRelay
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRelay
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpecId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiInterface", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRelay
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRelay
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiInterface = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRelay(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRelay
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProbeReply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRelay
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProbeReply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProbeReply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Guid", wireType)
+			}
+			m.Guid = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Guid |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolVersion", wireType)
+			}
+			m.ProtocolVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProtocolVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestBlock", wireType)
+			}
+			m.LatestBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LatestBlock |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRelay(dAtA[iNdEx:])
@@ -2780,6 +3490,44 @@ func (m *RelayReply) Unmarshal(dAtA []byte) error {
 				m.SigBlocks = []byte{}
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EarliestBlock", wireType)
+			}
+			m.EarliestBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EarliestBlock |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRelay(dAtA[iNdEx:])
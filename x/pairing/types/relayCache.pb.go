@@ -249,479 +249,2519 @@ func (m *RelayCacheSet) GetFinalized() bool {
 	return false
 }
 
-func init() {
-	proto.RegisterType((*CacheUsage)(nil), "lavanet.lava.pairing.CacheUsage")
-	proto.RegisterType((*RelayCacheGet)(nil), "lavanet.lava.pairing.RelayCacheGet")
-	proto.RegisterType((*RelayCacheSet)(nil), "lavanet.lava.pairing.RelayCacheSet")
+type RelayCacheEntry struct {
+	Response  *RelayReply   `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	BlockHash []byte        `protobuf:"bytes,2,opt,name=blockHash,proto3" json:"blockHash,omitempty"`
+	Finalized bool          `protobuf:"varint,3,opt,name=finalized,proto3" json:"finalized,omitempty"`
+	Request   *RelayRequest `protobuf:"bytes,4,opt,name=request,proto3" json:"request,omitempty"`
 }
 
-func init() { proto.RegisterFile("pairing/relayCache.proto", fileDescriptor_2cd8c815c0cb2c9f) }
-
-var fileDescriptor_2cd8c815c0cb2c9f = []byte{
-	// 446 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x53, 0xc1, 0x8e, 0xd3, 0x30,
-	0x10, 0x8d, 0x97, 0xa5, 0x4d, 0xbd, 0xe5, 0x62, 0x56, 0x28, 0x0a, 0x28, 0x8a, 0xc2, 0x81, 0x9e,
-	0x1c, 0x69, 0xb9, 0xee, 0x05, 0x28, 0xda, 0x56, 0x82, 0x8b, 0x2b, 0x2e, 0xdc, 0x9c, 0x30, 0x4d,
-	0xac, 0xcd, 0xc6, 0x21, 0x76, 0x11, 0xe5, 0x2b, 0xf8, 0x05, 0xbe, 0x06, 0x8e, 0x7b, 0xe4, 0x88,
-	0xda, 0x5f, 0xe0, 0x03, 0x50, 0xa6, 0x4d, 0xb3, 0x45, 0x6c, 0xe1, 0xc8, 0xc9, 0x9e, 0xe7, 0x79,
-	0x33, 0xef, 0x8d, 0xc6, 0xd4, 0xab, 0xa4, 0xaa, 0x55, 0x99, 0xc5, 0x35, 0x14, 0x72, 0xf9, 0x42,
-	0xa6, 0x39, 0xf0, 0xaa, 0xd6, 0x56, 0xb3, 0xd3, 0x42, 0x7e, 0x90, 0x25, 0x58, 0xde, 0x9c, 0x7c,
-	0x9b, 0xe6, 0x9f, 0x66, 0x3a, 0xd3, 0x98, 0x10, 0x37, 0xb7, 0x4d, 0xae, 0x7f, 0x7f, 0xaf, 0xca,
-	0x16, 0x7c, 0x98, 0x69, 0x9d, 0x15, 0x10, 0x63, 0x94, 0x2c, 0xe6, 0x31, 0x5c, 0x55, 0x76, 0xfb,
-	0x18, 0xbd, 0xa2, 0x14, 0x9b, 0xbd, 0x31, 0x32, 0x03, 0xf6, 0x88, 0x0e, 0x30, 0x9a, 0x28, 0x6b,
-	0x3c, 0x12, 0x92, 0xd1, 0xb1, 0xe8, 0x00, 0x16, 0xd2, 0x13, 0x0c, 0x5e, 0x2b, 0x63, 0xc0, 0x78,
-	0x47, 0xf8, 0x7e, 0x13, 0x8a, 0xbe, 0x12, 0x7a, 0x4f, 0xec, 0x0c, 0x5c, 0x80, 0x65, 0xe7, 0xb4,
-	0x5f, 0xc3, 0xfb, 0x05, 0x18, 0x8b, 0xf5, 0x4e, 0xce, 0x22, 0xfe, 0x27, 0x3f, 0x1c, 0x59, 0x62,
-	0x93, 0x29, 0x5a, 0x0a, 0x8b, 0xe8, 0x50, 0x56, 0x6a, 0x5a, 0x5a, 0xa8, 0xe7, 0x32, 0x05, 0x6c,
-	0x39, 0x10, 0x7b, 0x58, 0xa3, 0x39, 0x29, 0x74, 0x7a, 0x39, 0x91, 0x26, 0xf7, 0xee, 0x84, 0x64,
-	0x34, 0x14, 0x1d, 0xc0, 0x3c, 0xda, 0x4f, 0x73, 0xa9, 0xca, 0xe9, 0xd8, 0x3b, 0x46, 0x72, 0x1b,
-	0x36, 0xbc, 0xb9, 0x2a, 0x65, 0xa1, 0x3e, 0xc1, 0x3b, 0xef, 0x6e, 0x48, 0x46, 0xae, 0xe8, 0x80,
-	0xe8, 0xcb, 0xd1, 0x4d, 0x27, 0xb3, 0xff, 0xda, 0x89, 0x4f, 0xdd, 0x64, 0x91, 0x5e, 0x82, 0x9d,
-	0x8e, 0xd1, 0xc8, 0x40, 0xec, 0x62, 0x76, 0x4e, 0xdd, 0x1a, 0x4c, 0xa5, 0x4b, 0x03, 0x5e, 0x0f,
-	0x65, 0x87, 0x07, 0x65, 0x57, 0xc5, 0x52, 0xec, 0x18, 0xfb, 0x33, 0xea, 0xff, 0x36, 0xa3, 0xb3,
-	0x9f, 0x84, 0x0e, 0x91, 0x06, 0x35, 0x4e, 0x89, 0xcd, 0xa8, 0x7b, 0x01, 0x16, 0x21, 0xf6, 0xf8,
-	0x40, 0x9b, 0x76, 0x3b, 0xfc, 0xbf, 0x6a, 0x89, 0x1c, 0x36, 0xa5, 0xee, 0xec, 0x9f, 0x8b, 0xce,
-	0xc0, 0xfa, 0x0f, 0xf8, 0x66, 0xe1, 0x79, 0xbb, 0xf0, 0xfc, 0x65, 0xb3, 0xf0, 0x91, 0xc3, 0xc6,
-	0xb4, 0x37, 0x01, 0x59, 0xd8, 0x9c, 0xdd, 0x92, 0x73, 0x9b, 0xa0, 0xee, 0x8b, 0x44, 0xce, 0xf3,
-	0x67, 0xdf, 0x56, 0x01, 0xb9, 0x5e, 0x05, 0xe4, 0xc7, 0x2a, 0x20, 0x9f, 0xd7, 0x81, 0x73, 0xbd,
-	0x0e, 0x9c, 0xef, 0xeb, 0xc0, 0x79, 0xfb, 0x24, 0x53, 0x36, 0x5f, 0x24, 0x3c, 0xd5, 0x57, 0xf1,
-	0xb6, 0x0e, 0x9e, 0xf1, 0xc7, 0xb8, 0xfd, 0x98, 0x76, 0x59, 0x81, 0x49, 0x7a, 0xd8, 0xf6, 0xe9,
-	0xaf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x44, 0xb5, 0x17, 0xd4, 0xf6, 0x03, 0x00, 0x00,
+func (m *RelayCacheEntry) Reset()         { *m = RelayCacheEntry{} }
+func (m *RelayCacheEntry) String() string { return proto.CompactTextString(m) }
+func (*RelayCacheEntry) ProtoMessage()    {}
+func (*RelayCacheEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2cd8c815c0cb2c9f, []int{3}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// RelayerCacheClient is the client API for RelayerCache service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type RelayerCacheClient interface {
-	GetRelay(ctx context.Context, in *RelayCacheGet, opts ...grpc.CallOption) (*RelayReply, error)
-	SetRelay(ctx context.Context, in *RelayCacheSet, opts ...grpc.CallOption) (*emptypb.Empty, error)
-	Health(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CacheUsage, error)
+func (m *RelayCacheEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type relayerCacheClient struct {
-	cc grpc1.ClientConn
+func (m *RelayCacheEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RelayCacheEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewRelayerCacheClient(cc grpc1.ClientConn) RelayerCacheClient {
-	return &relayerCacheClient{cc}
+func (m *RelayCacheEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RelayCacheEntry.Merge(m, src)
+}
+func (m *RelayCacheEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *RelayCacheEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_RelayCacheEntry.DiscardUnknown(m)
 }
 
-func (c *relayerCacheClient) GetRelay(ctx context.Context, in *RelayCacheGet, opts ...grpc.CallOption) (*RelayReply, error) {
-	out := new(RelayReply)
-	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.RelayerCache/GetRelay", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_RelayCacheEntry proto.InternalMessageInfo
+
+func (m *RelayCacheEntry) GetResponse() *RelayReply {
+	if m != nil {
+		return m.Response
 	}
-	return out, nil
+	return nil
 }
 
-func (c *relayerCacheClient) SetRelay(ctx context.Context, in *RelayCacheSet, opts ...grpc.CallOption) (*emptypb.Empty, error) {
-	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.RelayerCache/SetRelay", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *RelayCacheEntry) GetBlockHash() []byte {
+	if m != nil {
+		return m.BlockHash
 	}
-	return out, nil
+	return nil
 }
 
-func (c *relayerCacheClient) Health(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CacheUsage, error) {
-	out := new(CacheUsage)
-	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.RelayerCache/Health", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *RelayCacheEntry) GetFinalized() bool {
+	if m != nil {
+		return m.Finalized
 	}
-	return out, nil
+	return false
 }
 
-// RelayerCacheServer is the server API for RelayerCache service.
-type RelayerCacheServer interface {
-	GetRelay(context.Context, *RelayCacheGet) (*RelayReply, error)
-	SetRelay(context.Context, *RelayCacheSet) (*emptypb.Empty, error)
-	Health(context.Context, *emptypb.Empty) (*CacheUsage, error)
+func (m *RelayCacheEntry) GetRequest() *RelayRequest {
+	if m != nil {
+		return m.Request
+	}
+	return nil
 }
 
-// UnimplementedRelayerCacheServer can be embedded to have forward compatible implementations.
-type UnimplementedRelayerCacheServer struct {
+type CacheChainStats struct {
+	ChainID                       string `protobuf:"bytes,1,opt,name=chainID,proto3" json:"chainID,omitempty"`
+	Hits                          uint64 `protobuf:"varint,2,opt,name=hits,proto3" json:"hits,omitempty"`
+	Misses                        uint64 `protobuf:"varint,3,opt,name=misses,proto3" json:"misses,omitempty"`
+	Entries                       uint64 `protobuf:"varint,4,opt,name=entries,proto3" json:"entries,omitempty"`
+	AverageGetLatencyMicroseconds uint64 `protobuf:"varint,5,opt,name=averageGetLatencyMicroseconds,proto3" json:"averageGetLatencyMicroseconds,omitempty"`
+	AverageSetLatencyMicroseconds uint64 `protobuf:"varint,6,opt,name=averageSetLatencyMicroseconds,proto3" json:"averageSetLatencyMicroseconds,omitempty"`
 }
 
-func (*UnimplementedRelayerCacheServer) GetRelay(ctx context.Context, req *RelayCacheGet) (*RelayReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetRelay not implemented")
+func (m *CacheChainStats) Reset()         { *m = CacheChainStats{} }
+func (m *CacheChainStats) String() string { return proto.CompactTextString(m) }
+func (*CacheChainStats) ProtoMessage()    {}
+func (*CacheChainStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2cd8c815c0cb2c9f, []int{4}
 }
-func (*UnimplementedRelayerCacheServer) SetRelay(ctx context.Context, req *RelayCacheSet) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetRelay not implemented")
+func (m *CacheChainStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedRelayerCacheServer) Health(ctx context.Context, req *emptypb.Empty) (*CacheUsage, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Health not implemented")
+func (m *CacheChainStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CacheChainStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func RegisterRelayerCacheServer(s grpc1.Server, srv RelayerCacheServer) {
-	s.RegisterService(&_RelayerCache_serviceDesc, srv)
+func (m *CacheChainStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CacheChainStats.Merge(m, src)
+}
+func (m *CacheChainStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *CacheChainStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_CacheChainStats.DiscardUnknown(m)
 }
 
-func _RelayerCache_GetRelay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RelayCacheGet)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(RelayerCacheServer).GetRelay(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lavanet.lava.pairing.RelayerCache/GetRelay",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RelayerCacheServer).GetRelay(ctx, req.(*RelayCacheGet))
+var xxx_messageInfo_CacheChainStats proto.InternalMessageInfo
+
+func (m *CacheChainStats) GetChainID() string {
+	if m != nil {
+		return m.ChainID
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _RelayerCache_SetRelay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RelayCacheSet)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(RelayerCacheServer).SetRelay(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lavanet.lava.pairing.RelayerCache/SetRelay",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RelayerCacheServer).SetRelay(ctx, req.(*RelayCacheSet))
+func (m *CacheChainStats) GetHits() uint64 {
+	if m != nil {
+		return m.Hits
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _RelayerCache_Health_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *CacheChainStats) GetMisses() uint64 {
+	if m != nil {
+		return m.Misses
 	}
-	if interceptor == nil {
-		return srv.(RelayerCacheServer).Health(ctx, in)
+	return 0
+}
+
+func (m *CacheChainStats) GetEntries() uint64 {
+	if m != nil {
+		return m.Entries
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lavanet.lava.pairing.RelayerCache/Health",
+	return 0
+}
+
+func (m *CacheChainStats) GetAverageGetLatencyMicroseconds() uint64 {
+	if m != nil {
+		return m.AverageGetLatencyMicroseconds
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RelayerCacheServer).Health(ctx, req.(*emptypb.Empty))
+	return 0
+}
+
+func (m *CacheChainStats) GetAverageSetLatencyMicroseconds() uint64 {
+	if m != nil {
+		return m.AverageSetLatencyMicroseconds
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-var _RelayerCache_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "lavanet.lava.pairing.RelayerCache",
-	HandlerType: (*RelayerCacheServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "GetRelay",
-			Handler:    _RelayerCache_GetRelay_Handler,
-		},
-		{
-			MethodName: "SetRelay",
-			Handler:    _RelayerCache_SetRelay_Handler,
-		},
-		{
-			MethodName: "Health",
-			Handler:    _RelayerCache_Health_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "pairing/relayCache.proto",
+type CacheStats struct {
+	Hits      uint64             `protobuf:"varint,1,opt,name=hits,proto3" json:"hits,omitempty"`
+	Misses    uint64             `protobuf:"varint,2,opt,name=misses,proto3" json:"misses,omitempty"`
+	Evictions uint64             `protobuf:"varint,3,opt,name=evictions,proto3" json:"evictions,omitempty"`
+	Chains    []*CacheChainStats `protobuf:"bytes,4,rep,name=chains,proto3" json:"chains,omitempty"`
 }
 
-func (m *CacheUsage) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *CacheStats) Reset()         { *m = CacheStats{} }
+func (m *CacheStats) String() string { return proto.CompactTextString(m) }
+func (*CacheStats) ProtoMessage()    {}
+func (*CacheStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2cd8c815c0cb2c9f, []int{5}
+}
+func (m *CacheStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CacheStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CacheStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *CacheStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CacheStats.Merge(m, src)
+}
+func (m *CacheStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *CacheStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_CacheStats.DiscardUnknown(m)
 }
 
-func (m *CacheUsage) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_CacheStats proto.InternalMessageInfo
+
+func (m *CacheStats) GetHits() uint64 {
+	if m != nil {
+		return m.Hits
+	}
+	return 0
 }
 
-func (m *CacheUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.CacheMisses != 0 {
-		i = encodeVarintRelayCache(dAtA, i, uint64(m.CacheMisses))
-		i--
-		dAtA[i] = 0x10
+func (m *CacheStats) GetMisses() uint64 {
+	if m != nil {
+		return m.Misses
 	}
-	if m.CacheHits != 0 {
-		i = encodeVarintRelayCache(dAtA, i, uint64(m.CacheHits))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *CacheStats) GetEvictions() uint64 {
+	if m != nil {
+		return m.Evictions
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *RelayCacheGet) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *CacheStats) GetChains() []*CacheChainStats {
+	if m != nil {
+		return m.Chains
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *RelayCacheGet) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type CacheFlushRequest struct {
+	ChainID string `protobuf:"bytes,1,opt,name=chainID,proto3" json:"chainID,omitempty"`
 }
 
-func (m *RelayCacheGet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Finalized {
-		i--
-		if m.Finalized {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *CacheFlushRequest) Reset()         { *m = CacheFlushRequest{} }
+func (m *CacheFlushRequest) String() string { return proto.CompactTextString(m) }
+func (*CacheFlushRequest) ProtoMessage()    {}
+func (*CacheFlushRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2cd8c815c0cb2c9f, []int{6}
+}
+func (m *CacheFlushRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CacheFlushRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CacheFlushRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x28
+		return b[:n], nil
 	}
-	if len(m.ChainID) > 0 {
-		i -= len(m.ChainID)
-		copy(dAtA[i:], m.ChainID)
-		i = encodeVarintRelayCache(dAtA, i, uint64(len(m.ChainID)))
-		i--
-		dAtA[i] = 0x22
+}
+func (m *CacheFlushRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CacheFlushRequest.Merge(m, src)
+}
+func (m *CacheFlushRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CacheFlushRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CacheFlushRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CacheFlushRequest proto.InternalMessageInfo
+
+func (m *CacheFlushRequest) GetChainID() string {
+	if m != nil {
+		return m.ChainID
 	}
-	if len(m.BlockHash) > 0 {
-		i -= len(m.BlockHash)
-		copy(dAtA[i:], m.BlockHash)
-		i = encodeVarintRelayCache(dAtA, i, uint64(len(m.BlockHash)))
-		i--
-		dAtA[i] = 0x1a
+	return ""
+}
+
+type CacheHotKeysRequest struct {
+	ChainID string `protobuf:"bytes,1,opt,name=chainID,proto3" json:"chainID,omitempty"`
+	Limit   uint32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (m *CacheHotKeysRequest) Reset()         { *m = CacheHotKeysRequest{} }
+func (m *CacheHotKeysRequest) String() string { return proto.CompactTextString(m) }
+func (*CacheHotKeysRequest) ProtoMessage()    {}
+func (*CacheHotKeysRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2cd8c815c0cb2c9f, []int{7}
+}
+func (m *CacheHotKeysRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CacheHotKeysRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CacheHotKeysRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.ApiInterface) > 0 {
-		i -= len(m.ApiInterface)
-		copy(dAtA[i:], m.ApiInterface)
-		i = encodeVarintRelayCache(dAtA, i, uint64(len(m.ApiInterface)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *CacheHotKeysRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CacheHotKeysRequest.Merge(m, src)
+}
+func (m *CacheHotKeysRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CacheHotKeysRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CacheHotKeysRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CacheHotKeysRequest proto.InternalMessageInfo
+
+func (m *CacheHotKeysRequest) GetChainID() string {
+	if m != nil {
+		return m.ChainID
 	}
-	if m.Request != nil {
-		{
-			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	return ""
+}
+
+func (m *CacheHotKeysRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type CacheHotKey struct {
+	ChainID      string `protobuf:"bytes,1,opt,name=chainID,proto3" json:"chainID,omitempty"`
+	ApiInterface string `protobuf:"bytes,2,opt,name=apiInterface,proto3" json:"apiInterface,omitempty"`
+	ApiUrl       string `protobuf:"bytes,3,opt,name=apiUrl,proto3" json:"apiUrl,omitempty"`
+	Data         string `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	Hits         uint64 `protobuf:"varint,5,opt,name=hits,proto3" json:"hits,omitempty"`
+}
+
+func (m *CacheHotKey) Reset()         { *m = CacheHotKey{} }
+func (m *CacheHotKey) String() string { return proto.CompactTextString(m) }
+func (*CacheHotKey) ProtoMessage()    {}
+func (*CacheHotKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2cd8c815c0cb2c9f, []int{8}
+}
+func (m *CacheHotKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CacheHotKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CacheHotKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CacheHotKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CacheHotKey.Merge(m, src)
+}
+func (m *CacheHotKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *CacheHotKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_CacheHotKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CacheHotKey proto.InternalMessageInfo
+
+func (m *CacheHotKey) GetChainID() string {
+	if m != nil {
+		return m.ChainID
+	}
+	return ""
+}
+
+func (m *CacheHotKey) GetApiInterface() string {
+	if m != nil {
+		return m.ApiInterface
+	}
+	return ""
+}
+
+func (m *CacheHotKey) GetApiUrl() string {
+	if m != nil {
+		return m.ApiUrl
+	}
+	return ""
+}
+
+func (m *CacheHotKey) GetData() string {
+	if m != nil {
+		return m.Data
+	}
+	return ""
+}
+
+func (m *CacheHotKey) GetHits() uint64 {
+	if m != nil {
+		return m.Hits
+	}
+	return 0
+}
+
+type CacheHotKeysResponse struct {
+	Keys []*CacheHotKey `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+}
+
+func (m *CacheHotKeysResponse) Reset()         { *m = CacheHotKeysResponse{} }
+func (m *CacheHotKeysResponse) String() string { return proto.CompactTextString(m) }
+func (*CacheHotKeysResponse) ProtoMessage()    {}
+func (*CacheHotKeysResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2cd8c815c0cb2c9f, []int{9}
+}
+func (m *CacheHotKeysResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CacheHotKeysResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CacheHotKeysResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CacheHotKeysResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CacheHotKeysResponse.Merge(m, src)
+}
+func (m *CacheHotKeysResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CacheHotKeysResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CacheHotKeysResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CacheHotKeysResponse proto.InternalMessageInfo
+
+func (m *CacheHotKeysResponse) GetKeys() []*CacheHotKey {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*CacheUsage)(nil), "lavanet.lava.pairing.CacheUsage")
+	proto.RegisterType((*RelayCacheGet)(nil), "lavanet.lava.pairing.RelayCacheGet")
+	proto.RegisterType((*RelayCacheSet)(nil), "lavanet.lava.pairing.RelayCacheSet")
+	proto.RegisterType((*RelayCacheEntry)(nil), "lavanet.lava.pairing.RelayCacheEntry")
+	proto.RegisterType((*CacheChainStats)(nil), "lavanet.lava.pairing.CacheChainStats")
+	proto.RegisterType((*CacheStats)(nil), "lavanet.lava.pairing.CacheStats")
+	proto.RegisterType((*CacheFlushRequest)(nil), "lavanet.lava.pairing.CacheFlushRequest")
+	proto.RegisterType((*CacheHotKeysRequest)(nil), "lavanet.lava.pairing.CacheHotKeysRequest")
+	proto.RegisterType((*CacheHotKey)(nil), "lavanet.lava.pairing.CacheHotKey")
+	proto.RegisterType((*CacheHotKeysResponse)(nil), "lavanet.lava.pairing.CacheHotKeysResponse")
+}
+
+func init() { proto.RegisterFile("pairing/relayCache.proto", fileDescriptor_2cd8c815c0cb2c9f) }
+
+var fileDescriptor_2cd8c815c0cb2c9f = []byte{
+	// 805 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0xcd, 0x6e, 0xdb, 0x46,
+	0x10, 0x26, 0xf5, 0xaf, 0x91, 0x5d, 0xc3, 0x6b, 0xc1, 0x20, 0x54, 0x57, 0x50, 0xb7, 0x30, 0xac,
+	0x16, 0x28, 0x05, 0xa8, 0xe8, 0xcd, 0x3d, 0xb8, 0x96, 0x6b, 0xab, 0xb5, 0x2f, 0x14, 0x7c, 0xe9,
+	0xa1, 0xc0, 0x8a, 0x5a, 0x53, 0x0b, 0x53, 0x24, 0xcb, 0x5d, 0x19, 0x55, 0x9f, 0x20, 0x40, 0x2e,
+	0xb9, 0xe4, 0x01, 0xf2, 0x34, 0x49, 0x6e, 0x3e, 0xe4, 0x90, 0x63, 0x60, 0x3f, 0x47, 0x80, 0x80,
+	0x4b, 0x52, 0x24, 0x05, 0x59, 0xb2, 0x7d, 0xca, 0x49, 0x9c, 0xd9, 0x99, 0xd9, 0xf9, 0xbe, 0xd9,
+	0x6f, 0x57, 0xa0, 0x79, 0x84, 0xf9, 0xcc, 0xb1, 0x3a, 0x3e, 0xb5, 0xc9, 0xec, 0x98, 0x98, 0x63,
+	0xaa, 0x7b, 0xbe, 0x2b, 0x5c, 0x54, 0xb7, 0xc9, 0x0d, 0x71, 0xa8, 0xd0, 0x83, 0x5f, 0x3d, 0x0a,
+	0x6b, 0xd4, 0x2d, 0xd7, 0x72, 0x65, 0x40, 0x27, 0xf8, 0x0a, 0x63, 0x1b, 0x3b, 0x99, 0x2a, 0x91,
+	0xf3, 0x5b, 0xcb, 0x75, 0x2d, 0x9b, 0x76, 0xa4, 0x35, 0x9c, 0x5e, 0x75, 0xe8, 0xc4, 0x13, 0xd1,
+	0x22, 0x3e, 0x07, 0x90, 0x9b, 0x5d, 0x72, 0x62, 0x51, 0xb4, 0x07, 0x55, 0x69, 0x9d, 0x31, 0xc1,
+	0x35, 0xb5, 0xa5, 0xb6, 0x0b, 0x46, 0xe2, 0x40, 0x2d, 0xa8, 0x49, 0xe3, 0x82, 0x71, 0x4e, 0xb9,
+	0x96, 0x93, 0xeb, 0x69, 0x17, 0x7e, 0xab, 0xc2, 0xa6, 0x31, 0x07, 0x70, 0x4a, 0x05, 0x3a, 0x84,
+	0xb2, 0x4f, 0xff, 0x9d, 0x52, 0x2e, 0x64, 0xbd, 0x5a, 0x17, 0xeb, 0xcb, 0xf0, 0xe8, 0x32, 0xcb,
+	0x08, 0x23, 0x8d, 0x38, 0x05, 0x61, 0xd8, 0x20, 0x1e, 0xeb, 0x3b, 0x82, 0xfa, 0x57, 0xc4, 0xa4,
+	0x72, 0xcb, 0xaa, 0x91, 0xf1, 0x05, 0x3d, 0x0f, 0x6d, 0xd7, 0xbc, 0x3e, 0x23, 0x7c, 0xac, 0xe5,
+	0x5b, 0x6a, 0x7b, 0xc3, 0x48, 0x1c, 0x48, 0x83, 0xb2, 0x39, 0x26, 0xcc, 0xe9, 0xf7, 0xb4, 0x82,
+	0x4c, 0x8e, 0xcd, 0x20, 0xef, 0x8a, 0x39, 0xc4, 0x66, 0xff, 0xd3, 0x91, 0x56, 0x6c, 0xa9, 0xed,
+	0x8a, 0x91, 0x38, 0xf0, 0x9b, 0x5c, 0x1a, 0xc9, 0xe0, 0xab, 0x46, 0xd2, 0x80, 0xca, 0x70, 0x6a,
+	0x5e, 0x53, 0xd1, 0xef, 0x49, 0x20, 0x55, 0x63, 0x6e, 0xa3, 0x43, 0xa8, 0xf8, 0x94, 0x7b, 0xae,
+	0xc3, 0xa9, 0x56, 0x92, 0x6d, 0xb7, 0x56, 0xb6, 0xed, 0xd9, 0x33, 0x63, 0x9e, 0x91, 0xe5, 0xa8,
+	0xbc, 0xc8, 0xd1, 0x7b, 0x15, 0xb6, 0x12, 0x8e, 0x4e, 0x1c, 0xe1, 0xcf, 0x32, 0xfb, 0xa9, 0xcf,
+	0xd9, 0x2f, 0x61, 0x20, 0xb7, 0xc8, 0x40, 0xa6, 0x9b, 0xfc, 0x42, 0x37, 0xe9, 0xf9, 0x14, 0x9e,
+	0x3c, 0x1f, 0xfc, 0x59, 0x85, 0x2d, 0x09, 0xe3, 0x38, 0x20, 0x75, 0x20, 0x88, 0xe0, 0x69, 0xc6,
+	0xd5, 0x2c, 0xe3, 0x08, 0x0a, 0xe3, 0x40, 0x22, 0xa1, 0x04, 0xe4, 0x37, 0xda, 0x85, 0xd2, 0x24,
+	0x14, 0x46, 0x5e, 0x7a, 0x23, 0x2b, 0xa8, 0x42, 0x1d, 0xe1, 0x33, 0xca, 0x65, 0x5f, 0x05, 0x23,
+	0x36, 0x51, 0x0f, 0xbe, 0x23, 0x37, 0xd4, 0x27, 0x56, 0xa0, 0x94, 0x73, 0x22, 0xa8, 0x63, 0xce,
+	0x2e, 0x98, 0xe9, 0xbb, 0x9c, 0x9a, 0xae, 0x33, 0xe2, 0x72, 0x98, 0x05, 0x63, 0x75, 0x50, 0xaa,
+	0xca, 0x60, 0x79, 0x95, 0x52, 0xa6, 0xca, 0xf2, 0x20, 0xfc, 0x5a, 0x8d, 0x2e, 0x82, 0x10, 0x7a,
+	0x0c, 0x50, 0x5d, 0x0a, 0x30, 0x97, 0x01, 0xb8, 0x07, 0x55, 0x7a, 0xc3, 0x4c, 0xc1, 0x5c, 0x27,
+	0xc6, 0x9e, 0x38, 0xd0, 0x6f, 0x50, 0x92, 0xac, 0x05, 0xe8, 0xf3, 0xed, 0x5a, 0x77, 0x7f, 0xf9,
+	0x54, 0x16, 0xb8, 0x37, 0xa2, 0x24, 0xfc, 0x33, 0x6c, 0xcb, 0xa5, 0x3f, 0xec, 0x29, 0x1f, 0x47,
+	0x53, 0x7b, 0x78, 0x30, 0xf8, 0x04, 0x76, 0xc2, 0xfb, 0xca, 0x15, 0x7f, 0xd1, 0x19, 0x5f, 0x9b,
+	0x80, 0xea, 0x50, 0xb4, 0xd9, 0x84, 0x09, 0x89, 0x69, 0xd3, 0x08, 0x0d, 0xfc, 0x52, 0x85, 0x5a,
+	0xaa, 0xce, 0x8a, 0xfc, 0xc7, 0xe8, 0x7a, 0x17, 0x4a, 0xc4, 0x63, 0x97, 0xbe, 0x2d, 0xd9, 0xa9,
+	0x1a, 0x91, 0x15, 0x90, 0x3c, 0x22, 0x82, 0x44, 0x72, 0x96, 0xdf, 0x73, 0xe2, 0x8b, 0x09, 0xf1,
+	0xf8, 0x02, 0xea, 0x59, 0x50, 0x91, 0x5a, 0x7e, 0x85, 0xc2, 0x35, 0x9d, 0x05, 0x43, 0x0a, 0x88,
+	0xfd, 0x7e, 0x05, 0xb1, 0x61, 0xa6, 0x21, 0xc3, 0xbb, 0x1f, 0x72, 0xb0, 0x21, 0x45, 0x40, 0x7d,
+	0xb9, 0x88, 0x06, 0x50, 0x39, 0xa5, 0x42, 0xba, 0xd0, 0x0f, 0x2b, 0x44, 0x13, 0x5f, 0xea, 0x8d,
+	0xb5, 0x92, 0xc6, 0x0a, 0xfa, 0x07, 0xbe, 0x39, 0xa5, 0x62, 0xc0, 0x2c, 0x87, 0x8e, 0x9e, 0x50,
+	0x7a, 0x7f, 0x5d, 0x90, 0xbc, 0x66, 0xb0, 0x82, 0xfa, 0x50, 0x19, 0x3c, 0xba, 0xe9, 0x01, 0x15,
+	0x8d, 0x5d, 0x3d, 0x7c, 0x07, 0xf5, 0xf8, 0x1d, 0xd4, 0x4f, 0x82, 0x77, 0x10, 0x2b, 0xa8, 0x07,
+	0xa5, 0x33, 0x4a, 0x6c, 0x31, 0x46, 0x0f, 0xc4, 0x3c, 0x04, 0x38, 0x79, 0x39, 0xb1, 0xd2, 0x7d,
+	0x91, 0x83, 0xed, 0x34, 0xad, 0x47, 0xa3, 0x09, 0x73, 0xd0, 0x31, 0x14, 0x43, 0x45, 0x3d, 0xa7,
+	0xb4, 0xcc, 0xc4, 0x0a, 0xfa, 0x13, 0x8a, 0xf2, 0xfc, 0xa3, 0x83, 0x15, 0xc1, 0x69, 0x85, 0xac,
+	0x00, 0x3b, 0x84, 0x72, 0x74, 0x8e, 0xd0, 0x8f, 0x6b, 0x4f, 0x4c, 0x2c, 0xa0, 0xc6, 0x4f, 0x8f,
+	0x09, 0x0d, 0x8f, 0x25, 0x56, 0x7e, 0x3f, 0x7a, 0x77, 0xd7, 0x54, 0x6f, 0xef, 0x9a, 0xea, 0xa7,
+	0xbb, 0xa6, 0xfa, 0xea, 0xbe, 0xa9, 0xdc, 0xde, 0x37, 0x95, 0x8f, 0xf7, 0x4d, 0xe5, 0xef, 0x03,
+	0x8b, 0x89, 0xf1, 0x74, 0xa8, 0x9b, 0xee, 0xa4, 0x13, 0x55, 0x94, 0xbf, 0x9d, 0xff, 0x3a, 0xf1,
+	0x5f, 0x17, 0x31, 0xf3, 0x28, 0x1f, 0x96, 0x64, 0xe3, 0xbf, 0x7c, 0x19, 0x00, 0x84, 0xba, 0xaf,
+	0x72, 0x18, 0x09, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// RelayerCacheClient is the client API for RelayerCache service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type RelayerCacheClient interface {
+	GetRelay(ctx context.Context, in *RelayCacheGet, opts ...grpc.CallOption) (*RelayReply, error)
+	GetSignedRelay(ctx context.Context, in *RelayCacheGet, opts ...grpc.CallOption) (*RelayCacheEntry, error)
+	SetRelay(ctx context.Context, in *RelayCacheSet, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	Health(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CacheUsage, error)
+}
+
+type relayerCacheClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewRelayerCacheClient(cc grpc1.ClientConn) RelayerCacheClient {
+	return &relayerCacheClient{cc}
+}
+
+func (c *relayerCacheClient) GetRelay(ctx context.Context, in *RelayCacheGet, opts ...grpc.CallOption) (*RelayReply, error) {
+	out := new(RelayReply)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.RelayerCache/GetRelay", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relayerCacheClient) GetSignedRelay(ctx context.Context, in *RelayCacheGet, opts ...grpc.CallOption) (*RelayCacheEntry, error) {
+	out := new(RelayCacheEntry)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.RelayerCache/GetSignedRelay", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relayerCacheClient) SetRelay(ctx context.Context, in *RelayCacheSet, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.RelayerCache/SetRelay", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relayerCacheClient) Health(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CacheUsage, error) {
+	out := new(CacheUsage)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.RelayerCache/Health", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RelayerCacheServer is the server API for RelayerCache service.
+type RelayerCacheServer interface {
+	GetRelay(context.Context, *RelayCacheGet) (*RelayReply, error)
+	GetSignedRelay(context.Context, *RelayCacheGet) (*RelayCacheEntry, error)
+	SetRelay(context.Context, *RelayCacheSet) (*emptypb.Empty, error)
+	Health(context.Context, *emptypb.Empty) (*CacheUsage, error)
+}
+
+// UnimplementedRelayerCacheServer can be embedded to have forward compatible implementations.
+type UnimplementedRelayerCacheServer struct {
+}
+
+func (*UnimplementedRelayerCacheServer) GetRelay(ctx context.Context, req *RelayCacheGet) (*RelayReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRelay not implemented")
+}
+func (*UnimplementedRelayerCacheServer) GetSignedRelay(ctx context.Context, req *RelayCacheGet) (*RelayCacheEntry, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSignedRelay not implemented")
+}
+func (*UnimplementedRelayerCacheServer) SetRelay(ctx context.Context, req *RelayCacheSet) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRelay not implemented")
+}
+func (*UnimplementedRelayerCacheServer) Health(ctx context.Context, req *emptypb.Empty) (*CacheUsage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Health not implemented")
+}
+
+func RegisterRelayerCacheServer(s grpc1.Server, srv RelayerCacheServer) {
+	s.RegisterService(&_RelayerCache_serviceDesc, srv)
+}
+
+func _RelayerCache_GetRelay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RelayCacheGet)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelayerCacheServer).GetRelay(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.pairing.RelayerCache/GetRelay",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelayerCacheServer).GetRelay(ctx, req.(*RelayCacheGet))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RelayerCache_GetSignedRelay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RelayCacheGet)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelayerCacheServer).GetSignedRelay(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.pairing.RelayerCache/GetSignedRelay",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelayerCacheServer).GetSignedRelay(ctx, req.(*RelayCacheGet))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RelayerCache_SetRelay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RelayCacheSet)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelayerCacheServer).SetRelay(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.pairing.RelayerCache/SetRelay",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelayerCacheServer).SetRelay(ctx, req.(*RelayCacheSet))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RelayerCache_Health_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelayerCacheServer).Health(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.pairing.RelayerCache/Health",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelayerCacheServer).Health(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _RelayerCache_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "lavanet.lava.pairing.RelayerCache",
+	HandlerType: (*RelayerCacheServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetRelay",
+			Handler:    _RelayerCache_GetRelay_Handler,
+		},
+		{
+			MethodName: "GetSignedRelay",
+			Handler:    _RelayerCache_GetSignedRelay_Handler,
+		},
+		{
+			MethodName: "SetRelay",
+			Handler:    _RelayerCache_SetRelay_Handler,
+		},
+		{
+			MethodName: "Health",
+			Handler:    _RelayerCache_Health_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pairing/relayCache.proto",
+}
+
+// RelayerCacheAdminClient is the client API for RelayerCacheAdmin service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type RelayerCacheAdminClient interface {
+	Stats(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CacheStats, error)
+	Flush(ctx context.Context, in *CacheFlushRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	HotKeys(ctx context.Context, in *CacheHotKeysRequest, opts ...grpc.CallOption) (*CacheHotKeysResponse, error)
+}
+
+type relayerCacheAdminClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewRelayerCacheAdminClient(cc grpc1.ClientConn) RelayerCacheAdminClient {
+	return &relayerCacheAdminClient{cc}
+}
+
+func (c *relayerCacheAdminClient) Stats(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CacheStats, error) {
+	out := new(CacheStats)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.RelayerCacheAdmin/Stats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relayerCacheAdminClient) Flush(ctx context.Context, in *CacheFlushRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.RelayerCacheAdmin/Flush", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relayerCacheAdminClient) HotKeys(ctx context.Context, in *CacheHotKeysRequest, opts ...grpc.CallOption) (*CacheHotKeysResponse, error) {
+	out := new(CacheHotKeysResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.RelayerCacheAdmin/HotKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RelayerCacheAdminServer is the server API for RelayerCacheAdmin service.
+type RelayerCacheAdminServer interface {
+	Stats(context.Context, *emptypb.Empty) (*CacheStats, error)
+	Flush(context.Context, *CacheFlushRequest) (*emptypb.Empty, error)
+	HotKeys(context.Context, *CacheHotKeysRequest) (*CacheHotKeysResponse, error)
+}
+
+// UnimplementedRelayerCacheAdminServer can be embedded to have forward compatible implementations.
+type UnimplementedRelayerCacheAdminServer struct {
+}
+
+func (*UnimplementedRelayerCacheAdminServer) Stats(ctx context.Context, req *emptypb.Empty) (*CacheStats, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Stats not implemented")
+}
+func (*UnimplementedRelayerCacheAdminServer) Flush(ctx context.Context, req *CacheFlushRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Flush not implemented")
+}
+func (*UnimplementedRelayerCacheAdminServer) HotKeys(ctx context.Context, req *CacheHotKeysRequest) (*CacheHotKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HotKeys not implemented")
+}
+
+func RegisterRelayerCacheAdminServer(s grpc1.Server, srv RelayerCacheAdminServer) {
+	s.RegisterService(&_RelayerCacheAdmin_serviceDesc, srv)
+}
+
+func _RelayerCacheAdmin_Stats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelayerCacheAdminServer).Stats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.pairing.RelayerCacheAdmin/Stats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelayerCacheAdminServer).Stats(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RelayerCacheAdmin_Flush_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CacheFlushRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelayerCacheAdminServer).Flush(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.pairing.RelayerCacheAdmin/Flush",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelayerCacheAdminServer).Flush(ctx, req.(*CacheFlushRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RelayerCacheAdmin_HotKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CacheHotKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelayerCacheAdminServer).HotKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.pairing.RelayerCacheAdmin/HotKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelayerCacheAdminServer).HotKeys(ctx, req.(*CacheHotKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _RelayerCacheAdmin_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "lavanet.lava.pairing.RelayerCacheAdmin",
+	HandlerType: (*RelayerCacheAdminServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Stats",
+			Handler:    _RelayerCacheAdmin_Stats_Handler,
+		},
+		{
+			MethodName: "Flush",
+			Handler:    _RelayerCacheAdmin_Flush_Handler,
+		},
+		{
+			MethodName: "HotKeys",
+			Handler:    _RelayerCacheAdmin_HotKeys_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pairing/relayCache.proto",
+}
+
+func (m *CacheUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CacheUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CacheUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CacheMisses != 0 {
+		i = encodeVarintRelayCache(dAtA, i, uint64(m.CacheMisses))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.CacheHits != 0 {
+		i = encodeVarintRelayCache(dAtA, i, uint64(m.CacheHits))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RelayCacheGet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RelayCacheGet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RelayCacheGet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Finalized {
+		i--
+		if m.Finalized {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintRelayCache(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.BlockHash) > 0 {
+		i -= len(m.BlockHash)
+		copy(dAtA[i:], m.BlockHash)
+		i = encodeVarintRelayCache(dAtA, i, uint64(len(m.BlockHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ApiInterface) > 0 {
+		i -= len(m.ApiInterface)
+		copy(dAtA[i:], m.ApiInterface)
+		i = encodeVarintRelayCache(dAtA, i, uint64(len(m.ApiInterface)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRelayCache(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RelayCacheSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RelayCacheSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RelayCacheSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Finalized {
+		i--
+		if m.Finalized {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Response != nil {
+		{
+			size, err := m.Response.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRelayCache(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.BucketID) > 0 {
+		i -= len(m.BucketID)
+		copy(dAtA[i:], m.BucketID)
+		i = encodeVarintRelayCache(dAtA, i, uint64(len(m.BucketID)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintRelayCache(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.BlockHash) > 0 {
+		i -= len(m.BlockHash)
+		copy(dAtA[i:], m.BlockHash)
+		i = encodeVarintRelayCache(dAtA, i, uint64(len(m.BlockHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ApiInterface) > 0 {
+		i -= len(m.ApiInterface)
+		copy(dAtA[i:], m.ApiInterface)
+		i = encodeVarintRelayCache(dAtA, i, uint64(len(m.ApiInterface)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRelayCache(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RelayCacheEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RelayCacheEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RelayCacheEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRelayCache(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Finalized {
+		i--
+		if m.Finalized {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.BlockHash) > 0 {
+		i -= len(m.BlockHash)
+		copy(dAtA[i:], m.BlockHash)
+		i = encodeVarintRelayCache(dAtA, i, uint64(len(m.BlockHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Response != nil {
+		{
+			size, err := m.Response.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRelayCache(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CacheChainStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CacheChainStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CacheChainStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AverageSetLatencyMicroseconds != 0 {
+		i = encodeVarintRelayCache(dAtA, i, uint64(m.AverageSetLatencyMicroseconds))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.AverageGetLatencyMicroseconds != 0 {
+		i = encodeVarintRelayCache(dAtA, i, uint64(m.AverageGetLatencyMicroseconds))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Entries != 0 {
+		i = encodeVarintRelayCache(dAtA, i, uint64(m.Entries))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Misses != 0 {
+		i = encodeVarintRelayCache(dAtA, i, uint64(m.Misses))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Hits != 0 {
+		i = encodeVarintRelayCache(dAtA, i, uint64(m.Hits))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintRelayCache(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CacheStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CacheStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CacheStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Chains) > 0 {
+		for iNdEx := len(m.Chains) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Chains[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRelayCache(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Evictions != 0 {
+		i = encodeVarintRelayCache(dAtA, i, uint64(m.Evictions))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Misses != 0 {
+		i = encodeVarintRelayCache(dAtA, i, uint64(m.Misses))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Hits != 0 {
+		i = encodeVarintRelayCache(dAtA, i, uint64(m.Hits))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CacheFlushRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CacheFlushRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CacheFlushRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintRelayCache(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CacheHotKeysRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CacheHotKeysRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CacheHotKeysRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		i = encodeVarintRelayCache(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintRelayCache(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CacheHotKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CacheHotKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CacheHotKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Hits != 0 {
+		i = encodeVarintRelayCache(dAtA, i, uint64(m.Hits))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintRelayCache(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ApiUrl) > 0 {
+		i -= len(m.ApiUrl)
+		copy(dAtA[i:], m.ApiUrl)
+		i = encodeVarintRelayCache(dAtA, i, uint64(len(m.ApiUrl)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ApiInterface) > 0 {
+		i -= len(m.ApiInterface)
+		copy(dAtA[i:], m.ApiInterface)
+		i = encodeVarintRelayCache(dAtA, i, uint64(len(m.ApiInterface)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintRelayCache(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CacheHotKeysResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CacheHotKeysResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CacheHotKeysResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Keys) > 0 {
+		for iNdEx := len(m.Keys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Keys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRelayCache(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRelayCache(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRelayCache(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *CacheUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CacheHits != 0 {
+		n += 1 + sovRelayCache(uint64(m.CacheHits))
+	}
+	if m.CacheMisses != 0 {
+		n += 1 + sovRelayCache(uint64(m.CacheMisses))
+	}
+	return n
+}
+
+func (m *RelayCacheGet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 1 + l + sovRelayCache(uint64(l))
+	}
+	l = len(m.ApiInterface)
+	if l > 0 {
+		n += 1 + l + sovRelayCache(uint64(l))
+	}
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sovRelayCache(uint64(l))
+	}
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovRelayCache(uint64(l))
+	}
+	if m.Finalized {
+		n += 2
+	}
+	return n
+}
+
+func (m *RelayCacheSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 1 + l + sovRelayCache(uint64(l))
+	}
+	l = len(m.ApiInterface)
+	if l > 0 {
+		n += 1 + l + sovRelayCache(uint64(l))
+	}
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sovRelayCache(uint64(l))
+	}
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovRelayCache(uint64(l))
+	}
+	l = len(m.BucketID)
+	if l > 0 {
+		n += 1 + l + sovRelayCache(uint64(l))
+	}
+	if m.Response != nil {
+		l = m.Response.Size()
+		n += 1 + l + sovRelayCache(uint64(l))
+	}
+	if m.Finalized {
+		n += 2
+	}
+	return n
+}
+
+func (m *RelayCacheEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Response != nil {
+		l = m.Response.Size()
+		n += 1 + l + sovRelayCache(uint64(l))
+	}
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sovRelayCache(uint64(l))
+	}
+	if m.Finalized {
+		n += 2
+	}
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 1 + l + sovRelayCache(uint64(l))
+	}
+	return n
+}
+
+func (m *CacheChainStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovRelayCache(uint64(l))
+	}
+	if m.Hits != 0 {
+		n += 1 + sovRelayCache(uint64(m.Hits))
+	}
+	if m.Misses != 0 {
+		n += 1 + sovRelayCache(uint64(m.Misses))
+	}
+	if m.Entries != 0 {
+		n += 1 + sovRelayCache(uint64(m.Entries))
+	}
+	if m.AverageGetLatencyMicroseconds != 0 {
+		n += 1 + sovRelayCache(uint64(m.AverageGetLatencyMicroseconds))
+	}
+	if m.AverageSetLatencyMicroseconds != 0 {
+		n += 1 + sovRelayCache(uint64(m.AverageSetLatencyMicroseconds))
+	}
+	return n
+}
+
+func (m *CacheStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Hits != 0 {
+		n += 1 + sovRelayCache(uint64(m.Hits))
+	}
+	if m.Misses != 0 {
+		n += 1 + sovRelayCache(uint64(m.Misses))
+	}
+	if m.Evictions != 0 {
+		n += 1 + sovRelayCache(uint64(m.Evictions))
+	}
+	if len(m.Chains) > 0 {
+		for _, e := range m.Chains {
+			l = e.Size()
+			n += 1 + l + sovRelayCache(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CacheFlushRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovRelayCache(uint64(l))
+	}
+	return n
+}
+
+func (m *CacheHotKeysRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovRelayCache(uint64(l))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovRelayCache(uint64(m.Limit))
+	}
+	return n
+}
+
+func (m *CacheHotKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovRelayCache(uint64(l))
+	}
+	l = len(m.ApiInterface)
+	if l > 0 {
+		n += 1 + l + sovRelayCache(uint64(l))
+	}
+	l = len(m.ApiUrl)
+	if l > 0 {
+		n += 1 + l + sovRelayCache(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovRelayCache(uint64(l))
+	}
+	if m.Hits != 0 {
+		n += 1 + sovRelayCache(uint64(m.Hits))
+	}
+	return n
+}
+
+func (m *CacheHotKeysResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Keys) > 0 {
+		for _, e := range m.Keys {
+			l = e.Size()
+			n += 1 + l + sovRelayCache(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovRelayCache(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRelayCache(x uint64) (n int) {
+	return sovRelayCache(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *CacheUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRelayCache
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CacheUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CacheUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CacheHits", wireType)
+			}
+			m.CacheHits = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelayCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CacheHits |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CacheMisses", wireType)
+			}
+			m.CacheMisses = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelayCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CacheMisses |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRelayCache(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRelayCache
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RelayCacheGet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRelayCache
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RelayCacheGet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RelayCacheGet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelayCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRelayCache
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRelayCache
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Request == nil {
+				m.Request = &RelayRequest{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiInterface", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelayCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRelayCache
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRelayCache
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiInterface = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelayCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRelayCache
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRelayCache
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockHash = append(m.BlockHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockHash == nil {
+				m.BlockHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelayCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRelayCache
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRelayCache
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Finalized", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelayCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Finalized = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRelayCache(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRelayCache
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RelayCacheSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRelayCache
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RelayCacheSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RelayCacheSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelayCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRelayCache
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRelayCache
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Request == nil {
+				m.Request = &RelayRequest{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiInterface", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelayCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRelayCache
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRelayCache
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiInterface = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelayCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRelayCache
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRelayCache
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockHash = append(m.BlockHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockHash == nil {
+				m.BlockHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelayCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRelayCache
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRelayCache
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelayCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRelayCache
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRelayCache
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BucketID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Response", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelayCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRelayCache
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRelayCache
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Response == nil {
+				m.Response = &RelayReply{}
+			}
+			if err := m.Response.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Finalized", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelayCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Finalized = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRelayCache(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRelayCache
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RelayCacheEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRelayCache
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RelayCacheEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RelayCacheEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Response", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelayCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRelayCache
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRelayCache
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Response == nil {
+				m.Response = &RelayReply{}
+			}
+			if err := m.Response.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelayCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRelayCache
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRelayCache
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockHash = append(m.BlockHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockHash == nil {
+				m.BlockHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Finalized", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelayCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Finalized = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelayCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRelayCache
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRelayCache
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Request == nil {
+				m.Request = &RelayRequest{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRelayCache(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRelayCache
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CacheChainStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRelayCache
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CacheChainStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CacheChainStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelayCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRelayCache
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRelayCache
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hits", wireType)
+			}
+			m.Hits = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelayCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Hits |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Misses", wireType)
+			}
+			m.Misses = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelayCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Misses |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			m.Entries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelayCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Entries |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AverageGetLatencyMicroseconds", wireType)
+			}
+			m.AverageGetLatencyMicroseconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelayCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AverageGetLatencyMicroseconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			i -= size
-			i = encodeVarintRelayCache(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *RelayCacheSet) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *RelayCacheSet) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *RelayCacheSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Finalized {
-		i--
-		if m.Finalized {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x38
-	}
-	if m.Response != nil {
-		{
-			size, err := m.Response.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AverageSetLatencyMicroseconds", wireType)
 			}
-			i -= size
-			i = encodeVarintRelayCache(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.BucketID) > 0 {
-		i -= len(m.BucketID)
-		copy(dAtA[i:], m.BucketID)
-		i = encodeVarintRelayCache(dAtA, i, uint64(len(m.BucketID)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.ChainID) > 0 {
-		i -= len(m.ChainID)
-		copy(dAtA[i:], m.ChainID)
-		i = encodeVarintRelayCache(dAtA, i, uint64(len(m.ChainID)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.BlockHash) > 0 {
-		i -= len(m.BlockHash)
-		copy(dAtA[i:], m.BlockHash)
-		i = encodeVarintRelayCache(dAtA, i, uint64(len(m.BlockHash)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.ApiInterface) > 0 {
-		i -= len(m.ApiInterface)
-		copy(dAtA[i:], m.ApiInterface)
-		i = encodeVarintRelayCache(dAtA, i, uint64(len(m.ApiInterface)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Request != nil {
-		{
-			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			m.AverageSetLatencyMicroseconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelayCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AverageSetLatencyMicroseconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRelayCache(dAtA[iNdEx:])
 			if err != nil {
-				return 0, err
+				return err
 			}
-			i -= size
-			i = encodeVarintRelayCache(dAtA, i, uint64(size))
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRelayCache
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintRelayCache(dAtA []byte, offset int, v uint64) int {
-	offset -= sovRelayCache(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *CacheUsage) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.CacheHits != 0 {
-		n += 1 + sovRelayCache(uint64(m.CacheHits))
-	}
-	if m.CacheMisses != 0 {
-		n += 1 + sovRelayCache(uint64(m.CacheMisses))
-	}
-	return n
-}
-
-func (m *RelayCacheGet) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Request != nil {
-		l = m.Request.Size()
-		n += 1 + l + sovRelayCache(uint64(l))
-	}
-	l = len(m.ApiInterface)
-	if l > 0 {
-		n += 1 + l + sovRelayCache(uint64(l))
-	}
-	l = len(m.BlockHash)
-	if l > 0 {
-		n += 1 + l + sovRelayCache(uint64(l))
-	}
-	l = len(m.ChainID)
-	if l > 0 {
-		n += 1 + l + sovRelayCache(uint64(l))
-	}
-	if m.Finalized {
-		n += 2
 	}
-	return n
-}
 
-func (m *RelayCacheSet) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Request != nil {
-		l = m.Request.Size()
-		n += 1 + l + sovRelayCache(uint64(l))
-	}
-	l = len(m.ApiInterface)
-	if l > 0 {
-		n += 1 + l + sovRelayCache(uint64(l))
-	}
-	l = len(m.BlockHash)
-	if l > 0 {
-		n += 1 + l + sovRelayCache(uint64(l))
-	}
-	l = len(m.ChainID)
-	if l > 0 {
-		n += 1 + l + sovRelayCache(uint64(l))
-	}
-	l = len(m.BucketID)
-	if l > 0 {
-		n += 1 + l + sovRelayCache(uint64(l))
-	}
-	if m.Response != nil {
-		l = m.Response.Size()
-		n += 1 + l + sovRelayCache(uint64(l))
-	}
-	if m.Finalized {
-		n += 2
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovRelayCache(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozRelayCache(x uint64) (n int) {
-	return sovRelayCache(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *CacheUsage) Unmarshal(dAtA []byte) error {
+func (m *CacheStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -744,17 +2784,17 @@ func (m *CacheUsage) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CacheUsage: wiretype end group for non-group")
+			return fmt.Errorf("proto: CacheStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CacheUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CacheStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CacheHits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hits", wireType)
 			}
-			m.CacheHits = 0
+			m.Hits = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRelayCache
@@ -764,16 +2804,54 @@ func (m *CacheUsage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CacheHits |= uint64(b&0x7F) << shift
+				m.Hits |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CacheMisses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Misses", wireType)
+			}
+			m.Misses = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelayCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Misses |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Evictions", wireType)
+			}
+			m.Evictions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelayCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Evictions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chains", wireType)
 			}
-			m.CacheMisses = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRelayCache
@@ -783,11 +2861,26 @@ func (m *CacheUsage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CacheMisses |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthRelayCache
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRelayCache
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chains = append(m.Chains, &CacheChainStats{})
+			if err := m.Chains[len(m.Chains)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRelayCache(dAtA[iNdEx:])
@@ -809,7 +2902,7 @@ func (m *CacheUsage) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RelayCacheGet) Unmarshal(dAtA []byte) error {
+func (m *CacheFlushRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -832,51 +2925,15 @@ func (m *RelayCacheGet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RelayCacheGet: wiretype end group for non-group")
+			return fmt.Errorf("proto: CacheFlushRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RelayCacheGet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CacheFlushRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRelayCache
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthRelayCache
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRelayCache
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Request == nil {
-				m.Request = &RelayRequest{}
-			}
-			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApiInterface", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -904,43 +2961,59 @@ func (m *RelayCacheGet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ApiInterface = string(dAtA[iNdEx:postIndex])
+			m.ChainID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRelayCache
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRelayCache(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if byteLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthRelayCache
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRelayCache
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CacheHotKeysRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRelayCache
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BlockHash = append(m.BlockHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.BlockHash == nil {
-				m.BlockHash = []byte{}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 4:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CacheHotKeysRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CacheHotKeysRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
 			}
@@ -972,11 +3045,11 @@ func (m *RelayCacheGet) Unmarshal(dAtA []byte) error {
 			}
 			m.ChainID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Finalized", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 			}
-			var v int
+			m.Limit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRelayCache
@@ -986,12 +3059,11 @@ func (m *RelayCacheGet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Limit |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Finalized = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRelayCache(dAtA[iNdEx:])
@@ -1013,7 +3085,7 @@ func (m *RelayCacheGet) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RelayCacheSet) Unmarshal(dAtA []byte) error {
+func (m *CacheHotKey) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1036,17 +3108,17 @@ func (m *RelayCacheSet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RelayCacheSet: wiretype end group for non-group")
+			return fmt.Errorf("proto: CacheHotKey: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RelayCacheSet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CacheHotKey: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRelayCache
@@ -1056,27 +3128,23 @@ func (m *RelayCacheSet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRelayCache
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRelayCache
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Request == nil {
-				m.Request = &RelayRequest{}
-			}
-			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -1112,9 +3180,9 @@ func (m *RelayCacheSet) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiUrl", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRelayCache
@@ -1124,29 +3192,27 @@ func (m *RelayCacheSet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRelayCache
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRelayCache
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BlockHash = append(m.BlockHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.BlockHash == nil {
-				m.BlockHash = []byte{}
-			}
+			m.ApiUrl = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1174,13 +3240,13 @@ func (m *RelayCacheSet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainID = string(dAtA[iNdEx:postIndex])
+			m.Data = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketID", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hits", wireType)
 			}
-			var stringLen uint64
+			m.Hits = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRelayCache
@@ -1190,27 +3256,64 @@ func (m *RelayCacheSet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Hits |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRelayCache
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRelayCache(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthRelayCache
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BucketID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CacheHotKeysResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRelayCache
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CacheHotKeysResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CacheHotKeysResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Response", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1237,33 +3340,11 @@ func (m *RelayCacheSet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Response == nil {
-				m.Response = &RelayReply{}
-			}
-			if err := m.Response.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Keys = append(m.Keys, &CacheHotKey{})
+			if err := m.Keys[len(m.Keys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Finalized", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRelayCache
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Finalized = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRelayCache(dAtA[iNdEx:])
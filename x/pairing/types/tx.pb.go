@@ -37,6 +37,7 @@ type MsgStakeProvider struct {
 	Endpoints   []types1.Endpoint `protobuf:"bytes,4,rep,name=endpoints,proto3" json:"endpoints"`
 	Geolocation uint64            `protobuf:"varint,5,opt,name=geolocation,proto3" json:"geolocation,omitempty"`
 	Moniker     string            `protobuf:"bytes,6,opt,name=moniker,proto3" json:"moniker,omitempty"`
+	CuCapacity  uint64            `protobuf:"varint,7,opt,name=cuCapacity,proto3" json:"cuCapacity,omitempty"`
 }
 
 func (m *MsgStakeProvider) Reset()         { *m = MsgStakeProvider{} }
@@ -114,6 +115,13 @@ func (m *MsgStakeProvider) GetMoniker() string {
 	return ""
 }
 
+func (m *MsgStakeProvider) GetCuCapacity() uint64 {
+	if m != nil {
+		return m.CuCapacity
+	}
+	return 0
+}
+
 type MsgStakeProviderResponse struct {
 }
 
@@ -543,9 +551,11 @@ func (m *MsgRelayPaymentResponse) XXX_DiscardUnknown() {
 var xxx_messageInfo_MsgRelayPaymentResponse proto.InternalMessageInfo
 
 type MsgFreezeProvider struct {
-	Creator  string   `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	ChainIds []string `protobuf:"bytes,2,rep,name=chainIds,proto3" json:"chainIds,omitempty"`
-	Reason   string   `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	Creator       string   `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ChainIds      []string `protobuf:"bytes,2,rep,name=chainIds,proto3" json:"chainIds,omitempty"`
+	Reason        string   `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	UnfreezeBlock uint64   `protobuf:"varint,4,opt,name=unfreezeBlock,proto3" json:"unfreezeBlock,omitempty"`
+	FreezeEpochs  uint64   `protobuf:"varint,5,opt,name=freezeEpochs,proto3" json:"freezeEpochs,omitempty"`
 }
 
 func (m *MsgFreezeProvider) Reset()         { *m = MsgFreezeProvider{} }
@@ -602,6 +612,20 @@ func (m *MsgFreezeProvider) GetReason() string {
 	return ""
 }
 
+func (m *MsgFreezeProvider) GetUnfreezeBlock() uint64 {
+	if m != nil {
+		return m.UnfreezeBlock
+	}
+	return 0
+}
+
+func (m *MsgFreezeProvider) GetFreezeEpochs() uint64 {
+	if m != nil {
+		return m.FreezeEpochs
+	}
+	return 0
+}
+
 type MsgFreezeProviderResponse struct {
 }
 
@@ -726,826 +750,975 @@ func (m *MsgUnfreezeProviderResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUnfreezeProviderResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgStakeProvider)(nil), "lavanet.lava.pairing.MsgStakeProvider")
-	proto.RegisterType((*MsgStakeProviderResponse)(nil), "lavanet.lava.pairing.MsgStakeProviderResponse")
-	proto.RegisterType((*MsgStakeClient)(nil), "lavanet.lava.pairing.MsgStakeClient")
-	proto.RegisterType((*MsgStakeClientResponse)(nil), "lavanet.lava.pairing.MsgStakeClientResponse")
-	proto.RegisterType((*MsgUnstakeProvider)(nil), "lavanet.lava.pairing.MsgUnstakeProvider")
-	proto.RegisterType((*MsgUnstakeProviderResponse)(nil), "lavanet.lava.pairing.MsgUnstakeProviderResponse")
-	proto.RegisterType((*MsgUnstakeClient)(nil), "lavanet.lava.pairing.MsgUnstakeClient")
-	proto.RegisterType((*MsgUnstakeClientResponse)(nil), "lavanet.lava.pairing.MsgUnstakeClientResponse")
-	proto.RegisterType((*MsgRelayPayment)(nil), "lavanet.lava.pairing.MsgRelayPayment")
-	proto.RegisterType((*MsgRelayPaymentResponse)(nil), "lavanet.lava.pairing.MsgRelayPaymentResponse")
-	proto.RegisterType((*MsgFreezeProvider)(nil), "lavanet.lava.pairing.MsgFreezeProvider")
-	proto.RegisterType((*MsgFreezeProviderResponse)(nil), "lavanet.lava.pairing.MsgFreezeProviderResponse")
-	proto.RegisterType((*MsgUnfreezeProvider)(nil), "lavanet.lava.pairing.MsgUnfreezeProvider")
-	proto.RegisterType((*MsgUnfreezeProviderResponse)(nil), "lavanet.lava.pairing.MsgUnfreezeProviderResponse")
+type MsgDelegateToProvider struct {
+	Creator  string     `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Provider string     `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+	ChainID  string     `protobuf:"bytes,3,opt,name=chainID,proto3" json:"chainID,omitempty"`
+	Amount   types.Coin `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount"`
 }
 
-func init() { proto.RegisterFile("pairing/tx.proto", fileDescriptor_b2db224a5e52fa36) }
-
-var fileDescriptor_b2db224a5e52fa36 = []byte{
-	// 705 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xcd, 0x6e, 0xd3, 0x4c,
-	0x14, 0x8d, 0x9b, 0x34, 0xdf, 0x97, 0x1b, 0xfa, 0xe7, 0x56, 0xc5, 0x75, 0x69, 0x88, 0x0c, 0xb4,
-	0x41, 0x2a, 0x63, 0x52, 0x16, 0x48, 0xec, 0x68, 0x4b, 0x00, 0xa1, 0x48, 0x95, 0x2b, 0xba, 0x60,
-	0x37, 0x49, 0xa6, 0xae, 0x69, 0x32, 0x63, 0x79, 0xa6, 0x51, 0xcb, 0x9a, 0x07, 0xe0, 0x65, 0x78,
-	0x87, 0x2e, 0xbb, 0x64, 0x85, 0x50, 0xbb, 0xe6, 0x1d, 0x90, 0xc7, 0x63, 0x13, 0x3b, 0x69, 0xb1,
-	0x00, 0xb1, 0xb2, 0xef, 0xdc, 0x73, 0xef, 0x99, 0x73, 0x7f, 0x12, 0xc3, 0xbc, 0x8f, 0xbd, 0xc0,
-	0xa3, 0xae, 0x2d, 0x4e, 0x91, 0x1f, 0x30, 0xc1, 0xf4, 0xa5, 0x3e, 0x1e, 0x62, 0x4a, 0x04, 0x0a,
-	0x9f, 0x48, 0xb9, 0xcd, 0x5a, 0x97, 0xf1, 0x01, 0xe3, 0x76, 0x07, 0x73, 0x62, 0x0f, 0x9b, 0x1d,
-	0x22, 0x70, 0xd3, 0xee, 0x32, 0x8f, 0x46, 0x51, 0xe6, 0x92, 0xcb, 0x5c, 0x26, 0x5f, 0xed, 0xf0,
-	0x4d, 0x9d, 0xae, 0x12, 0x9f, 0x75, 0x8f, 0xb8, 0x60, 0x01, 0x76, 0x89, 0x4d, 0x68, 0xcf, 0x67,
-	0x1e, 0x15, 0xca, 0xb9, 0x18, 0x53, 0x07, 0xa4, 0x8f, 0xcf, 0xa2, 0x43, 0xeb, 0xe3, 0x14, 0xcc,
-	0xb7, 0xb9, 0xbb, 0x2f, 0xf0, 0x31, 0xd9, 0x0b, 0xd8, 0xd0, 0xeb, 0x91, 0x40, 0x37, 0xe0, 0xbf,
-	0x6e, 0x40, 0xb0, 0x60, 0x81, 0xa1, 0xd5, 0xb5, 0x46, 0xc5, 0x89, 0x4d, 0xe9, 0x39, 0xc2, 0x1e,
-	0x7d, 0xbd, 0x6b, 0x4c, 0x29, 0x4f, 0x64, 0xea, 0x4f, 0xa1, 0x8c, 0x07, 0xec, 0x84, 0x0a, 0xa3,
-	0x58, 0xd7, 0x1a, 0xd5, 0xad, 0x15, 0x14, 0x29, 0x40, 0xa1, 0x02, 0xa4, 0x14, 0xa0, 0x1d, 0xe6,
-	0xd1, 0xed, 0xd2, 0xf9, 0xd7, 0xbb, 0x05, 0x47, 0xc1, 0xf5, 0x97, 0x50, 0x89, 0x2f, 0xca, 0x8d,
-	0x52, 0xbd, 0xd8, 0xa8, 0x6e, 0xdd, 0x43, 0xa9, 0x9a, 0x8c, 0x8a, 0x42, 0x2f, 0x14, 0x56, 0x65,
-	0xf9, 0x19, 0xab, 0xd7, 0xa1, 0xea, 0x12, 0xd6, 0x67, 0x5d, 0x2c, 0x3c, 0x46, 0x8d, 0xe9, 0xba,
-	0xd6, 0x28, 0x39, 0xa3, 0x47, 0xe1, 0xed, 0x07, 0x8c, 0x7a, 0xc7, 0x24, 0x30, 0xca, 0xd1, 0xed,
-	0x95, 0x69, 0x99, 0x60, 0x64, 0xab, 0xe0, 0x10, 0xee, 0x33, 0xca, 0x89, 0xf5, 0x59, 0x83, 0xd9,
-	0xd8, 0xb9, 0xd3, 0xf7, 0x08, 0x15, 0xff, 0xb6, 0x40, 0x19, 0x5d, 0xa5, 0x71, 0x5d, 0x4b, 0x30,
-	0x3d, 0x0c, 0x0e, 0xfd, 0x63, 0xa9, 0xb9, 0xe2, 0x44, 0x86, 0x65, 0xc0, 0x72, 0xfa, 0xda, 0x89,
-	0xa2, 0x57, 0xa0, 0xb7, 0xb9, 0xfb, 0x96, 0xf2, 0x3f, 0xed, 0xba, 0x75, 0x07, 0xcc, 0xf1, 0x4c,
-	0x09, 0x4f, 0x4b, 0xce, 0x96, 0xf2, 0xfe, 0x7e, 0xe9, 0x54, 0x77, 0x52, 0x79, 0x12, 0x8e, 0x73,
-	0x0d, 0xe6, 0xda, 0xdc, 0x75, 0xc2, 0x99, 0xde, 0xc3, 0x67, 0x83, 0x9b, 0x39, 0x9e, 0x41, 0x59,
-	0x4e, 0x3f, 0x37, 0xa6, 0xe4, 0xa4, 0x59, 0x68, 0xd2, 0xf6, 0x21, 0x99, 0x6d, 0x9f, 0x70, 0xee,
-	0x31, 0xea, 0xa8, 0x08, 0xbd, 0x09, 0xa5, 0x03, 0xa7, 0xc5, 0x8d, 0xa2, 0x8c, 0x5c, 0x9b, 0x1c,
-	0x79, 0xe0, 0xb4, 0x76, 0xb1, 0xc0, 0x8e, 0x84, 0xea, 0x9b, 0xb0, 0xd0, 0x23, 0xbc, 0x1b, 0x78,
-	0x7e, 0xd8, 0xa7, 0x7d, 0x11, 0x42, 0x64, 0x03, 0x2b, 0xce, 0xb8, 0xc3, 0x5a, 0x81, 0xdb, 0x19,
-	0x25, 0x89, 0x4a, 0x0c, 0x0b, 0x6d, 0xee, 0xb6, 0x02, 0x42, 0x3e, 0xe4, 0x69, 0x98, 0x09, 0xff,
-	0x47, 0xb5, 0xeb, 0x45, 0x42, 0x2b, 0x4e, 0x62, 0xeb, 0xcb, 0x61, 0x09, 0x30, 0x67, 0x54, 0xce,
-	0x61, 0xc5, 0x51, 0x96, 0xb5, 0x0a, 0x2b, 0x63, 0x14, 0x09, 0xff, 0x1b, 0x58, 0x94, 0x1d, 0x38,
-	0xfc, 0x0b, 0x37, 0xb0, 0xd6, 0x60, 0x75, 0x42, 0xb2, 0x98, 0x6b, 0xeb, 0xfb, 0x34, 0x14, 0xdb,
-	0xdc, 0xd5, 0x5d, 0x98, 0x49, 0xff, 0x2c, 0xad, 0x4f, 0x2e, 0x79, 0x76, 0x71, 0x4d, 0x94, 0x0f,
-	0x17, 0x13, 0xea, 0x18, 0xaa, 0xa3, 0xcb, 0x7d, 0xff, 0xe6, 0xf0, 0x08, 0x65, 0x6e, 0xe6, 0x41,
-	0x25, 0x14, 0x03, 0x98, 0xcb, 0xae, 0x5b, 0xe3, 0xda, 0x04, 0x19, 0xa4, 0xf9, 0x38, 0x2f, 0x32,
-	0xa1, 0x73, 0x61, 0x26, 0xbd, 0x75, 0xeb, 0xbf, 0x4a, 0xa1, 0x54, 0xa1, 0x7c, 0xb8, 0x84, 0xa8,
-	0x07, 0xb7, 0x52, 0x9b, 0xf7, 0xe0, 0xda, 0xf8, 0x51, 0x98, 0xf9, 0x28, 0x17, 0x2c, 0x61, 0x79,
-	0x0f, 0xb3, 0x99, 0xd1, 0xdf, 0xb8, 0x36, 0x41, 0x1a, 0x68, 0xda, 0x39, 0x81, 0x09, 0x97, 0x0f,
-	0xf3, 0x63, 0x63, 0xfe, 0xf0, 0x86, 0xaa, 0xa4, 0xa1, 0x66, 0x33, 0x37, 0x34, 0x66, 0xdc, 0x7e,
-	0x7e, 0x7e, 0x59, 0xd3, 0x2e, 0x2e, 0x6b, 0xda, 0xb7, 0xcb, 0x9a, 0xf6, 0xe9, 0xaa, 0x56, 0xb8,
-	0xb8, 0xaa, 0x15, 0xbe, 0x5c, 0xd5, 0x0a, 0xef, 0x36, 0x5c, 0x4f, 0x1c, 0x9d, 0x74, 0x50, 0x97,
-	0x0d, 0x6c, 0x95, 0x56, 0x3e, 0xed, 0x53, 0x3b, 0xf9, 0x8c, 0x38, 0xf3, 0x09, 0xef, 0x94, 0xe5,
-	0x9f, 0xf9, 0x93, 0x1f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x77, 0x94, 0x20, 0x36, 0x5e, 0x08, 0x00,
-	0x00,
+func (m *MsgDelegateToProvider) Reset()         { *m = MsgDelegateToProvider{} }
+func (m *MsgDelegateToProvider) String() string { return proto.CompactTextString(m) }
+func (*MsgDelegateToProvider) ProtoMessage()    {}
+func (*MsgDelegateToProvider) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b2db224a5e52fa36, []int{14}
+}
+func (m *MsgDelegateToProvider) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDelegateToProvider) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDelegateToProvider.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDelegateToProvider) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDelegateToProvider.Merge(m, src)
+}
+func (m *MsgDelegateToProvider) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDelegateToProvider) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDelegateToProvider.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_MsgDelegateToProvider proto.InternalMessageInfo
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	StakeProvider(ctx context.Context, in *MsgStakeProvider, opts ...grpc.CallOption) (*MsgStakeProviderResponse, error)
-	StakeClient(ctx context.Context, in *MsgStakeClient, opts ...grpc.CallOption) (*MsgStakeClientResponse, error)
-	UnstakeProvider(ctx context.Context, in *MsgUnstakeProvider, opts ...grpc.CallOption) (*MsgUnstakeProviderResponse, error)
-	UnstakeClient(ctx context.Context, in *MsgUnstakeClient, opts ...grpc.CallOption) (*MsgUnstakeClientResponse, error)
-	RelayPayment(ctx context.Context, in *MsgRelayPayment, opts ...grpc.CallOption) (*MsgRelayPaymentResponse, error)
-	FreezeProvider(ctx context.Context, in *MsgFreezeProvider, opts ...grpc.CallOption) (*MsgFreezeProviderResponse, error)
-	UnfreezeProvider(ctx context.Context, in *MsgUnfreezeProvider, opts ...grpc.CallOption) (*MsgUnfreezeProviderResponse, error)
+func (m *MsgDelegateToProvider) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgDelegateToProvider) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgDelegateToProvider) GetChainID() string {
+	if m != nil {
+		return m.ChainID
+	}
+	return ""
 }
 
-func (c *msgClient) StakeProvider(ctx context.Context, in *MsgStakeProvider, opts ...grpc.CallOption) (*MsgStakeProviderResponse, error) {
-	out := new(MsgStakeProviderResponse)
-	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.Msg/StakeProvider", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgDelegateToProvider) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
 	}
-	return out, nil
+	return types.Coin{}
 }
 
-func (c *msgClient) StakeClient(ctx context.Context, in *MsgStakeClient, opts ...grpc.CallOption) (*MsgStakeClientResponse, error) {
-	out := new(MsgStakeClientResponse)
-	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.Msg/StakeClient", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type MsgDelegateToProviderResponse struct {
 }
 
-func (c *msgClient) UnstakeProvider(ctx context.Context, in *MsgUnstakeProvider, opts ...grpc.CallOption) (*MsgUnstakeProviderResponse, error) {
-	out := new(MsgUnstakeProviderResponse)
-	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.Msg/UnstakeProvider", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgDelegateToProviderResponse) Reset()         { *m = MsgDelegateToProviderResponse{} }
+func (m *MsgDelegateToProviderResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDelegateToProviderResponse) ProtoMessage()    {}
+func (*MsgDelegateToProviderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b2db224a5e52fa36, []int{15}
+}
+func (m *MsgDelegateToProviderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDelegateToProviderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDelegateToProviderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgDelegateToProviderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDelegateToProviderResponse.Merge(m, src)
+}
+func (m *MsgDelegateToProviderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDelegateToProviderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDelegateToProviderResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) UnstakeClient(ctx context.Context, in *MsgUnstakeClient, opts ...grpc.CallOption) (*MsgUnstakeClientResponse, error) {
-	out := new(MsgUnstakeClientResponse)
-	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.Msg/UnstakeClient", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgDelegateToProviderResponse proto.InternalMessageInfo
+
+type MsgUndelegate struct {
+	Creator  string     `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Provider string     `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+	ChainID  string     `protobuf:"bytes,3,opt,name=chainID,proto3" json:"chainID,omitempty"`
+	Amount   types.Coin `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount"`
 }
 
-func (c *msgClient) RelayPayment(ctx context.Context, in *MsgRelayPayment, opts ...grpc.CallOption) (*MsgRelayPaymentResponse, error) {
-	out := new(MsgRelayPaymentResponse)
-	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.Msg/RelayPayment", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUndelegate) Reset()         { *m = MsgUndelegate{} }
+func (m *MsgUndelegate) String() string { return proto.CompactTextString(m) }
+func (*MsgUndelegate) ProtoMessage()    {}
+func (*MsgUndelegate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b2db224a5e52fa36, []int{16}
+}
+func (m *MsgUndelegate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUndelegate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUndelegate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgUndelegate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUndelegate.Merge(m, src)
+}
+func (m *MsgUndelegate) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUndelegate) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUndelegate.DiscardUnknown(m)
 }
 
-func (c *msgClient) FreezeProvider(ctx context.Context, in *MsgFreezeProvider, opts ...grpc.CallOption) (*MsgFreezeProviderResponse, error) {
-	out := new(MsgFreezeProviderResponse)
-	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.Msg/FreezeProvider", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgUndelegate proto.InternalMessageInfo
+
+func (m *MsgUndelegate) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) UnfreezeProvider(ctx context.Context, in *MsgUnfreezeProvider, opts ...grpc.CallOption) (*MsgUnfreezeProviderResponse, error) {
-	out := new(MsgUnfreezeProviderResponse)
-	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.Msg/UnfreezeProvider", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUndelegate) GetProvider() string {
+	if m != nil {
+		return m.Provider
 	}
-	return out, nil
+	return ""
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	StakeProvider(context.Context, *MsgStakeProvider) (*MsgStakeProviderResponse, error)
-	StakeClient(context.Context, *MsgStakeClient) (*MsgStakeClientResponse, error)
-	UnstakeProvider(context.Context, *MsgUnstakeProvider) (*MsgUnstakeProviderResponse, error)
-	UnstakeClient(context.Context, *MsgUnstakeClient) (*MsgUnstakeClientResponse, error)
-	RelayPayment(context.Context, *MsgRelayPayment) (*MsgRelayPaymentResponse, error)
-	FreezeProvider(context.Context, *MsgFreezeProvider) (*MsgFreezeProviderResponse, error)
-	UnfreezeProvider(context.Context, *MsgUnfreezeProvider) (*MsgUnfreezeProviderResponse, error)
+func (m *MsgUndelegate) GetChainID() string {
+	if m != nil {
+		return m.ChainID
+	}
+	return ""
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgUndelegate) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
 }
 
-func (*UnimplementedMsgServer) StakeProvider(ctx context.Context, req *MsgStakeProvider) (*MsgStakeProviderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method StakeProvider not implemented")
+type MsgUndelegateResponse struct {
 }
-func (*UnimplementedMsgServer) StakeClient(ctx context.Context, req *MsgStakeClient) (*MsgStakeClientResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method StakeClient not implemented")
+
+func (m *MsgUndelegateResponse) Reset()         { *m = MsgUndelegateResponse{} }
+func (m *MsgUndelegateResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUndelegateResponse) ProtoMessage()    {}
+func (*MsgUndelegateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b2db224a5e52fa36, []int{17}
 }
-func (*UnimplementedMsgServer) UnstakeProvider(ctx context.Context, req *MsgUnstakeProvider) (*MsgUnstakeProviderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UnstakeProvider not implemented")
+func (m *MsgUndelegateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) UnstakeClient(ctx context.Context, req *MsgUnstakeClient) (*MsgUnstakeClientResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UnstakeClient not implemented")
+func (m *MsgUndelegateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUndelegateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) RelayPayment(ctx context.Context, req *MsgRelayPayment) (*MsgRelayPaymentResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RelayPayment not implemented")
+func (m *MsgUndelegateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUndelegateResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) FreezeProvider(ctx context.Context, req *MsgFreezeProvider) (*MsgFreezeProviderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FreezeProvider not implemented")
+func (m *MsgUndelegateResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) UnfreezeProvider(ctx context.Context, req *MsgUnfreezeProvider) (*MsgUnfreezeProviderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UnfreezeProvider not implemented")
+func (m *MsgUndelegateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUndelegateResponse.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+var xxx_messageInfo_MsgUndelegateResponse proto.InternalMessageInfo
+
+type MsgSetProviderMetadata struct {
+	Creator     string   `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Moniker     string   `protobuf:"bytes,2,opt,name=moniker,proto3" json:"moniker,omitempty"`
+	Website     string   `protobuf:"bytes,3,opt,name=website,proto3" json:"website,omitempty"`
+	Description string   `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Contact     string   `protobuf:"bytes,5,opt,name=contact,proto3" json:"contact,omitempty"`
+	Addons      []string `protobuf:"bytes,6,rep,name=addons,proto3" json:"addons,omitempty"`
 }
 
-func _Msg_StakeProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgStakeProvider)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).StakeProvider(ctx, in)
+func (m *MsgSetProviderMetadata) Reset()         { *m = MsgSetProviderMetadata{} }
+func (m *MsgSetProviderMetadata) String() string { return proto.CompactTextString(m) }
+func (*MsgSetProviderMetadata) ProtoMessage()    {}
+func (*MsgSetProviderMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b2db224a5e52fa36, []int{18}
+}
+func (m *MsgSetProviderMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetProviderMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetProviderMetadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lavanet.lava.pairing.Msg/StakeProvider",
+}
+func (m *MsgSetProviderMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetProviderMetadata.Merge(m, src)
+}
+func (m *MsgSetProviderMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetProviderMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetProviderMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetProviderMetadata proto.InternalMessageInfo
+
+func (m *MsgSetProviderMetadata) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).StakeProvider(ctx, req.(*MsgStakeProvider))
+	return ""
+}
+
+func (m *MsgSetProviderMetadata) GetMoniker() string {
+	if m != nil {
+		return m.Moniker
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_StakeClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgStakeClient)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).StakeClient(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lavanet.lava.pairing.Msg/StakeClient",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).StakeClient(ctx, req.(*MsgStakeClient))
+func (m *MsgSetProviderMetadata) GetWebsite() string {
+	if m != nil {
+		return m.Website
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_UnstakeProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUnstakeProvider)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UnstakeProvider(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lavanet.lava.pairing.Msg/UnstakeProvider",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UnstakeProvider(ctx, req.(*MsgUnstakeProvider))
+func (m *MsgSetProviderMetadata) GetDescription() string {
+	if m != nil {
+		return m.Description
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_UnstakeClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUnstakeClient)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UnstakeClient(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lavanet.lava.pairing.Msg/UnstakeClient",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UnstakeClient(ctx, req.(*MsgUnstakeClient))
+func (m *MsgSetProviderMetadata) GetContact() string {
+	if m != nil {
+		return m.Contact
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_RelayPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRelayPayment)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RelayPayment(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lavanet.lava.pairing.Msg/RelayPayment",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RelayPayment(ctx, req.(*MsgRelayPayment))
+func (m *MsgSetProviderMetadata) GetAddons() []string {
+	if m != nil {
+		return m.Addons
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Msg_FreezeProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgFreezeProvider)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).FreezeProvider(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lavanet.lava.pairing.Msg/FreezeProvider",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).FreezeProvider(ctx, req.(*MsgFreezeProvider))
-	}
-	return interceptor(ctx, in, info, handler)
+type MsgSetProviderMetadataResponse struct {
 }
 
-func _Msg_UnfreezeProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUnfreezeProvider)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UnfreezeProvider(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lavanet.lava.pairing.Msg/UnfreezeProvider",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UnfreezeProvider(ctx, req.(*MsgUnfreezeProvider))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *MsgSetProviderMetadataResponse) Reset()         { *m = MsgSetProviderMetadataResponse{} }
+func (m *MsgSetProviderMetadataResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetProviderMetadataResponse) ProtoMessage()    {}
+func (*MsgSetProviderMetadataResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b2db224a5e52fa36, []int{19}
 }
-
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "lavanet.lava.pairing.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "StakeProvider",
-			Handler:    _Msg_StakeProvider_Handler,
-		},
-		{
-			MethodName: "StakeClient",
-			Handler:    _Msg_StakeClient_Handler,
-		},
-		{
-			MethodName: "UnstakeProvider",
-			Handler:    _Msg_UnstakeProvider_Handler,
-		},
-		{
-			MethodName: "UnstakeClient",
-			Handler:    _Msg_UnstakeClient_Handler,
-		},
-		{
-			MethodName: "RelayPayment",
-			Handler:    _Msg_RelayPayment_Handler,
-		},
-		{
-			MethodName: "FreezeProvider",
-			Handler:    _Msg_FreezeProvider_Handler,
-		},
-		{
-			MethodName: "UnfreezeProvider",
-			Handler:    _Msg_UnfreezeProvider_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "pairing/tx.proto",
+func (m *MsgSetProviderMetadataResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *MsgStakeProvider) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetProviderMetadataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetProviderMetadataResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgSetProviderMetadataResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetProviderMetadataResponse.Merge(m, src)
+}
+func (m *MsgSetProviderMetadataResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetProviderMetadataResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetProviderMetadataResponse.DiscardUnknown(m)
 }
 
-func (m *MsgStakeProvider) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgSetProviderMetadataResponse proto.InternalMessageInfo
+
+type MsgAttestEndpointHealth struct {
+	Creator         string   `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ChainIDs        []string `protobuf:"bytes,2,rep,name=chainIDs,proto3" json:"chainIDs,omitempty"`
+	ProtocolVersion uint32   `protobuf:"varint,3,opt,name=protocol_version,json=protocolVersion,proto3" json:"protocol_version,omitempty"`
+	BinaryVersion   string   `protobuf:"bytes,4,opt,name=binary_version,json=binaryVersion,proto3" json:"binary_version,omitempty"`
 }
 
-func (m *MsgStakeProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Moniker) > 0 {
-		i -= len(m.Moniker)
-		copy(dAtA[i:], m.Moniker)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Moniker)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.Geolocation != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Geolocation))
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.Endpoints) > 0 {
-		for iNdEx := len(m.Endpoints) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Endpoints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgAttestEndpointHealth) Reset()         { *m = MsgAttestEndpointHealth{} }
+func (m *MsgAttestEndpointHealth) String() string { return proto.CompactTextString(m) }
+func (*MsgAttestEndpointHealth) ProtoMessage()    {}
+func (*MsgAttestEndpointHealth) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b2db224a5e52fa36, []int{20}
+}
+func (m *MsgAttestEndpointHealth) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAttestEndpointHealth) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAttestEndpointHealth.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.ChainID) > 0 {
-		i -= len(m.ChainID)
-		copy(dAtA[i:], m.ChainID)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainID)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *MsgAttestEndpointHealth) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAttestEndpointHealth.Merge(m, src)
+}
+func (m *MsgAttestEndpointHealth) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAttestEndpointHealth) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAttestEndpointHealth.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAttestEndpointHealth proto.InternalMessageInfo
+
+func (m *MsgAttestEndpointHealth) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *MsgAttestEndpointHealth) GetChainIDs() []string {
+	if m != nil {
+		return m.ChainIDs
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *MsgStakeProviderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgAttestEndpointHealth) GetProtocolVersion() uint32 {
+	if m != nil {
+		return m.ProtocolVersion
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgStakeProviderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgAttestEndpointHealth) GetBinaryVersion() string {
+	if m != nil {
+		return m.BinaryVersion
+	}
+	return ""
 }
 
-func (m *MsgStakeProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type MsgAttestEndpointHealthResponse struct {
 }
 
-func (m *MsgStakeClient) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgAttestEndpointHealthResponse) Reset()         { *m = MsgAttestEndpointHealthResponse{} }
+func (m *MsgAttestEndpointHealthResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAttestEndpointHealthResponse) ProtoMessage()    {}
+func (*MsgAttestEndpointHealthResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b2db224a5e52fa36, []int{21}
 }
-
-func (m *MsgStakeClient) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgAttestEndpointHealthResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *MsgStakeClient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Vrfpk) > 0 {
-		i -= len(m.Vrfpk)
-		copy(dAtA[i:], m.Vrfpk)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Vrfpk)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.Geolocation != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Geolocation))
-		i--
-		dAtA[i] = 0x20
-	}
-	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgAttestEndpointHealthResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAttestEndpointHealthResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.ChainID) > 0 {
-		i -= len(m.ChainID)
-		copy(dAtA[i:], m.ChainID)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainID)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgAttestEndpointHealthResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAttestEndpointHealthResponse.Merge(m, src)
+}
+func (m *MsgAttestEndpointHealthResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAttestEndpointHealthResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAttestEndpointHealthResponse.DiscardUnknown(m)
 }
 
-func (m *MsgStakeClientResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+var xxx_messageInfo_MsgAttestEndpointHealthResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgStakeProvider)(nil), "lavanet.lava.pairing.MsgStakeProvider")
+	proto.RegisterType((*MsgStakeProviderResponse)(nil), "lavanet.lava.pairing.MsgStakeProviderResponse")
+	proto.RegisterType((*MsgStakeClient)(nil), "lavanet.lava.pairing.MsgStakeClient")
+	proto.RegisterType((*MsgStakeClientResponse)(nil), "lavanet.lava.pairing.MsgStakeClientResponse")
+	proto.RegisterType((*MsgUnstakeProvider)(nil), "lavanet.lava.pairing.MsgUnstakeProvider")
+	proto.RegisterType((*MsgUnstakeProviderResponse)(nil), "lavanet.lava.pairing.MsgUnstakeProviderResponse")
+	proto.RegisterType((*MsgUnstakeClient)(nil), "lavanet.lava.pairing.MsgUnstakeClient")
+	proto.RegisterType((*MsgUnstakeClientResponse)(nil), "lavanet.lava.pairing.MsgUnstakeClientResponse")
+	proto.RegisterType((*MsgRelayPayment)(nil), "lavanet.lava.pairing.MsgRelayPayment")
+	proto.RegisterType((*MsgRelayPaymentResponse)(nil), "lavanet.lava.pairing.MsgRelayPaymentResponse")
+	proto.RegisterType((*MsgFreezeProvider)(nil), "lavanet.lava.pairing.MsgFreezeProvider")
+	proto.RegisterType((*MsgFreezeProviderResponse)(nil), "lavanet.lava.pairing.MsgFreezeProviderResponse")
+	proto.RegisterType((*MsgUnfreezeProvider)(nil), "lavanet.lava.pairing.MsgUnfreezeProvider")
+	proto.RegisterType((*MsgUnfreezeProviderResponse)(nil), "lavanet.lava.pairing.MsgUnfreezeProviderResponse")
+	proto.RegisterType((*MsgDelegateToProvider)(nil), "lavanet.lava.pairing.MsgDelegateToProvider")
+	proto.RegisterType((*MsgDelegateToProviderResponse)(nil), "lavanet.lava.pairing.MsgDelegateToProviderResponse")
+	proto.RegisterType((*MsgUndelegate)(nil), "lavanet.lava.pairing.MsgUndelegate")
+	proto.RegisterType((*MsgUndelegateResponse)(nil), "lavanet.lava.pairing.MsgUndelegateResponse")
+	proto.RegisterType((*MsgSetProviderMetadata)(nil), "lavanet.lava.pairing.MsgSetProviderMetadata")
+	proto.RegisterType((*MsgSetProviderMetadataResponse)(nil), "lavanet.lava.pairing.MsgSetProviderMetadataResponse")
+	proto.RegisterType((*MsgAttestEndpointHealth)(nil), "lavanet.lava.pairing.MsgAttestEndpointHealth")
+	proto.RegisterType((*MsgAttestEndpointHealthResponse)(nil), "lavanet.lava.pairing.MsgAttestEndpointHealthResponse")
 }
 
-func (m *MsgStakeClientResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() { proto.RegisterFile("pairing/tx.proto", fileDescriptor_b2db224a5e52fa36) }
+
+var fileDescriptor_b2db224a5e52fa36 = []byte{
+	// 1025 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0xcf, 0x73, 0xdb, 0xc4,
+	0x17, 0x8f, 0x62, 0xd7, 0xa9, 0x5f, 0xea, 0xc4, 0x55, 0xd2, 0x56, 0x51, 0xbe, 0x71, 0xfc, 0x55,
+	0x7f, 0xb9, 0xd3, 0x22, 0x91, 0x14, 0x86, 0x19, 0x6e, 0x4d, 0xdc, 0x50, 0x86, 0xf1, 0x4c, 0x47,
+	0x81, 0x1c, 0x38, 0xc0, 0xac, 0xe5, 0x8d, 0x22, 0x62, 0xef, 0x6a, 0xb4, 0x1b, 0x53, 0x33, 0xfc,
+	0x11, 0x1c, 0x39, 0xc2, 0x0c, 0x37, 0xce, 0x5c, 0x38, 0x70, 0xce, 0xb1, 0x47, 0x4e, 0x0c, 0x93,
+	0xfc, 0x23, 0x8c, 0x56, 0xab, 0xad, 0x65, 0xcb, 0x46, 0x01, 0x86, 0xe1, 0x64, 0xbd, 0xb7, 0x9f,
+	0xf7, 0xe3, 0xb3, 0xfb, 0x7e, 0x24, 0x50, 0x0f, 0x51, 0x10, 0x05, 0xc4, 0x77, 0xf8, 0x2b, 0x3b,
+	0x8c, 0x28, 0xa7, 0xfa, 0x7a, 0x1f, 0x0d, 0x11, 0xc1, 0xdc, 0x8e, 0x7f, 0x6d, 0x79, 0x6c, 0x36,
+	0x3c, 0xca, 0x06, 0x94, 0x39, 0x5d, 0xc4, 0xb0, 0x33, 0xdc, 0xe9, 0x62, 0x8e, 0x76, 0x1c, 0x8f,
+	0x06, 0x24, 0xb1, 0x32, 0xd7, 0x7d, 0xea, 0x53, 0xf1, 0xe9, 0xc4, 0x5f, 0x52, 0xbb, 0x89, 0x43,
+	0xea, 0x9d, 0x30, 0x4e, 0x23, 0xe4, 0x63, 0x07, 0x93, 0x5e, 0x48, 0x03, 0xc2, 0xe5, 0xe1, 0x5a,
+	0x1a, 0x3a, 0xc2, 0x7d, 0x34, 0x4a, 0x94, 0xd6, 0x0f, 0x8b, 0x50, 0xef, 0x30, 0xff, 0x90, 0xa3,
+	0x53, 0xfc, 0x32, 0xa2, 0xc3, 0xa0, 0x87, 0x23, 0xdd, 0x80, 0x25, 0x2f, 0xc2, 0x88, 0xd3, 0xc8,
+	0xd0, 0x9a, 0x5a, 0xab, 0xea, 0xa6, 0xa2, 0x38, 0x39, 0x41, 0x01, 0xf9, 0xb0, 0x6d, 0x2c, 0xca,
+	0x93, 0x44, 0xd4, 0xdf, 0x83, 0x0a, 0x1a, 0xd0, 0x33, 0xc2, 0x8d, 0x52, 0x53, 0x6b, 0x2d, 0xef,
+	0x6e, 0xd8, 0x09, 0x03, 0x3b, 0x66, 0x60, 0x4b, 0x06, 0xf6, 0x3e, 0x0d, 0xc8, 0x5e, 0xf9, 0xfc,
+	0xb7, 0xed, 0x05, 0x57, 0xc2, 0xf5, 0x0f, 0xa0, 0x9a, 0x26, 0xca, 0x8c, 0x72, 0xb3, 0xd4, 0x5a,
+	0xde, 0xbd, 0x6b, 0x67, 0xee, 0x64, 0x9c, 0x94, 0xfd, 0x5c, 0x62, 0xa5, 0x97, 0x37, 0xb6, 0x7a,
+	0x13, 0x96, 0x7d, 0x4c, 0xfb, 0xd4, 0x43, 0x3c, 0xa0, 0xc4, 0xb8, 0xd6, 0xd4, 0x5a, 0x65, 0x77,
+	0x5c, 0x15, 0x67, 0x3f, 0xa0, 0x24, 0x38, 0xc5, 0x91, 0x51, 0x49, 0xb2, 0x97, 0xa2, 0xde, 0x00,
+	0xf0, 0xce, 0xf6, 0x51, 0x88, 0xbc, 0x80, 0x8f, 0x8c, 0x25, 0x61, 0x3a, 0xa6, 0xb1, 0x4c, 0x30,
+	0x26, 0x6f, 0xc9, 0xc5, 0x2c, 0xa4, 0x84, 0x61, 0xeb, 0x27, 0x0d, 0x56, 0xd2, 0xc3, 0xfd, 0x7e,
+	0x80, 0x09, 0xff, 0x77, 0x2f, 0x70, 0x82, 0x77, 0x79, 0x9a, 0xf7, 0x3a, 0x5c, 0x1b, 0x46, 0xc7,
+	0xe1, 0xa9, 0xb8, 0x93, 0xaa, 0x9b, 0x08, 0x96, 0x01, 0xb7, 0xb3, 0x69, 0x2b, 0x46, 0x2f, 0x40,
+	0xef, 0x30, 0xff, 0x13, 0xc2, 0xfe, 0x6e, 0x55, 0x58, 0xff, 0x03, 0x73, 0xda, 0x93, 0x8a, 0x73,
+	0x00, 0xf5, 0x37, 0xa7, 0x7f, 0xfd, 0xea, 0xe4, 0xeb, 0x64, 0xfc, 0xa8, 0x18, 0xe7, 0x1a, 0xac,
+	0x76, 0x98, 0xef, 0xc6, 0x35, 0xff, 0x12, 0x8d, 0x06, 0xf3, 0x63, 0xbc, 0x0f, 0x15, 0xd1, 0x1d,
+	0xcc, 0x58, 0x14, 0x95, 0x68, 0xd9, 0x79, 0xdd, 0x69, 0x0b, 0x6f, 0x87, 0x98, 0xb1, 0x80, 0x12,
+	0x57, 0x5a, 0xe8, 0x3b, 0x50, 0x3e, 0x72, 0x0f, 0x98, 0x51, 0x12, 0x96, 0x5b, 0xf9, 0x96, 0x47,
+	0xee, 0x41, 0x1b, 0x71, 0xe4, 0x0a, 0xa8, 0xfe, 0x04, 0x6e, 0xf6, 0x30, 0xf3, 0xa2, 0x20, 0x8c,
+	0xdf, 0xe9, 0x90, 0xc7, 0x10, 0xf1, 0x80, 0x55, 0x77, 0xfa, 0xc0, 0xda, 0x80, 0x3b, 0x13, 0x4c,
+	0x14, 0xcb, 0x1f, 0x35, 0xb8, 0xd9, 0x61, 0xfe, 0x41, 0x84, 0xf1, 0x57, 0x45, 0x5e, 0xcc, 0x84,
+	0xeb, 0xc9, 0xe5, 0xf5, 0x12, 0xa6, 0x55, 0x57, 0xc9, 0xfa, 0xed, 0xf8, 0x0e, 0x10, 0xa3, 0x44,
+	0x14, 0x62, 0xd5, 0x95, 0x92, 0x7e, 0x0f, 0x6a, 0x67, 0xe4, 0x58, 0x44, 0xd8, 0xeb, 0x53, 0xef,
+	0x54, 0x56, 0x5a, 0x56, 0xa9, 0x5b, 0x70, 0x23, 0x11, 0x9f, 0x8b, 0xae, 0x95, 0x6d, 0x98, 0xd1,
+	0x59, 0x9b, 0xb0, 0x31, 0x95, 0xac, 0xa2, 0xf2, 0x11, 0xac, 0x89, 0xc7, 0x3c, 0xfe, 0x07, 0xb8,
+	0x58, 0x5b, 0xb0, 0x99, 0xe3, 0x4c, 0xc5, 0xfa, 0x4e, 0x83, 0x5b, 0x1d, 0xe6, 0xb7, 0x71, 0x1f,
+	0xfb, 0x88, 0xe3, 0x8f, 0x69, 0xb1, 0x70, 0xa1, 0x44, 0xc9, 0x3a, 0xbc, 0x1e, 0x8e, 0x5b, 0xc9,
+	0x12, 0x2d, 0xcd, 0xea, 0xee, 0xf2, 0x95, 0xba, 0xdb, 0xda, 0x86, 0xad, 0xdc, 0x0c, 0x15, 0x87,
+	0x6f, 0x35, 0xa8, 0x09, 0x8e, 0x3d, 0x89, 0xf9, 0xef, 0xe4, 0x7e, 0x07, 0x6e, 0x65, 0x32, 0x53,
+	0x39, 0xff, 0xac, 0x25, 0xb3, 0x07, 0xf3, 0x94, 0x4e, 0x07, 0x73, 0xd4, 0x43, 0x1c, 0xcd, 0xef,
+	0xff, 0x74, 0x7a, 0x2f, 0x66, 0xa7, 0xb7, 0x01, 0x4b, 0x5f, 0xe2, 0x2e, 0x0b, 0x38, 0x4e, 0x53,
+	0x97, 0x62, 0x3c, 0x1b, 0xc7, 0xfa, 0x48, 0xb6, 0xd6, 0xb8, 0x4a, 0xc4, 0xa3, 0x84, 0x23, 0x8f,
+	0xcb, 0xe9, 0x98, 0x8a, 0x71, 0x1f, 0xa0, 0x5e, 0x8f, 0x12, 0x66, 0x54, 0x44, 0x55, 0x49, 0xc9,
+	0x6a, 0x42, 0x23, 0x3f, 0x77, 0x45, 0xef, 0x7b, 0x4d, 0x74, 0xea, 0x33, 0xce, 0x31, 0xe3, 0xe9,
+	0xc2, 0x7a, 0x81, 0x51, 0x9f, 0x9f, 0x14, 0xa9, 0xe3, 0xf6, 0x44, 0x1d, 0xb7, 0x99, 0xfe, 0x08,
+	0xea, 0x62, 0x5f, 0x7b, 0xb4, 0xff, 0xf9, 0x10, 0x47, 0xf1, 0xdc, 0x11, 0x54, 0x6b, 0xee, 0x6a,
+	0xaa, 0x3f, 0x4a, 0xd4, 0xfa, 0x7d, 0x58, 0xe9, 0x06, 0x04, 0x45, 0x23, 0x05, 0x4c, 0x58, 0xd7,
+	0x12, 0xad, 0x84, 0x59, 0xff, 0x87, 0xed, 0x19, 0x29, 0xa6, 0x34, 0x76, 0x7f, 0xa9, 0x42, 0xa9,
+	0xc3, 0x7c, 0xdd, 0x87, 0x5a, 0xf6, 0xef, 0x83, 0x07, 0xf9, 0xb3, 0x6d, 0x72, 0x43, 0x9a, 0x76,
+	0x31, 0x5c, 0x1a, 0x50, 0x47, 0xb0, 0x3c, 0xbe, 0x45, 0xef, 0xcd, 0x37, 0x4f, 0x50, 0xe6, 0x93,
+	0x22, 0x28, 0x15, 0x62, 0x00, 0xab, 0x93, 0x7b, 0xad, 0x35, 0xd3, 0xc1, 0x04, 0xd2, 0x7c, 0xbb,
+	0x28, 0x52, 0x85, 0xf3, 0xa1, 0x96, 0x5d, 0x6f, 0x0f, 0xfe, 0xcc, 0x85, 0x64, 0x65, 0x17, 0xc3,
+	0xa9, 0x40, 0x3d, 0xb8, 0x91, 0x59, 0x71, 0xf7, 0x67, 0xda, 0x8f, 0xc3, 0xcc, 0xb7, 0x0a, 0xc1,
+	0x54, 0x94, 0x2f, 0x60, 0x65, 0x62, 0xc5, 0x3c, 0x9c, 0xe9, 0x20, 0x0b, 0x34, 0x9d, 0x82, 0x40,
+	0x15, 0x2b, 0x84, 0xfa, 0xd4, 0x12, 0x78, 0x34, 0xe7, 0x56, 0xb2, 0x50, 0x73, 0xa7, 0x30, 0x54,
+	0x45, 0x1c, 0x82, 0x9e, 0xb3, 0x09, 0x1e, 0xcf, 0x74, 0x34, 0x0d, 0x36, 0x9f, 0x5e, 0x01, 0xac,
+	0xe2, 0x7e, 0x06, 0x30, 0x36, 0xbd, 0xef, 0xce, 0x49, 0x3c, 0x05, 0x99, 0x8f, 0x0b, 0x80, 0x94,
+	0xff, 0x11, 0xac, 0xe5, 0x4d, 0xda, 0x39, 0x8d, 0x33, 0x8d, 0x36, 0xdf, 0xb9, 0x0a, 0x5a, 0x85,
+	0xfe, 0x1a, 0xd6, 0x73, 0xa7, 0xe0, 0xec, 0xba, 0xcb, 0x83, 0x9b, 0xef, 0x5e, 0x09, 0x9e, 0x46,
+	0xdf, 0x7b, 0x76, 0x7e, 0xd1, 0xd0, 0x5e, 0x5f, 0x34, 0xb4, 0xdf, 0x2f, 0x1a, 0xda, 0x37, 0x97,
+	0x8d, 0x85, 0xd7, 0x97, 0x8d, 0x85, 0x5f, 0x2f, 0x1b, 0x0b, 0x9f, 0x3e, 0xf4, 0x03, 0x7e, 0x72,
+	0xd6, 0xb5, 0x3d, 0x3a, 0x70, 0xa4, 0x6b, 0xf1, 0xeb, 0xbc, 0x72, 0xd4, 0x3f, 0x68, 0xa3, 0x10,
+	0xb3, 0x6e, 0x45, 0x8c, 0xd7, 0xa7, 0x7f, 0x0c, 0x00, 0x84, 0x0f, 0x16, 0x65, 0xb8, 0x0d, 0x00,
+	0x00,
 }
 
-func (m *MsgStakeClientResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	StakeProvider(ctx context.Context, in *MsgStakeProvider, opts ...grpc.CallOption) (*MsgStakeProviderResponse, error)
+	StakeClient(ctx context.Context, in *MsgStakeClient, opts ...grpc.CallOption) (*MsgStakeClientResponse, error)
+	UnstakeProvider(ctx context.Context, in *MsgUnstakeProvider, opts ...grpc.CallOption) (*MsgUnstakeProviderResponse, error)
+	UnstakeClient(ctx context.Context, in *MsgUnstakeClient, opts ...grpc.CallOption) (*MsgUnstakeClientResponse, error)
+	RelayPayment(ctx context.Context, in *MsgRelayPayment, opts ...grpc.CallOption) (*MsgRelayPaymentResponse, error)
+	FreezeProvider(ctx context.Context, in *MsgFreezeProvider, opts ...grpc.CallOption) (*MsgFreezeProviderResponse, error)
+	UnfreezeProvider(ctx context.Context, in *MsgUnfreezeProvider, opts ...grpc.CallOption) (*MsgUnfreezeProviderResponse, error)
+	DelegateToProvider(ctx context.Context, in *MsgDelegateToProvider, opts ...grpc.CallOption) (*MsgDelegateToProviderResponse, error)
+	Undelegate(ctx context.Context, in *MsgUndelegate, opts ...grpc.CallOption) (*MsgUndelegateResponse, error)
+	SetProviderMetadata(ctx context.Context, in *MsgSetProviderMetadata, opts ...grpc.CallOption) (*MsgSetProviderMetadataResponse, error)
+	AttestEndpointHealth(ctx context.Context, in *MsgAttestEndpointHealth, opts ...grpc.CallOption) (*MsgAttestEndpointHealthResponse, error)
 }
 
-func (m *MsgUnstakeProvider) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) StakeProvider(ctx context.Context, in *MsgStakeProvider, opts ...grpc.CallOption) (*MsgStakeProviderResponse, error) {
+	out := new(MsgStakeProviderResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.Msg/StakeProvider", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgUnstakeProvider) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) StakeClient(ctx context.Context, in *MsgStakeClient, opts ...grpc.CallOption) (*MsgStakeClientResponse, error) {
+	out := new(MsgStakeClientResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.Msg/StakeClient", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgUnstakeProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ChainID) > 0 {
-		i -= len(m.ChainID)
-		copy(dAtA[i:], m.ChainID)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainID)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) UnstakeProvider(ctx context.Context, in *MsgUnstakeProvider, opts ...grpc.CallOption) (*MsgUnstakeProviderResponse, error) {
+	out := new(MsgUnstakeProviderResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.Msg/UnstakeProvider", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgUnstakeProviderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UnstakeClient(ctx context.Context, in *MsgUnstakeClient, opts ...grpc.CallOption) (*MsgUnstakeClientResponse, error) {
+	out := new(MsgUnstakeClientResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.Msg/UnstakeClient", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgUnstakeProviderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) RelayPayment(ctx context.Context, in *MsgRelayPayment, opts ...grpc.CallOption) (*MsgRelayPaymentResponse, error) {
+	out := new(MsgRelayPaymentResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.Msg/RelayPayment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgUnstakeProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) FreezeProvider(ctx context.Context, in *MsgFreezeProvider, opts ...grpc.CallOption) (*MsgFreezeProviderResponse, error) {
+	out := new(MsgFreezeProviderResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.Msg/FreezeProvider", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgUnstakeClient) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UnfreezeProvider(ctx context.Context, in *MsgUnfreezeProvider, opts ...grpc.CallOption) (*MsgUnfreezeProviderResponse, error) {
+	out := new(MsgUnfreezeProviderResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.Msg/UnfreezeProvider", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgUnstakeClient) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) DelegateToProvider(ctx context.Context, in *MsgDelegateToProvider, opts ...grpc.CallOption) (*MsgDelegateToProviderResponse, error) {
+	out := new(MsgDelegateToProviderResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.Msg/DelegateToProvider", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgUnstakeClient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ChainID) > 0 {
-		i -= len(m.ChainID)
-		copy(dAtA[i:], m.ChainID)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainID)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) Undelegate(ctx context.Context, in *MsgUndelegate, opts ...grpc.CallOption) (*MsgUndelegateResponse, error) {
+	out := new(MsgUndelegateResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.Msg/Undelegate", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgUnstakeClientResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SetProviderMetadata(ctx context.Context, in *MsgSetProviderMetadata, opts ...grpc.CallOption) (*MsgSetProviderMetadataResponse, error) {
+	out := new(MsgSetProviderMetadataResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.Msg/SetProviderMetadata", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgUnstakeClientResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) AttestEndpointHealth(ctx context.Context, in *MsgAttestEndpointHealth, opts ...grpc.CallOption) (*MsgAttestEndpointHealthResponse, error) {
+	out := new(MsgAttestEndpointHealthResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.Msg/AttestEndpointHealth", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgUnstakeClientResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	StakeProvider(context.Context, *MsgStakeProvider) (*MsgStakeProviderResponse, error)
+	StakeClient(context.Context, *MsgStakeClient) (*MsgStakeClientResponse, error)
+	UnstakeProvider(context.Context, *MsgUnstakeProvider) (*MsgUnstakeProviderResponse, error)
+	UnstakeClient(context.Context, *MsgUnstakeClient) (*MsgUnstakeClientResponse, error)
+	RelayPayment(context.Context, *MsgRelayPayment) (*MsgRelayPaymentResponse, error)
+	FreezeProvider(context.Context, *MsgFreezeProvider) (*MsgFreezeProviderResponse, error)
+	UnfreezeProvider(context.Context, *MsgUnfreezeProvider) (*MsgUnfreezeProviderResponse, error)
+	DelegateToProvider(context.Context, *MsgDelegateToProvider) (*MsgDelegateToProviderResponse, error)
+	Undelegate(context.Context, *MsgUndelegate) (*MsgUndelegateResponse, error)
+	SetProviderMetadata(context.Context, *MsgSetProviderMetadata) (*MsgSetProviderMetadataResponse, error)
+	AttestEndpointHealth(context.Context, *MsgAttestEndpointHealth) (*MsgAttestEndpointHealthResponse, error)
 }
 
-func (m *MsgRelayPayment) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
 }
 
-func (m *MsgRelayPayment) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedMsgServer) StakeProvider(ctx context.Context, req *MsgStakeProvider) (*MsgStakeProviderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StakeProvider not implemented")
+}
+func (*UnimplementedMsgServer) StakeClient(ctx context.Context, req *MsgStakeClient) (*MsgStakeClientResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StakeClient not implemented")
+}
+func (*UnimplementedMsgServer) UnstakeProvider(ctx context.Context, req *MsgUnstakeProvider) (*MsgUnstakeProviderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnstakeProvider not implemented")
+}
+func (*UnimplementedMsgServer) UnstakeClient(ctx context.Context, req *MsgUnstakeClient) (*MsgUnstakeClientResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnstakeClient not implemented")
+}
+func (*UnimplementedMsgServer) RelayPayment(ctx context.Context, req *MsgRelayPayment) (*MsgRelayPaymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RelayPayment not implemented")
+}
+func (*UnimplementedMsgServer) FreezeProvider(ctx context.Context, req *MsgFreezeProvider) (*MsgFreezeProviderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FreezeProvider not implemented")
+}
+func (*UnimplementedMsgServer) UnfreezeProvider(ctx context.Context, req *MsgUnfreezeProvider) (*MsgUnfreezeProviderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnfreezeProvider not implemented")
+}
+func (*UnimplementedMsgServer) DelegateToProvider(ctx context.Context, req *MsgDelegateToProvider) (*MsgDelegateToProviderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegateToProvider not implemented")
+}
+func (*UnimplementedMsgServer) Undelegate(ctx context.Context, req *MsgUndelegate) (*MsgUndelegateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Undelegate not implemented")
+}
+func (*UnimplementedMsgServer) SetProviderMetadata(ctx context.Context, req *MsgSetProviderMetadata) (*MsgSetProviderMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetProviderMetadata not implemented")
+}
+func (*UnimplementedMsgServer) AttestEndpointHealth(ctx context.Context, req *MsgAttestEndpointHealth) (*MsgAttestEndpointHealthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttestEndpointHealth not implemented")
 }
 
-func (m *MsgRelayPayment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.DescriptionString) > 0 {
-		i -= len(m.DescriptionString)
-		copy(dAtA[i:], m.DescriptionString)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.DescriptionString)))
-		i--
-		dAtA[i] = 0x22
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_StakeProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgStakeProvider)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.VRFs) > 0 {
-		for iNdEx := len(m.VRFs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.VRFs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
+	if interceptor == nil {
+		return srv.(MsgServer).StakeProvider(ctx, in)
 	}
-	if len(m.Relays) > 0 {
-		for iNdEx := len(m.Relays) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Relays[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.pairing.Msg/StakeProvider",
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).StakeProvider(ctx, req.(*MsgStakeProvider))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRelayPaymentResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_StakeClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgStakeClient)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgRelayPaymentResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(MsgServer).StakeClient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.pairing.Msg/StakeClient",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).StakeClient(ctx, req.(*MsgStakeClient))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRelayPaymentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Msg_UnstakeProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnstakeProvider)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnstakeProvider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.pairing.Msg/UnstakeProvider",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnstakeProvider(ctx, req.(*MsgUnstakeProvider))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgFreezeProvider) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_UnstakeClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnstakeClient)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).UnstakeClient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.pairing.Msg/UnstakeClient",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnstakeClient(ctx, req.(*MsgUnstakeClient))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgFreezeProvider) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_RelayPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRelayPayment)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RelayPayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.pairing.Msg/RelayPayment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RelayPayment(ctx, req.(*MsgRelayPayment))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgFreezeProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Reason) > 0 {
-		i -= len(m.Reason)
-		copy(dAtA[i:], m.Reason)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
-		i--
-		dAtA[i] = 0x1a
+func _Msg_FreezeProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFreezeProvider)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.ChainIds) > 0 {
-		for iNdEx := len(m.ChainIds) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.ChainIds[iNdEx])
-			copy(dAtA[i:], m.ChainIds[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.ChainIds[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
+	if interceptor == nil {
+		return srv.(MsgServer).FreezeProvider(ctx, in)
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.pairing.Msg/FreezeProvider",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FreezeProvider(ctx, req.(*MsgFreezeProvider))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgFreezeProviderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_UnfreezeProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnfreezeProvider)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).UnfreezeProvider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.pairing.Msg/UnfreezeProvider",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnfreezeProvider(ctx, req.(*MsgUnfreezeProvider))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgFreezeProviderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_DelegateToProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDelegateToProvider)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DelegateToProvider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.pairing.Msg/DelegateToProvider",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DelegateToProvider(ctx, req.(*MsgDelegateToProvider))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgFreezeProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Msg_Undelegate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUndelegate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Undelegate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.pairing.Msg/Undelegate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Undelegate(ctx, req.(*MsgUndelegate))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUnfreezeProvider) Marshal() (dAtA []byte, err error) {
+func _Msg_SetProviderMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetProviderMetadata)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetProviderMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.pairing.Msg/SetProviderMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetProviderMetadata(ctx, req.(*MsgSetProviderMetadata))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AttestEndpointHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAttestEndpointHealth)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AttestEndpointHealth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.pairing.Msg/AttestEndpointHealth",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AttestEndpointHealth(ctx, req.(*MsgAttestEndpointHealth))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "lavanet.lava.pairing.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "StakeProvider",
+			Handler:    _Msg_StakeProvider_Handler,
+		},
+		{
+			MethodName: "StakeClient",
+			Handler:    _Msg_StakeClient_Handler,
+		},
+		{
+			MethodName: "UnstakeProvider",
+			Handler:    _Msg_UnstakeProvider_Handler,
+		},
+		{
+			MethodName: "UnstakeClient",
+			Handler:    _Msg_UnstakeClient_Handler,
+		},
+		{
+			MethodName: "RelayPayment",
+			Handler:    _Msg_RelayPayment_Handler,
+		},
+		{
+			MethodName: "FreezeProvider",
+			Handler:    _Msg_FreezeProvider_Handler,
+		},
+		{
+			MethodName: "UnfreezeProvider",
+			Handler:    _Msg_UnfreezeProvider_Handler,
+		},
+		{
+			MethodName: "DelegateToProvider",
+			Handler:    _Msg_DelegateToProvider_Handler,
+		},
+		{
+			MethodName: "Undelegate",
+			Handler:    _Msg_Undelegate_Handler,
+		},
+		{
+			MethodName: "SetProviderMetadata",
+			Handler:    _Msg_SetProviderMetadata_Handler,
+		},
+		{
+			MethodName: "AttestEndpointHealth",
+			Handler:    _Msg_AttestEndpointHealth_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pairing/tx.proto",
+}
+
+func (m *MsgStakeProvider) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1555,24 +1728,63 @@ func (m *MsgUnfreezeProvider) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUnfreezeProvider) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgStakeProvider) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUnfreezeProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgStakeProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ChainIds) > 0 {
-		for iNdEx := len(m.ChainIds) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.ChainIds[iNdEx])
-			copy(dAtA[i:], m.ChainIds[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.ChainIds[iNdEx])))
+	if m.CuCapacity != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CuCapacity))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Moniker) > 0 {
+		i -= len(m.Moniker)
+		copy(dAtA[i:], m.Moniker)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Moniker)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Geolocation != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Geolocation))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Endpoints) > 0 {
+		for iNdEx := len(m.Endpoints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Endpoints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0x12
 	}
 	if len(m.Creator) > 0 {
 		i -= len(m.Creator)
@@ -1584,7 +1796,7 @@ func (m *MsgUnfreezeProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUnfreezeProviderResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgStakeProviderResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1594,12 +1806,12 @@ func (m *MsgUnfreezeProviderResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUnfreezeProviderResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgStakeProviderResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUnfreezeProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgStakeProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1607,250 +1819,1864 @@ func (m *MsgUnfreezeProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgStakeClient) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgStakeProvider) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgStakeClient) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStakeClient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Vrfpk) > 0 {
+		i -= len(m.Vrfpk)
+		copy(dAtA[i:], m.Vrfpk)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Vrfpk)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.ChainID)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Geolocation != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Geolocation))
+		i--
+		dAtA[i] = 0x20
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if len(m.Endpoints) > 0 {
-		for _, e := range m.Endpoints {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	if m.Geolocation != 0 {
-		n += 1 + sovTx(uint64(m.Geolocation))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Moniker)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgStakeProviderResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgStakeClientResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgStakeClient) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgStakeClientResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStakeClientResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ChainID)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnstakeProvider) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.Geolocation != 0 {
-		n += 1 + sovTx(uint64(m.Geolocation))
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnstakeProvider) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnstakeProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Vrfpk)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgStakeClientResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUnstakeProviderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnstakeProviderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnstakeProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUnstakeProvider) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUnstakeClient) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnstakeClient) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnstakeClient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.ChainID)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUnstakeProviderResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUnstakeClientResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnstakeClientResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnstakeClientResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUnstakeClient) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRelayPayment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRelayPayment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRelayPayment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.DescriptionString) > 0 {
+		i -= len(m.DescriptionString)
+		copy(dAtA[i:], m.DescriptionString)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DescriptionString)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.ChainID)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.VRFs) > 0 {
+		for iNdEx := len(m.VRFs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VRFs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	return n
+	if len(m.Relays) > 0 {
+		for iNdEx := len(m.Relays) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Relays[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUnstakeClientResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRelayPaymentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRelayPaymentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRelayPaymentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRelayPayment) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgFreezeProvider) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFreezeProvider) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFreezeProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
+	if m.FreezeEpochs != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.FreezeEpochs))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.UnfreezeBlock != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.UnfreezeBlock))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChainIds) > 0 {
+		for iNdEx := len(m.ChainIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ChainIds[iNdEx])
+			copy(dAtA[i:], m.ChainIds[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.ChainIds[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFreezeProviderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFreezeProviderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFreezeProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnfreezeProvider) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnfreezeProvider) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnfreezeProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainIds) > 0 {
+		for iNdEx := len(m.ChainIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ChainIds[iNdEx])
+			copy(dAtA[i:], m.ChainIds[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.ChainIds[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnfreezeProviderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnfreezeProviderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnfreezeProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDelegateToProvider) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDelegateToProvider) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDelegateToProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDelegateToProviderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDelegateToProviderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDelegateToProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUndelegate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUndelegate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUndelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUndelegateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUndelegateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUndelegateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetProviderMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetProviderMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetProviderMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addons) > 0 {
+		for iNdEx := len(m.Addons) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addons[iNdEx])
+			copy(dAtA[i:], m.Addons[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Addons[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Contact) > 0 {
+		i -= len(m.Contact)
+		copy(dAtA[i:], m.Contact)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Contact)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Website) > 0 {
+		i -= len(m.Website)
+		copy(dAtA[i:], m.Website)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Website)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Moniker) > 0 {
+		i -= len(m.Moniker)
+		copy(dAtA[i:], m.Moniker)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Moniker)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetProviderMetadataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetProviderMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetProviderMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAttestEndpointHealth) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAttestEndpointHealth) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAttestEndpointHealth) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BinaryVersion) > 0 {
+		i -= len(m.BinaryVersion)
+		copy(dAtA[i:], m.BinaryVersion)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BinaryVersion)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ProtocolVersion != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProtocolVersion))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChainIDs) > 0 {
+		for iNdEx := len(m.ChainIDs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ChainIDs[iNdEx])
+			copy(dAtA[i:], m.ChainIDs[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.ChainIDs[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAttestEndpointHealthResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAttestEndpointHealthResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAttestEndpointHealthResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgStakeProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Endpoints) > 0 {
+		for _, e := range m.Endpoints {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Geolocation != 0 {
+		n += 1 + sovTx(uint64(m.Geolocation))
+	}
+	l = len(m.Moniker)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CuCapacity != 0 {
+		n += 1 + sovTx(uint64(m.CuCapacity))
+	}
+	return n
+}
+
+func (m *MsgStakeProviderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgStakeClient) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Geolocation != 0 {
+		n += 1 + sovTx(uint64(m.Geolocation))
+	}
+	l = len(m.Vrfpk)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgStakeClientResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUnstakeProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUnstakeProviderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUnstakeClient) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUnstakeClientResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRelayPayment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	if len(m.Relays) > 0 {
 		for _, e := range m.Relays {
 			l = e.Size()
 			n += 1 + l + sovTx(uint64(l))
 		}
-	}
-	if len(m.VRFs) > 0 {
-		for _, e := range m.VRFs {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.VRFs) > 0 {
+		for _, e := range m.VRFs {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.DescriptionString)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRelayPaymentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgFreezeProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.ChainIds) > 0 {
+		for _, s := range m.ChainIds {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.UnfreezeBlock != 0 {
+		n += 1 + sovTx(uint64(m.UnfreezeBlock))
+	}
+	if m.FreezeEpochs != 0 {
+		n += 1 + sovTx(uint64(m.FreezeEpochs))
+	}
+	return n
+}
+
+func (m *MsgFreezeProviderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUnfreezeProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.ChainIds) > 0 {
+		for _, s := range m.ChainIds {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUnfreezeProviderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDelegateToProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgDelegateToProviderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUndelegate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUndelegateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetProviderMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Moniker)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Website)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Contact)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Addons) > 0 {
+		for _, s := range m.Addons {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetProviderMetadataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAttestEndpointHealth) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.ChainIDs) > 0 {
+		for _, s := range m.ChainIDs {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.ProtocolVersion != 0 {
+		n += 1 + sovTx(uint64(m.ProtocolVersion))
+	}
+	l = len(m.BinaryVersion)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAttestEndpointHealthResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgStakeProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStakeProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStakeProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Endpoints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Endpoints = append(m.Endpoints, types1.Endpoint{})
+			if err := m.Endpoints[len(m.Endpoints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Geolocation", wireType)
+			}
+			m.Geolocation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Geolocation |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Moniker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CuCapacity", wireType)
+			}
+			m.CuCapacity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CuCapacity |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgStakeProviderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStakeProviderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStakeProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgStakeClient) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStakeClient: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStakeClient: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Geolocation", wireType)
+			}
+			m.Geolocation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Geolocation |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vrfpk", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Vrfpk = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgStakeClientResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStakeClientResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStakeClientResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	l = len(m.DescriptionString)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
-}
 
-func (m *MsgRelayPaymentResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *MsgFreezeProvider) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.ChainIds) > 0 {
-		for _, s := range m.ChainIds {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+func (m *MsgUnstakeProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnstakeProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnstakeProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	l = len(m.Reason)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
-}
 
-func (m *MsgFreezeProviderResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *MsgUnfreezeProvider) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.ChainIds) > 0 {
-		for _, s := range m.ChainIds {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+func (m *MsgUnstakeProviderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnstakeProviderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnstakeProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *MsgUnfreezeProviderResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgStakeProvider) Unmarshal(dAtA []byte) error {
+func (m *MsgUnstakeClient) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1873,10 +3699,10 @@ func (m *MsgStakeProvider) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgStakeProvider: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnstakeClient: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgStakeProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnstakeClient: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1943,11 +3769,111 @@ func (m *MsgStakeProvider) Unmarshal(dAtA []byte) error {
 			}
 			m.ChainID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnstakeClientResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnstakeClientResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnstakeClientResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRelayPayment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRelayPayment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRelayPayment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1957,28 +3883,27 @@ func (m *MsgStakeProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Endpoints", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Relays", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2005,16 +3930,16 @@ func (m *MsgStakeProvider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Endpoints = append(m.Endpoints, types1.Endpoint{})
-			if err := m.Endpoints[len(m.Endpoints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Relays = append(m.Relays, &RelaySession{})
+			if err := m.Relays[len(m.Relays)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Geolocation", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VRFs", wireType)
 			}
-			m.Geolocation = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2024,14 +3949,29 @@ func (m *MsgStakeProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Geolocation |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VRFs = append(m.VRFs, &VRFData{})
+			if err := m.VRFs[len(m.VRFs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DescriptionString", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2059,7 +3999,7 @@ func (m *MsgStakeProvider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Moniker = string(dAtA[iNdEx:postIndex])
+			m.DescriptionString = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2082,7 +4022,7 @@ func (m *MsgStakeProvider) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgStakeProviderResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRelayPaymentResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2105,10 +4045,10 @@ func (m *MsgStakeProviderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgStakeProviderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRelayPaymentResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgStakeProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRelayPaymentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2132,7 +4072,7 @@ func (m *MsgStakeProviderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgStakeClient) Unmarshal(dAtA []byte) error {
+func (m *MsgFreezeProvider) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2155,10 +4095,10 @@ func (m *MsgStakeClient) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgStakeClient: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFreezeProvider: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgStakeClient: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFreezeProvider: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2195,7 +4135,7 @@ func (m *MsgStakeClient) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainIds", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2223,13 +4163,13 @@ func (m *MsgStakeClient) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainID = string(dAtA[iNdEx:postIndex])
+			m.ChainIds = append(m.ChainIds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2239,30 +4179,29 @@ func (m *MsgStakeClient) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Geolocation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnfreezeBlock", wireType)
 			}
-			m.Geolocation = 0
+			m.UnfreezeBlock = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2272,16 +4211,16 @@ func (m *MsgStakeClient) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Geolocation |= uint64(b&0x7F) << shift
+				m.UnfreezeBlock |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Vrfpk", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FreezeEpochs", wireType)
 			}
-			var stringLen uint64
+			m.FreezeEpochs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2291,24 +4230,11 @@ func (m *MsgStakeClient) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.FreezeEpochs |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Vrfpk = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2330,7 +4256,7 @@ func (m *MsgStakeClient) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgStakeClientResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgFreezeProviderResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2353,10 +4279,10 @@ func (m *MsgStakeClientResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgStakeClientResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFreezeProviderResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgStakeClientResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFreezeProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2380,7 +4306,7 @@ func (m *MsgStakeClientResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUnstakeProvider) Unmarshal(dAtA []byte) error {
+func (m *MsgUnfreezeProvider) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2403,10 +4329,10 @@ func (m *MsgUnstakeProvider) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUnstakeProvider: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnfreezeProvider: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUnstakeProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnfreezeProvider: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2443,7 +4369,7 @@ func (m *MsgUnstakeProvider) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainIds", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2471,7 +4397,7 @@ func (m *MsgUnstakeProvider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainID = string(dAtA[iNdEx:postIndex])
+			m.ChainIds = append(m.ChainIds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2494,7 +4420,7 @@ func (m *MsgUnstakeProvider) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUnstakeProviderResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUnfreezeProviderResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2517,10 +4443,10 @@ func (m *MsgUnstakeProviderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUnstakeProviderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnfreezeProviderResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUnstakeProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnfreezeProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2544,7 +4470,7 @@ func (m *MsgUnstakeProviderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUnstakeClient) Unmarshal(dAtA []byte) error {
+func (m *MsgDelegateToProvider) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2567,15 +4493,47 @@ func (m *MsgUnstakeClient) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUnstakeClient: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDelegateToProvider: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUnstakeClient: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDelegateToProvider: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2603,9 +4561,9 @@ func (m *MsgUnstakeClient) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Provider = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
 			}
@@ -2637,6 +4595,39 @@ func (m *MsgUnstakeClient) Unmarshal(dAtA []byte) error {
 			}
 			m.ChainID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2658,7 +4649,7 @@ func (m *MsgUnstakeClient) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUnstakeClientResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDelegateToProviderResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2681,10 +4672,10 @@ func (m *MsgUnstakeClientResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUnstakeClientResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDelegateToProviderResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUnstakeClientResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDelegateToProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2708,7 +4699,7 @@ func (m *MsgUnstakeClientResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRelayPayment) Unmarshal(dAtA []byte) error {
+func (m *MsgUndelegate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2731,10 +4722,10 @@ func (m *MsgRelayPayment) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRelayPayment: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUndelegate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRelayPayment: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUndelegate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2771,9 +4762,9 @@ func (m *MsgRelayPayment) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Relays", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2783,31 +4774,29 @@ func (m *MsgRelayPayment) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Relays = append(m.Relays, &RelaySession{})
-			if err := m.Relays[len(m.Relays)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VRFs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2817,31 +4806,29 @@ func (m *MsgRelayPayment) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VRFs = append(m.VRFs, &VRFData{})
-			if err := m.VRFs[len(m.VRFs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DescriptionString", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2851,23 +4838,24 @@ func (m *MsgRelayPayment) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DescriptionString = string(dAtA[iNdEx:postIndex])
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2890,7 +4878,7 @@ func (m *MsgRelayPayment) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRelayPaymentResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUndelegateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2913,10 +4901,10 @@ func (m *MsgRelayPaymentResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRelayPaymentResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUndelegateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRelayPaymentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUndelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2940,7 +4928,7 @@ func (m *MsgRelayPaymentResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgFreezeProvider) Unmarshal(dAtA []byte) error {
+func (m *MsgSetProviderMetadata) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2963,10 +4951,10 @@ func (m *MsgFreezeProvider) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFreezeProvider: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetProviderMetadata: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFreezeProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetProviderMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3003,7 +4991,7 @@ func (m *MsgFreezeProvider) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3031,11 +5019,11 @@ func (m *MsgFreezeProvider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainIds = append(m.ChainIds, string(dAtA[iNdEx:postIndex]))
+			m.Moniker = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Website", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3063,7 +5051,103 @@ func (m *MsgFreezeProvider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
+			m.Website = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contact", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contact = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addons", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addons = append(m.Addons, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3086,7 +5170,7 @@ func (m *MsgFreezeProvider) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgFreezeProviderResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetProviderMetadataResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3109,10 +5193,10 @@ func (m *MsgFreezeProviderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFreezeProviderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetProviderMetadataResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFreezeProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetProviderMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3136,7 +5220,7 @@ func (m *MsgFreezeProviderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUnfreezeProvider) Unmarshal(dAtA []byte) error {
+func (m *MsgAttestEndpointHealth) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3159,10 +5243,10 @@ func (m *MsgUnfreezeProvider) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUnfreezeProvider: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAttestEndpointHealth: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUnfreezeProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAttestEndpointHealth: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3199,7 +5283,7 @@ func (m *MsgUnfreezeProvider) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainIDs", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3227,7 +5311,58 @@ func (m *MsgUnfreezeProvider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainIds = append(m.ChainIds, string(dAtA[iNdEx:postIndex]))
+			m.ChainIDs = append(m.ChainIDs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolVersion", wireType)
+			}
+			m.ProtocolVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProtocolVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BinaryVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BinaryVersion = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3250,7 +5385,7 @@ func (m *MsgUnfreezeProvider) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUnfreezeProviderResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAttestEndpointHealthResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3273,10 +5408,10 @@ func (m *MsgUnfreezeProviderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUnfreezeProviderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAttestEndpointHealthResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUnfreezeProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAttestEndpointHealthResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
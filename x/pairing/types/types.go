@@ -12,8 +12,28 @@ const (
 	RelayPaymentEventName                          = "relay_payment"
 	UnresponsiveProviderUnstakeFailedEventName     = "unresponsive_provider"
 	ProviderJailedEventName                        = "provider_jailed"
+	ProviderFreezeEventName                        = "freeze_provider"
+	ClientCuOveruseEventName                       = "client_cu_overuse"
+	ClientJailedEventName                          = "client_jailed"
+	DelegateToProviderEventName                    = "delegate_to_provider"
+	UndelegateEventName                            = "undelegate"
+	UndelegateCommitEventName                      = "undelegate_commit"
+	ProviderExcludedFromPairingEventName           = "provider_excluded_from_pairing"
+	ProviderUnresponsiveJailedEventName            = "provider_unresponsive_jailed"
+	ProviderMetadataEventName                      = "provider_metadata"
+	ClientSessionDoubleSpendEventName              = "client_session_double_spend"
+	ProviderAttestationEventName                   = "provider_endpoint_attestation"
+	ProviderAttestationStaleEventName              = "provider_attestation_stale"
+	ProviderUnresponsiveReportEventName            = "provider_unresponsive_report"
+	ProviderWithheldRewardEventName                = "provider_withheld_reward_paid"
 )
 
+// DataReliabilitySessionId is the session id of the data reliability relays, a consumer sends them to several providers in an epoch under it
+const DataReliabilitySessionId = 0
+
+// RelayApiNameProtocolVersion is the relay protocol version consumers sign the api name of a relay session from
+const RelayApiNameProtocolVersion = 4
+
 // unstake description strings
 const (
 	UnstakeDescriptionClientUnstake     = "Client unstaked entry"
@@ -24,6 +44,26 @@ const (
 const (
 	FlagMoniker     = "provider-moniker"
 	MAX_LEN_MONIKER = 50
+	FlagWebsite     = "website"
+	FlagDescription = "description"
+	FlagContact     = "contact"
+	FlagAddons      = "addons"
+	FlagAddon       = "addon"
+	FlagCuCapacity  = "cu-capacity"
+	FlagFromEpoch   = "from-epoch"
+	FlagToEpoch     = "to-epoch"
+	FlagProvider    = "provider"
+	FlagConsumer    = "consumer"
+	FlagProject     = "project"
+
+	FlagProtocolVersion         = "protocol-version"
+	FlagBinaryVersion           = "binary-version"
+	MAX_LEN_ATTESTATION_VERSION = 50
+
+	FlagRequiredAddons = "required-addons"
+	FlagGeolocation    = "geolocation"
+	FlagMinStake       = "min-stake"
+	FlagExclude        = "exclude"
 )
 
 func StakeNewEventName(isProvider bool) string {
@@ -0,0 +1,44 @@
+package types
+
+import (
+	"encoding/json"
+)
+
+// UnresponsiveProviderErrors counts a consumer's failures with a provider in the epoch it reports it, by error class
+type UnresponsiveProviderErrors struct {
+	MaxComputeUnitsExceeded uint64 `json:"max_cu_exceeded,omitempty"`
+	ConnectionFailures      uint64 `json:"connection_failures,omitempty"`
+	SessionOutOfSync        uint64 `json:"session_out_of_sync,omitempty"`
+	Timeouts                uint64 `json:"timeouts,omitempty"`
+}
+
+// UnresponsiveProvider is an entry of the unresponsive providers a relay session reports
+type UnresponsiveProvider struct {
+	Address string                     `json:"address"`
+	Errors  UnresponsiveProviderErrors `json:"errors"`
+}
+
+// UnmarshalJSON also accepts a plain address, the entry format of consumers that report no errors
+func (up *UnresponsiveProvider) UnmarshalJSON(data []byte) error {
+	var address string
+	if err := json.Unmarshal(data, &address); err == nil {
+		*up = UnresponsiveProvider{Address: address}
+		return nil
+	}
+	type unresponsiveProvider UnresponsiveProvider // without the UnmarshalJSON method
+	var entry unresponsiveProvider
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return err
+	}
+	*up = UnresponsiveProvider(entry)
+	return nil
+}
+
+// ParseUnresponsiveProviders decodes the unresponsive providers of a relay session
+func ParseUnresponsiveProviders(data []byte) ([]UnresponsiveProvider, error) {
+	var unresponsiveProviders []UnresponsiveProvider
+	if err := json.Unmarshal(data, &unresponsiveProviders); err != nil {
+		return nil, err
+	}
+	return unresponsiveProviders, nil
+}
@@ -0,0 +1,22 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseUnresponsiveProviders(t *testing.T) {
+	parsed, err := ParseUnresponsiveProviders([]byte(`["lava@a",{"address":"lava@b","errors":{"timeouts":2,"connection_failures":1}},{"address":"lava@c"}]`))
+	require.NoError(t, err)
+	require.Equal(t, []UnresponsiveProvider{
+		{Address: "lava@a"},
+		{Address: "lava@b", Errors: UnresponsiveProviderErrors{Timeouts: 2, ConnectionFailures: 1}},
+		{Address: "lava@c"},
+	}, parsed)
+
+	for _, data := range []string{`[1,2]`, `"lava@a"`, `[["lava@a"]]`, `not json`} {
+		_, err := ParseUnresponsiveProviders([]byte(data))
+		require.Error(t, err, data)
+	}
+}
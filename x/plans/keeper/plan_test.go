@@ -167,6 +167,8 @@ const (
 	SERVICERS_FIELD
 	DESCRIPTION_FIELD
 	TYPE_FIELD
+	ADD_ON_PRICE_FIELD
+	ADD_ON_NAME_FIELD
 )
 
 // Test that the plan verification before adding it to the plan storage is working correctly
@@ -190,6 +192,8 @@ func TestInvalidPlanAddition(t *testing.T) {
 		{"InvalidServicersToPairTest", 5},
 		{"InvalidDescriptionTest", 6},
 		{"InvalidTypeTest", 7},
+		{"InvalidAddOnPriceTest", 8},
+		{"DuplicateAddOnTest", 9},
 	}
 
 	for _, tt := range tests {
@@ -214,6 +218,11 @@ func TestInvalidPlanAddition(t *testing.T) {
 				planToTest[0].Description = strings.Repeat("a", types.MAX_LEN_PACKAGE_DESCRIPTION+1)
 			case TYPE_FIELD:
 				planToTest[0].Type = strings.Repeat("a", types.MAX_LEN_PACKAGE_TYPE+1)
+			case ADD_ON_PRICE_FIELD:
+				planToTest[0].AddOns = []types.AddOn{{Name: "archive", Price: sdk.NewCoin(epochstoragetypes.TokenDenom, sdk.ZeroInt())}}
+			case ADD_ON_NAME_FIELD:
+				addOn := types.AddOn{Name: "archive", Price: sdk.NewCoin(epochstoragetypes.TokenDenom, sdk.NewInt(10))}
+				planToTest[0].AddOns = []types.AddOn{addOn, addOn}
 			}
 
 			// simulate a plan proposal - should fail
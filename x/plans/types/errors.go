@@ -17,4 +17,5 @@ var (
 	ErrInvalidPlanDescription     = sdkerrors.Register(ModuleName, 8, "plan's description field is invalid")
 	ErrInvalidPlanComputeUnits    = sdkerrors.Register(ModuleName, 9, "plan's compute units fields are invalid")
 	ErrInvalidPlanAnnualDiscount  = sdkerrors.Register(ModuleName, 10, "plan's annual discount field is invalid")
+	ErrInvalidPlanAddOn           = sdkerrors.Register(ModuleName, 11, "plan's add-ons field is invalid")
 )
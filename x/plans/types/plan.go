@@ -1,6 +1,8 @@
 package types
 
 import (
+	"strings"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	epochstoragetypes "github.com/lavanet/lava/x/epochstorage/types"
@@ -48,5 +50,44 @@ func (p Plan) ValidatePlan() error {
 		return sdkerrors.Wrap(ErrInvalidPlanAnnualDiscount, "plan's annual discount is invalid (not between 0-100 percent)")
 	}
 
+	// check that the plan's add-ons are named uniquely and priced in ulava
+	addOnNames := map[string]struct{}{}
+	for _, addOn := range p.GetAddOns() {
+		if strings.TrimSpace(addOn.Name) == "" {
+			return sdkerrors.Wrap(ErrInvalidPlanAddOn, "plan's add-on name can't be empty")
+		}
+		if _, found := addOnNames[addOn.Name]; found {
+			return sdkerrors.Wrapf(ErrInvalidPlanAddOn, "plan's add-on %s appears more than once", addOn.Name)
+		}
+		addOnNames[addOn.Name] = struct{}{}
+		if addOn.Price.Denom != epochstoragetypes.TokenDenom || !addOn.Price.IsPositive() {
+			return sdkerrors.Wrapf(ErrInvalidPlanAddOn, "plan's add-on %s price must be a positive amount of %s", addOn.Name, epochstoragetypes.TokenDenom)
+		}
+		if len(addOn.Description) > MAX_LEN_PACKAGE_DESCRIPTION {
+			return sdkerrors.Wrapf(ErrInvalidPlanAddOn, "plan's add-on %s description is too long", addOn.Name)
+		}
+	}
+
 	return nil
 }
+
+// GetAddOn returns the plan's add-on with the name
+func (p Plan) GetAddOn(name string) (AddOn, bool) {
+	for _, addOn := range p.GetAddOns() {
+		if addOn.Name == name {
+			return addOn, true
+		}
+	}
+	return AddOn{}, false
+}
+
+// GetMonthlyPrice returns the plan's price for a month with the add-ons, add-ons the plan doesn't offer aren't priced
+func (p Plan) GetMonthlyPrice(addOns []string) sdk.Coin {
+	price := p.GetPrice()
+	for _, name := range addOns {
+		if addOn, found := p.GetAddOn(name); found {
+			price = price.Add(addOn.Price)
+		}
+	}
+	return price
+}
@@ -35,6 +35,7 @@ type Plan struct {
 	Type                     string        `protobuf:"bytes,12,opt,name=type,proto3" json:"type,omitempty"`
 	AnnualDiscountPercentage uint64        `protobuf:"varint,13,opt,name=annual_discount_percentage,json=annualDiscountPercentage,proto3" json:"annual_discount_percentage,omitempty"`
 	PlanPolicy               types1.Policy `protobuf:"bytes,14,opt,name=plan_policy,json=planPolicy,proto3" json:"plan_policy"`
+	AddOns                   []AddOn       `protobuf:"bytes,15,rep,name=add_ons,json=addOns,proto3" json:"add_ons"`
 }
 
 func (m *Plan) Reset()         { *m = Plan{} }
@@ -133,41 +134,113 @@ func (m *Plan) GetPlanPolicy() types1.Policy {
 	return types1.Policy{}
 }
 
+func (m *Plan) GetAddOns() []AddOn {
+	if m != nil {
+		return m.AddOns
+	}
+	return nil
+}
+
+// AddOn is a package of premium spec apis (apis whose add_on is the package name)
+type AddOn struct {
+	Name        string     `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description string     `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Price       types.Coin `protobuf:"bytes,3,opt,name=price,proto3" json:"price"`
+}
+
+func (m *AddOn) Reset()         { *m = AddOn{} }
+func (m *AddOn) String() string { return proto.CompactTextString(m) }
+func (*AddOn) ProtoMessage()    {}
+func (*AddOn) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5909a10cd0e3497, []int{1}
+}
+func (m *AddOn) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddOn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AddOn.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AddOn) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddOn.Merge(m, src)
+}
+func (m *AddOn) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddOn) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddOn.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddOn proto.InternalMessageInfo
+
+func (m *AddOn) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *AddOn) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *AddOn) GetPrice() types.Coin {
+	if m != nil {
+		return m.Price
+	}
+	return types.Coin{}
+}
+
 func init() {
 	proto.RegisterType((*Plan)(nil), "lavanet.lava.plans.Plan")
+	proto.RegisterType((*AddOn)(nil), "lavanet.lava.plans.AddOn")
 }
 
 func init() { proto.RegisterFile("plans/plan.proto", fileDescriptor_e5909a10cd0e3497) }
 
 var fileDescriptor_e5909a10cd0e3497 = []byte{
-	// 418 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x52, 0xcd, 0x8e, 0xd3, 0x30,
-	0x10, 0xae, 0xa9, 0xdb, 0xcd, 0x3a, 0x5d, 0x14, 0x59, 0x2b, 0x64, 0x2a, 0x11, 0x02, 0x08, 0xa9,
-	0x27, 0x5b, 0x0b, 0xe2, 0xc6, 0x69, 0x77, 0x4f, 0xb9, 0x50, 0xe5, 0xc8, 0x25, 0x72, 0x5c, 0xab,
-	0x18, 0xb2, 0x76, 0x14, 0xbb, 0x65, 0xf7, 0x11, 0xb8, 0xf1, 0x18, 0x3c, 0xca, 0x1e, 0xf7, 0xc8,
-	0x09, 0xa1, 0xf6, 0x45, 0x90, 0x7f, 0x84, 0xe0, 0x92, 0x99, 0xf9, 0xbe, 0x2f, 0xfe, 0x3c, 0x33,
-	0x46, 0xc5, 0xd0, 0x73, 0x6d, 0x99, 0xff, 0xd2, 0x61, 0x34, 0xce, 0x60, 0xdc, 0xf3, 0x3d, 0xd7,
-	0xd2, 0x51, 0x1f, 0x69, 0xa0, 0x97, 0xe7, 0x5b, 0xb3, 0x35, 0x81, 0x66, 0x3e, 0x8b, 0xca, 0x65,
-	0x29, 0x8c, 0xbd, 0x31, 0x96, 0x75, 0xdc, 0x4a, 0xb6, 0xbf, 0xe8, 0xa4, 0xe3, 0x17, 0x4c, 0x18,
-	0x95, 0x4e, 0x5a, 0x3e, 0x19, 0x46, 0xf3, 0x59, 0x0a, 0x67, 0x59, 0x4a, 0x22, 0xfe, 0xf2, 0xdb,
-	0x14, 0xc1, 0x75, 0xcf, 0x35, 0x3e, 0x47, 0x33, 0xa5, 0x37, 0xf2, 0x96, 0x80, 0x0a, 0xac, 0x4e,
-	0x9b, 0x58, 0x78, 0xb4, 0xeb, 0x8d, 0xf8, 0x42, 0xa6, 0x15, 0x58, 0xc1, 0x26, 0x16, 0xf8, 0x1d,
-	0x9a, 0x0d, 0xa3, 0x12, 0x92, 0xc0, 0x0a, 0xac, 0xf2, 0x37, 0x4f, 0x69, 0x34, 0xa7, 0xde, 0x9c,
-	0x26, 0x73, 0x7a, 0x65, 0x94, 0xbe, 0x84, 0xf7, 0xbf, 0x9e, 0x4f, 0x9a, 0xa8, 0xc6, 0xaf, 0xd0,
-	0x19, 0xef, 0x7b, 0xf3, 0xb5, 0x35, 0x7b, 0x39, 0xee, 0xac, 0x24, 0x59, 0x05, 0x56, 0x59, 0xb3,
-	0x08, 0xe0, 0x87, 0x88, 0xe1, 0x17, 0x68, 0x91, 0xe8, 0x76, 0xe4, 0x4e, 0x92, 0xd3, 0x60, 0x9c,
-	0x27, 0xac, 0xe1, 0x4e, 0xe2, 0x0a, 0xe5, 0x1b, 0x69, 0xc5, 0xa8, 0x06, 0xa7, 0x8c, 0x26, 0x79,
-	0xb8, 0xf0, 0xbf, 0x10, 0xc6, 0x08, 0xba, 0xbb, 0x41, 0x92, 0x45, 0xa0, 0x42, 0x8e, 0xdf, 0xa3,
-	0x25, 0xd7, 0x7a, 0xc7, 0xfb, 0x76, 0xa3, 0xac, 0x30, 0x3b, 0xed, 0xda, 0x41, 0x8e, 0x42, 0x6a,
-	0xc7, 0xb7, 0x92, 0x9c, 0x05, 0x1b, 0x12, 0x15, 0xd7, 0x49, 0xb0, 0xfe, 0xcb, 0xe3, 0x6b, 0x94,
-	0xfb, 0xf1, 0xb7, 0x83, 0xe9, 0x95, 0xb8, 0x23, 0x8f, 0x43, 0xe3, 0xcf, 0xe8, 0xff, 0xfb, 0x49,
-	0x23, 0xa6, 0xeb, 0x20, 0x4a, 0xcd, 0x23, 0xff, 0x5f, 0x44, 0x6a, 0x98, 0xa1, 0x22, 0xaf, 0x61,
-	0xf6, 0xa8, 0x98, 0xd6, 0x30, 0x9b, 0x15, 0xf3, 0x1a, 0x66, 0xf3, 0xe2, 0xa4, 0x86, 0xd9, 0x49,
-	0x91, 0x5d, 0x5e, 0xfd, 0x38, 0x94, 0xe0, 0xfe, 0x50, 0x82, 0x87, 0x43, 0x09, 0x7e, 0x1f, 0x4a,
-	0xf0, 0xfd, 0x58, 0x4e, 0x1e, 0x8e, 0xe5, 0xe4, 0xe7, 0xb1, 0x9c, 0x7c, 0x7c, 0xbd, 0x55, 0xee,
-	0xd3, 0xae, 0xa3, 0xc2, 0xdc, 0xb0, 0x64, 0x1b, 0x22, 0xbb, 0x65, 0xf1, 0xdd, 0xf8, 0x2e, 0x6d,
-	0x37, 0x0f, 0x7b, 0x7d, 0xfb, 0x27, 0x00, 0x00, 0xff, 0xff, 0xaa, 0xd3, 0x8a, 0x36, 0x4d, 0x02,
-	0x00, 0x00,
+	// 472 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0x4f, 0x6f, 0xd3, 0x30,
+	0x1c, 0x6d, 0x16, 0xb7, 0xcd, 0x9c, 0x0e, 0x22, 0x6b, 0x42, 0xa6, 0x12, 0x21, 0x0c, 0x21, 0xf5,
+	0xe4, 0x68, 0x20, 0x24, 0x0e, 0x5c, 0xd8, 0x76, 0xca, 0x65, 0x55, 0x8e, 0x5c, 0x22, 0xc7, 0xb1,
+	0x4a, 0x20, 0xb5, 0xa3, 0xd8, 0x2d, 0xdb, 0xb7, 0xe0, 0x63, 0xf0, 0x3d, 0xb8, 0xec, 0xb8, 0x23,
+	0x27, 0x84, 0xda, 0x2f, 0x82, 0xfc, 0x87, 0x09, 0xd8, 0x89, 0x4b, 0xfd, 0xfb, 0xbd, 0xdf, 0x73,
+	0x5f, 0x7e, 0xef, 0x19, 0x26, 0x7d, 0x47, 0x85, 0xca, 0xcd, 0x2f, 0xe9, 0x07, 0xa9, 0x25, 0x42,
+	0x1d, 0xdd, 0x52, 0xc1, 0x35, 0x31, 0x27, 0xb1, 0xe3, 0xf9, 0xf1, 0x4a, 0xae, 0xa4, 0x1d, 0xe7,
+	0xa6, 0x72, 0xcc, 0x79, 0xca, 0xa4, 0x5a, 0x4b, 0x95, 0xd7, 0x54, 0xf1, 0x7c, 0x7b, 0x5a, 0x73,
+	0x4d, 0x4f, 0x73, 0x26, 0x5b, 0xff, 0x4f, 0xf3, 0x47, 0xfd, 0x20, 0x3f, 0x72, 0xa6, 0x55, 0xee,
+	0x0b, 0x87, 0x9f, 0x7c, 0x0b, 0x21, 0x58, 0x76, 0x54, 0xa0, 0x63, 0x38, 0x6e, 0x45, 0xc3, 0xaf,
+	0x70, 0x90, 0x05, 0x8b, 0xc3, 0xd2, 0x35, 0x06, 0xad, 0x3b, 0xc9, 0x3e, 0xe1, 0x30, 0x0b, 0x16,
+	0xa0, 0x74, 0x0d, 0x7a, 0x0d, 0xc7, 0xfd, 0xd0, 0x32, 0x8e, 0x41, 0x16, 0x2c, 0xe2, 0x97, 0x8f,
+	0x89, 0x13, 0x27, 0x46, 0x9c, 0x78, 0x71, 0x72, 0x2e, 0x5b, 0x71, 0x06, 0x6e, 0x7e, 0x3c, 0x1d,
+	0x95, 0x8e, 0x8d, 0x9e, 0xc3, 0x23, 0xda, 0x75, 0xf2, 0x73, 0x25, 0xb7, 0x7c, 0xd8, 0x28, 0x8e,
+	0xa3, 0x2c, 0x58, 0x44, 0xe5, 0xcc, 0x82, 0x97, 0x0e, 0x43, 0xcf, 0xe0, 0xcc, 0x8f, 0xab, 0x81,
+	0x6a, 0x8e, 0x0f, 0xad, 0x70, 0xec, 0xb1, 0x92, 0x6a, 0x8e, 0x32, 0x18, 0x37, 0x5c, 0xb1, 0xa1,
+	0xed, 0x75, 0x2b, 0x05, 0x8e, 0xed, 0x07, 0xff, 0x09, 0x21, 0x04, 0x81, 0xbe, 0xee, 0x39, 0x9e,
+	0xd9, 0x91, 0xad, 0xd1, 0x5b, 0x38, 0xa7, 0x42, 0x6c, 0x68, 0x57, 0x35, 0xad, 0x62, 0x72, 0x23,
+	0x74, 0xd5, 0xf3, 0x81, 0x71, 0xa1, 0xe9, 0x8a, 0xe3, 0x23, 0x2b, 0x83, 0x1d, 0xe3, 0xc2, 0x13,
+	0x96, 0x77, 0x73, 0x74, 0x01, 0x63, 0x63, 0x7f, 0xd5, 0xcb, 0xae, 0x65, 0xd7, 0xf8, 0x81, 0x5d,
+	0xfc, 0x09, 0xf9, 0x3b, 0x1f, 0x6f, 0x31, 0x59, 0x5a, 0x92, 0x5f, 0x1e, 0x9a, 0x7b, 0x0e, 0x41,
+	0x6f, 0xe0, 0x94, 0x36, 0x4d, 0x25, 0x85, 0xc2, 0x0f, 0xb3, 0xd0, 0x5a, 0x77, 0x3f, 0x61, 0xf2,
+	0xae, 0x69, 0x2e, 0x7f, 0x5b, 0x37, 0xa1, 0xa6, 0x51, 0x05, 0x88, 0x60, 0x12, 0x17, 0x20, 0x3a,
+	0x48, 0xc2, 0x02, 0x44, 0xe3, 0x64, 0x52, 0x80, 0x68, 0x92, 0x4c, 0x0b, 0x10, 0x4d, 0x93, 0xe8,
+	0x44, 0xc3, 0xb1, 0xbd, 0x64, 0x16, 0x17, 0x74, 0xcd, 0x7d, 0x88, 0xb6, 0xfe, 0xd7, 0xae, 0x83,
+	0xfb, 0x76, 0xdd, 0xe5, 0x19, 0xfe, 0x4f, 0x9e, 0x67, 0xe7, 0x5f, 0x77, 0x69, 0x70, 0xb3, 0x4b,
+	0x83, 0xdb, 0x5d, 0x1a, 0xfc, 0xdc, 0xa5, 0xc1, 0x97, 0x7d, 0x3a, 0xba, 0xdd, 0xa7, 0xa3, 0xef,
+	0xfb, 0x74, 0xf4, 0xfe, 0xc5, 0xaa, 0xd5, 0x1f, 0x36, 0x35, 0x61, 0x72, 0x9d, 0xfb, 0x25, 0xed,
+	0x99, 0x5f, 0xe5, 0xee, 0x9d, 0x9b, 0x54, 0x54, 0x3d, 0xb1, 0xef, 0xf0, 0xd5, 0xaf, 0x01, 0x00,
+	0x1a, 0xde, 0xa4, 0x52, 0xfd, 0x02, 0x00, 0x00,
 }
 
 func (this *Plan) Equal(that interface{}) bool {
@@ -216,6 +289,44 @@ func (this *Plan) Equal(that interface{}) bool {
 	if !this.PlanPolicy.Equal(&that1.PlanPolicy) {
 		return false
 	}
+	if len(this.AddOns) != len(that1.AddOns) {
+		return false
+	}
+	for i := range this.AddOns {
+		if !this.AddOns[i].Equal(&that1.AddOns[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *AddOn) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AddOn)
+	if !ok {
+		that2, ok := that.(AddOn)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if !this.Price.Equal(&that1.Price) {
+		return false
+	}
 	return true
 }
 func (m *Plan) Marshal() (dAtA []byte, err error) {
@@ -238,6 +349,20 @@ func (m *Plan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AddOns) > 0 {
+		for iNdEx := len(m.AddOns) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AddOns[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPlan(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
 	{
 		size, err := m.PlanPolicy.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -307,6 +432,53 @@ func (m *Plan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AddOn) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddOn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddOn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintPlan(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintPlan(dAtA []byte, offset int, v uint64) int {
 	offset -= sovPlan(v)
 	base := offset
@@ -352,6 +524,31 @@ func (m *Plan) Size() (n int) {
 	}
 	l = m.PlanPolicy.Size()
 	n += 1 + l + sovPlan(uint64(l))
+	if len(m.AddOns) > 0 {
+		for _, e := range m.AddOns {
+			l = e.Size()
+			n += 1 + l + sovPlan(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AddOn) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovPlan(uint64(l))
 	return n
 }
 
@@ -629,6 +826,187 @@ func (m *Plan) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddOns", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddOns = append(m.AddOns, AddOn{})
+			if err := m.AddOns[len(m.AddOns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddOn) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddOn: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddOn: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
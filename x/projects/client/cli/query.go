@@ -27,6 +27,8 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(CmdQueryParams())
 	cmd.AddCommand(CmdInfo())
 	cmd.AddCommand(CmdDeveloper())
+	cmd.AddCommand(CmdProjectUsage())
+	cmd.AddCommand(CmdDeveloperUsage())
 
 	// this line is used by starport scaffolding # 1
 
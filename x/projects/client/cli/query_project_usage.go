@@ -0,0 +1,52 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/lavanet/lava/x/projects/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdProjectUsage() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "project-usage [project-id]",
+		Short: "Query the cu used by a project in an epoch range, by developer key and by chain",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			fromEpoch, err := cmd.Flags().GetUint64(types.FlagFromEpoch)
+			if err != nil {
+				return err
+			}
+			toEpoch, err := cmd.Flags().GetUint64(types.FlagToEpoch)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryProjectUsageRequest{
+				Project:   args[0],
+				FromEpoch: fromEpoch,
+				ToEpoch:   toEpoch,
+			}
+
+			res, err := queryClient.ProjectUsage(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().Uint64(types.FlagFromEpoch, 0, "first epoch of the usage")
+	cmd.Flags().Uint64(types.FlagToEpoch, 0, "last epoch of the usage, the current epoch when not set")
+
+	return cmd
+}
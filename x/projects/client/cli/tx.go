@@ -31,6 +31,9 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdAddProjectKeys())
 	cmd.AddCommand(CmdSetAdminPolicy())
 	cmd.AddCommand(CmdSetSubscriptionPolicy())
+	cmd.AddCommand(CmdRotateProjectKey())
+	cmd.AddCommand(CmdDelKeysFromProject())
+	cmd.AddCommand(CmdSetProjectState())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
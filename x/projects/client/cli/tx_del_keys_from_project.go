@@ -0,0 +1,86 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	commontypes "github.com/lavanet/lava/common/types"
+	"github.com/lavanet/lava/x/projects/types"
+	"github.com/spf13/cobra"
+)
+
+var _ = strconv.Itoa(0)
+
+func CmdDelKeysFromProject() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "del-project-keys [project-id] [optional: project-keys-file-path]",
+		Short: "Delete developer/admin keys from an existing project",
+		Long: `The del-project-keys command allows the project admin to delete project keys (admin/developer) from the project.
+		Only the given key types are deleted, so deleting the admin type of a key that is also a developer demotes it to a developer.
+		To delete the keys you can optionally provide a YAML file of the project keys (see example in cookbook/project/example_project_keys.yml).
+		Another way to delete keys is with the --admin-key and --developer-key flags. A deleted developer key stays valid until the next epoch.
+		The last admin key of the project can only be deleted by the subscription owner`,
+		Example: `required flags: --from <admin-key> (the project's subscription address is also considered admin)
+
+		lavad tx project del-project-keys [project-id] [project-keys-file-path] --from <admin-key>
+		lavad tx project del-project-keys [project-id] --admin-key <other-admin-key> --developer-key <developer-key> --from <admin-key>`,
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			projectID := args[0]
+			var projectKeys []types.ProjectKey
+
+			if len(args) > 1 {
+				projectKeysFilePath := args[1]
+				err = commontypes.ReadYaml(projectKeysFilePath, "Project-Keys", &projectKeys)
+				if err != nil {
+					return err
+				}
+			} else {
+				developerAddresses, err := cmd.Flags().GetStringSlice("developer-key")
+				if err != nil {
+					return err
+				}
+				for _, developerAddress := range developerAddresses {
+					projectKeys = append(projectKeys, types.ProjectKey{
+						Key:   developerAddress,
+						Types: []types.ProjectKey_KEY_TYPE{types.ProjectKey_DEVELOPER},
+					})
+				}
+
+				adminAddresses, err := cmd.Flags().GetStringSlice("admin-key")
+				if err != nil {
+					return err
+				}
+				for _, adminAddress := range adminAddresses {
+					projectKeys = append(projectKeys, types.ProjectKey{
+						Key:   adminAddress,
+						Types: []types.ProjectKey_KEY_TYPE{types.ProjectKey_ADMIN},
+					})
+				}
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDelKeysFromProject(
+				clientCtx.GetFromAddress().String(),
+				projectID,
+				projectKeys,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().StringSlice("developer-key", []string{}, "Developer keys to delete")
+	cmd.Flags().StringSlice("admin-key", []string{}, "Admin keys to delete")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
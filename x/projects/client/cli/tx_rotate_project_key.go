@@ -0,0 +1,57 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/lavanet/lava/x/projects/types"
+	"github.com/spf13/cobra"
+)
+
+var _ = strconv.Itoa(0)
+
+func CmdRotateProjectKey() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rotate-project-key [project-id] [old-key] [new-key] [optional: new-key-vrfpk]",
+		Short: "Replace a developer/admin key of a project with a new key from the next epoch",
+		Long: `The rotate-project-key command allows the project admin to replace a project key without removing it first.
+		The old key stays valid until the next epoch, and from the next epoch the new key takes over with the same key types.
+		A new developer key must come with its VRF key.`,
+		Example: `required flags: --from <admin-key> (the project's subscription address is also considered admin)
+
+		lavad tx project rotate-project-key [project-id] [old-key] [new-key] [new-key-vrfpk] --from <admin-key>`,
+		Args: cobra.RangeArgs(3, 4),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			projectID := args[0]
+			oldKey := args[1]
+			newKey := args[2]
+			newKeyVrfpk := ""
+			if len(args) > 3 {
+				newKeyVrfpk = args[3]
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRotateProjectKey(
+				clientCtx.GetFromAddress().String(),
+				projectID,
+				oldKey,
+				newKey,
+				newKeyVrfpk,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
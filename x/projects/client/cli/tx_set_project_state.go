@@ -0,0 +1,53 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/lavanet/lava/x/projects/types"
+	"github.com/spf13/cobra"
+)
+
+var _ = strconv.Itoa(0)
+
+func CmdSetProjectState() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-project-state [project-id] [enabled]",
+		Short: "Pause or resume a project from the next epoch",
+		Long: `The set-project-state command allows the project admin to pause a project, for example when one of its keys leaked, and resume it later.
+		From the next epoch the keys of a paused project are not paired with providers and their relays are not paid for.`,
+		Example: `required flags: --from <admin-key> (the project's subscription address is also considered admin)
+
+		lavad tx project set-project-state [project-id] false --from <admin-key>
+		lavad tx project set-project-state [project-id] true --from <admin-key>`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			projectID := args[0]
+			enabled, err := strconv.ParseBool(args[1])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetProjectState(
+				clientCtx.GetFromAddress().String(),
+				projectID,
+				enabled,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -42,7 +42,7 @@ func (k Keeper) CreateProject(ctx sdk.Context, subscriptionAddress string, proje
 	project.SubscriptionPolicy = project.AdminPolicy
 
 	for _, projectKey := range projectData.GetProjectKeys() {
-		err = k.RegisterKey(ctx, types.ProjectKey{Key: projectKey.GetKey(), Types: projectKey.GetTypes(), Vrfpk: projectKey.GetVrfpk()}, &project, blockHeight)
+		err = k.RegisterKey(ctx, types.ProjectKey{Key: projectKey.GetKey(), Types: projectKey.GetTypes(), Vrfpk: projectKey.GetVrfpk(), ExpirationBlock: projectKey.GetExpirationBlock()}, &project, blockHeight)
 		if err != nil {
 			return err
 		}
@@ -56,6 +56,11 @@ func (k Keeper) RegisterKey(ctx sdk.Context, key types.ProjectKey, project *type
 		return utils.LavaError(ctx, k.Logger(ctx), "RegisterKey_project_is_nil", nil, "project is nil")
 	}
 
+	if key.IsExpired(blockHeight) {
+		details := map[string]string{"key": key.GetKey(), "expirationBlock": strconv.FormatUint(key.GetExpirationBlock(), 10), "blockHeight": strconv.FormatUint(blockHeight, 10)}
+		return utils.LavaError(ctx, k.Logger(ctx), "RegisterKey_key_expired", details, "key expiration block must be after the block it's registered at")
+	}
+
 	for _, keyType := range key.GetTypes() {
 		switch keyType {
 		case types.ProjectKey_ADMIN:
@@ -65,6 +70,9 @@ func (k Keeper) RegisterKey(ctx sdk.Context, key types.ProjectKey, project *type
 			var developerData types.ProtoDeveloperData
 			found := k.developerKeysFS.FindEntry(ctx, key.GetKey(), blockHeight, &developerData)
 
+			// a developer key deleted from its project is free to register again
+			found = found && developerData.ProjectID != ""
+
 			// if we find the developer key and it belongs to a different project, return error
 			if found && developerData.ProjectID != project.GetIndex() {
 				details := map[string]string{"key": key.GetKey(), "keyTypes": string(key.GetTypes())}
@@ -85,6 +93,12 @@ func (k Keeper) RegisterKey(ctx sdk.Context, key types.ProjectKey, project *type
 		}
 	}
 
+	if key.GetExpirationBlock() != 0 {
+		project.SetKeyExpiration(key.GetKey(), key.GetExpirationBlock())
+	}
+
+	details := map[string]string{"project": project.GetIndex(), "key": key.GetKey(), "keyTypes": key.TypesString(), "block": strconv.FormatUint(blockHeight, 10)}
+	utils.LogLavaEvent(ctx, k.Logger(ctx), types.ProjectKeyAddedEventName, details, "project key added")
 	return nil
 }
 
@@ -138,5 +152,11 @@ func (k Keeper) DeleteProject(ctx sdk.Context, projectID string) error {
 	project.Enabled = false
 	// TODO: delete all developer keys from the fixation
 
-	return k.projectsFS.AppendEntry(ctx, project.Index, uint64(ctx.BlockHeight()), &project)
+	err := k.projectsFS.AppendEntry(ctx, project.Index, uint64(ctx.BlockHeight()), &project)
+	if err != nil {
+		return err
+	}
+
+	k.afterProjectDisabled(ctx, project)
+	return nil
 }
@@ -16,7 +16,8 @@ func (k Keeper) Developer(goCtx context.Context, req *types.QueryDeveloperReques
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	project, _, err := k.GetProjectForDeveloper(ctx, req.Developer, uint64(ctx.BlockHeight()))
+	// paused projects are shown too, so their admins can find them
+	project, _, err := k.getProjectForDeveloper(ctx, req.Developer, uint64(ctx.BlockHeight()))
 	if err != nil {
 		return nil, err
 	}
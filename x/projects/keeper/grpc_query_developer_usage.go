@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/x/projects/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) DeveloperUsage(goCtx context.Context, req *types.QueryDeveloperUsageRequest) (*types.QueryDeveloperUsageResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	toEpoch, err := k.usageRangeEnd(ctx, req.FromEpoch, req.ToEpoch)
+	if err != nil {
+		return nil, err
+	}
+
+	// a developer key can move between projects, its usage in each of them is reported
+	usage := k.getUsageInRange(ctx, req.FromEpoch, toEpoch, func(usage types.ProjectUsage) bool {
+		return usage.DeveloperKey == req.Developer
+	})
+	projects, totalCu := summarizeUsage(usage, func(usage types.ProjectUsage) string { return usage.Project })
+	chains, _ := summarizeUsage(usage, func(usage types.ProjectUsage) string { return usage.ChainId })
+
+	return &types.QueryDeveloperUsageResponse{TotalCu: totalCu, Projects: projects, Chains: chains, Usage: usage}, nil
+}
@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/x/projects/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) ProjectUsage(goCtx context.Context, req *types.QueryProjectUsageRequest) (*types.QueryProjectUsageResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	toEpoch, err := k.usageRangeEnd(ctx, req.FromEpoch, req.ToEpoch)
+	if err != nil {
+		return nil, err
+	}
+
+	usage := k.getUsageInRange(ctx, req.FromEpoch, toEpoch, func(usage types.ProjectUsage) bool {
+		return usage.Project == req.Project
+	})
+	developerKeys, totalCu := summarizeUsage(usage, func(usage types.ProjectUsage) string { return usage.DeveloperKey })
+	chains, _ := summarizeUsage(usage, func(usage types.ProjectUsage) string { return usage.ChainId })
+
+	return &types.QueryProjectUsageResponse{TotalCu: totalCu, DeveloperKeys: developerKeys, Chains: chains, Usage: usage}, nil
+}
+
+// usageRangeEnd returns the last epoch of a usage query, the current epoch when it isn't set
+func (k Keeper) usageRangeEnd(ctx sdk.Context, fromEpoch uint64, toEpoch uint64) (uint64, error) {
+	if toEpoch == 0 {
+		toEpoch = k.epochStorageKeeper.GetEpochStart(ctx)
+	}
+	if fromEpoch > toEpoch {
+		return 0, status.Errorf(codes.InvalidArgument, "invalid epoch range, from epoch %d is after to epoch %d", fromEpoch, toEpoch)
+	}
+	return toEpoch, nil
+}
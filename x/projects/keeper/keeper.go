@@ -17,9 +17,11 @@ type (
 		cdc        codec.BinaryCodec
 		storeKey   sdk.StoreKey
 		memKey     sdk.StoreKey
+		tstoreKey  sdk.StoreKey
 		paramstore paramtypes.Subspace
 
 		epochStorageKeeper types.EpochStorageKeeper
+		hooks              types.ProjectsHooks
 
 		projectsFS      common.FixationStore
 		developerKeysFS common.FixationStore
@@ -30,6 +32,7 @@ func NewKeeper(
 	cdc codec.BinaryCodec,
 	storeKey,
 	memKey sdk.StoreKey,
+	tstoreKey sdk.StoreKey,
 	ps paramtypes.Subspace,
 	epochStorageKeeper types.EpochStorageKeeper,
 ) *Keeper {
@@ -45,6 +48,7 @@ func NewKeeper(
 		cdc:                cdc,
 		storeKey:           storeKey,
 		memKey:             memKey,
+		tstoreKey:          tstoreKey,
 		paramstore:         ps,
 		projectsFS:         *projectsfs,
 		developerKeysFS:    *developerKeysfs,
@@ -52,9 +56,31 @@ func NewKeeper(
 	}
 }
 
+// SetHooks sets the hooks called on project changes, keepers copied before it's called don't call them
+func (k *Keeper) SetHooks(hooks types.ProjectsHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set projects hooks twice")
+	}
+	k.hooks = hooks
+	return k
+}
+
+func (k Keeper) afterProjectDisabled(ctx sdk.Context, project types.Project) {
+	if k.hooks != nil {
+		k.hooks.AfterProjectDisabled(ctx, project.Index, project.Subscription)
+	}
+}
+
 func (k Keeper) BeginBlock(ctx sdk.Context) {
 	k.projectsFS.AdvanceBlock(ctx)
 	k.developerKeysFS.AdvanceBlock(ctx)
+	if k.epochStorageKeeper.IsEpochStart(ctx) {
+		k.RemoveOldProjectUsage(ctx)
+	}
+}
+
+func (k Keeper) EndBlock(ctx sdk.Context) {
+	k.EmitBlockUsageEvents(ctx)
 }
 
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
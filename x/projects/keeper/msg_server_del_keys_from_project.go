@@ -0,0 +1,18 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/x/projects/types"
+)
+
+func (k msgServer) DelKeysFromProject(goCtx context.Context, msg *types.MsgDelKeysFromProject) (*types.MsgDelKeysFromProjectResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := k.Keeper.DelKeysFromProject(ctx, msg.Project, msg.Creator, msg.ProjectKeys)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgDelKeysFromProjectResponse{}, nil
+}
@@ -0,0 +1,18 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/x/projects/types"
+)
+
+func (k msgServer) RotateProjectKey(goCtx context.Context, msg *types.MsgRotateProjectKey) (*types.MsgRotateProjectKeyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := k.Keeper.RotateProjectKey(ctx, msg.Project, msg.Creator, msg.OldKey, msg.NewKey, msg.NewKeyVrfpk)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgRotateProjectKeyResponse{}, nil
+}
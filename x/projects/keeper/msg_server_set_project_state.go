@@ -0,0 +1,18 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/x/projects/types"
+)
+
+func (k msgServer) SetProjectState(goCtx context.Context, msg *types.MsgSetProjectState) (*types.MsgSetProjectStateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := k.Keeper.SetProjectState(ctx, msg.Project, msg.Creator, msg.Enabled)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgSetProjectStateResponse{}, nil
+}
@@ -21,13 +21,28 @@ func (k Keeper) GetProjectForBlock(ctx sdk.Context, projectID string, blockHeigh
 
 func (k Keeper) GetProjectDeveloperData(ctx sdk.Context, developerKey string, blockHeight uint64) (types.ProtoDeveloperData, error) {
 	var projectDeveloperData types.ProtoDeveloperData
-	if found := k.developerKeysFS.FindEntry(ctx, developerKey, blockHeight, &projectDeveloperData); !found {
+	// a deleted developer key is kept as an entry without a project
+	if found := k.developerKeysFS.FindEntry(ctx, developerKey, blockHeight, &projectDeveloperData); !found || projectDeveloperData.ProjectID == "" {
 		return types.ProtoDeveloperData{}, fmt.Errorf("GetProjectIDForDeveloper_invalid_key, the requesting key is not registered to a project, developer: %s", developerKey)
 	}
 	return projectDeveloperData, nil
 }
 
+// GetProjectForDeveloper returns the project of a developer key that can relay at blockHeight, keys of paused projects are rejected
 func (k Keeper) GetProjectForDeveloper(ctx sdk.Context, developerKey string, blockHeight uint64) (proj types.Project, vrfpk string, errRet error) {
+	project, vrfpk, err := k.getProjectForDeveloper(ctx, developerKey, blockHeight)
+	if err != nil {
+		return project, "", err
+	}
+
+	if !project.Enabled {
+		return project, "", fmt.Errorf("GetProjectForDeveloper_project_paused, the developers project is paused, developer: %s, project: %s", developerKey, project.Index)
+	}
+
+	return project, vrfpk, nil
+}
+
+func (k Keeper) getProjectForDeveloper(ctx sdk.Context, developerKey string, blockHeight uint64) (proj types.Project, vrfpk string, errRet error) {
 	var project types.Project
 	projectDeveloperData, err := k.GetProjectDeveloperData(ctx, developerKey, blockHeight)
 	if err != nil {
@@ -38,6 +53,10 @@ func (k Keeper) GetProjectForDeveloper(ctx sdk.Context, developerKey string, blo
 		return project, "", utils.LavaError(ctx, ctx.Logger(), "GetProjectForDeveloper_project_not_found", map[string]string{"developer": developerKey, "project": projectDeveloperData.ProjectID}, "the developers project was not found")
 	}
 
+	if project.GetKey(developerKey).IsExpired(blockHeight) {
+		return project, "", fmt.Errorf("GetProjectForDeveloper_expired_key, the requesting key expired, developer: %s, block: %d", developerKey, blockHeight)
+	}
+
 	return project, projectDeveloperData.Vrfpk, nil
 }
 
@@ -48,7 +67,7 @@ func (k Keeper) AddKeysToProject(ctx sdk.Context, projectID string, adminKey str
 	}
 
 	// check if the admin key is valid
-	if !project.IsAdminKey(adminKey) {
+	if !project.IsAdminKey(adminKey, uint64(ctx.BlockHeight())) {
 		return utils.LavaError(ctx, ctx.Logger(), "AddProjectKeys_not_admin", map[string]string{"project": projectID}, "the requesting key is not admin key")
 	}
 
@@ -62,6 +81,150 @@ func (k Keeper) AddKeysToProject(ctx sdk.Context, projectID string, adminKey str
 	return k.projectsFS.AppendEntry(ctx, projectID, uint64(ctx.BlockHeight()), &project)
 }
 
+// DelKeysFromProject removes the given types from keys of the project, a developer key stays valid until the next epoch.
+// the last admin key of the project can only be removed by the subscription owner
+func (k Keeper) DelKeysFromProject(ctx sdk.Context, projectID string, adminKey string, projectKeys []types.ProjectKey) error {
+	blockHeight := uint64(ctx.BlockHeight())
+	var project types.Project
+	if found := k.projectsFS.FindEntry(ctx, projectID, blockHeight, &project); !found {
+		return utils.LavaError(ctx, ctx.Logger(), "DelProjectKeys_project_not_found", map[string]string{"project": projectID}, "project id not found")
+	}
+
+	if !project.IsAdminKey(adminKey, blockHeight) {
+		return utils.LavaError(ctx, ctx.Logger(), "DelProjectKeys_not_admin", map[string]string{"project": projectID}, "the requesting key is not admin key")
+	}
+
+	nextEpoch, err := k.epochStorageKeeper.GetNextEpoch(ctx, blockHeight)
+	if err != nil {
+		return utils.LavaError(ctx, k.Logger(ctx), "DelProjectKeys_cant_get_next_epoch", map[string]string{"block": strconv.FormatUint(blockHeight, 10)}, "can't get next epoch")
+	}
+
+	hadAdminKeys := project.HasAdminKeys(blockHeight)
+	for _, projectKey := range projectKeys {
+		details := map[string]string{"project": projectID, "projectKeyAddress": projectKey.GetKey(), "projectKeyTypes": string(projectKey.GetTypes())}
+		key := project.GetKey(projectKey.GetKey())
+		for _, keyType := range projectKey.GetTypes() {
+			if !key.IsKeyType(keyType) {
+				return utils.LavaError(ctx, ctx.Logger(), "DelProjectKeys_key_type_not_found", details, "the project key doesn't have the key type")
+			}
+		}
+
+		if projectKey.IsKeyType(types.ProjectKey_DEVELOPER) {
+			// the developer key entry is replaced by an entry without a project from the next epoch
+			err = k.developerKeysFS.AppendEntry(ctx, projectKey.GetKey(), nextEpoch, &types.ProtoDeveloperData{})
+			if err != nil {
+				details["err"] = err.Error()
+				return utils.LavaError(ctx, ctx.Logger(), "DelProjectKeys_del_dev_key_failed", details, "failed to delete developer key")
+			}
+		}
+		project.DeleteKeyTypes(projectKey.GetKey(), projectKey.GetTypes())
+	}
+
+	if hadAdminKeys && !project.HasAdminKeys(blockHeight) && adminKey != project.GetSubscription() {
+		return utils.LavaError(ctx, ctx.Logger(), "DelProjectKeys_last_admin_key", map[string]string{"project": projectID}, "only the subscription owner can delete the last admin key of the project")
+	}
+
+	err = k.projectsFS.AppendEntry(ctx, projectID, blockHeight, &project)
+	if err != nil {
+		return err
+	}
+	for _, projectKey := range projectKeys {
+		// a developer key keeps serving the project until the next epoch
+		details := map[string]string{"project": projectID, "key": projectKey.GetKey(), "keyTypes": projectKey.TypesString(), "block": strconv.FormatUint(blockHeight, 10)}
+		if projectKey.IsKeyType(types.ProjectKey_DEVELOPER) {
+			details["developerKeyRemovalBlock"] = strconv.FormatUint(nextEpoch, 10)
+		}
+		utils.LogLavaEvent(ctx, k.Logger(ctx), types.ProjectKeyRemovedEventName, details, "project key removed")
+	}
+	return nil
+}
+
+// SetProjectState pauses or resumes the project from the next epoch
+func (k Keeper) SetProjectState(ctx sdk.Context, projectID string, adminKey string, enabled bool) error {
+	blockHeight := uint64(ctx.BlockHeight())
+	var project types.Project
+	if found := k.projectsFS.FindEntry(ctx, projectID, blockHeight, &project); !found {
+		return utils.LavaError(ctx, ctx.Logger(), "SetProjectState_project_not_found", map[string]string{"project": projectID}, "project id not found")
+	}
+
+	if !project.IsAdminKey(adminKey, blockHeight) {
+		return utils.LavaError(ctx, ctx.Logger(), "SetProjectState_not_admin", map[string]string{"project": projectID}, "the requesting key is not admin key")
+	}
+
+	nextEpoch, err := k.epochStorageKeeper.GetNextEpoch(ctx, blockHeight)
+	if err != nil {
+		return utils.LavaError(ctx, k.Logger(ctx), "SetProjectState_cant_get_next_epoch", map[string]string{"block": strconv.FormatUint(blockHeight, 10)}, "can't get next epoch")
+	}
+
+	// the state is applied on top of the changes already pending for the next epoch
+	var nextEpochProject types.Project
+	k.projectsFS.FindEntry(ctx, projectID, nextEpoch, &nextEpochProject)
+	details := map[string]string{"project": projectID, "enabled": strconv.FormatBool(enabled), "stateBlock": strconv.FormatUint(nextEpoch, 10)}
+	if nextEpochProject.Enabled == enabled {
+		return utils.LavaError(ctx, ctx.Logger(), "SetProjectState_unchanged", details, "the project is already in the requested state")
+	}
+	nextEpochProject.Enabled = enabled
+	// a pending entry misses the cu charged since it was created, carry the current usage over
+	nextEpochProject.UsedCu = project.UsedCu
+
+	err = k.projectsFS.AppendEntry(ctx, projectID, nextEpoch, &nextEpochProject)
+	if err != nil {
+		details["err"] = err.Error()
+		return utils.LavaError(ctx, ctx.Logger(), "SetProjectState_append_failed", details, "failed to set the project state")
+	}
+
+	if !enabled {
+		k.afterProjectDisabled(ctx, project)
+	}
+
+	utils.LogLavaEvent(ctx, k.Logger(ctx), types.ProjectStateChangedEventName, details, "project state changed")
+	return nil
+}
+
+// RotateProjectKey expires the old key of the project at the next epoch and registers the new key with the old key's types from that epoch,
+// so the project keeps a valid key of every type throughout the rotation
+func (k Keeper) RotateProjectKey(ctx sdk.Context, projectID string, adminKey string, oldKey string, newKey string, newKeyVrfpk string) error {
+	blockHeight := uint64(ctx.BlockHeight())
+	var project types.Project
+	if found := k.projectsFS.FindEntry(ctx, projectID, blockHeight, &project); !found {
+		return utils.LavaError(ctx, ctx.Logger(), "RotateProjectKey_project_not_found", map[string]string{"project": projectID}, "project id not found")
+	}
+
+	if !project.IsAdminKey(adminKey, blockHeight) {
+		return utils.LavaError(ctx, ctx.Logger(), "RotateProjectKey_not_admin", map[string]string{"project": projectID}, "the requesting key is not admin key")
+	}
+
+	details := map[string]string{"project": projectID, "oldKey": oldKey, "newKey": newKey}
+	oldProjectKey := project.GetKey(oldKey)
+	if oldProjectKey.GetKey() == "" || oldProjectKey.IsExpired(blockHeight) {
+		return utils.LavaError(ctx, ctx.Logger(), "RotateProjectKey_old_key_not_found", details, "the rotated key is not a valid key of the project")
+	}
+	if project.GetKey(newKey).Key != "" {
+		return utils.LavaError(ctx, ctx.Logger(), "RotateProjectKey_new_key_exists", details, "the new key is already a key of the project")
+	}
+
+	nextEpoch, err := k.epochStorageKeeper.GetNextEpoch(ctx, blockHeight)
+	if err != nil {
+		return utils.LavaError(ctx, k.Logger(ctx), "RotateProjectKey_cant_get_next_epoch", map[string]string{"block": strconv.FormatUint(blockHeight, 10)}, "can't get next epoch")
+	}
+
+	// the new key takes over from the next epoch, the old key stays valid until then unless it expires earlier
+	if !oldProjectKey.IsExpired(nextEpoch) {
+		project.SetKeyExpiration(oldKey, nextEpoch)
+	}
+	err = k.RegisterKey(ctx, types.ProjectKey{Key: newKey, Types: oldProjectKey.GetTypes(), Vrfpk: newKeyVrfpk}, &project, nextEpoch)
+	if err != nil {
+		details["err"] = err.Error()
+		return utils.LavaError(ctx, ctx.Logger(), "RotateProjectKey_register_key_failed", details, "failed to register the new key")
+	}
+	// a developer key is registered from the next epoch, an admin key is marked so it can't act before then either
+	project.SetKeyActivation(newKey, nextEpoch)
+
+	details["rotationBlock"] = strconv.FormatUint(nextEpoch, 10)
+	utils.LogLavaEvent(ctx, k.Logger(ctx), types.ProjectKeyRotatedEventName, details, "project key rotated")
+	return k.projectsFS.AppendEntry(ctx, projectID, blockHeight, &project)
+}
+
 func (k Keeper) ChargeComputeUnitsToProject(ctx sdk.Context, project types.Project, cu uint64) (err error) {
 	project.UsedCu += cu
 	return k.projectsFS.ModifyEntry(ctx, project.Index, uint64(ctx.BlockHeight()), &project)
@@ -76,7 +239,7 @@ func (k Keeper) SetPolicy(ctx sdk.Context, projectIDs []string, policy *types.Po
 		// for admin policy - check if the key is an address of a project admin.
 		// Note, the subscription key is also considered an admin key
 		if setPolicyEnum == types.SET_ADMIN_POLICY {
-			if !project.IsAdminKey(key) {
+			if !project.IsAdminKey(key, uint64(ctx.BlockHeight())) {
 				return utils.LavaError(ctx, ctx.Logger(), "SetPolicy_not_admin", map[string]string{"project": projectID, "key": key}, "cannot set admin policy because the requesting key is not admin key")
 			} else {
 				project.AdminPolicy = policy
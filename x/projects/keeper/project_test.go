@@ -344,3 +344,235 @@ func SetPolicyTest(t *testing.T, testAdminPolicy bool) {
 		})
 	}
 }
+
+func TestRotateProjectKey(t *testing.T) {
+	servers, keepers, ctx := testkeeper.InitAllKeepers(t)
+
+	subAccount := common.CreateNewAccount(ctx, *keepers, 10000)
+	adminAcc := common.CreateNewAccount(ctx, *keepers, 10000)
+	developerAcc := common.CreateNewAccount(ctx, *keepers, 10000)
+	plan := common.CreateMockPlan()
+
+	projectData := types.ProjectData{
+		Name:        "mockname",
+		Description: "",
+		Enabled:     true,
+		ProjectKeys: []types.ProjectKey{
+			{
+				Key:   adminAcc.Addr.String(),
+				Types: []types.ProjectKey_KEY_TYPE{types.ProjectKey_ADMIN},
+				Vrfpk: "",
+			},
+			{
+				Key:   developerAcc.Addr.String(),
+				Types: []types.ProjectKey_KEY_TYPE{types.ProjectKey_DEVELOPER},
+				Vrfpk: "",
+			}},
+		Policy: nil,
+	}
+	err := keepers.Projects.CreateProject(sdk.UnwrapSDKContext(ctx), subAccount.Addr.String(), projectData, plan)
+	require.Nil(t, err)
+
+	ctx = testkeeper.AdvanceEpoch(ctx, keepers)
+
+	projectID := types.ProjectIndex(subAccount.Addr.String(), projectData.Name)
+	newDeveloperAcc := common.CreateNewAccount(ctx, *keepers, 10000)
+	newAdminAcc := common.CreateNewAccount(ctx, *keepers, 10000)
+
+	// a developer can't rotate keys
+	_, err = servers.ProjectServer.RotateProjectKey(ctx, types.NewMsgRotateProjectKey(developerAcc.Addr.String(), projectID, developerAcc.Addr.String(), newDeveloperAcc.Addr.String(), ""))
+	require.NotNil(t, err)
+
+	// a key that isn't in the project can't be rotated
+	_, err = servers.ProjectServer.RotateProjectKey(ctx, types.NewMsgRotateProjectKey(adminAcc.Addr.String(), projectID, newAdminAcc.Addr.String(), newDeveloperAcc.Addr.String(), ""))
+	require.NotNil(t, err)
+
+	_, err = servers.ProjectServer.RotateProjectKey(ctx, types.NewMsgRotateProjectKey(adminAcc.Addr.String(), projectID, developerAcc.Addr.String(), newDeveloperAcc.Addr.String(), ""))
+	require.Nil(t, err)
+	_, err = servers.ProjectServer.RotateProjectKey(ctx, types.NewMsgRotateProjectKey(adminAcc.Addr.String(), projectID, adminAcc.Addr.String(), newAdminAcc.Addr.String(), ""))
+	require.Nil(t, err)
+
+	// the old keys stay valid until the next epoch
+	_, err = keepers.Projects.Developer(ctx, &types.QueryDeveloperRequest{Developer: developerAcc.Addr.String()})
+	require.Nil(t, err)
+	_, err = keepers.Projects.Developer(ctx, &types.QueryDeveloperRequest{Developer: newDeveloperAcc.Addr.String()})
+	require.NotNil(t, err)
+
+	// and the new admin key can't act before then
+	keyToAdd := types.ProjectKey{Key: common.CreateNewAccount(ctx, *keepers, 10000).Addr.String(), Types: []types.ProjectKey_KEY_TYPE{types.ProjectKey_DEVELOPER}}
+	_, err = servers.ProjectServer.AddProjectKeys(ctx, &types.MsgAddProjectKeys{Creator: newAdminAcc.Addr.String(), Project: projectID, ProjectKeys: []types.ProjectKey{keyToAdd}})
+	require.NotNil(t, err)
+
+	ctx = testkeeper.AdvanceEpoch(ctx, keepers)
+
+	// the new keys take over from the next epoch
+	_, err = keepers.Projects.Developer(ctx, &types.QueryDeveloperRequest{Developer: developerAcc.Addr.String()})
+	require.NotNil(t, err)
+	res, err := keepers.Projects.Developer(ctx, &types.QueryDeveloperRequest{Developer: newDeveloperAcc.Addr.String()})
+	require.Nil(t, err)
+	require.Equal(t, projectID, res.Project.Index)
+
+	anotherDeveloperAcc := common.CreateNewAccount(ctx, *keepers, 10000)
+	pk := types.ProjectKey{Key: anotherDeveloperAcc.Addr.String(), Types: []types.ProjectKey_KEY_TYPE{types.ProjectKey_DEVELOPER}}
+	_, err = servers.ProjectServer.AddProjectKeys(ctx, &types.MsgAddProjectKeys{Creator: adminAcc.Addr.String(), Project: projectID, ProjectKeys: []types.ProjectKey{pk}})
+	require.NotNil(t, err)
+	_, err = servers.ProjectServer.AddProjectKeys(ctx, &types.MsgAddProjectKeys{Creator: newAdminAcc.Addr.String(), Project: projectID, ProjectKeys: []types.ProjectKey{pk}})
+	require.Nil(t, err)
+
+	// an expired key can't be rotated again
+	_, err = servers.ProjectServer.RotateProjectKey(ctx, types.NewMsgRotateProjectKey(newAdminAcc.Addr.String(), projectID, developerAcc.Addr.String(), common.CreateNewAccount(ctx, *keepers, 10000).Addr.String(), ""))
+	require.NotNil(t, err)
+}
+
+func TestDelKeys(t *testing.T) {
+	servers, keepers, ctx := testkeeper.InitAllKeepers(t)
+
+	subAccount := common.CreateNewAccount(ctx, *keepers, 10000)
+	adminAcc := common.CreateNewAccount(ctx, *keepers, 10000)
+	developerAcc := common.CreateNewAccount(ctx, *keepers, 10000)
+	plan := common.CreateMockPlan()
+
+	projectData := types.ProjectData{
+		Name:        "mockname",
+		Description: "",
+		Enabled:     true,
+		ProjectKeys: []types.ProjectKey{
+			{
+				Key:   adminAcc.Addr.String(),
+				Types: []types.ProjectKey_KEY_TYPE{types.ProjectKey_ADMIN},
+				Vrfpk: "",
+			},
+			{
+				Key:   developerAcc.Addr.String(),
+				Types: []types.ProjectKey_KEY_TYPE{types.ProjectKey_DEVELOPER},
+				Vrfpk: "",
+			}},
+		Policy: nil,
+	}
+	err := keepers.Projects.CreateProject(sdk.UnwrapSDKContext(ctx), subAccount.Addr.String(), projectData, plan)
+	require.Nil(t, err)
+
+	ctx = testkeeper.AdvanceEpoch(ctx, keepers)
+
+	projectID := types.ProjectIndex(subAccount.Addr.String(), projectData.Name)
+	developerKey := types.ProjectKey{Key: developerAcc.Addr.String(), Types: []types.ProjectKey_KEY_TYPE{types.ProjectKey_DEVELOPER}}
+	adminKey := types.ProjectKey{Key: adminAcc.Addr.String(), Types: []types.ProjectKey_KEY_TYPE{types.ProjectKey_ADMIN}}
+
+	// a developer can't delete keys
+	_, err = servers.ProjectServer.DelKeysFromProject(ctx, types.NewMsgDelKeysFromProject(developerAcc.Addr.String(), projectID, []types.ProjectKey{developerKey}))
+	require.NotNil(t, err)
+
+	// the key doesn't have the admin type
+	_, err = servers.ProjectServer.DelKeysFromProject(ctx, types.NewMsgDelKeysFromProject(adminAcc.Addr.String(), projectID, []types.ProjectKey{{Key: developerAcc.Addr.String(), Types: adminKey.Types}}))
+	require.NotNil(t, err)
+
+	_, err = servers.ProjectServer.DelKeysFromProject(ctx, types.NewMsgDelKeysFromProject(adminAcc.Addr.String(), projectID, []types.ProjectKey{developerKey}))
+	require.Nil(t, err)
+
+	// the developer key stays valid until the next epoch
+	_, err = keepers.Projects.Developer(ctx, &types.QueryDeveloperRequest{Developer: developerAcc.Addr.String()})
+	require.Nil(t, err)
+
+	ctx = testkeeper.AdvanceEpoch(ctx, keepers)
+
+	_, err = keepers.Projects.Developer(ctx, &types.QueryDeveloperRequest{Developer: developerAcc.Addr.String()})
+	require.NotNil(t, err)
+
+	// the last admin key can't delete itself, only the subscription owner can delete it
+	_, err = servers.ProjectServer.DelKeysFromProject(ctx, types.NewMsgDelKeysFromProject(adminAcc.Addr.String(), projectID, []types.ProjectKey{adminKey}))
+	require.NotNil(t, err)
+	_, err = servers.ProjectServer.DelKeysFromProject(ctx, types.NewMsgDelKeysFromProject(subAccount.Addr.String(), projectID, []types.ProjectKey{adminKey}))
+	require.Nil(t, err)
+
+	_, err = servers.ProjectServer.AddProjectKeys(ctx, &types.MsgAddProjectKeys{Creator: adminAcc.Addr.String(), Project: projectID, ProjectKeys: []types.ProjectKey{developerKey}})
+	require.NotNil(t, err)
+
+	// a deleted developer key can be registered again
+	_, err = servers.ProjectServer.AddProjectKeys(ctx, &types.MsgAddProjectKeys{Creator: subAccount.Addr.String(), Project: projectID, ProjectKeys: []types.ProjectKey{developerKey}})
+	require.Nil(t, err)
+	res, err := keepers.Projects.Developer(ctx, &types.QueryDeveloperRequest{Developer: developerAcc.Addr.String()})
+	require.Nil(t, err)
+	require.Equal(t, projectID, res.Project.Index)
+}
+
+func TestSetProjectState(t *testing.T) {
+	servers, keepers, ctx := testkeeper.InitAllKeepers(t)
+
+	subAccount := common.CreateNewAccount(ctx, *keepers, 10000)
+	adminAcc := common.CreateNewAccount(ctx, *keepers, 10000)
+	developerAcc := common.CreateNewAccount(ctx, *keepers, 10000)
+	plan := common.CreateMockPlan()
+
+	projectData := types.ProjectData{
+		Name:        "mockname",
+		Description: "",
+		Enabled:     true,
+		ProjectKeys: []types.ProjectKey{
+			{
+				Key:   adminAcc.Addr.String(),
+				Types: []types.ProjectKey_KEY_TYPE{types.ProjectKey_ADMIN},
+				Vrfpk: "",
+			},
+			{
+				Key:   developerAcc.Addr.String(),
+				Types: []types.ProjectKey_KEY_TYPE{types.ProjectKey_DEVELOPER},
+				Vrfpk: "",
+			}},
+		Policy: nil,
+	}
+	err := keepers.Projects.CreateProject(sdk.UnwrapSDKContext(ctx), subAccount.Addr.String(), projectData, plan)
+	require.Nil(t, err)
+
+	ctx = testkeeper.AdvanceEpoch(ctx, keepers)
+
+	projectID := types.ProjectIndex(subAccount.Addr.String(), projectData.Name)
+	developer := developerAcc.Addr.String()
+	isPaused := func() bool {
+		_, _, err := keepers.Projects.GetProjectForDeveloper(sdk.UnwrapSDKContext(ctx), developer, uint64(sdk.UnwrapSDKContext(ctx).BlockHeight()))
+		return err != nil
+	}
+
+	// a developer can't pause the project
+	_, err = servers.ProjectServer.SetProjectState(ctx, types.NewMsgSetProjectState(developer, projectID, false))
+	require.NotNil(t, err)
+
+	// the project is already enabled
+	_, err = servers.ProjectServer.SetProjectState(ctx, types.NewMsgSetProjectState(adminAcc.Addr.String(), projectID, true))
+	require.NotNil(t, err)
+
+	_, err = servers.ProjectServer.SetProjectState(ctx, types.NewMsgSetProjectState(adminAcc.Addr.String(), projectID, false))
+	require.Nil(t, err)
+
+	// the project is paused from the next epoch
+	require.False(t, isPaused())
+	ctx = testkeeper.AdvanceEpoch(ctx, keepers)
+	require.True(t, isPaused())
+
+	// the developer query still shows the paused project
+	res, err := keepers.Projects.Developer(ctx, &types.QueryDeveloperRequest{Developer: developer})
+	require.Nil(t, err)
+	require.False(t, res.Project.Enabled)
+
+	// the subscription owner resumes the project
+	_, err = servers.ProjectServer.SetProjectState(ctx, types.NewMsgSetProjectState(subAccount.Addr.String(), projectID, true))
+	require.Nil(t, err)
+	require.True(t, isPaused())
+	ctx = testkeeper.AdvanceEpoch(ctx, keepers)
+	require.False(t, isPaused())
+
+	// the usage charged while another change is pending survives a state toggle
+	policy := types.Policy{GeolocationProfile: 1, TotalCuLimit: 1000, EpochCuLimit: 100, MaxProvidersToPair: 3}
+	err = keepers.Projects.SetPolicy(sdk.UnwrapSDKContext(ctx), []string{projectID}, &policy, adminAcc.Addr.String(), types.SET_ADMIN_POLICY)
+	require.Nil(t, err)
+	project, err := keepers.Projects.GetProjectForBlock(sdk.UnwrapSDKContext(ctx), projectID, uint64(sdk.UnwrapSDKContext(ctx).BlockHeight()))
+	require.Nil(t, err)
+	err = keepers.Projects.ChargeComputeUnitsToProject(sdk.UnwrapSDKContext(ctx), project, 10)
+	require.Nil(t, err)
+	_, err = servers.ProjectServer.SetProjectState(ctx, types.NewMsgSetProjectState(adminAcc.Addr.String(), projectID, false))
+	require.Nil(t, err)
+	ctx = testkeeper.AdvanceEpoch(ctx, keepers)
+	require.True(t, isPaused())
+	project, err = keepers.Projects.GetProjectForBlock(sdk.UnwrapSDKContext(ctx), projectID, uint64(sdk.UnwrapSDKContext(ctx).BlockHeight()))
+	require.Nil(t, err)
+	require.Equal(t, uint64(10), project.UsedCu)
+}
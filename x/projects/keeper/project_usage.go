@@ -0,0 +1,115 @@
+package keeper
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/utils"
+	"github.com/lavanet/lava/x/projects/types"
+)
+
+// AddProjectUsage adds cu to the usage snapshot of a developer key of a project on a chain in an epoch, and to its usage in the block
+func (k Keeper) AddProjectUsage(ctx sdk.Context, projectID string, developerKey string, chainID string, epoch uint64, cu uint64) {
+	k.addUsage(prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ProjectUsageKeyPrefix)),
+		types.ProjectUsageKey(epoch, projectID, developerKey, chainID), projectID, developerKey, chainID, epoch, cu)
+	k.addUsage(prefix.NewStore(ctx.TransientStore(k.tstoreKey), types.KeyPrefix(types.ProjectBlockUsageKeyPrefix)),
+		types.ProjectBlockUsageKey(projectID, developerKey, chainID), projectID, developerKey, chainID, epoch, cu)
+
+	// the chain policies limit the project's usage on a chain, it's summed apart so it can be read without the developer keys
+	chainStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ProjectChainUsageKeyPrefix))
+	chainKey := types.ProjectChainUsageKey(epoch, projectID, chainID)
+	chainStore.Set(chainKey, sdk.Uint64ToBigEndian(sdk.BigEndianToUint64(chainStore.Get(chainKey))+cu))
+}
+
+func (k Keeper) addUsage(store prefix.Store, key []byte, projectID string, developerKey string, chainID string, epoch uint64, cu uint64) {
+	usage := types.ProjectUsage{Epoch: epoch, Project: projectID, DeveloperKey: developerKey, ChainId: chainID}
+	if b := store.Get(key); b != nil {
+		k.cdc.MustUnmarshal(b, &usage)
+	}
+	usage.UsedCu += cu
+	store.Set(key, k.cdc.MustMarshal(&usage))
+}
+
+// EmitBlockUsageEvents emits an event with the cu each developer key of a project used on a chain in the block,
+// so indexers can follow the usage without diffing the usage snapshots. the block's usage is transient and is dropped on commit
+func (k Keeper) EmitBlockUsageEvents(ctx sdk.Context) {
+	store := prefix.NewStore(ctx.TransientStore(k.tstoreKey), types.KeyPrefix(types.ProjectBlockUsageKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var usage types.ProjectUsage
+		k.cdc.MustUnmarshal(iterator.Value(), &usage)
+		details := map[string]string{
+			"project":      usage.Project,
+			"developerKey": usage.DeveloperKey,
+			"chainID":      usage.ChainId,
+			"cu":           strconv.FormatUint(usage.UsedCu, 10),
+			"epoch":        strconv.FormatUint(usage.Epoch, 10),
+			"block":        strconv.FormatInt(ctx.BlockHeight(), 10),
+		}
+		utils.LogLavaEvent(ctx, k.Logger(ctx), types.ProjectUsageEventName, details, "project developer key usage in block")
+	}
+}
+
+// getUsageInRange returns the usage snapshots of the epochs from fromEpoch to toEpoch (inclusive) that pass the filter, ordered by epoch
+func (k Keeper) getUsageInRange(ctx sdk.Context, fromEpoch uint64, toEpoch uint64, filter func(usage types.ProjectUsage) bool) []types.ProjectUsage {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ProjectUsageKeyPrefix))
+	iterator := store.Iterator(types.ProjectUsageEpochKey(fromEpoch), sdk.PrefixEndBytes(types.ProjectUsageEpochKey(toEpoch)))
+	defer iterator.Close()
+
+	usageList := []types.ProjectUsage{}
+	for ; iterator.Valid(); iterator.Next() {
+		var usage types.ProjectUsage
+		k.cdc.MustUnmarshal(iterator.Value(), &usage)
+		if filter(usage) {
+			usageList = append(usageList, usage)
+		}
+	}
+	return usageList
+}
+
+// GetProjectChainUsage returns the cu all the developer keys of a project used on a chain in an epoch
+func (k Keeper) GetProjectChainUsage(ctx sdk.Context, projectID string, chainID string, epoch uint64) (usedCu uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ProjectChainUsageKeyPrefix))
+	return sdk.BigEndianToUint64(store.Get(types.ProjectChainUsageKey(epoch, projectID, chainID)))
+}
+
+// summarizeUsage sums the usage by the id returned for each snapshot, in the order the ids first appear
+func summarizeUsage(usageList []types.ProjectUsage, id func(usage types.ProjectUsage) string) (summaries []types.UsageSummary, totalCu uint64) {
+	summaries = []types.UsageSummary{}
+	indexes := map[string]int{}
+	for _, usage := range usageList {
+		totalCu += usage.UsedCu
+		index, found := indexes[id(usage)]
+		if !found {
+			index = len(summaries)
+			indexes[id(usage)] = index
+			summaries = append(summaries, types.UsageSummary{Id: id(usage)})
+		}
+		summaries[index].UsedCu += usage.UsedCu
+	}
+	return summaries, totalCu
+}
+
+// RemoveOldProjectUsage deletes the usage snapshots and chain usage of the epochs deleted from the epoch storage
+func (k Keeper) RemoveOldProjectUsage(ctx sdk.Context) {
+	usageStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ProjectUsageKeyPrefix))
+	chainUsageStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ProjectChainUsageKeyPrefix))
+	for _, epoch := range k.epochStorageKeeper.GetDeletedEpochs(ctx) {
+		deleteEpochKeys(prefix.NewStore(usageStore, types.ProjectUsageEpochKey(epoch)))
+		deleteEpochKeys(prefix.NewStore(chainUsageStore, types.ProjectUsageEpochKey(epoch)))
+	}
+}
+
+func deleteEpochKeys(epochStore prefix.Store) {
+	iterator := sdk.KVStorePrefixIterator(epochStore, []byte{})
+	keys := [][]byte{}
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range keys {
+		epochStore.Delete(key)
+	}
+}
@@ -0,0 +1,125 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	testkeeper "github.com/lavanet/lava/testutil/keeper"
+	"github.com/lavanet/lava/utils"
+	"github.com/lavanet/lava/x/projects/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProjectUsageQueries(t *testing.T) {
+	_, keepers, ctx := testkeeper.InitAllKeepers(t)
+	ctx = testkeeper.AdvanceEpoch(ctx, keepers)
+	_ctx := sdk.UnwrapSDKContext(ctx)
+
+	epoch := keepers.Epochstorage.GetEpochStart(_ctx)
+	nextEpoch, err := keepers.Epochstorage.GetNextEpoch(_ctx, epoch)
+	require.Nil(t, err)
+
+	keepers.Projects.AddProjectUsage(_ctx, "sub-proj1", "dev1", "ETH1", epoch, 10)
+	keepers.Projects.AddProjectUsage(_ctx, "sub-proj1", "dev1", "ETH1", epoch, 5)
+	keepers.Projects.AddProjectUsage(_ctx, "sub-proj1", "dev2", "LAV1", epoch, 20)
+	keepers.Projects.AddProjectUsage(_ctx, "sub-proj1", "dev1", "LAV1", nextEpoch, 7)
+	keepers.Projects.AddProjectUsage(_ctx, "sub-proj2", "dev1", "ETH1", nextEpoch, 3)
+
+	for _, tt := range []struct {
+		name          string
+		fromEpoch     uint64
+		toEpoch       uint64
+		totalCu       uint64
+		developerKeys []types.UsageSummary
+		chains        []types.UsageSummary
+	}{
+		{
+			name:          "first epoch",
+			fromEpoch:     epoch,
+			toEpoch:       epoch,
+			totalCu:       35,
+			developerKeys: []types.UsageSummary{{Id: "dev1", UsedCu: 15}, {Id: "dev2", UsedCu: 20}},
+			chains:        []types.UsageSummary{{Id: "ETH1", UsedCu: 15}, {Id: "LAV1", UsedCu: 20}},
+		},
+		{
+			name:          "both epochs",
+			fromEpoch:     epoch,
+			toEpoch:       nextEpoch,
+			totalCu:       42,
+			developerKeys: []types.UsageSummary{{Id: "dev1", UsedCu: 22}, {Id: "dev2", UsedCu: 20}},
+			chains:        []types.UsageSummary{{Id: "ETH1", UsedCu: 15}, {Id: "LAV1", UsedCu: 27}},
+		},
+		{
+			name:          "second epoch",
+			fromEpoch:     nextEpoch,
+			toEpoch:       nextEpoch,
+			totalCu:       7,
+			developerKeys: []types.UsageSummary{{Id: "dev1", UsedCu: 7}},
+			chains:        []types.UsageSummary{{Id: "LAV1", UsedCu: 7}},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := keepers.Projects.ProjectUsage(ctx, &types.QueryProjectUsageRequest{Project: "sub-proj1", FromEpoch: tt.fromEpoch, ToEpoch: tt.toEpoch})
+			require.Nil(t, err)
+			require.Equal(t, tt.totalCu, res.TotalCu)
+			require.Equal(t, tt.developerKeys, res.DeveloperKeys)
+			require.Equal(t, tt.chains, res.Chains)
+		})
+	}
+
+	// the developer's usage spans its projects
+	res, err := keepers.Projects.DeveloperUsage(ctx, &types.QueryDeveloperUsageRequest{Developer: "dev1", FromEpoch: epoch, ToEpoch: nextEpoch})
+	require.Nil(t, err)
+	require.Equal(t, uint64(25), res.TotalCu)
+	require.Equal(t, []types.UsageSummary{{Id: "sub-proj1", UsedCu: 22}, {Id: "sub-proj2", UsedCu: 3}}, res.Projects)
+	require.Len(t, res.Usage, 3)
+
+	// the range ends at the current epoch by default
+	res, err = keepers.Projects.DeveloperUsage(ctx, &types.QueryDeveloperUsageRequest{Developer: "dev1", FromEpoch: epoch})
+	require.Nil(t, err)
+	require.Equal(t, uint64(15), res.TotalCu)
+
+	_, err = keepers.Projects.ProjectUsage(ctx, &types.QueryProjectUsageRequest{Project: "sub-proj1", FromEpoch: nextEpoch, ToEpoch: epoch})
+	require.NotNil(t, err)
+
+	// the chain usage sums the project's developer keys on the chain in the epoch
+	require.Equal(t, uint64(15), keepers.Projects.GetProjectChainUsage(_ctx, "sub-proj1", "ETH1", epoch))
+	require.Equal(t, uint64(20), keepers.Projects.GetProjectChainUsage(_ctx, "sub-proj1", "LAV1", epoch))
+	require.Equal(t, uint64(7), keepers.Projects.GetProjectChainUsage(_ctx, "sub-proj1", "LAV1", nextEpoch))
+	require.Zero(t, keepers.Projects.GetProjectChainUsage(_ctx, "sub-proj2", "LAV1", nextEpoch))
+}
+
+func TestBlockUsageEvents(t *testing.T) {
+	_, keepers, ctx := testkeeper.InitAllKeepers(t)
+	ctx = testkeeper.AdvanceEpoch(ctx, keepers)
+	_ctx := sdk.UnwrapSDKContext(ctx).WithEventManager(sdk.NewEventManager())
+
+	epoch := keepers.Epochstorage.GetEpochStart(_ctx)
+	keepers.Projects.AddProjectUsage(_ctx, "sub-proj1", "dev1", "ETH1", epoch, 10)
+	keepers.Projects.AddProjectUsage(_ctx, "sub-proj1", "dev1", "ETH1", epoch, 5)
+	keepers.Projects.AddProjectUsage(_ctx, "sub-proj1", "dev1", "LAV1", epoch, 20)
+
+	usageEvents := func() map[string]string {
+		usedCu := map[string]string{}
+		for _, event := range _ctx.EventManager().Events() {
+			if event.Type != utils.EventPrefix+types.ProjectUsageEventName {
+				continue
+			}
+			attributes := map[string]string{}
+			for _, attribute := range event.Attributes {
+				attributes[string(attribute.Key)] = string(attribute.Value)
+			}
+			usedCu[attributes["chainID"]] = attributes["cu"]
+		}
+		return usedCu
+	}
+
+	// the usage of the block is aggregated per developer key and chain
+	keepers.Projects.EmitBlockUsageEvents(_ctx)
+	require.Equal(t, map[string]string{"ETH1": "15", "LAV1": "20"}, usageEvents())
+
+	// the block's usage is kept aside of the epoch's snapshot
+	res, err := keepers.Projects.DeveloperUsage(sdk.WrapSDKContext(_ctx), &types.QueryDeveloperUsageRequest{Developer: "dev1", FromEpoch: epoch, ToEpoch: epoch})
+	require.Nil(t, err)
+	require.Equal(t, uint64(35), res.TotalCu)
+}
@@ -36,6 +36,18 @@ const (
 	// TODO: Determine the simulation weight value
 	defaultWeightMsgSetSubscriptionPolicy int = 100
 
+	opWeightMsgRotateProjectKey = "op_weight_msg_rotate_project_key"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgRotateProjectKey int = 100
+
+	opWeightMsgDelKeysFromProject = "op_weight_msg_del_keys_from_project"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgDelKeysFromProject int = 100
+
+	opWeightMsgSetProjectState = "op_weight_msg_set_project_state"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgSetProjectState int = 100
+
 	// this line is used by starport scaffolding # simapp/module/const
 )
 
@@ -102,6 +114,39 @@ func (am AppModule) WeightedOperations(simState module.SimulationState) []simtyp
 		projectssimulation.SimulateMsgSetSubscriptionPolicy(am.keeper),
 	))
 
+	var weightMsgRotateProjectKey int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgRotateProjectKey, &weightMsgRotateProjectKey, nil,
+		func(_ *rand.Rand) {
+			weightMsgRotateProjectKey = defaultWeightMsgRotateProjectKey
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgRotateProjectKey,
+		projectssimulation.SimulateMsgRotateProjectKey(am.keeper),
+	))
+
+	var weightMsgDelKeysFromProject int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgDelKeysFromProject, &weightMsgDelKeysFromProject, nil,
+		func(_ *rand.Rand) {
+			weightMsgDelKeysFromProject = defaultWeightMsgDelKeysFromProject
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgDelKeysFromProject,
+		projectssimulation.SimulateMsgDelKeysFromProject(am.keeper),
+	))
+
+	var weightMsgSetProjectState int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgSetProjectState, &weightMsgSetProjectState, nil,
+		func(_ *rand.Rand) {
+			weightMsgSetProjectState = defaultWeightMsgSetProjectState
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgSetProjectState,
+		projectssimulation.SimulateMsgSetProjectState(am.keeper),
+	))
+
 	// this line is used by starport scaffolding # simapp/module/operation
 
 	return operations
@@ -12,6 +12,9 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgAddProjectKeys{}, "projects/AddProjectKeys", nil)
 	cdc.RegisterConcrete(&MsgSetAdminPolicy{}, "projects/SetAdminPolicy", nil)
 	cdc.RegisterConcrete(&MsgSetSubscriptionPolicy{}, "projects/SetSubscriptionPolicy", nil)
+	cdc.RegisterConcrete(&MsgRotateProjectKey{}, "projects/RotateProjectKey", nil)
+	cdc.RegisterConcrete(&MsgDelKeysFromProject{}, "projects/DelKeysFromProject", nil)
+	cdc.RegisterConcrete(&MsgSetProjectState{}, "projects/SetProjectState", nil)
 	// this line is used by starport scaffolding # 2
 }
 
@@ -25,6 +28,15 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgSetSubscriptionPolicy{},
 	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgRotateProjectKey{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgDelKeysFromProject{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSetProjectState{},
+	)
 	// this line is used by starport scaffolding # 3
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
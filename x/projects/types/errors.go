@@ -13,4 +13,5 @@ var (
 	ErrInvalidPolicy                   = sdkerrors.Register(ModuleName, 1102, "Invalid policy")
 	ErrPolicyBasicValidation           = sdkerrors.Register(ModuleName, 1100, "invalid policy")
 	ErrInvalidKeyType                  = sdkerrors.Register(ModuleName, 1103, "invalid project key type")
+	ErrInvalidKeyRotation              = sdkerrors.Register(ModuleName, 1104, "invalid project key rotation")
 )
@@ -6,4 +6,12 @@ import (
 
 type EpochStorageKeeper interface {
 	GetNextEpoch(ctx sdk.Context, block uint64) (nextEpoch uint64, erro error)
+	IsEpochStart(ctx sdk.Context) (res bool)
+	GetDeletedEpochs(ctx sdk.Context) []uint64
+	GetEpochStart(ctx sdk.Context) uint64
+}
+
+// ProjectsHooks are called by the projects keeper so other modules can follow the projects that stop serving
+type ProjectsHooks interface {
+	AfterProjectDisabled(ctx sdk.Context, projectID string, subscription string) // a project was deleted or disabled
 }
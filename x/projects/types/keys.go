@@ -1,5 +1,9 @@
 package types
 
+import (
+	"encoding/binary"
+)
+
 const (
 	// ModuleName defines the module name
 	ModuleName = "project"
@@ -16,13 +20,49 @@ const (
 	// MemStoreKey defines the in-memory store key
 	MemStoreKey = "mem_project"
 
+	// TStoreKey defines the transient store key, for data kept only until the block is committed
+	TStoreKey = "transient_project"
+
 	// prefix for the projects fixation store
 	ProjectsFixationPrefix = "prj-fs"
 
 	// prefix for the developer keys fixation store
 	DeveloperKeysFixationPrefix = "dev-fs"
+
+	// prefix for the usage snapshots of the projects, by epoch
+	ProjectUsageKeyPrefix = "ProjectUsage/value/"
+
+	// prefix for the usage of the projects in the current block in the transient store, emitted as events at the end of the block
+	ProjectBlockUsageKeyPrefix = "ProjectBlockUsage/value/"
+
+	// prefix for the cu the projects used on each chain, by epoch
+	ProjectChainUsageKeyPrefix = "ProjectChainUsage/value/"
 )
 
 func KeyPrefix(p string) []byte {
 	return []byte(p)
 }
+
+// ProjectUsageEpochKey returns the store key prefix of the projects' usage in an epoch, under ProjectUsageKeyPrefix
+func ProjectUsageEpochKey(epoch uint64) []byte {
+	key := make([]byte, 8, 9)
+	binary.BigEndian.PutUint64(key, epoch)
+	return append(key, []byte("/")...)
+}
+
+// ProjectUsageKey returns the store key of the usage of a developer key of a project on a chain in an epoch, under ProjectUsageKeyPrefix
+func ProjectUsageKey(epoch uint64, projectID string, developerKey string, chainID string) []byte {
+	key := ProjectUsageEpochKey(epoch)
+	return append(key, []byte(projectID+"/"+developerKey+"/"+chainID+"/")...)
+}
+
+// ProjectBlockUsageKey returns the store key of the usage of a developer key of a project on a chain in the current block, under ProjectBlockUsageKeyPrefix
+func ProjectBlockUsageKey(projectID string, developerKey string, chainID string) []byte {
+	return []byte(projectID + "/" + developerKey + "/" + chainID + "/")
+}
+
+// ProjectChainUsageKey returns the store key of the cu a project used on a chain in an epoch, under ProjectChainUsageKeyPrefix
+func ProjectChainUsageKey(epoch uint64, projectID string, chainID string) []byte {
+	key := ProjectUsageEpochKey(epoch)
+	return append(key, []byte(projectID+"/"+chainID+"/")...)
+}
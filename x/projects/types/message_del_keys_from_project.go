@@ -0,0 +1,55 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgDelKeysFromProject = "del_keys_from_project"
+
+var _ sdk.Msg = &MsgDelKeysFromProject{}
+
+func NewMsgDelKeysFromProject(creator string, projectID string, projectKeys []ProjectKey) *MsgDelKeysFromProject {
+	return &MsgDelKeysFromProject{
+		Creator:     creator,
+		Project:     projectID,
+		ProjectKeys: projectKeys,
+	}
+}
+
+func (msg *MsgDelKeysFromProject) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgDelKeysFromProject) Type() string {
+	return TypeMsgDelKeysFromProject
+}
+
+func (msg *MsgDelKeysFromProject) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgDelKeysFromProject) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgDelKeysFromProject) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	for _, projectKey := range msg.GetProjectKeys() {
+		for _, keyType := range projectKey.GetTypes() {
+			if keyType != ProjectKey_ADMIN && keyType != ProjectKey_DEVELOPER {
+				return sdkerrors.Wrapf(ErrInvalidKeyType, "project key must be of type ADMIN(=1) or DEVELOPER(=2). projectKey = %d", keyType)
+			}
+		}
+	}
+	return nil
+}
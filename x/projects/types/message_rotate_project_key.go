@@ -0,0 +1,58 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgRotateProjectKey = "rotate_project_key"
+
+var _ sdk.Msg = &MsgRotateProjectKey{}
+
+func NewMsgRotateProjectKey(creator string, projectID string, oldKey string, newKey string, newKeyVrfpk string) *MsgRotateProjectKey {
+	return &MsgRotateProjectKey{
+		Creator:     creator,
+		Project:     projectID,
+		OldKey:      oldKey,
+		NewKey:      newKey,
+		NewKeyVrfpk: newKeyVrfpk,
+	}
+}
+
+func (msg *MsgRotateProjectKey) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRotateProjectKey) Type() string {
+	return TypeMsgRotateProjectKey
+}
+
+func (msg *MsgRotateProjectKey) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgRotateProjectKey) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgRotateProjectKey) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.NewKey)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid new key address (%s)", err)
+	}
+
+	if msg.OldKey == msg.NewKey {
+		return sdkerrors.Wrapf(ErrInvalidKeyRotation, "the new key must be different from the rotated key (%s)", msg.OldKey)
+	}
+	return nil
+}
@@ -0,0 +1,61 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/lavanet/lava/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgRotateProjectKey_ValidateBasic(t *testing.T) {
+	key := sample.AccAddress()
+	tests := []struct {
+		name string
+		msg  MsgRotateProjectKey
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgRotateProjectKey{
+				Creator: "invalid_address",
+				OldKey:  key,
+				NewKey:  sample.AccAddress(),
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid new key",
+			msg: MsgRotateProjectKey{
+				Creator: sample.AccAddress(),
+				OldKey:  key,
+				NewKey:  "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "same key",
+			msg: MsgRotateProjectKey{
+				Creator: sample.AccAddress(),
+				OldKey:  key,
+				NewKey:  key,
+			},
+			err: ErrInvalidKeyRotation,
+		}, {
+			name: "valid address",
+			msg: MsgRotateProjectKey{
+				Creator: sample.AccAddress(),
+				OldKey:  key,
+				NewKey:  sample.AccAddress(),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgSetProjectState = "set_project_state"
+
+var _ sdk.Msg = &MsgSetProjectState{}
+
+func NewMsgSetProjectState(creator string, projectID string, enabled bool) *MsgSetProjectState {
+	return &MsgSetProjectState{
+		Creator: creator,
+		Project: projectID,
+		Enabled: enabled,
+	}
+}
+
+func (msg *MsgSetProjectState) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetProjectState) Type() string {
+	return TypeMsgSetProjectState
+}
+
+func (msg *MsgSetProjectState) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgSetProjectState) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSetProjectState) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	return nil
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"golang.org/x/exp/slices"
 )
 
 func (policy *Policy) ContainsChainID(chainID string) bool {
@@ -18,11 +19,27 @@ func (policy *Policy) ContainsChainID(chainID string) bool {
 	return false
 }
 
+// GetChainPolicy returns the restrictions of the policy on the chain, if it has any
+func (policy *Policy) GetChainPolicy(chainID string) (ChainPolicy, bool) {
+	for _, chain := range policy.ChainPolicies {
+		if chain.ChainId == chainID {
+			return chain, true
+		}
+	}
+	return ChainPolicy{}, false
+}
+
 func (policy Policy) ValidateBasicPolicy() error {
 	if policy.EpochCuLimit > policy.TotalCuLimit {
 		return sdkerrors.Wrapf(ErrInvalidPolicyCuFields, "invalid policy's CU fields (EpochCuLimit = %v, TotalCuLimit = %v)", policy.EpochCuLimit, policy.TotalCuLimit)
 	}
 
+	for _, chain := range policy.ChainPolicies {
+		if chain.EpochCuLimit > policy.EpochCuLimit {
+			return sdkerrors.Wrapf(ErrInvalidPolicyCuFields, "invalid chain policy's CU fields (chainID = %s, chain EpochCuLimit = %v, EpochCuLimit = %v)", chain.ChainId, chain.EpochCuLimit, policy.EpochCuLimit)
+		}
+	}
+
 	if policy.MaxProvidersToPair <= 1 {
 		return sdkerrors.Wrapf(ErrInvalidPolicyMaxProvidersToPair, "invalid policy's MaxProvidersToPair fields (MaxProvidersToPair = %v)", policy.MaxProvidersToPair)
 	}
@@ -53,3 +70,84 @@ func VerifyTotalCuUsage(policies []*Policy, cuUsage uint64) bool {
 
 	return true
 }
+
+// GetChainEpochCuLimit returns the strictest cu limit per epoch the chain policies of the policies set on the chain, found is false if none of them set one
+func GetChainEpochCuLimit(chainID string, policies []*Policy) (epochCuLimit uint64, found bool) {
+	for _, policy := range policies {
+		if policy == nil {
+			continue
+		}
+		chain, ok := policy.GetChainPolicy(chainID)
+		if !ok || chain.EpochCuLimit == 0 {
+			continue
+		}
+		if !found || chain.EpochCuLimit < epochCuLimit {
+			epochCuLimit = chain.EpochCuLimit
+			found = true
+		}
+	}
+	return epochCuLimit, found
+}
+
+// GetChainGeolocation narrows the geolocation bitmap to the geolocations the chain policies of the policies set on the chain
+func GetChainGeolocation(chainID string, policies []*Policy, geolocation uint64) uint64 {
+	for _, policy := range policies {
+		if policy == nil {
+			continue
+		}
+		if chain, ok := policy.GetChainPolicy(chainID); ok && chain.GeolocationProfile != 0 {
+			geolocation &= chain.GeolocationProfile
+		}
+	}
+	return geolocation
+}
+
+// GetChainApiInterfaces returns the api interfaces allowed on the chain by all the policies, nil if none of them restricts the api interfaces
+func GetChainApiInterfaces(chainID string, policies []*Policy) []string {
+	var allowed []string
+	for _, policy := range policies {
+		if policy == nil {
+			continue
+		}
+		chain, ok := policy.GetChainPolicy(chainID)
+		if !ok || len(chain.ApiInterfaces) == 0 {
+			continue
+		}
+		allowed = restrictAllowed(allowed, chain.ApiInterfaces)
+	}
+	return allowed
+}
+
+// GetAllowedApis returns the apis all the policies allow, nil if none of them restricts the apis
+func GetAllowedApis(policies []*Policy) []string {
+	var allowed []string
+	for _, policy := range policies {
+		if policy == nil || len(policy.AllowedApis) == 0 {
+			continue
+		}
+		allowed = restrictAllowed(allowed, policy.AllowedApis)
+	}
+	return allowed
+}
+
+// IsApiAllowed returns whether an api is in the allowed apis by its name or function tag, a nil list allows all apis
+func IsApiAllowed(allowedApis []string, apiName string, functionTag string) bool {
+	if allowedApis == nil {
+		return true
+	}
+	return slices.Contains(allowedApis, apiName) || (functionTag != "" && slices.Contains(allowedApis, functionTag))
+}
+
+// restrictAllowed returns the allowed values that are also in restriction, a nil allowed list allows all values
+func restrictAllowed(allowed []string, restriction []string) []string {
+	if allowed == nil {
+		return append([]string{}, restriction...)
+	}
+	intersection := []string{}
+	for _, value := range allowed {
+		if slices.Contains(restriction, value) {
+			intersection = append(intersection, value)
+		}
+	}
+	return intersection
+}
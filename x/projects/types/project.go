@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	commontypes "github.com/lavanet/lava/common/types"
+	"golang.org/x/exp/slices"
 )
 
 const (
@@ -63,6 +64,15 @@ func (projectKey ProjectKey) IsKeyType(keyTypeToCheck ProjectKey_KEY_TYPE) bool
 	return false
 }
 
+// TypesString returns the names of the key's types separated by commas, e.g. "ADMIN,DEVELOPER"
+func (projectKey ProjectKey) TypesString() string {
+	names := make([]string, 0, len(projectKey.Types))
+	for _, keyType := range projectKey.Types {
+		names = append(names, keyType.String())
+	}
+	return strings.Join(names, ",")
+}
+
 func (projectKey *ProjectKey) AppendKeyType(typesToAdd []ProjectKey_KEY_TYPE) {
 	for _, keytype := range typesToAdd {
 		if !projectKey.IsKeyType(keytype) {
@@ -81,10 +91,74 @@ func (project *Project) AppendKey(keyToAdd ProjectKey) {
 	project.ProjectKeys = append(project.ProjectKeys, keyToAdd)
 }
 
+// IsExpired returns whether the key stopped being valid at block, keys without an expiration block never expire
+func (projectKey ProjectKey) IsExpired(block uint64) bool {
+	return projectKey.ExpirationBlock != 0 && block >= projectKey.ExpirationBlock
+}
+
+// IsPending returns whether the key isn't valid yet at block, e.g. a rotated key before the epoch it takes over at
+func (projectKey ProjectKey) IsPending(block uint64) bool {
+	return block < projectKey.ActivationBlock
+}
+
+func (project *Project) SetKeyActivation(projectKey string, activationBlock uint64) {
+	for i := 0; i < len(project.ProjectKeys); i++ {
+		if project.ProjectKeys[i].Key == projectKey {
+			project.ProjectKeys[i].ActivationBlock = activationBlock
+			return
+		}
+	}
+}
+
+func (project *Project) SetKeyExpiration(projectKey string, expirationBlock uint64) {
+	for i := 0; i < len(project.ProjectKeys); i++ {
+		if project.ProjectKeys[i].Key == projectKey {
+			project.ProjectKeys[i].ExpirationBlock = expirationBlock
+			return
+		}
+	}
+}
+
+// DeleteKeyTypes removes the types from the key, a key left without types is removed from the project
+func (project *Project) DeleteKeyTypes(projectKey string, typesToDelete []ProjectKey_KEY_TYPE) {
+	for i := 0; i < len(project.ProjectKeys); i++ {
+		if project.ProjectKeys[i].Key != projectKey {
+			continue
+		}
+		keyTypes := []ProjectKey_KEY_TYPE{}
+		for _, keyType := range project.ProjectKeys[i].Types {
+			if !slices.Contains(typesToDelete, keyType) {
+				keyTypes = append(keyTypes, keyType)
+			}
+		}
+		if len(keyTypes) == 0 {
+			project.ProjectKeys = append(project.ProjectKeys[:i], project.ProjectKeys[i+1:]...)
+		} else {
+			project.ProjectKeys[i].Types = keyTypes
+		}
+		return
+	}
+}
+
+// HasAdminKeys returns whether the project has a valid admin key at block other than the subscription key
+func (project *Project) HasAdminKeys(block uint64) bool {
+	for _, key := range project.ProjectKeys {
+		if key.IsKeyType(ProjectKey_ADMIN) && !key.IsPending(block) && !key.IsExpired(block) {
+			return true
+		}
+	}
+	return false
+}
+
 func (project *Project) HasKeyType(projectKey string, keyTypeToCheck ProjectKey_KEY_TYPE) bool {
 	return project.GetKey(projectKey).IsKeyType(keyTypeToCheck)
 }
 
-func (project *Project) IsAdminKey(projectKey string) bool {
-	return project.HasKeyType(projectKey, ProjectKey_ADMIN) || project.Subscription == projectKey
+// IsAdminKey returns whether the key is a valid admin key of the project at block, the subscription key is always an admin
+func (project *Project) IsAdminKey(projectKey string, block uint64) bool {
+	if project.Subscription == projectKey {
+		return true
+	}
+	key := project.GetKey(projectKey)
+	return key.IsKeyType(ProjectKey_ADMIN) && !key.IsPending(block) && !key.IsExpired(block)
 }
@@ -152,9 +152,11 @@ func (m *Project) GetSubscriptionPolicy() *Policy {
 }
 
 type ProjectKey struct {
-	Key   string                `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Types []ProjectKey_KEY_TYPE `protobuf:"varint,2,rep,packed,name=types,proto3,enum=lavanet.lava.projects.ProjectKey_KEY_TYPE" json:"types,omitempty"`
-	Vrfpk string                `protobuf:"bytes,3,opt,name=vrfpk,proto3" json:"vrfpk,omitempty"`
+	Key             string                `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Types           []ProjectKey_KEY_TYPE `protobuf:"varint,2,rep,packed,name=types,proto3,enum=lavanet.lava.projects.ProjectKey_KEY_TYPE" json:"types,omitempty"`
+	Vrfpk           string                `protobuf:"bytes,3,opt,name=vrfpk,proto3" json:"vrfpk,omitempty"`
+	ExpirationBlock uint64                `protobuf:"varint,4,opt,name=expiration_block,json=expirationBlock,proto3" json:"expiration_block,omitempty" mapstructure:"expiration_block"`
+	ActivationBlock uint64                `protobuf:"varint,5,opt,name=activation_block,json=activationBlock,proto3" json:"activation_block,omitempty"`
 }
 
 func (m *ProjectKey) Reset()         { *m = ProjectKey{} }
@@ -211,6 +213,20 @@ func (m *ProjectKey) GetVrfpk() string {
 	return ""
 }
 
+func (m *ProjectKey) GetExpirationBlock() uint64 {
+	if m != nil {
+		return m.ExpirationBlock
+	}
+	return 0
+}
+
+func (m *ProjectKey) GetActivationBlock() uint64 {
+	if m != nil {
+		return m.ActivationBlock
+	}
+	return 0
+}
+
 // protobuf expected in YAML format: used "moretags" to simplify parsing
 type Policy struct {
 	ChainPolicies      []ChainPolicy `protobuf:"bytes,1,rep,name=chain_policies,json=chainPolicies,proto3" json:"chain_policies" mapstructure:"chain_policies"`
@@ -218,6 +234,7 @@ type Policy struct {
 	TotalCuLimit       uint64        `protobuf:"varint,3,opt,name=total_cu_limit,json=totalCuLimit,proto3" json:"total_cu_limit" mapstructure:"total_cu_limit"`
 	EpochCuLimit       uint64        `protobuf:"varint,4,opt,name=epoch_cu_limit,json=epochCuLimit,proto3" json:"epoch_cu_limit" mapstructure:"epoch_cu_limit"`
 	MaxProvidersToPair uint64        `protobuf:"varint,5,opt,name=max_providers_to_pair,json=maxProvidersToPair,proto3" json:"max_providers_to_pair" mapstructure:"max_providers_to_pair"`
+	AllowedApis        []string      `protobuf:"bytes,6,rep,name=allowed_apis,json=allowedApis,proto3" json:"allowed_apis,omitempty" mapstructure:"allowed_apis"`
 }
 
 func (m *Policy) Reset()         { *m = Policy{} }
@@ -288,9 +305,19 @@ func (m *Policy) GetMaxProvidersToPair() uint64 {
 	return 0
 }
 
+func (m *Policy) GetAllowedApis() []string {
+	if m != nil {
+		return m.AllowedApis
+	}
+	return nil
+}
+
 type ChainPolicy struct {
-	ChainId string   `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty" mapstructure:"chain_id"`
-	Apis    []string `protobuf:"bytes,2,rep,name=apis,proto3" json:"apis,omitempty" mapstructure:"apis"`
+	ChainId            string   `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty" mapstructure:"chain_id"`
+	Apis               []string `protobuf:"bytes,2,rep,name=apis,proto3" json:"apis,omitempty" mapstructure:"apis"`
+	EpochCuLimit       uint64   `protobuf:"varint,3,opt,name=epoch_cu_limit,json=epochCuLimit,proto3" json:"epoch_cu_limit" mapstructure:"epoch_cu_limit"`
+	ApiInterfaces      []string `protobuf:"bytes,4,rep,name=api_interfaces,json=apiInterfaces,proto3" json:"api_interfaces,omitempty" mapstructure:"api_interfaces"`
+	GeolocationProfile uint64   `protobuf:"varint,5,opt,name=geolocation_profile,json=geolocationProfile,proto3" json:"geolocation_profile" mapstructure:"geolocation_profile"`
 }
 
 func (m *ChainPolicy) Reset()         { *m = ChainPolicy{} }
@@ -340,6 +367,27 @@ func (m *ChainPolicy) GetApis() []string {
 	return nil
 }
 
+func (m *ChainPolicy) GetEpochCuLimit() uint64 {
+	if m != nil {
+		return m.EpochCuLimit
+	}
+	return 0
+}
+
+func (m *ChainPolicy) GetApiInterfaces() []string {
+	if m != nil {
+		return m.ApiInterfaces
+	}
+	return nil
+}
+
+func (m *ChainPolicy) GetGeolocationProfile() uint64 {
+	if m != nil {
+		return m.GeolocationProfile
+	}
+	return 0
+}
+
 type ProtoDeveloperData struct {
 	ProjectID string `protobuf:"bytes,1,opt,name=projectID,proto3" json:"projectID,omitempty"`
 	Vrfpk     string `protobuf:"bytes,2,opt,name=vrfpk,proto3" json:"vrfpk,omitempty"`
@@ -482,55 +530,63 @@ func init() {
 func init() { proto.RegisterFile("projects/project.proto", fileDescriptor_9f89a31663a330ce) }
 
 var fileDescriptor_9f89a31663a330ce = []byte{
-	// 767 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0x4f, 0x4f, 0xe3, 0x46,
-	0x14, 0x8f, 0x13, 0xe7, 0xdf, 0x4b, 0x40, 0xd1, 0x00, 0xc5, 0x2a, 0x25, 0x4e, 0x47, 0xad, 0x14,
-	0xf5, 0xe0, 0x48, 0xa0, 0xf6, 0x50, 0xa9, 0x52, 0x09, 0x09, 0x6a, 0x0a, 0x0d, 0x91, 0x85, 0x2a,
-	0xd1, 0x8b, 0xe5, 0xd8, 0x43, 0x98, 0xe2, 0x64, 0x2c, 0xff, 0x89, 0x92, 0x6f, 0xd1, 0x53, 0x3f,
-	0xc3, 0x1e, 0xf6, 0x83, 0x70, 0xe4, 0xb8, 0x27, 0xef, 0x2a, 0xdc, 0x38, 0xe6, 0xb0, 0xe7, 0x95,
-	0xc7, 0x0e, 0x89, 0x21, 0xec, 0x22, 0xed, 0xc9, 0xf3, 0x7e, 0xef, 0xf7, 0xde, 0x9b, 0xf7, 0x67,
-	0x9e, 0xe1, 0x1b, 0xdb, 0x61, 0xff, 0x12, 0xc3, 0x73, 0x1b, 0xf1, 0x41, 0xb1, 0x1d, 0xe6, 0x31,
-	0xb4, 0x63, 0xe9, 0x63, 0x7d, 0x44, 0x3c, 0x25, 0xfc, 0x2a, 0x0b, 0xd2, 0xb7, 0xdb, 0x03, 0x36,
-	0x60, 0x9c, 0xd1, 0x08, 0x4f, 0x11, 0x19, 0x7f, 0x4c, 0x43, 0xbe, 0x17, 0x51, 0xd0, 0x36, 0x64,
-	0xe9, 0xc8, 0x24, 0x13, 0x49, 0xa8, 0x09, 0xf5, 0xa2, 0x1a, 0x09, 0x08, 0x43, 0xd9, 0xf5, 0xfb,
-	0xae, 0xe1, 0x50, 0xdb, 0xa3, 0x6c, 0x24, 0xa5, 0xb9, 0x32, 0x81, 0xa1, 0x1a, 0x94, 0x4c, 0xb2,
-	0xa4, 0x64, 0x38, 0x65, 0x15, 0x42, 0x12, 0xe4, 0xc9, 0x48, 0xef, 0x5b, 0xc4, 0x94, 0xc4, 0x9a,
-	0x50, 0x2f, 0xa8, 0x0b, 0x11, 0xfd, 0x09, 0xe5, 0xf8, 0x8e, 0xda, 0x0d, 0x99, 0xba, 0x52, 0xb6,
-	0x96, 0xa9, 0x97, 0x0e, 0xbe, 0x57, 0xd6, 0x66, 0xa1, 0xc4, 0x77, 0x3d, 0x25, 0xd3, 0xa6, 0x78,
-	0x1b, 0xc8, 0x29, 0xb5, 0x64, 0x3f, 0x22, 0x2e, 0xfa, 0x1d, 0xca, 0xba, 0x39, 0xa4, 0x23, 0xcd,
-	0x66, 0x16, 0x35, 0xa6, 0x52, 0xae, 0x26, 0xd4, 0x4b, 0x07, 0xfb, 0x2f, 0xf9, 0xe2, 0x24, 0xb5,
-	0xc4, 0x4d, 0x22, 0x01, 0xed, 0x42, 0xde, 0x77, 0x89, 0xa9, 0x19, 0xbe, 0x94, 0xaf, 0x09, 0x75,
-	0x51, 0xcd, 0x85, 0xe2, 0xb1, 0x8f, 0xba, 0xb0, 0xb5, 0x9a, 0xf2, 0x22, 0x42, 0xe1, 0x35, 0x11,
-	0xd0, 0xaa, 0x65, 0x84, 0xe1, 0xb7, 0x02, 0xc0, 0x32, 0x19, 0x54, 0x81, 0xcc, 0x0d, 0x99, 0xc6,
-	0x95, 0x0f, 0x8f, 0xe8, 0x04, 0xb2, 0xde, 0xd4, 0x26, 0xae, 0x94, 0xae, 0x65, 0xea, 0x9b, 0x07,
-	0x3f, 0x7d, 0xb1, 0x20, 0xca, 0x69, 0xfb, 0x52, 0xbb, 0xb8, 0xec, 0xb5, 0xe3, 0xca, 0x44, 0xe6,
-	0x61, 0x57, 0xc7, 0xce, 0x95, 0x7d, 0x13, 0x77, 0x25, 0x12, 0xb0, 0x02, 0x85, 0x05, 0x1d, 0x15,
-	0x40, 0xec, 0x9e, 0x77, 0xdb, 0x95, 0x14, 0x2a, 0x42, 0xf6, 0xa8, 0xf5, 0x57, 0xa7, 0x5b, 0x11,
-	0xd0, 0x06, 0x14, 0x5b, 0xed, 0xbf, 0xdb, 0x67, 0xe7, 0xbd, 0xb6, 0x5a, 0x49, 0xe3, 0xff, 0x45,
-	0xc8, 0xc5, 0x25, 0xb2, 0x61, 0xd3, 0xb8, 0xd6, 0x17, 0x45, 0xa6, 0xc4, 0x95, 0x04, 0xde, 0x32,
-	0xfc, 0xc2, 0x0d, 0x8f, 0x43, 0x72, 0x64, 0xdb, 0xfc, 0x31, 0xbc, 0xd9, 0x3c, 0x90, 0xf7, 0x87,
-	0xba, 0xed, 0x7a, 0x8e, 0x6f, 0x78, 0xbe, 0x43, 0x7e, 0xc5, 0x49, 0x7f, 0x58, 0xdd, 0x30, 0x1e,
-	0x6d, 0x28, 0x71, 0xd1, 0x08, 0xb6, 0x06, 0x84, 0x59, 0xcc, 0xd0, 0xa3, 0xd2, 0x3b, 0xec, 0x8a,
-	0x5a, 0x84, 0x4f, 0xa2, 0xd8, 0xfc, 0xed, 0x21, 0x90, 0xd7, 0xa9, 0xe7, 0x81, 0x8c, 0x93, 0x51,
-	0xd6, 0x90, 0xb0, 0x8a, 0x56, 0xd0, 0x5e, 0x04, 0xa2, 0x4b, 0xd8, 0xf4, 0x98, 0xa7, 0x5b, 0x9a,
-	0xe1, 0x6b, 0x16, 0x1d, 0x52, 0x8f, 0xd7, 0x4e, 0x6c, 0x1e, 0x3e, 0x04, 0xf2, 0x13, 0xcd, 0xf3,
-	0x5c, 0x92, 0x7a, 0xac, 0x96, 0x39, 0x70, 0xec, 0x9f, 0x85, 0x62, 0xe8, 0x9a, 0xd8, 0xcc, 0xb8,
-	0x5e, 0xba, 0x16, 0x97, 0xae, 0x93, 0x9a, 0xe7, 0xae, 0x93, 0x7a, 0xac, 0x96, 0x39, 0xb0, 0x70,
-	0xed, 0xc1, 0xce, 0x50, 0x9f, 0x84, 0x99, 0x8d, 0xa9, 0x49, 0x1c, 0x57, 0xf3, 0x98, 0x66, 0xeb,
-	0xd4, 0x91, 0xb2, 0x3c, 0xc2, 0xd1, 0x43, 0x20, 0xaf, 0x27, 0xcc, 0x03, 0xf9, 0x87, 0x64, 0xa0,
-	0xb5, 0x34, 0xac, 0xa2, 0xa1, 0x3e, 0xe9, 0x2d, 0xe0, 0x0b, 0xd6, 0x0b, 0xc1, 0x31, 0x94, 0x56,
-	0x1a, 0x8c, 0x7e, 0x81, 0x42, 0xd4, 0x4c, 0x6a, 0x46, 0xc3, 0xdc, 0xdc, 0x9b, 0x07, 0xf2, 0xee,
-	0xba, 0x76, 0x53, 0x13, 0xab, 0x79, 0x7e, 0xec, 0x98, 0xa8, 0x01, 0xa2, 0x6e, 0xd3, 0x68, 0xd8,
-	0x8b, 0xcd, 0xbd, 0x78, 0x4c, 0xb6, 0x92, 0x76, 0x21, 0x03, 0xab, 0x9c, 0x88, 0xff, 0x00, 0xd4,
-	0x0b, 0x37, 0x58, 0x8b, 0x8c, 0x89, 0xc5, 0x6c, 0xe2, 0xb4, 0x74, 0x4f, 0x47, 0xdf, 0x41, 0x31,
-	0x9e, 0xbb, 0x4e, 0x2b, 0x7e, 0x4c, 0x4b, 0x60, 0xf9, 0x14, 0xd2, 0xab, 0x4f, 0xe1, 0xbd, 0x00,
-	0xa5, 0xf8, 0x15, 0x71, 0x1f, 0x08, 0xc4, 0x91, 0x3e, 0x24, 0xb1, 0x39, 0x3f, 0x3f, 0x5d, 0x70,
-	0xe9, 0xcf, 0x2e, 0xb8, 0x4c, 0x72, 0xc1, 0x75, 0x60, 0x75, 0x47, 0x49, 0xe2, 0x57, 0xec, 0xb7,
-	0x9f, 0x21, 0x17, 0xef, 0x9d, 0xec, 0x6b, 0xf6, 0x4e, 0x4c, 0x6e, 0x9e, 0xbc, 0x99, 0x55, 0x85,
-	0xdb, 0x59, 0x55, 0xb8, 0x9b, 0x55, 0x85, 0x0f, 0xb3, 0xaa, 0xf0, 0xdf, 0x7d, 0x35, 0x75, 0x77,
-	0x5f, 0x4d, 0xbd, 0xbb, 0xaf, 0xa6, 0xfe, 0xa9, 0x0f, 0xa8, 0x77, 0xed, 0xf7, 0x15, 0x83, 0x0d,
-	0x1b, 0xb1, 0x3b, 0xfe, 0x6d, 0x4c, 0x1a, 0x8f, 0x7f, 0x18, 0xbe, 0x4a, 0xfa, 0x39, 0xfe, 0xcf,
-	0x38, 0xfc, 0x14, 0x00, 0x00, 0xff, 0xff, 0xf7, 0xe3, 0xcb, 0x0e, 0x7a, 0x06, 0x00, 0x00,
+	// 888 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x4f, 0x6f, 0xe3, 0x44,
+	0x14, 0x8f, 0x13, 0xe7, 0xdf, 0x4b, 0xda, 0x8d, 0xa6, 0xbb, 0xac, 0x45, 0xd9, 0x38, 0xcc, 0x82,
+	0x14, 0x38, 0x24, 0x52, 0x57, 0x70, 0x40, 0x42, 0xa2, 0x6e, 0x52, 0x11, 0xb6, 0x64, 0x23, 0x6b,
+	0x85, 0x54, 0x2e, 0xd6, 0xc4, 0x9e, 0xb6, 0x43, 0x9d, 0x8c, 0x65, 0x3b, 0x21, 0xf9, 0x14, 0xf0,
+	0x2d, 0xe0, 0xa3, 0xec, 0x71, 0x8f, 0x9c, 0x0c, 0x6a, 0x6f, 0x3d, 0xe6, 0xc0, 0x19, 0x79, 0x3c,
+	0x69, 0xec, 0x6e, 0x0a, 0x95, 0x80, 0x93, 0xe7, 0xfd, 0xe6, 0xf7, 0xde, 0xbc, 0x3f, 0xf3, 0xe6,
+	0x19, 0xde, 0xf3, 0x7c, 0xfe, 0x03, 0xb5, 0xc3, 0xa0, 0x2b, 0x17, 0x1d, 0xcf, 0xe7, 0x21, 0x47,
+	0x4f, 0x5c, 0x32, 0x27, 0x53, 0x1a, 0x76, 0xe2, 0x6f, 0x67, 0x4d, 0x7a, 0xff, 0xf1, 0x39, 0x3f,
+	0xe7, 0x82, 0xd1, 0x8d, 0x57, 0x09, 0x19, 0xff, 0x99, 0x87, 0xf2, 0x28, 0xa1, 0xa0, 0xc7, 0x50,
+	0x64, 0x53, 0x87, 0x2e, 0x34, 0xa5, 0xa5, 0xb4, 0xab, 0x66, 0x22, 0x20, 0x0c, 0xf5, 0x60, 0x36,
+	0x0e, 0x6c, 0x9f, 0x79, 0x21, 0xe3, 0x53, 0x2d, 0x2f, 0x36, 0x33, 0x18, 0x6a, 0x41, 0xcd, 0xa1,
+	0x1b, 0x4a, 0x41, 0x50, 0xd2, 0x10, 0xd2, 0xa0, 0x4c, 0xa7, 0x64, 0xec, 0x52, 0x47, 0x53, 0x5b,
+	0x4a, 0xbb, 0x62, 0xae, 0x45, 0xf4, 0x0d, 0xd4, 0xa5, 0x8f, 0xd6, 0x25, 0x5d, 0x06, 0x5a, 0xb1,
+	0x55, 0x68, 0xd7, 0x0e, 0x3e, 0xec, 0x6c, 0x8d, 0xa2, 0x23, 0x7d, 0x7d, 0x49, 0x97, 0x86, 0xfa,
+	0x26, 0xd2, 0x73, 0x66, 0xcd, 0xbb, 0x45, 0x02, 0xf4, 0x15, 0xd4, 0x89, 0x33, 0x61, 0x53, 0xcb,
+	0xe3, 0x2e, 0xb3, 0x97, 0x5a, 0xa9, 0xa5, 0xb4, 0x6b, 0x07, 0xcf, 0xee, 0xb3, 0x25, 0x48, 0x66,
+	0x4d, 0xa8, 0x24, 0x02, 0x7a, 0x0a, 0xe5, 0x59, 0x40, 0x1d, 0xcb, 0x9e, 0x69, 0xe5, 0x96, 0xd2,
+	0x56, 0xcd, 0x52, 0x2c, 0x1e, 0xcd, 0xd0, 0x10, 0xf6, 0xd2, 0x21, 0xaf, 0x4f, 0xa8, 0x3c, 0xe4,
+	0x04, 0x94, 0xd6, 0x4c, 0x30, 0xfc, 0x4b, 0x1e, 0x60, 0x13, 0x0c, 0x6a, 0x40, 0xe1, 0x92, 0x2e,
+	0x65, 0xe6, 0xe3, 0x25, 0x3a, 0x86, 0x62, 0xb8, 0xf4, 0x68, 0xa0, 0xe5, 0x5b, 0x85, 0xf6, 0xee,
+	0xc1, 0xa7, 0xff, 0x98, 0x90, 0xce, 0xcb, 0xfe, 0xa9, 0xf5, 0xfa, 0x74, 0xd4, 0x97, 0x99, 0x49,
+	0xd4, 0xe3, 0xaa, 0xce, 0xfd, 0x33, 0xef, 0x52, 0x56, 0x25, 0x11, 0xd0, 0x10, 0x1a, 0x74, 0xe1,
+	0x31, 0x9f, 0x88, 0x60, 0xc6, 0x2e, 0xb7, 0x2f, 0x45, 0x61, 0x54, 0xe3, 0xf9, 0x2a, 0xd2, 0xf5,
+	0x09, 0xf1, 0x82, 0xd0, 0x9f, 0xd9, 0xe1, 0xcc, 0xa7, 0x5f, 0xe0, 0xbb, 0x4c, 0x6c, 0x3e, 0xda,
+	0x40, 0x46, 0x8c, 0xa0, 0x4f, 0xa0, 0x41, 0xec, 0x90, 0xcd, 0xd3, 0xf6, 0x8a, 0x22, 0x81, 0x8f,
+	0x36, 0xb8, 0xa0, 0xe2, 0x0e, 0x54, 0xd6, 0x9e, 0xa2, 0x0a, 0xa8, 0xc3, 0x57, 0xc3, 0x7e, 0x23,
+	0x87, 0xaa, 0x50, 0x3c, 0xec, 0x7d, 0x3b, 0x18, 0x36, 0x14, 0xb4, 0x03, 0xd5, 0x5e, 0xff, 0xbb,
+	0xfe, 0xc9, 0xab, 0x51, 0xdf, 0x6c, 0xe4, 0x71, 0xa4, 0x42, 0x49, 0x56, 0xc7, 0x83, 0x5d, 0xfb,
+	0x82, 0xac, 0xeb, 0xcb, 0x68, 0xa0, 0x29, 0xe2, 0xb6, 0xe0, 0x7b, 0x92, 0x73, 0x14, 0x93, 0x13,
+	0x5d, 0xe3, 0xe3, 0x38, 0x29, 0xab, 0x48, 0x7f, 0x96, 0x8d, 0x2d, 0x6b, 0x0f, 0x9b, 0x3b, 0xf6,
+	0xad, 0x0e, 0xa3, 0x01, 0x9a, 0xc2, 0xde, 0x39, 0xe5, 0x2e, 0xb7, 0x93, 0xc0, 0x3c, 0x9f, 0x9f,
+	0x31, 0x97, 0x8a, 0x26, 0x50, 0x8d, 0x2f, 0x6f, 0x22, 0x7d, 0xdb, 0xf6, 0x2a, 0xd2, 0x71, 0xf6,
+	0x94, 0x2d, 0x24, 0x6c, 0xa2, 0x14, 0x3a, 0x4a, 0x40, 0x74, 0x0a, 0xbb, 0x21, 0x0f, 0x89, 0x6b,
+	0xd9, 0x33, 0xcb, 0x65, 0x13, 0x16, 0x8a, 0xb2, 0xa9, 0xc6, 0x8b, 0x9b, 0x48, 0xbf, 0xb3, 0xf3,
+	0x6e, 0x2c, 0xd9, 0x7d, 0x6c, 0xd6, 0x05, 0x70, 0x34, 0x3b, 0x89, 0xc5, 0xd8, 0x34, 0xf5, 0xb8,
+	0x7d, 0xb1, 0x31, 0xad, 0x6e, 0x4c, 0x67, 0x77, 0xde, 0x35, 0x9d, 0xdd, 0xc7, 0x66, 0x5d, 0x00,
+	0x6b, 0xd3, 0x21, 0x3c, 0x99, 0x90, 0x45, 0x1c, 0xd9, 0x9c, 0x39, 0xd4, 0x0f, 0xac, 0x90, 0x5b,
+	0x1e, 0x61, 0x7e, 0x72, 0x05, 0x8c, 0xc3, 0x9b, 0x48, 0xdf, 0x4e, 0x58, 0x45, 0xfa, 0x47, 0xd9,
+	0x83, 0xb6, 0xd2, 0xb0, 0x89, 0x26, 0x64, 0x31, 0x5a, 0xc3, 0xaf, 0xf9, 0x88, 0x30, 0x1f, 0x1d,
+	0x43, 0x9d, 0xb8, 0x2e, 0xff, 0x91, 0x3a, 0x16, 0xf1, 0x58, 0xa0, 0x95, 0x5a, 0x85, 0x76, 0xd5,
+	0x78, 0x2e, 0xeb, 0xbc, 0x9f, 0xb5, 0x9b, 0x66, 0x62, 0xb3, 0x26, 0xc5, 0xc3, 0x58, 0xfa, 0xa9,
+	0x00, 0xb5, 0xd4, 0x4d, 0x41, 0x9f, 0x43, 0x25, 0xb9, 0x15, 0xcc, 0x49, 0x1a, 0xd2, 0xd8, 0x5f,
+	0x45, 0xfa, 0xd3, 0x6d, 0xf7, 0x86, 0x39, 0xd8, 0x2c, 0x8b, 0xe5, 0xc0, 0x41, 0x5d, 0x50, 0x85,
+	0x1f, 0x79, 0xe1, 0xc7, 0xbe, 0xf4, 0x63, 0xef, 0x8e, 0x1f, 0xe2, 0x7c, 0x41, 0xdc, 0x52, 0x91,
+	0xc2, 0x7f, 0x55, 0x91, 0x13, 0xd8, 0x25, 0x1e, 0xb3, 0xd8, 0x34, 0xa4, 0xfe, 0x19, 0xb1, 0x69,
+	0xa0, 0xa9, 0xc2, 0xab, 0x7b, 0xba, 0x20, 0xcb, 0xc5, 0xe6, 0x0e, 0xf1, 0xd8, 0xe0, 0x56, 0xbe,
+	0xaf, 0x0b, 0x8a, 0xff, 0x53, 0x17, 0xe0, 0xaf, 0x01, 0x8d, 0xe2, 0xf1, 0xd4, 0xa3, 0x73, 0xea,
+	0x72, 0x8f, 0xfa, 0x3d, 0x12, 0x12, 0xf4, 0x01, 0x54, 0x65, 0x67, 0x0f, 0x7a, 0xf2, 0xa5, 0xdc,
+	0x00, 0x9b, 0x77, 0x2e, 0x9f, 0x7a, 0xe7, 0xf0, 0xef, 0x0a, 0xd4, 0xe4, 0x13, 0x29, 0x6c, 0x20,
+	0x50, 0xa7, 0x64, 0x42, 0xa5, 0xba, 0x58, 0xdf, 0x9d, 0x5e, 0xf9, 0xbf, 0x9d, 0x5e, 0x85, 0xec,
+	0xf4, 0x1a, 0x40, 0x7a, 0x00, 0x69, 0xea, 0xbf, 0x18, 0x5e, 0x9f, 0x41, 0x49, 0x0e, 0x95, 0xe2,
+	0x43, 0x86, 0x8a, 0x24, 0x1b, 0xc7, 0xbf, 0x5e, 0x35, 0x95, 0x37, 0x57, 0x4d, 0xe5, 0xed, 0x55,
+	0x53, 0xf9, 0xe3, 0xaa, 0xa9, 0xfc, 0x7c, 0xdd, 0xcc, 0xbd, 0xbd, 0x6e, 0xe6, 0x7e, 0xbb, 0x6e,
+	0xe6, 0xbe, 0x6f, 0x9f, 0xb3, 0xf0, 0x62, 0x36, 0xee, 0xd8, 0x7c, 0xd2, 0x95, 0xe6, 0xc4, 0xb7,
+	0xbb, 0xe8, 0xde, 0xfe, 0x3e, 0x88, 0x39, 0x31, 0x2e, 0x89, 0x1f, 0x82, 0x17, 0x7f, 0x0d, 0x00,
+	0xb3, 0x54, 0x30, 0x32, 0x57, 0x08, 0x00, 0x00,
 }
 
 func (this *Project) Equal(that interface{}) bool {
@@ -616,6 +672,12 @@ func (this *ProjectKey) Equal(that interface{}) bool {
 	if this.Vrfpk != that1.Vrfpk {
 		return false
 	}
+	if this.ExpirationBlock != that1.ExpirationBlock {
+		return false
+	}
+	if this.ActivationBlock != that1.ActivationBlock {
+		return false
+	}
 	return true
 }
 func (this *Policy) Equal(that interface{}) bool {
@@ -657,6 +719,14 @@ func (this *Policy) Equal(that interface{}) bool {
 	if this.MaxProvidersToPair != that1.MaxProvidersToPair {
 		return false
 	}
+	if len(this.AllowedApis) != len(that1.AllowedApis) {
+		return false
+	}
+	for i := range this.AllowedApis {
+		if this.AllowedApis[i] != that1.AllowedApis[i] {
+			return false
+		}
+	}
 	return true
 }
 func (this *ChainPolicy) Equal(that interface{}) bool {
@@ -689,6 +759,20 @@ func (this *ChainPolicy) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.EpochCuLimit != that1.EpochCuLimit {
+		return false
+	}
+	if len(this.ApiInterfaces) != len(that1.ApiInterfaces) {
+		return false
+	}
+	for i := range this.ApiInterfaces {
+		if this.ApiInterfaces[i] != that1.ApiInterfaces[i] {
+			return false
+		}
+	}
+	if this.GeolocationProfile != that1.GeolocationProfile {
+		return false
+	}
 	return true
 }
 func (this *ProtoDeveloperData) Equal(that interface{}) bool {
@@ -876,6 +960,16 @@ func (m *ProjectKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ActivationBlock != 0 {
+		i = encodeVarintProject(dAtA, i, uint64(m.ActivationBlock))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ExpirationBlock != 0 {
+		i = encodeVarintProject(dAtA, i, uint64(m.ExpirationBlock))
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.Vrfpk) > 0 {
 		i -= len(m.Vrfpk)
 		copy(dAtA[i:], m.Vrfpk)
@@ -931,6 +1025,15 @@ func (m *Policy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AllowedApis) > 0 {
+		for iNdEx := len(m.AllowedApis) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedApis[iNdEx])
+			copy(dAtA[i:], m.AllowedApis[iNdEx])
+			i = encodeVarintProject(dAtA, i, uint64(len(m.AllowedApis[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if m.MaxProvidersToPair != 0 {
 		i = encodeVarintProject(dAtA, i, uint64(m.MaxProvidersToPair))
 		i--
@@ -988,6 +1091,25 @@ func (m *ChainPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.GeolocationProfile != 0 {
+		i = encodeVarintProject(dAtA, i, uint64(m.GeolocationProfile))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.ApiInterfaces) > 0 {
+		for iNdEx := len(m.ApiInterfaces) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ApiInterfaces[iNdEx])
+			copy(dAtA[i:], m.ApiInterfaces[iNdEx])
+			i = encodeVarintProject(dAtA, i, uint64(len(m.ApiInterfaces[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.EpochCuLimit != 0 {
+		i = encodeVarintProject(dAtA, i, uint64(m.EpochCuLimit))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.Apis) > 0 {
 		for iNdEx := len(m.Apis) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Apis[iNdEx])
@@ -1190,6 +1312,12 @@ func (m *ProjectKey) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovProject(uint64(l))
 	}
+	if m.ExpirationBlock != 0 {
+		n += 1 + sovProject(uint64(m.ExpirationBlock))
+	}
+	if m.ActivationBlock != 0 {
+		n += 1 + sovProject(uint64(m.ActivationBlock))
+	}
 	return n
 }
 
@@ -1217,6 +1345,12 @@ func (m *Policy) Size() (n int) {
 	if m.MaxProvidersToPair != 0 {
 		n += 1 + sovProject(uint64(m.MaxProvidersToPair))
 	}
+	if len(m.AllowedApis) > 0 {
+		for _, s := range m.AllowedApis {
+			l = len(s)
+			n += 1 + l + sovProject(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1236,6 +1370,18 @@ func (m *ChainPolicy) Size() (n int) {
 			n += 1 + l + sovProject(uint64(l))
 		}
 	}
+	if m.EpochCuLimit != 0 {
+		n += 1 + sovProject(uint64(m.EpochCuLimit))
+	}
+	if len(m.ApiInterfaces) > 0 {
+		for _, s := range m.ApiInterfaces {
+			l = len(s)
+			n += 1 + l + sovProject(uint64(l))
+		}
+	}
+	if m.GeolocationProfile != 0 {
+		n += 1 + sovProject(uint64(m.GeolocationProfile))
+	}
 	return n
 }
 
@@ -1745,6 +1891,44 @@ func (m *ProjectKey) Unmarshal(dAtA []byte) error {
 			}
 			m.Vrfpk = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationBlock", wireType)
+			}
+			m.ExpirationBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpirationBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationBlock", wireType)
+			}
+			m.ActivationBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivationBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProject(dAtA[iNdEx:])
@@ -1905,6 +2089,38 @@ func (m *Policy) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedApis", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProject
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProject
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedApis = append(m.AllowedApis, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProject(dAtA[iNdEx:])
@@ -2019,6 +2235,76 @@ func (m *ChainPolicy) Unmarshal(dAtA []byte) error {
 			}
 			m.Apis = append(m.Apis, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochCuLimit", wireType)
+			}
+			m.EpochCuLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochCuLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiInterfaces", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProject
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProject
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiInterfaces = append(m.ApiInterfaces, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GeolocationProfile", wireType)
+			}
+			m.GeolocationProfile = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GeolocationProfile |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProject(dAtA[iNdEx:])
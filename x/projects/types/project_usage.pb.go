@@ -0,0 +1,700 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: projects/project_usage.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// the cu a developer key of a project used on a chain in an epoch
+type ProjectUsage struct {
+	Epoch        uint64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Project      string `protobuf:"bytes,2,opt,name=project,proto3" json:"project,omitempty"`
+	DeveloperKey string `protobuf:"bytes,3,opt,name=developer_key,json=developerKey,proto3" json:"developer_key,omitempty"`
+	ChainId      string `protobuf:"bytes,4,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	UsedCu       uint64 `protobuf:"varint,5,opt,name=used_cu,json=usedCu,proto3" json:"used_cu,omitempty"`
+}
+
+func (m *ProjectUsage) Reset()         { *m = ProjectUsage{} }
+func (m *ProjectUsage) String() string { return proto.CompactTextString(m) }
+func (*ProjectUsage) ProtoMessage()    {}
+func (*ProjectUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e04a35b67e0abd19, []int{0}
+}
+func (m *ProjectUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProjectUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProjectUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProjectUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProjectUsage.Merge(m, src)
+}
+func (m *ProjectUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProjectUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProjectUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProjectUsage proto.InternalMessageInfo
+
+func (m *ProjectUsage) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *ProjectUsage) GetProject() string {
+	if m != nil {
+		return m.Project
+	}
+	return ""
+}
+
+func (m *ProjectUsage) GetDeveloperKey() string {
+	if m != nil {
+		return m.DeveloperKey
+	}
+	return ""
+}
+
+func (m *ProjectUsage) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *ProjectUsage) GetUsedCu() uint64 {
+	if m != nil {
+		return m.UsedCu
+	}
+	return 0
+}
+
+// the cu used by a project, developer key or chain over an epoch range
+type UsageSummary struct {
+	Id     string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UsedCu uint64 `protobuf:"varint,2,opt,name=used_cu,json=usedCu,proto3" json:"used_cu,omitempty"`
+}
+
+func (m *UsageSummary) Reset()         { *m = UsageSummary{} }
+func (m *UsageSummary) String() string { return proto.CompactTextString(m) }
+func (*UsageSummary) ProtoMessage()    {}
+func (*UsageSummary) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e04a35b67e0abd19, []int{1}
+}
+func (m *UsageSummary) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UsageSummary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UsageSummary.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UsageSummary) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UsageSummary.Merge(m, src)
+}
+func (m *UsageSummary) XXX_Size() int {
+	return m.Size()
+}
+func (m *UsageSummary) XXX_DiscardUnknown() {
+	xxx_messageInfo_UsageSummary.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UsageSummary proto.InternalMessageInfo
+
+func (m *UsageSummary) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *UsageSummary) GetUsedCu() uint64 {
+	if m != nil {
+		return m.UsedCu
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*ProjectUsage)(nil), "lavanet.lava.projects.ProjectUsage")
+	proto.RegisterType((*UsageSummary)(nil), "lavanet.lava.projects.UsageSummary")
+}
+
+func init() { proto.RegisterFile("projects/project_usage.proto", fileDescriptor_e04a35b67e0abd19) }
+
+var fileDescriptor_e04a35b67e0abd19 = []byte{
+	// 261 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x29, 0x28, 0xca, 0xcf,
+	0x4a, 0x4d, 0x2e, 0x29, 0xd6, 0x87, 0x32, 0xe2, 0x4b, 0x8b, 0x13, 0xd3, 0x53, 0xf5, 0x0a, 0x8a,
+	0xf2, 0x4b, 0xf2, 0x85, 0x44, 0x73, 0x12, 0xcb, 0x12, 0xf3, 0x52, 0x4b, 0xf4, 0x40, 0xb4, 0x1e,
+	0x4c, 0xa9, 0xd2, 0x74, 0x46, 0x2e, 0x9e, 0x00, 0x08, 0x27, 0x14, 0xa4, 0x5a, 0x48, 0x84, 0x8b,
+	0x35, 0xb5, 0x20, 0x3f, 0x39, 0x43, 0x82, 0x51, 0x81, 0x51, 0x83, 0x25, 0x08, 0xc2, 0x11, 0x92,
+	0xe0, 0x62, 0x87, 0x6a, 0x91, 0x60, 0x52, 0x60, 0xd4, 0xe0, 0x0c, 0x82, 0x71, 0x85, 0x94, 0xb9,
+	0x78, 0x53, 0x52, 0xcb, 0x52, 0x73, 0xf2, 0x0b, 0x52, 0x8b, 0xe2, 0xb3, 0x53, 0x2b, 0x25, 0x98,
+	0xc1, 0xf2, 0x3c, 0x70, 0x41, 0xef, 0xd4, 0x4a, 0x21, 0x49, 0x2e, 0x8e, 0xe4, 0x8c, 0xc4, 0xcc,
+	0xbc, 0xf8, 0xcc, 0x14, 0x09, 0x16, 0x88, 0x7e, 0x30, 0xdf, 0x33, 0x45, 0x48, 0x9c, 0x8b, 0xbd,
+	0xb4, 0x38, 0x35, 0x25, 0x3e, 0xb9, 0x54, 0x82, 0x15, 0x6c, 0x23, 0x1b, 0x88, 0xeb, 0x5c, 0xaa,
+	0x64, 0xce, 0xc5, 0x03, 0x76, 0x51, 0x70, 0x69, 0x6e, 0x6e, 0x62, 0x51, 0xa5, 0x10, 0x1f, 0x17,
+	0x53, 0x66, 0x0a, 0xd8, 0x55, 0x9c, 0x41, 0x4c, 0x99, 0x28, 0x1a, 0x99, 0x90, 0x35, 0x3a, 0x39,
+	0x9d, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb,
+	0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x46, 0x7a, 0x66, 0x49, 0x46,
+	0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae, 0x3e, 0x34, 0x38, 0xc0, 0xb4, 0x7e, 0x85, 0x3e, 0x3c, 0xec,
+	0x4a, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0x81, 0x66, 0x0c, 0x18, 0x00, 0x13, 0x59, 0x4d,
+	0xde, 0x54, 0x01, 0x00, 0x00,
+}
+
+func (m *ProjectUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProjectUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProjectUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UsedCu != 0 {
+		i = encodeVarintProjectUsage(dAtA, i, uint64(m.UsedCu))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintProjectUsage(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.DeveloperKey) > 0 {
+		i -= len(m.DeveloperKey)
+		copy(dAtA[i:], m.DeveloperKey)
+		i = encodeVarintProjectUsage(dAtA, i, uint64(len(m.DeveloperKey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Project) > 0 {
+		i -= len(m.Project)
+		copy(dAtA[i:], m.Project)
+		i = encodeVarintProjectUsage(dAtA, i, uint64(len(m.Project)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintProjectUsage(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UsageSummary) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UsageSummary) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UsageSummary) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UsedCu != 0 {
+		i = encodeVarintProjectUsage(dAtA, i, uint64(m.UsedCu))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintProjectUsage(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintProjectUsage(dAtA []byte, offset int, v uint64) int {
+	offset -= sovProjectUsage(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ProjectUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovProjectUsage(uint64(m.Epoch))
+	}
+	l = len(m.Project)
+	if l > 0 {
+		n += 1 + l + sovProjectUsage(uint64(l))
+	}
+	l = len(m.DeveloperKey)
+	if l > 0 {
+		n += 1 + l + sovProjectUsage(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovProjectUsage(uint64(l))
+	}
+	if m.UsedCu != 0 {
+		n += 1 + sovProjectUsage(uint64(m.UsedCu))
+	}
+	return n
+}
+
+func (m *UsageSummary) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovProjectUsage(uint64(l))
+	}
+	if m.UsedCu != 0 {
+		n += 1 + sovProjectUsage(uint64(m.UsedCu))
+	}
+	return n
+}
+
+func sovProjectUsage(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozProjectUsage(x uint64) (n int) {
+	return sovProjectUsage(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ProjectUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProjectUsage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProjectUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProjectUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProjectUsage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProjectUsage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProjectUsage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProjectUsage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Project = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeveloperKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProjectUsage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProjectUsage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProjectUsage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeveloperKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProjectUsage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProjectUsage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProjectUsage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsedCu", wireType)
+			}
+			m.UsedCu = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProjectUsage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UsedCu |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProjectUsage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProjectUsage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UsageSummary) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProjectUsage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UsageSummary: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UsageSummary: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProjectUsage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProjectUsage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProjectUsage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsedCu", wireType)
+			}
+			m.UsedCu = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProjectUsage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UsedCu |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProjectUsage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProjectUsage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipProjectUsage(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowProjectUsage
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowProjectUsage
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowProjectUsage
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthProjectUsage
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupProjectUsage
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthProjectUsage
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthProjectUsage        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowProjectUsage          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupProjectUsage = fmt.Errorf("proto: unexpected end of group")
+)
@@ -288,6 +288,262 @@ func (m *QueryDeveloperResponse) GetProject() *Project {
 	return nil
 }
 
+type QueryProjectUsageRequest struct {
+	Project   string `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	FromEpoch uint64 `protobuf:"varint,2,opt,name=from_epoch,json=fromEpoch,proto3" json:"from_epoch,omitempty"`
+	ToEpoch   uint64 `protobuf:"varint,3,opt,name=to_epoch,json=toEpoch,proto3" json:"to_epoch,omitempty"`
+}
+
+func (m *QueryProjectUsageRequest) Reset()         { *m = QueryProjectUsageRequest{} }
+func (m *QueryProjectUsageRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProjectUsageRequest) ProtoMessage()    {}
+func (*QueryProjectUsageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bebeeb088f4d9d1b, []int{6}
+}
+func (m *QueryProjectUsageRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProjectUsageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProjectUsageRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProjectUsageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProjectUsageRequest.Merge(m, src)
+}
+func (m *QueryProjectUsageRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProjectUsageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProjectUsageRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProjectUsageRequest proto.InternalMessageInfo
+
+func (m *QueryProjectUsageRequest) GetProject() string {
+	if m != nil {
+		return m.Project
+	}
+	return ""
+}
+
+func (m *QueryProjectUsageRequest) GetFromEpoch() uint64 {
+	if m != nil {
+		return m.FromEpoch
+	}
+	return 0
+}
+
+func (m *QueryProjectUsageRequest) GetToEpoch() uint64 {
+	if m != nil {
+		return m.ToEpoch
+	}
+	return 0
+}
+
+type QueryProjectUsageResponse struct {
+	TotalCu       uint64         `protobuf:"varint,1,opt,name=total_cu,json=totalCu,proto3" json:"total_cu,omitempty"`
+	DeveloperKeys []UsageSummary `protobuf:"bytes,2,rep,name=developer_keys,json=developerKeys,proto3" json:"developer_keys"`
+	Chains        []UsageSummary `protobuf:"bytes,3,rep,name=chains,proto3" json:"chains"`
+	Usage         []ProjectUsage `protobuf:"bytes,4,rep,name=usage,proto3" json:"usage"`
+}
+
+func (m *QueryProjectUsageResponse) Reset()         { *m = QueryProjectUsageResponse{} }
+func (m *QueryProjectUsageResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProjectUsageResponse) ProtoMessage()    {}
+func (*QueryProjectUsageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bebeeb088f4d9d1b, []int{7}
+}
+func (m *QueryProjectUsageResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProjectUsageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProjectUsageResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProjectUsageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProjectUsageResponse.Merge(m, src)
+}
+func (m *QueryProjectUsageResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProjectUsageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProjectUsageResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProjectUsageResponse proto.InternalMessageInfo
+
+func (m *QueryProjectUsageResponse) GetTotalCu() uint64 {
+	if m != nil {
+		return m.TotalCu
+	}
+	return 0
+}
+
+func (m *QueryProjectUsageResponse) GetDeveloperKeys() []UsageSummary {
+	if m != nil {
+		return m.DeveloperKeys
+	}
+	return nil
+}
+
+func (m *QueryProjectUsageResponse) GetChains() []UsageSummary {
+	if m != nil {
+		return m.Chains
+	}
+	return nil
+}
+
+func (m *QueryProjectUsageResponse) GetUsage() []ProjectUsage {
+	if m != nil {
+		return m.Usage
+	}
+	return nil
+}
+
+type QueryDeveloperUsageRequest struct {
+	Developer string `protobuf:"bytes,1,opt,name=developer,proto3" json:"developer,omitempty"`
+	FromEpoch uint64 `protobuf:"varint,2,opt,name=from_epoch,json=fromEpoch,proto3" json:"from_epoch,omitempty"`
+	ToEpoch   uint64 `protobuf:"varint,3,opt,name=to_epoch,json=toEpoch,proto3" json:"to_epoch,omitempty"`
+}
+
+func (m *QueryDeveloperUsageRequest) Reset()         { *m = QueryDeveloperUsageRequest{} }
+func (m *QueryDeveloperUsageRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDeveloperUsageRequest) ProtoMessage()    {}
+func (*QueryDeveloperUsageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bebeeb088f4d9d1b, []int{8}
+}
+func (m *QueryDeveloperUsageRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDeveloperUsageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDeveloperUsageRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDeveloperUsageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDeveloperUsageRequest.Merge(m, src)
+}
+func (m *QueryDeveloperUsageRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDeveloperUsageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDeveloperUsageRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDeveloperUsageRequest proto.InternalMessageInfo
+
+func (m *QueryDeveloperUsageRequest) GetDeveloper() string {
+	if m != nil {
+		return m.Developer
+	}
+	return ""
+}
+
+func (m *QueryDeveloperUsageRequest) GetFromEpoch() uint64 {
+	if m != nil {
+		return m.FromEpoch
+	}
+	return 0
+}
+
+func (m *QueryDeveloperUsageRequest) GetToEpoch() uint64 {
+	if m != nil {
+		return m.ToEpoch
+	}
+	return 0
+}
+
+type QueryDeveloperUsageResponse struct {
+	TotalCu  uint64         `protobuf:"varint,1,opt,name=total_cu,json=totalCu,proto3" json:"total_cu,omitempty"`
+	Projects []UsageSummary `protobuf:"bytes,2,rep,name=projects,proto3" json:"projects"`
+	Chains   []UsageSummary `protobuf:"bytes,3,rep,name=chains,proto3" json:"chains"`
+	Usage    []ProjectUsage `protobuf:"bytes,4,rep,name=usage,proto3" json:"usage"`
+}
+
+func (m *QueryDeveloperUsageResponse) Reset()         { *m = QueryDeveloperUsageResponse{} }
+func (m *QueryDeveloperUsageResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDeveloperUsageResponse) ProtoMessage()    {}
+func (*QueryDeveloperUsageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bebeeb088f4d9d1b, []int{9}
+}
+func (m *QueryDeveloperUsageResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDeveloperUsageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDeveloperUsageResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDeveloperUsageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDeveloperUsageResponse.Merge(m, src)
+}
+func (m *QueryDeveloperUsageResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDeveloperUsageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDeveloperUsageResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDeveloperUsageResponse proto.InternalMessageInfo
+
+func (m *QueryDeveloperUsageResponse) GetTotalCu() uint64 {
+	if m != nil {
+		return m.TotalCu
+	}
+	return 0
+}
+
+func (m *QueryDeveloperUsageResponse) GetProjects() []UsageSummary {
+	if m != nil {
+		return m.Projects
+	}
+	return nil
+}
+
+func (m *QueryDeveloperUsageResponse) GetChains() []UsageSummary {
+	if m != nil {
+		return m.Chains
+	}
+	return nil
+}
+
+func (m *QueryDeveloperUsageResponse) GetUsage() []ProjectUsage {
+	if m != nil {
+		return m.Usage
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "lavanet.lava.projects.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "lavanet.lava.projects.QueryParamsResponse")
@@ -295,39 +551,59 @@ func init() {
 	proto.RegisterType((*QueryInfoResponse)(nil), "lavanet.lava.projects.QueryInfoResponse")
 	proto.RegisterType((*QueryDeveloperRequest)(nil), "lavanet.lava.projects.QueryDeveloperRequest")
 	proto.RegisterType((*QueryDeveloperResponse)(nil), "lavanet.lava.projects.QueryDeveloperResponse")
+	proto.RegisterType((*QueryProjectUsageRequest)(nil), "lavanet.lava.projects.QueryProjectUsageRequest")
+	proto.RegisterType((*QueryProjectUsageResponse)(nil), "lavanet.lava.projects.QueryProjectUsageResponse")
+	proto.RegisterType((*QueryDeveloperUsageRequest)(nil), "lavanet.lava.projects.QueryDeveloperUsageRequest")
+	proto.RegisterType((*QueryDeveloperUsageResponse)(nil), "lavanet.lava.projects.QueryDeveloperUsageResponse")
 }
 
 func init() { proto.RegisterFile("projects/query.proto", fileDescriptor_bebeeb088f4d9d1b) }
 
 var fileDescriptor_bebeeb088f4d9d1b = []byte{
-	// 432 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x29, 0x28, 0xca, 0xcf,
-	0x4a, 0x4d, 0x2e, 0x29, 0xd6, 0x2f, 0x2c, 0x4d, 0x2d, 0xaa, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9,
-	0x17, 0x12, 0xcd, 0x49, 0x2c, 0x4b, 0xcc, 0x4b, 0x2d, 0xd1, 0x03, 0xd1, 0x7a, 0x30, 0x25, 0x52,
-	0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0x15, 0xfa, 0x20, 0x16, 0x44, 0xb1, 0x94, 0x4c, 0x7a, 0x7e,
-	0x7e, 0x7a, 0x4e, 0xaa, 0x7e, 0x62, 0x41, 0xa6, 0x7e, 0x62, 0x5e, 0x5e, 0x7e, 0x49, 0x62, 0x49,
-	0x66, 0x7e, 0x5e, 0x31, 0x54, 0x56, 0x14, 0x6e, 0x41, 0x41, 0x62, 0x51, 0x62, 0x2e, 0x4c, 0x58,
-	0x0c, 0x21, 0x0c, 0x61, 0x40, 0xc4, 0x95, 0x44, 0xb8, 0x84, 0x02, 0x41, 0x0e, 0x09, 0x00, 0x2b,
-	0x0e, 0x4a, 0x2d, 0x2c, 0x4d, 0x2d, 0x2e, 0x51, 0x0a, 0xe2, 0x12, 0x46, 0x11, 0x2d, 0x2e, 0xc8,
-	0xcf, 0x2b, 0x4e, 0x15, 0xb2, 0xe6, 0x62, 0x83, 0x18, 0x2a, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0x6d,
-	0x24, 0xab, 0x87, 0xd5, 0xdd, 0x7a, 0x10, 0x6d, 0x4e, 0x2c, 0x27, 0xee, 0xc9, 0x33, 0x04, 0x41,
-	0xb5, 0x28, 0xe9, 0x70, 0x09, 0x80, 0xcd, 0xf4, 0xcc, 0x4b, 0xcb, 0x87, 0xda, 0x23, 0x24, 0xc1,
-	0xc5, 0x0e, 0xd5, 0x04, 0x36, 0x91, 0x33, 0x08, 0xc6, 0x55, 0xf2, 0xe5, 0x12, 0x44, 0x52, 0x0d,
-	0xb5, 0xdf, 0x02, 0x55, 0x39, 0xb7, 0x91, 0x1c, 0x2e, 0x07, 0x40, 0x18, 0x08, 0xe3, 0x4c, 0xb9,
-	0x44, 0xc1, 0xc6, 0xb9, 0xa4, 0x96, 0xa5, 0xe6, 0xe4, 0x17, 0xa4, 0x16, 0xc1, 0x5c, 0x20, 0xc3,
-	0xc5, 0x99, 0x02, 0x13, 0x83, 0xba, 0x01, 0x21, 0xa0, 0x14, 0xc4, 0x25, 0x86, 0xae, 0x8d, 0x52,
-	0xa7, 0x18, 0xed, 0x61, 0xe6, 0x62, 0x05, 0x1b, 0x2a, 0xd4, 0xc6, 0xc8, 0xc5, 0x06, 0x09, 0x2a,
-	0x21, 0x4d, 0x1c, 0xba, 0x31, 0xe3, 0x46, 0x4a, 0x8b, 0x18, 0xa5, 0x10, 0x57, 0x2a, 0xa9, 0x36,
-	0x5d, 0x7e, 0x32, 0x99, 0x49, 0x5e, 0x48, 0x56, 0x1f, 0xaa, 0x07, 0x4c, 0xeb, 0xa3, 0x25, 0x11,
-	0xa1, 0x0e, 0x46, 0x2e, 0x16, 0x50, 0x40, 0x0b, 0xa9, 0xe3, 0x33, 0x1b, 0x29, 0xe2, 0xa4, 0x34,
-	0x08, 0x2b, 0x84, 0x3a, 0x41, 0x17, 0xec, 0x04, 0x75, 0x21, 0x55, 0x1c, 0x4e, 0xc8, 0xcc, 0x4b,
-	0xcb, 0xd7, 0xaf, 0x86, 0x72, 0x6b, 0x85, 0xe6, 0x31, 0x72, 0x71, 0xc2, 0x43, 0x5b, 0x48, 0x07,
-	0x9f, 0x35, 0xe8, 0x71, 0x29, 0xa5, 0x4b, 0xa4, 0x6a, 0xa8, 0xcb, 0x4c, 0xc0, 0x2e, 0xd3, 0x13,
-	0xd2, 0xc1, 0xe1, 0x32, 0x78, 0x32, 0xd0, 0xaf, 0x86, 0x33, 0x6b, 0x9d, 0x9c, 0x4e, 0x3c, 0x92,
-	0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c,
-	0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x4a, 0x23, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f,
-	0x39, 0x3f, 0x17, 0xd5, 0xc4, 0x0a, 0x84, 0x99, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0xe0,
-	0xbc, 0x67, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0xe8, 0x54, 0xf0, 0xb9, 0x0d, 0x04, 0x00, 0x00,
+	// 684 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x55, 0xc1, 0x4f, 0x13, 0x4f,
+	0x14, 0xee, 0x94, 0x52, 0xe8, 0xe3, 0xf7, 0x23, 0x3a, 0x02, 0x59, 0x56, 0x58, 0xc8, 0x1a, 0x42,
+	0x35, 0xb0, 0x8b, 0x15, 0x8d, 0xd1, 0x83, 0x11, 0xe5, 0x60, 0x8c, 0x09, 0xd6, 0x78, 0xf1, 0xd2,
+	0x0c, 0x75, 0x58, 0xaa, 0xed, 0xce, 0xb2, 0x3b, 0x4b, 0x6c, 0x08, 0x17, 0x4d, 0x8c, 0x07, 0x0f,
+	0x26, 0xde, 0xb9, 0x7b, 0xf6, 0x9f, 0xe0, 0x48, 0xe2, 0xc5, 0x93, 0x31, 0xe0, 0x1f, 0x62, 0x76,
+	0x76, 0x76, 0xbb, 0x5b, 0xd8, 0xb6, 0x84, 0x8b, 0xa7, 0xce, 0xbc, 0x79, 0xdf, 0xf7, 0xbe, 0x79,
+	0xdf, 0xf4, 0x2d, 0x4c, 0x38, 0x2e, 0x7b, 0x43, 0xeb, 0xdc, 0x33, 0x77, 0x7c, 0xea, 0xb6, 0x0d,
+	0xc7, 0x65, 0x9c, 0xe1, 0xc9, 0x26, 0xd9, 0x25, 0x36, 0xe5, 0x46, 0xf0, 0x6b, 0x44, 0x29, 0xea,
+	0x84, 0xc5, 0x2c, 0x26, 0x32, 0xcc, 0x60, 0x15, 0x26, 0xab, 0x33, 0x16, 0x63, 0x56, 0x93, 0x9a,
+	0xc4, 0x69, 0x98, 0xc4, 0xb6, 0x19, 0x27, 0xbc, 0xc1, 0x6c, 0x4f, 0x9e, 0x4e, 0xc6, 0x05, 0x1c,
+	0xe2, 0x92, 0x56, 0x14, 0x9e, 0xea, 0x84, 0xc3, 0x45, 0x44, 0xd6, 0x1d, 0xaf, 0xf9, 0x1e, 0xb1,
+	0x68, 0x78, 0xaa, 0x4f, 0x00, 0x7e, 0x1e, 0xc8, 0xdc, 0x10, 0x54, 0x55, 0xba, 0xe3, 0x53, 0x8f,
+	0xeb, 0x55, 0xb8, 0x92, 0x8a, 0x7a, 0x0e, 0xb3, 0x3d, 0x8a, 0xef, 0x43, 0x31, 0x2c, 0xa9, 0xa0,
+	0x79, 0x54, 0x1e, 0xab, 0xcc, 0x1a, 0x67, 0xde, 0xca, 0x08, 0x61, 0x6b, 0x85, 0xc3, 0x5f, 0x73,
+	0xb9, 0xaa, 0x84, 0xe8, 0x4b, 0x70, 0x49, 0x70, 0x3e, 0xb1, 0xb7, 0x98, 0xac, 0x83, 0x15, 0x18,
+	0x91, 0x20, 0xc1, 0x58, 0xaa, 0x46, 0x5b, 0xfd, 0x19, 0x5c, 0x4e, 0x64, 0xcb, 0xfa, 0x77, 0xd3,
+	0xe9, 0x63, 0x15, 0x2d, 0x4b, 0x40, 0xb8, 0xe8, 0xd0, 0xdd, 0x86, 0x49, 0x41, 0xf7, 0x98, 0xee,
+	0xd2, 0x26, 0x73, 0xa8, 0x1b, 0x29, 0x98, 0x81, 0xd2, 0xeb, 0x28, 0x26, 0x35, 0x74, 0x02, 0x7a,
+	0x15, 0xa6, 0xba, 0x61, 0x17, 0x96, 0x62, 0x83, 0x12, 0xf6, 0x36, 0xdc, 0xbf, 0x0c, 0xcc, 0xe8,
+	0xdb, 0x0f, 0x3c, 0x0b, 0xb0, 0xe5, 0xb2, 0x56, 0x8d, 0x3a, 0xac, 0xbe, 0xad, 0xe4, 0xe7, 0x51,
+	0xb9, 0x50, 0x2d, 0x05, 0x91, 0xf5, 0x20, 0x80, 0xa7, 0x61, 0x94, 0x33, 0x79, 0x38, 0x24, 0x0e,
+	0x47, 0x38, 0x13, 0x47, 0xfa, 0xe7, 0x3c, 0x4c, 0x9f, 0x51, 0x50, 0xde, 0x43, 0x00, 0x39, 0x69,
+	0xd6, 0xea, 0xbe, 0x82, 0x22, 0x20, 0x27, 0xcd, 0x47, 0x3e, 0xde, 0x80, 0xf1, 0xb8, 0x13, 0xb5,
+	0xb7, 0xb4, 0xed, 0x29, 0xf9, 0xf9, 0xa1, 0xf2, 0x58, 0xe5, 0x5a, 0xc6, 0x4d, 0x05, 0xf1, 0x0b,
+	0xbf, 0xd5, 0x22, 0x6e, 0x5b, 0x7a, 0xff, 0x7f, 0x4c, 0xf0, 0x94, 0xb6, 0x3d, 0xfc, 0x10, 0x8a,
+	0xf5, 0x6d, 0xd2, 0xb0, 0x3d, 0x65, 0xe8, 0xbc, 0x4c, 0x12, 0x88, 0x1f, 0xc0, 0xb0, 0x78, 0xbe,
+	0x4a, 0xa1, 0x27, 0x43, 0xf2, 0xae, 0x92, 0x21, 0xc4, 0xe9, 0x1c, 0xd4, 0xb4, 0xa5, 0x29, 0x03,
+	0x7a, 0x3e, 0x87, 0x0b, 0x98, 0xf0, 0x21, 0x0f, 0x57, 0xcf, 0x2c, 0xdb, 0xdf, 0x86, 0x75, 0x18,
+	0x8d, 0xae, 0x75, 0x7e, 0x03, 0x62, 0xe8, 0xbf, 0xd0, 0xfb, 0xca, 0x41, 0x11, 0x86, 0x45, 0x17,
+	0xf0, 0x47, 0x04, 0xc5, 0x70, 0x4a, 0xe0, 0xeb, 0x19, 0x34, 0xa7, 0xc7, 0x92, 0x7a, 0x63, 0x90,
+	0xd4, 0xb0, 0xa3, 0xfa, 0xc2, 0xfb, 0x1f, 0x7f, 0xbe, 0xe6, 0xe7, 0xf0, 0xac, 0x29, 0x31, 0xe2,
+	0xd7, 0xec, 0x9a, 0x9d, 0xf8, 0x13, 0x82, 0x42, 0x30, 0x63, 0xf0, 0x62, 0x2f, 0xee, 0xc4, 0xcc,
+	0x52, 0xcb, 0xfd, 0x13, 0xa5, 0x84, 0x65, 0x21, 0x61, 0x11, 0x2f, 0x64, 0x48, 0x68, 0xd8, 0x5b,
+	0xcc, 0xdc, 0x93, 0xdb, 0x7d, 0x7c, 0x80, 0xa0, 0x14, 0x3f, 0x0f, 0xbc, 0xd4, 0xab, 0x4c, 0xf7,
+	0x18, 0x53, 0x97, 0x07, 0xcc, 0x96, 0xca, 0x56, 0x85, 0x32, 0x03, 0x2f, 0x65, 0x28, 0x8b, 0x9f,
+	0xbc, 0xb9, 0x17, 0x2f, 0xf7, 0xf1, 0x37, 0x04, 0xff, 0x25, 0xcd, 0xc5, 0x66, 0x4f, 0x3f, 0x4e,
+	0xcf, 0x37, 0x75, 0x65, 0x70, 0x80, 0x54, 0x7a, 0x47, 0x28, 0x5d, 0xc1, 0x46, 0x96, 0x8d, 0xc9,
+	0x6f, 0x5a, 0xa2, 0x99, 0xdf, 0x11, 0x8c, 0xa7, 0xff, 0x6b, 0xf8, 0xe6, 0x40, 0x3d, 0x4a, 0xe9,
+	0xad, 0x9c, 0x07, 0x22, 0x15, 0xdf, 0x13, 0x8a, 0x57, 0x71, 0xa5, 0x5f, 0x6f, 0x23, 0xcd, 0x9d,
+	0x0e, 0xaf, 0xad, 0x1d, 0x1e, 0x6b, 0xe8, 0xe8, 0x58, 0x43, 0xbf, 0x8f, 0x35, 0xf4, 0xe5, 0x44,
+	0xcb, 0x1d, 0x9d, 0x68, 0xb9, 0x9f, 0x27, 0x5a, 0xee, 0x55, 0xd9, 0x6a, 0xf0, 0x6d, 0x7f, 0xd3,
+	0xa8, 0xb3, 0x56, 0x9a, 0xf7, 0x5d, 0x87, 0x99, 0xb7, 0x1d, 0xea, 0x6d, 0x16, 0xc5, 0x87, 0xfd,
+	0xd6, 0xdf, 0x01, 0x00, 0x71, 0x66, 0x39, 0x91, 0x88, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -348,6 +624,10 @@ type QueryClient interface {
 	Info(ctx context.Context, in *QueryInfoRequest, opts ...grpc.CallOption) (*QueryInfoResponse, error)
 	// Queries a list of ShowDevelopersProject items.
 	Developer(ctx context.Context, in *QueryDeveloperRequest, opts ...grpc.CallOption) (*QueryDeveloperResponse, error)
+	// Queries the cu used by a project in an epoch range, by developer key and by chain.
+	ProjectUsage(ctx context.Context, in *QueryProjectUsageRequest, opts ...grpc.CallOption) (*QueryProjectUsageResponse, error)
+	// Queries the cu used by a developer key in an epoch range, by project and by chain.
+	DeveloperUsage(ctx context.Context, in *QueryDeveloperUsageRequest, opts ...grpc.CallOption) (*QueryDeveloperUsageResponse, error)
 }
 
 type queryClient struct {
@@ -385,6 +665,24 @@ func (c *queryClient) Developer(ctx context.Context, in *QueryDeveloperRequest,
 	return out, nil
 }
 
+func (c *queryClient) ProjectUsage(ctx context.Context, in *QueryProjectUsageRequest, opts ...grpc.CallOption) (*QueryProjectUsageResponse, error) {
+	out := new(QueryProjectUsageResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.projects.Query/ProjectUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DeveloperUsage(ctx context.Context, in *QueryDeveloperUsageRequest, opts ...grpc.CallOption) (*QueryDeveloperUsageResponse, error) {
+	out := new(QueryDeveloperUsageResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.projects.Query/DeveloperUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
@@ -393,6 +691,10 @@ type QueryServer interface {
 	Info(context.Context, *QueryInfoRequest) (*QueryInfoResponse, error)
 	// Queries a list of ShowDevelopersProject items.
 	Developer(context.Context, *QueryDeveloperRequest) (*QueryDeveloperResponse, error)
+	// Queries the cu used by a project in an epoch range, by developer key and by chain.
+	ProjectUsage(context.Context, *QueryProjectUsageRequest) (*QueryProjectUsageResponse, error)
+	// Queries the cu used by a developer key in an epoch range, by project and by chain.
+	DeveloperUsage(context.Context, *QueryDeveloperUsageRequest) (*QueryDeveloperUsageResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -408,6 +710,12 @@ func (*UnimplementedQueryServer) Info(ctx context.Context, req *QueryInfoRequest
 func (*UnimplementedQueryServer) Developer(ctx context.Context, req *QueryDeveloperRequest) (*QueryDeveloperResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Developer not implemented")
 }
+func (*UnimplementedQueryServer) ProjectUsage(ctx context.Context, req *QueryProjectUsageRequest) (*QueryProjectUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProjectUsage not implemented")
+}
+func (*UnimplementedQueryServer) DeveloperUsage(ctx context.Context, req *QueryDeveloperUsageRequest) (*QueryDeveloperUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeveloperUsage not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -467,6 +775,42 @@ func _Query_Developer_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ProjectUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProjectUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProjectUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.projects.Query/ProjectUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProjectUsage(ctx, req.(*QueryProjectUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DeveloperUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDeveloperUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DeveloperUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.projects.Query/DeveloperUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DeveloperUsage(ctx, req.(*QueryDeveloperUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "lavanet.lava.projects.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -483,6 +827,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Developer",
 			Handler:    _Query_Developer_Handler,
 		},
+		{
+			MethodName: "ProjectUsage",
+			Handler:    _Query_ProjectUsage_Handler,
+		},
+		{
+			MethodName: "DeveloperUsage",
+			Handler:    _Query_DeveloperUsage_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "projects/query.proto",
@@ -674,56 +1026,276 @@ func (m *QueryDeveloperResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryProjectUsageRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryProjectUsageRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryInfoRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryProjectUsageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Project)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.ToEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ToEpoch))
+		i--
+		dAtA[i] = 0x18
 	}
-	return n
+	if m.FromEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FromEpoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Project) > 0 {
+		i -= len(m.Project)
+		copy(dAtA[i:], m.Project)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Project)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
+func (m *QueryProjectUsageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProjectUsageResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProjectUsageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Usage) > 0 {
+		for iNdEx := len(m.Usage) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Usage[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Chains) > 0 {
+		for iNdEx := len(m.Chains) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Chains[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.DeveloperKeys) > 0 {
+		for iNdEx := len(m.DeveloperKeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DeveloperKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.TotalCu != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalCu))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDeveloperUsageRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDeveloperUsageRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDeveloperUsageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ToEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ToEpoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.FromEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FromEpoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Developer) > 0 {
+		i -= len(m.Developer)
+		copy(dAtA[i:], m.Developer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Developer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDeveloperUsageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDeveloperUsageResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDeveloperUsageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Usage) > 0 {
+		for iNdEx := len(m.Usage) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Usage[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Chains) > 0 {
+		for iNdEx := len(m.Chains) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Chains[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Projects) > 0 {
+		for iNdEx := len(m.Projects) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Projects[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.TotalCu != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalCu))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Project)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
 	if m.Project != nil {
 		l = m.Project.Size()
 		n += 1 + l + sovQuery(uint64(l))
@@ -757,6 +1329,104 @@ func (m *QueryDeveloperResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryProjectUsageRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Project)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.FromEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.FromEpoch))
+	}
+	if m.ToEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.ToEpoch))
+	}
+	return n
+}
+
+func (m *QueryProjectUsageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TotalCu != 0 {
+		n += 1 + sovQuery(uint64(m.TotalCu))
+	}
+	if len(m.DeveloperKeys) > 0 {
+		for _, e := range m.DeveloperKeys {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Chains) > 0 {
+		for _, e := range m.Chains {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Usage) > 0 {
+		for _, e := range m.Usage {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryDeveloperUsageRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Developer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.FromEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.FromEpoch))
+	}
+	if m.ToEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.ToEpoch))
+	}
+	return n
+}
+
+func (m *QueryDeveloperUsageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TotalCu != 0 {
+		n += 1 + sovQuery(uint64(m.TotalCu))
+	}
+	if len(m.Projects) > 0 {
+		for _, e := range m.Projects {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Chains) > 0 {
+		for _, e := range m.Chains {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Usage) > 0 {
+		for _, e := range m.Usage {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -813,7 +1483,340 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInfoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Project = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Project == nil {
+				m.Project = &Project{}
+			}
+			if err := m.Project.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDeveloperRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDeveloperRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDeveloperRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Developer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Developer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDeveloperResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -836,15 +1839,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDeveloperResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDeveloperResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -871,7 +1874,10 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Project == nil {
+				m.Project = &Project{}
+			}
+			if err := m.Project.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -896,7 +1902,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryInfoRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryProjectUsageRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -919,10 +1925,10 @@ func (m *QueryInfoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryInfoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProjectUsageRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProjectUsageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -957,6 +1963,44 @@ func (m *QueryInfoRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Project = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromEpoch", wireType)
+			}
+			m.FromEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToEpoch", wireType)
+			}
+			m.ToEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -978,7 +2022,7 @@ func (m *QueryInfoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryProjectUsageResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1001,15 +2045,34 @@ func (m *QueryInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProjectUsageResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProjectUsageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalCu", wireType)
+			}
+			m.TotalCu = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalCu |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeveloperKeys", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1036,10 +2099,76 @@ func (m *QueryInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Project == nil {
-				m.Project = &Project{}
+			m.DeveloperKeys = append(m.DeveloperKeys, UsageSummary{})
+			if err := m.DeveloperKeys[len(m.DeveloperKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Project.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chains", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chains = append(m.Chains, UsageSummary{})
+			if err := m.Chains[len(m.Chains)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Usage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Usage = append(m.Usage, ProjectUsage{})
+			if err := m.Usage[len(m.Usage)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1064,7 +2193,7 @@ func (m *QueryInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDeveloperRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDeveloperUsageRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1087,10 +2216,10 @@ func (m *QueryDeveloperRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDeveloperRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDeveloperUsageRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDeveloperRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDeveloperUsageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1125,6 +2254,44 @@ func (m *QueryDeveloperRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Developer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromEpoch", wireType)
+			}
+			m.FromEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToEpoch", wireType)
+			}
+			m.ToEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1146,7 +2313,7 @@ func (m *QueryDeveloperRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDeveloperResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDeveloperUsageResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1169,15 +2336,34 @@ func (m *QueryDeveloperResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDeveloperResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDeveloperUsageResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDeveloperResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDeveloperUsageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalCu", wireType)
+			}
+			m.TotalCu = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalCu |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Projects", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1204,10 +2390,76 @@ func (m *QueryDeveloperResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Project == nil {
-				m.Project = &Project{}
+			m.Projects = append(m.Projects, UsageSummary{})
+			if err := m.Projects[len(m.Projects)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Project.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chains", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chains = append(m.Chains, UsageSummary{})
+			if err := m.Chains[len(m.Chains)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Usage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Usage = append(m.Usage, ProjectUsage{})
+			if err := m.Usage[len(m.Usage)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
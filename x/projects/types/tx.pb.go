@@ -316,6 +316,313 @@ func (m *MsgSetSubscriptionPolicyResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSetSubscriptionPolicyResponse proto.InternalMessageInfo
 
+// replaces a key of a project with a new key of the same types from the next epoch
+type MsgRotateProjectKey struct {
+	Creator     string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Project     string `protobuf:"bytes,2,opt,name=project,proto3" json:"project,omitempty"`
+	OldKey      string `protobuf:"bytes,3,opt,name=old_key,json=oldKey,proto3" json:"old_key,omitempty"`
+	NewKey      string `protobuf:"bytes,4,opt,name=new_key,json=newKey,proto3" json:"new_key,omitempty"`
+	NewKeyVrfpk string `protobuf:"bytes,5,opt,name=new_key_vrfpk,json=newKeyVrfpk,proto3" json:"new_key_vrfpk,omitempty"`
+}
+
+func (m *MsgRotateProjectKey) Reset()         { *m = MsgRotateProjectKey{} }
+func (m *MsgRotateProjectKey) String() string { return proto.CompactTextString(m) }
+func (*MsgRotateProjectKey) ProtoMessage()    {}
+func (*MsgRotateProjectKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b5dcbe7dfba713c0, []int{6}
+}
+func (m *MsgRotateProjectKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRotateProjectKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRotateProjectKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRotateProjectKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRotateProjectKey.Merge(m, src)
+}
+func (m *MsgRotateProjectKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRotateProjectKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRotateProjectKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRotateProjectKey proto.InternalMessageInfo
+
+func (m *MsgRotateProjectKey) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgRotateProjectKey) GetProject() string {
+	if m != nil {
+		return m.Project
+	}
+	return ""
+}
+
+func (m *MsgRotateProjectKey) GetOldKey() string {
+	if m != nil {
+		return m.OldKey
+	}
+	return ""
+}
+
+func (m *MsgRotateProjectKey) GetNewKey() string {
+	if m != nil {
+		return m.NewKey
+	}
+	return ""
+}
+
+func (m *MsgRotateProjectKey) GetNewKeyVrfpk() string {
+	if m != nil {
+		return m.NewKeyVrfpk
+	}
+	return ""
+}
+
+type MsgRotateProjectKeyResponse struct {
+}
+
+func (m *MsgRotateProjectKeyResponse) Reset()         { *m = MsgRotateProjectKeyResponse{} }
+func (m *MsgRotateProjectKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRotateProjectKeyResponse) ProtoMessage()    {}
+func (*MsgRotateProjectKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b5dcbe7dfba713c0, []int{7}
+}
+func (m *MsgRotateProjectKeyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRotateProjectKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRotateProjectKeyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRotateProjectKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRotateProjectKeyResponse.Merge(m, src)
+}
+func (m *MsgRotateProjectKeyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRotateProjectKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRotateProjectKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRotateProjectKeyResponse proto.InternalMessageInfo
+
+// removes the given types from keys of a project, a key left without types is removed from the project
+type MsgDelKeysFromProject struct {
+	Creator     string       `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Project     string       `protobuf:"bytes,2,opt,name=project,proto3" json:"project,omitempty"`
+	ProjectKeys []ProjectKey `protobuf:"bytes,3,rep,name=project_keys,json=projectKeys,proto3" json:"project_keys"`
+}
+
+func (m *MsgDelKeysFromProject) Reset()         { *m = MsgDelKeysFromProject{} }
+func (m *MsgDelKeysFromProject) String() string { return proto.CompactTextString(m) }
+func (*MsgDelKeysFromProject) ProtoMessage()    {}
+func (*MsgDelKeysFromProject) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b5dcbe7dfba713c0, []int{8}
+}
+func (m *MsgDelKeysFromProject) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDelKeysFromProject) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDelKeysFromProject.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDelKeysFromProject) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDelKeysFromProject.Merge(m, src)
+}
+func (m *MsgDelKeysFromProject) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDelKeysFromProject) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDelKeysFromProject.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDelKeysFromProject proto.InternalMessageInfo
+
+func (m *MsgDelKeysFromProject) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgDelKeysFromProject) GetProject() string {
+	if m != nil {
+		return m.Project
+	}
+	return ""
+}
+
+func (m *MsgDelKeysFromProject) GetProjectKeys() []ProjectKey {
+	if m != nil {
+		return m.ProjectKeys
+	}
+	return nil
+}
+
+type MsgDelKeysFromProjectResponse struct {
+}
+
+func (m *MsgDelKeysFromProjectResponse) Reset()         { *m = MsgDelKeysFromProjectResponse{} }
+func (m *MsgDelKeysFromProjectResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDelKeysFromProjectResponse) ProtoMessage()    {}
+func (*MsgDelKeysFromProjectResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b5dcbe7dfba713c0, []int{9}
+}
+func (m *MsgDelKeysFromProjectResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDelKeysFromProjectResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDelKeysFromProjectResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDelKeysFromProjectResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDelKeysFromProjectResponse.Merge(m, src)
+}
+func (m *MsgDelKeysFromProjectResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDelKeysFromProjectResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDelKeysFromProjectResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDelKeysFromProjectResponse proto.InternalMessageInfo
+
+// pauses or resumes a project from the next epoch, the keys of a paused project aren't paired
+type MsgSetProjectState struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Project string `protobuf:"bytes,2,opt,name=project,proto3" json:"project,omitempty"`
+	Enabled bool   `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (m *MsgSetProjectState) Reset()         { *m = MsgSetProjectState{} }
+func (m *MsgSetProjectState) String() string { return proto.CompactTextString(m) }
+func (*MsgSetProjectState) ProtoMessage()    {}
+func (*MsgSetProjectState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b5dcbe7dfba713c0, []int{10}
+}
+func (m *MsgSetProjectState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetProjectState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetProjectState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetProjectState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetProjectState.Merge(m, src)
+}
+func (m *MsgSetProjectState) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetProjectState) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetProjectState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetProjectState proto.InternalMessageInfo
+
+func (m *MsgSetProjectState) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgSetProjectState) GetProject() string {
+	if m != nil {
+		return m.Project
+	}
+	return ""
+}
+
+func (m *MsgSetProjectState) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+type MsgSetProjectStateResponse struct {
+}
+
+func (m *MsgSetProjectStateResponse) Reset()         { *m = MsgSetProjectStateResponse{} }
+func (m *MsgSetProjectStateResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetProjectStateResponse) ProtoMessage()    {}
+func (*MsgSetProjectStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b5dcbe7dfba713c0, []int{11}
+}
+func (m *MsgSetProjectStateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetProjectStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetProjectStateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetProjectStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetProjectStateResponse.Merge(m, src)
+}
+func (m *MsgSetProjectStateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetProjectStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetProjectStateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetProjectStateResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgAddProjectKeys)(nil), "lavanet.lava.projects.MsgAddProjectKeys")
 	proto.RegisterType((*MsgAddProjectKeysResponse)(nil), "lavanet.lava.projects.MsgAddProjectKeysResponse")
@@ -323,37 +630,55 @@ func init() {
 	proto.RegisterType((*MsgSetAdminPolicyResponse)(nil), "lavanet.lava.projects.MsgSetAdminPolicyResponse")
 	proto.RegisterType((*MsgSetSubscriptionPolicy)(nil), "lavanet.lava.projects.MsgSetSubscriptionPolicy")
 	proto.RegisterType((*MsgSetSubscriptionPolicyResponse)(nil), "lavanet.lava.projects.MsgSetSubscriptionPolicyResponse")
+	proto.RegisterType((*MsgRotateProjectKey)(nil), "lavanet.lava.projects.MsgRotateProjectKey")
+	proto.RegisterType((*MsgRotateProjectKeyResponse)(nil), "lavanet.lava.projects.MsgRotateProjectKeyResponse")
+	proto.RegisterType((*MsgDelKeysFromProject)(nil), "lavanet.lava.projects.MsgDelKeysFromProject")
+	proto.RegisterType((*MsgDelKeysFromProjectResponse)(nil), "lavanet.lava.projects.MsgDelKeysFromProjectResponse")
+	proto.RegisterType((*MsgSetProjectState)(nil), "lavanet.lava.projects.MsgSetProjectState")
+	proto.RegisterType((*MsgSetProjectStateResponse)(nil), "lavanet.lava.projects.MsgSetProjectStateResponse")
 }
 
 func init() { proto.RegisterFile("projects/tx.proto", fileDescriptor_b5dcbe7dfba713c0) }
 
 var fileDescriptor_b5dcbe7dfba713c0 = []byte{
-	// 391 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x2c, 0x28, 0xca, 0xcf,
-	0x4a, 0x4d, 0x2e, 0x29, 0xd6, 0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0xcd,
-	0x49, 0x2c, 0x4b, 0xcc, 0x4b, 0x2d, 0xd1, 0x03, 0xd1, 0x7a, 0x30, 0x79, 0x29, 0x31, 0xb8, 0x4a,
-	0x28, 0x03, 0xa2, 0x5c, 0x4a, 0x24, 0x3d, 0x3f, 0x3d, 0x1f, 0xcc, 0xd4, 0x07, 0xb1, 0x20, 0xa2,
-	0x4a, 0x93, 0x19, 0xb9, 0x04, 0x7d, 0x8b, 0xd3, 0x1d, 0x53, 0x52, 0x02, 0x20, 0xaa, 0xbd, 0x53,
-	0x2b, 0x8b, 0x85, 0x24, 0xb8, 0xd8, 0x93, 0x8b, 0x52, 0x13, 0x4b, 0xf2, 0x8b, 0x24, 0x18, 0x15,
-	0x18, 0x35, 0x38, 0x83, 0x60, 0x5c, 0x90, 0x0c, 0xd4, 0x58, 0x09, 0x26, 0x88, 0x0c, 0x94, 0x2b,
-	0xe4, 0xc5, 0xc5, 0x03, 0x65, 0xc6, 0x67, 0xa7, 0x56, 0x16, 0x4b, 0x30, 0x2b, 0x30, 0x6b, 0x70,
-	0x1b, 0x29, 0xea, 0x61, 0x75, 0xa5, 0x1e, 0xc2, 0x36, 0x27, 0x96, 0x13, 0xf7, 0xe4, 0x19, 0x82,
-	0xb8, 0x0b, 0x10, 0xf6, 0x2b, 0x49, 0x73, 0x49, 0x62, 0x38, 0x2a, 0x28, 0xb5, 0xb8, 0x20, 0x3f,
-	0xaf, 0x38, 0x55, 0xa9, 0x05, 0xe2, 0xe4, 0xe0, 0xd4, 0x12, 0xc7, 0x94, 0xdc, 0xcc, 0xbc, 0x80,
-	0xfc, 0x9c, 0xcc, 0xe4, 0x4a, 0xb2, 0x9c, 0x6c, 0xcd, 0xc5, 0x56, 0x00, 0xd6, 0x2d, 0xc1, 0xac,
-	0xc0, 0xa8, 0xc1, 0x6d, 0x24, 0x8b, 0xcb, 0xb1, 0x60, 0x45, 0x50, 0x87, 0x42, 0xb5, 0x40, 0xdd,
-	0x88, 0xea, 0x0a, 0xb8, 0x1b, 0x7b, 0x19, 0xb9, 0x24, 0x20, 0xb2, 0xc1, 0xa5, 0x49, 0xc5, 0xc9,
-	0x45, 0x99, 0x05, 0x25, 0x99, 0xf9, 0x84, 0x9d, 0x2a, 0xc5, 0xc5, 0x01, 0xb3, 0x54, 0x82, 0x49,
-	0x81, 0x59, 0x83, 0x33, 0x08, 0xce, 0xa7, 0xcc, 0xb1, 0x4a, 0x5c, 0x0a, 0xb8, 0x9c, 0x03, 0x73,
-	0xb3, 0xd1, 0x53, 0x26, 0x2e, 0x66, 0xdf, 0xe2, 0x74, 0xa1, 0x1c, 0x2e, 0x3e, 0xb4, 0xe4, 0xa0,
-	0x81, 0xc3, 0x2a, 0x8c, 0x38, 0x92, 0x32, 0x20, 0x56, 0x25, 0xcc, 0x56, 0x90, 0x6d, 0x68, 0x31,
-	0x89, 0xc7, 0x36, 0x54, 0x95, 0xf8, 0x6c, 0xc3, 0x1e, 0x2f, 0x42, 0x8d, 0x8c, 0x5c, 0xa2, 0xd8,
-	0x23, 0x45, 0x1f, 0xaf, 0x59, 0x98, 0x1a, 0xa4, 0xcc, 0x49, 0xd4, 0x00, 0x73, 0x83, 0x93, 0xd3,
-	0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c,
-	0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0x69, 0xa4, 0x67, 0x96, 0x64, 0x94,
-	0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea, 0x43, 0x0d, 0x07, 0xd3, 0xfa, 0x15, 0xfa, 0x88, 0xec, 0x5f,
-	0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06, 0xce, 0xbd, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x1b,
-	0xf8, 0x81, 0x0b, 0x17, 0x04, 0x00, 0x00,
+	// 577 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x95, 0x3f, 0x6f, 0xd3, 0x40,
+	0x18, 0xc6, 0x73, 0x4d, 0x9b, 0x34, 0x6f, 0xf8, 0x57, 0x43, 0xa8, 0x71, 0x89, 0x1b, 0x3c, 0x19,
+	0x84, 0xec, 0x12, 0x90, 0x18, 0x98, 0x1a, 0x21, 0x06, 0x50, 0xa4, 0xca, 0x91, 0x18, 0x58, 0xaa,
+	0x24, 0x3e, 0x4c, 0xa8, 0xe3, 0xb3, 0x7c, 0xd7, 0x36, 0x19, 0x91, 0x58, 0x91, 0x90, 0x18, 0x18,
+	0xf9, 0x32, 0x0c, 0x1d, 0x3b, 0x32, 0x21, 0x94, 0x7c, 0x11, 0x74, 0xf6, 0xf9, 0x42, 0xe3, 0x24,
+	0xa4, 0x61, 0x60, 0xf2, 0x9d, 0x9f, 0xe7, 0xde, 0xe7, 0x77, 0x97, 0x7b, 0x63, 0xd8, 0x0a, 0x23,
+	0xf2, 0x1e, 0x77, 0x19, 0xb5, 0xd9, 0xc0, 0x0a, 0x23, 0xc2, 0x88, 0x52, 0xf1, 0xdb, 0x27, 0xed,
+	0x00, 0x33, 0x8b, 0x3f, 0xad, 0x54, 0xd7, 0x6e, 0x4b, 0xa7, 0x18, 0x24, 0x76, 0xed, 0x96, 0x47,
+	0x3c, 0x12, 0x0f, 0x6d, 0x3e, 0x4a, 0xde, 0x1a, 0x5f, 0x10, 0x6c, 0x35, 0xa9, 0xb7, 0xef, 0xba,
+	0x07, 0x89, 0xfb, 0x15, 0x1e, 0x52, 0x45, 0x85, 0x62, 0x37, 0xc2, 0x6d, 0x46, 0x22, 0x15, 0xd5,
+	0x90, 0x59, 0x72, 0xd2, 0x29, 0x57, 0x44, 0x59, 0x75, 0x2d, 0x51, 0xc4, 0x54, 0x79, 0x09, 0x57,
+	0xc4, 0xf0, 0xf0, 0x08, 0x0f, 0xa9, 0x9a, 0xaf, 0xe5, 0xcd, 0x72, 0xfd, 0x9e, 0x35, 0x93, 0xd2,
+	0x9a, 0xa4, 0x35, 0xd6, 0xcf, 0x7e, 0xee, 0xe6, 0x9c, 0x72, 0x38, 0xc9, 0x37, 0x76, 0xe0, 0x4e,
+	0x06, 0xca, 0xc1, 0x34, 0x24, 0x01, 0xc5, 0xc6, 0xc7, 0x04, 0xb9, 0x85, 0xd9, 0xbe, 0xdb, 0xef,
+	0x05, 0x07, 0xc4, 0xef, 0x75, 0x87, 0x2b, 0x21, 0x3f, 0x83, 0x42, 0x18, 0xaf, 0x56, 0xf3, 0x35,
+	0x64, 0x96, 0xeb, 0xd5, 0x79, 0xb0, 0xb1, 0x49, 0x80, 0x8a, 0x25, 0x82, 0xf1, 0x22, 0x85, 0x64,
+	0xfc, 0x84, 0x40, 0x4d, 0xd4, 0xd6, 0x71, 0x87, 0x76, 0xa3, 0x5e, 0xc8, 0x7a, 0xe4, 0xef, 0xa8,
+	0x1a, 0x6c, 0xa6, 0xa1, 0xea, 0x5a, 0x2d, 0x6f, 0x96, 0x1c, 0x39, 0xff, 0x37, 0x58, 0x03, 0x6a,
+	0xf3, 0x70, 0x24, 0xf3, 0x37, 0x04, 0x37, 0x9b, 0xd4, 0x73, 0x08, 0x6b, 0x33, 0x3c, 0x39, 0xf8,
+	0x95, 0x4e, 0x76, 0x1b, 0x8a, 0xc4, 0x77, 0xf9, 0x45, 0x88, 0x69, 0x4b, 0x4e, 0x81, 0xf8, 0x2e,
+	0x2f, 0xb6, 0x0d, 0xc5, 0x00, 0x9f, 0xc6, 0xc2, 0x7a, 0x22, 0x04, 0xf8, 0x94, 0x0b, 0x06, 0x5c,
+	0x15, 0xc2, 0xe1, 0x49, 0xf4, 0x36, 0x3c, 0x52, 0x37, 0x62, 0xb9, 0x9c, 0xc8, 0xaf, 0xf9, 0x2b,
+	0xa3, 0x0a, 0x3b, 0x33, 0x00, 0xe5, 0x06, 0xbe, 0x22, 0xa8, 0x34, 0xa9, 0xf7, 0x1c, 0xfb, 0xfc,
+	0xbe, 0xbc, 0x88, 0x48, 0x5f, 0x98, 0xfe, 0xfb, 0x7d, 0xde, 0x85, 0xea, 0x4c, 0x30, 0x89, 0xde,
+	0x01, 0x25, 0xf9, 0x7d, 0x84, 0xd0, 0xe2, 0x5b, 0x5c, 0x09, 0x5b, 0x85, 0x22, 0x0e, 0xda, 0x1d,
+	0x1f, 0xbb, 0xf1, 0xc9, 0x6f, 0x3a, 0xe9, 0xd4, 0xb8, 0x0b, 0x5a, 0x36, 0x23, 0x25, 0xa8, 0x7f,
+	0xdf, 0x80, 0x7c, 0x93, 0x7a, 0x8a, 0x0f, 0xd7, 0xa6, 0xfe, 0x0c, 0xcc, 0x39, 0x5b, 0xce, 0x74,
+	0xa8, 0xb6, 0xb7, 0xac, 0x33, 0x4d, 0xe5, 0x69, 0x53, 0x7d, 0xbc, 0x20, 0xed, 0xa2, 0x53, 0xdb,
+	0x5b, 0xd6, 0x29, 0xd3, 0x3e, 0x20, 0xa8, 0xcc, 0x6e, 0x49, 0x7b, 0x61, 0xad, 0xec, 0x02, 0xed,
+	0xe9, 0x25, 0x17, 0x48, 0x86, 0x08, 0x6e, 0x64, 0x3a, 0xec, 0xc1, 0xfc, 0x62, 0xd3, 0x5e, 0xad,
+	0xbe, 0xbc, 0x57, 0x66, 0x0e, 0x40, 0x99, 0xd1, 0x14, 0x0f, 0xe7, 0x57, 0xca, 0xba, 0xb5, 0x27,
+	0x97, 0x71, 0xcb, 0x64, 0x02, 0xd7, 0xa7, 0x2f, 0xf5, 0xfd, 0x85, 0x27, 0xf7, 0xa7, 0x55, 0x7b,
+	0xb4, 0xb4, 0x35, 0x0d, 0x6c, 0x34, 0xce, 0x46, 0x3a, 0x3a, 0x1f, 0xe9, 0xe8, 0xd7, 0x48, 0x47,
+	0x9f, 0xc7, 0x7a, 0xee, 0x7c, 0xac, 0xe7, 0x7e, 0x8c, 0xf5, 0xdc, 0x1b, 0xd3, 0xeb, 0xb1, 0x77,
+	0xc7, 0x1d, 0xab, 0x4b, 0xfa, 0xb6, 0x28, 0x1b, 0x3f, 0xed, 0x81, 0x3d, 0xf9, 0xb6, 0x0e, 0x43,
+	0x4c, 0x3b, 0x85, 0xf8, 0xd3, 0xf8, 0xf8, 0xf7, 0x00, 0x92, 0x08, 0x1a, 0x3f, 0x74, 0x07, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -371,6 +696,9 @@ type MsgClient interface {
 	AddProjectKeys(ctx context.Context, in *MsgAddProjectKeys, opts ...grpc.CallOption) (*MsgAddProjectKeysResponse, error)
 	SetAdminPolicy(ctx context.Context, in *MsgSetAdminPolicy, opts ...grpc.CallOption) (*MsgSetAdminPolicyResponse, error)
 	SetSubscriptionPolicy(ctx context.Context, in *MsgSetSubscriptionPolicy, opts ...grpc.CallOption) (*MsgSetSubscriptionPolicyResponse, error)
+	RotateProjectKey(ctx context.Context, in *MsgRotateProjectKey, opts ...grpc.CallOption) (*MsgRotateProjectKeyResponse, error)
+	DelKeysFromProject(ctx context.Context, in *MsgDelKeysFromProject, opts ...grpc.CallOption) (*MsgDelKeysFromProjectResponse, error)
+	SetProjectState(ctx context.Context, in *MsgSetProjectState, opts ...grpc.CallOption) (*MsgSetProjectStateResponse, error)
 }
 
 type msgClient struct {
@@ -408,11 +736,41 @@ func (c *msgClient) SetSubscriptionPolicy(ctx context.Context, in *MsgSetSubscri
 	return out, nil
 }
 
+func (c *msgClient) RotateProjectKey(ctx context.Context, in *MsgRotateProjectKey, opts ...grpc.CallOption) (*MsgRotateProjectKeyResponse, error) {
+	out := new(MsgRotateProjectKeyResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.projects.Msg/RotateProjectKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DelKeysFromProject(ctx context.Context, in *MsgDelKeysFromProject, opts ...grpc.CallOption) (*MsgDelKeysFromProjectResponse, error) {
+	out := new(MsgDelKeysFromProjectResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.projects.Msg/DelKeysFromProject", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetProjectState(ctx context.Context, in *MsgSetProjectState, opts ...grpc.CallOption) (*MsgSetProjectStateResponse, error) {
+	out := new(MsgSetProjectStateResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.projects.Msg/SetProjectState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	AddProjectKeys(context.Context, *MsgAddProjectKeys) (*MsgAddProjectKeysResponse, error)
 	SetAdminPolicy(context.Context, *MsgSetAdminPolicy) (*MsgSetAdminPolicyResponse, error)
 	SetSubscriptionPolicy(context.Context, *MsgSetSubscriptionPolicy) (*MsgSetSubscriptionPolicyResponse, error)
+	RotateProjectKey(context.Context, *MsgRotateProjectKey) (*MsgRotateProjectKeyResponse, error)
+	DelKeysFromProject(context.Context, *MsgDelKeysFromProject) (*MsgDelKeysFromProjectResponse, error)
+	SetProjectState(context.Context, *MsgSetProjectState) (*MsgSetProjectStateResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -428,6 +786,15 @@ func (*UnimplementedMsgServer) SetAdminPolicy(ctx context.Context, req *MsgSetAd
 func (*UnimplementedMsgServer) SetSubscriptionPolicy(ctx context.Context, req *MsgSetSubscriptionPolicy) (*MsgSetSubscriptionPolicyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetSubscriptionPolicy not implemented")
 }
+func (*UnimplementedMsgServer) RotateProjectKey(ctx context.Context, req *MsgRotateProjectKey) (*MsgRotateProjectKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateProjectKey not implemented")
+}
+func (*UnimplementedMsgServer) DelKeysFromProject(ctx context.Context, req *MsgDelKeysFromProject) (*MsgDelKeysFromProjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelKeysFromProject not implemented")
+}
+func (*UnimplementedMsgServer) SetProjectState(ctx context.Context, req *MsgSetProjectState) (*MsgSetProjectStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetProjectState not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -487,6 +854,60 @@ func _Msg_SetSubscriptionPolicy_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RotateProjectKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRotateProjectKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RotateProjectKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.projects.Msg/RotateProjectKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RotateProjectKey(ctx, req.(*MsgRotateProjectKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DelKeysFromProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDelKeysFromProject)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DelKeysFromProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.projects.Msg/DelKeysFromProject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DelKeysFromProject(ctx, req.(*MsgDelKeysFromProject))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetProjectState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetProjectState)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetProjectState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.projects.Msg/SetProjectState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetProjectState(ctx, req.(*MsgSetProjectState))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "lavanet.lava.projects.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -503,6 +924,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SetSubscriptionPolicy",
 			Handler:    _Msg_SetSubscriptionPolicy_Handler,
 		},
+		{
+			MethodName: "RotateProjectKey",
+			Handler:    _Msg_RotateProjectKey_Handler,
+		},
+		{
+			MethodName: "DelKeysFromProject",
+			Handler:    _Msg_DelKeysFromProject_Handler,
+		},
+		{
+			MethodName: "SetProjectState",
+			Handler:    _Msg_SetProjectState_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "projects/tx.proto",
@@ -724,7 +1157,232 @@ func (m *MsgSetSubscriptionPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+func (m *MsgRotateProjectKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRotateProjectKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRotateProjectKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewKeyVrfpk) > 0 {
+		i -= len(m.NewKeyVrfpk)
+		copy(dAtA[i:], m.NewKeyVrfpk)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewKeyVrfpk)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.NewKey) > 0 {
+		i -= len(m.NewKey)
+		copy(dAtA[i:], m.NewKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewKey)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.OldKey) > 0 {
+		i -= len(m.OldKey)
+		copy(dAtA[i:], m.OldKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OldKey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Project) > 0 {
+		i -= len(m.Project)
+		copy(dAtA[i:], m.Project)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Project)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRotateProjectKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRotateProjectKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRotateProjectKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDelKeysFromProject) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDelKeysFromProject) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDelKeysFromProject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProjectKeys) > 0 {
+		for iNdEx := len(m.ProjectKeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProjectKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Project) > 0 {
+		i -= len(m.Project)
+		copy(dAtA[i:], m.Project)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Project)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDelKeysFromProjectResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDelKeysFromProjectResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDelKeysFromProjectResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetProjectState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetProjectState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetProjectState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Project) > 0 {
+		i -= len(m.Project)
+		copy(dAtA[i:], m.Project)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Project)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetProjectStateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetProjectStateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetProjectStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
 	for v >= 1<<7 {
@@ -755,83 +1413,774 @@ func (m *MsgAddProjectKeys) Size() (n int) {
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
-	return n
-}
-
-func (m *MsgAddProjectKeysResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgSetAdminPolicy) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Project)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.Policy.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
+	return n
+}
+
+func (m *MsgAddProjectKeysResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetAdminPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Project)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Policy.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetAdminPolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetSubscriptionPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Projects) > 0 {
+		for _, s := range m.Projects {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = m.Policy.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetSubscriptionPolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRotateProjectKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Project)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OldKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewKeyVrfpk)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRotateProjectKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDelKeysFromProject) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Project)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.ProjectKeys) > 0 {
+		for _, e := range m.ProjectKeys {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgDelKeysFromProjectResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetProjectState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Project)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetProjectStateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgAddProjectKeys) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddProjectKeys: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddProjectKeys: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Project = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectKeys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProjectKeys = append(m.ProjectKeys, ProjectKey{})
+			if err := m.ProjectKeys[len(m.ProjectKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddProjectKeysResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddProjectKeysResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddProjectKeysResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetAdminPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetAdminPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetAdminPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Project = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetAdminPolicyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetAdminPolicyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetAdminPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetSubscriptionPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetSubscriptionPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetSubscriptionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Projects", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Projects = append(m.Projects, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgSetAdminPolicyResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *MsgSetSubscriptionPolicy) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.Projects) > 0 {
-		for _, s := range m.Projects {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+func (m *MsgSetSubscriptionPolicyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetSubscriptionPolicyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetSubscriptionPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	l = m.Policy.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
 
-func (m *MsgSetSubscriptionPolicyResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgAddProjectKeys) Unmarshal(dAtA []byte) error {
+func (m *MsgRotateProjectKey) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -854,10 +2203,10 @@ func (m *MsgAddProjectKeys) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddProjectKeys: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRotateProjectKey: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddProjectKeys: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRotateProjectKey: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -926,9 +2275,9 @@ func (m *MsgAddProjectKeys) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProjectKeys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OldKey", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -938,25 +2287,87 @@ func (m *MsgAddProjectKeys) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProjectKeys = append(m.ProjectKeys, ProjectKey{})
-			if err := m.ProjectKeys[len(m.ProjectKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.OldKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewKeyVrfpk", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.NewKeyVrfpk = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -979,7 +2390,7 @@ func (m *MsgAddProjectKeys) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddProjectKeysResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRotateProjectKeyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1002,10 +2413,10 @@ func (m *MsgAddProjectKeysResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddProjectKeysResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRotateProjectKeyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddProjectKeysResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRotateProjectKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1029,7 +2440,7 @@ func (m *MsgAddProjectKeysResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetAdminPolicy) Unmarshal(dAtA []byte) error {
+func (m *MsgDelKeysFromProject) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1052,10 +2463,10 @@ func (m *MsgSetAdminPolicy) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetAdminPolicy: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDelKeysFromProject: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetAdminPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDelKeysFromProject: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1124,7 +2535,7 @@ func (m *MsgSetAdminPolicy) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectKeys", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1151,7 +2562,8 @@ func (m *MsgSetAdminPolicy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ProjectKeys = append(m.ProjectKeys, ProjectKey{})
+			if err := m.ProjectKeys[len(m.ProjectKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1176,7 +2588,7 @@ func (m *MsgSetAdminPolicy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetAdminPolicyResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDelKeysFromProjectResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1199,10 +2611,10 @@ func (m *MsgSetAdminPolicyResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetAdminPolicyResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDelKeysFromProjectResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetAdminPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDelKeysFromProjectResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1226,7 +2638,7 @@ func (m *MsgSetAdminPolicyResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetSubscriptionPolicy) Unmarshal(dAtA []byte) error {
+func (m *MsgSetProjectState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1249,10 +2661,10 @@ func (m *MsgSetSubscriptionPolicy) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetSubscriptionPolicy: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetProjectState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetSubscriptionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetProjectState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1289,7 +2701,7 @@ func (m *MsgSetSubscriptionPolicy) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Projects", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1317,13 +2729,13 @@ func (m *MsgSetSubscriptionPolicy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Projects = append(m.Projects, string(dAtA[iNdEx:postIndex]))
+			m.Project = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1333,25 +2745,12 @@ func (m *MsgSetSubscriptionPolicy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Enabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1373,7 +2772,7 @@ func (m *MsgSetSubscriptionPolicy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetSubscriptionPolicyResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetProjectStateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1396,10 +2795,10 @@ func (m *MsgSetSubscriptionPolicyResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetSubscriptionPolicyResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetProjectStateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetSubscriptionPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetProjectStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -5,6 +5,19 @@ const (
 	MAX_PROJECT_DESCRIPTION_LEN = 150
 )
 
+const (
+	ProjectKeyRotatedEventName   = "project_key_rotated"
+	ProjectStateChangedEventName = "project_state_changed"
+	ProjectKeyAddedEventName     = "project_key_added"
+	ProjectKeyRemovedEventName   = "project_key_removed"
+	ProjectUsageEventName        = "project_developer_usage"
+)
+
+const (
+	FlagFromEpoch = "from-epoch"
+	FlagToEpoch   = "to-epoch"
+)
+
 // set policy enum
 type SetPolicyEnum int
 
@@ -32,6 +32,8 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 
 	cmd.AddCommand(CmdShowChainInfo())
 
+	cmd.AddCommand(CmdValidateSpec())
+
 	// this line is used by starport scaffolding # 1
 
 	return cmd
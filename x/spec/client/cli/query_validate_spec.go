@@ -0,0 +1,52 @@
+package cli
+
+import (
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/lavanet/lava/x/spec/client/utils"
+	"github.com/lavanet/lava/x/spec/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdValidateSpec() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validate-spec [spec-proposal-json-file]",
+		Short: "Validate the specs of a spec add proposal without submitting it",
+		Long: strings.TrimSpace(`Validate the specs of a spec add proposal file (or comma separated files) against the chain,
+reporting all the problems the proposal would be rejected for.
+
+Example:
+$ lavad query spec validate-spec cookbook/specs/spec_add_ethereum.json`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposal, err := utils.ParseSpecAddProposalJSON(clientCtx.LegacyAmino, args[0])
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryValidateSpecRequest{
+				Specs: proposal.Proposal.Specs,
+			}
+
+			res, err := queryClient.SpecValidate(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,21 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/x/spec/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) SpecValidate(goCtx context.Context, req *types.QueryValidateSpecRequest) (*types.QueryValidateSpecResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	report := k.SpecValidationReport(ctx, req.Specs)
+	return &types.QueryValidateSpecResponse{Valid: len(report) == 0, Errors: report}, nil
+}
@@ -0,0 +1,80 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/lavanet/lava/testutil/keeper"
+	epochstoragetypes "github.com/lavanet/lava/x/epochstorage/types"
+	"github.com/lavanet/lava/x/spec/types"
+)
+
+func validMockSpec(index string, imports []string, apiNames ...string) types.Spec {
+	spec := types.Spec{
+		Index:                     index,
+		Name:                      index,
+		Imports:                   imports,
+		Enabled:                   true,
+		ReliabilityThreshold:      1,
+		BlocksInFinalizationProof: 1,
+		AverageBlockTime:          1000,
+		AllowedBlockLagForQosSync: 1,
+		MinStakeClient:            sdk.NewCoin(epochstoragetypes.TokenDenom, sdk.NewInt(100)),
+		MinStakeProvider:          sdk.NewCoin(epochstoragetypes.TokenDenom, sdk.NewInt(1000)),
+	}
+	for _, name := range apiNames {
+		spec.Apis = append(spec.Apis, types.ServiceApi{
+			Name:          name,
+			Enabled:       true,
+			ComputeUnits:  10,
+			ApiInterfaces: []types.ApiInterface{{Interface: types.APIInterfaceJsonRPC}},
+		})
+	}
+	return spec
+}
+
+func TestSpecValidateQuery(t *testing.T) {
+	keeper, ctx := keepertest.SpecKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	invalid := validMockSpec("invalid", nil, "api-0", "api-0")
+	invalid.ReliabilityThreshold = 0
+	invalid.Apis[1].ComputeUnits = 0
+
+	qos := validMockSpec("qos", nil, "api-0")
+	qos.MinQosScorePercent = 101
+	qos.QosExemptProviders = []string{"invalid"}
+
+	tagged := validMockSpec("tagged", nil, "api-0", "api-1")
+	tagged.Apis[0].Tags = []string{types.ApiTagArchive, types.ApiTagHeavy}
+	tagged.Apis[1].Tags = []string{types.ApiTagPersonalData, "unknown", types.ApiTagPersonalData}
+
+	for _, tt := range []struct {
+		name   string
+		specs  []types.Spec
+		errors int
+	}{
+		{"valid spec", []types.Spec{validMockSpec("spec", nil, "api-0")}, 0},
+		{"all problems reported", []types.Spec{invalid}, 3},
+		{"api tags", []types.Spec{tagged}, 2},
+		{"qos threshold", []types.Spec{qos}, 2},
+		{"unknown import", []types.Spec{validMockSpec("spec", []string{"unknown"}, "api-0")}, 1},
+		{"import of a proposed spec", []types.Spec{validMockSpec("base", nil, "api-0"), validMockSpec("spec", []string{"base"}, "api-1")}, 0},
+		{"duplicate imported api", []types.Spec{validMockSpec("base-0", nil, "api-0"), validMockSpec("base-1", nil, "api-0"), validMockSpec("spec", []string{"base-0", "base-1"})}, 1},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := keeper.SpecValidate(wctx, &types.QueryValidateSpecRequest{Specs: tt.specs})
+			require.Nil(t, err)
+			require.Len(t, res.Errors, tt.errors, res.Errors)
+			require.Equal(t, tt.errors == 0, res.Valid)
+
+			// validation doesn't store the specs
+			for _, spec := range tt.specs {
+				_, found := keeper.GetSpec(ctx, spec.Index)
+				require.False(t, found)
+			}
+		})
+	}
+}
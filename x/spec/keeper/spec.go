@@ -170,6 +170,37 @@ func (k Keeper) ValidateSpec(ctx sdk.Context, spec types.Spec) (map[string]strin
 	return details, nil
 }
 
+// SpecValidationReport returns all the problems a spec add proposal of the specs would be rejected for,
+// like in the proposal a spec may import the specs before it and existing specs that import them are re-validated
+func (k Keeper) SpecValidationReport(ctx sdk.Context, specs []types.Spec) []string {
+	// the cached store is never written, the specs are only set on it to resolve the imports
+	ctx, _ = ctx.CacheContext()
+	report := []string{}
+	proposed := map[string]struct{}{}
+	for _, spec := range specs {
+		expanded, err := k.ExpandSpec(ctx, spec)
+		if err != nil {
+			report = append(report, fmt.Sprintf("spec %s: imports %s: %s", spec.Index, strings.Join(spec.Imports, ","), err))
+		}
+		for _, problem := range expanded.ValidationReport(k.MaxCU(ctx)) {
+			report = append(report, fmt.Sprintf("spec %s: %s", spec.Index, problem))
+		}
+		k.SetSpec(ctx, spec)
+		proposed[spec.Index] = struct{}{}
+	}
+
+	for _, spec := range k.GetAllSpec(ctx) {
+		if _, ok := proposed[spec.Index]; ok {
+			continue
+		}
+		if _, err := k.ValidateSpec(ctx, spec); err != nil {
+			report = append(report, fmt.Sprintf("spec %s invalidated: %s", spec.Index, err))
+		}
+	}
+
+	return report
+}
+
 // returns whether a spec name is a valid spec in the consensus
 // first return value is found and active, second argument is found only
 func (k Keeper) IsSpecFoundAndActive(ctx sdk.Context, chainID string) (foundAndActive bool, found bool) {
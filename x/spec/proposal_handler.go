@@ -78,7 +78,7 @@ func handleSpecProposal(ctx sdk.Context, k keeper.Keeper, p *types.SpecAddPropos
 	logger := k.Logger(ctx)
 
 	for _, spec := range p.Specs {
-		_, found := k.GetSpec(ctx, spec.Index)
+		existingSpec, found := k.GetSpec(ctx, spec.Index)
 
 		details, err := k.ValidateSpec(ctx, spec)
 		if err != nil {
@@ -86,6 +86,11 @@ func handleSpecProposal(ctx sdk.Context, k keeper.Keeper, p *types.SpecAddPropos
 		}
 
 		spec.BlockLastUpdated = uint64(ctx.BlockHeight())
+		// providers that staked before the minimum self stake was set or changed keep being paired
+		spec.MinSelfStakeProviderBlock = existingSpec.MinSelfStakeProviderBlock
+		if !found || !existingSpec.MinSelfStake().Equal(spec.MinSelfStake()) {
+			spec.MinSelfStakeProviderBlock = uint64(ctx.BlockHeight())
+		}
 		k.SetSpec(ctx, spec)
 
 		name := types.SpecAddEventName
@@ -0,0 +1,68 @@
+package types
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// MatchApi returns the api a relay was sent to and its interface entry. rest relays are matched by their url path, where
+// {param} segments of the api name match any value, grpc relays by their method url, and json-rpc relays by the method in
+// their data, or by their url for tendermint uri requests
+func (spec Spec) MatchApi(apiInterface string, apiUrl string, data []byte) (ServiceApi, ApiInterface, bool) {
+	path := apiUrl
+	if idx := strings.Index(path, "?"); idx != -1 {
+		path = path[:idx]
+	}
+	var jsonrpcMessage struct {
+		Method string `json:"method"`
+	}
+	if apiUrl == "" {
+		// not every relay carries a json-rpc message, those simply match no method
+		_ = json.Unmarshal(data, &jsonrpcMessage)
+	}
+
+	for _, api := range spec.Apis {
+		for _, iface := range api.ApiInterfaces {
+			if iface.Interface != apiInterface {
+				continue
+			}
+			var matched bool
+			switch apiInterface {
+			case APIInterfaceRest:
+				matched = restPathMatches(api.Name, path)
+			case APIInterfaceGrpc:
+				matched = api.Name == path
+			default:
+				if apiUrl != "" {
+					matched = api.Name == strings.TrimPrefix(path, "/")
+				} else {
+					matched = jsonrpcMessage.Method != "" && api.Name == jsonrpcMessage.Method
+				}
+			}
+			if matched {
+				return api, iface, true
+			}
+		}
+	}
+	return ServiceApi{}, ApiInterface{}, false
+}
+
+func restPathMatches(name string, path string) bool {
+	nameSegments := strings.Split(strings.Trim(name, "/"), "/")
+	pathSegments := strings.Split(strings.Trim(path, "/"), "/")
+	if len(nameSegments) != len(pathSegments) {
+		return false
+	}
+	for idx, segment := range nameSegments {
+		if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+			if pathSegments[idx] == "" {
+				return false
+			}
+			continue
+		}
+		if segment != pathSegments[idx] {
+			return false
+		}
+	}
+	return true
+}
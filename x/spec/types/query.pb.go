@@ -593,6 +593,102 @@ func (m *QueryShowChainInfoResponse) GetSupportedApisInterfaceList() []*ApiList
 	return nil
 }
 
+type QueryValidateSpecRequest struct {
+	Specs []Spec `protobuf:"bytes,1,rep,name=specs,proto3" json:"specs"`
+}
+
+func (m *QueryValidateSpecRequest) Reset()         { *m = QueryValidateSpecRequest{} }
+func (m *QueryValidateSpecRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidateSpecRequest) ProtoMessage()    {}
+func (*QueryValidateSpecRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6723cd4498ae5af7, []int{12}
+}
+func (m *QueryValidateSpecRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidateSpecRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidateSpecRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidateSpecRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidateSpecRequest.Merge(m, src)
+}
+func (m *QueryValidateSpecRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidateSpecRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidateSpecRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidateSpecRequest proto.InternalMessageInfo
+
+func (m *QueryValidateSpecRequest) GetSpecs() []Spec {
+	if m != nil {
+		return m.Specs
+	}
+	return nil
+}
+
+type QueryValidateSpecResponse struct {
+	Valid  bool     `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	Errors []string `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
+}
+
+func (m *QueryValidateSpecResponse) Reset()         { *m = QueryValidateSpecResponse{} }
+func (m *QueryValidateSpecResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidateSpecResponse) ProtoMessage()    {}
+func (*QueryValidateSpecResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6723cd4498ae5af7, []int{13}
+}
+func (m *QueryValidateSpecResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidateSpecResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidateSpecResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidateSpecResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidateSpecResponse.Merge(m, src)
+}
+func (m *QueryValidateSpecResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidateSpecResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidateSpecResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidateSpecResponse proto.InternalMessageInfo
+
+func (m *QueryValidateSpecResponse) GetValid() bool {
+	if m != nil {
+		return m.Valid
+	}
+	return false
+}
+
+func (m *QueryValidateSpecResponse) GetErrors() []string {
+	if m != nil {
+		return m.Errors
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "lavanet.lava.spec.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "lavanet.lava.spec.QueryParamsResponse")
@@ -606,63 +702,69 @@ func init() {
 	proto.RegisterType((*QueryShowChainInfoRequest)(nil), "lavanet.lava.spec.QueryShowChainInfoRequest")
 	proto.RegisterType((*ApiList)(nil), "lavanet.lava.spec.apiList")
 	proto.RegisterType((*QueryShowChainInfoResponse)(nil), "lavanet.lava.spec.QueryShowChainInfoResponse")
+	proto.RegisterType((*QueryValidateSpecRequest)(nil), "lavanet.lava.spec.QueryValidateSpecRequest")
+	proto.RegisterType((*QueryValidateSpecResponse)(nil), "lavanet.lava.spec.QueryValidateSpecResponse")
 }
 
 func init() { proto.RegisterFile("spec/query.proto", fileDescriptor_6723cd4498ae5af7) }
 
 var fileDescriptor_6723cd4498ae5af7 = []byte{
-	// 807 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0xcf, 0x4e, 0xdb, 0x4a,
-	0x14, 0xc6, 0xe3, 0x24, 0x90, 0xcb, 0x5c, 0xa1, 0x0b, 0x73, 0x23, 0x91, 0x18, 0x1a, 0xc0, 0xfc,
-	0x2d, 0x2a, 0x76, 0xa1, 0x52, 0xab, 0x6e, 0x2a, 0x05, 0xaa, 0x22, 0xaa, 0x16, 0x51, 0xb3, 0x43,
-	0xaa, 0xa2, 0x89, 0x3b, 0x24, 0x96, 0x1c, 0x8f, 0x89, 0x27, 0xa4, 0x14, 0xb1, 0x41, 0xea, 0xa2,
-	0xbb, 0xaa, 0xdd, 0xf4, 0x01, 0xfa, 0x04, 0x7d, 0x0a, 0x96, 0x48, 0xdd, 0xb0, 0xaa, 0x2a, 0xe8,
-	0x83, 0x54, 0x3e, 0x33, 0x09, 0x36, 0xb1, 0x03, 0xaa, 0xba, 0x89, 0xf1, 0xcc, 0x39, 0xdf, 0xf7,
-	0x9b, 0x73, 0xc6, 0x47, 0xa0, 0x11, 0xdf, 0xa3, 0x96, 0xb1, 0xdf, 0xa2, 0xcd, 0x43, 0xdd, 0x6b,
-	0x32, 0xce, 0xf0, 0xa8, 0x43, 0x0e, 0x88, 0x4b, 0xb9, 0x1e, 0x3c, 0xf5, 0x60, 0x5b, 0xcd, 0xd7,
-	0x58, 0x8d, 0xc1, 0xae, 0x11, 0xfc, 0x25, 0x02, 0xd5, 0x89, 0x1a, 0x63, 0x35, 0x87, 0x1a, 0xc4,
-	0xb3, 0x0d, 0xe2, 0xba, 0x8c, 0x13, 0x6e, 0x33, 0xd7, 0x97, 0xbb, 0x4b, 0x16, 0xf3, 0x1b, 0xcc,
-	0x37, 0xaa, 0xc4, 0xa7, 0x42, 0xdf, 0x38, 0x58, 0xa9, 0x52, 0x4e, 0x56, 0x0c, 0x8f, 0xd4, 0x6c,
-	0x17, 0x82, 0x65, 0xec, 0x28, 0x40, 0x78, 0xa4, 0x49, 0x1a, 0x9d, 0xf4, 0xff, 0x60, 0x29, 0xf8,
-	0x11, 0x0b, 0x5a, 0x1e, 0xe1, 0x57, 0x81, 0xca, 0x36, 0x44, 0x99, 0x74, 0xbf, 0x45, 0x7d, 0xae,
-	0x6d, 0xa1, 0xff, 0x23, 0xab, 0xbe, 0xc7, 0x5c, 0x9f, 0xe2, 0x47, 0x68, 0x50, 0xa8, 0x15, 0x94,
-	0x29, 0x65, 0xf1, 0xdf, 0xd5, 0xa2, 0xde, 0x73, 0x28, 0x5d, 0xa4, 0xac, 0x65, 0x4f, 0x7f, 0x4c,
-	0xa6, 0x4c, 0x19, 0xae, 0x19, 0x52, 0x6f, 0x83, 0xf2, 0x1d, 0x8f, 0x5a, 0xd2, 0x06, 0x17, 0x50,
-	0x6e, 0xbd, 0x4e, 0x6c, 0x77, 0xf3, 0x29, 0x08, 0x0e, 0x99, 0x9d, 0x57, 0x6d, 0x13, 0xe5, 0xa3,
-	0x09, 0x92, 0x60, 0x05, 0x65, 0x83, 0x77, 0xe9, 0x3f, 0x16, 0xe3, 0x1f, 0x6c, 0x4b, 0x77, 0x08,
-	0xd5, 0x5e, 0x4b, 0xef, 0xb2, 0xe3, 0x84, 0xbd, 0x9f, 0x21, 0x74, 0x55, 0x30, 0xa9, 0x37, 0xaf,
-	0x8b, 0xea, 0xea, 0x41, 0x75, 0x75, 0xd1, 0x3d, 0x59, 0x5d, 0x7d, 0x9b, 0xd4, 0xa8, 0xcc, 0x35,
-	0x43, 0x99, 0xda, 0x27, 0x45, 0xa2, 0x76, 0xf5, 0x7b, 0x50, 0x33, 0xb7, 0x44, 0xc5, 0x1b, 0x11,
-	0xa6, 0x34, 0x30, 0x2d, 0xdc, 0xc8, 0x24, 0xfc, 0x22, 0x50, 0xe3, 0xa8, 0x08, 0x4c, 0x3b, 0x75,
-	0xd6, 0x2e, 0x3b, 0x0e, 0x54, 0xb5, 0xdb, 0x5c, 0x8e, 0xd4, 0xb8, 0x4d, 0x89, 0xbd, 0x8d, 0x86,
-	0x2d, 0x68, 0x82, 0xbb, 0xc7, 0x5e, 0xd8, 0x3e, 0x2f, 0xa4, 0x81, 0x7f, 0x29, 0x86, 0xdf, 0x0f,
-	0x0b, 0x04, 0xf1, 0x3b, 0xbc, 0xd9, 0xb2, 0xb8, 0x19, 0x15, 0x78, 0x9e, 0xfd, 0x47, 0x19, 0x49,
-	0x6b, 0xef, 0x15, 0x34, 0x96, 0x90, 0x80, 0x27, 0xd0, 0x10, 0xa4, 0x6c, 0x91, 0x06, 0x95, 0x37,
-	0xe1, 0x6a, 0x21, 0xb8, 0x25, 0x96, 0xbc, 0x25, 0x69, 0x71, 0x4b, 0xe4, 0x2b, 0x5e, 0x45, 0x79,
-	0xea, 0x92, 0xaa, 0x43, 0xdf, 0x94, 0x3d, 0x7b, 0xd3, 0xe5, 0xb4, 0xb9, 0x47, 0x2c, 0xea, 0x17,
-	0x32, 0x53, 0x99, 0xc5, 0x21, 0x33, 0x76, 0x4f, 0x7b, 0x1c, 0x2a, 0xcd, 0x7a, 0x87, 0xb3, 0x73,
-	0x29, 0xfa, 0x82, 0x68, 0x2f, 0x51, 0x8e, 0x78, 0x76, 0x70, 0xa6, 0x20, 0xd0, 0xee, 0x68, 0x16,
-	0xb2, 0x22, 0xb0, 0xbb, 0x80, 0x67, 0xd1, 0xb0, 0xdf, 0xf2, 0x3c, 0xd6, 0xe4, 0xe0, 0xee, 0x17,
-	0x06, 0x00, 0x28, 0xba, 0xa8, 0x7d, 0x53, 0x42, 0x8d, 0x08, 0xa1, 0xc8, 0x46, 0x84, 0x8e, 0xad,
-	0x44, 0x8f, 0x5d, 0x42, 0xc8, 0xbe, 0x3a, 0x6c, 0x1a, 0xb4, 0x43, 0x2b, 0x78, 0x17, 0xa9, 0x11,
-	0xa7, 0xee, 0xe9, 0xa1, 0x9f, 0x19, 0xe8, 0xa7, 0x1a, 0xd3, 0x4f, 0x79, 0x38, 0xb3, 0x4f, 0xf6,
-	0xea, 0x79, 0x0e, 0x0d, 0x00, 0x34, 0x7e, 0x87, 0x06, 0xc5, 0xb7, 0x8e, 0xe7, 0x62, 0xb4, 0x7a,
-	0x87, 0x8a, 0x3a, 0x7f, 0x53, 0x98, 0x38, 0xb8, 0x36, 0x7d, 0xf2, 0xfd, 0xd7, 0xe7, 0xf4, 0x38,
-	0x2e, 0x1a, 0x32, 0x1e, 0x9e, 0x46, 0x68, 0x98, 0xe1, 0x13, 0x45, 0x7c, 0x5c, 0x38, 0x51, 0x33,
-	0x3a, 0x69, 0xd4, 0x85, 0x1b, 0xe3, 0xa4, 0xf9, 0x5d, 0x30, 0x9f, 0xc1, 0xd3, 0x31, 0xe6, 0xf0,
-	0x73, 0x24, 0x47, 0xd4, 0x31, 0x3e, 0x42, 0xb9, 0x20, 0xb5, 0xec, 0x38, 0xc9, 0x18, 0xd1, 0xa1,
-	0x93, 0x8c, 0x71, 0x6d, 0x78, 0x68, 0x93, 0x80, 0x51, 0xc4, 0x63, 0x09, 0x18, 0xf8, 0x83, 0x22,
-	0xdc, 0x4d, 0xd2, 0xfe, 0xfb, 0x45, 0x58, 0x06, 0xf7, 0x05, 0x3c, 0x97, 0xe0, 0x5e, 0x69, 0x92,
-	0x76, 0xa8, 0x10, 0x27, 0x0a, 0x42, 0xb2, 0x12, 0x7d, 0x71, 0xfe, 0xb4, 0x18, 0x33, 0x80, 0x73,
-	0x07, 0x8f, 0xf7, 0xc1, 0xc1, 0x5f, 0x14, 0x34, 0x1c, 0x99, 0x68, 0xf8, 0x5e, 0x92, 0x7e, 0xdc,
-	0x54, 0x54, 0x97, 0x6f, 0x19, 0x2d, 0x99, 0x96, 0x80, 0x69, 0x16, 0x6b, 0x71, 0x4c, 0x75, 0xd6,
-	0xae, 0x10, 0xc7, 0xa9, 0x58, 0x02, 0xe4, 0xab, 0x44, 0xeb, 0x7e, 0xe3, 0xfd, 0xd1, 0xae, 0x4f,
-	0xa5, 0xfe, 0x68, 0x3d, 0x83, 0x43, 0x7b, 0x08, 0x68, 0xf7, 0xb1, 0x9e, 0x84, 0x06, 0x58, 0x15,
-	0xdb, 0xdd, 0x63, 0xc6, 0x51, 0x77, 0xba, 0x1d, 0xaf, 0x3d, 0x39, 0xbd, 0x28, 0x29, 0x67, 0x17,
-	0x25, 0xe5, 0xe7, 0x45, 0x49, 0xf9, 0x78, 0x59, 0x4a, 0x9d, 0x5d, 0x96, 0x52, 0xe7, 0x97, 0xa5,
-	0xd4, 0xee, 0x6c, 0xcd, 0xe6, 0xf5, 0x56, 0x55, 0xb7, 0x58, 0x23, 0xaa, 0xf9, 0x56, 0xa8, 0xf2,
-	0x43, 0x8f, 0xfa, 0xd5, 0x41, 0xf8, 0x8f, 0xe2, 0xc1, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0x26,
-	0x0b, 0xc4, 0xda, 0xfc, 0x08, 0x00, 0x00,
+	// 879 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0x5d, 0x4f, 0x33, 0x45,
+	0x14, 0xc7, 0xbb, 0x7d, 0x03, 0x8e, 0x12, 0x9f, 0x67, 0x6c, 0xa4, 0x5d, 0x1e, 0x0b, 0x2c, 0x2f,
+	0x45, 0x84, 0x5d, 0x81, 0x44, 0xe3, 0x8d, 0x49, 0xc1, 0x48, 0x6a, 0x10, 0x71, 0x49, 0xbc, 0x20,
+	0x31, 0xcd, 0x74, 0x19, 0xda, 0x8d, 0xdb, 0x9d, 0x65, 0x77, 0x4a, 0x45, 0xc2, 0x0d, 0x89, 0x17,
+	0xde, 0x19, 0xbd, 0xf1, 0x03, 0xf8, 0x09, 0xfc, 0x0e, 0x26, 0x5c, 0x92, 0x78, 0xe3, 0x95, 0x31,
+	0xe0, 0x07, 0x31, 0x3b, 0x33, 0x2d, 0xbb, 0xb2, 0xdb, 0x36, 0xc6, 0x9b, 0x96, 0x99, 0xf9, 0x9f,
+	0xf3, 0xff, 0x9d, 0x33, 0xd3, 0x13, 0xe0, 0x45, 0xe0, 0x11, 0xcb, 0xb8, 0xe8, 0x11, 0xff, 0x4a,
+	0xf7, 0x7c, 0xca, 0x28, 0x7a, 0xe9, 0xe0, 0x4b, 0xec, 0x12, 0xa6, 0x87, 0xdf, 0x7a, 0x78, 0xac,
+	0x96, 0xda, 0xb4, 0x4d, 0xf9, 0xa9, 0x11, 0xfe, 0x25, 0x84, 0xea, 0xab, 0x36, 0xa5, 0x6d, 0x87,
+	0x18, 0xd8, 0xb3, 0x0d, 0xec, 0xba, 0x94, 0x61, 0x66, 0x53, 0x37, 0x90, 0xa7, 0x1b, 0x16, 0x0d,
+	0xba, 0x34, 0x30, 0x5a, 0x38, 0x20, 0x22, 0xbf, 0x71, 0xb9, 0xdd, 0x22, 0x0c, 0x6f, 0x1b, 0x1e,
+	0x6e, 0xdb, 0x2e, 0x17, 0x4b, 0xed, 0x4b, 0x0e, 0xe1, 0x61, 0x1f, 0x77, 0x07, 0xe1, 0x6f, 0xf0,
+	0xad, 0xf0, 0x43, 0x6c, 0x68, 0x25, 0x40, 0x5f, 0x84, 0x59, 0x8e, 0xb9, 0xca, 0x24, 0x17, 0x3d,
+	0x12, 0x30, 0xed, 0x08, 0xde, 0x8c, 0xed, 0x06, 0x1e, 0x75, 0x03, 0x82, 0x3e, 0x80, 0xa2, 0xc8,
+	0x56, 0x56, 0x16, 0x95, 0xf5, 0xd7, 0x76, 0x2a, 0xfa, 0xb3, 0xa2, 0x74, 0x11, 0xb2, 0x97, 0xbf,
+	0xfb, 0x73, 0x21, 0x63, 0x4a, 0xb9, 0x66, 0xc8, 0x7c, 0x07, 0x84, 0x9d, 0x78, 0xc4, 0x92, 0x36,
+	0xa8, 0x0c, 0x53, 0xfb, 0x1d, 0x6c, 0xbb, 0x8d, 0x8f, 0x79, 0xc2, 0x19, 0x73, 0xb0, 0xd4, 0x1a,
+	0x50, 0x8a, 0x07, 0x48, 0x82, 0x6d, 0xc8, 0x87, 0x6b, 0xe9, 0x3f, 0x97, 0xe0, 0x1f, 0x1e, 0x4b,
+	0x77, 0x2e, 0xd5, 0xbe, 0x92, 0xde, 0x75, 0xc7, 0x89, 0x7a, 0x7f, 0x02, 0xf0, 0xd4, 0x30, 0x99,
+	0x6f, 0x4d, 0x17, 0xdd, 0xd5, 0xc3, 0xee, 0xea, 0xe2, 0xf6, 0x64, 0x77, 0xf5, 0x63, 0xdc, 0x26,
+	0x32, 0xd6, 0x8c, 0x44, 0x6a, 0x3f, 0x2a, 0x50, 0x8a, 0xe7, 0x7f, 0x86, 0x9a, 0x9b, 0x10, 0x15,
+	0x1d, 0xc4, 0x98, 0xb2, 0x9c, 0xa9, 0x36, 0x96, 0x49, 0xf8, 0xc5, 0xa0, 0xe6, 0xa1, 0xc2, 0x99,
+	0x4e, 0x3a, 0xb4, 0x5f, 0x77, 0x1c, 0xde, 0xd5, 0xe1, 0xe5, 0x32, 0x50, 0x93, 0x0e, 0x25, 0xf6,
+	0x31, 0xcc, 0x5a, 0xfc, 0x12, 0xdc, 0x73, 0x7a, 0x68, 0x07, 0xac, 0x9c, 0xe5, 0xfc, 0x1b, 0x09,
+	0xfc, 0x41, 0x34, 0x41, 0xa8, 0x3f, 0x61, 0x7e, 0xcf, 0x62, 0x66, 0x3c, 0xc1, 0xa7, 0xf9, 0x69,
+	0xe5, 0x45, 0x56, 0xfb, 0x4e, 0x81, 0xb9, 0x94, 0x00, 0xf4, 0x0a, 0x66, 0x78, 0xc8, 0x11, 0xee,
+	0x12, 0xf9, 0x12, 0x9e, 0x36, 0xc2, 0x57, 0x62, 0xc9, 0x57, 0x92, 0x15, 0xaf, 0x44, 0x2e, 0xd1,
+	0x0e, 0x94, 0x88, 0x8b, 0x5b, 0x0e, 0x39, 0xab, 0x7b, 0x76, 0xc3, 0x65, 0xc4, 0x3f, 0xc7, 0x16,
+	0x09, 0xca, 0xb9, 0xc5, 0xdc, 0xfa, 0x8c, 0x99, 0x78, 0xa6, 0x7d, 0x18, 0x69, 0xcd, 0xfe, 0x80,
+	0x73, 0xf0, 0x28, 0x46, 0x82, 0x68, 0x9f, 0xc1, 0x14, 0xf6, 0xec, 0x43, 0x5b, 0x08, 0xed, 0x41,
+	0xce, 0x72, 0x5e, 0x08, 0x87, 0x1b, 0x68, 0x05, 0x66, 0x83, 0x9e, 0xe7, 0x51, 0x9f, 0x71, 0xf7,
+	0xa0, 0x5c, 0xe0, 0x40, 0xf1, 0x4d, 0xed, 0x57, 0x05, 0xd4, 0x24, 0x14, 0x79, 0x11, 0x91, 0xb2,
+	0x95, 0x78, 0xd9, 0x55, 0x00, 0xfb, 0xa9, 0xd8, 0x2c, 0xcf, 0x1d, 0xd9, 0x41, 0xa7, 0xa0, 0xc6,
+	0x9c, 0x86, 0xd5, 0xf3, 0xfb, 0xcc, 0xf1, 0xfb, 0x54, 0x13, 0xee, 0x53, 0x16, 0x67, 0x8e, 0x88,
+	0xd6, 0x3e, 0x87, 0x32, 0x67, 0xfe, 0x12, 0x3b, 0xf6, 0x19, 0x66, 0x24, 0xfa, 0x93, 0xda, 0x85,
+	0x42, 0x98, 0x27, 0x98, 0xec, 0xc9, 0x0b, 0xad, 0xd6, 0x80, 0x4a, 0x42, 0x42, 0xd9, 0x83, 0x12,
+	0x14, 0x2e, 0xc3, 0x7d, 0xde, 0x81, 0x69, 0x53, 0x2c, 0xd0, 0x5b, 0x50, 0x24, 0xbe, 0x4f, 0xfd,
+	0x41, 0xed, 0x72, 0xb5, 0xf3, 0xdb, 0x34, 0x14, 0x78, 0x2e, 0xf4, 0x2d, 0x14, 0xc5, 0x1c, 0x42,
+	0xab, 0x09, 0x10, 0xcf, 0x07, 0x9e, 0xba, 0x36, 0x4e, 0x26, 0x80, 0xb4, 0xa5, 0xdb, 0xdf, 0xff,
+	0xfe, 0x29, 0x3b, 0x8f, 0x2a, 0x86, 0xd4, 0xf3, 0x6f, 0x23, 0x32, 0x68, 0xd1, 0xad, 0x22, 0x7e,
+	0xf8, 0x28, 0x35, 0x67, 0x7c, 0x0a, 0xaa, 0xb5, 0xb1, 0x3a, 0x69, 0xfe, 0x0e, 0x37, 0x5f, 0x46,
+	0x4b, 0x09, 0xe6, 0xfc, 0xe3, 0x5a, 0x8e, 0xcf, 0x1b, 0x74, 0x0d, 0x53, 0x61, 0x68, 0xdd, 0x71,
+	0xd2, 0x31, 0xe2, 0x03, 0x51, 0xad, 0x8d, 0xd5, 0x49, 0x8c, 0x05, 0x8e, 0x51, 0x41, 0x73, 0x29,
+	0x18, 0xe8, 0x7b, 0x45, 0xb8, 0x9b, 0xb8, 0xff, 0xff, 0x37, 0x61, 0x8b, 0xbb, 0xd7, 0xd0, 0x6a,
+	0x8a, 0x7b, 0xd3, 0xc7, 0xfd, 0x48, 0x23, 0x6e, 0x15, 0x00, 0xd9, 0x89, 0x91, 0x38, 0xff, 0xb5,
+	0x19, 0xcb, 0x1c, 0xe7, 0x6d, 0x34, 0x3f, 0x02, 0x07, 0xfd, 0xac, 0xc0, 0x6c, 0x6c, 0xda, 0xa2,
+	0xcd, 0xb4, 0xfc, 0x49, 0x13, 0x5b, 0xdd, 0x9a, 0x50, 0x2d, 0x99, 0x36, 0x38, 0xd3, 0x0a, 0xd2,
+	0x92, 0x98, 0x3a, 0xb4, 0xdf, 0xc4, 0x8e, 0xd3, 0xb4, 0x04, 0xc8, 0x2f, 0x12, 0x6d, 0x38, 0x7f,
+	0x46, 0xa3, 0xfd, 0x7b, 0x62, 0xaa, 0x5b, 0x13, 0xaa, 0x25, 0xda, 0xfb, 0x1c, 0xed, 0x3d, 0xa4,
+	0xa7, 0xa1, 0x71, 0xac, 0xa6, 0xed, 0x9e, 0x53, 0xe3, 0x7a, 0x38, 0x79, 0x6f, 0xd0, 0xd7, 0xf0,
+	0x7a, 0xd8, 0xf6, 0xc1, 0x90, 0x40, 0xef, 0xa6, 0xd9, 0x26, 0xcc, 0x25, 0x75, 0x73, 0x32, 0xb1,
+	0x44, 0xcc, 0xec, 0x7d, 0x74, 0xf7, 0x50, 0x55, 0xee, 0x1f, 0xaa, 0xca, 0x5f, 0x0f, 0x55, 0xe5,
+	0x87, 0xc7, 0x6a, 0xe6, 0xfe, 0xb1, 0x9a, 0xf9, 0xe3, 0xb1, 0x9a, 0x39, 0x5d, 0x69, 0xdb, 0xac,
+	0xd3, 0x6b, 0xe9, 0x16, 0xed, 0xc6, 0x0b, 0xf8, 0x46, 0x94, 0xc0, 0xae, 0x3c, 0x12, 0xb4, 0x8a,
+	0xfc, 0x5f, 0xab, 0xdd, 0x7f, 0x06, 0x00, 0x02, 0x2a, 0xb7, 0x21, 0x05, 0x0a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -691,6 +793,8 @@ type QueryClient interface {
 	ShowAllChains(ctx context.Context, in *QueryShowAllChainsRequest, opts ...grpc.CallOption) (*QueryShowAllChainsResponse, error)
 	// Queries a list of ShowChainInfo items.
 	ShowChainInfo(ctx context.Context, in *QueryShowChainInfoRequest, opts ...grpc.CallOption) (*QueryShowChainInfoResponse, error)
+	// Validates the specs of a spec add proposal without proposing it, reporting all of their problems.
+	SpecValidate(ctx context.Context, in *QueryValidateSpecRequest, opts ...grpc.CallOption) (*QueryValidateSpecResponse, error)
 }
 
 type queryClient struct {
@@ -764,6 +868,15 @@ func (c *queryClient) ShowChainInfo(ctx context.Context, in *QueryShowChainInfoR
 	return out, nil
 }
 
+func (c *queryClient) SpecValidate(ctx context.Context, in *QueryValidateSpecRequest, opts ...grpc.CallOption) (*QueryValidateSpecResponse, error) {
+	out := new(QueryValidateSpecResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.spec.Query/SpecValidate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
@@ -780,6 +893,8 @@ type QueryServer interface {
 	ShowAllChains(context.Context, *QueryShowAllChainsRequest) (*QueryShowAllChainsResponse, error)
 	// Queries a list of ShowChainInfo items.
 	ShowChainInfo(context.Context, *QueryShowChainInfoRequest) (*QueryShowChainInfoResponse, error)
+	// Validates the specs of a spec add proposal without proposing it, reporting all of their problems.
+	SpecValidate(context.Context, *QueryValidateSpecRequest) (*QueryValidateSpecResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -807,6 +922,9 @@ func (*UnimplementedQueryServer) ShowAllChains(ctx context.Context, req *QuerySh
 func (*UnimplementedQueryServer) ShowChainInfo(ctx context.Context, req *QueryShowChainInfoRequest) (*QueryShowChainInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ShowChainInfo not implemented")
 }
+func (*UnimplementedQueryServer) SpecValidate(ctx context.Context, req *QueryValidateSpecRequest) (*QueryValidateSpecResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SpecValidate not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -938,6 +1056,24 @@ func _Query_ShowChainInfo_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_SpecValidate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidateSpecRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SpecValidate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.spec.Query/SpecValidate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SpecValidate(ctx, req.(*QueryValidateSpecRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "lavanet.lava.spec.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -970,6 +1106,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ShowChainInfo",
 			Handler:    _Query_ShowChainInfo_Handler,
 		},
+		{
+			MethodName: "SpecValidate",
+			Handler:    _Query_SpecValidate_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "spec/query.proto",
@@ -1406,6 +1546,85 @@ func (m *QueryShowChainInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryValidateSpecRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidateSpecRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidateSpecRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Specs) > 0 {
+		for iNdEx := len(m.Specs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Specs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidateSpecResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidateSpecResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidateSpecResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Errors) > 0 {
+		for iNdEx := len(m.Errors) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Errors[iNdEx])
+			copy(dAtA[i:], m.Errors[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Errors[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Valid {
+		i--
+		if m.Valid {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -1597,6 +1816,39 @@ func (m *QueryShowChainInfoResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryValidateSpecRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Specs) > 0 {
+		for _, e := range m.Specs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryValidateSpecResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Valid {
+		n += 2
+	}
+	if len(m.Errors) > 0 {
+		for _, s := range m.Errors {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2731,6 +2983,192 @@ func (m *QueryShowChainInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryValidateSpecRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidateSpecRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidateSpecRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Specs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Specs = append(m.Specs, Spec{})
+			if err := m.Specs[len(m.Specs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidateSpecResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidateSpecResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidateSpecResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Valid", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Valid = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Errors", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Errors = append(m.Errors, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
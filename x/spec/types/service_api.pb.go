@@ -32,7 +32,9 @@ const (
 	PARSER_FUNC_PARSE_DICTIONARY            PARSER_FUNC = 3
 	PARSER_FUNC_PARSE_DICTIONARY_OR_ORDERED PARSER_FUNC = 4
 	// reserved
-	PARSER_FUNC_DEFAULT PARSER_FUNC = 6
+	PARSER_FUNC_DEFAULT        PARSER_FUNC = 6
+	PARSER_FUNC_PARSE_JSONPATH PARSER_FUNC = 7
+	PARSER_FUNC_PARSE_BLOCK_ID PARSER_FUNC = 8
 )
 
 var PARSER_FUNC_name = map[int32]string{
@@ -42,6 +44,8 @@ var PARSER_FUNC_name = map[int32]string{
 	3: "PARSE_DICTIONARY",
 	4: "PARSE_DICTIONARY_OR_ORDERED",
 	6: "DEFAULT",
+	7: "PARSE_JSONPATH",
+	8: "PARSE_BLOCK_ID",
 }
 
 var PARSER_FUNC_value = map[string]int32{
@@ -51,6 +55,8 @@ var PARSER_FUNC_value = map[string]int32{
 	"PARSE_DICTIONARY":            3,
 	"PARSE_DICTIONARY_OR_ORDERED": 4,
 	"DEFAULT":                     6,
+	"PARSE_JSONPATH":              7,
+	"PARSE_BLOCK_ID":              8,
 }
 
 func (x PARSER_FUNC) String() string {
@@ -62,13 +68,16 @@ func (PARSER_FUNC) EnumDescriptor() ([]byte, []int) {
 }
 
 type ServiceApi struct {
-	Name          string         `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	BlockParsing  BlockParser    `protobuf:"bytes,2,opt,name=block_parsing,json=blockParsing,proto3" json:"block_parsing"`
-	ComputeUnits  uint64         `protobuf:"varint,3,opt,name=compute_units,json=computeUnits,proto3" json:"compute_units,omitempty"`
-	Enabled       bool           `protobuf:"varint,4,opt,name=enabled,proto3" json:"enabled,omitempty"`
-	ApiInterfaces []ApiInterface `protobuf:"bytes,5,rep,name=api_interfaces,json=apiInterfaces,proto3" json:"api_interfaces"`
-	Reserved      *SpecCategory  `protobuf:"bytes,6,opt,name=reserved,proto3" json:"reserved,omitempty"`
-	Parsing       Parsing        `protobuf:"bytes,7,opt,name=parsing,proto3" json:"parsing"`
+	Name                string         `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	BlockParsing        BlockParser    `protobuf:"bytes,2,opt,name=block_parsing,json=blockParsing,proto3" json:"block_parsing"`
+	ComputeUnits        uint64         `protobuf:"varint,3,opt,name=compute_units,json=computeUnits,proto3" json:"compute_units,omitempty"`
+	Enabled             bool           `protobuf:"varint,4,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	ApiInterfaces       []ApiInterface `protobuf:"bytes,5,rep,name=api_interfaces,json=apiInterfaces,proto3" json:"api_interfaces"`
+	Reserved            *SpecCategory  `protobuf:"bytes,6,opt,name=reserved,proto3" json:"reserved,omitempty"`
+	Parsing             Parsing        `protobuf:"bytes,7,opt,name=parsing,proto3" json:"parsing"`
+	AddOn               string         `protobuf:"bytes,8,opt,name=add_on,json=addOn,proto3" json:"add_on,omitempty"`
+	Tags                []string       `protobuf:"bytes,9,rep,name=tags,proto3" json:"tags,omitempty"`
+	ResponseIgnorePaths []string       `protobuf:"bytes,10,rep,name=response_ignore_paths,json=responseIgnorePaths,proto3" json:"response_ignore_paths,omitempty"`
 }
 
 func (m *ServiceApi) Reset()         { *m = ServiceApi{} }
@@ -153,6 +162,27 @@ func (m *ServiceApi) GetParsing() Parsing {
 	return Parsing{}
 }
 
+func (m *ServiceApi) GetAddOn() string {
+	if m != nil {
+		return m.AddOn
+	}
+	return ""
+}
+
+func (m *ServiceApi) GetTags() []string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *ServiceApi) GetResponseIgnorePaths() []string {
+	if m != nil {
+		return m.ResponseIgnorePaths
+	}
+	return nil
+}
+
 type Parsing struct {
 	FunctionTag      string      `protobuf:"bytes,1,opt,name=function_tag,json=functionTag,proto3" json:"function_tag,omitempty"`
 	FunctionTemplate string      `protobuf:"bytes,2,opt,name=function_template,json=functionTemplate,proto3" json:"function_template,omitempty"`
@@ -445,55 +475,60 @@ func init() {
 func init() { proto.RegisterFile("spec/service_api.proto", fileDescriptor_3323a3ad252c5ed4) }
 
 var fileDescriptor_3323a3ad252c5ed4 = []byte{
-	// 760 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xcd, 0x6e, 0xeb, 0x44,
-	0x14, 0x8e, 0xf3, 0xd3, 0x38, 0xc7, 0x4e, 0x71, 0xe7, 0x16, 0x88, 0x0a, 0x38, 0x21, 0xdc, 0x45,
-	0x04, 0x52, 0x22, 0x5d, 0x76, 0xdc, 0x05, 0x72, 0x7e, 0x8a, 0x22, 0x4a, 0x52, 0x4d, 0xd3, 0x2b,
-	0x95, 0x8d, 0x35, 0x71, 0x26, 0xbe, 0x23, 0x1c, 0xdb, 0x1a, 0x8f, 0xc3, 0xbd, 0x6b, 0xc4, 0x9e,
-	0xa7, 0x40, 0x48, 0x48, 0x3c, 0x47, 0x97, 0x5d, 0xb2, 0x42, 0x28, 0x7d, 0x02, 0xde, 0x00, 0xcd,
-	0xc4, 0x4e, 0x53, 0xc8, 0xa2, 0x77, 0xe5, 0x39, 0xdf, 0x39, 0x67, 0xe6, 0x9b, 0xef, 0x3b, 0x1e,
-	0xf8, 0x20, 0x89, 0xa9, 0xd7, 0x4b, 0x28, 0x5f, 0x33, 0x8f, 0xba, 0x24, 0x66, 0xdd, 0x98, 0x47,
-	0x22, 0x42, 0x27, 0x01, 0x59, 0x93, 0x90, 0x8a, 0xae, 0xfc, 0x76, 0x65, 0xd1, 0xd9, 0xa9, 0x1f,
-	0xf9, 0x91, 0xca, 0xf6, 0xe4, 0x6a, 0x5b, 0xd8, 0xfe, 0xa7, 0x08, 0x70, 0xb5, 0x6d, 0x77, 0x62,
-	0x86, 0x10, 0x94, 0x43, 0xb2, 0xa2, 0x0d, 0xad, 0xa5, 0x75, 0x6a, 0x58, 0xad, 0xd1, 0x18, 0xea,
-	0xf3, 0x20, 0xf2, 0x7e, 0x70, 0x63, 0xc2, 0x13, 0x16, 0xfa, 0x8d, 0x62, 0x4b, 0xeb, 0x18, 0x2f,
-	0xec, 0xee, 0xff, 0xce, 0xe8, 0xf6, 0x65, 0xdd, 0x25, 0xe1, 0x09, 0xe5, 0xfd, 0xf2, 0xed, 0x5f,
-	0xcd, 0x02, 0x36, 0xe7, 0x39, 0xc4, 0x42, 0x1f, 0x7d, 0x06, 0x75, 0x2f, 0x5a, 0xc5, 0xa9, 0xa0,
-	0x6e, 0x1a, 0x32, 0x91, 0x34, 0x4a, 0x2d, 0xad, 0x53, 0xc6, 0x66, 0x06, 0x5e, 0x4b, 0x0c, 0x35,
-	0xa0, 0x4a, 0x43, 0x32, 0x0f, 0xe8, 0xa2, 0x51, 0x6e, 0x69, 0x1d, 0x1d, 0xe7, 0x21, 0xba, 0x80,
-	0x63, 0x12, 0x33, 0x97, 0x85, 0x82, 0xf2, 0x25, 0xf1, 0x68, 0xd2, 0xa8, 0xb4, 0x4a, 0x1d, 0xe3,
-	0x45, 0xf3, 0x00, 0x15, 0x27, 0x66, 0xe3, 0xbc, 0x2e, 0xe3, 0x52, 0x27, 0x7b, 0x58, 0x82, 0x5e,
-	0x82, 0xce, 0xa9, 0x94, 0x8e, 0x2e, 0x1a, 0x47, 0xea, 0x4a, 0x87, 0xf6, 0xb9, 0x8a, 0xa9, 0x37,
-	0x20, 0x82, 0xfa, 0x11, 0x7f, 0x8b, 0x77, 0x0d, 0xe8, 0x2b, 0xa8, 0xe6, 0x72, 0x54, 0x55, 0xef,
-	0xd9, 0x81, 0xde, 0xec, 0xda, 0xd9, 0xf1, 0x79, 0x43, 0xfb, 0x57, 0x0d, 0xaa, 0xb9, 0x22, 0x9f,
-	0x82, 0xb9, 0x4c, 0x43, 0x4f, 0xb0, 0x28, 0x74, 0x05, 0xf1, 0x33, 0xe1, 0x8d, 0x1c, 0x9b, 0x11,
-	0x1f, 0x7d, 0x01, 0x27, 0x0f, 0x25, 0x74, 0x15, 0x07, 0x44, 0x50, 0xe5, 0x41, 0x0d, 0x5b, 0xbb,
-	0xba, 0x0c, 0x47, 0xdf, 0xc2, 0x31, 0xa7, 0x49, 0x1a, 0x88, 0x9d, 0x5b, 0xa5, 0x77, 0x70, 0xab,
-	0xbe, 0xed, 0xcd, 0xc8, 0xb5, 0x7f, 0x2e, 0x82, 0xb9, 0xaf, 0x23, 0xfa, 0x18, 0x6a, 0x3b, 0xf1,
-	0x33, 0xaa, 0x0f, 0x80, 0x1c, 0x1e, 0xf1, 0x36, 0xce, 0xb9, 0xa9, 0x35, 0xea, 0xc2, 0x33, 0xfa,
-	0x46, 0x70, 0xe2, 0x1e, 0xf2, 0xfd, 0x44, 0xa5, 0x06, 0xfb, 0xe6, 0xbf, 0x04, 0xdd, 0xcb, 0xd4,
-	0x56, 0xee, 0x3f, 0xc5, 0x94, 0xbc, 0x01, 0xbd, 0x82, 0x0f, 0xa3, 0x35, 0xe5, 0x3f, 0x72, 0x26,
-	0xa8, 0xfb, 0x78, 0x66, 0x2b, 0x4f, 0x51, 0x01, 0xbf, 0xbf, 0x6b, 0xef, 0xef, 0x8d, 0x6d, 0xfb,
-	0x0f, 0x0d, 0x8c, 0xbd, 0x32, 0xf4, 0x09, 0x40, 0xac, 0x56, 0x2e, 0xe1, 0xd2, 0xb2, 0x92, 0xd4,
-	0x61, 0x8b, 0x38, 0xdc, 0x47, 0x5f, 0x83, 0x91, 0xa5, 0xa5, 0x3d, 0x4a, 0x8e, 0xe3, 0x83, 0x47,
-	0x5f, 0x3a, 0xf8, 0x6a, 0x84, 0xdd, 0xf3, 0xeb, 0xc9, 0x00, 0x67, 0x3b, 0x9e, 0xa7, 0xa1, 0x27,
-	0x7f, 0x93, 0x05, 0x5d, 0x12, 0xe9, 0xe2, 0x9a, 0x04, 0x29, 0x55, 0x72, 0xd5, 0xb0, 0x99, 0x81,
-	0xaf, 0x24, 0x86, 0xce, 0x40, 0xa7, 0xa1, 0x17, 0x2d, 0xe4, 0xed, 0xca, 0x2a, 0xbf, 0x8b, 0xdb,
-	0xbf, 0x6b, 0x60, 0xee, 0x6b, 0x84, 0x9e, 0xcb, 0x1d, 0x05, 0xe5, 0x2b, 0x16, 0xb2, 0x44, 0x30,
-	0x4f, 0x99, 0xa7, 0xe3, 0xc7, 0x20, 0x3a, 0x85, 0x4a, 0x10, 0x79, 0x24, 0x50, 0x94, 0x75, 0xbc,
-	0x0d, 0x50, 0x1b, 0xcc, 0x24, 0x9d, 0x27, 0x1e, 0x67, 0xb1, 0x1c, 0x35, 0x45, 0x46, 0xc7, 0x8f,
-	0x30, 0x49, 0x26, 0x11, 0x44, 0xd0, 0x65, 0x1a, 0x28, 0x32, 0x75, 0xbc, 0x8b, 0x51, 0x13, 0x8c,
-	0xd7, 0x24, 0xf4, 0x59, 0xe8, 0xcb, 0x07, 0x4a, 0x39, 0xa1, 0x63, 0xc8, 0x20, 0x27, 0x66, 0x9f,
-	0xff, 0xa4, 0x81, 0xb1, 0x27, 0x05, 0xaa, 0x41, 0x65, 0xf4, 0xdd, 0xe5, 0xec, 0xc6, 0x2a, 0x20,
-	0x0b, 0x4c, 0x95, 0x71, 0xfb, 0x37, 0xae, 0x83, 0xbf, 0xb1, 0x34, 0xf4, 0x0c, 0xde, 0xdb, 0x22,
-	0x03, 0x67, 0x32, 0x9d, 0x8c, 0x07, 0xce, 0x85, 0x55, 0x44, 0xa7, 0x60, 0x6d, 0xc1, 0xe1, 0x78,
-	0x30, 0x1b, 0x4f, 0x27, 0x0e, 0xbe, 0xb1, 0x4a, 0xa8, 0x09, 0x1f, 0xfd, 0x17, 0x75, 0xa7, 0xd8,
-	0x9d, 0xe2, 0xe1, 0x08, 0x8f, 0x86, 0x56, 0x19, 0x19, 0x50, 0x1d, 0x8e, 0xce, 0x9d, 0xeb, 0x8b,
-	0x99, 0x75, 0xd4, 0xef, 0xff, 0xb6, 0xb1, 0xb5, 0xdb, 0x8d, 0xad, 0xdd, 0x6d, 0x6c, 0xed, 0xef,
-	0x8d, 0xad, 0xfd, 0x72, 0x6f, 0x17, 0xee, 0xee, 0xed, 0xc2, 0x9f, 0xf7, 0x76, 0xe1, 0xfb, 0xe7,
-	0x3e, 0x13, 0xaf, 0xd3, 0x79, 0xd7, 0x8b, 0x56, 0xbd, 0xcc, 0x48, 0xf5, 0xed, 0xbd, 0xe9, 0xa9,
-	0x27, 0x58, 0x0e, 0x7b, 0x32, 0x3f, 0x52, 0x8f, 0xea, 0x97, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff,
-	0x68, 0x1c, 0x7f, 0x3b, 0x97, 0x05, 0x00, 0x00,
+	// 837 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0x4f, 0x6f, 0xe3, 0x44,
+	0x14, 0xaf, 0x9b, 0xa4, 0x71, 0x5e, 0x92, 0xe2, 0x4e, 0x5b, 0xb0, 0x0a, 0xa4, 0x21, 0xec, 0x21,
+	0x02, 0x29, 0x91, 0xca, 0x8d, 0x3d, 0x20, 0xe7, 0x4f, 0x21, 0x6c, 0x49, 0xa2, 0x69, 0xba, 0x52,
+	0xb9, 0x58, 0x13, 0x67, 0xea, 0x8e, 0x70, 0xc6, 0xd6, 0xcc, 0xb8, 0xec, 0x7e, 0x00, 0xee, 0x7c,
+	0x0a, 0x84, 0xb4, 0x12, 0x9f, 0x63, 0x8f, 0x3d, 0x72, 0x42, 0xa8, 0xfd, 0x22, 0x68, 0x26, 0x76,
+	0x9a, 0x42, 0x91, 0x76, 0x4f, 0x7e, 0xf3, 0x7b, 0xef, 0xcd, 0xfc, 0xfc, 0xfb, 0xbd, 0xb1, 0xe1,
+	0x43, 0x99, 0xd0, 0xa0, 0x2b, 0xa9, 0xb8, 0x61, 0x01, 0xf5, 0x49, 0xc2, 0x3a, 0x89, 0x88, 0x55,
+	0x8c, 0xf6, 0x22, 0x72, 0x43, 0x38, 0x55, 0x1d, 0xfd, 0xec, 0xe8, 0xa2, 0xa3, 0x83, 0x30, 0x0e,
+	0x63, 0x93, 0xed, 0xea, 0x68, 0x55, 0xd8, 0xba, 0x2d, 0x00, 0x9c, 0xaf, 0xda, 0xbd, 0x84, 0x21,
+	0x04, 0x45, 0x4e, 0x96, 0xd4, 0xb5, 0x9a, 0x56, 0xbb, 0x82, 0x4d, 0x8c, 0x46, 0x50, 0x9f, 0x47,
+	0x71, 0xf0, 0x93, 0x9f, 0x10, 0x21, 0x19, 0x0f, 0xdd, 0xed, 0xa6, 0xd5, 0xae, 0x9e, 0x34, 0x3a,
+	0xff, 0x39, 0xa3, 0xd3, 0xd3, 0x75, 0x53, 0x22, 0x24, 0x15, 0xbd, 0xe2, 0xdb, 0xbf, 0x8e, 0xb7,
+	0x70, 0x6d, 0x9e, 0x43, 0x8c, 0x87, 0xe8, 0x73, 0xa8, 0x07, 0xf1, 0x32, 0x49, 0x15, 0xf5, 0x53,
+	0xce, 0x94, 0x74, 0x0b, 0x4d, 0xab, 0x5d, 0xc4, 0xb5, 0x0c, 0xbc, 0xd0, 0x18, 0x72, 0xa1, 0x4c,
+	0x39, 0x99, 0x47, 0x74, 0xe1, 0x16, 0x9b, 0x56, 0xdb, 0xc6, 0xf9, 0x12, 0x9d, 0xc1, 0x2e, 0x49,
+	0x98, 0xcf, 0xb8, 0xa2, 0xe2, 0x8a, 0x04, 0x54, 0xba, 0xa5, 0x66, 0xa1, 0x5d, 0x3d, 0x39, 0x7e,
+	0x82, 0x8a, 0x97, 0xb0, 0x51, 0x5e, 0x97, 0x71, 0xa9, 0x93, 0x0d, 0x4c, 0xa2, 0xe7, 0x60, 0x0b,
+	0xaa, 0xa5, 0xa3, 0x0b, 0x77, 0xa7, 0x69, 0xfd, 0xcf, 0x3e, 0xe7, 0x09, 0x0d, 0xfa, 0x44, 0xd1,
+	0x30, 0x16, 0xaf, 0xf1, 0xba, 0x01, 0x7d, 0x0d, 0xe5, 0x5c, 0x8e, 0xb2, 0xe9, 0x3d, 0x7a, 0xa2,
+	0x37, 0x7b, 0xed, 0xec, 0xf8, 0xbc, 0x01, 0x1d, 0xc2, 0x0e, 0x59, 0x2c, 0xfc, 0x98, 0xbb, 0xb6,
+	0x91, 0xb9, 0x44, 0x16, 0x8b, 0x09, 0xd7, 0xda, 0x2b, 0x12, 0x4a, 0xb7, 0xd2, 0x2c, 0x68, 0xed,
+	0x75, 0x8c, 0x4e, 0xe0, 0x50, 0x50, 0x99, 0xc4, 0x5c, 0x52, 0x9f, 0x85, 0x3c, 0x16, 0xd4, 0x4f,
+	0x88, 0xba, 0x96, 0x2e, 0x98, 0xa2, 0xfd, 0x3c, 0x39, 0x32, 0xb9, 0xa9, 0x4e, 0xb5, 0x7e, 0xb3,
+	0xa0, 0x9c, 0x0b, 0xfe, 0x19, 0xd4, 0xae, 0x52, 0x1e, 0x28, 0x16, 0x73, 0x5f, 0x91, 0x30, 0xf3,
+	0xb5, 0x9a, 0x63, 0x33, 0x12, 0xa2, 0x2f, 0x61, 0xef, 0xa1, 0x84, 0x2e, 0x93, 0x88, 0x28, 0x6a,
+	0x2c, 0xae, 0x60, 0x67, 0x5d, 0x97, 0xe1, 0xe8, 0x05, 0xec, 0x0a, 0x2a, 0xd3, 0x48, 0xad, 0x87,
+	0xa1, 0xf0, 0x1e, 0xc3, 0x50, 0x5f, 0xf5, 0x66, 0xe4, 0x5a, 0xbf, 0x6c, 0x43, 0x6d, 0xd3, 0x26,
+	0xf4, 0x09, 0x54, 0xd6, 0xde, 0x66, 0x54, 0x1f, 0x00, 0xa3, 0xcf, 0xeb, 0x24, 0xe7, 0x66, 0x62,
+	0xd4, 0x81, 0x7d, 0xfa, 0x4a, 0x09, 0xe2, 0x3f, 0x35, 0x56, 0x7b, 0x26, 0xd5, 0xdf, 0x9c, 0xad,
+	0xe7, 0x60, 0x07, 0x99, 0x99, 0x6e, 0xf1, 0x1d, 0x3d, 0xcf, 0x1b, 0xd0, 0x4b, 0xf8, 0x28, 0xbe,
+	0xa1, 0xe2, 0x67, 0xc1, 0x14, 0xf5, 0x1f, 0x5f, 0x89, 0xd2, 0xbb, 0xa8, 0x80, 0x0f, 0xd7, 0xed,
+	0xbd, 0x8d, 0x5b, 0xd1, 0xfa, 0xc3, 0x82, 0xea, 0x46, 0x19, 0xfa, 0x14, 0x20, 0x31, 0x91, 0x4f,
+	0x84, 0xb6, 0x4c, 0x3b, 0x5d, 0x59, 0x21, 0x9e, 0x08, 0xd1, 0x37, 0x50, 0xcd, 0xd2, 0xda, 0x1e,
+	0x23, 0xc7, 0xee, 0x93, 0x47, 0x4f, 0x3d, 0x7c, 0x3e, 0xc4, 0xfe, 0xe9, 0xc5, 0xb8, 0x8f, 0xb3,
+	0x1d, 0x4f, 0x53, 0x1e, 0xe8, 0x5b, 0xb8, 0xa0, 0x57, 0x44, 0xbb, 0x78, 0x43, 0xa2, 0x94, 0x1a,
+	0xb9, 0x2a, 0xb8, 0x96, 0x81, 0x2f, 0x35, 0x86, 0x8e, 0xc0, 0xa6, 0x3c, 0x88, 0x17, 0xfa, 0xed,
+	0x8a, 0x26, 0xbf, 0x5e, 0xb7, 0xde, 0x58, 0x50, 0xdb, 0xd4, 0x08, 0x3d, 0xd3, 0x3b, 0x2a, 0x2a,
+	0x96, 0x8c, 0x33, 0xa9, 0x58, 0x60, 0xcc, 0xb3, 0xf1, 0x63, 0x10, 0x1d, 0x40, 0x29, 0x8a, 0x03,
+	0x12, 0x19, 0xca, 0x36, 0x5e, 0x2d, 0x50, 0x0b, 0x6a, 0x32, 0x9d, 0xcb, 0x40, 0xb0, 0x44, 0x8f,
+	0x9a, 0x21, 0x63, 0xe3, 0x47, 0x98, 0x26, 0x23, 0x15, 0x51, 0xf4, 0x2a, 0x8d, 0x0c, 0x99, 0x3a,
+	0x5e, 0xaf, 0xd1, 0x31, 0x54, 0xaf, 0x09, 0x0f, 0x19, 0x0f, 0xf5, 0xf7, 0xcf, 0x38, 0x61, 0x63,
+	0xc8, 0x20, 0x2f, 0x61, 0x5f, 0xbc, 0xb1, 0xa0, 0xba, 0x21, 0x05, 0xaa, 0x40, 0x69, 0xf8, 0xc3,
+	0x74, 0x76, 0xe9, 0x6c, 0x21, 0x07, 0x6a, 0x26, 0xe3, 0xf7, 0x2e, 0x7d, 0x0f, 0x7f, 0xeb, 0x58,
+	0x68, 0x1f, 0x3e, 0x58, 0x21, 0x7d, 0x6f, 0x3c, 0x19, 0x8f, 0xfa, 0xde, 0x99, 0xb3, 0x8d, 0x0e,
+	0xc0, 0x59, 0x81, 0x83, 0x51, 0x7f, 0x36, 0x9a, 0x8c, 0x3d, 0x7c, 0xe9, 0x14, 0xd0, 0x31, 0x7c,
+	0xfc, 0x6f, 0xd4, 0x9f, 0x60, 0x7f, 0x82, 0x07, 0x43, 0x3c, 0x1c, 0x38, 0x45, 0x54, 0x85, 0xf2,
+	0x60, 0x78, 0xea, 0x5d, 0x9c, 0xcd, 0x9c, 0x1d, 0x84, 0x60, 0x77, 0x55, 0xfd, 0xfd, 0xf9, 0x64,
+	0x3c, 0xf5, 0x66, 0xdf, 0x39, 0xe5, 0x07, 0xac, 0x77, 0x36, 0xe9, 0xbf, 0xf0, 0x47, 0x03, 0xc7,
+	0xee, 0xf5, 0x7e, 0xbf, 0x6b, 0x58, 0x6f, 0xef, 0x1a, 0xd6, 0xed, 0x5d, 0xc3, 0xfa, 0xfb, 0xae,
+	0x61, 0xfd, 0x7a, 0xdf, 0xd8, 0xba, 0xbd, 0x6f, 0x6c, 0xfd, 0x79, 0xdf, 0xd8, 0xfa, 0xf1, 0x59,
+	0xc8, 0xd4, 0x75, 0x3a, 0xef, 0x04, 0xf1, 0xb2, 0x9b, 0x19, 0x6e, 0x9e, 0xdd, 0x57, 0x5d, 0xf3,
+	0x27, 0xd0, 0x97, 0x42, 0xce, 0x77, 0xcc, 0xb7, 0xfd, 0xab, 0x7f, 0x06, 0x00, 0x4a, 0x28, 0x27,
+	0xb3, 0x1e, 0x06, 0x00, 0x00,
 }
 
 func (this *ServiceApi) Equal(that interface{}) bool {
@@ -541,6 +576,25 @@ func (this *ServiceApi) Equal(that interface{}) bool {
 	if !this.Parsing.Equal(&that1.Parsing) {
 		return false
 	}
+	if this.AddOn != that1.AddOn {
+		return false
+	}
+	if len(this.Tags) != len(that1.Tags) {
+		return false
+	}
+	for i := range this.Tags {
+		if this.Tags[i] != that1.Tags[i] {
+			return false
+		}
+	}
+	if len(this.ResponseIgnorePaths) != len(that1.ResponseIgnorePaths) {
+		return false
+	}
+	for i := range this.ResponseIgnorePaths {
+		if this.ResponseIgnorePaths[i] != that1.ResponseIgnorePaths[i] {
+			return false
+		}
+	}
 	return true
 }
 func (this *Parsing) Equal(that interface{}) bool {
@@ -703,6 +757,31 @@ func (m *ServiceApi) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ResponseIgnorePaths) > 0 {
+		for iNdEx := len(m.ResponseIgnorePaths) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ResponseIgnorePaths[iNdEx])
+			copy(dAtA[i:], m.ResponseIgnorePaths[iNdEx])
+			i = encodeVarintServiceApi(dAtA, i, uint64(len(m.ResponseIgnorePaths[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.Tags) > 0 {
+		for iNdEx := len(m.Tags) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Tags[iNdEx])
+			copy(dAtA[i:], m.Tags[iNdEx])
+			i = encodeVarintServiceApi(dAtA, i, uint64(len(m.Tags[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.AddOn) > 0 {
+		i -= len(m.AddOn)
+		copy(dAtA[i:], m.AddOn)
+		i = encodeVarintServiceApi(dAtA, i, uint64(len(m.AddOn)))
+		i--
+		dAtA[i] = 0x42
+	}
 	{
 		size, err := m.Parsing.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -1047,6 +1126,22 @@ func (m *ServiceApi) Size() (n int) {
 	}
 	l = m.Parsing.Size()
 	n += 1 + l + sovServiceApi(uint64(l))
+	l = len(m.AddOn)
+	if l > 0 {
+		n += 1 + l + sovServiceApi(uint64(l))
+	}
+	if len(m.Tags) > 0 {
+		for _, s := range m.Tags {
+			l = len(s)
+			n += 1 + l + sovServiceApi(uint64(l))
+		}
+	}
+	if len(m.ResponseIgnorePaths) > 0 {
+		for _, s := range m.ResponseIgnorePaths {
+			l = len(s)
+			n += 1 + l + sovServiceApi(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1389,6 +1484,102 @@ func (m *ServiceApi) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddOn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServiceApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddOn = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServiceApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tags = append(m.Tags, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseIgnorePaths", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServiceApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResponseIgnorePaths = append(m.ResponseIgnorePaths, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipServiceApi(dAtA[iNdEx:])
@@ -3,14 +3,48 @@ package types
 import (
 	fmt "fmt"
 	"strconv"
+	"strings"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	epochstoragetypes "github.com/lavanet/lava/x/epochstorage/types"
+	"golang.org/x/exp/slices"
 )
 
 const minCU = 1
 
 func (spec Spec) ValidateSpec(maxCU uint64) (map[string]string, error) {
 	details := map[string]string{"spec": spec.Name, "status": strconv.FormatBool(spec.Enabled), "chainID": spec.Index}
+	for _, problem := range spec.validate(maxCU) {
+		if problem.api != "" {
+			details["api"] = problem.api
+		}
+		return details, problem.err
+	}
+	return details, nil
+}
+
+// ValidationReport returns all the problems of the spec, where ValidateSpec stops at the first
+func (spec Spec) ValidationReport(maxCU uint64) []string {
+	report := []string{}
+	for _, problem := range spec.validate(maxCU) {
+		if problem.api != "" {
+			report = append(report, fmt.Sprintf("api %s: %s", problem.api, problem.err))
+		} else {
+			report = append(report, problem.err.Error())
+		}
+	}
+	return report
+}
+
+type specProblem struct {
+	api string
+	err error
+}
+
+func (spec Spec) validate(maxCU uint64) (problems []specProblem) {
+	fail := func(api string, err error) {
+		problems = append(problems, specProblem{api: api, err: err})
+	}
 	functionTags := map[string]bool{}
 
 	availableAPIInterface := map[string]struct{}{
@@ -25,42 +59,87 @@ func (spec Spec) ValidateSpec(maxCU uint64) (map[string]string, error) {
 	}
 
 	if spec.ReliabilityThreshold == 0 {
-		return details, fmt.Errorf("ReliabilityThreshold can't be zero")
+		fail("", fmt.Errorf("ReliabilityThreshold can't be zero"))
 	}
 
 	if spec.BlocksInFinalizationProof == 0 {
-		return details, fmt.Errorf("BlocksInFinalizationProof can't be zero")
+		fail("", fmt.Errorf("BlocksInFinalizationProof can't be zero"))
 	}
 
 	if spec.AverageBlockTime <= 0 {
-		return details, fmt.Errorf("AverageBlockTime can't be zero")
+		fail("", fmt.Errorf("AverageBlockTime can't be zero"))
 	}
 
 	if spec.AllowedBlockLagForQosSync <= 0 {
-		return details, fmt.Errorf("AllowedBlockLagForQosSync can't be zero")
+		fail("", fmt.Errorf("AllowedBlockLagForQosSync can't be zero"))
 	}
 
-	if spec.MinStakeClient.Denom != epochstoragetypes.TokenDenom || spec.MinStakeClient.Amount.IsZero() {
-		return details, fmt.Errorf("MinStakeClient can't be zero andmust have denom of ulava")
+	if spec.MinStakeClient.Denom != epochstoragetypes.TokenDenom || spec.MinStakeClient.Amount.IsNil() || spec.MinStakeClient.Amount.IsZero() {
+		fail("", fmt.Errorf("MinStakeClient can't be zero andmust have denom of ulava"))
 	}
 
-	if spec.MinStakeProvider.Denom != epochstoragetypes.TokenDenom || spec.MinStakeProvider.Amount.IsZero() {
-		return details, fmt.Errorf("MinStakeProvider can't be zero andmust have denom of ulava")
+	if spec.MinStakeProvider.Denom != epochstoragetypes.TokenDenom || spec.MinStakeProvider.Amount.IsNil() || spec.MinStakeProvider.Amount.IsZero() {
+		fail("", fmt.Errorf("MinStakeProvider can't be zero andmust have denom of ulava"))
 	}
 
+	if spec.MinSelfStake().IsPositive() && spec.MinSelfStakeProvider.Denom != epochstoragetypes.TokenDenom {
+		fail("", fmt.Errorf("MinSelfStakeProvider must have denom of ulava"))
+	}
+
+	if spec.PairingStakeCap().IsPositive() && spec.MaxPairingStake.Denom != epochstoragetypes.TokenDenom {
+		fail("", fmt.Errorf("MaxPairingStake must have denom of ulava"))
+	}
+
+	if spec.MinQosScorePercent > 100 {
+		fail("", fmt.Errorf("MinQosScorePercent can't be above 100"))
+	}
+
+	qosExemptProviders := map[string]struct{}{}
+	for _, provider := range spec.QosExemptProviders {
+		if _, err := sdk.AccAddressFromBech32(provider); err != nil {
+			fail("", fmt.Errorf("invalid qos exempt provider %s: %w", provider, err))
+		}
+		if _, ok := qosExemptProviders[provider]; ok {
+			fail("", fmt.Errorf("duplicate qos exempt provider %s", provider))
+		}
+		qosExemptProviders[provider] = struct{}{}
+	}
+
+	apiNames := map[string]struct{}{}
 	for _, api := range spec.Apis {
+		if _, ok := apiNames[api.Name]; ok {
+			fail(api.Name, fmt.Errorf("duplicate api name"))
+		}
+		apiNames[api.Name] = struct{}{}
+
 		if api.ComputeUnits < minCU || api.ComputeUnits > maxCU {
-			details["api"] = api.Name
-			return details, fmt.Errorf("compute units out or range")
+			fail(api.Name, fmt.Errorf("compute units out or range"))
+		}
+
+		apiTags := map[string]struct{}{}
+		for _, tag := range api.Tags {
+			if !slices.Contains(SupportedApiTags[:], tag) {
+				fail(api.Name, fmt.Errorf("unsupported api tag %s", tag))
+			}
+			if _, ok := apiTags[tag]; ok {
+				fail(api.Name, fmt.Errorf("duplicate api tag %s", tag))
+			}
+			apiTags[tag] = struct{}{}
+		}
+
+		for _, path := range api.ResponseIgnorePaths {
+			if err := ValidateResponseIgnorePath(path); err != nil {
+				fail(api.Name, err)
+			}
 		}
 
 		if len(api.ApiInterfaces) == 0 {
-			return details, fmt.Errorf("api interface list empty for %v", api.Name)
+			fail("", fmt.Errorf("api interface list empty for %v", api.Name))
 		}
 
 		for _, apiInterface := range api.ApiInterfaces {
 			if _, ok := availableAPIInterface[apiInterface.Interface]; !ok {
-				return details, fmt.Errorf("unsupported api interface %v", apiInterface.Interface)
+				fail("", fmt.Errorf("unsupported api interface %v", apiInterface.Interface))
 			}
 		}
 
@@ -75,12 +154,11 @@ func (spec Spec) ValidateSpec(maxCU uint64) (map[string]string, error) {
 			}
 
 			if !result {
-				details["api"] = api.Name
-				return details, fmt.Errorf("unsupported function tag")
+				fail(api.Name, fmt.Errorf("unsupported function tag"))
 			}
 			if api.Parsing.ResultParsing.Encoding != "" {
 				if _, ok := availavleEncodings[api.Parsing.ResultParsing.Encoding]; !ok {
-					return details, fmt.Errorf("unsupported api encoding %s in api %v ", api.Parsing.ResultParsing.Encoding, api)
+					fail("", fmt.Errorf("unsupported api encoding %s in api %v ", api.Parsing.ResultParsing.Encoding, api))
 				}
 			}
 		}
@@ -89,10 +167,52 @@ func (spec Spec) ValidateSpec(maxCU uint64) (map[string]string, error) {
 	if spec.DataReliabilityEnabled && spec.Enabled {
 		for _, tag := range []string{GET_BLOCKNUM, GET_BLOCK_BY_NUM} {
 			if found := functionTags[tag]; !found {
-				return details, fmt.Errorf("missing tagged functions for hash comparison: %s", tag)
+				fail("", fmt.Errorf("missing tagged functions for hash comparison: %s", tag))
 			}
 		}
 	}
 
-	return details, nil
+	return problems
+}
+
+// MinSelfStake returns the own stake a provider needs to be paired on the spec, zero when the spec doesn't set one
+func (spec Spec) MinSelfStake() sdk.Int {
+	if spec.MinSelfStakeProvider.Amount.IsNil() {
+		return sdk.ZeroInt()
+	}
+	return spec.MinSelfStakeProvider.Amount
+}
+
+// PairingStakeCap returns the most effective stake a provider is weighted by in pairing on the spec, zero when it isn't capped
+func (spec Spec) PairingStakeCap() sdk.Int {
+	if spec.MaxPairingStake.Amount.IsNil() {
+		return sdk.ZeroInt()
+	}
+	return spec.MaxPairingStake.Amount
+}
+
+// HasTag returns whether the api is tagged with tag
+func (api ServiceApi) HasTag(tag string) bool {
+	return slices.Contains(api.Tags, tag)
+}
+
+// ValidateResponseIgnorePath checks that a response ignore path is made of non empty keys, * matches any key or array index
+func ValidateResponseIgnorePath(path string) error {
+	for _, key := range strings.Split(path, ResponseIgnorePathSeparator) {
+		if key == "" {
+			return fmt.Errorf("invalid response ignore path %q", path)
+		}
+	}
+	return nil
+}
+
+// GetAddOns returns the add-ons the spec's apis belong to
+func (spec Spec) GetAddOns() []string {
+	addOns := []string{}
+	for _, api := range spec.Apis {
+		if api.AddOn != "" && !slices.Contains(addOns, api.AddOn) {
+			addOns = append(addOns, api.AddOn)
+		}
+	}
+	return addOns
 }
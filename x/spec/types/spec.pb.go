@@ -65,6 +65,12 @@ type Spec struct {
 	MinStakeProvider              types.Coin          `protobuf:"bytes,12,opt,name=min_stake_provider,json=minStakeProvider,proto3" json:"min_stake_provider"`
 	MinStakeClient                types.Coin          `protobuf:"bytes,13,opt,name=min_stake_client,json=minStakeClient,proto3" json:"min_stake_client"`
 	ProvidersTypes                Spec_ProvidersTypes `protobuf:"varint,14,opt,name=providers_types,json=providersTypes,proto3,enum=lavanet.lava.spec.Spec_ProvidersTypes" json:"providers_types,omitempty"`
+	MinSelfStakeProvider          types.Coin          `protobuf:"bytes,16,opt,name=min_self_stake_provider,json=minSelfStakeProvider,proto3" json:"min_self_stake_provider"`
+	MinSelfStakeProviderBlock     uint64              `protobuf:"varint,17,opt,name=min_self_stake_provider_block,json=minSelfStakeProviderBlock,proto3" json:"min_self_stake_provider_block,omitempty"`
+	MaxPairingStake               types.Coin          `protobuf:"bytes,18,opt,name=max_pairing_stake,json=maxPairingStake,proto3" json:"max_pairing_stake"`
+	MinQosScorePercent            uint32              `protobuf:"varint,19,opt,name=min_qos_score_percent,json=minQosScorePercent,proto3" json:"min_qos_score_percent,omitempty"`
+	QosExemptProviders            []string            `protobuf:"bytes,20,rep,name=qos_exempt_providers,json=qosExemptProviders,proto3" json:"qos_exempt_providers,omitempty"`
+	MaxPaginationLimit            uint64              `protobuf:"varint,21,opt,name=max_pagination_limit,json=maxPaginationLimit,proto3" json:"max_pagination_limit,omitempty"`
 }
 
 func (m *Spec) Reset()         { *m = Spec{} }
@@ -205,6 +211,48 @@ func (m *Spec) GetProvidersTypes() Spec_ProvidersTypes {
 	return Spec_dynamic
 }
 
+func (m *Spec) GetMinSelfStakeProvider() types.Coin {
+	if m != nil {
+		return m.MinSelfStakeProvider
+	}
+	return types.Coin{}
+}
+
+func (m *Spec) GetMinSelfStakeProviderBlock() uint64 {
+	if m != nil {
+		return m.MinSelfStakeProviderBlock
+	}
+	return 0
+}
+
+func (m *Spec) GetMaxPairingStake() types.Coin {
+	if m != nil {
+		return m.MaxPairingStake
+	}
+	return types.Coin{}
+}
+
+func (m *Spec) GetMinQosScorePercent() uint32 {
+	if m != nil {
+		return m.MinQosScorePercent
+	}
+	return 0
+}
+
+func (m *Spec) GetQosExemptProviders() []string {
+	if m != nil {
+		return m.QosExemptProviders
+	}
+	return nil
+}
+
+func (m *Spec) GetMaxPaginationLimit() uint64 {
+	if m != nil {
+		return m.MaxPaginationLimit
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("lavanet.lava.spec.Spec_ProvidersTypes", Spec_ProvidersTypes_name, Spec_ProvidersTypes_value)
 	proto.RegisterType((*Spec)(nil), "lavanet.lava.spec.Spec")
@@ -213,47 +261,55 @@ func init() {
 func init() { proto.RegisterFile("spec/spec.proto", fileDescriptor_c4cc771ffab81d0a) }
 
 var fileDescriptor_c4cc771ffab81d0a = []byte{
-	// 629 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xcf, 0x4e, 0xdc, 0x38,
-	0x18, 0x1f, 0xef, 0x0c, 0x03, 0x78, 0x96, 0x61, 0xd6, 0x62, 0x91, 0x41, 0x4b, 0x36, 0x45, 0x55,
-	0x95, 0x4a, 0x55, 0x22, 0xe0, 0xd0, 0xde, 0x2a, 0x06, 0x3a, 0x2a, 0x52, 0xab, 0xd2, 0x0c, 0xbd,
-	0xf4, 0x62, 0x39, 0x8e, 0x19, 0x2c, 0x12, 0x3b, 0x8d, 0xcd, 0x94, 0xe9, 0x53, 0xf4, 0x31, 0xfa,
-	0x28, 0x1c, 0x7a, 0xe0, 0xd8, 0x53, 0x55, 0x0d, 0x2f, 0x52, 0xd9, 0x49, 0x04, 0xa8, 0x3d, 0xf4,
-	0x62, 0xfb, 0xf3, 0xef, 0xcf, 0xf7, 0x8b, 0xf2, 0x19, 0xae, 0xea, 0x82, 0xb3, 0xc8, 0x2e, 0x61,
-	0x51, 0x2a, 0xa3, 0xd0, 0x3f, 0x19, 0x9d, 0x52, 0xc9, 0x4d, 0x68, 0xf7, 0xd0, 0x02, 0x9b, 0x6b,
-	0x13, 0x35, 0x51, 0x0e, 0x8d, 0xec, 0xa9, 0x22, 0x6e, 0xae, 0x57, 0x4a, 0x5e, 0x4e, 0x05, 0xe3,
-	0x84, 0x16, 0xa2, 0xbe, 0xf7, 0x98, 0xd2, 0xb9, 0xd2, 0x51, 0x42, 0x35, 0x8f, 0xa6, 0x3b, 0x09,
-	0x37, 0x74, 0x27, 0x62, 0x4a, 0xc8, 0x0a, 0xdf, 0xfe, 0xda, 0x85, 0x9d, 0x71, 0xc1, 0x19, 0x5a,
-	0x83, 0x0b, 0x42, 0xa6, 0xfc, 0x12, 0x03, 0x1f, 0x04, 0xcb, 0x71, 0x55, 0x20, 0x04, 0x3b, 0x92,
-	0xe6, 0x1c, 0xff, 0xe5, 0x2e, 0xdd, 0x19, 0x61, 0xb8, 0x28, 0xf2, 0x42, 0x95, 0x46, 0xe3, 0x55,
-	0xbf, 0x1d, 0x2c, 0xc7, 0x4d, 0x89, 0x9e, 0xc2, 0x0e, 0x2d, 0x84, 0xc6, 0x6d, 0xbf, 0x1d, 0xf4,
-	0x76, 0xb7, 0xc2, 0x5f, 0xc2, 0x87, 0xe3, 0x2a, 0xe0, 0x7e, 0x21, 0x86, 0x9d, 0xab, 0xef, 0xff,
-	0xb7, 0x62, 0x27, 0xb0, 0x96, 0x5c, 0xd2, 0x24, 0xe3, 0x29, 0xee, 0xf8, 0x20, 0x58, 0x8a, 0x9b,
-	0x12, 0xed, 0xc1, 0x7f, 0x4b, 0x9e, 0x09, 0x9a, 0x88, 0x4c, 0x98, 0x19, 0x31, 0x67, 0x25, 0xd7,
-	0x67, 0x2a, 0x4b, 0xf1, 0x82, 0x0f, 0x82, 0x95, 0x78, 0xed, 0x0e, 0x78, 0xd2, 0x60, 0xe8, 0x19,
-	0xc4, 0x29, 0x35, 0x94, 0xdc, 0x55, 0x36, 0xfe, 0x5d, 0xe7, 0xbf, 0x6e, 0xf1, 0xf8, 0x16, 0x7e,
-	0x51, 0xb7, 0x7b, 0x09, 0x1f, 0x24, 0x99, 0x62, 0xe7, 0x24, 0x15, 0xda, 0x50, 0xc9, 0x38, 0x39,
-	0x55, 0x25, 0x39, 0x15, 0x92, 0x66, 0xe2, 0x13, 0x4f, 0x89, 0x95, 0xe1, 0x45, 0xd7, 0x7a, 0xcb,
-	0x11, 0x0f, 0x6b, 0xde, 0x48, 0x95, 0xa3, 0x86, 0x75, 0x48, 0x0d, 0x45, 0xcf, 0xe1, 0x7f, 0x8e,
-	0xa0, 0x89, 0x90, 0x8d, 0x01, 0x35, 0x42, 0x49, 0x52, 0x94, 0x4a, 0x9d, 0xe2, 0x25, 0x67, 0xb2,
-	0x51, 0x71, 0x8e, 0xe4, 0xe8, 0x0e, 0xe3, 0xd8, 0x12, 0xd0, 0x13, 0x88, 0xe8, 0x94, 0x97, 0x74,
-	0xc2, 0x49, 0x15, 0xc9, 0x88, 0x9c, 0xe3, 0x65, 0x1f, 0x04, 0xed, 0x78, 0x50, 0x23, 0x43, 0x0b,
-	0x9c, 0x88, 0x9c, 0xa3, 0x7d, 0xe8, 0xd1, 0x2c, 0x53, 0x1f, 0x79, 0x5a, 0xb3, 0x33, 0x3a, 0x71,
-	0xd9, 0x3f, 0x28, 0x4d, 0xf4, 0x4c, 0x32, 0x0c, 0x9d, 0x72, 0xa3, 0x66, 0x39, 0xe5, 0x2b, 0x3a,
-	0x19, 0xa9, 0xf2, 0xad, 0xd2, 0xe3, 0x99, 0x64, 0xb6, 0x61, 0x23, 0xd5, 0x86, 0x5c, 0x14, 0x29,
-	0x35, 0x3c, 0xc5, 0x3d, 0x1f, 0x04, 0x9d, 0x78, 0x90, 0x54, 0x7c, 0x6d, 0xde, 0x55, 0xf7, 0xe8,
-	0x35, 0x44, 0xb9, 0x90, 0x44, 0x1b, 0x7a, 0xce, 0xed, 0x27, 0x4d, 0x45, 0xca, 0x4b, 0xfc, 0xb7,
-	0x0f, 0x82, 0xde, 0xee, 0x46, 0x58, 0x4d, 0x5d, 0x68, 0xa7, 0x2e, 0xac, 0xa7, 0x2e, 0x3c, 0x50,
-	0x42, 0xd6, 0x7f, 0x7d, 0x90, 0x0b, 0x39, 0xb6, 0xca, 0xe3, 0x5a, 0x88, 0x8e, 0xe0, 0xe0, 0xd6,
-	0x8e, 0x65, 0x82, 0x4b, 0x83, 0x57, 0xfe, 0xcc, 0xac, 0xdf, 0x98, 0x1d, 0x38, 0x19, 0x7a, 0x03,
-	0x57, 0x9b, 0x3c, 0x9a, 0x98, 0x59, 0xc1, 0x35, 0xee, 0xfb, 0x20, 0xe8, 0xef, 0x3e, 0xfa, 0xdd,
-	0x40, 0xda, 0xa5, 0x49, 0xa1, 0x4f, 0x2c, 0x3b, 0xee, 0x17, 0xf7, 0xea, 0xed, 0xc7, 0xb0, 0x7f,
-	0x9f, 0x81, 0x7a, 0x70, 0x31, 0x9d, 0x49, 0x9a, 0x0b, 0x36, 0x68, 0x21, 0x08, 0xbb, 0xda, 0x50,
-	0x23, 0xd8, 0x00, 0x0c, 0x87, 0x5f, 0xe6, 0x1e, 0xb8, 0x9a, 0x7b, 0xe0, 0x7a, 0xee, 0x81, 0x1f,
-	0x73, 0x0f, 0x7c, 0xbe, 0xf1, 0x5a, 0xd7, 0x37, 0x5e, 0xeb, 0xdb, 0x8d, 0xd7, 0x7a, 0xff, 0x70,
-	0x22, 0xcc, 0xd9, 0x45, 0x12, 0x32, 0x95, 0x47, 0x75, 0x14, 0xb7, 0x47, 0x97, 0xee, 0xcd, 0x47,
-	0x2e, 0x6c, 0xd2, 0x75, 0x2f, 0x73, 0xef, 0x67, 0x00, 0x00, 0x00, 0xff, 0xff, 0x1c, 0x0c, 0xe0,
-	0x42, 0x0d, 0x04, 0x00, 0x00,
+	// 764 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xdf, 0x6f, 0xe3, 0x34,
+	0x1c, 0x6f, 0x68, 0x6f, 0xbb, 0xba, 0x5c, 0xdb, 0x99, 0xee, 0x70, 0x4f, 0x2c, 0x84, 0x13, 0x42,
+	0x41, 0x42, 0x09, 0xdb, 0x3d, 0xc0, 0x1b, 0x5c, 0xef, 0x56, 0x31, 0x31, 0x44, 0x49, 0x07, 0x0f,
+	0xbc, 0x58, 0x4e, 0xe2, 0x66, 0xd6, 0x12, 0x3b, 0x8b, 0xbd, 0xd2, 0xf2, 0x57, 0xf0, 0x67, 0xf0,
+	0xa7, 0x4c, 0x3c, 0xed, 0x91, 0x27, 0x84, 0xba, 0x7f, 0x04, 0xd9, 0x4e, 0xf6, 0x03, 0x86, 0xb4,
+	0x97, 0x24, 0xf6, 0xe7, 0x87, 0x3f, 0xf6, 0xf7, 0x1b, 0x83, 0x81, 0x2c, 0x69, 0x12, 0xea, 0x47,
+	0x50, 0x56, 0x42, 0x09, 0xb8, 0x93, 0x93, 0x25, 0xe1, 0x54, 0x05, 0xfa, 0x1d, 0x68, 0xe0, 0xc5,
+	0x28, 0x13, 0x99, 0x30, 0x68, 0xa8, 0xbf, 0x2c, 0xf1, 0xc5, 0x73, 0xab, 0xa4, 0xd5, 0x92, 0x25,
+	0x14, 0x93, 0x92, 0xd5, 0xf3, 0x6e, 0x22, 0x64, 0x21, 0x64, 0x18, 0x13, 0x49, 0xc3, 0xe5, 0x7e,
+	0x4c, 0x15, 0xd9, 0x0f, 0x13, 0xc1, 0xb8, 0xc5, 0x5f, 0xfe, 0xd1, 0x05, 0x9d, 0x79, 0x49, 0x13,
+	0x38, 0x02, 0x4f, 0x18, 0x4f, 0xe9, 0x0a, 0x39, 0x9e, 0xe3, 0x77, 0x23, 0x3b, 0x80, 0x10, 0x74,
+	0x38, 0x29, 0x28, 0x7a, 0xc7, 0x4c, 0x9a, 0x6f, 0x88, 0xc0, 0x36, 0x2b, 0x4a, 0x51, 0x29, 0x89,
+	0x06, 0x5e, 0xdb, 0xef, 0x46, 0xcd, 0x10, 0x7e, 0x01, 0x3a, 0xa4, 0x64, 0x12, 0xb5, 0xbd, 0xb6,
+	0xdf, 0x3b, 0xd8, 0x0b, 0xfe, 0x13, 0x3e, 0x98, 0xdb, 0x80, 0xaf, 0x4b, 0x36, 0xe9, 0x5c, 0xfe,
+	0xf5, 0x61, 0x2b, 0x32, 0x02, 0x6d, 0x49, 0x39, 0x89, 0x73, 0x9a, 0xa2, 0x8e, 0xe7, 0xf8, 0x4f,
+	0xa3, 0x66, 0x08, 0x5f, 0x81, 0xdd, 0x8a, 0xe6, 0x8c, 0xc4, 0x2c, 0x67, 0x6a, 0x8d, 0xd5, 0x69,
+	0x45, 0xe5, 0xa9, 0xc8, 0x53, 0xf4, 0xc4, 0x73, 0xfc, 0x67, 0xd1, 0xe8, 0x0e, 0x78, 0xd2, 0x60,
+	0xf0, 0x4b, 0x80, 0x52, 0xa2, 0x08, 0xbe, 0xab, 0x6c, 0xfc, 0xb7, 0x8c, 0xff, 0x73, 0x8d, 0x47,
+	0xb7, 0xf0, 0x61, 0xbd, 0xdc, 0x37, 0xe0, 0xa3, 0x38, 0x17, 0xc9, 0x19, 0x4e, 0x99, 0x54, 0x84,
+	0x27, 0x14, 0x2f, 0x44, 0x85, 0x17, 0x8c, 0x93, 0x9c, 0xfd, 0x4a, 0x53, 0xac, 0x65, 0x68, 0xdb,
+	0x2c, 0xbd, 0x67, 0x88, 0x6f, 0x6b, 0xde, 0x54, 0x54, 0xd3, 0x86, 0xf5, 0x96, 0x28, 0x02, 0xbf,
+	0x02, 0x1f, 0x18, 0x82, 0xc4, 0x8c, 0x37, 0x06, 0x44, 0x31, 0xc1, 0x71, 0x59, 0x09, 0xb1, 0x40,
+	0x4f, 0x8d, 0xc9, 0xd8, 0x72, 0x8e, 0xf8, 0xf4, 0x0e, 0x63, 0xa6, 0x09, 0xf0, 0x33, 0x00, 0xc9,
+	0x92, 0x56, 0x24, 0xa3, 0xd8, 0x46, 0x52, 0xac, 0xa0, 0xa8, 0xeb, 0x39, 0x7e, 0x3b, 0x1a, 0xd6,
+	0xc8, 0x44, 0x03, 0x27, 0xac, 0xa0, 0xf0, 0x35, 0x70, 0x49, 0x9e, 0x8b, 0x5f, 0x68, 0x5a, 0xb3,
+	0x73, 0x92, 0x99, 0xec, 0xe7, 0x42, 0x62, 0xb9, 0xe6, 0x09, 0x02, 0x46, 0x39, 0xae, 0x59, 0x46,
+	0x79, 0x4c, 0xb2, 0xa9, 0xa8, 0x7e, 0x10, 0x72, 0xbe, 0xe6, 0x89, 0x5e, 0xb0, 0x91, 0x4a, 0x85,
+	0x2f, 0xca, 0x94, 0x28, 0x9a, 0xa2, 0x9e, 0xe7, 0xf8, 0x9d, 0x68, 0x18, 0x5b, 0xbe, 0x54, 0x3f,
+	0xda, 0x79, 0xf8, 0x1d, 0x80, 0x05, 0xe3, 0x58, 0x2a, 0x72, 0x46, 0xf5, 0x96, 0x96, 0x2c, 0xa5,
+	0x15, 0x7a, 0xd7, 0x73, 0xfc, 0xde, 0xc1, 0x38, 0xb0, 0x5d, 0x17, 0xe8, 0xae, 0x0b, 0xea, 0xae,
+	0x0b, 0xde, 0x08, 0xc6, 0xeb, 0xaa, 0x0f, 0x0b, 0xc6, 0xe7, 0x5a, 0x39, 0xab, 0x85, 0xf0, 0x08,
+	0x0c, 0x6f, 0xed, 0x92, 0x9c, 0x51, 0xae, 0xd0, 0xb3, 0xc7, 0x99, 0xf5, 0x1b, 0xb3, 0x37, 0x46,
+	0x06, 0xbf, 0x07, 0x83, 0x26, 0x8f, 0xc4, 0x6a, 0x5d, 0x52, 0x89, 0xfa, 0x9e, 0xe3, 0xf7, 0x0f,
+	0x3e, 0x79, 0xa8, 0x21, 0xf5, 0xa3, 0x49, 0x21, 0x4f, 0x34, 0x3b, 0xea, 0x97, 0xf7, 0xc6, 0xf0,
+	0x27, 0xf0, 0xbe, 0xc9, 0x46, 0xf3, 0xc5, 0xbf, 0xf7, 0x3b, 0x7c, 0x5c, 0xc4, 0x91, 0x8e, 0x48,
+	0xf3, 0xc5, 0xfd, 0x3d, 0x7f, 0x0d, 0xf6, 0xfe, 0xc7, 0xd7, 0xd6, 0x10, 0xed, 0x98, 0xb3, 0x1f,
+	0x3f, 0x24, 0x36, 0xf5, 0x83, 0xdf, 0x82, 0x9d, 0x82, 0xac, 0x70, 0x49, 0x58, 0xc5, 0x78, 0x66,
+	0x4d, 0x10, 0x7c, 0x5c, 0xa6, 0x41, 0x41, 0x56, 0x33, 0x2b, 0x34, 0xce, 0x70, 0x1f, 0xec, 0xea,
+	0x38, 0xa6, 0x61, 0x12, 0x51, 0x51, 0x5c, 0xd2, 0x2a, 0xd1, 0x75, 0x78, 0xcf, 0xb4, 0xaa, 0x2e,
+	0xb7, 0x6e, 0x15, 0x0d, 0xcd, 0x2c, 0x02, 0x3f, 0x07, 0x23, 0x4d, 0xa7, 0x2b, 0x5a, 0x94, 0xea,
+	0x26, 0xbd, 0x44, 0x23, 0x73, 0x2f, 0xc0, 0x73, 0x21, 0x0f, 0x0d, 0x74, 0x73, 0xc0, 0x5a, 0x61,
+	0x13, 0x67, 0x8c, 0xdb, 0xdf, 0x21, 0x67, 0x05, 0x53, 0x68, 0xd7, 0x6c, 0x15, 0x9a, 0x4c, 0x0d,
+	0x74, 0xac, 0x91, 0x97, 0x9f, 0x82, 0xfe, 0xfd, 0xfa, 0xc0, 0x1e, 0xd8, 0x4e, 0xd7, 0x9c, 0x14,
+	0x2c, 0x19, 0xb6, 0x20, 0x00, 0x5b, 0x52, 0x11, 0xc5, 0x92, 0xa1, 0x33, 0x99, 0xfc, 0xbe, 0x71,
+	0x9d, 0xcb, 0x8d, 0xeb, 0x5c, 0x6d, 0x5c, 0xe7, 0xef, 0x8d, 0xeb, 0xfc, 0x76, 0xed, 0xb6, 0xae,
+	0xae, 0xdd, 0xd6, 0x9f, 0xd7, 0x6e, 0xeb, 0xe7, 0x8f, 0x33, 0xa6, 0x4e, 0x2f, 0xe2, 0x20, 0x11,
+	0x45, 0x58, 0x37, 0x82, 0x79, 0x87, 0x2b, 0x73, 0xe3, 0x86, 0xa6, 0x55, 0xe2, 0x2d, 0x73, 0x2f,
+	0xbe, 0xfa, 0x67, 0x00, 0x61, 0xf6, 0x2a, 0x1b, 0x8b, 0x05, 0x00, 0x00,
 }
 
 func (this *Spec) Equal(that interface{}) bool {
@@ -330,6 +386,29 @@ func (this *Spec) Equal(that interface{}) bool {
 	if this.ProvidersTypes != that1.ProvidersTypes {
 		return false
 	}
+	if !this.MinSelfStakeProvider.Equal(&that1.MinSelfStakeProvider) {
+		return false
+	}
+	if this.MinSelfStakeProviderBlock != that1.MinSelfStakeProviderBlock {
+		return false
+	}
+	if !this.MaxPairingStake.Equal(&that1.MaxPairingStake) {
+		return false
+	}
+	if this.MinQosScorePercent != that1.MinQosScorePercent {
+		return false
+	}
+	if len(this.QosExemptProviders) != len(that1.QosExemptProviders) {
+		return false
+	}
+	for i := range this.QosExemptProviders {
+		if this.QosExemptProviders[i] != that1.QosExemptProviders[i] {
+			return false
+		}
+	}
+	if this.MaxPaginationLimit != that1.MaxPaginationLimit {
+		return false
+	}
 	return true
 }
 func (m *Spec) Marshal() (dAtA []byte, err error) {
@@ -352,6 +431,62 @@ func (m *Spec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxPaginationLimit != 0 {
+		i = encodeVarintSpec(dAtA, i, uint64(m.MaxPaginationLimit))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if len(m.QosExemptProviders) > 0 {
+		for iNdEx := len(m.QosExemptProviders) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.QosExemptProviders[iNdEx])
+			copy(dAtA[i:], m.QosExemptProviders[iNdEx])
+			i = encodeVarintSpec(dAtA, i, uint64(len(m.QosExemptProviders[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if m.MinQosScorePercent != 0 {
+		i = encodeVarintSpec(dAtA, i, uint64(m.MinQosScorePercent))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	{
+		size, err := m.MaxPairingStake.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintSpec(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x92
+	if m.MinSelfStakeProviderBlock != 0 {
+		i = encodeVarintSpec(dAtA, i, uint64(m.MinSelfStakeProviderBlock))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	{
+		size, err := m.MinSelfStakeProvider.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintSpec(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
 	if len(m.Imports) > 0 {
 		for iNdEx := len(m.Imports) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Imports[iNdEx])
@@ -535,6 +670,25 @@ func (m *Spec) Size() (n int) {
 			n += 1 + l + sovSpec(uint64(l))
 		}
 	}
+	l = m.MinSelfStakeProvider.Size()
+	n += 2 + l + sovSpec(uint64(l))
+	if m.MinSelfStakeProviderBlock != 0 {
+		n += 2 + sovSpec(uint64(m.MinSelfStakeProviderBlock))
+	}
+	l = m.MaxPairingStake.Size()
+	n += 2 + l + sovSpec(uint64(l))
+	if m.MinQosScorePercent != 0 {
+		n += 2 + sovSpec(uint64(m.MinQosScorePercent))
+	}
+	if len(m.QosExemptProviders) > 0 {
+		for _, s := range m.QosExemptProviders {
+			l = len(s)
+			n += 2 + l + sovSpec(uint64(l))
+		}
+	}
+	if m.MaxPaginationLimit != 0 {
+		n += 2 + sovSpec(uint64(m.MaxPaginationLimit))
+	}
 	return n
 }
 
@@ -942,6 +1096,161 @@ func (m *Spec) Unmarshal(dAtA []byte) error {
 			}
 			m.Imports = append(m.Imports, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSelfStakeProvider", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSpec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSpec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinSelfStakeProvider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSelfStakeProviderBlock", wireType)
+			}
+			m.MinSelfStakeProviderBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinSelfStakeProviderBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPairingStake", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSpec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSpec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPairingStake.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinQosScorePercent", wireType)
+			}
+			m.MinQosScorePercent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinQosScorePercent |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QosExemptProviders", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSpec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSpec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QosExemptProviders = append(m.QosExemptProviders, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPaginationLimit", wireType)
+			}
+			m.MaxPaginationLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPaginationLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSpec(dAtA[iNdEx:])
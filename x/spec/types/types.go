@@ -40,6 +40,22 @@ const (
 
 var SupportedTags = [...]string{GET_BLOCKNUM, GET_BLOCK_BY_NUM}
 
+// api tags are routing hints for consumers and providers, relays of an api are handled by the policies of its tags
+const (
+	ApiTagArchive      = "archive"       // needs the history of an archive node
+	ApiTagHeavy        = "heavy"         // expensive for the node to serve
+	ApiTagStateful     = "stateful"      // changes the state of the chain
+	ApiTagPersonalData = "personal-data" // replies hold data of the requesting user, they aren't cached
+)
+
+var SupportedApiTags = [...]string{ApiTagArchive, ApiTagHeavy, ApiTagStateful, ApiTagPersonalData}
+
+// response ignore paths are json keys separated by ResponseIgnorePathSeparator, ResponseIgnorePathWildcard matches any key or array index
+const (
+	ResponseIgnorePathSeparator = "."
+	ResponseIgnorePathWildcard  = "*"
+)
+
 // allows unmarshaling parser func
 func (s PARSER_FUNC) MarshalJSON() ([]byte, error) {
 	buffer := bytes.NewBufferString(`"`)
@@ -30,6 +30,9 @@ func GetTxCmd() *cobra.Command {
 
 	cmd.AddCommand(CmdBuy())
 	cmd.AddCommand(CmdAddProject())
+	cmd.AddCommand(CmdUpgradeSubscription())
+	cmd.AddCommand(CmdBuyAddOn())
+	cmd.AddCommand(CmdSetProjectAllocation())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
@@ -14,10 +14,11 @@ func CmdBuy() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "buy [plan-index] [optional: consumer] [optional: duration(months)]",
 		Short: "buy a service plan",
-		Long:  `The buy command allows a user to buy a subscription to a service plan for another user, effective next epoch. The consumer is the beneficiary user (default: the creator). The duration is stated in number of months (default: 1).`,
+		Long:  `The buy command allows a user to buy a subscription to a service plan for another user, effective next epoch. The consumer is the beneficiary user (default: the creator). The duration is stated in number of months (default: 1). With --auto-renewal the subscription is renewed for the same duration when it ends, as long as the creator can pay for it.`,
 		Example: `required flags: --from <creator-address>
 		lavad tx subscription buy [plan-index] --from <creator_address>
-		lavad tx subscription buy [plan-index] --from <creator_address> <consumer_address> 12`,
+		lavad tx subscription buy [plan-index] --from <creator_address> <consumer_address> 12
+		lavad tx subscription buy [plan-index] --from <creator_address> --auto-renewal`,
 		Args: cobra.RangeArgs(1, 3),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -38,6 +39,11 @@ func CmdBuy() *cobra.Command {
 				argDuration = cast.ToUint64(args[2])
 			}
 
+			autoRenewal, err := cmd.Flags().GetBool(types.FlagAutoRenewal)
+			if err != nil {
+				return err
+			}
+
 			_, vrfpk, err := utils.GetOrCreateVRFKey(clientCtx)
 			if err != nil {
 				return err
@@ -52,6 +58,7 @@ func CmdBuy() *cobra.Command {
 				argIndex,
 				argDuration,
 				vrfpk_str,
+				autoRenewal,
 			)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -60,6 +67,7 @@ func CmdBuy() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().Bool(types.FlagAutoRenewal, false, "renew the subscription when it ends")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -0,0 +1,49 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/lavanet/lava/x/subscription/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdBuyAddOn() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "buy-add-on [add-on] [optional: consumer]",
+		Short: "buy an add-on of the subscription's plan",
+		Long:  `The buy-add-on command allows the creator of a subscription to buy an add-on offered by the subscription's plan (e.g. archive, trace), granting access to the spec apis of the add-on. The creator is charged the monthly add-on price for the remaining duration of the subscription, prorated for the rest of the current month, and renewals of the subscription include the add-on. The consumer is the beneficiary user (default: the creator).`,
+		Example: `required flags: --from <creator-address>
+		lavad tx subscription buy-add-on archive --from <creator_address>
+		lavad tx subscription buy-add-on archive <consumer_address> --from <creator_address>`,
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			creator := clientCtx.GetFromAddress().String()
+			argAddOn := args[0]
+
+			argConsumer := creator
+			if len(args) == 2 {
+				argConsumer = args[1]
+			}
+
+			msg := types.NewMsgBuyAddOn(
+				creator,
+				argConsumer,
+				argAddOn,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,61 @@
+package cli
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/lavanet/lava/x/subscription/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdSetProjectAllocation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-project-allocation [project-name] [allocation]",
+		Short: "set the monthly CU budget of a project of the subscription",
+		Long: `The set-project-allocation command allows the subscription owner to cap the CU one of its projects may use each month,
+		either a fixed amount of CU or a percent of the subscription's monthly CU (e.g. 40%). Relays of a project that used up its
+		allocation are rejected until the next month, and the allocations of all projects may not exceed the subscription's monthly CU.
+		An allocation of 0 removes the project's cap.`,
+		Example: `required flags: --from <subscription_consumer>
+		lavad tx subscription set-project-allocation backend 500000 --from <subscription_consumer>
+		lavad tx subscription set-project-allocation frontend 40% --from <subscription_consumer>`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			creator := clientCtx.GetFromAddress().String()
+			argProject := args[0]
+
+			var argCu, argPercent uint64
+			if strings.HasSuffix(args[1], "%") {
+				argPercent, err = strconv.ParseUint(strings.TrimSuffix(args[1], "%"), 10, 64)
+			} else {
+				argCu, err = strconv.ParseUint(args[1], 10, 64)
+			}
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetProjectAllocation(
+				creator,
+				argProject,
+				argCu,
+				argPercent,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
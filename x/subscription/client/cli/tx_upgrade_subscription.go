@@ -0,0 +1,49 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/lavanet/lava/x/subscription/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdUpgradeSubscription() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "upgrade [plan-index] [optional: consumer]",
+		Short: "upgrade a subscription to a higher plan",
+		Long:  `The upgrade command allows the creator of a subscription to move it to a more expensive service plan in the middle of a month. The creator is charged the price difference of the plans for the remaining duration of the subscription, prorated for the rest of the current month, and the CU allowance of the new plan applies immediately. The consumer is the beneficiary user (default: the creator).`,
+		Example: `required flags: --from <creator-address>
+		lavad tx subscription upgrade [plan-index] --from <creator_address>
+		lavad tx subscription upgrade [plan-index] <consumer_address> --from <creator_address>`,
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			creator := clientCtx.GetFromAddress().String()
+			argIndex := args[0]
+
+			argConsumer := creator
+			if len(args) == 2 {
+				argConsumer = args[1]
+			}
+
+			msg := types.NewMsgUpgradeSubscription(
+				creator,
+				argConsumer,
+				argIndex,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -23,6 +23,15 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		case *types.MsgAddProject:
 			res, err := msgServer.AddProject(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgUpgradeSubscription:
+			res, err := msgServer.UpgradeSubscription(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgBuyAddOn:
+			res, err := msgServer.BuyAddOn(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgSetProjectAllocation:
+			res, err := msgServer.SetProjectAllocation(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 			// this line is used by starport scaffolding # 1
 		default:
 			errMsg := fmt.Sprintf("unrecognized %s message type: %T", types.ModuleName, msg)
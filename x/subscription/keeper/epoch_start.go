@@ -5,18 +5,22 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/utils"
 	"github.com/lavanet/lava/x/subscription/types"
 )
 
 // EpochStart runs the functions that are supposed to run in epoch start
 func (k Keeper) EpochStart(ctx sdk.Context) {
-	// On epoch start we need to iterate through all the subscriptions and
+	// On epoch start the upgraded subscriptions get the CU allowance of
+	// their new plan. Then we need to iterate through all the subscriptions and
 	// check those whose current month just expired:
 	//
 	// - record the actual epoch of expiry (for rewards validation)
 	// - save the month's remaining CU in prev (for rewards validation)
 	// - reset the month's remaining CU to the plan's allowance
 	// - reduce remaining duration, and delete if it reaches zero
+	// - when the duration ends, renew subscriptions set to auto-renew or
+	//   give the subscription a reduced CU allowance for the grace period
 	//
 	// Note that actual deletion is deferred by EpochsToSave parameter
 	// (in Epochstorage) to allow payments for the last month of the
@@ -32,6 +36,8 @@ func (k Keeper) EpochStart(ctx sdk.Context) {
 		panic("Subscription: EpochStart: failed to obtain BlocksToSave at block " + strconv.Itoa(int(block)))
 	}
 
+	k.applyPendingUpgrades(ctx)
+
 	subExpired := k.GetCondSubscription(ctx, func(sub types.Subscription) bool {
 		return sub.IsMonthExpired(date) || sub.IsStale(block-blocksToSave)
 	})
@@ -49,12 +55,24 @@ func (k Keeper) EpochStart(ctx sdk.Context) {
 			continue
 		}
 
+		if sub.IsInGracePeriod() {
+			// grace period ended without a renewal: stop the service
+			sub.GraceExpiryTime = 0
+			date = k.endSubscription(ctx, &sub, date)
+			k.SetSubscription(ctx, sub)
+			continue
+		}
+
 		if sub.DurationLeft == 0 {
 			panic("Subscription: EpochStart: negative DurationLeft for consumer " + sub.Consumer)
 		}
 
 		sub.DurationLeft -= 1
 
+		if sub.DurationLeft == 0 && sub.AutoRenewal {
+			k.autoRenewSubscription(ctx, &sub)
+		}
+
 		if sub.DurationLeft > 0 {
 			date = nextMonth(date)
 			sub.MonthExpiryTime = uint64(date.Unix())
@@ -64,20 +82,62 @@ func (k Keeper) EpochStart(ctx sdk.Context) {
 
 			// reset projects' CU allowance for this coming month
 			k.projectsKeeper.SnapshotSubscriptionProjects(ctx, sub.Consumer)
+		} else if gracePeriodDays := k.GracePeriodDays(ctx); gracePeriodDays > 0 {
+			// duration ended: keep a reduced service until the grace period
+			// expires (which is handled as the month's expiry) so consumers
+			// can renew before service stops.
+			graceExpiry := date.AddDate(0, 0, int(gracePeriodDays))
+			sub.GraceExpiryTime = uint64(graceExpiry.Unix())
+			sub.MonthExpiryTime = sub.GraceExpiryTime
+
+			sub.MonthCuLeft = sub.MonthCuTotal * k.GraceCuPercent(ctx) / 100
+			k.projectsKeeper.SnapshotSubscriptionProjects(ctx, sub.Consumer)
+
+			details := map[string]string{"consumer": sub.Consumer, "graceExpiryTime": strconv.FormatUint(sub.GraceExpiryTime, 10), "graceCu": strconv.FormatUint(sub.MonthCuLeft, 10)}
+			utils.LogLavaEvent(ctx, k.Logger(ctx), types.SubscriptionExpiredEventName, details, "subscription expired, reduced service until the grace period ends")
 		} else {
-			// duration ended, but don't delete yet - keep around for another
-			// EpochsToSave epochs before removing, to allow for payments for
-			// the months the ends now to be validated.
+			date = k.endSubscription(ctx, &sub, date)
+		}
 
-			// set expiry timeout far far in the future, so the test
-			// for sub.IsStale() above would kick in first.
-			date = date.Add(8760 * time.Hour) // 1 yr
-			sub.MonthExpiryTime = uint64(date.Unix())
+		k.SetSubscription(ctx, sub)
+	}
+}
 
-			// zero CU allowance for this coming month
-			sub.MonthCuLeft = 0
-		}
+// applyPendingUpgrades gives upgraded subscriptions the CU allowance of their new plan, the CU used this month is kept
+// so the allowance left grows by the difference of the plans
+func (k Keeper) applyPendingUpgrades(ctx sdk.Context) {
+	subUpgraded := k.GetCondSubscription(ctx, func(sub types.Subscription) bool {
+		return sub.PendingMonthCuTotal != 0
+	})
 
+	for _, sub := range subUpgraded {
+		if sub.PendingMonthCuTotal > sub.MonthCuTotal {
+			sub.MonthCuLeft += sub.PendingMonthCuTotal - sub.MonthCuTotal
+		}
+		sub.MonthCuTotal = sub.PendingMonthCuTotal
+		sub.PendingMonthCuTotal = 0
 		k.SetSubscription(ctx, sub)
+
+		details := map[string]string{"consumer": sub.Consumer, "monthCuTotal": strconv.FormatUint(sub.MonthCuTotal, 10), "monthCuLeft": strconv.FormatUint(sub.MonthCuLeft, 10)}
+		utils.LogLavaEvent(ctx, k.Logger(ctx), types.SubscriptionUpgradeAppliedEventName, details, "upgraded subscription got the CU allowance of its new plan")
 	}
 }
+
+// endSubscription stops the service of a subscription that ended, returns the date it's set to expire at
+func (k Keeper) endSubscription(ctx sdk.Context, sub *types.Subscription, date time.Time) time.Time {
+	// duration ended, but don't delete yet - keep around for another
+	// EpochsToSave epochs before removing, to allow for payments for
+	// the months the ends now to be validated.
+
+	// set expiry timeout far far in the future, so the test
+	// for sub.IsStale() above would kick in first.
+	date = date.Add(8760 * time.Hour) // 1 yr
+	sub.MonthExpiryTime = uint64(date.Unix())
+
+	// zero CU allowance for this coming month
+	sub.MonthCuLeft = 0
+
+	details := map[string]string{"consumer": sub.Consumer}
+	utils.LogLavaEvent(ctx, k.Logger(ctx), types.SubscriptionExpiredEventName, details, "subscription expired, service stopped")
+	return date
+}
@@ -6,6 +6,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/lavanet/lava/utils/sigs"
 	epochstoragetypes "github.com/lavanet/lava/x/epochstorage/types"
+	"github.com/lavanet/lava/x/subscription/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -23,7 +24,7 @@ func TestSubscriptionExpire(t *testing.T) {
 	// advance block to reach time > 0
 	ts.advanceBlock()
 
-	err := keeper.CreateSubscription(ts.ctx, creator, consumer, "mockPlan1", 1, "")
+	err := keeper.CreateSubscription(ts.ctx, creator, consumer, "mockPlan1", 1, "", false)
 	require.Nil(t, err)
 
 	sub, found := keeper.GetSubscription(ts.ctx, account.String())
@@ -45,3 +46,100 @@ func TestSubscriptionExpire(t *testing.T) {
 	_, found = keeper.GetSubscription(ts.ctx, account.String())
 	require.False(t, found)
 }
+
+func TestSubscriptionGracePeriod(t *testing.T) {
+	ts := setupTestStruct(t, 1)
+	keeper := ts.keepers.Subscription
+	keeper.SetParams(ts.ctx, types.NewParams(7, 10))
+
+	_, account := sigs.GenerateFloatingKey()
+	coins := sdk.NewCoins(sdk.NewCoin(epochstoragetypes.TokenDenom, sdk.NewInt(10000)))
+	ts.keepers.BankKeeper.SetBalance(ts.ctx, account, coins)
+	consumer := account.String()
+
+	// advance block to reach time > 0
+	ts.advanceBlock()
+
+	err := keeper.CreateSubscription(ts.ctx, consumer, consumer, "mockPlan1", 1, "", false)
+	require.Nil(t, err)
+
+	sub, found := keeper.GetSubscription(ts.ctx, consumer)
+	require.True(t, found)
+
+	// the ended subscription keeps a reduced CU allowance for the grace period
+	sub = ts.expireSubscription(sub)
+	require.Equal(t, uint64(0), sub.DurationLeft)
+	require.True(t, sub.IsInGracePeriod())
+	require.Equal(t, sub.MonthCuTotal/10, sub.MonthCuLeft)
+
+	// the grace period expires: the service stops
+	sub = ts.expireSubscription(sub)
+	require.False(t, sub.IsInGracePeriod())
+	require.Equal(t, uint64(0), sub.MonthCuLeft)
+
+	ts.expireSubscription(sub)
+	_, found = keeper.GetSubscription(ts.ctx, consumer)
+	require.False(t, found)
+}
+
+func TestSubscriptionRenewInGracePeriod(t *testing.T) {
+	ts := setupTestStruct(t, 1)
+	keeper := ts.keepers.Subscription
+	keeper.SetParams(ts.ctx, types.NewParams(7, 10))
+
+	_, account := sigs.GenerateFloatingKey()
+	coins := sdk.NewCoins(sdk.NewCoin(epochstoragetypes.TokenDenom, sdk.NewInt(10000)))
+	ts.keepers.BankKeeper.SetBalance(ts.ctx, account, coins)
+	consumer := account.String()
+
+	ts.advanceBlock()
+
+	err := keeper.CreateSubscription(ts.ctx, consumer, consumer, "mockPlan1", 1, "", false)
+	require.Nil(t, err)
+
+	sub, found := keeper.GetSubscription(ts.ctx, consumer)
+	require.True(t, found)
+	sub = ts.expireSubscription(sub)
+	require.True(t, sub.IsInGracePeriod())
+
+	// renewing in the grace period restores the full CU allowance
+	err = keeper.CreateSubscription(ts.ctx, consumer, consumer, "mockPlan1", 1, "", false)
+	require.Nil(t, err)
+
+	sub, found = keeper.GetSubscription(ts.ctx, consumer)
+	require.True(t, found)
+	require.False(t, sub.IsInGracePeriod())
+	require.Equal(t, uint64(1), sub.DurationLeft)
+	require.Equal(t, sub.MonthCuTotal, sub.MonthCuLeft)
+}
+
+func TestSubscriptionAutoRenewal(t *testing.T) {
+	ts := setupTestStruct(t, 1)
+	keeper := ts.keepers.Subscription
+	price := ts.plans[0].Price
+
+	// the creator can pay for the subscription and one renewal
+	_, account := sigs.GenerateFloatingKey()
+	coins := sdk.NewCoins(price.Add(price))
+	ts.keepers.BankKeeper.SetBalance(ts.ctx, account, coins)
+	consumer := account.String()
+
+	ts.advanceBlock()
+
+	err := keeper.CreateSubscription(ts.ctx, consumer, consumer, "mockPlan1", 1, "", true)
+	require.Nil(t, err)
+
+	sub, found := keeper.GetSubscription(ts.ctx, consumer)
+	require.True(t, found)
+	require.True(t, sub.AutoRenewal)
+
+	sub = ts.expireSubscription(sub)
+	require.Equal(t, uint64(1), sub.DurationLeft)
+	require.Equal(t, sub.MonthCuTotal, sub.MonthCuLeft)
+	require.True(t, ts.keepers.BankKeeper.GetBalance(ts.ctx, account, price.Denom).IsZero())
+
+	// the creator can't pay for another renewal
+	sub = ts.expireSubscription(sub)
+	require.Equal(t, uint64(0), sub.DurationLeft)
+	require.Equal(t, uint64(0), sub.MonthCuLeft)
+}
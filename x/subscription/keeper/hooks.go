@@ -0,0 +1,22 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	projectstypes "github.com/lavanet/lava/x/projects/types"
+)
+
+// Hooks follows the projects of the subscriptions for the projects keeper
+type Hooks struct {
+	k Keeper
+}
+
+var _ projectstypes.ProjectsHooks = Hooks{}
+
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+// AfterProjectDisabled removes the CU allocation of a deleted or disabled project
+func (h Hooks) AfterProjectDisabled(ctx sdk.Context, projectID string, subscription string) {
+	h.k.RemoveProjectAllocation(ctx, subscription, projectID)
+}
@@ -12,10 +12,10 @@ import (
 func (k msgServer) Buy(goCtx context.Context, msg *types.MsgBuy) (*types.MsgBuyResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	err := k.Keeper.CreateSubscription(ctx, msg.Creator, msg.Consumer, msg.Index, msg.Duration, msg.Vrfpk)
+	err := k.Keeper.CreateSubscription(ctx, msg.Creator, msg.Consumer, msg.Index, msg.Duration, msg.Vrfpk, msg.AutoRenewal)
 	if err == nil {
 		logger := k.Keeper.Logger(ctx)
-		details := map[string]string{"consumer": msg.Consumer, "duration": strconv.FormatUint(msg.Duration, 10), "plan": msg.Index}
+		details := map[string]string{"consumer": msg.Consumer, "duration": strconv.FormatUint(msg.Duration, 10), "plan": msg.Index, "autoRenewal": strconv.FormatBool(msg.AutoRenewal)}
 		utils.LogLavaEvent(ctx, logger, types.BuySubscriptionEventName, details, "consumer bought subscription")
 	}
 	return &types.MsgBuyResponse{}, err
@@ -0,0 +1,15 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/x/subscription/types"
+)
+
+func (k msgServer) BuyAddOn(goCtx context.Context, msg *types.MsgBuyAddOn) (*types.MsgBuyAddOnResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := k.Keeper.BuyAddOn(ctx, msg.Creator, msg.Consumer, msg.AddOn)
+	return &types.MsgBuyAddOnResponse{}, err
+}
@@ -0,0 +1,15 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/x/subscription/types"
+)
+
+func (k msgServer) SetProjectAllocation(goCtx context.Context, msg *types.MsgSetProjectAllocation) (*types.MsgSetProjectAllocationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := k.Keeper.SetProjectAllocation(ctx, msg.Creator, msg.Project, msg.Cu, msg.Percent)
+	return &types.MsgSetProjectAllocationResponse{}, err
+}
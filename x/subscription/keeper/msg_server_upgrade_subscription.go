@@ -0,0 +1,15 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/x/subscription/types"
+)
+
+func (k msgServer) UpgradeSubscription(goCtx context.Context, msg *types.MsgUpgradeSubscription) (*types.MsgUpgradeSubscriptionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := k.Keeper.UpgradeSubscription(ctx, msg.Creator, msg.Consumer, msg.Index)
+	return &types.MsgUpgradeSubscriptionResponse{}, err
+}
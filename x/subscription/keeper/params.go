@@ -7,10 +7,29 @@ import (
 
 // GetParams get all parameters as types.Params
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
-	return types.NewParams()
+	return types.NewParams(
+		k.GracePeriodDays(ctx),
+		k.GraceCuPercent(ctx),
+	)
 }
 
 // SetParams set the params
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	k.paramstore.SetParamSet(ctx, &params)
 }
+
+// the grace params were added after launch, chains that didn't set them use the defaults
+
+// GracePeriodDays returns the GracePeriodDays param
+func (k Keeper) GracePeriodDays(ctx sdk.Context) (res uint64) {
+	res = types.DefaultGracePeriodDays
+	k.paramstore.GetIfExists(ctx, types.KeyGracePeriodDays, &res)
+	return
+}
+
+// GraceCuPercent returns the GraceCuPercent param
+func (k Keeper) GraceCuPercent(ctx sdk.Context) (res uint64) {
+	res = types.DefaultGraceCuPercent
+	k.paramstore.GetIfExists(ctx, types.KeyGraceCuPercent, &res)
+	return
+}
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"sort"
 	"strconv"
 	"time"
 
@@ -14,7 +15,11 @@ import (
 	"github.com/lavanet/lava/x/subscription/types"
 )
 
-const MONTHS_IN_YEAR = 12
+const (
+	MONTHS_IN_YEAR = 12
+	// length of a month when prorating the current month of an upgrade
+	SECONDS_IN_MONTH = 30 * 24 * 60 * 60
+)
 
 // SetSubscription sets a subscription (of a consumer) in the store
 func (k Keeper) SetSubscription(ctx sdk.Context, sub types.Subscription) {
@@ -105,6 +110,7 @@ func (k Keeper) CreateSubscription(
 	planIndex string,
 	duration uint64,
 	vrfpk string,
+	autoRenewal bool,
 ) error {
 	var err error
 
@@ -138,6 +144,8 @@ func (k Keeper) CreateSubscription(
 	}
 
 	sub, found := k.GetSubscription(ctx, consumer)
+	// an ended subscription that wasn't removed yet starts over when renewed
+	lapsed := found && sub.DurationLeft == 0
 
 	// Subscription creation:
 	//   When: if not already exists for consumer address)
@@ -150,7 +158,7 @@ func (k Keeper) CreateSubscription(
 	//   What: find plan, update duration (total and remaining), calculate price,
 	//         charge fees, save subscription.
 	//
-	// Subscription upgrade: (TBD)
+	// Subscription upgrade: (see UpgradeSubscription)
 	//
 	// Subscription downgrade: (TBD)
 
@@ -202,6 +210,12 @@ func (k Keeper) CreateSubscription(
 	// update total (last requested) duration and remaining duration
 	sub.DurationTotal = duration
 	sub.DurationLeft += duration
+	sub.AutoRenewal = autoRenewal
+
+	if lapsed {
+		sub.GraceExpiryTime = 0
+		sub.MonthCuLeft = sub.MonthCuTotal
+	}
 
 	// use current block's timestamp to calculate next month's time
 	timestamp := ctx.BlockTime()
@@ -218,7 +232,26 @@ func (k Keeper) CreateSubscription(
 	}
 
 	// subscription looks good; let's charge the creator
-	price := plan.GetPrice()
+	err = k.chargeSubscription(ctx, creatorAcct, plan, sub.AddOns, duration)
+	if err != nil {
+		return err
+	}
+
+	if lapsed {
+		// reset the projects' CU left from the reduced grace period allowance
+		k.projectsKeeper.SnapshotSubscriptionProjects(ctx, sub.Consumer)
+		details := map[string]string{"consumer": consumer, "duration": strconv.FormatUint(duration, 10), "autoRenewal": "false"}
+		utils.LogLavaEvent(ctx, logger, types.SubscriptionRenewedEventName, details, "ended subscription renewed")
+	}
+
+	k.SetSubscription(ctx, sub)
+
+	return nil
+}
+
+// chargeSubscription charges the creator the price of the plan and the add-ons for duration months
+func (k Keeper) chargeSubscription(ctx sdk.Context, creatorAcct sdk.AccAddress, plan planstypes.Plan, addOns []string, duration uint64) error {
+	price := plan.GetMonthlyPrice(addOns)
 	price.Amount = price.Amount.MulRaw(int64(duration))
 
 	if duration >= MONTHS_IN_YEAR {
@@ -230,37 +263,337 @@ func (k Keeper) CreateSubscription(
 		}
 	}
 
+	return k.chargeCreator(ctx, "CreateSub", creatorAcct, price)
+}
+
+// chargeCreator transfers price from the creator of a subscription to the module, its errors are reported under errorName
+func (k Keeper) chargeCreator(ctx sdk.Context, errorName string, creatorAcct sdk.AccAddress, price sdk.Coin) error {
+	logger := k.Logger(ctx)
+	creator := creatorAcct.String()
+
 	if k.bankKeeper.GetBalance(ctx, creatorAcct, epochstoragetypes.TokenDenom).IsLT(price) {
 		details := map[string]string{
 			"creator": creator,
 			"price":   price.String(),
 			"error":   sdkerrors.ErrInsufficientFunds.Error(),
 		}
-		return utils.LavaError(ctx, logger, "CreateSub", details, "insufficient funds")
+		return utils.LavaError(ctx, logger, errorName, details, "insufficient funds")
 	}
 
-	err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, creatorAcct, types.ModuleName, []sdk.Coin{price})
+	err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, creatorAcct, types.ModuleName, []sdk.Coin{price})
 	if err != nil {
 		details := map[string]string{
 			"creator": creator,
 			"price":   price.String(),
 			"error":   err.Error(),
 		}
-		return utils.LavaError(ctx, logger, "CreateSubscription", details, "funds transfer failed")
+		return utils.LavaError(ctx, logger, errorName, details, "funds transfer failed")
+	}
+
+	return nil
+}
+
+// autoRenewSubscription renews an ended subscription for another DurationTotal months with the plan it was bought with,
+// returns false if the creator can't pay for it
+func (k Keeper) autoRenewSubscription(ctx sdk.Context, sub *types.Subscription) bool {
+	plan, found := k.plansKeeper.FindPlan(ctx, sub.PlanIndex, sub.PlanBlock)
+	if !found {
+		utils.LavaFormatError("can't find the plan of a subscription to renew", nil, utils.Attribute{Key: "consumer", Value: sub.Consumer}, utils.Attribute{Key: "plan", Value: sub.PlanIndex})
+		return false
+	}
+
+	creatorAcct, err := sdk.AccAddressFromBech32(sub.Creator)
+	if err != nil {
+		utils.LavaFormatError("invalid creator of a subscription to renew", err, utils.Attribute{Key: "consumer", Value: sub.Consumer}, utils.Attribute{Key: "creator", Value: sub.Creator})
+		return false
+	}
+
+	err = k.chargeSubscription(ctx, creatorAcct, plan, sub.AddOns, sub.DurationTotal)
+	if err != nil {
+		return false
+	}
+
+	sub.DurationLeft = sub.DurationTotal
+	details := map[string]string{"consumer": sub.Consumer, "duration": strconv.FormatUint(sub.DurationTotal, 10), "autoRenewal": "true"}
+	utils.LogLavaEvent(ctx, k.Logger(ctx), types.SubscriptionRenewedEventName, details, "subscription renewed automatically")
+	return true
+}
+
+// UpgradeSubscription moves a subscription to a more expensive plan in the middle of its month.
+// The creator pays the monthly price difference of the plans for the months left, where the current
+// month is prorated by its remaining time, and the subscription gets the CU allowance of the new plan from the next epoch
+func (k Keeper) UpgradeSubscription(ctx sdk.Context, creator string, consumer string, planIndex string) error {
+	logger := k.Logger(ctx)
+
+	creatorAcct, err := sdk.AccAddressFromBech32(creator)
+	if err != nil {
+		details := map[string]string{
+			"creator": creator,
+			"error":   err.Error(),
+		}
+		return utils.LavaError(ctx, logger, "UpgradeSubscription", details, "invalid creator")
+	}
+
+	sub, found := k.GetSubscription(ctx, consumer)
+	if !found {
+		details := map[string]string{"consumer": consumer}
+		return utils.LavaError(ctx, logger, "UpgradeSubscription", details, "consumer has no subscription")
+	}
+
+	if creator != sub.Creator {
+		details := map[string]string{"creator": creator, "consumer": consumer}
+		return utils.LavaError(ctx, logger, "UpgradeSubscription", details, "only the creator of a subscription can upgrade it")
+	}
+
+	// an ended subscription (or one in its grace period) is bought again rather than upgraded
+	if sub.DurationLeft == 0 || sub.IsInGracePeriod() {
+		details := map[string]string{"consumer": consumer}
+		return utils.LavaError(ctx, logger, "UpgradeSubscription", details, "subscription has ended")
+	}
+
+	oldPlan, found := k.plansKeeper.FindPlan(ctx, sub.PlanIndex, sub.PlanBlock)
+	if !found {
+		details := map[string]string{"consumer": consumer, "plan": sub.PlanIndex}
+		return utils.LavaError(ctx, logger, "UpgradeSubscription", details, "can't find the plan of the subscription")
+	}
+
+	if planIndex == sub.PlanIndex {
+		details := map[string]string{"consumer": consumer, "plan": planIndex}
+		return utils.LavaError(ctx, logger, "UpgradeSubscription", details, "subscription already has this plan")
+	}
+
+	newPlan, found := k.plansKeeper.FindPlan(ctx, planIndex, uint64(ctx.BlockHeight()))
+	if !found {
+		details := map[string]string{"plan": planIndex}
+		return utils.LavaError(ctx, logger, "UpgradeSubscription", details, "invalid plan")
+	}
+
+	// the subscription keeps its add-ons
+	for _, addOn := range sub.AddOns {
+		if _, found := newPlan.GetAddOn(addOn); !found {
+			details := map[string]string{"consumer": consumer, "plan": planIndex, "addOn": addOn}
+			return utils.LavaError(ctx, logger, "UpgradeSubscription", details, "plan doesn't offer an add-on of the subscription")
+		}
+	}
+
+	oldPrice := oldPlan.GetMonthlyPrice(sub.AddOns)
+	newPrice := newPlan.GetMonthlyPrice(sub.AddOns)
+	if !oldPrice.IsLT(newPrice) {
+		details := map[string]string{
+			"consumer": consumer,
+			"oldPlan":  oldPlan.Index,
+			"oldPrice": oldPrice.String(),
+			"newPlan":  newPlan.Index,
+			"newPrice": newPrice.String(),
+		}
+		return utils.LavaError(ctx, logger, "UpgradeSubscription", details, "plan is not an upgrade of the subscription's plan")
+	}
+
+	// the fixed CU budgets of the projects must still fit in the new plan's allowance
+	newCuTotal := newPlan.PlanPolicy.GetTotalCuLimit()
+	upgraded := sub
+	upgraded.MonthCuTotal = newCuTotal
+	if allocatedCu := upgraded.AllocatedCu(); allocatedCu > newCuTotal {
+		details := map[string]string{
+			"consumer":     consumer,
+			"newPlan":      newPlan.Index,
+			"allocatedCu":  strconv.FormatUint(allocatedCu, 10),
+			"monthCuTotal": strconv.FormatUint(newCuTotal, 10),
+		}
+		return utils.LavaError(ctx, logger, "UpgradeSubscription", details, "project allocations exceed the plan's monthly CU")
+	}
+
+	monthlyDiff := newPrice.Sub(oldPrice)
+	price, secondsLeft := prorateRemainingDuration(ctx, sub, monthlyDiff)
+
+	err = k.chargeCreator(ctx, "UpgradeSubscription", creatorAcct, price)
+	if err != nil {
+		return err
+	}
+
+	// take a reference of the new plan before releasing the old one
+	newPlan, _ = k.plansKeeper.GetPlan(ctx, planIndex)
+	k.plansKeeper.PutPlan(ctx, sub.PlanIndex, sub.PlanBlock)
+
+	sub.PlanIndex = newPlan.Index
+	sub.PlanBlock = newPlan.Block
+
+	// the new plan's CU allowance applies from the next epoch
+	sub.PendingMonthCuTotal = newCuTotal
+
+	k.SetSubscription(ctx, sub)
+
+	details := map[string]string{
+		"consumer":         consumer,
+		"oldPlan":          oldPlan.Index,
+		"newPlan":          newPlan.Index,
+		"monthsLeft":       strconv.FormatUint(sub.DurationLeft, 10),
+		"monthSecondsLeft": strconv.FormatInt(secondsLeft, 10),
+		"monthlyDiff":      monthlyDiff.Amount.String(),
+		"price":            price.String(),
+		"monthCuTotal":     strconv.FormatUint(newCuTotal, 10),
+	}
+	utils.LogLavaEvent(ctx, logger, types.SubscriptionUpgradedEventName, details, "subscription upgraded to a higher plan")
+
+	return nil
+}
+
+// prorateRemainingDuration returns the price of the rest of a subscription's duration at a monthly price: the
+// months after the current one are charged in full, the current month only for the time left of it
+func prorateRemainingDuration(ctx sdk.Context, sub types.Subscription, monthlyPrice sdk.Coin) (price sdk.Coin, secondsLeft int64) {
+	secondsLeft = int64(sub.MonthExpiryTime) - ctx.BlockTime().UTC().Unix()
+	if secondsLeft < 0 {
+		secondsLeft = 0
+	} else if secondsLeft > SECONDS_IN_MONTH {
+		secondsLeft = SECONDS_IN_MONTH
+	}
+	price = sdk.NewCoin(monthlyPrice.Denom, monthlyPrice.Amount.MulRaw(int64(sub.DurationLeft-1)))
+	price.Amount = price.Amount.Add(monthlyPrice.Amount.MulRaw(secondsLeft).QuoRaw(SECONDS_IN_MONTH))
+	return price, secondsLeft
+}
+
+// BuyAddOn adds an add-on of the subscription's plan to the subscription for the rest of its duration, the creator pays the monthly
+// add-on price for the months left, where the current month is prorated by its remaining time. Renewals charge for the add-on too
+func (k Keeper) BuyAddOn(ctx sdk.Context, creator string, consumer string, addOnName string) error {
+	logger := k.Logger(ctx)
+
+	creatorAcct, err := sdk.AccAddressFromBech32(creator)
+	if err != nil {
+		details := map[string]string{
+			"creator": creator,
+			"error":   err.Error(),
+		}
+		return utils.LavaError(ctx, logger, "BuyAddOn", details, "invalid creator")
+	}
+
+	sub, found := k.GetSubscription(ctx, consumer)
+	if !found {
+		details := map[string]string{"consumer": consumer}
+		return utils.LavaError(ctx, logger, "BuyAddOn", details, "consumer has no subscription")
+	}
+
+	if creator != sub.Creator {
+		details := map[string]string{"creator": creator, "consumer": consumer}
+		return utils.LavaError(ctx, logger, "BuyAddOn", details, "only the creator of a subscription can buy add-ons for it")
+	}
+
+	if sub.DurationLeft == 0 || sub.IsInGracePeriod() {
+		details := map[string]string{"consumer": consumer}
+		return utils.LavaError(ctx, logger, "BuyAddOn", details, "subscription has ended")
+	}
+
+	if sub.HasAddOn(addOnName) {
+		details := map[string]string{"consumer": consumer, "addOn": addOnName}
+		return utils.LavaError(ctx, logger, "BuyAddOn", details, "subscription already has the add-on")
+	}
+
+	plan, found := k.plansKeeper.FindPlan(ctx, sub.PlanIndex, sub.PlanBlock)
+	if !found {
+		details := map[string]string{"consumer": consumer, "plan": sub.PlanIndex}
+		return utils.LavaError(ctx, logger, "BuyAddOn", details, "can't find the plan of the subscription")
+	}
+
+	addOn, found := plan.GetAddOn(addOnName)
+	if !found {
+		details := map[string]string{"consumer": consumer, "plan": sub.PlanIndex, "addOn": addOnName}
+		return utils.LavaError(ctx, logger, "BuyAddOn", details, "the subscription's plan doesn't offer the add-on")
+	}
+
+	price, secondsLeft := prorateRemainingDuration(ctx, sub, addOn.Price)
+	err = k.chargeCreator(ctx, "BuyAddOn", creatorAcct, price)
+	if err != nil {
+		return err
+	}
+
+	sub.AddOns = append(sub.AddOns, addOnName)
+	k.SetSubscription(ctx, sub)
+
+	details := map[string]string{
+		"consumer":         consumer,
+		"addOn":            addOnName,
+		"monthsLeft":       strconv.FormatUint(sub.DurationLeft, 10),
+		"monthSecondsLeft": strconv.FormatInt(secondsLeft, 10),
+		"price":            price.String(),
+	}
+	utils.LogLavaEvent(ctx, logger, types.BuyAddOnEventName, details, "add-on bought for subscription")
+
+	return nil
+}
+
+// SetProjectAllocation sets the monthly CU budget of a project of the consumer's subscription, a fixed cu or a percent of the
+// subscription's monthly CU. both 0 remove the project's budget
+func (k Keeper) SetProjectAllocation(ctx sdk.Context, consumer string, projectName string, cu uint64, percent uint64) error {
+	logger := k.Logger(ctx)
+
+	sub, found := k.GetSubscription(ctx, consumer)
+	if !found {
+		details := map[string]string{"consumer": consumer}
+		return utils.LavaError(ctx, logger, "SetProjectAllocation", details, "consumer has no subscription")
+	}
+
+	projectIndex := projectstypes.ProjectIndex(consumer, projectName)
+	project, err := k.projectsKeeper.GetProjectForBlock(ctx, projectIndex, uint64(ctx.BlockHeight()))
+	if err != nil || project.Subscription != consumer {
+		details := map[string]string{"consumer": consumer, "project": projectIndex}
+		return utils.LavaError(ctx, logger, "SetProjectAllocation", details, "project doesn't belong to the subscription")
+	}
+	if !project.Enabled && (cu != 0 || percent != 0) {
+		details := map[string]string{"consumer": consumer, "project": projectIndex}
+		return utils.LavaError(ctx, logger, "SetProjectAllocation", details, "project is disabled")
+	}
+
+	sub.RemoveProjectAllocation(projectIndex)
+	if cu != 0 || percent != 0 {
+		sub.ProjectAllocations = append(sub.ProjectAllocations, types.ProjectAllocation{Project: projectIndex, Cu: cu, Percent: percent})
+	}
+	sort.Slice(sub.ProjectAllocations, func(i, j int) bool {
+		return sub.ProjectAllocations[i].Project < sub.ProjectAllocations[j].Project
+	})
+
+	if allocatedCu := sub.AllocatedCu(); allocatedCu > sub.MonthCuTotal {
+		details := map[string]string{
+			"consumer":     consumer,
+			"project":      projectIndex,
+			"allocatedCu":  strconv.FormatUint(allocatedCu, 10),
+			"monthCuTotal": strconv.FormatUint(sub.MonthCuTotal, 10),
+		}
+		return utils.LavaError(ctx, logger, "SetProjectAllocation", details, "project allocations exceed the subscription's monthly CU")
 	}
 
 	k.SetSubscription(ctx, sub)
 
+	details := map[string]string{
+		"consumer": consumer,
+		"project":  projectIndex,
+		"cu":       strconv.FormatUint(cu, 10),
+		"percent":  strconv.FormatUint(percent, 10),
+	}
+	utils.LogLavaEvent(ctx, logger, types.SetProjectAllocationEventName, details, "project allocation set for subscription")
+
 	return nil
 }
 
+// RemoveProjectAllocation removes the monthly CU budget of a project that was deleted or disabled, so it no longer counts
+// against the subscription's monthly CU
+func (k Keeper) RemoveProjectAllocation(ctx sdk.Context, consumer string, projectIndex string) {
+	sub, found := k.GetSubscription(ctx, consumer)
+	if !found || !sub.RemoveProjectAllocation(projectIndex) {
+		return
+	}
+
+	k.SetSubscription(ctx, sub)
+
+	details := map[string]string{"consumer": consumer, "project": projectIndex}
+	utils.LogLavaEvent(ctx, k.Logger(ctx), types.RemoveProjectAllocationEventName, details, "allocation of a disabled project removed from subscription")
+}
+
 func (k Keeper) GetPlanFromSubscription(ctx sdk.Context, consumer string) (planstypes.Plan, error) {
 	sub, found := k.GetSubscription(ctx, consumer)
 	if !found {
 		return planstypes.Plan{}, utils.LavaError(ctx, k.Logger(ctx), "GetPlanFromSubscription_cant_find_subscription", map[string]string{"consumer": consumer}, "can't find subscription with consumer address")
 	}
 
-	plan, found := k.plansKeeper.FindPlan(ctx, sub.PlanIndex, sub.Block)
+	plan, found := k.plansKeeper.FindPlan(ctx, sub.PlanIndex, sub.PlanBlock)
 	if !found {
 		return planstypes.Plan{}, utils.LavaError(ctx, k.Logger(ctx), "GetPlanFromSubscription_cant_find_plan", map[string]string{"consumer": consumer, "planId": sub.PlanIndex}, "can't find plan from subscription with consumer address")
 	}
@@ -274,7 +607,7 @@ func (k Keeper) AddProjectToSubscription(ctx sdk.Context, subscription string, p
 		return sdkerrors.ErrKeyNotFound.Wrapf("AddProjectToSubscription_can't_get_subscription_of_%s", subscription)
 	}
 
-	plan, found := k.plansKeeper.FindPlan(ctx, sub.GetPlanIndex(), sub.GetBlock())
+	plan, found := k.plansKeeper.FindPlan(ctx, sub.GetPlanIndex(), sub.GetPlanBlock())
 	if !found {
 		details := map[string]string{
 			"subscription": sub.GetCreator(),
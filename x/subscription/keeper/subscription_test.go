@@ -256,7 +256,7 @@ func TestCreateSubscription(t *testing.T) {
 				}
 
 				err := keeper.CreateSubscription(
-					ts.ctx, sub.Creator, sub.Consumer, sub.PlanIndex, tt.duration, "")
+					ts.ctx, sub.Creator, sub.Consumer, sub.PlanIndex, tt.duration, "", false)
 				if tt.success {
 					require.Nil(t, err, tt.name)
 					_, found := keeper.GetSubscription(ts.ctx, sub.Consumer)
@@ -276,7 +276,7 @@ func TestRenewSubscription(t *testing.T) {
 	account := common.CreateNewAccount(ts._ctx, *ts.keepers, 10000)
 	creator := account.Addr.String()
 
-	err := keeper.CreateSubscription(ts.ctx, creator, creator, ts.plans[0].Index, 6, "")
+	err := keeper.CreateSubscription(ts.ctx, creator, creator, ts.plans[0].Index, 6, "", false)
 	require.Nil(t, err)
 
 	sub, found := keeper.GetSubscription(ts.ctx, creator)
@@ -289,11 +289,11 @@ func TestRenewSubscription(t *testing.T) {
 	require.Equal(t, uint64(3), sub.DurationLeft)
 
 	// with 3 months duration left, asking for 12 more should fail
-	err = keeper.CreateSubscription(ts.ctx, creator, creator, ts.plans[0].Index, 12, "")
+	err = keeper.CreateSubscription(ts.ctx, creator, creator, ts.plans[0].Index, 12, "", false)
 	require.NotNil(t, err)
 
 	// but asking for additional 10 is fine
-	err = keeper.CreateSubscription(ts.ctx, creator, creator, ts.plans[0].Index, 10, "")
+	err = keeper.CreateSubscription(ts.ctx, creator, creator, ts.plans[0].Index, 10, "", false)
 	require.Nil(t, err)
 
 	sub, found = keeper.GetSubscription(ts.ctx, creator)
@@ -310,7 +310,7 @@ func TestSubscriptionAdminProject(t *testing.T) {
 	account := common.CreateNewAccount(ts._ctx, *ts.keepers, 10000)
 	creator := account.Addr.String()
 
-	err := keeper.CreateSubscription(ts.ctx, creator, creator, "mockPlan1", 1, "")
+	err := keeper.CreateSubscription(ts.ctx, creator, creator, "mockPlan1", 1, "", false)
 	require.Nil(t, err)
 
 	block := uint64(ts.ctx.BlockHeight())
@@ -329,7 +329,7 @@ func TestMonthlyRechargeCU(t *testing.T) {
 	account := common.CreateNewAccount(ts._ctx, *ts.keepers, 10000)
 	creator := account.Addr.String()
 
-	err := keeper.CreateSubscription(ts.ctx, creator, creator, ts.plans[0].Index, 2, "")
+	err := keeper.CreateSubscription(ts.ctx, creator, creator, ts.plans[0].Index, 2, "", false)
 	require.Nil(t, err)
 
 	block1 := uint64(ts.ctx.BlockHeight())
@@ -436,7 +436,7 @@ func TestExpiryTime(t *testing.T) {
 			delta := now.Sub(ts.ctx.BlockTime())
 			ts.advanceBlock(delta)
 
-			err := keeper.CreateSubscription(ts.ctx, creator, creator, plan.Index, tt.months, "")
+			err := keeper.CreateSubscription(ts.ctx, creator, creator, plan.Index, tt.months, "", false)
 			require.Nil(t, err)
 
 			sub, found := keeper.GetSubscription(ts.ctx, creator)
@@ -482,7 +482,7 @@ func TestPrice(t *testing.T) {
 			plan.Price = sdk.NewCoin("ulava", sdk.NewInt(tt.price))
 			ts.keepers.Plans.AddPlan(ts.ctx, plan)
 
-			err := keeper.CreateSubscription(ts.ctx, creator, creator, plan.Index, tt.duration, "")
+			err := keeper.CreateSubscription(ts.ctx, creator, creator, plan.Index, tt.duration, "", false)
 			require.Nil(t, err)
 
 			_, found := keeper.GetSubscription(ts.ctx, creator)
@@ -513,7 +513,7 @@ func TestAddProjectToSubscription(t *testing.T) {
 	consumerAddr := consumer.Addr.String()
 	regularAccountAddr := regularAccount.Addr.String()
 
-	err := keeper.CreateSubscription(ts.ctx, subPayerAddr, consumerAddr, plan.Index, 1, "")
+	err := keeper.CreateSubscription(ts.ctx, subPayerAddr, consumerAddr, plan.Index, 1, "", false)
 	require.Nil(t, err)
 
 	defaultProjectName := projectstypes.ADMIN_PROJECT_NAME
@@ -563,3 +563,236 @@ func TestAddProjectToSubscription(t *testing.T) {
 		})
 	}
 }
+
+func TestUpgradeSubscription(t *testing.T) {
+	ts := setupTestStruct(t, 2)
+	keeper := ts.keepers.Subscription
+
+	// the second plan costs 200 more a month and allows 2000 more CU
+	plan := ts.plans[1]
+	plan.Price = sdk.NewCoin("ulava", sdk.NewInt(300))
+	plan.PlanPolicy.TotalCuLimit = 3000
+	ts.keepers.Plans.AddPlan(ts.ctx, plan)
+
+	account := common.CreateNewAccount(ts._ctx, *ts.keepers, 10000)
+	creator := account.Addr.String()
+	other := common.CreateNewAccount(ts._ctx, *ts.keepers, 10000).Addr.String()
+
+	err := keeper.CreateSubscription(ts.ctx, creator, creator, ts.plans[0].Index, 3, "", false)
+	require.Nil(t, err)
+
+	// half of the current month is left
+	sub, found := keeper.GetSubscription(ts.ctx, creator)
+	require.True(t, found)
+	sub.MonthExpiryTime = uint64(ts.ctx.BlockTime().Add(15 * 24 * time.Hour).UTC().Unix())
+	keeper.SetSubscription(ts.ctx, sub)
+
+	err = keeper.ChargeComputeUnitsToSubscription(ts.ctx, creator, 400)
+	require.Nil(t, err)
+
+	// only the creator may upgrade, and only to another existing plan
+	err = keeper.UpgradeSubscription(ts.ctx, other, creator, plan.Index)
+	require.NotNil(t, err)
+	err = keeper.UpgradeSubscription(ts.ctx, creator, creator, "no-such-plan")
+	require.NotNil(t, err)
+	err = keeper.UpgradeSubscription(ts.ctx, creator, creator, ts.plans[0].Index)
+	require.NotNil(t, err)
+
+	err = keeper.UpgradeSubscription(ts.ctx, creator, creator, plan.Index)
+	require.Nil(t, err)
+
+	// 3 months of the first plan, then 2 months and a half of the price difference
+	balance := ts.keepers.BankKeeper.GetBalance(ts.ctx, account.Addr, epochstoragetypes.TokenDenom)
+	require.Equal(t, int64(10000-300-500), balance.Amount.Int64())
+
+	sub, found = keeper.GetSubscription(ts.ctx, creator)
+	require.True(t, found)
+	require.Equal(t, plan.Index, sub.PlanIndex)
+	require.Equal(t, uint64(3), sub.DurationLeft)
+
+	// the new plan's allowance applies from the next epoch, the CU used this month is kept
+	monthCuTotal := ts.plans[0].PlanPolicy.TotalCuLimit
+	require.Equal(t, monthCuTotal, sub.MonthCuTotal)
+	require.Equal(t, monthCuTotal-400, sub.MonthCuLeft)
+	require.Equal(t, uint64(3000), sub.PendingMonthCuTotal)
+
+	ts._ctx = keepertest.AdvanceEpoch(ts._ctx, ts.keepers)
+	ts.ctx = sdk.UnwrapSDKContext(ts._ctx)
+	keeper.EpochStart(ts.ctx)
+
+	sub, found = keeper.GetSubscription(ts.ctx, creator)
+	require.True(t, found)
+	require.Equal(t, uint64(3000), sub.MonthCuTotal)
+	require.Equal(t, uint64(2600), sub.MonthCuLeft)
+	require.Zero(t, sub.PendingMonthCuTotal)
+
+	subPlan, err := keeper.GetPlanFromSubscription(ts.ctx, creator)
+	require.Nil(t, err)
+	require.Equal(t, plan.Index, subPlan.Index)
+
+	// a cheaper plan is not an upgrade
+	err = keeper.UpgradeSubscription(ts.ctx, creator, creator, ts.plans[0].Index)
+	require.NotNil(t, err)
+
+	// the next month starts with the new plan's allowance
+	sub = ts.expireSubscription(sub)
+	require.Equal(t, uint64(2), sub.DurationLeft)
+	require.Equal(t, uint64(3000), sub.MonthCuLeft)
+}
+
+func TestBuyAddOn(t *testing.T) {
+	ts := setupTestStruct(t, 1)
+	keeper := ts.keepers.Subscription
+
+	plan := ts.plans[0]
+	plan.AddOns = []planstypes.AddOn{{Name: "archive", Price: sdk.NewCoin("ulava", sdk.NewInt(20))}}
+	ts.keepers.Plans.AddPlan(ts.ctx, plan)
+
+	account := common.CreateNewAccount(ts._ctx, *ts.keepers, 10000)
+	creator := account.Addr.String()
+
+	err := keeper.CreateSubscription(ts.ctx, creator, creator, plan.Index, 3, "", false)
+	require.Nil(t, err)
+
+	// half of the current month is left
+	sub, found := keeper.GetSubscription(ts.ctx, creator)
+	require.True(t, found)
+	sub.MonthExpiryTime = uint64(ts.ctx.BlockTime().Add(15 * 24 * time.Hour).UTC().Unix())
+	keeper.SetSubscription(ts.ctx, sub)
+
+	// only add-ons of the plan can be bought
+	err = keeper.BuyAddOn(ts.ctx, creator, creator, "trace")
+	require.NotNil(t, err)
+
+	err = keeper.BuyAddOn(ts.ctx, creator, creator, "archive")
+	require.Nil(t, err)
+	err = keeper.BuyAddOn(ts.ctx, creator, creator, "archive")
+	require.NotNil(t, err)
+
+	// 3 months of the plan, then 2 months and a half of the add-on
+	balance := ts.keepers.BankKeeper.GetBalance(ts.ctx, account.Addr, epochstoragetypes.TokenDenom)
+	require.Equal(t, int64(10000-300-50), balance.Amount.Int64())
+
+	sub, found = keeper.GetSubscription(ts.ctx, creator)
+	require.True(t, found)
+	require.Equal(t, []string{"archive"}, sub.AddOns)
+
+	// renewing the subscription charges for the add-on too
+	err = keeper.CreateSubscription(ts.ctx, creator, creator, plan.Index, 1, "", false)
+	require.Nil(t, err)
+	balance = ts.keepers.BankKeeper.GetBalance(ts.ctx, account.Addr, epochstoragetypes.TokenDenom)
+	require.Equal(t, int64(10000-300-50-120), balance.Amount.Int64())
+}
+
+func TestSetProjectAllocation(t *testing.T) {
+	ts := setupTestStruct(t, 1)
+	keeper := ts.keepers.Subscription
+	plan := ts.plans[0]
+
+	account := common.CreateNewAccount(ts._ctx, *ts.keepers, 10000)
+	consumer := account.Addr.String()
+
+	err := keeper.CreateSubscription(ts.ctx, consumer, consumer, plan.Index, 1, "", false)
+	require.Nil(t, err)
+	err = keeper.AddProjectToSubscription(ts.ctx, consumer, projectstypes.ProjectData{Name: "backend", Enabled: true})
+	require.Nil(t, err)
+
+	sub, found := keeper.GetSubscription(ts.ctx, consumer)
+	require.True(t, found)
+	monthCuTotal := sub.MonthCuTotal
+	adminProject := projectstypes.ProjectIndex(consumer, projectstypes.ADMIN_PROJECT_NAME)
+	backendProject := projectstypes.ProjectIndex(consumer, "backend")
+
+	// only projects of the subscription can be allocated
+	err = keeper.SetProjectAllocation(ts.ctx, consumer, "frontend", monthCuTotal/10, 0)
+	require.NotNil(t, err)
+
+	err = keeper.SetProjectAllocation(ts.ctx, consumer, "backend", 0, 60)
+	require.Nil(t, err)
+	err = keeper.SetProjectAllocation(ts.ctx, consumer, projectstypes.ADMIN_PROJECT_NAME, monthCuTotal/10, 0)
+	require.Nil(t, err)
+
+	// the allocations may not exceed the subscription's cu
+	err = keeper.SetProjectAllocation(ts.ctx, consumer, projectstypes.ADMIN_PROJECT_NAME, monthCuTotal/2, 0)
+	require.NotNil(t, err)
+
+	sub, found = keeper.GetSubscription(ts.ctx, consumer)
+	require.True(t, found)
+	require.Len(t, sub.ProjectAllocations, 2)
+	backendCu, found := sub.ProjectCuAllocation(backendProject)
+	require.True(t, found)
+	require.Equal(t, monthCuTotal*60/100, backendCu)
+	require.Equal(t, monthCuTotal/10-5, sub.ProjectCuLeft(adminProject, 5))
+	require.Equal(t, uint64(0), sub.ProjectCuLeft(adminProject, monthCuTotal/10))
+
+	// removing an allocation leaves the project with the subscription's cu
+	err = keeper.SetProjectAllocation(ts.ctx, consumer, projectstypes.ADMIN_PROJECT_NAME, 0, 0)
+	require.Nil(t, err)
+	sub, found = keeper.GetSubscription(ts.ctx, consumer)
+	require.True(t, found)
+	require.Len(t, sub.ProjectAllocations, 1)
+	require.Equal(t, sub.MonthCuLeft, sub.ProjectCuLeft(adminProject, monthCuTotal))
+
+	// the allocations of disabled and deleted projects are removed
+	err = keeper.SetProjectAllocation(ts.ctx, consumer, projectstypes.ADMIN_PROJECT_NAME, monthCuTotal/10, 0)
+	require.Nil(t, err)
+	err = ts.keepers.Projects.SetProjectState(ts.ctx, backendProject, consumer, false)
+	require.Nil(t, err)
+	sub, found = keeper.GetSubscription(ts.ctx, consumer)
+	require.True(t, found)
+	_, found = sub.ProjectCuAllocation(backendProject)
+	require.False(t, found)
+	require.Len(t, sub.ProjectAllocations, 1)
+
+	err = ts.keepers.Projects.DeleteProject(ts.ctx, adminProject)
+	require.Nil(t, err)
+	sub, found = keeper.GetSubscription(ts.ctx, consumer)
+	require.True(t, found)
+	require.Empty(t, sub.ProjectAllocations)
+}
+
+func TestUpgradeSubscriptionProjectAllocations(t *testing.T) {
+	ts := setupTestStruct(t, 2)
+	keeper := ts.keepers.Subscription
+
+	// the second plan costs more but allows less CU
+	plan := ts.plans[1]
+	plan.Price = sdk.NewCoin("ulava", sdk.NewInt(300))
+	plan.PlanPolicy.TotalCuLimit = 500
+	ts.keepers.Plans.AddPlan(ts.ctx, plan)
+
+	account := common.CreateNewAccount(ts._ctx, *ts.keepers, 10000)
+	consumer := account.Addr.String()
+
+	err := keeper.CreateSubscription(ts.ctx, consumer, consumer, ts.plans[0].Index, 3, "", false)
+	require.Nil(t, err)
+
+	// a fixed allocation that doesn't fit in the new plan's CU blocks the upgrade
+	err = keeper.SetProjectAllocation(ts.ctx, consumer, projectstypes.ADMIN_PROJECT_NAME, 600, 0)
+	require.Nil(t, err)
+	err = keeper.UpgradeSubscription(ts.ctx, consumer, consumer, plan.Index)
+	require.NotNil(t, err)
+	balance := ts.keepers.BankKeeper.GetBalance(ts.ctx, account.Addr, epochstoragetypes.TokenDenom)
+	require.Equal(t, int64(10000-300), balance.Amount.Int64())
+
+	// a percent allocation scales with the plan's CU
+	err = keeper.SetProjectAllocation(ts.ctx, consumer, projectstypes.ADMIN_PROJECT_NAME, 0, 60)
+	require.Nil(t, err)
+	err = keeper.UpgradeSubscription(ts.ctx, consumer, consumer, plan.Index)
+	require.Nil(t, err)
+
+	// from the next epoch, with the new plan's CU
+	adminCu := func() uint64 {
+		sub, found := keeper.GetSubscription(ts.ctx, consumer)
+		require.True(t, found)
+		cu, found := sub.ProjectCuAllocation(projectstypes.ProjectIndex(consumer, projectstypes.ADMIN_PROJECT_NAME))
+		require.True(t, found)
+		return cu
+	}
+	require.Equal(t, uint64(600), adminCu())
+
+	ts._ctx = keepertest.AdvanceEpoch(ts._ctx, ts.keepers)
+	ts.ctx = sdk.UnwrapSDKContext(ts._ctx)
+	keeper.EpochStart(ts.ctx)
+	require.Equal(t, uint64(300), adminCu())
+}
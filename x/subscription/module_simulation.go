@@ -32,6 +32,18 @@ const (
 	// TODO: Determine the simulation weight value
 	defaultWeightMsgAddProject int = 100
 
+	opWeightMsgUpgradeSubscription = "op_weight_msg_upgrade_subscription"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgUpgradeSubscription int = 100
+
+	opWeightMsgBuyAddOn = "op_weight_msg_buy_add_on"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgBuyAddOn int = 100
+
+	opWeightMsgSetProjectAllocation = "op_weight_msg_set_project_allocation"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgSetProjectAllocation int = 100
+
 	// this line is used by starport scaffolding # simapp/module/const
 )
 
@@ -87,6 +99,39 @@ func (am AppModule) WeightedOperations(simState module.SimulationState) []simtyp
 		subscriptionsimulation.SimulateMsgAddProject(am.accountKeeper, am.bankKeeper, am.keeper),
 	))
 
+	var weightMsgUpgradeSubscription int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgUpgradeSubscription, &weightMsgUpgradeSubscription, nil,
+		func(_ *rand.Rand) {
+			weightMsgUpgradeSubscription = defaultWeightMsgUpgradeSubscription
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgUpgradeSubscription,
+		subscriptionsimulation.SimulateMsgUpgradeSubscription(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgBuyAddOn int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgBuyAddOn, &weightMsgBuyAddOn, nil,
+		func(_ *rand.Rand) {
+			weightMsgBuyAddOn = defaultWeightMsgBuyAddOn
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgBuyAddOn,
+		subscriptionsimulation.SimulateMsgBuyAddOn(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgSetProjectAllocation int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgSetProjectAllocation, &weightMsgSetProjectAllocation, nil,
+		func(_ *rand.Rand) {
+			weightMsgSetProjectAllocation = defaultWeightMsgSetProjectAllocation
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgSetProjectAllocation,
+		subscriptionsimulation.SimulateMsgSetProjectAllocation(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
 	// this line is used by starport scaffolding # simapp/module/operation
 
 	return operations
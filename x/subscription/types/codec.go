@@ -12,6 +12,9 @@ import (
 func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgBuy{}, "subscription/Buy", nil)
 	cdc.RegisterConcrete(&MsgAddProject{}, "subscription/AddProject", nil)
+	cdc.RegisterConcrete(&MsgUpgradeSubscription{}, "subscription/UpgradeSubscription", nil)
+	cdc.RegisterConcrete(&MsgBuyAddOn{}, "subscription/BuyAddOn", nil)
+	cdc.RegisterConcrete(&MsgSetProjectAllocation{}, "subscription/SetProjectAllocation", nil)
 	// this line is used by starport scaffolding # 2
 }
 
@@ -22,6 +25,15 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgAddProject{},
 	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgUpgradeSubscription{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgBuyAddOn{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSetProjectAllocation{},
+	)
 	// this line is used by starport scaffolding # 3
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
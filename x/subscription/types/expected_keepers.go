@@ -31,6 +31,7 @@ type ProjectsKeeper interface {
 	CreateProject(ctx sdk.Context, subscriptionAddress string, projectData projectstypes.ProjectData, plan planstypes.Plan) error
 	DeleteProject(ctx sdk.Context, index string) error
 	SnapshotSubscriptionProjects(ctx sdk.Context, subscriptionAddr string)
+	GetProjectForBlock(ctx sdk.Context, projectID string, blockHeight uint64) (projectstypes.Project, error)
 	// Methods imported from projectskeeper should be defined here
 }
 
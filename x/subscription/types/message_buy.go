@@ -11,13 +11,14 @@ const TypeMsgBuy = "buy"
 
 var _ sdk.Msg = &MsgBuy{}
 
-func NewMsgBuy(creator string, consumer string, index string, duration uint64, vrfpk string) *MsgBuy {
+func NewMsgBuy(creator string, consumer string, index string, duration uint64, vrfpk string, autoRenewal bool) *MsgBuy {
 	return &MsgBuy{
-		Creator:  creator,
-		Consumer: consumer,
-		Index:    index,
-		Duration: duration,
-		Vrfpk:    vrfpk,
+		Creator:     creator,
+		Consumer:    consumer,
+		Index:       index,
+		Duration:    duration,
+		Vrfpk:       vrfpk,
+		AutoRenewal: autoRenewal,
 	}
 }
 
@@ -0,0 +1,57 @@
+package types
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgBuyAddOn = "buy_add_on"
+
+var _ sdk.Msg = &MsgBuyAddOn{}
+
+func NewMsgBuyAddOn(creator string, consumer string, addOn string) *MsgBuyAddOn {
+	return &MsgBuyAddOn{
+		Creator:  creator,
+		Consumer: consumer,
+		AddOn:    addOn,
+	}
+}
+
+func (msg *MsgBuyAddOn) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgBuyAddOn) Type() string {
+	return TypeMsgBuyAddOn
+}
+
+func (msg *MsgBuyAddOn) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgBuyAddOn) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgBuyAddOn) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	_, err = sdk.AccAddressFromBech32(msg.Consumer)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid consumer address (%s)", err)
+	}
+	if strings.TrimSpace(msg.AddOn) == "" {
+		return sdkerrors.Wrapf(ErrBlankParameter, "invalid add-on (%s)", msg.AddOn)
+	}
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/lavanet/lava/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgBuyAddOn(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgBuyAddOn
+		err  error
+	}{
+		{
+			name: "invalid creator address",
+			msg: MsgBuyAddOn{
+				Creator:  "invalid_address",
+				Consumer: sample.AccAddress(),
+				AddOn:    "archive",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid consumer addresses",
+			msg: MsgBuyAddOn{
+				Creator:  sample.AccAddress(),
+				Consumer: "invalid_address",
+				AddOn:    "archive",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "valid addresses",
+			msg: MsgBuyAddOn{
+				Creator:  sample.AccAddress(),
+				Consumer: sample.AccAddress(),
+				AddOn:    "archive",
+			},
+		}, {
+			name: "blank add-on",
+			msg: MsgBuyAddOn{
+				Creator:  sample.AccAddress(),
+				Consumer: sample.AccAddress(),
+			},
+			err: ErrBlankParameter,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package types
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgSetProjectAllocation = "set_project_allocation"
+
+var _ sdk.Msg = &MsgSetProjectAllocation{}
+
+func NewMsgSetProjectAllocation(creator string, project string, cu uint64, percent uint64) *MsgSetProjectAllocation {
+	return &MsgSetProjectAllocation{
+		Creator: creator,
+		Project: project,
+		Cu:      cu,
+		Percent: percent,
+	}
+}
+
+func (msg *MsgSetProjectAllocation) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetProjectAllocation) Type() string {
+	return TypeMsgSetProjectAllocation
+}
+
+func (msg *MsgSetProjectAllocation) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgSetProjectAllocation) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSetProjectAllocation) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if strings.TrimSpace(msg.Project) == "" {
+		return sdkerrors.Wrapf(ErrBlankParameter, "invalid project (%s)", msg.Project)
+	}
+	if msg.Cu != 0 && msg.Percent != 0 {
+		return sdkerrors.Wrapf(ErrInvalidParameter, "allocation is either cu (%d) or percent (%d), not both", msg.Cu, msg.Percent)
+	}
+	if msg.Percent > 100 {
+		return sdkerrors.Wrapf(ErrInvalidParameter, "invalid percent (%d)", msg.Percent)
+	}
+
+	return nil
+}
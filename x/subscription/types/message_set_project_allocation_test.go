@@ -0,0 +1,81 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/lavanet/lava/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgSetProjectAllocation(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgSetProjectAllocation
+		err  error
+	}{
+		{
+			name: "invalid creator address",
+			msg: MsgSetProjectAllocation{
+				Creator: "invalid_address",
+				Project: "backend",
+				Cu:      1000,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "fixed cu",
+			msg: MsgSetProjectAllocation{
+				Creator: sample.AccAddress(),
+				Project: "backend",
+				Cu:      1000,
+			},
+		}, {
+			name: "percent",
+			msg: MsgSetProjectAllocation{
+				Creator: sample.AccAddress(),
+				Project: "backend",
+				Percent: 40,
+			},
+		}, {
+			name: "removed allocation",
+			msg: MsgSetProjectAllocation{
+				Creator: sample.AccAddress(),
+				Project: "backend",
+			},
+		}, {
+			name: "blank project",
+			msg: MsgSetProjectAllocation{
+				Creator: sample.AccAddress(),
+				Cu:      1000,
+			},
+			err: ErrBlankParameter,
+		}, {
+			name: "both cu and percent",
+			msg: MsgSetProjectAllocation{
+				Creator: sample.AccAddress(),
+				Project: "backend",
+				Cu:      1000,
+				Percent: 40,
+			},
+			err: ErrInvalidParameter,
+		}, {
+			name: "percent above 100",
+			msg: MsgSetProjectAllocation{
+				Creator: sample.AccAddress(),
+				Project: "backend",
+				Percent: 101,
+			},
+			err: ErrInvalidParameter,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package types
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgUpgradeSubscription = "upgrade_subscription"
+
+var _ sdk.Msg = &MsgUpgradeSubscription{}
+
+func NewMsgUpgradeSubscription(creator string, consumer string, index string) *MsgUpgradeSubscription {
+	return &MsgUpgradeSubscription{
+		Creator:  creator,
+		Consumer: consumer,
+		Index:    index,
+	}
+}
+
+func (msg *MsgUpgradeSubscription) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUpgradeSubscription) Type() string {
+	return TypeMsgUpgradeSubscription
+}
+
+func (msg *MsgUpgradeSubscription) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgUpgradeSubscription) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgUpgradeSubscription) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	_, err = sdk.AccAddressFromBech32(msg.Consumer)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid consumer address (%s)", err)
+	}
+	if strings.TrimSpace(msg.Index) == "" {
+		return sdkerrors.Wrapf(ErrBlankParameter, "invalid plan index (%s)", msg.Index)
+	}
+
+	return nil
+}
@@ -1,34 +1,60 @@
 package types
 
 import (
+	"fmt"
+
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"gopkg.in/yaml.v2"
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
 
+var (
+	KeyGracePeriodDays            = []byte("GracePeriodDays")
+	DefaultGracePeriodDays uint64 = 0
+)
+
+var (
+	KeyGraceCuPercent            = []byte("GraceCuPercent")
+	DefaultGraceCuPercent uint64 = 10
+)
+
 // ParamKeyTable the param key table for launch module
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
 // NewParams creates a new Params instance
-func NewParams() Params {
-	return Params{}
+func NewParams(gracePeriodDays uint64, graceCuPercent uint64) Params {
+	return Params{
+		GracePeriodDays: gracePeriodDays,
+		GraceCuPercent:  graceCuPercent,
+	}
 }
 
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
-	return NewParams()
+	return NewParams(DefaultGracePeriodDays, DefaultGraceCuPercent)
 }
 
 // ParamSetPairs get the params.ParamSet
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
-	return paramtypes.ParamSetPairs{}
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyGracePeriodDays, &p.GracePeriodDays, validateGracePeriodDays),
+		paramtypes.NewParamSetPair(KeyGraceCuPercent, &p.GraceCuPercent, validateGraceCuPercent),
+	}
 }
 
 // Validate validates the set of params
 func (p Params) Validate() error {
+	if err := validateGracePeriodDays(p.GracePeriodDays); err != nil {
+		return err
+	}
+
+	if err := validateGraceCuPercent(p.GraceCuPercent); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -37,3 +63,30 @@ func (p Params) String() string {
 	out, _ := yaml.Marshal(p)
 	return string(out)
 }
+
+func validateGracePeriodDays(v interface{}) error {
+	gracePeriodDays, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	// a grace period can't outlast a month of the subscription
+	if gracePeriodDays > 28 {
+		return fmt.Errorf("invalid parameter GracePeriodDays, must be at most 28 days")
+	}
+
+	return nil
+}
+
+func validateGraceCuPercent(v interface{}) error {
+	graceCuPercent, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if graceCuPercent > 100 {
+		return fmt.Errorf("invalid parameter GraceCuPercent, must be at most 100")
+	}
+
+	return nil
+}
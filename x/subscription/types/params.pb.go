@@ -25,6 +25,8 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the parameters for the module.
 type Params struct {
+	GracePeriodDays uint64 `protobuf:"varint,1,opt,name=grace_period_days,json=gracePeriodDays,proto3" json:"grace_period_days,omitempty" yaml:"grace_period_days"`
+	GraceCuPercent  uint64 `protobuf:"varint,2,opt,name=grace_cu_percent,json=graceCuPercent,proto3" json:"grace_cu_percent,omitempty" yaml:"grace_cu_percent"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -59,6 +61,20 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetGracePeriodDays() uint64 {
+	if m != nil {
+		return m.GracePeriodDays
+	}
+	return 0
+}
+
+func (m *Params) GetGraceCuPercent() uint64 {
+	if m != nil {
+		return m.GraceCuPercent
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "lavanet.lava.subscription.Params")
 }
@@ -66,17 +82,23 @@ func init() {
 func init() { proto.RegisterFile("subscription/params.proto", fileDescriptor_2e445ece5ef15599) }
 
 var fileDescriptor_2e445ece5ef15599 = []byte{
-	// 152 bytes of a gzipped FileDescriptorProto
+	// 241 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x2c, 0x2e, 0x4d, 0x2a,
 	0x4e, 0x2e, 0xca, 0x2c, 0x28, 0xc9, 0xcc, 0xcf, 0xd3, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6,
 	0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0xcc, 0x49, 0x2c, 0x4b, 0xcc, 0x4b, 0x2d, 0xd1, 0x03,
 	0xd1, 0x7a, 0xc8, 0xea, 0xa4, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0xaa, 0xf4, 0x41, 0x2c, 0x88,
-	0x06, 0x25, 0x3e, 0x2e, 0xb6, 0x00, 0xb0, 0x01, 0x56, 0x2c, 0x33, 0x16, 0xc8, 0x33, 0x38, 0xb9,
-	0x9d, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb,
-	0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x4e, 0x7a, 0x66, 0x49, 0x46,
-	0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae, 0x3e, 0xd4, 0x16, 0x30, 0xad, 0x5f, 0xa1, 0x8f, 0xe2, 0x9e,
-	0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36, 0xb0, 0xf1, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0x5d, 0xc5, 0xb8, 0xe7, 0xac, 0x00, 0x00, 0x00,
+	0x06, 0xa5, 0xf9, 0x8c, 0x5c, 0x6c, 0x01, 0x60, 0x13, 0x84, 0x3c, 0xb8, 0x04, 0xd3, 0x8b, 0x12,
+	0x93, 0x53, 0xe3, 0x0b, 0x52, 0x8b, 0x32, 0xf3, 0x53, 0xe2, 0x53, 0x12, 0x2b, 0x8b, 0x25, 0x18,
+	0x15, 0x18, 0x35, 0x58, 0x9c, 0x64, 0x3e, 0xdd, 0x93, 0x97, 0xa8, 0x4c, 0xcc, 0xcd, 0xb1, 0x52,
+	0xc2, 0x50, 0xa2, 0x14, 0xc4, 0x0f, 0x16, 0x0b, 0x00, 0x0b, 0xb9, 0x24, 0x56, 0x16, 0x0b, 0xb9,
+	0x72, 0x09, 0x40, 0x94, 0x25, 0x97, 0x82, 0x54, 0x26, 0xa7, 0xe6, 0x95, 0x48, 0x30, 0x81, 0x0d,
+	0x92, 0xfe, 0x74, 0x4f, 0x5e, 0x1c, 0xd9, 0x20, 0x84, 0x0a, 0xa5, 0x20, 0x3e, 0xb0, 0x90, 0x73,
+	0x69, 0x00, 0x44, 0xc0, 0x8a, 0x65, 0xc6, 0x02, 0x79, 0x06, 0x27, 0xb7, 0x13, 0x8f, 0xe4, 0x18,
+	0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5,
+	0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0xd2, 0x49, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce,
+	0xcf, 0xd5, 0x87, 0xfa, 0x1b, 0x4c, 0xeb, 0x57, 0xe8, 0xa3, 0x84, 0x50, 0x49, 0x65, 0x41, 0x6a,
+	0x71, 0x12, 0x1b, 0xd8, 0xc3, 0xc6, 0x80, 0x01, 0x00, 0x18, 0x68, 0xf5, 0xb1, 0x3e, 0x01, 0x00,
+	0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -99,6 +121,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.GraceCuPercent != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.GraceCuPercent))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.GracePeriodDays != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.GracePeriodDays))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -119,6 +151,12 @@ func (m *Params) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if m.GracePeriodDays != 0 {
+		n += 1 + sovParams(uint64(m.GracePeriodDays))
+	}
+	if m.GraceCuPercent != 0 {
+		n += 1 + sovParams(uint64(m.GraceCuPercent))
+	}
 	return n
 }
 
@@ -157,6 +195,44 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GracePeriodDays", wireType)
+			}
+			m.GracePeriodDays = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GracePeriodDays |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GraceCuPercent", wireType)
+			}
+			m.GraceCuPercent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GraceCuPercent |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
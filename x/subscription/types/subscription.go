@@ -16,8 +16,77 @@ func (sub Subscription) IsMonthExpired(date time.Time) bool {
 	return expiry.Before(date)
 }
 
+// IsStale returns whether the subscription ended before block, a subscription in its grace period isn't stale
 func (sub Subscription) IsStale(block uint64) bool {
-	return sub.DurationLeft == 0 && sub.PrevExpiryBlock < block
+	return sub.DurationLeft == 0 && !sub.IsInGracePeriod() && sub.PrevExpiryBlock < block
+}
+
+// IsInGracePeriod returns whether the subscription ended and still keeps a reduced service
+func (sub Subscription) IsInGracePeriod() bool {
+	return sub.GraceExpiryTime != 0
+}
+
+// HasAddOn returns whether the add-on was bought for the subscription
+func (sub Subscription) HasAddOn(addOn string) bool {
+	for _, subAddOn := range sub.AddOns {
+		if subAddOn == addOn {
+			return true
+		}
+	}
+	return false
+}
+
+// ProjectCuAllocation returns the monthly CU budget of a project of the subscription, and whether the project has one
+func (sub Subscription) ProjectCuAllocation(projectIndex string) (uint64, bool) {
+	for _, allocation := range sub.ProjectAllocations {
+		if allocation.Project != projectIndex {
+			continue
+		}
+		if allocation.Cu != 0 {
+			return allocation.Cu, true
+		}
+		return allocation.Percent * sub.MonthCuTotal / 100, true
+	}
+	return 0, false
+}
+
+// AllocatedCu returns the sum of the monthly CU budgets of the subscription's projects
+func (sub Subscription) AllocatedCu() uint64 {
+	var allocatedCu uint64
+	for _, allocation := range sub.ProjectAllocations {
+		projectCu, _ := sub.ProjectCuAllocation(allocation.Project)
+		allocatedCu += projectCu
+	}
+	return allocatedCu
+}
+
+// RemoveProjectAllocation removes the monthly CU budget of a project, returns whether it had one
+func (sub *Subscription) RemoveProjectAllocation(projectIndex string) bool {
+	allocations := []ProjectAllocation{}
+	for _, allocation := range sub.ProjectAllocations {
+		if allocation.Project != projectIndex {
+			allocations = append(allocations, allocation)
+		}
+	}
+	removed := len(allocations) != len(sub.ProjectAllocations)
+	sub.ProjectAllocations = allocations
+	return removed
+}
+
+// ProjectCuLeft returns the CU a project that used usedCu this month may still use, its remaining budget bounded by the
+// subscription's remaining CU
+func (sub Subscription) ProjectCuLeft(projectIndex string, usedCu uint64) uint64 {
+	allocation, found := sub.ProjectCuAllocation(projectIndex)
+	if !found {
+		return sub.MonthCuLeft
+	}
+	if usedCu >= allocation {
+		return 0
+	}
+	if allocation-usedCu < sub.MonthCuLeft {
+		return allocation - usedCu
+	}
+	return sub.MonthCuLeft
 }
 
 // ValidateSubscription validates a subscription object fields
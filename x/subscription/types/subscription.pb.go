@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
 	math "math"
@@ -23,18 +24,23 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type Subscription struct {
-	Creator         string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	Consumer        string `protobuf:"bytes,2,opt,name=consumer,proto3" json:"consumer,omitempty"`
-	Block           uint64 `protobuf:"varint,3,opt,name=block,proto3" json:"block,omitempty"`
-	PlanIndex       string `protobuf:"bytes,4,opt,name=plan_index,json=planIndex,proto3" json:"plan_index,omitempty"`
-	PlanBlock       uint64 `protobuf:"varint,5,opt,name=plan_block,json=planBlock,proto3" json:"plan_block,omitempty"`
-	DurationTotal   uint64 `protobuf:"varint,6,opt,name=duration_total,json=durationTotal,proto3" json:"duration_total,omitempty"`
-	DurationLeft    uint64 `protobuf:"varint,7,opt,name=duration_left,json=durationLeft,proto3" json:"duration_left,omitempty"`
-	MonthExpiryTime uint64 `protobuf:"varint,8,opt,name=month_expiry_time,json=monthExpiryTime,proto3" json:"month_expiry_time,omitempty"`
-	PrevExpiryBlock uint64 `protobuf:"varint,9,opt,name=prev_expiry_block,json=prevExpiryBlock,proto3" json:"prev_expiry_block,omitempty"`
-	MonthCuTotal    uint64 `protobuf:"varint,10,opt,name=month_cu_total,json=monthCuTotal,proto3" json:"month_cu_total,omitempty"`
-	MonthCuLeft     uint64 `protobuf:"varint,11,opt,name=month_cu_left,json=monthCuLeft,proto3" json:"month_cu_left,omitempty"`
-	PrevCuLeft      uint64 `protobuf:"varint,12,opt,name=prev_cu_left,json=prevCuLeft,proto3" json:"prev_cu_left,omitempty"`
+	Creator             string              `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Consumer            string              `protobuf:"bytes,2,opt,name=consumer,proto3" json:"consumer,omitempty"`
+	Block               uint64              `protobuf:"varint,3,opt,name=block,proto3" json:"block,omitempty"`
+	PlanIndex           string              `protobuf:"bytes,4,opt,name=plan_index,json=planIndex,proto3" json:"plan_index,omitempty"`
+	PlanBlock           uint64              `protobuf:"varint,5,opt,name=plan_block,json=planBlock,proto3" json:"plan_block,omitempty"`
+	DurationTotal       uint64              `protobuf:"varint,6,opt,name=duration_total,json=durationTotal,proto3" json:"duration_total,omitempty"`
+	DurationLeft        uint64              `protobuf:"varint,7,opt,name=duration_left,json=durationLeft,proto3" json:"duration_left,omitempty"`
+	MonthExpiryTime     uint64              `protobuf:"varint,8,opt,name=month_expiry_time,json=monthExpiryTime,proto3" json:"month_expiry_time,omitempty"`
+	PrevExpiryBlock     uint64              `protobuf:"varint,9,opt,name=prev_expiry_block,json=prevExpiryBlock,proto3" json:"prev_expiry_block,omitempty"`
+	MonthCuTotal        uint64              `protobuf:"varint,10,opt,name=month_cu_total,json=monthCuTotal,proto3" json:"month_cu_total,omitempty"`
+	MonthCuLeft         uint64              `protobuf:"varint,11,opt,name=month_cu_left,json=monthCuLeft,proto3" json:"month_cu_left,omitempty"`
+	PrevCuLeft          uint64              `protobuf:"varint,12,opt,name=prev_cu_left,json=prevCuLeft,proto3" json:"prev_cu_left,omitempty"`
+	AutoRenewal         bool                `protobuf:"varint,13,opt,name=auto_renewal,json=autoRenewal,proto3" json:"auto_renewal,omitempty"`
+	GraceExpiryTime     uint64              `protobuf:"varint,14,opt,name=grace_expiry_time,json=graceExpiryTime,proto3" json:"grace_expiry_time,omitempty"`
+	AddOns              []string            `protobuf:"bytes,15,rep,name=add_ons,json=addOns,proto3" json:"add_ons,omitempty"`
+	ProjectAllocations  []ProjectAllocation `protobuf:"bytes,16,rep,name=project_allocations,json=projectAllocations,proto3" json:"project_allocations"`
+	PendingMonthCuTotal uint64              `protobuf:"varint,17,opt,name=pending_month_cu_total,json=pendingMonthCuTotal,proto3" json:"pending_month_cu_total,omitempty"`
 }
 
 func (m *Subscription) Reset()         { *m = Subscription{} }
@@ -154,37 +160,144 @@ func (m *Subscription) GetPrevCuLeft() uint64 {
 	return 0
 }
 
+func (m *Subscription) GetAutoRenewal() bool {
+	if m != nil {
+		return m.AutoRenewal
+	}
+	return false
+}
+
+func (m *Subscription) GetGraceExpiryTime() uint64 {
+	if m != nil {
+		return m.GraceExpiryTime
+	}
+	return 0
+}
+
+func (m *Subscription) GetAddOns() []string {
+	if m != nil {
+		return m.AddOns
+	}
+	return nil
+}
+
+func (m *Subscription) GetProjectAllocations() []ProjectAllocation {
+	if m != nil {
+		return m.ProjectAllocations
+	}
+	return nil
+}
+
+func (m *Subscription) GetPendingMonthCuTotal() uint64 {
+	if m != nil {
+		return m.PendingMonthCuTotal
+	}
+	return 0
+}
+
+// ProjectAllocation caps the CU a project of the subscription may use each month, either a fixed amount or a percent of month_cu_total
+type ProjectAllocation struct {
+	Project string `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	Cu      uint64 `protobuf:"varint,2,opt,name=cu,proto3" json:"cu,omitempty"`
+	Percent uint64 `protobuf:"varint,3,opt,name=percent,proto3" json:"percent,omitempty"`
+}
+
+func (m *ProjectAllocation) Reset()         { *m = ProjectAllocation{} }
+func (m *ProjectAllocation) String() string { return proto.CompactTextString(m) }
+func (*ProjectAllocation) ProtoMessage()    {}
+func (*ProjectAllocation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ac47bc0f89224537, []int{1}
+}
+func (m *ProjectAllocation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProjectAllocation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProjectAllocation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProjectAllocation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProjectAllocation.Merge(m, src)
+}
+func (m *ProjectAllocation) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProjectAllocation) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProjectAllocation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProjectAllocation proto.InternalMessageInfo
+
+func (m *ProjectAllocation) GetProject() string {
+	if m != nil {
+		return m.Project
+	}
+	return ""
+}
+
+func (m *ProjectAllocation) GetCu() uint64 {
+	if m != nil {
+		return m.Cu
+	}
+	return 0
+}
+
+func (m *ProjectAllocation) GetPercent() uint64 {
+	if m != nil {
+		return m.Percent
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Subscription)(nil), "lavanet.lava.subscription.Subscription")
+	proto.RegisterType((*ProjectAllocation)(nil), "lavanet.lava.subscription.ProjectAllocation")
 }
 
 func init() { proto.RegisterFile("subscription/subscription.proto", fileDescriptor_ac47bc0f89224537) }
 
 var fileDescriptor_ac47bc0f89224537 = []byte{
-	// 353 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x92, 0xcf, 0x4a, 0xeb, 0x40,
-	0x14, 0xc6, 0x9b, 0xdb, 0xff, 0xa7, 0x69, 0x2f, 0x77, 0xb8, 0x8b, 0x51, 0x30, 0x96, 0xaa, 0x50,
-	0x44, 0x92, 0x85, 0x6f, 0x50, 0x51, 0x10, 0x5c, 0xd5, 0xae, 0xdc, 0x84, 0x24, 0x9d, 0xda, 0xc1,
-	0x24, 0x13, 0x26, 0x93, 0xd2, 0xbe, 0x85, 0x8f, 0xe1, 0xa3, 0xb8, 0xec, 0xd2, 0xa5, 0xb4, 0x2f,
-	0x22, 0x73, 0xa6, 0x09, 0x75, 0x35, 0x9c, 0xdf, 0xf7, 0x3b, 0x99, 0x0f, 0x32, 0x70, 0x9e, 0x17,
-	0x61, 0x1e, 0x49, 0x9e, 0x29, 0x2e, 0x52, 0xef, 0x78, 0x70, 0x33, 0x29, 0x94, 0x20, 0x27, 0x71,
-	0xb0, 0x0a, 0x52, 0xa6, 0x5c, 0x7d, 0xba, 0xc7, 0xc2, 0xe8, 0xa3, 0x0e, 0xf6, 0xf3, 0x11, 0x20,
-	0x14, 0xda, 0x91, 0x64, 0x81, 0x12, 0x92, 0x5a, 0x43, 0x6b, 0xdc, 0x9d, 0x96, 0x23, 0x39, 0x85,
-	0x4e, 0x24, 0xd2, 0xbc, 0x48, 0x98, 0xa4, 0x7f, 0x30, 0xaa, 0x66, 0xf2, 0x1f, 0x9a, 0x61, 0x2c,
-	0xa2, 0x37, 0x5a, 0x1f, 0x5a, 0xe3, 0xc6, 0xd4, 0x0c, 0xe4, 0x0c, 0x20, 0x8b, 0x83, 0xd4, 0xe7,
-	0xe9, 0x9c, 0xad, 0x69, 0x03, 0x77, 0xba, 0x9a, 0x3c, 0x6a, 0x50, 0xc5, 0x66, 0xb3, 0x89, 0x9b,
-	0x18, 0x4f, 0x70, 0xfb, 0x0a, 0x06, 0xf3, 0x42, 0x06, 0xba, 0x95, 0xaf, 0x84, 0x0a, 0x62, 0xda,
-	0x42, 0xa5, 0x5f, 0xd2, 0x99, 0x86, 0xe4, 0x02, 0x2a, 0xe0, 0xc7, 0x6c, 0xa1, 0x68, 0x1b, 0x2d,
-	0xbb, 0x84, 0x4f, 0x6c, 0xa1, 0xc8, 0x35, 0xfc, 0x4b, 0x44, 0xaa, 0x96, 0x3e, 0x5b, 0x67, 0x5c,
-	0x6e, 0x7c, 0xc5, 0x13, 0x46, 0x3b, 0x28, 0xfe, 0xc5, 0xe0, 0x1e, 0xf9, 0x8c, 0x27, 0x4c, 0xbb,
-	0x99, 0x64, 0xab, 0x52, 0x35, 0xed, 0xba, 0xc6, 0xd5, 0x81, 0x51, 0x4d, 0xc7, 0x4b, 0x18, 0x98,
-	0xef, 0x46, 0xc5, 0xa1, 0x23, 0x98, 0xdb, 0x91, 0xde, 0x15, 0xa6, 0xe2, 0x08, 0xfa, 0x95, 0x85,
-	0x15, 0x7b, 0x28, 0xf5, 0x0e, 0x12, 0x36, 0x1c, 0x82, 0x8d, 0xb7, 0x96, 0x8a, 0x8d, 0x0a, 0x68,
-	0x66, 0x8c, 0xc9, 0xc3, 0xe7, 0xce, 0xb1, 0xb6, 0x3b, 0xc7, 0xfa, 0xde, 0x39, 0xd6, 0xfb, 0xde,
-	0xa9, 0x6d, 0xf7, 0x4e, 0xed, 0x6b, 0xef, 0xd4, 0x5e, 0x6e, 0x5e, 0xb9, 0x5a, 0x16, 0xa1, 0x1b,
-	0x89, 0xc4, 0x3b, 0xfc, 0x6a, 0x3c, 0xbd, 0xf5, 0xaf, 0xd7, 0xe0, 0xa9, 0x4d, 0xc6, 0xf2, 0xb0,
-	0x85, 0x8f, 0xe2, 0xf6, 0x27, 0x00, 0x00, 0xff, 0xff, 0xd7, 0x6e, 0x85, 0xd9, 0x37, 0x02, 0x00,
-	0x00,
+	// 521 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x93, 0xdf, 0x6e, 0xd3, 0x30,
+	0x14, 0xc6, 0x9b, 0xf5, 0xff, 0xe9, 0x9f, 0xd1, 0x6c, 0x02, 0x33, 0x89, 0x2c, 0x14, 0x90, 0x2a,
+	0x34, 0xa5, 0x12, 0x7b, 0x02, 0x8a, 0x40, 0x42, 0x02, 0x81, 0xc2, 0x24, 0x24, 0x6e, 0x22, 0xd7,
+	0xf1, 0xba, 0x40, 0x6a, 0x47, 0x8e, 0x33, 0xba, 0xb7, 0xe0, 0x39, 0x78, 0x92, 0x5d, 0xee, 0x92,
+	0x2b, 0x84, 0xda, 0x17, 0x41, 0x3e, 0x4e, 0xaa, 0x6e, 0x88, 0xab, 0xe4, 0x7c, 0xdf, 0xef, 0xd8,
+	0x9f, 0xad, 0x63, 0x38, 0xce, 0x8b, 0x79, 0xce, 0x54, 0x92, 0xe9, 0x44, 0x8a, 0xe9, 0x6e, 0x11,
+	0x64, 0x4a, 0x6a, 0xe9, 0x3e, 0x4c, 0xe9, 0x25, 0x15, 0x5c, 0x07, 0xe6, 0x1b, 0xec, 0x02, 0x47,
+	0x87, 0x0b, 0xb9, 0x90, 0x48, 0x4d, 0xcd, 0x9f, 0x6d, 0x18, 0xff, 0x6c, 0x42, 0xff, 0xd3, 0x0e,
+	0xe6, 0x12, 0x68, 0x33, 0xc5, 0xa9, 0x96, 0x8a, 0x38, 0xbe, 0x33, 0xe9, 0x86, 0x55, 0xe9, 0x1e,
+	0x41, 0x87, 0x49, 0x91, 0x17, 0x4b, 0xae, 0xc8, 0x1e, 0x5a, 0xdb, 0xda, 0x3d, 0x84, 0xe6, 0x3c,
+	0x95, 0xec, 0x1b, 0xa9, 0xfb, 0xce, 0xa4, 0x11, 0xda, 0xc2, 0x7d, 0x04, 0x90, 0xa5, 0x54, 0x44,
+	0x89, 0x88, 0xf9, 0x8a, 0x34, 0xb0, 0xa7, 0x6b, 0x94, 0xb7, 0x46, 0xd8, 0xda, 0xb6, 0xb3, 0x89,
+	0x9d, 0x68, 0xcf, 0xb0, 0xfb, 0x19, 0x0c, 0xe3, 0x42, 0x51, 0x93, 0x2a, 0xd2, 0x52, 0xd3, 0x94,
+	0xb4, 0x10, 0x19, 0x54, 0xea, 0x99, 0x11, 0xdd, 0x27, 0xb0, 0x15, 0xa2, 0x94, 0x9f, 0x6b, 0xd2,
+	0x46, 0xaa, 0x5f, 0x89, 0xef, 0xf8, 0xb9, 0x76, 0x9f, 0xc3, 0x68, 0x29, 0x85, 0xbe, 0x88, 0xf8,
+	0x2a, 0x4b, 0xd4, 0x55, 0xa4, 0x93, 0x25, 0x27, 0x1d, 0x04, 0xf7, 0xd1, 0x78, 0x8d, 0xfa, 0x59,
+	0xb2, 0xe4, 0x86, 0xcd, 0x14, 0xbf, 0xac, 0x50, 0x9b, 0xae, 0x6b, 0x59, 0x63, 0x58, 0xd4, 0x66,
+	0x7c, 0x0a, 0x43, 0xbb, 0x2e, 0x2b, 0xca, 0x8c, 0x60, 0x77, 0x47, 0xf5, 0x55, 0x61, 0x23, 0x8e,
+	0x61, 0xb0, 0xa5, 0x30, 0x62, 0x0f, 0xa1, 0x5e, 0x09, 0x61, 0x42, 0x1f, 0xfa, 0xb8, 0x6b, 0x85,
+	0xf4, 0x11, 0x01, 0xa3, 0x95, 0xc4, 0x63, 0xe8, 0xd3, 0x42, 0xcb, 0x48, 0x71, 0xc1, 0xbf, 0xd3,
+	0x94, 0x0c, 0x7c, 0x67, 0xd2, 0x09, 0x7b, 0x46, 0x0b, 0xad, 0x64, 0xa2, 0x2f, 0x14, 0x65, 0xfc,
+	0xd6, 0x31, 0x87, 0x36, 0x3a, 0x1a, 0x3b, 0xc7, 0x7c, 0x00, 0x6d, 0x1a, 0xc7, 0x91, 0x14, 0x39,
+	0xd9, 0xf7, 0xeb, 0x93, 0x6e, 0xd8, 0xa2, 0x71, 0xfc, 0x41, 0xe4, 0x2e, 0x83, 0x83, 0x4c, 0xc9,
+	0xaf, 0x9c, 0xe9, 0x88, 0xa6, 0xa9, 0x64, 0x78, 0x8b, 0x39, 0xb9, 0xe7, 0xd7, 0x27, 0xbd, 0x17,
+	0x27, 0xc1, 0x7f, 0x27, 0x2c, 0xf8, 0x68, 0xbb, 0x5e, 0x6e, 0x9b, 0x66, 0x8d, 0xeb, 0xdf, 0xc7,
+	0xb5, 0xd0, 0xcd, 0xee, 0x1a, 0xb9, 0x7b, 0x0a, 0xf7, 0x33, 0x2e, 0xe2, 0x44, 0x2c, 0xa2, 0x3b,
+	0x17, 0x38, 0xc2, 0xb8, 0x07, 0xa5, 0xfb, 0x7e, 0xe7, 0x1e, 0xc7, 0x9f, 0x61, 0xf4, 0xcf, 0x1e,
+	0x66, 0x60, 0xcb, 0xf5, 0xab, 0x81, 0x2d, 0x4b, 0x77, 0x08, 0x7b, 0xac, 0xc0, 0x51, 0x6d, 0x84,
+	0x7b, 0xac, 0x40, 0x92, 0x2b, 0xc6, 0x85, 0x2e, 0xc7, 0xb4, 0x2a, 0x67, 0x6f, 0xae, 0xd7, 0x9e,
+	0x73, 0xb3, 0xf6, 0x9c, 0x3f, 0x6b, 0xcf, 0xf9, 0xb1, 0xf1, 0x6a, 0x37, 0x1b, 0xaf, 0xf6, 0x6b,
+	0xe3, 0xd5, 0xbe, 0x9c, 0x2c, 0x12, 0x7d, 0x51, 0xcc, 0x03, 0x26, 0x97, 0xd3, 0xf2, 0xe4, 0xf8,
+	0x9d, 0xae, 0x6e, 0x3d, 0xbf, 0xa9, 0xbe, 0xca, 0x78, 0x3e, 0x6f, 0xe1, 0xa3, 0x3a, 0xfd, 0x3b,
+	0x00, 0xec, 0xdc, 0x07, 0x10, 0xa8, 0x03, 0x00, 0x00,
 }
 
 func (m *Subscription) Marshal() (dAtA []byte, err error) {
@@ -207,6 +320,53 @@ func (m *Subscription) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PendingMonthCuTotal != 0 {
+		i = encodeVarintSubscription(dAtA, i, uint64(m.PendingMonthCuTotal))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if len(m.ProjectAllocations) > 0 {
+		for iNdEx := len(m.ProjectAllocations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProjectAllocations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSubscription(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.AddOns) > 0 {
+		for iNdEx := len(m.AddOns) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AddOns[iNdEx])
+			copy(dAtA[i:], m.AddOns[iNdEx])
+			i = encodeVarintSubscription(dAtA, i, uint64(len(m.AddOns[iNdEx])))
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if m.GraceExpiryTime != 0 {
+		i = encodeVarintSubscription(dAtA, i, uint64(m.GraceExpiryTime))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.AutoRenewal {
+		i--
+		if m.AutoRenewal {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
 	if m.PrevCuLeft != 0 {
 		i = encodeVarintSubscription(dAtA, i, uint64(m.PrevCuLeft))
 		i--
@@ -276,6 +436,46 @@ func (m *Subscription) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ProjectAllocation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProjectAllocation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProjectAllocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Percent != 0 {
+		i = encodeVarintSubscription(dAtA, i, uint64(m.Percent))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Cu != 0 {
+		i = encodeVarintSubscription(dAtA, i, uint64(m.Cu))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Project) > 0 {
+		i -= len(m.Project)
+		copy(dAtA[i:], m.Project)
+		i = encodeVarintSubscription(dAtA, i, uint64(len(m.Project)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintSubscription(dAtA []byte, offset int, v uint64) int {
 	offset -= sovSubscription(v)
 	base := offset
@@ -332,6 +532,46 @@ func (m *Subscription) Size() (n int) {
 	if m.PrevCuLeft != 0 {
 		n += 1 + sovSubscription(uint64(m.PrevCuLeft))
 	}
+	if m.AutoRenewal {
+		n += 2
+	}
+	if m.GraceExpiryTime != 0 {
+		n += 1 + sovSubscription(uint64(m.GraceExpiryTime))
+	}
+	if len(m.AddOns) > 0 {
+		for _, s := range m.AddOns {
+			l = len(s)
+			n += 1 + l + sovSubscription(uint64(l))
+		}
+	}
+	if len(m.ProjectAllocations) > 0 {
+		for _, e := range m.ProjectAllocations {
+			l = e.Size()
+			n += 2 + l + sovSubscription(uint64(l))
+		}
+	}
+	if m.PendingMonthCuTotal != 0 {
+		n += 2 + sovSubscription(uint64(m.PendingMonthCuTotal))
+	}
+	return n
+}
+
+func (m *ProjectAllocation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Project)
+	if l > 0 {
+		n += 1 + l + sovSubscription(uint64(l))
+	}
+	if m.Cu != 0 {
+		n += 1 + sovSubscription(uint64(m.Cu))
+	}
+	if m.Percent != 0 {
+		n += 1 + sovSubscription(uint64(m.Percent))
+	}
 	return n
 }
 
@@ -637,6 +877,250 @@ func (m *Subscription) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoRenewal", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoRenewal = bool(v != 0)
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GraceExpiryTime", wireType)
+			}
+			m.GraceExpiryTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GraceExpiryTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddOns", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddOns = append(m.AddOns, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectAllocations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProjectAllocations = append(m.ProjectAllocations, ProjectAllocation{})
+			if err := m.ProjectAllocations[len(m.ProjectAllocations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingMonthCuTotal", wireType)
+			}
+			m.PendingMonthCuTotal = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PendingMonthCuTotal |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubscription(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProjectAllocation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubscription
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProjectAllocation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProjectAllocation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Project = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cu", wireType)
+			}
+			m.Cu = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Cu |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Percent", wireType)
+			}
+			m.Percent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Percent |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSubscription(dAtA[iNdEx:])
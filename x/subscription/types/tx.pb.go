@@ -30,11 +30,12 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type MsgBuy struct {
-	Creator  string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	Consumer string `protobuf:"bytes,2,opt,name=consumer,proto3" json:"consumer,omitempty"`
-	Index    string `protobuf:"bytes,3,opt,name=index,proto3" json:"index,omitempty"`
-	Duration uint64 `protobuf:"varint,4,opt,name=duration,proto3" json:"duration,omitempty"`
-	Vrfpk    string `protobuf:"bytes,5,opt,name=vrfpk,proto3" json:"vrfpk,omitempty"`
+	Creator     string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Consumer    string `protobuf:"bytes,2,opt,name=consumer,proto3" json:"consumer,omitempty"`
+	Index       string `protobuf:"bytes,3,opt,name=index,proto3" json:"index,omitempty"`
+	Duration    uint64 `protobuf:"varint,4,opt,name=duration,proto3" json:"duration,omitempty"`
+	Vrfpk       string `protobuf:"bytes,5,opt,name=vrfpk,proto3" json:"vrfpk,omitempty"`
+	AutoRenewal bool   `protobuf:"varint,6,opt,name=auto_renewal,json=autoRenewal,proto3" json:"auto_renewal,omitempty"`
 }
 
 func (m *MsgBuy) Reset()         { *m = MsgBuy{} }
@@ -105,6 +106,13 @@ func (m *MsgBuy) GetVrfpk() string {
 	return ""
 }
 
+func (m *MsgBuy) GetAutoRenewal() bool {
+	if m != nil {
+		return m.AutoRenewal
+	}
+	return false
+}
+
 type MsgBuyResponse struct {
 }
 
@@ -229,40 +237,354 @@ func (m *MsgAddProjectResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgAddProjectResponse proto.InternalMessageInfo
 
+type MsgUpgradeSubscription struct {
+	Creator  string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Consumer string `protobuf:"bytes,2,opt,name=consumer,proto3" json:"consumer,omitempty"`
+	Index    string `protobuf:"bytes,3,opt,name=index,proto3" json:"index,omitempty"`
+}
+
+func (m *MsgUpgradeSubscription) Reset()         { *m = MsgUpgradeSubscription{} }
+func (m *MsgUpgradeSubscription) String() string { return proto.CompactTextString(m) }
+func (*MsgUpgradeSubscription) ProtoMessage()    {}
+func (*MsgUpgradeSubscription) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc8b79a0f6744252, []int{4}
+}
+func (m *MsgUpgradeSubscription) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpgradeSubscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpgradeSubscription.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpgradeSubscription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpgradeSubscription.Merge(m, src)
+}
+func (m *MsgUpgradeSubscription) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpgradeSubscription) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpgradeSubscription.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpgradeSubscription proto.InternalMessageInfo
+
+func (m *MsgUpgradeSubscription) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgUpgradeSubscription) GetConsumer() string {
+	if m != nil {
+		return m.Consumer
+	}
+	return ""
+}
+
+func (m *MsgUpgradeSubscription) GetIndex() string {
+	if m != nil {
+		return m.Index
+	}
+	return ""
+}
+
+type MsgUpgradeSubscriptionResponse struct {
+}
+
+func (m *MsgUpgradeSubscriptionResponse) Reset()         { *m = MsgUpgradeSubscriptionResponse{} }
+func (m *MsgUpgradeSubscriptionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpgradeSubscriptionResponse) ProtoMessage()    {}
+func (*MsgUpgradeSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc8b79a0f6744252, []int{5}
+}
+func (m *MsgUpgradeSubscriptionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpgradeSubscriptionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpgradeSubscriptionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpgradeSubscriptionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpgradeSubscriptionResponse.Merge(m, src)
+}
+func (m *MsgUpgradeSubscriptionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpgradeSubscriptionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpgradeSubscriptionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpgradeSubscriptionResponse proto.InternalMessageInfo
+
+type MsgBuyAddOn struct {
+	Creator  string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Consumer string `protobuf:"bytes,2,opt,name=consumer,proto3" json:"consumer,omitempty"`
+	AddOn    string `protobuf:"bytes,3,opt,name=add_on,json=addOn,proto3" json:"add_on,omitempty"`
+}
+
+func (m *MsgBuyAddOn) Reset()         { *m = MsgBuyAddOn{} }
+func (m *MsgBuyAddOn) String() string { return proto.CompactTextString(m) }
+func (*MsgBuyAddOn) ProtoMessage()    {}
+func (*MsgBuyAddOn) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc8b79a0f6744252, []int{6}
+}
+func (m *MsgBuyAddOn) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBuyAddOn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBuyAddOn.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgBuyAddOn) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBuyAddOn.Merge(m, src)
+}
+func (m *MsgBuyAddOn) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBuyAddOn) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBuyAddOn.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBuyAddOn proto.InternalMessageInfo
+
+func (m *MsgBuyAddOn) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgBuyAddOn) GetConsumer() string {
+	if m != nil {
+		return m.Consumer
+	}
+	return ""
+}
+
+func (m *MsgBuyAddOn) GetAddOn() string {
+	if m != nil {
+		return m.AddOn
+	}
+	return ""
+}
+
+type MsgBuyAddOnResponse struct {
+}
+
+func (m *MsgBuyAddOnResponse) Reset()         { *m = MsgBuyAddOnResponse{} }
+func (m *MsgBuyAddOnResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBuyAddOnResponse) ProtoMessage()    {}
+func (*MsgBuyAddOnResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc8b79a0f6744252, []int{7}
+}
+func (m *MsgBuyAddOnResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBuyAddOnResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBuyAddOnResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgBuyAddOnResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBuyAddOnResponse.Merge(m, src)
+}
+func (m *MsgBuyAddOnResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBuyAddOnResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBuyAddOnResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBuyAddOnResponse proto.InternalMessageInfo
+
+type MsgSetProjectAllocation struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Project string `protobuf:"bytes,2,opt,name=project,proto3" json:"project,omitempty"`
+	Cu      uint64 `protobuf:"varint,3,opt,name=cu,proto3" json:"cu,omitempty"`
+	Percent uint64 `protobuf:"varint,4,opt,name=percent,proto3" json:"percent,omitempty"`
+}
+
+func (m *MsgSetProjectAllocation) Reset()         { *m = MsgSetProjectAllocation{} }
+func (m *MsgSetProjectAllocation) String() string { return proto.CompactTextString(m) }
+func (*MsgSetProjectAllocation) ProtoMessage()    {}
+func (*MsgSetProjectAllocation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc8b79a0f6744252, []int{8}
+}
+func (m *MsgSetProjectAllocation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetProjectAllocation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetProjectAllocation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetProjectAllocation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetProjectAllocation.Merge(m, src)
+}
+func (m *MsgSetProjectAllocation) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetProjectAllocation) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetProjectAllocation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetProjectAllocation proto.InternalMessageInfo
+
+func (m *MsgSetProjectAllocation) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgSetProjectAllocation) GetProject() string {
+	if m != nil {
+		return m.Project
+	}
+	return ""
+}
+
+func (m *MsgSetProjectAllocation) GetCu() uint64 {
+	if m != nil {
+		return m.Cu
+	}
+	return 0
+}
+
+func (m *MsgSetProjectAllocation) GetPercent() uint64 {
+	if m != nil {
+		return m.Percent
+	}
+	return 0
+}
+
+type MsgSetProjectAllocationResponse struct {
+}
+
+func (m *MsgSetProjectAllocationResponse) Reset()         { *m = MsgSetProjectAllocationResponse{} }
+func (m *MsgSetProjectAllocationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetProjectAllocationResponse) ProtoMessage()    {}
+func (*MsgSetProjectAllocationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc8b79a0f6744252, []int{9}
+}
+func (m *MsgSetProjectAllocationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetProjectAllocationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetProjectAllocationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetProjectAllocationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetProjectAllocationResponse.Merge(m, src)
+}
+func (m *MsgSetProjectAllocationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetProjectAllocationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetProjectAllocationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetProjectAllocationResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgBuy)(nil), "lavanet.lava.subscription.MsgBuy")
 	proto.RegisterType((*MsgBuyResponse)(nil), "lavanet.lava.subscription.MsgBuyResponse")
 	proto.RegisterType((*MsgAddProject)(nil), "lavanet.lava.subscription.MsgAddProject")
 	proto.RegisterType((*MsgAddProjectResponse)(nil), "lavanet.lava.subscription.MsgAddProjectResponse")
+	proto.RegisterType((*MsgUpgradeSubscription)(nil), "lavanet.lava.subscription.MsgUpgradeSubscription")
+	proto.RegisterType((*MsgUpgradeSubscriptionResponse)(nil), "lavanet.lava.subscription.MsgUpgradeSubscriptionResponse")
+	proto.RegisterType((*MsgBuyAddOn)(nil), "lavanet.lava.subscription.MsgBuyAddOn")
+	proto.RegisterType((*MsgBuyAddOnResponse)(nil), "lavanet.lava.subscription.MsgBuyAddOnResponse")
+	proto.RegisterType((*MsgSetProjectAllocation)(nil), "lavanet.lava.subscription.MsgSetProjectAllocation")
+	proto.RegisterType((*MsgSetProjectAllocationResponse)(nil), "lavanet.lava.subscription.MsgSetProjectAllocationResponse")
 }
 
 func init() { proto.RegisterFile("subscription/tx.proto", fileDescriptor_cc8b79a0f6744252) }
 
 var fileDescriptor_cc8b79a0f6744252 = []byte{
-	// 362 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0xbd, 0x4e, 0xf3, 0x30,
-	0x14, 0x8d, 0xbf, 0xfe, 0x7c, 0xe0, 0x02, 0x42, 0x51, 0x0b, 0x21, 0x43, 0x28, 0x99, 0x8a, 0x84,
-	0x1c, 0x54, 0x9e, 0x80, 0x0a, 0xb1, 0xa0, 0x4a, 0x28, 0x6c, 0x2c, 0xc8, 0x4d, 0x4c, 0x1a, 0xa0,
-	0xb1, 0x65, 0x3b, 0x55, 0xfb, 0x02, 0xcc, 0x3c, 0x14, 0x43, 0xc7, 0x8e, 0x4c, 0x08, 0xb5, 0x2f,
-	0x82, 0x62, 0x27, 0xfd, 0x19, 0x28, 0x4c, 0xbe, 0xc7, 0x3e, 0xe7, 0xfa, 0x9c, 0x6b, 0xc3, 0x86,
-	0x48, 0x7b, 0x22, 0xe0, 0x31, 0x93, 0x31, 0x4d, 0x3c, 0x39, 0x42, 0x8c, 0x53, 0x49, 0xcd, 0xa3,
-	0x17, 0x3c, 0xc4, 0x09, 0x91, 0x28, 0x5b, 0xd1, 0x2a, 0xc7, 0x3e, 0x60, 0x9c, 0x3e, 0x91, 0x40,
-	0x0a, 0x2f, 0x2f, 0xb4, 0xc4, 0xae, 0x47, 0x34, 0xa2, 0xaa, 0xf4, 0xb2, 0x4a, 0xef, 0xba, 0xaf,
-	0x00, 0x56, 0xbb, 0x22, 0xea, 0xa4, 0x63, 0xd3, 0x82, 0xff, 0x03, 0x4e, 0xb0, 0xa4, 0xdc, 0x02,
-	0x4d, 0xd0, 0xda, 0xf6, 0x0b, 0x68, 0xda, 0x70, 0x2b, 0xa0, 0x89, 0x48, 0x07, 0x84, 0x5b, 0xff,
-	0xd4, 0xd1, 0x02, 0x9b, 0x75, 0x58, 0x89, 0x93, 0x90, 0x8c, 0xac, 0x92, 0x3a, 0xd0, 0x20, 0x53,
-	0x84, 0x29, 0xc7, 0x99, 0x21, 0xab, 0xdc, 0x04, 0xad, 0xb2, 0xbf, 0xc0, 0x99, 0x62, 0xc8, 0x1f,
-	0xd9, 0xb3, 0x55, 0xd1, 0x0a, 0x05, 0xdc, 0x7d, 0xb8, 0xa7, 0x7d, 0xf8, 0x44, 0x30, 0x9a, 0x08,
-	0xe2, 0x0e, 0xe1, 0x6e, 0x57, 0x44, 0x97, 0x61, 0x78, 0xab, 0x73, 0x6c, 0x30, 0x78, 0x03, 0x77,
-	0xf2, 0xb0, 0x0f, 0x21, 0x96, 0x58, 0x99, 0xac, 0xb5, 0x5d, 0xb4, 0x36, 0xa5, 0x62, 0x2e, 0x28,
-	0xef, 0x77, 0x85, 0x25, 0xee, 0x94, 0x27, 0x9f, 0xc7, 0x86, 0x5f, 0x63, 0xcb, 0x2d, 0xf7, 0x10,
-	0x36, 0xd6, 0xee, 0x2d, 0x0c, 0xb5, 0xdf, 0x01, 0x2c, 0x75, 0x45, 0x64, 0xde, 0xc1, 0x52, 0x36,
-	0xaf, 0x13, 0xf4, 0xe3, 0x23, 0x20, 0x1d, 0xc5, 0x3e, 0xfd, 0x95, 0x52, 0x34, 0x37, 0xfb, 0x10,
-	0xae, 0x44, 0x6d, 0x6d, 0x16, 0x2e, 0x99, 0xf6, 0xf9, 0x5f, 0x99, 0xc5, 0x4d, 0x9d, 0xeb, 0xc9,
-	0xcc, 0x01, 0xd3, 0x99, 0x03, 0xbe, 0x66, 0x0e, 0x78, 0x9b, 0x3b, 0xc6, 0x74, 0xee, 0x18, 0x1f,
-	0x73, 0xc7, 0xb8, 0x3f, 0x8b, 0x62, 0xd9, 0x4f, 0x7b, 0x28, 0xa0, 0x03, 0x2f, 0xef, 0xaa, 0x56,
-	0x6f, 0xe4, 0xad, 0x7f, 0xc3, 0x31, 0x23, 0xa2, 0x57, 0x55, 0x3f, 0xe8, 0xe2, 0x3b, 0x00, 0x00,
-	0xff, 0xff, 0xe8, 0xdf, 0x9b, 0xf9, 0xa3, 0x02, 0x00, 0x00,
+	// 550 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0xce, 0x36, 0x69, 0x1a, 0x26, 0xa5, 0x42, 0x6e, 0xd2, 0x1a, 0x1f, 0xdc, 0xc4, 0x07, 0x14,
+	0x24, 0x64, 0x43, 0x38, 0xc1, 0x2d, 0x11, 0xe2, 0x82, 0x22, 0x90, 0x23, 0x2e, 0xbd, 0x44, 0x1b,
+	0xef, 0xe2, 0x06, 0x52, 0xaf, 0xb5, 0xbb, 0x4e, 0xd3, 0x33, 0x12, 0x67, 0x5e, 0x83, 0x27, 0xe0,
+	0x15, 0x7a, 0xec, 0x91, 0x13, 0x42, 0xc9, 0x8b, 0x20, 0xaf, 0x7f, 0x9a, 0x48, 0x6e, 0xd2, 0x22,
+	0x4e, 0xde, 0x99, 0x9d, 0x6f, 0xbe, 0x6f, 0xbe, 0x4c, 0x16, 0x9a, 0x22, 0x1a, 0x0b, 0x8f, 0x4f,
+	0x42, 0x39, 0x61, 0x81, 0x23, 0xe7, 0x76, 0xc8, 0x99, 0x64, 0xda, 0xe3, 0x29, 0x9e, 0xe1, 0x80,
+	0x4a, 0x3b, 0xfe, 0xda, 0xab, 0x35, 0xc6, 0x51, 0xc8, 0xd9, 0x67, 0xea, 0x49, 0xe1, 0xa4, 0x87,
+	0x04, 0x62, 0x34, 0x7c, 0xe6, 0x33, 0x75, 0x74, 0xe2, 0x53, 0x92, 0xb5, 0x7e, 0x20, 0xa8, 0x0e,
+	0x84, 0xdf, 0x8f, 0x2e, 0x35, 0x1d, 0xf6, 0x3c, 0x4e, 0xb1, 0x64, 0x5c, 0x47, 0x2d, 0xd4, 0x79,
+	0xe0, 0x66, 0xa1, 0x66, 0x40, 0xcd, 0x63, 0x81, 0x88, 0xce, 0x29, 0xd7, 0x77, 0xd4, 0x55, 0x1e,
+	0x6b, 0x0d, 0xd8, 0x9d, 0x04, 0x84, 0xce, 0xf5, 0xb2, 0xba, 0x48, 0x82, 0x18, 0x41, 0x22, 0x8e,
+	0x63, 0x41, 0x7a, 0xa5, 0x85, 0x3a, 0x15, 0x37, 0x8f, 0x63, 0xc4, 0x8c, 0x7f, 0x0a, 0xbf, 0xe8,
+	0xbb, 0x09, 0x42, 0x05, 0x5a, 0x1b, 0xf6, 0x71, 0x24, 0xd9, 0x88, 0xd3, 0x80, 0x5e, 0xe0, 0xa9,
+	0x5e, 0x6d, 0xa1, 0x4e, 0xcd, 0xad, 0xc7, 0x39, 0x37, 0x49, 0x59, 0x8f, 0xe0, 0x20, 0x91, 0xea,
+	0x52, 0x11, 0xb2, 0x40, 0x50, 0x6b, 0x06, 0x0f, 0x07, 0xc2, 0xef, 0x11, 0xf2, 0x21, 0x19, 0x75,
+	0xc3, 0x0c, 0xef, 0x60, 0x3f, 0xf5, 0x63, 0x44, 0xb0, 0xc4, 0x6a, 0x8e, 0x7a, 0xd7, 0xb2, 0xd7,
+	0x8c, 0xcc, 0xac, 0xb3, 0xd3, 0x7e, 0x6f, 0xb0, 0xc4, 0xfd, 0xca, 0xd5, 0xef, 0x93, 0x92, 0x5b,
+	0x0f, 0x6f, 0x52, 0xd6, 0x31, 0x34, 0xd7, 0x78, 0x73, 0x41, 0x04, 0x8e, 0x06, 0xc2, 0xff, 0x18,
+	0xfa, 0x1c, 0x13, 0x3a, 0x5c, 0xf9, 0x59, 0xfe, 0xa7, 0xbb, 0x56, 0x0b, 0xcc, 0x62, 0x96, 0x5c,
+	0xc7, 0x29, 0xd4, 0x13, 0xab, 0x7a, 0x84, 0xbc, 0xff, 0x57, 0xf2, 0x26, 0x54, 0x31, 0x21, 0x23,
+	0x16, 0x64, 0xec, 0x38, 0x6e, 0x66, 0x35, 0xe1, 0x70, 0xa5, 0x77, 0x4e, 0x79, 0x01, 0xc7, 0x03,
+	0xe1, 0x0f, 0xa9, 0x4c, 0x3d, 0xe9, 0x4d, 0xa7, 0xcc, 0xc3, 0x5b, 0x66, 0xd7, 0x61, 0x2f, 0xf5,
+	0x35, 0x65, 0xcf, 0x42, 0xed, 0x00, 0x76, 0xbc, 0x48, 0x11, 0x57, 0xdc, 0x1d, 0x2f, 0x52, 0x95,
+	0x94, 0x7b, 0x34, 0x90, 0xe9, 0x42, 0x65, 0xa1, 0xd5, 0x86, 0x93, 0x5b, 0x88, 0x33, 0x6d, 0xdd,
+	0x9f, 0x15, 0x28, 0x0f, 0x84, 0xaf, 0x0d, 0xa1, 0x1c, 0x6f, 0x7a, 0xdb, 0xbe, 0xf5, 0xef, 0x63,
+	0x27, 0xa3, 0x19, 0x4f, 0xb7, 0x96, 0x64, 0xcd, 0xb5, 0x33, 0x80, 0x95, 0x0d, 0xec, 0x6c, 0x06,
+	0xde, 0x54, 0x1a, 0xcf, 0xef, 0x5a, 0x99, 0x33, 0x7d, 0x45, 0x70, 0x58, 0xb4, 0x5b, 0x2f, 0x36,
+	0x77, 0x2a, 0x80, 0x18, 0xaf, 0xee, 0x0d, 0xc9, 0x55, 0x8c, 0xa1, 0x96, 0x2f, 0xd6, 0x93, 0xad,
+	0x36, 0xa9, 0x3a, 0xc3, 0xbe, 0x5b, 0x5d, 0xce, 0xf1, 0x0d, 0x41, 0xa3, 0x70, 0x95, 0xba, 0x9b,
+	0x1b, 0x15, 0x61, 0x8c, 0xd7, 0xf7, 0xc7, 0x64, 0x42, 0xfa, 0x6f, 0xaf, 0x16, 0x26, 0xba, 0x5e,
+	0x98, 0xe8, 0xcf, 0xc2, 0x44, 0xdf, 0x97, 0x66, 0xe9, 0x7a, 0x69, 0x96, 0x7e, 0x2d, 0xcd, 0xd2,
+	0xe9, 0x33, 0x7f, 0x22, 0xcf, 0xa2, 0xb1, 0xed, 0xb1, 0x73, 0x27, 0xed, 0xaf, 0xbe, 0xce, 0xdc,
+	0x59, 0x7f, 0xb3, 0x2f, 0x43, 0x2a, 0xc6, 0x55, 0xf5, 0xdc, 0xbe, 0xfc, 0x3b, 0x00, 0x28, 0xe8,
+	0xca, 0x25, 0xd0, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -279,6 +601,9 @@ const _ = grpc.SupportPackageIsVersion4
 type MsgClient interface {
 	Buy(ctx context.Context, in *MsgBuy, opts ...grpc.CallOption) (*MsgBuyResponse, error)
 	AddProject(ctx context.Context, in *MsgAddProject, opts ...grpc.CallOption) (*MsgAddProjectResponse, error)
+	UpgradeSubscription(ctx context.Context, in *MsgUpgradeSubscription, opts ...grpc.CallOption) (*MsgUpgradeSubscriptionResponse, error)
+	BuyAddOn(ctx context.Context, in *MsgBuyAddOn, opts ...grpc.CallOption) (*MsgBuyAddOnResponse, error)
+	SetProjectAllocation(ctx context.Context, in *MsgSetProjectAllocation, opts ...grpc.CallOption) (*MsgSetProjectAllocationResponse, error)
 }
 
 type msgClient struct {
@@ -307,10 +632,40 @@ func (c *msgClient) AddProject(ctx context.Context, in *MsgAddProject, opts ...g
 	return out, nil
 }
 
+func (c *msgClient) UpgradeSubscription(ctx context.Context, in *MsgUpgradeSubscription, opts ...grpc.CallOption) (*MsgUpgradeSubscriptionResponse, error) {
+	out := new(MsgUpgradeSubscriptionResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.subscription.Msg/UpgradeSubscription", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) BuyAddOn(ctx context.Context, in *MsgBuyAddOn, opts ...grpc.CallOption) (*MsgBuyAddOnResponse, error) {
+	out := new(MsgBuyAddOnResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.subscription.Msg/BuyAddOn", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetProjectAllocation(ctx context.Context, in *MsgSetProjectAllocation, opts ...grpc.CallOption) (*MsgSetProjectAllocationResponse, error) {
+	out := new(MsgSetProjectAllocationResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.subscription.Msg/SetProjectAllocation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	Buy(context.Context, *MsgBuy) (*MsgBuyResponse, error)
 	AddProject(context.Context, *MsgAddProject) (*MsgAddProjectResponse, error)
+	UpgradeSubscription(context.Context, *MsgUpgradeSubscription) (*MsgUpgradeSubscriptionResponse, error)
+	BuyAddOn(context.Context, *MsgBuyAddOn) (*MsgBuyAddOnResponse, error)
+	SetProjectAllocation(context.Context, *MsgSetProjectAllocation) (*MsgSetProjectAllocationResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -323,6 +678,15 @@ func (*UnimplementedMsgServer) Buy(ctx context.Context, req *MsgBuy) (*MsgBuyRes
 func (*UnimplementedMsgServer) AddProject(ctx context.Context, req *MsgAddProject) (*MsgAddProjectResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddProject not implemented")
 }
+func (*UnimplementedMsgServer) UpgradeSubscription(ctx context.Context, req *MsgUpgradeSubscription) (*MsgUpgradeSubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpgradeSubscription not implemented")
+}
+func (*UnimplementedMsgServer) BuyAddOn(ctx context.Context, req *MsgBuyAddOn) (*MsgBuyAddOnResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BuyAddOn not implemented")
+}
+func (*UnimplementedMsgServer) SetProjectAllocation(ctx context.Context, req *MsgSetProjectAllocation) (*MsgSetProjectAllocationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetProjectAllocation not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -364,6 +728,60 @@ func _Msg_AddProject_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpgradeSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpgradeSubscription)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpgradeSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.subscription.Msg/UpgradeSubscription",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpgradeSubscription(ctx, req.(*MsgUpgradeSubscription))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BuyAddOn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBuyAddOn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BuyAddOn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.subscription.Msg/BuyAddOn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BuyAddOn(ctx, req.(*MsgBuyAddOn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetProjectAllocation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetProjectAllocation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetProjectAllocation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.subscription.Msg/SetProjectAllocation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetProjectAllocation(ctx, req.(*MsgSetProjectAllocation))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "lavanet.lava.subscription.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -376,8 +794,20 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AddProject",
 			Handler:    _Msg_AddProject_Handler,
 		},
-	},
-	Streams:  []grpc.StreamDesc{},
+		{
+			MethodName: "UpgradeSubscription",
+			Handler:    _Msg_UpgradeSubscription_Handler,
+		},
+		{
+			MethodName: "BuyAddOn",
+			Handler:    _Msg_BuyAddOn_Handler,
+		},
+		{
+			MethodName: "SetProjectAllocation",
+			Handler:    _Msg_SetProjectAllocation_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
 	Metadata: "subscription/tx.proto",
 }
 
@@ -401,6 +831,16 @@ func (m *MsgBuy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AutoRenewal {
+		i--
+		if m.AutoRenewal {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
 	if len(m.Vrfpk) > 0 {
 		i -= len(m.Vrfpk)
 		copy(dAtA[i:], m.Vrfpk)
@@ -523,6 +963,210 @@ func (m *MsgAddProjectResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgUpgradeSubscription) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpgradeSubscription) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpgradeSubscription) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Consumer) > 0 {
+		i -= len(m.Consumer)
+		copy(dAtA[i:], m.Consumer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Consumer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpgradeSubscriptionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpgradeSubscriptionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpgradeSubscriptionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBuyAddOn) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBuyAddOn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBuyAddOn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AddOn) > 0 {
+		i -= len(m.AddOn)
+		copy(dAtA[i:], m.AddOn)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AddOn)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Consumer) > 0 {
+		i -= len(m.Consumer)
+		copy(dAtA[i:], m.Consumer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Consumer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBuyAddOnResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBuyAddOnResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBuyAddOnResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetProjectAllocation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetProjectAllocation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetProjectAllocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Percent != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Percent))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Cu != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Cu))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Project) > 0 {
+		i -= len(m.Project)
+		copy(dAtA[i:], m.Project)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Project)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetProjectAllocationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetProjectAllocationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetProjectAllocationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -559,6 +1203,9 @@ func (m *MsgBuy) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.AutoRenewal {
+		n += 2
+	}
 	return n
 }
 
@@ -581,27 +1228,551 @@ func (m *MsgAddProject) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = m.ProjectData.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
+	l = m.ProjectData.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgAddProjectResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpgradeSubscription) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Consumer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpgradeSubscriptionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgBuyAddOn) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Consumer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.AddOn)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgBuyAddOnResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetProjectAllocation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Project)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Cu != 0 {
+		n += 1 + sovTx(uint64(m.Cu))
+	}
+	if m.Percent != 0 {
+		n += 1 + sovTx(uint64(m.Percent))
+	}
+	return n
+}
+
+func (m *MsgSetProjectAllocationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgBuy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBuy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBuy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Consumer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Consumer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			m.Duration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Duration |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vrfpk", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Vrfpk = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoRenewal", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoRenewal = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBuyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBuyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBuyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddProject) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddProject: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddProject: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectData", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProjectData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddProjectResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddProjectResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddProjectResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgAddProjectResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgBuy) Unmarshal(dAtA []byte) error {
+func (m *MsgUpgradeSubscription) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -624,10 +1795,10 @@ func (m *MsgBuy) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBuy: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpgradeSubscription: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBuy: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpgradeSubscription: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -726,11 +1897,111 @@ func (m *MsgBuy) Unmarshal(dAtA []byte) error {
 			}
 			m.Index = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.Duration = 0
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpgradeSubscriptionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpgradeSubscriptionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpgradeSubscriptionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBuyAddOn) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBuyAddOn: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBuyAddOn: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -740,14 +2011,27 @@ func (m *MsgBuy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Duration |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Vrfpk", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Consumer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -775,7 +2059,39 @@ func (m *MsgBuy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Vrfpk = string(dAtA[iNdEx:postIndex])
+			m.Consumer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddOn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddOn = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -798,7 +2114,7 @@ func (m *MsgBuy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBuyResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgBuyAddOnResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -821,10 +2137,10 @@ func (m *MsgBuyResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBuyResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBuyAddOnResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBuyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBuyAddOnResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -848,7 +2164,7 @@ func (m *MsgBuyResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddProject) Unmarshal(dAtA []byte) error {
+func (m *MsgSetProjectAllocation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -871,10 +2187,10 @@ func (m *MsgAddProject) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddProject: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetProjectAllocation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddProject: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetProjectAllocation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -911,9 +2227,9 @@ func (m *MsgAddProject) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProjectData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -923,25 +2239,62 @@ func (m *MsgAddProject) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ProjectData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Project = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cu", wireType)
+			}
+			m.Cu = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Cu |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Percent", wireType)
+			}
+			m.Percent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Percent |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -963,7 +2316,7 @@ func (m *MsgAddProject) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddProjectResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetProjectAllocationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -986,10 +2339,10 @@ func (m *MsgAddProjectResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddProjectResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetProjectAllocationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddProjectResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetProjectAllocationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1,6 +1,17 @@
 package types
 
 const (
-	BuySubscriptionEventName = "buy_subscription_event"
-	AddProjectEventName      = "add_project_to_subscription_event"
+	BuySubscriptionEventName            = "buy_subscription_event"
+	AddProjectEventName                 = "add_project_to_subscription_event"
+	SubscriptionExpiredEventName        = "expire_subscription_event"
+	SubscriptionRenewedEventName        = "renew_subscription_event"
+	SubscriptionUpgradedEventName       = "upgrade_subscription_event"
+	SubscriptionUpgradeAppliedEventName = "upgrade_subscription_applied_event"
+	BuyAddOnEventName                   = "buy_add_on_event"
+	SetProjectAllocationEventName       = "set_project_allocation_event"
+	RemoveProjectAllocationEventName    = "remove_project_allocation_event"
+)
+
+const (
+	FlagAutoRenewal = "auto-renewal"
 )